@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"wish-list/internal/app"
+	"wish-list/internal/app/database"
+	"wish-list/internal/app/jobs"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepo "wish-list/internal/domain/user/repository"
+	userservice "wish-list/internal/domain/user/service"
+	"wish-list/internal/pkg/mailer"
+)
+
+// minAdminPasswordLength is stricter than the public registration minimum
+const minAdminPasswordLength = 12
+
+func runCreateAdminUser(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("create-admin-user", flag.ContinueOnError)
+	var (
+		email     = fs.String("email", "", "Admin account email (required)")
+		password  = fs.String("password", "", "Password for a new account (defaults to ADMIN_PASSWORD env var)")
+		firstName = fs.String("first-name", "", "First name for a new account")
+		lastName  = fs.String("last-name", "", "Last name for a new account")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	*email = strings.TrimSpace(*email)
+	if *email == "" {
+		return errors.New("-email is required")
+	}
+
+	repos, err := env.Repositories(ctx)
+	if err != nil {
+		return err
+	}
+	userRepo := repos.Users
+
+	// Promote an existing account; its password is left unchanged
+	existing, err := userRepo.GetByEmail(ctx, *email)
+	switch {
+	case err == nil:
+		if existing.UserType == usermodels.UserTypeAdmin {
+			log.Printf("User %s is already an admin", existing.ID.String())
+			return nil
+		}
+		if _, err := userRepo.SetUserType(ctx, existing.ID, usermodels.UserTypeAdmin); err != nil {
+			return err
+		}
+		log.Printf("[AUDIT] User promoted to admin: UserID=%s", existing.ID.String())
+		return nil
+	case !errors.Is(err, userrepo.ErrUserNotFound):
+		return fmt.Errorf("failed to look up user: %w", err)
+	}
+
+	if *password == "" {
+		*password = os.Getenv("ADMIN_PASSWORD")
+	}
+	if len(*password) < minAdminPasswordLength {
+		return fmt.Errorf("password must be at least %d characters (use -password or ADMIN_PASSWORD)", minAdminPasswordLength)
+	}
+
+	userSvc := userservice.NewUserService(userRepo, nil)
+	created, err := userSvc.Register(ctx, userservice.RegisterUserInput{
+		Email:     *email,
+		Password:  *password,
+		FirstName: *firstName,
+		LastName:  *lastName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	id, err := database.StringToUUID(created.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := userRepo.SetUserType(ctx, id, usermodels.UserTypeAdmin); err != nil {
+		return err
+	}
+
+	log.Printf("[AUDIT] Admin user created: UserID=%s", created.ID)
+	return nil
+}
+
+func runSetPlan(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("set-plan", flag.ContinueOnError)
+	var (
+		email = fs.String("email", "", "Account email (required)")
+		plan  = fs.String("plan", "", "Plan to assign: free or premium (required)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	*email = strings.TrimSpace(*email)
+	if *email == "" {
+		return errors.New("-email is required")
+	}
+	if *plan != usermodels.PlanFree && *plan != usermodels.PlanPremium {
+		return fmt.Errorf("-plan must be %s or %s", usermodels.PlanFree, usermodels.PlanPremium)
+	}
+
+	repos, err := env.Repositories(ctx)
+	if err != nil {
+		return err
+	}
+	userRepo := repos.Users
+
+	user, err := userRepo.GetByEmail(ctx, *email)
+	if err != nil {
+		return fmt.Errorf("failed to look up user: %w", err)
+	}
+	if user.Plan == *plan {
+		log.Printf("User %s is already on the %s plan", user.ID.String(), *plan)
+		return nil
+	}
+
+	if _, err := userRepo.SetPlan(ctx, user.ID, *plan); err != nil {
+		return err
+	}
+
+	log.Printf("[AUDIT] User plan changed: UserID=%s Plan=%s->%s", user.ID.String(), user.Plan, *plan)
+	return nil
+}
+
+func runRotateEncryptionKey(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("rotate-encryption-key", flag.ContinueOnError)
+	var (
+		batchSize        = fs.Int("batch-size", jobs.DefaultReEncryptionBatchSize, "Number of rows processed per batch")
+		dryRun           = fs.Bool("dry-run", false, "Report rows that need re-encryption without updating them")
+		encryptPlaintext = fs.Bool("encrypt-plaintext", false, "Also encrypt guest PII still stored in plaintext columns")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := env.DB(ctx)
+	if err != nil {
+		return err
+	}
+	encSvc, err := env.EncryptionService(ctx)
+	if err != nil {
+		return err
+	}
+	if encSvc == nil {
+		return errors.New("no data key configured (set ENCRYPTION_DATA_KEY or ENCRYPTED_DATA_KEY)")
+	}
+
+	log.Printf("Re-encrypting PII with the current key (dry run: %t)", *dryRun)
+
+	job := jobs.NewReEncryptionJob(db, encSvc, *batchSize, *dryRun)
+
+	if *encryptPlaintext {
+		plaintextStats, err := job.EncryptPlaintext(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("Plaintext encryption completed: scanned=%d updated=%d conflicts=%d", plaintextStats.Scanned, plaintextStats.Updated, plaintextStats.Conflicts)
+	}
+
+	stats, err := job.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Re-encryption completed: scanned=%d updated=%d conflicts=%d", stats.Scanned, stats.Updated, stats.Conflicts)
+	return nil
+}
+
+func runResendFailedEmails(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("resend-failed-emails", flag.ContinueOnError)
+	limit := fs.Int("limit", 100, "Maximum number of emails to resend")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *limit <= 0 {
+		return errors.New("-limit must be positive")
+	}
+
+	db, err := env.DB(ctx)
+	if err != nil {
+		return err
+	}
+	encSvc, err := env.EncryptionService(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := jobs.NewFailedEmailStore(db, encSvc)
+	stats, err := jobs.ResendFailedEmails(ctx, store, mailer.New(app.MailerConfig(env.cfg)), *limit)
+	if err != nil {
+		return fmt.Errorf("resent %d emails before failing: %w", stats.Sent, err)
+	}
+
+	log.Printf("Resend completed: sent=%d failed=%d dropped=%d", stats.Sent, stats.Failed, stats.Dropped)
+	return nil
+}
+
+func runRecomputeSlugs(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("recompute-slugs", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Old slugs are evicted from the public wishlist cache when Redis is reachable
+	svcs, err := env.Services(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := svcs.WishLists.RecomputeInvalidPublicSlugs(ctx)
+	if err != nil {
+		return fmt.Errorf("recomputed %d slugs before failing: %w", updated, err)
+	}
+
+	log.Printf("Recomputed slugs for %d public wishlists", updated)
+	return nil
+}
+
+func runPurgeSoftDeleted(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("purge-soft-deleted", flag.ContinueOnError)
+	olderThanDays := fs.Int("older-than-days", 30, "Purge items archived more than this many days ago")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *olderThanDays < 0 {
+		return errors.New("-older-than-days must not be negative")
+	}
+
+	repos, err := env.Repositories(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := env.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	before := time.Now().Add(-time.Duration(*olderThanDays) * 24 * time.Hour)
+	purged, err := repos.GiftItems.PurgeArchivedBefore(ctx, before)
+	if err != nil {
+		return err
+	}
+	log.Printf("Purged %d archived items", purged)
+
+	emailSvc, err := env.EmailService(ctx)
+	if err != nil {
+		return err
+	}
+
+	cleanupSvc := jobs.NewAccountCleanupService(
+		db,
+		repos.Users,
+		repos.WishLists,
+		repos.GiftItems,
+		repos.Reservations,
+		emailSvc,
+		env.cfg.AccountDeletionGrace,
+	)
+	if err := cleanupSvc.DeletePendingAccounts(ctx); err != nil {
+		return err
+	}
+
+	log.Println("Accounts past their deletion grace period purged")
+	return nil
+}
+
+func runCacheFlush(ctx context.Context, env *environment, args []string) error {
+	fs := flag.NewFlagSet("cache-flush", flag.ContinueOnError)
+	pattern := fs.String("pattern", "wishlist:*", "Key pattern to delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	redisCache, err := env.Cache()
+	if err != nil {
+		return err
+	}
+
+	if err := redisCache.DeletePattern(ctx, *pattern); err != nil {
+		return err
+	}
+
+	log.Printf("Flushed cache keys matching %q", *pattern)
+	return nil
+}
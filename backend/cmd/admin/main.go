@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"wish-list/internal/app"
+	"wish-list/internal/app/config"
+	"wish-list/internal/app/database"
+	"wish-list/internal/app/jobs"
+	"wish-list/internal/pkg/cache"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/secrets"
+)
+
+// command is a single admin subcommand
+type command struct {
+	description string
+	run         func(ctx context.Context, env *environment, args []string) error
+}
+
+var commands = map[string]command{
+	"create-admin-user": {
+		description: "Create an admin account or promote an existing user to admin",
+		run:         runCreateAdminUser,
+	},
+	"rotate-encryption-key": {
+		description: "Re-encrypt PII with the current key (ENCRYPTION_CURRENT_KEY_ID)",
+		run:         runRotateEncryptionKey,
+	},
+	"resend-failed-emails": {
+		description: "Send emails the providers did not accept again",
+		run:         runResendFailedEmails,
+	},
+	"recompute-slugs": {
+		description: "Assign new slugs to public wishlists with a missing or invalid slug",
+		run:         runRecomputeSlugs,
+	},
+	"purge-soft-deleted": {
+		description: "Permanently delete archived items and accounts past their deletion grace period",
+		run:         runPurgeSoftDeleted,
+	},
+	"set-plan": {
+		description: "Assign a plan (free or premium) to a user",
+		run:         runSetPlan,
+	},
+	"cache-flush": {
+		description: "Delete cached entries from Redis",
+		run:         runCacheFlush,
+	},
+}
+
+// admin runs operational tasks against the application database and cache.
+// Usage: admin <command> [flags]; run "admin <command> -h" for command flags.
+func main() {
+	// Load environment variables from .env file
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		if name != "-h" && name != "--help" && name != "help" {
+			fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", name)
+		}
+		printUsage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if _, err := secrets.ResolveEnv(ctx); err != nil {
+		log.Fatalf("Failed to load secrets: %v", err)
+	}
+
+	cfg := config.Load()
+	logger.Initialize(cfg.ServerEnv)
+
+	env := &environment{cfg: cfg}
+	defer env.Close()
+
+	if err := cmd.run(ctx, env, os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		log.Printf("%s failed: %v", name, err)
+		env.Close()
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "Usage: admin <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", name, commands[name].description)
+	}
+}
+
+// environment lazily opens the connections a command needs
+type environment struct {
+	cfg           *config.Config
+	db            *database.DB
+	encryptionSvc *encryption.Service
+	redisCache    *cache.RedisCache
+}
+
+// DB connects to the application database
+func (e *environment) DB(ctx context.Context) (*database.DB, error) {
+	if e.db != nil {
+		return e.db, nil
+	}
+
+	db, err := app.Connect(ctx, e.cfg)
+	if err != nil {
+		return nil, err
+	}
+	e.db = db
+
+	return db, nil
+}
+
+// EncryptionService loads the PII keyring. It returns nil without error when no data key
+// is configured, so commands never encrypt with a throwaway development key.
+func (e *environment) EncryptionService(ctx context.Context) (*encryption.Service, error) {
+	if e.encryptionSvc != nil {
+		return e.encryptionSvc, nil
+	}
+
+	if os.Getenv("ENCRYPTION_DATA_KEY") == "" && os.Getenv("ENCRYPTED_DATA_KEY") == "" {
+		return nil, nil
+	}
+
+	keyCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	legacyKey, _, err := encryption.GetOrCreateDataKey(keyCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load data key: %w", err)
+	}
+
+	keys, currentKeyID, err := encryption.LoadKeyring(keyCtx, legacyKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load encryption keyring: %w", err)
+	}
+
+	encSvc, err := encryption.NewServiceWithKeys(keys, currentKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption service: %w", err)
+	}
+	e.encryptionSvc = encSvc
+
+	return encSvc, nil
+}
+
+// Repositories returns the core stores, encrypting PII like the server does
+func (e *environment) Repositories(ctx context.Context) (*app.Repositories, error) {
+	db, err := e.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encSvc, err := e.EncryptionService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewRepositories(db, encSvc), nil
+}
+
+// EmailService sends through the configured SMTP providers, or the sandbox
+func (e *environment) EmailService(ctx context.Context) (*jobs.EmailService, error) {
+	db, err := e.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encSvc, err := e.EncryptionService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewEmailService(e.cfg, db, encSvc), nil
+}
+
+// Services returns the domain services wired like the server's. Caching is
+// skipped with a warning when Redis cannot be reached.
+func (e *environment) Services(ctx context.Context) (*app.Services, error) {
+	db, err := e.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encSvc, err := e.EncryptionService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := e.Repositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cacheSvc cache.CacheInterface
+	if redisCache, err := e.Cache(); err != nil {
+		log.Printf("Warning: %v; stale cache entries expire after the cache TTL", err)
+	} else {
+		cacheSvc = redisCache
+	}
+
+	return app.NewServices(e.cfg, db, encSvc, repos, cacheSvc, nil), nil
+}
+
+// errCacheUnavailable is returned when Redis cannot be reached
+var errCacheUnavailable = errors.New("redis cache is not available")
+
+// Cache connects to Redis
+func (e *environment) Cache() (*cache.RedisCache, error) {
+	if e.redisCache != nil {
+		return e.redisCache, nil
+	}
+
+	redisCache, err := cache.NewRedisCache(
+		e.cfg.RedisAddr,
+		e.cfg.RedisPassword,
+		e.cfg.RedisDB,
+		e.cfg.CacheTTL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCacheUnavailable, err)
+	}
+	e.redisCache = redisCache
+
+	return redisCache, nil
+}
+
+// Close releases all opened connections
+func (e *environment) Close() {
+	if e.db != nil {
+		if err := e.db.Close(); err != nil {
+			log.Printf("Failed to close database: %v", err)
+		}
+		e.db = nil
+	}
+	if e.redisCache != nil {
+		if err := e.redisCache.Close(); err != nil {
+			log.Printf("Failed to close Redis cache: %v", err)
+		}
+		e.redisCache = nil
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+
+	"wish-list/internal/app/database/seed"
+)
+
+// target is one request of the scenario
+type target struct {
+	Name   string // Endpoint, used to tag k6 metrics
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// scenarioConfig selects the requests of a scenario
+type scenarioConfig struct {
+	BaseURL string
+	Items   int    // Gift items on the load test wishlist
+	Token   string // Access token of the load test user; item listing is skipped without it
+}
+
+// Item listing filters exercised by the scenario, covering each sort and the
+// attached and search filters that add joins and predicates
+var itemListQueries = []url.Values{
+	{"limit": {"10"}},
+	{"limit": {"50"}, "sort": {"price"}, "order": {"asc"}},
+	{"limit": {"50"}, "sort": {"priority"}, "order": {"desc"}, "attached": {"true"}},
+	{"limit": {"20"}, "sort": {"title"}, "search": {"gift 1"}},
+	{"limit": {"100"}, "page": {"2"}, "sort": {"updated_at"}, "include_archived": {"true"}},
+}
+
+// buildTargets returns the scenario requests: the public wishlist, each page of its
+// gift items at two page sizes, the owner's item listing with several filters, and a
+// guest reservation of every item
+func buildTargets(cfg scenarioConfig) []target {
+	base := strings.TrimRight(cfg.BaseURL, "/")
+	var targets []target
+
+	targets = append(targets, target{
+		Name:   "public_wishlist",
+		Method: http.MethodGet,
+		URL:    base + "/api/public/wishlists/" + seed.LoadTestSlug,
+	})
+
+	for _, limit := range []int{10, 50} {
+		pages := max(1, (cfg.Items+limit-1)/limit)
+		for page := 1; page <= pages; page++ {
+			query := url.Values{"page": {fmt.Sprint(page)}, "limit": {fmt.Sprint(limit)}}
+			targets = append(targets, target{
+				Name:   "public_gift_items",
+				Method: http.MethodGet,
+				URL:    base + "/api/public/wishlists/" + seed.LoadTestSlug + "/gift-items?" + query.Encode(),
+			})
+		}
+	}
+
+	if cfg.Token != "" {
+		for _, query := range itemListQueries {
+			targets = append(targets, target{
+				Name:   "item_list",
+				Method: http.MethodGet,
+				URL:    base + "/api/items?" + query.Encode(),
+				Header: http.Header{"Authorization": {"Bearer " + cfg.Token}},
+			})
+		}
+	}
+
+	for i := range cfg.Items {
+		body, _ := json.Marshal(map[string]string{"guest_name": fmt.Sprintf("Load Test Guest %d", i)})
+		targets = append(targets, target{
+			Name:   "create_reservation",
+			Method: http.MethodPost,
+			URL:    base + "/api/public/reservations/wishlist/" + seed.LoadTestWishListID + "/item/" + seed.LoadTestItemID(i),
+			Header: http.Header{"Content-Type": {"application/json"}},
+			Body:   body,
+		})
+	}
+
+	return targets
+}
+
+// writeVegetaHTTP writes targets in vegeta's default http format. The format references
+// bodies by file path, so each body is stored with writeBody, which returns its path.
+func writeVegetaHTTP(w io.Writer, targets []target, writeBody func(name string, body []byte) (string, error)) error {
+	for i, t := range targets {
+		if _, err := fmt.Fprintf(w, "%s %s\n", t.Method, t.URL); err != nil {
+			return err
+		}
+		for _, key := range sortedKeys(t.Header) {
+			for _, value := range t.Header[key] {
+				if _, err := fmt.Fprintf(w, "%s: %s\n", key, value); err != nil {
+					return err
+				}
+			}
+		}
+		if len(t.Body) > 0 {
+			path, err := writeBody(fmt.Sprintf("body-%d.json", i), t.Body)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "@%s\n", path); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vegetaJSONTarget is a target in vegeta's json format
+type vegetaJSONTarget struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"` // base64
+}
+
+// writeVegetaJSON writes targets in vegeta's json format, one object per line
+func writeVegetaJSON(w io.Writer, targets []target) error {
+	enc := json.NewEncoder(w)
+	for _, t := range targets {
+		line := vegetaJSONTarget{Method: t.Method, URL: t.URL, Header: t.Header}
+		if len(t.Body) > 0 {
+			line.Body = base64.StdEncoding.EncodeToString(t.Body)
+		}
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// k6Script requests every target in turn and tags requests with the target name so
+// k6 reports latency per endpoint. Thresholds fail the run on regressions.
+var k6Script = template.Must(template.New("k6").Parse(`// Generated by cmd/loadtest. Run with: k6 run <this file>
+import http from 'k6/http';
+import { check } from 'k6';
+
+export const options = {
+  scenarios: {
+    hot_endpoints: {
+      executor: 'constant-arrival-rate',
+      rate: {{.Rate}},
+      timeUnit: '1s',
+      duration: '{{.Duration}}',
+      preAllocatedVUs: {{.VUs}},
+    },
+  },
+  thresholds: {
+    http_req_failed: ['rate<0.01'],
+    'http_req_duration{name:public_wishlist}': ['p(95)<200'],
+    'http_req_duration{name:public_gift_items}': ['p(95)<300'],
+    'http_req_duration{name:item_list}': ['p(95)<300'],
+    'http_req_duration{name:create_reservation}': ['p(95)<500'],
+  },
+};
+
+const targets = {{.Targets}};
+
+export default function () {
+  const t = targets[(__VU * 7919 + __ITER) % targets.length];
+  const params = { headers: t.header || {}, tags: { name: t.name } };
+  const res = http.request(t.method, t.url, t.body || null, params);
+  check(res, { 'status is 2xx': (r) => r.status >= 200 && r.status < 300 });
+}
+`))
+
+// k6Target is a target as embedded in the k6 script; bodies are plain strings
+type k6Target struct {
+	Name   string            `json:"name"`
+	Method string            `json:"method"`
+	URL    string            `json:"url"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   string            `json:"body,omitempty"`
+}
+
+// k6Options are the load profile of the generated k6 script
+type k6Options struct {
+	Rate     int
+	Duration string
+	VUs      int
+}
+
+// writeK6 writes a k6 script replaying the targets at a constant arrival rate
+func writeK6(w io.Writer, targets []target, opts k6Options) error {
+	embedded := make([]k6Target, 0, len(targets))
+	for _, t := range targets {
+		header := make(map[string]string, len(t.Header))
+		for key := range t.Header {
+			header[key] = t.Header.Get(key)
+		}
+		embedded = append(embedded, k6Target{Name: t.Name, Method: t.Method, URL: t.URL, Header: header, Body: string(t.Body)})
+	}
+
+	data, err := json.MarshalIndent(embedded, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return k6Script.Execute(w, struct {
+		k6Options
+		Targets string
+	}{opts, string(data)})
+}
+
+func sortedKeys(header http.Header) []string {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
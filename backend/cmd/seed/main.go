@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"wish-list/internal/app"
+	"wish-list/internal/app/config"
+	"wish-list/internal/app/database/seed"
+	"wish-list/internal/pkg/logger"
+)
+
+// seed populates the database with deterministic fixture data for local development,
+// demos and e2e tests. Run migrations first. Seeded users log in with seed.Password.
+func main() {
+	// Load environment variables from .env file
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	var (
+		env   = flag.String("env", "", "Target environment; must be \"development\"")
+		clean = flag.Bool("clean", false, "Delete previously seeded data before seeding")
+	)
+	flag.Parse()
+
+	// Both the flag and SERVER_ENV must agree so a stray shell variable cannot
+	// point the seeder at a shared database
+	if *env != "development" {
+		log.Fatal("Refusing to seed: pass --env=development")
+	}
+	if serverEnv := os.Getenv("SERVER_ENV"); serverEnv != "" && serverEnv != "development" {
+		log.Fatalf("Refusing to seed: SERVER_ENV is %q", serverEnv)
+	}
+
+	logger.Initialize("development")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	db, err := app.Connect(ctx, config.Load())
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	if *clean {
+		deleted, err := seed.Clean(ctx, db)
+		if err != nil {
+			log.Fatal("Failed to clean seed data:", err)
+		}
+		log.Printf("Removed %d seeded users and their data", deleted)
+	}
+
+	stats, err := seed.Seed(ctx, db)
+	if err != nil {
+		log.Fatal("Failed to seed database:", err)
+	}
+
+	log.Printf("Seed completed: users=%d wishlists=%d items=%d reservations=%d (existing rows skipped)",
+		stats.Users, stats.WishLists, stats.GiftItems, stats.Reservations)
+}
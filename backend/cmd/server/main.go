@@ -1,10 +1,16 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"wish-list/internal/app"
 	"wish-list/internal/app/config"
+	"wish-list/internal/pkg/secrets"
 
 	"github.com/joho/godotenv"
 )
@@ -28,17 +34,59 @@ import (
 //	@name						Authorization
 //	@description				Type "Bearer" followed by a space and JWT token.
 
+//	@securityDefinitions.apikey	APITokenAuth
+//	@in							header
+//	@name						Authorization
+//	@description				Type "Bearer" followed by a space and a personal API token (wlt_...). Accepted only by /ext routes and the calendar feed.
+
+//	@securityDefinitions.apikey	PartnerKeyAuth
+//	@in							header
+//	@name						X-API-Key
+//	@description				Partner API key (wlp_...). Accepted only by /partner/v1 routes.
+
 func main() {
+	printConfig := flag.Bool("print-config", false, "Print the effective configuration with secrets redacted and exit")
+	flag.Parse()
+
 	if err := godotenv.Load(); err != nil {
 		log.Println("No .env file found, using system environment variables")
 	}
 
+	// Replace secrets-manager references (aws-sm://, aws-ssm://, vault://) with their values
+	secretsCtx, secretsCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	resolver, err := secrets.ResolveEnv(secretsCtx)
+	secretsCancel()
+	if err != nil {
+		log.Fatalf("Failed to load secrets: %v", err)
+	}
+
 	cfg := config.Load()
+	validationErr := cfg.Validate()
+
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		if validationErr != nil {
+			fmt.Fprintf(os.Stderr, "\nInvalid configuration:\n%v\n", validationErr)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Fail fast outside development; locally, defaults keep the server usable
+	if validationErr != nil {
+		if !cfg.IsDevelopment() {
+			log.Fatalf("Invalid configuration:\n%v", validationErr)
+		}
+		log.Printf("WARNING: Invalid configuration (ignored in development):\n%v", validationErr)
+	}
 
 	application, err := app.New(cfg)
 	if err != nil {
 		log.Fatalf("Failed to initialize application: %v", err)
 	}
+	application.UseSecrets(resolver)
 
 	if err := application.Run(); err != nil {
 		log.Fatalf("Application error: %v", err)
@@ -0,0 +1,50 @@
+//go:build integration
+
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type authResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// TestAuthJourney signs a registered user in with their password, refreshes
+// the session and checks that wrong passwords and taken emails are refused.
+func TestAuthJourney(t *testing.T) {
+	account := register(t, "Priya")
+	visitor := guest(t)
+
+	var login authResponse
+	visitor.do(http.MethodPost, "/api/auth/login", map[string]any{
+		"email":    account.Email,
+		"password": "correct horse battery",
+	}, http.StatusOK, &login)
+	require.NotEmpty(t, login.AccessToken)
+	require.NotEmpty(t, login.RefreshToken)
+
+	// Mobile clients send the refresh token as a bearer token and get a new pair
+	mobile := &client{t: t, token: login.RefreshToken}
+	var refreshed authResponse
+	mobile.do(http.MethodPost, "/api/auth/refresh", nil, http.StatusOK, &refreshed)
+	assert.NotEmpty(t, refreshed.AccessToken)
+	assert.NotEmpty(t, refreshed.RefreshToken)
+
+	visitor.do(http.MethodPost, "/api/auth/login", map[string]any{
+		"email":    account.Email,
+		"password": "wrong horse battery",
+	}, http.StatusUnauthorized, nil)
+
+	visitor.do(http.MethodPost, "/api/auth/register", map[string]any{
+		"email":      account.Email,
+		"password":   "another horse battery",
+		"first_name": "Priya",
+		"last_name":  "Tester",
+	}, http.StatusConflict, nil)
+}
@@ -0,0 +1,379 @@
+//go:build integration
+
+package e2e
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sentEmail is one message accepted by the fake SMTP server
+type sentEmail struct {
+	To   string
+	Data string
+}
+
+// smtpServer accepts every message and keeps it for the tests to inspect. It
+// offers no STARTTLS or AUTH, so the mailer sends in plain text.
+type smtpServer struct {
+	ln net.Listener
+
+	mu   sync.Mutex
+	sent []sentEmail
+}
+
+func startSMTP() (*smtpServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start fake SMTP server: %w", err)
+	}
+	s := &smtpServer{ln: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s, nil
+}
+
+func (s *smtpServer) Addr() (host, port string) {
+	host, port, _ = net.SplitHostPort(s.ln.Addr().String())
+	return host, port
+}
+
+func (s *smtpServer) Close() {
+	_ = s.ln.Close()
+}
+
+// To returns the messages sent to recipient, oldest first
+func (s *smtpServer) To(recipient string) []sentEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sent []sentEmail
+	for _, email := range s.sent {
+		if strings.EqualFold(email.To, recipient) {
+			sent = append(sent, email)
+		}
+	}
+	return sent
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+
+	var recipients []string
+	_ = text.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			_ = text.PrintfLine("250 fake")
+		case "MAIL", "NOOP":
+			_ = text.PrintfLine("250 OK")
+		case "RSET":
+			recipients = nil
+			_ = text.PrintfLine("250 OK")
+		case "RCPT":
+			_, to, _ := strings.Cut(arg, ":")
+			recipients = append(recipients, strings.Trim(to, "<> "))
+			_ = text.PrintfLine("250 OK")
+		case "DATA":
+			_ = text.PrintfLine("354 go ahead")
+			data, err := text.ReadDotLines()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			for _, to := range recipients {
+				s.sent = append(s.sent, sentEmail{To: to, Data: strings.Join(data, "\n")})
+			}
+			s.mu.Unlock()
+			recipients = nil
+			_ = text.PrintfLine("250 queued")
+		case "QUIT":
+			_ = text.PrintfLine("221 bye")
+			return
+		default:
+			_ = text.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+// s3Server keeps objects in memory. It serves path-style requests, which the
+// SDK uses for an endpoint given as an IP address. Without TLS the SDK sends
+// every body whole, never aws-chunked.
+type s3Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string][]byte // By "bucket/key"
+}
+
+func startS3() *s3Server {
+	s := &s3Server{objects: map[string][]byte{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Object returns the stored object and whether there is one
+func (s *s3Server) Object(bucket, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[bucket+"/"+key]
+	return data, ok
+}
+
+func (s *s3Server) handle(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.objects[name] = data
+		s.mu.Unlock()
+		w.Header().Set("ETag", `"fake"`)
+	case http.MethodGet, http.MethodHead:
+		if !strings.Contains(name, "/") {
+			return // HeadBucket
+		}
+		s.mu.Lock()
+		data, ok := s.objects[name]
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, name)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// redisServer speaks enough RESP2 for the cache: strings with an expiry and
+// SCAN over them. Other commands, including the RESP3 HELLO, are refused, which
+// go-redis takes as an old server.
+type redisServer struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+}
+
+func startRedis() (*redisServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start fake Redis server: %w", err)
+	}
+	s := &redisServer{ln: ln, values: map[string]string{}, expires: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s, nil
+}
+
+func (s *redisServer) Addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *redisServer) Close() {
+	_ = s.ln.Close()
+}
+
+// Has reports whether key holds an unexpired value
+func (s *redisServer) Has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.get(key)
+	return ok
+}
+
+// get returns the value of key, dropping it once expired. The caller holds mu.
+func (s *redisServer) get(key string) (string, bool) {
+	if at, ok := s.expires[key]; ok && !time.Now().Before(at) {
+		delete(s.values, key)
+		delete(s.expires, key)
+	}
+	value, ok := s.values[key]
+	return value, ok
+}
+
+func (s *redisServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		s.exec(w, args)
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *redisServer) exec(w *bufio.Writer, args []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch cmd := strings.ToUpper(args[0]); {
+	case cmd == "PING":
+		fmt.Fprint(w, "+PONG\r\n")
+	case cmd == "GET" && len(args) == 2:
+		if value, ok := s.get(args[1]); ok {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(value), value)
+		} else {
+			fmt.Fprint(w, "$-1\r\n")
+		}
+	case cmd == "SET" && len(args) >= 3:
+		s.set(w, args[1], args[2], args[3:])
+	case cmd == "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := s.get(key); ok {
+				delete(s.values, key)
+				delete(s.expires, key)
+				deleted++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", deleted)
+	case cmd == "SCAN":
+		// Every key in one page; the cursor is always 0
+		pattern := "*"
+		for i := 2; i+1 < len(args); i += 2 {
+			if strings.EqualFold(args[i], "MATCH") {
+				pattern = args[i+1]
+			}
+		}
+		var keys []string
+		for key := range s.values {
+			if _, ok := s.get(key); !ok {
+				continue
+			}
+			if ok, _ := path.Match(pattern, key); ok {
+				keys = append(keys, key)
+			}
+		}
+		fmt.Fprintf(w, "*2\r\n$1\r\n0\r\n*%d\r\n", len(keys))
+		for _, key := range keys {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(key), key)
+		}
+	default:
+		fmt.Fprintf(w, "-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// set handles SET key value with an optional EX, PX, NX or XX. The caller holds mu.
+func (s *redisServer) set(w *bufio.Writer, key, value string, options []string) {
+	var ttl time.Duration
+	for i := 0; i < len(options); i++ {
+		switch option := strings.ToUpper(options[i]); option {
+		case "EX", "PX":
+			if i+1 == len(options) {
+				fmt.Fprint(w, "-ERR syntax error\r\n")
+				return
+			}
+			n, err := strconv.Atoi(options[i+1])
+			if err != nil {
+				fmt.Fprint(w, "-ERR value is not an integer or out of range\r\n")
+				return
+			}
+			ttl = time.Duration(n) * time.Millisecond
+			if option == "EX" {
+				ttl = time.Duration(n) * time.Second
+			}
+			i++
+		case "NX", "XX":
+			if _, exists := s.get(key); exists == (option == "NX") {
+				fmt.Fprint(w, "$-1\r\n")
+				return
+			}
+		default:
+			fmt.Fprint(w, "-ERR syntax error\r\n")
+			return
+		}
+	}
+
+	s.values[key] = value
+	delete(s.expires, key)
+	if ttl > 0 {
+		s.expires[key] = time.Now().Add(ttl)
+	}
+	fmt.Fprint(w, "+OK\r\n")
+}
+
+// readCommand reads one command, sent as an array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("expected an array, got %q", line)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("bad array length %q", line)
+	}
+
+	args := make([]string, 0, n)
+	for range n {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(line, "$") {
+			return nil, fmt.Errorf("expected a bulk string, got %q", line)
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil || size < 0 {
+			return nil, errors.New("bad bulk string length")
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
@@ -0,0 +1,248 @@
+//go:build integration
+
+package e2e
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngImage is a small valid PNG to upload
+func pngImage(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	return buf.Bytes()
+}
+
+type wishListResponse struct {
+	ID         string `json:"id"`
+	PublicSlug string `json:"public_slug"`
+}
+
+type itemResponse struct {
+	ID string `json:"id"`
+}
+
+type publicItem struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	ImageURL          string `json:"image_url"`
+	ReservationStatus string `json:"reservation_status"`
+	PurchasedByUserID string `json:"purchased_by_user_id"`
+}
+
+type reservationDetails struct {
+	GiftItem struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"gift_item"`
+	Wishlist struct {
+		Title string `json:"title"`
+	} `json:"wishlist"`
+}
+
+// publicItems returns the items of a public wish list by name
+func publicItems(c *client, slug string) map[string]publicItem {
+	c.t.Helper()
+	var resp struct {
+		Items []publicItem `json:"items"`
+	}
+	c.do(http.MethodGet, "/api/public/wishlists/"+slug+"/gift-items", nil, http.StatusOK, &resp)
+
+	items := make(map[string]publicItem, len(resp.Items))
+	for _, item := range resp.Items {
+		items[item.Name] = item
+	}
+	return items
+}
+
+// TestGiftingJourney follows one wish list from its owner signing up to a gift
+// being bought: the owner shares a list, a guest and a signed-in friend each
+// reserve a gift, the friend buys theirs and the guest leaves a comment that
+// reaches the owner in the app and by email.
+func TestGiftingJourney(t *testing.T) {
+	owner := register(t, "Olivia")
+
+	// The owner uploads a photo, which is stored in S3
+	photo := pngImage(t)
+	var upload struct {
+		URL string `json:"url"`
+	}
+	owner.upload("/api/images/upload", "image", "board game.png", "image/png", photo, http.StatusOK, &upload)
+	photoURL, err := url.Parse(upload.URL)
+	require.NoError(t, err)
+	stored, ok := storage.Object(s3Bucket, strings.TrimPrefix(photoURL.Path, "/"))
+	require.True(t, ok, "uploaded image is not in the bucket: %s", upload.URL)
+	assert.Equal(t, photo, stored)
+
+	// and shares a wish list with two gifts on it
+	var wishList wishListResponse
+	owner.do(http.MethodPost, "/api/wishlists", map[string]any{
+		"title":     "Olivia's birthday",
+		"is_public": true,
+	}, http.StatusCreated, &wishList)
+	require.NotEmpty(t, wishList.PublicSlug)
+
+	var boardGame, grinder itemResponse
+	owner.do(http.MethodPost, "/api/wishlists/"+wishList.ID+"/items/new", map[string]any{
+		"title":     "Board game",
+		"price":     40,
+		"image_url": upload.URL,
+	}, http.StatusCreated, &boardGame)
+	owner.do(http.MethodPost, "/api/wishlists/"+wishList.ID+"/items/new", map[string]any{
+		"title": "Coffee grinder",
+		"price": 60,
+	}, http.StatusCreated, &grinder)
+
+	// A guest opens the shared list, which is then served from the cache
+	visitor := guest(t)
+	var sharePage struct {
+		Items []publicItem `json:"items"`
+	}
+	visitor.do(http.MethodGet, "/api/public/wishlists/"+wishList.PublicSlug+"/full", nil, http.StatusOK, &sharePage)
+	require.Len(t, sharePage.Items, 2)
+	assert.True(t, redis.Has("wishlist:share-page:"+wishList.PublicSlug), "share page is not cached")
+
+	items := publicItems(visitor, wishList.PublicSlug)
+	assert.Equal(t, upload.URL, items["Board game"].ImageURL)
+	assert.Equal(t, "available", items["Board game"].ReservationStatus)
+
+	// and reserves the board game without an account
+	var guestReservation struct {
+		ReservationToken string `json:"reservation_token"`
+		Status           string `json:"status"`
+	}
+	visitor.do(http.MethodPost, "/api/public/reservations/wishlist/"+wishList.ID+"/item/"+boardGame.ID, map[string]any{
+		"guest_name":  "Grandma",
+		"guest_email": "grandma@example.com",
+	}, http.StatusOK, &guestReservation)
+	require.NotEmpty(t, guestReservation.ReservationToken)
+	assert.Equal(t, "active", guestReservation.Status)
+
+	var guestReservations []reservationDetails
+	visitor.do(http.MethodGet, "/api/guest/reservations?token="+guestReservation.ReservationToken, nil, http.StatusOK, &guestReservations)
+	require.Len(t, guestReservations, 1)
+	assert.Equal(t, "Board game", guestReservations[0].GiftItem.Name)
+	assert.Equal(t, "Olivia's birthday", guestReservations[0].Wishlist.Title)
+
+	// A friend signs up, reserves the coffee grinder and buys it
+	friend := register(t, "Farid")
+	friend.do(http.MethodPost, "/api/public/reservations/wishlist/"+wishList.ID+"/item/"+grinder.ID, map[string]any{},
+		http.StatusOK, nil)
+	friend.do(http.MethodPost, "/api/items/"+grinder.ID+"/mark-purchased", map[string]any{
+		"purchased_price": 55,
+	}, http.StatusOK, nil)
+
+	var friendReservations struct {
+		Data []reservationDetails `json:"data"`
+	}
+	friend.do(http.MethodGet, "/api/reservations/user", nil, http.StatusOK, &friendReservations)
+	require.Len(t, friendReservations.Data, 1)
+	assert.Equal(t, grinder.ID, friendReservations.Data[0].GiftItem.ID)
+
+	// Everyone looking at the list now sees both gifts taken
+	items = publicItems(visitor, wishList.PublicSlug)
+	assert.Equal(t, "fully_reserved", items["Board game"].ReservationStatus)
+	assert.Equal(t, "fully_reserved", items["Coffee grinder"].ReservationStatus)
+	assert.Equal(t, friend.ID, items["Coffee grinder"].PurchasedByUserID)
+
+	var statuses struct {
+		Items []struct {
+			GiftItemID string `json:"gift_item_id"`
+			IsReserved bool   `json:"is_reserved"`
+		} `json:"items"`
+	}
+	visitor.do(http.MethodGet, "/api/public/reservations/list/"+wishList.PublicSlug, nil, http.StatusOK, &statuses)
+	require.Len(t, statuses.Items, 2)
+	for _, status := range statuses.Items {
+		assert.True(t, status.IsReserved, status.GiftItemID)
+	}
+
+	// The guest leaves a comment, which the owner hears about in the app
+	visitor.do(http.MethodPost, "/api/public/wishlists/"+wishList.PublicSlug+"/items/"+boardGame.ID+"/comments", map[string]any{
+		"author_name": "Grandma",
+		"body":        "Which edition would you like?",
+	}, http.StatusCreated, nil)
+
+	var notifications struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+		UnreadCount int `json:"unread_count"`
+	}
+	owner.do(http.MethodGet, "/api/protected/notifications", nil, http.StatusOK, &notifications)
+	require.Len(t, notifications.Data, 1)
+	assert.Equal(t, "new_comment", notifications.Data[0].Type)
+	assert.Equal(t, 1, notifications.UnreadCount)
+
+	owner.do(http.MethodPost, "/api/protected/notifications/"+notifications.Data[0].ID+"/read", nil, http.StatusOK, nil)
+	owner.do(http.MethodGet, "/api/protected/notifications", nil, http.StatusOK, &notifications)
+	assert.Zero(t, notifications.UnreadCount)
+
+	// and by email
+	emails := mail.To(owner.Email)
+	require.Len(t, emails, 1, "the owner should get exactly the comment email")
+	assert.Contains(t, emails[0].Data, "From: "+senderEmail)
+	assert.Contains(t, emails[0].Data, "Subject: New comment on your wish list")
+	assert.Contains(t, emails[0].Data, `Grandma left a comment on "Board game"`)
+}
+
+// TestReserveAfterMarkReceived checks that an item the owner received elsewhere
+// cannot be reserved again, even when units of it were still open.
+func TestReserveAfterMarkReceived(t *testing.T) {
+	owner := register(t, "Omar")
+
+	var wishList wishListResponse
+	owner.do(http.MethodPost, "/api/wishlists", map[string]any{
+		"title":     "Omar's housewarming",
+		"is_public": true,
+	}, http.StatusCreated, &wishList)
+
+	var glasses itemResponse
+	owner.do(http.MethodPost, "/api/wishlists/"+wishList.ID+"/items/new", map[string]any{
+		"title":    "Wine glass",
+		"quantity": 3,
+	}, http.StatusCreated, &glasses)
+
+	// A guest reserves one of the three glasses
+	reservePath := "/api/public/reservations/wishlist/" + wishList.ID + "/item/" + glasses.ID
+	guest(t).do(http.MethodPost, reservePath, map[string]any{
+		"guest_name":  "Aunt May",
+		"guest_email": "may@example.com",
+	}, http.StatusOK, nil)
+
+	var statuses struct {
+		Items []struct {
+			ReservationStatus string `json:"reservation_status"`
+			RemainingQuantity int32  `json:"remaining_quantity"`
+		} `json:"items"`
+	}
+	visitor := guest(t)
+	visitor.do(http.MethodGet, "/api/public/reservations/list/"+wishList.PublicSlug, nil, http.StatusOK, &statuses)
+	require.Len(t, statuses.Items, 1)
+	assert.Equal(t, "partially_reserved", statuses.Items[0].ReservationStatus)
+	assert.Equal(t, int32(2), statuses.Items[0].RemainingQuantity)
+
+	// The owner got the glasses as a set elsewhere
+	owner.do(http.MethodPost, "/api/items/"+glasses.ID+"/mark-received", nil, http.StatusOK, nil)
+
+	visitor.do(http.MethodPost, reservePath, map[string]any{
+		"guest_name":  "Uncle Ben",
+		"guest_email": "ben@example.com",
+	}, http.StatusConflict, nil)
+
+	visitor.do(http.MethodGet, "/api/public/reservations/list/"+wishList.PublicSlug, nil, http.StatusOK, &statuses)
+	require.Len(t, statuses.Items, 1)
+	assert.Equal(t, "fully_reserved", statuses.Items[0].ReservationStatus)
+	assert.Zero(t, statuses.Items[0].RemainingQuantity)
+}
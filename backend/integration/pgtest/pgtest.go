@@ -0,0 +1,175 @@
+//go:build integration
+
+// Package pgtest provides the migrated PostgreSQL that the integration suites
+// run against.
+//
+// TEST_DATABASE_URL points the suites at a running database, e.g. a CI service
+// container. Otherwise Start runs a throwaway postgres container with the docker
+// CLI, which Close removes again; docker must be on the PATH and reach a running
+// daemon, see the backend README.
+package pgtest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+
+	"wish-list/internal/app/database"
+)
+
+const (
+	// postgresImage matches the major version of the CI service and docker-compose
+	postgresImage = "postgres:13-alpine"
+
+	// startupTimeout bounds how long the container may take to accept connections
+	startupTimeout = time.Minute
+)
+
+// Database is a PostgreSQL with every migration applied
+type Database struct {
+	// URL connects to the database
+	URL string
+
+	// container is the docker container started for the database, if any
+	container string
+}
+
+// Start returns the database at TEST_DATABASE_URL, or starts one in a container,
+// and migrates it. The database is shared, so tests create their own rows.
+func Start() (*Database, error) {
+	db := &Database{URL: os.Getenv("TEST_DATABASE_URL")}
+	if db.URL == "" {
+		container, url, err := startPostgres()
+		if err != nil {
+			return nil, err
+		}
+		db.container, db.URL = container, url
+	}
+
+	if err := migrateUp(db.URL); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// Close removes the container started for the database. A database given by
+// TEST_DATABASE_URL is left as it is.
+func (d *Database) Close() {
+	if d.container == "" {
+		return
+	}
+	if _, err := docker("rm", "-f", d.container); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to remove postgres container %s: %v\n", d.container, err)
+	}
+}
+
+// startPostgres runs a postgres container on a random local port and waits
+// until it accepts connections
+func startPostgres() (container, url string, err error) {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return "", "", errors.New("set TEST_DATABASE_URL or install docker")
+	}
+
+	container, err = docker("run", "-d", "--rm",
+		"-e", "POSTGRES_USER=test",
+		"-e", "POSTGRES_PASSWORD=test",
+		"-e", "POSTGRES_DB=wishlist_test",
+		"-p", "127.0.0.1::5432",
+		postgresImage)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to start postgres container: %w", err)
+	}
+	stop := (&Database{container: container}).Close
+
+	addr, err := docker("port", container, "5432/tcp")
+	if err != nil {
+		stop()
+		return "", "", fmt.Errorf("failed to get postgres container port: %w", err)
+	}
+	// One line per address family; the first is the one bound above
+	addr, _, _ = strings.Cut(addr, "\n")
+	url = fmt.Sprintf("postgres://test:test@%s/wishlist_test?sslmode=disable", addr)
+
+	if err := waitForPostgres(url); err != nil {
+		stop()
+		return "", "", err
+	}
+
+	return container, url, nil
+}
+
+// waitForPostgres polls until the server accepts connections. The image only
+// listens on TCP once its init scripts are done, so the first success means ready.
+func waitForPostgres(url string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+
+	for {
+		db, err := database.New(ctx, url)
+		if err == nil {
+			db.Close()
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("postgres container did not start: %w", err)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// docker runs a docker CLI command and returns its trimmed output
+func docker(args ...string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("docker", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// migrationsDir returns the schema migrations directory, wherever the suite runs from
+func migrationsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "internal", "app", "database", "migrations")
+}
+
+// migrateUp applies every migration, the same way cmd/migrate does
+func migrateUp(url string) error {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to create postgres driver: %w", err)
+	}
+	m, err := migrate.NewWithDatabaseInstance("file://"+migrationsDir(), "postgres", driver)
+	if err != nil {
+		return fmt.Errorf("failed to create migrate instance: %w", err)
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/domain/contact/models"
+	contactrepo "wish-list/internal/domain/contact/repository"
+	"wish-list/internal/pkg/encryption"
+)
+
+func TestContactRepository_EncryptedEmailsAndErasure(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	encryptionSvc, err := encryption.NewService([]byte(strings.Repeat("k", 32)))
+	require.NoError(t, err)
+	repo := contactrepo.NewContactRepositoryWithEncryption(db, encryptionSvc)
+	owner := createUser(t, db)
+
+	email := strings.ToLower(rand.Text()) + "@example.com"
+	family := pgtype.Text{String: "family", Valid: true}
+
+	// A contact saved before encryption was enabled
+	_, err = contactrepo.NewContactRepository(db).Create(ctx, models.Contact{OwnerID: owner.ID, Name: "Aunt", Email: email}, 10)
+	require.NoError(t, err)
+
+	// is still found by its email
+	_, err = repo.Create(ctx, models.Contact{OwnerID: owner.ID, Name: "Aunt", Email: email}, 10)
+	require.ErrorIs(t, err, contactrepo.ErrContactExists)
+
+	imported, err := repo.Import(ctx, owner.ID, []models.Contact{
+		{Name: "Auntie", Email: email, Relationship: family},
+		{Name: "Uncle", Email: "uncle-" + email, Relationship: family},
+	}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported)
+
+	contacts, err := repo.ListByOwner(ctx, owner.ID, "")
+	require.NoError(t, err)
+	require.Len(t, contacts, 2)
+	assert.Equal(t, "Auntie", contacts[0].Name)
+	assert.Equal(t, email, contacts[0].Email)
+
+	_, err = repo.Update(ctx, models.Contact{ID: contacts[1].ID, OwnerID: owner.ID, Name: "Uncle", Email: email})
+	require.ErrorIs(t, err, contactrepo.ErrContactExists)
+
+	emails, err := repo.ListEmailsByRelationships(ctx, owner.ID, []string{"family"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{email, "uncle-" + email}, emails)
+
+	var plaintext int
+	require.NoError(t, db.GetContext(ctx, &plaintext, `SELECT COUNT(*) FROM contacts WHERE owner_id = $1 AND email IS NOT NULL`, owner.ID))
+	assert.Zero(t, plaintext)
+
+	exported, err := repo.ListGuestContactsByEmail(ctx, " "+strings.ToUpper(email))
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, "Auntie", exported[0].Name)
+
+	deleted, err := repo.DeleteGuestContactsByEmail(ctx, email)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	contacts, err = repo.ListByOwner(ctx, owner.ID, "")
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, "Uncle", contacts[0].Name)
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gifthistoryrepo "wish-list/internal/domain/gift_history/repository"
+	itemrepo "wish-list/internal/domain/item/repository"
+	reservationrepo "wish-list/internal/domain/reservation/repository"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+)
+
+func TestGiftHistoryRepository_AnonymizeGuestGiverByEmail(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := gifthistoryrepo.NewGiftHistoryRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	item := createItem(t, db, owner.ID, wishList.ID, "Teapot", 1)
+
+	email := strings.ToLower(rand.Text()) + "@example.com"
+	reservation := guestReservation(wishList.ID, item.ID)
+	reservation.GuestEmail = pgtype.Text{String: email, Valid: true}
+	reservation.Message = pgtype.Text{String: "Happy birthday!", Valid: true}
+	_, err := reservationrepo.NewReservationRepository(db).Create(ctx, reservation)
+	require.NoError(t, err)
+
+	snapshotted, err := repo.SnapshotWishlist(ctx, wishList.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, snapshotted)
+	// Deleting the wishlist also deletes the reservation
+	require.NoError(t, wishlistrepo.NewWishListRepository(db).Delete(ctx, wishList.ID))
+
+	anonymized, err := repo.AnonymizeGuestGiverByEmail(ctx, " "+strings.ToUpper(email))
+	require.NoError(t, err)
+	assert.Equal(t, 1, anonymized)
+
+	records, err := repo.ListByRecipient(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 1, "the gift itself is kept")
+	assert.Equal(t, "Teapot", records[0].ItemName)
+	assert.False(t, records[0].GiverName.Valid)
+	assert.False(t, records[0].GiverMessage.Valid)
+}
+
+func TestGiftHistoryRepository_SkipsItemsReceivedElsewhere(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := gifthistoryrepo.NewGiftHistoryRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	given := createItem(t, db, owner.ID, wishList.ID, "Teapot", 1)
+	received := createItem(t, db, owner.ID, wishList.ID, "Kettle", 1)
+
+	_, err := reservationrepo.NewReservationRepository(db).Create(ctx, guestReservation(wishList.ID, given.ID))
+	require.NoError(t, err)
+	_, _, err = itemrepo.NewGiftItemReservationRepository(db).MarkReceived(ctx, received.ID, owner.ID)
+	require.NoError(t, err)
+
+	snapshotted, err := repo.SnapshotWishlist(ctx, wishList.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, snapshotted, "the owner is not recorded as giving themselves the received item")
+	require.NoError(t, wishlistrepo.NewWishListRepository(db).Delete(ctx, wishList.ID))
+
+	records, err := repo.ListByRecipient(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "Teapot", records[0].ItemName)
+	assert.Equal(t, "reserved", records[0].Kind)
+}
@@ -0,0 +1,148 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	itemrepo "wish-list/internal/domain/item/repository"
+)
+
+func itemNames(items []*itemmodels.GiftItem) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestGiftItemRepository_GetByOwnerPaginated(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := itemrepo.NewGiftItemRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+
+	createItem(t, db, owner.ID, wishList.ID, "Board game", 1)
+	createItem(t, db, owner.ID, wishList.ID, "Coffee grinder", 1)
+	createItem(t, db, owner.ID, pgtype.UUID{}, "Apron", 1)
+	createItem(t, db, owner.ID, pgtype.UUID{}, "Dice set", 1)
+	archived := createItem(t, db, owner.ID, wishList.ID, "Espresso cups", 1)
+	require.NoError(t, repo.SoftDelete(ctx, archived.ID))
+	createItem(t, db, createUser(t, db).ID, pgtype.UUID{}, "Another owner's item", 1)
+
+	t.Run("pages through the owner's items", func(t *testing.T) {
+		filters := itemrepo.ItemFilters{Page: 1, Limit: 3, Sort: "title", Order: "asc"}
+		first, err := repo.GetByOwnerPaginated(ctx, owner.ID, filters)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), first.TotalCount, "archived and foreign items are not counted")
+		assert.Equal(t, []string{"Apron", "Board game", "Coffee grinder"}, itemNames(first.Items))
+
+		filters.Page = 2
+		second, err := repo.GetByOwnerPaginated(ctx, owner.ID, filters)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), second.TotalCount)
+		assert.Equal(t, []string{"Dice set"}, itemNames(second.Items))
+	})
+
+	t.Run("reports the wish lists of each item", func(t *testing.T) {
+		result, err := repo.GetByOwnerPaginated(ctx, owner.ID, itemrepo.ItemFilters{Page: 1, Limit: 10, Sort: "title", Order: "asc"})
+		require.NoError(t, err)
+		for _, item := range result.Items {
+			switch item.Name {
+			case "Board game", "Coffee grinder":
+				assert.Equal(t, []string{wishList.ID.String()}, result.WishlistIDsMap[item.ID.String()], item.Name)
+			default:
+				assert.NotContains(t, result.WishlistIDsMap, item.ID.String(), item.Name)
+			}
+		}
+	})
+
+	tests := []struct {
+		name    string
+		filters itemrepo.ItemFilters
+		want    []string
+	}{
+		{
+			name:    "attached only",
+			filters: itemrepo.ItemFilters{Attached: true},
+			want:    []string{"Board game", "Coffee grinder"},
+		},
+		{
+			name:    "unattached only",
+			filters: itemrepo.ItemFilters{Unattached: true},
+			want:    []string{"Apron", "Dice set"},
+		},
+		{
+			name:    "including archived",
+			filters: itemrepo.ItemFilters{Attached: true, IncludeArchived: true},
+			want:    []string{"Board game", "Coffee grinder", "Espresso cups"},
+		},
+		{
+			name:    "search is case-insensitive",
+			filters: itemrepo.ItemFilters{Search: "GAME"},
+			want:    []string{"Board game"},
+		},
+		{
+			name:    "search treats the term literally",
+			filters: itemrepo.ItemFilters{Search: "'; DROP TABLE gift_items; --"},
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := tt.filters
+			filters.Page, filters.Limit, filters.Sort, filters.Order = 1, 10, "title", "asc"
+
+			result, err := repo.GetByOwnerPaginated(ctx, owner.ID, filters)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, itemNames(result.Items))
+			assert.Equal(t, int64(len(tt.want)), result.TotalCount)
+		})
+	}
+
+	t.Run("rejects unknown sort fields and orders", func(t *testing.T) {
+		_, err := repo.GetByOwnerPaginated(ctx, owner.ID, itemrepo.ItemFilters{Page: 1, Limit: 10, Sort: "name; --"})
+		assert.ErrorIs(t, err, itemrepo.ErrInvalidSortField)
+
+		_, err = repo.GetByOwnerPaginated(ctx, owner.ID, itemrepo.ItemFilters{Page: 1, Limit: 10, Sort: "title", Order: "sideways"})
+		assert.ErrorIs(t, err, itemrepo.ErrInvalidSortOrder)
+	})
+}
+
+func TestGiftItemRepository_GetPublicWishListGiftItemsPaginated(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := itemrepo.NewGiftItemRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+
+	for _, name := range []string{"Book", "Lamp", "Mug"} {
+		createItem(t, db, owner.ID, wishList.ID, name, 1)
+	}
+	archived := createItem(t, db, owner.ID, wishList.ID, "Old mug", 1)
+	require.NoError(t, repo.SoftDelete(ctx, archived.ID))
+	createItem(t, db, owner.ID, createWishList(t, db, owner.ID, "Other list").ID, "Scarf", 1)
+
+	first, total, err := repo.GetPublicWishListGiftItemsPaginated(ctx, wishList.PublicSlug.String, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, first, 2)
+
+	rest, total, err := repo.GetPublicWishListGiftItemsPaginated(ctx, wishList.PublicSlug.String, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, rest, 1)
+	assert.ElementsMatch(t, []string{"Book", "Lamp", "Mug"}, append(itemNames(first), rest[0].Name))
+
+	none, total, err := repo.GetPublicWishListGiftItemsPaginated(ctx, "no-such-list", 2, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, none)
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/encryption"
+)
+
+func TestInviteRepository_EncryptedPrepareAndErasure(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	encryptionSvc, err := encryption.NewService([]byte(strings.Repeat("k", 32)))
+	require.NoError(t, err)
+	repo := wishlistrepo.NewInviteRepositoryWithEncryption(db, encryptionSvc)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Wedding")
+
+	email := strings.ToLower(rand.Text()) + "@example.com"
+
+	// An invite made before encryption was enabled
+	_, err = wishlistrepo.NewInviteRepository(db).Prepare(ctx, wishList.ID, []string{email}, time.Now())
+	require.NoError(t, err)
+
+	// is reused and encrypted when the guest is invited again
+	invites, err := repo.Prepare(ctx, wishList.ID, []string{email}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	assert.Equal(t, email, invites[0].Email)
+
+	invites, err = repo.ListByWishList(ctx, wishList.ID)
+	require.NoError(t, err)
+	require.Len(t, invites, 1, "inviting the same address again reuses its row")
+	assert.Equal(t, email, invites[0].Email)
+
+	var plaintext pgtype.Text
+	require.NoError(t, db.GetContext(ctx, &plaintext, `SELECT email FROM wishlist_invites WHERE id = $1`, invites[0].ID))
+	assert.False(t, plaintext.Valid)
+
+	exported, err := repo.ListGuestInvitesByEmail(ctx, " "+strings.ToUpper(email))
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, email, exported[0].Email)
+
+	deleted, err := repo.DeleteGuestInvitesByEmail(ctx, email)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	invites, err = repo.ListByWishList(ctx, wishList.ID)
+	require.NoError(t, err)
+	assert.Empty(t, invites)
+}
@@ -0,0 +1,109 @@
+//go:build integration
+
+// Package repository runs the repositories against a real PostgreSQL with the
+// migrated schema, to cover the SQL that the service tests mock away. See
+// pgtest for where the database comes from; without one the tests are skipped.
+//
+//	go test -tags integration ./integration/repository/...
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/integration/pgtest"
+	"wish-list/internal/app/database"
+	itemmodels "wish-list/internal/domain/item/models"
+	itemrepo "wish-list/internal/domain/item/repository"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepo "wish-list/internal/domain/user/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	wishlistitemrepo "wish-list/internal/domain/wishlist_item/repository"
+)
+
+var (
+	// databaseURL is the migrated test database, empty when setupErr is set
+	databaseURL string
+
+	// setupErr says why there is no test database
+	setupErr error
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	pg, err := pgtest.Start()
+	if err != nil {
+		setupErr = err
+		return m.Run()
+	}
+	defer pg.Close()
+	databaseURL = pg.URL
+
+	return m.Run()
+}
+
+// openDB connects to the test database, skipping the test when there is none.
+// Tests share the database, so each one creates its own users and lists.
+func openDB(t *testing.T) *database.DB {
+	t.Helper()
+	if setupErr != nil {
+		t.Skipf("integration tests require PostgreSQL: %v", setupErr)
+	}
+
+	db, err := database.New(context.Background(), databaseURL)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// createUser inserts a user with a unique email
+func createUser(t *testing.T, db *database.DB) *usermodels.User {
+	t.Helper()
+	user, err := userrepo.NewUserRepository(db).Create(context.Background(), usermodels.User{
+		Email:     strings.ToLower(rand.Text()) + "@example.com",
+		FirstName: pgtype.Text{String: "Test", Valid: true},
+		LastName:  pgtype.Text{String: "User", Valid: true},
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// createWishList inserts a public wish list with a unique slug
+func createWishList(t *testing.T, db *database.DB, ownerID pgtype.UUID, title string) *wishlistmodels.WishList {
+	t.Helper()
+	wishList, err := wishlistrepo.NewWishListRepository(db).Create(context.Background(), wishlistmodels.WishList{
+		OwnerID:    ownerID,
+		Title:      title,
+		IsPublic:   pgtype.Bool{Bool: true, Valid: true},
+		PublicSlug: pgtype.Text{String: strings.ToLower(rand.Text()), Valid: true},
+	})
+	require.NoError(t, err)
+	return wishList
+}
+
+// createItem inserts a gift item wanted quantity times, attached to wishListID
+// unless that is the zero UUID
+func createItem(t *testing.T, db *database.DB, ownerID, wishListID pgtype.UUID, name string, quantity int32) *itemmodels.GiftItem {
+	t.Helper()
+	ctx := context.Background()
+	item, err := itemrepo.NewGiftItemRepository(db).CreateWithOwner(ctx, itemmodels.GiftItem{
+		OwnerID:  ownerID,
+		Name:     name,
+		Quantity: quantity,
+	})
+	require.NoError(t, err)
+	if wishListID.Valid {
+		require.NoError(t, wishlistitemrepo.NewWishlistItemRepository(db).Attach(ctx, wishListID, item.ID))
+	}
+	return item
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/domain/partner/models"
+	partnerrepo "wish-list/internal/domain/partner/repository"
+)
+
+func TestPartnerRepository_WebhookDomains(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := partnerrepo.NewPartnerRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+
+	allowed := strings.ToLower(rand.Text()) + ".example"
+	other := strings.ToLower(rand.Text()) + ".example"
+
+	key, err := repo.CreateKey(ctx, models.APIKey{
+		Name:               "Gift Shop",
+		KeyHash:            rand.Text(),
+		KeyPrefix:          "wlp_test",
+		Scopes:             models.ScopeWebhooksManage,
+		WebhookDomains:     allowed,
+		RateLimitPerMinute: 60,
+		CreatedBy:          owner.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{allowed}, key.WebhookDomainList())
+
+	// The repository stores whatever it is given; the allowlist is checked again
+	// when events are queued
+	for _, domain := range []string{allowed, other} {
+		_, err := repo.UpsertWebhook(ctx, models.Webhook{KeyID: key.ID, Domain: domain, URL: "https://hooks.example/" + domain, Secret: "whsec_test"})
+		require.NoError(t, err)
+	}
+
+	// An item on the public wish list linking to a product on a subdomain of domain
+	itemOnDomain := func(domain string) pgtype.UUID {
+		item := createItem(t, db, owner.ID, wishList.ID, "Teapot", 1)
+		_, err := db.ExecContext(ctx, `UPDATE gift_items SET link = $2 WHERE id = $1`, item.ID, "https://www.eu."+domain+"/teapot")
+		require.NoError(t, err)
+		return item.ID
+	}
+
+	queued, err := repo.EnqueueItemEvent(ctx, itemOnDomain(allowed), models.EventItemReserved)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, queued)
+
+	queued, err = repo.EnqueueItemEvent(ctx, itemOnDomain(other), models.EventItemReserved)
+	require.NoError(t, err)
+	assert.Zero(t, queued, "webhooks for domains not allowed on the key get no deliveries")
+
+	// Removing the domain from the key removes its webhook
+	key, err = repo.SetWebhookDomains(ctx, key.ID, other)
+	require.NoError(t, err)
+	assert.Equal(t, []string{other}, key.WebhookDomainList())
+
+	webhooks, err := repo.ListWebhooks(ctx, key.ID)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, other, webhooks[0].Domain)
+
+	require.NoError(t, repo.RevokeKey(ctx, key.ID))
+	_, err = repo.SetWebhookDomains(ctx, key.ID, allowed)
+	assert.ErrorIs(t, err, partnerrepo.ErrKeyNotFound)
+}
@@ -0,0 +1,203 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	itemrepo "wish-list/internal/domain/item/repository"
+	"wish-list/internal/domain/reservation/models"
+	reservationrepo "wish-list/internal/domain/reservation/repository"
+)
+
+// guestReservation is an active guest reservation of one unit of the item
+func guestReservation(wishListID, itemID pgtype.UUID) models.Reservation {
+	now := time.Now()
+	return models.Reservation{
+		WishlistID: wishListID,
+		GiftItemID: itemID,
+		GuestName:  pgtype.Text{String: "Guest", Valid: true},
+		GuestEmail: pgtype.Text{String: "guest@example.com", Valid: true},
+		Status:     "active",
+		Quantity:   1,
+		ReservedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		ExpiresAt:  pgtype.Timestamptz{Time: now.Add(30 * 24 * time.Hour), Valid: true},
+	}
+}
+
+func TestReservationRepository_CreateHoldsUnits(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := reservationrepo.NewReservationRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	item := createItem(t, db, owner.ID, wishList.ID, "Wine glass", 4)
+
+	pair := guestReservation(wishList.ID, item.ID)
+	pair.Quantity = 2
+	created, err := repo.Create(ctx, pair)
+	require.NoError(t, err)
+	assert.True(t, created.ReservationToken.Valid, "guests get a token to manage the reservation")
+
+	byToken, err := repo.GetByToken(ctx, created.ReservationToken)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byToken.ID)
+
+	three := guestReservation(wishList.ID, item.ID)
+	three.Quantity = 3
+	_, err = repo.Create(ctx, three)
+	var insufficient *reservationrepo.InsufficientQuantityError
+	require.ErrorAs(t, err, &insufficient)
+	assert.Equal(t, int32(2), insufficient.Remaining)
+
+	// Canceling gives the units back
+	_, err = repo.UpdateStatus(ctx, created.ID, "canceled",
+		pgtype.Timestamptz{Time: time.Now(), Valid: true}, pgtype.Text{String: "changed my mind", Valid: true})
+	require.NoError(t, err)
+	stock, err := itemrepo.NewGiftItemRepository(db).GetByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Zero(t, stock.ReservedQuantity)
+
+	_, err = repo.Create(ctx, three)
+	require.NoError(t, err)
+}
+
+func TestReservationRepository_CreateReleasesExpiredUnits(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := reservationrepo.NewReservationRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	item := createItem(t, db, owner.ID, wishList.ID, "Candle", 1)
+
+	// Expired, but still active as nothing swept it
+	stale := guestReservation(wishList.ID, item.ID)
+	stale.ReservedAt = pgtype.Timestamptz{Time: time.Now().Add(-48 * time.Hour), Valid: true}
+	stale.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+	expired, err := repo.Create(ctx, stale)
+	require.NoError(t, err)
+
+	statuses, err := repo.ListPublicWishListReservationStatuses(ctx, wishList.PublicSlug.String)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, int32(1), statuses[0].ExpiredQuantity, "the public status reports the unit open")
+
+	_, err = repo.Create(ctx, guestReservation(wishList.ID, item.ID))
+	require.NoError(t, err, "the unit shown as open can be reserved")
+
+	swept, err := repo.GetByID(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "expired", swept.Status)
+	stock, err := itemrepo.NewGiftItemRepository(db).GetByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stock.ReservedQuantity)
+
+	var events int
+	require.NoError(t, db.GetContext(ctx, &events,
+		`SELECT COUNT(*) FROM reservation_events WHERE reservation_id = $1 AND event_type = 'expired'`, expired.ID))
+	assert.Equal(t, 1, events)
+}
+
+func TestReservationRepository_CreateRejectsUnavailableItems(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := reservationrepo.NewReservationRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+
+	purchased := createItem(t, db, owner.ID, wishList.ID, "Napkins", 3)
+	_, err := db.ExecContext(ctx,
+		`UPDATE gift_items SET purchased_by_user_id = $2, purchased_at = NOW() WHERE id = $1`, purchased.ID, owner.ID)
+	require.NoError(t, err)
+
+	manual := createItem(t, db, owner.ID, wishList.ID, "Candles", 3)
+	_, err = db.ExecContext(ctx,
+		`UPDATE gift_items SET manual_reserved_by_name = 'Grandma', manual_reserved_at = NOW() WHERE id = $1`, manual.ID)
+	require.NoError(t, err)
+
+	for _, item := range []pgtype.UUID{purchased.ID, manual.ID} {
+		_, err := repo.Create(ctx, guestReservation(wishList.ID, item))
+		var insufficient *reservationrepo.InsufficientQuantityError
+		require.ErrorAs(t, err, &insufficient)
+		assert.Zero(t, insufficient.Remaining)
+	}
+}
+
+func TestReservationRepository_ConcurrentCreateDoesNotOverReserve(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := reservationrepo.NewReservationRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	const units, guests = 3, 12
+	item := createItem(t, db, owner.ID, wishList.ID, "Napkin ring", units)
+
+	var (
+		wg                   sync.WaitGroup
+		mu                   sync.Mutex
+		reserved, outOfStock int
+		unexpected           []error
+	)
+	start := make(chan struct{})
+	for range guests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := repo.Create(ctx, guestReservation(wishList.ID, item.ID))
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				reserved++
+			case errors.Is(err, reservationrepo.ErrInsufficientQuantity):
+				outOfStock++
+			default:
+				unexpected = append(unexpected, err)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	require.Empty(t, unexpected)
+	assert.Equal(t, units, reserved)
+	assert.Equal(t, guests-units, outOfStock)
+
+	stock, err := itemrepo.NewGiftItemRepository(db).GetByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(units), stock.ReservedQuantity)
+
+	var active int
+	require.NoError(t, db.GetContext(ctx, &active,
+		`SELECT COUNT(*) FROM reservations WHERE gift_item_id = $1 AND status = 'active'`, item.ID))
+	assert.Equal(t, units, active)
+}
+
+func TestReservationRepository_ListGuestReservationsWithDetails(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := reservationrepo.NewReservationRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	item := createItem(t, db, owner.ID, wishList.ID, "Teapot", 1)
+
+	created, err := repo.Create(ctx, guestReservation(wishList.ID, item.ID))
+	require.NoError(t, err)
+
+	details, err := repo.ListGuestReservationsWithDetails(ctx, created.ReservationToken)
+	require.NoError(t, err)
+	require.Len(t, details, 1)
+	assert.Equal(t, "Teapot", details[0].GiftItemName.String)
+	assert.Equal(t, "Birthday", details[0].WishlistTitle.String)
+	assert.Equal(t, "Test", details[0].OwnerFirstName.String)
+}
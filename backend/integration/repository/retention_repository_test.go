@@ -0,0 +1,129 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/domain/retention/models"
+	retentionrepo "wish-list/internal/domain/retention/repository"
+)
+
+func TestRetentionRepository_EnforceGuestPII(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := retentionrepo.NewRetentionRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday 2000")
+	item := createItem(t, db, owner.ID, wishList.ID, "Kite", 1)
+
+	// The occasion is long past; records of other tests are newer than the cutoff
+	cutoff := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err := db.ExecContext(ctx, `UPDATE wishlists SET occasion_date = '2000-06-01' WHERE id = $1`, wishList.ID)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, `
+		UPDATE gift_items SET manual_reserved_by_name = 'Aunt May', manual_reserved_at = NOW()
+		WHERE id = $1`, item.ID)
+	require.NoError(t, err)
+
+	var guestComment, ownerComment, guestSuggestion, guestGift, purchasedGift, oldEmail, newEmail pgtype.UUID
+	require.NoError(t, db.GetContext(ctx, &guestComment, `
+		INSERT INTO item_comments (wishlist_id, gift_item_id, author_name, body)
+		VALUES ($1, $2, 'Guest Name', 'Which color?') RETURNING id`, wishList.ID, item.ID))
+	require.NoError(t, db.GetContext(ctx, &ownerComment, `
+		INSERT INTO item_comments (wishlist_id, gift_item_id, author_user_id, author_name, body)
+		VALUES ($1, $2, $3, 'Test User', 'Blue') RETURNING id`, wishList.ID, item.ID, owner.ID))
+	require.NoError(t, db.GetContext(ctx, &guestSuggestion, `
+		INSERT INTO item_suggestions (wishlist_id, suggester_name, name)
+		VALUES ($1, 'Guest Name', 'Yo-yo') RETURNING id`, wishList.ID))
+	require.NoError(t, db.GetContext(ctx, &guestGift, `
+		INSERT INTO gift_history (recipient_user_id, source_wishlist_id, item_name, wishlist_title, occasion_date,
+			giver_name, giver_email, giver_message, kind, given_at)
+		VALUES ($1, gen_random_uuid(), 'Kite', 'Birthday 2000', '2000-06-01',
+			'Guest Name', 'guest@example.com', 'Enjoy!', 'reserved', NOW())
+		RETURNING id`, owner.ID))
+	require.NoError(t, db.GetContext(ctx, &purchasedGift, `
+		INSERT INTO gift_history (recipient_user_id, source_wishlist_id, item_name, wishlist_title, occasion_date,
+			giver_user_id, giver_name, giver_message, kind, given_at)
+		VALUES ($1, gen_random_uuid(), 'Ball', 'Birthday 2000', '2000-06-01',
+			$1, 'Test User', 'From me', 'purchased', NOW())
+		RETURNING id`, owner.ID))
+
+	require.NoError(t, db.GetContext(ctx, &oldEmail, `
+		INSERT INTO failed_emails (template, message, created_at)
+		VALUES ('reservation_confirmation', '{"to":"guest@example.com"}', '2000-07-01') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &newEmail, `
+		INSERT INTO failed_emails (template, message)
+		VALUES ('reservation_confirmation', '{"to":"guest@example.com"}') RETURNING id`))
+
+	expired, err := repo.CountExpired(ctx, models.DataClassGuestPII, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"reservations":     0,
+		"gift_history":     1,
+		"item_comments":    1,
+		"item_suggestions": 1,
+		"gift_items":       1,
+		"rsvps":            0,
+		"wishlist_invites": 0,
+		"failed_emails":    1,
+	}, recordsByTable(expired))
+
+	_, err = repo.Enforce(ctx, models.DataClassGuestPII, cutoff)
+	require.NoError(t, err)
+
+	var authorName, ownerName, suggesterName string
+	require.NoError(t, db.GetContext(ctx, &authorName, `SELECT author_name FROM item_comments WHERE id = $1`, guestComment))
+	require.NoError(t, db.GetContext(ctx, &ownerName, `SELECT author_name FROM item_comments WHERE id = $1`, ownerComment))
+	require.NoError(t, db.GetContext(ctx, &suggesterName, `SELECT suggester_name FROM item_suggestions WHERE id = $1`, guestSuggestion))
+	assert.Equal(t, "Anonymous", authorName)
+	assert.Equal(t, "Test User", ownerName, "registered users are not guests")
+	assert.Equal(t, "Anonymous", suggesterName)
+
+	var giver struct {
+		Name    pgtype.Text `db:"giver_name"`
+		Email   pgtype.Text `db:"giver_email"`
+		Message pgtype.Text `db:"giver_message"`
+	}
+	require.NoError(t, db.GetContext(ctx, &giver, `SELECT giver_name, giver_email, giver_message FROM gift_history WHERE id = $1`, guestGift))
+	assert.False(t, giver.Name.Valid)
+	assert.False(t, giver.Email.Valid)
+	assert.False(t, giver.Message.Valid)
+
+	require.NoError(t, db.GetContext(ctx, &giver, `SELECT giver_name, giver_email, giver_message FROM gift_history WHERE id = $1`, purchasedGift))
+	assert.Equal(t, "Test User", giver.Name.String)
+	assert.Equal(t, "From me", giver.Message.String)
+
+	var manual struct {
+		Name       pgtype.Text        `db:"manual_reserved_by_name"`
+		ReservedAt pgtype.Timestamptz `db:"manual_reserved_at"`
+	}
+	require.NoError(t, db.GetContext(ctx, &manual, `SELECT manual_reserved_by_name, manual_reserved_at FROM gift_items WHERE id = $1`, item.ID))
+	assert.False(t, manual.Name.Valid)
+	assert.True(t, manual.ReservedAt.Valid, "the item stays reserved")
+
+	var emails []pgtype.UUID
+	require.NoError(t, db.SelectContext(ctx, &emails, `SELECT id FROM failed_emails WHERE id IN ($1, $2)`, oldEmail, newEmail))
+	assert.Equal(t, []pgtype.UUID{newEmail}, emails)
+
+	expired, err = repo.CountExpired(ctx, models.DataClassGuestPII, cutoff)
+	require.NoError(t, err)
+	for _, count := range expired {
+		assert.Zero(t, count.Records, "%s is anonymized once", count.Table)
+	}
+}
+
+func recordsByTable(counts []models.TargetCount) map[string]int64 {
+	records := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		records[count.Table] = count.Records
+	}
+	return records
+}
@@ -0,0 +1,82 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/domain/rsvp/models"
+	rsvprepo "wish-list/internal/domain/rsvp/repository"
+	"wish-list/internal/pkg/encryption"
+)
+
+func TestRSVPRepository_EncryptedUpsertAndErasure(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	encryptionSvc, err := encryption.NewService([]byte(strings.Repeat("k", 32)))
+	require.NoError(t, err)
+	repo := rsvprepo.NewRSVPRepositoryWithEncryption(db, encryptionSvc)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Housewarming")
+
+	email := strings.ToLower(rand.Text()) + "@example.com"
+
+	// An answer given before encryption was enabled
+	_, err = rsvprepo.NewRSVPRepository(db).Upsert(ctx, models.RSVP{
+		WishlistID: wishList.ID,
+		Name:       "Guest",
+		Email:      pgtype.Text{String: email, Valid: true},
+		Attending:  false,
+	})
+	require.NoError(t, err)
+
+	// replaces it and is stored encrypted, as is the answer after it
+	for _, note := range []string{"Bringing a cake", "Bringing two cakes"} {
+		saved, err := repo.Upsert(ctx, models.RSVP{
+			WishlistID: wishList.ID,
+			Name:       "Guest",
+			Email:      pgtype.Text{String: strings.ToUpper(email), Valid: true},
+			Attending:  true,
+			Note:       pgtype.Text{String: note, Valid: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Guest", saved.Name)
+		assert.Equal(t, note, saved.Note.String)
+	}
+
+	rsvps, err := repo.ListByWishlist(ctx, wishList.ID)
+	require.NoError(t, err)
+	require.Len(t, rsvps, 1, "the guest's answers are merged")
+	assert.True(t, rsvps[0].Attending)
+	assert.Equal(t, "Bringing two cakes", rsvps[0].Note.String)
+
+	var plaintext struct {
+		Name  pgtype.Text `db:"name"`
+		Email pgtype.Text `db:"email"`
+		Note  pgtype.Text `db:"note"`
+	}
+	require.NoError(t, db.GetContext(ctx, &plaintext, `SELECT name, email, note FROM rsvps WHERE id = $1`, rsvps[0].ID))
+	assert.False(t, plaintext.Name.Valid)
+	assert.False(t, plaintext.Email.Valid)
+	assert.False(t, plaintext.Note.Valid)
+
+	exported, err := repo.ListGuestRSVPsByEmail(ctx, " "+email)
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, strings.ToUpper(email), exported[0].Email.String)
+
+	deleted, err := repo.DeleteGuestRSVPsByEmail(ctx, email)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	rsvps, err = repo.ListByWishlist(ctx, wishList.ID)
+	require.NoError(t, err)
+	assert.Empty(t, rsvps)
+}
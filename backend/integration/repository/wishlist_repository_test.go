@@ -0,0 +1,216 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	itemrepo "wish-list/internal/domain/item/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+)
+
+func TestWishListRepository_CreateAndGet(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewWishListRepository(db)
+	owner := createUser(t, db)
+
+	created := createWishList(t, db, owner.ID, "Birthday")
+
+	got, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Birthday", got.Title)
+	assert.Equal(t, owner.ID, got.OwnerID)
+	assert.Equal(t, int32(1), got.Version)
+
+	public, err := repo.GetByPublicSlug(ctx, created.PublicSlug.String)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, public.ID)
+
+	_, err = repo.GetByID(ctx, pgtype.UUID{Bytes: [16]byte{1}, Valid: true})
+	assert.ErrorIs(t, err, wishlistrepo.ErrWishListNotFound)
+}
+
+func TestWishListRepository_UpdateChecksVersionAndRetiresSlug(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewWishListRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	oldSlug := wishList.PublicSlug.String
+
+	edit := *wishList
+	edit.Title = "Birthday party"
+	edit.PublicSlug = pgtype.Text{String: oldSlug + "-party", Valid: true}
+	updated, err := repo.Update(ctx, edit)
+	require.NoError(t, err)
+	assert.Equal(t, "Birthday party", updated.Title)
+	assert.Equal(t, wishList.Version+1, updated.Version)
+
+	retired, err := repo.GetByRetiredSlug(ctx, oldSlug)
+	require.NoError(t, err)
+	assert.Equal(t, wishList.ID, retired.ID, "the old slug keeps pointing at the list")
+
+	// A second edit based on the stale version loses
+	_, err = repo.Update(ctx, edit)
+	assert.ErrorIs(t, err, wishlistrepo.ErrWishListVersionConflict)
+}
+
+func TestWishListRepository_ClosePublicSlug(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewWishListRepository(db)
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	slug := wishList.PublicSlug.String
+	_, err := db.ExecContext(ctx, `UPDATE wishlists SET occasion_date = NOW() - INTERVAL '200 days' WHERE id = $1`, wishList.ID)
+	require.NoError(t, err)
+
+	expired, err := repo.ListExpiredPublic(ctx, time.Now().Add(-90*24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(expired, func(w *wishlistmodels.WishList) bool { return w.ID == wishList.ID }))
+
+	require.NoError(t, repo.ClosePublicSlug(ctx, wishList.ID))
+	assert.ErrorIs(t, repo.ClosePublicSlug(ctx, wishList.ID), wishlistrepo.ErrWishListNotFound, "already closed")
+
+	_, err = repo.GetByPublicSlug(ctx, slug)
+	assert.ErrorIs(t, err, wishlistrepo.ErrWishListNotFound)
+	closed, err := repo.IsSlugClosed(ctx, slug)
+	require.NoError(t, err)
+	assert.True(t, closed)
+
+	// Publishing the list again reopens it under a new slug
+	current, err := repo.GetByID(ctx, wishList.ID)
+	require.NoError(t, err)
+	assert.False(t, current.IsPublic.Bool)
+	current.IsPublic = pgtype.Bool{Bool: true, Valid: true}
+	current.PublicSlug = pgtype.Text{String: slug + "-again", Valid: true}
+	_, err = repo.Update(ctx, *current)
+	require.NoError(t, err)
+
+	closed, err = repo.IsSlugClosed(ctx, slug)
+	require.NoError(t, err)
+	assert.False(t, closed)
+}
+
+func TestWishListRepository_GetByOwnerWithItemCount(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewWishListRepository(db)
+	owner := createUser(t, db)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	upcoming := createWishList(t, db, owner.ID, "Upcoming")
+	upcoming.OccasionDate = pgtype.Date{Time: today.AddDate(0, 1, 0), Valid: true}
+	_, err := repo.Update(ctx, *upcoming)
+	require.NoError(t, err)
+	undated := createWishList(t, db, owner.ID, "Undated")
+
+	createItem(t, db, owner.ID, upcoming.ID, "Book", 1)
+	createItem(t, db, owner.ID, upcoming.ID, "Lamp", 1)
+	createItem(t, db, owner.ID, undated.ID, "Mug", 1)
+	archived := createItem(t, db, owner.ID, undated.ID, "Old mug", 1)
+	require.NoError(t, itemrepo.NewGiftItemRepository(db).SoftDelete(ctx, archived.ID))
+
+	// Another owner's lists are never listed
+	createWishList(t, db, createUser(t, db).ID, "Someone else's")
+
+	lists, err := repo.GetByOwnerWithItemCount(ctx, owner.ID, wishlistrepo.WishListFilters{Sort: "title"})
+	require.NoError(t, err)
+	require.Len(t, lists, 2)
+	assert.Equal(t, "Undated", lists[0].Title)
+	assert.Equal(t, int64(1), lists[0].ItemCount, "archived items are not counted")
+	assert.Equal(t, "Upcoming", lists[1].Title)
+	assert.Equal(t, int64(2), lists[1].ItemCount)
+
+	lists, err = repo.GetByOwnerWithItemCount(ctx, owner.ID, wishlistrepo.WishListFilters{Filter: "no_date"})
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, undated.ID, lists[0].ID)
+
+	_, err = repo.GetByOwnerWithItemCount(ctx, owner.ID, wishlistrepo.WishListFilters{Sort: "name; DROP TABLE wishlists"})
+	assert.ErrorIs(t, err, wishlistrepo.ErrInvalidSortField)
+}
+
+func TestWishListRepository_AddViewsAndDelete(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewWishListRepository(db)
+	wishList := createWishList(t, db, createUser(t, db).ID, "Birthday")
+
+	require.NoError(t, repo.AddViews(ctx, wishList.ID, 1))
+	require.NoError(t, repo.AddViews(ctx, wishList.ID, 2))
+
+	var views int32
+	require.NoError(t, db.GetContext(ctx, &views,
+		`SELECT views FROM wishlist_daily_views WHERE wishlist_id = $1 AND day = CURRENT_DATE`, wishList.ID))
+	assert.Equal(t, int32(3), views)
+	got, err := repo.GetByID(ctx, wishList.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), got.ViewCount.Int32)
+
+	require.NoError(t, repo.Delete(ctx, wishList.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, wishList.ID), wishlistrepo.ErrWishListNotFound)
+	assert.ErrorIs(t, repo.AddViews(ctx, wishList.ID, 1), wishlistrepo.ErrWishListNotFound)
+}
+
+func TestImportRepository_ImportCopiesItemsWithoutReservations(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	repo := wishlistrepo.NewImportRepository(db)
+	owner := createUser(t, db)
+	importer := createUser(t, db)
+	source := createWishList(t, db, owner.ID, "Birthday")
+
+	reserved := createItem(t, db, owner.ID, source.ID, "Espresso machine", 1)
+	_, err := db.ExecContext(ctx,
+		`UPDATE gift_items SET reserved_by_user_id = $2, reserved_at = NOW(), reserved_quantity = 1 WHERE id = $1`,
+		reserved.ID, importer.ID)
+	require.NoError(t, err)
+	_, err = itemrepo.NewGiftItemImageRepository(db).Add(ctx, reserved.ID, "https://example.com/espresso.jpg", 10)
+	require.NoError(t, err)
+	createItem(t, db, owner.ID, source.ID, "Tea set", 2)
+	createItem(t, db, owner.ID, source.ID, "Grinder", 1)
+
+	copied, imported, err := repo.Import(ctx, source, wishlistmodels.WishList{
+		OwnerID:  importer.ID,
+		Title:    source.Title,
+		IsPublic: pgtype.Bool{Bool: false, Valid: true},
+	}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, importer.ID, copied.OwnerID)
+	assert.Equal(t, int32(2), imported.ItemsImported, "the item limit is applied")
+	assert.Equal(t, source.ID, imported.SourceWishListID)
+	assert.Equal(t, "Birthday", imported.SourceTitle)
+
+	items, err := itemrepo.NewGiftItemRepository(db).GetByOwnerPaginated(ctx, importer.ID, itemrepo.ItemFilters{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items.Items, 2)
+	for _, item := range items.Items {
+		assert.NotEqual(t, reserved.ID, item.ID, "items are copied, not shared")
+		assert.False(t, item.ReservedByUserID.Valid)
+		assert.Zero(t, item.ReservedQuantity)
+		if item.Name == "Espresso machine" {
+			assert.Equal(t, "https://example.com/espresso.jpg", item.ImageUrl.String)
+		}
+	}
+
+	got, err := repo.GetByWishList(ctx, copied.ID)
+	require.NoError(t, err)
+	assert.Equal(t, imported.ID, got.ID)
+
+	count, err := repo.CountSince(ctx, importer.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = repo.GetByWishList(ctx, source.ID)
+	assert.ErrorIs(t, err, wishlistrepo.ErrImportNotFound)
+}
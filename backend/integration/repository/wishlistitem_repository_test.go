@@ -0,0 +1,37 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	itemrepo "wish-list/internal/domain/item/repository"
+	wishlistitemrepo "wish-list/internal/domain/wishlist_item/repository"
+)
+
+func TestWishlistItemRepository_GetByWishlist(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	owner := createUser(t, db)
+	wishList := createWishList(t, db, owner.ID, "Birthday")
+	item := createItem(t, db, owner.ID, wishList.ID, "Bike", 1)
+
+	note := "Said so at dinner"
+	_, err := itemrepo.NewGiftItemRepository(db).MarkManualReservation(ctx, item.ID, "Grandma", &note)
+	require.NoError(t, err)
+
+	items, err := wishlistitemrepo.NewWishlistItemRepository(db).GetByWishlist(ctx, wishList.ID, 1, 10)
+	require.NoError(t, err)
+
+	require.Len(t, items, 1)
+	assert.Positive(t, items[0].Version, "the client sends the version back with an update")
+	assert.Equal(t, "Grandma", items[0].ManualReservedByName.String)
+	assert.Equal(t, note, items[0].ManualReservationNote.String)
+	assert.True(t, items[0].ManualReservedAt.Valid)
+	assert.Equal(t, itemmodels.ReservationFullyReserved, items[0].ReservationState())
+}
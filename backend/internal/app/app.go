@@ -3,7 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
-	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -13,36 +13,102 @@ import (
 	"wish-list/internal/app/config"
 	"wish-list/internal/app/database"
 	"wish-list/internal/app/jobs"
+	"wish-list/internal/app/middleware"
 	"wish-list/internal/app/server"
 
+	apitokenhttp "wish-list/internal/domain/apitoken/delivery/http"
+	apitokenrepo "wish-list/internal/domain/apitoken/repository"
+	apitokenservice "wish-list/internal/domain/apitoken/service"
 	authhttp "wish-list/internal/domain/auth/delivery/http"
+	billinghttp "wish-list/internal/domain/billing/delivery/http"
+	billingrepo "wish-list/internal/domain/billing/repository"
+	billingservice "wish-list/internal/domain/billing/service"
+	calendarhttp "wish-list/internal/domain/calendar/delivery/http"
+	calendarrepo "wish-list/internal/domain/calendar/repository"
+	calendarservice "wish-list/internal/domain/calendar/service"
+	commenthttp "wish-list/internal/domain/comment/delivery/http"
+	commentrepo "wish-list/internal/domain/comment/repository"
+	commentservice "wish-list/internal/domain/comment/service"
+	contacthttp "wish-list/internal/domain/contact/delivery/http"
+	contactservice "wish-list/internal/domain/contact/service"
+	dataexporthttp "wish-list/internal/domain/data_export/delivery/http"
+	dataexportrepo "wish-list/internal/domain/data_export/repository"
+	dataexportservice "wish-list/internal/domain/data_export/service"
+	discoveryhttp "wish-list/internal/domain/discovery/delivery/http"
+	discoveryrepo "wish-list/internal/domain/discovery/repository"
+	discoveryservice "wish-list/internal/domain/discovery/service"
+	followhttp "wish-list/internal/domain/follow/delivery/http"
+	followrepo "wish-list/internal/domain/follow/repository"
+	followservice "wish-list/internal/domain/follow/service"
+	gifthistoryhttp "wish-list/internal/domain/gift_history/delivery/http"
+	gifthistoryservice "wish-list/internal/domain/gift_history/service"
 	healthhttp "wish-list/internal/domain/health/delivery/http"
 	itemhttp "wish-list/internal/domain/item/delivery/http"
 	itemrepo "wish-list/internal/domain/item/repository"
 	itemservice "wish-list/internal/domain/item/service"
+	moderationhttp "wish-list/internal/domain/moderation/delivery/http"
+	notificationhttp "wish-list/internal/domain/notification/delivery/http"
+	notificationrepo "wish-list/internal/domain/notification/repository"
+	outboundhttp "wish-list/internal/domain/outbound/delivery/http"
+	outboundrepo "wish-list/internal/domain/outbound/repository"
+	outboundservice "wish-list/internal/domain/outbound/service"
+	partnerhttp "wish-list/internal/domain/partner/delivery/http"
+	partnerrepo "wish-list/internal/domain/partner/repository"
+	partnerservice "wish-list/internal/domain/partner/service"
+	privacyhttp "wish-list/internal/domain/privacy/delivery/http"
+	privacyservice "wish-list/internal/domain/privacy/service"
+	quotahttp "wish-list/internal/domain/quota/delivery/http"
+	quotarepo "wish-list/internal/domain/quota/repository"
+	registryhttp "wish-list/internal/domain/registry/delivery/http"
+	registryrepo "wish-list/internal/domain/registry/repository"
+	registryservice "wish-list/internal/domain/registry/service"
 	reservationhttp "wish-list/internal/domain/reservation/delivery/http"
 	reservationrepo "wish-list/internal/domain/reservation/repository"
 	reservationservice "wish-list/internal/domain/reservation/service"
+	retentionhttp "wish-list/internal/domain/retention/delivery/http"
+	retentionmodels "wish-list/internal/domain/retention/models"
+	retentionrepo "wish-list/internal/domain/retention/repository"
+	retentionservice "wish-list/internal/domain/retention/service"
+	rsvphttp "wish-list/internal/domain/rsvp/delivery/http"
+	rsvpservice "wish-list/internal/domain/rsvp/service"
+	shipmenthttp "wish-list/internal/domain/shipment/delivery/http"
+	shipmentrepo "wish-list/internal/domain/shipment/repository"
+	shipmentservice "wish-list/internal/domain/shipment/service"
+	statshttp "wish-list/internal/domain/stats/delivery/http"
 	storagehttp "wish-list/internal/domain/storage/delivery/http"
+	suggestionhttp "wish-list/internal/domain/suggestion/delivery/http"
+	suggestionrepo "wish-list/internal/domain/suggestion/repository"
+	suggestionservice "wish-list/internal/domain/suggestion/service"
 	userhttp "wish-list/internal/domain/user/delivery/http"
-	userrepo "wish-list/internal/domain/user/repository"
 	userservice "wish-list/internal/domain/user/service"
 	wishlisthttp "wish-list/internal/domain/wishlist/delivery/http"
 	wishlistrepo "wish-list/internal/domain/wishlist/repository"
 	wishlistservice "wish-list/internal/domain/wishlist/service"
 	wishlistitemhttp "wish-list/internal/domain/wishlist_item/delivery/http"
-	wishlistitemrepo "wish-list/internal/domain/wishlist_item/repository"
 	wishlistitemservice "wish-list/internal/domain/wishlist_item/service"
 
+	"wish-list/internal/pkg/abuse"
+	"wish-list/internal/pkg/affiliate"
 	"wish-list/internal/pkg/analytics"
 	"wish-list/internal/pkg/auth"
 	"wish-list/internal/pkg/aws"
 	"wish-list/internal/pkg/cache"
+	"wish-list/internal/pkg/captcha"
+	"wish-list/internal/pkg/carrier"
+	"wish-list/internal/pkg/emailcheck"
 	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/lifecycle"
+	"wish-list/internal/pkg/linkcheck"
 	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/moderation"
+	"wish-list/internal/pkg/pageviews"
+	"wish-list/internal/pkg/pdf"
+	"wish-list/internal/pkg/presence"
+	"wish-list/internal/pkg/push"
+	"wish-list/internal/pkg/secrets"
+	"wish-list/internal/pkg/stripe"
 	"wish-list/internal/pkg/validation"
-
-	_ "wish-list/internal/app/swagger/docs" // Import generated Swagger docs
+	"wish-list/internal/pkg/webhook"
 )
 
 // App is the main application struct that wires all dependencies together.
@@ -58,20 +124,88 @@ type App struct {
 	redisCache       cache.CacheInterface
 	encryptionSvc    *encryption.Service
 	analyticsService *analytics.AnalyticsService
+	affiliateLinks   *affiliate.Decorator
+	ipReputation     *abuse.IPReputation
+	captchaVerifier  captcha.Verifier
+	imageModerator   moderation.ImageModerator
+	emailValidator   emailcheck.Validator
+	pushRouter       *push.Router
+	carrierRouter    *carrier.Router
+	secrets          *secrets.Resolver // Nil when configuration was read without it
 
 	// Background jobs
 	accountCleanupService *jobs.AccountCleanupService
+	rolloverService       *jobs.OccasionRolloverService
+	reminderService       *jobs.OccasionReminderService
+	purchaseReminder      *jobs.PurchaseReminderService
+	digestService         *jobs.WeeklyDigestService
+	pushDispatcher        *jobs.PushDispatcherService
+	webhookDispatcher     *jobs.PartnerWebhookDispatcherService
+	dataExportJob         *jobs.DataExportJobService
+	availabilityService   *jobs.ItemAvailabilityService
+	shipmentTracking      *jobs.ShipmentTrackingService
+	discoveryRefresh      *jobs.DiscoveryRefreshService
+	slugExpiry            *jobs.PublicSlugExpiryService
+	dataRetention         *jobs.DataRetentionService
+	snapshotJob           *jobs.OccasionSnapshotService
+	viewFlush             *jobs.ViewFlushService
+	reservationEventCheck *jobs.ReservationEventCheckService
+	guestReservationLink  *jobs.GuestReservationLinkService
+	background            *lifecycle.Group
 
 	// Domain handlers
 	healthHandler       *healthhttp.Handler
 	storageHandler      *storagehttp.Handler
+	dataExportHandler   *dataexporthttp.Handler
 	userHandler         *userhttp.Handler
+	profileHandler      *userhttp.ProfileHandler
 	authHandler         *authhttp.Handler
 	oauthHandler        *authhttp.OAuthHandler
 	wishlistHandler     *wishlisthttp.Handler
+	presenceHandler     *wishlisthttp.PresenceHandler
+	snapshotHandler     *wishlisthttp.SnapshotHandler
+	viewHandler         *wishlisthttp.ViewHandler
+	importHandler       *wishlisthttp.ImportHandler
+	inviteHandler       *wishlisthttp.InviteHandler
 	itemHandler         *itemhttp.Handler
+	attachmentHandler   *itemhttp.AttachmentHandler
+	shareLinkHandler    *itemhttp.ShareLinkHandler
+	receivedHandler     *itemhttp.ReceivedHandler
 	wishlistItemHandler *wishlistitemhttp.Handler
 	reservationHandler  *reservationhttp.Handler
+	guestLinkHandler    *reservationhttp.GuestLinkHandler
+	shoppingListHandler *reservationhttp.ShoppingListHandler
+	purchaseHandler     *reservationhttp.GuestPurchaseHandler
+	privacyHandler      *privacyhttp.Handler
+	commentHandler      *commenthttp.Handler
+	suggestionHandler   *suggestionhttp.Handler
+	rsvpHandler         *rsvphttp.Handler
+	shipmentHandler     *shipmenthttp.Handler
+	statsHandler        *statshttp.Handler
+	notificationHandler *notificationhttp.Handler
+	giftHistoryHandler  *gifthistoryhttp.Handler
+	registryHandler     *registryhttp.Handler
+	outboundHandler     *outboundhttp.Handler
+	quotaHandler        *quotahttp.Handler
+	billingHandler      *billinghttp.Handler
+	apiTokenHandler     *apitokenhttp.Handler
+	followHandler       *followhttp.Handler
+	calendarHandler     *calendarhttp.Handler
+	moderationHandler   *moderationhttp.Handler
+	partnerHandler      *partnerhttp.Handler
+	discoveryHandler    *discoveryhttp.Handler
+	contactHandler      *contacthttp.Handler
+	retentionHandler    *retentionhttp.Handler
+
+	// Plans gate premium-only routes
+	planLookup middleware.PlanLookup
+
+	// Personal API tokens authenticate integration routes
+	apiTokens apitokenservice.APITokenServiceInterface
+
+	// Owner checks load the wishlist or item a route acts on once, for the handler
+	wishListOwners wishlisthttp.WishListLoader
+	itemOwners     itemhttp.ItemLoader
 }
 
 // New creates a new App instance, initializing all infrastructure, domain
@@ -81,30 +215,51 @@ func New(cfg *config.Config) (*App, error) {
 	logger.Initialize(cfg.ServerEnv)
 	logger.Info("initializing application", "env", cfg.ServerEnv)
 
-	a := &App{cfg: cfg}
+	a := &App{cfg: cfg, background: lifecycle.NewGroup()}
 
 	if err := a.initInfrastructure(); err != nil {
 		return nil, fmt.Errorf("infrastructure init: %w", err)
 	}
 
 	a.initDomains()
-	a.initServer()
+	if err := a.initServer(); err != nil {
+		return nil, fmt.Errorf("server init: %w", err)
+	}
 
 	return a, nil
 }
 
 // initInfrastructure sets up database, encryption, cache, S3, token management.
 func (a *App) initInfrastructure() error {
-	// Database
-	dbCtx, dbCancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer dbCancel()
+	// Database, retried while it is still starting (e.g. under docker-compose)
+	poolConfig := database.PoolConfig{
+		MaxOpenConns:       a.cfg.DatabaseMaxConns,
+		MaxIdleConns:       a.cfg.DatabaseMaxIdleConns,
+		ConnMaxLifetime:    a.cfg.DatabaseConnMaxLifetime,
+		ConnMaxIdleTime:    a.cfg.DatabaseConnMaxIdleTime,
+		SlowQueryThreshold: a.cfg.DatabaseSlowQuery,
+	}
 
-	db, err := database.New(dbCtx, a.cfg.DatabaseURL)
+	db, err := database.NewWithRetry(context.Background(), a.cfg.DatabaseURL, poolConfig, database.RetryConfig{
+		Attempts:       a.cfg.DatabaseConnAttempts,
+		AttemptTimeout: 10 * time.Second,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+	})
 	if err != nil {
 		return fmt.Errorf("database connection: %w", err)
 	}
 	a.db = db
 
+	// Read replica (optional): public reads fall back to the primary when it is unavailable
+	if a.cfg.DatabaseReplicaURL != "" {
+		replicaCtx, replicaCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer replicaCancel()
+		if err := db.AttachReplica(replicaCtx, a.cfg.DatabaseReplicaURL, poolConfig, a.cfg.DatabaseReplicaMaxLag); err != nil {
+			logger.Warn("failed to attach read replica, all reads will use the primary database", "error", err)
+		}
+	}
+
 	// JWT token manager
 	a.tokenManager = auth.NewTokenManager(a.cfg.JWTSecret)
 
@@ -112,23 +267,31 @@ func (a *App) initInfrastructure() error {
 	a.codeStore = auth.NewCodeStore()
 
 	// S3 client (optional)
-	s3Client, err := aws.NewS3Client(a.cfg.AWSRegion, a.cfg.AWSAccessKeyID, a.cfg.AWSSecretAccessKey, a.cfg.AWSS3BucketName)
+	s3Client, err := aws.NewS3Client(a.cfg.AWSRegion, a.cfg.AWSAccessKeyID, a.cfg.AWSSecretAccessKey, a.cfg.AWSS3BucketName, BreakerConfig(a.cfg))
 	if err != nil {
-		log.Printf("Warning: Failed to initialize S3 client: %v", err)
-		log.Println("Image upload functionality will be disabled")
+		logger.Warn("failed to initialize S3 client, image upload will be disabled", "error", err)
 	}
 	a.s3Client = s3Client
 
-	// Redis cache (optional)
-	redisCache, err := cache.NewRedisCache(
+	// Redis cache (optional). An unreachable Redis leaves caching off until the
+	// reconnect loop started by Run reaches it.
+	redisCache := cache.NewLazyRedisCache(
 		a.cfg.RedisAddr,
 		a.cfg.RedisPassword,
 		a.cfg.RedisDB,
-		time.Duration(a.cfg.CacheTTLMinutes)*time.Minute,
+		a.cfg.CacheTTL,
+		BreakerConfig(a.cfg),
 	)
-	if err != nil {
-		log.Printf("Warning: Failed to initialize Redis cache: %v", err)
-		log.Println("Caching functionality will be disabled")
+	redisCtx, redisCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer redisCancel()
+	if err := redisCache.Connect(redisCtx); err != nil {
+		if a.cfg.RedisReconnect > 0 {
+			logger.Warn("failed to connect to Redis, caching is disabled until it reconnects", "error", err)
+			a.redisCache = redisCache
+		} else {
+			logger.Warn("failed to initialize Redis cache, caching will be disabled", "error", err)
+			_ = redisCache.Close()
+		}
 	} else {
 		a.redisCache = redisCache
 	}
@@ -142,35 +305,129 @@ func (a *App) initInfrastructure() error {
 		if a.cfg.ServerEnv != "development" {
 			return fmt.Errorf("encryption service required in %s: %w", a.cfg.ServerEnv, err)
 		}
-		log.Printf("Warning: Failed to initialize encryption service: %v. PII will not be encrypted.", err)
+		logger.Warn("failed to initialize encryption service, PII will not be encrypted", "error", err)
 	} else {
 		if encryptedKeyToStore != "" {
-			fmt.Println("================================================================================")
-			fmt.Println("IMPORTANT: A new KMS data encryption key has been generated.")
-			fmt.Println("You MUST persist the following value to the ENCRYPTED_DATA_KEY environment")
-			fmt.Println("variable or secret manager to prevent data loss on restart:")
-			fmt.Println("")
-			fmt.Printf("ENCRYPTED_DATA_KEY=%s\n", encryptedKeyToStore)
-			fmt.Println("")
-			fmt.Println("Without persisting this value, encrypted data will be unrecoverable.")
-			fmt.Println("================================================================================")
+			// Emitted once as a single entry so it survives log aggregation;
+			// the value is KMS-encrypted and safe to log
+			logger.Warn("new KMS data encryption key generated: persist it to ENCRYPTED_DATA_KEY "+
+				"or encrypted data will be unrecoverable after restart",
+				"encrypted_data_key", encryptedKeyToStore)
+		}
+
+		// Versioned keys for rotation; the base key is always kept for legacy ciphertext
+		keys, currentKeyID, err := encryption.LoadKeyring(encryptionCtx, encryptionKey)
+		if err != nil {
+			return fmt.Errorf("encryption keyring: %w", err)
 		}
 
-		encSvc, err := encryption.NewService(encryptionKey)
+		encSvc, err := encryption.NewServiceWithKeys(keys, currentKeyID)
 		if err != nil {
 			if a.cfg.ServerEnv != "development" {
 				return fmt.Errorf("encryption service creation in %s: %w", a.cfg.ServerEnv, err)
 			}
-			log.Printf("Warning: Failed to create encryption service: %v. PII will not be encrypted.", err)
+			logger.Warn("failed to create encryption service, PII will not be encrypted", "error", err)
 		} else {
 			a.encryptionSvc = encSvc
-			log.Println("Encryption service initialized successfully for PII protection")
+			logger.Info("encryption service initialized for PII protection", "keys_loaded", len(keys))
 		}
 	}
 
 	// Analytics
 	a.analyticsService = analytics.NewAnalyticsService(a.cfg.AnalyticsEnabled)
 
+	// Affiliate tags for outbound item links (rules were checked by config validation)
+	affiliateRules, err := affiliate.ParseRules(a.cfg.AffiliateRules)
+	if err != nil {
+		return fmt.Errorf("affiliate rules: %w", err)
+	}
+	a.affiliateLinks = affiliate.NewDecorator(affiliateRules)
+
+	// Client address reputation for guest abuse scoring (denylist was checked by config validation)
+	a.ipReputation, err = abuse.NewIPReputation(a.cfg.AbuseIPDenylist)
+	if err != nil {
+		return fmt.Errorf("abuse ip denylist: %w", err)
+	}
+
+	// CAPTCHA verifier for registration and guest reservations (optional, bypassed in tests)
+	switch {
+	case a.cfg.ServerEnv == "test" || a.cfg.CaptchaProvider == "":
+		a.captchaVerifier = captcha.NoopVerifier{}
+	default:
+		verifier, err := captcha.NewVerifier(
+			a.cfg.CaptchaProvider,
+			a.cfg.CaptchaSecret,
+			a.cfg.CaptchaTimeout,
+		)
+		if err != nil {
+			return fmt.Errorf("captcha verifier: %w", err)
+		}
+		a.captchaVerifier = verifier
+	}
+
+	// Image moderation for public content (optional)
+	a.imageModerator = moderation.NoopImageModerator{}
+	if a.cfg.ModerationImageEndpoint != "" {
+		moderator, err := moderation.NewHTTPImageModerator(
+			a.cfg.ModerationImageEndpoint,
+			a.cfg.ModerationImageAPIKey,
+			a.cfg.ModerationImageTimeout,
+		)
+		if err != nil {
+			return fmt.Errorf("image moderator: %w", err)
+		}
+		a.imageModerator = moderator
+	}
+
+	// Push notifications to mobile devices (optional, per platform)
+	pushSenders := map[string]push.Sender{}
+	if a.cfg.FCMCredentials != "" {
+		sender, err := push.NewFCMSender(context.Background(), []byte(a.cfg.FCMCredentials), a.cfg.PushTimeout)
+		if err != nil {
+			return fmt.Errorf("fcm sender: %w", err)
+		}
+		pushSenders[push.PlatformAndroid] = sender
+	}
+	if a.cfg.APNsKey != "" {
+		apnsURL := push.APNsProductionURL
+		if a.cfg.APNsSandbox {
+			apnsURL = push.APNsDevelopmentURL
+		}
+		sender, err := push.NewAPNsSender(push.APNsConfig{
+			BaseURL: apnsURL,
+			KeyPEM:  []byte(a.cfg.APNsKey),
+			KeyID:   a.cfg.APNsKeyID,
+			TeamID:  a.cfg.APNsTeamID,
+			Topic:   a.cfg.APNsTopic,
+		}, a.cfg.PushTimeout)
+		if err != nil {
+			return fmt.Errorf("apns sender: %w", err)
+		}
+		pushSenders[push.PlatformIOS] = sender
+	}
+	a.pushRouter = push.NewRouter(pushSenders)
+
+	// Delivery tracking of purchased gifts (optional)
+	trackers := map[string]carrier.Tracker{}
+	if a.cfg.AfterShipAPIKey != "" {
+		for _, slug := range a.cfg.ShipmentCarriers {
+			trackers[slug] = carrier.NewAfterShipTracker(carrier.AfterShipURL, a.cfg.AfterShipAPIKey, slug, a.cfg.TrackingTimeout)
+		}
+	}
+	a.carrierRouter = carrier.NewRouter(trackers)
+
+	// Email checks for registrations and guest reservations (mode was checked by config validation)
+	emailValidator, err := emailcheck.NewValidator(emailcheck.Options{
+		Mode:              a.cfg.EmailCheckMode,
+		DisposableDomains: a.cfg.EmailDisposableDomains,
+		LookupTimeout:     a.cfg.EmailCheckTimeout,
+		CacheTTL:          a.cfg.EmailCheckCacheTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("email validator: %w", err)
+	}
+	a.emailValidator = emailValidator
+
 	return nil
 }
 
@@ -178,40 +435,142 @@ func (a *App) initInfrastructure() error {
 func (a *App) initDomains() {
 	// --- Repositories ---
 
-	var userRepo userrepo.UserRepositoryInterface
-	if a.encryptionSvc != nil {
-		userRepo = userrepo.NewUserRepositoryWithEncryption(a.db, a.encryptionSvc)
-	} else {
-		userRepo = userrepo.NewUserRepository(a.db)
-	}
-
-	wishlistRepo := wishlistrepo.NewWishListRepository(a.db)
-	giftItemRepo := itemrepo.NewGiftItemRepository(a.db)
-	giftItemReservationRepo := itemrepo.NewGiftItemReservationRepository(a.db)
-	giftItemPurchaseRepo := itemrepo.NewGiftItemPurchaseRepository(a.db)
-	wishlistItemRepo := wishlistitemrepo.NewWishlistItemRepository(a.db)
-
-	var reservationRepo reservationrepo.ReservationRepositoryInterface
-	if a.encryptionSvc != nil {
-		reservationRepo = reservationrepo.NewReservationRepositoryWithEncryption(a.db, a.encryptionSvc)
-	} else {
-		reservationRepo = reservationrepo.NewReservationRepository(a.db)
-	}
+	repos := NewRepositories(a.db, a.encryptionSvc)
+	userRepo := repos.Users
+	wishlistRepo := repos.WishLists
+	giftItemRepo := repos.GiftItems
+	reservationRepo := repos.Reservations
+
+	giftItemReservationRepo := repos.GiftItemReservations
+	giftItemPurchaseRepo := repos.GiftItemPurchases
+	giftItemImageRepo := repos.GiftItemImages
+	wishlistItemRepo := repos.WishlistItems
+	guestConfirmationRepo := repos.GuestConfirmations
+	purchaseReminderRepo := repos.PurchaseReminders
+	privacyRequestRepo := repos.PrivacyRequests
+	rsvpRepo := repos.RSVPs
+	giftHistoryRepo := repos.GiftHistory
+	inviteRepo := repos.Invites
+	contactRepo := repos.Contacts
+
+	commentRepo := commentrepo.NewCommentRepository(a.db)
+	suggestionRepo := suggestionrepo.NewSuggestionRepository(a.db)
+	notificationRepo := notificationrepo.NewNotificationRepository(a.db)
+	budgetRepo := reservationrepo.NewBudgetRepository(a.db)
+	registryRepo := registryrepo.NewRegistryRepository(a.db)
+	linkClickRepo := outboundrepo.NewLinkClickRepository(a.db)
+	quotaRepo := quotarepo.NewQuotaRepository(a.db)
+	billingRepo := billingrepo.NewBillingRepository(a.db)
+	apiTokenRepo := apitokenrepo.NewAPITokenRepository(a.db)
+	followRepo := followrepo.NewFollowRepository(a.db)
+	calendarRepo := calendarrepo.NewCalendarRepository(a.db)
+	partnerRepo := partnerrepo.NewPartnerRepository(a.db)
+	dataExportRepo := dataexportrepo.NewDataExportRepository(a.db)
+	guestLimitRepo := reservationrepo.NewGuestLimitRepository(a.db)
+	reservationEventRepo := reservationrepo.NewReservationEventRepository(a.db)
+	guestLinkRepo := reservationrepo.NewGuestLinkRepository(a.db)
+	shipmentRepo := shipmentrepo.NewShipmentRepository(a.db)
+	discoveryRepo := discoveryrepo.NewDiscoveryRepository(a.db)
+	snapshotRepo := wishlistrepo.NewSnapshotRepository(a.db)
+	retentionRepo := retentionrepo.NewRetentionRepository(a.db)
 
 	// --- Services ---
 
-	emailService := jobs.NewEmailService()
-	userSvc := userservice.NewUserService(userRepo, reservationRepo)
-	wishlistSvc := wishlistservice.NewWishListService(wishlistRepo, giftItemRepo, giftItemReservationRepo, giftItemPurchaseRepo, emailService, reservationRepo, a.redisCache)
-	itemSvc := itemservice.NewItemService(giftItemRepo, wishlistItemRepo)
-	wishlistItemSvc := wishlistitemservice.NewWishlistItemService(wishlistRepo, giftItemRepo, wishlistItemRepo)
-	reservationSvc := reservationservice.NewReservationService(reservationRepo, giftItemRepo, giftItemReservationRepo)
-	a.accountCleanupService = jobs.NewAccountCleanupService(a.db, userRepo, wishlistRepo, giftItemRepo, reservationRepo, emailService)
+	svcs := NewServices(a.cfg, a.db, a.encryptionSvc, repos, a.redisCache, a.imageModerator)
+	emailService := svcs.Email
+	quotaSvc := svcs.Quota
+	moderationSvc := svcs.Moderation
+	notificationSvc := svcs.Notifications
+	statsSvc := svcs.Stats
+	wishlistSvc := svcs.WishLists
+	partnerSvc := partnerservice.NewPartnerService(partnerRepo, a.cfg.FrontendURL)
+	discoverySvc := discoveryservice.NewDiscoveryService(discoveryRepo, a.redisCache, a.cfg.FrontendURL)
+	guestLinkSvc := reservationservice.NewGuestLinkService(guestLinkRepo, reservationRepo, userRepo)
+	userSvc := userservice.NewUserService(userRepo, a.emailValidator, guestLinkSvc)
+	profileSvc := userservice.NewPublicProfileService(userRepo, discoveryRepo, moderationSvc)
+	snapshotSvc := wishlistservice.NewSnapshotService(snapshotRepo, giftItemRepo)
+	retentionSvc := retentionservice.NewRetentionService(retentionRepo, map[string]int{
+		retentionmodels.DataClassGuestPII:  a.cfg.RetentionGuestPII,
+		retentionmodels.DataClassAuditLog:  a.cfg.RetentionAuditLog,
+		retentionmodels.DataClassAnalytics: a.cfg.RetentionAnalytics,
+	})
+	viewSvc := wishlistservice.NewViewService(wishlistRepo, a.newViewBuffer())
+	importSvc := wishlistservice.NewImportService(wishlistrepo.NewImportRepository(a.db), wishlistRepo, userRepo, quotaSvc)
+	itemSvc := itemservice.NewItemService(giftItemRepo, giftItemImageRepo, giftItemPurchaseRepo, wishlistItemRepo, moderationSvc, partnerSvc)
+	wishlistItemSvc := wishlistitemservice.NewWishlistItemService(wishlistRepo, giftItemRepo, wishlistItemRepo, quotaSvc, moderationSvc)
+	guestLimiter := reservationservice.NewGuestLimiter(guestLimitRepo, reservationservice.GuestReservationLimits{
+		PerEmail: a.cfg.GuestLimitPerEmail,
+		PerIP:    a.cfg.GuestLimitPerIP,
+		Window:   a.cfg.GuestLimitWindow,
+	})
+	// Abuse scoring holds suspicious guest reservations for email confirmation
+	var guestScreening *reservationservice.GuestScreening
+	if a.cfg.AbuseScoreThreshold > 0 {
+		guestScreening = reservationservice.NewGuestScreening(guestLimitRepo, a.ipReputation, guestConfirmationRepo, emailService, a.cfg.AbuseScoreThreshold)
+	}
+	reservationSvc := reservationservice.NewReservationService(reservationRepo, giftItemRepo, giftItemReservationRepo, budgetRepo, userRepo, a.emailValidator, guestLimiter, partnerSvc, statsSvc, reservationEventRepo, guestScreening)
+	privacySvc := privacyservice.NewPrivacyService(privacyRequestRepo, reservationRepo, giftHistoryRepo, rsvpRepo, inviteRepo, contactRepo, emailService)
+	commentSvc := commentservice.NewCommentService(commentRepo, wishlistRepo, giftItemRepo, wishlistItemRepo, userRepo, emailService, notificationSvc)
+	suggestionSvc := suggestionservice.NewSuggestionService(suggestionRepo, wishlistRepo, userRepo, wishlistItemSvc, emailService)
+	rsvpSvc := rsvpservice.NewRSVPService(rsvpRepo, wishlistRepo)
+	giftHistorySvc := gifthistoryservice.NewGiftHistoryService(giftHistoryRepo)
+	registrySvc := registryservice.NewRegistryService(registryRepo, wishlistRepo, quotaSvc)
+	outboundSvc := outboundservice.NewOutboundService(linkClickRepo, a.affiliateLinks)
+	apiTokenSvc := apitokenservice.NewAPITokenService(apiTokenRepo)
+	followSvc := followservice.NewFollowService(followRepo, userRepo, notificationSvc)
+	calendarSvc := calendarservice.NewCalendarService(calendarRepo, a.cfg.FrontendURL)
+
+	// Without tracking any carrier is accepted; the shipment is stored but not polled
+	var carrierChecker shipmentservice.CarrierCheckerInterface
+	if a.carrierRouter.Enabled() {
+		carrierChecker = a.carrierRouter
+	}
+	shipmentSvc := shipmentservice.NewShipmentService(shipmentRepo, giftItemRepo, carrierChecker)
+	a.planLookup = quotaSvc
+	a.apiTokens = apiTokenSvc
+	a.wishListOwners = wishlistRepo
+	a.itemOwners = giftItemRepo
+
+	// Billing is disabled without a Stripe key; the provider stays an untyped nil
+	var payments billingservice.PaymentProviderInterface
+	if a.cfg.StripeSecretKey != "" {
+		payments = stripe.NewClient(a.cfg.StripeSecretKey, a.cfg.StripeTimeout)
+	}
+	billingSvc := billingservice.NewBillingService(billingRepo, payments, billingservice.CheckoutConfig{
+		PriceID:    a.cfg.StripePremiumPriceID,
+		SuccessURL: a.cfg.BillingSuccessURL,
+		CancelURL:  a.cfg.BillingCancelURL,
+	}, a.cfg.StripeWebhookSecret)
+	a.accountCleanupService = jobs.NewAccountCleanupService(
+		a.db,
+		userRepo,
+		wishlistRepo,
+		giftItemRepo,
+		reservationRepo,
+		emailService,
+		a.cfg.AccountDeletionGrace,
+	)
+	a.rolloverService = jobs.NewOccasionRolloverService(wishlistRepo, userRepo, emailService)
+	a.reminderService = jobs.NewOccasionReminderService(rsvpRepo, userRepo, emailService, notificationSvc)
+	a.purchaseReminder = jobs.NewPurchaseReminderService(purchaseReminderRepo, a.tokenManager, emailService, a.cfg.FrontendURL, a.cfg.PurchaseReminderLead)
+	a.digestService = jobs.NewWeeklyDigestService(notificationRepo, userRepo, emailService)
+	a.pushDispatcher = jobs.NewPushDispatcherService(notificationRepo, a.pushRouter)
+	a.webhookDispatcher = jobs.NewPartnerWebhookDispatcherService(partnerRepo, webhook.NewSender(a.cfg.PartnerWebhookTimeout))
+	a.shipmentTracking = jobs.NewShipmentTrackingService(shipmentRepo, a.carrierRouter, notificationSvc)
+	a.discoveryRefresh = jobs.NewDiscoveryRefreshService(discoverySvc, discoveryservice.RefreshInterval)
+	a.slugExpiry = jobs.NewPublicSlugExpiryService(wishlistSvc, discoverySvc, wishlistservice.PublicSlugExpiryInterval)
+	a.dataRetention = jobs.NewDataRetentionService(retentionSvc, retentionservice.RetentionInterval)
+	a.snapshotJob = jobs.NewOccasionSnapshotService(snapshotSvc, wishlistservice.SnapshotInterval)
+	a.viewFlush = jobs.NewViewFlushService(viewSvc, wishlistservice.ViewFlushInterval)
+	a.reservationEventCheck = jobs.NewReservationEventCheckService(reservationSvc, reservationservice.EventCheckInterval)
+	a.guestReservationLink = jobs.NewGuestReservationLinkService(guestLinkSvc, reservationservice.GuestLinkInterval)
+	a.availabilityService = jobs.NewItemAvailabilityService(giftItemRepo, linkcheck.NewHTTPChecker(a.cfg.LinkCheckTimeout))
 
 	// --- Handlers ---
 
-	a.healthHandler = healthhttp.NewHandler(a.db)
+	a.healthHandler = a.newHealthHandler()
 	a.userHandler = userhttp.NewHandler(userSvc, a.tokenManager, a.accountCleanupService, a.analyticsService)
+	a.profileHandler = userhttp.NewProfileHandler(profileSvc)
 	a.authHandler = authhttp.NewHandler(userSvc, a.tokenManager, a.codeStore)
 	a.oauthHandler = authhttp.NewOAuthHandler(
 		userRepo,
@@ -222,42 +581,253 @@ func (a *App) initDomains() {
 		a.cfg.FacebookClientSecret,
 		a.cfg.OAuthRedirectURL,
 		a.cfg.OAuthHTTPTimeout,
-		reservationRepo,
+		guestLinkSvc,
+	)
+	a.wishlistHandler = wishlisthttp.NewHandler(wishlistSvc, a.affiliateLinks)
+	a.presenceHandler = wishlisthttp.NewPresenceHandler(wishlistservice.NewEditPresenceService(a.newPresenceTracker()))
+	a.snapshotHandler = wishlisthttp.NewSnapshotHandler(snapshotSvc)
+	a.viewHandler = wishlisthttp.NewViewHandler(viewSvc)
+	a.importHandler = wishlisthttp.NewImportHandler(importSvc)
+	a.inviteHandler = wishlisthttp.NewInviteHandler(
+		wishlistservice.NewInviteService(inviteRepo, userRepo, emailService, contactRepo, moderationSvc, a.cfg.FrontendURL),
 	)
-	a.wishlistHandler = wishlisthttp.NewHandler(wishlistSvc)
 	a.itemHandler = itemhttp.NewHandler(itemSvc)
+	var guestEmailDecrypter itemservice.GuestEmailDecrypterInterface
+	if a.encryptionSvc != nil {
+		guestEmailDecrypter = a.encryptionSvc
+	}
+	a.receivedHandler = itemhttp.NewReceivedHandler(
+		itemservice.NewReceivedService(giftItemReservationRepo, giftItemImageRepo, reservationEventRepo, notificationSvc, emailService, guestEmailDecrypter, partnerSvc),
+	)
+	a.shareLinkHandler = itemhttp.NewShareLinkHandler(
+		itemservice.NewShareLinkService(itemrepo.NewGiftItemShareLinkRepository(a.db), giftItemImageRepo, a.tokenManager, a.cfg.FrontendURL),
+	)
 	a.wishlistItemHandler = wishlistitemhttp.NewHandler(wishlistItemSvc)
 	a.reservationHandler = reservationhttp.NewHandler(reservationSvc)
+	a.guestLinkHandler = reservationhttp.NewGuestLinkHandler(guestLinkSvc)
+	a.shoppingListHandler = reservationhttp.NewShoppingListHandler(
+		reservationservice.NewShoppingListService(reservationRepo, pdf.NewImageFetcher(5*time.Second)),
+	)
+	a.purchaseHandler = reservationhttp.NewGuestPurchaseHandler(
+		reservationservice.NewGuestPurchaseService(purchaseReminderRepo, a.tokenManager, wishlistSvc),
+	)
+	a.privacyHandler = privacyhttp.NewHandler(privacySvc)
+	a.commentHandler = commenthttp.NewHandler(commentSvc)
+	a.suggestionHandler = suggestionhttp.NewHandler(suggestionSvc)
+	a.rsvpHandler = rsvphttp.NewHandler(rsvpSvc)
+	a.notificationHandler = notificationhttp.NewHandler(notificationSvc)
+	a.giftHistoryHandler = gifthistoryhttp.NewHandler(giftHistorySvc)
+	a.registryHandler = registryhttp.NewHandler(registrySvc, a.affiliateLinks)
+	a.outboundHandler = outboundhttp.NewHandler(outboundSvc, a.analyticsService)
+	a.quotaHandler = quotahttp.NewHandler(quotaSvc)
+	a.billingHandler = billinghttp.NewHandler(billingSvc)
+	a.apiTokenHandler = apitokenhttp.NewHandler(apiTokenSvc)
+	a.followHandler = followhttp.NewHandler(followSvc)
+	a.calendarHandler = calendarhttp.NewHandler(calendarSvc)
+	a.partnerHandler = partnerhttp.NewHandler(partnerSvc)
+	a.moderationHandler = moderationhttp.NewHandler(moderationSvc)
+	a.shipmentHandler = shipmenthttp.NewHandler(shipmentSvc)
+	a.statsHandler = statshttp.NewHandler(statsSvc)
+	a.discoveryHandler = discoveryhttp.NewHandler(discoverySvc)
+	a.contactHandler = contacthttp.NewHandler(contactservice.NewContactService(contactRepo))
+	a.retentionHandler = retentionhttp.NewHandler(retentionSvc)
 
 	if a.s3Client != nil {
-		a.storageHandler = storagehttp.NewHandler(a.s3Client)
+		a.storageHandler = storagehttp.NewHandler(a.s3Client, quotaSvc)
+
+		// Item attachments are stored in S3 and only served through presigned URLs
+		attachmentRepo := itemrepo.NewGiftItemAttachmentRepository(a.db)
+		attachmentSvc := itemservice.NewAttachmentService(attachmentRepo, giftItemRepo, wishlistItemRepo, wishlistRepo, a.s3Client)
+		a.attachmentHandler = itemhttp.NewAttachmentHandler(attachmentSvc)
+
+		// Data export archives are stored in S3
+		dataExportSvc := dataexportservice.NewDataExportService(dataExportRepo, a.s3Client, a.cfg.DataExportLinkTTL)
+		a.dataExportHandler = dataexporthttp.NewHandler(dataExportSvc)
+		a.dataExportJob = jobs.NewDataExportJobService(
+			dataExportRepo,
+			a.accountCleanupService,
+			reservationRepo,
+			quotaRepo,
+			apiTokenRepo,
+			a.s3Client,
+			a.cfg.DataExportRetention,
+		)
 	}
 }
 
 // initServer creates the Echo server with middleware and registers all domain routes.
-func (a *App) initServer() {
+// It fails when two registrars claim the same method and path.
+func (a *App) initServer() error {
 	a.server = server.New(a.cfg, validation.NewValidator())
 	e := a.server.Echo
 
+	// Throttles crawling of /api/public and hides which slugs exist
+	e.Use(middleware.PublicGuard(middleware.PublicGuardConfig{
+		RequestsPerMinute:   a.cfg.PublicRateLimit,
+		SuspiciousPerMinute: a.cfg.PublicRateLimitBots,
+		TokenSecret:         a.cfg.PublicTokenSecret,
+	}))
+
 	// Swagger
 	swagger.InitSwagger(e)
 
-	// Auth middleware for protected routes
-	authMiddleware := auth.JWTMiddleware(a.tokenManager)
-	optionalAuthMiddleware := auth.OptionalJWTMiddleware(a.tokenManager)
+	a.registerRoutes(e)
+
+	if err := a.server.CheckRoutes(); err != nil {
+		return err
+	}
+	a.server.LogRoutes()
+
+	return nil
+}
+
+// newPresenceTracker shares edit presence between instances through Redis. Without
+// Redis each instance only sees its own sessions.
+func (a *App) newPresenceTracker() presence.Tracker {
+	if redisCache, ok := a.redisCache.(*cache.RedisCache); ok && redisCache.Available() {
+		return presence.NewRedisTracker(redisCache.Client())
+	}
+	logger.Warn("Redis unavailable, wishlist edit presence is tracked per instance")
+	return presence.NewMemoryTracker()
+}
+
+// newViewBuffer shares buffered wishlist views between instances through Redis.
+// Without Redis each instance buffers and deduplicates its own visitors.
+func (a *App) newViewBuffer() pageviews.Buffer {
+	if redisCache, ok := a.redisCache.(*cache.RedisCache); ok && redisCache.Available() {
+		return pageviews.NewRedisBuffer(redisCache.Client())
+	}
+	logger.Warn("Redis unavailable, wishlist views are buffered per instance")
+	return pageviews.NewMemoryBuffer()
+}
+
+// newHealthHandler wires the optional dependencies into the readiness probe.
+// Disabled dependencies are passed as untyped nil so they report as disabled.
+func (a *App) newHealthHandler() *healthhttp.Handler {
+	var redis, storage healthhttp.Pinger
+	if pinger, ok := a.redisCache.(healthhttp.Pinger); ok {
+		redis = pinger
+	}
+	if a.s3Client != nil {
+		storage = a.s3Client
+	}
+
+	migrationVersion, err := database.LatestMigrationVersion()
+	if err != nil {
+		logger.Warn("failed to determine expected migration version", "error", err)
+	}
+
+	return healthhttp.NewHandler(a.db, redis, storage, migrationVersion)
+}
+
+// UseSecrets hands over the resolver that loaded secrets-manager references into the
+// environment, so Run can reload them every SECRETS_RELOAD_MINUTES.
+func (a *App) UseSecrets(r *secrets.Resolver) {
+	a.secrets = r
+}
+
+// applyRotatedSecrets takes over secrets rotated since startup. The JWT secret is
+// swapped in place; everything else was wired into clients at startup.
+func (a *App) applyRotatedSecrets(keys []string) {
+	for _, key := range keys {
+		if key == "JWT_SECRET" {
+			a.tokenManager.RotateSecret(os.Getenv(key))
+			logger.Info("JWT secret rotated")
+			continue
+		}
+		logger.Warn("secret rotated, restart to apply it", "key", key)
+	}
+}
+
+// Handler returns the routes with all their middleware, for serving the app
+// without Run, e.g. from an httptest server. Background jobs are left to
+// StartBackground.
+func (a *App) Handler() http.Handler {
+	return a.server.Echo
+}
+
+// StartBackground starts the background jobs and monitors, which run until ctx
+// is canceled. Shutdown waits for the jobs to finish.
+func (a *App) StartBackground(ctx context.Context) {
+	// Start code store cleanup goroutine
+	a.codeStore.StartCleanupRoutine(ctx)
+
+	// Start background jobs; shutdown waits for them before closing DB/Redis
+	a.background.Go("account-cleanup", func() {
+		a.accountCleanupService.RunScheduledCleanup(ctx)
+	})
+	a.background.Go("occasion-rollover", func() {
+		a.rolloverService.RunScheduledRollover(ctx)
+	})
+	a.background.Go("occasion-reminder", func() {
+		a.reminderService.RunScheduledReminders(ctx)
+	})
+	if a.cfg.PurchaseReminderLead > 0 {
+		a.background.Go("purchase-reminder", func() {
+			a.purchaseReminder.RunScheduledReminders(ctx)
+		})
+	}
+	a.background.Go("weekly-digest", func() {
+		a.digestService.RunScheduledDigests(ctx)
+	})
+	a.background.Go("item-availability", func() {
+		a.availabilityService.RunScheduledCheck(ctx)
+	})
+	if a.dataExportJob != nil {
+		a.background.Go("data-export", func() {
+			a.dataExportJob.RunScheduledExports(ctx)
+		})
+	}
+	if a.pushRouter.Enabled() {
+		a.background.Go("push-dispatch", func() {
+			a.pushDispatcher.RunScheduledDispatch(ctx)
+		})
+	}
+	a.background.Go("discovery-refresh", func() {
+		a.discoveryRefresh.RunScheduledRefresh(ctx)
+	})
+	a.background.Go("public-slug-expiry", func() {
+		a.slugExpiry.RunScheduledExpiry(ctx)
+	})
+	a.background.Go("data-retention", func() {
+		a.dataRetention.RunScheduledRetention(ctx)
+	})
+	a.background.Go("occasion-snapshot", func() {
+		a.snapshotJob.RunScheduledSnapshots(ctx)
+	})
+	a.background.Go("view-flush", func() {
+		a.viewFlush.RunScheduledFlush(ctx)
+	})
+	a.background.Go("reservation-event-check", func() {
+		a.reservationEventCheck.RunScheduledCheck(ctx)
+	})
+	a.background.Go("guest-reservation-link", func() {
+		a.guestReservationLink.RunScheduledLinking(ctx)
+	})
+	a.background.Go("partner-webhook-dispatch", func() {
+		a.webhookDispatcher.RunScheduledDispatch(ctx)
+	})
+	if a.carrierRouter.Enabled() {
+		a.background.Go("shipment-tracking", func() {
+			a.shipmentTracking.RunScheduledTracking(ctx)
+		})
+	}
 
-	// Register all domain routes
-	healthhttp.RegisterRoutes(e, a.healthHandler)
-	userhttp.RegisterRoutes(e, a.userHandler, authMiddleware)
-	authhttp.RegisterRoutes(e, a.authHandler, a.oauthHandler, authMiddleware)
-	wishlisthttp.RegisterRoutes(e, a.wishlistHandler, authMiddleware)
-	itemhttp.RegisterRoutes(e, a.itemHandler, authMiddleware)
-	wishlistitemhttp.RegisterRoutes(e, a.wishlistItemHandler, authMiddleware)
-	reservationhttp.RegisterRoutes(e, a.reservationHandler, optionalAuthMiddleware, authMiddleware)
+	if a.secrets != nil && len(a.secrets.Keys()) > 0 && a.cfg.SecretsReloadInterval > 0 {
+		a.background.Go("secrets-reload", func() {
+			a.secrets.Watch(ctx, a.cfg.SecretsReloadInterval, a.applyRotatedSecrets)
+		})
+	}
 
-	if a.storageHandler != nil {
-		storagehttp.RegisterRoutes(e, a.storageHandler, a.tokenManager)
+	if redisCache, ok := a.redisCache.(*cache.RedisCache); ok && a.cfg.RedisReconnect > 0 {
+		a.background.Go("redis-reconnect", func() {
+			redisCache.MonitorConnection(ctx, a.cfg.RedisReconnect)
+		})
 	}
+
+	// Periodic database health check and pool stats reporting
+	a.db.StartPoolMonitor(ctx, a.cfg.DatabaseHealthCheck, database.LogPoolStats)
+	a.db.StartReplicaMonitor(ctx, a.cfg.DatabaseHealthCheck)
 }
 
 // Run starts the application: background jobs and HTTP server.
@@ -267,15 +837,11 @@ func (a *App) Run() error {
 	appCtx, appCancel := context.WithCancel(context.Background())
 	defer appCancel()
 
-	// Start code store cleanup goroutine
-	a.codeStore.StartCleanupRoutine(appCtx)
-
-	// Start background jobs
-	a.accountCleanupService.StartScheduledCleanup(appCtx)
+	a.StartBackground(appCtx)
 
 	// Start HTTP server
 	port := fmt.Sprintf(":%d", a.cfg.ServerPort)
-	log.Printf("Server is starting on port %s", port)
+	logger.Info("server is starting", "port", a.cfg.ServerPort)
 
 	serverErrors := make(chan error, 1)
 	go func() {
@@ -293,7 +859,7 @@ func (a *App) Run() error {
 		return fmt.Errorf("server failed to start: %w", err)
 
 	case sig := <-stop:
-		log.Printf("Received signal (%v), starting graceful shutdown...", sig)
+		logger.Info("received signal, starting graceful shutdown", "signal", sig.String())
 		appCancel()
 		return a.Shutdown(context.Background())
 	}
@@ -301,33 +867,46 @@ func (a *App) Run() error {
 
 // Shutdown gracefully shuts down the application.
 func (a *App) Shutdown(ctx context.Context) error {
-	log.Println("Stopping background services...")
+	logger.Info("stopping background services")
 
 	// Shutdown HTTP server
 	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer shutdownCancel()
 
 	if err := a.server.Echo.Shutdown(shutdownCtx); err != nil {
-		log.Printf("Server forced to shutdown: %v", err)
+		logger.Error("server forced to shutdown", "error", err)
 		if closeErr := a.server.Echo.Close(); closeErr != nil {
-			log.Printf("Error closing server: %v", closeErr)
+			logger.Error("failed to close server", "error", closeErr)
+		}
+	}
+
+	// Wait for in-flight background jobs (and the emails they send) before
+	// their connections go away. Request-path emails are sent synchronously,
+	// so the HTTP shutdown above has already drained them.
+	if a.background != nil {
+		drainCtx, drainCancel := context.WithTimeout(ctx, a.cfg.ShutdownDrainTimeout)
+		defer drainCancel()
+
+		logger.Info("waiting for background jobs to finish")
+		if err := a.background.Wait(drainCtx); err != nil {
+			logger.Warn("shutdown drain incomplete", "error", err)
 		}
 	}
 
 	// Close Redis
 	if a.redisCache != nil {
-		log.Println("Closing Redis connection...")
+		logger.Info("closing Redis connection")
 		if err := a.redisCache.Close(); err != nil {
-			log.Printf("Error closing Redis: %v", err)
+			logger.Error("failed to close Redis", "error", err)
 		}
 	}
 
 	// Close database
-	log.Println("Closing database connection...")
+	logger.Info("closing database connection")
 	if err := a.db.Close(); err != nil {
-		log.Printf("Error closing database: %v", err)
+		logger.Error("failed to close database", "error", err)
 	}
 
-	log.Println("Server stopped gracefully")
+	logger.Info("server stopped gracefully")
 	return nil
 }
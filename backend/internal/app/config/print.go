@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// redacted replaces secret values in Print output
+const redacted = "[REDACTED]"
+
+// Print writes the effective configuration as KEY=value lines, one per field, in
+// declaration order. Secrets are redacted; URLs keep everything but their password.
+// Defaulted values are marked so typos in variable names stand out.
+func (c *Config) Print(w io.Writer) error {
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key := field.Tag.Get("env")
+		if key == "" {
+			continue
+		}
+
+		value := formatValue(v.Field(i).Interface())
+		if value != "" {
+			switch field.Tag.Get("secret") {
+			case "true":
+				value = redacted
+			case "url":
+				value = redactURL(value)
+			}
+		}
+
+		source := ""
+		if !c.explicit[key] {
+			source = " (default)"
+		}
+
+		if _, err := fmt.Fprintf(w, "%s=%s%s\n", key, value, source); err != nil {
+			return fmt.Errorf("failed to print config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case time.Duration:
+		return v.String()
+	case []*net.IPNet:
+		ranges := make([]string, len(v))
+		for i, ipNet := range v {
+			ranges[i] = ipNet.String()
+		}
+		return strings.Join(ranges, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// redactURL hides the password of a connection URL, or the whole value when it
+// cannot be parsed
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+	return u.Redacted()
+}
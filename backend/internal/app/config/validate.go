@@ -0,0 +1,202 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"time"
+
+	"wish-list/internal/pkg/abuse"
+	"wish-list/internal/pkg/affiliate"
+	"wish-list/internal/pkg/emailcheck"
+)
+
+// Supported SERVER_ENV values
+const (
+	EnvDevelopment = "development"
+	EnvTest        = "test"
+	EnvStaging     = "staging"
+	EnvProduction  = "production"
+)
+
+// requiredOutsideDevelopment lists variables whose defaults only make sense on a
+// developer machine, so they must be set explicitly everywhere else
+var requiredOutsideDevelopment = []string{
+	"DATABASE_URL",
+	"JWT_SECRET",
+	"REDIS_ADDR",
+	"CORS_ALLOWED_ORIGINS",
+}
+
+// IsDevelopment reports whether the server runs with development defaults
+func (c *Config) IsDevelopment() bool {
+	return c.ServerEnv == EnvDevelopment
+}
+
+// Validate checks the loaded configuration and returns every problem found,
+// joined into a single error. Outside development, variables that only have
+// local defaults must be set explicitly.
+func (c *Config) Validate() error {
+	errs := slices.Clone(c.loadErrors)
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(slices.Contains([]string{EnvDevelopment, EnvTest, EnvStaging, EnvProduction}, c.ServerEnv),
+		"SERVER_ENV: unknown environment %q", c.ServerEnv)
+	check(c.ServerPort > 0 && c.ServerPort <= 65535, "SERVER_PORT: %d is out of range", c.ServerPort)
+	check(c.ShutdownDrainTimeout >= 0, "SHUTDOWN_DRAIN_TIMEOUT_SECONDS: must not be negative")
+	check(c.SecretsReloadInterval >= 0, "SECRETS_RELOAD_MINUTES: must not be negative")
+
+	// Database
+	if err := validateURL(c.DatabaseURL, "postgres", "postgresql"); err != nil {
+		errs = append(errs, fmt.Errorf("DATABASE_URL: %w", err))
+	}
+	if c.DatabaseReplicaURL != "" {
+		if err := validateURL(c.DatabaseReplicaURL, "postgres", "postgresql"); err != nil {
+			errs = append(errs, fmt.Errorf("DATABASE_REPLICA_URL: %w", err))
+		}
+	}
+	check(c.DatabaseMaxConns > 0, "DATABASE_MAX_CONNECTIONS: must be positive")
+	check(c.DatabaseMaxIdleConns >= 0 && c.DatabaseMaxIdleConns <= c.DatabaseMaxConns,
+		"DATABASE_MAX_IDLE_CONNECTIONS: must be between 0 and DATABASE_MAX_CONNECTIONS")
+	check(c.DatabaseConnMaxLifetime >= 0, "DATABASE_CONN_MAX_LIFETIME_MINUTES: must not be negative")
+	check(c.DatabaseConnMaxIdleTime >= 0, "DATABASE_CONN_MAX_IDLE_TIME_MINUTES: must not be negative")
+	check(c.DatabaseHealthCheck >= 0, "DATABASE_HEALTH_CHECK_SECONDS: must not be negative")
+	check(c.DatabaseReplicaMaxLag >= 0, "DATABASE_REPLICA_MAX_LAG_SECONDS: must not be negative")
+	check(c.DatabaseSlowQuery >= 0, "DATABASE_SLOW_QUERY_MS: must not be negative")
+	check(c.DatabaseConnAttempts >= 1, "DATABASE_CONNECT_ATTEMPTS: must be at least 1")
+
+	// Redis
+	if _, _, err := net.SplitHostPort(c.RedisAddr); err != nil {
+		errs = append(errs, fmt.Errorf("REDIS_ADDR: expected host:port: %w", err))
+	}
+	check(c.RedisDB >= 0, "REDIS_DB: must not be negative")
+	check(c.RedisReconnect >= 0, "REDIS_RECONNECT_SECONDS: must not be negative")
+	check(c.BreakerFailures >= 0, "CIRCUIT_BREAKER_FAILURES: must not be negative")
+	check(c.BreakerFailures == 0 || c.BreakerCooldown > 0, "CIRCUIT_BREAKER_COOLDOWN_SECONDS: must be positive")
+	check(c.CacheTTL > 0, "CACHE_TTL_MINUTES: must be positive")
+
+	// HTTP
+	for _, origin := range c.CorsAllowedOrigins {
+		if err := validateURL(origin, "http", "https"); err != nil {
+			errs = append(errs, fmt.Errorf("CORS_ALLOWED_ORIGINS: %q: %w", origin, err))
+		}
+	}
+	if _, err := url.Parse(c.OAuthRedirectURL); err != nil {
+		errs = append(errs, fmt.Errorf("OAUTH_REDIRECT_URL: %w", err))
+	}
+	if err := validateURL(c.FrontendURL, "http", "https"); err != nil {
+		errs = append(errs, fmt.Errorf("FRONTEND_URL: %w", err))
+	}
+	check(c.OAuthHTTPTimeout > 0, "OAUTH_HTTP_TIMEOUT: must be positive")
+	check((c.GoogleClientID == "") == (c.GoogleClientSecret == ""),
+		"GOOGLE_CLIENT_ID and GOOGLE_CLIENT_SECRET must be set together")
+	check((c.FacebookClientID == "") == (c.FacebookClientSecret == ""),
+		"FACEBOOK_CLIENT_ID and FACEBOOK_CLIENT_SECRET must be set together")
+
+	// CAPTCHA
+	if c.CaptchaProvider != "" {
+		check(slices.Contains([]string{"hcaptcha", "turnstile", "recaptcha"}, c.CaptchaProvider),
+			"CAPTCHA_PROVIDER: unknown provider %q", c.CaptchaProvider)
+		check(c.CaptchaSecret != "", "CAPTCHA_SECRET: required when CAPTCHA_PROVIDER is set")
+	}
+	check(c.CaptchaTimeout > 0, "CAPTCHA_TIMEOUT: must be positive")
+	check(c.GuestLimitPerEmail >= 0, "GUEST_RESERVATION_LIMIT_PER_EMAIL: must not be negative")
+	check(c.GuestLimitPerIP >= 0, "GUEST_RESERVATION_LIMIT_PER_IP: must not be negative")
+	check(c.GuestLimitWindow > 0, "GUEST_RESERVATION_LIMIT_WINDOW_HOURS: must be positive")
+	check(c.PurchaseReminderLead >= 0, "PURCHASE_REMINDER_DAYS: must not be negative")
+	check(c.AbuseScoreThreshold >= 0, "ABUSE_SCORE_THRESHOLD: must not be negative")
+	if _, err := abuse.NewIPReputation(c.AbuseIPDenylist); err != nil {
+		errs = append(errs, fmt.Errorf("ABUSE_IP_DENYLIST: %w", err))
+	}
+	check(c.PublicRateLimit >= 0, "PUBLIC_RATE_LIMIT_PER_MINUTE: must not be negative")
+	if c.PublicRateLimit > 0 {
+		check(c.PublicRateLimitBots > 0 && c.PublicRateLimitBots <= c.PublicRateLimit,
+			"PUBLIC_RATE_LIMIT_BOTS_PER_MINUTE: must be between 1 and PUBLIC_RATE_LIMIT_PER_MINUTE")
+	}
+	check(c.AccountDeletionGrace >= 0, "ACCOUNT_DELETION_GRACE_DAYS: must not be negative")
+	check(c.DataExportRetention > 0, "DATA_EXPORT_RETENTION_DAYS: must be positive")
+	// Presigned S3 links cannot be valid for more than 7 days
+	check(c.DataExportLinkTTL > 0 && c.DataExportLinkTTL <= 7*24*time.Hour, "DATA_EXPORT_LINK_TTL_MINUTES: must be between 1 minute and 7 days")
+	check(c.RetentionGuestPII >= 0, "RETENTION_GUEST_PII_MONTHS: must not be negative")
+	check(c.RetentionAuditLog >= 0, "RETENTION_AUDIT_LOG_MONTHS: must not be negative")
+	check(c.RetentionAnalytics >= 0, "RETENTION_ANALYTICS_MONTHS: must not be negative")
+	check(c.LinkCheckTimeout > 0, "LINK_CHECK_TIMEOUT: must be positive")
+	if _, err := affiliate.ParseRules(c.AffiliateRules); err != nil {
+		errs = append(errs, fmt.Errorf("AFFILIATE_RULES: %w", err))
+	}
+
+	// Billing
+	if c.StripeSecretKey != "" {
+		check(c.StripeWebhookSecret != "", "STRIPE_WEBHOOK_SECRET: required when STRIPE_SECRET_KEY is set")
+		check(c.StripePremiumPriceID != "", "STRIPE_PREMIUM_PRICE_ID: required when STRIPE_SECRET_KEY is set")
+		if err := validateURL(c.BillingSuccessURL, "http", "https"); err != nil {
+			errs = append(errs, fmt.Errorf("BILLING_SUCCESS_URL: %w", err))
+		}
+		if err := validateURL(c.BillingCancelURL, "http", "https"); err != nil {
+			errs = append(errs, fmt.Errorf("BILLING_CANCEL_URL: %w", err))
+		}
+	}
+	check(c.StripeTimeout > 0, "STRIPE_TIMEOUT: must be positive")
+
+	// Moderation
+	if c.ModerationImageEndpoint != "" {
+		if err := validateURL(c.ModerationImageEndpoint, "http", "https"); err != nil {
+			errs = append(errs, fmt.Errorf("MODERATION_IMAGE_ENDPOINT: %w", err))
+		}
+	}
+	check(c.ModerationImageTimeout > 0, "MODERATION_IMAGE_TIMEOUT: must be positive")
+
+	// Email checks
+	check(slices.Contains(emailcheck.Modes, c.EmailCheckMode),
+		"EMAIL_CHECK_MODE: unknown mode %q", c.EmailCheckMode)
+	check(c.EmailCheckTimeout > 0, "EMAIL_CHECK_TIMEOUT: must be positive")
+	check(c.EmailCheckCacheTTL > 0, "EMAIL_CHECK_CACHE_TTL_MINUTES: must be positive")
+
+	// Push notifications
+	if c.APNsKey != "" {
+		check(c.APNsKeyID != "", "APNS_KEY_ID: required when APNS_KEY is set")
+		check(c.APNsTeamID != "", "APNS_TEAM_ID: required when APNS_KEY is set")
+		check(c.APNsTopic != "", "APNS_TOPIC: required when APNS_KEY is set")
+	}
+	check(c.PushTimeout > 0, "PUSH_TIMEOUT: must be positive")
+	check(c.TrackingTimeout > 0, "TRACKING_TIMEOUT: must be positive")
+	check(c.PartnerWebhookTimeout > 0, "PARTNER_WEBHOOK_TIMEOUT: must be positive")
+
+	// Email delivery
+	if !c.EmailSandbox {
+		check(c.SMTPHost != "", "SMTP_HOST: required unless EMAIL_SANDBOX is enabled")
+		check(c.IsDevelopment() || c.explicit["SENDER_EMAIL"], "SENDER_EMAIL: required unless EMAIL_SANDBOX is enabled")
+	}
+	check(c.SMTPPort > 0 && c.SMTPPort <= 65535, "SMTP_PORT: must be between 1 and 65535")
+	check(c.SMTPSecondaryPort > 0 && c.SMTPSecondaryPort <= 65535, "SMTP_SECONDARY_PORT: must be between 1 and 65535")
+	check(c.SMTPTimeout > 0, "SMTP_TIMEOUT: must be positive")
+
+	if !c.IsDevelopment() {
+		for _, key := range requiredOutsideDevelopment {
+			check(c.explicit[key], "%s: required in %s", key, c.ServerEnv)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateURL checks that raw is an absolute URL with one of the given schemes
+func validateURL(raw string, schemes ...string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return errors.New("malformed URL")
+	}
+	if !slices.Contains(schemes, u.Scheme) {
+		return fmt.Errorf("scheme must be one of %v", schemes)
+	}
+	if u.Host == "" {
+		return errors.New("missing host")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// migrationFiles embeds the up migrations so the running binary knows which
+// schema version it was built against
+//
+//go:embed migrations/*.up.sql
+var migrationFiles embed.FS
+
+// LatestMigrationVersion returns the highest migration version shipped with this build
+func LatestMigrationVersion() (uint, error) {
+	names, err := fs.Glob(migrationFiles, "migrations/*.up.sql")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	var latest uint
+	for _, name := range names {
+		prefix, _, found := strings.Cut(strings.TrimPrefix(name, "migrations/"), "_")
+		if !found {
+			return 0, fmt.Errorf("invalid migration file name: %s", name)
+		}
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration file name %s: %w", name, err)
+		}
+		latest = max(latest, uint(version))
+	}
+
+	return latest, nil
+}
+
+// MigrationVersion returns the schema version recorded by golang-migrate and
+// whether the last migration left the schema dirty
+func (db *DB) MigrationVersion(ctx context.Context) (version uint, dirty bool, err error) {
+	row := db.QueryRowxContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if err := row.Scan(&version, &dirty); err != nil {
+		return 0, false, fmt.Errorf("failed to read migration version: %w", err)
+	}
+
+	return version, dirty, nil
+}
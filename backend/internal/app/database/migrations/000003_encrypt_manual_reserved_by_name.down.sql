@@ -0,0 +1,4 @@
+-- Revert encrypted manual reservation name from gift_items
+-- Note: encrypted values are dropped; run the plaintext backfill in reverse before rolling back
+ALTER TABLE gift_items
+    DROP COLUMN IF EXISTS encrypted_manual_reserved_by_name;
@@ -0,0 +1,6 @@
+-- Add encrypted copy of the manual reservation name (PII, CR-004)
+-- When encryption is enabled, manual_reserved_by_name stays NULL and the name
+-- is stored only in encrypted_manual_reserved_by_name.
+-- Existing plaintext rows are migrated with: go run ./cmd/admin rotate-encryption-key -encrypt-plaintext
+ALTER TABLE gift_items
+    ADD COLUMN encrypted_manual_reserved_by_name TEXT NULL;
@@ -0,0 +1,2 @@
+-- Revert guest privacy requests
+DROP TABLE IF EXISTS privacy_requests;
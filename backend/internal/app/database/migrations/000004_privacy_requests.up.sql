@@ -0,0 +1,33 @@
+-- Guest privacy requests (GDPR erasure/export of guest reservation PII)
+-- Flow: pending_verification -> pending_review (email verified) -> completed | rejected
+-- When encryption is enabled, email stays NULL and is stored only in encrypted_email.
+CREATE TABLE privacy_requests (
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    request_type        VARCHAR(20) NOT NULL,
+    email               TEXT,
+    encrypted_email     TEXT,                        -- PII encrypted copy
+    verification_token  UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    status              VARCHAR(30) NOT NULL DEFAULT 'pending_verification',
+    expires_at          TIMESTAMPTZ NOT NULL,        -- Verification link expiry
+    verified_at         TIMESTAMPTZ,
+    reviewed_by_user_id UUID,
+    reviewed_at         TIMESTAMPTZ,
+    review_note         TEXT,
+    affected_records    INTEGER,
+    completed_at        TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_privacy_requests_type
+        CHECK (request_type IN ('erasure', 'export')),
+
+    CONSTRAINT chk_privacy_requests_status
+        CHECK (status IN ('pending_verification', 'pending_review', 'completed', 'rejected')),
+
+    CONSTRAINT fk_privacy_requests_reviewed_by
+        FOREIGN KEY (reviewed_by_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_privacy_requests_status ON privacy_requests(status, created_at);
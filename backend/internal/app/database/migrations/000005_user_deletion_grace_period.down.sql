@@ -0,0 +1,5 @@
+-- Revert account deletion grace period
+DROP INDEX IF EXISTS idx_users_deletion_requested_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deletion_requested_at;
@@ -0,0 +1,10 @@
+-- Account deletion grace period
+-- A user-requested deletion only sets deletion_requested_at; the scheduled cleanup job
+-- purges the account once ACCOUNT_DELETION_GRACE_DAYS have passed. Until then the
+-- request can be canceled and login is blocked.
+ALTER TABLE users
+    ADD COLUMN deletion_requested_at TIMESTAMPTZ NULL;
+
+CREATE INDEX idx_users_deletion_requested_at
+    ON users(deletion_requested_at)
+    WHERE deletion_requested_at IS NOT NULL;
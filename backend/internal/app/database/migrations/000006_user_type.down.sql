@@ -0,0 +1,3 @@
+-- Revert persisted user type
+ALTER TABLE users
+    DROP COLUMN IF EXISTS user_type;
@@ -0,0 +1,6 @@
+-- Persist the account type issued in access tokens
+-- Previously every login issued user_type 'user', so admin routes were unreachable.
+-- Admins are created or promoted with the admin CLI (cmd/admin create-admin-user).
+ALTER TABLE users
+    ADD COLUMN user_type VARCHAR(20) NOT NULL DEFAULT 'user'
+        CONSTRAINT chk_users_user_type CHECK (user_type IN ('user', 'admin'));
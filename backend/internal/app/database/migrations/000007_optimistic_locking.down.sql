@@ -0,0 +1,6 @@
+-- Revert optimistic locking
+ALTER TABLE gift_items
+    DROP COLUMN IF EXISTS version;
+
+ALTER TABLE wishlists
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,8 @@
+-- Optimistic locking for owner edits
+-- Updates bump version and only apply when the row still has the version the writer read,
+-- so concurrent edits of the same wishlist or item no longer silently overwrite each other.
+ALTER TABLE wishlists
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE gift_items
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- Revert guest item comments
+DROP TABLE IF EXISTS item_comments;
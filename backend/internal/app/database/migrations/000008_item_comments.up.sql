@@ -0,0 +1,35 @@
+-- Guest comments / questions on public wishlist items
+-- Owners moderate their own threads: reply, hide from the public page, or delete.
+CREATE TABLE item_comments (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id    UUID NOT NULL,
+    gift_item_id   UUID NOT NULL,
+    author_user_id UUID,                          -- NULL for guest comments
+    author_name    VARCHAR(100) NOT NULL,
+    body           TEXT NOT NULL,
+    owner_reply    TEXT,
+    replied_at     TIMESTAMPTZ,
+    is_hidden      BOOLEAN NOT NULL DEFAULT false,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_item_comments_body_length
+        CHECK (char_length(body) BETWEEN 1 AND 2000),
+
+    CONSTRAINT fk_item_comments_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_item_comments_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_item_comments_author
+        FOREIGN KEY (author_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_item_comments_item ON item_comments(wishlist_id, gift_item_id, created_at);
@@ -0,0 +1,2 @@
+-- Revert guest item suggestions
+DROP TABLE IF EXISTS item_suggestions;
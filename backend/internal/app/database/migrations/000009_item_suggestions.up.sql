@@ -0,0 +1,39 @@
+-- Guest item suggestions for a public wishlist
+-- Flow: pending -> accepted (converted into a gift item on the wishlist) | declined
+CREATE TABLE item_suggestions (
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id          UUID NOT NULL,
+    suggested_by_user_id UUID,                    -- NULL for guest suggestions
+    suggester_name       VARCHAR(100) NOT NULL,
+    name                 VARCHAR(255) NOT NULL,
+    link                 TEXT,
+    price                NUMERIC(12,2),
+    status               VARCHAR(20) NOT NULL DEFAULT 'pending',
+    gift_item_id         UUID,                    -- Item created when the suggestion is accepted
+    decided_at           TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_item_suggestions_status
+        CHECK (status IN ('pending', 'accepted', 'declined')),
+
+    CONSTRAINT chk_item_suggestions_price
+        CHECK (price IS NULL OR price >= 0),
+
+    CONSTRAINT fk_item_suggestions_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_item_suggestions_suggested_by
+        FOREIGN KEY (suggested_by_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_item_suggestions_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_item_suggestions_inbox ON item_suggestions(wishlist_id, status, created_at);
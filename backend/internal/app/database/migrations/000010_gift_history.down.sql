@@ -0,0 +1,2 @@
+-- Revert gift history
+DROP TABLE IF EXISTS gift_history;
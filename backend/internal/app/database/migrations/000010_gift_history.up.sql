@@ -0,0 +1,42 @@
+-- Gift history: snapshots of gifts reserved/purchased for a user, kept after their wishlist is deleted.
+-- Gifts on wishlists that still exist are read live; rows are only written right before a wishlist is deleted.
+CREATE TABLE gift_history (
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    recipient_user_id    UUID NOT NULL,
+    source_wishlist_id   UUID NOT NULL,           -- Deleted wishlist the gift came from (no FK)
+    gift_item_id         UUID,
+    item_name            VARCHAR(255) NOT NULL,
+    price                NUMERIC(12,2),
+    wishlist_title       VARCHAR(255) NOT NULL,
+    occasion             TEXT,
+    occasion_date        DATE,
+    giver_user_id        UUID,
+    giver_name           TEXT,
+    encrypted_giver_name TEXT,                    -- PII encrypted (guest / manual reservation names)
+    kind                 VARCHAR(20) NOT NULL,
+    given_at             TIMESTAMPTZ NOT NULL,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_gift_history_kind
+        CHECK (kind IN ('reserved', 'purchased')),
+
+    CONSTRAINT fk_gift_history_recipient
+        FOREIGN KEY (recipient_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_gift_history_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_gift_history_giver
+        FOREIGN KEY (giver_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_gift_history_recipient ON gift_history(recipient_user_id, given_at);
+
+-- Makes snapshotting a wishlist idempotent if its deletion is retried
+CREATE UNIQUE INDEX idx_gift_history_source ON gift_history(source_wishlist_id, gift_item_id, kind);
@@ -0,0 +1,2 @@
+-- Revert giver budgets
+DROP TABLE IF EXISTS giver_budgets;
@@ -0,0 +1,32 @@
+-- Giver budgets: spending limits a registered giver sets for themselves
+-- monthly: one recurring limit per user, compared with purchases made in the current calendar month
+-- occasion: a limit for one wishlist, compared with purchases made from that wishlist
+CREATE TABLE giver_budgets (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID NOT NULL,
+    period      VARCHAR(20) NOT NULL,
+    wishlist_id UUID,                    -- Set only for occasion budgets
+    amount      NUMERIC(12,2) NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_giver_budgets_period
+        CHECK ((period = 'monthly' AND wishlist_id IS NULL)
+            OR (period = 'occasion' AND wishlist_id IS NOT NULL)),
+
+    CONSTRAINT chk_giver_budgets_amount
+        CHECK (amount > 0),
+
+    CONSTRAINT fk_giver_budgets_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_giver_budgets_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_giver_budgets_monthly ON giver_budgets(user_id) WHERE wishlist_id IS NULL;
+CREATE UNIQUE INDEX idx_giver_budgets_occasion ON giver_budgets(user_id, wishlist_id) WHERE wishlist_id IS NOT NULL;
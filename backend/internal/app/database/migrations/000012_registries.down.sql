@@ -0,0 +1,3 @@
+-- Revert registries
+DROP TABLE IF EXISTS registry_wishlists;
+DROP TABLE IF EXISTS registries;
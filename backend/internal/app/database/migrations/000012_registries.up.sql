@@ -0,0 +1,43 @@
+-- Registries: one public page combining several wishlists of the same owner
+-- (e.g. a wedding registry made of a "home" list and a "honeymoon fund" list)
+CREATE TABLE registries (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id    UUID NOT NULL,
+    title       VARCHAR(200) NOT NULL,
+    description TEXT,
+    public_slug VARCHAR(255) NOT NULL,
+    is_public   BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_registries_public_slug UNIQUE (public_slug),
+
+    CONSTRAINT fk_registries_owner
+        FOREIGN KEY (owner_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_registries_owner ON registries(owner_id);
+
+-- Member wishlists of a registry, in display order
+CREATE TABLE registry_wishlists (
+    registry_id UUID NOT NULL,
+    wishlist_id UUID NOT NULL,
+    position    INTEGER NOT NULL DEFAULT 0,
+    added_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (registry_id, wishlist_id),
+
+    CONSTRAINT fk_registry_wishlists_registry
+        FOREIGN KEY (registry_id)
+        REFERENCES registries(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_registry_wishlists_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_registry_wishlists_wishlist ON registry_wishlists(wishlist_id);
@@ -0,0 +1,9 @@
+-- Revert recurring occasions
+DROP INDEX IF EXISTS idx_wishlists_recurrence_due;
+
+ALTER TABLE wishlists
+    DROP CONSTRAINT IF EXISTS fk_wishlists_rolled_over_to,
+    DROP CONSTRAINT IF EXISTS chk_wishlists_recurrence_date,
+    DROP CONSTRAINT IF EXISTS chk_wishlists_recurrence,
+    DROP COLUMN IF EXISTS rolled_over_to_id,
+    DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,17 @@
+-- Recurring occasions: a yearly wishlist (birthday, anniversary) is cloned for its next
+-- occurrence ahead of the date, carrying over the items nobody has bought yet
+ALTER TABLE wishlists
+    ADD COLUMN recurrence VARCHAR(20),             -- NULL = one-off occasion
+    ADD COLUMN rolled_over_to_id UUID,             -- The list created for the next occurrence
+    ADD CONSTRAINT chk_wishlists_recurrence
+        CHECK (recurrence IS NULL OR recurrence IN ('yearly')),
+    ADD CONSTRAINT chk_wishlists_recurrence_date
+        CHECK (recurrence IS NULL OR occasion_date IS NOT NULL),
+    ADD CONSTRAINT fk_wishlists_rolled_over_to
+        FOREIGN KEY (rolled_over_to_id)
+        REFERENCES wishlists(id)
+        ON DELETE SET NULL;
+
+-- Scheduler lookup: recurring lists that have not been rolled over yet
+CREATE INDEX idx_wishlists_recurrence_due ON wishlists(occasion_date)
+    WHERE recurrence IS NOT NULL AND rolled_over_to_id IS NULL;
@@ -0,0 +1,7 @@
+-- Revert item availability
+DROP INDEX IF EXISTS idx_gift_items_availability_due;
+
+ALTER TABLE gift_items
+    DROP CONSTRAINT IF EXISTS chk_gift_items_availability_status,
+    DROP COLUMN IF EXISTS availability_checked_at,
+    DROP COLUMN IF EXISTS availability_status;
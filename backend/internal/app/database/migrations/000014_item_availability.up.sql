@@ -0,0 +1,11 @@
+-- Item availability: a background job checks each item's product link and records
+-- whether the page is gone (404/410) or reports the product as out of stock
+ALTER TABLE gift_items
+    ADD COLUMN availability_status VARCHAR(20) NOT NULL DEFAULT 'unknown',
+    ADD COLUMN availability_checked_at TIMESTAMPTZ,     -- NULL = never checked
+    ADD CONSTRAINT chk_gift_items_availability_status
+        CHECK (availability_status IN ('unknown', 'available', 'out_of_stock', 'not_found'));
+
+-- Checker lookup: linked items still worth buying, least recently checked first
+CREATE INDEX idx_gift_items_availability_due ON gift_items(availability_checked_at NULLS FIRST)
+    WHERE link IS NOT NULL AND archived_at IS NULL AND purchased_at IS NULL;
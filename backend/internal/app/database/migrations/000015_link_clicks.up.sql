@@ -0,0 +1,13 @@
+-- Click-through tracking: public item links are followed through GET /api/out/:itemId,
+-- which records one row per click before redirecting to the (affiliate-decorated) link
+CREATE TABLE item_link_clicks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    gift_item_id UUID NOT NULL REFERENCES gift_items(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,  -- Signed-in visitor; NULL for guests
+    merchant VARCHAR(255) NOT NULL,
+    affiliated BOOLEAN NOT NULL DEFAULT false,             -- An affiliate rule was applied to the link
+    clicked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_item_link_clicks_item ON item_link_clicks(gift_item_id, clicked_at);
+CREATE INDEX idx_item_link_clicks_merchant ON item_link_clicks(merchant, clicked_at);
@@ -0,0 +1,6 @@
+-- Revert plan quotas
+DROP TABLE IF EXISTS image_uploads;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_plan,
+    DROP COLUMN IF EXISTS plan;
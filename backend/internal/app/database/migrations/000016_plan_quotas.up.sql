@@ -0,0 +1,15 @@
+-- Plan quotas: every user is on a plan (free or premium) that caps wishlists,
+-- items per wishlist and image uploads per month
+ALTER TABLE users
+    ADD COLUMN plan VARCHAR(20) NOT NULL DEFAULT 'free',
+    ADD CONSTRAINT chk_users_plan CHECK (plan IN ('free', 'premium'));
+
+-- One row per uploaded image, counted against the monthly upload quota
+CREATE TABLE image_uploads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_image_uploads_user ON image_uploads(user_id, created_at);
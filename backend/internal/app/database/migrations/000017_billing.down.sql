@@ -0,0 +1,9 @@
+-- Revert Stripe billing
+DROP INDEX IF EXISTS idx_users_stripe_customer;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS subscription_synced_at,
+    DROP COLUMN IF EXISTS subscription_period_end,
+    DROP COLUMN IF EXISTS subscription_status,
+    DROP COLUMN IF EXISTS subscription_id,
+    DROP COLUMN IF EXISTS stripe_customer_id;
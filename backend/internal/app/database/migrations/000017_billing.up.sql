@@ -0,0 +1,9 @@
+-- Stripe subscription state synced from webhooks onto the user
+ALTER TABLE users
+    ADD COLUMN stripe_customer_id VARCHAR(255),
+    ADD COLUMN subscription_id VARCHAR(255),
+    ADD COLUMN subscription_status VARCHAR(30),
+    ADD COLUMN subscription_period_end TIMESTAMPTZ,
+    ADD COLUMN subscription_synced_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX idx_users_stripe_customer ON users(stripe_customer_id) WHERE stripe_customer_id IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Revert personal API tokens
+DROP TABLE IF EXISTS api_tokens;
@@ -0,0 +1,16 @@
+-- Personal API tokens for integrations such as the browser extension.
+-- Only the SHA-256 hash of a token is stored; the token itself is shown once on creation.
+CREATE TABLE api_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,     -- Leading characters shown to tell tokens apart
+    scopes TEXT NOT NULL,                  -- Space-separated, e.g. 'items:write wishlists:read'
+    expires_at TIMESTAMPTZ,                -- NULL = never expires
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_tokens_user ON api_tokens(user_id, created_at DESC);
@@ -0,0 +1,3 @@
+-- Revert follows and calendar settings
+ALTER TABLE users DROP COLUMN IF EXISTS calendar_reminder_days;
+DROP TABLE IF EXISTS user_follows;
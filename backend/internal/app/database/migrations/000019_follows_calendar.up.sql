@@ -0,0 +1,14 @@
+-- Users following other users, e.g. to see friends' occasions in their calendar feed
+CREATE TABLE user_follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX idx_user_follows_followee ON user_follows(followee_id);
+
+-- Days before an occasion the calendar feed reminds the user; NULL = no reminders
+ALTER TABLE users
+    ADD COLUMN calendar_reminder_days SMALLINT DEFAULT 1 CHECK (calendar_reminder_days BETWEEN 0 AND 30);
@@ -0,0 +1,5 @@
+-- Revert the partner API
+DROP INDEX IF EXISTS idx_wishlists_partner_shared;
+ALTER TABLE wishlists DROP COLUMN IF EXISTS partner_shared;
+DROP TABLE IF EXISTS partner_api_usage;
+DROP TABLE IF EXISTS partner_api_keys;
@@ -0,0 +1,27 @@
+-- API keys for partners (e.g. gift shops) embedding shared public wishlists.
+-- Only the SHA-256 hash of a key is stored; the key itself is shown once on creation.
+CREATE TABLE partner_api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,            -- Partner the key was issued to
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    key_prefix VARCHAR(16) NOT NULL,       -- Leading characters shown to tell keys apart
+    scopes TEXT NOT NULL,                  -- Space-separated, e.g. 'wishlists:read items:read'
+    rate_limit_per_minute INTEGER NOT NULL DEFAULT 60 CHECK (rate_limit_per_minute > 0),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Requests per key per day (UTC), for metering and billing partners
+CREATE TABLE partner_api_usage (
+    key_id UUID NOT NULL REFERENCES partner_api_keys(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (key_id, day)
+);
+
+-- Owners opt each public wishlist in to the partner API
+ALTER TABLE wishlists ADD COLUMN partner_shared BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_wishlists_partner_shared ON wishlists(public_slug) WHERE partner_shared = true AND is_public = true;
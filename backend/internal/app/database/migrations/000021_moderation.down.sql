@@ -0,0 +1,3 @@
+-- Revert content moderation
+DROP TABLE IF EXISTS wishlist_takedowns;
+DROP TABLE IF EXISTS wishlist_reports;
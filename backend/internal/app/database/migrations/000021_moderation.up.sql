@@ -0,0 +1,47 @@
+-- Reports of abusive public wishlists, reviewed by admins
+-- Flow: pending -> dismissed | actioned (the wishlist was taken down)
+CREATE TABLE wishlist_reports (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id      UUID NOT NULL,
+    reason           VARCHAR(30) NOT NULL,
+    details          TEXT,
+    reporter_user_id UUID,                    -- NULL for guest reports
+    status           VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by      UUID,
+    reviewed_at      TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_wishlist_reports_reason
+        CHECK (reason IN ('spam', 'offensive', 'inappropriate_image', 'scam', 'other')),
+
+    CONSTRAINT chk_wishlist_reports_status
+        CHECK (status IN ('pending', 'dismissed', 'actioned')),
+
+    CONSTRAINT fk_wishlist_reports_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_wishlist_reports_reporter
+        FOREIGN KEY (reporter_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_wishlist_reports_reviewed_by
+        FOREIGN KEY (reviewed_by)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_wishlist_reports_queue ON wishlist_reports(status, created_at);
+CREATE INDEX idx_wishlist_reports_wishlist ON wishlist_reports(wishlist_id) WHERE status = 'pending';
+
+-- Wishlists taken down by an admin. A taken down wishlist is private and its
+-- owner cannot make it public again until an admin restores it.
+CREATE TABLE wishlist_takedowns (
+    wishlist_id   UUID PRIMARY KEY REFERENCES wishlists(id) ON DELETE CASCADE,
+    reason        VARCHAR(30) NOT NULL,
+    note          TEXT,
+    taken_down_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+-- Revert slug history
+DROP TABLE IF EXISTS wishlist_slug_history;
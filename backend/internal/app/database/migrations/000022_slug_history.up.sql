@@ -0,0 +1,16 @@
+-- Public slugs a wishlist used before its owner changed them. Old shared links
+-- resolve to the wishlist's current slug, and a retired slug stays reserved
+-- for its owner for a while so nobody else can take over the link.
+CREATE TABLE wishlist_slug_history (
+    slug        TEXT PRIMARY KEY,
+    wishlist_id UUID NOT NULL,
+    owner_id    UUID NOT NULL,
+    retired_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_wishlist_slug_history_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_wishlist_slug_history_wishlist ON wishlist_slug_history(wishlist_id);
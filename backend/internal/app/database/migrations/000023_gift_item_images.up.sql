@@ -0,0 +1,25 @@
+-- Ordered photo gallery of a gift item. The first image is the item's primary
+-- image and is mirrored into gift_items.image_url so clients that only know
+-- the single image keep working.
+CREATE TABLE gift_item_images (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    gift_item_id UUID NOT NULL,
+    url          TEXT NOT NULL,
+    position     INTEGER NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_gift_item_images_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE,
+    -- Deferred so a reorder can shuffle positions within one transaction
+    CONSTRAINT uq_gift_item_images_position
+        UNIQUE (gift_item_id, position) DEFERRABLE INITIALLY DEFERRED,
+    CONSTRAINT chk_gift_item_images_position CHECK (position >= 0)
+);
+
+-- Existing single images become the first image of each gallery
+INSERT INTO gift_item_images (gift_item_id, url, position)
+SELECT id, image_url, 0
+FROM gift_items
+WHERE image_url IS NOT NULL AND image_url <> '';
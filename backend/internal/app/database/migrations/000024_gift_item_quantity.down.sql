@@ -0,0 +1,5 @@
+ALTER TABLE reservations DROP COLUMN IF EXISTS quantity;
+
+ALTER TABLE gift_items
+    DROP COLUMN IF EXISTS reserved_quantity,
+    DROP COLUMN IF EXISTS quantity;
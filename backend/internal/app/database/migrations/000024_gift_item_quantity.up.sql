@@ -0,0 +1,25 @@
+-- Items can ask for several units ("wine glasses x6") that different guests
+-- reserve part of. reserved_quantity is the sum of the quantities of the
+-- item's active reservations and is adjusted in the same transaction that
+-- creates or ends a reservation.
+ALTER TABLE gift_items
+    ADD COLUMN quantity          INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN reserved_quantity INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE reservations
+    ADD COLUMN quantity INTEGER NOT NULL DEFAULT 1;
+
+-- Every existing item wants a single unit, reserved once it has an active reservation
+UPDATE gift_items gi
+SET reserved_quantity = 1
+WHERE EXISTS (
+    SELECT 1 FROM reservations r
+    WHERE r.gift_item_id = gi.id AND r.status = 'active'
+);
+
+ALTER TABLE gift_items
+    ADD CONSTRAINT chk_gift_items_quantity CHECK (quantity >= 1),
+    ADD CONSTRAINT chk_gift_items_reserved_quantity CHECK (reserved_quantity BETWEEN 0 AND quantity);
+
+ALTER TABLE reservations
+    ADD CONSTRAINT chk_reservations_quantity CHECK (quantity >= 1);
@@ -0,0 +1,4 @@
+ALTER TABLE gift_items
+    DROP COLUMN IF EXISTS priority_weight,
+    DROP CONSTRAINT IF EXISTS chk_gift_items_priority_level,
+    DROP COLUMN IF EXISTS priority_level;
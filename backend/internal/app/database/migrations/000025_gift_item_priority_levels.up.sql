@@ -0,0 +1,25 @@
+-- Items are prioritised with named levels instead of the raw 0-10 integer.
+-- priority is kept for clients that still send and read numbers; the level is
+-- what the API validates and what sorting uses through priority_weight.
+ALTER TABLE gift_items
+    ADD COLUMN priority_level VARCHAR(20) NOT NULL DEFAULT 'nice_to_have';
+
+-- 7-10 must have, 4-6 nice to have, 1-3 dream; 0 (no priority) stays nice to have
+UPDATE gift_items
+SET priority_level = CASE
+    WHEN priority >= 7 THEN 'must_have'
+    WHEN priority >= 4 THEN 'nice_to_have'
+    WHEN priority >= 1 THEN 'dream'
+    ELSE 'nice_to_have'
+END;
+
+ALTER TABLE gift_items
+    ADD CONSTRAINT chk_gift_items_priority_level
+        CHECK (priority_level IN ('must_have', 'nice_to_have', 'dream')),
+    ADD COLUMN priority_weight SMALLINT GENERATED ALWAYS AS (
+        CASE priority_level
+            WHEN 'must_have' THEN 3
+            WHEN 'nice_to_have' THEN 2
+            ELSE 1
+        END
+    ) STORED;
@@ -0,0 +1,2 @@
+ALTER TABLE reservations DROP COLUMN IF EXISTS variant;
+ALTER TABLE gift_items DROP COLUMN IF EXISTS options;
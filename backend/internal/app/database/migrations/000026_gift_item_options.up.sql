@@ -0,0 +1,5 @@
+-- Structured options (size, color, model) guests must match when buying an item
+ALTER TABLE gift_items ADD COLUMN options JSONB;
+
+-- Variant chosen by the giver when reserving an item with options
+ALTER TABLE reservations ADD COLUMN variant JSONB;
@@ -0,0 +1,2 @@
+ALTER TABLE wishlists DROP COLUMN IF EXISTS rsvp_reminded_for;
+DROP TABLE IF EXISTS rsvps;
@@ -0,0 +1,23 @@
+-- Guest RSVPs for event occasions, submitted from the public wishlist page without an account.
+-- A guest who answers again with the same email updates their earlier answer.
+CREATE TABLE rsvps (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id UUID NOT NULL,
+    name        VARCHAR(100) NOT NULL,
+    email       VARCHAR(255),
+    attending   BOOLEAN NOT NULL,
+    note        VARCHAR(500),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_rsvps_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_rsvps_wishlist ON rsvps(wishlist_id, created_at);
+CREATE UNIQUE INDEX idx_rsvps_wishlist_email ON rsvps(wishlist_id, LOWER(email)) WHERE email IS NOT NULL;
+
+-- Occasion date the owner was last sent the RSVP reminder for, so each occasion is reminded once
+ALTER TABLE wishlists ADD COLUMN rsvp_reminded_for DATE;
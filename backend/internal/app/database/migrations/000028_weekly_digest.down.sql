@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS digest_sends;
+DROP TABLE IF EXISTS wishlist_daily_views;
+DROP TABLE IF EXISTS notification_preferences;
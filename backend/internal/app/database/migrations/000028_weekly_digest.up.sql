@@ -0,0 +1,43 @@
+-- Per-user notification preferences. A missing row means the defaults: every
+-- optional email is off until the user opts in.
+CREATE TABLE notification_preferences (
+    user_id       UUID PRIMARY KEY,
+    weekly_digest BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_notification_preferences_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notification_preferences_digest ON notification_preferences(user_id) WHERE weekly_digest;
+
+-- Public page views per wishlist per day, so digests can report views for a period
+-- rather than only the lifetime wishlists.view_count
+CREATE TABLE wishlist_daily_views (
+    wishlist_id UUID NOT NULL,
+    day         DATE NOT NULL,
+    views       INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (wishlist_id, day),
+
+    CONSTRAINT fk_wishlist_daily_views_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
+
+-- One row per digest sent; the primary key keeps each period to a single email per user
+CREATE TABLE digest_sends (
+    user_id      UUID NOT NULL,
+    period_start DATE NOT NULL,
+    sent_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, period_start),
+
+    CONSTRAINT fk_digest_sends_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
@@ -0,0 +1,28 @@
+-- In-app notifications, written alongside the emails for the same events so the
+-- frontend can show them from a single endpoint
+CREATE TABLE notifications (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID NOT NULL,
+    type        VARCHAR(30) NOT NULL,
+    title       VARCHAR(255) NOT NULL,
+    body        TEXT NOT NULL,
+    wishlist_id UUID,                    -- Wishlist the notification is about, if any
+    read_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_notifications_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_notifications_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT chk_notifications_type
+        CHECK (type IN ('reservation_removed', 'item_purchased', 'new_follower', 'new_comment'))
+);
+
+CREATE INDEX idx_notifications_user ON notifications(user_id, created_at DESC, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
@@ -0,0 +1,13 @@
+DELETE FROM notifications WHERE type = 'occasion_reminder';
+ALTER TABLE notifications DROP CONSTRAINT chk_notifications_type;
+ALTER TABLE notifications ADD CONSTRAINT chk_notifications_type
+    CHECK (type IN ('reservation_removed', 'item_purchased', 'new_follower', 'new_comment'));
+
+DROP INDEX IF EXISTS idx_notifications_push_pending;
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS push_attempts,
+    DROP COLUMN IF EXISTS pushed_at;
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS push_notifications;
+
+DROP TABLE IF EXISTS device_tokens;
@@ -0,0 +1,38 @@
+-- Mobile devices registered for push notifications. A token belongs to one device,
+-- so registering it again moves it to the signed-in user.
+CREATE TABLE device_tokens (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL,
+    platform     VARCHAR(10) NOT NULL,
+    token        TEXT NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_device_tokens_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_device_tokens_platform
+        CHECK (platform IN ('android', 'ios'))
+);
+
+CREATE INDEX idx_device_tokens_user ON device_tokens(user_id);
+
+-- Push is on by default: registering a device is the opt-in
+ALTER TABLE notification_preferences
+    ADD COLUMN push_notifications BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Notifications double as the push outbox. Existing notifications are treated as
+-- already pushed so enabling push does not replay history.
+ALTER TABLE notifications
+    ADD COLUMN pushed_at     TIMESTAMPTZ,
+    ADD COLUMN push_attempts INTEGER NOT NULL DEFAULT 0;
+
+UPDATE notifications SET pushed_at = created_at;
+
+CREATE INDEX idx_notifications_push_pending ON notifications(created_at) WHERE pushed_at IS NULL;
+
+ALTER TABLE notifications DROP CONSTRAINT chk_notifications_type;
+ALTER TABLE notifications ADD CONSTRAINT chk_notifications_type
+    CHECK (type IN ('reservation_removed', 'item_purchased', 'new_follower', 'new_comment', 'occasion_reminder'));
@@ -0,0 +1,32 @@
+-- Account data exports. A request is queued here and a background job bundles the
+-- user's data into a ZIP in S3, downloadable through a presigned URL until expires_at.
+-- Flow: pending -> processing -> completed -> expired | failed
+CREATE TABLE data_exports (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL,
+    status       VARCHAR(20) NOT NULL DEFAULT 'pending',
+    object_key   TEXT,                   -- S3 key of the archive while it is available
+    size_bytes   BIGINT,
+    error        TEXT,                   -- Why the export failed, shown to the user
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ,            -- When the archive is deleted
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_data_exports_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_data_exports_status
+        CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired'))
+);
+
+CREATE INDEX idx_data_exports_user ON data_exports(user_id, created_at DESC);
+CREATE INDEX idx_data_exports_queue ON data_exports(status, created_at)
+    WHERE status IN ('pending', 'processing');
+CREATE INDEX idx_data_exports_expiry ON data_exports(expires_at) WHERE status = 'completed';
+
+-- At most one export per user is queued or running at a time
+CREATE UNIQUE INDEX idx_data_exports_active_user ON data_exports(user_id)
+    WHERE status IN ('pending', 'processing');
@@ -0,0 +1,22 @@
+-- Guest reservations counted against the per-email and per-client-address caps.
+-- Subjects are stored as SHA-256 hashes so the table holds no plaintext emails or
+-- IP addresses; rows older than the longest window are purged as new ones are added.
+CREATE TABLE guest_reservation_events (
+    id             BIGSERIAL PRIMARY KEY,
+    subject_type   VARCHAR(10) NOT NULL,
+    subject_hash   CHAR(64) NOT NULL,
+    reservation_id UUID,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_guest_reservation_events_reservation
+        FOREIGN KEY (reservation_id)
+        REFERENCES reservations(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT chk_guest_reservation_events_subject_type
+        CHECK (subject_type IN ('email', 'ip'))
+);
+
+CREATE INDEX idx_guest_reservation_events_subject
+    ON guest_reservation_events(subject_type, subject_hash, created_at);
+CREATE INDEX idx_guest_reservation_events_created ON guest_reservation_events(created_at);
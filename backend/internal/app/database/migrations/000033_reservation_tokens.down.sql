@@ -0,0 +1 @@
+ALTER TABLE reservations ALTER COLUMN reservation_token DROP DEFAULT;
@@ -0,0 +1,5 @@
+-- Every reservation gets a management token. Guests use it to view, cancel and
+-- claim their reservations; it is rotated when a reservation changes hands.
+UPDATE reservations SET reservation_token = gen_random_uuid() WHERE reservation_token IS NULL;
+
+ALTER TABLE reservations ALTER COLUMN reservation_token SET DEFAULT gen_random_uuid();
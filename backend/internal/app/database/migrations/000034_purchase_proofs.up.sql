@@ -0,0 +1,34 @@
+-- Proof of purchase the purchaser attaches to a purchased gift item: an order
+-- confirmation screenshot uploaded through the image pipeline and/or a tracking
+-- number. Kept beside gift_items so item queries are unaffected.
+-- visibility decides when the item's owner may see it:
+--   private        only the purchaser
+--   after_occasion once the occasion date of every wishlist holding the item has passed
+--   owner          right away
+CREATE TABLE purchase_proofs (
+    gift_item_id      UUID PRIMARY KEY,
+    purchaser_user_id UUID NOT NULL,
+    image_url         TEXT,
+    tracking_number   VARCHAR(100),
+    visibility        VARCHAR(20) NOT NULL DEFAULT 'after_occasion',
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_purchase_proofs_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_purchase_proofs_purchaser
+        FOREIGN KEY (purchaser_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_purchase_proofs_visibility
+        CHECK (visibility IN ('private', 'after_occasion', 'owner')),
+
+    CONSTRAINT chk_purchase_proofs_content
+        CHECK (image_url IS NOT NULL OR tracking_number IS NOT NULL)
+);
+
+CREATE INDEX idx_purchase_proofs_purchaser ON purchase_proofs(purchaser_user_id);
@@ -0,0 +1,7 @@
+DELETE FROM notifications WHERE type = 'gift_delivered';
+
+ALTER TABLE notifications DROP CONSTRAINT chk_notifications_type;
+ALTER TABLE notifications ADD CONSTRAINT chk_notifications_type
+    CHECK (type IN ('reservation_removed', 'item_purchased', 'new_follower', 'new_comment', 'occasion_reminder'));
+
+DROP TABLE IF EXISTS shipments;
@@ -0,0 +1,40 @@
+-- Parcels the purchaser of a gift item is tracking. A background job polls the
+-- carrier until the parcel is delivered, then notifies the item's owner; while the
+-- gift is still a surprise the notice waits until after the occasion.
+CREATE TABLE shipments (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    gift_item_id      UUID NOT NULL,
+    purchaser_user_id UUID NOT NULL,
+    carrier           VARCHAR(50) NOT NULL,
+    tracking_number   VARCHAR(100) NOT NULL,
+    status            VARCHAR(30) NOT NULL DEFAULT 'pending',
+    status_detail     TEXT,
+    last_checked_at   TIMESTAMPTZ,
+    delivered_at      TIMESTAMPTZ,
+    owner_notified_at TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_shipments_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_shipments_purchaser
+        FOREIGN KEY (purchaser_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_shipments_status
+        CHECK (status IN ('pending', 'in_transit', 'out_for_delivery', 'delivered', 'exception')),
+
+    CONSTRAINT uq_shipments_tracking UNIQUE (gift_item_id, carrier, tracking_number)
+);
+
+CREATE INDEX idx_shipments_gift_item ON shipments(gift_item_id);
+CREATE INDEX idx_shipments_tracking_due ON shipments(last_checked_at NULLS FIRST) WHERE status <> 'delivered';
+CREATE INDEX idx_shipments_notice_pending ON shipments(delivered_at) WHERE status = 'delivered' AND owner_notified_at IS NULL;
+
+ALTER TABLE notifications DROP CONSTRAINT chk_notifications_type;
+ALTER TABLE notifications ADD CONSTRAINT chk_notifications_type
+    CHECK (type IN ('reservation_removed', 'item_purchased', 'new_follower', 'new_comment', 'occasion_reminder', 'gift_delivered'));
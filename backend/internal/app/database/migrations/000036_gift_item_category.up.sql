@@ -0,0 +1,2 @@
+-- Free-form category owners use to group items into sections on the share page
+ALTER TABLE gift_items ADD COLUMN category VARCHAR(50);
@@ -0,0 +1,5 @@
+ALTER TABLE gift_history DROP COLUMN IF EXISTS encrypted_giver_message;
+ALTER TABLE gift_history DROP COLUMN IF EXISTS giver_message;
+
+ALTER TABLE reservations DROP COLUMN IF EXISTS encrypted_message;
+ALTER TABLE reservations DROP COLUMN IF EXISTS message;
@@ -0,0 +1,8 @@
+-- Optional message a giver attaches to a reservation ("from Aunt Maria, will bring it Saturday").
+-- Stored like the other guest PII: plaintext without encryption, otherwise only the encrypted column.
+ALTER TABLE reservations ADD COLUMN message TEXT;
+ALTER TABLE reservations ADD COLUMN encrypted_message TEXT; -- PII encrypted
+
+-- Snapshotted gifts keep the message for the recipient's gift history
+ALTER TABLE gift_history ADD COLUMN giver_message TEXT;
+ALTER TABLE gift_history ADD COLUMN encrypted_giver_message TEXT; -- PII encrypted
@@ -0,0 +1,4 @@
+-- Revert wishlist merging
+ALTER TABLE wishlists
+    DROP CONSTRAINT IF EXISTS fk_wishlists_merged_into,
+    DROP COLUMN IF EXISTS merged_into_id;
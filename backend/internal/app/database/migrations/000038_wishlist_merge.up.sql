@@ -0,0 +1,8 @@
+-- Merging wishlists: the items of one list are moved or copied into another of the same
+-- owner; an archived source keeps a link to the list it was merged into
+ALTER TABLE wishlists
+    ADD COLUMN merged_into_id UUID,                -- The list this one was merged into
+    ADD CONSTRAINT fk_wishlists_merged_into
+        FOREIGN KEY (merged_into_id)
+        REFERENCES wishlists(id)
+        ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+-- Revert partner webhooks
+DROP TABLE IF EXISTS partner_webhook_deliveries;
+DROP TABLE IF EXISTS partner_webhooks;
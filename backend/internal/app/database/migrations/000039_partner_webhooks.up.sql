@@ -0,0 +1,44 @@
+-- Callbacks partner shops register for their own domain. When an item linking to a
+-- product on that domain is reserved, released or purchased on a public wishlist, a
+-- delivery is queued and a background job POSTs it, signed with the webhook's secret.
+CREATE TABLE partner_webhooks (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    key_id     UUID NOT NULL,
+    domain     VARCHAR(255) NOT NULL,          -- Lowercase host without "www.", also matches subdomains
+    url        TEXT NOT NULL,                  -- HTTPS endpoint the events are posted to
+    secret     TEXT NOT NULL,                  -- HMAC signing secret, shown to the partner once
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_partner_webhooks_key
+        FOREIGN KEY (key_id)
+        REFERENCES partner_api_keys(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT uq_partner_webhooks_domain UNIQUE (key_id, domain)
+);
+
+CREATE INDEX idx_partner_webhooks_domain ON partner_webhooks(domain);
+
+-- Outbox of webhook deliveries. The payload is a snapshot taken when the event happened.
+CREATE TABLE partner_webhook_deliveries (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id      UUID NOT NULL,
+    event           VARCHAR(50) NOT NULL,
+    payload         JSONB NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Pushed back after each failure
+    delivered_at    TIMESTAMPTZ,
+    failed_at       TIMESTAMPTZ,                        -- Set once the retries are used up
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_partner_webhook_deliveries_webhook
+        FOREIGN KEY (webhook_id)
+        REFERENCES partner_webhooks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_partner_webhook_deliveries_event
+        CHECK (event IN ('item.reserved', 'item.released', 'item.purchased'))
+);
+
+CREATE INDEX idx_partner_webhook_deliveries_pending ON partner_webhook_deliveries(next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
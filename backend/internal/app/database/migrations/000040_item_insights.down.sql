@@ -0,0 +1,2 @@
+-- Revert item insights
+DROP TABLE IF EXISTS gift_item_daily_stats;
@@ -0,0 +1,16 @@
+-- Public activity per gift item per day, so owners can see which items draw interest.
+-- Only counters are kept: nothing identifies the visitor, so the figures can be shown
+-- even while reservations stay a surprise.
+CREATE TABLE gift_item_daily_stats (
+    gift_item_id         UUID NOT NULL,
+    day                  DATE NOT NULL,
+    views                INTEGER NOT NULL DEFAULT 0, -- Times the item was listed on its public wishlist
+    reservation_attempts INTEGER NOT NULL DEFAULT 0, -- Reservation requests, including ones that failed
+
+    PRIMARY KEY (gift_item_id, day),
+
+    CONSTRAINT fk_gift_item_daily_stats_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Revert wishlist discovery
+DROP INDEX IF EXISTS idx_reservations_reserved_at;
+DROP INDEX IF EXISTS idx_wishlists_discovery_search;
+ALTER TABLE wishlists DROP COLUMN IF EXISTS discoverable;
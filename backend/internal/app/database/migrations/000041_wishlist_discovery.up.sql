@@ -0,0 +1,12 @@
+-- Public wishlists stay reachable by link only unless the owner opts in to
+-- discovery: public search and the trending list
+ALTER TABLE wishlists ADD COLUMN discoverable BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Search matches words of the title, occasion and description. The expression must
+-- match the one in the discovery repository for the index to be used.
+CREATE INDEX idx_wishlists_discovery_search ON wishlists
+    USING GIN (to_tsvector('simple', title || ' ' || COALESCE(occasion, '') || ' ' || COALESCE(description, '')))
+    WHERE discoverable AND is_public;
+
+-- Trending counts the reservations made over the last days
+CREATE INDEX idx_reservations_reserved_at ON reservations(reserved_at);
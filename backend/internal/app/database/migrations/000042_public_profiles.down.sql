@@ -0,0 +1,7 @@
+-- Revert public profiles
+DROP INDEX IF EXISTS idx_users_username;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS show_avatar,
+    DROP COLUMN IF EXISTS bio,
+    DROP COLUMN IF EXISTS display_name,
+    DROP COLUMN IF EXISTS username;
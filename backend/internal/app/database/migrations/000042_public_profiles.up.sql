@@ -0,0 +1,10 @@
+-- Public profiles: a unique username addresses the profile, which shows the display
+-- name, bio and, when the user allows it, the avatar. First and last names stay private.
+ALTER TABLE users
+    ADD COLUMN username VARCHAR(30),
+    ADD COLUMN display_name VARCHAR(50),
+    ADD COLUMN bio VARCHAR(500),
+    ADD COLUMN show_avatar BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Usernames are stored lowercase
+CREATE UNIQUE INDEX idx_users_username ON users(username) WHERE username IS NOT NULL;
@@ -0,0 +1,4 @@
+-- Revert vanity slugs
+DROP TABLE IF EXISTS username_history;
+DROP INDEX IF EXISTS idx_wishlists_owner_vanity_slug;
+ALTER TABLE wishlists DROP COLUMN IF EXISTS vanity_slug;
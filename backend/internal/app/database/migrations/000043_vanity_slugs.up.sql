@@ -0,0 +1,20 @@
+-- Vanity links /u/<username>/<slug>: premium owners keep the slug they chose without
+-- a random suffix. It only has to be unique among the owner's own wishlists.
+ALTER TABLE wishlists ADD COLUMN vanity_slug TEXT;
+
+CREATE UNIQUE INDEX idx_wishlists_owner_vanity_slug ON wishlists(owner_id, vanity_slug) WHERE vanity_slug IS NOT NULL;
+
+-- Usernames a user gave up. Vanity links under them keep resolving, and they stay
+-- reserved for the user for a while so nobody else can take over the links.
+CREATE TABLE username_history (
+    username   VARCHAR(30) PRIMARY KEY,
+    user_id    UUID NOT NULL,
+    retired_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_username_history_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_username_history_user ON username_history(user_id);
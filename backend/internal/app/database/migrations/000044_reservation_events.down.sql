@@ -0,0 +1,3 @@
+-- Revert reservation events
+DROP TABLE IF EXISTS reservation_events;
+DROP FUNCTION IF EXISTS reject_reservation_event_change();
@@ -0,0 +1,35 @@
+-- Append-only log of reservation state changes. Events keep ids and statuses
+-- only, so they outlive the reservation and never hold the giver's details.
+CREATE TABLE reservation_events (
+    id             BIGSERIAL PRIMARY KEY,
+    reservation_id UUID NOT NULL,
+    wishlist_id    UUID NOT NULL,
+    gift_item_id   UUID NOT NULL,
+    event_type     VARCHAR(20) NOT NULL
+        CHECK (event_type IN ('created', 'canceled', 'expired', 'fulfilled', 'claimed', 'transferred')),
+    status         VARCHAR(20) NOT NULL, -- Status of the reservation after the event
+    occurred_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reservation_events_reservation ON reservation_events(reservation_id, id);
+CREATE INDEX idx_reservation_events_occurred_at ON reservation_events(occurred_at);
+
+CREATE FUNCTION reject_reservation_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'reservation_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_reservation_events_append_only
+    BEFORE UPDATE OR DELETE ON reservation_events
+    FOR EACH ROW EXECUTE FUNCTION reject_reservation_event_change();
+
+-- Existing reservations start their history with what is known of them today
+INSERT INTO reservation_events (reservation_id, wishlist_id, gift_item_id, event_type, status, occurred_at)
+SELECT id, wishlist_id, gift_item_id, 'created', 'active', reserved_at
+FROM reservations;
+
+INSERT INTO reservation_events (reservation_id, wishlist_id, gift_item_id, event_type, status, occurred_at)
+SELECT id, wishlist_id, gift_item_id, status, status, COALESCE(canceled_at, updated_at)
+FROM reservations
+WHERE status IN ('canceled', 'expired', 'fulfilled');
@@ -0,0 +1,2 @@
+-- Revert guest reservation links
+DROP TABLE IF EXISTS guest_reservation_links;
@@ -0,0 +1,27 @@
+-- Queue linking a new account's guest reservations by its email. A background job
+-- works through it and retries with backoff; jobs out of retries are listed for
+-- admins. The email is read from the user at run time and never copied here.
+CREATE TABLE guest_reservation_links (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id         UUID NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Pushed back after each failure
+    last_error      TEXT,
+    linked_count    INTEGER,                            -- Reservations linked once done
+    completed_at    TIMESTAMPTZ,
+    failed_at       TIMESTAMPTZ,                        -- Set once the retries are used up
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_guest_reservation_links_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+-- One open job per user; queueing again while one waits is a no-op
+CREATE UNIQUE INDEX idx_guest_reservation_links_open ON guest_reservation_links(user_id)
+    WHERE completed_at IS NULL AND failed_at IS NULL;
+CREATE INDEX idx_guest_reservation_links_pending ON guest_reservation_links(next_attempt_at)
+    WHERE completed_at IS NULL AND failed_at IS NULL;
+CREATE INDEX idx_guest_reservation_links_failed ON guest_reservation_links(failed_at)
+    WHERE failed_at IS NOT NULL AND completed_at IS NULL;
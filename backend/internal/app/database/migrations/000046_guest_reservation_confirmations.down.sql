@@ -0,0 +1,2 @@
+-- Revert guest reservation confirmations
+DROP TABLE IF EXISTS guest_reservation_confirmations;
@@ -0,0 +1,25 @@
+-- Guest reservations that scored as likely abuse are held here until the guest
+-- confirms them with the code emailed to them. The guest's name, email, note and
+-- choices are kept as JSON in details, or encrypted_details when PII encryption
+-- is enabled. Confirming deletes the row; unconfirmed rows are purged on expiry.
+CREATE TABLE guest_reservation_confirmations (
+    confirmation_token UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id        UUID NOT NULL,
+    gift_item_id       UUID NOT NULL,
+    details            TEXT,
+    encrypted_details  TEXT,
+    score              INTEGER NOT NULL, -- Abuse score that required the confirmation
+    expires_at         TIMESTAMPTZ NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_guest_reservation_confirmations_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_guest_reservation_confirmations_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_guest_reservation_confirmations_expires_at ON guest_reservation_confirmations(expires_at);
@@ -0,0 +1 @@
+ALTER TABLE gift_items DROP COLUMN IF EXISTS notes_visibility;
@@ -0,0 +1,5 @@
+-- Whether guests of a public wishlist see an item's notes. Owners wrote the
+-- existing notes for themselves, so they stay private.
+ALTER TABLE gift_items
+    ADD COLUMN notes_visibility VARCHAR(10) NOT NULL DEFAULT 'private'
+        CONSTRAINT chk_gift_items_notes_visibility CHECK (notes_visibility IN ('private', 'public'));
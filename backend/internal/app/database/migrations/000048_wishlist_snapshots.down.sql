@@ -0,0 +1,2 @@
+-- Revert wishlist snapshots
+DROP TABLE IF EXISTS wishlist_snapshots;
@@ -0,0 +1,19 @@
+-- Frozen copies of a wishlist, taken on its occasion date or on the owner's
+-- request, so that later edits to the items do not rewrite what the list looked
+-- like then. items holds the items as JSON with their reservation and purchase
+-- state at the time. The first snapshot of a day stands.
+CREATE TABLE wishlist_snapshots (
+    wishlist_id    UUID NOT NULL,
+    snapshot_date  DATE NOT NULL,
+    title          VARCHAR(255) NOT NULL,
+    occasion       TEXT,
+    occasion_date  DATE,
+    items          JSONB NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (wishlist_id, snapshot_date),
+    CONSTRAINT fk_wishlist_snapshots_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE
+);
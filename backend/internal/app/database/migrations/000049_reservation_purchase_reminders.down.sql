@@ -0,0 +1,3 @@
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS purchase_reminded_for,
+    DROP COLUMN IF EXISTS purchased_at;
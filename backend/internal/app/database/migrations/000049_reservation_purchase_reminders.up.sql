@@ -0,0 +1,6 @@
+-- Givers say they bought a reserved gift from the link in their reminder email.
+-- purchase_reminded_for is the occasion date they were last reminded for, so a
+-- moved occasion sends a fresh reminder.
+ALTER TABLE reservations
+    ADD COLUMN purchased_at TIMESTAMPTZ,
+    ADD COLUMN purchase_reminded_for DATE;
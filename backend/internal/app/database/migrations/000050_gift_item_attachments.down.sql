@@ -0,0 +1,2 @@
+-- Revert gift item attachments
+DROP TABLE IF EXISTS gift_item_attachments;
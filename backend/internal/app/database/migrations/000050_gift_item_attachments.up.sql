@@ -0,0 +1,21 @@
+-- Files owners attach to a gift item for givers, e.g. a size chart or a
+-- specification PDF. The files live in S3 under object_key and are only
+-- handed out through short-lived presigned URLs.
+CREATE TABLE gift_item_attachments (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    gift_item_id UUID NOT NULL,
+    file_name    TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes   BIGINT NOT NULL,
+    object_key   TEXT NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_gift_item_attachments_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_gift_item_attachments_object_key UNIQUE (object_key),
+    CONSTRAINT chk_gift_item_attachments_size CHECK (size_bytes >= 0)
+);
+
+CREATE INDEX idx_gift_item_attachments_gift_item_id ON gift_item_attachments (gift_item_id, created_at);
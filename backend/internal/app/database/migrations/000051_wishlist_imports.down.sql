@@ -0,0 +1,2 @@
+-- Revert wishlist imports
+DROP TABLE IF EXISTS wishlist_imports;
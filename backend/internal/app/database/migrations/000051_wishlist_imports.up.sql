@@ -0,0 +1,34 @@
+-- Wishlists copied from someone else's public wishlist ("copy this list to my
+-- account"). The source is kept for attribution and cleared when it is deleted;
+-- its title is copied so the attribution survives. Rows outlive deleted copies so
+-- they keep counting towards the importer's daily limit.
+CREATE TABLE wishlist_imports (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id        UUID,
+    imported_by        UUID NOT NULL,
+    source_wishlist_id UUID,
+    source_owner_id    UUID,
+    source_title       TEXT NOT NULL,
+    items_imported     INTEGER NOT NULL DEFAULT 0,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_wishlist_imports_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE SET NULL,
+    CONSTRAINT fk_wishlist_imports_imported_by
+        FOREIGN KEY (imported_by)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_wishlist_imports_source_wishlist
+        FOREIGN KEY (source_wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE SET NULL,
+    CONSTRAINT fk_wishlist_imports_source_owner
+        FOREIGN KEY (source_owner_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL,
+    CONSTRAINT uq_wishlist_imports_wishlist UNIQUE (wishlist_id)
+);
+
+CREATE INDEX idx_wishlist_imports_imported_by ON wishlist_imports (imported_by, created_at);
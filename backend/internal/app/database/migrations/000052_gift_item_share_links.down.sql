@@ -0,0 +1,2 @@
+-- Revert gift item share links
+DROP TABLE IF EXISTS gift_item_share_links;
@@ -0,0 +1,15 @@
+-- Links that share a single gift item, whatever the visibility of the wishlists
+-- it is on. The public token is the link ID signed with the server secret, so
+-- deleting the row revokes the link.
+CREATE TABLE gift_item_share_links (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    gift_item_id UUID NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_gift_item_share_links_gift_item
+        FOREIGN KEY (gift_item_id)
+        REFERENCES gift_items(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_gift_item_share_links_gift_item_id ON gift_item_share_links (gift_item_id, created_at);
@@ -0,0 +1,2 @@
+-- Revert wishlist invites
+DROP TABLE IF EXISTS wishlist_invites;
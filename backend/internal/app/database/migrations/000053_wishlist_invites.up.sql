@@ -0,0 +1,25 @@
+-- Guests the owner invited to a wishlist by email. The emailed link is the share
+-- page with the invite token, so the owner can see which invitees visited.
+-- Inviting the same address again reuses its row.
+CREATE TABLE wishlist_invites (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id UUID NOT NULL,
+    email       TEXT NOT NULL, -- Lowercased
+    token       UUID NOT NULL DEFAULT gen_random_uuid(),
+    status      TEXT NOT NULL DEFAULT 'pending',
+    sent_at     TIMESTAMPTZ,
+    visited_at  TIMESTAMPTZ, -- First visit through the invite link
+    visit_count INTEGER NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_wishlist_invites_wishlist
+        FOREIGN KEY (wishlist_id)
+        REFERENCES wishlists(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_wishlist_invites_email UNIQUE (wishlist_id, email),
+    CONSTRAINT uq_wishlist_invites_token UNIQUE (token),
+    CONSTRAINT chk_wishlist_invites_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+CREATE INDEX idx_wishlist_invites_sent_at ON wishlist_invites (sent_at);
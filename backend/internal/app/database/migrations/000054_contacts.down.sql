@@ -0,0 +1,2 @@
+-- Revert contacts
+DROP TABLE IF EXISTS contacts;
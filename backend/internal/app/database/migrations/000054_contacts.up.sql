@@ -0,0 +1,19 @@
+-- Address book of people a user invites often. The relationship doubles as the
+-- group name, so invites can go to everyone saved as "family" at once.
+CREATE TABLE contacts (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id     UUID NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    email        VARCHAR(255) NOT NULL, -- Lowercased
+    relationship VARCHAR(50), -- Lowercased
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_contacts_owner
+        FOREIGN KEY (owner_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_contacts_email UNIQUE (owner_id, email)
+);
+
+CREATE INDEX idx_contacts_relationship ON contacts (owner_id, relationship) WHERE relationship IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Revert reservation cancel reasons
+ALTER TABLE reservation_events DROP COLUMN IF EXISTS reason;
@@ -0,0 +1,7 @@
+-- Why a giver canceled, picked from a fixed list so the event log still holds
+-- no free text from the giver. Only cancellations carry one, and it is optional.
+ALTER TABLE reservation_events
+    ADD COLUMN reason VARCHAR(20)
+        CHECK (reason IN ('changed_mind', 'bought_elsewhere', 'too_expensive', 'other')),
+    ADD CONSTRAINT reservation_events_reason_on_cancel
+        CHECK (reason IS NULL OR event_type = 'canceled');
@@ -0,0 +1,5 @@
+-- Revert reservation item received. Events are append-only, so this fails once
+-- a reservation has been released that way.
+ALTER TABLE reservation_events DROP CONSTRAINT reservation_events_reason_check;
+ALTER TABLE reservation_events ADD CONSTRAINT reservation_events_reason_check
+    CHECK (reason IN ('changed_mind', 'bought_elsewhere', 'too_expensive', 'other'));
@@ -0,0 +1,5 @@
+-- Reservations released because the owner received the item elsewhere are
+-- canceled with their own reason, kept apart from the reasons givers pick
+ALTER TABLE reservation_events DROP CONSTRAINT reservation_events_reason_check;
+ALTER TABLE reservation_events ADD CONSTRAINT reservation_events_reason_check
+    CHECK (reason IN ('changed_mind', 'bought_elsewhere', 'too_expensive', 'other', 'item_received'));
@@ -0,0 +1,2 @@
+-- Revert closed public slugs
+ALTER TABLE wishlists DROP COLUMN IF EXISTS closed_at;
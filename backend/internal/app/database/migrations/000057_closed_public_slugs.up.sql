@@ -0,0 +1,4 @@
+-- Public links of wishlists whose occasion is long past are closed: the list is
+-- unpublished and its slug moves to the slug history, where the old link answers
+-- that the list is closed. Publishing the list again clears closed_at.
+ALTER TABLE wishlists ADD COLUMN closed_at TIMESTAMPTZ;
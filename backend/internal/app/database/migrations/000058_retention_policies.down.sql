@@ -0,0 +1,12 @@
+-- Revert retention policies
+DROP INDEX IF EXISTS idx_gift_item_daily_stats_day;
+DROP INDEX IF EXISTS idx_wishlist_daily_views_day;
+DROP INDEX IF EXISTS idx_item_link_clicks_clicked_at;
+
+CREATE OR REPLACE FUNCTION reject_reservation_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'reservation_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS retention_policies;
@@ -0,0 +1,30 @@
+-- Retention periods admins set per data class, overriding the defaults from the
+-- RETENTION_*_MONTHS settings. A period of 0 keeps the class's data.
+CREATE TABLE retention_policies (
+    data_class         VARCHAR(20) PRIMARY KEY
+        CHECK (data_class IN ('guest_pii', 'audit_log', 'analytics')),
+    months             INTEGER NOT NULL CHECK (months >= 0),
+    updated_by_user_id UUID,
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_retention_policies_updated_by
+        FOREIGN KEY (updated_by_user_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+-- Reservation events stay append-only, except that the retention job may delete
+-- events past the audit log period. It says so for its own transaction only.
+CREATE OR REPLACE FUNCTION reject_reservation_event_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND current_setting('app.retention_purge', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'reservation_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+-- The retention job finds expired analytics by date
+CREATE INDEX idx_item_link_clicks_clicked_at ON item_link_clicks(clicked_at);
+CREATE INDEX idx_wishlist_daily_views_day ON wishlist_daily_views(day);
+CREATE INDEX idx_gift_item_daily_stats_day ON gift_item_daily_stats(day);
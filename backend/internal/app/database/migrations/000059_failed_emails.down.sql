@@ -0,0 +1,2 @@
+-- Revert failed email outbox
+DROP TABLE IF EXISTS failed_emails;
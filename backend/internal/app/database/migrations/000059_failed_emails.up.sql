@@ -0,0 +1,16 @@
+-- Emails the providers did not accept, kept so an operator can resend them with
+-- "admin resend-failed-emails". The rendered message holds the recipient address
+-- and personal details, so with encryption enabled it is stored only encrypted.
+CREATE TABLE failed_emails (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template          VARCHAR(50) NOT NULL,
+    message           TEXT,        -- JSON-encoded message
+    encrypted_message TEXT,        -- PII encrypted copy
+    attempts          INTEGER NOT NULL DEFAULT 1,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_failed_emails_message CHECK (message IS NOT NULL OR encrypted_message IS NOT NULL)
+);
+
+CREATE INDEX idx_failed_emails_created_at ON failed_emails (created_at);
@@ -0,0 +1,4 @@
+-- Revert guest address on gift history
+ALTER TABLE gift_history
+    DROP COLUMN IF EXISTS encrypted_giver_email,
+    DROP COLUMN IF EXISTS giver_email;
@@ -0,0 +1,7 @@
+-- The guest's address is kept with a snapshotted gift so a privacy erasure can
+-- still find the guest's name and message after the wishlist, and with it the
+-- reservation, was deleted. Like the name, it is stored only encrypted when
+-- encryption is enabled.
+ALTER TABLE gift_history
+    ADD COLUMN giver_email           TEXT,
+    ADD COLUMN encrypted_giver_email TEXT; -- PII encrypted
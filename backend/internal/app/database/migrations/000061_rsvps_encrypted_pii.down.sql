@@ -0,0 +1,11 @@
+-- Revert encrypted RSVP details
+-- Note: encrypted values are dropped; rows without a plaintext name block restoring NOT NULL
+DROP INDEX IF EXISTS idx_rsvps_wishlist_email_hash;
+
+ALTER TABLE rsvps
+    DROP CONSTRAINT IF EXISTS chk_rsvps_name,
+    DROP COLUMN IF EXISTS email_hash,
+    DROP COLUMN IF EXISTS encrypted_note,
+    DROP COLUMN IF EXISTS encrypted_email,
+    DROP COLUMN IF EXISTS encrypted_name,
+    ALTER COLUMN name SET NOT NULL;
@@ -0,0 +1,14 @@
+-- Encrypted copies of the guest's RSVP details (PII)
+-- When encryption is enabled, name, email and note stay NULL and the values are
+-- stored only in the encrypted columns. The encrypted email cannot be compared in
+-- SQL, so email_hash (encryption.Service.HashEmail) keeps one answer per guest.
+-- Existing plaintext rows are migrated with: go run ./cmd/admin rotate-encryption-key -encrypt-plaintext
+ALTER TABLE rsvps
+    ALTER COLUMN name DROP NOT NULL,
+    ADD COLUMN encrypted_name  TEXT, -- PII encrypted
+    ADD COLUMN encrypted_email TEXT, -- PII encrypted
+    ADD COLUMN encrypted_note  TEXT, -- PII encrypted
+    ADD COLUMN email_hash      VARCHAR(64),
+    ADD CONSTRAINT chk_rsvps_name CHECK (name IS NOT NULL OR encrypted_name IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_rsvps_wishlist_email_hash ON rsvps(wishlist_id, email_hash) WHERE email_hash IS NOT NULL;
@@ -0,0 +1,17 @@
+-- Revert encrypted invite and contact emails
+-- Note: encrypted values are dropped; rows without a plaintext email block restoring NOT NULL
+DROP INDEX IF EXISTS idx_contacts_email_hash;
+
+ALTER TABLE contacts
+    DROP CONSTRAINT IF EXISTS chk_contacts_email,
+    DROP COLUMN IF EXISTS email_hash,
+    DROP COLUMN IF EXISTS encrypted_email,
+    ALTER COLUMN email SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_wishlist_invites_email_hash;
+
+ALTER TABLE wishlist_invites
+    DROP CONSTRAINT IF EXISTS chk_wishlist_invites_email,
+    DROP COLUMN IF EXISTS email_hash,
+    DROP COLUMN IF EXISTS encrypted_email,
+    ALTER COLUMN email SET NOT NULL;
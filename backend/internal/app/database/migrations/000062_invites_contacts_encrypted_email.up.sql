@@ -0,0 +1,20 @@
+-- Encrypted copies of the email addresses of invited guests and saved contacts (PII)
+-- When encryption is enabled, email stays NULL and the address is stored only in
+-- encrypted_email. email_hash (encryption.Service.HashEmail) keeps the addresses
+-- unique per wishlist and per owner, as the encrypted value cannot be compared in SQL.
+-- Existing plaintext rows are migrated with: go run ./cmd/admin rotate-encryption-key -encrypt-plaintext
+ALTER TABLE wishlist_invites
+    ALTER COLUMN email DROP NOT NULL,
+    ADD COLUMN encrypted_email TEXT, -- PII encrypted
+    ADD COLUMN email_hash      VARCHAR(64),
+    ADD CONSTRAINT chk_wishlist_invites_email CHECK (email IS NOT NULL OR encrypted_email IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_wishlist_invites_email_hash ON wishlist_invites(wishlist_id, email_hash) WHERE email_hash IS NOT NULL;
+
+ALTER TABLE contacts
+    ALTER COLUMN email DROP NOT NULL,
+    ADD COLUMN encrypted_email TEXT, -- PII encrypted
+    ADD COLUMN email_hash      VARCHAR(64),
+    ADD CONSTRAINT chk_contacts_email CHECK (email IS NOT NULL OR encrypted_email IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_contacts_email_hash ON contacts(owner_id, email_hash) WHERE email_hash IS NOT NULL;
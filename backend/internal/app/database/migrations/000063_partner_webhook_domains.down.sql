@@ -0,0 +1,2 @@
+-- Revert partner webhook domain allowlist
+ALTER TABLE partner_api_keys DROP COLUMN IF EXISTS webhook_domains;
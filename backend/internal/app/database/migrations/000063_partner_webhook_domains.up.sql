@@ -0,0 +1,8 @@
+-- Domains an admin verified a partner owns. A key can only register webhooks for
+-- these domains and their subdomains, so it cannot watch another shop's items.
+ALTER TABLE partner_api_keys
+    ADD COLUMN webhook_domains TEXT NOT NULL DEFAULT ''; -- Space-separated, lowercase without "www."
+
+-- Webhooks registered so far were never checked against an allowlist. Partners
+-- register them again once an admin has allowed their domains.
+DELETE FROM partner_webhooks;
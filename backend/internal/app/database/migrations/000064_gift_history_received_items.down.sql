@@ -0,0 +1,2 @@
+-- Revert dropping received items from gift history
+-- Note: the deleted rows are not restored
@@ -0,0 +1,4 @@
+-- Items an owner marked as received elsewhere were snapshotted as purchased by the
+-- owner themselves, at list price. Nobody on the wishlist gave them, so drop them.
+DELETE FROM gift_history
+WHERE kind = 'purchased' AND giver_user_id = recipient_user_id;
@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// PoolConfig holds connection pool settings. Zero durations disable the limit.
+type PoolConfig struct {
+	MaxOpenConns       int
+	MaxIdleConns       int
+	ConnMaxLifetime    time.Duration
+	ConnMaxIdleTime    time.Duration
+	SlowQueryThreshold time.Duration // Queries taking at least this long are logged and counted
+}
+
+// DefaultPoolConfig returns the pool settings used by short-lived tools
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxOpenConns:    25,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 5 * time.Minute,
+	}
+}
+
+func (c PoolConfig) apply(db *sql.DB) {
+	db.SetMaxOpenConns(c.MaxOpenConns)
+	db.SetMaxIdleConns(c.MaxIdleConns)
+	db.SetConnMaxLifetime(c.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+}
+
+// PoolStatsHook receives pool statistics on every monitor tick, e.g. to export metrics
+type PoolStatsHook func(stats sql.DBStats)
+
+// PoolStats returns the current connection pool statistics
+func (db *DB) PoolStats() sql.DBStats {
+	return db.Stats()
+}
+
+// StartPoolMonitor pings the database and reports pool statistics to the hooks every
+// interval until ctx is canceled. Failed pings are logged; the pool replaces broken
+// connections on its own.
+func (db *DB) StartPoolMonitor(ctx context.Context, interval time.Duration, hooks ...PoolStatsHook) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pingCtx, cancel := context.WithTimeout(ctx, interval)
+				if err := db.PingContext(pingCtx); err != nil && ctx.Err() == nil {
+					logger.Warn("database health check failed", "error", err)
+				}
+				cancel()
+
+				stats := db.PoolStats()
+				for _, hook := range hooks {
+					hook(stats)
+				}
+			}
+		}
+	}()
+}
+
+// LogPoolStats is a PoolStatsHook that logs pool statistics at debug level
+func LogPoolStats(stats sql.DBStats) {
+	logger.Debug("database pool stats",
+		"open", stats.OpenConnections,
+		"in_use", stats.InUse,
+		"idle", stats.Idle,
+		"wait_count", stats.WaitCount,
+		"wait_duration", stats.WaitDuration,
+		"max_idle_closed", stats.MaxIdleClosed,
+		"max_idle_time_closed", stats.MaxIdleTimeClosed,
+		"max_lifetime_closed", stats.MaxLifetimeClosed,
+	)
+}
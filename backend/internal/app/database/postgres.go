@@ -6,32 +6,101 @@ import (
 	"fmt"
 	"time"
 
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	_ "github.com/jackc/pgx/v5/stdlib" // PostgreSQL driver
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 )
 
 type DB struct {
 	*sqlx.DB
+	replica *replica // Optional read replica, see AttachReplica
 }
 
+// New connects to the database with DefaultPoolConfig
 func New(ctx context.Context, connUrl string) (*DB, error) {
-	db, err := sqlx.ConnectContext(ctx, "pgx", connUrl)
+	return NewWithPoolConfig(ctx, connUrl, DefaultPoolConfig())
+}
+
+// NewWithPoolConfig connects to the database and applies the given pool settings
+func NewWithPoolConfig(ctx context.Context, connUrl string, pool PoolConfig) (*DB, error) {
+	db, err := open(ctx, connUrl, pool)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// set reasonable connection pool defaults
-	db.SetMaxOpenConns(25)
-	db.SetMaxIdleConns(5)
-	db.SetConnMaxLifetime(5 * time.Minute)
+	return &DB{DB: db}, nil
+}
+
+// RetryConfig bounds how long NewWithRetry keeps trying to reach the database
+type RetryConfig struct {
+	Attempts       int           // Connection attempts before giving up; values below 1 mean one
+	AttemptTimeout time.Duration // Limit for a single attempt; 0 = only the caller's context
+	InitialBackoff time.Duration // Wait after the first failure, doubled after each further one
+	MaxBackoff     time.Duration // Cap for the doubled wait
+}
+
+// NewWithRetry connects like NewWithPoolConfig, retrying with exponential backoff
+// while the database is unreachable, e.g. while it is still starting next to the
+// server. A malformed connection URL fails at once.
+func NewWithRetry(ctx context.Context, connUrl string, pool PoolConfig, retry RetryConfig) (*DB, error) {
+	if _, err := pgx.ParseConfig(connUrl); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	backoff := retry.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		db, err := connectAttempt(ctx, connUrl, pool, retry.AttemptTimeout)
+		if err == nil {
+			return db, nil
+		}
+		if attempt >= retry.Attempts {
+			return nil, fmt.Errorf("after %d attempts: %w", attempt, err)
+		}
+
+		logger.Warn("database unavailable, retrying", "attempt", attempt, "retry_in", backoff.String(), "error", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		}
+		backoff *= 2
+		if retry.MaxBackoff > 0 {
+			backoff = min(backoff, retry.MaxBackoff)
+		}
+	}
+}
+
+func connectAttempt(ctx context.Context, connUrl string, pool PoolConfig, timeout time.Duration) (*DB, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return NewWithPoolConfig(ctx, connUrl, pool)
+}
+
+// open connects with the pgx driver, tracing slow queries when the pool config asks for it
+func open(ctx context.Context, connUrl string, pool PoolConfig) (*sqlx.DB, error) {
+	connConfig, err := pgx.ParseConfig(connUrl)
+	if err != nil {
+		return nil, err
+	}
+	if pool.SlowQueryThreshold > 0 {
+		connConfig.Tracer = &slowQueryTracer{threshold: pool.SlowQueryThreshold}
+	}
+
+	db := sqlx.NewDb(stdlib.OpenDB(*connConfig), "pgx")
+	pool.apply(db.DB)
 
-	// Test the connection
 	if err := db.PingContext(ctx); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+		_ = db.Close()
+		return nil, err
 	}
 
-	return &DB{db}, nil
+	return db, nil
 }
 
 // UUIDToString converts pgtype.UUID to string
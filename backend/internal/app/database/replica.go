@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// replicaLagQuery returns the replay lag in seconds; 0 when the replica has replayed
+// everything it received (pg_last_xact_replay_timestamp alone grows on idle primaries)
+const replicaLagQuery = `
+	SELECT COALESCE(
+		CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		     ELSE EXTRACT(EPOCH FROM NOW() - pg_last_xact_replay_timestamp())
+		END, 0)
+`
+
+// replica is a read-only connection used for lag-tolerant reads
+type replica struct {
+	db      *sqlx.DB
+	maxLag  time.Duration
+	healthy atomic.Bool
+}
+
+// AttachReplica connects a read replica. Reads routed through Reader go to the replica
+// while its replication lag stays within maxLag; otherwise they fall back to the primary.
+// Only a failed connection is returned as an error.
+func (db *DB) AttachReplica(ctx context.Context, connUrl string, pool PoolConfig, maxLag time.Duration) error {
+	replicaDB, err := open(ctx, connUrl, pool)
+	if err != nil {
+		return fmt.Errorf("failed to connect to replica: %w", err)
+	}
+
+	// A lagging replica is still attached; StartReplicaMonitor enables it once it catches up
+	r := &replica{db: replicaDB, maxLag: maxLag}
+	if err := r.checkLag(ctx); err != nil {
+		logger.Warn("read replica attached but not used yet", "error", err)
+	}
+
+	db.replica = r
+	return nil
+}
+
+// Reader returns the connection for reads that tolerate replication lag: public pages
+// and listings. Writes and reads that must observe the caller's own writes use the
+// primary directly.
+func (db *DB) Reader() *sqlx.DB {
+	if db.replica != nil && db.replica.healthy.Load() {
+		return db.replica.db
+	}
+	return db.DB
+}
+
+// StartReplicaMonitor re-checks replication lag every interval until ctx is canceled
+func (db *DB) StartReplicaMonitor(ctx context.Context, interval time.Duration) {
+	if db.replica == nil || interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				checkCtx, cancel := context.WithTimeout(ctx, interval)
+				if err := db.replica.checkLag(checkCtx); err != nil && ctx.Err() == nil {
+					logger.Warn("read replica unavailable, reading from primary", "error", err)
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// checkLag marks the replica healthy when its lag is within maxLag
+func (r *replica) checkLag(ctx context.Context) error {
+	var lagSeconds float64
+	if err := r.db.GetContext(ctx, &lagSeconds, replicaLagQuery); err != nil {
+		r.healthy.Store(false)
+		return fmt.Errorf("failed to check replica lag: %w", err)
+	}
+
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	if lag > r.maxLag {
+		r.healthy.Store(false)
+		return fmt.Errorf("replica lag %s exceeds %s", lag.Round(time.Millisecond), r.maxLag)
+	}
+
+	r.healthy.Store(true)
+	return nil
+}
+
+// Close closes the replica connection, if any, and the primary pool
+func (db *DB) Close() error {
+	if db.replica != nil {
+		if err := db.replica.db.Close(); err != nil {
+			logger.Warn("failed to close read replica", "error", err)
+		}
+	}
+	return db.DB.Close()
+}
@@ -0,0 +1,230 @@
+package seed
+
+import "time"
+
+// Deterministic fixture IDs. E2E tests may rely on these; do not change them.
+const (
+	AliceUserID = "5eed0000-0000-4000-8000-000000000001"
+	BobUserID   = "5eed0000-0000-4000-8000-000000000002"
+	CarolUserID = "5eed0000-0000-4000-8000-000000000003"
+
+	AliceBirthdayWishListID = "5eed0000-0000-4000-8001-000000000001"
+	AliceHomeWishListID     = "5eed0000-0000-4000-8001-000000000002"
+	BobWeddingWishListID    = "5eed0000-0000-4000-8001-000000000003"
+
+	AliceHeadphonesItemID = "5eed0000-0000-4000-8002-000000000001"
+	AliceBookItemID       = "5eed0000-0000-4000-8002-000000000002"
+	AliceBicycleItemID    = "5eed0000-0000-4000-8002-000000000003"
+	AliceDeskLampItemID   = "5eed0000-0000-4000-8002-000000000004"
+	AliceChairItemID      = "5eed0000-0000-4000-8002-000000000005"
+	BobCookwareItemID     = "5eed0000-0000-4000-8002-000000000006"
+	BobPlatesItemID       = "5eed0000-0000-4000-8002-000000000007"
+	BobKettleItemID       = "5eed0000-0000-4000-8002-000000000008"
+
+	GuestHeadphonesReservationID = "5eed0000-0000-4000-8003-000000000001"
+	BobBookReservationID         = "5eed0000-0000-4000-8003-000000000002"
+	GuestPlatesReservationID     = "5eed0000-0000-4000-8003-000000000003"
+
+	GuestHeadphonesReservationToken = "5eed0000-0000-4000-8004-000000000001"
+	GuestPlatesReservationToken     = "5eed0000-0000-4000-8004-000000000003"
+)
+
+// Public slugs of the seeded public wishlists
+const (
+	AliceBirthdaySlug = "alice-birthday"
+	BobWeddingSlug    = "bob-and-dana-wedding"
+)
+
+// Password is the password of every seeded user
+const Password = "password123"
+
+type userFixture struct {
+	ID        string
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+type wishListFixture struct {
+	ID           string
+	OwnerID      string
+	Title        string
+	Description  string
+	Occasion     string
+	OccasionDate time.Time
+	PublicSlug   string // empty for private wishlists
+}
+
+type giftItemFixture struct {
+	ID          string
+	OwnerID     string
+	WishListIDs []string
+	Name        string
+	Description string
+	Link        string
+	Price       float64
+	Priority    int
+	Position    int
+
+	// Purchase state; PurchasedByUserID is empty when the item is not purchased
+	PurchasedByUserID string
+	PurchasedPrice    float64
+}
+
+type reservationFixture struct {
+	ID         string
+	WishListID string
+	GiftItemID string
+
+	// Either ReservedByUserID or the guest fields are set
+	ReservedByUserID string
+	GuestName        string
+	GuestEmail       string
+	Token            string
+}
+
+var users = []userFixture{
+	{ID: AliceUserID, Email: "alice@example.com", FirstName: "Alice", LastName: "Johnson"},
+	{ID: BobUserID, Email: "bob@example.com", FirstName: "Bob", LastName: "Smith"},
+	{ID: CarolUserID, Email: "carol@example.com", FirstName: "Carol", LastName: "Davis"},
+}
+
+var wishLists = []wishListFixture{
+	{
+		ID:           AliceBirthdayWishListID,
+		OwnerID:      AliceUserID,
+		Title:        "Alice's 30th Birthday",
+		Description:  "Things I'd love for my birthday party",
+		Occasion:     "Birthday",
+		OccasionDate: time.Date(2027, time.March, 14, 0, 0, 0, 0, time.UTC),
+		PublicSlug:   AliceBirthdaySlug,
+	},
+	{
+		ID:          AliceHomeWishListID,
+		OwnerID:     AliceUserID,
+		Title:       "Home Office Upgrade",
+		Description: "Private list for planning purchases",
+		Occasion:    "Other",
+	},
+	{
+		ID:           BobWeddingWishListID,
+		OwnerID:      BobUserID,
+		Title:        "Bob & Dana's Wedding",
+		Description:  "Our wedding registry",
+		Occasion:     "Wedding",
+		OccasionDate: time.Date(2027, time.June, 5, 0, 0, 0, 0, time.UTC),
+		PublicSlug:   BobWeddingSlug,
+	},
+}
+
+var giftItems = []giftItemFixture{
+	{
+		ID:          AliceHeadphonesItemID,
+		OwnerID:     AliceUserID,
+		WishListIDs: []string{AliceBirthdayWishListID},
+		Name:        "Noise-cancelling headphones",
+		Description: "Over-ear, black",
+		Link:        "https://example.com/products/headphones",
+		Price:       299.99,
+		Priority:    5,
+		Position:    1,
+	},
+	{
+		ID:          AliceBookItemID,
+		OwnerID:     AliceUserID,
+		WishListIDs: []string{AliceBirthdayWishListID},
+		Name:        "The Pragmatic Programmer",
+		Description: "20th anniversary edition, hardcover",
+		Link:        "https://example.com/products/pragmatic-programmer",
+		Price:       39.95,
+		Priority:    3,
+		Position:    2,
+	},
+	{
+		ID:          AliceBicycleItemID,
+		OwnerID:     AliceUserID,
+		WishListIDs: []string{AliceBirthdayWishListID},
+		Name:        "City bicycle",
+		Description: "Size M, any color but pink",
+		Link:        "https://example.com/products/city-bike",
+		Price:       649.00,
+		Priority:    4,
+		Position:    3,
+	},
+	{
+		ID:          AliceDeskLampItemID,
+		OwnerID:     AliceUserID,
+		WishListIDs: []string{AliceBirthdayWishListID, AliceHomeWishListID},
+		Name:        "LED desk lamp",
+		Link:        "https://example.com/products/desk-lamp",
+		Price:       59.50,
+		Priority:    2,
+		Position:    4,
+	},
+	{
+		ID:          AliceChairItemID,
+		OwnerID:     AliceUserID,
+		WishListIDs: []string{AliceHomeWishListID},
+		Name:        "Ergonomic office chair",
+		Link:        "https://example.com/products/office-chair",
+		Price:       420.00,
+		Priority:    5,
+		Position:    1,
+	},
+	{
+		ID:                BobCookwareItemID,
+		OwnerID:           BobUserID,
+		WishListIDs:       []string{BobWeddingWishListID},
+		Name:              "Cast iron cookware set",
+		Link:              "https://example.com/products/cookware",
+		Price:             189.00,
+		Priority:          4,
+		Position:          1,
+		PurchasedByUserID: CarolUserID,
+		PurchasedPrice:    175.00,
+	},
+	{
+		ID:          BobPlatesItemID,
+		OwnerID:     BobUserID,
+		WishListIDs: []string{BobWeddingWishListID},
+		Name:        "Dinner plates (set of 8)",
+		Price:       120.00,
+		Priority:    3,
+		Position:    2,
+	},
+	{
+		ID:          BobKettleItemID,
+		OwnerID:     BobUserID,
+		WishListIDs: []string{BobWeddingWishListID},
+		Name:        "Electric kettle",
+		Link:        "https://example.com/products/kettle",
+		Price:       45.00,
+		Priority:    1,
+		Position:    3,
+	},
+}
+
+var reservations = []reservationFixture{
+	{
+		ID:         GuestHeadphonesReservationID,
+		WishListID: AliceBirthdayWishListID,
+		GiftItemID: AliceHeadphonesItemID,
+		GuestName:  "Grace Guest",
+		GuestEmail: "grace.guest@example.com",
+		Token:      GuestHeadphonesReservationToken,
+	},
+	{
+		ID:               BobBookReservationID,
+		WishListID:       AliceBirthdayWishListID,
+		GiftItemID:       AliceBookItemID,
+		ReservedByUserID: BobUserID,
+	},
+	{
+		ID:         GuestPlatesReservationID,
+		WishListID: BobWeddingWishListID,
+		GiftItemID: BobPlatesItemID,
+		GuestName:  "Henry Guest",
+		GuestEmail: "henry.guest@example.com",
+		Token:      GuestPlatesReservationToken,
+	},
+}
@@ -0,0 +1,79 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixtures_ReferencesAreConsistent(t *testing.T) {
+	ids := make(map[string]bool)
+	addID := func(id string) {
+		_, err := uuid.Parse(id)
+		require.NoError(t, err, "fixture ID %q must be a UUID", id)
+		require.False(t, ids[id], "fixture ID %q is used twice", id)
+		ids[id] = true
+	}
+
+	userIDs := make(map[string]bool)
+	for _, u := range users {
+		addID(u.ID)
+		userIDs[u.ID] = true
+	}
+
+	wishListOwners := make(map[string]string)
+	for _, w := range wishLists {
+		addID(w.ID)
+		assert.True(t, userIDs[w.OwnerID], "wishlist %s has unknown owner", w.ID)
+		if w.PublicSlug != "" {
+			assert.Regexp(t, `^[a-z0-9-]+$`, w.PublicSlug)
+		}
+		wishListOwners[w.ID] = w.OwnerID
+	}
+
+	itemWishLists := make(map[string][]string)
+	for _, item := range giftItems {
+		addID(item.ID)
+		assert.True(t, userIDs[item.OwnerID], "gift item %s has unknown owner", item.ID)
+		for _, wishListID := range item.WishListIDs {
+			assert.Equal(t, item.OwnerID, wishListOwners[wishListID], "gift item %s is on a wishlist of another owner", item.ID)
+		}
+		if item.PurchasedByUserID != "" {
+			assert.True(t, userIDs[item.PurchasedByUserID], "gift item %s purchased by unknown user", item.ID)
+		}
+		itemWishLists[item.ID] = item.WishListIDs
+	}
+
+	reservedItems := make(map[string]bool)
+	for _, r := range reservations {
+		addID(r.ID)
+		assert.Contains(t, itemWishLists[r.GiftItemID], r.WishListID, "reservation %s is for an item not on its wishlist", r.ID)
+		assert.False(t, reservedItems[r.GiftItemID], "gift item %s is reserved twice", r.GiftItemID)
+		reservedItems[r.GiftItemID] = true
+
+		if r.ReservedByUserID != "" {
+			assert.True(t, userIDs[r.ReservedByUserID], "reservation %s by unknown user", r.ID)
+			assert.Empty(t, r.GuestEmail)
+		} else {
+			assert.NotEmpty(t, r.GuestEmail, "guest reservation %s needs an email", r.ID)
+			addID(r.Token)
+		}
+	}
+}
+
+func TestLoadTestItemID_IsDistinctUUID(t *testing.T) {
+	seen := map[string]bool{LoadTestUserID: true, LoadTestWishListID: true}
+	for _, item := range giftItems {
+		seen[item.ID] = true
+	}
+
+	for _, i := range []int{0, 1, 999_999} {
+		id := LoadTestItemID(i)
+		_, err := uuid.Parse(id)
+		require.NoError(t, err, "load test item ID %q must be a UUID", id)
+		assert.False(t, seen[id], "load test item ID %q is used twice", id)
+		seen[id] = true
+	}
+}
@@ -0,0 +1,113 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/priority"
+)
+
+// Load test fixture IDs, kept apart from the e2e fixtures so the two datasets can be
+// set up and torn down independently
+const (
+	LoadTestUserID     = "5eed0000-0000-4000-8010-000000000001"
+	LoadTestWishListID = "5eed0000-0000-4000-8011-000000000001"
+	LoadTestSlug       = "load-test"
+	LoadTestEmail      = "loadtest@example.com"
+)
+
+// loadTestItemQuantity is the quantity wanted of every load test item. It is large
+// enough that a run never fully reserves an item, so reservation requests keep
+// exercising the insert path instead of failing with a conflict.
+const loadTestItemQuantity = 1_000_000
+
+// LoadTestItemID returns the deterministic ID of the i-th load test gift item
+func LoadTestItemID(i int) string {
+	return fmt.Sprintf("5eed0000-0000-4000-8012-%012d", i)
+}
+
+// SeedLoadTest creates the load test user and a public wishlist holding items gift
+// items, replacing any previous load test data so item counts are exact
+func SeedLoadTest(ctx context.Context, db *database.DB, items int) (Stats, error) {
+	var stats Stats
+
+	if _, err := CleanLoadTest(ctx, db); err != nil {
+		return stats, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.DefaultCost)
+	if err != nil {
+		return stats, fmt.Errorf("failed to hash seed password: %w", err)
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return stats, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.Warn("transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	stats.Users, err = execCount(ctx, tx, `
+		INSERT INTO users (id, email, password_hash, first_name, last_name, is_verified)
+		VALUES ($1, $2, $3, 'Load', 'Test', true)`,
+		LoadTestUserID, LoadTestEmail, string(passwordHash))
+	if err != nil {
+		return stats, fmt.Errorf("failed to seed load test user: %w", err)
+	}
+
+	stats.WishLists, err = execCount(ctx, tx, `
+		INSERT INTO wishlists (id, owner_id, title, description, occasion, is_public, public_slug)
+		VALUES ($1, $2, 'Load Test', 'Generated by cmd/loadtest', 'Other', true, $3)`,
+		LoadTestWishListID, LoadTestUserID, LoadTestSlug)
+	if err != nil {
+		return stats, fmt.Errorf("failed to seed load test wishlist: %w", err)
+	}
+
+	for i := range items {
+		id := LoadTestItemID(i)
+		n, err := execCount(ctx, tx, `
+			INSERT INTO gift_items (id, owner_id, name, description, link, price, priority, position, quantity, priority_level)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			id, LoadTestUserID,
+			fmt.Sprintf("Load test gift %d", i),
+			fmt.Sprintf("Generated gift item number %d with a description of typical length", i),
+			fmt.Sprintf("https://example.com/products/load-test-%d", i),
+			float64(i%500)+0.99, i%10, i+1, loadTestItemQuantity,
+			priority.FromNumber(int32(i%10))) //nolint:gosec // Bounded by the modulo
+		if err != nil {
+			return stats, fmt.Errorf("failed to seed load test gift item %s: %w", id, err)
+		}
+		stats.GiftItems += n
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO wishlist_items (wishlist_id, gift_item_id) VALUES ($1, $2)`,
+			LoadTestWishListID, id); err != nil {
+			return stats, fmt.Errorf("failed to link load test gift item %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return stats, fmt.Errorf("failed to commit load test data: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CleanLoadTest removes the load test user, which cascades to its wishlist, gift items
+// and the reservations made on them during a run
+func CleanLoadTest(ctx context.Context, db *database.DB) (int64, error) {
+	deleted, err := execCount(ctx, db, `DELETE FROM users WHERE id = $1`, LoadTestUserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete load test user: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,184 @@
+// Package seed populates the database with deterministic fixture data for local
+// development, demos and e2e tests. It must never run against production data.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/priority"
+)
+
+// Stats reports how many fixture rows were inserted. Rows that already exist are skipped.
+type Stats struct {
+	Users        int64
+	WishLists    int64
+	GiftItems    int64
+	Reservations int64
+}
+
+// Seed inserts all fixtures in a single transaction. It is idempotent: fixtures that
+// already exist (by ID or unique email/slug) are left untouched.
+func Seed(ctx context.Context, db *database.DB) (Stats, error) {
+	var stats Stats
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.DefaultCost)
+	if err != nil {
+		return stats, fmt.Errorf("failed to hash seed password: %w", err)
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return stats, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.Warn("transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	for _, u := range users {
+		n, err := execCount(ctx, tx, `
+			INSERT INTO users (id, email, password_hash, first_name, last_name, is_verified)
+			VALUES ($1, $2, $3, $4, $5, true)
+			ON CONFLICT DO NOTHING`,
+			u.ID, u.Email, string(passwordHash), u.FirstName, u.LastName)
+		if err != nil {
+			return stats, fmt.Errorf("failed to seed user %s: %w", u.ID, err)
+		}
+		stats.Users += n
+	}
+
+	for _, w := range wishLists {
+		n, err := execCount(ctx, tx, `
+			INSERT INTO wishlists (id, owner_id, title, description, occasion, occasion_date, is_public, public_slug)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT DO NOTHING`,
+			w.ID, w.OwnerID, w.Title, nullString(w.Description), nullString(w.Occasion),
+			nullDate(w), w.PublicSlug != "", nullString(w.PublicSlug))
+		if err != nil {
+			return stats, fmt.Errorf("failed to seed wishlist %s: %w", w.ID, err)
+		}
+		stats.WishLists += n
+	}
+
+	reservedBy := make(map[string]reservationFixture, len(reservations))
+	for _, r := range reservations {
+		reservedBy[r.GiftItemID] = r
+	}
+
+	for _, item := range giftItems {
+		reservation, reserved := reservedBy[item.ID]
+		n, err := execCount(ctx, tx, `
+			INSERT INTO gift_items (
+				id, owner_id, name, description, link, price, priority, position,
+				reserved_by_user_id, reserved_at, purchased_by_user_id, purchased_at, purchased_price,
+				priority_level
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8,
+				$9, CASE WHEN $10::boolean THEN NOW() END,
+				$11, CASE WHEN $11::uuid IS NOT NULL THEN NOW() END, $12,
+				$13
+			)
+			ON CONFLICT DO NOTHING`,
+			item.ID, item.OwnerID, item.Name, nullString(item.Description), nullString(item.Link),
+			item.Price, item.Priority, item.Position,
+			nullString(reservation.ReservedByUserID), reserved,
+			nullString(item.PurchasedByUserID), nullPrice(item),
+			priority.FromNumber(int32(item.Priority))) //nolint:gosec // Fixture priorities are 0-10
+		if err != nil {
+			return stats, fmt.Errorf("failed to seed gift item %s: %w", item.ID, err)
+		}
+		stats.GiftItems += n
+
+		for _, wishListID := range item.WishListIDs {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO wishlist_items (wishlist_id, gift_item_id)
+				VALUES ($1, $2)
+				ON CONFLICT DO NOTHING`,
+				wishListID, item.ID); err != nil {
+				return stats, fmt.Errorf("failed to link gift item %s to wishlist %s: %w", item.ID, wishListID, err)
+			}
+		}
+	}
+
+	for _, r := range reservations {
+		n, err := execCount(ctx, tx, `
+			INSERT INTO reservations (
+				id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, guest_email,
+				reservation_token, status
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, 'active')
+			ON CONFLICT DO NOTHING`,
+			r.ID, r.WishListID, r.GiftItemID, nullString(r.ReservedByUserID),
+			nullString(r.GuestName), nullString(r.GuestEmail), nullString(r.Token))
+		if err != nil {
+			return stats, fmt.Errorf("failed to seed reservation %s: %w", r.ID, err)
+		}
+		stats.Reservations += n
+
+		if n > 0 {
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE gift_items SET reserved_quantity = reserved_quantity + 1
+				WHERE id = $1`,
+				r.GiftItemID); err != nil {
+				return stats, fmt.Errorf("failed to hold quantity for reservation %s: %w", r.ID, err)
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO reservation_events (reservation_id, wishlist_id, gift_item_id, event_type, status)
+				VALUES ($1, $2, $3, 'created', 'active')`,
+				r.ID, r.WishListID, r.GiftItemID); err != nil {
+				return stats, fmt.Errorf("failed to record reservation %s: %w", r.ID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return stats, fmt.Errorf("failed to commit seed data: %w", err)
+	}
+
+	return stats, nil
+}
+
+// Clean removes all seeded data. Deleting the fixture users cascades to their
+// wishlists, gift items and the reservations on those items.
+func Clean(ctx context.Context, db *database.DB) (int64, error) {
+	var deleted int64
+	for _, u := range users {
+		n, err := execCount(ctx, db, `DELETE FROM users WHERE id = $1`, u.ID)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete seed user %s: %w", u.ID, err)
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+func execCount(ctx context.Context, executor database.Executor, query string, args ...any) (int64, error) {
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullDate(w wishListFixture) sql.NullTime {
+	return sql.NullTime{Time: w.OccasionDate, Valid: !w.OccasionDate.IsZero()}
+}
+
+func nullPrice(item giftItemFixture) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: item.PurchasedPrice, Valid: item.PurchasedByUserID != ""}
+}
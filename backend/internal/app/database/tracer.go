@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"expvar"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// slowQueryMaxSQLLength truncates the statement in slow query logs
+const slowQueryMaxSQLLength = 2000
+
+// slowQueryRouteBackground counts slow queries not issued by an HTTP request, e.g. jobs
+const slowQueryRouteBackground = "background"
+
+// SlowQueries counts slow queries per route since the process started. It is
+// published through expvar as database_slow_queries.
+var SlowQueries = expvar.NewMap("database_slow_queries")
+
+type routeKey struct{}
+
+// WithRoute tags queries run with the returned context with the route that issued
+// them, e.g. "GET /api/public/wishlists/:slug", for slow query logs and counts
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeKey{}, route)
+}
+
+func routeFromContext(ctx context.Context) string {
+	if route, ok := ctx.Value(routeKey{}).(string); ok && route != "" {
+		return route
+	}
+	return slowQueryRouteBackground
+}
+
+// slowQueryTracer logs queries that take at least threshold. Parameter values are
+// never logged, only their count, since they carry user data.
+type slowQueryTracer struct {
+	threshold time.Duration
+}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql   string
+	args  int
+	start time.Time
+}
+
+func (t *slowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{sql: data.SQL, args: len(data.Args), start: time.Now()})
+}
+
+func (t *slowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(start.start)
+	if elapsed < t.threshold {
+		return
+	}
+
+	route := routeFromContext(ctx)
+	SlowQueries.Add(route, 1)
+
+	args := []any{
+		"duration", elapsed.String(),
+		"threshold", t.threshold.String(),
+		"route", route,
+		"sql", compactSQL(start.sql),
+		"args", start.args,
+	}
+	if data.Err != nil {
+		args = append(args, "error", data.Err)
+	}
+	logger.WarnContext(ctx, "slow database query", args...)
+}
+
+// compactSQL collapses the indentation of multi-line queries and truncates long ones
+func compactSQL(sql string) string {
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > slowQueryMaxSQLLength {
+		sql = sql[:slowQueryMaxSQLLength] + "..."
+	}
+	return sql
+}
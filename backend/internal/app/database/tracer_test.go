@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"expvar"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func slowQueryCount(route string) int64 {
+	if v, ok := SlowQueries.Get(route).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestSlowQueryTracer(t *testing.T) {
+	route := "GET /api/public/wishlists/:slug"
+	ctx := WithRoute(context.Background(), route)
+
+	t.Run("counts queries over the threshold", func(t *testing.T) {
+		before := slowQueryCount(route)
+		tracer := &slowQueryTracer{threshold: time.Nanosecond}
+
+		queryCtx := tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: "SELECT 1 WHERE $1", Args: []any{"secret"}})
+		time.Sleep(time.Millisecond)
+		tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{})
+
+		assert.Equal(t, before+1, slowQueryCount(route))
+	})
+
+	t.Run("ignores fast queries", func(t *testing.T) {
+		before := slowQueryCount(route)
+		tracer := &slowQueryTracer{threshold: time.Hour}
+
+		queryCtx := tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+		tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{})
+
+		assert.Equal(t, before, slowQueryCount(route))
+	})
+
+	t.Run("queries outside requests count as background", func(t *testing.T) {
+		before := slowQueryCount(slowQueryRouteBackground)
+		tracer := &slowQueryTracer{threshold: time.Nanosecond}
+
+		queryCtx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+		time.Sleep(time.Millisecond)
+		tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{})
+
+		assert.Equal(t, before+1, slowQueryCount(slowQueryRouteBackground))
+	})
+}
+
+func TestCompactSQL(t *testing.T) {
+	assert.Equal(t, "SELECT id FROM wishlists WHERE id = $1", compactSQL(`
+		SELECT id
+		FROM wishlists
+		WHERE id = $1
+	`))
+
+	long := compactSQL("SELECT " + strings.Repeat("x", 3*slowQueryMaxSQLLength))
+	assert.Len(t, long, slowQueryMaxSQLLength+len("..."))
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	"wish-list/internal/app/database"
@@ -13,6 +12,7 @@ import (
 	reservationmodels "wish-list/internal/domain/reservation/models"
 	usermodels "wish-list/internal/domain/user/models"
 	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/logger"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -24,6 +24,8 @@ type UserRepoInterface interface {
 	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
 	ListInactiveSince(ctx context.Context, since time.Time) ([]*usermodels.User, error)
 	DeleteWithExecutor(ctx context.Context, executor database.Executor, id pgtype.UUID) error
+	RequestDeletion(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+	ListDeletionRequestedBefore(ctx context.Context, before time.Time) ([]*usermodels.User, error)
 }
 
 // WishListRepoInterface defines wishlist repo methods needed by cleanup service
@@ -51,6 +53,7 @@ type AccountCleanupService struct {
 	giftItemRepo    GiftItemRepoInterface
 	reservationRepo ReservationRepoInterface
 	emailService    EmailServiceInterface
+	gracePeriod     time.Duration // Delay between a user deletion request and the purge
 	ticker          *time.Ticker
 }
 
@@ -62,6 +65,7 @@ func NewAccountCleanupService(
 	giftItemRepo GiftItemRepoInterface,
 	reservationRepo ReservationRepoInterface,
 	emailService EmailServiceInterface,
+	gracePeriod time.Duration,
 ) *AccountCleanupService {
 	return &AccountCleanupService{
 		db:              db,
@@ -70,9 +74,59 @@ func NewAccountCleanupService(
 		giftItemRepo:    giftItemRepo,
 		reservationRepo: reservationRepo,
 		emailService:    emailService,
+		gracePeriod:     gracePeriod,
 	}
 }
 
+// RequestAccountDeletion schedules a user-requested deletion after the grace period.
+// Returns the time the account will be purged. With no grace period configured the
+// account is deleted immediately and a zero time is returned.
+func (s *AccountCleanupService) RequestAccountDeletion(ctx context.Context, userID string) (time.Time, error) {
+	if s.gracePeriod <= 0 {
+		if err := s.DeleteUserAccount(ctx, userID, "user_requested_deletion"); err != nil {
+			return time.Time{}, err
+		}
+		return time.Time{}, nil
+	}
+
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		return time.Time{}, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	user, err := s.userRepo.RequestDeletion(ctx, id)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to request account deletion: %w", err)
+	}
+
+	scheduledAt := user.DeletionRequestedAt.Time.Add(s.gracePeriod)
+	logger.InfoContext(ctx, "account deletion requested",
+		"audit", true,
+		"user_id", user.ID.String(),
+		"scheduled_for", scheduledAt.Format(time.RFC3339))
+
+	return scheduledAt, nil
+}
+
+// DeletePendingAccounts purges accounts whose deletion grace period has expired
+func (s *AccountCleanupService) DeletePendingAccounts(ctx context.Context) error {
+	users, err := s.userRepo.ListDeletionRequestedBefore(ctx, time.Now().Add(-s.gracePeriod))
+	if err != nil {
+		return fmt.Errorf("failed to find accounts pending deletion: %w", err)
+	}
+
+	for _, user := range users {
+		if err := s.DeleteUserAccount(ctx, user.ID.String(), "user_requested_deletion"); err != nil {
+			logger.ErrorContext(ctx, "failed to delete pending account", "user_id", user.ID.String(), "error", err)
+			continue
+		}
+
+		logger.InfoContext(ctx, "deleted account after grace period", "user_id", user.ID.String())
+	}
+
+	return nil
+}
+
 // CheckInactiveAccounts identifies accounts approaching the deletion threshold
 // and sends warning notifications
 func (s *AccountCleanupService) CheckInactiveAccounts(ctx context.Context) error {
@@ -98,9 +152,9 @@ func (s *AccountCleanupService) CheckInactiveAccounts(ctx context.Context) error
 			userName += user.LastName.String
 		}
 		if err := s.emailService.SendAccountInactivityNotification(ctx, user.Email, userName, InactivityWarning23Month); err != nil {
-			log.Printf("Failed to send 23-month warning to user %s: %v", user.ID.String(), err)
+			logger.ErrorContext(ctx, "failed to send 23-month inactivity warning", "user_id", user.ID.String(), "error", err)
 		} else {
-			log.Printf("Sent 23-month inactivity warning to user %s", user.ID.String())
+			logger.InfoContext(ctx, "sent 23-month inactivity warning", "user_id", user.ID.String())
 		}
 	}
 
@@ -124,9 +178,9 @@ func (s *AccountCleanupService) CheckInactiveAccounts(ctx context.Context) error
 			userName += user.LastName.String
 		}
 		if err := s.emailService.SendAccountInactivityNotification(ctx, user.Email, userName, InactivityWarningFinal); err != nil {
-			log.Printf("Failed to send 7-day final warning to user %s: %v", user.ID.String(), err)
+			logger.ErrorContext(ctx, "failed to send 7-day final warning", "user_id", user.ID.String(), "error", err)
 		} else {
-			log.Printf("Sent 7-day final warning to user %s", user.ID.String())
+			logger.InfoContext(ctx, "sent 7-day final warning", "user_id", user.ID.String())
 		}
 	}
 
@@ -144,14 +198,16 @@ func (s *AccountCleanupService) DeleteInactiveAccounts(ctx context.Context) erro
 	}
 
 	for _, user := range inactiveUsers {
-		log.Printf("Deleting inactive user account: %s (last active: %s)", user.ID.String(), user.UpdatedAt.Time.Format(time.RFC3339))
+		logger.InfoContext(ctx, "deleting inactive user account",
+			"user_id", user.ID.String(),
+			"last_active", user.UpdatedAt.Time.Format(time.RFC3339))
 
 		if err := s.DeleteUserAccount(ctx, user.ID.String(), "automatic_inactivity_deletion"); err != nil {
-			log.Printf("Failed to delete user %s: %v", user.ID.String(), err)
+			logger.ErrorContext(ctx, "failed to delete inactive user", "user_id", user.ID.String(), "error", err)
 			continue
 		}
 
-		log.Printf("Successfully deleted inactive user %s", user.ID.String())
+		logger.InfoContext(ctx, "deleted inactive user", "user_id", user.ID.String())
 	}
 
 	return nil
@@ -191,7 +247,7 @@ func (s *AccountCleanupService) DeleteUserAccount(ctx context.Context, userID, r
 	}
 	defer func() {
 		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
-			log.Printf("tx rollback error: %v", rbErr)
+			logger.ErrorContext(ctx, "tx rollback error", "error", rbErr)
 		}
 	}()
 
@@ -243,7 +299,7 @@ func (s *AccountCleanupService) DeleteUserAccount(ctx context.Context, userID, r
 	for _, notif := range notifications {
 		err := s.emailService.SendReservationRemovedEmail(ctx, notif.email, notif.itemName, notif.wishListName)
 		if err != nil {
-			log.Printf("Warning: failed to send deletion notification to %s: %v", notif.email, err)
+			logger.WarnContext(ctx, "failed to send deletion notification", "error", err)
 		}
 	}
 
@@ -342,43 +398,53 @@ func (s *AccountCleanupService) findInactiveUsersSince(ctx context.Context, sinc
 // logAccountDeletion logs account deletion for audit purposes
 // Note: Email parameter is intentionally unused to comply with CR-004 (no plaintext PII in logs)
 func (s *AccountCleanupService) logAccountDeletion(userID, _, reason string, isAutomatic bool) {
-	log.Printf("[AUDIT] Account deleted: UserID=%s, Reason=%s, Automatic=%v, Timestamp=%s",
-		userID,
-		reason,
-		isAutomatic,
-		time.Now().Format(time.RFC3339))
+	logger.Info("account deleted",
+		"audit", true,
+		"user_id", userID,
+		"reason", reason,
+		"automatic", isAutomatic)
 }
 
-// StartScheduledCleanup starts the scheduled cleanup job
-func (s *AccountCleanupService) StartScheduledCleanup(ctx context.Context) {
+// RunScheduledCleanup runs the cleanup job daily until ctx is canceled. It blocks, so
+// callers start it in a goroutine. A run that is already in progress when ctx is
+// canceled is finished, so shutdown never leaves an account half deleted or its
+// notifications unsent.
+func (s *AccountCleanupService) RunScheduledCleanup(ctx context.Context) {
 	// Run cleanup daily at 2 AM
 	s.ticker = time.NewTicker(24 * time.Hour)
+	defer s.ticker.Stop()
+
+	logger.Info("scheduled account cleanup job started", "interval", "24h")
 
-	go func() {
-		defer s.ticker.Stop() // Automatic cleanup when goroutine exits
+	for {
+		select {
+		case <-s.ticker.C:
+			s.runCleanup(context.WithoutCancel(ctx))
+		case <-ctx.Done():
+			logger.Info("account cleanup job stopped")
+			return
+		}
+	}
+}
 
-		for {
-			select {
-			case <-s.ticker.C:
-				log.Println("Running scheduled account cleanup check...")
+// runCleanup performs one scheduled cleanup pass
+func (s *AccountCleanupService) runCleanup(ctx context.Context) {
+	logger.InfoContext(ctx, "running scheduled account cleanup")
 
-				// Check for inactive accounts and send warnings
-				if err := s.CheckInactiveAccounts(ctx); err != nil {
-					log.Printf("Error checking inactive accounts: %v", err)
-				}
+	// Check for inactive accounts and send warnings
+	if err := s.CheckInactiveAccounts(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to check inactive accounts", "error", err)
+	}
 
-				// Delete accounts inactive for 24 months
-				if err := s.DeleteInactiveAccounts(ctx); err != nil {
-					log.Printf("Error deleting inactive accounts: %v", err)
-				}
+	// Delete accounts inactive for 24 months
+	if err := s.DeleteInactiveAccounts(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to delete inactive accounts", "error", err)
+	}
 
-				log.Println("Scheduled account cleanup completed")
-			case <-ctx.Done():
-				log.Println("Account cleanup job stopped")
-				return
-			}
-		}
-	}()
+	// Purge user-requested deletions whose grace period has expired
+	if err := s.DeletePendingAccounts(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to delete accounts pending deletion", "error", err)
+	}
 
-	log.Println("Scheduled account cleanup job started (runs daily at current time)")
+	logger.InfoContext(ctx, "scheduled account cleanup completed")
 }
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/linkcheck"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// availabilityCheckInterval is how often the checker looks for items due for a check
+	availabilityCheckInterval = 6 * time.Hour
+	// availabilityRecheckAfter is how long a check result is trusted before the link is checked again
+	availabilityRecheckAfter = 24 * time.Hour
+	// availabilityBatchSize bounds how many links one run checks
+	availabilityBatchSize = 200
+)
+
+// Cross-domain interfaces — only methods used by ItemAvailabilityService
+
+// AvailabilityGiftItemRepoInterface defines gift item repo methods needed by the availability checker
+type AvailabilityGiftItemRepoInterface interface {
+	ListDueForAvailabilityCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*itemmodels.GiftItem, error)
+	UpdateAvailability(ctx context.Context, id pgtype.UUID, status string) error
+}
+
+// ItemAvailabilityService checks item product links and records whether they are still available
+type ItemAvailabilityService struct {
+	giftItemRepo AvailabilityGiftItemRepoInterface
+	checker      linkcheck.Checker
+}
+
+// NewItemAvailabilityService creates a new item availability service
+func NewItemAvailabilityService(giftItemRepo AvailabilityGiftItemRepoInterface, checker linkcheck.Checker) *ItemAvailabilityService {
+	return &ItemAvailabilityService{
+		giftItemRepo: giftItemRepo,
+		checker:      checker,
+	}
+}
+
+// CheckDueItems checks one batch of linked items whose last check is missing or stale.
+// An inconclusive check (network error, server error) still stamps the item as unknown
+// so a flaky shop does not hold back the rest of the queue.
+func (s *ItemAvailabilityService) CheckDueItems(ctx context.Context) error {
+	items, err := s.giftItemRepo.ListDueForAvailabilityCheck(ctx, time.Now().Add(-availabilityRecheckAfter), availabilityBatchSize)
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	for _, item := range items {
+		// Stop between items on shutdown; a half-run batch is picked up by the next run
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		status := s.check(ctx, item)
+		counts[status]++
+
+		if err := s.giftItemRepo.UpdateAvailability(ctx, item.ID, status); err != nil {
+			logger.ErrorContext(ctx, "failed to record item availability", "item_id", item.ID.String(), "error", err)
+		}
+	}
+
+	logger.InfoContext(ctx, "item availability check completed",
+		"checked", len(items),
+		itemmodels.AvailabilityAvailable, counts[itemmodels.AvailabilityAvailable],
+		itemmodels.AvailabilityOutOfStock, counts[itemmodels.AvailabilityOutOfStock],
+		itemmodels.AvailabilityNotFound, counts[itemmodels.AvailabilityNotFound],
+		itemmodels.AvailabilityUnknown, counts[itemmodels.AvailabilityUnknown])
+
+	return nil
+}
+
+func (s *ItemAvailabilityService) check(ctx context.Context, item *itemmodels.GiftItem) string {
+	result, err := s.checker.Check(ctx, item.Link.String)
+	if err != nil {
+		if !errors.Is(err, linkcheck.ErrInconclusive) {
+			logger.DebugContext(ctx, "item link not checkable", "item_id", item.ID.String(), "error", err)
+		}
+		return itemmodels.AvailabilityUnknown
+	}
+
+	switch result {
+	case linkcheck.ResultAvailable:
+		return itemmodels.AvailabilityAvailable
+	case linkcheck.ResultOutOfStock:
+		return itemmodels.AvailabilityOutOfStock
+	case linkcheck.ResultNotFound:
+		return itemmodels.AvailabilityNotFound
+	default:
+		return itemmodels.AvailabilityUnknown
+	}
+}
+
+// RunScheduledCheck runs the availability check every availabilityCheckInterval until ctx
+// is canceled. It blocks, so callers start it in a goroutine.
+func (s *ItemAvailabilityService) RunScheduledCheck(ctx context.Context) {
+	ticker := time.NewTicker(availabilityCheckInterval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled item availability check started", "interval", availabilityCheckInterval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.CheckDueItems(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.ErrorContext(ctx, "failed to check item availability", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("item availability check stopped")
+			return
+		}
+	}
+}
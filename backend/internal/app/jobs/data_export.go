@@ -0,0 +1,446 @@
+package jobs
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"wish-list/internal/app/database"
+	apitokenmodels "wish-list/internal/domain/apitoken/models"
+	exportmodels "wish-list/internal/domain/data_export/models"
+	quotamodels "wish-list/internal/domain/quota/models"
+	reservationrepo "wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// dataExportInterval is how often queued exports are picked up
+	dataExportInterval = time.Minute
+	// dataExportBatchSize caps the exports built per run; archives are built one at a time
+	dataExportBatchSize = 5
+	// dataExportStaleAfter is when an export left processing, e.g. by a crashed instance, is built again
+	dataExportStaleAfter = time.Hour
+	// dataExportMaxImageBytes skips images larger than this instead of bloating the archive
+	dataExportMaxImageBytes = 20 << 20
+	// dataExportReservationPage is the page size used to read all of a user's reservations
+	dataExportReservationPage = 100
+	// dataExportFailureReason is shown to the user; the cause is only logged
+	dataExportFailureReason = "The export could not be created. Please request a new one."
+)
+
+// Cross-domain interfaces — only methods used by DataExportJobService
+
+// DataExportRepoInterface defines data export repo methods needed by the export job
+type DataExportRepoInterface interface {
+	ClaimPending(ctx context.Context, staleBefore time.Time, limit int) ([]*exportmodels.DataExport, error)
+	Complete(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error
+	Fail(ctx context.Context, id pgtype.UUID, reason string) error
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*exportmodels.DataExport, error)
+	MarkExpired(ctx context.Context, id pgtype.UUID) error
+	ListByUser(ctx context.Context, userID pgtype.UUID, limit int) ([]*exportmodels.DataExport, error)
+}
+
+// UserDataExporterInterface returns the user's profile and wishlists, as AccountCleanupService does
+type UserDataExporterInterface interface {
+	ExportUserData(ctx context.Context, userID string) (map[string]any, error)
+}
+
+// ExportReservationRepoInterface defines reservation repo methods needed by the export job
+type ExportReservationRepoInterface interface {
+	ListUserReservationsWithDetails(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]reservationrepo.ReservationDetail, error)
+}
+
+// ExportImageUploadRepoInterface defines quota repo methods needed by the export job
+type ExportImageUploadRepoInterface interface {
+	ListImageUploads(ctx context.Context, userID pgtype.UUID) ([]*quotamodels.ImageUpload, error)
+}
+
+// ExportAPITokenRepoInterface defines API token repo methods needed by the export job
+type ExportAPITokenRepoInterface interface {
+	ListByUser(ctx context.Context, userID pgtype.UUID) ([]*apitokenmodels.APIToken, error)
+}
+
+// ArchiveStorageInterface stores archives and reads the user's uploaded images
+type ArchiveStorageInterface interface {
+	UploadObject(ctx context.Context, key string, body io.Reader, contentType string) error
+	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteFile(ctx context.Context, fileKey string) error
+	KeyFromURL(rawURL string) (string, bool)
+	Available() bool
+}
+
+// DataExportJobService builds queued account data exports into ZIP archives in S3
+// and deletes the archives once they expire
+type DataExportJobService struct {
+	exportRepo      DataExportRepoInterface
+	exporter        UserDataExporterInterface
+	reservationRepo ExportReservationRepoInterface
+	imageRepo       ExportImageUploadRepoInterface
+	apiTokenRepo    ExportAPITokenRepoInterface
+	storage         ArchiveStorageInterface
+	retention       time.Duration // How long an archive can be downloaded
+}
+
+// NewDataExportJobService creates a new data export job service
+func NewDataExportJobService(
+	exportRepo DataExportRepoInterface,
+	exporter UserDataExporterInterface,
+	reservationRepo ExportReservationRepoInterface,
+	imageRepo ExportImageUploadRepoInterface,
+	apiTokenRepo ExportAPITokenRepoInterface,
+	storage ArchiveStorageInterface,
+	retention time.Duration,
+) *DataExportJobService {
+	return &DataExportJobService{
+		exportRepo:      exportRepo,
+		exporter:        exporter,
+		reservationRepo: reservationRepo,
+		imageRepo:       imageRepo,
+		apiTokenRepo:    apiTokenRepo,
+		storage:         storage,
+		retention:       retention,
+	}
+}
+
+// ProcessPending builds the queued exports. An export that cannot be built is marked
+// failed so the user can request a new one.
+func (s *DataExportJobService) ProcessPending(ctx context.Context) error {
+	// Leave exports queued while S3 is known to be down instead of failing them all
+	if !s.storage.Available() {
+		logger.WarnContext(ctx, "storage unavailable, postponing data exports")
+		return nil
+	}
+
+	exports, err := s.exportRepo.ClaimPending(ctx, time.Now().Add(-dataExportStaleAfter), dataExportBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim data exports: %w", err)
+	}
+
+	for _, export := range exports {
+		if err := s.build(ctx, export); err != nil {
+			logger.ErrorContext(ctx, "failed to build data export", "export_id", export.ID.String(), "error", err)
+			if err := s.exportRepo.Fail(ctx, export.ID, dataExportFailureReason); err != nil {
+				logger.ErrorContext(ctx, "failed to mark data export failed", "export_id", export.ID.String(), "error", err)
+			}
+			continue
+		}
+		logger.InfoContext(ctx, "data export completed", "audit", true, "export_id", export.ID.String(), "user_id", export.UserID.String())
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes archives past their retention and marks their exports expired
+func (s *DataExportJobService) DeleteExpired(ctx context.Context) error {
+	exports, err := s.exportRepo.ListExpired(ctx, time.Now(), 100)
+	if err != nil {
+		return fmt.Errorf("failed to find expired data exports: %w", err)
+	}
+
+	for _, export := range exports {
+		if export.ObjectKey.Valid {
+			if err := s.storage.DeleteFile(ctx, export.ObjectKey.String); err != nil {
+				logger.ErrorContext(ctx, "failed to delete data export archive", "export_id", export.ID.String(), "error", err)
+				continue
+			}
+		}
+		if err := s.exportRepo.MarkExpired(ctx, export.ID); err != nil {
+			logger.ErrorContext(ctx, "failed to mark data export expired", "export_id", export.ID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+// build writes the archive to a temporary file, uploads it and records it on the export
+func (s *DataExportJobService) build(ctx context.Context, export *exportmodels.DataExport) error {
+	file, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary archive: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	if err := s.writeArchive(ctx, file, export.UserID); err != nil {
+		return err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("failed to measure archive: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind archive: %w", err)
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.zip", export.UserID.String(), export.ID.String())
+	if err := s.storage.UploadObject(ctx, key, file, "application/zip"); err != nil {
+		return err
+	}
+
+	return s.exportRepo.Complete(ctx, export.ID, key, size, time.Now().Add(s.retention))
+}
+
+// writeArchive writes the user's data as JSON files plus their uploaded images
+func (s *DataExportJobService) writeArchive(ctx context.Context, w io.Writer, userID pgtype.UUID) error {
+	data, err := s.exporter.ExportUserData(ctx, userID.String())
+	if err != nil {
+		return err
+	}
+
+	reservations, err := s.exportReservations(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	uploads, err := s.imageRepo.ListImageUploads(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	auditLog, err := s.exportAuditLog(ctx, userID, uploads)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", data["user"]},
+		{"wishlists.json", data["wishlists"]},
+		{"reservations.json", reservations},
+		{"audit_log.json", auditLog},
+	}
+	for _, f := range files {
+		if err := writeJSONFile(archive, f.name, f.content); err != nil {
+			return err
+		}
+	}
+
+	images := s.writeImages(ctx, archive, imageURLs(data, uploads))
+
+	manifest := map[string]any{
+		"user_id":     userID.String(),
+		"exported_at": time.Now().Format(time.RFC3339),
+		"files":       []string{"profile.json", "wishlists.json", "reservations.json", "audit_log.json"},
+		"images":      images,
+	}
+	if err := writeJSONFile(archive, "manifest.json", manifest); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+// exportReservations returns every reservation the user made, across all pages
+func (s *DataExportJobService) exportReservations(ctx context.Context, userID pgtype.UUID) ([]map[string]any, error) {
+	reservations := make([]map[string]any, 0)
+	for offset := 0; ; offset += dataExportReservationPage {
+		page, err := s.reservationRepo.ListUserReservationsWithDetails(ctx, userID, dataExportReservationPage, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list reservations: %w", err)
+		}
+
+		for _, r := range page {
+			reservations = append(reservations, map[string]any{
+				"id":             r.ID.String(),
+				"gift_item_id":   r.GiftItemID.String(),
+				"gift_item_name": r.GiftItemName.String,
+				"wishlist_id":    r.WishlistID.String(),
+				"wishlist_title": r.WishlistTitle.String,
+				"status":         r.Status,
+				"quantity":       r.Quantity,
+				"variant":        r.Variant,
+				"message":        r.Message.String,
+				"price":          database.NumericToFloat64(r.GiftItemPrice),
+				"reserved_at":    formatExportTime(r.ReservedAt),
+				"expires_at":     formatExportTime(r.ExpiresAt),
+				"canceled_at":    formatExportTime(r.CanceledAt),
+				"cancel_reason":  r.CancelReason.String,
+			})
+		}
+
+		if len(page) < dataExportReservationPage {
+			return reservations, nil
+		}
+	}
+}
+
+// exportAuditEvent is one entry of audit_log.json
+type exportAuditEvent struct {
+	At      time.Time      `json:"at"`
+	Event   string         `json:"event"`
+	Details map[string]any `json:"details"`
+}
+
+// exportAuditLog lists account events recorded in the database, oldest first:
+// API token activity, image uploads and earlier data exports
+func (s *DataExportJobService) exportAuditLog(ctx context.Context, userID pgtype.UUID, uploads []*quotamodels.ImageUpload) ([]exportAuditEvent, error) {
+	events := make([]exportAuditEvent, 0)
+	record := func(at pgtype.Timestamptz, event string, details map[string]any) {
+		if at.Valid {
+			events = append(events, exportAuditEvent{At: at.Time, Event: event, Details: details})
+		}
+	}
+
+	tokens, err := s.apiTokenRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api tokens: %w", err)
+	}
+	for _, t := range tokens {
+		details := map[string]any{"name": t.Name, "token_prefix": t.TokenPrefix, "scopes": t.ScopeList()}
+		record(t.CreatedAt, "api_token_created", details)
+		record(t.LastUsedAt, "api_token_last_used", details)
+		record(t.RevokedAt, "api_token_revoked", details)
+	}
+
+	for _, u := range uploads {
+		record(u.CreatedAt, "image_uploaded", map[string]any{"url": u.URL})
+	}
+
+	exports, err := s.exportRepo.ListByUser(ctx, userID, 100)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data exports: %w", err)
+	}
+	for _, e := range exports {
+		record(e.CreatedAt, "data_export_requested", map[string]any{"export_id": e.ID.String(), "status": e.Status})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+	return events, nil
+}
+
+// writeImages copies the user's images stored in the bucket into images/. Images
+// that cannot be read or are too large are listed as skipped rather than failing
+// the export.
+func (s *DataExportJobService) writeImages(ctx context.Context, archive *zip.Writer, urls []string) []map[string]any {
+	written := make(map[string]bool)
+	images := make([]map[string]any, 0)
+
+	for _, rawURL := range urls {
+		key, ok := s.storage.KeyFromURL(rawURL)
+		if !ok || written[key] {
+			continue
+		}
+		written[key] = true
+
+		name := fmt.Sprintf("images/%03d-%s", len(images)+1, path.Base(key))
+		entry := map[string]any{"url": rawURL, "file": name}
+		if err := s.copyImage(ctx, archive, key, name); err != nil {
+			logger.WarnContext(ctx, "skipping image in data export", "key", key, "error", err)
+			entry["file"] = nil
+			entry["skipped"] = true
+		}
+		images = append(images, entry)
+	}
+
+	return images
+}
+
+func (s *DataExportJobService) copyImage(ctx context.Context, archive *zip.Writer, key, name string) error {
+	body, err := s.storage.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// Read fully before adding the entry so a failed download leaves no partial file
+	content, err := io.ReadAll(io.LimitReader(body, dataExportMaxImageBytes+1))
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(content) > dataExportMaxImageBytes {
+		return fmt.Errorf("image exceeds %d bytes", dataExportMaxImageBytes)
+	}
+
+	// Images are already compressed
+	f, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to add image to archive: %w", err)
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+// imageURLs returns the user's uploads followed by their gift items' image URLs
+func imageURLs(data map[string]any, uploads []*quotamodels.ImageUpload) []string {
+	urls := make([]string, 0, len(uploads))
+	for _, u := range uploads {
+		urls = append(urls, u.URL)
+	}
+
+	wishLists, _ := data["wishlists"].([]map[string]any)
+	for _, wl := range wishLists {
+		items, _ := wl["gift_items"].([]map[string]any)
+		for _, item := range items {
+			if url, _ := item["image_url"].(string); url != "" {
+				urls = append(urls, url)
+			}
+		}
+	}
+
+	return urls
+}
+
+func writeJSONFile(archive *zip.Writer, name string, content any) error {
+	f, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func formatExportTime(t pgtype.Timestamptz) any {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+// RunScheduledExports builds queued exports and deletes expired archives every
+// dataExportInterval until ctx is canceled. It blocks, so callers start it in a
+// goroutine. A run in progress when ctx is canceled is finished.
+func (s *DataExportJobService) RunScheduledExports(ctx context.Context) {
+	ticker := time.NewTicker(dataExportInterval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled data export job started", "interval", dataExportInterval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.ProcessPending(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to process data exports", "error", err)
+			}
+			if err := s.DeleteExpired(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to delete expired data exports", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("data export job stopped")
+			return
+		}
+	}
+}
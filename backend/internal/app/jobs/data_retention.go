@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by DataRetentionService
+
+// RetentionEnforcerInterface defines retention service methods needed by the retention job
+type RetentionEnforcerInterface interface {
+	EnforcePolicies(ctx context.Context) (int64, error)
+}
+
+// DataRetentionService anonymizes or deletes data past the retention period of
+// its class
+type DataRetentionService struct {
+	enforcer RetentionEnforcerInterface
+	interval time.Duration
+}
+
+// NewDataRetentionService creates a job enforcing retention policies every interval
+func NewDataRetentionService(enforcer RetentionEnforcerInterface, interval time.Duration) *DataRetentionService {
+	return &DataRetentionService{
+		enforcer: enforcer,
+		interval: interval,
+	}
+}
+
+// RunScheduledRetention enforces the policies right away and then every interval
+// until ctx is canceled. It blocks, so callers start it in a goroutine.
+func (s *DataRetentionService) RunScheduledRetention(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled data retention started", "interval", s.interval.String())
+
+	s.enforce(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			s.enforce(ctx)
+		case <-ctx.Done():
+			logger.Info("data retention stopped")
+			return
+		}
+	}
+}
+
+func (s *DataRetentionService) enforce(ctx context.Context) {
+	records, err := s.enforcer.EnforcePolicies(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "failed to enforce retention policies", "error", err)
+		}
+		return
+	}
+	if records > 0 {
+		logger.InfoContext(ctx, "expired data anonymized or deleted", "records", records)
+	}
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by DiscoveryRefreshService
+
+// DiscoveryRefresherInterface defines discovery service methods needed by the refresh job
+type DiscoveryRefresherInterface interface {
+	RefreshTrending(ctx context.Context) error
+	RefreshSitemap(ctx context.Context) error
+}
+
+// DiscoveryRefreshService keeps the cached trending wishlists and sitemap current,
+// so public requests and crawlers rarely have to wait for them to be computed
+type DiscoveryRefreshService struct {
+	refresher DiscoveryRefresherInterface
+	interval  time.Duration
+}
+
+// NewDiscoveryRefreshService creates a job refreshing trending wishlists and the
+// sitemap every interval
+func NewDiscoveryRefreshService(refresher DiscoveryRefresherInterface, interval time.Duration) *DiscoveryRefreshService {
+	return &DiscoveryRefreshService{
+		refresher: refresher,
+		interval:  interval,
+	}
+}
+
+// RunScheduledRefresh refreshes right away and then every interval until ctx is
+// canceled. It blocks, so callers start it in a goroutine.
+func (s *DiscoveryRefreshService) RunScheduledRefresh(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled discovery refresh started", "interval", s.interval.String())
+
+	s.refresh(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			s.refresh(ctx)
+		case <-ctx.Done():
+			logger.Info("discovery refresh stopped")
+			return
+		}
+	}
+}
+
+func (s *DiscoveryRefreshService) refresh(ctx context.Context) {
+	if err := s.refresher.RefreshTrending(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.ErrorContext(ctx, "failed to refresh trending wishlists", "error", err)
+	}
+	if err := s.refresher.RefreshSitemap(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.ErrorContext(ctx, "failed to refresh sitemap", "error", err)
+	}
+}
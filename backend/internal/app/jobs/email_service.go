@@ -1,12 +1,16 @@
 package jobs
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"html/template"
-	"log"
+	"strings"
 	"time"
+
+	"wish-list/internal/pkg/emailtemplate"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/mailer"
+	"wish-list/internal/pkg/requestid"
 )
 
 // InactivityNotificationType represents the type of inactivity notification
@@ -19,80 +23,149 @@ const (
 	InactivityWarningFinal InactivityNotificationType = "final_warning"
 )
 
+// Email template names, see internal/pkg/emailtemplate/templates
+const (
+	emailAccountInactivity       = "account_inactivity"
+	emailReservationCancellation = "reservation_cancellation"
+	emailReservationRemoved      = "reservation_removed"
+	emailItemReceived            = "item_received"
+	emailGiftPurchased           = "gift_purchased"
+	emailPrivacyVerification     = "privacy_verification"
+	emailGuestConfirmation       = "guest_reservation_confirmation"
+	emailGuestDataExport         = "guest_data_export"
+	emailNewComment              = "new_comment"
+	emailNewSuggestion           = "new_suggestion"
+	emailSuggestionDecision      = "suggestion_decision"
+	emailWishlistRollover        = "wishlist_rollover"
+	emailOccasionReminder        = "occasion_reminder"
+	emailWeeklyDigest            = "weekly_digest"
+	emailPurchaseReminder        = "purchase_reminder"
+	emailWishlistInvite          = "wishlist_invite"
+)
+
 // EmailServiceInterface defines the interface for email operations
 type EmailServiceInterface interface {
 	SendReservationCancellationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error
 	SendReservationRemovedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error
-	SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName string) error
+	SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName, message string) error
 	SendAccountInactivityNotification(ctx context.Context, recipientEmail, userName string, notificationType InactivityNotificationType) error
 	ScheduleAccountCleanupNotifications(ctx context.Context) // Schedules periodic checks for inactive accounts
 }
 
 type EmailService struct {
-	// In a real implementation, this would contain SMTP configuration, etc.
+	sender    mailer.Sender
+	from      string
+	templates *emailtemplate.Set
+	failures  FailedEmailStoreInterface
 }
 
-func NewEmailService() *EmailService {
-	return &EmailService{}
+// NewEmailService sends emails through sender with from as the sender address.
+// Emails that cannot be sent are kept in failures for a later resend; failures
+// may be nil.
+func NewEmailService(sender mailer.Sender, from string, failures FailedEmailStoreInterface) *EmailService {
+	return &EmailService{sender: sender, from: from, templates: emailtemplate.Embedded(), failures: failures}
+}
+
+// send renders the email template name with data and delivers it to recipient.
+// Recipients have no stored language yet, so every email uses the default locale.
+func (s *EmailService) send(ctx context.Context, recipient, name string, data any, attachments ...mailer.Attachment) error {
+	msg, err := s.templates.Render(name, emailtemplate.DefaultLocale, data)
+	if err != nil {
+		return fmt.Errorf("failed to build email body: %w", err)
+	}
+	return s.deliver(ctx, &mailer.Message{
+		From:        s.from,
+		To:          recipient,
+		Subject:     msg.Subject,
+		HTML:        msg.HTML,
+		Text:        msg.Text,
+		Attachments: attachments,
+	}, name)
+}
+
+// deliver sends a rendered email. Emails triggered by an HTTP request carry its
+// ID in an X-Request-ID header and a reference line, so support can match a
+// forwarded email to the server logs.
+func (s *EmailService) deliver(ctx context.Context, msg *mailer.Message, name string) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = map[string]string{requestid.Header: id}
+		msg.HTML = withReference(msg.HTML, id)
+		msg.Text += "\nReference: " + id + "\n"
+	}
+
+	if err := s.sender.Send(ctx, msg); err != nil {
+		if s.failures != nil {
+			if recordErr := s.failures.Record(ctx, name, msg); recordErr != nil {
+				logger.ErrorContext(ctx, "failed to keep email for resending", "template", name, "error", recordErr)
+			}
+		}
+		return fmt.Errorf("failed to send %s email: %w", name, err)
+	}
+
+	// Do not log PII (email addresses), body content or attachment content
+	logger.InfoContext(ctx, "email sent", "template", name, "subject", msg.Subject, "attachments", len(msg.Attachments))
+	return nil
+}
+
+// withReference appends a support reference line to an HTML email body
+func withReference(body, requestID string) string {
+	reference := fmt.Sprintf(`<p style="color:#888888;font-size:12px">Reference: %s</p>`, template.HTMLEscapeString(requestID))
+	if i := strings.LastIndex(body, "</body>"); i >= 0 {
+		return body[:i] + reference + "\n" + body[i:]
+	}
+	return body + reference
 }
 
 type ReservationCancellationEmailData struct {
-	GiftItemName  string
-	WishlistTitle string
+	GiftItemName  string `validate:"required"`
+	WishlistTitle string `validate:"required"`
 }
 
 type ReservationRemovedEmailData struct {
-	GiftItemName  string
-	WishlistTitle string
+	GiftItemName  string `validate:"required"`
+	WishlistTitle string `validate:"required"`
+}
+
+type ItemReceivedEmailData struct {
+	GiftItemName  string `validate:"required"`
+	WishlistTitle string `validate:"required"`
 }
 
 type AccountInactivityNotificationData struct {
 	UserName          string
-	NotificationType  InactivityNotificationType
-	DaysUntilDeletion int
+	NotificationType  InactivityNotificationType `validate:"oneof=23_month_warning final_warning"`
+	DaysUntilDeletion int                        `validate:"gt=0"`
 	IsUrgent          bool
 }
 
+// GiftPurchasedConfirmationEmailData leaves WishlistTitle optional: the
+// confirmation still goes out when the wishlist could not be loaded.
 type GiftPurchasedConfirmationEmailData struct {
-	GiftItemName  string
+	GiftItemName  string `validate:"required"`
 	WishlistTitle string
 	GuestName     string
+	Message       string
 }
 
 func (s *EmailService) SendAccountInactivityNotification(ctx context.Context, recipientEmail, userName string, notificationType InactivityNotificationType) error {
-	var subject string
-	var daysUntilDeletion int
-	var isUrgent bool
-
+	data := AccountInactivityNotificationData{UserName: userName, NotificationType: notificationType}
 	switch notificationType {
 	case InactivityWarning23Month:
-		subject = "Account inactivity notice - scheduled deletion in 30 days"
-		daysUntilDeletion = 30
-		isUrgent = false
+		data.DaysUntilDeletion = 30
 	case InactivityWarningFinal:
-		subject = "URGENT: Account will be deleted in 7 days"
-		daysUntilDeletion = 7
-		isUrgent = true
+		data.DaysUntilDeletion = 7
+		data.IsUrgent = true
 	default:
 		return fmt.Errorf("unknown notification type: %s", notificationType)
 	}
 
-	_, err := s.buildAccountInactivityNotification(userName, notificationType, daysUntilDeletion, isUrgent)
-	if err != nil {
-		return fmt.Errorf("failed to build email body: %w", err)
-	}
-
-	// In a real implementation, this would send the email via SMTP
-	// Do not log PII (email addresses) or full body content
-	log.Printf("Email send simulated: subject=%q type=%s (recipient redacted)", subject, notificationType)
-
-	return nil
+	return s.send(ctx, recipientEmail, emailAccountInactivity, data)
 }
 
 func (s *EmailService) ScheduleAccountCleanupNotifications(ctx context.Context) {
 	// In a real implementation, this would schedule periodic checks for inactive accounts
 	// For example, it could run daily to check for accounts that will be deleted in 30 days
-	log.Println("Scheduling account cleanup notifications...")
+	logger.Info("scheduling account cleanup notifications")
 
 	go func() {
 		ticker := time.NewTicker(24 * time.Hour) // Run once per day
@@ -104,214 +177,256 @@ func (s *EmailService) ScheduleAccountCleanupNotifications(ctx context.Context)
 				// This would call a method to check for inactive accounts and send notifications
 				// In a real implementation, this would query the database for accounts that are approaching
 				// the 2-year inactivity threshold and send notifications to their owners
-				log.Println("Checking for accounts approaching inactivity deletion...")
+				logger.Info("checking for accounts approaching inactivity deletion")
 			case <-ctx.Done():
-				log.Println("Stopping account cleanup notification scheduler...")
+				logger.Info("stopping account cleanup notification scheduler")
 				return
 			}
 		}
 	}()
 }
 
-func (s *EmailService) buildAccountInactivityNotification(userName string, notificationType InactivityNotificationType, daysUntilDeletion int, isUrgent bool) (string, error) {
-	tmpl := `
-		<!DOCTYPE html>
-		<html>
-		<head>
-			<title>Account inactivity notice</title>
-		</head>
-		<body>
-			{{if .IsUrgent}}
-			<h2 style="color: #d32f2f;">⚠️ URGENT: Account Deletion Warning</h2>
-			{{else}}
-			<h2>Account inactivity notice</h2>
-			{{end}}
-			<p>Hello {{.UserName}},</p>
-			{{if .IsUrgent}}
-			<p><strong>This is your final warning.</strong> Your wish list account has been inactive for nearly 2 years.</p>
-			<p style="color: #d32f2f;"><strong>Your account and all associated wish lists will be permanently deleted in {{.DaysUntilDeletion}} days if no activity is detected.</strong></p>
-			<p>This is the last notification you will receive before deletion.</p>
-			{{else}}
-			<p>This is a courtesy notice that your wish list account has been inactive for an extended period (23 months).</p>
-			<p>Due to our data retention policy, your account and associated wish lists will be automatically deleted in {{.DaysUntilDeletion}} days if no activity is detected.</p>
-			<p>You will receive one more reminder 7 days before deletion.</p>
-			{{end}}
-			<p><strong>To prevent deletion, please log in to your account before this period ends.</strong></p>
-			<p>Any activity on your account (logging in, viewing wish lists, adding items, etc.) will reset the inactivity timer.</p>
-			<p>If you have any questions, please contact our support team.</p>
-			<p>Thank you for using our wish list service.</p>
-		</body>
-		</html>
-	`
-
-	t, err := template.New("accountInactivity").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
+func (s *EmailService) SendReservationCancellationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
+	return s.send(ctx, recipientEmail, emailReservationCancellation, ReservationCancellationEmailData{
+		GiftItemName:  giftItemName,
+		WishlistTitle: wishlistTitle,
+	})
+}
 
-	var buf bytes.Buffer
-	data := AccountInactivityNotificationData{
-		UserName:          userName,
-		NotificationType:  notificationType,
-		DaysUntilDeletion: daysUntilDeletion,
-		IsUrgent:          isUrgent,
-	}
+func (s *EmailService) SendReservationRemovedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
+	return s.send(ctx, recipientEmail, emailReservationRemoved, ReservationRemovedEmailData{
+		GiftItemName:  giftItemName,
+		WishlistTitle: wishlistTitle,
+	})
+}
 
-	err = t.Execute(&buf, data)
-	if err != nil {
-		return "", err
-	}
+// SendItemReceivedEmail tells a giver that the owner got the item they reserved
+// elsewhere, so their reservation was released
+func (s *EmailService) SendItemReceivedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
+	return s.send(ctx, recipientEmail, emailItemReceived, ItemReceivedEmailData{
+		GiftItemName:  giftItemName,
+		WishlistTitle: wishlistTitle,
+	})
+}
+
+// SendGiftPurchasedConfirmationEmail thanks the giver once the owner confirms
+// the purchase. message is the note the giver left when reserving, if any.
+func (s *EmailService) SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName, message string) error {
+	return s.send(ctx, recipientEmail, emailGiftPurchased, GiftPurchasedConfirmationEmailData{
+		GiftItemName:  giftItemName,
+		WishlistTitle: wishlistTitle,
+		GuestName:     guestName,
+		Message:       message,
+	})
+}
 
-	return buf.String(), nil
+type PrivacyVerificationEmailData struct {
+	RequestType       string `validate:"required"`
+	VerificationToken string `validate:"required"`
 }
 
-func (s *EmailService) SendReservationCancellationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
-	subject := "Your reservation has been canceled"
-	_, err := s.buildReservationCancellationEmail(giftItemName, wishlistTitle)
-	if err != nil {
-		return fmt.Errorf("failed to build email body: %w", err)
-	}
+type GuestReservationConfirmationEmailData struct {
+	GiftItemName      string `validate:"required"`
+	ConfirmationToken string `validate:"required"`
+}
 
-	// In a real implementation, this would send the email via SMTP
-	// Do not log PII (email addresses) or full body content
-	log.Printf("Email send simulated: subject=%q (recipient redacted)", subject)
+// GuestDataExportEmailData is empty; the export itself is the attachment
+type GuestDataExportEmailData struct{}
 
-	return nil
+// SendPrivacyVerificationEmail asks a guest to confirm ownership of the email address
+// before a privacy (erasure/export) request is queued for review
+func (s *EmailService) SendPrivacyVerificationEmail(ctx context.Context, recipientEmail, requestType, verificationToken string) error {
+	return s.send(ctx, recipientEmail, emailPrivacyVerification, PrivacyVerificationEmailData{
+		RequestType:       requestType,
+		VerificationToken: verificationToken,
+	})
 }
 
-func (s *EmailService) SendReservationRemovedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
-	subject := "Your reserved gift item has been removed"
-	_, err := s.buildReservationRemovedEmail(giftItemName, wishlistTitle)
-	if err != nil {
-		return fmt.Errorf("failed to build email body: %w", err)
-	}
+// SendGuestReservationConfirmationEmail asks a guest to confirm a reservation that
+// abuse scoring held back
+func (s *EmailService) SendGuestReservationConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, confirmationToken string) error {
+	return s.send(ctx, recipientEmail, emailGuestConfirmation, GuestReservationConfirmationEmailData{
+		GiftItemName:      giftItemName,
+		ConfirmationToken: confirmationToken,
+	})
+}
 
-	// In a real implementation, this would send the email via SMTP
-	// Do not log PII (email addresses) or full body content
-	log.Printf("Email send simulated: subject=%q (recipient redacted)", subject)
+// SendGuestDataExportEmail delivers a guest's exported reservation data as a JSON attachment
+func (s *EmailService) SendGuestDataExportEmail(ctx context.Context, recipientEmail string, exportJSON []byte) error {
+	return s.send(ctx, recipientEmail, emailGuestDataExport, GuestDataExportEmailData{}, mailer.Attachment{
+		Filename:    "data-export.json",
+		ContentType: "application/json",
+		Data:        exportJSON,
+	})
+}
 
-	return nil
+type NewCommentEmailData struct {
+	GiftItemName  string `validate:"required"`
+	WishlistTitle string `validate:"required"`
+	AuthorName    string `validate:"required"`
 }
 
-func (s *EmailService) SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName string) error {
-	subject := "Gift Purchased - Thank you!"
-	_, err := s.buildGiftPurchasedConfirmationEmail(giftItemName, wishlistTitle, guestName)
-	if err != nil {
-		return fmt.Errorf("failed to build email body: %w", err)
-	}
+// SendNewCommentEmail tells a wishlist owner that someone commented on one of their items
+func (s *EmailService) SendNewCommentEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, authorName string) error {
+	return s.send(ctx, recipientEmail, emailNewComment, NewCommentEmailData{
+		GiftItemName:  giftItemName,
+		WishlistTitle: wishlistTitle,
+		AuthorName:    authorName,
+	})
+}
 
-	// In a real implementation, this would send the email via SMTP
-	// Do not log PII (email addresses) or full body content
-	log.Printf("Email send simulated: subject=%q (recipient redacted)", subject)
+type NewSuggestionEmailData struct {
+	SuggestionName string `validate:"required"`
+	WishlistTitle  string `validate:"required"`
+	SuggesterName  string `validate:"required"`
+}
 
-	return nil
+type SuggestionDecisionEmailData struct {
+	SuggestionName string `validate:"required"`
+	WishlistTitle  string `validate:"required"`
+	Accepted       bool
 }
 
-func (s *EmailService) buildReservationCancellationEmail(giftItemName, wishlistTitle string) (string, error) {
-	tmpl := `
-		<!DOCTYPE html>
-		<html>
-		<head>
-			<title>Your reservation has been canceled</title>
-		</head>
-		<body>
-			<h2>Your reservation has been canceled</h2>
-			<p>Hello,</p>
-			<p>We wanted to inform you that your reservation for the gift item "{{.GiftItemName}}" from the wish list "{{.WishlistTitle}}" has been canceled.</p>
-			<p>If you believe this was done in error, please contact the wish list owner.</p>
-			<p>Thank you for using our wish list service.</p>
-		</body>
-		</html>
-	`
-
-	t, err := template.New("reservationCancellation").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
+// SendNewSuggestionEmail tells a wishlist owner that someone proposed an item for their wish list
+func (s *EmailService) SendNewSuggestionEmail(ctx context.Context, recipientEmail, suggestionName, wishlistTitle, suggesterName string) error {
+	return s.send(ctx, recipientEmail, emailNewSuggestion, NewSuggestionEmailData{
+		SuggestionName: suggestionName,
+		WishlistTitle:  wishlistTitle,
+		SuggesterName:  suggesterName,
+	})
+}
 
-	var buf bytes.Buffer
-	data := ReservationCancellationEmailData{
-		GiftItemName:  giftItemName,
-		WishlistTitle: wishlistTitle,
-	}
+// SendSuggestionDecisionEmail tells a registered suggester whether the owner accepted their suggestion
+func (s *EmailService) SendSuggestionDecisionEmail(ctx context.Context, recipientEmail, suggestionName, wishlistTitle string, accepted bool) error {
+	return s.send(ctx, recipientEmail, emailSuggestionDecision, SuggestionDecisionEmailData{
+		SuggestionName: suggestionName,
+		WishlistTitle:  wishlistTitle,
+		Accepted:       accepted,
+	})
+}
 
-	err = t.Execute(&buf, data)
-	if err != nil {
-		return "", err
-	}
+type WishlistRolloverEmailData struct {
+	WishlistTitle string `validate:"required"`
+	OccasionDate  string `validate:"required"`
+	CarriedOver   int64  `validate:"gte=0"`
+}
 
-	return buf.String(), nil
-}
-
-func (s *EmailService) buildReservationRemovedEmail(giftItemName, wishlistTitle string) (string, error) {
-	tmpl := `
-		<!DOCTYPE html>
-		<html>
-		<head>
-			<title>Your reserved gift item has been removed</title>
-		</head>
-		<body>
-			<h2>Your reserved gift item has been removed</h2>
-			<p>Hello,</p>
-			<p>We wanted to inform you that the gift item "{{.GiftItemName}}" from the wish list "{{.WishlistTitle}}" that you had reserved has been removed by the wish list owner.</p>
-			<p>Your reservation is no longer valid. You may want to consider other gift items on the list.</p>
-			<p>Thank you for using our wish list service.</p>
-		</body>
-		</html>
-	`
-
-	t, err := template.New("reservationRemoved").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
+// SendWishlistRolloverEmail asks an owner to review the wishlist created for the next occurrence of their occasion
+func (s *EmailService) SendWishlistRolloverEmail(ctx context.Context, recipientEmail, wishlistTitle string, occasionDate time.Time, carriedOver int64) error {
+	return s.send(ctx, recipientEmail, emailWishlistRollover, WishlistRolloverEmailData{
+		WishlistTitle: wishlistTitle,
+		OccasionDate:  occasionDate.Format("January 2, 2006"),
+		CarriedOver:   carriedOver,
+	})
+}
 
-	var buf bytes.Buffer
-	data := ReservationRemovedEmailData{
-		GiftItemName:  giftItemName,
+type OccasionReminderEmailData struct {
+	WishlistTitle string `validate:"required"`
+	OccasionDate  string `validate:"required"`
+	Attending     []string
+	NotAttending  int `validate:"gte=0"`
+}
+
+// SendOccasionReminderEmail reminds an owner of an upcoming occasion and lists who said they are coming
+func (s *EmailService) SendOccasionReminderEmail(ctx context.Context, recipientEmail, wishlistTitle string, occasionDate time.Time, attending []string, notAttending int) error {
+	return s.send(ctx, recipientEmail, emailOccasionReminder, OccasionReminderEmailData{
 		WishlistTitle: wishlistTitle,
-	}
+		OccasionDate:  occasionDate.Format("January 2, 2006"),
+		Attending:     attending,
+		NotAttending:  notAttending,
+	})
+}
 
-	err = t.Execute(&buf, data)
-	if err != nil {
-		return "", err
-	}
+type WeeklyDigestEmailData struct {
+	PeriodStart     string `validate:"required"`
+	PeriodEnd       string `validate:"required"`
+	NewViews        int
+	NewReservations int
+	Purchases       int
+	NewComments     int
+	NewSuggestions  int
+	Upcoming        []WeeklyDigestOccasion `validate:"dive"`
+}
 
-	return buf.String(), nil
-}
-
-func (s *EmailService) buildGiftPurchasedConfirmationEmail(giftItemName, wishlistTitle, guestName string) (string, error) {
-	tmpl := `
-		<!DOCTYPE html>
-		<html>
-		<head>
-			<title>Gift Purchased - Thank you!</title>
-		</head>
-		<body>
-			<h2>Gift Purchased - Thank you {{.GuestName}}!</h2>
-			<p>Hello {{.GuestName}},</p>
-			<p>Great news! The wish list owner has confirmed that the gift item "{{.GiftItemName}}" from the wish list "{{.WishlistTitle}}" has been purchased.</p>
-			<p>Thank you for your thoughtful gift! The recipient will be delighted.</p>
-			<p>Thank you for using our wish list service.</p>
-		</body>
-		</html>
-	`
-
-	t, err := template.New("giftPurchased").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
+// WeeklyDigestOccasion is an upcoming occasion listed in the weekly digest
+type WeeklyDigestOccasion struct {
+	WishlistTitle string `validate:"required"`
+	OccasionDate  string `validate:"required"`
+}
 
-	var buf bytes.Buffer
-	data := GiftPurchasedConfirmationEmailData{
-		GiftItemName:  giftItemName,
+// SendWeeklyDigestEmail sends an owner the summary of activity on their wishlists for the past week
+func (s *EmailService) SendWeeklyDigestEmail(ctx context.Context, recipientEmail string, data WeeklyDigestEmailData) error {
+	return s.send(ctx, recipientEmail, emailWeeklyDigest, data)
+}
+
+type PurchaseReminderEmailData struct {
+	GuestName     string
+	GiftItemName  string `validate:"required"`
+	WishlistTitle string `validate:"required"`
+	OccasionDate  string `validate:"required"`
+	ItemLink      string // Product page, if the owner added one
+	PurchasedLink string `validate:"required,url"` // Marks the reservation bought without signing in
+}
+
+// SendPurchaseReminderEmail reminds a guest of a gift they reserved but have not marked as bought
+func (s *EmailService) SendPurchaseReminderEmail(ctx context.Context, recipientEmail string, data PurchaseReminderEmailData) error {
+	return s.send(ctx, recipientEmail, emailPurchaseReminder, data)
+}
+
+type WishlistInviteEmailData struct {
+	WishlistTitle string `validate:"required"`
+	OwnerName     string
+	Message       string // Personal note from the owner
+	Link          string `validate:"required,url"` // Share page, tagged to track the guest's visit
+}
+
+// SendWishlistInviteEmail invites a guest to the owner's public wishlist
+func (s *EmailService) SendWishlistInviteEmail(ctx context.Context, recipientEmail, wishlistTitle, ownerName, message, link string) error {
+	return s.send(ctx, recipientEmail, emailWishlistInvite, WishlistInviteEmailData{
 		WishlistTitle: wishlistTitle,
-		GuestName:     guestName,
-	}
+		OwnerName:     ownerName,
+		Message:       message,
+		Link:          link,
+	})
+}
 
-	err = t.Execute(&buf, data)
-	if err != nil {
-		return "", err
+// EmailPreviews returns sample data for every email template, keyed by name, for
+// the development preview endpoint
+func EmailPreviews() map[string]any {
+	occasion := time.Now().AddDate(0, 0, 14).Format("January 2, 2006")
+	return map[string]any{
+		emailAccountInactivity: AccountInactivityNotificationData{
+			UserName: "Alex Smith", NotificationType: InactivityWarningFinal, DaysUntilDeletion: 7, IsUrgent: true,
+		},
+		emailReservationCancellation: ReservationCancellationEmailData{GiftItemName: "Espresso machine", WishlistTitle: "Housewarming"},
+		emailReservationRemoved:      ReservationRemovedEmailData{GiftItemName: "Espresso machine", WishlistTitle: "Housewarming"},
+		emailItemReceived:            ItemReceivedEmailData{GiftItemName: "Espresso machine", WishlistTitle: "Housewarming"},
+		emailGiftPurchased: GiftPurchasedConfirmationEmailData{
+			GiftItemName: "Espresso machine", WishlistTitle: "Housewarming", GuestName: "Jamie", Message: "Enjoy the coffee!",
+		},
+		emailPrivacyVerification: PrivacyVerificationEmailData{RequestType: "erasure", VerificationToken: "482913"},
+		emailGuestConfirmation: GuestReservationConfirmationEmailData{
+			GiftItemName: "Espresso machine", ConfirmationToken: "0c8f5b4e-6a2d-4f1b-9d3e-2b7a1c5e8f90",
+		},
+		emailGuestDataExport:    GuestDataExportEmailData{},
+		emailNewComment:         NewCommentEmailData{GiftItemName: "Espresso machine", WishlistTitle: "Housewarming", AuthorName: "Jamie"},
+		emailNewSuggestion:      NewSuggestionEmailData{SuggestionName: "Milk frother", WishlistTitle: "Housewarming", SuggesterName: "Jamie"},
+		emailSuggestionDecision: SuggestionDecisionEmailData{SuggestionName: "Milk frother", WishlistTitle: "Housewarming", Accepted: true},
+		emailWishlistRollover:   WishlistRolloverEmailData{WishlistTitle: "Birthday", OccasionDate: occasion, CarriedOver: 3},
+		emailOccasionReminder: OccasionReminderEmailData{
+			WishlistTitle: "Birthday", OccasionDate: occasion, Attending: []string{"Jamie", "Sam"}, NotAttending: 1,
+		},
+		emailWeeklyDigest: WeeklyDigestEmailData{
+			PeriodStart: "March 2", PeriodEnd: "March 9", NewViews: 42, NewReservations: 3, Purchases: 1, NewComments: 2, NewSuggestions: 1,
+			Upcoming: []WeeklyDigestOccasion{{WishlistTitle: "Birthday", OccasionDate: occasion}},
+		},
+		emailPurchaseReminder: PurchaseReminderEmailData{
+			GuestName: "Jamie", GiftItemName: "Espresso machine", WishlistTitle: "Housewarming", OccasionDate: occasion,
+			ItemLink: "https://shop.example.com/espresso", PurchasedLink: "https://app.example.com/reservations/purchased?token=sample",
+		},
+		emailWishlistInvite: WishlistInviteEmailData{
+			WishlistTitle: "Housewarming", OwnerName: "Alex", Message: "We finally moved in, come see the place!",
+			Link: "https://app.example.com/public/housewarming?invite=sample",
+		},
 	}
-
-	return buf.String(), nil
 }
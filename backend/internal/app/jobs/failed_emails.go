@@ -0,0 +1,182 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/mailer"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MaxEmailSendAttempts is how often an email is tried before it is dropped from
+// the failed email store
+const MaxEmailSendAttempts = 5
+
+// FailedEmailStoreInterface keeps emails the providers did not accept so they
+// can be resent later
+type FailedEmailStoreInterface interface {
+	Record(ctx context.Context, template string, msg *mailer.Message) error
+}
+
+// FailedEmail is an email waiting to be resent
+type FailedEmail struct {
+	ID       pgtype.UUID
+	Template string
+	Message  *mailer.Message
+	Attempts int
+}
+
+// FailedEmailStore stores failed emails in the failed_emails table. The message
+// holds the recipient's address, so it is encrypted when encryptionSvc is set.
+type FailedEmailStore struct {
+	db            *database.DB
+	encryptionSvc *encryption.Service
+}
+
+// NewFailedEmailStore creates a failed email store. encryptionSvc may be nil, in
+// which case messages are stored in plaintext.
+func NewFailedEmailStore(db *database.DB, encryptionSvc *encryption.Service) *FailedEmailStore {
+	return &FailedEmailStore{
+		db:            db,
+		encryptionSvc: encryptionSvc,
+	}
+}
+
+// Record stores an email that could not be sent
+func (s *FailedEmailStore) Record(ctx context.Context, template string, msg *mailer.Message) error {
+	encoded, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode email: %w", err)
+	}
+
+	var message, encryptedMessage pgtype.Text
+	if s.encryptionSvc != nil {
+		ciphertext, err := s.encryptionSvc.Encrypt(ctx, string(encoded))
+		if err != nil {
+			return fmt.Errorf("failed to encrypt email: %w", err)
+		}
+		encryptedMessage = pgtype.Text{String: ciphertext, Valid: true}
+	} else {
+		message = pgtype.Text{String: string(encoded), Valid: true}
+	}
+
+	query := `INSERT INTO failed_emails (template, message, encrypted_message) VALUES ($1, $2, $3)`
+	if _, err := s.db.ExecContext(ctx, query, template, message, encryptedMessage); err != nil {
+		return fmt.Errorf("failed to record failed email: %w", err)
+	}
+
+	return nil
+}
+
+// ListPending returns up to limit failed emails, oldest first
+func (s *FailedEmailStore) ListPending(ctx context.Context, limit int) ([]*FailedEmail, error) {
+	query := `
+		SELECT id, template, message, encrypted_message, attempts
+		FROM failed_emails
+		ORDER BY created_at
+		LIMIT $1
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed emails: %w", err)
+	}
+	defer rows.Close()
+
+	var emails []*FailedEmail
+	for rows.Next() {
+		var (
+			email                     FailedEmail
+			message, encryptedMessage pgtype.Text
+		)
+		if err := rows.Scan(&email.ID, &email.Template, &message, &encryptedMessage, &email.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan failed email: %w", err)
+		}
+
+		encoded := message.String
+		if encryptedMessage.Valid {
+			if s.encryptionSvc == nil {
+				return nil, fmt.Errorf("failed email %s is encrypted but no data key is configured", email.ID.String())
+			}
+			if encoded, err = s.encryptionSvc.Decrypt(ctx, encryptedMessage.String); err != nil {
+				return nil, fmt.Errorf("failed to decrypt failed email %s: %w", email.ID.String(), err)
+			}
+		}
+		if err := json.Unmarshal([]byte(encoded), &email.Message); err != nil {
+			return nil, fmt.Errorf("failed to decode failed email %s: %w", email.ID.String(), err)
+		}
+
+		emails = append(emails, &email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate failed emails: %w", err)
+	}
+
+	return emails, nil
+}
+
+// Delete removes a failed email once it was resent or given up on
+func (s *FailedEmailStore) Delete(ctx context.Context, id pgtype.UUID) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM failed_emails WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete failed email: %w", err)
+	}
+	return nil
+}
+
+// RecordAttempt counts another failed attempt to send an email
+func (s *FailedEmailStore) RecordAttempt(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE failed_emails SET attempts = attempts + 1, updated_at = NOW() WHERE id = $1`
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to record email attempt: %w", err)
+	}
+	return nil
+}
+
+// ResendStats summarizes a resend run
+type ResendStats struct {
+	Sent    int
+	Failed  int
+	Dropped int // Emails that failed their last attempt
+}
+
+// ResendFailedEmails tries up to limit stored emails again. Sent emails are
+// removed; an email is dropped after MaxEmailSendAttempts failures.
+func ResendFailedEmails(ctx context.Context, store *FailedEmailStore, sender mailer.Sender, limit int) (ResendStats, error) {
+	var stats ResendStats
+
+	emails, err := store.ListPending(ctx, limit)
+	if err != nil {
+		return stats, err
+	}
+
+	for _, email := range emails {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		sendErr := sender.Send(ctx, email.Message)
+		switch {
+		case sendErr == nil:
+			stats.Sent++
+			err = store.Delete(ctx, email.ID)
+		case email.Attempts+1 >= MaxEmailSendAttempts:
+			stats.Dropped++
+			logger.WarnContext(ctx, "dropping email after repeated failures", "template", email.Template, "attempts", email.Attempts+1, "error", sendErr)
+			err = store.Delete(ctx, email.ID)
+		default:
+			stats.Failed++
+			logger.WarnContext(ctx, "failed to resend email", "template", email.Template, "error", sendErr)
+			err = store.RecordAttempt(ctx, email.ID)
+		}
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	return stats, nil
+}
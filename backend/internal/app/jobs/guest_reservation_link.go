@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by GuestReservationLinkService
+
+// GuestReservationLinkerInterface defines guest link service methods needed by the linking job
+type GuestReservationLinkerInterface interface {
+	LinkPending(ctx context.Context) error
+}
+
+// GuestReservationLinkService works through the queue of new accounts whose guest
+// reservations still have to be linked to them
+type GuestReservationLinkService struct {
+	linker   GuestReservationLinkerInterface
+	interval time.Duration
+}
+
+// NewGuestReservationLinkService creates a job running queued guest reservation
+// linking every interval
+func NewGuestReservationLinkService(linker GuestReservationLinkerInterface, interval time.Duration) *GuestReservationLinkService {
+	return &GuestReservationLinkService{
+		linker:   linker,
+		interval: interval,
+	}
+}
+
+// RunScheduledLinking links every interval until ctx is canceled. It blocks, so
+// callers start it in a goroutine.
+func (s *GuestReservationLinkService) RunScheduledLinking(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled guest reservation linking started", "interval", s.interval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.linker.LinkPending(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.ErrorContext(ctx, "failed to link guest reservations", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("guest reservation linking stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	notificationmodels "wish-list/internal/domain/notification/models"
+	rsvpmodels "wish-list/internal/domain/rsvp/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// occasionReminderLeadTime is how long before an occasion its owner is reminded of the RSVPs
+const occasionReminderLeadTime = 7 * 24 * time.Hour
+
+// Cross-domain interfaces — only methods used by OccasionReminderService
+
+// ReminderRSVPRepoInterface defines RSVP repo methods needed by the reminder service
+type ReminderRSVPRepoInterface interface {
+	ListDueForReminder(ctx context.Context, from, to time.Time) ([]*rsvpmodels.ReminderDue, error)
+	ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*rsvpmodels.RSVP, error)
+	MarkReminded(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error
+}
+
+// ReminderEmailSenderInterface defines email methods needed by the reminder service
+type ReminderEmailSenderInterface interface {
+	SendOccasionReminderEmail(ctx context.Context, recipientEmail, wishlistTitle string, occasionDate time.Time, attending []string, notAttending int) error
+}
+
+// ReminderNotifierInterface defines notification center methods needed by the reminder service
+type ReminderNotifierInterface interface {
+	Notify(ctx context.Context, notification notificationmodels.Notification) error
+}
+
+// OccasionReminderService emails owners ahead of an occasion with the guests' RSVPs
+type OccasionReminderService struct {
+	rsvpRepo     ReminderRSVPRepoInterface
+	userRepo     RolloverUserRepoInterface
+	emailService ReminderEmailSenderInterface
+	notifier     ReminderNotifierInterface
+}
+
+// NewOccasionReminderService creates a new occasion reminder service. notifier may be
+// nil to send the reminder by email only.
+func NewOccasionReminderService(
+	rsvpRepo ReminderRSVPRepoInterface,
+	userRepo RolloverUserRepoInterface,
+	emailService ReminderEmailSenderInterface,
+	notifier ReminderNotifierInterface,
+) *OccasionReminderService {
+	return &OccasionReminderService{
+		rsvpRepo:     rsvpRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		notifier:     notifier,
+	}
+}
+
+// SendDueReminders reminds the owner of every wishlist with RSVPs whose occasion is
+// within occasionReminderLeadTime. Each occasion date is reminded once, so moving the
+// date sends a fresh reminder.
+func (s *OccasionReminderService) SendDueReminders(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	due, err := s.rsvpRepo.ListDueForReminder(ctx, today, today.Add(occasionReminderLeadTime))
+	if err != nil {
+		return fmt.Errorf("failed to find wishlists due for occasion reminder: %w", err)
+	}
+
+	for _, wishList := range due {
+		if err := s.remind(ctx, wishList); err != nil {
+			logger.ErrorContext(ctx, "failed to send occasion reminder", "wishlist_id", wishList.WishlistID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *OccasionReminderService) remind(ctx context.Context, wishList *rsvpmodels.ReminderDue) error {
+	rsvps, err := s.rsvpRepo.ListByWishlist(ctx, wishList.WishlistID)
+	if err != nil {
+		return err
+	}
+
+	var attending []string
+	notAttending := 0
+	for _, rsvp := range rsvps {
+		if rsvp.Attending {
+			attending = append(attending, rsvp.Name)
+		} else {
+			notAttending++
+		}
+	}
+
+	owner, err := s.userRepo.GetByID(ctx, wishList.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to get wishlist owner: %w", err)
+	}
+
+	if err := s.emailService.SendOccasionReminderEmail(ctx, owner.Email, wishList.Title, wishList.OccasionDate.Time, attending, notAttending); err != nil {
+		return fmt.Errorf("failed to send occasion reminder email: %w", err)
+	}
+
+	if s.notifier != nil {
+		err := s.notifier.Notify(ctx, notificationmodels.Notification{
+			UserID:     wishList.OwnerID,
+			Type:       notificationmodels.TypeOccasionReminder,
+			Title:      fmt.Sprintf("%q is coming up", wishList.Title),
+			Body:       fmt.Sprintf("%d guest(s) are coming on %s.", len(attending), wishList.OccasionDate.Time.Format("January 2")),
+			WishlistID: wishList.WishlistID,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "failed to create occasion reminder notification", "wishlist_id", wishList.WishlistID.String(), "error", err)
+		}
+	}
+
+	return s.rsvpRepo.MarkReminded(ctx, wishList.WishlistID, wishList.OccasionDate)
+}
+
+// RunScheduledReminders sends due reminders daily until ctx is canceled. It blocks, so
+// callers start it in a goroutine. A run in progress when ctx is canceled is finished.
+func (s *OccasionReminderService) RunScheduledReminders(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	logger.Info("scheduled occasion reminder job started", "interval", "24h")
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.SendDueReminders(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to send occasion reminders", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("occasion reminder job stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// rolloverLeadTime is how long before the next occurrence a recurring wishlist is rolled over
+const rolloverLeadTime = 30 * 24 * time.Hour
+
+// Cross-domain interfaces — only methods used by OccasionRolloverService
+
+// RolloverWishListRepoInterface defines wishlist repo methods needed by the rollover service
+type RolloverWishListRepoInterface interface {
+	ListDueForRollover(ctx context.Context, occasionBefore time.Time) ([]*wishlistmodels.WishList, error)
+	RollOver(ctx context.Context, sourceID pgtype.UUID, next wishlistmodels.WishList) (*wishlistmodels.WishList, int64, error)
+}
+
+// RolloverUserRepoInterface defines user repo methods needed by the rollover service
+type RolloverUserRepoInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+}
+
+// RolloverEmailSenderInterface defines email methods needed by the rollover service
+type RolloverEmailSenderInterface interface {
+	SendWishlistRolloverEmail(ctx context.Context, recipientEmail, wishlistTitle string, occasionDate time.Time, carriedOver int64) error
+}
+
+// OccasionRolloverService clones recurring wishlists ahead of their next occurrence
+type OccasionRolloverService struct {
+	wishListRepo RolloverWishListRepoInterface
+	userRepo     RolloverUserRepoInterface
+	emailService RolloverEmailSenderInterface
+}
+
+// NewOccasionRolloverService creates a new occasion rollover service
+func NewOccasionRolloverService(
+	wishListRepo RolloverWishListRepoInterface,
+	userRepo RolloverUserRepoInterface,
+	emailService RolloverEmailSenderInterface,
+) *OccasionRolloverService {
+	return &OccasionRolloverService{
+		wishListRepo: wishListRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+	}
+}
+
+// RollOverDueWishLists creates the next occurrence of every recurring wishlist whose next
+// date is within rolloverLeadTime, carrying over items nobody has bought, and asks the
+// owner to review it. The new list starts private so nothing is shared before that review.
+func (s *OccasionRolloverService) RollOverDueWishLists(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	// A list is due once its next occurrence (one year on) is within the lead time
+	due, err := s.wishListRepo.ListDueForRollover(ctx, today.Add(rolloverLeadTime).AddDate(-1, 0, 0))
+	if err != nil {
+		return fmt.Errorf("failed to find wishlists due for rollover: %w", err)
+	}
+
+	for _, wishList := range due {
+		if err := s.rollOver(ctx, wishList, today); err != nil {
+			logger.ErrorContext(ctx, "failed to roll over wishlist", "wishlist_id", wishList.ID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *OccasionRolloverService) rollOver(ctx context.Context, source *wishlistmodels.WishList, today time.Time) error {
+	previous := source.OccasionDate.Time
+	next := wishlistmodels.NextOccurrence(previous, today)
+
+	created, carried, err := s.wishListRepo.RollOver(ctx, source.ID, wishlistmodels.WishList{
+		OwnerID:      source.OwnerID,
+		Title:        wishlistmodels.RolloverTitle(source.Title, previous.Year(), next.Year()),
+		Description:  source.Description,
+		Occasion:     source.Occasion,
+		OccasionDate: pgtype.Date{Time: next, Valid: true},
+		IsPublic:     pgtype.Bool{Bool: false, Valid: true},
+		Recurrence:   source.Recurrence,
+		Discoverable: source.Discoverable,
+	})
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListRolledOver) {
+			return nil
+		}
+		return err
+	}
+
+	logger.InfoContext(ctx, "rolled over recurring wishlist",
+		"wishlist_id", source.ID.String(),
+		"next_wishlist_id", created.ID.String(),
+		"items_carried_over", carried)
+
+	owner, err := s.userRepo.GetByID(ctx, source.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to get wishlist owner: %w", err)
+	}
+
+	if err := s.emailService.SendWishlistRolloverEmail(ctx, owner.Email, created.Title, next, carried); err != nil {
+		return fmt.Errorf("failed to send rollover email: %w", err)
+	}
+
+	return nil
+}
+
+// RunScheduledRollover runs the rollover daily until ctx is canceled. It blocks, so
+// callers start it in a goroutine. A run in progress when ctx is canceled is finished.
+func (s *OccasionRolloverService) RunScheduledRollover(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	logger.Info("scheduled occasion rollover job started", "interval", "24h")
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.RollOverDueWishLists(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to roll over recurring wishlists", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("occasion rollover job stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by OccasionSnapshotService
+
+// OccasionSnapshotterInterface defines wishlist snapshot service methods needed by the snapshot job
+type OccasionSnapshotterInterface interface {
+	SnapshotOccasions(ctx context.Context) (int, error)
+}
+
+// OccasionSnapshotService freezes wishlists on their occasion date, so gift
+// history shows what was wished for and given even after the list is edited
+type OccasionSnapshotService struct {
+	snapshotter OccasionSnapshotterInterface
+	interval    time.Duration
+}
+
+// NewOccasionSnapshotService creates a job looking for wishlists to freeze
+// every interval
+func NewOccasionSnapshotService(snapshotter OccasionSnapshotterInterface, interval time.Duration) *OccasionSnapshotService {
+	return &OccasionSnapshotService{
+		snapshotter: snapshotter,
+		interval:    interval,
+	}
+}
+
+// RunScheduledSnapshots snapshots right away and then every interval until ctx
+// is canceled. It blocks, so callers start it in a goroutine.
+func (s *OccasionSnapshotService) RunScheduledSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled occasion snapshots started", "interval", s.interval.String())
+
+	s.snapshot(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			s.snapshot(ctx)
+		case <-ctx.Done():
+			logger.Info("occasion snapshots stopped")
+			return
+		}
+	}
+}
+
+func (s *OccasionSnapshotService) snapshot(ctx context.Context) {
+	taken, err := s.snapshotter.SnapshotOccasions(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "failed to snapshot wishlists", "error", err)
+		}
+		return
+	}
+	if taken > 0 {
+		logger.InfoContext(ctx, "wishlists snapshotted on their occasion date", "count", taken)
+	}
+}
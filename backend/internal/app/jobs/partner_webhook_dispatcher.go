@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	partnermodels "wish-list/internal/domain/partner/models"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/webhook"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// partnerWebhookDispatchInterval is how often the partner webhook outbox is drained
+	partnerWebhookDispatchInterval = 30 * time.Second
+	// partnerWebhookBatchSize caps the deliveries sent per run
+	partnerWebhookBatchSize = 100
+	// partnerWebhookMaxAttempts is how often a delivery is tried before it is marked failed.
+	// With the repository's exponential backoff the last attempt is about 8.5 hours after the first.
+	partnerWebhookMaxAttempts = 8
+)
+
+// Cross-domain interfaces — only methods used by PartnerWebhookDispatcherService
+
+// PartnerWebhookRepoInterface defines partner repo methods needed by the webhook dispatcher
+type PartnerWebhookRepoInterface interface {
+	ListPendingDeliveries(ctx context.Context, limit int) ([]*partnermodels.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id pgtype.UUID) error
+	RecordDeliveryFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+}
+
+// WebhookSenderInterface posts a signed delivery to an endpoint
+type WebhookSenderInterface interface {
+	Send(ctx context.Context, url, secret string, d webhook.Delivery) error
+}
+
+// PartnerWebhookDispatcherService sends queued item events to partner webhooks
+type PartnerWebhookDispatcherService struct {
+	repo   PartnerWebhookRepoInterface
+	sender WebhookSenderInterface
+}
+
+// NewPartnerWebhookDispatcherService creates a new partner webhook dispatcher service
+func NewPartnerWebhookDispatcherService(repo PartnerWebhookRepoInterface, sender WebhookSenderInterface) *PartnerWebhookDispatcherService {
+	return &PartnerWebhookDispatcherService{
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+// DispatchPending sends a batch of deliveries that are due. A delivery the endpoint
+// does not accept is retried with backoff, up to partnerWebhookMaxAttempts.
+func (s *PartnerWebhookDispatcherService) DispatchPending(ctx context.Context) error {
+	pending, err := s.repo.ListPendingDeliveries(ctx, partnerWebhookBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to find partner webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range pending {
+		if err := s.dispatch(ctx, delivery); err != nil {
+			logger.ErrorContext(ctx, "failed to dispatch partner webhook", "delivery_id", delivery.ID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PartnerWebhookDispatcherService) dispatch(ctx context.Context, delivery *partnermodels.WebhookDelivery) error {
+	err := s.sender.Send(ctx, delivery.URL, delivery.Secret, webhook.Delivery{
+		ID:    delivery.ID.String(),
+		Event: delivery.Event,
+		Body:  delivery.Payload,
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "partner webhook delivery failed",
+			"delivery_id", delivery.ID.String(), "webhook_id", delivery.WebhookID.String(),
+			"attempt", delivery.Attempts+1, "error", err)
+		return s.repo.RecordDeliveryFailure(ctx, delivery.ID, partnerWebhookMaxAttempts)
+	}
+	return s.repo.MarkDelivered(ctx, delivery.ID)
+}
+
+// RunScheduledDispatch drains the partner webhook outbox every
+// partnerWebhookDispatchInterval until ctx is canceled. It blocks, so callers start it
+// in a goroutine. A run in progress when ctx is canceled is finished.
+func (s *PartnerWebhookDispatcherService) RunScheduledDispatch(ctx context.Context) {
+	ticker := time.NewTicker(partnerWebhookDispatchInterval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled partner webhook dispatch job started", "interval", partnerWebhookDispatchInterval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.DispatchPending(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to dispatch partner webhooks", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("partner webhook dispatch job stopped")
+			return
+		}
+	}
+}
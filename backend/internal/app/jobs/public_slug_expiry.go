@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by PublicSlugExpiryService
+
+// PublicSlugCloserInterface defines wishlist service methods needed by the slug expiry job
+type PublicSlugCloserInterface interface {
+	CloseExpiredPublicSlugs(ctx context.Context) (int, error)
+}
+
+// PublicSlugExpiryService closes the public links of wishlists whose occasion is
+// long past, and refreshes discovery so closed lists leave trending and the
+// sitemap without waiting for the next scheduled refresh
+type PublicSlugExpiryService struct {
+	closer    PublicSlugCloserInterface
+	discovery DiscoveryRefresherInterface
+	interval  time.Duration
+}
+
+// NewPublicSlugExpiryService creates a job looking for public links to close
+// every interval
+func NewPublicSlugExpiryService(closer PublicSlugCloserInterface, discovery DiscoveryRefresherInterface, interval time.Duration) *PublicSlugExpiryService {
+	return &PublicSlugExpiryService{
+		closer:    closer,
+		discovery: discovery,
+		interval:  interval,
+	}
+}
+
+// RunScheduledExpiry closes expired links right away and then every interval
+// until ctx is canceled. It blocks, so callers start it in a goroutine.
+func (s *PublicSlugExpiryService) RunScheduledExpiry(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled public slug expiry started", "interval", s.interval.String())
+
+	s.expire(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			s.expire(ctx)
+		case <-ctx.Done():
+			logger.Info("public slug expiry stopped")
+			return
+		}
+	}
+}
+
+func (s *PublicSlugExpiryService) expire(ctx context.Context) {
+	closed, err := s.closer.CloseExpiredPublicSlugs(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "failed to close expired public slugs", "error", err)
+		}
+		return
+	}
+	if closed == 0 {
+		return
+	}
+	logger.InfoContext(ctx, "public slugs of past wishlists closed", "count", closed)
+
+	if err := s.discovery.RefreshTrending(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.ErrorContext(ctx, "failed to refresh trending wishlists", "error", err)
+	}
+	if err := s.discovery.RefreshSitemap(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.ErrorContext(ctx, "failed to refresh sitemap", "error", err)
+	}
+}
@@ -0,0 +1,126 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// purchaseLinkGrace is how long after the occasion the "I bought it" link keeps working
+const purchaseLinkGrace = 30 * 24 * time.Hour
+
+// Cross-domain interfaces — only methods used by PurchaseReminderService
+
+// PurchaseReminderRepoInterface defines reservation repo methods needed by the purchase reminder service
+type PurchaseReminderRepoInterface interface {
+	ListDue(ctx context.Context, from, to time.Time) ([]*reservationmodels.PurchaseReminder, error)
+	MarkReminded(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error
+}
+
+// PurchaseTokenSignerInterface signs the "I bought it" links in purchase reminders
+type PurchaseTokenSignerInterface interface {
+	GeneratePurchaseToken(reservationID string, expires time.Time) string
+}
+
+// PurchaseReminderEmailSenderInterface defines email methods needed by the purchase reminder service
+type PurchaseReminderEmailSenderInterface interface {
+	SendPurchaseReminderEmail(ctx context.Context, recipientEmail string, data PurchaseReminderEmailData) error
+}
+
+// PurchaseReminderService emails guests ahead of an occasion about the gifts they
+// reserved but have not marked as bought, with a link to do so without signing in
+type PurchaseReminderService struct {
+	repo         PurchaseReminderRepoInterface
+	tokens       PurchaseTokenSignerInterface
+	emailService PurchaseReminderEmailSenderInterface
+	frontendURL  string
+	leadTime     time.Duration
+}
+
+// NewPurchaseReminderService creates a new purchase reminder service sending
+// reminders leadTime before the occasion. Links point at the web app at frontendURL.
+func NewPurchaseReminderService(
+	repo PurchaseReminderRepoInterface,
+	tokens PurchaseTokenSignerInterface,
+	emailService PurchaseReminderEmailSenderInterface,
+	frontendURL string,
+	leadTime time.Duration,
+) *PurchaseReminderService {
+	return &PurchaseReminderService{
+		repo:         repo,
+		tokens:       tokens,
+		emailService: emailService,
+		frontendURL:  strings.TrimRight(frontendURL, "/"),
+		leadTime:     leadTime,
+	}
+}
+
+// SendDueReminders reminds the guest of every reservation not bought yet whose
+// occasion is within the lead time. Each occasion date is reminded once, so moving
+// the date sends a fresh reminder.
+func (s *PurchaseReminderService) SendDueReminders(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	due, err := s.repo.ListDue(ctx, today, today.Add(s.leadTime))
+	if err != nil {
+		return fmt.Errorf("failed to find reservations due for purchase reminder: %w", err)
+	}
+
+	for _, reminder := range due {
+		if err := s.remind(ctx, reminder); err != nil {
+			logger.ErrorContext(ctx, "failed to send purchase reminder", "reservation_id", reminder.ReservationID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PurchaseReminderService) remind(ctx context.Context, reminder *reservationmodels.PurchaseReminder) error {
+	if reminder.GuestEmail.String == "" {
+		return nil
+	}
+
+	token := s.tokens.GeneratePurchaseToken(reminder.ReservationID.String(), reminder.OccasionDate.Time.Add(purchaseLinkGrace))
+	err := s.emailService.SendPurchaseReminderEmail(ctx, reminder.GuestEmail.String, PurchaseReminderEmailData{
+		GuestName:     reminder.GuestName.String,
+		GiftItemName:  reminder.GiftItemName,
+		WishlistTitle: reminder.WishlistTitle,
+		OccasionDate:  reminder.OccasionDate.Time.Format("January 2, 2006"),
+		ItemLink:      reminder.GiftItemLink.String,
+		PurchasedLink: s.frontendURL + "/reservations/purchased?token=" + url.QueryEscape(token),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send purchase reminder email: %w", err)
+	}
+
+	return s.repo.MarkReminded(ctx, reminder.ReservationID, reminder.OccasionDate)
+}
+
+// RunScheduledReminders sends due reminders daily until ctx is canceled. It blocks, so
+// callers start it in a goroutine. A run in progress when ctx is canceled is finished.
+func (s *PurchaseReminderService) RunScheduledReminders(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	logger.Info("scheduled purchase reminder job started", "interval", "24h", "lead_time", s.leadTime.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.SendDueReminders(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to send purchase reminders", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("purchase reminder job stopped")
+			return
+		}
+	}
+}
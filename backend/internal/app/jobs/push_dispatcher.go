@@ -0,0 +1,149 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	notificationmodels "wish-list/internal/domain/notification/models"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/push"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// pushDispatchInterval is how often the push outbox is drained
+	pushDispatchInterval = 30 * time.Second
+	// pushBatchSize caps the notifications pushed per run
+	pushBatchSize = 100
+	// pushMaxAttempts is how many runs a notification is retried after transient provider errors
+	pushMaxAttempts = 5
+	// pushMaxAge drops notifications too old to be worth a push, e.g. after push was disabled for a while
+	pushMaxAge = 24 * time.Hour
+)
+
+// Cross-domain interfaces — only methods used by PushDispatcherService
+
+// PushNotificationRepoInterface defines notification repo methods needed by the push dispatcher
+type PushNotificationRepoInterface interface {
+	ListPendingPush(ctx context.Context, limit int) ([]*notificationmodels.PendingPush, error)
+	ListDevices(ctx context.Context, userID pgtype.UUID) ([]*notificationmodels.Device, error)
+	DeleteDeviceToken(ctx context.Context, token string) error
+	MarkPushed(ctx context.Context, id pgtype.UUID) error
+	RecordPushFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+}
+
+// PushSenderInterface delivers a message to one device
+type PushSenderInterface interface {
+	Send(ctx context.Context, platform, token string, msg push.Message) error
+}
+
+// PushDispatcherService pushes new in-app notifications to the recipients' mobile devices
+type PushDispatcherService struct {
+	repo   PushNotificationRepoInterface
+	sender PushSenderInterface
+}
+
+// NewPushDispatcherService creates a new push dispatcher service
+func NewPushDispatcherService(repo PushNotificationRepoInterface, sender PushSenderInterface) *PushDispatcherService {
+	return &PushDispatcherService{
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+// DispatchPending pushes a batch of notifications waiting in the outbox. Tokens the
+// provider rejects are forgotten. A notification no device received because of a
+// transient error is retried on later runs, up to pushMaxAttempts.
+func (s *PushDispatcherService) DispatchPending(ctx context.Context) error {
+	pending, err := s.repo.ListPendingPush(ctx, pushBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to find notifications to push: %w", err)
+	}
+
+	for _, notification := range pending {
+		if err := s.dispatch(ctx, notification); err != nil {
+			logger.ErrorContext(ctx, "failed to push notification", "notification_id", notification.ID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PushDispatcherService) dispatch(ctx context.Context, notification *notificationmodels.PendingPush) error {
+	if !notification.PushEnabled || time.Since(notification.CreatedAt.Time) > pushMaxAge {
+		return s.repo.MarkPushed(ctx, notification.ID)
+	}
+
+	devices, err := s.repo.ListDevices(ctx, notification.UserID)
+	if err != nil {
+		return err
+	}
+
+	msg := pushMessage(&notification.Notification)
+	delivered, retry := false, false
+	for _, device := range devices {
+		err := s.sender.Send(ctx, device.Platform, device.Token, msg)
+		switch {
+		case err == nil:
+			delivered = true
+		case errors.Is(err, push.ErrInvalidToken):
+			logger.InfoContext(ctx, "removing rejected push token", "device_id", device.ID.String(), "platform", device.Platform)
+			if err := s.repo.DeleteDeviceToken(ctx, device.Token); err != nil {
+				logger.ErrorContext(ctx, "failed to remove rejected push token", "device_id", device.ID.String(), "error", err)
+			}
+		case errors.Is(err, push.ErrUnsupportedPlatform):
+			// Provider not configured for this platform; nothing to retry
+		default:
+			retry = true
+			logger.WarnContext(ctx, "push delivery failed", "device_id", device.ID.String(), "platform", device.Platform, "error", err)
+		}
+	}
+
+	// Retrying after a partial delivery would notify the other devices twice
+	if retry && !delivered {
+		return s.repo.RecordPushFailure(ctx, notification.ID, pushMaxAttempts)
+	}
+	return s.repo.MarkPushed(ctx, notification.ID)
+}
+
+func pushMessage(notification *notificationmodels.Notification) push.Message {
+	data := map[string]string{
+		"notification_id": notification.ID.String(),
+		"type":            notification.Type,
+	}
+	if notification.WishlistID.Valid {
+		data["wishlist_id"] = notification.WishlistID.String()
+	}
+
+	return push.Message{
+		Title: notification.Title,
+		Body:  notification.Body,
+		Data:  data,
+	}
+}
+
+// RunScheduledDispatch drains the push outbox every pushDispatchInterval until ctx is
+// canceled. It blocks, so callers start it in a goroutine. A run in progress when ctx
+// is canceled is finished.
+func (s *PushDispatcherService) RunScheduledDispatch(ctx context.Context) {
+	ticker := time.NewTicker(pushDispatchInterval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled push dispatch job started", "interval", pushDispatchInterval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.DispatchPending(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to dispatch push notifications", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("push dispatch job stopped")
+			return
+		}
+	}
+}
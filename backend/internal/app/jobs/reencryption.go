@@ -0,0 +1,393 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+)
+
+// DefaultReEncryptionBatchSize is the number of rows processed per batch
+const DefaultReEncryptionBatchSize = 500
+
+// reEncryptionTarget describes a table with encrypted PII columns
+type reEncryptionTarget struct {
+	table   string
+	key     string // UUID primary key column, "id" when empty
+	columns []string
+}
+
+// keyColumn returns the column rows of the target are paginated and updated by
+func (t reEncryptionTarget) keyColumn() string {
+	if t.key == "" {
+		return "id"
+	}
+	return t.key
+}
+
+// reEncryptionTargets lists every column holding ciphertext produced by encryption.Service
+var reEncryptionTargets = []reEncryptionTarget{
+	{table: "users", columns: []string{"encrypted_email", "encrypted_first_name", "encrypted_last_name"}},
+	{table: "reservations", columns: []string{"encrypted_guest_name", "encrypted_guest_email", "encrypted_message"}},
+	{table: "gift_items", columns: []string{"encrypted_manual_reserved_by_name"}},
+	{table: "privacy_requests", columns: []string{"encrypted_email"}},
+	{table: "gift_history", columns: []string{"encrypted_giver_name", "encrypted_giver_email", "encrypted_giver_message"}},
+	{table: "failed_emails", columns: []string{"encrypted_message"}},
+	{table: "rsvps", columns: []string{"encrypted_name", "encrypted_email", "encrypted_note"}},
+	{table: "guest_reservation_confirmations", key: "confirmation_token", columns: []string{"encrypted_details"}},
+	{table: "wishlist_invites", columns: []string{"encrypted_email"}},
+	{table: "contacts", columns: []string{"encrypted_email"}},
+}
+
+// plaintextPIIColumn maps a plaintext guest PII column to its encrypted counterpart.
+// Repositories with encryption enabled write only the encrypted column; rows created
+// before encryption was enabled still hold plaintext until EncryptPlaintext migrates them.
+type plaintextPIIColumn struct {
+	table     string
+	plaintext string
+	encrypted string
+	hash      string // Column receiving encryption.Service.HashEmail of the value, for emails matched in SQL
+}
+
+// plaintextPIIColumns lists guest PII columns that must not hold plaintext when encryption is enabled
+var plaintextPIIColumns = []plaintextPIIColumn{
+	{table: "reservations", plaintext: "guest_name", encrypted: "encrypted_guest_name"},
+	{table: "reservations", plaintext: "guest_email", encrypted: "encrypted_guest_email"},
+	{table: "reservations", plaintext: "message", encrypted: "encrypted_message"},
+	{table: "gift_items", plaintext: "manual_reserved_by_name", encrypted: "encrypted_manual_reserved_by_name"},
+	{table: "privacy_requests", plaintext: "email", encrypted: "encrypted_email"},
+	{table: "gift_history", plaintext: "giver_name", encrypted: "encrypted_giver_name"},
+	{table: "gift_history", plaintext: "giver_email", encrypted: "encrypted_giver_email"},
+	{table: "gift_history", plaintext: "giver_message", encrypted: "encrypted_giver_message"},
+	{table: "failed_emails", plaintext: "message", encrypted: "encrypted_message"},
+	{table: "rsvps", plaintext: "name", encrypted: "encrypted_name"},
+	{table: "rsvps", plaintext: "email", encrypted: "encrypted_email", hash: "email_hash"},
+	{table: "rsvps", plaintext: "note", encrypted: "encrypted_note"},
+	{table: "wishlist_invites", plaintext: "email", encrypted: "encrypted_email", hash: "email_hash"},
+	{table: "contacts", plaintext: "email", encrypted: "encrypted_email", hash: "email_hash"},
+}
+
+// ReEncryptionStats summarizes a re-encryption run
+type ReEncryptionStats struct {
+	Scanned   int
+	Updated   int
+	Conflicts int // rows modified concurrently; they were written with the current key
+}
+
+// ReEncryptionJob migrates encrypted PII columns to the current encryption key.
+//
+// Rows are processed in small keyset-paginated batches and each row is updated with a
+// compare-and-swap on its previous ciphertext, so the job can run against a live database
+// without locking tables or overwriting concurrent writes.
+type ReEncryptionJob struct {
+	db            *database.DB
+	encryptionSvc *encryption.Service
+	batchSize     int
+	dryRun        bool
+}
+
+// NewReEncryptionJob creates a new re-encryption job
+func NewReEncryptionJob(db *database.DB, encryptionSvc *encryption.Service, batchSize int, dryRun bool) *ReEncryptionJob {
+	if batchSize <= 0 {
+		batchSize = DefaultReEncryptionBatchSize
+	}
+
+	return &ReEncryptionJob{
+		db:            db,
+		encryptionSvc: encryptionSvc,
+		batchSize:     batchSize,
+		dryRun:        dryRun,
+	}
+}
+
+// Run re-encrypts all rows not yet using the current key
+func (j *ReEncryptionJob) Run(ctx context.Context) (ReEncryptionStats, error) {
+	var total ReEncryptionStats
+
+	for _, target := range reEncryptionTargets {
+		stats, err := j.runTarget(ctx, target)
+		if err != nil {
+			return total, fmt.Errorf("failed to re-encrypt %s: %w", target.table, err)
+		}
+
+		logger.Info("re-encryption finished for table",
+			"table", target.table,
+			"scanned", stats.Scanned,
+			"updated", stats.Updated,
+			"conflicts", stats.Conflicts,
+			"dry_run", j.dryRun)
+
+		total.Scanned += stats.Scanned
+		total.Updated += stats.Updated
+		total.Conflicts += stats.Conflicts
+	}
+
+	return total, nil
+}
+
+// runTarget walks a single table in id order, one batch at a time
+func (j *ReEncryptionJob) runTarget(ctx context.Context, target reEncryptionTarget) (ReEncryptionStats, error) {
+	var stats ReEncryptionStats
+
+	columnList := strings.Join(target.columns, ", ")
+	anyNotNull := make([]string, len(target.columns))
+	for i, col := range target.columns {
+		anyNotNull[i] = col + " IS NOT NULL"
+	}
+
+	//nolint:gosec // Table and column names come from the static reEncryptionTargets list
+	selectQuery := fmt.Sprintf(`
+		SELECT %[1]s::text, %[2]s
+		FROM %[3]s
+		WHERE %[1]s > $1::uuid AND (%[4]s)
+		ORDER BY %[1]s
+		LIMIT $2
+	`, target.keyColumn(), columnList, target.table, strings.Join(anyNotNull, " OR "))
+
+	// Keyset pagination starting from the nil UUID keeps each batch on the primary key index
+	lastID := "00000000-0000-0000-0000-000000000000"
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		rows, err := j.db.QueryContext(ctx, selectQuery, lastID, j.batchSize)
+		if err != nil {
+			return stats, fmt.Errorf("failed to select batch: %w", err)
+		}
+
+		type row struct {
+			id     string
+			values []sql.NullString
+		}
+
+		var batch []row
+		for rows.Next() {
+			r := row{values: make([]sql.NullString, len(target.columns))}
+			dest := make([]any, 0, len(target.columns)+1)
+			dest = append(dest, &r.id)
+			for i := range r.values {
+				dest = append(dest, &r.values[i])
+			}
+			if err := rows.Scan(dest...); err != nil {
+				rows.Close()
+				return stats, fmt.Errorf("failed to scan row: %w", err)
+			}
+			batch = append(batch, r)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return stats, fmt.Errorf("failed to iterate rows: %w", err)
+		}
+		rows.Close()
+
+		if len(batch) == 0 {
+			return stats, nil
+		}
+
+		for _, r := range batch {
+			stats.Scanned++
+			updated, err := j.reEncryptRow(ctx, target, r.id, r.values)
+			if err != nil {
+				return stats, err
+			}
+			switch updated {
+			case rowUpdated:
+				stats.Updated++
+			case rowConflict:
+				stats.Conflicts++
+			}
+		}
+
+		lastID = batch[len(batch)-1].id
+	}
+}
+
+type rowOutcome int
+
+const (
+	rowUnchanged rowOutcome = iota
+	rowUpdated
+	rowConflict
+)
+
+// reEncryptRow re-encrypts stale columns of a single row using compare-and-swap
+func (j *ReEncryptionJob) reEncryptRow(ctx context.Context, target reEncryptionTarget, id string, values []sql.NullString) (rowOutcome, error) {
+	setClauses := make([]string, 0, len(values))
+	whereClauses := make([]string, 0, len(values))
+	args := []any{id}
+
+	for i, value := range values {
+		if !value.Valid || !j.encryptionSvc.NeedsReEncryption(value.String) {
+			continue
+		}
+
+		reEncrypted, err := j.encryptionSvc.ReEncrypt(ctx, value.String)
+		if err != nil {
+			return rowUnchanged, fmt.Errorf("failed to re-encrypt %s.%s for id %s: %w", target.table, target.columns[i], id, err)
+		}
+
+		args = append(args, reEncrypted, value.String)
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", target.columns[i], len(args)-1))
+		whereClauses = append(whereClauses, fmt.Sprintf("%s = $%d", target.columns[i], len(args)))
+	}
+
+	if len(setClauses) == 0 {
+		return rowUnchanged, nil
+	}
+
+	if j.dryRun {
+		return rowUpdated, nil
+	}
+
+	//nolint:gosec // Table and column names come from the static reEncryptionTargets list
+	updateQuery := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE %s = $1 AND %s",
+		target.table,
+		strings.Join(setClauses, ", "),
+		target.keyColumn(),
+		strings.Join(whereClauses, " AND "),
+	)
+
+	result, err := j.db.ExecContext(ctx, updateQuery, args...)
+	if err != nil {
+		return rowUnchanged, fmt.Errorf("failed to update %s row %s: %w", target.table, id, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return rowUnchanged, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return rowConflict, nil
+	}
+
+	return rowUpdated, nil
+}
+
+// EncryptPlaintext encrypts guest PII still stored in plaintext columns and clears the plaintext.
+// Like Run, it works in keyset-paginated batches with compare-and-swap updates.
+func (j *ReEncryptionJob) EncryptPlaintext(ctx context.Context) (ReEncryptionStats, error) {
+	var total ReEncryptionStats
+
+	for _, column := range plaintextPIIColumns {
+		stats, err := j.encryptPlaintextColumn(ctx, column)
+		if err != nil {
+			return total, fmt.Errorf("failed to encrypt %s.%s: %w", column.table, column.plaintext, err)
+		}
+
+		logger.Info("plaintext encryption finished for column",
+			"table", column.table,
+			"column", column.plaintext,
+			"scanned", stats.Scanned,
+			"updated", stats.Updated,
+			"conflicts", stats.Conflicts,
+			"dry_run", j.dryRun)
+
+		total.Scanned += stats.Scanned
+		total.Updated += stats.Updated
+		total.Conflicts += stats.Conflicts
+	}
+
+	return total, nil
+}
+
+// encryptPlaintextColumn migrates a single plaintext column, one batch at a time
+func (j *ReEncryptionJob) encryptPlaintextColumn(ctx context.Context, column plaintextPIIColumn) (ReEncryptionStats, error) {
+	var stats ReEncryptionStats
+
+	//nolint:gosec // Table and column names come from the static plaintextPIIColumns list
+	selectQuery := fmt.Sprintf(`
+		SELECT id::text, %[2]s
+		FROM %[1]s
+		WHERE id > $1::uuid AND %[2]s IS NOT NULL AND %[3]s IS NULL
+		ORDER BY id
+		LIMIT $2
+	`, column.table, column.plaintext, column.encrypted)
+
+	setHash := ""
+	if column.hash != "" {
+		setHash = ", " + column.hash + " = $4"
+	}
+
+	//nolint:gosec // Table and column names come from the static plaintextPIIColumns list
+	updateQuery := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[3]s = $2, %[2]s = NULL%[4]s
+		WHERE id = $1 AND %[2]s = $3 AND %[3]s IS NULL
+	`, column.table, column.plaintext, column.encrypted, setHash)
+
+	type row struct {
+		ID        string
+		Plaintext string
+	}
+
+	lastID := "00000000-0000-0000-0000-000000000000"
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		var batch []row
+		rows, err := j.db.QueryContext(ctx, selectQuery, lastID, j.batchSize)
+		if err != nil {
+			return stats, fmt.Errorf("failed to select batch: %w", err)
+		}
+		for rows.Next() {
+			var r row
+			if err := rows.Scan(&r.ID, &r.Plaintext); err != nil {
+				rows.Close()
+				return stats, fmt.Errorf("failed to scan row: %w", err)
+			}
+			batch = append(batch, r)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return stats, fmt.Errorf("failed to iterate rows: %w", err)
+		}
+		rows.Close()
+
+		if len(batch) == 0 {
+			return stats, nil
+		}
+
+		for _, r := range batch {
+			stats.Scanned++
+			if j.dryRun {
+				stats.Updated++
+				continue
+			}
+
+			encrypted, err := j.encryptionSvc.Encrypt(ctx, r.Plaintext)
+			if err != nil {
+				return stats, fmt.Errorf("failed to encrypt row %s: %w", r.ID, err)
+			}
+
+			args := []any{r.ID, encrypted, r.Plaintext}
+			if column.hash != "" {
+				args = append(args, j.encryptionSvc.HashEmail(r.Plaintext))
+			}
+
+			result, err := j.db.ExecContext(ctx, updateQuery, args...)
+			if err != nil {
+				return stats, fmt.Errorf("failed to update row %s: %w", r.ID, err)
+			}
+
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return stats, fmt.Errorf("failed to get rows affected: %w", err)
+			}
+			if affected == 0 {
+				stats.Conflicts++
+			} else {
+				stats.Updated++
+			}
+		}
+
+		lastID = batch[len(batch)-1].ID
+	}
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by ReservationEventCheckService
+
+// ReservationEventCheckerInterface defines reservation service methods needed by the check job
+type ReservationEventCheckerInterface interface {
+	CheckEventConsistency(ctx context.Context) (int, error)
+}
+
+// ReservationEventCheckService compares reservations with their event log, so an
+// event lost to a failed write or a change made outside the service is noticed
+type ReservationEventCheckService struct {
+	checker  ReservationEventCheckerInterface
+	interval time.Duration
+}
+
+// NewReservationEventCheckService creates a job checking the reservation event
+// log every interval
+func NewReservationEventCheckService(checker ReservationEventCheckerInterface, interval time.Duration) *ReservationEventCheckService {
+	return &ReservationEventCheckService{
+		checker:  checker,
+		interval: interval,
+	}
+}
+
+// RunScheduledCheck checks every interval until ctx is canceled. It blocks, so
+// callers start it in a goroutine.
+func (s *ReservationEventCheckService) RunScheduledCheck(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled reservation event check started", "interval", s.interval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			s.check(ctx)
+		case <-ctx.Done():
+			logger.Info("reservation event check stopped")
+			return
+		}
+	}
+}
+
+func (s *ReservationEventCheckService) check(ctx context.Context) {
+	drift, err := s.checker.CheckEventConsistency(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "failed to check reservation events", "error", err)
+		}
+		return
+	}
+	if drift > 0 {
+		logger.WarnContext(ctx, "reservations disagree with their event log", "count", drift)
+	}
+}
@@ -0,0 +1,159 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	notificationmodels "wish-list/internal/domain/notification/models"
+	shipmentmodels "wish-list/internal/domain/shipment/models"
+	"wish-list/internal/pkg/carrier"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// shipmentTrackingInterval is how often undelivered shipments are checked with their carrier
+	shipmentTrackingInterval = 30 * time.Minute
+	// shipmentTrackingBatchSize caps the carrier lookups per run
+	shipmentTrackingBatchSize = 200
+	// shipmentNoticeBatchSize caps the delivery notices considered per run
+	shipmentNoticeBatchSize = 200
+)
+
+// Cross-domain interfaces — only methods used by ShipmentTrackingService
+
+// ShipmentTrackingRepoInterface defines shipment repo methods needed by the tracking job
+type ShipmentTrackingRepoInterface interface {
+	ListDueForTracking(ctx context.Context, checkedBefore time.Time, limit int) ([]*shipmentmodels.Shipment, error)
+	UpdateStatus(ctx context.Context, id pgtype.UUID, status, detail string, deliveredAt pgtype.Timestamptz) error
+	ListPendingNotices(ctx context.Context, revealedBefore time.Time, limit int) ([]*shipmentmodels.DeliveryNotice, error)
+	MarkOwnerNotified(ctx context.Context, id pgtype.UUID) error
+}
+
+// ShipmentTrackerInterface looks up a parcel with its carrier
+type ShipmentTrackerInterface interface {
+	Track(ctx context.Context, carrierCode, trackingNumber string) (*carrier.Result, error)
+}
+
+// ShipmentTrackingService polls carriers for purchased gifts' shipments and tells the
+// item owners once a gift is delivered
+type ShipmentTrackingService struct {
+	repo     ShipmentTrackingRepoInterface
+	tracker  ShipmentTrackerInterface
+	notifier ReminderNotifierInterface
+}
+
+// NewShipmentTrackingService creates a new shipment tracking service
+func NewShipmentTrackingService(
+	repo ShipmentTrackingRepoInterface,
+	tracker ShipmentTrackerInterface,
+	notifier ReminderNotifierInterface,
+) *ShipmentTrackingService {
+	return &ShipmentTrackingService{
+		repo:     repo,
+		tracker:  tracker,
+		notifier: notifier,
+	}
+}
+
+// TrackDue refreshes the status of undelivered shipments not checked during the
+// last interval. Shipments of a carrier without a tracker are skipped.
+func (s *ShipmentTrackingService) TrackDue(ctx context.Context) error {
+	due, err := s.repo.ListDueForTracking(ctx, time.Now().Add(-shipmentTrackingInterval), shipmentTrackingBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to find shipments to track: %w", err)
+	}
+
+	for _, shipment := range due {
+		if err := s.track(ctx, shipment); err != nil {
+			logger.WarnContext(ctx, "failed to track shipment", "shipment_id", shipment.ID.String(), "carrier", shipment.Carrier, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ShipmentTrackingService) track(ctx context.Context, shipment *shipmentmodels.Shipment) error {
+	result, err := s.tracker.Track(ctx, shipment.Carrier, shipment.TrackingNumber)
+	switch {
+	case errors.Is(err, carrier.ErrUnsupportedCarrier):
+		return nil
+	case errors.Is(err, carrier.ErrTrackingNotFound):
+		// Recorded as checked so the number is not looked up again every run
+		return s.repo.UpdateStatus(ctx, shipment.ID, shipmentmodels.StatusException, "Tracking number not found", pgtype.Timestamptz{})
+	case err != nil:
+		return err
+	}
+
+	deliveredAt := pgtype.Timestamptz{}
+	if result.Status == carrier.StatusDelivered {
+		deliveredAt = pgtype.Timestamptz{Time: result.DeliveredAt, Valid: true}
+		if result.DeliveredAt.IsZero() {
+			deliveredAt.Time = time.Now()
+		}
+	}
+
+	return s.repo.UpdateStatus(ctx, shipment.ID, result.Status, result.Detail, deliveredAt)
+}
+
+// NotifyDelivered tells item owners about delivered gifts. A gift on a wishlist with
+// an upcoming occasion stays a surprise: its notice waits for a run after the occasion.
+func (s *ShipmentTrackingService) NotifyDelivered(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	notices, err := s.repo.ListPendingNotices(ctx, today, shipmentNoticeBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to find delivery notices: %w", err)
+	}
+
+	for _, notice := range notices {
+		if err := s.notify(ctx, notice); err != nil {
+			logger.ErrorContext(ctx, "failed to send delivery notice", "shipment_id", notice.ShipmentID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ShipmentTrackingService) notify(ctx context.Context, notice *shipmentmodels.DeliveryNotice) error {
+	err := s.notifier.Notify(ctx, notificationmodels.Notification{
+		UserID: notice.OwnerID,
+		Type:   notificationmodels.TypeGiftDelivered,
+		Title:  fmt.Sprintf("%q was delivered", notice.ItemName),
+		Body:   fmt.Sprintf("A gift from your wish list, %q, has been delivered.", notice.ItemName),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.repo.MarkOwnerNotified(ctx, notice.ShipmentID)
+}
+
+// RunScheduledTracking tracks due shipments and sends delivery notices every
+// shipmentTrackingInterval until ctx is canceled. It blocks, so callers start it in a
+// goroutine. A run in progress when ctx is canceled is finished.
+func (s *ShipmentTrackingService) RunScheduledTracking(ctx context.Context) {
+	ticker := time.NewTicker(shipmentTrackingInterval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled shipment tracking job started", "interval", shipmentTrackingInterval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.TrackDue(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to track shipments", "error", err)
+			}
+			if err := s.NotifyDelivered(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to send delivery notices", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("shipment tracking job stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Cross-domain interfaces — only methods used by ViewFlushService
+
+// ViewFlusherInterface defines wishlist view service methods needed by the view flush job
+type ViewFlusherInterface interface {
+	FlushViews(ctx context.Context) (int, error)
+}
+
+// ViewFlushService adds the buffered public wishlist views to the stored counts
+type ViewFlushService struct {
+	flusher  ViewFlusherInterface
+	interval time.Duration
+}
+
+// NewViewFlushService creates a job flushing buffered views every interval
+func NewViewFlushService(flusher ViewFlusherInterface, interval time.Duration) *ViewFlushService {
+	return &ViewFlushService{
+		flusher:  flusher,
+		interval: interval,
+	}
+}
+
+// RunScheduledFlush flushes every interval until ctx is canceled, then once more
+// so views buffered in memory are not lost on shutdown. It blocks, so callers
+// start it in a goroutine.
+func (s *ViewFlushService) RunScheduledFlush(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	logger.Info("scheduled view flush started", "interval", s.interval.String())
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flush(ctx)
+		case <-ctx.Done():
+			s.flush(context.WithoutCancel(ctx))
+			logger.Info("view flush stopped")
+			return
+		}
+	}
+}
+
+func (s *ViewFlushService) flush(ctx context.Context) {
+	flushed, err := s.flusher.FlushViews(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "failed to flush wishlist views", "error", err)
+		}
+		return
+	}
+	if flushed > 0 {
+		logger.DebugContext(ctx, "wishlist views flushed", "wishlists", flushed)
+	}
+}
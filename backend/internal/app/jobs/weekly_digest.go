@@ -0,0 +1,147 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	notificationmodels "wish-list/internal/domain/notification/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces — only methods used by WeeklyDigestService
+
+// DigestNotificationRepoInterface defines notification repo methods needed by the digest service
+type DigestNotificationRepoInterface interface {
+	ListDigestRecipients(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error)
+	GetDigestStats(ctx context.Context, ownerID pgtype.UUID, from, to time.Time) (*notificationmodels.DigestStats, error)
+	ClaimDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error)
+	ReleaseDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error
+}
+
+// DigestEmailSenderInterface defines email methods needed by the digest service
+type DigestEmailSenderInterface interface {
+	SendWeeklyDigestEmail(ctx context.Context, recipientEmail string, data WeeklyDigestEmailData) error
+}
+
+// WeeklyDigestService emails opted-in owners a summary of the past week on their wishlists
+type WeeklyDigestService struct {
+	notificationRepo DigestNotificationRepoInterface
+	userRepo         RolloverUserRepoInterface
+	emailService     DigestEmailSenderInterface
+}
+
+// NewWeeklyDigestService creates a new weekly digest service
+func NewWeeklyDigestService(
+	notificationRepo DigestNotificationRepoInterface,
+	userRepo RolloverUserRepoInterface,
+	emailService DigestEmailSenderInterface,
+) *WeeklyDigestService {
+	return &WeeklyDigestService{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		emailService:     emailService,
+	}
+}
+
+// digestPeriod returns the last full Monday-to-Monday week before now, in UTC
+func digestPeriod(now time.Time) (time.Time, time.Time) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	end := today.AddDate(0, 0, -daysSinceMonday)
+	return end.AddDate(0, 0, -7), end
+}
+
+// SendDueDigests sends the digest for the last full week to every opted-in owner who
+// has not had it yet. Each period is claimed before sending, so a digest goes out at
+// most once however often this runs; owners with no activity are skipped.
+func (s *WeeklyDigestService) SendDueDigests(ctx context.Context) error {
+	from, to := digestPeriod(time.Now())
+
+	recipients, err := s.notificationRepo.ListDigestRecipients(ctx, from)
+	if err != nil {
+		return fmt.Errorf("failed to find digest recipients: %w", err)
+	}
+
+	for _, userID := range recipients {
+		if err := s.sendDigest(ctx, userID, from, to); err != nil {
+			logger.ErrorContext(ctx, "failed to send weekly digest", "user_id", userID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WeeklyDigestService) sendDigest(ctx context.Context, userID pgtype.UUID, from, to time.Time) error {
+	stats, err := s.notificationRepo.GetDigestStats(ctx, userID, from, to)
+	if err != nil {
+		return err
+	}
+
+	claimed, err := s.notificationRepo.ClaimDigestSend(ctx, userID, from)
+	if err != nil || !claimed {
+		return err
+	}
+
+	// An empty week stays claimed so it is not recounted on every run
+	if stats.IsEmpty() {
+		return nil
+	}
+
+	owner, err := s.userRepo.GetByID(ctx, userID)
+	if err == nil {
+		err = s.emailService.SendWeeklyDigestEmail(ctx, owner.Email, weeklyDigestEmailData(stats, from, to))
+	}
+	if err != nil {
+		if releaseErr := s.notificationRepo.ReleaseDigestSend(ctx, userID, from); releaseErr != nil {
+			logger.ErrorContext(ctx, "failed to release weekly digest claim", "user_id", userID.String(), "error", releaseErr)
+		}
+		return fmt.Errorf("failed to send weekly digest email: %w", err)
+	}
+
+	return nil
+}
+
+func weeklyDigestEmailData(stats *notificationmodels.DigestStats, from, to time.Time) WeeklyDigestEmailData {
+	data := WeeklyDigestEmailData{
+		PeriodStart:     from.Format("January 2"),
+		PeriodEnd:       to.AddDate(0, 0, -1).Format("January 2, 2006"),
+		NewViews:        stats.NewViews,
+		NewReservations: stats.NewReservations,
+		Purchases:       stats.Purchases,
+		NewComments:     stats.NewComments,
+		NewSuggestions:  stats.NewSuggestions,
+	}
+	for _, occasion := range stats.Upcoming {
+		data.Upcoming = append(data.Upcoming, WeeklyDigestOccasion{
+			WishlistTitle: occasion.Title,
+			OccasionDate:  occasion.OccasionDate.Time.Format("January 2, 2006"),
+		})
+	}
+	return data
+}
+
+// RunScheduledDigests sends due digests daily until ctx is canceled, so a week's digest
+// goes out on the first run after it ends. It blocks, so callers start it in a goroutine.
+// A run in progress when ctx is canceled is finished.
+func (s *WeeklyDigestService) RunScheduledDigests(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	logger.Info("scheduled weekly digest job started", "interval", "24h")
+
+	for {
+		select {
+		case <-ticker.C:
+			runCtx := context.WithoutCancel(ctx)
+			if err := s.SendDueDigests(runCtx); err != nil {
+				logger.ErrorContext(runCtx, "failed to send weekly digests", "error", err)
+			}
+		case <-ctx.Done():
+			logger.Info("weekly digest job stopped")
+			return
+		}
+	}
+}
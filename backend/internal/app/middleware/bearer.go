@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequireBearerToken allows the request only when it carries token as a bearer
+// token, for machine clients such as a Prometheus scraper that have no account
+func RequireBearerToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			presented, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || token == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+				return apperrors.Unauthorized("Invalid or missing bearer token")
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"errors"
+
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/captcha"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CaptchaTokenHeader is the request header carrying the client's CAPTCHA response token
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// CaptchaMiddleware verifies the CAPTCHA token sent in the X-Captcha-Token header.
+// Authenticated requests (user_id set by a preceding JWT middleware) are not challenged,
+// so on routes shared by users and guests only guests must solve the CAPTCHA.
+// A nil verifier disables the check.
+func CaptchaMiddleware(verifier captcha.Verifier) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if verifier == nil || c.Get("user_id") != nil {
+				return next(c)
+			}
+
+			token := c.Request().Header.Get(CaptchaTokenHeader)
+			err := verifier.Verify(c.Request().Context(), token, c.RealIP())
+			switch {
+			case err == nil:
+				return next(c)
+			case errors.Is(err, captcha.ErrMissingToken):
+				return apperrors.BadRequest("CAPTCHA token is required")
+			case errors.Is(err, captcha.ErrVerificationFailed):
+				return apperrors.Forbidden("CAPTCHA verification failed")
+			default:
+				return apperrors.BadGateway("CAPTCHA verification unavailable").Wrap(err)
+			}
+		}
+	}
+}
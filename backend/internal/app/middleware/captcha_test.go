@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"wish-list/internal/pkg/captcha"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubVerifier struct {
+	err       error
+	gotToken  string
+	callCount int
+}
+
+func (s *stubVerifier) Verify(_ context.Context, token, _ string) error {
+	s.callCount++
+	s.gotToken = token
+	if token == "" {
+		return captcha.ErrMissingToken
+	}
+	return s.err
+}
+
+func runCaptchaMiddleware(verifier captcha.Verifier, token string, userID any) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.HTTPErrorHandler = CustomHTTPErrorHandler
+
+	req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+	if token != "" {
+		req.Header.Set(CaptchaTokenHeader, token)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if userID != nil {
+		c.Set("user_id", userID)
+	}
+
+	handler := CaptchaMiddleware(verifier)(func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	if err := handler(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec
+}
+
+func TestCaptchaMiddleware(t *testing.T) {
+	t.Run("valid token passes", func(t *testing.T) {
+		v := &stubVerifier{}
+		rec := runCaptchaMiddleware(v, "token", nil)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "token", v.gotToken)
+	})
+
+	t.Run("missing token rejected", func(t *testing.T) {
+		rec := runCaptchaMiddleware(&stubVerifier{}, "", nil)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("failed verification rejected", func(t *testing.T) {
+		v := &stubVerifier{err: captcha.ErrVerificationFailed}
+		rec := runCaptchaMiddleware(v, "token", nil)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		v := &stubVerifier{err: errors.New("connection refused")}
+		rec := runCaptchaMiddleware(v, "token", nil)
+		assert.Equal(t, http.StatusBadGateway, rec.Code)
+	})
+
+	t.Run("authenticated user skips challenge", func(t *testing.T) {
+		v := &stubVerifier{}
+		rec := runCaptchaMiddleware(v, "", "user-123")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Zero(t, v.callCount)
+	})
+
+	t.Run("nil verifier disables check", func(t *testing.T) {
+		rec := runCaptchaMiddleware(nil, "", nil)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ClientIPExtractor decides what c.RealIP() returns, which keys the per-address
+// rate limits, reservation caps and abuse signals. Without trusted proxies the
+// peer address is used and X-Forwarded-For is ignored, as any client can send it.
+// Behind proxies, X-Forwarded-For is read right to left and the first address
+// outside the trusted ranges wins, so an address a client prepends is skipped.
+func ClientIPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, ipRange := range trustedProxies {
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
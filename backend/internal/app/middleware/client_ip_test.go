@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIPExtractor(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	cfg := PublicGuardConfig{RequestsPerMinute: 2, TokenSecret: "secret"}
+
+	fromAddress := func(remoteAddr, forwardedFor string) *http.Request {
+		req := browserRequest(http.MethodGet, "/api/public/wishlists/anna")
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		return req
+	}
+
+	t.Run("spoofed X-Forwarded-For does not reset the limit", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		e.IPExtractor = ClientIPExtractor(nil)
+
+		for _, spoofed := range []string{"", "198.51.100.1"} {
+			assert.Equal(t, http.StatusOK, serve(e, fromAddress("203.0.113.7:1234", spoofed)).Code)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, fromAddress("203.0.113.7:1234", "198.51.100.2")).Code)
+	})
+
+	t.Run("trusted proxies forward the client address", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		e.IPExtractor = ClientIPExtractor([]*net.IPNet{proxies})
+
+		for range 2 {
+			assert.Equal(t, http.StatusOK, serve(e, fromAddress("10.0.0.2:443", "203.0.113.7")).Code)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, fromAddress("10.0.0.2:443", "203.0.113.7")).Code)
+		assert.Equal(t, http.StatusOK, serve(e, fromAddress("10.0.0.2:443", "203.0.113.8")).Code, "other clients behind the proxy have their own limit")
+	})
+
+	t.Run("addresses a client prepends through a proxy are skipped", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		e.IPExtractor = ClientIPExtractor([]*net.IPNet{proxies})
+
+		for _, spoofed := range []string{"198.51.100.1", "198.51.100.2"} {
+			assert.Equal(t, http.StatusOK, serve(e, fromAddress("10.0.0.2:443", spoofed+", 203.0.113.7")).Code)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, fromAddress("10.0.0.2:443", "198.51.100.3, 203.0.113.7")).Code)
+	})
+
+	t.Run("untrusted peers cannot pose as a proxy", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		e.IPExtractor = ClientIPExtractor([]*net.IPNet{proxies})
+
+		for _, spoofed := range []string{"198.51.100.1", "198.51.100.2"} {
+			assert.Equal(t, http.StatusOK, serve(e, fromAddress("203.0.113.7:1234", spoofed)).Code)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, fromAddress("203.0.113.7:1234", "198.51.100.3")).Code)
+	})
+}
@@ -1,13 +1,16 @@
 package middleware
 
 import (
-	"encoding/json"
 	"errors"
-	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 
+	"wish-list/internal/app/database"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/requestid"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -16,8 +19,11 @@ import (
 // CustomHTTPErrorHandler handles all errors returned from handlers and middleware.
 // It produces a unified JSON error response:
 //
-//	{"error": "message"}                              — standard errors
-//	{"error": "Validation failed", "details": {...}}  — validation errors
+//	{"error": "message", "request_id": "..."}                              — standard errors
+//	{"error": "Validation failed", "details": {...}, "request_id": "..."}  — validation errors
+//
+// request_id lets support correlate a user report with server logs; it is
+// omitted when the request has none.
 //
 // Priority: AppError > echo.HTTPError > unknown (500).
 func CustomHTTPErrorHandler(err error, c echo.Context) {
@@ -29,7 +35,8 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 	var appErr *apperrors.AppError
 	if errors.As(err, &appErr) {
 		if appErr.Err != nil {
-			c.Logger().Errorf("Application error: %v", appErr.Err)
+			logger.ErrorContext(c.Request().Context(), "application error",
+				"error", appErr.Err, "status", appErr.Code, "path", c.Request().URL.Path)
 		}
 
 		sendAppErrorResponse(c, appErr)
@@ -45,35 +52,51 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 			message = msg
 		}
 
-		c.Logger().Errorf("HTTP error: %d - %s - %s", code, c.Request().URL.Path, message)
-		_ = c.JSON(code, map[string]string{"error": message})
+		logger.ErrorContext(c.Request().Context(), "http error",
+			"status", code, "path", c.Request().URL.Path, "message", message)
+		_ = c.JSON(code, errorBody(c, message))
 		return
 	}
 
 	// 3. Unknown errors — log and return generic 500
-	c.Logger().Errorf("Unhandled error: %v", err)
-	_ = c.JSON(http.StatusInternalServerError, map[string]string{
-		"error": "Internal server error",
-	})
+	logger.ErrorContext(c.Request().Context(), "unhandled error",
+		"error", err, "path", c.Request().URL.Path)
+	_ = c.JSON(http.StatusInternalServerError, errorBody(c, "Internal server error"))
 }
 
 // sendAppErrorResponse writes the AppError as JSON.
 // Validation errors include a "details" field.
 func sendAppErrorResponse(c echo.Context, appErr *apperrors.AppError) {
+	body := errorBody(c, appErr.Message)
 	if len(appErr.Details) > 0 {
-		_ = c.JSON(appErr.Code, map[string]any{
-			"error":   appErr.Message,
-			"details": appErr.Details,
-		})
-		return
+		body["details"] = appErr.Details
 	}
 
-	_ = c.JSON(appErr.Code, map[string]string{"error": appErr.Message})
+	_ = c.JSON(appErr.Code, body)
+}
+
+// errorBody builds the JSON error envelope, tagged with the request ID
+func errorBody(c echo.Context, message string) map[string]any {
+	body := map[string]any{"error": message}
+	if id := requestid.FromContext(c.Request().Context()); id != "" {
+		body["request_id"] = id
+	}
+	return body
 }
 
-// RequestIDMiddleware adds a unique request ID to each request.
+// RequestIDMiddleware adds a unique request ID to each request. The ID is stored
+// in the request context, together with a request-scoped logger carrying it, so
+// services and notifications can reference it. The matched route is stored too, so
+// slow database queries can be traced to their handler.
 func RequestIDMiddleware() echo.MiddlewareFunc {
-	return middleware.RequestID()
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			ctx := requestid.NewContext(c.Request().Context(), requestID)
+			ctx = database.WithRoute(ctx, c.Request().Method+" "+c.Path())
+			ctx = logger.WithContext(ctx, "request_id", requestID)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
 }
 
 // LoggerMiddleware adds structured logging for requests.
@@ -85,26 +108,57 @@ func LoggerMiddleware() echo.MiddlewareFunc {
 		LogLatency:   true,
 		LogRemoteIP:  true,
 		LogUserAgent: true,
-		LogRequestID: true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			logEntry := map[string]any{
-				"time":       time.Now().Format(time.RFC3339),
-				"method":     v.Method,
-				"uri":        v.URI,
-				"status":     v.Status,
-				"latency":    v.Latency.String(),
-				"ip":         v.RemoteIP,
-				"user_agent": v.UserAgent,
-				"request_id": v.RequestID,
-			}
-			if data, err := json.Marshal(logEntry); err == nil {
-				fmt.Println(string(data))
+			// The request-scoped logger already carries request_id and, for
+			// authenticated routes, user_id
+			ctx := c.Request().Context()
+			level := slog.LevelInfo
+			switch {
+			case v.Status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case v.Status >= http.StatusBadRequest:
+				level = slog.LevelWarn
 			}
+			logger.FromContext(ctx).LogAttrs(ctx, level, "http request",
+				slog.String("method", v.Method),
+				slog.String("uri", redactURI(v.URI)),
+				slog.Int("status", v.Status),
+				slog.String("latency", v.Latency.String()),
+				slog.String("ip", v.RemoteIP),
+				slog.String("user_agent", v.UserAgent),
+			)
 			return nil
 		},
 	})
 }
 
+// redactedQueryParams are query parameters that carry credentials, e.g. the API token
+// of a calendar feed subscription URL
+var redactedQueryParams = []string{"token"}
+
+// redactURI masks credentials in a request URI before it is logged
+func redactURI(uri string) string {
+	u, err := url.ParseRequestURI(uri)
+	if err != nil || u.RawQuery == "" {
+		return uri
+	}
+
+	query := u.Query()
+	redacted := false
+	for _, param := range redactedQueryParams {
+		if query.Has(param) {
+			query.Set(param, "xxxxx")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return uri
+	}
+
+	u.RawQuery = query.Encode()
+	return u.RequestURI()
+}
+
 // RecoverMiddleware recovers from panics and logs the error.
 func RecoverMiddleware() echo.MiddlewareFunc {
 	return middleware.RecoverWithConfig(middleware.RecoverConfig{
@@ -125,7 +179,11 @@ func RateLimiterMiddleware() echo.MiddlewareFunc {
 	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
 		Store: middleware.NewRateLimiterMemoryStore(20),
 		Skipper: func(c echo.Context) bool {
-			return c.Path() == "/healthz"
+			switch c.Path() {
+			case "/healthz", "/livez", "/readyz":
+				return true
+			}
+			return false
 		},
 		IdentifierExtractor: func(c echo.Context) (string, error) {
 			ip := c.RealIP()
@@ -135,9 +193,7 @@ func RateLimiterMiddleware() echo.MiddlewareFunc {
 			return ip, nil
 		},
 		ErrorHandler: func(c echo.Context, err error) error {
-			return c.JSON(http.StatusTooManyRequests, map[string]string{
-				"error": "Rate limit exceeded",
-			})
+			return c.JSON(http.StatusTooManyRequests, errorBody(c, "Rate limit exceeded"))
 		},
 	})
 }
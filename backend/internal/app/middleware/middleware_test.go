@@ -1,14 +1,17 @@
 package middleware
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -146,6 +149,47 @@ func TestRequestIDMiddleware(t *testing.T) {
 	assert.NotEmpty(t, rec.Header().Get("X-Request-ID"))
 }
 
+func TestRequestIDMiddleware_ScopesLogger(t *testing.T) {
+	e := echo.New()
+
+	var buf bytes.Buffer
+	base := slog.New(slog.NewJSONHandler(&buf, nil))
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req = req.WithContext(logger.NewContext(req.Context(), base))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := RequestIDMiddleware()(func(c echo.Context) error {
+		logger.InfoContext(c.Request().Context(), "handled")
+		return c.NoContent(http.StatusOK)
+	})
+	require.NoError(t, handler(c))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), entry["request_id"])
+}
+
+func TestCustomHTTPErrorHandler_IncludesRequestID(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = CustomHTTPErrorHandler
+	e.Use(RequestIDMiddleware())
+	e.GET("/", func(c echo.Context) error {
+		return apperrors.NotFound("Wish list not found")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "Wish list not found", body["error"])
+	assert.Equal(t, "req-123", body["request_id"])
+}
+
 func TestLoggerMiddleware(t *testing.T) {
 	e := echo.New()
 
@@ -163,6 +207,25 @@ func TestLoggerMiddleware(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
+func TestLoggerMiddleware_RedactsTokens(t *testing.T) {
+	e := echo.New()
+
+	var buf bytes.Buffer
+	req := httptest.NewRequest(http.MethodGet, "/api/protected/calendar.ics?token=wlt_secret&v=1", http.NoBody)
+	req = req.WithContext(logger.NewContext(req.Context(), slog.New(slog.NewJSONHandler(&buf, nil))))
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	handler := LoggerMiddleware()(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	require.NoError(t, handler(c))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "/api/protected/calendar.ics?token=xxxxx&v=1", entry["uri"])
+	assert.NotContains(t, buf.String(), "wlt_secret")
+}
+
 func TestRecoverMiddleware(t *testing.T) {
 	e := echo.New()
 
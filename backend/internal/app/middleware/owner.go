@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// ErrResourceNotFound is returned by an OwnerLoader when no resource has the ID
+var ErrResourceNotFound = errors.New("resource not found")
+
+// OwnerLoader loads the resource with the given ID and returns it with its owner's ID
+type OwnerLoader[T any] func(ctx context.Context, id pgtype.UUID) (T, pgtype.UUID, error)
+
+// RequireOwner loads the resource named by the path parameter param and allows the
+// request only when the authenticated user owns it. The resource is stored under key
+// so the handler does not load it again, see OwnedResource. Malformed and unknown IDs
+// get 404 "<resource> not found" and other users' resources 403 "Access denied".
+// It must run after the JWT middleware.
+func RequireOwner[T any](param, key, resource string, load OwnerLoader[T]) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userIDStr, _, _, err := auth.GetUserFromContext(c)
+			if err != nil {
+				return apperrors.Unauthorized("Authentication required")
+			}
+
+			userID := pgtype.UUID{}
+			if err := userID.Scan(userIDStr); err != nil {
+				return apperrors.Unauthorized("Authentication required")
+			}
+
+			id := pgtype.UUID{}
+			if err := id.Scan(c.Param(param)); err != nil {
+				return apperrors.NotFound(resource + " not found")
+			}
+
+			owned, ownerID, err := load(c.Request().Context(), id)
+			if errors.Is(err, ErrResourceNotFound) {
+				return apperrors.NotFound(resource + " not found")
+			}
+			if err != nil {
+				return apperrors.Internal("Failed to check ownership").Wrap(err)
+			}
+
+			if ownerID != userID {
+				return apperrors.Forbidden("Access denied")
+			}
+
+			c.Set(key, owned)
+			return next(c)
+		}
+	}
+}
+
+// OwnedResource returns the resource RequireOwner stored under key. ok is false when
+// the route is not guarded by RequireOwner.
+func OwnedResource[T any](c echo.Context, key string) (resource T, ok bool) {
+	resource, ok = c.Get(key).(T)
+	return resource, ok
+}
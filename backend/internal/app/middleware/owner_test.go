@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type ownedThing struct {
+	Name string
+}
+
+func runRequireOwner(load OwnerLoader[*ownedThing], userID any, id string) (*httptest.ResponseRecorder, *ownedThing) {
+	e := echo.New()
+	e.HTTPErrorHandler = CustomHTTPErrorHandler
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+	if userID != nil {
+		c.Set("user_id", userID)
+	}
+
+	var seen *ownedThing
+	handler := RequireOwner("id", "owned_thing", "Thing", load)(func(c echo.Context) error {
+		seen, _ = OwnedResource[*ownedThing](c, "owned_thing")
+		return c.NoContent(http.StatusNoContent)
+	})
+	if err := handler(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec, seen
+}
+
+func TestRequireOwner(t *testing.T) {
+	const (
+		userID  = "01010101-0101-0101-0101-010101010101"
+		thingID = "02020202-0202-0202-0202-020202020202"
+	)
+	owner := pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	someoneElse := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+
+	loadOwnedBy := func(ownerID pgtype.UUID) OwnerLoader[*ownedThing] {
+		return func(ctx context.Context, id pgtype.UUID) (*ownedThing, pgtype.UUID, error) {
+			return &ownedThing{Name: id.String()}, ownerID, nil
+		}
+	}
+
+	t.Run("owner passes with the resource in context", func(t *testing.T) {
+		rec, seen := runRequireOwner(loadOwnedBy(owner), userID, thingID)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		if assert.NotNil(t, seen) {
+			assert.Equal(t, thingID, seen.Name)
+		}
+	})
+
+	t.Run("other user is forbidden", func(t *testing.T) {
+		rec, seen := runRequireOwner(loadOwnedBy(someoneElse), userID, thingID)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Nil(t, seen)
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		load := func(ctx context.Context, id pgtype.UUID) (*ownedThing, pgtype.UUID, error) {
+			return nil, pgtype.UUID{}, ErrResourceNotFound
+		}
+		rec, _ := runRequireOwner(load, userID, thingID)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Thing not found")
+	})
+
+	t.Run("malformed ID is not found without loading", func(t *testing.T) {
+		load := func(ctx context.Context, id pgtype.UUID) (*ownedThing, pgtype.UUID, error) {
+			t.Fatal("loader must not be called")
+			return nil, pgtype.UUID{}, nil
+		}
+		rec, _ := runRequireOwner(load, userID, "not-a-uuid")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("load failure", func(t *testing.T) {
+		load := func(ctx context.Context, id pgtype.UUID) (*ownedThing, pgtype.UUID, error) {
+			return nil, pgtype.UUID{}, errors.New("connection refused")
+		}
+		rec, _ := runRequireOwner(load, userID, thingID)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		rec, _ := runRequireOwner(loadOwnedBy(owner), nil, thingID)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
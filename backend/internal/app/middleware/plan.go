@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// PlanLookup resolves the plan a user is on
+type PlanLookup interface {
+	GetPlan(ctx context.Context, userID pgtype.UUID) (string, error)
+}
+
+// RequirePlan allows the request only when the authenticated user is on one of the
+// given plans; everyone else gets 402 so clients can offer an upgrade.
+// It must run after the JWT middleware.
+func RequirePlan(lookup PlanLookup, plans ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userIDStr, _, _, err := auth.GetUserFromContext(c)
+			if err != nil {
+				return apperrors.Unauthorized("Authentication required")
+			}
+
+			userID := pgtype.UUID{}
+			if err := userID.Scan(userIDStr); err != nil {
+				return apperrors.Unauthorized("Authentication required")
+			}
+
+			plan, err := lookup.GetPlan(c.Request().Context(), userID)
+			if err != nil {
+				return apperrors.Internal("Failed to check plan").Wrap(err)
+			}
+
+			if !slices.Contains(plans, plan) {
+				return apperrors.PaymentRequired("This feature requires a premium plan")
+			}
+
+			c.Set("plan", plan)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubPlanLookup struct {
+	plan string
+	err  error
+}
+
+func (s stubPlanLookup) GetPlan(context.Context, pgtype.UUID) (string, error) {
+	return s.plan, s.err
+}
+
+func runRequirePlan(lookup PlanLookup, userID any) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.HTTPErrorHandler = CustomHTTPErrorHandler
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if userID != nil {
+		c.Set("user_id", userID)
+	}
+
+	handler := RequirePlan(lookup, "premium")(func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	if err := handler(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec
+}
+
+func TestRequirePlan(t *testing.T) {
+	const userID = "01010101-0101-0101-0101-010101010101"
+
+	t.Run("allowed plan passes", func(t *testing.T) {
+		rec := runRequirePlan(stubPlanLookup{plan: "premium"}, userID)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("other plan must upgrade", func(t *testing.T) {
+		rec := runRequirePlan(stubPlanLookup{plan: "free"}, userID)
+		assert.Equal(t, http.StatusPaymentRequired, rec.Code)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		rec := runRequirePlan(stubPlanLookup{plan: "premium"}, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		rec := runRequirePlan(stubPlanLookup{err: errors.New("db down")}, userID)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PublicTokenHeader carries a token the web app's server signs to vouch for the
+// requests it makes while rendering public pages for many visitors from one address
+const PublicTokenHeader = "X-Public-Token"
+
+// publicTokenMaxTTL caps how far ahead a signed public token may expire, so a leaked
+// token is only useful for a short while
+const publicTokenMaxTTL = time.Hour
+
+// publicPathPrefix is the part of the API open to anyone who knows a slug
+const publicPathPrefix = "/api/public/"
+
+// automationAgents are User-Agent fragments of HTTP libraries and crawlers, lowercase
+var automationAgents = []string{
+	"curl", "wget", "python-requests", "python-urllib", "aiohttp", "httpx", "go-http-client",
+	"java/", "okhttp", "libwww-perl", "scrapy", "headlesschrome", "phantomjs", "bot", "spider", "crawler",
+}
+
+// PublicGuardConfig holds the limits for the public API
+type PublicGuardConfig struct {
+	// RequestsPerMinute is how many public requests a client address may make per minute; 0 disables throttling
+	RequestsPerMinute int
+	// SuspiciousPerMinute is the lower limit for requests that look automated
+	SuspiciousPerMinute int
+	// TokenSecret verifies PublicTokenHeader; empty disables signed tokens
+	TokenSecret string
+}
+
+// PublicGuard makes crawling /api/public/* expensive without getting in the way of
+// people opening a shared link:
+//   - every client address gets RequestsPerMinute public requests per minute, and
+//     requests that look automated (see looksAutomated) count against the much lower
+//     SuspiciousPerMinute instead;
+//   - requests carrying a valid signed PublicTokenHeader are not throttled;
+//   - reads of a slug answer every 403 and 404 with the same 404, so probing slugs
+//     cannot tell a private or taken down wishlist from one that does not exist.
+//
+// Register it with Echo#Use; other paths pass through untouched.
+func PublicGuard(cfg PublicGuardConfig) echo.MiddlewareFunc {
+	var limiter, suspicious *AuthRateLimiter
+	if cfg.RequestsPerMinute > 0 {
+		limiter = NewAuthRateLimiter(RateLimitConfig{Requests: cfg.RequestsPerMinute, Window: time.Minute, BurstSize: cfg.RequestsPerMinute})
+		suspicious = NewAuthRateLimiter(RateLimitConfig{Requests: cfg.SuspiciousPerMinute, Window: time.Minute, BurstSize: cfg.SuspiciousPerMinute})
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !strings.HasPrefix(c.Path(), publicPathPrefix) {
+				return next(c)
+			}
+
+			if limiter != nil && !validPublicToken(cfg.TokenSecret, c.Request().Header.Get(PublicTokenHeader), time.Now()) {
+				rl := limiter
+				if looksAutomated(c.Request()) {
+					rl = suspicious
+				}
+				ip := c.RealIP()
+				if !rl.Allow(ip) {
+					return c.JSON(http.StatusTooManyRequests, errorBody(c, "Too many requests. Please try again later."))
+				}
+				c.Response().Header().Set("X-RateLimit-Limit", strconv.Itoa(rl.config.BurstSize))
+				c.Response().Header().Set("X-RateLimit-Remaining", strconv.Itoa(rl.Remaining(ip)))
+			}
+
+			err := next(c)
+			if err != nil && c.Request().Method == http.MethodGet && c.Param("slug") != "" {
+				if code := errorStatus(err); code == http.StatusForbidden || code == http.StatusNotFound {
+					return apperrors.NotFound("Not found")
+				}
+			}
+			return err
+		}
+	}
+}
+
+// looksAutomated reports whether a request lacks the headers every browser sends or
+// names an HTTP library or crawler as its User-Agent. Scrapers can fake all of this;
+// the signals only lower the limit for the lazy ones.
+func looksAutomated(r *http.Request) bool {
+	agent := strings.ToLower(r.UserAgent())
+	if agent == "" || r.Header.Get("Accept") == "" || r.Header.Get("Accept-Language") == "" {
+		return true
+	}
+	for _, fragment := range automationAgents {
+		if strings.Contains(agent, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignPublicToken returns a PublicTokenHeader value valid until expires:
+// "<expiry as unix seconds>.<hex HMAC-SHA256 of the expiry with secret>"
+func SignPublicToken(secret string, expires time.Time) string {
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + publicTokenMAC(secret, exp)
+}
+
+func validPublicToken(secret, token string, now time.Time) bool {
+	if secret == "" || token == "" {
+		return false
+	}
+
+	exp, mac, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return false
+	}
+	expires := time.Unix(unix, 0)
+	if !now.Before(expires) || expires.Sub(now) > publicTokenMaxTTL {
+		return false
+	}
+
+	return hmac.Equal([]byte(mac), []byte(publicTokenMAC(secret, exp)))
+}
+
+func publicTokenMAC(secret, exp string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(exp))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// errorStatus returns the HTTP status an error will be answered with, 0 if unknown
+func errorStatus(err error) int {
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return 0
+}
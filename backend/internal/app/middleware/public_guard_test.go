@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPublicGuardServer(cfg PublicGuardConfig) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = CustomHTTPErrorHandler
+	e.Use(PublicGuard(cfg))
+
+	e.GET("/api/public/wishlists/:slug", func(c echo.Context) error {
+		switch c.Param("slug") {
+		case "private":
+			return apperrors.Forbidden("Access denied")
+		case "missing":
+			return apperrors.NotFound("Wish list not found")
+		}
+		return c.NoContent(http.StatusOK)
+	})
+	e.POST("/api/public/wishlists/:slug/report", func(c echo.Context) error {
+		return apperrors.Forbidden("CAPTCHA verification failed")
+	})
+	e.GET("/api/wishlists", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	return e
+}
+
+func browserRequest(method, path string) *http.Request {
+	req := httptest.NewRequest(method, path, http.NoBody)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Language", "en-US")
+	return req
+}
+
+func serve(e *echo.Echo, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPublicGuard_Throttling(t *testing.T) {
+	cfg := PublicGuardConfig{RequestsPerMinute: 3, SuspiciousPerMinute: 1, TokenSecret: "secret"}
+
+	t.Run("browsers get the full limit", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		for range 3 {
+			assert.Equal(t, http.StatusOK, serve(e, browserRequest(http.MethodGet, "/api/public/wishlists/anna")).Code)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, browserRequest(http.MethodGet, "/api/public/wishlists/anna")).Code)
+	})
+
+	t.Run("automated clients get the lower limit", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		curl := func() *http.Request {
+			req := browserRequest(http.MethodGet, "/api/public/wishlists/anna")
+			req.Header.Set("User-Agent", "curl/8.5.0")
+			return req
+		}
+
+		assert.Equal(t, http.StatusOK, serve(e, curl()).Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve(e, curl()).Code)
+	})
+
+	t.Run("signed token skips the limit", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		token := SignPublicToken("secret", time.Now().Add(time.Minute))
+		for range 5 {
+			req := httptest.NewRequest(http.MethodGet, "/api/public/wishlists/anna", http.NoBody)
+			req.Header.Set(PublicTokenHeader, token)
+			assert.Equal(t, http.StatusOK, serve(e, req).Code)
+		}
+	})
+
+	t.Run("other paths are not throttled", func(t *testing.T) {
+		e := newPublicGuardServer(cfg)
+		for range 5 {
+			assert.Equal(t, http.StatusOK, serve(e, httptest.NewRequest(http.MethodGet, "/api/wishlists", http.NoBody)).Code)
+		}
+	})
+
+	t.Run("zero limit disables throttling", func(t *testing.T) {
+		e := newPublicGuardServer(PublicGuardConfig{})
+		for range 5 {
+			assert.Equal(t, http.StatusOK, serve(e, httptest.NewRequest(http.MethodGet, "/api/public/wishlists/anna", http.NoBody)).Code)
+		}
+	})
+}
+
+func TestPublicGuard_NormalizesSlugErrors(t *testing.T) {
+	e := newPublicGuardServer(PublicGuardConfig{})
+
+	private := serve(e, browserRequest(http.MethodGet, "/api/public/wishlists/private"))
+	missing := serve(e, browserRequest(http.MethodGet, "/api/public/wishlists/missing"))
+
+	assert.Equal(t, http.StatusNotFound, private.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	assert.JSONEq(t, missing.Body.String(), private.Body.String())
+
+	// Writes keep their status, e.g. so clients can tell a failed CAPTCHA apart
+	report := serve(e, browserRequest(http.MethodPost, "/api/public/wishlists/anna/report"))
+	assert.Equal(t, http.StatusForbidden, report.Code)
+}
+
+func TestValidPublicToken(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{"valid", SignPublicToken("secret", now.Add(time.Minute)), true},
+		{"expired", SignPublicToken("secret", now.Add(-time.Second)), false},
+		{"expires too far ahead", SignPublicToken("secret", now.Add(2*time.Hour)), false},
+		{"other secret", SignPublicToken("other", now.Add(time.Minute)), false},
+		{"malformed", "not-a-token", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validPublicToken("secret", tt.token, now))
+		})
+	}
+
+	assert.False(t, validPublicToken("", SignPublicToken("", now.Add(time.Minute)), now), "empty secret disables tokens")
+}
@@ -35,6 +35,23 @@ var AuthRateLimits = struct {
 	OAuth:         RateLimitConfig{Requests: 5, Window: time.Minute, BurstSize: 5},
 }
 
+// GuestWriteRateLimits defines rate limits for public endpoints that let guests write content
+var GuestWriteRateLimits = struct {
+	Comment     RateLimitConfig
+	Suggestion  RateLimitConfig
+	Report      RateLimitConfig
+	RSVP        RateLimitConfig
+	View        RateLimitConfig
+	InviteVisit RateLimitConfig
+}{
+	Comment:     RateLimitConfig{Requests: 5, Window: time.Minute, BurstSize: 5},
+	Suggestion:  RateLimitConfig{Requests: 5, Window: time.Minute, BurstSize: 5},
+	Report:      RateLimitConfig{Requests: 3, Window: time.Minute, BurstSize: 3},
+	RSVP:        RateLimitConfig{Requests: 5, Window: time.Minute, BurstSize: 5},
+	View:        RateLimitConfig{Requests: 30, Window: time.Minute, BurstSize: 30},
+	InviteVisit: RateLimitConfig{Requests: 10, Window: time.Minute, BurstSize: 10},
+}
+
 // rateLimitEntry tracks request count for a single identifier
 type rateLimitEntry struct {
 	count     int
@@ -70,6 +87,12 @@ func NewAuthRateLimiterWithContext(ctx context.Context, config RateLimitConfig)
 // Allow checks if the request from the given identifier should be allowed.
 // Returns true if allowed, false if rate limited.
 func (rl *AuthRateLimiter) Allow(identifier string) bool {
+	return rl.AllowWithLimit(identifier, rl.config.BurstSize)
+}
+
+// AllowWithLimit is Allow with a per-identifier limit instead of the configured
+// burst size, for callers such as API keys that each carry their own limit
+func (rl *AuthRateLimiter) AllowWithLimit(identifier string, limit int) bool {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -86,7 +109,7 @@ func (rl *AuthRateLimiter) Allow(identifier string) bool {
 	}
 
 	// Within existing window
-	if entry.count < rl.config.BurstSize {
+	if entry.count < limit {
 		entry.count++
 		return true
 	}
@@ -96,6 +119,11 @@ func (rl *AuthRateLimiter) Allow(identifier string) bool {
 
 // Remaining returns the number of remaining requests for the identifier
 func (rl *AuthRateLimiter) Remaining(identifier string) int {
+	return rl.RemainingWithLimit(identifier, rl.config.BurstSize)
+}
+
+// RemainingWithLimit is Remaining for an identifier rate limited with AllowWithLimit
+func (rl *AuthRateLimiter) RemainingWithLimit(identifier string, limit int) int {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -103,10 +131,10 @@ func (rl *AuthRateLimiter) Remaining(identifier string) int {
 	entry, exists := rl.entries[identifier]
 
 	if !exists || now.After(entry.windowEnd) {
-		return rl.config.BurstSize
+		return limit
 	}
 
-	remaining := rl.config.BurstSize - entry.count
+	remaining := limit - entry.count
 	if remaining < 0 {
 		return 0
 	}
@@ -156,9 +184,7 @@ func AuthRateLimitMiddleware(limiter *AuthRateLimiter, identifierFunc func(echo.
 			identifier := identifierFunc(c)
 
 			if !limiter.Allow(identifier) {
-				return c.JSON(http.StatusTooManyRequests, map[string]string{
-					"error": "Too many requests. Please try again later.",
-				})
+				return c.JSON(http.StatusTooManyRequests, errorBody(c, "Too many requests. Please try again later."))
 			}
 
 			// Add rate limit headers
@@ -211,3 +237,33 @@ func NewRefreshRateLimiter() *AuthRateLimiter {
 func NewOAuthRateLimiter() *AuthRateLimiter {
 	return NewAuthRateLimiter(AuthRateLimits.OAuth)
 }
+
+// NewCommentRateLimiter creates a rate limiter configured for posting item comments
+func NewCommentRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.Comment)
+}
+
+// NewSuggestionRateLimiter creates a rate limiter configured for submitting item suggestions
+func NewSuggestionRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.Suggestion)
+}
+
+// NewReportRateLimiter creates a rate limiter configured for reporting public wishlists
+func NewReportRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.Report)
+}
+
+// NewRSVPRateLimiter creates a rate limiter configured for answering occasion RSVPs
+func NewRSVPRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.RSVP)
+}
+
+// NewViewRateLimiter creates a rate limiter configured for counting public wishlist views
+func NewViewRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.View)
+}
+
+// NewInviteVisitRateLimiter creates a rate limiter configured for counting visits through invite links
+func NewInviteVisitRateLimiter() *AuthRateLimiter {
+	return NewAuthRateLimiter(GuestWriteRateLimits.InviteVisit)
+}
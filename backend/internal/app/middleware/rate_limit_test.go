@@ -106,6 +106,20 @@ func TestAuthRateLimiter_Remaining(t *testing.T) {
 	})
 }
 
+func TestAuthRateLimiter_AllowWithLimit(t *testing.T) {
+	limiter := NewAuthRateLimiter(RateLimitConfig{Window: time.Minute})
+
+	for i := range 5 {
+		assert.True(t, limiter.AllowWithLimit("key-a", 5), "request %d should be allowed", i+1)
+	}
+	assert.False(t, limiter.AllowWithLimit("key-a", 5))
+	assert.Equal(t, 0, limiter.RemainingWithLimit("key-a", 5))
+
+	assert.True(t, limiter.AllowWithLimit("key-b", 2), "limits are tracked per identifier")
+	assert.Equal(t, 1, limiter.RemainingWithLimit("key-b", 2))
+	assert.Equal(t, 10, limiter.RemainingWithLimit("key-c", 10))
+}
+
 func TestAuthRateLimiter_Reset(t *testing.T) {
 	config := RateLimitConfig{
 		Requests:  2,
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"expvar"
+
+	"wish-list/internal/app/jobs"
+	"wish-list/internal/app/middleware"
+
+	apitokenhttp "wish-list/internal/domain/apitoken/delivery/http"
+	apitokenmodels "wish-list/internal/domain/apitoken/models"
+	authhttp "wish-list/internal/domain/auth/delivery/http"
+	billinghttp "wish-list/internal/domain/billing/delivery/http"
+	calendarhttp "wish-list/internal/domain/calendar/delivery/http"
+	commenthttp "wish-list/internal/domain/comment/delivery/http"
+	contacthttp "wish-list/internal/domain/contact/delivery/http"
+	dataexporthttp "wish-list/internal/domain/data_export/delivery/http"
+	discoveryhttp "wish-list/internal/domain/discovery/delivery/http"
+	followhttp "wish-list/internal/domain/follow/delivery/http"
+	gifthistoryhttp "wish-list/internal/domain/gift_history/delivery/http"
+	healthhttp "wish-list/internal/domain/health/delivery/http"
+	itemhttp "wish-list/internal/domain/item/delivery/http"
+	moderationhttp "wish-list/internal/domain/moderation/delivery/http"
+	notificationhttp "wish-list/internal/domain/notification/delivery/http"
+	outboundhttp "wish-list/internal/domain/outbound/delivery/http"
+	partnerhttp "wish-list/internal/domain/partner/delivery/http"
+	privacyhttp "wish-list/internal/domain/privacy/delivery/http"
+	quotahttp "wish-list/internal/domain/quota/delivery/http"
+	registryhttp "wish-list/internal/domain/registry/delivery/http"
+	reservationhttp "wish-list/internal/domain/reservation/delivery/http"
+	retentionhttp "wish-list/internal/domain/retention/delivery/http"
+	rsvphttp "wish-list/internal/domain/rsvp/delivery/http"
+	shipmenthttp "wish-list/internal/domain/shipment/delivery/http"
+	statshttp "wish-list/internal/domain/stats/delivery/http"
+	storagehttp "wish-list/internal/domain/storage/delivery/http"
+	suggestionhttp "wish-list/internal/domain/suggestion/delivery/http"
+	userhttp "wish-list/internal/domain/user/delivery/http"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlisthttp "wish-list/internal/domain/wishlist/delivery/http"
+	wishlistitemhttp "wish-list/internal/domain/wishlist_item/delivery/http"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/emailtemplate"
+	"wish-list/internal/pkg/metrics"
+
+	"github.com/labstack/echo/v4"
+)
+
+// registerRoutes registers every domain's routes. Each domain owns its paths in its
+// delivery/http/routes.go; this only builds the shared middleware and hands it out.
+func (a *App) registerRoutes(e *echo.Echo) {
+	// Auth middleware for protected routes
+	authMiddleware := auth.JWTMiddleware(a.tokenManager)
+	optionalAuthMiddleware := auth.OptionalJWTMiddleware(a.tokenManager)
+	captchaMiddleware := middleware.CaptchaMiddleware(a.captchaVerifier)
+	premiumMiddleware := middleware.RequirePlan(a.planLookup, usermodels.PlanPremium)
+	itemsTokenMiddleware := apitokenhttp.TokenMiddleware(a.apiTokens, apitokenmodels.ScopeItemsWrite)
+	wishlistsTokenMiddleware := apitokenhttp.TokenMiddleware(a.apiTokens, apitokenmodels.ScopeWishlistsRead)
+	calendarTokenMiddleware := apitokenhttp.FeedTokenMiddleware(a.apiTokens, apitokenmodels.ScopeCalendarRead)
+	wishListOwnerMiddleware := wishlisthttp.RequireWishListOwner(a.wishListOwners)
+	itemOwnerMiddleware := itemhttp.RequireItemOwner(a.itemOwners)
+
+	// Register all domain routes
+	healthhttp.RegisterRoutes(e, a.healthHandler)
+	userhttp.RegisterRoutes(e, a.userHandler, authMiddleware, captchaMiddleware)
+	userhttp.RegisterProfileRoutes(e, a.profileHandler, authMiddleware)
+	authhttp.RegisterRoutes(e, a.authHandler, a.oauthHandler, authMiddleware)
+	wishlisthttp.RegisterRoutes(e, a.wishlistHandler, authMiddleware, wishlistsTokenMiddleware)
+	wishlisthttp.RegisterPresenceRoutes(e, a.presenceHandler, authMiddleware, wishListOwnerMiddleware)
+	wishlisthttp.RegisterSnapshotRoutes(e, a.snapshotHandler, authMiddleware, wishListOwnerMiddleware)
+	wishlisthttp.RegisterViewRoutes(e, a.viewHandler)
+	wishlisthttp.RegisterImportRoutes(e, a.importHandler, authMiddleware, wishListOwnerMiddleware)
+	wishlisthttp.RegisterInviteRoutes(e, a.inviteHandler, authMiddleware, wishListOwnerMiddleware)
+	itemhttp.RegisterRoutes(e, a.itemHandler, authMiddleware, itemOwnerMiddleware)
+	itemhttp.RegisterShareLinkRoutes(e, a.shareLinkHandler, authMiddleware, itemOwnerMiddleware)
+	itemhttp.RegisterReceivedRoutes(e, a.receivedHandler, authMiddleware, itemOwnerMiddleware)
+	wishlistitemhttp.RegisterRoutes(e, a.wishlistItemHandler, authMiddleware, itemsTokenMiddleware)
+	reservationhttp.RegisterRoutes(e, a.reservationHandler, optionalAuthMiddleware, authMiddleware, captchaMiddleware)
+	reservationhttp.RegisterGuestLinkRoutes(e, a.guestLinkHandler, authMiddleware)
+	reservationhttp.RegisterShoppingListRoutes(e, a.shoppingListHandler)
+	reservationhttp.RegisterGuestPurchaseRoutes(e, a.purchaseHandler)
+	privacyhttp.RegisterRoutes(e, a.privacyHandler, authMiddleware, captchaMiddleware)
+	commenthttp.RegisterRoutes(e, a.commentHandler, optionalAuthMiddleware, authMiddleware, captchaMiddleware)
+	suggestionhttp.RegisterRoutes(e, a.suggestionHandler, optionalAuthMiddleware, authMiddleware, captchaMiddleware)
+	rsvphttp.RegisterRoutes(e, a.rsvpHandler, authMiddleware, captchaMiddleware)
+	notificationhttp.RegisterRoutes(e, a.notificationHandler, authMiddleware)
+	gifthistoryhttp.RegisterRoutes(e, a.giftHistoryHandler, authMiddleware)
+	registryhttp.RegisterRoutes(e, a.registryHandler, authMiddleware)
+	outboundhttp.RegisterRoutes(e, a.outboundHandler, optionalAuthMiddleware, authMiddleware, premiumMiddleware)
+	quotahttp.RegisterRoutes(e, a.quotaHandler, authMiddleware)
+	billinghttp.RegisterRoutes(e, a.billingHandler, authMiddleware)
+	apitokenhttp.RegisterRoutes(e, a.apiTokenHandler, authMiddleware)
+	followhttp.RegisterRoutes(e, a.followHandler, authMiddleware)
+	calendarhttp.RegisterRoutes(e, a.calendarHandler, authMiddleware, calendarTokenMiddleware)
+	partnerhttp.RegisterRoutes(e, a.partnerHandler, authMiddleware)
+	moderationhttp.RegisterRoutes(e, a.moderationHandler, optionalAuthMiddleware, authMiddleware, captchaMiddleware)
+	shipmenthttp.RegisterRoutes(e, a.shipmentHandler, authMiddleware)
+	statshttp.RegisterRoutes(e, a.statsHandler, authMiddleware)
+	discoveryhttp.RegisterRoutes(e, a.discoveryHandler)
+	contacthttp.RegisterRoutes(e, a.contactHandler, authMiddleware)
+	retentionhttp.RegisterRoutes(e, a.retentionHandler, authMiddleware)
+
+	// Process counters published with expvar, e.g. database_slow_queries per route
+	e.GET("/api/admin/debug/vars", echo.WrapHandler(expvar.Handler()), authMiddleware, auth.RequireUserType("admin"))
+
+	// Business metrics of the reservation funnel for Prometheus, which has no account to log in with
+	if a.cfg.MetricsToken != "" {
+		e.GET("/metrics", echo.WrapHandler(metrics.Handler()), middleware.RequireBearerToken(a.cfg.MetricsToken))
+	}
+
+	// Designers preview transactional emails with sample data; never exposed outside development
+	if a.cfg.IsDevelopment() {
+		e.GET("/api/dev/email-preview/:template", emailtemplate.PreviewHandler(emailtemplate.Embedded(), jobs.EmailPreviews()))
+	}
+
+	if a.storageHandler != nil {
+		storagehttp.RegisterRoutes(e, a.storageHandler, a.tokenManager)
+	}
+	if a.attachmentHandler != nil {
+		itemhttp.RegisterAttachmentRoutes(e, a.attachmentHandler, authMiddleware, itemOwnerMiddleware)
+	}
+	if a.dataExportHandler != nil {
+		dataexporthttp.RegisterRoutes(e, a.dataExportHandler, authMiddleware)
+	}
+}
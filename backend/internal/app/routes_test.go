@@ -0,0 +1,321 @@
+package app
+
+import (
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"wish-list/internal/app/config"
+	"wish-list/internal/app/jobs"
+	apitokenhttp "wish-list/internal/domain/apitoken/delivery/http"
+	authhttp "wish-list/internal/domain/auth/delivery/http"
+	billinghttp "wish-list/internal/domain/billing/delivery/http"
+	calendarhttp "wish-list/internal/domain/calendar/delivery/http"
+	commenthttp "wish-list/internal/domain/comment/delivery/http"
+	contacthttp "wish-list/internal/domain/contact/delivery/http"
+	dataexporthttp "wish-list/internal/domain/data_export/delivery/http"
+	discoveryhttp "wish-list/internal/domain/discovery/delivery/http"
+	followhttp "wish-list/internal/domain/follow/delivery/http"
+	gifthistoryhttp "wish-list/internal/domain/gift_history/delivery/http"
+	healthhttp "wish-list/internal/domain/health/delivery/http"
+	itemhttp "wish-list/internal/domain/item/delivery/http"
+	moderationhttp "wish-list/internal/domain/moderation/delivery/http"
+	notificationhttp "wish-list/internal/domain/notification/delivery/http"
+	outboundhttp "wish-list/internal/domain/outbound/delivery/http"
+	partnerhttp "wish-list/internal/domain/partner/delivery/http"
+	privacyhttp "wish-list/internal/domain/privacy/delivery/http"
+	quotahttp "wish-list/internal/domain/quota/delivery/http"
+	registryhttp "wish-list/internal/domain/registry/delivery/http"
+	reservationhttp "wish-list/internal/domain/reservation/delivery/http"
+	retentionhttp "wish-list/internal/domain/retention/delivery/http"
+	rsvphttp "wish-list/internal/domain/rsvp/delivery/http"
+	shipmenthttp "wish-list/internal/domain/shipment/delivery/http"
+	statshttp "wish-list/internal/domain/stats/delivery/http"
+	storagehttp "wish-list/internal/domain/storage/delivery/http"
+	suggestionhttp "wish-list/internal/domain/suggestion/delivery/http"
+	userhttp "wish-list/internal/domain/user/delivery/http"
+	wishlisthttp "wish-list/internal/domain/wishlist/delivery/http"
+	wishlistitemhttp "wish-list/internal/domain/wishlist_item/delivery/http"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/emailtemplate"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRouteTestApp returns an App with every handler set, including the optional
+// storage, attachment and data export handlers, so that all routes are registered.
+// Handlers are never invoked, so their dependencies are left empty.
+func newRouteTestApp() *App {
+	return &App{
+		cfg:                 &config.Config{},
+		tokenManager:        auth.NewTokenManager("test-secret"),
+		healthHandler:       &healthhttp.Handler{},
+		storageHandler:      &storagehttp.Handler{},
+		dataExportHandler:   &dataexporthttp.Handler{},
+		userHandler:         &userhttp.Handler{},
+		profileHandler:      &userhttp.ProfileHandler{},
+		authHandler:         &authhttp.Handler{},
+		oauthHandler:        &authhttp.OAuthHandler{},
+		wishlistHandler:     &wishlisthttp.Handler{},
+		presenceHandler:     &wishlisthttp.PresenceHandler{},
+		snapshotHandler:     &wishlisthttp.SnapshotHandler{},
+		viewHandler:         &wishlisthttp.ViewHandler{},
+		importHandler:       &wishlisthttp.ImportHandler{},
+		inviteHandler:       &wishlisthttp.InviteHandler{},
+		itemHandler:         &itemhttp.Handler{},
+		attachmentHandler:   &itemhttp.AttachmentHandler{},
+		shareLinkHandler:    &itemhttp.ShareLinkHandler{},
+		receivedHandler:     &itemhttp.ReceivedHandler{},
+		wishlistItemHandler: &wishlistitemhttp.Handler{},
+		reservationHandler:  &reservationhttp.Handler{},
+		guestLinkHandler:    &reservationhttp.GuestLinkHandler{},
+		shoppingListHandler: &reservationhttp.ShoppingListHandler{},
+		purchaseHandler:     &reservationhttp.GuestPurchaseHandler{},
+		privacyHandler:      &privacyhttp.Handler{},
+		commentHandler:      &commenthttp.Handler{},
+		suggestionHandler:   &suggestionhttp.Handler{},
+		rsvpHandler:         &rsvphttp.Handler{},
+		shipmentHandler:     &shipmenthttp.Handler{},
+		notificationHandler: &notificationhttp.Handler{},
+		giftHistoryHandler:  &gifthistoryhttp.Handler{},
+		registryHandler:     &registryhttp.Handler{},
+		outboundHandler:     &outboundhttp.Handler{},
+		quotaHandler:        &quotahttp.Handler{},
+		billingHandler:      &billinghttp.Handler{},
+		apiTokenHandler:     &apitokenhttp.Handler{},
+		followHandler:       &followhttp.Handler{},
+		calendarHandler:     &calendarhttp.Handler{},
+		moderationHandler:   &moderationhttp.Handler{},
+		partnerHandler:      &partnerhttp.Handler{},
+		statsHandler:        &statshttp.Handler{},
+		discoveryHandler:    &discoveryhttp.Handler{},
+		contactHandler:      &contacthttp.Handler{},
+		retentionHandler:    &retentionhttp.Handler{},
+	}
+}
+
+// expectedRoutes is the full route table. Update it when adding, moving or removing
+// a route, so that route changes are visible in review.
+var expectedRoutes = []string{
+	"GET /api/admin/debug/vars",
+	"GET /api/admin/guest-reservation-links",
+	"GET /api/admin/metrics/activity",
+	"GET /api/admin/metrics/backlog",
+	"GET /api/admin/metrics/top-wishlists",
+	"GET /api/admin/moderation/reports",
+	"POST /api/admin/moderation/reports/:id/dismiss",
+	"POST /api/admin/moderation/reports/:id/takedown",
+	"POST /api/admin/moderation/wishlists/:id/restore",
+	"GET /api/admin/partner-keys",
+	"POST /api/admin/partner-keys",
+	"DELETE /api/admin/partner-keys/:id",
+	"GET /api/admin/partner-keys/:id/usage",
+	"PUT /api/admin/partner-keys/:id/webhook-domains",
+	"GET /api/admin/privacy-requests",
+	"POST /api/admin/privacy-requests/:id/approve",
+	"POST /api/admin/privacy-requests/:id/reject",
+	"GET /api/admin/retention/policies",
+	"PUT /api/admin/retention/policies/:class",
+	"GET /api/admin/retention/report",
+	"POST /api/auth/account/cancel-deletion",
+	"POST /api/auth/change-email",
+	"POST /api/auth/change-password",
+	"POST /api/auth/exchange",
+	"POST /api/auth/login",
+	"POST /api/auth/logout",
+	"POST /api/auth/mobile-handoff",
+	"POST /api/auth/oauth/facebook",
+	"POST /api/auth/oauth/google",
+	"POST /api/auth/refresh",
+	"POST /api/auth/register",
+	"POST /api/billing/webhook",
+	"DELETE /api/comments/:id",
+	"PUT /api/comments/:id/reply",
+	"PUT /api/comments/:id/visibility",
+	"POST /api/ext/items",
+	"GET /api/ext/wishlists",
+	"GET /api/guest/reservations",
+	"GET /api/guest/reservations/export",
+	"GET /api/guest/reservations/timeline",
+	"POST /api/images/upload",
+	"GET /api/items",
+	"POST /api/items",
+	"DELETE /api/items/:id",
+	"GET /api/items/:id",
+	"PUT /api/items/:id",
+	"GET /api/items/:id/attachments",
+	"POST /api/items/:id/attachments",
+	"DELETE /api/items/:id/attachments/:attachmentId",
+	"POST /api/items/:id/images",
+	"DELETE /api/items/:id/images/:imageId",
+	"PUT /api/items/:id/images/order",
+	"POST /api/items/:id/mark-purchased",
+	"POST /api/items/:id/mark-received",
+	"GET /api/items/:id/purchase-proof",
+	"PUT /api/items/:id/purchase-proof",
+	"GET /api/items/:id/share-links",
+	"POST /api/items/:id/share-links",
+	"DELETE /api/items/:id/share-links/:linkId",
+	"GET /api/notifications/preferences",
+	"PUT /api/notifications/preferences",
+	"GET /api/out/:itemId",
+	"GET /api/partner/v1/webhooks",
+	"POST /api/partner/v1/webhooks",
+	"DELETE /api/partner/v1/webhooks/:id",
+	"GET /api/partner/v1/wishlists/:slug",
+	"GET /api/partner/v1/wishlists/:slug/items",
+	"DELETE /api/protected/account",
+	"POST /api/protected/account/cancel-deletion",
+	"GET /api/protected/analytics/link-clicks",
+	"POST /api/protected/billing/checkout",
+	"GET /api/protected/billing/subscription",
+	"GET /api/protected/calendar.ics",
+	"GET /api/protected/calendar/settings",
+	"PUT /api/protected/calendar/settings",
+	"POST /api/protected/claim-guest-reservations",
+	"GET /api/protected/contacts",
+	"POST /api/protected/contacts",
+	"DELETE /api/protected/contacts/:id",
+	"PUT /api/protected/contacts/:id",
+	"GET /api/protected/contacts/groups",
+	"POST /api/protected/contacts/import",
+	"POST /api/protected/devices",
+	"DELETE /api/protected/devices/:id",
+	"GET /api/protected/export-data",
+	"GET /api/protected/exports",
+	"POST /api/protected/exports",
+	"GET /api/protected/exports/:id",
+	"GET /api/protected/follows",
+	"DELETE /api/protected/follows/:userId",
+	"POST /api/protected/follows/:userId",
+	"GET /api/protected/gift-history",
+	"GET /api/protected/notifications",
+	"POST /api/protected/notifications/:id/read",
+	"GET /api/protected/profile",
+	"PUT /api/protected/profile",
+	"GET /api/protected/profile/public",
+	"PUT /api/protected/profile/public",
+	"GET /api/protected/quota",
+	"GET /api/protected/stats",
+	"GET /api/protected/stats/items",
+	"GET /api/protected/tokens",
+	"POST /api/protected/tokens",
+	"DELETE /api/protected/tokens/:id",
+	"POST /api/public/invites/:token/visit",
+	"GET /api/public/items/:token",
+	"POST /api/public/privacy/erasure-request",
+	"POST /api/public/privacy/export-request",
+	"POST /api/public/privacy/verify",
+	"GET /api/public/purchase-confirm/:token",
+	"POST /api/public/purchase-confirm/:token",
+	"GET /api/public/registries/:slug",
+	"POST /api/public/reservations/confirm",
+	"GET /api/public/reservations/list/:slug",
+	"GET /api/public/reservations/list/:slug/item/:itemId",
+	"DELETE /api/public/reservations/wishlist/:wishlistId/item/:itemId",
+	"POST /api/public/reservations/wishlist/:wishlistId/item/:itemId",
+	"GET /api/public/search",
+	"GET /api/public/trending",
+	"GET /api/public/u/:username/:slug",
+	"GET /api/public/users/:username",
+	"GET /api/public/wishlists/:slug",
+	"GET /api/public/wishlists/:slug/full",
+	"GET /api/public/wishlists/:slug/gift-items",
+	"GET /api/public/wishlists/:slug/items/:itemId/attachments",
+	"GET /api/public/wishlists/:slug/items/:itemId/comments",
+	"POST /api/public/wishlists/:slug/items/:itemId/comments",
+	"POST /api/public/wishlists/:slug/report",
+	"POST /api/public/wishlists/:slug/rsvps",
+	"POST /api/public/wishlists/:slug/suggestions",
+	"POST /api/public/wishlists/:slug/view",
+	"GET /api/registries",
+	"POST /api/registries",
+	"DELETE /api/registries/:id",
+	"GET /api/registries/:id",
+	"PUT /api/registries/:id",
+	"GET /api/reservations/:id/timeline",
+	"POST /api/reservations/:id/transfer",
+	"PUT /api/reservations/budgets",
+	"DELETE /api/reservations/budgets/:id",
+	"POST /api/reservations/claim",
+	"GET /api/reservations/user",
+	"POST /api/shipments",
+	"DELETE /api/shipments/:id",
+	"GET /api/shipments/item/:itemId",
+	"GET /api/shipments/received",
+	"POST /api/suggestions/:id/accept",
+	"POST /api/suggestions/:id/decline",
+	"GET /api/wishlists",
+	"POST /api/wishlists",
+	"DELETE /api/wishlists/:id",
+	"GET /api/wishlists/:id",
+	"PUT /api/wishlists/:id",
+	"GET /api/wishlists/:id/comments",
+	"DELETE /api/wishlists/:id/editors/:sessionId",
+	"PUT /api/wishlists/:id/editors/:sessionId",
+	"GET /api/wishlists/:id/import-source",
+	"POST /api/wishlists/:id/invite",
+	"GET /api/wishlists/:id/invites",
+	"GET /api/wishlists/:id/items",
+	"POST /api/wishlists/:id/items",
+	"DELETE /api/wishlists/:id/items/:itemId",
+	"PATCH /api/wishlists/:id/items/:itemId/mark-reserved",
+	"POST /api/wishlists/:id/items/new",
+	"POST /api/wishlists/:id/merge",
+	"PUT /api/wishlists/:id/partner-sharing",
+	"POST /api/wishlists/:id/rollover-unpurchased",
+	"GET /api/wishlists/:id/rsvps",
+	"GET /api/wishlists/:id/rsvps/export",
+	"GET /api/wishlists/:id/snapshots",
+	"POST /api/wishlists/:id/snapshots",
+	"GET /api/wishlists/:id/snapshots/:date",
+	"GET /api/wishlists/:id/suggestions",
+	"POST /api/wishlists/import",
+	"GET /healthz",
+	"GET /livez",
+	"GET /readyz",
+	"GET /robots.txt",
+	"GET /sitemap-:chunk",
+	"GET /sitemap.xml",
+	"GET /swagger/*",
+}
+
+func TestInitServer_RegistersExpectedRoutes(t *testing.T) {
+	a := newRouteTestApp()
+
+	require.NoError(t, a.initServer())
+	assert.Equal(t, expectedRoutes, a.server.Routes())
+}
+
+func TestInitServer_EmailPreviewOnlyInDevelopment(t *testing.T) {
+	a := newRouteTestApp()
+	require.NoError(t, a.initServer())
+	assert.NotContains(t, a.server.Routes(), "GET /api/dev/email-preview/:template")
+
+	a = newRouteTestApp()
+	a.cfg.ServerEnv = config.EnvDevelopment
+	require.NoError(t, a.initServer())
+	assert.Contains(t, a.server.Routes(), "GET /api/dev/email-preview/:template")
+}
+
+func TestEmailPreviews_RenderEveryTemplate(t *testing.T) {
+	samples := jobs.EmailPreviews()
+	assert.ElementsMatch(t, emailtemplate.Embedded().Names(), slices.Collect(maps.Keys(samples)))
+
+	handler := emailtemplate.PreviewHandler(emailtemplate.Embedded(), samples)
+	for name := range samples {
+		for _, format := range []string{"html", "text"} {
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?format="+format, nil), rec)
+			c.SetParamNames("template")
+			c.SetParamValues(name)
+
+			require.NoError(t, handler(c), "%s as %s", name, format)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	}
+}
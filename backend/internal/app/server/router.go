@@ -1,15 +1,90 @@
 package server
 
-// Route registration is handled in app.go's initServer() method.
-// Each domain's RegisterRoutes() function is called with the appropriate
-// Echo instance and auth middleware.
-//
-// Domain route registration pattern:
-//   healthhttp.RegisterRoutes(e, healthHandler)
-//   userhttp.RegisterRoutes(e, userHandler, authMiddleware)
-//   authhttp.RegisterRoutes(e, authHandler, oauthHandler, authMiddleware)
-//   wishlisthttp.RegisterRoutes(e, wishlistHandler, authMiddleware)
-//   itemhttp.RegisterRoutes(e, itemHandler, authMiddleware)
-//   wishlistitemhttp.RegisterRoutes(e, wishlistItemHandler, authMiddleware)
-//   reservationhttp.RegisterRoutes(e, reservationHandler, authMiddleware)
-//   storagehttp.RegisterRoutes(e, storageHandler, tokenManager)
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"wish-list/internal/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ErrDuplicateRoute is returned by CheckRoutes when a method and path are registered
+// more than once. Echo silently keeps the last handler, so the earlier one is dead code.
+var ErrDuplicateRoute = errors.New("duplicate route registration")
+
+// routeTable records every route added to the Echo instance, in registration order.
+// Echo's own route list is keyed by method and path and so hides duplicates.
+type routeTable struct {
+	routes []echo.Route
+	counts map[string]int
+}
+
+func newRouteTable() *routeTable {
+	return &routeTable{counts: make(map[string]int)}
+}
+
+// add is installed as echo.OnAddRouteHandler
+func (t *routeTable) add(host string, route echo.Route, _ echo.HandlerFunc, _ []echo.MiddlewareFunc) {
+	// Groups with middleware register catch-all 404 routes under their prefix; two
+	// groups sharing a prefix are expected, not a conflict
+	if route.Method == echo.RouteNotFound {
+		return
+	}
+	t.routes = append(t.routes, route)
+	t.counts[routeKey(host, route.Method, route.Path)]++
+}
+
+// Routes returns the registered routes as "METHOD path", sorted by path then method
+func (s *Server) Routes() []string {
+	seen := make(map[string]bool, len(s.routes.routes))
+	routes := make([]string, 0, len(s.routes.routes))
+	for _, route := range s.routes.routes {
+		key := route.Method + " " + route.Path
+		if !seen[key] {
+			seen[key] = true
+			routes = append(routes, key)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		pi, pj := routes[i][strings.IndexByte(routes[i], ' ')+1:], routes[j][strings.IndexByte(routes[j], ' ')+1:]
+		if pi != pj {
+			return pi < pj
+		}
+		return routes[i] < routes[j]
+	})
+	return routes
+}
+
+// CheckRoutes reports every method and path registered more than once
+func (s *Server) CheckRoutes() error {
+	var duplicates []string
+	for key, count := range s.routes.counts {
+		if count > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%s (%d times)", key, count))
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	sort.Strings(duplicates)
+	return fmt.Errorf("%w: %s", ErrDuplicateRoute, strings.Join(duplicates, ", "))
+}
+
+// LogRoutes logs the route count and, at debug level, the full route table with the
+// handler serving each route
+func (s *Server) LogRoutes() {
+	logger.Info("routes registered", "count", len(s.Routes()))
+	for _, route := range s.routes.routes {
+		logger.Debug("route", "method", route.Method, "path", route.Path, "handler", route.Name)
+	}
+}
+
+func routeKey(host, method, path string) string {
+	if host != "" {
+		return method + " " + host + path
+	}
+	return method + " " + path
+}
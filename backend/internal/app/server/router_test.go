@@ -0,0 +1,48 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"wish-list/internal/app/config"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noopHandler(c echo.Context) error { return c.NoContent(http.StatusNoContent) }
+
+func noopMiddleware(next echo.HandlerFunc) echo.HandlerFunc { return next }
+
+func TestCheckRoutes_NoDuplicates(t *testing.T) {
+	s := New(&config.Config{}, nil)
+	g := s.Echo.Group("/api/wishlists", noopMiddleware)
+	g.GET("/:id", noopHandler)
+	g.DELETE("/:id", noopHandler)
+	// A second group with middleware on the same prefix registers the same catch-all
+	// 404 routes, which is not a conflict
+	other := s.Echo.Group("/api/wishlists", noopMiddleware)
+	other.GET("/:id/items", noopHandler)
+
+	require.NoError(t, s.CheckRoutes())
+	assert.Equal(t, []string{
+		"DELETE /api/wishlists/:id",
+		"GET /api/wishlists/:id",
+		"GET /api/wishlists/:id/items",
+	}, s.Routes())
+}
+
+func TestCheckRoutes_ReportsDuplicates(t *testing.T) {
+	s := New(&config.Config{}, nil)
+	s.Echo.DELETE("/api/wishlists/:id", noopHandler)
+	s.Echo.Group("/api/wishlists").DELETE("/:id", noopHandler)
+	s.Echo.GET("/api/items", noopHandler)
+
+	err := s.CheckRoutes()
+
+	require.ErrorIs(t, err, ErrDuplicateRoute)
+	assert.Contains(t, err.Error(), "DELETE /api/wishlists/:id (2 times)")
+	assert.NotContains(t, err.Error(), "/api/items")
+	assert.Equal(t, []string{"GET /api/items", "DELETE /api/wishlists/:id"}, s.Routes())
+}
@@ -4,31 +4,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
 	"wish-list/internal/app/config"
 	"wish-list/internal/app/middleware"
+	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/logger"
 
 	"github.com/labstack/echo/v4"
 )
 
 // Server wraps the Echo instance with lifecycle management
 type Server struct {
-	Echo *echo.Echo
-	cfg  *config.Config
+	Echo   *echo.Echo
+	cfg    *config.Config
+	routes *routeTable
 }
 
 // New creates a new Server instance with middleware pipeline configured
 func New(cfg *config.Config, validator echo.Validator) *Server {
 	e := echo.New()
 
+	// Record registrations before any route is added so CheckRoutes sees them all
+	routes := newRouteTable()
+	e.OnAddRouteHandler = routes.add
+
 	// Set custom validator
 	if validator != nil {
 		e.Validator = validator
 	}
 
+	// Only believe X-Forwarded-For from the configured proxies
+	e.IPExtractor = middleware.ClientIPExtractor(cfg.TrustedProxies)
+
 	// Set custom error handler
 	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
 
@@ -40,17 +49,21 @@ func New(cfg *config.Config, validator echo.Validator) *Server {
 	e.Use(middleware.CORSMiddleware(cfg.CorsAllowedOrigins))
 	e.Use(middleware.TimeoutMiddleware(30 * time.Second))
 	e.Use(middleware.RateLimiterMiddleware())
+	if cfg.RequireIfMatch {
+		e.Use(helpers.RequireVersionMiddleware())
+	}
 
 	return &Server{
-		Echo: e,
-		cfg:  cfg,
+		Echo:   e,
+		cfg:    cfg,
+		routes: routes,
 	}
 }
 
 // Start starts the HTTP server and blocks until shutdown
 func (s *Server) Start() error {
 	port := fmt.Sprintf(":%d", s.cfg.ServerPort)
-	log.Printf("Server is starting on port %s", port)
+	logger.Info("server is starting", "port", s.cfg.ServerPort)
 
 	serverErrors := make(chan error, 1)
 	go func() {
@@ -69,15 +82,15 @@ func (s *Server) Start() error {
 
 // Shutdown gracefully shuts down the server
 func (s *Server) Shutdown(ctx context.Context) error {
-	log.Println("Starting graceful shutdown...")
+	logger.Info("starting graceful shutdown")
 
 	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	if err := s.Echo.Shutdown(shutdownCtx); err != nil {
-		log.Printf("Server forced to shutdown: %v", err)
+		logger.Error("server forced to shutdown", "error", err)
 		if closeErr := s.Echo.Close(); closeErr != nil {
-			log.Printf("Error closing server: %v", closeErr)
+			logger.Error("failed to close server", "error", closeErr)
 		}
 		return err
 	}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"wish-list/internal/app/config"
+	"wish-list/internal/app/database"
+	"wish-list/internal/app/jobs"
+	contactrepo "wish-list/internal/domain/contact/repository"
+	gifthistoryrepo "wish-list/internal/domain/gift_history/repository"
+	itemrepo "wish-list/internal/domain/item/repository"
+	moderationrepo "wish-list/internal/domain/moderation/repository"
+	moderationservice "wish-list/internal/domain/moderation/service"
+	notificationrepo "wish-list/internal/domain/notification/repository"
+	notificationservice "wish-list/internal/domain/notification/service"
+	privacyrepo "wish-list/internal/domain/privacy/repository"
+	quotarepo "wish-list/internal/domain/quota/repository"
+	quotaservice "wish-list/internal/domain/quota/service"
+	reservationrepo "wish-list/internal/domain/reservation/repository"
+	rsvprepo "wish-list/internal/domain/rsvp/repository"
+	statsrepo "wish-list/internal/domain/stats/repository"
+	statsservice "wish-list/internal/domain/stats/service"
+	userrepo "wish-list/internal/domain/user/repository"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	wishlistservice "wish-list/internal/domain/wishlist/service"
+	wishlistitemrepo "wish-list/internal/domain/wishlist_item/repository"
+	"wish-list/internal/pkg/breaker"
+	"wish-list/internal/pkg/cache"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/mailer"
+	"wish-list/internal/pkg/moderation"
+)
+
+// The constructors below are shared with the tools in cmd/, so that a task run
+// from the command line reads and writes the same way the server does.
+
+// Connect opens the application database configured in cfg
+func Connect(ctx context.Context, cfg *config.Config) (*database.DB, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	db, err := database.New(connectCtx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return db, nil
+}
+
+// Repositories are the stores shared by the server and the tools. Those
+// holding PII encrypt it when an encryption service is configured.
+type Repositories struct {
+	Users                userrepo.UserRepositoryInterface
+	WishLists            wishlistrepo.WishListRepositoryInterface
+	Invites              wishlistrepo.InviteRepositoryInterface
+	Contacts             contactrepo.ContactRepositoryInterface
+	GiftItems            itemrepo.GiftItemRepositoryInterface
+	GiftItemReservations itemrepo.GiftItemReservationRepositoryInterface
+	GiftItemPurchases    itemrepo.GiftItemPurchaseRepositoryInterface
+	GiftItemImages       itemrepo.GiftItemImageRepositoryInterface
+	WishlistItems        wishlistitemrepo.WishlistItemRepositoryInterface
+	Reservations         reservationrepo.ReservationRepositoryInterface
+	GuestConfirmations   reservationrepo.GuestConfirmationRepositoryInterface
+	PurchaseReminders    reservationrepo.PurchaseReminderRepositoryInterface
+	PrivacyRequests      privacyrepo.PrivacyRequestRepositoryInterface
+	RSVPs                rsvprepo.RSVPRepositoryInterface
+	GiftHistory          gifthistoryrepo.GiftHistoryRepositoryInterface
+}
+
+// NewRepositories creates the shared stores on db. encSvc may be nil, in which
+// case PII is stored in plaintext.
+func NewRepositories(db *database.DB, encSvc *encryption.Service) *Repositories {
+	repos := &Repositories{
+		WishLists:            wishlistrepo.NewWishListRepository(db),
+		GiftItemReservations: itemrepo.NewGiftItemReservationRepository(db),
+		GiftItemPurchases:    itemrepo.NewGiftItemPurchaseRepository(db),
+		GiftItemImages:       itemrepo.NewGiftItemImageRepository(db),
+	}
+	if encSvc != nil {
+		repos.Users = userrepo.NewUserRepositoryWithEncryption(db, encSvc)
+		repos.Invites = wishlistrepo.NewInviteRepositoryWithEncryption(db, encSvc)
+		repos.Contacts = contactrepo.NewContactRepositoryWithEncryption(db, encSvc)
+		repos.GiftItems = itemrepo.NewGiftItemRepositoryWithEncryption(db, encSvc)
+		repos.WishlistItems = wishlistitemrepo.NewWishlistItemRepositoryWithEncryption(db, encSvc)
+		repos.Reservations = reservationrepo.NewReservationRepositoryWithEncryption(db, encSvc)
+		repos.GuestConfirmations = reservationrepo.NewGuestConfirmationRepositoryWithEncryption(db, encSvc)
+		repos.PurchaseReminders = reservationrepo.NewPurchaseReminderRepositoryWithEncryption(db, encSvc)
+		repos.PrivacyRequests = privacyrepo.NewPrivacyRequestRepositoryWithEncryption(db, encSvc)
+		repos.RSVPs = rsvprepo.NewRSVPRepositoryWithEncryption(db, encSvc)
+		repos.GiftHistory = gifthistoryrepo.NewGiftHistoryRepositoryWithEncryption(db, encSvc)
+	} else {
+		repos.Users = userrepo.NewUserRepository(db)
+		repos.Invites = wishlistrepo.NewInviteRepository(db)
+		repos.Contacts = contactrepo.NewContactRepository(db)
+		repos.GiftItems = itemrepo.NewGiftItemRepository(db)
+		repos.WishlistItems = wishlistitemrepo.NewWishlistItemRepository(db)
+		repos.Reservations = reservationrepo.NewReservationRepository(db)
+		repos.GuestConfirmations = reservationrepo.NewGuestConfirmationRepository(db)
+		repos.PurchaseReminders = reservationrepo.NewPurchaseReminderRepository(db)
+		repos.PrivacyRequests = privacyrepo.NewPrivacyRequestRepository(db)
+		repos.RSVPs = rsvprepo.NewRSVPRepository(db)
+		repos.GiftHistory = gifthistoryrepo.NewGiftHistoryRepository(db)
+	}
+	return repos
+}
+
+// Services are the domain services shared by the server and the tools
+type Services struct {
+	Email         *jobs.EmailService
+	Quota         *quotaservice.QuotaService
+	Moderation    *moderationservice.ModerationService
+	Notifications *notificationservice.NotificationService
+	Stats         *statsservice.StatsService
+	WishLists     *wishlistservice.WishListService
+}
+
+// NewServices creates the shared services on top of repos. cacheSvc may be nil
+// to disable caching, and images nil to skip image moderation.
+func NewServices(
+	cfg *config.Config,
+	db *database.DB,
+	encSvc *encryption.Service,
+	repos *Repositories,
+	cacheSvc cache.CacheInterface,
+	images moderation.ImageModerator,
+) *Services {
+	if images == nil {
+		images = moderation.NoopImageModerator{}
+	}
+	textFilter := moderation.NewTextFilter(slices.Concat(moderation.DefaultBlockedWords, cfg.ModerationBlockedWords), cfg.ModerationBlockedHosts)
+
+	svcs := &Services{
+		Email:         NewEmailService(cfg, db, encSvc),
+		Quota:         quotaservice.NewQuotaService(quotarepo.NewQuotaRepository(db)),
+		Moderation:    moderationservice.NewModerationService(moderationrepo.NewModerationRepository(db), textFilter, images, cacheSvc),
+		Notifications: notificationservice.NewNotificationService(notificationrepo.NewNotificationRepository(db)),
+		Stats:         statsservice.NewStatsService(statsrepo.NewStatsRepository(db), cacheSvc),
+	}
+	svcs.WishLists = wishlistservice.NewWishListService(
+		repos.WishLists,
+		repos.GiftItems,
+		repos.GiftItemReservations,
+		repos.GiftItemPurchases,
+		svcs.Email,
+		repos.Reservations,
+		cacheSvc,
+		repos.GiftHistory,
+		svcs.Quota,
+		svcs.Moderation,
+		repos.GiftItemImages,
+		svcs.Notifications,
+		svcs.Stats,
+		repos.Users,
+	)
+	return svcs
+}
+
+// NewEmailService sends email through the providers configured in cfg. Emails
+// that cannot be sent are kept in the failed_emails table, encrypted with encSvc
+// when it is set.
+func NewEmailService(cfg *config.Config, db *database.DB, encSvc *encryption.Service) *jobs.EmailService {
+	return jobs.NewEmailService(mailer.New(MailerConfig(cfg)), cfg.SenderEmail, jobs.NewFailedEmailStore(db, encSvc))
+}
+
+// MailerConfig returns the email providers in failover order, or the sandbox
+func MailerConfig(cfg *config.Config) mailer.Config {
+	mailerCfg := mailer.Config{Sandbox: cfg.EmailSandbox, SandboxDir: cfg.EmailSandboxDir, Breaker: BreakerConfig(cfg)}
+	if cfg.SMTPHost != "" {
+		mailerCfg.Providers = append(mailerCfg.Providers, mailer.SMTPConfig{
+			Name: "primary", Host: cfg.SMTPHost, Port: cfg.SMTPPort,
+			Username: cfg.SMTPUsername, Password: cfg.SMTPPassword, Timeout: cfg.SMTPTimeout,
+		})
+	}
+	if cfg.SMTPSecondaryHost != "" {
+		mailerCfg.Providers = append(mailerCfg.Providers, mailer.SMTPConfig{
+			Name: "secondary", Host: cfg.SMTPSecondaryHost, Port: cfg.SMTPSecondaryPort,
+			Username: cfg.SMTPSecondaryUsername, Password: cfg.SMTPSecondaryPassword, Timeout: cfg.SMTPTimeout,
+		})
+	}
+	return mailerCfg
+}
+
+// BreakerConfig returns the circuit breaker settings shared by Redis, S3 and the email providers
+func BreakerConfig(cfg *config.Config) breaker.Config {
+	return breaker.Config{Failures: cfg.BreakerFailures, Cooldown: cfg.BreakerCooldown}
+}
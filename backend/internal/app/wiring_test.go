@@ -0,0 +1,38 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"wish-list/internal/app/config"
+	"wish-list/internal/pkg/mailer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailerConfig(t *testing.T) {
+	t.Run("providers in failover order", func(t *testing.T) {
+		cfg := MailerConfig(&config.Config{
+			SMTPHost: "smtp.primary.test", SMTPPort: 587, SMTPUsername: "user", SMTPPassword: "secret",
+			SMTPSecondaryHost: "smtp.secondary.test", SMTPSecondaryPort: 2525,
+			SMTPTimeout:     5 * time.Second,
+			BreakerFailures: 3, BreakerCooldown: time.Minute,
+		})
+
+		assert.False(t, cfg.Sandbox)
+		assert.Equal(t, []mailer.SMTPConfig{
+			{Name: "primary", Host: "smtp.primary.test", Port: 587, Username: "user", Password: "secret", Timeout: 5 * time.Second},
+			{Name: "secondary", Host: "smtp.secondary.test", Port: 2525, Timeout: 5 * time.Second},
+		}, cfg.Providers)
+		assert.Equal(t, 3, cfg.Breaker.Failures)
+		assert.Equal(t, time.Minute, cfg.Breaker.Cooldown)
+	})
+
+	t.Run("sandbox without providers", func(t *testing.T) {
+		cfg := MailerConfig(&config.Config{EmailSandbox: true, EmailSandboxDir: "/tmp/emails"})
+
+		assert.True(t, cfg.Sandbox)
+		assert.Equal(t, "/tmp/emails", cfg.SandboxDir)
+		assert.Empty(t, cfg.Providers)
+	})
+}
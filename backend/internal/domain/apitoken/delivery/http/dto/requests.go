@@ -0,0 +1,17 @@
+package dto
+
+import "wish-list/internal/domain/apitoken/service"
+
+type CreateTokenRequest struct {
+	Name          string   `json:"name" validate:"required,max=100" example:"Browser extension"`
+	Scopes        []string `json:"scopes" validate:"required,min=1,dive,oneof=items:write wishlists:read calendar:read" example:"items:write,wishlists:read"`
+	ExpiresInDays *int     `json:"expires_in_days" validate:"omitempty,gte=1,lte=365" example:"90"` // Omit for a token that never expires
+}
+
+func (r *CreateTokenRequest) ToServiceInput() service.CreateTokenInput {
+	return service.CreateTokenInput{
+		Name:          r.Name,
+		Scopes:        r.Scopes,
+		ExpiresInDays: r.ExpiresInDays,
+	}
+}
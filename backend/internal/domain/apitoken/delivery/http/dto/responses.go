@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/apitoken/service"
+)
+
+// TokenResponse describes a token without its secret
+type TokenResponse struct {
+	ID         string   `json:"id" validate:"required"`
+	Name       string   `json:"name" validate:"required"`
+	Prefix     string   `json:"prefix" validate:"required"` // Leading characters of the token, to tell tokens apart
+	Scopes     []string `json:"scopes" validate:"required"`
+	ExpiresAt  *string  `json:"expires_at,omitempty"`
+	LastUsedAt *string  `json:"last_used_at,omitempty"`
+	CreatedAt  string   `json:"created_at" validate:"required"`
+}
+
+// CreatedTokenResponse includes the token itself, which is shown only once
+type CreatedTokenResponse struct {
+	TokenResponse
+	Token string `json:"token" validate:"required"`
+}
+
+type TokenListResponse struct {
+	Tokens []TokenResponse `json:"tokens" validate:"required"`
+}
+
+func FromTokenOutput(o *service.TokenOutput) TokenResponse {
+	return TokenResponse{
+		ID:         o.ID.String(),
+		Name:       o.Name,
+		Prefix:     o.Prefix,
+		Scopes:     o.Scopes,
+		ExpiresAt:  formatTime(o.ExpiresAt),
+		LastUsedAt: formatTime(o.LastUsedAt),
+		CreatedAt:  o.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromCreatedTokenOutput(o *service.CreatedTokenOutput) CreatedTokenResponse {
+	return CreatedTokenResponse{
+		TokenResponse: FromTokenOutput(&o.TokenOutput),
+		Token:         o.Token,
+	}
+}
+
+func FromTokenOutputs(outputs []*service.TokenOutput) TokenListResponse {
+	tokens := make([]TokenResponse, 0, len(outputs))
+	for _, o := range outputs {
+		tokens = append(tokens, FromTokenOutput(o))
+	}
+	return TokenListResponse{Tokens: tokens}
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
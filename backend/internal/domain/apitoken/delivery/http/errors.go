@@ -0,0 +1,30 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/apitoken/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapAPITokenServiceError converts API token service errors to AppErrors
+func mapAPITokenServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidTokenID):
+		return apperrors.BadRequest("Invalid token ID")
+	case errors.Is(err, service.ErrNameRequired):
+		return apperrors.BadRequest("Token name is required")
+	case errors.Is(err, service.ErrScopesRequired):
+		return apperrors.BadRequest("At least one scope is required")
+	case errors.Is(err, service.ErrInvalidScope):
+		return apperrors.BadRequest("Unknown scope")
+	case errors.Is(err, service.ErrInvalidExpiry):
+		return apperrors.BadRequest("Expiry must be between 1 and 365 days")
+	case errors.Is(err, service.ErrTooManyTokens):
+		return apperrors.Conflict("Too many active API tokens; revoke one first")
+	case errors.Is(err, service.ErrTokenNotFound):
+		return apperrors.NotFound("API token not found")
+	default:
+		return apperrors.Internal("API token request failed").Wrap(err)
+	}
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/apitoken/delivery/http/dto"
+	"wish-list/internal/domain/apitoken/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for personal API tokens
+type Handler struct {
+	service service.APITokenServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.APITokenServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateToken godoc
+//
+//	@Summary		Create an API token
+//	@Description	Issues a long-lived personal API token for integrations such as the browser extension. The token is returned only in this response; store it right away. Scopes: items:write adds items to wishlists, wishlists:read lists wishlists, calendar:read subscribes to the calendar feed.
+//	@Tags			API Tokens
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	body		dto.CreateTokenRequest		true	"Token name, scopes and expiry"
+//	@Success		201		{object}	dto.CreatedTokenResponse	"Token created"
+//	@Failure		400		{object}	map[string]string			"Invalid request body"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		409		{object}	map[string]string			"Too many active tokens"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/tokens [post]
+func (h *Handler) CreateToken(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.CreateTokenRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	output, err := h.service.CreateToken(c.Request().Context(), userID, req.ToServiceInput())
+	if err != nil {
+		return mapAPITokenServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromCreatedTokenOutput(output))
+}
+
+// ListTokens godoc
+//
+//	@Summary		List API tokens
+//	@Description	The current user's unrevoked API tokens, newest first. Expired tokens are listed until revoked; secrets are never returned.
+//	@Tags			API Tokens
+//	@Produce		json
+//	@Success		200	{object}	dto.TokenListResponse	"API tokens"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/tokens [get]
+func (h *Handler) ListTokens(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	tokens, err := h.service.ListTokens(c.Request().Context(), userID)
+	if err != nil {
+		return mapAPITokenServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromTokenOutputs(tokens))
+}
+
+// RevokeToken godoc
+//
+//	@Summary		Revoke an API token
+//	@Description	Disables an API token immediately. Integrations using it start getting 401.
+//	@Tags			API Tokens
+//	@Param			id	path	string	true	"Token ID"
+//	@Success		204	"Token revoked"
+//	@Failure		400	{object}	map[string]string	"Invalid token ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Token not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/tokens/{id} [delete]
+func (h *Handler) RevokeToken(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.RevokeToken(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return mapAPITokenServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
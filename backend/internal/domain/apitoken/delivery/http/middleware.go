@@ -0,0 +1,79 @@
+package http
+
+import (
+	"errors"
+	"strings"
+
+	"wish-list/internal/domain/apitoken/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TokenMiddleware authenticates requests with a personal API token sent as
+// "Authorization: Bearer wlt_...". The token must carry scope. On success the
+// token's user is set as user_id, so handlers read it like a JWT-authenticated user.
+func TokenMiddleware(svc service.APITokenServiceInterface, scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" {
+				return apperrors.Unauthorized("Missing authorization header")
+			}
+
+			// Expect format: "Bearer <token>"
+			rawToken, ok := strings.CutPrefix(authHeader, "Bearer ")
+			if !ok || rawToken == "" {
+				return apperrors.Unauthorized("Invalid authorization header format")
+			}
+
+			if err := authenticate(c, svc, rawToken, scope); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// FeedTokenMiddleware authenticates subscription URLs, such as calendar feeds, whose
+// clients cannot send headers. The token is read from the "token" query parameter,
+// falling back to the Authorization header.
+func FeedTokenMiddleware(svc service.APITokenServiceInterface, scope string) echo.MiddlewareFunc {
+	header := TokenMiddleware(svc, scope)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		viaHeader := header(next)
+		return func(c echo.Context) error {
+			rawToken := c.QueryParam("token")
+			if rawToken == "" {
+				return viaHeader(c)
+			}
+
+			if err := authenticate(c, svc, rawToken, scope); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// authenticate resolves rawToken and sets its user on the request
+func authenticate(c echo.Context, svc service.APITokenServiceInterface, rawToken, scope string) error {
+	ctx := c.Request().Context()
+	userID, err := svc.Authenticate(ctx, rawToken, scope)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrInvalidToken):
+		return apperrors.Unauthorized("Invalid, expired or revoked API token")
+	case errors.Is(err, service.ErrInsufficientScope):
+		return apperrors.Forbidden("API token lacks the " + scope + " scope")
+	default:
+		return apperrors.Internal("Failed to authenticate API token").Wrap(err)
+	}
+
+	c.Set("user_id", userID.String())
+	ctx = logger.WithContext(ctx, "user_id", userID.String(), "auth_method", "api_token")
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	return nil
+}
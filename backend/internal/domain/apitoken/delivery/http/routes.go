@@ -0,0 +1,14 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers API token management routes. Tokens are managed with a
+// regular session only; an API token cannot create or revoke tokens.
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected/tokens", authMiddleware)
+	protected.POST("", h.CreateToken)
+	protected.GET("", h.ListTokens)
+	protected.DELETE("/:id", h.RevokeToken)
+}
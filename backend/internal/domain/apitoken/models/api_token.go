@@ -0,0 +1,42 @@
+package models
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Scopes an API token can be granted
+const (
+	ScopeItemsWrite    = "items:write"    // Add items to the user's wishlists
+	ScopeWishlistsRead = "wishlists:read" // List the user's wishlists
+	ScopeCalendarRead  = "calendar:read"  // Subscribe to the user's calendar feed
+)
+
+// Scopes lists every scope in the order they are documented
+var Scopes = []string{ScopeItemsWrite, ScopeWishlistsRead, ScopeCalendarRead}
+
+// APIToken is a long-lived personal token. The token itself is never stored, only its hash.
+type APIToken struct {
+	ID          pgtype.UUID        `db:"id"`
+	UserID      pgtype.UUID        `db:"user_id"`
+	Name        string             `db:"name"`
+	TokenHash   string             `db:"token_hash"`
+	TokenPrefix string             `db:"token_prefix"`
+	Scopes      string             `db:"scopes"` // Space-separated
+	ExpiresAt   pgtype.Timestamptz `db:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `db:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `db:"revoked_at"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+}
+
+// ScopeList returns the token's scopes
+func (t *APIToken) ScopeList() []string {
+	return strings.Fields(t.Scopes)
+}
+
+// HasScope reports whether the token was granted scope
+func (t *APIToken) HasScope(scope string) bool {
+	return slices.Contains(t.ScopeList(), scope)
+}
@@ -0,0 +1,149 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_api_token_repository_test.go -pkg service . APITokenRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/apitoken/models"
+)
+
+// Sentinel errors for API token repository
+var (
+	ErrTokenNotFound = errors.New("api token not found")
+)
+
+// APITokenRepositoryInterface defines the interface for API token database operations
+type APITokenRepositoryInterface interface {
+	Create(ctx context.Context, token models.APIToken) (*models.APIToken, error)
+	ListByUser(ctx context.Context, userID pgtype.UUID) ([]*models.APIToken, error)
+	CountActiveByUser(ctx context.Context, userID pgtype.UUID) (int, error)
+	GetActiveByHash(ctx context.Context, tokenHash string) (*models.APIToken, error)
+	Revoke(ctx context.Context, id, userID pgtype.UUID) error
+	TouchLastUsed(ctx context.Context, id pgtype.UUID) error
+}
+
+type APITokenRepository struct {
+	db *database.DB
+}
+
+func NewAPITokenRepository(db *database.DB) APITokenRepositoryInterface {
+	return &APITokenRepository{
+		db: db,
+	}
+}
+
+const apiTokenColumns = `id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+// Create stores a new token
+func (r *APITokenRepository) Create(ctx context.Context, token models.APIToken) (*models.APIToken, error) {
+	query := `
+		INSERT INTO api_tokens (user_id, name, token_hash, token_prefix, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + apiTokenColumns
+
+	var created models.APIToken
+	if err := r.db.QueryRowxContext(ctx, query,
+		token.UserID, token.Name, token.TokenHash, token.TokenPrefix, token.Scopes, token.ExpiresAt,
+	).StructScan(&created); err != nil {
+		return nil, fmt.Errorf("failed to create api token: %w", err)
+	}
+
+	return &created, nil
+}
+
+// ListByUser returns the user's unrevoked tokens, newest first. Expired tokens are
+// included so users can see why an integration stopped working.
+func (r *APITokenRepository) ListByUser(ctx context.Context, userID pgtype.UUID) ([]*models.APIToken, error) {
+	query := `
+		SELECT ` + apiTokenColumns + `
+		FROM api_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	var tokens []*models.APIToken
+	if err := r.db.SelectContext(ctx, &tokens, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list api tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// CountActiveByUser returns the number of the user's tokens that are neither revoked nor expired
+func (r *APITokenRepository) CountActiveByUser(ctx context.Context, userID pgtype.UUID) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM api_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, fmt.Errorf("failed to count api tokens: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetActiveByHash returns the token with the given hash unless it is revoked or expired
+func (r *APITokenRepository) GetActiveByHash(ctx context.Context, tokenHash string) (*models.APIToken, error) {
+	query := `
+		SELECT ` + apiTokenColumns + `
+		FROM api_tokens
+		WHERE token_hash = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var token models.APIToken
+	if err := r.db.GetContext(ctx, &token, query, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get api token: %w", err)
+	}
+
+	return &token, nil
+}
+
+// Revoke disables one of the user's tokens. Tokens of other users are not found.
+func (r *APITokenRepository) Revoke(ctx context.Context, id, userID pgtype.UUID) error {
+	query := `UPDATE api_tokens SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrTokenNotFound
+	}
+
+	return nil
+}
+
+// TouchLastUsed records that the token was used. Writes are skipped while the stored
+// time is under a minute old, so busy integrations do not update the row on every request.
+func (r *APITokenRepository) TouchLastUsed(ctx context.Context, id pgtype.UUID) error {
+	query := `
+		UPDATE api_tokens
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update api token last use: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/apitoken/models"
+	"wish-list/internal/domain/apitoken/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// TokenPrefix starts every API token, so leaked tokens are easy to recognize and scan for
+	TokenPrefix = "wlt_"
+
+	// MaxActiveTokens bounds how many usable tokens one user can hold
+	MaxActiveTokens = 20
+
+	// MaxExpiryDays bounds the lifetime of a token created with an expiry
+	MaxExpiryDays = 365
+
+	tokenBytes        = 32
+	displayPrefixSize = 12
+)
+
+var (
+	ErrInvalidTokenID    = errors.New("invalid token id")
+	ErrTokenNotFound     = errors.New("api token not found")
+	ErrNameRequired      = errors.New("token name is required")
+	ErrScopesRequired    = errors.New("at least one scope is required")
+	ErrInvalidScope      = errors.New("unknown scope")
+	ErrInvalidExpiry     = errors.New("expiry must be between 1 and 365 days")
+	ErrTooManyTokens     = errors.New("too many active api tokens")
+	ErrInvalidToken      = errors.New("invalid api token")
+	ErrInsufficientScope = errors.New("api token lacks the required scope")
+)
+
+// APITokenServiceInterface defines the interface for API token operations
+type APITokenServiceInterface interface {
+	CreateToken(ctx context.Context, userID pgtype.UUID, input CreateTokenInput) (*CreatedTokenOutput, error)
+	ListTokens(ctx context.Context, userID pgtype.UUID) ([]*TokenOutput, error)
+	RevokeToken(ctx context.Context, userID pgtype.UUID, tokenID string) error
+	Authenticate(ctx context.Context, rawToken, scope string) (pgtype.UUID, error)
+}
+
+type APITokenService struct {
+	repo repository.APITokenRepositoryInterface
+}
+
+func NewAPITokenService(repo repository.APITokenRepositoryInterface) *APITokenService {
+	return &APITokenService{
+		repo: repo,
+	}
+}
+
+type CreateTokenInput struct {
+	Name          string
+	Scopes        []string
+	ExpiresInDays *int // nil = never expires
+}
+
+// TokenOutput describes a token without its secret
+type TokenOutput struct {
+	ID         pgtype.UUID
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// CreatedTokenOutput carries the token itself, which is only available at creation
+type CreatedTokenOutput struct {
+	TokenOutput
+	Token string
+}
+
+// CreateToken issues a new token for the user. The returned token is not stored and
+// cannot be retrieved again.
+func (s *APITokenService) CreateToken(ctx context.Context, userID pgtype.UUID, input CreateTokenInput) (*CreatedTokenOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if input.ExpiresInDays != nil {
+		days := *input.ExpiresInDays
+		if days < 1 || days > MaxExpiryDays {
+			return nil, ErrInvalidExpiry
+		}
+		expiresAt = pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, days), Valid: true}
+	}
+
+	active, err := s.repo.CountActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count api tokens: %w", err)
+	}
+	if active >= MaxActiveTokens {
+		return nil, ErrTooManyTokens
+	}
+
+	raw, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, models.APIToken{
+		UserID:      userID,
+		Name:        name,
+		TokenHash:   hashToken(raw),
+		TokenPrefix: raw[:displayPrefixSize],
+		Scopes:      strings.Join(scopes, " "),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api token: %w", err)
+	}
+
+	return &CreatedTokenOutput{
+		TokenOutput: *toTokenOutput(created),
+		Token:       raw,
+	}, nil
+}
+
+// ListTokens returns the user's unrevoked tokens, newest first
+func (s *APITokenService) ListTokens(ctx context.Context, userID pgtype.UUID) ([]*TokenOutput, error) {
+	tokens, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api tokens: %w", err)
+	}
+
+	outputs := make([]*TokenOutput, 0, len(tokens))
+	for _, token := range tokens {
+		outputs = append(outputs, toTokenOutput(token))
+	}
+
+	return outputs, nil
+}
+
+// RevokeToken disables one of the user's tokens immediately
+func (s *APITokenService) RevokeToken(ctx context.Context, userID pgtype.UUID, tokenID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(tokenID); err != nil {
+		return ErrInvalidTokenID
+	}
+
+	if err := s.repo.Revoke(ctx, id, userID); err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return ErrTokenNotFound
+		}
+		return fmt.Errorf("failed to revoke api token: %w", err)
+	}
+
+	return nil
+}
+
+// Authenticate resolves a raw token to its user, provided the token is active and was
+// granted scope. A failure to record the token's last use is logged, not returned.
+func (s *APITokenService) Authenticate(ctx context.Context, rawToken, scope string) (pgtype.UUID, error) {
+	if !strings.HasPrefix(rawToken, TokenPrefix) {
+		return pgtype.UUID{}, ErrInvalidToken
+	}
+
+	token, err := s.repo.GetActiveByHash(ctx, hashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return pgtype.UUID{}, ErrInvalidToken
+		}
+		return pgtype.UUID{}, fmt.Errorf("failed to get api token: %w", err)
+	}
+
+	if !token.HasScope(scope) {
+		return pgtype.UUID{}, ErrInsufficientScope
+	}
+
+	if err := s.repo.TouchLastUsed(ctx, token.ID); err != nil {
+		logger.WarnContext(ctx, "failed to record api token use", "token_id", token.ID.String(), "error", err)
+	}
+
+	return token.UserID, nil
+}
+
+// normalizeScopes validates the requested scopes and returns them deduplicated in documented order
+func normalizeScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, ErrScopesRequired
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(models.Scopes, scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+	}
+
+	scopes := make([]string, 0, len(models.Scopes))
+	for _, scope := range models.Scopes {
+		if slices.Contains(requested, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
+
+// generateToken returns a new random token with the recognizable prefix
+func generateToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate api token: %w", err)
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 of a token. Tokens carry 256 bits of entropy,
+// so a fast unsalted hash is sufficient and allows lookup by hash.
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func toTokenOutput(token *models.APIToken) *TokenOutput {
+	output := &TokenOutput{
+		ID:        token.ID,
+		Name:      token.Name,
+		Prefix:    token.TokenPrefix,
+		Scopes:    token.ScopeList(),
+		CreatedAt: token.CreatedAt.Time,
+	}
+	if token.ExpiresAt.Valid {
+		expiresAt := token.ExpiresAt.Time
+		output.ExpiresAt = &expiresAt
+	}
+	if token.LastUsedAt.Valid {
+		lastUsedAt := token.LastUsedAt.Time
+		output.LastUsedAt = &lastUsedAt
+	}
+	return output
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/apitoken/models"
+	"wish-list/internal/domain/apitoken/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testUserID  = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testTokenID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+)
+
+func tokenRepo() *APITokenRepositoryInterfaceMock {
+	return &APITokenRepositoryInterfaceMock{
+		CountActiveByUserFunc: func(ctx context.Context, userID pgtype.UUID) (int, error) {
+			return 0, nil
+		},
+		CreateFunc: func(ctx context.Context, token models.APIToken) (*models.APIToken, error) {
+			token.ID = testTokenID
+			token.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+			return &token, nil
+		},
+		TouchLastUsedFunc: func(ctx context.Context, id pgtype.UUID) error {
+			return nil
+		},
+	}
+}
+
+func TestAPITokenService_CreateToken(t *testing.T) {
+	t.Run("returns the token once and stores only its hash", func(t *testing.T) {
+		repo := tokenRepo()
+		svc := NewAPITokenService(repo)
+		days := 30
+
+		output, err := svc.CreateToken(context.Background(), testUserID, CreateTokenInput{
+			Name:          "  Browser extension ",
+			Scopes:        []string{models.ScopeWishlistsRead, models.ScopeItemsWrite, models.ScopeItemsWrite},
+			ExpiresInDays: &days,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(output.Token, TokenPrefix))
+		assert.Equal(t, "Browser extension", output.Name)
+		assert.Equal(t, []string{models.ScopeItemsWrite, models.ScopeWishlistsRead}, output.Scopes)
+		assert.Equal(t, output.Token[:12], output.Prefix)
+		require.NotNil(t, output.ExpiresAt)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *output.ExpiresAt, time.Minute)
+
+		stored := repo.CreateCalls()[0].Token
+		assert.Equal(t, testUserID, stored.UserID)
+		assert.Equal(t, hashToken(output.Token), stored.TokenHash)
+		assert.NotContains(t, stored.TokenHash, output.Token)
+		assert.Equal(t, "items:write wishlists:read", stored.Scopes)
+	})
+
+	t.Run("tokens are unique", func(t *testing.T) {
+		svc := NewAPITokenService(tokenRepo())
+		input := CreateTokenInput{Name: "CLI", Scopes: []string{models.ScopeItemsWrite}}
+
+		first, err := svc.CreateToken(context.Background(), testUserID, input)
+		require.NoError(t, err)
+		second, err := svc.CreateToken(context.Background(), testUserID, input)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.Token, second.Token)
+		assert.Nil(t, first.ExpiresAt)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tooLong := MaxExpiryDays + 1
+		tests := []struct {
+			name    string
+			input   CreateTokenInput
+			wantErr error
+		}{
+			{"blank name", CreateTokenInput{Name: " ", Scopes: []string{models.ScopeItemsWrite}}, ErrNameRequired},
+			{"no scopes", CreateTokenInput{Name: "CLI"}, ErrScopesRequired},
+			{"unknown scope", CreateTokenInput{Name: "CLI", Scopes: []string{"admin"}}, ErrInvalidScope},
+			{"expiry too long", CreateTokenInput{Name: "CLI", Scopes: []string{models.ScopeItemsWrite}, ExpiresInDays: &tooLong}, ErrInvalidExpiry},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := tokenRepo()
+				_, err := NewAPITokenService(repo).CreateToken(context.Background(), testUserID, tt.input)
+
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.CreateCalls())
+			})
+		}
+	})
+
+	t.Run("too many active tokens", func(t *testing.T) {
+		repo := tokenRepo()
+		repo.CountActiveByUserFunc = func(ctx context.Context, userID pgtype.UUID) (int, error) {
+			return MaxActiveTokens, nil
+		}
+
+		_, err := NewAPITokenService(repo).CreateToken(context.Background(), testUserID, CreateTokenInput{
+			Name: "CLI", Scopes: []string{models.ScopeItemsWrite},
+		})
+
+		assert.ErrorIs(t, err, ErrTooManyTokens)
+		assert.Empty(t, repo.CreateCalls())
+	})
+}
+
+func TestAPITokenService_Authenticate(t *testing.T) {
+	const raw = TokenPrefix + "secret"
+
+	activeRepo := func() *APITokenRepositoryInterfaceMock {
+		repo := tokenRepo()
+		repo.GetActiveByHashFunc = func(ctx context.Context, tokenHash string) (*models.APIToken, error) {
+			if tokenHash != hashToken(raw) {
+				return nil, repository.ErrTokenNotFound
+			}
+			return &models.APIToken{ID: testTokenID, UserID: testUserID, Scopes: models.ScopeItemsWrite}, nil
+		}
+		return repo
+	}
+
+	t.Run("valid token with scope", func(t *testing.T) {
+		repo := activeRepo()
+
+		userID, err := NewAPITokenService(repo).Authenticate(context.Background(), raw, models.ScopeItemsWrite)
+
+		require.NoError(t, err)
+		assert.Equal(t, testUserID, userID)
+		require.Len(t, repo.TouchLastUsedCalls(), 1)
+		assert.Equal(t, testTokenID, repo.TouchLastUsedCalls()[0].ID)
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		_, err := NewAPITokenService(activeRepo()).Authenticate(context.Background(), raw, models.ScopeWishlistsRead)
+
+		assert.ErrorIs(t, err, ErrInsufficientScope)
+	})
+
+	t.Run("unknown, revoked or expired token", func(t *testing.T) {
+		_, err := NewAPITokenService(activeRepo()).Authenticate(context.Background(), TokenPrefix+"other", models.ScopeItemsWrite)
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("not an api token", func(t *testing.T) {
+		repo := activeRepo()
+
+		_, err := NewAPITokenService(repo).Authenticate(context.Background(), "eyJhbGciOiJIUzI1NiJ9.jwt", models.ScopeItemsWrite)
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+		assert.Empty(t, repo.GetActiveByHashCalls())
+	})
+
+	t.Run("failure to record use does not reject the request", func(t *testing.T) {
+		repo := activeRepo()
+		repo.TouchLastUsedFunc = func(ctx context.Context, id pgtype.UUID) error {
+			return errors.New("db down")
+		}
+
+		_, err := NewAPITokenService(repo).Authenticate(context.Background(), raw, models.ScopeItemsWrite)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAPITokenService_RevokeToken(t *testing.T) {
+	t.Run("revokes the user's token", func(t *testing.T) {
+		repo := &APITokenRepositoryInterfaceMock{
+			RevokeFunc: func(ctx context.Context, id, userID pgtype.UUID) error {
+				return nil
+			},
+		}
+
+		require.NoError(t, NewAPITokenService(repo).RevokeToken(context.Background(), testUserID, testTokenID.String()))
+
+		assert.Equal(t, testTokenID, repo.RevokeCalls()[0].ID)
+		assert.Equal(t, testUserID, repo.RevokeCalls()[0].UserID)
+	})
+
+	t.Run("token of another user", func(t *testing.T) {
+		repo := &APITokenRepositoryInterfaceMock{
+			RevokeFunc: func(ctx context.Context, id, userID pgtype.UUID) error {
+				return repository.ErrTokenNotFound
+			},
+		}
+
+		err := NewAPITokenService(repo).RevokeToken(context.Background(), testUserID, testTokenID.String())
+
+		assert.ErrorIs(t, err, ErrTokenNotFound)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		err := NewAPITokenService(&APITokenRepositoryInterfaceMock{}).RevokeToken(context.Background(), testUserID, "nope")
+
+		assert.ErrorIs(t, err, ErrInvalidTokenID)
+	})
+}
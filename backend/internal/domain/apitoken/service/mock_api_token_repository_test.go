@@ -0,0 +1,340 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/apitoken/models"
+	"wish-list/internal/domain/apitoken/repository"
+)
+
+// Ensure, that APITokenRepositoryInterfaceMock does implement repository.APITokenRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.APITokenRepositoryInterface = &APITokenRepositoryInterfaceMock{}
+
+// APITokenRepositoryInterfaceMock is a mock implementation of repository.APITokenRepositoryInterface.
+//
+//	func TestSomethingThatUsesAPITokenRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.APITokenRepositoryInterface
+//		mockedAPITokenRepositoryInterface := &APITokenRepositoryInterfaceMock{
+//			CountActiveByUserFunc: func(ctx context.Context, userID pgtype.UUID) (int, error) {
+//				panic("mock out the CountActiveByUser method")
+//			},
+//			CreateFunc: func(ctx context.Context, token models.APIToken) (*models.APIToken, error) {
+//				panic("mock out the Create method")
+//			},
+//			GetActiveByHashFunc: func(ctx context.Context, tokenHash string) (*models.APIToken, error) {
+//				panic("mock out the GetActiveByHash method")
+//			},
+//			ListByUserFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.APIToken, error) {
+//				panic("mock out the ListByUser method")
+//			},
+//			RevokeFunc: func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+//				panic("mock out the Revoke method")
+//			},
+//			TouchLastUsedFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the TouchLastUsed method")
+//			},
+//		}
+//
+//		// use mockedAPITokenRepositoryInterface in code that requires repository.APITokenRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type APITokenRepositoryInterfaceMock struct {
+	// CountActiveByUserFunc mocks the CountActiveByUser method.
+	CountActiveByUserFunc func(ctx context.Context, userID pgtype.UUID) (int, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, token models.APIToken) (*models.APIToken, error)
+
+	// GetActiveByHashFunc mocks the GetActiveByHash method.
+	GetActiveByHashFunc func(ctx context.Context, tokenHash string) (*models.APIToken, error)
+
+	// ListByUserFunc mocks the ListByUser method.
+	ListByUserFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.APIToken, error)
+
+	// RevokeFunc mocks the Revoke method.
+	RevokeFunc func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error
+
+	// TouchLastUsedFunc mocks the TouchLastUsed method.
+	TouchLastUsedFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountActiveByUser holds details about calls to the CountActiveByUser method.
+		CountActiveByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token models.APIToken
+		}
+		// GetActiveByHash holds details about calls to the GetActiveByHash method.
+		GetActiveByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TokenHash is the tokenHash argument value.
+			TokenHash string
+		}
+		// ListByUser holds details about calls to the ListByUser method.
+		ListByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// Revoke holds details about calls to the Revoke method.
+		Revoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// TouchLastUsed holds details about calls to the TouchLastUsed method.
+		TouchLastUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockCountActiveByUser sync.RWMutex
+	lockCreate            sync.RWMutex
+	lockGetActiveByHash   sync.RWMutex
+	lockListByUser        sync.RWMutex
+	lockRevoke            sync.RWMutex
+	lockTouchLastUsed     sync.RWMutex
+}
+
+// CountActiveByUser calls CountActiveByUserFunc.
+func (mock *APITokenRepositoryInterfaceMock) CountActiveByUser(ctx context.Context, userID pgtype.UUID) (int, error) {
+	if mock.CountActiveByUserFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.CountActiveByUserFunc: method is nil but APITokenRepositoryInterface.CountActiveByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockCountActiveByUser.Lock()
+	mock.calls.CountActiveByUser = append(mock.calls.CountActiveByUser, callInfo)
+	mock.lockCountActiveByUser.Unlock()
+	return mock.CountActiveByUserFunc(ctx, userID)
+}
+
+// CountActiveByUserCalls gets all the calls that were made to CountActiveByUser.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.CountActiveByUserCalls())
+func (mock *APITokenRepositoryInterfaceMock) CountActiveByUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockCountActiveByUser.RLock()
+	calls = mock.calls.CountActiveByUser
+	mock.lockCountActiveByUser.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *APITokenRepositoryInterfaceMock) Create(ctx context.Context, token models.APIToken) (*models.APIToken, error) {
+	if mock.CreateFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.CreateFunc: method is nil but APITokenRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token models.APIToken
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, token)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.CreateCalls())
+func (mock *APITokenRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx   context.Context
+	Token models.APIToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token models.APIToken
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// GetActiveByHash calls GetActiveByHashFunc.
+func (mock *APITokenRepositoryInterfaceMock) GetActiveByHash(ctx context.Context, tokenHash string) (*models.APIToken, error) {
+	if mock.GetActiveByHashFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.GetActiveByHashFunc: method is nil but APITokenRepositoryInterface.GetActiveByHash was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		TokenHash string
+	}{
+		Ctx:       ctx,
+		TokenHash: tokenHash,
+	}
+	mock.lockGetActiveByHash.Lock()
+	mock.calls.GetActiveByHash = append(mock.calls.GetActiveByHash, callInfo)
+	mock.lockGetActiveByHash.Unlock()
+	return mock.GetActiveByHashFunc(ctx, tokenHash)
+}
+
+// GetActiveByHashCalls gets all the calls that were made to GetActiveByHash.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.GetActiveByHashCalls())
+func (mock *APITokenRepositoryInterfaceMock) GetActiveByHashCalls() []struct {
+	Ctx       context.Context
+	TokenHash string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		TokenHash string
+	}
+	mock.lockGetActiveByHash.RLock()
+	calls = mock.calls.GetActiveByHash
+	mock.lockGetActiveByHash.RUnlock()
+	return calls
+}
+
+// ListByUser calls ListByUserFunc.
+func (mock *APITokenRepositoryInterfaceMock) ListByUser(ctx context.Context, userID pgtype.UUID) ([]*models.APIToken, error) {
+	if mock.ListByUserFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.ListByUserFunc: method is nil but APITokenRepositoryInterface.ListByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListByUser.Lock()
+	mock.calls.ListByUser = append(mock.calls.ListByUser, callInfo)
+	mock.lockListByUser.Unlock()
+	return mock.ListByUserFunc(ctx, userID)
+}
+
+// ListByUserCalls gets all the calls that were made to ListByUser.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.ListByUserCalls())
+func (mock *APITokenRepositoryInterfaceMock) ListByUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListByUser.RLock()
+	calls = mock.calls.ListByUser
+	mock.lockListByUser.RUnlock()
+	return calls
+}
+
+// Revoke calls RevokeFunc.
+func (mock *APITokenRepositoryInterfaceMock) Revoke(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+	if mock.RevokeFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.RevokeFunc: method is nil but APITokenRepositoryInterface.Revoke was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		UserID: userID,
+	}
+	mock.lockRevoke.Lock()
+	mock.calls.Revoke = append(mock.calls.Revoke, callInfo)
+	mock.lockRevoke.Unlock()
+	return mock.RevokeFunc(ctx, id, userID)
+}
+
+// RevokeCalls gets all the calls that were made to Revoke.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.RevokeCalls())
+func (mock *APITokenRepositoryInterfaceMock) RevokeCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockRevoke.RLock()
+	calls = mock.calls.Revoke
+	mock.lockRevoke.RUnlock()
+	return calls
+}
+
+// TouchLastUsed calls TouchLastUsedFunc.
+func (mock *APITokenRepositoryInterfaceMock) TouchLastUsed(ctx context.Context, id pgtype.UUID) error {
+	if mock.TouchLastUsedFunc == nil {
+		panic("APITokenRepositoryInterfaceMock.TouchLastUsedFunc: method is nil but APITokenRepositoryInterface.TouchLastUsed was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockTouchLastUsed.Lock()
+	mock.calls.TouchLastUsed = append(mock.calls.TouchLastUsed, callInfo)
+	mock.lockTouchLastUsed.Unlock()
+	return mock.TouchLastUsedFunc(ctx, id)
+}
+
+// TouchLastUsedCalls gets all the calls that were made to TouchLastUsed.
+// Check the length with:
+//
+//	len(mockedAPITokenRepositoryInterface.TouchLastUsedCalls())
+func (mock *APITokenRepositoryInterfaceMock) TouchLastUsedCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockTouchLastUsed.RLock()
+	calls = mock.calls.TouchLastUsed
+	mock.lockTouchLastUsed.RUnlock()
+	return calls
+}
@@ -5,6 +5,7 @@ import (
 
 	userservice "wish-list/internal/domain/user/service"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/emailcheck"
 )
 
 // mapAuthServiceError converts auth-related service errors to AppErrors
@@ -16,6 +17,10 @@ func mapAuthServiceError(err error) error {
 		return apperrors.Unauthorized("Current password is incorrect")
 	case errors.Is(err, userservice.ErrUserAlreadyExists):
 		return apperrors.Conflict("Email already in use")
+	case errors.Is(err, userservice.ErrEmailRejected):
+		return apperrors.UnprocessableEntity(emailcheck.Describe(err))
+	case errors.Is(err, userservice.ErrAccountPendingDeletion):
+		return apperrors.Forbidden("Account is scheduled for deletion. Cancel the deletion to sign in again")
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
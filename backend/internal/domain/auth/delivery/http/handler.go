@@ -51,6 +51,7 @@ func NewHandler(
 //	@Produce		json
 //	@Success		200	{object}	dto.RefreshResponse		"Token refreshed successfully"
 //	@Failure		401	{object}	map[string]string	"Invalid or expired refresh token"
+//	@Failure		403	{object}	map[string]string	"Account is scheduled for deletion"
 //	@Router			/auth/refresh [post]
 func (h *Handler) Refresh(c echo.Context) error {
 	var refreshToken string
@@ -83,15 +84,25 @@ func (h *Handler) Refresh(c echo.Context) error {
 		return apperrors.Unauthorized("Invalid or expired refresh token")
 	}
 
+	// Reload the account: sessions pending deletion must not be extended and the
+	// user type is re-read so promotions and demotions apply on the next refresh
+	user, err := h.userService.GetUser(c.Request().Context(), claims.UserID)
+	if err != nil {
+		return mapAuthServiceError(err)
+	}
+	if user.DeletionRequestedAt != nil {
+		return mapAuthServiceError(userservice.ErrAccountPendingDeletion)
+	}
+
 	// Generate new access token
-	newAccessToken, err := h.tokenManager.GenerateAccessToken(claims.UserID, claims.Email, claims.UserType)
+	newAccessToken, err := h.tokenManager.GenerateAccessToken(claims.UserID, claims.Email, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Failed to generate access token").Wrap(err)
 	}
 
 	// Generate new refresh token (rotation)
 	newTokenID := uuid.New().String()
-	newRefreshToken, err := h.tokenManager.GenerateRefreshToken(claims.UserID, claims.Email, claims.UserType, newTokenID)
+	newRefreshToken, err := h.tokenManager.GenerateRefreshToken(claims.UserID, claims.Email, user.UserType, newTokenID)
 	if err != nil {
 		return apperrors.Internal("Failed to generate refresh token").Wrap(err)
 	}
@@ -151,6 +162,7 @@ func (h *Handler) MobileHandoff(c echo.Context) error {
 //	@Success		200		{object}	dto.ExchangeResponse	"Code exchanged successfully"
 //	@Failure		400		{object}	map[string]string	"Invalid request body"
 //	@Failure		401		{object}	map[string]string	"Invalid or expired code"
+//	@Failure		403		{object}	map[string]string	"Account is scheduled for deletion"
 //	@Failure		429		{object}	map[string]string	"Rate limit exceeded (10 requests/minute)"
 //	@Failure		500		{object}	map[string]string	"Internal server error"
 //	@Router			/auth/exchange [post]
@@ -176,16 +188,19 @@ func (h *Handler) Exchange(c echo.Context) error {
 	if err != nil {
 		return mapAuthServiceError(err)
 	}
+	if user.DeletionRequestedAt != nil {
+		return mapAuthServiceError(userservice.ErrAccountPendingDeletion)
+	}
 
 	// Generate access token
-	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, "user")
+	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Failed to generate access token").Wrap(err)
 	}
 
 	// Generate refresh token
 	tokenID := uuid.New().String()
-	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, "user", tokenID)
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, user.UserType, tokenID)
 	if err != nil {
 		return apperrors.Internal("Failed to generate refresh token").Wrap(err)
 	}
@@ -238,6 +253,7 @@ func (h *Handler) Logout(c echo.Context) error {
 //	@Failure		400		{object}	map[string]string	"Invalid request body or validation error"
 //	@Failure		401		{object}	map[string]string	"Unauthorized or incorrect password"
 //	@Failure		409		{object}	map[string]string	"Email already in use"
+//	@Failure		422		{object}	map[string]string	"Email address rejected (malformed, disposable or undeliverable)"
 //	@Failure		500		{object}	map[string]string	"Internal server error"
 //	@Router			/auth/change-email [post]
 func (h *Handler) ChangeEmail(c echo.Context) error {
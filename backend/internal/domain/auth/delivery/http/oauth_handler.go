@@ -16,9 +16,11 @@ import (
 	"wish-list/internal/domain/auth/delivery/http/dto"
 	usermodels "wish-list/internal/domain/user/models"
 	"wish-list/internal/domain/user/repository"
+	userservice "wish-list/internal/domain/user/service"
 	"wish-list/internal/pkg/apperrors"
 	"wish-list/internal/pkg/auth"
 	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/httpclient"
 	"wish-list/internal/pkg/logger"
 
 	"github.com/google/uuid"
@@ -36,9 +38,10 @@ type UserRepositoryInterface interface {
 	Update(ctx context.Context, user usermodels.User) (*usermodels.User, error)
 }
 
-// GuestReservationLinker links guest reservations to an authenticated user by email.
+// GuestReservationLinker queues linking the guest reservations made with a user's
+// email to the user.
 type GuestReservationLinker interface {
-	LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
+	Enqueue(ctx context.Context, userID pgtype.UUID) error
 }
 
 // OAuthHandler handles OAuth authentication flows
@@ -48,7 +51,7 @@ type OAuthHandler struct {
 	tokenManager      *auth.TokenManager
 	googleConfig      *oauth2.Config
 	fbConfig          *oauth2.Config
-	httpTimeout       time.Duration
+	httpClient        *http.Client // Token exchange and profile requests to the providers
 }
 
 // NewOAuthHandler creates a new OAuth handler
@@ -60,11 +63,11 @@ func NewOAuthHandler(
 	fbClientID string,
 	fbClientSecret string,
 	redirectURL string,
-	httpTimeout int,
+	httpTimeout time.Duration,
 	reservationLinker ...GuestReservationLinker,
 ) *OAuthHandler {
-	timeout := time.Duration(httpTimeout) * time.Second
-	if httpTimeout <= 0 {
+	timeout := httpTimeout
+	if timeout <= 0 {
 		timeout = config.DefaultOAuthHTTPTimeout
 	}
 
@@ -94,7 +97,13 @@ func NewOAuthHandler(
 			Scopes:       []string{"email", "public_profile"},
 			Endpoint:     facebook.Endpoint,
 		},
-		httpTimeout: timeout,
+		// Provider endpoints are fixed https URLs; profile reads may be retried
+		httpClient: httpclient.New(httpclient.Options{
+			Timeout:      timeout,
+			BlockPrivate: true,
+			Schemes:      []string{"https"},
+			MaxRetries:   2,
+		}),
 	}
 }
 
@@ -141,7 +150,7 @@ func (h *OAuthHandler) GoogleOAuth(c echo.Context) error {
 	}
 
 	// Exchange authorization code for token
-	ctx := c.Request().Context()
+	ctx := context.WithValue(c.Request().Context(), oauth2.HTTPClient, h.httpClient)
 	token, err := h.googleConfig.Exchange(ctx, req.Code)
 	if err != nil {
 		return h.handleOAuthExchangeError(c, "Google", err)
@@ -170,6 +179,9 @@ func (h *OAuthHandler) GoogleOAuth(c echo.Context) error {
 	if err != nil {
 		return apperrors.Internal("Failed to process user").Wrap(err)
 	}
+	if user.DeletionRequestedAt.Valid {
+		return mapAuthServiceError(userservice.ErrAccountPendingDeletion)
+	}
 
 	// Generate our own tokens
 	userIDStr := uuid.UUID(user.ID.Bytes).String()
@@ -177,13 +189,13 @@ func (h *OAuthHandler) GoogleOAuth(c echo.Context) error {
 	if user.Email == "" && user.EncryptedEmail.Valid {
 		userEmail = user.EncryptedEmail.String
 	}
-	accessToken, err := h.tokenManager.GenerateAccessToken(userIDStr, userEmail, "user")
+	accessToken, err := h.tokenManager.GenerateAccessToken(userIDStr, userEmail, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Failed to generate access token").Wrap(err)
 	}
 
 	tokenID := uuid.New().String()
-	refreshToken, err := h.tokenManager.GenerateRefreshToken(userIDStr, userEmail, "user", tokenID)
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(userIDStr, userEmail, user.UserType, tokenID)
 	if err != nil {
 		return apperrors.Internal("Failed to generate refresh token").Wrap(err)
 	}
@@ -228,7 +240,7 @@ func (h *OAuthHandler) FacebookOAuth(c echo.Context) error {
 	}
 
 	// Exchange authorization code for token
-	ctx := c.Request().Context()
+	ctx := context.WithValue(c.Request().Context(), oauth2.HTTPClient, h.httpClient)
 	token, err := h.fbConfig.Exchange(ctx, req.Code)
 	if err != nil {
 		return h.handleOAuthExchangeError(c, "Facebook", err)
@@ -255,6 +267,9 @@ func (h *OAuthHandler) FacebookOAuth(c echo.Context) error {
 	if err != nil {
 		return apperrors.Internal("Failed to process user").Wrap(err)
 	}
+	if user.DeletionRequestedAt.Valid {
+		return mapAuthServiceError(userservice.ErrAccountPendingDeletion)
+	}
 
 	// Generate our own tokens
 	userIDStr := uuid.UUID(user.ID.Bytes).String()
@@ -262,13 +277,13 @@ func (h *OAuthHandler) FacebookOAuth(c echo.Context) error {
 	if user.Email == "" && user.EncryptedEmail.Valid {
 		userEmail = user.EncryptedEmail.String
 	}
-	accessToken, err := h.tokenManager.GenerateAccessToken(userIDStr, userEmail, "user")
+	accessToken, err := h.tokenManager.GenerateAccessToken(userIDStr, userEmail, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Failed to generate access token").Wrap(err)
 	}
 
 	tokenID := uuid.New().String()
-	refreshToken, err := h.tokenManager.GenerateRefreshToken(userIDStr, userEmail, "user", tokenID)
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(userIDStr, userEmail, user.UserType, tokenID)
 	if err != nil {
 		return apperrors.Internal("Failed to generate refresh token").Wrap(err)
 	}
@@ -296,7 +311,6 @@ func (h *OAuthHandler) FacebookOAuth(c echo.Context) error {
 
 // getGoogleUserInfo fetches user information from Google
 func (h *OAuthHandler) getGoogleUserInfo(ctx context.Context, accessToken string) (*GoogleUserInfo, error) {
-	client := &http.Client{Timeout: h.httpTimeout}
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
@@ -310,7 +324,7 @@ func (h *OAuthHandler) getGoogleUserInfo(ctx context.Context, accessToken string
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
 	//nolint:gosec // Intentional external API call to Google OAuth
-	resp, err := client.Do(req)
+	resp, err := h.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +345,6 @@ func (h *OAuthHandler) getGoogleUserInfo(ctx context.Context, accessToken string
 
 // getFacebookUserInfo fetches user information from Facebook
 func (h *OAuthHandler) getFacebookUserInfo(ctx context.Context, accessToken string) (*FacebookUserInfo, error) {
-	client := &http.Client{Timeout: h.httpTimeout}
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
@@ -345,7 +358,7 @@ func (h *OAuthHandler) getFacebookUserInfo(ctx context.Context, accessToken stri
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
 	//nolint:gosec // Intentional external API call to Facebook OAuth
-	resp, err := client.Do(req)
+	resp, err := h.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -416,7 +429,7 @@ func (h *OAuthHandler) findOrCreateUser(ctx context.Context, email, firstName, l
 		}
 
 		if user.IsVerified.Valid && user.IsVerified.Bool {
-			h.linkGuestReservationsByEmail(ctx, email, user.ID)
+			h.linkGuestReservations(ctx, user.ID)
 		}
 		return user, nil
 	}
@@ -448,21 +461,21 @@ func (h *OAuthHandler) findOrCreateUser(ctx context.Context, email, firstName, l
 	}
 
 	if createdUser.IsVerified.Valid && createdUser.IsVerified.Bool {
-		h.linkGuestReservationsByEmail(ctx, email, createdUser.ID)
+		h.linkGuestReservations(ctx, createdUser.ID)
 	}
 
 	return createdUser, nil
 }
 
-func (h *OAuthHandler) linkGuestReservationsByEmail(ctx context.Context, email string, userID pgtype.UUID) {
+func (h *OAuthHandler) linkGuestReservations(ctx context.Context, userID pgtype.UUID) {
 	if h.reservationLinker == nil || !userID.Valid {
 		return
 	}
 
-	if _, err := h.reservationLinker.LinkGuestReservationsToUserByEmail(ctx, email, userID); err != nil {
+	if err := h.reservationLinker.Enqueue(ctx, userID); err != nil {
 		// Best-effort linking: OAuth login should still succeed.
-		logger.Warn(
-			"failed to link guest reservations for OAuth user",
+		logger.WarnContext(ctx,
+			"failed to queue guest reservation linking for OAuth user",
 			"error",
 			err,
 			"user_id",
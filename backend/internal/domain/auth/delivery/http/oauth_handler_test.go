@@ -38,27 +38,18 @@ func (m *userRepoMock) Update(ctx context.Context, user usermodels.User) (*userm
 }
 
 type guestReservationLinkerMock struct {
-	linkFunc func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
-	calls    []struct {
-		guestEmail string
-		userID     pgtype.UUID
-	}
+	enqueueFunc func(ctx context.Context, userID pgtype.UUID) error
+	calls       []pgtype.UUID
 }
 
-func (m *guestReservationLinkerMock) LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
-	m.calls = append(m.calls, struct {
-		guestEmail string
-		userID     pgtype.UUID
-	}{
-		guestEmail: guestEmail,
-		userID:     userID,
-	})
-
-	if m.linkFunc == nil {
-		return 0, nil
+func (m *guestReservationLinkerMock) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	m.calls = append(m.calls, userID)
+
+	if m.enqueueFunc == nil {
+		return nil
 	}
 
-	return m.linkFunc(ctx, guestEmail, userID)
+	return m.enqueueFunc(ctx, userID)
 }
 
 func TestOAuthHandler_findOrCreateUser_LinksGuestReservations_ExistingUser(t *testing.T) {
@@ -99,8 +90,7 @@ func TestOAuthHandler_findOrCreateUser_LinksGuestReservations_ExistingUser(t *te
 	require.NoError(t, err)
 	require.NotNil(t, user)
 	require.Len(t, linker.calls, 1)
-	assert.Equal(t, "oauth@example.com", linker.calls[0].guestEmail)
-	assert.Equal(t, existingUser.ID, linker.calls[0].userID)
+	assert.Equal(t, existingUser.ID, linker.calls[0])
 }
 
 func TestOAuthHandler_findOrCreateUser_LinksGuestReservations_NewUser(t *testing.T) {
@@ -137,8 +127,7 @@ func TestOAuthHandler_findOrCreateUser_LinksGuestReservations_NewUser(t *testing
 	require.NoError(t, err)
 	require.NotNil(t, user)
 	require.Len(t, linker.calls, 1)
-	assert.Equal(t, "new@example.com", linker.calls[0].guestEmail)
-	assert.Equal(t, createdUserID, uuid.UUID(linker.calls[0].userID.Bytes))
+	assert.Equal(t, createdUserID, uuid.UUID(linker.calls[0].Bytes))
 }
 
 func TestOAuthHandler_findOrCreateUser_LinkingErrorIsNonFatal(t *testing.T) {
@@ -163,8 +152,8 @@ func TestOAuthHandler_findOrCreateUser_LinkingErrorIsNonFatal(t *testing.T) {
 		},
 	}
 	linker := &guestReservationLinkerMock{
-		linkFunc: func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
-			return 0, errors.New("link failure")
+		enqueueFunc: func(ctx context.Context, userID pgtype.UUID) error {
+			return errors.New("link failure")
 		},
 	}
 	handler := &OAuthHandler{
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/billing/service"
+)
+
+// CheckoutResponse is the hosted Stripe page the client redirects the user to
+type CheckoutResponse struct {
+	SessionID string `json:"session_id" validate:"required"`
+	URL       string `json:"url" validate:"required"`
+}
+
+type SubscriptionResponse struct {
+	Plan string `json:"plan" validate:"required" enums:"free,premium"`
+	// Empty when the user never subscribed
+	Status           string  `json:"status" enums:"trialing,active,past_due,canceled,unpaid,incomplete,incomplete_expired,paused"`
+	CurrentPeriodEnd *string `json:"current_period_end,omitempty"`
+}
+
+func FromCheckoutOutput(o *service.CheckoutOutput) CheckoutResponse {
+	return CheckoutResponse{
+		SessionID: o.SessionID,
+		URL:       o.URL,
+	}
+}
+
+func FromSubscriptionOutput(o *service.SubscriptionOutput) SubscriptionResponse {
+	response := SubscriptionResponse{
+		Plan:   o.Plan,
+		Status: o.Status,
+	}
+	if o.CurrentPeriodEnd != nil {
+		periodEnd := o.CurrentPeriodEnd.Format(time.RFC3339)
+		response.CurrentPeriodEnd = &periodEnd
+	}
+	return response
+}
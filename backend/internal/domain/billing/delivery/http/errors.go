@@ -0,0 +1,26 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/billing/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapBillingServiceError converts billing service errors to AppErrors
+func mapBillingServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrBillingDisabled):
+		return apperrors.ServiceUnavailable("Billing is not available")
+	case errors.Is(err, service.ErrUserNotFound):
+		return apperrors.NotFound("User not found")
+	case errors.Is(err, service.ErrAlreadySubscribed):
+		return apperrors.Conflict("You already have an active subscription")
+	case errors.Is(err, service.ErrInvalidSignature):
+		return apperrors.BadRequest("Invalid webhook signature")
+	case errors.Is(err, service.ErrInvalidEvent):
+		return apperrors.BadRequest("Invalid webhook event").Wrap(err)
+	default:
+		return apperrors.Internal("Billing request failed").Wrap(err)
+	}
+}
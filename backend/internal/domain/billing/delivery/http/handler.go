@@ -0,0 +1,111 @@
+package http
+
+import (
+	"io"
+	nethttp "net/http"
+
+	"wish-list/internal/domain/billing/delivery/http/dto"
+	"wish-list/internal/domain/billing/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/stripe"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxWebhookBytes caps the webhook payload read into memory; Stripe events are a few KB
+const maxWebhookBytes = 256 << 10
+
+// Handler handles HTTP requests for billing
+type Handler struct {
+	service service.BillingServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.BillingServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateCheckoutSession godoc
+//
+//	@Summary		Start a premium checkout
+//	@Description	Creates a Stripe Checkout Session for the premium plan. Redirect the user to the returned URL; the plan is upgraded once Stripe confirms the subscription through the webhook.
+//	@Tags			Billing
+//	@Produce		json
+//	@Success		200	{object}	dto.CheckoutResponse	"Checkout session"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		404	{object}	map[string]string		"User not found"
+//	@Failure		409	{object}	map[string]string		"Already subscribed"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Failure		503	{object}	map[string]string		"Billing not configured"
+//	@Security		BearerAuth
+//	@Router			/protected/billing/checkout [post]
+func (h *Handler) CreateCheckoutSession(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	output, err := h.service.CreateCheckoutSession(c.Request().Context(), userID)
+	if err != nil {
+		return mapBillingServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromCheckoutOutput(output))
+}
+
+// GetSubscription godoc
+//
+//	@Summary		Get subscription
+//	@Description	The current user's plan and the state of their Stripe subscription
+//	@Tags			Billing
+//	@Produce		json
+//	@Success		200	{object}	dto.SubscriptionResponse	"Subscription"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		404	{object}	map[string]string			"User not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/billing/subscription [get]
+func (h *Handler) GetSubscription(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	output, err := h.service.GetSubscription(c.Request().Context(), userID)
+	if err != nil {
+		return mapBillingServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSubscriptionOutput(output))
+}
+
+// HandleWebhook godoc
+//
+//	@Summary		Stripe webhook
+//	@Description	Receives Stripe events and syncs subscription state onto the user. Requests must carry a valid Stripe-Signature header.
+//	@Tags			Billing
+//	@Accept			json
+//	@Param			Stripe-Signature	header	string	true	"Stripe webhook signature"
+//	@Success		200					"Event received"
+//	@Failure		400					{object}	map[string]string	"Invalid signature or event"
+//	@Failure		500					{object}	map[string]string	"Internal server error"
+//	@Failure		503					{object}	map[string]string	"Billing not configured"
+//	@Router			/billing/webhook [post]
+func (h *Handler) HandleWebhook(c echo.Context) error {
+	// The signature covers the exact bytes Stripe sent, so the body is read raw
+	payload, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookBytes))
+	if err != nil {
+		return apperrors.BadRequest("Failed to read request body")
+	}
+
+	signature := c.Request().Header.Get(stripe.SignatureHeader)
+	if err := h.service.HandleWebhook(c.Request().Context(), payload, signature); err != nil {
+		return mapBillingServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusOK)
+}
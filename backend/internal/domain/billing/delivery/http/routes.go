@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers billing HTTP routes.
+// The webhook is public; Stripe authenticates it with a signature instead of a token.
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	e.POST("/api/billing/webhook", h.HandleWebhook)
+
+	protected := e.Group("/api/protected/billing", authMiddleware)
+	protected.POST("/checkout", h.CreateCheckoutSession)
+	protected.GET("/subscription", h.GetSubscription)
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	usermodels "wish-list/internal/domain/user/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Subscription statuses reported by Stripe
+const (
+	StatusTrialing          = "trialing"
+	StatusActive            = "active"
+	StatusPastDue           = "past_due"
+	StatusCanceled          = "canceled"
+	StatusUnpaid            = "unpaid"
+	StatusIncomplete        = "incomplete"
+	StatusIncompleteExpired = "incomplete_expired"
+	StatusPaused            = "paused"
+)
+
+// Account is a user's billing state as stored on the user record
+type Account struct {
+	UserID                pgtype.UUID        `db:"id"`
+	Email                 string             `db:"email"`
+	Plan                  string             `db:"plan"`
+	StripeCustomerID      pgtype.Text        `db:"stripe_customer_id"`
+	SubscriptionID        pgtype.Text        `db:"subscription_id"`
+	SubscriptionStatus    pgtype.Text        `db:"subscription_status"`
+	SubscriptionPeriodEnd pgtype.Timestamptz `db:"subscription_period_end"`
+}
+
+// SubscriptionState is a subscription snapshot taken from a webhook event.
+// SyncedAt is the event time, used to ignore events delivered out of order.
+type SubscriptionState struct {
+	CustomerID     string
+	SubscriptionID string
+	Status         string
+	PeriodEnd      time.Time
+	SyncedAt       time.Time
+}
+
+// PlanForStatus returns the plan a subscription in the given status grants.
+// Past-due subscriptions keep premium while Stripe retries the payment.
+func PlanForStatus(status string) string {
+	switch status {
+	case StatusActive, StatusTrialing, StatusPastDue:
+		return usermodels.PlanPremium
+	default:
+		return usermodels.PlanFree
+	}
+}
@@ -0,0 +1,108 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_billing_repository_test.go -pkg service . BillingRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/billing/models"
+)
+
+// Sentinel errors for billing repository
+var (
+	ErrAccountNotFound = errors.New("billing account not found")
+)
+
+// BillingRepositoryInterface defines the interface for billing database operations
+type BillingRepositoryInterface interface {
+	GetAccount(ctx context.Context, userID pgtype.UUID) (*models.Account, error)
+	SetCustomerID(ctx context.Context, userID pgtype.UUID, customerID string) error
+	SyncSubscription(ctx context.Context, state models.SubscriptionState, plan string) (bool, error)
+}
+
+type BillingRepository struct {
+	db *database.DB
+}
+
+func NewBillingRepository(db *database.DB) BillingRepositoryInterface {
+	return &BillingRepository{
+		db: db,
+	}
+}
+
+// GetAccount returns the billing state of a user
+func (r *BillingRepository) GetAccount(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+	query := `
+		SELECT id, email, plan, stripe_customer_id, subscription_id, subscription_status, subscription_period_end
+		FROM users
+		WHERE id = $1
+	`
+
+	var account models.Account
+	if err := r.db.GetContext(ctx, &account, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get billing account: %w", err)
+	}
+
+	return &account, nil
+}
+
+// SetCustomerID links a user to their Stripe customer
+func (r *BillingRepository) SetCustomerID(ctx context.Context, userID pgtype.UUID, customerID string) error {
+	query := `UPDATE users SET stripe_customer_id = $2, updated_at = NOW() WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, userID, customerID)
+	if err != nil {
+		return fmt.Errorf("failed to set stripe customer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrAccountNotFound
+	}
+
+	return nil
+}
+
+// SyncSubscription stores the subscription state and resulting plan on the user linked to
+// the Stripe customer. It reports false, without changing anything, when no user is linked
+// to the customer or a newer event has already been applied.
+func (r *BillingRepository) SyncSubscription(ctx context.Context, state models.SubscriptionState, plan string) (bool, error) {
+	query := `
+		UPDATE users
+		SET subscription_id = $2,
+		    subscription_status = $3,
+		    subscription_period_end = $4,
+		    subscription_synced_at = $5,
+		    plan = $6,
+		    updated_at = NOW()
+		WHERE stripe_customer_id = $1
+		  AND (subscription_synced_at IS NULL OR subscription_synced_at <= $5)
+	`
+
+	periodEnd := pgtype.Timestamptz{Time: state.PeriodEnd, Valid: !state.PeriodEnd.IsZero()}
+	result, err := r.db.ExecContext(ctx, query,
+		state.CustomerID, state.SubscriptionID, state.Status, periodEnd, state.SyncedAt, plan)
+	if err != nil {
+		return false, fmt.Errorf("failed to sync subscription: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,251 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_payment_provider_test.go -pkg service . PaymentProviderInterface
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/billing/models"
+	"wish-list/internal/domain/billing/repository"
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/stripe"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrBillingDisabled   = errors.New("billing is not configured")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrAlreadySubscribed = errors.New("user already has an active subscription")
+	ErrInvalidSignature  = errors.New("invalid webhook signature")
+	ErrInvalidEvent      = errors.New("invalid webhook event")
+)
+
+// PaymentProviderInterface creates hosted checkout pages (implemented by *stripe.Client)
+type PaymentProviderInterface interface {
+	CreateCheckoutSession(ctx context.Context, params stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error)
+}
+
+// BillingServiceInterface defines the interface for billing operations
+type BillingServiceInterface interface {
+	CreateCheckoutSession(ctx context.Context, userID pgtype.UUID) (*CheckoutOutput, error)
+	GetSubscription(ctx context.Context, userID pgtype.UUID) (*SubscriptionOutput, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+// CheckoutConfig is the premium price and the URLs Stripe returns the user to
+type CheckoutConfig struct {
+	PriceID    string
+	SuccessURL string
+	CancelURL  string
+}
+
+type BillingService struct {
+	repo          repository.BillingRepositoryInterface
+	payments      PaymentProviderInterface
+	checkout      CheckoutConfig
+	webhookSecret string
+}
+
+// NewBillingService creates a billing service. A nil payments provider disables checkout
+// and an empty webhookSecret disables webhooks.
+func NewBillingService(
+	repo repository.BillingRepositoryInterface,
+	payments PaymentProviderInterface,
+	checkout CheckoutConfig,
+	webhookSecret string,
+) *BillingService {
+	return &BillingService{
+		repo:          repo,
+		payments:      payments,
+		checkout:      checkout,
+		webhookSecret: webhookSecret,
+	}
+}
+
+// CheckoutOutput is the hosted checkout page the user is sent to
+type CheckoutOutput struct {
+	SessionID string
+	URL       string
+}
+
+// SubscriptionOutput is the user's plan and the state of their subscription, if any
+type SubscriptionOutput struct {
+	Plan             string
+	Status           string     // Empty when the user never subscribed
+	CurrentPeriodEnd *time.Time // When the subscription renews or, once canceled, ends
+}
+
+// CreateCheckoutSession starts a premium subscription checkout for the user
+func (s *BillingService) CreateCheckoutSession(ctx context.Context, userID pgtype.UUID) (*CheckoutOutput, error) {
+	if s.payments == nil {
+		return nil, ErrBillingDisabled
+	}
+
+	account, err := s.getAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.SubscriptionID.Valid && models.PlanForStatus(account.SubscriptionStatus.String) == usermodels.PlanPremium {
+		return nil, ErrAlreadySubscribed
+	}
+
+	params := stripe.CheckoutSessionParams{
+		PriceID:           s.checkout.PriceID,
+		ClientReferenceID: userID.String(),
+		SuccessURL:        s.checkout.SuccessURL,
+		CancelURL:         s.checkout.CancelURL,
+	}
+	if account.StripeCustomerID.Valid {
+		params.CustomerID = account.StripeCustomerID.String
+	} else {
+		params.CustomerEmail = account.Email
+	}
+
+	session, err := s.payments.CreateCheckoutSession(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	return &CheckoutOutput{
+		SessionID: session.ID,
+		URL:       session.URL,
+	}, nil
+}
+
+// GetSubscription returns the user's plan and subscription state
+func (s *BillingService) GetSubscription(ctx context.Context, userID pgtype.UUID) (*SubscriptionOutput, error) {
+	account, err := s.getAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SubscriptionOutput{
+		Plan:   account.Plan,
+		Status: account.SubscriptionStatus.String,
+	}
+	if account.SubscriptionPeriodEnd.Valid {
+		periodEnd := account.SubscriptionPeriodEnd.Time
+		output.CurrentPeriodEnd = &periodEnd
+	}
+
+	return output, nil
+}
+
+// HandleWebhook verifies a Stripe webhook and syncs the subscription it reports onto the
+// user. Events for unknown users and event types billing does not use are acknowledged
+// and ignored, so Stripe does not retry them.
+func (s *BillingService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	if s.webhookSecret == "" {
+		return ErrBillingDisabled
+	}
+
+	event, err := stripe.ConstructEvent(payload, signature, s.webhookSecret)
+	if err != nil {
+		if errors.Is(err, stripe.ErrInvalidSignature) {
+			return ErrInvalidSignature
+		}
+		return fmt.Errorf("%w: %w", ErrInvalidEvent, err)
+	}
+
+	switch event.Type {
+	case stripe.EventCheckoutSessionCompleted:
+		var session stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Object, &session); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidEvent, err)
+		}
+		return s.linkCustomer(ctx, session.ClientReferenceID, session.Customer)
+
+	case stripe.EventSubscriptionCreated, stripe.EventSubscriptionUpdated, stripe.EventSubscriptionDeleted:
+		var subscription stripe.Subscription
+		if err := json.Unmarshal(event.Data.Object, &subscription); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidEvent, err)
+		}
+		return s.syncSubscription(ctx, event, &subscription)
+
+	default:
+		logger.DebugContext(ctx, "ignoring stripe event", "event_id", event.ID, "type", event.Type)
+		return nil
+	}
+}
+
+// syncSubscription applies a subscription event to the user linked to its customer.
+// Subscription events can arrive before checkout.session.completed, so the user_id set
+// on the subscription at checkout is used to link the customer first.
+func (s *BillingService) syncSubscription(ctx context.Context, event *stripe.Event, subscription *stripe.Subscription) error {
+	if userID := subscription.Metadata["user_id"]; userID != "" {
+		if err := s.linkCustomer(ctx, userID, subscription.Customer); err != nil {
+			return err
+		}
+	}
+
+	status := subscription.Status
+	if event.Type == stripe.EventSubscriptionDeleted {
+		status = models.StatusCanceled
+	}
+
+	state := models.SubscriptionState{
+		CustomerID:     subscription.Customer,
+		SubscriptionID: subscription.ID,
+		Status:         status,
+		SyncedAt:       time.Unix(event.Created, 0),
+	}
+	if subscription.CurrentPeriodEnd > 0 {
+		state.PeriodEnd = time.Unix(subscription.CurrentPeriodEnd, 0)
+	}
+
+	plan := models.PlanForStatus(status)
+	applied, err := s.repo.SyncSubscription(ctx, state, plan)
+	if err != nil {
+		return fmt.Errorf("failed to sync subscription: %w", err)
+	}
+	if !applied {
+		logger.InfoContext(ctx, "stripe subscription event not applied",
+			"event_id", event.ID, "customer", subscription.Customer, "reason", "unknown customer or stale event")
+		return nil
+	}
+
+	logger.InfoContext(ctx, "stripe subscription synced",
+		"event_id", event.ID, "customer", subscription.Customer, "status", status, "plan", plan)
+	return nil
+}
+
+// linkCustomer stores the Stripe customer on the user it was created for
+func (s *BillingService) linkCustomer(ctx context.Context, userID, customerID string) error {
+	if userID == "" || customerID == "" {
+		return nil
+	}
+
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		logger.WarnContext(ctx, "stripe event references an invalid user id", "user_id", userID)
+		return nil
+	}
+
+	if err := s.repo.SetCustomerID(ctx, id, customerID); err != nil {
+		if errors.Is(err, repository.ErrAccountNotFound) {
+			logger.WarnContext(ctx, "stripe event references an unknown user", "user_id", userID)
+			return nil
+		}
+		return fmt.Errorf("failed to link stripe customer: %w", err)
+	}
+
+	return nil
+}
+
+func (s *BillingService) getAccount(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+	account, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAccountNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get billing account: %w", err)
+	}
+	return account, nil
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/billing/models"
+	"wish-list/internal/domain/billing/repository"
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/stripe"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+const (
+	testUserIDString = "01010101-0101-0101-0101-010101010101"
+	testWebhookKey   = "whsec_test"
+)
+
+var (
+	testUserID = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+
+	testCheckout = CheckoutConfig{
+		PriceID:    "price_premium",
+		SuccessURL: "https://app.example.com/billing/success",
+		CancelURL:  "https://app.example.com/billing",
+	}
+)
+
+func freeAccount() *models.Account {
+	return &models.Account{UserID: testUserID, Email: "user@example.com", Plan: usermodels.PlanFree}
+}
+
+func billingRepo(account *models.Account) *BillingRepositoryInterfaceMock {
+	return &BillingRepositoryInterfaceMock{
+		GetAccountFunc: func(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+			return account, nil
+		},
+		SetCustomerIDFunc: func(ctx context.Context, userID pgtype.UUID, customerID string) error {
+			return nil
+		},
+		SyncSubscriptionFunc: func(ctx context.Context, state models.SubscriptionState, plan string) (bool, error) {
+			return true, nil
+		},
+	}
+}
+
+func checkoutProvider() *PaymentProviderInterfaceMock {
+	return &PaymentProviderInterfaceMock{
+		CreateCheckoutSessionFunc: func(ctx context.Context, params stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+			return &stripe.CheckoutSession{ID: "cs_1", URL: "https://checkout.stripe.com/c/cs_1"}, nil
+		},
+	}
+}
+
+func signed(payload string) (body []byte, header string) {
+	return []byte(payload), stripe.GenerateTestHeader([]byte(payload), testWebhookKey, time.Now())
+}
+
+func TestBillingService_CreateCheckoutSession(t *testing.T) {
+	t.Run("new customer checks out with their email", func(t *testing.T) {
+		payments := checkoutProvider()
+		svc := NewBillingService(billingRepo(freeAccount()), payments, testCheckout, testWebhookKey)
+
+		output, err := svc.CreateCheckoutSession(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cs_1", output.SessionID)
+		assert.Equal(t, "https://checkout.stripe.com/c/cs_1", output.URL)
+
+		params := payments.CreateCheckoutSessionCalls()[0].Params
+		assert.Equal(t, "price_premium", params.PriceID)
+		assert.Equal(t, testUserIDString, params.ClientReferenceID)
+		assert.Equal(t, "user@example.com", params.CustomerEmail)
+		assert.Empty(t, params.CustomerID)
+	})
+
+	t.Run("returning customer reuses the stripe customer", func(t *testing.T) {
+		account := freeAccount()
+		account.StripeCustomerID = pgtype.Text{String: "cus_1", Valid: true}
+		account.SubscriptionID = pgtype.Text{String: "sub_old", Valid: true}
+		account.SubscriptionStatus = pgtype.Text{String: models.StatusCanceled, Valid: true}
+		payments := checkoutProvider()
+		svc := NewBillingService(billingRepo(account), payments, testCheckout, testWebhookKey)
+
+		_, err := svc.CreateCheckoutSession(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		params := payments.CreateCheckoutSessionCalls()[0].Params
+		assert.Equal(t, "cus_1", params.CustomerID)
+		assert.Empty(t, params.CustomerEmail)
+	})
+
+	t.Run("active subscription", func(t *testing.T) {
+		account := freeAccount()
+		account.Plan = usermodels.PlanPremium
+		account.SubscriptionID = pgtype.Text{String: "sub_1", Valid: true}
+		account.SubscriptionStatus = pgtype.Text{String: models.StatusActive, Valid: true}
+		payments := checkoutProvider()
+		svc := NewBillingService(billingRepo(account), payments, testCheckout, testWebhookKey)
+
+		_, err := svc.CreateCheckoutSession(context.Background(), testUserID)
+
+		assert.ErrorIs(t, err, ErrAlreadySubscribed)
+		assert.Empty(t, payments.CreateCheckoutSessionCalls())
+	})
+
+	t.Run("billing not configured", func(t *testing.T) {
+		svc := NewBillingService(billingRepo(freeAccount()), nil, testCheckout, testWebhookKey)
+
+		_, err := svc.CreateCheckoutSession(context.Background(), testUserID)
+
+		assert.ErrorIs(t, err, ErrBillingDisabled)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		repo := billingRepo(nil)
+		repo.GetAccountFunc = func(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+			return nil, repository.ErrAccountNotFound
+		}
+		svc := NewBillingService(repo, checkoutProvider(), testCheckout, testWebhookKey)
+
+		_, err := svc.CreateCheckoutSession(context.Background(), testUserID)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestBillingService_GetSubscription(t *testing.T) {
+	periodEnd := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	account := freeAccount()
+	account.Plan = usermodels.PlanPremium
+	account.SubscriptionStatus = pgtype.Text{String: models.StatusActive, Valid: true}
+	account.SubscriptionPeriodEnd = pgtype.Timestamptz{Time: periodEnd, Valid: true}
+	svc := NewBillingService(billingRepo(account), nil, testCheckout, testWebhookKey)
+
+	output, err := svc.GetSubscription(context.Background(), testUserID)
+
+	require.NoError(t, err)
+	assert.Equal(t, usermodels.PlanPremium, output.Plan)
+	assert.Equal(t, models.StatusActive, output.Status)
+	require.NotNil(t, output.CurrentPeriodEnd)
+	assert.Equal(t, periodEnd, *output.CurrentPeriodEnd)
+}
+
+func TestBillingService_HandleWebhook(t *testing.T) {
+	t.Run("checkout completion links the customer", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload, header := signed(`{"id": "evt_1", "type": "checkout.session.completed", "created": 1700000000,
+			"data": {"object": {"id": "cs_1", "customer": "cus_1", "client_reference_id": "` + testUserIDString + `"}}}`)
+
+		require.NoError(t, svc.HandleWebhook(context.Background(), payload, header))
+
+		require.Len(t, repo.SetCustomerIDCalls(), 1)
+		assert.Equal(t, testUserID, repo.SetCustomerIDCalls()[0].UserID)
+		assert.Equal(t, "cus_1", repo.SetCustomerIDCalls()[0].CustomerID)
+	})
+
+	t.Run("active subscription grants premium", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload, header := signed(`{"id": "evt_2", "type": "customer.subscription.created", "created": 1700000000,
+			"data": {"object": {"id": "sub_1", "customer": "cus_1", "status": "active", "current_period_end": 1702592000,
+			"metadata": {"user_id": "` + testUserIDString + `"}}}}`)
+
+		require.NoError(t, svc.HandleWebhook(context.Background(), payload, header))
+
+		require.Len(t, repo.SetCustomerIDCalls(), 1)
+		require.Len(t, repo.SyncSubscriptionCalls(), 1)
+		call := repo.SyncSubscriptionCalls()[0]
+		assert.Equal(t, usermodels.PlanPremium, call.Plan)
+		assert.Equal(t, "cus_1", call.State.CustomerID)
+		assert.Equal(t, "sub_1", call.State.SubscriptionID)
+		assert.Equal(t, models.StatusActive, call.State.Status)
+		assert.Equal(t, time.Unix(1702592000, 0), call.State.PeriodEnd)
+		assert.Equal(t, time.Unix(1700000000, 0), call.State.SyncedAt)
+	})
+
+	t.Run("deleted subscription downgrades to free", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload, header := signed(`{"id": "evt_3", "type": "customer.subscription.deleted", "created": 1700000000,
+			"data": {"object": {"id": "sub_1", "customer": "cus_1", "status": "active"}}}`)
+
+		require.NoError(t, svc.HandleWebhook(context.Background(), payload, header))
+
+		call := repo.SyncSubscriptionCalls()[0]
+		assert.Equal(t, usermodels.PlanFree, call.Plan)
+		assert.Equal(t, models.StatusCanceled, call.State.Status)
+	})
+
+	t.Run("unhandled event type is ignored", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload, header := signed(`{"id": "evt_4", "type": "invoice.paid", "created": 1700000000, "data": {"object": {}}}`)
+
+		require.NoError(t, svc.HandleWebhook(context.Background(), payload, header))
+
+		assert.Empty(t, repo.SyncSubscriptionCalls())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload := []byte(`{"id": "evt_5", "type": "customer.subscription.updated"}`)
+		header := stripe.GenerateTestHeader(payload, "whsec_other", time.Now())
+
+		err := svc.HandleWebhook(context.Background(), payload, header)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.Empty(t, repo.SyncSubscriptionCalls())
+	})
+
+	t.Run("repository failure is returned so stripe retries", func(t *testing.T) {
+		repo := billingRepo(freeAccount())
+		repo.SyncSubscriptionFunc = func(ctx context.Context, state models.SubscriptionState, plan string) (bool, error) {
+			return false, errors.New("db down")
+		}
+		svc := NewBillingService(repo, nil, testCheckout, testWebhookKey)
+		payload, header := signed(`{"id": "evt_6", "type": "customer.subscription.updated", "created": 1700000000,
+			"data": {"object": {"id": "sub_1", "customer": "cus_1", "status": "past_due"}}}`)
+
+		assert.Error(t, svc.HandleWebhook(context.Background(), payload, header))
+	})
+
+	t.Run("webhooks not configured", func(t *testing.T) {
+		svc := NewBillingService(billingRepo(freeAccount()), nil, testCheckout, "")
+		payload, header := signed(`{}`)
+
+		assert.ErrorIs(t, svc.HandleWebhook(context.Background(), payload, header), ErrBillingDisabled)
+	})
+}
+
+func TestPlanForStatus(t *testing.T) {
+	assert.Equal(t, usermodels.PlanPremium, models.PlanForStatus(models.StatusActive))
+	assert.Equal(t, usermodels.PlanPremium, models.PlanForStatus(models.StatusTrialing))
+	assert.Equal(t, usermodels.PlanPremium, models.PlanForStatus(models.StatusPastDue))
+	assert.Equal(t, usermodels.PlanFree, models.PlanForStatus(models.StatusUnpaid))
+	assert.Equal(t, usermodels.PlanFree, models.PlanForStatus(models.StatusIncompleteExpired))
+	assert.Equal(t, usermodels.PlanFree, models.PlanForStatus(""))
+}
@@ -0,0 +1,196 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/billing/models"
+	"wish-list/internal/domain/billing/repository"
+)
+
+// Ensure, that BillingRepositoryInterfaceMock does implement repository.BillingRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.BillingRepositoryInterface = &BillingRepositoryInterfaceMock{}
+
+// BillingRepositoryInterfaceMock is a mock implementation of repository.BillingRepositoryInterface.
+//
+//	func TestSomethingThatUsesBillingRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.BillingRepositoryInterface
+//		mockedBillingRepositoryInterface := &BillingRepositoryInterfaceMock{
+//			GetAccountFunc: func(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+//				panic("mock out the GetAccount method")
+//			},
+//			SetCustomerIDFunc: func(ctx context.Context, userID pgtype.UUID, customerID string) error {
+//				panic("mock out the SetCustomerID method")
+//			},
+//			SyncSubscriptionFunc: func(ctx context.Context, state models.SubscriptionState, plan string) (bool, error) {
+//				panic("mock out the SyncSubscription method")
+//			},
+//		}
+//
+//		// use mockedBillingRepositoryInterface in code that requires repository.BillingRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type BillingRepositoryInterfaceMock struct {
+	// GetAccountFunc mocks the GetAccount method.
+	GetAccountFunc func(ctx context.Context, userID pgtype.UUID) (*models.Account, error)
+
+	// SetCustomerIDFunc mocks the SetCustomerID method.
+	SetCustomerIDFunc func(ctx context.Context, userID pgtype.UUID, customerID string) error
+
+	// SyncSubscriptionFunc mocks the SyncSubscription method.
+	SyncSubscriptionFunc func(ctx context.Context, state models.SubscriptionState, plan string) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetAccount holds details about calls to the GetAccount method.
+		GetAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// SetCustomerID holds details about calls to the SetCustomerID method.
+		SetCustomerID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// CustomerID is the customerID argument value.
+			CustomerID string
+		}
+		// SyncSubscription holds details about calls to the SyncSubscription method.
+		SyncSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// State is the state argument value.
+			State models.SubscriptionState
+			// Plan is the plan argument value.
+			Plan string
+		}
+	}
+	lockGetAccount       sync.RWMutex
+	lockSetCustomerID    sync.RWMutex
+	lockSyncSubscription sync.RWMutex
+}
+
+// GetAccount calls GetAccountFunc.
+func (mock *BillingRepositoryInterfaceMock) GetAccount(ctx context.Context, userID pgtype.UUID) (*models.Account, error) {
+	if mock.GetAccountFunc == nil {
+		panic("BillingRepositoryInterfaceMock.GetAccountFunc: method is nil but BillingRepositoryInterface.GetAccount was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetAccount.Lock()
+	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
+	mock.lockGetAccount.Unlock()
+	return mock.GetAccountFunc(ctx, userID)
+}
+
+// GetAccountCalls gets all the calls that were made to GetAccount.
+// Check the length with:
+//
+//	len(mockedBillingRepositoryInterface.GetAccountCalls())
+func (mock *BillingRepositoryInterfaceMock) GetAccountCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetAccount.RLock()
+	calls = mock.calls.GetAccount
+	mock.lockGetAccount.RUnlock()
+	return calls
+}
+
+// SetCustomerID calls SetCustomerIDFunc.
+func (mock *BillingRepositoryInterfaceMock) SetCustomerID(ctx context.Context, userID pgtype.UUID, customerID string) error {
+	if mock.SetCustomerIDFunc == nil {
+		panic("BillingRepositoryInterfaceMock.SetCustomerIDFunc: method is nil but BillingRepositoryInterface.SetCustomerID was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		CustomerID string
+	}{
+		Ctx:        ctx,
+		UserID:     userID,
+		CustomerID: customerID,
+	}
+	mock.lockSetCustomerID.Lock()
+	mock.calls.SetCustomerID = append(mock.calls.SetCustomerID, callInfo)
+	mock.lockSetCustomerID.Unlock()
+	return mock.SetCustomerIDFunc(ctx, userID, customerID)
+}
+
+// SetCustomerIDCalls gets all the calls that were made to SetCustomerID.
+// Check the length with:
+//
+//	len(mockedBillingRepositoryInterface.SetCustomerIDCalls())
+func (mock *BillingRepositoryInterfaceMock) SetCustomerIDCalls() []struct {
+	Ctx        context.Context
+	UserID     pgtype.UUID
+	CustomerID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		CustomerID string
+	}
+	mock.lockSetCustomerID.RLock()
+	calls = mock.calls.SetCustomerID
+	mock.lockSetCustomerID.RUnlock()
+	return calls
+}
+
+// SyncSubscription calls SyncSubscriptionFunc.
+func (mock *BillingRepositoryInterfaceMock) SyncSubscription(ctx context.Context, state models.SubscriptionState, plan string) (bool, error) {
+	if mock.SyncSubscriptionFunc == nil {
+		panic("BillingRepositoryInterfaceMock.SyncSubscriptionFunc: method is nil but BillingRepositoryInterface.SyncSubscription was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		State models.SubscriptionState
+		Plan  string
+	}{
+		Ctx:   ctx,
+		State: state,
+		Plan:  plan,
+	}
+	mock.lockSyncSubscription.Lock()
+	mock.calls.SyncSubscription = append(mock.calls.SyncSubscription, callInfo)
+	mock.lockSyncSubscription.Unlock()
+	return mock.SyncSubscriptionFunc(ctx, state, plan)
+}
+
+// SyncSubscriptionCalls gets all the calls that were made to SyncSubscription.
+// Check the length with:
+//
+//	len(mockedBillingRepositoryInterface.SyncSubscriptionCalls())
+func (mock *BillingRepositoryInterfaceMock) SyncSubscriptionCalls() []struct {
+	Ctx   context.Context
+	State models.SubscriptionState
+	Plan  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		State models.SubscriptionState
+		Plan  string
+	}
+	mock.lockSyncSubscription.RLock()
+	calls = mock.calls.SyncSubscription
+	mock.lockSyncSubscription.RUnlock()
+	return calls
+}
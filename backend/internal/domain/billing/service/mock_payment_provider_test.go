@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"sync"
+	"wish-list/internal/pkg/stripe"
+)
+
+// Ensure, that PaymentProviderInterfaceMock does implement PaymentProviderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ PaymentProviderInterface = &PaymentProviderInterfaceMock{}
+
+// PaymentProviderInterfaceMock is a mock implementation of PaymentProviderInterface.
+//
+//	func TestSomethingThatUsesPaymentProviderInterface(t *testing.T) {
+//
+//		// make and configure a mocked PaymentProviderInterface
+//		mockedPaymentProviderInterface := &PaymentProviderInterfaceMock{
+//			CreateCheckoutSessionFunc: func(ctx context.Context, params stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+//				panic("mock out the CreateCheckoutSession method")
+//			},
+//		}
+//
+//		// use mockedPaymentProviderInterface in code that requires PaymentProviderInterface
+//		// and then make assertions.
+//
+//	}
+type PaymentProviderInterfaceMock struct {
+	// CreateCheckoutSessionFunc mocks the CreateCheckoutSession method.
+	CreateCheckoutSessionFunc func(ctx context.Context, params stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateCheckoutSession holds details about calls to the CreateCheckoutSession method.
+		CreateCheckoutSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params stripe.CheckoutSessionParams
+		}
+	}
+	lockCreateCheckoutSession sync.RWMutex
+}
+
+// CreateCheckoutSession calls CreateCheckoutSessionFunc.
+func (mock *PaymentProviderInterfaceMock) CreateCheckoutSession(ctx context.Context, params stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	if mock.CreateCheckoutSessionFunc == nil {
+		panic("PaymentProviderInterfaceMock.CreateCheckoutSessionFunc: method is nil but PaymentProviderInterface.CreateCheckoutSession was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params stripe.CheckoutSessionParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockCreateCheckoutSession.Lock()
+	mock.calls.CreateCheckoutSession = append(mock.calls.CreateCheckoutSession, callInfo)
+	mock.lockCreateCheckoutSession.Unlock()
+	return mock.CreateCheckoutSessionFunc(ctx, params)
+}
+
+// CreateCheckoutSessionCalls gets all the calls that were made to CreateCheckoutSession.
+// Check the length with:
+//
+//	len(mockedPaymentProviderInterface.CreateCheckoutSessionCalls())
+func (mock *PaymentProviderInterfaceMock) CreateCheckoutSessionCalls() []struct {
+	Ctx    context.Context
+	Params stripe.CheckoutSessionParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params stripe.CheckoutSessionParams
+	}
+	mock.lockCreateCheckoutSession.RLock()
+	calls = mock.calls.CreateCheckoutSession
+	mock.lockCreateCheckoutSession.RUnlock()
+	return calls
+}
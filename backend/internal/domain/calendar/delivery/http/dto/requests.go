@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"wish-list/internal/domain/calendar/service"
+)
+
+// UpdateSettingsRequest replaces the calendar feed settings
+type UpdateSettingsRequest struct {
+	ReminderDays *int `json:"reminder_days" validate:"omitempty,gte=0,lte=30" example:"1"` // Days before each occasion to remind; null disables reminders
+}
+
+func (r *UpdateSettingsRequest) ToServiceInput() service.UpdateSettingsInput {
+	return service.UpdateSettingsInput{
+		ReminderDays: r.ReminderDays,
+	}
+}
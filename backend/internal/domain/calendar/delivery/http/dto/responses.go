@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"wish-list/internal/domain/calendar/service"
+)
+
+// SettingsResponse holds the calendar feed settings
+type SettingsResponse struct {
+	ReminderDays *int `json:"reminder_days"` // null when reminders are off
+}
+
+func FromSettingsOutput(o *service.SettingsOutput) SettingsResponse {
+	return SettingsResponse{
+		ReminderDays: o.ReminderDays,
+	}
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/calendar/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapCalendarServiceError converts calendar service errors to AppErrors
+func mapCalendarServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidReminderDays):
+		return apperrors.BadRequest("Reminder must be between 0 and 30 days before the occasion")
+	case errors.Is(err, service.ErrUserNotFound):
+		return apperrors.NotFound("User not found")
+	default:
+		return apperrors.Internal("Calendar request failed").Wrap(err)
+	}
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/calendar/delivery/http/dto"
+	"wish-list/internal/domain/calendar/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/ical"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for the calendar feed
+type Handler struct {
+	service service.CalendarServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.CalendarServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// GetFeed godoc
+//
+//	@Summary		Calendar feed of occasions
+//	@Description	iCalendar feed of the user's wishlist occasion dates and the public occasions of users they follow. Subscribe to it from Google or Apple Calendar with a personal API token that has the calendar:read scope, passed as the token query parameter. Events carry a reminder per the user's calendar settings.
+//	@Tags			Calendar
+//	@Produce		text/calendar
+//	@Param			token	query		string				false	"Personal API token with the calendar:read scope"
+//	@Success		200		{string}	string				"iCalendar feed"
+//	@Failure		401		{object}	map[string]string	"Invalid, expired or revoked API token"
+//	@Failure		403		{object}	map[string]string	"API token lacks the calendar:read scope"
+//	@Failure		404		{object}	map[string]string	"User not found"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		APITokenAuth
+//	@Router			/protected/calendar.ics [get]
+func (h *Handler) GetFeed(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	feed, err := h.service.Feed(c.Request().Context(), userID)
+	if err != nil {
+		return mapCalendarServiceError(err)
+	}
+
+	// Calendar apps poll the feed; let them reuse it briefly
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=900")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `inline; filename="occasions.ics"`)
+	return c.Blob(nethttp.StatusOK, ical.ContentType, feed)
+}
+
+// GetSettings godoc
+//
+//	@Summary		Get calendar settings
+//	@Description	How many days before each occasion the calendar feed reminds the user
+//	@Tags			Calendar
+//	@Produce		json
+//	@Success		200	{object}	dto.SettingsResponse	"Calendar settings"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		404	{object}	map[string]string		"User not found"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/calendar/settings [get]
+func (h *Handler) GetSettings(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	settings, err := h.service.GetSettings(c.Request().Context(), userID)
+	if err != nil {
+		return mapCalendarServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSettingsOutput(settings))
+}
+
+// UpdateSettings godoc
+//
+//	@Summary		Update calendar settings
+//	@Description	Sets how many days (0-30) before each occasion the calendar feed reminds the user; null turns reminders off. Calendar apps pick up the change on their next refresh.
+//	@Tags			Calendar
+//	@Accept			json
+//	@Produce		json
+//	@Param			settings	body		dto.UpdateSettingsRequest	true	"Calendar settings"
+//	@Success		200			{object}	dto.SettingsResponse		"Updated settings"
+//	@Failure		400			{object}	map[string]string			"Invalid request body"
+//	@Failure		401			{object}	map[string]string			"Unauthorized"
+//	@Failure		404			{object}	map[string]string			"User not found"
+//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/calendar/settings [put]
+func (h *Handler) UpdateSettings(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateSettingsRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	settings, err := h.service.UpdateSettings(c.Request().Context(), userID, req.ToServiceInput())
+	if err != nil {
+		return mapCalendarServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSettingsOutput(settings))
+}
@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers calendar routes. feedTokenMiddleware authenticates the
+// feed with a personal API token, since calendar apps cannot log in.
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware, feedTokenMiddleware echo.MiddlewareFunc) {
+	e.GET("/api/protected/calendar.ics", h.GetFeed, feedTokenMiddleware)
+
+	protected := e.Group("/api/protected/calendar", authMiddleware)
+	protected.GET("/settings", h.GetSettings)
+	protected.PUT("/settings", h.UpdateSettings)
+}
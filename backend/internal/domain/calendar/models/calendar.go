@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Occasion is a dated wishlist shown in the calendar feed
+type Occasion struct {
+	WishlistID   pgtype.UUID `db:"id"`
+	OwnerID      pgtype.UUID `db:"owner_id"`
+	Title        string      `db:"title"`
+	Occasion     pgtype.Text `db:"occasion"`
+	OccasionDate pgtype.Date `db:"occasion_date"`
+	Recurrence   pgtype.Text `db:"recurrence"`
+	IsPublic     pgtype.Bool `db:"is_public"`
+	PublicSlug   pgtype.Text `db:"public_slug"`
+}
+
+// MaxReminderDays bounds how far ahead of an occasion a reminder can fire
+const MaxReminderDays = 30
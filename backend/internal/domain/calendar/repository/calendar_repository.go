@@ -0,0 +1,116 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_calendar_repository_test.go -pkg service . CalendarRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/calendar/models"
+)
+
+// Sentinel errors for calendar repository
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// CalendarRepositoryInterface defines the interface for calendar database operations
+type CalendarRepositoryInterface interface {
+	ListOwnOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error)
+	ListFollowedOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error)
+	GetReminderDays(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error)
+	SetReminderDays(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error
+}
+
+type CalendarRepository struct {
+	db *database.DB
+}
+
+func NewCalendarRepository(db *database.DB) CalendarRepositoryInterface {
+	return &CalendarRepository{
+		db: db,
+	}
+}
+
+const occasionColumns = `w.id, w.owner_id, w.title, w.occasion, w.occasion_date, w.recurrence, w.is_public, w.public_slug`
+
+// ListOwnOccasions returns the user's wishlists that have an occasion date. Lists that
+// were rolled over are left out; the list for the next occurrence carries the date.
+// Lists archived by a merge are left out too.
+func (r *CalendarRepository) ListOwnOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+	query := `
+		SELECT ` + occasionColumns + `
+		FROM wishlists w
+		WHERE w.owner_id = $1 AND w.occasion_date IS NOT NULL AND w.rolled_over_to_id IS NULL AND w.merged_into_id IS NULL
+		ORDER BY w.occasion_date
+	`
+
+	var occasions []*models.Occasion
+	if err := r.db.SelectContext(ctx, &occasions, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list own occasions: %w", err)
+	}
+
+	return occasions, nil
+}
+
+// ListFollowedOccasions returns the dated public wishlists of active users the user follows
+func (r *CalendarRepository) ListFollowedOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+	query := `
+		SELECT ` + occasionColumns + `
+		FROM user_follows f
+		JOIN users u ON u.id = f.followee_id AND u.deactivated_at IS NULL
+		JOIN wishlists w ON w.owner_id = f.followee_id
+		WHERE f.follower_id = $1
+			AND w.is_public = true AND w.public_slug IS NOT NULL
+			AND w.occasion_date IS NOT NULL AND w.rolled_over_to_id IS NULL
+		ORDER BY w.occasion_date
+	`
+
+	var occasions []*models.Occasion
+	if err := r.db.SelectContext(ctx, &occasions, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list followed occasions: %w", err)
+	}
+
+	return occasions, nil
+}
+
+// GetReminderDays returns how many days before an occasion the user is reminded; NULL = never
+func (r *CalendarRepository) GetReminderDays(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error) {
+	query := `SELECT calendar_reminder_days FROM users WHERE id = $1`
+
+	var days pgtype.Int2
+	if err := r.db.GetContext(ctx, &days, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pgtype.Int2{}, ErrUserNotFound
+		}
+		return pgtype.Int2{}, fmt.Errorf("failed to get calendar reminder: %w", err)
+	}
+
+	return days, nil
+}
+
+// SetReminderDays stores the user's reminder setting
+func (r *CalendarRepository) SetReminderDays(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error {
+	query := `UPDATE users SET calendar_reminder_days = $2, updated_at = NOW() WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, userID, days)
+	if err != nil {
+		return fmt.Errorf("failed to set calendar reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/calendar/models"
+	"wish-list/internal/domain/calendar/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/ical"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	feedProdID = "-//Wish List//Occasions//EN"
+	feedName   = "Wishlist occasions"
+
+	// Reminders fire at this time of day, counted from the start of the occasion's day
+	reminderTimeOfDay = 9 * time.Hour
+)
+
+var (
+	ErrUserNotFound        = errors.New("user not found")
+	ErrInvalidReminderDays = errors.New("reminder must be between 0 and 30 days before the occasion")
+)
+
+// CalendarServiceInterface defines the interface for calendar operations
+type CalendarServiceInterface interface {
+	Feed(ctx context.Context, userID pgtype.UUID) ([]byte, error)
+	GetSettings(ctx context.Context, userID pgtype.UUID) (*SettingsOutput, error)
+	UpdateSettings(ctx context.Context, userID pgtype.UUID, input UpdateSettingsInput) (*SettingsOutput, error)
+}
+
+type CalendarService struct {
+	repo        repository.CalendarRepositoryInterface
+	frontendURL string
+}
+
+// NewCalendarService creates a calendar service. frontendURL is the web app's base URL,
+// used to link events to public wishlists.
+func NewCalendarService(repo repository.CalendarRepositoryInterface, frontendURL string) *CalendarService {
+	return &CalendarService{
+		repo:        repo,
+		frontendURL: strings.TrimSuffix(frontendURL, "/"),
+	}
+}
+
+// SettingsOutput holds the user's calendar feed settings
+type SettingsOutput struct {
+	ReminderDays *int // nil = no reminders
+}
+
+type UpdateSettingsInput struct {
+	ReminderDays *int // nil disables reminders
+}
+
+// Feed renders the user's occasions and the public occasions of users they follow as
+// an iCalendar feed, with a reminder on each event when the user has one configured
+func (s *CalendarService) Feed(ctx context.Context, userID pgtype.UUID) ([]byte, error) {
+	reminderDays, err := s.repo.GetReminderDays(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get calendar settings: %w", err)
+	}
+
+	own, err := s.repo.ListOwnOccasions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list own occasions: %w", err)
+	}
+
+	followed, err := s.repo.ListFollowedOccasions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followed occasions: %w", err)
+	}
+
+	cal := ical.Calendar{
+		ProdID: feedProdID,
+		Name:   feedName,
+		Events: make([]ical.Event, 0, len(own)+len(followed)),
+	}
+	for _, occasion := range own {
+		cal.Events = append(cal.Events, s.toEvent(occasion, reminderDays))
+	}
+	for _, occasion := range followed {
+		cal.Events = append(cal.Events, s.toEvent(occasion, reminderDays))
+	}
+
+	return cal.Bytes(time.Now()), nil
+}
+
+// GetSettings returns the user's calendar feed settings
+func (s *CalendarService) GetSettings(ctx context.Context, userID pgtype.UUID) (*SettingsOutput, error) {
+	days, err := s.repo.GetReminderDays(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get calendar settings: %w", err)
+	}
+
+	return toSettingsOutput(days), nil
+}
+
+// UpdateSettings replaces the user's calendar feed settings
+func (s *CalendarService) UpdateSettings(ctx context.Context, userID pgtype.UUID, input UpdateSettingsInput) (*SettingsOutput, error) {
+	var days pgtype.Int2
+	if input.ReminderDays != nil {
+		if *input.ReminderDays < 0 || *input.ReminderDays > models.MaxReminderDays {
+			return nil, ErrInvalidReminderDays
+		}
+		days = pgtype.Int2{Int16: int16(*input.ReminderDays), Valid: true} //nolint:gosec // Bounded above
+	}
+
+	if err := s.repo.SetReminderDays(ctx, userID, days); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update calendar settings: %w", err)
+	}
+
+	return toSettingsOutput(days), nil
+}
+
+func (s *CalendarService) toEvent(occasion *models.Occasion, reminderDays pgtype.Int2) ical.Event {
+	event := ical.Event{
+		UID:     occasion.WishlistID.String() + "@wish-list",
+		Summary: occasion.Title,
+		Date:    occasion.OccasionDate.Time,
+		Yearly:  occasion.Recurrence.String == wishlistmodels.RecurrenceYearly,
+	}
+	if occasion.Occasion.Valid && !strings.EqualFold(occasion.Occasion.String, occasion.Title) {
+		event.Description = occasion.Occasion.String
+	}
+	if occasion.IsPublic.Bool && occasion.PublicSlug.Valid {
+		event.URL = s.frontendURL + "/public/" + url.PathEscape(occasion.PublicSlug.String)
+	}
+	if reminderDays.Valid {
+		event.Alarms = []ical.Alarm{{
+			Before:      time.Duration(reminderDays.Int16)*24*time.Hour - reminderTimeOfDay,
+			Description: reminderText(occasion.Title, int(reminderDays.Int16)),
+		}}
+	}
+	return event
+}
+
+func reminderText(title string, days int) string {
+	switch days {
+	case 0:
+		return title + " is today"
+	case 1:
+		return title + " is tomorrow"
+	default:
+		return fmt.Sprintf("%s is in %d days", title, days)
+	}
+}
+
+func toSettingsOutput(days pgtype.Int2) *SettingsOutput {
+	output := &SettingsOutput{}
+	if days.Valid {
+		d := int(days.Int16)
+		output.ReminderDays = &d
+	}
+	return output
+}
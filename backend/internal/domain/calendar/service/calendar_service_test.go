@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/calendar/models"
+	"wish-list/internal/domain/calendar/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testUserID     = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	ownWishlistID  = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+	friendListID   = pgtype.UUID{Bytes: [16]byte{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, Valid: true}
+	testReminderOn = pgtype.Int2{Int16: 3, Valid: true}
+)
+
+func calendarRepo(reminder pgtype.Int2) *CalendarRepositoryInterfaceMock {
+	return &CalendarRepositoryInterfaceMock{
+		GetReminderDaysFunc: func(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error) {
+			return reminder, nil
+		},
+		ListOwnOccasionsFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+			return []*models.Occasion{{
+				WishlistID:   ownWishlistID,
+				Title:        "My birthday",
+				Occasion:     pgtype.Text{String: "Birthday", Valid: true},
+				OccasionDate: pgtype.Date{Time: time.Date(2027, time.March, 8, 0, 0, 0, 0, time.UTC), Valid: true},
+				Recurrence:   pgtype.Text{String: "yearly", Valid: true},
+			}}, nil
+		},
+		ListFollowedOccasionsFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+			return []*models.Occasion{{
+				WishlistID:   friendListID,
+				Title:        "Anna's wedding",
+				OccasionDate: pgtype.Date{Time: time.Date(2026, time.November, 20, 0, 0, 0, 0, time.UTC), Valid: true},
+				IsPublic:     pgtype.Bool{Bool: true, Valid: true},
+				PublicSlug:   pgtype.Text{String: "anna-wedding", Valid: true},
+			}}, nil
+		},
+	}
+}
+
+func TestCalendarService_Feed(t *testing.T) {
+	t.Run("own and followed occasions with reminders", func(t *testing.T) {
+		svc := NewCalendarService(calendarRepo(testReminderOn), "https://app.example.com/")
+
+		data, err := svc.Feed(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		feed := string(data)
+		assert.Equal(t, 2, strings.Count(feed, "BEGIN:VEVENT"))
+		assert.Contains(t, feed, "UID:"+ownWishlistID.String()+"@wish-list\r\n")
+		assert.Contains(t, feed, "DTSTART;VALUE=DATE:20270308\r\nDTEND;VALUE=DATE:20270309\r\nRRULE:FREQ=YEARLY\r\nSUMMARY:My birthday\r\nDESCRIPTION:Birthday\r\n")
+		assert.Contains(t, feed, "SUMMARY:Anna's wedding\r\nURL:https://app.example.com/public/anna-wedding\r\n")
+		assert.Equal(t, 2, strings.Count(feed, "TRIGGER:-P2DT15H\r\n"), "three days ahead at 09:00")
+		assert.Contains(t, feed, "DESCRIPTION:Anna's wedding is in 3 days\r\n")
+	})
+
+	t.Run("reminders off", func(t *testing.T) {
+		data, err := NewCalendarService(calendarRepo(pgtype.Int2{}), "https://app.example.com").Feed(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "VALARM")
+	})
+
+	t.Run("reminder on the day", func(t *testing.T) {
+		data, err := NewCalendarService(calendarRepo(pgtype.Int2{Int16: 0, Valid: true}), "https://app.example.com").Feed(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "TRIGGER:PT9H\r\n")
+		assert.Contains(t, string(data), "DESCRIPTION:My birthday is today\r\n")
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		repo := calendarRepo(pgtype.Int2{})
+		repo.GetReminderDaysFunc = func(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error) {
+			return pgtype.Int2{}, repository.ErrUserNotFound
+		}
+
+		_, err := NewCalendarService(repo, "https://app.example.com").Feed(context.Background(), testUserID)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestCalendarService_UpdateSettings(t *testing.T) {
+	newRepo := func() *CalendarRepositoryInterfaceMock {
+		return &CalendarRepositoryInterfaceMock{
+			SetReminderDaysFunc: func(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error {
+				return nil
+			},
+		}
+	}
+
+	t.Run("sets reminder days", func(t *testing.T) {
+		repo := newRepo()
+		days := 7
+
+		output, err := NewCalendarService(repo, "").UpdateSettings(context.Background(), testUserID, UpdateSettingsInput{ReminderDays: &days})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.ReminderDays)
+		assert.Equal(t, 7, *output.ReminderDays)
+		assert.Equal(t, pgtype.Int2{Int16: 7, Valid: true}, repo.SetReminderDaysCalls()[0].Days)
+	})
+
+	t.Run("nil disables reminders", func(t *testing.T) {
+		repo := newRepo()
+
+		output, err := NewCalendarService(repo, "").UpdateSettings(context.Background(), testUserID, UpdateSettingsInput{})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.ReminderDays)
+		assert.False(t, repo.SetReminderDaysCalls()[0].Days.Valid)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		repo := newRepo()
+		days := models.MaxReminderDays + 1
+
+		_, err := NewCalendarService(repo, "").UpdateSettings(context.Background(), testUserID, UpdateSettingsInput{ReminderDays: &days})
+
+		assert.ErrorIs(t, err, ErrInvalidReminderDays)
+		assert.Empty(t, repo.SetReminderDaysCalls())
+	})
+}
@@ -0,0 +1,240 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/calendar/models"
+	"wish-list/internal/domain/calendar/repository"
+)
+
+// Ensure, that CalendarRepositoryInterfaceMock does implement repository.CalendarRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.CalendarRepositoryInterface = &CalendarRepositoryInterfaceMock{}
+
+// CalendarRepositoryInterfaceMock is a mock implementation of repository.CalendarRepositoryInterface.
+//
+//	func TestSomethingThatUsesCalendarRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.CalendarRepositoryInterface
+//		mockedCalendarRepositoryInterface := &CalendarRepositoryInterfaceMock{
+//			GetReminderDaysFunc: func(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error) {
+//				panic("mock out the GetReminderDays method")
+//			},
+//			ListFollowedOccasionsFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+//				panic("mock out the ListFollowedOccasions method")
+//			},
+//			ListOwnOccasionsFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+//				panic("mock out the ListOwnOccasions method")
+//			},
+//			SetReminderDaysFunc: func(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error {
+//				panic("mock out the SetReminderDays method")
+//			},
+//		}
+//
+//		// use mockedCalendarRepositoryInterface in code that requires repository.CalendarRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type CalendarRepositoryInterfaceMock struct {
+	// GetReminderDaysFunc mocks the GetReminderDays method.
+	GetReminderDaysFunc func(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error)
+
+	// ListFollowedOccasionsFunc mocks the ListFollowedOccasions method.
+	ListFollowedOccasionsFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error)
+
+	// ListOwnOccasionsFunc mocks the ListOwnOccasions method.
+	ListOwnOccasionsFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error)
+
+	// SetReminderDaysFunc mocks the SetReminderDays method.
+	SetReminderDaysFunc func(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetReminderDays holds details about calls to the GetReminderDays method.
+		GetReminderDays []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListFollowedOccasions holds details about calls to the ListFollowedOccasions method.
+		ListFollowedOccasions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListOwnOccasions holds details about calls to the ListOwnOccasions method.
+		ListOwnOccasions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// SetReminderDays holds details about calls to the SetReminderDays method.
+		SetReminderDays []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// Days is the days argument value.
+			Days pgtype.Int2
+		}
+	}
+	lockGetReminderDays       sync.RWMutex
+	lockListFollowedOccasions sync.RWMutex
+	lockListOwnOccasions      sync.RWMutex
+	lockSetReminderDays       sync.RWMutex
+}
+
+// GetReminderDays calls GetReminderDaysFunc.
+func (mock *CalendarRepositoryInterfaceMock) GetReminderDays(ctx context.Context, userID pgtype.UUID) (pgtype.Int2, error) {
+	if mock.GetReminderDaysFunc == nil {
+		panic("CalendarRepositoryInterfaceMock.GetReminderDaysFunc: method is nil but CalendarRepositoryInterface.GetReminderDays was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetReminderDays.Lock()
+	mock.calls.GetReminderDays = append(mock.calls.GetReminderDays, callInfo)
+	mock.lockGetReminderDays.Unlock()
+	return mock.GetReminderDaysFunc(ctx, userID)
+}
+
+// GetReminderDaysCalls gets all the calls that were made to GetReminderDays.
+// Check the length with:
+//
+//	len(mockedCalendarRepositoryInterface.GetReminderDaysCalls())
+func (mock *CalendarRepositoryInterfaceMock) GetReminderDaysCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetReminderDays.RLock()
+	calls = mock.calls.GetReminderDays
+	mock.lockGetReminderDays.RUnlock()
+	return calls
+}
+
+// ListFollowedOccasions calls ListFollowedOccasionsFunc.
+func (mock *CalendarRepositoryInterfaceMock) ListFollowedOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+	if mock.ListFollowedOccasionsFunc == nil {
+		panic("CalendarRepositoryInterfaceMock.ListFollowedOccasionsFunc: method is nil but CalendarRepositoryInterface.ListFollowedOccasions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListFollowedOccasions.Lock()
+	mock.calls.ListFollowedOccasions = append(mock.calls.ListFollowedOccasions, callInfo)
+	mock.lockListFollowedOccasions.Unlock()
+	return mock.ListFollowedOccasionsFunc(ctx, userID)
+}
+
+// ListFollowedOccasionsCalls gets all the calls that were made to ListFollowedOccasions.
+// Check the length with:
+//
+//	len(mockedCalendarRepositoryInterface.ListFollowedOccasionsCalls())
+func (mock *CalendarRepositoryInterfaceMock) ListFollowedOccasionsCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListFollowedOccasions.RLock()
+	calls = mock.calls.ListFollowedOccasions
+	mock.lockListFollowedOccasions.RUnlock()
+	return calls
+}
+
+// ListOwnOccasions calls ListOwnOccasionsFunc.
+func (mock *CalendarRepositoryInterfaceMock) ListOwnOccasions(ctx context.Context, userID pgtype.UUID) ([]*models.Occasion, error) {
+	if mock.ListOwnOccasionsFunc == nil {
+		panic("CalendarRepositoryInterfaceMock.ListOwnOccasionsFunc: method is nil but CalendarRepositoryInterface.ListOwnOccasions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListOwnOccasions.Lock()
+	mock.calls.ListOwnOccasions = append(mock.calls.ListOwnOccasions, callInfo)
+	mock.lockListOwnOccasions.Unlock()
+	return mock.ListOwnOccasionsFunc(ctx, userID)
+}
+
+// ListOwnOccasionsCalls gets all the calls that were made to ListOwnOccasions.
+// Check the length with:
+//
+//	len(mockedCalendarRepositoryInterface.ListOwnOccasionsCalls())
+func (mock *CalendarRepositoryInterfaceMock) ListOwnOccasionsCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListOwnOccasions.RLock()
+	calls = mock.calls.ListOwnOccasions
+	mock.lockListOwnOccasions.RUnlock()
+	return calls
+}
+
+// SetReminderDays calls SetReminderDaysFunc.
+func (mock *CalendarRepositoryInterfaceMock) SetReminderDays(ctx context.Context, userID pgtype.UUID, days pgtype.Int2) error {
+	if mock.SetReminderDaysFunc == nil {
+		panic("CalendarRepositoryInterfaceMock.SetReminderDaysFunc: method is nil but CalendarRepositoryInterface.SetReminderDays was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Days   pgtype.Int2
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Days:   days,
+	}
+	mock.lockSetReminderDays.Lock()
+	mock.calls.SetReminderDays = append(mock.calls.SetReminderDays, callInfo)
+	mock.lockSetReminderDays.Unlock()
+	return mock.SetReminderDaysFunc(ctx, userID, days)
+}
+
+// SetReminderDaysCalls gets all the calls that were made to SetReminderDays.
+// Check the length with:
+//
+//	len(mockedCalendarRepositoryInterface.SetReminderDaysCalls())
+func (mock *CalendarRepositoryInterfaceMock) SetReminderDaysCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	Days   pgtype.Int2
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Days   pgtype.Int2
+	}
+	mock.lockSetReminderDays.RLock()
+	calls = mock.calls.SetReminderDays
+	mock.lockSetReminderDays.RUnlock()
+	return calls
+}
@@ -0,0 +1,14 @@
+package dto
+
+type CreateCommentRequest struct {
+	AuthorName string `json:"author_name" validate:"required,max=100"`
+	Body       string `json:"body" validate:"required,max=2000"`
+}
+
+type ReplyCommentRequest struct {
+	Reply *string `json:"reply" validate:"omitempty,max=2000"`
+}
+
+type CommentVisibilityRequest struct {
+	Hidden bool `json:"hidden"`
+}
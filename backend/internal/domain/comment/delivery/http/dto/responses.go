@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"wish-list/internal/domain/comment/service"
+)
+
+type CommentResponse struct {
+	ID         string  `json:"id" validate:"required"`
+	WishlistID string  `json:"wishlist_id" validate:"required"`
+	GiftItemID string  `json:"gift_item_id" validate:"required"`
+	AuthorName string  `json:"author_name" validate:"required"`
+	Body       string  `json:"body" validate:"required"`
+	OwnerReply *string `json:"owner_reply"`
+	RepliedAt  *string `json:"replied_at"`
+	IsHidden   bool    `json:"is_hidden"`
+	CreatedAt  string  `json:"created_at" validate:"required"`
+}
+
+type CommentsListResponse struct {
+	Data []CommentResponse `json:"data" validate:"required"`
+}
+
+func FromCommentOutput(c *service.CommentOutput) CommentResponse {
+	resp := CommentResponse{
+		ID:         c.ID.String(),
+		WishlistID: c.WishlistID.String(),
+		GiftItemID: c.GiftItemID.String(),
+		AuthorName: c.AuthorName,
+		Body:       c.Body,
+		IsHidden:   c.IsHidden,
+		CreatedAt:  c.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if c.OwnerReply.Valid {
+		reply := c.OwnerReply.String
+		resp.OwnerReply = &reply
+	}
+
+	if c.RepliedAt.Valid {
+		repliedAt := c.RepliedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.RepliedAt = &repliedAt
+	}
+
+	return resp
+}
+
+func FromCommentOutputs(outputs []*service.CommentOutput) []CommentResponse {
+	responses := make([]CommentResponse, len(outputs))
+	for i, output := range outputs {
+		responses[i] = FromCommentOutput(output)
+	}
+	return responses
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/comment/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapCommentServiceError converts comment service errors to AppErrors
+func mapCommentServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCommentID):
+		return apperrors.BadRequest("Invalid comment ID")
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrInvalidGiftItemID):
+		return apperrors.BadRequest("Invalid gift item ID")
+	case errors.Is(err, service.ErrEmptyComment):
+		return apperrors.BadRequest("Comment body and author name are required")
+	case errors.Is(err, service.ErrCommentNotFound):
+		return apperrors.NotFound("Comment not found")
+	case errors.Is(err, service.ErrPublicItemNotFound):
+		return apperrors.NotFound("Gift item not found in this wishlist")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Wishlist not found")
+	case errors.Is(err, service.ErrCommentAccessDenied):
+		return apperrors.Forbidden("Only the wishlist owner can view its comments")
+	default:
+		return apperrors.Internal("Failed to process comment").Wrap(err)
+	}
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/comment/delivery/http/dto"
+	"wish-list/internal/domain/comment/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for item comments
+type Handler struct {
+	service service.CommentServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.CommentServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// ListPublicComments godoc
+//
+//	@Summary		List comments on a public wishlist item
+//	@Description	Get the visible comments and owner replies on an item of a public wishlist, oldest first.
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			slug	path		string						true	"Public slug of the wishlist"
+//	@Param			itemId	path		string						true	"Gift item ID"
+//	@Success		200		{object}	dto.CommentsListResponse	"List of comments"
+//	@Failure		400		{object}	map[string]string			"Invalid gift item ID"
+//	@Failure		404		{object}	map[string]string			"Gift item not found in this wishlist"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Router			/public/wishlists/{slug}/items/{itemId}/comments [get]
+func (h *Handler) ListPublicComments(c echo.Context) error {
+	comments, err := h.service.ListPublicComments(c.Request().Context(), c.Param("slug"), c.Param("itemId"))
+	if err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.CommentsListResponse{
+		Data: dto.FromCommentOutputs(comments),
+	})
+}
+
+// CreateComment godoc
+//
+//	@Summary		Comment on a public wishlist item
+//	@Description	Leave a comment or question on an item of a public wishlist. Guests may comment without an account; the wishlist owner is notified by email.
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			slug			path		string						true	"Public slug of the wishlist"
+//	@Param			itemId			path		string						true	"Gift item ID"
+//	@Param			request			body		dto.CreateCommentRequest	true	"Comment"
+//	@Param			X-Captcha-Token	header		string						false	"CAPTCHA response token (required for guests when CAPTCHA is enabled)"
+//	@Success		201				{object}	dto.CommentResponse			"Comment created"
+//	@Failure		400				{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string			"CAPTCHA verification failed"
+//	@Failure		404				{object}	map[string]string			"Gift item not found in this wishlist"
+//	@Failure		429				{object}	map[string]string			"Too many requests"
+//	@Failure		500				{object}	map[string]string			"Internal server error"
+//	@Router			/public/wishlists/{slug}/items/{itemId}/comments [post]
+func (h *Handler) CreateComment(c echo.Context) error {
+	var req dto.CreateCommentRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	// Authenticated users are recorded as the author; guests are anonymous
+	authorUserID := pgtype.UUID{}
+	if userIDStr, _, _, authErr := auth.GetUserFromContext(c); authErr == nil {
+		userID, err := helpers.ParseUUID(c, userIDStr)
+		if err != nil {
+			return err
+		}
+		authorUserID = userID
+	}
+
+	comment, err := h.service.PostComment(c.Request().Context(), service.PostCommentInput{
+		PublicSlug:   c.Param("slug"),
+		GiftItemID:   c.Param("itemId"),
+		AuthorUserID: authorUserID,
+		AuthorName:   req.AuthorName,
+		Body:         req.Body,
+	})
+	if err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromCommentOutput(comment))
+}
+
+// ListWishlistComments godoc
+//
+//	@Summary		List all comments on a wishlist
+//	@Description	Owner view of every comment on the wishlist's items, including hidden ones, newest first.
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			id	path		string						true	"Wishlist ID"
+//	@Success		200	{object}	dto.CommentsListResponse	"List of comments"
+//	@Failure		400	{object}	map[string]string			"Invalid wishlist ID"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		403	{object}	map[string]string			"Not the wishlist owner"
+//	@Failure		404	{object}	map[string]string			"Wishlist not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/comments [get]
+func (h *Handler) ListWishlistComments(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	comments, err := h.service.ListWishlistComments(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.CommentsListResponse{
+		Data: dto.FromCommentOutputs(comments),
+	})
+}
+
+// ReplyToComment godoc
+//
+//	@Summary		Reply to a comment
+//	@Description	Set the owner's public reply on a comment. An empty or missing reply removes the existing one.
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Comment ID"
+//	@Param			request	body		dto.ReplyCommentRequest	true	"Reply"
+//	@Success		200		{object}	dto.CommentResponse		"Updated comment"
+//	@Failure		400		{object}	map[string]string		"Invalid request"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		404		{object}	map[string]string		"Comment not found"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/comments/{id}/reply [put]
+func (h *Handler) ReplyToComment(c echo.Context) error {
+	var req dto.ReplyCommentRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	comment, err := h.service.ReplyToComment(c.Request().Context(), c.Param("id"), ownerID, req.Reply)
+	if err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromCommentOutput(comment))
+}
+
+// SetCommentVisibility godoc
+//
+//	@Summary		Hide or show a comment
+//	@Description	Hide a comment from the public item page, or make a hidden comment visible again.
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Comment ID"
+//	@Param			request	body		dto.CommentVisibilityRequest	true	"Visibility"
+//	@Success		200		{object}	dto.CommentResponse				"Updated comment"
+//	@Failure		400		{object}	map[string]string				"Invalid request"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		404		{object}	map[string]string				"Comment not found"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/comments/{id}/visibility [put]
+func (h *Handler) SetCommentVisibility(c echo.Context) error {
+	var req dto.CommentVisibilityRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	comment, err := h.service.SetCommentHidden(c.Request().Context(), c.Param("id"), ownerID, req.Hidden)
+	if err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromCommentOutput(comment))
+}
+
+// DeleteComment godoc
+//
+//	@Summary		Delete a comment
+//	@Description	Permanently delete a comment from one of the owner's wishlists.
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			id	path		string				true	"Comment ID"
+//	@Success		204	{object}	nil					"Comment deleted"
+//	@Failure		400	{object}	map[string]string	"Invalid comment ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Comment not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/comments/{id} [delete]
+func (h *Handler) DeleteComment(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteComment(c.Request().Context(), c.Param("id"), ownerID); err != nil {
+		return mapCommentServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/app/middleware"
+)
+
+// RegisterRoutes registers all item comment HTTP routes
+func RegisterRoutes(
+	e *echo.Echo,
+	h *Handler,
+	optionalAuthMiddleware echo.MiddlewareFunc,
+	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
+) {
+	// Public comment threads — guests and authenticated users.
+	// Posting is rate limited per IP (5 req/min); captchaMiddleware runs after
+	// optionalAuthMiddleware so that only guest comments are challenged.
+	commentLimiter := middleware.NewCommentRateLimiter()
+	public := e.Group("/api/public/wishlists/:slug/items/:itemId/comments")
+	public.GET("", h.ListPublicComments)
+	public.POST("", h.CreateComment,
+		middleware.AuthRateLimitMiddleware(commentLimiter, middleware.IPIdentifier),
+		optionalAuthMiddleware,
+		captchaMiddleware)
+
+	// Owner moderation
+	e.GET("/api/wishlists/:id/comments", h.ListWishlistComments, authMiddleware)
+	comments := e.Group("/api/comments", authMiddleware)
+	comments.PUT("/:id/reply", h.ReplyToComment)
+	comments.PUT("/:id/visibility", h.SetCommentVisibility)
+	comments.DELETE("/:id", h.DeleteComment)
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Comment struct {
+	ID           pgtype.UUID        `db:"id"`
+	WishlistID   pgtype.UUID        `db:"wishlist_id"`
+	GiftItemID   pgtype.UUID        `db:"gift_item_id"`
+	AuthorUserID pgtype.UUID        `db:"author_user_id"` // NULL for guest comments
+	AuthorName   string             `db:"author_name"`
+	Body         string             `db:"body"`
+	OwnerReply   pgtype.Text        `db:"owner_reply"`
+	RepliedAt    pgtype.Timestamptz `db:"replied_at"`
+	IsHidden     bool               `db:"is_hidden"` // Hidden comments are only visible to the wishlist owner
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
+}
@@ -0,0 +1,178 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_comment_repository_test.go -pkg service . CommentRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/comment/models"
+)
+
+// Sentinel errors for comment repository
+var (
+	ErrCommentNotFound = errors.New("comment not found")
+)
+
+const commentColumns = `
+	id, wishlist_id, gift_item_id, author_user_id, author_name, body,
+	owner_reply, replied_at, is_hidden, created_at, updated_at
+`
+
+// CommentRepositoryInterface defines the interface for item comment database operations
+type CommentRepositoryInterface interface {
+	Create(ctx context.Context, comment models.Comment) (*models.Comment, error)
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.Comment, error)
+	ListByItem(ctx context.Context, wishlistID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error)
+	ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error)
+	Reply(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error)
+	SetHidden(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error)
+	Delete(ctx context.Context, id pgtype.UUID) error
+}
+
+type CommentRepository struct {
+	db *database.DB
+}
+
+func NewCommentRepository(db *database.DB) CommentRepositoryInterface {
+	return &CommentRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new comment on a wishlist item
+func (r *CommentRepository) Create(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+	query := `
+		INSERT INTO item_comments (wishlist_id, gift_item_id, author_user_id, author_name, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + commentColumns
+
+	var created models.Comment
+	err := r.db.QueryRowxContext(ctx, query,
+		comment.WishlistID,
+		comment.GiftItemID,
+		comment.AuthorUserID,
+		comment.AuthorName,
+		comment.Body,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a comment by ID
+func (r *CommentRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM item_comments WHERE id = $1`
+
+	var comment models.Comment
+	if err := r.db.GetContext(ctx, &comment, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// ListByItem returns the comment thread of an item within a wishlist, oldest first
+func (r *CommentRepository) ListByItem(ctx context.Context, wishlistID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM item_comments
+		WHERE wishlist_id = $1 AND gift_item_id = $2
+		  AND ($3 OR is_hidden = false)
+		ORDER BY created_at ASC
+	`
+
+	var comments []*models.Comment
+	if err := r.db.SelectContext(ctx, &comments, query, wishlistID, giftItemID, includeHidden); err != nil {
+		return nil, fmt.Errorf("failed to list item comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// ListByWishlist returns all comments on a wishlist, including hidden ones, newest first
+func (r *CommentRepository) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM item_comments
+		WHERE wishlist_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var comments []*models.Comment
+	if err := r.db.SelectContext(ctx, &comments, query, wishlistID); err != nil {
+		return nil, fmt.Errorf("failed to list wishlist comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// Reply sets or clears (NULL reply) the owner's reply to a comment
+func (r *CommentRepository) Reply(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error) {
+	query := `
+		UPDATE item_comments SET
+			owner_reply = $2,
+			replied_at = CASE WHEN $2::text IS NULL THEN NULL ELSE NOW() END,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + commentColumns
+
+	var comment models.Comment
+	if err := r.db.QueryRowxContext(ctx, query, id, reply).StructScan(&comment); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to reply to comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// SetHidden hides a comment from (or restores it to) the public item page
+func (r *CommentRepository) SetHidden(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error) {
+	query := `
+		UPDATE item_comments SET
+			is_hidden = $2,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + commentColumns
+
+	var comment models.Comment
+	if err := r.db.QueryRowxContext(ctx, query, id, hidden).StructScan(&comment); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to update comment visibility: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// Delete permanently removes a comment
+func (r *CommentRepository) Delete(ctx context.Context, id pgtype.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM item_comments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+
+	return nil
+}
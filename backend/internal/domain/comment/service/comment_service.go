@@ -0,0 +1,385 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . WishListRepositoryInterface GiftItemRepositoryInterface WishlistItemRepositoryInterface UserRepositoryInterface CommentEmailSenderInterface NotifierInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"wish-list/internal/domain/comment/models"
+	"wish-list/internal/domain/comment/repository"
+	itemmodels "wish-list/internal/domain/item/models"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces - only methods actually used by CommentService
+
+// WishListRepositoryInterface defines wishlist repository methods used by comment service
+type WishListRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+	GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+}
+
+// GiftItemRepositoryInterface defines gift item repository methods used by comment service
+type GiftItemRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error)
+}
+
+// WishlistItemRepositoryInterface defines wishlist-item repository methods used by comment service
+type WishlistItemRepositoryInterface interface {
+	IsAttached(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error)
+}
+
+// UserRepositoryInterface defines user repository methods used by comment service
+type UserRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+}
+
+// CommentEmailSenderInterface defines email methods used by comment service
+type CommentEmailSenderInterface interface {
+	SendNewCommentEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, authorName string) error
+}
+
+// NotifierInterface defines notification center methods used by comment service
+type NotifierInterface interface {
+	Notify(ctx context.Context, notification notificationmodels.Notification) error
+}
+
+var (
+	ErrInvalidCommentID    = errors.New("invalid comment id")
+	ErrInvalidWishlistID   = errors.New("invalid wishlist id")
+	ErrInvalidGiftItemID   = errors.New("invalid gift item id")
+	ErrEmptyComment        = errors.New("comment body and author name are required")
+	ErrCommentNotFound     = errors.New("comment not found")
+	ErrPublicItemNotFound  = errors.New("gift item not found in the specified public wishlist")
+	ErrWishlistNotFound    = errors.New("wishlist not found")
+	ErrCommentAccessDenied = errors.New("only the wishlist owner can moderate comments")
+)
+
+// CommentServiceInterface defines the interface for item comment operations
+type CommentServiceInterface interface {
+	PostComment(ctx context.Context, input PostCommentInput) (*CommentOutput, error)
+	ListPublicComments(ctx context.Context, publicSlug, giftItemID string) ([]*CommentOutput, error)
+	ListWishlistComments(ctx context.Context, wishlistID string, ownerID pgtype.UUID) ([]*CommentOutput, error)
+	ReplyToComment(ctx context.Context, commentID string, ownerID pgtype.UUID, reply *string) (*CommentOutput, error)
+	SetCommentHidden(ctx context.Context, commentID string, ownerID pgtype.UUID, hidden bool) (*CommentOutput, error)
+	DeleteComment(ctx context.Context, commentID string, ownerID pgtype.UUID) error
+}
+
+type CommentService struct {
+	repo             repository.CommentRepositoryInterface
+	wishListRepo     WishListRepositoryInterface
+	giftItemRepo     GiftItemRepositoryInterface
+	wishlistItemRepo WishlistItemRepositoryInterface
+	userRepo         UserRepositoryInterface
+	emailSender      CommentEmailSenderInterface
+	notifier         NotifierInterface
+}
+
+func NewCommentService(
+	repo repository.CommentRepositoryInterface,
+	wishListRepo WishListRepositoryInterface,
+	giftItemRepo GiftItemRepositoryInterface,
+	wishlistItemRepo WishlistItemRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	emailSender CommentEmailSenderInterface,
+	notifier NotifierInterface,
+) *CommentService {
+	return &CommentService{
+		repo:             repo,
+		wishListRepo:     wishListRepo,
+		giftItemRepo:     giftItemRepo,
+		wishlistItemRepo: wishlistItemRepo,
+		userRepo:         userRepo,
+		emailSender:      emailSender,
+		notifier:         notifier,
+	}
+}
+
+type PostCommentInput struct {
+	PublicSlug   string
+	GiftItemID   string
+	AuthorUserID pgtype.UUID // Invalid for guests
+	AuthorName   string
+	Body         string
+}
+
+type CommentOutput struct {
+	ID           pgtype.UUID
+	WishlistID   pgtype.UUID
+	GiftItemID   pgtype.UUID
+	AuthorUserID pgtype.UUID
+	AuthorName   string
+	Body         string
+	OwnerReply   pgtype.Text
+	RepliedAt    pgtype.Timestamptz
+	IsHidden     bool
+	CreatedAt    pgtype.Timestamptz
+}
+
+// PostComment adds a comment to an item of a public wishlist and notifies the owner by email.
+// A failed notification does not fail the comment.
+func (s *CommentService) PostComment(ctx context.Context, input PostCommentInput) (*CommentOutput, error) {
+	authorName := strings.TrimSpace(input.AuthorName)
+	body := strings.TrimSpace(input.Body)
+	if authorName == "" || body == "" {
+		return nil, ErrEmptyComment
+	}
+
+	wishList, item, err := s.getPublicItem(ctx, input.PublicSlug, input.GiftItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.Create(ctx, models.Comment{
+		WishlistID:   wishList.ID,
+		GiftItemID:   item.ID,
+		AuthorUserID: input.AuthorUserID,
+		AuthorName:   authorName,
+		Body:         body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item comment posted",
+		"comment_id", comment.ID.String(),
+		"wishlist_id", wishList.ID.String(),
+		"gift_item_id", item.ID.String())
+
+	if input.AuthorUserID != wishList.OwnerID {
+		s.notifyOwner(ctx, wishList, item, authorName)
+	}
+
+	return toCommentOutput(comment), nil
+}
+
+// ListPublicComments returns the visible comment thread of an item on a public wishlist
+func (s *CommentService) ListPublicComments(ctx context.Context, publicSlug, giftItemID string) ([]*CommentOutput, error) {
+	wishList, item, err := s.getPublicItem(ctx, publicSlug, giftItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repo.ListByItem(ctx, wishList.ID, item.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item comments: %w", err)
+	}
+
+	return toCommentOutputs(comments), nil
+}
+
+// ListWishlistComments returns every comment on a wishlist, hidden ones included, for its owner
+func (s *CommentService) ListWishlistComments(ctx context.Context, wishlistID string, ownerID pgtype.UUID) ([]*CommentOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(wishlistID); err != nil {
+		return nil, ErrInvalidWishlistID
+	}
+
+	wishList, err := s.wishListRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	if wishList.OwnerID != ownerID {
+		return nil, ErrCommentAccessDenied
+	}
+
+	comments, err := s.repo.ListByWishlist(ctx, wishList.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlist comments: %w", err)
+	}
+
+	return toCommentOutputs(comments), nil
+}
+
+// ReplyToComment sets the owner's reply on a comment; an empty reply removes it
+func (s *CommentService) ReplyToComment(ctx context.Context, commentID string, ownerID pgtype.UUID, reply *string) (*CommentOutput, error) {
+	comment, err := s.getOwnedComment(ctx, commentID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	replyText := pgtype.Text{Valid: false}
+	if reply != nil && strings.TrimSpace(*reply) != "" {
+		replyText = pgtype.Text{String: strings.TrimSpace(*reply), Valid: true}
+	}
+
+	updated, err := s.repo.Reply(ctx, comment.ID, replyText)
+	if err != nil {
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to reply to comment: %w", err)
+	}
+
+	return toCommentOutput(updated), nil
+}
+
+// SetCommentHidden hides a comment from the public item page or makes it visible again
+func (s *CommentService) SetCommentHidden(ctx context.Context, commentID string, ownerID pgtype.UUID, hidden bool) (*CommentOutput, error) {
+	comment, err := s.getOwnedComment(ctx, commentID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.SetHidden(ctx, comment.ID, hidden)
+	if err != nil {
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to update comment visibility: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item comment visibility changed",
+		"comment_id", updated.ID.String(),
+		"hidden", hidden)
+
+	return toCommentOutput(updated), nil
+}
+
+// DeleteComment permanently removes a comment from the owner's wishlist
+func (s *CommentService) DeleteComment(ctx context.Context, commentID string, ownerID pgtype.UUID) error {
+	comment, err := s.getOwnedComment(ctx, commentID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, comment.ID); err != nil {
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return ErrCommentNotFound
+		}
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item comment deleted", "comment_id", comment.ID.String())
+
+	return nil
+}
+
+// getPublicItem resolves a public wishlist and one of its (non-archived) items
+func (s *CommentService) getPublicItem(ctx context.Context, publicSlug, giftItemID string) (*wishlistmodels.WishList, *itemmodels.GiftItem, error) {
+	itemID := pgtype.UUID{}
+	if err := itemID.Scan(giftItemID); err != nil {
+		return nil, nil, ErrInvalidGiftItemID
+	}
+
+	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, nil, ErrPublicItemNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	attached, err := s.wishlistItemRepo.IsAttached(ctx, wishList.ID, itemID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check item attachment: %w", err)
+	}
+	if !attached {
+		return nil, nil, ErrPublicItemNotFound
+	}
+
+	item, err := s.giftItemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get gift item: %w", err)
+	}
+	if item.ArchivedAt.Valid {
+		return nil, nil, ErrPublicItemNotFound
+	}
+
+	return wishList, item, nil
+}
+
+// getOwnedComment loads a comment and verifies the caller owns the wishlist it was posted on
+func (s *CommentService) getOwnedComment(ctx context.Context, commentID string, ownerID pgtype.UUID) (*models.Comment, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(commentID); err != nil {
+		return nil, ErrInvalidCommentID
+	}
+
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	wishList, err := s.wishListRepo.GetByID(ctx, comment.WishlistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	// Don't reveal comments on other users' wishlists
+	if wishList.OwnerID != ownerID {
+		return nil, ErrCommentNotFound
+	}
+
+	return comment, nil
+}
+
+func (s *CommentService) notifyOwner(ctx context.Context, wishList *wishlistmodels.WishList, item *itemmodels.GiftItem, authorName string) {
+	if s.notifier != nil {
+		err := s.notifier.Notify(ctx, notificationmodels.Notification{
+			UserID:     wishList.OwnerID,
+			Type:       notificationmodels.TypeNewComment,
+			Title:      fmt.Sprintf("New comment on %q", item.Name),
+			Body:       fmt.Sprintf("%s commented on %q in %q.", authorName, item.Name, wishList.Title),
+			WishlistID: wishList.ID,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "failed to create new comment notification",
+				"wishlist_id", wishList.ID.String(),
+				"error", err)
+		}
+	}
+
+	owner, err := s.userRepo.GetByID(ctx, wishList.OwnerID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to load wishlist owner for comment notification",
+			"wishlist_id", wishList.ID.String(),
+			"error", err)
+		return
+	}
+
+	if err := s.emailSender.SendNewCommentEmail(ctx, owner.Email, item.Name, wishList.Title, authorName); err != nil {
+		logger.WarnContext(ctx, "failed to send new comment notification",
+			"wishlist_id", wishList.ID.String(),
+			"error", err)
+	}
+}
+
+func toCommentOutput(comment *models.Comment) *CommentOutput {
+	return &CommentOutput{
+		ID:           comment.ID,
+		WishlistID:   comment.WishlistID,
+		GiftItemID:   comment.GiftItemID,
+		AuthorUserID: comment.AuthorUserID,
+		AuthorName:   comment.AuthorName,
+		Body:         comment.Body,
+		OwnerReply:   comment.OwnerReply,
+		RepliedAt:    comment.RepliedAt,
+		IsHidden:     comment.IsHidden,
+		CreatedAt:    comment.CreatedAt,
+	}
+}
+
+func toCommentOutputs(comments []*models.Comment) []*CommentOutput {
+	outputs := make([]*CommentOutput, len(comments))
+	for i, comment := range comments {
+		outputs[i] = toCommentOutput(comment)
+	}
+	return outputs
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wish-list/internal/domain/comment/models"
+	"wish-list/internal/domain/comment/repository"
+	itemmodels "wish-list/internal/domain/item/models"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testWishlistID = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testItemID     = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testOwnerID    = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+	testCommentID  = pgtype.UUID{Bytes: [16]byte{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, Valid: true}
+	testOtherID    = pgtype.UUID{Bytes: [16]byte{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, Valid: true}
+)
+
+func testWishList() *wishlistmodels.WishList {
+	return &wishlistmodels.WishList{ID: testWishlistID, OwnerID: testOwnerID, Title: "Birthday"}
+}
+
+type commentMocks struct {
+	repo         *CommentRepositoryInterfaceMock
+	wishLists    *WishListRepositoryInterfaceMock
+	items        *GiftItemRepositoryInterfaceMock
+	wishlistItem *WishlistItemRepositoryInterfaceMock
+	users        *UserRepositoryInterfaceMock
+	email        *CommentEmailSenderInterfaceMock
+	notifier     *NotifierInterfaceMock
+}
+
+func newCommentMocks() *commentMocks {
+	return &commentMocks{
+		repo: &CommentRepositoryInterfaceMock{},
+		wishLists: &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+				return testWishList(), nil
+			},
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+				return testWishList(), nil
+			},
+		},
+		items: &GiftItemRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error) {
+				return &itemmodels.GiftItem{ID: id, Name: "Scarf"}, nil
+			},
+		},
+		wishlistItem: &WishlistItemRepositoryInterfaceMock{
+			IsAttachedFunc: func(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error) {
+				return true, nil
+			},
+		},
+		users: &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				return &usermodels.User{ID: id, Email: "owner@example.com"}, nil
+			},
+		},
+		email: &CommentEmailSenderInterfaceMock{
+			SendNewCommentEmailFunc: func(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, authorName string) error {
+				return nil
+			},
+		},
+		notifier: &NotifierInterfaceMock{
+			NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error {
+				return nil
+			},
+		},
+	}
+}
+
+func (m *commentMocks) service() *CommentService {
+	return NewCommentService(m.repo, m.wishLists, m.items, m.wishlistItem, m.users, m.email, m.notifier)
+}
+
+func TestCommentService_PostComment(t *testing.T) {
+	input := PostCommentInput{
+		PublicSlug: "birthday",
+		GiftItemID: testItemID.String(),
+		AuthorName: " Aunt May ",
+		Body:       " Which size? ",
+	}
+
+	t.Run("creates guest comment and notifies owner", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.CreateFunc = func(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+			comment.ID = testCommentID
+			return &comment, nil
+		}
+
+		output, err := m.service().PostComment(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Aunt May", output.AuthorName)
+		assert.Equal(t, "Which size?", output.Body)
+		assert.Equal(t, testWishlistID, output.WishlistID)
+		assert.False(t, output.AuthorUserID.Valid)
+
+		require.Len(t, m.email.SendNewCommentEmailCalls(), 1)
+		call := m.email.SendNewCommentEmailCalls()[0]
+		assert.Equal(t, "owner@example.com", call.RecipientEmail)
+		assert.Equal(t, "Scarf", call.GiftItemName)
+		assert.Equal(t, "Birthday", call.WishlistTitle)
+
+		require.Len(t, m.notifier.NotifyCalls(), 1)
+		notification := m.notifier.NotifyCalls()[0].Notification
+		assert.Equal(t, testOwnerID, notification.UserID)
+		assert.Equal(t, notificationmodels.TypeNewComment, notification.Type)
+		assert.Equal(t, testWishlistID, notification.WishlistID)
+	})
+
+	t.Run("owner comment does not notify", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.CreateFunc = func(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+			return &comment, nil
+		}
+
+		ownerInput := input
+		ownerInput.AuthorUserID = testOwnerID
+		_, err := m.service().PostComment(context.Background(), ownerInput)
+
+		require.NoError(t, err)
+		assert.Empty(t, m.email.SendNewCommentEmailCalls())
+		assert.Empty(t, m.notifier.NotifyCalls())
+	})
+
+	t.Run("notification failure does not fail the comment", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.CreateFunc = func(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+			return &comment, nil
+		}
+		m.email.SendNewCommentEmailFunc = func(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, authorName string) error {
+			return errors.New("smtp down")
+		}
+
+		_, err := m.service().PostComment(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Len(t, m.repo.CreateCalls(), 1)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		m := newCommentMocks()
+		emptyInput := input
+		emptyInput.Body = "   "
+
+		_, err := m.service().PostComment(context.Background(), emptyInput)
+
+		assert.ErrorIs(t, err, ErrEmptyComment)
+	})
+
+	t.Run("wishlist not public", func(t *testing.T) {
+		m := newCommentMocks()
+		m.wishLists.GetByPublicSlugFunc = func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return nil, wishlistrepo.ErrWishListNotFound
+		}
+
+		_, err := m.service().PostComment(context.Background(), input)
+
+		assert.ErrorIs(t, err, ErrPublicItemNotFound)
+	})
+
+	t.Run("item not in wishlist", func(t *testing.T) {
+		m := newCommentMocks()
+		m.wishlistItem.IsAttachedFunc = func(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error) {
+			return false, nil
+		}
+
+		_, err := m.service().PostComment(context.Background(), input)
+
+		assert.ErrorIs(t, err, ErrPublicItemNotFound)
+	})
+
+	t.Run("archived item", func(t *testing.T) {
+		m := newCommentMocks()
+		m.items.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error) {
+			return &itemmodels.GiftItem{ID: id, ArchivedAt: pgtype.Timestamptz{Valid: true}}, nil
+		}
+
+		_, err := m.service().PostComment(context.Background(), input)
+
+		assert.ErrorIs(t, err, ErrPublicItemNotFound)
+	})
+}
+
+func TestCommentService_ListPublicComments(t *testing.T) {
+	m := newCommentMocks()
+	m.repo.ListByItemFunc = func(ctx context.Context, wishlistID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error) {
+		return []*models.Comment{{ID: testCommentID, Body: "Which size?"}}, nil
+	}
+
+	comments, err := m.service().ListPublicComments(context.Background(), "birthday", testItemID.String())
+
+	require.NoError(t, err)
+	assert.Len(t, comments, 1)
+	require.Len(t, m.repo.ListByItemCalls(), 1)
+	assert.False(t, m.repo.ListByItemCalls()[0].IncludeHidden)
+}
+
+func TestCommentService_ListWishlistComments(t *testing.T) {
+	t.Run("owner sees all comments", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.ListByWishlistFunc = func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error) {
+			return []*models.Comment{{ID: testCommentID, IsHidden: true}}, nil
+		}
+
+		comments, err := m.service().ListWishlistComments(context.Background(), testWishlistID.String(), testOwnerID)
+
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.True(t, comments[0].IsHidden)
+	})
+
+	t.Run("non-owner is denied", func(t *testing.T) {
+		m := newCommentMocks()
+
+		_, err := m.service().ListWishlistComments(context.Background(), testWishlistID.String(), testOtherID)
+
+		assert.ErrorIs(t, err, ErrCommentAccessDenied)
+	})
+
+	t.Run("invalid wishlist id", func(t *testing.T) {
+		m := newCommentMocks()
+
+		_, err := m.service().ListWishlistComments(context.Background(), "not-a-uuid", testOwnerID)
+
+		assert.ErrorIs(t, err, ErrInvalidWishlistID)
+	})
+}
+
+func TestCommentService_Moderation(t *testing.T) {
+	existing := func(ctx context.Context, id pgtype.UUID) (*models.Comment, error) {
+		return &models.Comment{ID: id, WishlistID: testWishlistID}, nil
+	}
+
+	t.Run("reply trims text", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = existing
+		m.repo.ReplyFunc = func(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error) {
+			return &models.Comment{ID: id, OwnerReply: reply}, nil
+		}
+
+		reply := "  Size M please  "
+		output, err := m.service().ReplyToComment(context.Background(), testCommentID.String(), testOwnerID, &reply)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Size M please", output.OwnerReply.String)
+	})
+
+	t.Run("empty reply clears it", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = existing
+		m.repo.ReplyFunc = func(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error) {
+			return &models.Comment{ID: id, OwnerReply: reply}, nil
+		}
+
+		_, err := m.service().ReplyToComment(context.Background(), testCommentID.String(), testOwnerID, nil)
+
+		require.NoError(t, err)
+		assert.False(t, m.repo.ReplyCalls()[0].Reply.Valid)
+	})
+
+	t.Run("hide", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = existing
+		m.repo.SetHiddenFunc = func(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error) {
+			return &models.Comment{ID: id, IsHidden: hidden}, nil
+		}
+
+		output, err := m.service().SetCommentHidden(context.Background(), testCommentID.String(), testOwnerID, true)
+
+		require.NoError(t, err)
+		assert.True(t, output.IsHidden)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = existing
+		m.repo.DeleteFunc = func(ctx context.Context, id pgtype.UUID) error {
+			return nil
+		}
+
+		err := m.service().DeleteComment(context.Background(), testCommentID.String(), testOwnerID)
+
+		require.NoError(t, err)
+		assert.Len(t, m.repo.DeleteCalls(), 1)
+	})
+
+	t.Run("other user's comment is not found", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = existing
+
+		err := m.service().DeleteComment(context.Background(), testCommentID.String(), testOtherID)
+
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+		assert.Empty(t, m.repo.DeleteCalls())
+	})
+
+	t.Run("missing comment", func(t *testing.T) {
+		m := newCommentMocks()
+		m.repo.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*models.Comment, error) {
+			return nil, repository.ErrCommentNotFound
+		}
+
+		_, err := m.service().SetCommentHidden(context.Background(), testCommentID.String(), testOwnerID, true)
+
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+	})
+}
@@ -0,0 +1,408 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/comment/models"
+	"wish-list/internal/domain/comment/repository"
+)
+
+// Ensure, that CommentRepositoryInterfaceMock does implement repository.CommentRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.CommentRepositoryInterface = &CommentRepositoryInterfaceMock{}
+
+// CommentRepositoryInterfaceMock is a mock implementation of repository.CommentRepositoryInterface.
+//
+//	func TestSomethingThatUsesCommentRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.CommentRepositoryInterface
+//		mockedCommentRepositoryInterface := &CommentRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.Comment, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByItemFunc: func(ctx context.Context, wishlistID pgtype.UUID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error) {
+//				panic("mock out the ListByItem method")
+//			},
+//			ListByWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error) {
+//				panic("mock out the ListByWishlist method")
+//			},
+//			ReplyFunc: func(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error) {
+//				panic("mock out the Reply method")
+//			},
+//			SetHiddenFunc: func(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error) {
+//				panic("mock out the SetHidden method")
+//			},
+//		}
+//
+//		// use mockedCommentRepositoryInterface in code that requires repository.CommentRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type CommentRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, comment models.Comment) (*models.Comment, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*models.Comment, error)
+
+	// ListByItemFunc mocks the ListByItem method.
+	ListByItemFunc func(ctx context.Context, wishlistID pgtype.UUID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error)
+
+	// ListByWishlistFunc mocks the ListByWishlist method.
+	ListByWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error)
+
+	// ReplyFunc mocks the Reply method.
+	ReplyFunc func(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error)
+
+	// SetHiddenFunc mocks the SetHidden method.
+	SetHiddenFunc func(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Comment is the comment argument value.
+			Comment models.Comment
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// ListByItem holds details about calls to the ListByItem method.
+		ListByItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+			// IncludeHidden is the includeHidden argument value.
+			IncludeHidden bool
+		}
+		// ListByWishlist holds details about calls to the ListByWishlist method.
+		ListByWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// Reply holds details about calls to the Reply method.
+		Reply []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Reply is the reply argument value.
+			Reply pgtype.Text
+		}
+		// SetHidden holds details about calls to the SetHidden method.
+		SetHidden []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Hidden is the hidden argument value.
+			Hidden bool
+		}
+	}
+	lockCreate         sync.RWMutex
+	lockDelete         sync.RWMutex
+	lockGetByID        sync.RWMutex
+	lockListByItem     sync.RWMutex
+	lockListByWishlist sync.RWMutex
+	lockReply          sync.RWMutex
+	lockSetHidden      sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *CommentRepositoryInterfaceMock) Create(ctx context.Context, comment models.Comment) (*models.Comment, error) {
+	if mock.CreateFunc == nil {
+		panic("CommentRepositoryInterfaceMock.CreateFunc: method is nil but CommentRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Comment models.Comment
+	}{
+		Ctx:     ctx,
+		Comment: comment,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, comment)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.CreateCalls())
+func (mock *CommentRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx     context.Context
+	Comment models.Comment
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Comment models.Comment
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *CommentRepositoryInterfaceMock) Delete(ctx context.Context, id pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("CommentRepositoryInterfaceMock.DeleteFunc: method is nil but CommentRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.DeleteCalls())
+func (mock *CommentRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *CommentRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*models.Comment, error) {
+	if mock.GetByIDFunc == nil {
+		panic("CommentRepositoryInterfaceMock.GetByIDFunc: method is nil but CommentRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.GetByIDCalls())
+func (mock *CommentRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByItem calls ListByItemFunc.
+func (mock *CommentRepositoryInterfaceMock) ListByItem(ctx context.Context, wishlistID pgtype.UUID, giftItemID pgtype.UUID, includeHidden bool) ([]*models.Comment, error) {
+	if mock.ListByItemFunc == nil {
+		panic("CommentRepositoryInterfaceMock.ListByItemFunc: method is nil but CommentRepositoryInterface.ListByItem was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		WishlistID    pgtype.UUID
+		GiftItemID    pgtype.UUID
+		IncludeHidden bool
+	}{
+		Ctx:           ctx,
+		WishlistID:    wishlistID,
+		GiftItemID:    giftItemID,
+		IncludeHidden: includeHidden,
+	}
+	mock.lockListByItem.Lock()
+	mock.calls.ListByItem = append(mock.calls.ListByItem, callInfo)
+	mock.lockListByItem.Unlock()
+	return mock.ListByItemFunc(ctx, wishlistID, giftItemID, includeHidden)
+}
+
+// ListByItemCalls gets all the calls that were made to ListByItem.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.ListByItemCalls())
+func (mock *CommentRepositoryInterfaceMock) ListByItemCalls() []struct {
+	Ctx           context.Context
+	WishlistID    pgtype.UUID
+	GiftItemID    pgtype.UUID
+	IncludeHidden bool
+} {
+	var calls []struct {
+		Ctx           context.Context
+		WishlistID    pgtype.UUID
+		GiftItemID    pgtype.UUID
+		IncludeHidden bool
+	}
+	mock.lockListByItem.RLock()
+	calls = mock.calls.ListByItem
+	mock.lockListByItem.RUnlock()
+	return calls
+}
+
+// ListByWishlist calls ListByWishlistFunc.
+func (mock *CommentRepositoryInterfaceMock) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.Comment, error) {
+	if mock.ListByWishlistFunc == nil {
+		panic("CommentRepositoryInterfaceMock.ListByWishlistFunc: method is nil but CommentRepositoryInterface.ListByWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockListByWishlist.Lock()
+	mock.calls.ListByWishlist = append(mock.calls.ListByWishlist, callInfo)
+	mock.lockListByWishlist.Unlock()
+	return mock.ListByWishlistFunc(ctx, wishlistID)
+}
+
+// ListByWishlistCalls gets all the calls that were made to ListByWishlist.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.ListByWishlistCalls())
+func (mock *CommentRepositoryInterfaceMock) ListByWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockListByWishlist.RLock()
+	calls = mock.calls.ListByWishlist
+	mock.lockListByWishlist.RUnlock()
+	return calls
+}
+
+// Reply calls ReplyFunc.
+func (mock *CommentRepositoryInterfaceMock) Reply(ctx context.Context, id pgtype.UUID, reply pgtype.Text) (*models.Comment, error) {
+	if mock.ReplyFunc == nil {
+		panic("CommentRepositoryInterfaceMock.ReplyFunc: method is nil but CommentRepositoryInterface.Reply was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		Reply pgtype.Text
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Reply: reply,
+	}
+	mock.lockReply.Lock()
+	mock.calls.Reply = append(mock.calls.Reply, callInfo)
+	mock.lockReply.Unlock()
+	return mock.ReplyFunc(ctx, id, reply)
+}
+
+// ReplyCalls gets all the calls that were made to Reply.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.ReplyCalls())
+func (mock *CommentRepositoryInterfaceMock) ReplyCalls() []struct {
+	Ctx   context.Context
+	ID    pgtype.UUID
+	Reply pgtype.Text
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		Reply pgtype.Text
+	}
+	mock.lockReply.RLock()
+	calls = mock.calls.Reply
+	mock.lockReply.RUnlock()
+	return calls
+}
+
+// SetHidden calls SetHiddenFunc.
+func (mock *CommentRepositoryInterfaceMock) SetHidden(ctx context.Context, id pgtype.UUID, hidden bool) (*models.Comment, error) {
+	if mock.SetHiddenFunc == nil {
+		panic("CommentRepositoryInterfaceMock.SetHiddenFunc: method is nil but CommentRepositoryInterface.SetHidden was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Hidden bool
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Hidden: hidden,
+	}
+	mock.lockSetHidden.Lock()
+	mock.calls.SetHidden = append(mock.calls.SetHidden, callInfo)
+	mock.lockSetHidden.Unlock()
+	return mock.SetHiddenFunc(ctx, id, hidden)
+}
+
+// SetHiddenCalls gets all the calls that were made to SetHidden.
+// Check the length with:
+//
+//	len(mockedCommentRepositoryInterface.SetHiddenCalls())
+func (mock *CommentRepositoryInterfaceMock) SetHiddenCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	Hidden bool
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Hidden bool
+	}
+	mock.lockSetHidden.RLock()
+	calls = mock.calls.SetHidden
+	mock.lockSetHidden.RUnlock()
+	return calls
+}
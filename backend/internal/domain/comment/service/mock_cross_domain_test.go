@@ -0,0 +1,520 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	itemmodels "wish-list/internal/domain/item/models"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+)
+
+// Ensure, that WishListRepositoryInterfaceMock does implement WishListRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ WishListRepositoryInterface = &WishListRepositoryInterfaceMock{}
+
+// WishListRepositoryInterfaceMock is a mock implementation of WishListRepositoryInterface.
+//
+//	func TestSomethingThatUsesWishListRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked WishListRepositoryInterface
+//		mockedWishListRepositoryInterface := &WishListRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+//				panic("mock out the GetByPublicSlug method")
+//			},
+//		}
+//
+//		// use mockedWishListRepositoryInterface in code that requires WishListRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type WishListRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+
+	// GetByPublicSlugFunc mocks the GetByPublicSlug method.
+	GetByPublicSlugFunc func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetByPublicSlug holds details about calls to the GetByPublicSlug method.
+		GetByPublicSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+	}
+	lockGetByID         sync.RWMutex
+	lockGetByPublicSlug sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+	if mock.GetByIDFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByIDFunc: method is nil but WishListRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByIDCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// GetByPublicSlug calls GetByPublicSlugFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+	if mock.GetByPublicSlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByPublicSlugFunc: method is nil but WishListRepositoryInterface.GetByPublicSlug was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetByPublicSlug.Lock()
+	mock.calls.GetByPublicSlug = append(mock.calls.GetByPublicSlug, callInfo)
+	mock.lockGetByPublicSlug.Unlock()
+	return mock.GetByPublicSlugFunc(ctx, publicSlug)
+}
+
+// GetByPublicSlugCalls gets all the calls that were made to GetByPublicSlug.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByPublicSlugCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByPublicSlugCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetByPublicSlug.RLock()
+	calls = mock.calls.GetByPublicSlug
+	mock.lockGetByPublicSlug.RUnlock()
+	return calls
+}
+
+// Ensure, that GiftItemRepositoryInterfaceMock does implement GiftItemRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GiftItemRepositoryInterface = &GiftItemRepositoryInterfaceMock{}
+
+// GiftItemRepositoryInterfaceMock is a mock implementation of GiftItemRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftItemRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GiftItemRepositoryInterface
+//		mockedGiftItemRepositoryInterface := &GiftItemRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error) {
+//				panic("mock out the GetByID method")
+//			},
+//		}
+//
+//		// use mockedGiftItemRepositoryInterface in code that requires GiftItemRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockGetByID sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *GiftItemRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error) {
+	if mock.GetByIDFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.GetByIDFunc: method is nil but GiftItemRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.GetByIDCalls())
+func (mock *GiftItemRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Ensure, that WishlistItemRepositoryInterfaceMock does implement WishlistItemRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ WishlistItemRepositoryInterface = &WishlistItemRepositoryInterfaceMock{}
+
+// WishlistItemRepositoryInterfaceMock is a mock implementation of WishlistItemRepositoryInterface.
+//
+//	func TestSomethingThatUsesWishlistItemRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked WishlistItemRepositoryInterface
+//		mockedWishlistItemRepositoryInterface := &WishlistItemRepositoryInterfaceMock{
+//			IsAttachedFunc: func(ctx context.Context, wishlistID pgtype.UUID, itemID pgtype.UUID) (bool, error) {
+//				panic("mock out the IsAttached method")
+//			},
+//		}
+//
+//		// use mockedWishlistItemRepositoryInterface in code that requires WishlistItemRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type WishlistItemRepositoryInterfaceMock struct {
+	// IsAttachedFunc mocks the IsAttached method.
+	IsAttachedFunc func(ctx context.Context, wishlistID pgtype.UUID, itemID pgtype.UUID) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// IsAttached holds details about calls to the IsAttached method.
+		IsAttached []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+		}
+	}
+	lockIsAttached sync.RWMutex
+}
+
+// IsAttached calls IsAttachedFunc.
+func (mock *WishlistItemRepositoryInterfaceMock) IsAttached(ctx context.Context, wishlistID pgtype.UUID, itemID pgtype.UUID) (bool, error) {
+	if mock.IsAttachedFunc == nil {
+		panic("WishlistItemRepositoryInterfaceMock.IsAttachedFunc: method is nil but WishlistItemRepositoryInterface.IsAttached was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		ItemID     pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+		ItemID:     itemID,
+	}
+	mock.lockIsAttached.Lock()
+	mock.calls.IsAttached = append(mock.calls.IsAttached, callInfo)
+	mock.lockIsAttached.Unlock()
+	return mock.IsAttachedFunc(ctx, wishlistID, itemID)
+}
+
+// IsAttachedCalls gets all the calls that were made to IsAttached.
+// Check the length with:
+//
+//	len(mockedWishlistItemRepositoryInterface.IsAttachedCalls())
+func (mock *WishlistItemRepositoryInterfaceMock) IsAttachedCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+	ItemID     pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		ItemID     pgtype.UUID
+	}
+	mock.lockIsAttached.RLock()
+	calls = mock.calls.IsAttached
+	mock.lockIsAttached.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRepositoryInterfaceMock does implement UserRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ UserRepositoryInterface = &UserRepositoryInterfaceMock{}
+
+// UserRepositoryInterfaceMock is a mock implementation of UserRepositoryInterface.
+//
+//	func TestSomethingThatUsesUserRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked UserRepositoryInterface
+//		mockedUserRepositoryInterface := &UserRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+//				panic("mock out the GetByID method")
+//			},
+//		}
+//
+//		// use mockedUserRepositoryInterface in code that requires UserRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockGetByID sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *UserRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+	if mock.GetByIDFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByIDFunc: method is nil but UserRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByIDCalls())
+func (mock *UserRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Ensure, that CommentEmailSenderInterfaceMock does implement CommentEmailSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ CommentEmailSenderInterface = &CommentEmailSenderInterfaceMock{}
+
+// CommentEmailSenderInterfaceMock is a mock implementation of CommentEmailSenderInterface.
+//
+//	func TestSomethingThatUsesCommentEmailSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked CommentEmailSenderInterface
+//		mockedCommentEmailSenderInterface := &CommentEmailSenderInterfaceMock{
+//			SendNewCommentEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, authorName string) error {
+//				panic("mock out the SendNewCommentEmail method")
+//			},
+//		}
+//
+//		// use mockedCommentEmailSenderInterface in code that requires CommentEmailSenderInterface
+//		// and then make assertions.
+//
+//	}
+type CommentEmailSenderInterfaceMock struct {
+	// SendNewCommentEmailFunc mocks the SendNewCommentEmail method.
+	SendNewCommentEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, authorName string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendNewCommentEmail holds details about calls to the SendNewCommentEmail method.
+		SendNewCommentEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// GiftItemName is the giftItemName argument value.
+			GiftItemName string
+			// WishlistTitle is the wishlistTitle argument value.
+			WishlistTitle string
+			// AuthorName is the authorName argument value.
+			AuthorName string
+		}
+	}
+	lockSendNewCommentEmail sync.RWMutex
+}
+
+// SendNewCommentEmail calls SendNewCommentEmailFunc.
+func (mock *CommentEmailSenderInterfaceMock) SendNewCommentEmail(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, authorName string) error {
+	if mock.SendNewCommentEmailFunc == nil {
+		panic("CommentEmailSenderInterfaceMock.SendNewCommentEmailFunc: method is nil but CommentEmailSenderInterface.SendNewCommentEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		GiftItemName   string
+		WishlistTitle  string
+		AuthorName     string
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		GiftItemName:   giftItemName,
+		WishlistTitle:  wishlistTitle,
+		AuthorName:     authorName,
+	}
+	mock.lockSendNewCommentEmail.Lock()
+	mock.calls.SendNewCommentEmail = append(mock.calls.SendNewCommentEmail, callInfo)
+	mock.lockSendNewCommentEmail.Unlock()
+	return mock.SendNewCommentEmailFunc(ctx, recipientEmail, giftItemName, wishlistTitle, authorName)
+}
+
+// SendNewCommentEmailCalls gets all the calls that were made to SendNewCommentEmail.
+// Check the length with:
+//
+//	len(mockedCommentEmailSenderInterface.SendNewCommentEmailCalls())
+func (mock *CommentEmailSenderInterfaceMock) SendNewCommentEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	GiftItemName   string
+	WishlistTitle  string
+	AuthorName     string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		GiftItemName   string
+		WishlistTitle  string
+		AuthorName     string
+	}
+	mock.lockSendNewCommentEmail.RLock()
+	calls = mock.calls.SendNewCommentEmail
+	mock.lockSendNewCommentEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that NotifierInterfaceMock does implement NotifierInterface.
+// If this is not the case, regenerate this file with moq.
+var _ NotifierInterface = &NotifierInterfaceMock{}
+
+// NotifierInterfaceMock is a mock implementation of NotifierInterface.
+//
+//	func TestSomethingThatUsesNotifierInterface(t *testing.T) {
+//
+//		// make and configure a mocked NotifierInterface
+//		mockedNotifierInterface := &NotifierInterfaceMock{
+//			NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error {
+//				panic("mock out the Notify method")
+//			},
+//		}
+//
+//		// use mockedNotifierInterface in code that requires NotifierInterface
+//		// and then make assertions.
+//
+//	}
+type NotifierInterfaceMock struct {
+	// NotifyFunc mocks the Notify method.
+	NotifyFunc func(ctx context.Context, notification notificationmodels.Notification) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Notify holds details about calls to the Notify method.
+		Notify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Notification is the notification argument value.
+			Notification notificationmodels.Notification
+		}
+	}
+	lockNotify sync.RWMutex
+}
+
+// Notify calls NotifyFunc.
+func (mock *NotifierInterfaceMock) Notify(ctx context.Context, notification notificationmodels.Notification) error {
+	if mock.NotifyFunc == nil {
+		panic("NotifierInterfaceMock.NotifyFunc: method is nil but NotifierInterface.Notify was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}{
+		Ctx:          ctx,
+		Notification: notification,
+	}
+	mock.lockNotify.Lock()
+	mock.calls.Notify = append(mock.calls.Notify, callInfo)
+	mock.lockNotify.Unlock()
+	return mock.NotifyFunc(ctx, notification)
+}
+
+// NotifyCalls gets all the calls that were made to Notify.
+// Check the length with:
+//
+//	len(mockedNotifierInterface.NotifyCalls())
+func (mock *NotifierInterfaceMock) NotifyCalls() []struct {
+	Ctx          context.Context
+	Notification notificationmodels.Notification
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}
+	mock.lockNotify.RLock()
+	calls = mock.calls.Notify
+	mock.lockNotify.RUnlock()
+	return calls
+}
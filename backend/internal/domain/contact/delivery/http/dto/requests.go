@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"wish-list/internal/domain/contact/service"
+)
+
+// ContactRequest creates or replaces a contact
+type ContactRequest struct {
+	Name         string `json:"name" validate:"required,max=100" example:"Aunt May"`
+	Email        string `json:"email" validate:"required,email,max=255" example:"may@example.com"`
+	Relationship string `json:"relationship" validate:"max=50" example:"family"` // Also the group invites can be sent to
+}
+
+func (r *ContactRequest) ToServiceInput() service.ContactInput {
+	return service.ContactInput{
+		Name:         r.Name,
+		Email:        r.Email,
+		Relationship: r.Relationship,
+	}
+}
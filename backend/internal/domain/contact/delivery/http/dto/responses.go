@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"wish-list/internal/domain/contact/service"
+)
+
+type ContactResponse struct {
+	ID           string  `json:"id" validate:"required"`
+	Name         string  `json:"name" validate:"required"`
+	Email        string  `json:"email" validate:"required"`
+	Relationship *string `json:"relationship"`
+	CreatedAt    string  `json:"created_at" validate:"required"`
+	UpdatedAt    string  `json:"updated_at" validate:"required"`
+}
+
+type ContactListResponse struct {
+	Data []ContactResponse `json:"data" validate:"required"`
+}
+
+// GroupResponse is a relationship contacts are saved with, usable as an invite group
+type GroupResponse struct {
+	Relationship string `json:"relationship" validate:"required" example:"family"`
+	Count        int    `json:"count" validate:"required" example:"6"`
+}
+
+type GroupListResponse struct {
+	Data []GroupResponse `json:"data" validate:"required"`
+}
+
+// RejectedRowResponse is a CSV row that was not imported
+type RejectedRowResponse struct {
+	Line   int    `json:"line" validate:"required" example:"4"`
+	Reason string `json:"reason" validate:"required" example:"contact needs a name and a valid email"`
+}
+
+type ImportResponse struct {
+	Created  int                   `json:"created" validate:"required" example:"12"`
+	Updated  int                   `json:"updated" validate:"required" example:"3"`
+	Rejected []RejectedRowResponse `json:"rejected" validate:"required"`
+}
+
+func FromContactOutput(c *service.ContactOutput) ContactResponse {
+	resp := ContactResponse{
+		ID:        c.ID,
+		Name:      c.Name,
+		Email:     c.Email,
+		CreatedAt: c.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: c.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if c.Relationship != "" {
+		relationship := c.Relationship
+		resp.Relationship = &relationship
+	}
+	return resp
+}
+
+func FromContactOutputs(outputs []*service.ContactOutput) ContactListResponse {
+	resp := ContactListResponse{Data: make([]ContactResponse, len(outputs))}
+	for i, output := range outputs {
+		resp.Data[i] = FromContactOutput(output)
+	}
+	return resp
+}
+
+func FromGroupOutputs(outputs []*service.GroupOutput) GroupListResponse {
+	resp := GroupListResponse{Data: make([]GroupResponse, len(outputs))}
+	for i, output := range outputs {
+		resp.Data[i] = GroupResponse{Relationship: output.Relationship, Count: output.Count}
+	}
+	return resp
+}
+
+func FromImportOutput(o *service.ImportOutput) ImportResponse {
+	resp := ImportResponse{
+		Created:  o.Created,
+		Updated:  o.Updated,
+		Rejected: make([]RejectedRowResponse, len(o.Rejected)),
+	}
+	for i, row := range o.Rejected {
+		resp.Rejected[i] = RejectedRowResponse{Line: row.Line, Reason: row.Reason}
+	}
+	return resp
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+
+	"wish-list/internal/domain/contact/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapContactServiceError converts contact service errors to AppErrors
+func mapContactServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidContactID):
+		return apperrors.BadRequest("Invalid contact ID")
+	case errors.Is(err, service.ErrInvalidContact):
+		return apperrors.BadRequest("Contact needs a name and a valid email")
+	case errors.Is(err, service.ErrInvalidContactsCSV):
+		return apperrors.BadRequest(err.Error())
+	case errors.Is(err, service.ErrContactNotFound):
+		return apperrors.NotFound("Contact not found")
+	case errors.Is(err, service.ErrContactExists):
+		return apperrors.Conflict("A contact with this email already exists")
+	case errors.Is(err, service.ErrContactLimitReached):
+		return apperrors.UnprocessableEntity(fmt.Sprintf("You can save up to %d contacts", service.MaxContacts))
+	default:
+		return apperrors.Internal("Contact request failed").Wrap(err)
+	}
+}
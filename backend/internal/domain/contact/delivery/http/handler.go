@@ -0,0 +1,217 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/contact/delivery/http/dto"
+	"wish-list/internal/domain/contact/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for the address book
+type Handler struct {
+	service service.ContactServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.ContactServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// ListContacts godoc
+//
+//	@Summary		List contacts
+//	@Description	The people saved to the address book, by name. Pass relationship to list one group only.
+//	@Tags			Contacts
+//	@Produce		json
+//	@Param			relationship	query		string					false	"Only contacts saved with this relationship"
+//	@Success		200				{object}	dto.ContactListResponse	"Contacts"
+//	@Failure		401				{object}	map[string]string		"Unauthorized"
+//	@Failure		500				{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts [get]
+func (h *Handler) ListContacts(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	contacts, err := h.service.ListContacts(c.Request().Context(), ownerID, c.QueryParam("relationship"))
+	if err != nil {
+		return mapContactServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromContactOutputs(contacts))
+}
+
+// ListGroups godoc
+//
+//	@Summary		List contact groups
+//	@Description	The relationships contacts are saved with, such as family, with how many contacts each has. A group can be invited to a wish list at once.
+//	@Tags			Contacts
+//	@Produce		json
+//	@Success		200	{object}	dto.GroupListResponse	"Contact groups"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts/groups [get]
+func (h *Handler) ListGroups(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.service.ListGroups(c.Request().Context(), ownerID)
+	if err != nil {
+		return mapContactServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromGroupOutputs(groups))
+}
+
+// CreateContact godoc
+//
+//	@Summary		Save a contact
+//	@Description	Add a person to the address book. Emails are unique per user, ignoring case; relationships are stored lowercased. At most 1000 contacts can be saved.
+//	@Tags			Contacts
+//	@Accept			json
+//	@Produce		json
+//	@Param			contact	body		dto.ContactRequest	true	"Contact"
+//	@Success		201		{object}	dto.ContactResponse	"Contact saved"
+//	@Failure		400		{object}	map[string]string	"Invalid name or email"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		409		{object}	map[string]string	"Email already saved"
+//	@Failure		422		{object}	map[string]string	"Contact limit reached"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts [post]
+func (h *Handler) CreateContact(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.ContactRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	contact, err := h.service.CreateContact(c.Request().Context(), ownerID, req.ToServiceInput())
+	if err != nil {
+		return mapContactServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromContactOutput(contact))
+}
+
+// UpdateContact godoc
+//
+//	@Summary		Update a contact
+//	@Description	Replace a contact's name, email and relationship.
+//	@Tags			Contacts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Contact ID"
+//	@Param			contact	body		dto.ContactRequest	true	"Contact"
+//	@Success		200		{object}	dto.ContactResponse	"Contact updated"
+//	@Failure		400		{object}	map[string]string	"Invalid contact ID, name or email"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		404		{object}	map[string]string	"Contact not found"
+//	@Failure		409		{object}	map[string]string	"Email already saved for another contact"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts/{id} [put]
+func (h *Handler) UpdateContact(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.ContactRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	contact, err := h.service.UpdateContact(c.Request().Context(), ownerID, c.Param("id"), req.ToServiceInput())
+	if err != nil {
+		return mapContactServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromContactOutput(contact))
+}
+
+// DeleteContact godoc
+//
+//	@Summary		Delete a contact
+//	@Description	Remove a person from the address book. Invites already sent to them are kept.
+//	@Tags			Contacts
+//	@Param			id	path	string	true	"Contact ID"
+//	@Success		204	"Contact deleted"
+//	@Failure		400	{object}	map[string]string	"Invalid contact ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Contact not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts/{id} [delete]
+func (h *Handler) DeleteContact(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteContact(c.Request().Context(), ownerID, c.Param("id")); err != nil {
+		return mapContactServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// ImportContacts godoc
+//
+//	@Summary		Import contacts from CSV
+//	@Description	Save the contacts in a CSV file of up to 1MB. The first row names the columns: name and email are required, relationship is optional, and other columns are ignored. Contacts whose email is already saved are updated. Invalid rows are skipped and reported by line; the rest are saved together, unless that would exceed 1000 contacts.
+//	@Tags			Contacts
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			file	formData	file				true	"CSV file (max 1MB)"
+//	@Success		200		{object}	dto.ImportResponse	"Contacts imported"
+//	@Failure		400		{object}	map[string]string	"Missing file, file too large or invalid CSV"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		422		{object}	map[string]string	"Contact limit reached"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/contacts/import [post]
+func (h *Handler) ImportContacts(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return apperrors.BadRequest("Failed to get uploaded file")
+	}
+	if file.Size > service.MaxImportBytes {
+		return apperrors.BadRequest("File too large. Maximum size is 1MB.")
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return apperrors.Internal("Failed to open uploaded file").Wrap(err)
+	}
+	defer src.Close()
+
+	result, err := h.service.ImportContacts(c.Request().Context(), ownerID, src)
+	if err != nil {
+		return mapContactServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromImportOutput(result))
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers address book routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected/contacts", authMiddleware)
+	protected.GET("", h.ListContacts)
+	protected.POST("", h.CreateContact)
+	protected.GET("/groups", h.ListGroups)
+	protected.POST("/import", h.ImportContacts)
+	protected.PUT("/:id", h.UpdateContact)
+	protected.DELETE("/:id", h.DeleteContact)
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Contact is a person the owner saved to invite again
+type Contact struct {
+	ID             pgtype.UUID        `db:"id"`
+	OwnerID        pgtype.UUID        `db:"owner_id"`
+	Name           string             `db:"name"`
+	Email          string             `db:"email"`           // Lowercased
+	EncryptedEmail pgtype.Text        `db:"encrypted_email"` // PII encrypted
+	Relationship   pgtype.Text        `db:"relationship"`    // Lowercased, e.g. "family"; also the group name
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `db:"updated_at"`
+}
+
+// ContactGroup is the set of contacts saved with the same relationship
+type ContactGroup struct {
+	Relationship string `db:"relationship"`
+	Count        int    `db:"count"`
+}
@@ -0,0 +1,460 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_contact_repository_test.go -pkg service . ContactRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/contact/models"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+)
+
+var (
+	// ErrContactNotFound is returned when the contact does not exist or belongs to another user
+	ErrContactNotFound = errors.New("contact not found")
+	// ErrContactExists is returned when the owner already saved a contact with the email
+	ErrContactExists = errors.New("contact already exists")
+	// ErrContactLimitReached is returned when saving would take the owner past the limit
+	ErrContactLimitReached = errors.New("contact limit reached")
+)
+
+// The email is NULL once encrypted; models.Contact keeps the decrypted value
+const contactColumns = `id, owner_id, name, COALESCE(email, '') AS email, encrypted_email, relationship, created_at, updated_at`
+
+// ContactRepositoryInterface defines the interface for contact database operations
+type ContactRepositoryInterface interface {
+	Create(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error)
+	GetByID(ctx context.Context, id, ownerID pgtype.UUID) (*models.Contact, error)
+	ListByOwner(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*models.Contact, error)
+	ListGroups(ctx context.Context, ownerID pgtype.UUID) ([]*models.ContactGroup, error)
+	Update(ctx context.Context, contact models.Contact) (*models.Contact, error)
+	Delete(ctx context.Context, id, ownerID pgtype.UUID) error
+	Import(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error)
+	ListEmailsByRelationships(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error)
+	ListGuestContactsByEmail(ctx context.Context, guestEmail string) ([]*models.Contact, error)
+	DeleteGuestContactsByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+type ContactRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewContactRepository(db *database.DB) ContactRepositoryInterface {
+	return &ContactRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewContactRepositoryWithEncryption creates a new ContactRepository that stores
+// the contacts' emails encrypted
+func NewContactRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) ContactRepositoryInterface {
+	return &ContactRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// encryptContactEmail returns the values stored for email: the plaintext, or with
+// encryption enabled its ciphertext and hash
+func (r *ContactRepository) encryptContactEmail(ctx context.Context, email string) (plaintext, encrypted, hash pgtype.Text, err error) {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{String: email, Valid: true}, pgtype.Text{}, pgtype.Text{}, nil
+	}
+
+	ciphertext, err := r.encryptionSvc.Encrypt(ctx, email)
+	if err != nil {
+		return pgtype.Text{}, pgtype.Text{}, pgtype.Text{}, fmt.Errorf("failed to encrypt contact email: %w", err)
+	}
+	return pgtype.Text{}, pgtype.Text{String: ciphertext, Valid: true}, pgtype.Text{String: r.encryptionSvc.HashEmail(email), Valid: true}, nil
+}
+
+// decryptContactEmail fills the contact's email from the encrypted column
+func (r *ContactRepository) decryptContactEmail(ctx context.Context, contact *models.Contact) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil || !contact.EncryptedEmail.Valid {
+		return nil
+	}
+
+	decrypted, err := r.encryptionSvc.Decrypt(ctx, contact.EncryptedEmail.String)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt contact email: %w", err)
+	}
+	contact.Email = decrypted
+
+	return nil
+}
+
+// decryptContactEmails fills the emails of contacts from the encrypted column
+func (r *ContactRepository) decryptContactEmails(ctx context.Context, contacts []*models.Contact) error {
+	for _, contact := range contacts {
+		if err := r.decryptContactEmail(ctx, contact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emailHash returns the hash stored for email, or NULL when encryption is disabled
+func (r *ContactRepository) emailHash(email string) pgtype.Text {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: r.encryptionSvc.HashEmail(email), Valid: true}
+}
+
+// conflictTarget is the unique index an email is checked against
+func (r *ContactRepository) conflictTarget() string {
+	if r.encryptionEnabled {
+		return `(owner_id, email_hash) WHERE email_hash IS NOT NULL`
+	}
+	return `(owner_id, email)`
+}
+
+// encryptLegacyContact encrypts in place the owner's contact with email when it
+// was stored in plaintext before encryption was enabled
+func encryptLegacyContact(ctx context.Context, tx *sqlx.Tx, ownerID pgtype.UUID, email string, encrypted, hash pgtype.Text) error {
+	if !hash.Valid {
+		return nil
+	}
+
+	query := `
+		UPDATE contacts SET email = NULL, encrypted_email = $3, email_hash = $4
+		WHERE owner_id = $1 AND email_hash IS NULL AND email = $2
+	`
+	if _, err := tx.ExecContext(ctx, query, ownerID, email, encrypted, hash); err != nil {
+		return fmt.Errorf("failed to encrypt contact: %w", err)
+	}
+	return nil
+}
+
+// Create saves a contact. The owner's row is locked while counting so that
+// concurrent requests cannot exceed limit.
+func (r *ContactRepository) Create(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackContactTx(ctx, tx)
+
+	count, err := lockAndCountContacts(ctx, tx, contact.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= limit {
+		return nil, ErrContactLimitReached
+	}
+
+	email, encryptedEmail, emailHash, err := r.encryptContactEmail(ctx, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := encryptLegacyContact(ctx, tx, contact.OwnerID, contact.Email, encryptedEmail, emailHash); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO contacts (owner_id, name, email, encrypted_email, email_hash, relationship)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT ` + r.conflictTarget() + ` DO NOTHING
+		RETURNING ` + contactColumns
+
+	var created models.Contact
+	err = tx.QueryRowxContext(ctx, query,
+		contact.OwnerID, contact.Name, email, encryptedEmail, emailHash, contact.Relationship,
+	).StructScan(&created)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrContactExists
+		}
+		return nil, fmt.Errorf("failed to create contact: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit contact: %w", err)
+	}
+	if err := r.decryptContactEmail(ctx, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves one of the owner's contacts
+func (r *ContactRepository) GetByID(ctx context.Context, id, ownerID pgtype.UUID) (*models.Contact, error) {
+	query := `SELECT ` + contactColumns + ` FROM contacts WHERE id = $1 AND owner_id = $2`
+
+	var contact models.Contact
+	if err := r.db.GetContext(ctx, &contact, query, id, ownerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrContactNotFound
+		}
+		return nil, fmt.Errorf("failed to get contact: %w", err)
+	}
+	if err := r.decryptContactEmail(ctx, &contact); err != nil {
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+// ListByOwner returns the owner's contacts by name. A non-empty relationship
+// only returns the contacts in that group.
+func (r *ContactRepository) ListByOwner(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*models.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE owner_id = $1 AND ($2::text = '' OR relationship = $2)
+		ORDER BY LOWER(name), email, id
+	`
+
+	var contacts []*models.Contact
+	if err := r.db.SelectContext(ctx, &contacts, query, ownerID, relationship); err != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", err)
+	}
+	if err := r.decryptContactEmails(ctx, contacts); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// ListGroups returns the relationships the owner's contacts are saved with and
+// how many contacts each has, by name
+func (r *ContactRepository) ListGroups(ctx context.Context, ownerID pgtype.UUID) ([]*models.ContactGroup, error) {
+	query := `
+		SELECT relationship, COUNT(*) AS count
+		FROM contacts
+		WHERE owner_id = $1 AND relationship IS NOT NULL
+		GROUP BY relationship
+		ORDER BY relationship
+	`
+
+	var groups []*models.ContactGroup
+	if err := r.db.SelectContext(ctx, &groups, query, ownerID); err != nil {
+		return nil, fmt.Errorf("failed to list contact groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// Update replaces a contact's details. It returns ErrContactExists when another
+// of the owner's contacts already has the new email.
+func (r *ContactRepository) Update(ctx context.Context, contact models.Contact) (*models.Contact, error) {
+	email, encryptedEmail, emailHash, err := r.encryptContactEmail(ctx, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE contacts SET
+			name = $3,
+			email = $4,
+			encrypted_email = $5,
+			email_hash = $6,
+			relationship = $7,
+			updated_at = NOW()
+		WHERE id = $1 AND owner_id = $2
+			AND NOT EXISTS (
+				SELECT 1 FROM contacts other
+				WHERE other.owner_id = $2 AND (other.email = $8 OR other.email_hash = $6) AND other.id <> $1
+			)
+		RETURNING ` + contactColumns
+
+	var updated models.Contact
+	err = r.db.QueryRowxContext(ctx, query,
+		contact.ID, contact.OwnerID, contact.Name, email, encryptedEmail, emailHash, contact.Relationship, contact.Email,
+	).StructScan(&updated)
+	if err == nil {
+		if err := r.decryptContactEmail(ctx, &updated); err != nil {
+			return nil, err
+		}
+		return &updated, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to update contact: %w", err)
+	}
+
+	// Nothing updated: either the contact is gone or the email is taken
+	if _, err := r.GetByID(ctx, contact.ID, contact.OwnerID); err != nil {
+		return nil, err
+	}
+	return nil, ErrContactExists
+}
+
+// Delete removes one of the owner's contacts
+func (r *ContactRepository) Delete(ctx context.Context, id, ownerID pgtype.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM contacts WHERE id = $1 AND owner_id = $2`, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete contact: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrContactNotFound
+	}
+
+	return nil
+}
+
+// Import saves contacts in one transaction, updating the name and relationship
+// of those whose email is already saved. A contact without a relationship keeps
+// the one it had. Nothing is saved when the owner would end up with more than
+// limit contacts. Returns how many contacts were new.
+func (r *ContactRepository) Import(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackContactTx(ctx, tx)
+
+	before, err := lockAndCountContacts(ctx, tx, ownerID)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		INSERT INTO contacts (owner_id, name, email, encrypted_email, email_hash, relationship)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT ` + r.conflictTarget() + ` DO UPDATE SET
+			name = EXCLUDED.name,
+			relationship = COALESCE(EXCLUDED.relationship, contacts.relationship),
+			updated_at = NOW()
+	`
+	for _, contact := range contacts {
+		email, encryptedEmail, emailHash, err := r.encryptContactEmail(ctx, contact.Email)
+		if err != nil {
+			return 0, err
+		}
+		if err := encryptLegacyContact(ctx, tx, ownerID, contact.Email, encryptedEmail, emailHash); err != nil {
+			return 0, err
+		}
+		if _, err := tx.ExecContext(ctx, query, ownerID, contact.Name, email, encryptedEmail, emailHash, contact.Relationship); err != nil {
+			return 0, fmt.Errorf("failed to import contact: %w", err)
+		}
+	}
+
+	var after int
+	if err := tx.GetContext(ctx, &after, `SELECT COUNT(*) FROM contacts WHERE owner_id = $1`, ownerID); err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+	if after > limit {
+		return 0, ErrContactLimitReached
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit contact import: %w", err)
+	}
+
+	return after - before, nil
+}
+
+// ListEmailsByRelationships returns the emails of the owner's contacts saved with
+// any of the relationships
+func (r *ContactRepository) ListEmailsByRelationships(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+	if len(relationships) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(
+		`SELECT `+contactColumns+`
+		 FROM contacts
+		 WHERE owner_id = ? AND relationship IN (?)
+		 ORDER BY LOWER(name), email, id`,
+		ownerID, relationships,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build contact emails query: %w", err)
+	}
+	query = r.db.Rebind(query)
+
+	var contacts []*models.Contact
+	if err := r.db.SelectContext(ctx, &contacts, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list contact emails: %w", err)
+	}
+	if err := r.decryptContactEmails(ctx, contacts); err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, len(contacts))
+	for i, contact := range contacts {
+		emails[i] = contact.Email
+	}
+
+	return emails, nil
+}
+
+// ListGuestContactsByEmail returns the contacts any owner saved with the email,
+// oldest first
+func (r *ContactRepository) ListGuestContactsByEmail(ctx context.Context, guestEmail string) ([]*models.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE email = LOWER($1) OR email_hash = $2
+		ORDER BY created_at ASC
+	`
+
+	var contacts []*models.Contact
+	if err := r.db.SelectContext(ctx, &contacts, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail)); err != nil {
+		return nil, fmt.Errorf("failed to list guest contacts: %w", err)
+	}
+	if err := r.decryptContactEmails(ctx, contacts); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// DeleteGuestContactsByEmail deletes the contacts any owner saved with the email
+// and returns how many were removed
+func (r *ContactRepository) DeleteGuestContactsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	query := `DELETE FROM contacts WHERE email = LOWER($1) OR email_hash = $2`
+
+	result, err := r.db.ExecContext(ctx, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete guest contacts: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// lockAndCountContacts locks the owner's row, serializing changes to how many
+// contacts they have, and counts them
+func lockAndCountContacts(ctx context.Context, tx *sqlx.Tx, ownerID pgtype.UUID) (int, error) {
+	var locked pgtype.UUID
+	if err := tx.GetContext(ctx, &locked, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, ownerID); err != nil {
+		return 0, fmt.Errorf("failed to lock contact owner: %w", err)
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM contacts WHERE owner_id = $1`, ownerID); err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+
+	return count, nil
+}
+
+func rollbackContactTx(ctx context.Context, tx *sqlx.Tx) {
+	if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+		logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+	}
+}
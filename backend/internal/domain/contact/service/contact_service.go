@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"wish-list/internal/domain/contact/models"
+	"wish-list/internal/domain/contact/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// MaxContacts is how many contacts a user can save
+	MaxContacts = 1000
+	// MaxImportBytes is the largest CSV file accepted by ImportContacts
+	MaxImportBytes = 1 << 20
+
+	maxNameLength         = 100
+	maxEmailLength        = 255
+	maxRelationshipLength = 50
+)
+
+var (
+	ErrInvalidContactID    = errors.New("invalid contact id")
+	ErrInvalidContact      = errors.New("contact needs a name and a valid email")
+	ErrContactNotFound     = errors.New("contact not found")
+	ErrContactExists       = errors.New("a contact with this email already exists")
+	ErrContactLimitReached = errors.New("contact limit reached")
+	ErrInvalidContactsCSV  = errors.New("invalid contacts CSV")
+)
+
+// ContactServiceInterface defines the interface for address book operations
+type ContactServiceInterface interface {
+	ListContacts(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*ContactOutput, error)
+	ListGroups(ctx context.Context, ownerID pgtype.UUID) ([]*GroupOutput, error)
+	CreateContact(ctx context.Context, ownerID pgtype.UUID, input ContactInput) (*ContactOutput, error)
+	UpdateContact(ctx context.Context, ownerID pgtype.UUID, contactID string, input ContactInput) (*ContactOutput, error)
+	DeleteContact(ctx context.Context, ownerID pgtype.UUID, contactID string) error
+	ImportContacts(ctx context.Context, ownerID pgtype.UUID, csvFile io.Reader) (*ImportOutput, error)
+}
+
+// ContactService keeps the people a user invites often, so invites can be sent
+// to a saved group instead of typing addresses each time
+type ContactService struct {
+	repo repository.ContactRepositoryInterface
+}
+
+func NewContactService(repo repository.ContactRepositoryInterface) *ContactService {
+	return &ContactService{
+		repo: repo,
+	}
+}
+
+type ContactInput struct {
+	Name         string
+	Email        string
+	Relationship string // Optional, e.g. "family"
+}
+
+type ContactOutput struct {
+	ID           string
+	Name         string
+	Email        string
+	Relationship string // Empty when not set
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// GroupOutput is a relationship contacts are saved with
+type GroupOutput struct {
+	Relationship string
+	Count        int
+}
+
+// ImportOutput reports a CSV import
+type ImportOutput struct {
+	Created  int
+	Updated  int
+	Rejected []RejectedRow // Rows left out, the others were saved
+}
+
+// RejectedRow is a CSV row that could not be imported
+type RejectedRow struct {
+	Line   int
+	Reason string
+}
+
+// ListContacts returns the user's contacts by name, only those in the group
+// when relationship is set
+func (s *ContactService) ListContacts(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*ContactOutput, error) {
+	contacts, err := s.repo.ListByOwner(ctx, ownerID, normalizeRelationship(relationship))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", err)
+	}
+
+	outputs := make([]*ContactOutput, len(contacts))
+	for i, contact := range contacts {
+		outputs[i] = toContactOutput(contact)
+	}
+
+	return outputs, nil
+}
+
+// ListGroups returns the relationships the user's contacts are saved with
+func (s *ContactService) ListGroups(ctx context.Context, ownerID pgtype.UUID) ([]*GroupOutput, error) {
+	groups, err := s.repo.ListGroups(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact groups: %w", err)
+	}
+
+	outputs := make([]*GroupOutput, len(groups))
+	for i, group := range groups {
+		outputs[i] = &GroupOutput{Relationship: group.Relationship, Count: group.Count}
+	}
+
+	return outputs, nil
+}
+
+// CreateContact saves a contact. Emails are unique per user, ignoring case.
+func (s *ContactService) CreateContact(ctx context.Context, ownerID pgtype.UUID, input ContactInput) (*ContactOutput, error) {
+	contact, err := toContact(input)
+	if err != nil {
+		return nil, err
+	}
+	contact.OwnerID = ownerID
+
+	created, err := s.repo.Create(ctx, contact, MaxContacts)
+	if err != nil {
+		return nil, mapRepositoryError(err, "failed to create contact")
+	}
+
+	return toContactOutput(created), nil
+}
+
+// UpdateContact replaces the details of one of the user's contacts
+func (s *ContactService) UpdateContact(ctx context.Context, ownerID pgtype.UUID, contactID string, input ContactInput) (*ContactOutput, error) {
+	id, err := parseContactID(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := toContact(input)
+	if err != nil {
+		return nil, err
+	}
+	contact.ID = id
+	contact.OwnerID = ownerID
+
+	updated, err := s.repo.Update(ctx, contact)
+	if err != nil {
+		return nil, mapRepositoryError(err, "failed to update contact")
+	}
+
+	return toContactOutput(updated), nil
+}
+
+// DeleteContact removes one of the user's contacts
+func (s *ContactService) DeleteContact(ctx context.Context, ownerID pgtype.UUID, contactID string) error {
+	id, err := parseContactID(contactID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, ownerID); err != nil {
+		return mapRepositoryError(err, "failed to delete contact")
+	}
+
+	return nil
+}
+
+// ImportContacts saves the contacts in a CSV file with a header row naming the
+// name, email and, optionally, relationship columns. Contacts whose email is
+// already saved are updated, keeping their relationship when the row has none.
+// Invalid rows are reported and left out; when an email appears more than once,
+// its last row wins.
+func (s *ContactService) ImportContacts(ctx context.Context, ownerID pgtype.UUID, csvFile io.Reader) (*ImportOutput, error) {
+	contacts, rejected, err := parseContactsCSV(csvFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(contacts) > MaxContacts {
+		return nil, ErrContactLimitReached
+	}
+
+	created, err := s.repo.Import(ctx, ownerID, contacts, MaxContacts)
+	if err != nil {
+		return nil, mapRepositoryError(err, "failed to import contacts")
+	}
+
+	return &ImportOutput{
+		Created:  created,
+		Updated:  len(contacts) - created,
+		Rejected: rejected,
+	}, nil
+}
+
+// parseContactsCSV reads the contacts from a CSV file, deduplicated by email in
+// file order
+func parseContactsCSV(csvFile io.Reader) ([]models.Contact, []RejectedRow, error) {
+	reader := csv.NewReader(csvFile)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: missing header row", ErrInvalidContactsCSV)
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))) // Excel adds a BOM
+		if _, seen := columns[column]; !seen {
+			columns[column] = i
+		}
+	}
+	nameCol, hasName := columns["name"]
+	emailCol, hasEmail := columns["email"]
+	relationshipCol, hasRelationship := columns["relationship"]
+	if !hasName || !hasEmail {
+		return nil, nil, fmt.Errorf("%w: header must have name and email columns", ErrInvalidContactsCSV)
+	}
+
+	field := func(record []string, col int) string {
+		if col < len(record) {
+			return record[col]
+		}
+		return ""
+	}
+
+	var contacts []models.Contact
+	var rejected []RejectedRow
+	index := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidContactsCSV, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		input := ContactInput{
+			Name:  field(record, nameCol),
+			Email: field(record, emailCol),
+		}
+		if hasRelationship {
+			input.Relationship = field(record, relationshipCol)
+		}
+
+		contact, err := toContact(input)
+		if err != nil {
+			rejected = append(rejected, RejectedRow{Line: line, Reason: err.Error()})
+			continue
+		}
+		if i, ok := index[contact.Email]; ok {
+			if !contact.Relationship.Valid {
+				contact.Relationship = contacts[i].Relationship
+			}
+			contacts[i] = contact
+			continue
+		}
+		index[contact.Email] = len(contacts)
+		contacts = append(contacts, contact)
+	}
+
+	return contacts, rejected, nil
+}
+
+// toContact validates and normalizes a contact. Emails and relationships are
+// lowercased so that they match however they were typed.
+func toContact(input ContactInput) (models.Contact, error) {
+	name := strings.TrimSpace(input.Name)
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	relationship := normalizeRelationship(input.Relationship)
+
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength ||
+		len(email) > maxEmailLength || utf8.RuneCountInString(relationship) > maxRelationshipLength {
+		return models.Contact{}, ErrInvalidContact
+	}
+	// Only bare addresses, not "Name <address>"
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return models.Contact{}, ErrInvalidContact
+	}
+
+	contact := models.Contact{Name: name, Email: email}
+	if relationship != "" {
+		contact.Relationship = pgtype.Text{String: relationship, Valid: true}
+	}
+	return contact, nil
+}
+
+func normalizeRelationship(relationship string) string {
+	return strings.ToLower(strings.TrimSpace(relationship))
+}
+
+func parseContactID(contactID string) (pgtype.UUID, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(contactID); err != nil {
+		return id, ErrInvalidContactID
+	}
+	return id, nil
+}
+
+func mapRepositoryError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrContactNotFound):
+		return ErrContactNotFound
+	case errors.Is(err, repository.ErrContactExists):
+		return ErrContactExists
+	case errors.Is(err, repository.ErrContactLimitReached):
+		return ErrContactLimitReached
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
+
+func toContactOutput(contact *models.Contact) *ContactOutput {
+	return &ContactOutput{
+		ID:           contact.ID.String(),
+		Name:         contact.Name,
+		Email:        contact.Email,
+		Relationship: contact.Relationship.String,
+		CreatedAt:    contact.CreatedAt.Time,
+		UpdatedAt:    contact.UpdatedAt.Time,
+	}
+}
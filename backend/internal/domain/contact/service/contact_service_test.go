@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"wish-list/internal/domain/contact/models"
+	"wish-list/internal/domain/contact/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testOwnerID   = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testContactID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+)
+
+func TestContactService_CreateContact(t *testing.T) {
+	t.Run("normalizes the contact", func(t *testing.T) {
+		repo := &ContactRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error) {
+				contact.ID = testContactID
+				return &contact, nil
+			},
+		}
+
+		contact, err := NewContactService(repo).CreateContact(context.Background(), testOwnerID, ContactInput{
+			Name:         " Aunt May ",
+			Email:        "May@Example.com",
+			Relationship: " Family",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &ContactOutput{
+			ID:           testContactID.String(),
+			Name:         "Aunt May",
+			Email:        "may@example.com",
+			Relationship: "family",
+		}, contact)
+		require.Len(t, repo.CreateCalls(), 1)
+		assert.Equal(t, testOwnerID, repo.CreateCalls()[0].Contact.OwnerID)
+		assert.Equal(t, MaxContacts, repo.CreateCalls()[0].Limit)
+	})
+
+	tests := []struct {
+		name    string
+		input   ContactInput
+		repoErr error
+		wantErr error
+	}{
+		{"missing name", ContactInput{Name: " ", Email: "may@example.com"}, nil, ErrInvalidContact},
+		{"invalid email", ContactInput{Name: "May", Email: "May <may@example.com>"}, nil, ErrInvalidContact},
+		{"long relationship", ContactInput{Name: "May", Email: "may@example.com", Relationship: strings.Repeat("x", 51)}, nil, ErrInvalidContact},
+		{"saved email", ContactInput{Name: "May", Email: "may@example.com"}, repository.ErrContactExists, ErrContactExists},
+		{"limit", ContactInput{Name: "May", Email: "may@example.com"}, repository.ErrContactLimitReached, ErrContactLimitReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &ContactRepositoryInterfaceMock{
+				CreateFunc: func(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error) {
+					return nil, tt.repoErr
+				},
+			}
+
+			_, err := NewContactService(repo).CreateContact(context.Background(), testOwnerID, tt.input)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestContactService_UpdateContact(t *testing.T) {
+	repo := &ContactRepositoryInterfaceMock{
+		UpdateFunc: func(ctx context.Context, contact models.Contact) (*models.Contact, error) {
+			return nil, repository.ErrContactNotFound
+		},
+	}
+	svc := NewContactService(repo)
+	input := ContactInput{Name: "May", Email: "may@example.com"}
+
+	_, err := svc.UpdateContact(context.Background(), testOwnerID, "nope", input)
+	assert.ErrorIs(t, err, ErrInvalidContactID)
+
+	_, err = svc.UpdateContact(context.Background(), testOwnerID, testContactID.String(), input)
+	assert.ErrorIs(t, err, ErrContactNotFound)
+	require.Len(t, repo.UpdateCalls(), 1)
+	assert.Equal(t, testContactID, repo.UpdateCalls()[0].Contact.ID)
+}
+
+func TestContactService_ImportContacts(t *testing.T) {
+	t.Run("saves valid rows and reports the others", func(t *testing.T) {
+		repo := &ContactRepositoryInterfaceMock{
+			ImportFunc: func(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error) {
+				return 1, nil
+			},
+		}
+		csv := "\ufeffEmail,Name,Relationship\n" +
+			"may@example.com,Aunt May,Family\n" +
+			"not-an-email,Ben,\n" +
+			"ned@example.com,Ned,friend\n" +
+			"MAY@example.com,May Parker\n"
+
+		result, err := NewContactService(repo).ImportContacts(context.Background(), testOwnerID, strings.NewReader(csv))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Updated)
+		assert.Equal(t, []RejectedRow{{Line: 3, Reason: ErrInvalidContact.Error()}}, result.Rejected)
+
+		require.Len(t, repo.ImportCalls(), 1)
+		assert.Equal(t, []models.Contact{
+			{Name: "May Parker", Email: "may@example.com", Relationship: pgtype.Text{String: "family", Valid: true}},
+			{Name: "Ned", Email: "ned@example.com", Relationship: pgtype.Text{String: "friend", Valid: true}},
+		}, repo.ImportCalls()[0].Contacts)
+	})
+
+	t.Run("header without email column", func(t *testing.T) {
+		repo := &ContactRepositoryInterfaceMock{}
+
+		_, err := NewContactService(repo).ImportContacts(context.Background(), testOwnerID, strings.NewReader("name,phone\nMay,555\n"))
+
+		assert.ErrorIs(t, err, ErrInvalidContactsCSV)
+		assert.Empty(t, repo.ImportCalls())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		repo := &ContactRepositoryInterfaceMock{
+			ImportFunc: func(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error) {
+				return 0, repository.ErrContactLimitReached
+			},
+		}
+
+		_, err := NewContactService(repo).ImportContacts(context.Background(), testOwnerID, strings.NewReader("name,email\nMay,may@example.com\n"))
+
+		assert.ErrorIs(t, err, ErrContactLimitReached)
+	})
+}
@@ -0,0 +1,576 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/contact/models"
+	"wish-list/internal/domain/contact/repository"
+)
+
+// Ensure, that ContactRepositoryInterfaceMock does implement repository.ContactRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.ContactRepositoryInterface = &ContactRepositoryInterfaceMock{}
+
+// ContactRepositoryInterfaceMock is a mock implementation of repository.ContactRepositoryInterface.
+//
+//	func TestSomethingThatUsesContactRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.ContactRepositoryInterface
+//		mockedContactRepositoryInterface := &ContactRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestContactsByEmail method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) (*models.Contact, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ImportFunc: func(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error) {
+//				panic("mock out the Import method")
+//			},
+//			ListByOwnerFunc: func(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*models.Contact, error) {
+//				panic("mock out the ListByOwner method")
+//			},
+//			ListEmailsByRelationshipsFunc: func(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+//				panic("mock out the ListEmailsByRelationships method")
+//			},
+//			ListGroupsFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.ContactGroup, error) {
+//				panic("mock out the ListGroups method")
+//			},
+//			ListGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*models.Contact, error) {
+//				panic("mock out the ListGuestContactsByEmail method")
+//			},
+//			UpdateFunc: func(ctx context.Context, contact models.Contact) (*models.Contact, error) {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedContactRepositoryInterface in code that requires repository.ContactRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type ContactRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) error
+
+	// DeleteGuestContactsByEmailFunc mocks the DeleteGuestContactsByEmail method.
+	DeleteGuestContactsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) (*models.Contact, error)
+
+	// ImportFunc mocks the Import method.
+	ImportFunc func(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error)
+
+	// ListByOwnerFunc mocks the ListByOwner method.
+	ListByOwnerFunc func(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*models.Contact, error)
+
+	// ListEmailsByRelationshipsFunc mocks the ListEmailsByRelationships method.
+	ListEmailsByRelationshipsFunc func(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error)
+
+	// ListGroupsFunc mocks the ListGroups method.
+	ListGroupsFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.ContactGroup, error)
+
+	// ListGuestContactsByEmailFunc mocks the ListGuestContactsByEmail method.
+	ListGuestContactsByEmailFunc func(ctx context.Context, guestEmail string) ([]*models.Contact, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, contact models.Contact) (*models.Contact, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Contact is the contact argument value.
+			Contact models.Contact
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// DeleteGuestContactsByEmail holds details about calls to the DeleteGuestContactsByEmail method.
+		DeleteGuestContactsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// Import holds details about calls to the Import method.
+		Import []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Contacts is the contacts argument value.
+			Contacts []models.Contact
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListByOwner holds details about calls to the ListByOwner method.
+		ListByOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Relationship is the relationship argument value.
+			Relationship string
+		}
+		// ListEmailsByRelationships holds details about calls to the ListEmailsByRelationships method.
+		ListEmailsByRelationships []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Relationships is the relationships argument value.
+			Relationships []string
+		}
+		// ListGroups holds details about calls to the ListGroups method.
+		ListGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// ListGuestContactsByEmail holds details about calls to the ListGuestContactsByEmail method.
+		ListGuestContactsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Contact is the contact argument value.
+			Contact models.Contact
+		}
+	}
+	lockCreate                     sync.RWMutex
+	lockDelete                     sync.RWMutex
+	lockDeleteGuestContactsByEmail sync.RWMutex
+	lockGetByID                    sync.RWMutex
+	lockImport                     sync.RWMutex
+	lockListByOwner                sync.RWMutex
+	lockListEmailsByRelationships  sync.RWMutex
+	lockListGroups                 sync.RWMutex
+	lockListGuestContactsByEmail   sync.RWMutex
+	lockUpdate                     sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *ContactRepositoryInterfaceMock) Create(ctx context.Context, contact models.Contact, limit int) (*models.Contact, error) {
+	if mock.CreateFunc == nil {
+		panic("ContactRepositoryInterfaceMock.CreateFunc: method is nil but ContactRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Contact models.Contact
+		Limit   int
+	}{
+		Ctx:     ctx,
+		Contact: contact,
+		Limit:   limit,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, contact, limit)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.CreateCalls())
+func (mock *ContactRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx     context.Context
+	Contact models.Contact
+	Limit   int
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Contact models.Contact
+		Limit   int
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ContactRepositoryInterfaceMock) Delete(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("ContactRepositoryInterfaceMock.DeleteFunc: method is nil but ContactRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		OwnerID: ownerID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id, ownerID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.DeleteCalls())
+func (mock *ContactRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx     context.Context
+	ID      pgtype.UUID
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		OwnerID pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// DeleteGuestContactsByEmail calls DeleteGuestContactsByEmailFunc.
+func (mock *ContactRepositoryInterfaceMock) DeleteGuestContactsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestContactsByEmailFunc == nil {
+		panic("ContactRepositoryInterfaceMock.DeleteGuestContactsByEmailFunc: method is nil but ContactRepositoryInterface.DeleteGuestContactsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestContactsByEmail.Lock()
+	mock.calls.DeleteGuestContactsByEmail = append(mock.calls.DeleteGuestContactsByEmail, callInfo)
+	mock.lockDeleteGuestContactsByEmail.Unlock()
+	return mock.DeleteGuestContactsByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestContactsByEmailCalls gets all the calls that were made to DeleteGuestContactsByEmail.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.DeleteGuestContactsByEmailCalls())
+func (mock *ContactRepositoryInterfaceMock) DeleteGuestContactsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestContactsByEmail.RLock()
+	calls = mock.calls.DeleteGuestContactsByEmail
+	mock.lockDeleteGuestContactsByEmail.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *ContactRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID, ownerID pgtype.UUID) (*models.Contact, error) {
+	if mock.GetByIDFunc == nil {
+		panic("ContactRepositoryInterfaceMock.GetByIDFunc: method is nil but ContactRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		OwnerID: ownerID,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id, ownerID)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.GetByIDCalls())
+func (mock *ContactRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx     context.Context
+	ID      pgtype.UUID
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		OwnerID pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Import calls ImportFunc.
+func (mock *ContactRepositoryInterfaceMock) Import(ctx context.Context, ownerID pgtype.UUID, contacts []models.Contact, limit int) (int, error) {
+	if mock.ImportFunc == nil {
+		panic("ContactRepositoryInterfaceMock.ImportFunc: method is nil but ContactRepositoryInterface.Import was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		OwnerID  pgtype.UUID
+		Contacts []models.Contact
+		Limit    int
+	}{
+		Ctx:      ctx,
+		OwnerID:  ownerID,
+		Contacts: contacts,
+		Limit:    limit,
+	}
+	mock.lockImport.Lock()
+	mock.calls.Import = append(mock.calls.Import, callInfo)
+	mock.lockImport.Unlock()
+	return mock.ImportFunc(ctx, ownerID, contacts, limit)
+}
+
+// ImportCalls gets all the calls that were made to Import.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.ImportCalls())
+func (mock *ContactRepositoryInterfaceMock) ImportCalls() []struct {
+	Ctx      context.Context
+	OwnerID  pgtype.UUID
+	Contacts []models.Contact
+	Limit    int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		OwnerID  pgtype.UUID
+		Contacts []models.Contact
+		Limit    int
+	}
+	mock.lockImport.RLock()
+	calls = mock.calls.Import
+	mock.lockImport.RUnlock()
+	return calls
+}
+
+// ListByOwner calls ListByOwnerFunc.
+func (mock *ContactRepositoryInterfaceMock) ListByOwner(ctx context.Context, ownerID pgtype.UUID, relationship string) ([]*models.Contact, error) {
+	if mock.ListByOwnerFunc == nil {
+		panic("ContactRepositoryInterfaceMock.ListByOwnerFunc: method is nil but ContactRepositoryInterface.ListByOwner was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		OwnerID      pgtype.UUID
+		Relationship string
+	}{
+		Ctx:          ctx,
+		OwnerID:      ownerID,
+		Relationship: relationship,
+	}
+	mock.lockListByOwner.Lock()
+	mock.calls.ListByOwner = append(mock.calls.ListByOwner, callInfo)
+	mock.lockListByOwner.Unlock()
+	return mock.ListByOwnerFunc(ctx, ownerID, relationship)
+}
+
+// ListByOwnerCalls gets all the calls that were made to ListByOwner.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.ListByOwnerCalls())
+func (mock *ContactRepositoryInterfaceMock) ListByOwnerCalls() []struct {
+	Ctx          context.Context
+	OwnerID      pgtype.UUID
+	Relationship string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		OwnerID      pgtype.UUID
+		Relationship string
+	}
+	mock.lockListByOwner.RLock()
+	calls = mock.calls.ListByOwner
+	mock.lockListByOwner.RUnlock()
+	return calls
+}
+
+// ListEmailsByRelationships calls ListEmailsByRelationshipsFunc.
+func (mock *ContactRepositoryInterfaceMock) ListEmailsByRelationships(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+	if mock.ListEmailsByRelationshipsFunc == nil {
+		panic("ContactRepositoryInterfaceMock.ListEmailsByRelationshipsFunc: method is nil but ContactRepositoryInterface.ListEmailsByRelationships was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		OwnerID       pgtype.UUID
+		Relationships []string
+	}{
+		Ctx:           ctx,
+		OwnerID:       ownerID,
+		Relationships: relationships,
+	}
+	mock.lockListEmailsByRelationships.Lock()
+	mock.calls.ListEmailsByRelationships = append(mock.calls.ListEmailsByRelationships, callInfo)
+	mock.lockListEmailsByRelationships.Unlock()
+	return mock.ListEmailsByRelationshipsFunc(ctx, ownerID, relationships)
+}
+
+// ListEmailsByRelationshipsCalls gets all the calls that were made to ListEmailsByRelationships.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.ListEmailsByRelationshipsCalls())
+func (mock *ContactRepositoryInterfaceMock) ListEmailsByRelationshipsCalls() []struct {
+	Ctx           context.Context
+	OwnerID       pgtype.UUID
+	Relationships []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		OwnerID       pgtype.UUID
+		Relationships []string
+	}
+	mock.lockListEmailsByRelationships.RLock()
+	calls = mock.calls.ListEmailsByRelationships
+	mock.lockListEmailsByRelationships.RUnlock()
+	return calls
+}
+
+// ListGroups calls ListGroupsFunc.
+func (mock *ContactRepositoryInterfaceMock) ListGroups(ctx context.Context, ownerID pgtype.UUID) ([]*models.ContactGroup, error) {
+	if mock.ListGroupsFunc == nil {
+		panic("ContactRepositoryInterfaceMock.ListGroupsFunc: method is nil but ContactRepositoryInterface.ListGroups was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockListGroups.Lock()
+	mock.calls.ListGroups = append(mock.calls.ListGroups, callInfo)
+	mock.lockListGroups.Unlock()
+	return mock.ListGroupsFunc(ctx, ownerID)
+}
+
+// ListGroupsCalls gets all the calls that were made to ListGroups.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.ListGroupsCalls())
+func (mock *ContactRepositoryInterfaceMock) ListGroupsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}
+	mock.lockListGroups.RLock()
+	calls = mock.calls.ListGroups
+	mock.lockListGroups.RUnlock()
+	return calls
+}
+
+// ListGuestContactsByEmail calls ListGuestContactsByEmailFunc.
+func (mock *ContactRepositoryInterfaceMock) ListGuestContactsByEmail(ctx context.Context, guestEmail string) ([]*models.Contact, error) {
+	if mock.ListGuestContactsByEmailFunc == nil {
+		panic("ContactRepositoryInterfaceMock.ListGuestContactsByEmailFunc: method is nil but ContactRepositoryInterface.ListGuestContactsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestContactsByEmail.Lock()
+	mock.calls.ListGuestContactsByEmail = append(mock.calls.ListGuestContactsByEmail, callInfo)
+	mock.lockListGuestContactsByEmail.Unlock()
+	return mock.ListGuestContactsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestContactsByEmailCalls gets all the calls that were made to ListGuestContactsByEmail.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.ListGuestContactsByEmailCalls())
+func (mock *ContactRepositoryInterfaceMock) ListGuestContactsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestContactsByEmail.RLock()
+	calls = mock.calls.ListGuestContactsByEmail
+	mock.lockListGuestContactsByEmail.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *ContactRepositoryInterfaceMock) Update(ctx context.Context, contact models.Contact) (*models.Contact, error) {
+	if mock.UpdateFunc == nil {
+		panic("ContactRepositoryInterfaceMock.UpdateFunc: method is nil but ContactRepositoryInterface.Update was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Contact models.Contact
+	}{
+		Ctx:     ctx,
+		Contact: contact,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, contact)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedContactRepositoryInterface.UpdateCalls())
+func (mock *ContactRepositoryInterfaceMock) UpdateCalls() []struct {
+	Ctx     context.Context
+	Contact models.Contact
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Contact models.Contact
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
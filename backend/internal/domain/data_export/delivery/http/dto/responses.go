@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"wish-list/internal/domain/data_export/service"
+)
+
+type ExportResponse struct {
+	ID                   string  `json:"id" validate:"required"`
+	Status               string  `json:"status" validate:"required" enums:"pending,processing,completed,failed,expired"`
+	Error                *string `json:"error"`      // Set when the export failed
+	SizeBytes            *int64  `json:"size_bytes"` // Size of the archive once completed
+	CreatedAt            string  `json:"created_at" validate:"required"`
+	CompletedAt          *string `json:"completed_at"`
+	ExpiresAt            *string `json:"expires_at"`   // When the archive is deleted
+	DownloadURL          *string `json:"download_url"` // Time-limited link; poll again for a fresh one
+	DownloadURLExpiresAt *string `json:"download_url_expires_at"`
+}
+
+type ExportListResponse struct {
+	Data []ExportResponse `json:"data" validate:"required"`
+}
+
+func FromExportOutput(e *service.ExportOutput) ExportResponse {
+	resp := ExportResponse{
+		ID:        e.ID.String(),
+		Status:    e.Status,
+		CreatedAt: e.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if e.Error != "" {
+		resp.Error = &e.Error
+	}
+	if e.SizeBytes > 0 {
+		resp.SizeBytes = &e.SizeBytes
+	}
+	if e.CompletedAt.Valid {
+		completedAt := e.CompletedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.CompletedAt = &completedAt
+	}
+	if e.ExpiresAt.Valid {
+		expiresAt := e.ExpiresAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.ExpiresAt = &expiresAt
+	}
+	if e.DownloadURL != "" {
+		urlExpiresAt := e.DownloadURLExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+		resp.DownloadURL = &e.DownloadURL
+		resp.DownloadURLExpiresAt = &urlExpiresAt
+	}
+	return resp
+}
+
+func FromExportOutputs(exports []*service.ExportOutput) ExportListResponse {
+	data := make([]ExportResponse, 0, len(exports))
+	for _, e := range exports {
+		data = append(data, FromExportOutput(e))
+	}
+	return ExportListResponse{Data: data}
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/data_export/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapDataExportServiceError converts data export service errors to AppErrors
+func mapDataExportServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidExportID):
+		return apperrors.BadRequest("Invalid export ID")
+	case errors.Is(err, service.ErrExportNotFound):
+		return apperrors.NotFound("Export not found")
+	default:
+		return apperrors.Internal("Failed to process data export").Wrap(err)
+	}
+}
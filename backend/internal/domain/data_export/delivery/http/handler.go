@@ -0,0 +1,107 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/data_export/delivery/http/dto"
+	"wish-list/internal/domain/data_export/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for account data exports
+type Handler struct {
+	service service.DataExportServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.DataExportServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// RequestExport godoc
+//
+//	@Summary		Request an account data export
+//	@Description	Queue a ZIP archive of the current user's profile, wishlists, items, reservations, uploaded images and account activity. Poll the export until it is completed, then download the archive from download_url. Only one export runs at a time; while one is queued or running it is returned with status 200 instead of starting another.
+//	@Tags			User
+//	@Produce		json
+//	@Success		202	{object}	dto.ExportResponse	"Export queued"
+//	@Success		200	{object}	dto.ExportResponse	"Export already in progress"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/exports [post]
+func (h *Handler) RequestExport(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	export, created, err := h.service.RequestExport(c.Request().Context(), userID)
+	if err != nil {
+		return mapDataExportServiceError(err)
+	}
+
+	status := nethttp.StatusOK
+	if created {
+		status = nethttp.StatusAccepted
+	}
+	return c.JSON(status, dto.FromExportOutput(export))
+}
+
+// ListExports godoc
+//
+//	@Summary		List account data exports
+//	@Description	The current user's recent data exports, newest first
+//	@Tags			User
+//	@Produce		json
+//	@Success		200	{object}	dto.ExportListResponse	"Recent exports"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/exports [get]
+func (h *Handler) ListExports(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	exports, err := h.service.ListExports(c.Request().Context(), userID)
+	if err != nil {
+		return mapDataExportServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromExportOutputs(exports))
+}
+
+// GetExport godoc
+//
+//	@Summary		Get an account data export
+//	@Description	Status of one of the current user's data exports. A completed export includes a download link that expires shortly; request the export again for a fresh link.
+//	@Tags			User
+//	@Produce		json
+//	@Param			id	path		string				true	"Export ID"
+//	@Success		200	{object}	dto.ExportResponse	"Export status"
+//	@Failure		400	{object}	map[string]string	"Invalid export ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Export not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/exports/{id} [get]
+func (h *Handler) GetExport(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	export, err := h.service.GetExport(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return mapDataExportServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromExportOutput(export))
+}
@@ -0,0 +1,13 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers account data export HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	exports := e.Group("/api/protected/exports", authMiddleware)
+	exports.POST("", h.RequestExport)
+	exports.GET("", h.ListExports)
+	exports.GET("/:id", h.GetExport)
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Export statuses
+const (
+	StatusPending    = "pending"    // Queued for the export job
+	StatusProcessing = "processing" // The archive is being built
+	StatusCompleted  = "completed"  // The archive can be downloaded until ExpiresAt
+	StatusFailed     = "failed"
+	StatusExpired    = "expired" // The archive was deleted
+)
+
+// DataExport is a user's request for an archive of their account data
+type DataExport struct {
+	ID          pgtype.UUID        `db:"id"`
+	UserID      pgtype.UUID        `db:"user_id"`
+	Status      string             `db:"status"`
+	ObjectKey   pgtype.Text        `db:"object_key"`
+	SizeBytes   pgtype.Int8        `db:"size_bytes"`
+	Error       pgtype.Text        `db:"error"`
+	StartedAt   pgtype.Timestamptz `db:"started_at"`
+	CompletedAt pgtype.Timestamptz `db:"completed_at"`
+	ExpiresAt   pgtype.Timestamptz `db:"expires_at"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+}
+
+// IsActive reports whether the export is still queued or running
+func (e *DataExport) IsActive() bool {
+	return e.Status == StatusPending || e.Status == StatusProcessing
+}
@@ -0,0 +1,199 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_data_export_repository_test.go -pkg service . DataExportRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/data_export/models"
+)
+
+const exportColumns = `id, user_id, status, object_key, size_bytes, error, started_at, completed_at, expires_at, created_at`
+
+var (
+	// ErrExportNotFound is returned when the export does not exist or belongs to another user
+	ErrExportNotFound = errors.New("data export not found")
+	// ErrExportInProgress is returned when the user already has an export queued or running
+	ErrExportInProgress = errors.New("data export already in progress")
+)
+
+// DataExportRepositoryInterface defines the interface for data export database operations
+type DataExportRepositoryInterface interface {
+	Create(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error)
+	GetByID(ctx context.Context, id, userID pgtype.UUID) (*models.DataExport, error)
+	GetActiveByUser(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error)
+	ListByUser(ctx context.Context, userID pgtype.UUID, limit int) ([]*models.DataExport, error)
+	ClaimPending(ctx context.Context, staleBefore time.Time, limit int) ([]*models.DataExport, error)
+	Complete(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error
+	Fail(ctx context.Context, id pgtype.UUID, reason string) error
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error)
+	MarkExpired(ctx context.Context, id pgtype.UUID) error
+}
+
+type DataExportRepository struct {
+	db *database.DB
+}
+
+func NewDataExportRepository(db *database.DB) DataExportRepositoryInterface {
+	return &DataExportRepository{
+		db: db,
+	}
+}
+
+// Create queues an export for the user. It returns ErrExportInProgress when one is
+// already queued or running.
+func (r *DataExportRepository) Create(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+	query := `
+		INSERT INTO data_exports (user_id)
+		VALUES ($1)
+		ON CONFLICT (user_id) WHERE status IN ('pending', 'processing') DO NOTHING
+		RETURNING ` + exportColumns
+
+	var export models.DataExport
+	err := r.db.QueryRowxContext(ctx, query, userID).StructScan(&export)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExportInProgress
+		}
+		return nil, fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return &export, nil
+}
+
+// GetByID returns one of the user's exports
+func (r *DataExportRepository) GetByID(ctx context.Context, id, userID pgtype.UUID) (*models.DataExport, error) {
+	query := `SELECT ` + exportColumns + ` FROM data_exports WHERE id = $1 AND user_id = $2`
+
+	var export models.DataExport
+	if err := r.db.GetContext(ctx, &export, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	return &export, nil
+}
+
+// GetActiveByUser returns the user's queued or running export, or ErrExportNotFound
+func (r *DataExportRepository) GetActiveByUser(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+	query := `
+		SELECT ` + exportColumns + `
+		FROM data_exports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+	`
+
+	var export models.DataExport
+	if err := r.db.GetContext(ctx, &export, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to get active data export: %w", err)
+	}
+
+	return &export, nil
+}
+
+// ListByUser returns the user's most recent exports, newest first
+func (r *DataExportRepository) ListByUser(ctx context.Context, userID pgtype.UUID, limit int) ([]*models.DataExport, error) {
+	query := `
+		SELECT ` + exportColumns + `
+		FROM data_exports
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	var exports []*models.DataExport
+	if err := r.db.SelectContext(ctx, &exports, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("failed to list data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// ClaimPending marks up to limit queued exports as processing and returns them.
+// Exports left processing since before staleBefore, e.g. by a crashed instance, are
+// claimed again. Rows claimed by another instance are skipped.
+func (r *DataExportRepository) ClaimPending(ctx context.Context, staleBefore time.Time, limit int) ([]*models.DataExport, error) {
+	query := `
+		UPDATE data_exports SET status = 'processing', started_at = NOW()
+		WHERE id IN (
+			SELECT id FROM data_exports
+			WHERE status = 'pending' OR (status = 'processing' AND started_at < $1)
+			ORDER BY created_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + exportColumns
+
+	var exports []*models.DataExport
+	if err := r.db.SelectContext(ctx, &exports, query, staleBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to claim data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// Complete records the uploaded archive of an export
+func (r *DataExportRepository) Complete(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'completed', object_key = $2, size_bytes = $3, expires_at = $4, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, objectKey, sizeBytes, expiresAt); err != nil {
+		return fmt.Errorf("failed to complete data export: %w", err)
+	}
+
+	return nil
+}
+
+// Fail records that an export could not be built
+func (r *DataExportRepository) Fail(ctx context.Context, id pgtype.UUID, reason string) error {
+	query := `UPDATE data_exports SET status = 'failed', error = $2, completed_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id, reason); err != nil {
+		return fmt.Errorf("failed to mark data export failed: %w", err)
+	}
+
+	return nil
+}
+
+// ListExpired returns completed exports whose archive should be deleted
+func (r *DataExportRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error) {
+	query := `
+		SELECT ` + exportColumns + `
+		FROM data_exports
+		WHERE status = 'completed' AND expires_at <= $1
+		ORDER BY expires_at ASC
+		LIMIT $2
+	`
+
+	var exports []*models.DataExport
+	if err := r.db.SelectContext(ctx, &exports, query, now, limit); err != nil {
+		return nil, fmt.Errorf("failed to list expired data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// MarkExpired records that an export's archive was deleted
+func (r *DataExportRepository) MarkExpired(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE data_exports SET status = 'expired', object_key = NULL WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark data export expired: %w", err)
+	}
+
+	return nil
+}
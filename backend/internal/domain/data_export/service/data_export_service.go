@@ -0,0 +1,163 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . URLSignerInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/data_export/models"
+	"wish-list/internal/domain/data_export/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// recentExportsLimit caps the export history returned to the user
+const recentExportsLimit = 10
+
+var (
+	ErrInvalidExportID = errors.New("invalid export id")
+	ErrExportNotFound  = errors.New("data export not found")
+)
+
+// Cross-domain interfaces — only methods used by DataExportService
+
+// URLSignerInterface creates time-limited download links for stored archives
+type URLSignerInterface interface {
+	GeneratePresignedURL(ctx context.Context, fileKey string, duration time.Duration) (string, error)
+}
+
+// DataExportServiceInterface defines the interface for account data export operations
+type DataExportServiceInterface interface {
+	RequestExport(ctx context.Context, userID pgtype.UUID) (*ExportOutput, bool, error)
+	GetExport(ctx context.Context, userID pgtype.UUID, exportID string) (*ExportOutput, error)
+	ListExports(ctx context.Context, userID pgtype.UUID) ([]*ExportOutput, error)
+}
+
+type DataExportService struct {
+	repo           repository.DataExportRepositoryInterface
+	urlSigner      URLSignerInterface
+	downloadURLTTL time.Duration // Lifetime of the download links handed out
+}
+
+func NewDataExportService(
+	repo repository.DataExportRepositoryInterface,
+	urlSigner URLSignerInterface,
+	downloadURLTTL time.Duration,
+) *DataExportService {
+	return &DataExportService{
+		repo:           repo,
+		urlSigner:      urlSigner,
+		downloadURLTTL: downloadURLTTL,
+	}
+}
+
+// ExportOutput is an export request and, once completed, a link to its archive
+type ExportOutput struct {
+	ID                   pgtype.UUID
+	Status               string
+	Error                string
+	SizeBytes            int64
+	CreatedAt            pgtype.Timestamptz
+	CompletedAt          pgtype.Timestamptz
+	ExpiresAt            pgtype.Timestamptz // When the archive is deleted
+	DownloadURL          string
+	DownloadURLExpiresAt time.Time
+}
+
+// RequestExport queues an archive of the user's data. If an export is already queued
+// or running it is returned instead, and the second result is false.
+func (s *DataExportService) RequestExport(ctx context.Context, userID pgtype.UUID) (*ExportOutput, bool, error) {
+	export, err := s.repo.Create(ctx, userID)
+	if err == nil {
+		return s.toExportOutput(ctx, export), true, nil
+	}
+	if !errors.Is(err, repository.ErrExportInProgress) {
+		return nil, false, fmt.Errorf("failed to request data export: %w", err)
+	}
+
+	export, err = s.repo.GetActiveByUser(ctx, userID)
+	if err != nil {
+		// The running export finished between the two queries; ask again
+		if errors.Is(err, repository.ErrExportNotFound) {
+			return s.RequestExport(ctx, userID)
+		}
+		return nil, false, fmt.Errorf("failed to get active data export: %w", err)
+	}
+
+	return s.toExportOutput(ctx, export), false, nil
+}
+
+// GetExport returns the status of one of the user's exports
+func (s *DataExportService) GetExport(ctx context.Context, userID pgtype.UUID, exportID string) (*ExportOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(exportID); err != nil {
+		return nil, ErrInvalidExportID
+	}
+
+	export, err := s.repo.GetByID(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrExportNotFound) {
+			return nil, ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	return s.toExportOutput(ctx, export), nil
+}
+
+// ListExports returns the user's recent exports, newest first
+func (s *DataExportService) ListExports(ctx context.Context, userID pgtype.UUID) ([]*ExportOutput, error) {
+	exports, err := s.repo.ListByUser(ctx, userID, recentExportsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data exports: %w", err)
+	}
+
+	outputs := make([]*ExportOutput, 0, len(exports))
+	for _, export := range exports {
+		outputs = append(outputs, s.toExportOutput(ctx, export))
+	}
+
+	return outputs, nil
+}
+
+// toExportOutput converts an export, signing a download link for a completed one.
+// A link that cannot be signed is left out so the status can still be polled.
+func (s *DataExportService) toExportOutput(ctx context.Context, export *models.DataExport) *ExportOutput {
+	output := &ExportOutput{
+		ID:          export.ID,
+		Status:      export.Status,
+		Error:       export.Error.String,
+		SizeBytes:   export.SizeBytes.Int64,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+
+	if export.Status != models.StatusCompleted || !export.ObjectKey.Valid {
+		return output
+	}
+
+	// Never hand out a link that outlives the archive
+	now := time.Now()
+	ttl := s.downloadURLTTL
+	if remaining := export.ExpiresAt.Time.Sub(now); remaining < ttl {
+		ttl = remaining
+	}
+	if ttl <= 0 {
+		return output
+	}
+
+	url, err := s.urlSigner.GeneratePresignedURL(ctx, export.ObjectKey.String, ttl)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to sign data export download link", "export_id", export.ID.String(), "error", err)
+		return output
+	}
+	output.DownloadURL = url
+	output.DownloadURLExpiresAt = now.Add(ttl)
+
+	return output
+}
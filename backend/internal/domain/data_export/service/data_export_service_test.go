@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/data_export/models"
+	"wish-list/internal/domain/data_export/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testUserID   = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testExportID = pgtype.UUID{Bytes: [16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, Valid: true}
+)
+
+func newSigner() *URLSignerInterfaceMock {
+	return &URLSignerInterfaceMock{
+		GeneratePresignedURLFunc: func(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+			return "https://signed.example.com/" + fileKey, nil
+		},
+	}
+}
+
+func TestDataExportService_RequestExport_Queues(t *testing.T) {
+	repo := &DataExportRepositoryInterfaceMock{
+		CreateFunc: func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+			return &models.DataExport{ID: testExportID, UserID: userID, Status: models.StatusPending}, nil
+		},
+	}
+	svc := NewDataExportService(repo, newSigner(), 15*time.Minute)
+
+	export, created, err := svc.RequestExport(context.Background(), testUserID)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, models.StatusPending, export.Status)
+	assert.Empty(t, export.DownloadURL)
+}
+
+func TestDataExportService_RequestExport_ReturnsActive(t *testing.T) {
+	repo := &DataExportRepositoryInterfaceMock{
+		CreateFunc: func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+			return nil, repository.ErrExportInProgress
+		},
+		GetActiveByUserFunc: func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+			return &models.DataExport{ID: testExportID, UserID: userID, Status: models.StatusProcessing}, nil
+		},
+	}
+	svc := NewDataExportService(repo, newSigner(), 15*time.Minute)
+
+	export, created, err := svc.RequestExport(context.Background(), testUserID)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, testExportID, export.ID)
+	assert.Equal(t, models.StatusProcessing, export.Status)
+}
+
+func TestDataExportService_GetExport_SignsCompletedArchive(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute)
+	repo := &DataExportRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id, userID pgtype.UUID) (*models.DataExport, error) {
+			return &models.DataExport{
+				ID:        id,
+				UserID:    userID,
+				Status:    models.StatusCompleted,
+				ObjectKey: pgtype.Text{String: "exports/archive.zip", Valid: true},
+				SizeBytes: pgtype.Int8{Int64: 2048, Valid: true},
+				ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+			}, nil
+		},
+	}
+	signer := newSigner()
+	svc := NewDataExportService(repo, signer, 15*time.Minute)
+
+	export, err := svc.GetExport(context.Background(), testUserID, testExportID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "https://signed.example.com/exports/archive.zip", export.DownloadURL)
+	assert.Equal(t, int64(2048), export.SizeBytes)
+
+	// The link must not outlive the archive
+	require.Len(t, signer.GeneratePresignedURLCalls(), 1)
+	assert.LessOrEqual(t, signer.GeneratePresignedURLCalls()[0].Duration, 5*time.Minute)
+	assert.False(t, export.DownloadURLExpiresAt.After(expiresAt))
+}
+
+func TestDataExportService_GetExport_Errors(t *testing.T) {
+	repo := &DataExportRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id, userID pgtype.UUID) (*models.DataExport, error) {
+			return nil, repository.ErrExportNotFound
+		},
+	}
+	svc := NewDataExportService(repo, newSigner(), 15*time.Minute)
+
+	_, err := svc.GetExport(context.Background(), testUserID, "nope")
+	require.ErrorIs(t, err, ErrInvalidExportID)
+
+	_, err = svc.GetExport(context.Background(), testUserID, testExportID.String())
+	require.ErrorIs(t, err, ErrExportNotFound)
+}
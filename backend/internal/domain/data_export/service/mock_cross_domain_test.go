@@ -0,0 +1,88 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Ensure, that URLSignerInterfaceMock does implement URLSignerInterface.
+// If this is not the case, regenerate this file with moq.
+var _ URLSignerInterface = &URLSignerInterfaceMock{}
+
+// URLSignerInterfaceMock is a mock implementation of URLSignerInterface.
+//
+//	func TestSomethingThatUsesURLSignerInterface(t *testing.T) {
+//
+//		// make and configure a mocked URLSignerInterface
+//		mockedURLSignerInterface := &URLSignerInterfaceMock{
+//			GeneratePresignedURLFunc: func(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+//				panic("mock out the GeneratePresignedURL method")
+//			},
+//		}
+//
+//		// use mockedURLSignerInterface in code that requires URLSignerInterface
+//		// and then make assertions.
+//
+//	}
+type URLSignerInterfaceMock struct {
+	// GeneratePresignedURLFunc mocks the GeneratePresignedURL method.
+	GeneratePresignedURLFunc func(ctx context.Context, fileKey string, duration time.Duration) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GeneratePresignedURL holds details about calls to the GeneratePresignedURL method.
+		GeneratePresignedURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileKey is the fileKey argument value.
+			FileKey string
+			// Duration is the duration argument value.
+			Duration time.Duration
+		}
+	}
+	lockGeneratePresignedURL sync.RWMutex
+}
+
+// GeneratePresignedURL calls GeneratePresignedURLFunc.
+func (mock *URLSignerInterfaceMock) GeneratePresignedURL(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+	if mock.GeneratePresignedURLFunc == nil {
+		panic("URLSignerInterfaceMock.GeneratePresignedURLFunc: method is nil but URLSignerInterface.GeneratePresignedURL was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FileKey  string
+		Duration time.Duration
+	}{
+		Ctx:      ctx,
+		FileKey:  fileKey,
+		Duration: duration,
+	}
+	mock.lockGeneratePresignedURL.Lock()
+	mock.calls.GeneratePresignedURL = append(mock.calls.GeneratePresignedURL, callInfo)
+	mock.lockGeneratePresignedURL.Unlock()
+	return mock.GeneratePresignedURLFunc(ctx, fileKey, duration)
+}
+
+// GeneratePresignedURLCalls gets all the calls that were made to GeneratePresignedURL.
+// Check the length with:
+//
+//	len(mockedURLSignerInterface.GeneratePresignedURLCalls())
+func (mock *URLSignerInterfaceMock) GeneratePresignedURLCalls() []struct {
+	Ctx      context.Context
+	FileKey  string
+	Duration time.Duration
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FileKey  string
+		Duration time.Duration
+	}
+	mock.lockGeneratePresignedURL.RLock()
+	calls = mock.calls.GeneratePresignedURL
+	mock.lockGeneratePresignedURL.RUnlock()
+	return calls
+}
@@ -0,0 +1,533 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/data_export/models"
+	"wish-list/internal/domain/data_export/repository"
+)
+
+// Ensure, that DataExportRepositoryInterfaceMock does implement repository.DataExportRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.DataExportRepositoryInterface = &DataExportRepositoryInterfaceMock{}
+
+// DataExportRepositoryInterfaceMock is a mock implementation of repository.DataExportRepositoryInterface.
+//
+//	func TestSomethingThatUsesDataExportRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.DataExportRepositoryInterface
+//		mockedDataExportRepositoryInterface := &DataExportRepositoryInterfaceMock{
+//			ClaimPendingFunc: func(ctx context.Context, staleBefore time.Time, limit int) ([]*models.DataExport, error) {
+//				panic("mock out the ClaimPending method")
+//			},
+//			CompleteFunc: func(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error {
+//				panic("mock out the Complete method")
+//			},
+//			CreateFunc: func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+//				panic("mock out the Create method")
+//			},
+//			FailFunc: func(ctx context.Context, id pgtype.UUID, reason string) error {
+//				panic("mock out the Fail method")
+//			},
+//			GetActiveByUserFunc: func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+//				panic("mock out the GetActiveByUser method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.DataExport, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByUserFunc: func(ctx context.Context, userID pgtype.UUID, limit int) ([]*models.DataExport, error) {
+//				panic("mock out the ListByUser method")
+//			},
+//			ListExpiredFunc: func(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error) {
+//				panic("mock out the ListExpired method")
+//			},
+//			MarkExpiredFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the MarkExpired method")
+//			},
+//		}
+//
+//		// use mockedDataExportRepositoryInterface in code that requires repository.DataExportRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type DataExportRepositoryInterfaceMock struct {
+	// ClaimPendingFunc mocks the ClaimPending method.
+	ClaimPendingFunc func(ctx context.Context, staleBefore time.Time, limit int) ([]*models.DataExport, error)
+
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error)
+
+	// FailFunc mocks the Fail method.
+	FailFunc func(ctx context.Context, id pgtype.UUID, reason string) error
+
+	// GetActiveByUserFunc mocks the GetActiveByUser method.
+	GetActiveByUserFunc func(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.DataExport, error)
+
+	// ListByUserFunc mocks the ListByUser method.
+	ListByUserFunc func(ctx context.Context, userID pgtype.UUID, limit int) ([]*models.DataExport, error)
+
+	// ListExpiredFunc mocks the ListExpired method.
+	ListExpiredFunc func(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error)
+
+	// MarkExpiredFunc mocks the MarkExpired method.
+	MarkExpiredFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClaimPending holds details about calls to the ClaimPending method.
+		ClaimPending []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// StaleBefore is the staleBefore argument value.
+			StaleBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// ObjectKey is the objectKey argument value.
+			ObjectKey string
+			// SizeBytes is the sizeBytes argument value.
+			SizeBytes int64
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// Fail holds details about calls to the Fail method.
+		Fail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// GetActiveByUser holds details about calls to the GetActiveByUser method.
+		GetActiveByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListByUser holds details about calls to the ListByUser method.
+		ListByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListExpired holds details about calls to the ListExpired method.
+		ListExpired []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// MarkExpired holds details about calls to the MarkExpired method.
+		MarkExpired []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockClaimPending    sync.RWMutex
+	lockComplete        sync.RWMutex
+	lockCreate          sync.RWMutex
+	lockFail            sync.RWMutex
+	lockGetActiveByUser sync.RWMutex
+	lockGetByID         sync.RWMutex
+	lockListByUser      sync.RWMutex
+	lockListExpired     sync.RWMutex
+	lockMarkExpired     sync.RWMutex
+}
+
+// ClaimPending calls ClaimPendingFunc.
+func (mock *DataExportRepositoryInterfaceMock) ClaimPending(ctx context.Context, staleBefore time.Time, limit int) ([]*models.DataExport, error) {
+	if mock.ClaimPendingFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.ClaimPendingFunc: method is nil but DataExportRepositoryInterface.ClaimPending was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		StaleBefore time.Time
+		Limit       int
+	}{
+		Ctx:         ctx,
+		StaleBefore: staleBefore,
+		Limit:       limit,
+	}
+	mock.lockClaimPending.Lock()
+	mock.calls.ClaimPending = append(mock.calls.ClaimPending, callInfo)
+	mock.lockClaimPending.Unlock()
+	return mock.ClaimPendingFunc(ctx, staleBefore, limit)
+}
+
+// ClaimPendingCalls gets all the calls that were made to ClaimPending.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.ClaimPendingCalls())
+func (mock *DataExportRepositoryInterfaceMock) ClaimPendingCalls() []struct {
+	Ctx         context.Context
+	StaleBefore time.Time
+	Limit       int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		StaleBefore time.Time
+		Limit       int
+	}
+	mock.lockClaimPending.RLock()
+	calls = mock.calls.ClaimPending
+	mock.lockClaimPending.RUnlock()
+	return calls
+}
+
+// Complete calls CompleteFunc.
+func (mock *DataExportRepositoryInterfaceMock) Complete(ctx context.Context, id pgtype.UUID, objectKey string, sizeBytes int64, expiresAt time.Time) error {
+	if mock.CompleteFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.CompleteFunc: method is nil but DataExportRepositoryInterface.Complete was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ID        pgtype.UUID
+		ObjectKey string
+		SizeBytes int64
+		ExpiresAt time.Time
+	}{
+		Ctx:       ctx,
+		ID:        id,
+		ObjectKey: objectKey,
+		SizeBytes: sizeBytes,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(ctx, id, objectKey, sizeBytes, expiresAt)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.CompleteCalls())
+func (mock *DataExportRepositoryInterfaceMock) CompleteCalls() []struct {
+	Ctx       context.Context
+	ID        pgtype.UUID
+	ObjectKey string
+	SizeBytes int64
+	ExpiresAt time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ID        pgtype.UUID
+		ObjectKey string
+		SizeBytes int64
+		ExpiresAt time.Time
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *DataExportRepositoryInterfaceMock) Create(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+	if mock.CreateFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.CreateFunc: method is nil but DataExportRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, userID)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.CreateCalls())
+func (mock *DataExportRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Fail calls FailFunc.
+func (mock *DataExportRepositoryInterfaceMock) Fail(ctx context.Context, id pgtype.UUID, reason string) error {
+	if mock.FailFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.FailFunc: method is nil but DataExportRepositoryInterface.Fail was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Reason string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
+	}
+	mock.lockFail.Lock()
+	mock.calls.Fail = append(mock.calls.Fail, callInfo)
+	mock.lockFail.Unlock()
+	return mock.FailFunc(ctx, id, reason)
+}
+
+// FailCalls gets all the calls that were made to Fail.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.FailCalls())
+func (mock *DataExportRepositoryInterfaceMock) FailCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	Reason string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Reason string
+	}
+	mock.lockFail.RLock()
+	calls = mock.calls.Fail
+	mock.lockFail.RUnlock()
+	return calls
+}
+
+// GetActiveByUser calls GetActiveByUserFunc.
+func (mock *DataExportRepositoryInterfaceMock) GetActiveByUser(ctx context.Context, userID pgtype.UUID) (*models.DataExport, error) {
+	if mock.GetActiveByUserFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.GetActiveByUserFunc: method is nil but DataExportRepositoryInterface.GetActiveByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetActiveByUser.Lock()
+	mock.calls.GetActiveByUser = append(mock.calls.GetActiveByUser, callInfo)
+	mock.lockGetActiveByUser.Unlock()
+	return mock.GetActiveByUserFunc(ctx, userID)
+}
+
+// GetActiveByUserCalls gets all the calls that were made to GetActiveByUser.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.GetActiveByUserCalls())
+func (mock *DataExportRepositoryInterfaceMock) GetActiveByUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetActiveByUser.RLock()
+	calls = mock.calls.GetActiveByUser
+	mock.lockGetActiveByUser.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *DataExportRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.DataExport, error) {
+	if mock.GetByIDFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.GetByIDFunc: method is nil but DataExportRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		UserID: userID,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id, userID)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.GetByIDCalls())
+func (mock *DataExportRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByUser calls ListByUserFunc.
+func (mock *DataExportRepositoryInterfaceMock) ListByUser(ctx context.Context, userID pgtype.UUID, limit int) ([]*models.DataExport, error) {
+	if mock.ListByUserFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.ListByUserFunc: method is nil but DataExportRepositoryInterface.ListByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Limit  int
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Limit:  limit,
+	}
+	mock.lockListByUser.Lock()
+	mock.calls.ListByUser = append(mock.calls.ListByUser, callInfo)
+	mock.lockListByUser.Unlock()
+	return mock.ListByUserFunc(ctx, userID, limit)
+}
+
+// ListByUserCalls gets all the calls that were made to ListByUser.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.ListByUserCalls())
+func (mock *DataExportRepositoryInterfaceMock) ListByUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Limit  int
+	}
+	mock.lockListByUser.RLock()
+	calls = mock.calls.ListByUser
+	mock.lockListByUser.RUnlock()
+	return calls
+}
+
+// ListExpired calls ListExpiredFunc.
+func (mock *DataExportRepositoryInterfaceMock) ListExpired(ctx context.Context, now time.Time, limit int) ([]*models.DataExport, error) {
+	if mock.ListExpiredFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.ListExpiredFunc: method is nil but DataExportRepositoryInterface.ListExpired was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+	}
+	mock.lockListExpired.Lock()
+	mock.calls.ListExpired = append(mock.calls.ListExpired, callInfo)
+	mock.lockListExpired.Unlock()
+	return mock.ListExpiredFunc(ctx, now, limit)
+}
+
+// ListExpiredCalls gets all the calls that were made to ListExpired.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.ListExpiredCalls())
+func (mock *DataExportRepositoryInterfaceMock) ListExpiredCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+	}
+	mock.lockListExpired.RLock()
+	calls = mock.calls.ListExpired
+	mock.lockListExpired.RUnlock()
+	return calls
+}
+
+// MarkExpired calls MarkExpiredFunc.
+func (mock *DataExportRepositoryInterfaceMock) MarkExpired(ctx context.Context, id pgtype.UUID) error {
+	if mock.MarkExpiredFunc == nil {
+		panic("DataExportRepositoryInterfaceMock.MarkExpiredFunc: method is nil but DataExportRepositoryInterface.MarkExpired was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkExpired.Lock()
+	mock.calls.MarkExpired = append(mock.calls.MarkExpired, callInfo)
+	mock.lockMarkExpired.Unlock()
+	return mock.MarkExpiredFunc(ctx, id)
+}
+
+// MarkExpiredCalls gets all the calls that were made to MarkExpired.
+// Check the length with:
+//
+//	len(mockedDataExportRepositoryInterface.MarkExpiredCalls())
+func (mock *DataExportRepositoryInterfaceMock) MarkExpiredCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockMarkExpired.RLock()
+	calls = mock.calls.MarkExpired
+	mock.lockMarkExpired.RUnlock()
+	return calls
+}
@@ -0,0 +1,124 @@
+package dto
+
+import (
+	"encoding/xml"
+	"time"
+
+	"wish-list/internal/domain/discovery/service"
+)
+
+// WishlistSummaryResponse is a wishlist as shown in search results and trending
+type WishlistSummaryResponse struct {
+	ID           string `json:"id" validate:"required"`
+	Title        string `json:"title" validate:"required"`
+	Occasion     string `json:"occasion,omitempty" example:"Birthday"`
+	OccasionDate string `json:"occasion_date,omitempty" example:"2026-12-24"`
+	PublicSlug   string `json:"public_slug" validate:"required"`
+	ItemCount    int    `json:"item_count" example:"5"`
+}
+
+type SearchResponse struct {
+	Wishlists []*WishlistSummaryResponse `json:"wishlists" validate:"required"`
+	Total     int                        `json:"total" validate:"required"`
+	Page      int                        `json:"page" validate:"required"`
+	Limit     int                        `json:"limit" validate:"required"`
+	Pages     int                        `json:"pages" validate:"required"`
+}
+
+type TrendingWishlistResponse struct {
+	WishlistSummaryResponse
+	RecentViews        int `json:"recent_views"`
+	RecentReservations int `json:"recent_reservations"`
+}
+
+type TrendingResponse struct {
+	Since      string                      `json:"since" validate:"required"` // First day of the period counted (YYYY-MM-DD, UTC)
+	Wishlists  []*TrendingWishlistResponse `json:"wishlists" validate:"required"`
+	ComputedAt string                      `json:"computed_at" validate:"required"`
+}
+
+func fromSummaryOutput(w *service.WishlistSummaryOutput) WishlistSummaryResponse {
+	response := WishlistSummaryResponse{
+		ID:         w.ID,
+		Title:      w.Title,
+		Occasion:   w.Occasion,
+		PublicSlug: w.PublicSlug,
+		ItemCount:  w.ItemCount,
+	}
+	if w.OccasionDate != nil {
+		response.OccasionDate = w.OccasionDate.Format("2006-01-02")
+	}
+	return response
+}
+
+func FromSearchOutput(wishlists []*service.WishlistSummaryOutput, total, page, limit int) SearchResponse {
+	response := SearchResponse{
+		Wishlists: make([]*WishlistSummaryResponse, len(wishlists)),
+		Total:     total,
+		Page:      page,
+		Limit:     limit,
+		Pages:     (total + limit - 1) / limit,
+	}
+	for i, w := range wishlists {
+		summary := fromSummaryOutput(w)
+		response.Wishlists[i] = &summary
+	}
+	return response
+}
+
+func FromTrendingOutput(t *service.TrendingOutput) TrendingResponse {
+	response := TrendingResponse{
+		Since:      t.Since.Format("2006-01-02"),
+		Wishlists:  make([]*TrendingWishlistResponse, len(t.Wishlists)),
+		ComputedAt: t.ComputedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for i, w := range t.Wishlists {
+		response.Wishlists[i] = &TrendingWishlistResponse{
+			WishlistSummaryResponse: fromSummaryOutput(&w.WishlistSummaryOutput),
+			RecentViews:             w.RecentViews,
+			RecentReservations:      w.RecentReservations,
+		}
+	}
+	return response
+}
+
+// sitemapNamespace is the XML namespace of the sitemap protocol
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// SitemapIndexResponse lists the sitemap chunks
+type SitemapIndexResponse struct {
+	XMLName  xml.Name             `xml:"sitemapindex"`
+	Xmlns    string               `xml:"xmlns,attr"`
+	Sitemaps []SitemapURLResponse `xml:"sitemap"`
+}
+
+// URLSetResponse lists the wishlist pages of a sitemap chunk
+type URLSetResponse struct {
+	XMLName xml.Name             `xml:"urlset"`
+	Xmlns   string               `xml:"xmlns,attr"`
+	URLs    []SitemapURLResponse `xml:"url"`
+}
+
+type SitemapURLResponse struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+func fromSitemapURLOutputs(urls []service.SitemapURLOutput) []SitemapURLResponse {
+	responses := make([]SitemapURLResponse, len(urls))
+	for i, u := range urls {
+		responses[i] = SitemapURLResponse{Loc: u.Loc}
+		if !u.LastModified.IsZero() {
+			responses[i].LastMod = u.LastModified.UTC().Format(time.RFC3339)
+		}
+	}
+	return responses
+}
+
+func FromSitemapIndexOutput(s *service.SitemapOutput) SitemapIndexResponse {
+	return SitemapIndexResponse{Xmlns: sitemapNamespace, Sitemaps: fromSitemapURLOutputs(s.URLs)}
+}
+
+func FromSitemapOutput(s *service.SitemapOutput) URLSetResponse {
+	return URLSetResponse{Xmlns: sitemapNamespace, URLs: fromSitemapURLOutputs(s.URLs)}
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/discovery/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapDiscoveryServiceError converts discovery service errors to AppErrors
+func mapDiscoveryServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidQuery):
+		return apperrors.BadRequest("Query must be between 2 and 100 characters")
+	case errors.Is(err, service.ErrInvalidLimit):
+		return apperrors.BadRequest("Limit must be between 1 and 50")
+	case errors.Is(err, service.ErrSitemapNotFound):
+		return apperrors.NotFound("Sitemap not found")
+	default:
+		return apperrors.Internal("Failed to list wishlists").Wrap(err)
+	}
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	"encoding/xml"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+
+	"wish-list/internal/domain/discovery/delivery/http/dto"
+	"wish-list/internal/domain/discovery/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for browsing public wishlists
+type Handler struct {
+	service service.DiscoveryServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.DiscoveryServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// Search godoc
+//
+//	@Summary		Search public wishlists
+//	@Description	Full-text search over the title, occasion and description of wishlists whose owners made them public and opted in to discovery. Best matches come first. Owners are never included.
+//	@Tags			Discovery
+//	@Produce		json
+//	@Param			q		query		string				true	"Search text (2-100 characters)"
+//	@Param			page	query		int					false	"Page number (default 1)"
+//	@Param			limit	query		int					false	"Wishlists per page (default 10, max 100)"
+//	@Success		200		{object}	dto.SearchResponse	"Matching wishlists"
+//	@Failure		400		{object}	map[string]string	"Invalid query"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/public/search [get]
+func (h *Handler) Search(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+
+	wishlists, total, err := h.service.Search(c.Request().Context(), c.QueryParam("q"), pagination.Limit, pagination.Offset)
+	if err != nil {
+		return mapDiscoveryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSearchOutput(wishlists, total, pagination.Page, pagination.Limit))
+}
+
+// ListTrending godoc
+//
+//	@Summary		List trending wishlists
+//	@Description	Discoverable public wishlists ranked by views and reservations over the last 7 days, today included; a reservation weighs as much as 5 views. The ranking is refreshed every 15 minutes.
+//	@Tags			Discovery
+//	@Produce		json
+//	@Param			limit	query		int					false	"Number of wishlists (default 10, max 50)"
+//	@Success		200		{object}	dto.TrendingResponse	"Trending wishlists"
+//	@Failure		400		{object}	map[string]string	"Invalid limit"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/public/trending [get]
+func (h *Handler) ListTrending(c echo.Context) error {
+	limit := 0
+	if raw := c.QueryParam("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil {
+			return apperrors.BadRequest("Limit must be between 1 and 50")
+		}
+	}
+
+	trending, err := h.service.ListTrending(c.Request().Context(), limit)
+	if err != nil {
+		return mapDiscoveryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromTrendingOutput(trending))
+}
+
+// GetSitemapIndex godoc
+//
+//	@Summary		Sitemap index
+//	@Description	Sitemap index for search engines, linking chunks of up to 10,000 share pages each. Only public wishlists whose owners opted in to discovery are listed. Refreshed every 15 minutes.
+//	@Tags			Discovery
+//	@Produce		xml
+//	@Success		200	{string}	string				"Sitemap index"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Router			/sitemap.xml [get]
+func (h *Handler) GetSitemapIndex(c echo.Context) error {
+	index, err := h.service.SitemapIndex(c.Request().Context())
+	if err != nil {
+		return mapDiscoveryServiceError(err)
+	}
+
+	return xmlResponse(c, dto.FromSitemapIndexOutput(index))
+}
+
+// GetSitemap godoc
+//
+//	@Summary		Sitemap chunk
+//	@Description	One chunk of the sitemap, e.g. /sitemap-1.xml, listing share pages of discoverable public wishlists.
+//	@Tags			Discovery
+//	@Produce		xml
+//	@Param			chunk	path		string				true	"Chunk file name, e.g. 1.xml"
+//	@Success		200		{string}	string				"Sitemap"
+//	@Failure		404		{object}	map[string]string	"No such chunk"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/sitemap-{chunk} [get]
+func (h *Handler) GetSitemap(c echo.Context) error {
+	number, ok := strings.CutSuffix(c.Param("chunk"), ".xml")
+	chunk, err := strconv.Atoi(number)
+	if !ok || err != nil {
+		return apperrors.NotFound("Sitemap not found")
+	}
+
+	sitemap, err := h.service.Sitemap(c.Request().Context(), chunk)
+	if err != nil {
+		return mapDiscoveryServiceError(err)
+	}
+
+	return xmlResponse(c, dto.FromSitemapOutput(sitemap))
+}
+
+// GetRobotsTxt godoc
+//
+//	@Summary		robots.txt
+//	@Description	Lets crawlers visit share pages but not account pages, and points them at the sitemap.
+//	@Tags			Discovery
+//	@Produce		plain
+//	@Success		200	{string}	string	"robots.txt"
+//	@Router			/robots.txt [get]
+func (h *Handler) GetRobotsTxt(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=86400")
+	return c.String(nethttp.StatusOK, h.service.RobotsTxt())
+}
+
+// xmlResponse writes a sitemap document that crawlers may reuse until the next refresh
+func xmlResponse(c echo.Context, document any) error {
+	body, err := xml.Marshal(document)
+	if err != nil {
+		return apperrors.Internal("Failed to render sitemap").Wrap(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=900")
+	return c.Blob(nethttp.StatusOK, echo.MIMEApplicationXMLCharsetUTF8, append([]byte(xml.Header), body...))
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers public search and trending HTTP routes, and the sitemap
+// and robots.txt at the root, where the web app serves them from
+func RegisterRoutes(e *echo.Echo, h *Handler) {
+	public := e.Group("/api/public")
+	public.GET("/search", h.Search)
+	public.GET("/trending", h.ListTrending)
+
+	e.GET("/sitemap.xml", h.GetSitemapIndex)
+	e.GET("/sitemap-:chunk", h.GetSitemap)
+	e.GET("/robots.txt", h.GetRobotsTxt)
+}
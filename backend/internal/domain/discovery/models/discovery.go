@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// WishlistSummary is a discoverable public wishlist as anonymous visitors see it.
+// The owner is left out; the list is reached through its public slug.
+type WishlistSummary struct {
+	ID           pgtype.UUID `db:"id"`
+	Title        string      `db:"title"`
+	Occasion     pgtype.Text `db:"occasion"`
+	OccasionDate pgtype.Date `db:"occasion_date"`
+	PublicSlug   string      `db:"public_slug"`
+	ItemCount    int         `db:"item_count"` // Unarchived items on the list
+}
+
+// TrendingWishlist is a discoverable wishlist with its recent activity
+type TrendingWishlist struct {
+	WishlistSummary
+	RecentViews        int `db:"recent_views"`
+	RecentReservations int `db:"recent_reservations"` // Reservations made, canceled ones included
+}
+
+// SitemapEntry is a discoverable wishlist listed in the sitemap
+type SitemapEntry struct {
+	PublicSlug string             `db:"public_slug"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at"`
+}
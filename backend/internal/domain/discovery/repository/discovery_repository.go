@@ -0,0 +1,184 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_discovery_repository_test.go -pkg service . DiscoveryRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/discovery/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DiscoveryRepositoryInterface defines the interface for public discovery database operations
+type DiscoveryRepositoryInterface interface {
+	Search(ctx context.Context, query string, limit, offset int) ([]*models.WishlistSummary, int, error)
+	ListTrending(ctx context.Context, since time.Time, reservationWeight, limit int) ([]*models.TrendingWishlist, error)
+	ListSitemapChunks(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error)
+	ListSitemapEntries(ctx context.Context, limit, offset int) ([]*models.SitemapEntry, error)
+	ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishlistSummary, error)
+}
+
+type DiscoveryRepository struct {
+	db *database.DB
+}
+
+func NewDiscoveryRepository(db *database.DB) DiscoveryRepositoryInterface {
+	return &DiscoveryRepository{
+		db: db,
+	}
+}
+
+// discoverable limits w to public wishlists whose owner opted in to discovery and
+// whose account is active. Merged lists are unpublished, so they drop out too.
+const discoverable = `
+	w.is_public = true AND w.discoverable AND w.public_slug IS NOT NULL
+	AND EXISTS (SELECT 1 FROM users u WHERE u.id = w.owner_id AND u.deactivated_at IS NULL)
+`
+
+// summaryColumns selects a WishlistSummary of w
+const summaryColumns = `
+	w.id, w.title, w.occasion, w.occasion_date, w.public_slug,
+	(SELECT COUNT(*) FROM wishlist_items wi JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = w.id AND gi.archived_at IS NULL) AS item_count
+`
+
+// searchDocument is the text searched; it matches idx_wishlists_discovery_search
+const searchDocument = `to_tsvector('simple', w.title || ' ' || COALESCE(w.occasion, '') || ' ' || COALESCE(w.description, ''))`
+
+// likeEscaper makes search text match literally in an ILIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Search returns discoverable wishlists matching the words of query, best match
+// first, and the number of matches. Words are matched whole in the title, occasion
+// and description; the title also matches on any part, so typing ahead finds lists.
+func (r *DiscoveryRepository) Search(ctx context.Context, query string, limit, offset int) ([]*models.WishlistSummary, int, error) {
+	match := `
+		WHERE ` + discoverable + `
+		AND (` + searchDocument + ` @@ websearch_to_tsquery('simple', $1)
+			OR w.title ILIKE $2)
+	`
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+
+	var total int
+	if err := r.db.Reader().GetContext(ctx, &total, `SELECT COUNT(*) FROM wishlists w `+match, query, pattern); err != nil {
+		return nil, 0, fmt.Errorf("failed to count wishlist search results: %w", err)
+	}
+	if total == 0 {
+		return []*models.WishlistSummary{}, 0, nil
+	}
+
+	selectQuery := `
+		SELECT ` + summaryColumns + `
+		FROM wishlists w
+	` + match + `
+		ORDER BY ts_rank(` + searchDocument + `, websearch_to_tsquery('simple', $1)) DESC, w.view_count DESC, w.id
+		LIMIT $3 OFFSET $4
+	`
+
+	var wishlists []*models.WishlistSummary
+	if err := r.db.Reader().SelectContext(ctx, &wishlists, selectQuery, query, pattern, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to search wishlists: %w", err)
+	}
+
+	return wishlists, total, nil
+}
+
+// ListTrending ranks discoverable wishlists by their page views and reservations
+// since the given day, a reservation counting as reservationWeight views
+func (r *DiscoveryRepository) ListTrending(ctx context.Context, since time.Time, reservationWeight, limit int) ([]*models.TrendingWishlist, error) {
+	query := `
+		WITH views AS (
+			SELECT wishlist_id, SUM(views) AS views
+			FROM wishlist_daily_views
+			WHERE day >= $1::date
+			GROUP BY wishlist_id
+		), reserved AS (
+			SELECT wishlist_id, COUNT(*) AS reservations
+			FROM reservations
+			WHERE reserved_at >= $1::date
+			GROUP BY wishlist_id
+		)
+		SELECT ` + summaryColumns + `,
+			COALESCE(v.views, 0) AS recent_views,
+			COALESCE(rv.reservations, 0) AS recent_reservations
+		FROM wishlists w
+		LEFT JOIN views v ON v.wishlist_id = w.id
+		LEFT JOIN reserved rv ON rv.wishlist_id = w.id
+		WHERE ` + discoverable + `
+		AND (v.wishlist_id IS NOT NULL OR rv.wishlist_id IS NOT NULL)
+		ORDER BY COALESCE(v.views, 0) + $2 * COALESCE(rv.reservations, 0) DESC, w.id
+		LIMIT $3
+	`
+
+	var wishlists []*models.TrendingWishlist
+	if err := r.db.Reader().SelectContext(ctx, &wishlists, query, since.UTC().Format(time.DateOnly), reservationWeight, limit); err != nil {
+		return nil, fmt.Errorf("failed to list trending wishlists: %w", err)
+	}
+
+	return wishlists, nil
+}
+
+// ListSitemapChunks splits discoverable wishlists, ordered by id, into chunks of
+// chunkSize and returns when each chunk last changed. It returns no chunks when
+// there is nothing to list.
+func (r *DiscoveryRepository) ListSitemapChunks(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error) {
+	query := `
+		SELECT MAX(updated_at) AS updated_at
+		FROM (
+			SELECT w.updated_at, (ROW_NUMBER() OVER (ORDER BY w.id) - 1) / $1 AS chunk
+			FROM wishlists w
+			WHERE ` + discoverable + `
+		) numbered
+		GROUP BY chunk
+		ORDER BY chunk
+	`
+
+	var chunks []pgtype.Timestamptz
+	if err := r.db.Reader().SelectContext(ctx, &chunks, query, chunkSize); err != nil {
+		return nil, fmt.Errorf("failed to list sitemap chunks: %w", err)
+	}
+
+	return chunks, nil
+}
+
+// ListSitemapEntries returns a page of discoverable wishlists in the order
+// ListSitemapChunks splits them
+func (r *DiscoveryRepository) ListSitemapEntries(ctx context.Context, limit, offset int) ([]*models.SitemapEntry, error) {
+	query := `
+		SELECT w.public_slug, w.updated_at
+		FROM wishlists w
+		WHERE ` + discoverable + `
+		ORDER BY w.id
+		LIMIT $1 OFFSET $2
+	`
+
+	var entries []*models.SitemapEntry
+	if err := r.db.Reader().SelectContext(ctx, &entries, query, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list sitemap entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListByOwner returns the discoverable wishlists of one owner for their public
+// profile, upcoming occasions first
+func (r *DiscoveryRepository) ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishlistSummary, error) {
+	query := `
+		SELECT ` + summaryColumns + `
+		FROM wishlists w
+		WHERE w.owner_id = $1 AND ` + discoverable + `
+		ORDER BY w.occasion_date ASC NULLS LAST, w.created_at DESC
+	`
+
+	var wishlists []*models.WishlistSummary
+	if err := r.db.Reader().SelectContext(ctx, &wishlists, query, ownerID); err != nil {
+		return nil, fmt.Errorf("failed to list wishlists of owner: %w", err)
+	}
+
+	return wishlists, nil
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/discovery/models"
+	"wish-list/internal/domain/discovery/repository"
+)
+
+// Search limits
+const (
+	MinQueryLength = 2
+	MaxQueryLength = 100
+)
+
+// Trending wishlists and the sitemap are computed every RefreshInterval and served
+// from cache
+const (
+	RefreshInterval = 15 * time.Minute
+	// cacheMaxAge is how old a cached result may get before a request recomputes it,
+	// e.g. while the refresh job is not running
+	cacheMaxAge = 2 * RefreshInterval
+)
+
+// Trending wishlists rank views and reservations of the last TrendingDays; the top
+// TrendingSize are kept
+const (
+	TrendingDays              = 7
+	TrendingReservationWeight = 5 // A reservation counts as this many page views
+	TrendingSize              = 50
+	DefaultTrendingLimit      = 10
+
+	trendingCacheKey = "discovery:trending"
+)
+
+// The sitemap is an index of chunks listing up to SitemapChunkSize wishlists each,
+// well below the 50,000 URLs the sitemap protocol allows per file
+const (
+	SitemapChunkSize = 10000
+
+	sitemapCacheKey      = "discovery:sitemap"
+	sitemapChunkCacheKey = "discovery:sitemap:%d"
+)
+
+var (
+	ErrInvalidQuery = errors.New("query must be between 2 and 100 characters")
+	ErrInvalidLimit = errors.New("limit must be between 1 and 50")
+	// ErrSitemapNotFound is returned for a sitemap chunk past the last one
+	ErrSitemapNotFound = errors.New("sitemap not found")
+)
+
+// Cross-domain interfaces - only methods actually used by DiscoveryService
+
+// CacheInterface defines cache methods used by discovery service
+type CacheInterface interface {
+	Get(ctx context.Context, key string, dest any) error
+	Set(ctx context.Context, key string, value any) error
+}
+
+// DiscoveryServiceInterface defines the interface for public discovery operations
+type DiscoveryServiceInterface interface {
+	Search(ctx context.Context, query string, limit, offset int) ([]*WishlistSummaryOutput, int, error)
+	ListTrending(ctx context.Context, limit int) (*TrendingOutput, error)
+	RefreshTrending(ctx context.Context) error
+	SitemapIndex(ctx context.Context) (*SitemapOutput, error)
+	Sitemap(ctx context.Context, chunk int) (*SitemapOutput, error)
+	RefreshSitemap(ctx context.Context) error
+	RobotsTxt() string
+}
+
+type DiscoveryService struct {
+	repo        repository.DiscoveryRepositoryInterface
+	cache       CacheInterface
+	frontendURL string
+}
+
+// NewDiscoveryService creates a new DiscoveryService. cache may be nil, in which
+// case trending wishlists and the sitemap are computed on every request.
+// frontendURL is the web app's base URL, which sitemap links point to.
+func NewDiscoveryService(repo repository.DiscoveryRepositoryInterface, cache CacheInterface, frontendURL string) *DiscoveryService {
+	return &DiscoveryService{
+		repo:        repo,
+		cache:       cache,
+		frontendURL: strings.TrimSuffix(frontendURL, "/"),
+	}
+}
+
+// WishlistSummaryOutput is a discoverable wishlist, without its owner
+type WishlistSummaryOutput struct {
+	ID           string
+	Title        string
+	Occasion     string
+	OccasionDate *time.Time
+	PublicSlug   string
+	ItemCount    int
+}
+
+// TrendingOutput is the trending ranking as last computed
+type TrendingOutput struct {
+	Since      time.Time // First day (UTC) of the activity counted
+	Wishlists  []*TrendingWishlistOutput
+	ComputedAt time.Time
+}
+
+type TrendingWishlistOutput struct {
+	WishlistSummaryOutput
+	RecentViews        int
+	RecentReservations int
+}
+
+// SitemapOutput is a sitemap index, listing chunks, or a chunk, listing wishlist pages
+type SitemapOutput struct {
+	URLs       []SitemapURLOutput
+	ComputedAt time.Time
+}
+
+type SitemapURLOutput struct {
+	Loc          string
+	LastModified time.Time
+}
+
+// Search returns a page of discoverable wishlists matching query, and the total
+// number of matches
+func (s *DiscoveryService) Search(ctx context.Context, query string, limit, offset int) ([]*WishlistSummaryOutput, int, error) {
+	query = strings.TrimSpace(query)
+	if length := utf8.RuneCountInString(query); length < MinQueryLength || length > MaxQueryLength {
+		return nil, 0, ErrInvalidQuery
+	}
+
+	wishlists, total, err := s.repo.Search(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search wishlists: %w", err)
+	}
+
+	outputs := make([]*WishlistSummaryOutput, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		output := summaryToOutput(wishlist)
+		outputs = append(outputs, &output)
+	}
+
+	return outputs, total, nil
+}
+
+// ListTrending returns the top limit trending wishlists, computed at most
+// cacheMaxAge ago. limit 0 means DefaultTrendingLimit.
+func (s *DiscoveryService) ListTrending(ctx context.Context, limit int) (*TrendingOutput, error) {
+	if limit == 0 {
+		limit = DefaultTrendingLimit
+	}
+	if limit < 1 || limit > TrendingSize {
+		return nil, ErrInvalidLimit
+	}
+
+	var trending *TrendingOutput
+	if s.cache != nil {
+		var cached TrendingOutput
+		if err := s.cache.Get(ctx, trendingCacheKey, &cached); err == nil && time.Since(cached.ComputedAt) < cacheMaxAge {
+			trending = &cached
+		}
+	}
+	if trending == nil {
+		var err error
+		if trending, err = s.computeTrending(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(trending.Wishlists) > limit {
+		trending.Wishlists = trending.Wishlists[:limit]
+	}
+	return trending, nil
+}
+
+// RefreshTrending recomputes the trending ranking and caches it for ListTrending
+func (s *DiscoveryService) RefreshTrending(ctx context.Context) error {
+	_, err := s.computeTrending(ctx)
+	return err
+}
+
+func (s *DiscoveryService) computeTrending(ctx context.Context) (*TrendingOutput, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, 1-TrendingDays)
+
+	wishlists, err := s.repo.ListTrending(ctx, since, TrendingReservationWeight, TrendingSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trending wishlists: %w", err)
+	}
+
+	output := &TrendingOutput{
+		Since:      since,
+		Wishlists:  make([]*TrendingWishlistOutput, 0, len(wishlists)),
+		ComputedAt: time.Now().UTC(),
+	}
+	for _, wishlist := range wishlists {
+		output.Wishlists = append(output.Wishlists, &TrendingWishlistOutput{
+			WishlistSummaryOutput: summaryToOutput(&wishlist.WishlistSummary),
+			RecentViews:           wishlist.RecentViews,
+			RecentReservations:    wishlist.RecentReservations,
+		})
+	}
+
+	if s.cache != nil {
+		_ = s.cache.Set(ctx, trendingCacheKey, output)
+	}
+
+	return output, nil
+}
+
+// SitemapIndex lists the sitemap chunks, computed at most cacheMaxAge ago
+func (s *DiscoveryService) SitemapIndex(ctx context.Context) (*SitemapOutput, error) {
+	return s.cachedSitemap(ctx, sitemapCacheKey, s.computeSitemapIndex)
+}
+
+// Sitemap lists the wishlist pages of a chunk, numbered from 1, computed at most
+// cacheMaxAge ago
+func (s *DiscoveryService) Sitemap(ctx context.Context, chunk int) (*SitemapOutput, error) {
+	if chunk < 1 {
+		return nil, ErrSitemapNotFound
+	}
+	return s.cachedSitemap(ctx, fmt.Sprintf(sitemapChunkCacheKey, chunk), func(ctx context.Context) (*SitemapOutput, error) {
+		return s.computeSitemap(ctx, chunk)
+	})
+}
+
+// RefreshSitemap recomputes the sitemap index and every chunk and caches them
+func (s *DiscoveryService) RefreshSitemap(ctx context.Context) error {
+	index, err := s.computeSitemapIndex(ctx)
+	if err != nil {
+		return err
+	}
+	s.storeSitemap(ctx, sitemapCacheKey, index)
+
+	for chunk := 1; chunk <= len(index.URLs); chunk++ {
+		sitemap, err := s.computeSitemap(ctx, chunk)
+		if errors.Is(err, ErrSitemapNotFound) {
+			// Lists were unpublished since the index was computed
+			break
+		}
+		if err != nil {
+			return err
+		}
+		s.storeSitemap(ctx, fmt.Sprintf(sitemapChunkCacheKey, chunk), sitemap)
+	}
+	return nil
+}
+
+// RobotsTxt returns the web app's robots.txt: crawlers may visit everything but
+// account pages, and are pointed at the sitemap
+func (s *DiscoveryService) RobotsTxt() string {
+	return "User-agent: *\n" +
+		"Disallow: /my/\n" +
+		"Disallow: /auth/\n" +
+		"Disallow: /api/\n" +
+		"\n" +
+		"Sitemap: " + s.frontendURL + "/sitemap.xml\n"
+}
+
+func (s *DiscoveryService) cachedSitemap(ctx context.Context, key string, compute func(context.Context) (*SitemapOutput, error)) (*SitemapOutput, error) {
+	if s.cache != nil {
+		var cached SitemapOutput
+		if err := s.cache.Get(ctx, key, &cached); err == nil && time.Since(cached.ComputedAt) < cacheMaxAge {
+			return &cached, nil
+		}
+	}
+
+	sitemap, err := compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.storeSitemap(ctx, key, sitemap)
+	return sitemap, nil
+}
+
+func (s *DiscoveryService) storeSitemap(ctx context.Context, key string, sitemap *SitemapOutput) {
+	if s.cache != nil {
+		_ = s.cache.Set(ctx, key, sitemap)
+	}
+}
+
+func (s *DiscoveryService) computeSitemapIndex(ctx context.Context) (*SitemapOutput, error) {
+	chunks, err := s.repo.ListSitemapChunks(ctx, SitemapChunkSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sitemap chunks: %w", err)
+	}
+
+	output := &SitemapOutput{
+		URLs:       make([]SitemapURLOutput, len(chunks)),
+		ComputedAt: time.Now().UTC(),
+	}
+	for i, updatedAt := range chunks {
+		output.URLs[i] = SitemapURLOutput{
+			Loc:          fmt.Sprintf("%s/sitemap-%d.xml", s.frontendURL, i+1),
+			LastModified: updatedAt.Time,
+		}
+	}
+	return output, nil
+}
+
+func (s *DiscoveryService) computeSitemap(ctx context.Context, chunk int) (*SitemapOutput, error) {
+	entries, err := s.repo.ListSitemapEntries(ctx, SitemapChunkSize, (chunk-1)*SitemapChunkSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sitemap entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, ErrSitemapNotFound
+	}
+
+	output := &SitemapOutput{
+		URLs:       make([]SitemapURLOutput, len(entries)),
+		ComputedAt: time.Now().UTC(),
+	}
+	for i, entry := range entries {
+		output.URLs[i] = SitemapURLOutput{
+			Loc:          s.frontendURL + "/public/" + url.PathEscape(entry.PublicSlug),
+			LastModified: entry.UpdatedAt.Time,
+		}
+	}
+	return output, nil
+}
+
+func summaryToOutput(wishlist *models.WishlistSummary) WishlistSummaryOutput {
+	output := WishlistSummaryOutput{
+		ID:         wishlist.ID.String(),
+		Title:      wishlist.Title,
+		Occasion:   database.TextToString(wishlist.Occasion),
+		PublicSlug: wishlist.PublicSlug,
+		ItemCount:  wishlist.ItemCount,
+	}
+	if wishlist.OccasionDate.Valid {
+		date := wishlist.OccasionDate.Time
+		output.OccasionDate = &date
+	}
+	return output
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/discovery/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testWishlistID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+
+const testFrontendURL = "https://wishlist.example.com/"
+
+func testSummary() models.WishlistSummary {
+	return models.WishlistSummary{
+		ID:           testWishlistID,
+		Title:        "Birthday ideas",
+		Occasion:     pgtype.Text{String: "Birthday", Valid: true},
+		OccasionDate: pgtype.Date{Time: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC), Valid: true},
+		PublicSlug:   "birthday-ideas",
+		ItemCount:    4,
+	}
+}
+
+func discoveryRepo(trending int) *DiscoveryRepositoryInterfaceMock {
+	return &DiscoveryRepositoryInterfaceMock{
+		SearchFunc: func(ctx context.Context, query string, limit, offset int) ([]*models.WishlistSummary, int, error) {
+			summary := testSummary()
+			return []*models.WishlistSummary{&summary}, 1, nil
+		},
+		ListTrendingFunc: func(ctx context.Context, since time.Time, reservationWeight, limit int) ([]*models.TrendingWishlist, error) {
+			wishlists := make([]*models.TrendingWishlist, trending)
+			for i := range wishlists {
+				wishlists[i] = &models.TrendingWishlist{WishlistSummary: testSummary(), RecentViews: 10 - i, RecentReservations: 1}
+			}
+			return wishlists, nil
+		},
+	}
+}
+
+// memoryCache stores values as JSON, as the Redis cache does
+type memoryCache map[string][]byte
+
+func (c memoryCache) Get(ctx context.Context, key string, dest any) error {
+	data, ok := c[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c memoryCache) Set(ctx context.Context, key string, value any) error {
+	data, err := json.Marshal(value)
+	c[key] = data
+	return err
+}
+
+func TestDiscoveryService_Search(t *testing.T) {
+	t.Run("trims the query and maps results", func(t *testing.T) {
+		repo := discoveryRepo(0)
+		svc := NewDiscoveryService(repo, nil, testFrontendURL)
+
+		wishlists, total, err := svc.Search(context.Background(), "  birthday ", 10, 20)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, wishlists, 1)
+		assert.Equal(t, testWishlistID.String(), wishlists[0].ID)
+		assert.Equal(t, "Birthday", wishlists[0].Occasion)
+		require.NotNil(t, wishlists[0].OccasionDate)
+		assert.Equal(t, "2026-12-24", wishlists[0].OccasionDate.Format("2006-01-02"))
+
+		calls := repo.SearchCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, "birthday", calls[0].Query)
+		assert.Equal(t, 10, calls[0].Limit)
+		assert.Equal(t, 20, calls[0].Offset)
+	})
+
+	t.Run("rejects short and long queries", func(t *testing.T) {
+		repo := discoveryRepo(0)
+		svc := NewDiscoveryService(repo, nil, testFrontendURL)
+
+		for _, query := range []string{"", " a ", strings.Repeat("ж", MaxQueryLength+1)} {
+			_, _, err := svc.Search(context.Background(), query, 10, 0)
+			assert.ErrorIs(t, err, ErrInvalidQuery, query)
+		}
+		assert.Empty(t, repo.SearchCalls())
+	})
+}
+
+func TestDiscoveryService_ListTrending(t *testing.T) {
+	t.Run("computes the last week with weighted reservations", func(t *testing.T) {
+		repo := discoveryRepo(3)
+		svc := NewDiscoveryService(repo, nil, testFrontendURL)
+
+		trending, err := svc.ListTrending(context.Background(), 0)
+
+		require.NoError(t, err)
+		require.Len(t, trending.Wishlists, 3)
+		assert.Equal(t, 10, trending.Wishlists[0].RecentViews)
+		assert.Equal(t, 1, trending.Wishlists[0].RecentReservations)
+
+		calls := repo.ListTrendingCalls()
+		require.Len(t, calls, 1)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		assert.Equal(t, today.AddDate(0, 0, 1-TrendingDays), calls[0].Since)
+		assert.Equal(t, TrendingReservationWeight, calls[0].ReservationWeight)
+		assert.Equal(t, TrendingSize, calls[0].Limit)
+	})
+
+	t.Run("applies the limit", func(t *testing.T) {
+		svc := NewDiscoveryService(discoveryRepo(TrendingSize), nil, testFrontendURL)
+
+		trending, err := svc.ListTrending(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Len(t, trending.Wishlists, DefaultTrendingLimit)
+
+		trending, err = svc.ListTrending(context.Background(), 3)
+		require.NoError(t, err)
+		assert.Len(t, trending.Wishlists, 3)
+	})
+
+	t.Run("rejects an invalid limit", func(t *testing.T) {
+		svc := NewDiscoveryService(discoveryRepo(3), nil, testFrontendURL)
+
+		for _, limit := range []int{-1, TrendingSize + 1} {
+			_, err := svc.ListTrending(context.Background(), limit)
+			assert.ErrorIs(t, err, ErrInvalidLimit)
+		}
+	})
+
+	t.Run("serves the refreshed ranking from cache", func(t *testing.T) {
+		repo := discoveryRepo(TrendingSize)
+		svc := NewDiscoveryService(repo, memoryCache{}, testFrontendURL)
+
+		require.NoError(t, svc.RefreshTrending(context.Background()))
+		trending, err := svc.ListTrending(context.Background(), 5)
+		require.NoError(t, err)
+		full, err := svc.ListTrending(context.Background(), TrendingSize)
+		require.NoError(t, err)
+
+		assert.Len(t, repo.ListTrendingCalls(), 1)
+		assert.Len(t, trending.Wishlists, 5)
+		assert.Len(t, full.Wishlists, TrendingSize, "a short page must not shrink the cached ranking")
+	})
+
+	t.Run("recomputes a stale ranking", func(t *testing.T) {
+		repo := discoveryRepo(3)
+		cache := memoryCache{}
+		require.NoError(t, cache.Set(context.Background(), trendingCacheKey, TrendingOutput{ComputedAt: time.Now().Add(-cacheMaxAge)}))
+		svc := NewDiscoveryService(repo, cache, testFrontendURL)
+
+		trending, err := svc.ListTrending(context.Background(), 0)
+
+		require.NoError(t, err)
+		assert.Len(t, repo.ListTrendingCalls(), 1)
+		assert.Len(t, trending.Wishlists, 3)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := discoveryRepo(0)
+		repo.ListTrendingFunc = func(ctx context.Context, since time.Time, reservationWeight, limit int) ([]*models.TrendingWishlist, error) {
+			return nil, errors.New("db down")
+		}
+		svc := NewDiscoveryService(repo, memoryCache{}, testFrontendURL)
+
+		assert.Error(t, svc.RefreshTrending(context.Background()))
+		_, err := svc.ListTrending(context.Background(), 0)
+		assert.Error(t, err)
+	})
+}
+
+func sitemapRepo(lists int) *DiscoveryRepositoryInterfaceMock {
+	updatedAt := pgtype.Timestamptz{Time: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	return &DiscoveryRepositoryInterfaceMock{
+		ListSitemapChunksFunc: func(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error) {
+			chunks := make([]pgtype.Timestamptz, (lists+chunkSize-1)/chunkSize)
+			for i := range chunks {
+				chunks[i] = updatedAt
+			}
+			return chunks, nil
+		},
+		ListSitemapEntriesFunc: func(ctx context.Context, limit, offset int) ([]*models.SitemapEntry, error) {
+			entries := []*models.SitemapEntry{}
+			for i := offset; i < lists && i < offset+limit; i++ {
+				entries = append(entries, &models.SitemapEntry{PublicSlug: "list-" + strconv.Itoa(i) + " ü", UpdatedAt: updatedAt})
+			}
+			return entries, nil
+		},
+	}
+}
+
+func TestDiscoveryService_Sitemap(t *testing.T) {
+	t.Run("index links every chunk", func(t *testing.T) {
+		svc := NewDiscoveryService(sitemapRepo(SitemapChunkSize+1), nil, testFrontendURL)
+
+		index, err := svc.SitemapIndex(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, index.URLs, 2)
+		assert.Equal(t, "https://wishlist.example.com/sitemap-1.xml", index.URLs[0].Loc)
+		assert.Equal(t, "https://wishlist.example.com/sitemap-2.xml", index.URLs[1].Loc)
+		assert.Equal(t, 2026, index.URLs[0].LastModified.Year())
+	})
+
+	t.Run("chunk lists escaped share page links", func(t *testing.T) {
+		repo := sitemapRepo(SitemapChunkSize + 1)
+		svc := NewDiscoveryService(repo, nil, testFrontendURL)
+
+		sitemap, err := svc.Sitemap(context.Background(), 2)
+
+		require.NoError(t, err)
+		require.Len(t, sitemap.URLs, 1)
+		assert.Equal(t, "https://wishlist.example.com/public/list-10000%20%C3%BC", sitemap.URLs[0].Loc)
+		assert.Equal(t, SitemapChunkSize, repo.ListSitemapEntriesCalls()[0].Offset)
+	})
+
+	t.Run("chunk out of range", func(t *testing.T) {
+		svc := NewDiscoveryService(sitemapRepo(3), nil, testFrontendURL)
+
+		for _, chunk := range []int{0, 2} {
+			_, err := svc.Sitemap(context.Background(), chunk)
+			assert.ErrorIs(t, err, ErrSitemapNotFound, chunk)
+		}
+	})
+
+	t.Run("refresh caches the index and every chunk", func(t *testing.T) {
+		repo := sitemapRepo(SitemapChunkSize + 1)
+		svc := NewDiscoveryService(repo, memoryCache{}, testFrontendURL)
+
+		require.NoError(t, svc.RefreshSitemap(context.Background()))
+		_, err := svc.SitemapIndex(context.Background())
+		require.NoError(t, err)
+		for chunk := 1; chunk <= 2; chunk++ {
+			_, err := svc.Sitemap(context.Background(), chunk)
+			require.NoError(t, err)
+		}
+
+		assert.Len(t, repo.ListSitemapChunksCalls(), 1)
+		assert.Len(t, repo.ListSitemapEntriesCalls(), 2)
+	})
+}
+
+func TestDiscoveryService_RobotsTxt(t *testing.T) {
+	robots := NewDiscoveryService(nil, nil, testFrontendURL).RobotsTxt()
+
+	assert.Contains(t, robots, "Disallow: /my/\n")
+	assert.Contains(t, robots, "Sitemap: https://wishlist.example.com/sitemap.xml\n")
+}
@@ -0,0 +1,315 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/discovery/models"
+	"wish-list/internal/domain/discovery/repository"
+)
+
+// Ensure, that DiscoveryRepositoryInterfaceMock does implement repository.DiscoveryRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.DiscoveryRepositoryInterface = &DiscoveryRepositoryInterfaceMock{}
+
+// DiscoveryRepositoryInterfaceMock is a mock implementation of repository.DiscoveryRepositoryInterface.
+//
+//	func TestSomethingThatUsesDiscoveryRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.DiscoveryRepositoryInterface
+//		mockedDiscoveryRepositoryInterface := &DiscoveryRepositoryInterfaceMock{
+//			ListByOwnerFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishlistSummary, error) {
+//				panic("mock out the ListByOwner method")
+//			},
+//			ListSitemapChunksFunc: func(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error) {
+//				panic("mock out the ListSitemapChunks method")
+//			},
+//			ListSitemapEntriesFunc: func(ctx context.Context, limit int, offset int) ([]*models.SitemapEntry, error) {
+//				panic("mock out the ListSitemapEntries method")
+//			},
+//			ListTrendingFunc: func(ctx context.Context, since time.Time, reservationWeight int, limit int) ([]*models.TrendingWishlist, error) {
+//				panic("mock out the ListTrending method")
+//			},
+//			SearchFunc: func(ctx context.Context, query string, limit int, offset int) ([]*models.WishlistSummary, int, error) {
+//				panic("mock out the Search method")
+//			},
+//		}
+//
+//		// use mockedDiscoveryRepositoryInterface in code that requires repository.DiscoveryRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type DiscoveryRepositoryInterfaceMock struct {
+	// ListByOwnerFunc mocks the ListByOwner method.
+	ListByOwnerFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishlistSummary, error)
+
+	// ListSitemapChunksFunc mocks the ListSitemapChunks method.
+	ListSitemapChunksFunc func(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error)
+
+	// ListSitemapEntriesFunc mocks the ListSitemapEntries method.
+	ListSitemapEntriesFunc func(ctx context.Context, limit int, offset int) ([]*models.SitemapEntry, error)
+
+	// ListTrendingFunc mocks the ListTrending method.
+	ListTrendingFunc func(ctx context.Context, since time.Time, reservationWeight int, limit int) ([]*models.TrendingWishlist, error)
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(ctx context.Context, query string, limit int, offset int) ([]*models.WishlistSummary, int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListByOwner holds details about calls to the ListByOwner method.
+		ListByOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// ListSitemapChunks holds details about calls to the ListSitemapChunks method.
+		ListSitemapChunks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChunkSize is the chunkSize argument value.
+			ChunkSize int
+		}
+		// ListSitemapEntries holds details about calls to the ListSitemapEntries method.
+		ListSitemapEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// ListTrending holds details about calls to the ListTrending method.
+		ListTrending []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+			// ReservationWeight is the reservationWeight argument value.
+			ReservationWeight int
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+	}
+	lockListByOwner        sync.RWMutex
+	lockListSitemapChunks  sync.RWMutex
+	lockListSitemapEntries sync.RWMutex
+	lockListTrending       sync.RWMutex
+	lockSearch             sync.RWMutex
+}
+
+// ListByOwner calls ListByOwnerFunc.
+func (mock *DiscoveryRepositoryInterfaceMock) ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishlistSummary, error) {
+	if mock.ListByOwnerFunc == nil {
+		panic("DiscoveryRepositoryInterfaceMock.ListByOwnerFunc: method is nil but DiscoveryRepositoryInterface.ListByOwner was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockListByOwner.Lock()
+	mock.calls.ListByOwner = append(mock.calls.ListByOwner, callInfo)
+	mock.lockListByOwner.Unlock()
+	return mock.ListByOwnerFunc(ctx, ownerID)
+}
+
+// ListByOwnerCalls gets all the calls that were made to ListByOwner.
+// Check the length with:
+//
+//	len(mockedDiscoveryRepositoryInterface.ListByOwnerCalls())
+func (mock *DiscoveryRepositoryInterfaceMock) ListByOwnerCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}
+	mock.lockListByOwner.RLock()
+	calls = mock.calls.ListByOwner
+	mock.lockListByOwner.RUnlock()
+	return calls
+}
+
+// ListSitemapChunks calls ListSitemapChunksFunc.
+func (mock *DiscoveryRepositoryInterfaceMock) ListSitemapChunks(ctx context.Context, chunkSize int) ([]pgtype.Timestamptz, error) {
+	if mock.ListSitemapChunksFunc == nil {
+		panic("DiscoveryRepositoryInterfaceMock.ListSitemapChunksFunc: method is nil but DiscoveryRepositoryInterface.ListSitemapChunks was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ChunkSize int
+	}{
+		Ctx:       ctx,
+		ChunkSize: chunkSize,
+	}
+	mock.lockListSitemapChunks.Lock()
+	mock.calls.ListSitemapChunks = append(mock.calls.ListSitemapChunks, callInfo)
+	mock.lockListSitemapChunks.Unlock()
+	return mock.ListSitemapChunksFunc(ctx, chunkSize)
+}
+
+// ListSitemapChunksCalls gets all the calls that were made to ListSitemapChunks.
+// Check the length with:
+//
+//	len(mockedDiscoveryRepositoryInterface.ListSitemapChunksCalls())
+func (mock *DiscoveryRepositoryInterfaceMock) ListSitemapChunksCalls() []struct {
+	Ctx       context.Context
+	ChunkSize int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ChunkSize int
+	}
+	mock.lockListSitemapChunks.RLock()
+	calls = mock.calls.ListSitemapChunks
+	mock.lockListSitemapChunks.RUnlock()
+	return calls
+}
+
+// ListSitemapEntries calls ListSitemapEntriesFunc.
+func (mock *DiscoveryRepositoryInterfaceMock) ListSitemapEntries(ctx context.Context, limit int, offset int) ([]*models.SitemapEntry, error) {
+	if mock.ListSitemapEntriesFunc == nil {
+		panic("DiscoveryRepositoryInterfaceMock.ListSitemapEntriesFunc: method is nil but DiscoveryRepositoryInterface.ListSitemapEntries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockListSitemapEntries.Lock()
+	mock.calls.ListSitemapEntries = append(mock.calls.ListSitemapEntries, callInfo)
+	mock.lockListSitemapEntries.Unlock()
+	return mock.ListSitemapEntriesFunc(ctx, limit, offset)
+}
+
+// ListSitemapEntriesCalls gets all the calls that were made to ListSitemapEntries.
+// Check the length with:
+//
+//	len(mockedDiscoveryRepositoryInterface.ListSitemapEntriesCalls())
+func (mock *DiscoveryRepositoryInterfaceMock) ListSitemapEntriesCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockListSitemapEntries.RLock()
+	calls = mock.calls.ListSitemapEntries
+	mock.lockListSitemapEntries.RUnlock()
+	return calls
+}
+
+// ListTrending calls ListTrendingFunc.
+func (mock *DiscoveryRepositoryInterfaceMock) ListTrending(ctx context.Context, since time.Time, reservationWeight int, limit int) ([]*models.TrendingWishlist, error) {
+	if mock.ListTrendingFunc == nil {
+		panic("DiscoveryRepositoryInterfaceMock.ListTrendingFunc: method is nil but DiscoveryRepositoryInterface.ListTrending was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		Since             time.Time
+		ReservationWeight int
+		Limit             int
+	}{
+		Ctx:               ctx,
+		Since:             since,
+		ReservationWeight: reservationWeight,
+		Limit:             limit,
+	}
+	mock.lockListTrending.Lock()
+	mock.calls.ListTrending = append(mock.calls.ListTrending, callInfo)
+	mock.lockListTrending.Unlock()
+	return mock.ListTrendingFunc(ctx, since, reservationWeight, limit)
+}
+
+// ListTrendingCalls gets all the calls that were made to ListTrending.
+// Check the length with:
+//
+//	len(mockedDiscoveryRepositoryInterface.ListTrendingCalls())
+func (mock *DiscoveryRepositoryInterfaceMock) ListTrendingCalls() []struct {
+	Ctx               context.Context
+	Since             time.Time
+	ReservationWeight int
+	Limit             int
+} {
+	var calls []struct {
+		Ctx               context.Context
+		Since             time.Time
+		ReservationWeight int
+		Limit             int
+	}
+	mock.lockListTrending.RLock()
+	calls = mock.calls.ListTrending
+	mock.lockListTrending.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *DiscoveryRepositoryInterfaceMock) Search(ctx context.Context, query string, limit int, offset int) ([]*models.WishlistSummary, int, error) {
+	if mock.SearchFunc == nil {
+		panic("DiscoveryRepositoryInterfaceMock.SearchFunc: method is nil but DiscoveryRepositoryInterface.Search was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Query  string
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(ctx, query, limit, offset)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+// Check the length with:
+//
+//	len(mockedDiscoveryRepositoryInterface.SearchCalls())
+func (mock *DiscoveryRepositoryInterfaceMock) SearchCalls() []struct {
+	Ctx    context.Context
+	Query  string
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Query  string
+		Limit  int
+		Offset int
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
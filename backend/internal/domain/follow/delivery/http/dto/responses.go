@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/follow/service"
+)
+
+// FollowResponse is a user the caller follows
+type FollowResponse struct {
+	UserID     string `json:"user_id" validate:"required"`
+	FollowedAt string `json:"followed_at" validate:"required"`
+}
+
+type FollowingListResponse struct {
+	Following []FollowResponse `json:"following" validate:"required"`
+}
+
+func FromFollowOutputs(outputs []*service.FollowOutput) FollowingListResponse {
+	following := make([]FollowResponse, 0, len(outputs))
+	for _, o := range outputs {
+		following = append(following, FollowResponse{
+			UserID:     o.UserID.String(),
+			FollowedAt: o.FollowedAt.Format(time.RFC3339),
+		})
+	}
+	return FollowingListResponse{Following: following}
+}
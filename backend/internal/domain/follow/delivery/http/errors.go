@@ -0,0 +1,24 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/follow/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapFollowServiceError converts follow service errors to AppErrors
+func mapFollowServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidUserID):
+		return apperrors.BadRequest("Invalid user ID")
+	case errors.Is(err, service.ErrCannotFollowSelf):
+		return apperrors.BadRequest("You cannot follow yourself")
+	case errors.Is(err, service.ErrUserNotFound):
+		return apperrors.NotFound("User not found")
+	case errors.Is(err, service.ErrNotFollowing):
+		return apperrors.NotFound("You do not follow this user")
+	default:
+		return apperrors.Internal("Follow request failed").Wrap(err)
+	}
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/follow/delivery/http/dto"
+	"wish-list/internal/domain/follow/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for follows
+type Handler struct {
+	service service.FollowServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.FollowServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// Follow godoc
+//
+//	@Summary		Follow a user
+//	@Description	Follows a user, e.g. the owner of a public wishlist (owner_id). Their public occasions appear in the follower's calendar feed. Following someone already followed succeeds.
+//	@Tags			Follows
+//	@Param			userId	path	string	true	"User ID"
+//	@Success		204		"Following"
+//	@Failure		400		{object}	map[string]string	"Invalid user ID or own ID"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		404		{object}	map[string]string	"User not found"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/follows/{userId} [post]
+func (h *Handler) Follow(c echo.Context) error {
+	followerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.Follow(c.Request().Context(), followerID, c.Param("userId")); err != nil {
+		return mapFollowServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// Unfollow godoc
+//
+//	@Summary		Unfollow a user
+//	@Tags			Follows
+//	@Param			userId	path	string	true	"User ID"
+//	@Success		204		"No longer following"
+//	@Failure		400		{object}	map[string]string	"Invalid user ID"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		404		{object}	map[string]string	"Not following this user"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/follows/{userId} [delete]
+func (h *Handler) Unfollow(c echo.Context) error {
+	followerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.Unfollow(c.Request().Context(), followerID, c.Param("userId")); err != nil {
+		return mapFollowServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// ListFollowing godoc
+//
+//	@Summary		List followed users
+//	@Description	Users the current user follows, most recent first
+//	@Tags			Follows
+//	@Produce		json
+//	@Success		200	{object}	dto.FollowingListResponse	"Followed users"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/follows [get]
+func (h *Handler) ListFollowing(c echo.Context) error {
+	followerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	following, err := h.service.ListFollowing(c.Request().Context(), followerID)
+	if err != nil {
+		return mapFollowServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromFollowOutputs(following))
+}
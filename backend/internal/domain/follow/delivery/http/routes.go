@@ -0,0 +1,13 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers follow routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected/follows", authMiddleware)
+	protected.GET("", h.ListFollowing)
+	protected.POST("/:userId", h.Follow)
+	protected.DELETE("/:userId", h.Unfollow)
+}
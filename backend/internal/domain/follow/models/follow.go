@@ -0,0 +1,12 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Follow records that one user follows another, e.g. to see their occasions in the calendar feed
+type Follow struct {
+	FollowerID pgtype.UUID        `db:"follower_id"`
+	FolloweeID pgtype.UUID        `db:"followee_id"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+}
@@ -0,0 +1,95 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_follow_repository_test.go -pkg service . FollowRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/follow/models"
+)
+
+// Sentinel errors for follow repository
+var (
+	ErrFollowNotFound = errors.New("follow not found")
+)
+
+// FollowRepositoryInterface defines the interface for follow database operations
+type FollowRepositoryInterface interface {
+	Create(ctx context.Context, followerID, followeeID pgtype.UUID) (bool, error)
+	Delete(ctx context.Context, followerID, followeeID pgtype.UUID) error
+	ListFollowing(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error)
+}
+
+type FollowRepository struct {
+	db *database.DB
+}
+
+func NewFollowRepository(db *database.DB) FollowRepositoryInterface {
+	return &FollowRepository{
+		db: db,
+	}
+}
+
+// Create records the follow and reports whether it is new. Following someone twice is not an error.
+func (r *FollowRepository) Create(ctx context.Context, followerID, followeeID pgtype.UUID) (bool, error) {
+	query := `
+		INSERT INTO user_follows (follower_id, followee_id)
+		VALUES ($1, $2)
+		ON CONFLICT (follower_id, followee_id) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to create follow: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// Delete removes the follow
+func (r *FollowRepository) Delete(ctx context.Context, followerID, followeeID pgtype.UUID) error {
+	query := `DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to delete follow: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrFollowNotFound
+	}
+
+	return nil
+}
+
+// ListFollowing returns who the user follows, most recent first
+func (r *FollowRepository) ListFollowing(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error) {
+	query := `
+		SELECT follower_id, followee_id, created_at
+		FROM user_follows
+		WHERE follower_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var follows []*models.Follow
+	if err := r.db.SelectContext(ctx, &follows, query, followerID); err != nil {
+		return nil, fmt.Errorf("failed to list follows: %w", err)
+	}
+
+	return follows, nil
+}
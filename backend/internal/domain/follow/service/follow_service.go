@@ -0,0 +1,163 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . UserRepositoryInterface NotifierInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/follow/repository"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepository "wish-list/internal/domain/user/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UserRepositoryInterface defines user repository methods used by follow service
+type UserRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+}
+
+// NotifierInterface defines notification center methods used by follow service
+type NotifierInterface interface {
+	Notify(ctx context.Context, notification notificationmodels.Notification) error
+}
+
+var (
+	ErrInvalidUserID    = errors.New("invalid user id")
+	ErrUserNotFound     = errors.New("user not found")
+	ErrCannotFollowSelf = errors.New("cannot follow yourself")
+	ErrNotFollowing     = errors.New("not following this user")
+)
+
+// FollowServiceInterface defines the interface for follow operations
+type FollowServiceInterface interface {
+	Follow(ctx context.Context, followerID pgtype.UUID, userID string) error
+	Unfollow(ctx context.Context, followerID pgtype.UUID, userID string) error
+	ListFollowing(ctx context.Context, followerID pgtype.UUID) ([]*FollowOutput, error)
+}
+
+type FollowService struct {
+	repo     repository.FollowRepositoryInterface
+	userRepo UserRepositoryInterface
+	notifier NotifierInterface
+}
+
+// NewFollowService creates a new FollowService. notifier may be nil to skip in-app notifications.
+func NewFollowService(repo repository.FollowRepositoryInterface, userRepo UserRepositoryInterface, notifier NotifierInterface) *FollowService {
+	return &FollowService{
+		repo:     repo,
+		userRepo: userRepo,
+		notifier: notifier,
+	}
+}
+
+// FollowOutput is a user the caller follows
+type FollowOutput struct {
+	UserID     pgtype.UUID
+	FollowedAt time.Time
+}
+
+// Follow makes followerID follow the user. Following someone already followed is a no-op.
+func (s *FollowService) Follow(ctx context.Context, followerID pgtype.UUID, userID string) error {
+	followeeID, err := parseUserID(userID)
+	if err != nil {
+		return err
+	}
+	if followeeID == followerID {
+		return ErrCannotFollowSelf
+	}
+
+	followee, err := s.userRepo.GetByID(ctx, followeeID)
+	if err != nil {
+		if errors.Is(err, userrepository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if followee.DeactivatedAt.Valid {
+		return ErrUserNotFound
+	}
+
+	created, err := s.repo.Create(ctx, followerID, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to follow user: %w", err)
+	}
+
+	if created {
+		s.notifyFollowee(ctx, followerID, followeeID)
+	}
+
+	return nil
+}
+
+// notifyFollowee tells a user someone started following them. Failures are logged, not returned.
+func (s *FollowService) notifyFollowee(ctx context.Context, followerID, followeeID pgtype.UUID) {
+	if s.notifier == nil {
+		return
+	}
+
+	name := "Someone"
+	if follower, err := s.userRepo.GetByID(ctx, followerID); err == nil && follower.FirstName.Valid && follower.FirstName.String != "" {
+		name = follower.FirstName.String
+	}
+
+	err := s.notifier.Notify(ctx, notificationmodels.Notification{
+		UserID: followeeID,
+		Type:   notificationmodels.TypeNewFollower,
+		Title:  "You have a new follower",
+		Body:   name + " started following you.",
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "failed to create new follower notification",
+			"followee_id", followeeID.String(),
+			"error", err)
+	}
+}
+
+// Unfollow stops followerID from following the user
+func (s *FollowService) Unfollow(ctx context.Context, followerID pgtype.UUID, userID string) error {
+	followeeID, err := parseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, followerID, followeeID); err != nil {
+		if errors.Is(err, repository.ErrFollowNotFound) {
+			return ErrNotFollowing
+		}
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+
+	return nil
+}
+
+// ListFollowing returns the users followerID follows, most recent first
+func (s *FollowService) ListFollowing(ctx context.Context, followerID pgtype.UUID) ([]*FollowOutput, error) {
+	follows, err := s.repo.ListFollowing(ctx, followerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list follows: %w", err)
+	}
+
+	outputs := make([]*FollowOutput, 0, len(follows))
+	for _, follow := range follows {
+		outputs = append(outputs, &FollowOutput{
+			UserID:     follow.FolloweeID,
+			FollowedAt: follow.CreatedAt.Time,
+		})
+	}
+
+	return outputs, nil
+}
+
+func parseUserID(userID string) (pgtype.UUID, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		return pgtype.UUID{}, ErrInvalidUserID
+	}
+	return id, nil
+}
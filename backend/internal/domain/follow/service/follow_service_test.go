@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/follow/models"
+	"wish-list/internal/domain/follow/repository"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepository "wish-list/internal/domain/user/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testFollowerID = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testFolloweeID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+)
+
+func followRepo() *FollowRepositoryInterfaceMock {
+	return &FollowRepositoryInterfaceMock{
+		CreateFunc: func(ctx context.Context, followerID, followeeID pgtype.UUID) (bool, error) {
+			return true, nil
+		},
+	}
+}
+
+func usersWith(user *usermodels.User) *UserRepositoryInterfaceMock {
+	return &UserRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+			if user == nil || id != user.ID {
+				return nil, userrepository.ErrUserNotFound
+			}
+			return user, nil
+		},
+	}
+}
+
+func TestFollowService_Follow(t *testing.T) {
+	t.Run("follows an existing user", func(t *testing.T) {
+		repo := followRepo()
+		svc := NewFollowService(repo, usersWith(&usermodels.User{ID: testFolloweeID}), nil)
+
+		require.NoError(t, svc.Follow(context.Background(), testFollowerID, testFolloweeID.String()))
+
+		require.Len(t, repo.CreateCalls(), 1)
+		assert.Equal(t, testFollowerID, repo.CreateCalls()[0].FollowerID)
+		assert.Equal(t, testFolloweeID, repo.CreateCalls()[0].FolloweeID)
+	})
+
+	tests := []struct {
+		name    string
+		user    *usermodels.User
+		userID  string
+		wantErr error
+	}{
+		{"invalid id", nil, "nope", ErrInvalidUserID},
+		{"self", &usermodels.User{ID: testFollowerID}, testFollowerID.String(), ErrCannotFollowSelf},
+		{"unknown user", nil, testFolloweeID.String(), ErrUserNotFound},
+		{
+			"deactivated user",
+			&usermodels.User{ID: testFolloweeID, DeactivatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+			testFolloweeID.String(),
+			ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := followRepo()
+
+			err := NewFollowService(repo, usersWith(tt.user), nil).Follow(context.Background(), testFollowerID, tt.userID)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, repo.CreateCalls())
+		})
+	}
+}
+
+func TestFollowService_Follow_NotifiesFollowee(t *testing.T) {
+	notifier := &NotifierInterfaceMock{
+		NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error {
+			return nil
+		},
+	}
+
+	t.Run("new follow notifies the followee", func(t *testing.T) {
+		svc := NewFollowService(followRepo(), usersWith(&usermodels.User{ID: testFolloweeID}), notifier)
+
+		require.NoError(t, svc.Follow(context.Background(), testFollowerID, testFolloweeID.String()))
+
+		require.Len(t, notifier.NotifyCalls(), 1)
+		notification := notifier.NotifyCalls()[0].Notification
+		assert.Equal(t, testFolloweeID, notification.UserID)
+		assert.Equal(t, notificationmodels.TypeNewFollower, notification.Type)
+	})
+
+	t.Run("following again does not notify", func(t *testing.T) {
+		repo := &FollowRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, followerID, followeeID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+		}
+		calls := len(notifier.NotifyCalls())
+		svc := NewFollowService(repo, usersWith(&usermodels.User{ID: testFolloweeID}), notifier)
+
+		require.NoError(t, svc.Follow(context.Background(), testFollowerID, testFolloweeID.String()))
+
+		assert.Len(t, notifier.NotifyCalls(), calls)
+	})
+}
+
+func TestFollowService_Unfollow(t *testing.T) {
+	repo := &FollowRepositoryInterfaceMock{
+		DeleteFunc: func(ctx context.Context, followerID, followeeID pgtype.UUID) error {
+			return repository.ErrFollowNotFound
+		},
+	}
+
+	err := NewFollowService(repo, usersWith(nil), nil).Unfollow(context.Background(), testFollowerID, testFolloweeID.String())
+
+	assert.ErrorIs(t, err, ErrNotFollowing)
+}
+
+func TestFollowService_ListFollowing(t *testing.T) {
+	followedAt := time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC)
+	repo := &FollowRepositoryInterfaceMock{
+		ListFollowingFunc: func(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error) {
+			return []*models.Follow{{
+				FollowerID: followerID,
+				FolloweeID: testFolloweeID,
+				CreatedAt:  pgtype.Timestamptz{Time: followedAt, Valid: true},
+			}}, nil
+		},
+	}
+
+	following, err := NewFollowService(repo, usersWith(nil), nil).ListFollowing(context.Background(), testFollowerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*FollowOutput{{UserID: testFolloweeID, FollowedAt: followedAt}}, following)
+}
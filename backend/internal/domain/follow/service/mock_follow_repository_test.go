@@ -0,0 +1,196 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/follow/models"
+	"wish-list/internal/domain/follow/repository"
+)
+
+// Ensure, that FollowRepositoryInterfaceMock does implement repository.FollowRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.FollowRepositoryInterface = &FollowRepositoryInterfaceMock{}
+
+// FollowRepositoryInterfaceMock is a mock implementation of repository.FollowRepositoryInterface.
+//
+//	func TestSomethingThatUsesFollowRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.FollowRepositoryInterface
+//		mockedFollowRepositoryInterface := &FollowRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) (bool, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			ListFollowingFunc: func(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error) {
+//				panic("mock out the ListFollowing method")
+//			},
+//		}
+//
+//		// use mockedFollowRepositoryInterface in code that requires repository.FollowRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type FollowRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) (bool, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) error
+
+	// ListFollowingFunc mocks the ListFollowing method.
+	ListFollowingFunc func(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FollowerID is the followerID argument value.
+			FollowerID pgtype.UUID
+			// FolloweeID is the followeeID argument value.
+			FolloweeID pgtype.UUID
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FollowerID is the followerID argument value.
+			FollowerID pgtype.UUID
+			// FolloweeID is the followeeID argument value.
+			FolloweeID pgtype.UUID
+		}
+		// ListFollowing holds details about calls to the ListFollowing method.
+		ListFollowing []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FollowerID is the followerID argument value.
+			FollowerID pgtype.UUID
+		}
+	}
+	lockCreate        sync.RWMutex
+	lockDelete        sync.RWMutex
+	lockListFollowing sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *FollowRepositoryInterfaceMock) Create(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) (bool, error) {
+	if mock.CreateFunc == nil {
+		panic("FollowRepositoryInterfaceMock.CreateFunc: method is nil but FollowRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+		FolloweeID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, followerID, followeeID)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedFollowRepositoryInterface.CreateCalls())
+func (mock *FollowRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx        context.Context
+	FollowerID pgtype.UUID
+	FolloweeID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+		FolloweeID pgtype.UUID
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *FollowRepositoryInterfaceMock) Delete(ctx context.Context, followerID pgtype.UUID, followeeID pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("FollowRepositoryInterfaceMock.DeleteFunc: method is nil but FollowRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+		FolloweeID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, followerID, followeeID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedFollowRepositoryInterface.DeleteCalls())
+func (mock *FollowRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx        context.Context
+	FollowerID pgtype.UUID
+	FolloweeID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+		FolloweeID pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// ListFollowing calls ListFollowingFunc.
+func (mock *FollowRepositoryInterfaceMock) ListFollowing(ctx context.Context, followerID pgtype.UUID) ([]*models.Follow, error) {
+	if mock.ListFollowingFunc == nil {
+		panic("FollowRepositoryInterfaceMock.ListFollowingFunc: method is nil but FollowRepositoryInterface.ListFollowing was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		FollowerID: followerID,
+	}
+	mock.lockListFollowing.Lock()
+	mock.calls.ListFollowing = append(mock.calls.ListFollowing, callInfo)
+	mock.lockListFollowing.Unlock()
+	return mock.ListFollowingFunc(ctx, followerID)
+}
+
+// ListFollowingCalls gets all the calls that were made to ListFollowing.
+// Check the length with:
+//
+//	len(mockedFollowRepositoryInterface.ListFollowingCalls())
+func (mock *FollowRepositoryInterfaceMock) ListFollowingCalls() []struct {
+	Ctx        context.Context
+	FollowerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FollowerID pgtype.UUID
+	}
+	mock.lockListFollowing.RLock()
+	calls = mock.calls.ListFollowing
+	mock.lockListFollowing.RUnlock()
+	return calls
+}
@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"wish-list/internal/domain/gift_history/service"
+)
+
+type GiftHistoryItemResponse struct {
+	GiftItemID    *string  `json:"gift_item_id"`
+	ItemName      string   `json:"item_name" validate:"required"`
+	Price         *float64 `json:"price"`
+	WishlistID    *string  `json:"wishlist_id"` // null once the wishlist was deleted
+	WishlistTitle string   `json:"wishlist_title" validate:"required"`
+	Occasion      *string  `json:"occasion"`
+	OccasionDate  *string  `json:"occasion_date"`
+	GiverUserID   *string  `json:"giver_user_id"`
+	GiverName     string   `json:"giver_name" validate:"required"`
+	GiverMessage  *string  `json:"giver_message"` // Note the giver left when reserving
+	Kind          string   `json:"kind" validate:"required" enums:"reserved,purchased"`
+	GivenAt       string   `json:"given_at" validate:"required"`
+}
+
+type GiftHistoryYearResponse struct {
+	Year  int                       `json:"year" validate:"required" example:"2025"`
+	Count int                       `json:"count" validate:"required"`
+	Gifts []GiftHistoryItemResponse `json:"gifts" validate:"required"`
+}
+
+type GiftHistoryGiverResponse struct {
+	GiverUserID *string                   `json:"giver_user_id"`
+	GiverName   string                    `json:"giver_name" validate:"required"`
+	Count       int                       `json:"count" validate:"required"`
+	Gifts       []GiftHistoryItemResponse `json:"gifts" validate:"required"`
+}
+
+type GiftHistoryResponse struct {
+	TotalGifts int                        `json:"total_gifts" validate:"required"`
+	ByYear     []GiftHistoryYearResponse  `json:"by_year" validate:"required"`
+	ByGiver    []GiftHistoryGiverResponse `json:"by_giver" validate:"required"`
+}
+
+func FromGiftHistoryOutput(h *service.GiftHistoryOutput) GiftHistoryResponse {
+	resp := GiftHistoryResponse{
+		TotalGifts: h.TotalGifts,
+		ByYear:     make([]GiftHistoryYearResponse, len(h.Years)),
+		ByGiver:    make([]GiftHistoryGiverResponse, len(h.Givers)),
+	}
+
+	for i, year := range h.Years {
+		resp.ByYear[i] = GiftHistoryYearResponse{
+			Year:  year.Year,
+			Count: len(year.Gifts),
+			Gifts: fromGiftOutputs(year.Gifts),
+		}
+	}
+
+	for i, giver := range h.Givers {
+		resp.ByGiver[i] = GiftHistoryGiverResponse{
+			GiverUserID: uuidPtr(giver.GiverUserID.Valid, giver.GiverUserID.String()),
+			GiverName:   giver.GiverName,
+			Count:       len(giver.Gifts),
+			Gifts:       fromGiftOutputs(giver.Gifts),
+		}
+	}
+
+	return resp
+}
+
+func fromGiftOutputs(gifts []*service.GiftOutput) []GiftHistoryItemResponse {
+	responses := make([]GiftHistoryItemResponse, len(gifts))
+	for i, g := range gifts {
+		item := GiftHistoryItemResponse{
+			GiftItemID:    uuidPtr(g.GiftItemID.Valid, g.GiftItemID.String()),
+			ItemName:      g.ItemName,
+			Price:         g.Price,
+			WishlistID:    uuidPtr(g.WishlistID.Valid, g.WishlistID.String()),
+			WishlistTitle: g.WishlistTitle,
+			GiverUserID:   uuidPtr(g.GiverUserID.Valid, g.GiverUserID.String()),
+			GiverName:     g.GiverName,
+			Kind:          g.Kind,
+			GivenAt:       g.GivenAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		}
+
+		if g.GiverMessage != "" {
+			message := g.GiverMessage
+			item.GiverMessage = &message
+		}
+
+		if g.Occasion.Valid {
+			occasion := g.Occasion.String
+			item.Occasion = &occasion
+		}
+
+		if g.OccasionDate.Valid {
+			occasionDate := g.OccasionDate.Time.Format("2006-01-02")
+			item.OccasionDate = &occasionDate
+		}
+
+		responses[i] = item
+	}
+	return responses
+}
+
+func uuidPtr(valid bool, value string) *string {
+	if !valid {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,10 @@
+package http
+
+import (
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapGiftHistoryServiceError converts gift history service errors to AppErrors
+func mapGiftHistoryServiceError(err error) error {
+	return apperrors.Internal("Failed to get gift history").Wrap(err)
+}
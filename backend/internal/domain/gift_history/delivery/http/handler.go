@@ -0,0 +1,49 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/gift_history/delivery/http/dto"
+	"wish-list/internal/domain/gift_history/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for gift history
+type Handler struct {
+	service service.GiftHistoryServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.GiftHistoryServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// GetGiftHistory godoc
+//
+//	@Summary		Get gift history
+//	@Description	Gifts reserved or purchased for the current user on past wishlists, grouped by year and by giver. A wishlist counts as past once its occasion date has passed or it was deleted, so upcoming surprises are never revealed. Messages givers left when reserving are included, so they too stay hidden until then.
+//	@Tags			User
+//	@Produce		json
+//	@Success		200	{object}	dto.GiftHistoryResponse	"Gift history"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/gift-history [get]
+func (h *Handler) GetGiftHistory(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	history, err := h.service.GetGiftHistory(c.Request().Context(), userID)
+	if err != nil {
+		return mapGiftHistoryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromGiftHistoryOutput(history))
+}
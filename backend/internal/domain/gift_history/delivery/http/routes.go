@@ -0,0 +1,11 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers gift history HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected", authMiddleware)
+	protected.GET("/gift-history", h.GetGiftHistory)
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Gift record kinds
+const (
+	KindReserved  = "reserved"
+	KindPurchased = "purchased"
+)
+
+// GiftRecord is a gift reserved or purchased for a user on one of their wishlists,
+// read either live or from a snapshot taken when the wishlist was deleted
+type GiftRecord struct {
+	GiftItemID            pgtype.UUID        `db:"gift_item_id"`
+	ItemName              string             `db:"item_name"`
+	Price                 pgtype.Numeric     `db:"price"`
+	WishlistID            pgtype.UUID        `db:"wishlist_id"` // NULL once the wishlist is deleted
+	WishlistTitle         string             `db:"wishlist_title"`
+	Occasion              pgtype.Text        `db:"occasion"`
+	OccasionDate          pgtype.Date        `db:"occasion_date"`
+	GiverUserID           pgtype.UUID        `db:"giver_user_id"` // NULL for guests and manual reservations
+	GiverName             pgtype.Text        `db:"giver_name"`
+	EncryptedGiverName    pgtype.Text        `db:"encrypted_giver_name"`    // PII encrypted
+	GiverMessage          pgtype.Text        `db:"giver_message"`           // Note left with the reservation
+	EncryptedGiverMessage pgtype.Text        `db:"encrypted_giver_message"` // PII encrypted
+	Kind                  string             `db:"kind"`
+	GivenAt               pgtype.Timestamptz `db:"given_at"`
+}
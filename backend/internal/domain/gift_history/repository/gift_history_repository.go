@@ -0,0 +1,295 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_gift_history_repository_test.go -pkg service . GiftHistoryRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/gift_history/models"
+	"wish-list/internal/pkg/encryption"
+)
+
+// GiftHistoryRepositoryInterface defines the interface for gift history database operations
+type GiftHistoryRepositoryInterface interface {
+	ListByRecipient(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error)
+	SnapshotWishlist(ctx context.Context, wishlistID pgtype.UUID) (int64, error)
+	AnonymizeGuestGiverByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+type GiftHistoryRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewGiftHistoryRepository(db *database.DB) GiftHistoryRepositoryInterface {
+	return &GiftHistoryRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewGiftHistoryRepositoryWithEncryption creates a new GiftHistoryRepository with encryption enabled
+func NewGiftHistoryRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) GiftHistoryRepositoryInterface {
+	return &GiftHistoryRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// liveGiftsQuery selects gifts on existing wishlists matching filter (a condition on alias w):
+// purchases, active/fulfilled reservations and manual reservations of items not yet purchased.
+// Purchases carry the message of the latest reservation of the item on the wishlist.
+// Items the owner marked as received elsewhere are stored as purchased by the owner;
+// nobody on the wishlist gave them, so they are left out.
+// Guest and manual reservation names and messages are copied as stored, so encrypted values stay encrypted.
+// giver_email is the guest's address when the name or message came from a guest reservation.
+func liveGiftsQuery(filter string) string {
+	return `
+		SELECT
+			gi.id AS gift_item_id, gi.name AS item_name, COALESCE(gi.purchased_price, gi.price) AS price,
+			w.id AS wishlist_id, w.owner_id AS recipient_user_id, w.title AS wishlist_title,
+			w.occasion, w.occasion_date,
+			gi.purchased_by_user_id AS giver_user_id,
+			NULLIF(TRIM(CONCAT_WS(' ', u.first_name, u.last_name)), '') AS giver_name,
+			NULL::text AS encrypted_giver_name,
+			pr.message AS giver_message, pr.encrypted_message AS encrypted_giver_message,
+			pr.guest_email AS giver_email, pr.encrypted_guest_email AS encrypted_giver_email,
+			'purchased' AS kind, gi.purchased_at AS given_at
+		FROM wishlists w
+		JOIN wishlist_items wi ON wi.wishlist_id = w.id
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		LEFT JOIN users u ON u.id = gi.purchased_by_user_id
+		LEFT JOIN LATERAL (
+			SELECT r.message, r.encrypted_message,
+				CASE WHEN r.reserved_by_user_id IS NULL THEN r.guest_email END AS guest_email,
+				CASE WHEN r.reserved_by_user_id IS NULL THEN r.encrypted_guest_email END AS encrypted_guest_email
+			FROM reservations r
+			WHERE r.gift_item_id = gi.id AND r.wishlist_id = w.id AND r.status IN ('active', 'fulfilled')
+			ORDER BY r.reserved_at DESC
+			LIMIT 1
+		) pr ON true
+		WHERE ` + filter + ` AND gi.purchased_at IS NOT NULL
+			AND gi.purchased_by_user_id IS DISTINCT FROM w.owner_id
+
+		UNION ALL
+
+		SELECT
+			gi.id, gi.name, gi.price,
+			w.id, w.owner_id, w.title, w.occasion, w.occasion_date,
+			r.reserved_by_user_id,
+			COALESCE(NULLIF(TRIM(CONCAT_WS(' ', u.first_name, u.last_name)), ''), r.guest_name),
+			CASE WHEN r.reserved_by_user_id IS NULL THEN r.encrypted_guest_name END,
+			r.message, r.encrypted_message,
+			CASE WHEN r.reserved_by_user_id IS NULL THEN r.guest_email END,
+			CASE WHEN r.reserved_by_user_id IS NULL THEN r.encrypted_guest_email END,
+			'reserved', r.reserved_at
+		FROM reservations r
+		JOIN wishlists w ON w.id = r.wishlist_id
+		JOIN gift_items gi ON gi.id = r.gift_item_id
+		LEFT JOIN users u ON u.id = r.reserved_by_user_id
+		WHERE ` + filter + ` AND r.status IN ('active', 'fulfilled') AND gi.purchased_at IS NULL
+
+		UNION ALL
+
+		SELECT
+			gi.id, gi.name, gi.price,
+			w.id, w.owner_id, w.title, w.occasion, w.occasion_date,
+			NULL::uuid, gi.manual_reserved_by_name, gi.encrypted_manual_reserved_by_name,
+			NULL::text, NULL::text, NULL::text, NULL::text,
+			'reserved', gi.manual_reserved_at
+		FROM wishlists w
+		JOIN wishlist_items wi ON wi.wishlist_id = w.id
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE ` + filter + ` AND gi.manual_reserved_at IS NOT NULL AND gi.purchased_at IS NULL
+	`
+}
+
+// decryptGiverPII restores a guest or manual reservation name and the giver's
+// message from the encrypted columns
+func (r *GiftHistoryRepository) decryptGiverPII(ctx context.Context, record *models.GiftRecord) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if record.EncryptedGiverName.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, record.EncryptedGiverName.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt giver name: %w", err)
+		}
+		record.GiverName = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	if record.EncryptedGiverMessage.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, record.EncryptedGiverMessage.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt giver message: %w", err)
+		}
+		record.GiverMessage = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	return nil
+}
+
+// ListByRecipient returns the gifts given to a user on their past wishlists, newest first.
+// A wishlist is past once its occasion date has passed; gifts on wishlists without an
+// occasion date only appear after the wishlist is deleted, so upcoming surprises stay hidden.
+func (r *GiftHistoryRepository) ListByRecipient(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+	query := `
+		SELECT
+			gift_item_id, item_name, price, wishlist_id, wishlist_title, occasion, occasion_date,
+			giver_user_id, giver_name, encrypted_giver_name, giver_message, encrypted_giver_message,
+			kind, given_at
+		FROM (` + liveGiftsQuery(`w.owner_id = $1 AND w.occasion_date < CURRENT_DATE`) + `) live
+
+		UNION ALL
+
+		SELECT
+			gh.gift_item_id, gh.item_name, gh.price, NULL::uuid, gh.wishlist_title, gh.occasion, gh.occasion_date,
+			gh.giver_user_id, gh.giver_name, gh.encrypted_giver_name, gh.giver_message, gh.encrypted_giver_message,
+			gh.kind, gh.given_at
+		FROM gift_history gh
+		WHERE gh.recipient_user_id = $1
+		  AND NOT EXISTS (SELECT 1 FROM wishlists w WHERE w.id = gh.source_wishlist_id)
+
+		ORDER BY given_at DESC
+	`
+
+	var records []*models.GiftRecord
+	if err := r.db.SelectContext(ctx, &records, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list gift history: %w", err)
+	}
+
+	for _, record := range records {
+		if err := r.decryptGiverPII(ctx, record); err != nil {
+			return nil, fmt.Errorf("failed to decrypt gift history PII: %w", err)
+		}
+	}
+
+	return records, nil
+}
+
+// SnapshotWishlist copies the gifts on a wishlist into gift_history so they outlive its deletion.
+// Safe to call more than once for the same wishlist.
+func (r *GiftHistoryRepository) SnapshotWishlist(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+	query := `
+		INSERT INTO gift_history (
+			recipient_user_id, source_wishlist_id, gift_item_id, item_name, price,
+			wishlist_title, occasion, occasion_date, giver_user_id, giver_name,
+			encrypted_giver_name, giver_message, encrypted_giver_message,
+			giver_email, encrypted_giver_email, kind, given_at
+		)
+		SELECT
+			recipient_user_id, wishlist_id, gift_item_id, item_name, price,
+			wishlist_title, occasion, occasion_date, giver_user_id, giver_name,
+			encrypted_giver_name, giver_message, encrypted_giver_message,
+			giver_email, encrypted_giver_email, kind, given_at
+		FROM (` + liveGiftsQuery(`w.id = $1`) + `) live
+		ON CONFLICT (source_wishlist_id, gift_item_id, kind) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query, wishlistID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to snapshot wishlist gift history: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// AnonymizeGuestGiverByEmail removes a guest's name, message and address from the
+// snapshotted gifts they gave. The gifts are kept so recipients still see what they
+// were given. Returns the number of anonymized rows.
+func (r *GiftHistoryRepository) AnonymizeGuestGiverByEmail(ctx context.Context, guestEmail string) (int, error) {
+	normalizedEmail := strings.ToLower(strings.TrimSpace(guestEmail))
+	if normalizedEmail == "" {
+		return 0, nil
+	}
+
+	ids, err := r.guestGiverRecordIDs(ctx, normalizedEmail)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// The name of a registered purchaser is theirs, not the guest's, so it stays
+	query := `
+		UPDATE gift_history
+		SET giver_name = CASE WHEN giver_user_id IS NULL THEN NULL ELSE giver_name END,
+		    encrypted_giver_name = CASE WHEN giver_user_id IS NULL THEN NULL ELSE encrypted_giver_name END,
+		    giver_message = NULL,
+		    encrypted_giver_message = NULL,
+		    giver_email = NULL,
+		    encrypted_giver_email = NULL
+		WHERE id = ANY($1::uuid[])
+	`
+
+	result, err := r.db.ExecContext(ctx, query, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize gift history: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+// guestGiverRecordIDs returns the gift history rows holding a guest's address.
+// Encrypted addresses are compared after decrypting, as for reservations.
+func (r *GiftHistoryRepository) guestGiverRecordIDs(ctx context.Context, normalizedEmail string) ([]pgtype.UUID, error) {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		var ids []pgtype.UUID
+		query := `SELECT id FROM gift_history WHERE LOWER(TRIM(giver_email)) = $1`
+		if err := r.db.SelectContext(ctx, &ids, query, normalizedEmail); err != nil {
+			return nil, fmt.Errorf("failed to find guest gift history: %w", err)
+		}
+		return ids, nil
+	}
+
+	var candidates []struct {
+		ID                  pgtype.UUID `db:"id"`
+		GiverEmail          pgtype.Text `db:"giver_email"`
+		EncryptedGiverEmail pgtype.Text `db:"encrypted_giver_email"`
+	}
+	query := `
+		SELECT id, giver_email, encrypted_giver_email
+		FROM gift_history
+		WHERE giver_email IS NOT NULL OR encrypted_giver_email IS NOT NULL
+	`
+	if err := r.db.SelectContext(ctx, &candidates, query); err != nil {
+		return nil, fmt.Errorf("failed to load guest gift history candidates: %w", err)
+	}
+
+	var ids []pgtype.UUID
+	for _, candidate := range candidates {
+		email := candidate.GiverEmail.String
+		if candidate.EncryptedGiverEmail.Valid {
+			decrypted, err := r.encryptionSvc.Decrypt(ctx, candidate.EncryptedGiverEmail.String)
+			if err != nil {
+				// Skip corrupted row to keep matching best-effort, as for reservations
+				continue
+			}
+			email = decrypted
+		}
+		if strings.ToLower(strings.TrimSpace(email)) == normalizedEmail {
+			ids = append(ids, candidate.ID)
+		}
+	}
+
+	return ids, nil
+}
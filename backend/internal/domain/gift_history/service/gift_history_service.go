@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"wish-list/internal/domain/gift_history/models"
+	"wish-list/internal/domain/gift_history/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AnonymousGiverName labels gifts whose giver left no name (e.g. anonymized guest reservations)
+const AnonymousGiverName = "Anonymous"
+
+// GiftHistoryServiceInterface defines the interface for gift history operations
+type GiftHistoryServiceInterface interface {
+	GetGiftHistory(ctx context.Context, userID pgtype.UUID) (*GiftHistoryOutput, error)
+}
+
+type GiftHistoryService struct {
+	repo repository.GiftHistoryRepositoryInterface
+}
+
+func NewGiftHistoryService(repo repository.GiftHistoryRepositoryInterface) *GiftHistoryService {
+	return &GiftHistoryService{
+		repo: repo,
+	}
+}
+
+type GiftOutput struct {
+	GiftItemID    pgtype.UUID
+	ItemName      string
+	Price         *float64
+	WishlistID    pgtype.UUID
+	WishlistTitle string
+	Occasion      pgtype.Text
+	OccasionDate  pgtype.Date
+	GiverUserID   pgtype.UUID
+	GiverName     string
+	GiverMessage  string // Note the giver left with the reservation, empty for none
+	Kind          string
+	GivenAt       pgtype.Timestamptz
+}
+
+// YearOutput groups gifts by the year of their occasion (or of the gift when the wishlist had no date)
+type YearOutput struct {
+	Year  int
+	Gifts []*GiftOutput
+}
+
+// GiverOutput groups gifts by the person who gave them
+type GiverOutput struct {
+	GiverUserID pgtype.UUID
+	GiverName   string
+	Gifts       []*GiftOutput
+}
+
+type GiftHistoryOutput struct {
+	TotalGifts int
+	Years      []*YearOutput
+	Givers     []*GiverOutput
+}
+
+// GetGiftHistory aggregates what was reserved or purchased for the user on past wishlists,
+// grouped by year (newest first) and by giver (most gifts first)
+func (s *GiftHistoryService) GetGiftHistory(ctx context.Context, userID pgtype.UUID) (*GiftHistoryOutput, error) {
+	records, err := s.repo.ListByRecipient(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gift history: %w", err)
+	}
+
+	years := make(map[int]*YearOutput)
+	givers := make(map[string]*GiverOutput)
+	output := &GiftHistoryOutput{
+		TotalGifts: len(records),
+		Years:      []*YearOutput{},
+		Givers:     []*GiverOutput{},
+	}
+
+	for _, record := range records {
+		gift := toGiftOutput(record)
+
+		year := giftYear(record)
+		if _, ok := years[year]; !ok {
+			years[year] = &YearOutput{Year: year}
+			output.Years = append(output.Years, years[year])
+		}
+		years[year].Gifts = append(years[year].Gifts, gift)
+
+		key := giverKey(gift)
+		if _, ok := givers[key]; !ok {
+			givers[key] = &GiverOutput{GiverUserID: gift.GiverUserID, GiverName: gift.GiverName}
+			output.Givers = append(output.Givers, givers[key])
+		}
+		givers[key].Gifts = append(givers[key].Gifts, gift)
+	}
+
+	sort.SliceStable(output.Years, func(i, j int) bool {
+		return output.Years[i].Year > output.Years[j].Year
+	})
+	sort.SliceStable(output.Givers, func(i, j int) bool {
+		if len(output.Givers[i].Gifts) != len(output.Givers[j].Gifts) {
+			return len(output.Givers[i].Gifts) > len(output.Givers[j].Gifts)
+		}
+		return output.Givers[i].GiverName < output.Givers[j].GiverName
+	})
+
+	return output, nil
+}
+
+func giftYear(record *models.GiftRecord) int {
+	if record.OccasionDate.Valid {
+		return record.OccasionDate.Time.Year()
+	}
+	return record.GivenAt.Time.Year()
+}
+
+// giverKey identifies registered givers by account and others by (case-insensitive) name
+func giverKey(gift *GiftOutput) string {
+	if gift.GiverUserID.Valid {
+		return "user:" + gift.GiverUserID.String()
+	}
+	return "name:" + strings.ToLower(gift.GiverName)
+}
+
+func toGiftOutput(record *models.GiftRecord) *GiftOutput {
+	gift := &GiftOutput{
+		GiftItemID:    record.GiftItemID,
+		ItemName:      record.ItemName,
+		WishlistID:    record.WishlistID,
+		WishlistTitle: record.WishlistTitle,
+		Occasion:      record.Occasion,
+		OccasionDate:  record.OccasionDate,
+		GiverUserID:   record.GiverUserID,
+		GiverName:     AnonymousGiverName,
+		Kind:          record.Kind,
+		GivenAt:       record.GivenAt,
+	}
+
+	if name := strings.TrimSpace(record.GiverName.String); record.GiverName.Valid && name != "" {
+		gift.GiverName = name
+	}
+
+	if record.GiverMessage.Valid {
+		gift.GiverMessage = record.GiverMessage.String
+	}
+
+	if record.Price.Valid {
+		if value, err := record.Price.Float64Value(); err == nil && value.Valid {
+			gift.Price = &value.Float64
+		}
+	}
+
+	return gift
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/gift_history/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testRecipientID = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testGiverID     = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+)
+
+func giftRecord(name string, occasionDate, givenAt time.Time, giverID pgtype.UUID, giverName string) *models.GiftRecord {
+	record := &models.GiftRecord{
+		ItemName:      name,
+		WishlistTitle: "Birthday",
+		GiverUserID:   giverID,
+		GiverName:     pgtype.Text{String: giverName, Valid: giverName != ""},
+		Kind:          models.KindPurchased,
+		GivenAt:       pgtype.Timestamptz{Time: givenAt, Valid: true},
+	}
+	if !occasionDate.IsZero() {
+		record.OccasionDate = pgtype.Date{Time: occasionDate, Valid: true}
+	}
+	return record
+}
+
+func TestGiftHistoryService_GetGiftHistory(t *testing.T) {
+	t.Run("groups gifts by year and giver", func(t *testing.T) {
+		repo := &GiftHistoryRepositoryInterfaceMock{
+			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+				return []*models.GiftRecord{
+					giftRecord("Book", time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC), testGiverID, "Alex"),
+					giftRecord("Scarf", time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), pgtype.UUID{}, "Sam"),
+					giftRecord("Mug", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 28, 0, 0, 0, 0, time.UTC), testGiverID, "Alex"),
+					giftRecord("Socks", time.Time{}, time.Date(2023, 3, 3, 0, 0, 0, 0, time.UTC), pgtype.UUID{}, "sam"),
+				}, nil
+			},
+		}
+
+		svc := NewGiftHistoryService(repo)
+		history, err := svc.GetGiftHistory(context.Background(), testRecipientID)
+
+		require.NoError(t, err)
+		require.Len(t, repo.ListByRecipientCalls(), 1)
+		assert.Equal(t, testRecipientID, repo.ListByRecipientCalls()[0].UserID)
+		assert.Equal(t, 4, history.TotalGifts)
+
+		require.Len(t, history.Years, 3)
+		assert.Equal(t, 2025, history.Years[0].Year)
+		assert.Equal(t, 2024, history.Years[1].Year)
+		assert.Len(t, history.Years[1].Gifts, 2)
+		assert.Equal(t, 2023, history.Years[2].Year, "falls back to the gift date without an occasion date")
+
+		require.Len(t, history.Givers, 2)
+		assert.Equal(t, "Alex", history.Givers[0].GiverName)
+		assert.Equal(t, testGiverID, history.Givers[0].GiverUserID)
+		assert.Len(t, history.Givers[0].Gifts, 2)
+		assert.Equal(t, "Sam", history.Givers[1].GiverName)
+		assert.Len(t, history.Givers[1].Gifts, 2, "guest names are matched case-insensitively")
+	})
+
+	t.Run("labels gifts without a giver name as anonymous", func(t *testing.T) {
+		repo := &GiftHistoryRepositoryInterfaceMock{
+			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+				record := giftRecord("Lamp", time.Time{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), pgtype.UUID{}, "")
+				record.Price = pgtype.Numeric{Int: big.NewInt(100), Exp: -2, Valid: true}
+				return []*models.GiftRecord{record}, nil
+			},
+		}
+
+		svc := NewGiftHistoryService(repo)
+		history, err := svc.GetGiftHistory(context.Background(), testRecipientID)
+
+		require.NoError(t, err)
+		require.Len(t, history.Givers, 1)
+		assert.Equal(t, AnonymousGiverName, history.Givers[0].GiverName)
+		require.NotNil(t, history.Years[0].Gifts[0].Price)
+		assert.InDelta(t, 1.0, *history.Years[0].Gifts[0].Price, 0.001)
+	})
+
+	t.Run("keeps the giver's message", func(t *testing.T) {
+		repo := &GiftHistoryRepositoryInterfaceMock{
+			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+				record := giftRecord("Kettle", time.Time{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), pgtype.UUID{}, "Aunt Maria")
+				record.GiverMessage = pgtype.Text{String: "Will bring it Saturday", Valid: true}
+				return []*models.GiftRecord{record}, nil
+			},
+		}
+
+		history, err := NewGiftHistoryService(repo).GetGiftHistory(context.Background(), testRecipientID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Will bring it Saturday", history.Years[0].Gifts[0].GiverMessage)
+	})
+
+	t.Run("returns empty groups without gifts", func(t *testing.T) {
+		repo := &GiftHistoryRepositoryInterfaceMock{
+			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+				return nil, nil
+			},
+		}
+
+		history, err := NewGiftHistoryService(repo).GetGiftHistory(context.Background(), testRecipientID)
+
+		require.NoError(t, err)
+		assert.Zero(t, history.TotalGifts)
+		assert.NotNil(t, history.Years)
+		assert.NotNil(t, history.Givers)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		repoErr := errors.New("db down")
+		repo := &GiftHistoryRepositoryInterfaceMock{
+			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+				return nil, repoErr
+			},
+		}
+
+		history, err := NewGiftHistoryService(repo).GetGiftHistory(context.Background(), testRecipientID)
+
+		require.ErrorIs(t, err, repoErr)
+		assert.Nil(t, history)
+	})
+}
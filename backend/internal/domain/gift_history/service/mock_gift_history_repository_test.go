@@ -0,0 +1,184 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/gift_history/models"
+	"wish-list/internal/domain/gift_history/repository"
+)
+
+// Ensure, that GiftHistoryRepositoryInterfaceMock does implement repository.GiftHistoryRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GiftHistoryRepositoryInterface = &GiftHistoryRepositoryInterfaceMock{}
+
+// GiftHistoryRepositoryInterfaceMock is a mock implementation of repository.GiftHistoryRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftHistoryRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GiftHistoryRepositoryInterface
+//		mockedGiftHistoryRepositoryInterface := &GiftHistoryRepositoryInterfaceMock{
+//			AnonymizeGuestGiverByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the AnonymizeGuestGiverByEmail method")
+//			},
+//			ListByRecipientFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+//				panic("mock out the ListByRecipient method")
+//			},
+//			SnapshotWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+//				panic("mock out the SnapshotWishlist method")
+//			},
+//		}
+//
+//		// use mockedGiftHistoryRepositoryInterface in code that requires repository.GiftHistoryRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftHistoryRepositoryInterfaceMock struct {
+	// AnonymizeGuestGiverByEmailFunc mocks the AnonymizeGuestGiverByEmail method.
+	AnonymizeGuestGiverByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListByRecipientFunc mocks the ListByRecipient method.
+	ListByRecipientFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error)
+
+	// SnapshotWishlistFunc mocks the SnapshotWishlist method.
+	SnapshotWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) (int64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AnonymizeGuestGiverByEmail holds details about calls to the AnonymizeGuestGiverByEmail method.
+		AnonymizeGuestGiverByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListByRecipient holds details about calls to the ListByRecipient method.
+		ListByRecipient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// SnapshotWishlist holds details about calls to the SnapshotWishlist method.
+		SnapshotWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+	}
+	lockAnonymizeGuestGiverByEmail sync.RWMutex
+	lockListByRecipient            sync.RWMutex
+	lockSnapshotWishlist           sync.RWMutex
+}
+
+// AnonymizeGuestGiverByEmail calls AnonymizeGuestGiverByEmailFunc.
+func (mock *GiftHistoryRepositoryInterfaceMock) AnonymizeGuestGiverByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.AnonymizeGuestGiverByEmailFunc == nil {
+		panic("GiftHistoryRepositoryInterfaceMock.AnonymizeGuestGiverByEmailFunc: method is nil but GiftHistoryRepositoryInterface.AnonymizeGuestGiverByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockAnonymizeGuestGiverByEmail.Lock()
+	mock.calls.AnonymizeGuestGiverByEmail = append(mock.calls.AnonymizeGuestGiverByEmail, callInfo)
+	mock.lockAnonymizeGuestGiverByEmail.Unlock()
+	return mock.AnonymizeGuestGiverByEmailFunc(ctx, guestEmail)
+}
+
+// AnonymizeGuestGiverByEmailCalls gets all the calls that were made to AnonymizeGuestGiverByEmail.
+// Check the length with:
+//
+//	len(mockedGiftHistoryRepositoryInterface.AnonymizeGuestGiverByEmailCalls())
+func (mock *GiftHistoryRepositoryInterfaceMock) AnonymizeGuestGiverByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockAnonymizeGuestGiverByEmail.RLock()
+	calls = mock.calls.AnonymizeGuestGiverByEmail
+	mock.lockAnonymizeGuestGiverByEmail.RUnlock()
+	return calls
+}
+
+// ListByRecipient calls ListByRecipientFunc.
+func (mock *GiftHistoryRepositoryInterfaceMock) ListByRecipient(ctx context.Context, userID pgtype.UUID) ([]*models.GiftRecord, error) {
+	if mock.ListByRecipientFunc == nil {
+		panic("GiftHistoryRepositoryInterfaceMock.ListByRecipientFunc: method is nil but GiftHistoryRepositoryInterface.ListByRecipient was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListByRecipient.Lock()
+	mock.calls.ListByRecipient = append(mock.calls.ListByRecipient, callInfo)
+	mock.lockListByRecipient.Unlock()
+	return mock.ListByRecipientFunc(ctx, userID)
+}
+
+// ListByRecipientCalls gets all the calls that were made to ListByRecipient.
+// Check the length with:
+//
+//	len(mockedGiftHistoryRepositoryInterface.ListByRecipientCalls())
+func (mock *GiftHistoryRepositoryInterfaceMock) ListByRecipientCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListByRecipient.RLock()
+	calls = mock.calls.ListByRecipient
+	mock.lockListByRecipient.RUnlock()
+	return calls
+}
+
+// SnapshotWishlist calls SnapshotWishlistFunc.
+func (mock *GiftHistoryRepositoryInterfaceMock) SnapshotWishlist(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+	if mock.SnapshotWishlistFunc == nil {
+		panic("GiftHistoryRepositoryInterfaceMock.SnapshotWishlistFunc: method is nil but GiftHistoryRepositoryInterface.SnapshotWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockSnapshotWishlist.Lock()
+	mock.calls.SnapshotWishlist = append(mock.calls.SnapshotWishlist, callInfo)
+	mock.lockSnapshotWishlist.Unlock()
+	return mock.SnapshotWishlistFunc(ctx, wishlistID)
+}
+
+// SnapshotWishlistCalls gets all the calls that were made to SnapshotWishlist.
+// Check the length with:
+//
+//	len(mockedGiftHistoryRepositoryInterface.SnapshotWishlistCalls())
+func (mock *GiftHistoryRepositoryInterfaceMock) SnapshotWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockSnapshotWishlist.RLock()
+	calls = mock.calls.SnapshotWishlist
+	mock.lockSnapshotWishlist.RUnlock()
+	return calls
+}
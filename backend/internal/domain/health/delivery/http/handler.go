@@ -2,7 +2,9 @@ package http
 
 import (
 	"context"
+	"fmt"
 	nethttp "net/http"
+	"sync"
 	"time"
 
 	"wish-list/internal/app/database"
@@ -11,15 +13,37 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// Component status values reported by /readyz
+const (
+	StatusOK       = "ok"
+	StatusError    = "error"
+	StatusDisabled = "disabled"
+)
+
+// probeTimeout bounds every individual dependency check
+const probeTimeout = 2 * time.Second
+
+// Pinger is implemented by optional dependencies that can report reachability
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
 // Handler handles health check endpoints
 type Handler struct {
-	db *database.DB
+	db               *database.DB
+	redis            Pinger // nil when the cache is disabled
+	storage          Pinger // nil when S3 is not configured
+	migrationVersion uint   // schema version this build expects
 }
 
-// NewHandler creates a new health check handler
-func NewHandler(db *database.DB) *Handler {
+// NewHandler creates a new health check handler. redis and storage are optional
+// and may be nil.
+func NewHandler(db *database.DB, redis, storage Pinger, migrationVersion uint) *Handler {
 	return &Handler{
-		db: db,
+		db:               db,
+		redis:            redis,
+		storage:          storage,
+		migrationVersion: migrationVersion,
 	}
 }
 
@@ -30,6 +54,20 @@ type HealthResponse struct {
 	Error  string            `json:"error,omitempty"`
 }
 
+// ComponentStatus is the result of probing a single dependency
+type ComponentStatus struct {
+	Status    string `json:"status" validate:"required"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ProbeResponse represents the response from the liveness and readiness endpoints
+type ProbeResponse struct {
+	Status     string                     `json:"status" validate:"required"`
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+}
+
 // Health godoc
 //
 //	@Summary		Health check endpoint
@@ -42,7 +80,7 @@ type HealthResponse struct {
 //
 // Health checks the health of the application and its dependencies
 func (h *Handler) Health(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), probeTimeout)
 	defer cancel()
 
 	// Check database connection
@@ -58,3 +96,115 @@ func (h *Handler) Health(c echo.Context) error {
 		},
 	})
 }
+
+// Live godoc
+//
+//	@Summary		Liveness probe
+//	@Description	Reports that the process is running. Does not touch any dependency, so a broken database or cache never causes a restart.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	ProbeResponse	"Process is alive"
+//	@Router			/livez [get]
+//
+// Live reports that the process is able to serve requests
+func (h *Handler) Live(c echo.Context) error {
+	return c.JSON(nethttp.StatusOK, ProbeResponse{Status: "alive"})
+}
+
+// Ready godoc
+//
+//	@Summary		Readiness probe
+//	@Description	Checks the database, Redis, S3 and schema migration version. Returns 503 when a critical component is failing so traffic is routed elsewhere. S3 is reported but never fails readiness.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	ProbeResponse	"Pod is ready"
+//	@Failure		503	{object}	ProbeResponse	"A critical dependency is failing"
+//	@Router			/readyz [get]
+//
+// Ready probes all dependencies concurrently and reports their statuses
+func (h *Handler) Ready(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	probes := map[string]struct {
+		critical bool
+		check    func(context.Context) error
+	}{
+		"database":   {critical: true, check: h.db.PingContext},
+		"migrations": {critical: true, check: h.checkMigrations},
+		"redis":      {critical: true, check: pingOrNil(h.redis)},
+		"storage":    {critical: false, check: pingOrNil(h.storage)},
+	}
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		components = make(map[string]ComponentStatus, len(probes))
+	)
+	for name, probe := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status := runProbe(ctx, probe.critical, probe.check)
+			mu.Lock()
+			components[name] = status
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	code, status := nethttp.StatusOK, "ready"
+	for _, component := range components {
+		if component.Critical && component.Status == StatusError {
+			code, status = nethttp.StatusServiceUnavailable, "not_ready"
+			break
+		}
+	}
+
+	return c.JSON(code, ProbeResponse{Status: status, Components: components})
+}
+
+// checkMigrations verifies the database schema is at least the version this build expects
+func (h *Handler) checkMigrations(ctx context.Context) error {
+	version, dirty, err := h.db.MigrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < h.migrationVersion {
+		return fmt.Errorf("schema at version %d, expected %d", version, h.migrationVersion)
+	}
+	return nil
+}
+
+// pingOrNil returns nil for a disabled dependency so it is reported as such
+func pingOrNil(p Pinger) func(context.Context) error {
+	if p == nil {
+		return nil
+	}
+	return p.Ping
+}
+
+// runProbe executes a single check with its own timeout
+func runProbe(ctx context.Context, critical bool, check func(context.Context) error) ComponentStatus {
+	if check == nil {
+		return ComponentStatus{Status: StatusDisabled, Critical: critical}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	status := ComponentStatus{
+		Status:    StatusOK,
+		Critical:  critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		status.Status = StatusError
+		status.Error = err.Error()
+	}
+	return status
+}
@@ -1,8 +1,10 @@
 package http
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	nethttp "net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,7 +30,7 @@ func TestHandler_Health(t *testing.T) {
 		sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
 		dbWrapper := &database.DB{DB: sqlxDB}
 
-		handler := NewHandler(dbWrapper)
+		handler := NewHandler(dbWrapper, nil, nil, 0)
 
 		// Expect ping to succeed
 		mock.ExpectPing()
@@ -61,7 +63,7 @@ func TestHandler_Health(t *testing.T) {
 		sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
 		dbWrapper := &database.DB{DB: sqlxDB}
 
-		handler := NewHandler(dbWrapper)
+		handler := NewHandler(dbWrapper, nil, nil, 0)
 
 		// Expect ping to fail
 		mock.ExpectPing().WillReturnError(sql.ErrConnDone)
@@ -93,7 +95,7 @@ func TestHandler_Health(t *testing.T) {
 		sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
 		dbWrapper := &database.DB{DB: sqlxDB}
 
-		handler := NewHandler(dbWrapper)
+		handler := NewHandler(dbWrapper, nil, nil, 0)
 
 		// Expect ping
 		mock.ExpectPing()
@@ -111,3 +113,136 @@ func TestHandler_Health(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+type stubPinger struct {
+	err error
+}
+
+func (p stubPinger) Ping(context.Context) error {
+	return p.err
+}
+
+func TestHandler_Live(t *testing.T) {
+	// No expectations: liveness must not touch the database
+	mockDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	handler := NewHandler(&database.DB{DB: sqlx.NewDb(mockDB, "sqlmock")}, stubPinger{err: errors.New("down")}, nil, 7)
+
+	e := echo.New()
+	req := httptest.NewRequest(nethttp.MethodGet, "/livez", nethttp.NoBody)
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, handler.Live(e.NewContext(req, rec)))
+	assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+	var response ProbeResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "alive", response.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandler_Ready(t *testing.T) {
+	tests := []struct {
+		name             string
+		redis            Pinger
+		storage          Pinger
+		pingErr          error
+		migrationVersion int64
+		dirty            bool
+		wantCode         int
+		wantStatus       string
+		wantComponents   map[string]string
+	}{
+		{
+			name:             "all dependencies healthy",
+			redis:            stubPinger{},
+			storage:          stubPinger{},
+			migrationVersion: 7,
+			wantCode:         nethttp.StatusOK,
+			wantStatus:       "ready",
+			wantComponents:   map[string]string{"database": StatusOK, "migrations": StatusOK, "redis": StatusOK, "storage": StatusOK},
+		},
+		{
+			name:             "optional dependencies disabled",
+			migrationVersion: 7,
+			wantCode:         nethttp.StatusOK,
+			wantStatus:       "ready",
+			wantComponents:   map[string]string{"database": StatusOK, "migrations": StatusOK, "redis": StatusDisabled, "storage": StatusDisabled},
+		},
+		{
+			name:             "broken redis is not ready",
+			redis:            stubPinger{err: errors.New("connection refused")},
+			migrationVersion: 7,
+			wantCode:         nethttp.StatusServiceUnavailable,
+			wantStatus:       "not_ready",
+			wantComponents:   map[string]string{"redis": StatusError},
+		},
+		{
+			name:             "broken storage stays ready",
+			redis:            stubPinger{},
+			storage:          stubPinger{err: errors.New("access denied")},
+			migrationVersion: 7,
+			wantCode:         nethttp.StatusOK,
+			wantStatus:       "ready",
+			wantComponents:   map[string]string{"storage": StatusError},
+		},
+		{
+			name:             "database behind expected migration",
+			migrationVersion: 6,
+			wantCode:         nethttp.StatusServiceUnavailable,
+			wantStatus:       "not_ready",
+			wantComponents:   map[string]string{"database": StatusOK, "migrations": StatusError},
+		},
+		{
+			name:             "dirty migration",
+			migrationVersion: 7,
+			dirty:            true,
+			wantCode:         nethttp.StatusServiceUnavailable,
+			wantStatus:       "not_ready",
+			wantComponents:   map[string]string{"migrations": StatusError},
+		},
+		{
+			name:             "database unreachable",
+			pingErr:          sql.ErrConnDone,
+			migrationVersion: 7,
+			wantCode:         nethttp.StatusServiceUnavailable,
+			wantStatus:       "not_ready",
+			wantComponents:   map[string]string{"database": StatusError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			// Probes run concurrently
+			mock.MatchExpectationsInOrder(false)
+			mock.ExpectPing().WillReturnError(tt.pingErr)
+			mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+				WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(tt.migrationVersion, tt.dirty))
+
+			handler := NewHandler(&database.DB{DB: sqlx.NewDb(mockDB, "sqlmock")}, tt.redis, tt.storage, 7)
+
+			e := echo.New()
+			req := httptest.NewRequest(nethttp.MethodGet, "/readyz", nethttp.NoBody)
+			rec := httptest.NewRecorder()
+
+			require.NoError(t, handler.Ready(e.NewContext(req, rec)))
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			var response ProbeResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantStatus, response.Status)
+			assert.Len(t, response.Components, 4)
+			for name, want := range tt.wantComponents {
+				assert.Equal(t, want, response.Components[name].Status, name)
+			}
+			assert.False(t, response.Components["storage"].Critical)
+			assert.True(t, response.Components["redis"].Critical)
+		})
+	}
+}
@@ -7,4 +7,6 @@ import (
 // RegisterRoutes registers health check routes on the Echo instance.
 func RegisterRoutes(e *echo.Echo, h *Handler) {
 	e.GET("/healthz", h.Health)
+	e.GET("/livez", h.Live)
+	e.GET("/readyz", h.Ready)
 }
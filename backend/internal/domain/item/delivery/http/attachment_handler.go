@@ -0,0 +1,134 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/item/delivery/http/dto"
+	"wish-list/internal/domain/item/service"
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AttachmentHandler handles HTTP requests for the files attached to gift items
+type AttachmentHandler struct {
+	service service.AttachmentServiceInterface
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler
+func NewAttachmentHandler(svc service.AttachmentServiceInterface) *AttachmentHandler {
+	return &AttachmentHandler{
+		service: svc,
+	}
+}
+
+// ListAttachments godoc
+//
+//	@Summary		List gift item attachments
+//	@Description	List the files attached to the item, each with a download link valid for 15 minutes.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string						true	"Item ID"
+//	@Success		200	{object}	dto.AttachmentListResponse	"Attachments"
+//	@Failure		401	{object}	map[string]string			"Not authenticated"
+//	@Failure		403	{object}	map[string]string			"Access denied"
+//	@Failure		404	{object}	map[string]string			"Item not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/attachments [get]
+func (h *AttachmentHandler) ListAttachments(c echo.Context) error {
+	attachments, err := h.service.ListAttachments(c.Request().Context(), OwnedItem(c))
+	if err != nil {
+		return mapAttachmentServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.AttachmentListResponseFromService(attachments))
+}
+
+// UploadAttachment godoc
+//
+//	@Summary		Attach a file to a gift item
+//	@Description	Upload a file givers may need before buying the item, such as a size chart or a specification PDF. PDF, JPEG, PNG and WebP files of up to 10MB are accepted; the type is checked against the file content. An item holds at most 5 attachments.
+//	@Tags			Items
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			id		path		string					true	"Item ID"
+//	@Param			file	formData	file					true	"File to attach (max 10MB)"
+//	@Success		201		{object}	dto.AttachmentResponse	"File attached"
+//	@Failure		400		{object}	map[string]string		"Missing file, invalid file type or file too large"
+//	@Failure		401		{object}	map[string]string		"Not authenticated"
+//	@Failure		403		{object}	map[string]string		"Access denied"
+//	@Failure		404		{object}	map[string]string		"Item not found"
+//	@Failure		422		{object}	map[string]string		"Attachment limit reached"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Failure		503		{object}	map[string]string		"File storage temporarily unavailable"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/attachments [post]
+func (h *AttachmentHandler) UploadAttachment(c echo.Context) error {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return apperrors.BadRequest("Failed to get uploaded file")
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return apperrors.Internal("Failed to open uploaded file").Wrap(err)
+	}
+	defer src.Close()
+
+	attachment, err := h.service.UploadAttachment(c.Request().Context(), OwnedItem(c), service.AttachmentUpload{
+		FileName: file.Filename,
+		Size:     file.Size,
+		Body:     src,
+	})
+	if err != nil {
+		return mapAttachmentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.AttachmentResponseFromService(attachment))
+}
+
+// DeleteAttachment godoc
+//
+//	@Summary		Delete gift item attachment
+//	@Description	Remove a file from the item. Download links handed out before stop working once they expire.
+//	@Tags			Items
+//	@Param			id				path	string	true	"Item ID"
+//	@Param			attachmentId	path	string	true	"Attachment ID"
+//	@Success		204				"Attachment deleted"
+//	@Failure		401				{object}	map[string]string	"Not authenticated"
+//	@Failure		403				{object}	map[string]string	"Access denied"
+//	@Failure		404				{object}	map[string]string	"Item or attachment not found"
+//	@Failure		500				{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/attachments/{attachmentId} [delete]
+func (h *AttachmentHandler) DeleteAttachment(c echo.Context) error {
+	if err := h.service.DeleteAttachment(c.Request().Context(), OwnedItem(c), c.Param("attachmentId")); err != nil {
+		return mapAttachmentServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// ListPublicAttachments godoc
+//
+//	@Summary		List attachments of a public gift item
+//	@Description	List the files the owner attached to an item of a public wish list, each with a download link valid for 15 minutes, so givers can check sizes or specifications before buying.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			slug	path		string						true	"Public Slug"
+//	@Param			itemId	path		string						true	"Item ID"
+//	@Success		200		{object}	dto.AttachmentListResponse	"Attachments"
+//	@Failure		404		{object}	map[string]string			"Wish list or item not found"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Router			/public/wishlists/{slug}/items/{itemId}/attachments [get]
+func (h *AttachmentHandler) ListPublicAttachments(c echo.Context) error {
+	attachments, err := h.service.ListPublicAttachments(c.Request().Context(), c.Param("slug"), c.Param("itemId"))
+	if err != nil {
+		return mapAttachmentServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.AttachmentListResponseFromService(attachments))
+}
@@ -2,57 +2,110 @@ package dto
 
 import (
 	"wish-list/internal/domain/item/service"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
 )
 
 // CreateItemRequest represents the request to create a gift item
 type CreateItemRequest struct {
-	Title       string  `json:"title" validate:"required,min=1,max=255" example:"iPhone 15 Pro"`
-	Description string  `json:"description" validate:"max=2000" example:"256GB, Blue Titanium"`
-	Link        string  `json:"link" validate:"omitempty,url" example:"https://apple.com/iphone-15-pro"`
-	ImageURL    string  `json:"image_url" validate:"omitempty,url" example:"https://example.com/image.jpg"`
-	Price       float64 `json:"price" validate:"omitempty,gte=0" example:"999.99"`
-	Priority    int32   `json:"priority" validate:"omitempty,gte=0,lte=10" example:"3"`
-	Notes       string  `json:"notes" validate:"max=1000" example:"Preferred color: Blue"`
+	Title           string          `json:"title" validate:"required,min=1,max=255" example:"iPhone 15 Pro"`
+	Description     string          `json:"description" validate:"max=2000" example:"256GB, Blue Titanium"`
+	Link            string          `json:"link" validate:"omitempty,url" example:"https://apple.com/iphone-15-pro"`
+	ImageURL        string          `json:"image_url" validate:"omitempty,url" example:"https://example.com/image.jpg"`
+	Price           float64         `json:"price" validate:"omitempty,gte=0" example:"999.99"`
+	Priority        *priority.Value `json:"priority" swaggertype:"string" enums:"must_have,nice_to_have,dream" example:"must_have"` // Level name, or a legacy 0-10 number
+	Notes           string          `json:"notes" validate:"max=1000" example:"Preferred color: Blue"`
+	NotesVisibility string          `json:"notes_visibility" validate:"omitempty,oneof=private public" enums:"private,public" example:"private"` // Whether guests see the notes, defaults to private
+	Quantity        int32           `json:"quantity" validate:"omitempty,min=1,max=100" example:"6"`                                             // Units wanted, defaults to 1
+	Options         variant.Options `json:"options"`                                                                                             // Acceptable sizes, colors and models
+	Category        string          `json:"category" validate:"max=50" example:"Electronics"`                                                    // Section on the share page
 }
 
 // ToDomain converts CreateItemRequest to service input
 func (r *CreateItemRequest) ToDomain() service.CreateItemInput {
-	return service.CreateItemInput{
-		Title:       r.Title,
-		Description: r.Description,
-		Link:        r.Link,
-		ImageURL:    r.ImageURL,
-		Price:       r.Price,
-		Priority:    r.Priority,
-		Notes:       r.Notes,
+	input := service.CreateItemInput{
+		Title:           r.Title,
+		Description:     r.Description,
+		Link:            r.Link,
+		ImageURL:        r.ImageURL,
+		Price:           r.Price,
+		Notes:           r.Notes,
+		NotesVisibility: r.NotesVisibility,
+		Quantity:        r.Quantity,
+		Options:         r.Options,
+		Category:        r.Category,
 	}
+	if r.Priority != nil {
+		input.Priority = r.Priority.Number
+		input.PriorityLevel = r.Priority.Level
+	}
+	return input
 }
 
 // UpdateItemRequest represents the request to update a gift item
 type UpdateItemRequest struct {
-	Title       *string  `json:"title" validate:"omitempty,min=1,max=255"`
-	Description *string  `json:"description" validate:"omitempty,max=2000"`
-	Link        *string  `json:"link" validate:"omitempty,url"`
-	ImageURL    *string  `json:"image_url" validate:"omitempty,url"`
-	Price       *float64 `json:"price" validate:"omitempty,gte=0"`
-	Priority    *int32   `json:"priority" validate:"omitempty,gte=0,lte=10"`
-	Notes       *string  `json:"notes" validate:"omitempty,max=1000"`
+	Title           *string          `json:"title" validate:"omitempty,min=1,max=255"`
+	Description     *string          `json:"description" validate:"omitempty,max=2000"`
+	Link            *string          `json:"link" validate:"omitempty,url"`
+	ImageURL        *string          `json:"image_url" validate:"omitempty,url"`
+	Price           *float64         `json:"price" validate:"omitempty,gte=0"`
+	Priority        *priority.Value  `json:"priority" swaggertype:"string" enums:"must_have,nice_to_have,dream"` // Level name, or a legacy 0-10 number
+	Notes           *string          `json:"notes" validate:"omitempty,max=1000"`
+	NotesVisibility *string          `json:"notes_visibility" validate:"omitempty,oneof=private public" enums:"private,public"`
+	Quantity        *int32           `json:"quantity" validate:"omitempty,min=1,max=100"`  // Cannot go below the reserved units
+	Options         *variant.Options `json:"options"`                                      // Replaces all options; {} clears them
+	Category        *string          `json:"category" validate:"omitempty,max=50"`         // Empty clears the category
+	Version         *int32           `json:"version,omitempty" validate:"omitempty,gte=1"` // Alternative to the If-Match header
 }
 
 // ToDomain converts UpdateItemRequest to service input
 func (r *UpdateItemRequest) ToDomain() service.UpdateItemInput {
-	return service.UpdateItemInput{
-		Title:       r.Title,
-		Description: r.Description,
-		Link:        r.Link,
-		ImageURL:    r.ImageURL,
-		Price:       r.Price,
-		Priority:    r.Priority,
-		Notes:       r.Notes,
+	input := service.UpdateItemInput{
+		Title:           r.Title,
+		Description:     r.Description,
+		Link:            r.Link,
+		ImageURL:        r.ImageURL,
+		Price:           r.Price,
+		Notes:           r.Notes,
+		NotesVisibility: r.NotesVisibility,
+		Quantity:        r.Quantity,
+		Options:         r.Options,
+		Category:        r.Category,
+	}
+	if r.Priority != nil {
+		input.Priority = &r.Priority.Number
+		input.PriorityLevel = &r.Priority.Level
 	}
+	return input
 }
 
 // MarkPurchasedRequest represents the request to mark item as purchased
 type MarkPurchasedRequest struct {
 	PurchasedPrice float64 `json:"purchased_price" validate:"required,gte=0" example:"899.99"`
 }
+
+// SetPurchaseProofRequest attaches an order confirmation image and/or tracking number to a purchase
+type SetPurchaseProofRequest struct {
+	ImageURL       string `json:"image_url" validate:"omitempty,url,max=2048" example:"https://bucket.s3.amazonaws.com/images/order.png"` // From POST /images/upload
+	TrackingNumber string `json:"tracking_number" validate:"omitempty,max=100" example:"1Z999AA10123456784"`
+	Visibility     string `json:"visibility" validate:"omitempty,oneof=private after_occasion owner" example:"after_occasion"`
+}
+
+// ToServiceInput converts the request to service input
+func (r *SetPurchaseProofRequest) ToServiceInput() service.PurchaseProofInput {
+	return service.PurchaseProofInput{
+		ImageURL:       r.ImageURL,
+		TrackingNumber: r.TrackingNumber,
+		Visibility:     r.Visibility,
+	}
+}
+
+// AddItemImageRequest represents the request to add an image to an item's gallery
+type AddItemImageRequest struct {
+	URL string `json:"url" validate:"required,url,max=2048" example:"https://example.com/image-2.jpg"`
+}
+
+// ReorderItemImagesRequest lists every image ID of an item in the new order; the first becomes primary
+type ReorderItemImagesRequest struct {
+	ImageIDs []string `json:"image_ids" validate:"required,min=1,dive,uuid" example:"550e8400-e29b-41d4-a716-446655440010,550e8400-e29b-41d4-a716-446655440011"`
+}
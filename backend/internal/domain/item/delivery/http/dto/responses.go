@@ -1,25 +1,59 @@
 package dto
 
 import (
+	"time"
+
 	"wish-list/internal/domain/item/service"
+	"wish-list/internal/pkg/fieldset"
+	"wish-list/internal/pkg/variant"
 )
 
 // ItemResponse represents a gift item in API responses
 type ItemResponse struct {
-	ID          string   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	OwnerID     string   `json:"owner_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	Title       string   `json:"title" example:"iPhone 15 Pro"`
-	Description string   `json:"description" example:"256GB, Blue Titanium"`
-	Link        string   `json:"link" example:"https://apple.com/iphone-15-pro"`
-	ImageURL    string   `json:"image_url" example:"https://example.com/image.jpg"`
-	Price       float64  `json:"price" example:"999.99"`
-	Priority    int      `json:"priority" example:"3"`
-	Notes       string   `json:"notes" example:"Preferred color: Blue"`
-	IsPurchased bool     `json:"is_purchased" example:"false"`
-	IsArchived  bool     `json:"is_archived" example:"false"`
-	WishlistIDs []string `json:"wishlist_ids" example:"550e8400-e29b-41d4-a716-446655440002"`
-	CreatedAt   string   `json:"created_at" example:"2024-01-01T12:00:00Z"`
-	UpdatedAt   string   `json:"updated_at" example:"2024-01-01T12:00:00Z"`
+	ID              string              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OwnerID         string              `json:"owner_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Title           string              `json:"title" example:"iPhone 15 Pro"`
+	Description     string              `json:"description" example:"256GB, Blue Titanium"`
+	Link            string              `json:"link" example:"https://apple.com/iphone-15-pro"`
+	ImageURL        string              `json:"image_url" example:"https://example.com/image.jpg"` // Primary image, same as images[0].url
+	Images          []ItemImageResponse `json:"images"`
+	Price           float64             `json:"price" example:"999.99"`
+	Priority        int                 `json:"priority" example:"9"` // Legacy 0-10 number
+	PriorityLevel   string              `json:"priority_level" example:"must_have" enums:"must_have,nice_to_have,dream"`
+	PriorityWeight  int                 `json:"priority_weight" example:"3"`
+	Notes           string              `json:"notes" example:"Preferred color: Blue"`
+	NotesVisibility string              `json:"notes_visibility" example:"private" enums:"private,public"`
+	IsPurchased     bool                `json:"is_purchased" example:"false"`
+	IsReserved      bool                `json:"is_reserved" example:"false"` // True only when every unit is reserved
+	IsArchived      bool                `json:"is_archived" example:"false"`
+	WishlistIDs     []string            `json:"wishlist_ids" example:"550e8400-e29b-41d4-a716-446655440002"`
+	CreatedAt       string              `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	UpdatedAt       string              `json:"updated_at" example:"2024-01-01T12:00:00Z"`
+	Version         int32               `json:"version" example:"1"`
+
+	Quantity          int32  `json:"quantity" example:"6"`
+	ReservedQuantity  int32  `json:"reserved_quantity" example:"2"`
+	ReservationStatus string `json:"reservation_status" example:"partially_reserved" enums:"available,partially_reserved,fully_reserved"`
+
+	Options          variant.Options  `json:"options"`
+	Category         string           `json:"category,omitempty" example:"Electronics"`
+	ReservedVariants []variant.Choice `json:"reserved_variants,omitempty"` // Variants givers picked, shown once the item is purchased
+
+	AvailabilityStatus    string `json:"availability_status" example:"available" enums:"unknown,available,out_of_stock,not_found"`
+	AvailabilityCheckedAt string `json:"availability_checked_at,omitempty" example:"2024-01-01T12:00:00Z"`
+
+	fields fieldset.Set // Sparse fieldset; the zero set encodes every member
+}
+
+// SelectFields limits the JSON encoding to a sparse fieldset
+func (r *ItemResponse) SelectFields(fields fieldset.Set) {
+	r.fields = fields
+}
+
+// MarshalJSON encodes the members selected with SelectFields
+func (r ItemResponse) MarshalJSON() ([]byte, error) {
+	type plain ItemResponse
+	return r.fields.Marshal(plain(r))
 }
 
 // ItemResponseFromService converts service output to API response
@@ -29,23 +63,67 @@ func ItemResponseFromService(item *service.ItemOutput) ItemResponse {
 		wishlistIDs = []string{}
 	}
 	return ItemResponse{
-		ID:          item.ID,
-		OwnerID:     item.OwnerID,
-		Title:       item.Name,
-		Description: item.Description,
-		Link:        item.Link,
-		ImageURL:    item.ImageURL,
-		Price:       item.Price,
-		Priority:    item.Priority,
-		Notes:       item.Notes,
-		IsPurchased: item.IsPurchased,
-		IsArchived:  item.IsArchived,
-		WishlistIDs: wishlistIDs,
-		CreatedAt:   item.CreatedAt,
-		UpdatedAt:   item.UpdatedAt,
+		ID:              item.ID,
+		OwnerID:         item.OwnerID,
+		Title:           item.Name,
+		Description:     item.Description,
+		Link:            item.Link,
+		ImageURL:        item.ImageURL,
+		Images:          itemImageResponses(item.Images),
+		Price:           item.Price,
+		Priority:        item.Priority,
+		PriorityLevel:   item.PriorityLevel,
+		PriorityWeight:  item.PriorityWeight,
+		Notes:           item.Notes,
+		NotesVisibility: item.NotesVisibility,
+		IsPurchased:     item.IsPurchased,
+		IsReserved:      item.IsReserved,
+		IsArchived:      item.IsArchived,
+		WishlistIDs:     wishlistIDs,
+		CreatedAt:       item.CreatedAt,
+		UpdatedAt:       item.UpdatedAt,
+		Version:         item.Version,
+
+		Quantity:          item.Quantity,
+		ReservedQuantity:  item.ReservedQuantity,
+		ReservationStatus: item.ReservationStatus,
+
+		Options:          item.Options,
+		Category:         item.Category,
+		ReservedVariants: item.ReservedVariants,
+
+		AvailabilityStatus:    item.AvailabilityStatus,
+		AvailabilityCheckedAt: item.AvailabilityCheckedAt,
 	}
 }
 
+// ItemImageResponse represents one image of an item's gallery.
+// ID is omitted for an image only set through image_url; it gets one once another image is added.
+type ItemImageResponse struct {
+	ID       string `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440010"`
+	URL      string `json:"url" example:"https://example.com/image.jpg"`
+	Position int    `json:"position" example:"0"`
+}
+
+func itemImageResponses(images []*service.ItemImageOutput) []ItemImageResponse {
+	resp := make([]ItemImageResponse, 0, len(images))
+	for _, image := range images {
+		resp = append(resp, ItemImageResponse{
+			ID:       image.ID,
+			URL:      image.URL,
+			Position: image.Position,
+		})
+	}
+	return resp
+}
+
+// ItemVersionConflictResponse is returned with 409 when an update was based on a stale version
+type ItemVersionConflictResponse struct {
+	Error     string       `json:"error" example:"Item was modified by another request"`
+	Current   ItemResponse `json:"current"`
+	RequestID string       `json:"request_id,omitempty"`
+}
+
 // PaginatedItemsResponse represents paginated list of items
 type PaginatedItemsResponse struct {
 	Items      []ItemResponse `json:"items"`
@@ -69,3 +147,150 @@ func PaginatedItemsResponseFromService(result *service.PaginatedItemsOutput) Pag
 		TotalPages: result.TotalPages,
 	}
 }
+
+// PurchaseProofResponse is the proof of purchase attached to a gift item
+type PurchaseProofResponse struct {
+	GiftItemID     string `json:"gift_item_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageURL       string `json:"image_url,omitempty" example:"https://bucket.s3.amazonaws.com/images/order.png"`
+	TrackingNumber string `json:"tracking_number,omitempty" example:"1Z999AA10123456784"`
+	Visibility     string `json:"visibility" example:"after_occasion" enums:"private,after_occasion,owner"`
+	RevealDate     string `json:"reveal_date,omitempty" example:"2024-12-26"` // When an after_occasion proof becomes visible to the owner
+	VisibleToOwner bool   `json:"visible_to_owner" example:"false"`
+	CreatedAt      string `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	UpdatedAt      string `json:"updated_at" example:"2024-01-01T12:00:00Z"`
+}
+
+// PurchaseProofResponseFromService converts service output to API response
+func PurchaseProofResponseFromService(proof *service.PurchaseProofOutput) PurchaseProofResponse {
+	return PurchaseProofResponse{
+		GiftItemID:     proof.GiftItemID,
+		ImageURL:       proof.ImageURL,
+		TrackingNumber: proof.TrackingNumber,
+		Visibility:     proof.Visibility,
+		RevealDate:     proof.RevealDate,
+		VisibleToOwner: proof.VisibleToOwner,
+		CreatedAt:      proof.CreatedAt,
+		UpdatedAt:      proof.UpdatedAt,
+	}
+}
+
+// AttachmentResponse is a file attached to a gift item, such as a size chart
+type AttachmentResponse struct {
+	ID                   string  `json:"id" example:"550e8400-e29b-41d4-a716-446655440020"`
+	GiftItemID           string  `json:"gift_item_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FileName             string  `json:"file_name" example:"size-chart.pdf"`
+	ContentType          string  `json:"content_type" example:"application/pdf" enums:"application/pdf,image/jpeg,image/png,image/webp"`
+	SizeBytes            int64   `json:"size_bytes" example:"184320"`
+	CreatedAt            string  `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	DownloadURL          *string `json:"download_url"` // Time-limited link; list again for a fresh one
+	DownloadURLExpiresAt *string `json:"download_url_expires_at" example:"2024-01-01T12:15:00Z"`
+}
+
+// AttachmentListResponse lists the files attached to a gift item
+type AttachmentListResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+// AttachmentResponseFromService converts service output to API response
+func AttachmentResponseFromService(attachment *service.AttachmentOutput) AttachmentResponse {
+	resp := AttachmentResponse{
+		ID:          attachment.ID,
+		GiftItemID:  attachment.GiftItemID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		CreatedAt:   attachment.CreatedAt,
+	}
+	if attachment.DownloadURL != "" {
+		expiresAt := attachment.DownloadURLExpiresAt.Format(time.RFC3339)
+		resp.DownloadURL = &attachment.DownloadURL
+		resp.DownloadURLExpiresAt = &expiresAt
+	}
+	return resp
+}
+
+// AttachmentListResponseFromService converts service output to API response
+func AttachmentListResponseFromService(attachments []*service.AttachmentOutput) AttachmentListResponse {
+	resp := AttachmentListResponse{Attachments: make([]AttachmentResponse, 0, len(attachments))}
+	for _, attachment := range attachments {
+		resp.Attachments = append(resp.Attachments, AttachmentResponseFromService(attachment))
+	}
+	return resp
+}
+
+// ShareLinkResponse is a link sharing a single gift item
+type ShareLinkResponse struct {
+	ID        string `json:"id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440020"`
+	Token     string `json:"token" validate:"required"` // For GET /api/public/items/{token}
+	URL       string `json:"url" validate:"required"`   // Page of the web app showing the item
+	CreatedAt string `json:"created_at" validate:"required" example:"2024-01-01T12:00:00Z"`
+}
+
+// ShareLinkListResponse lists the links sharing a gift item
+type ShareLinkListResponse struct {
+	ShareLinks []ShareLinkResponse `json:"share_links"`
+}
+
+// ShareLinkResponseFromService converts service output to API response
+func ShareLinkResponseFromService(link *service.ShareLinkOutput) ShareLinkResponse {
+	return ShareLinkResponse{
+		ID:        link.ID,
+		Token:     link.Token,
+		URL:       link.URL,
+		CreatedAt: link.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// ShareLinkListResponseFromService converts service output to API response
+func ShareLinkListResponseFromService(links []*service.ShareLinkOutput) ShareLinkListResponse {
+	resp := ShareLinkListResponse{ShareLinks: make([]ShareLinkResponse, 0, len(links))}
+	for _, link := range links {
+		resp.ShareLinks = append(resp.ShareLinks, ShareLinkResponseFromService(link))
+	}
+	return resp
+}
+
+// SharedItemResponse is a gift item as shown through a share link. Who reserved
+// or bought it is not included.
+type SharedItemResponse struct {
+	ID            string              `json:"id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title         string              `json:"title" validate:"required" example:"iPhone 15 Pro"`
+	Description   string              `json:"description" example:"256GB, Blue Titanium"`
+	Link          string              `json:"link" example:"https://apple.com/iphone-15-pro"`
+	ImageURL      string              `json:"image_url" example:"https://example.com/image.jpg"`
+	Images        []ItemImageResponse `json:"images"`
+	Price         float64             `json:"price" example:"999.99"`
+	PriorityLevel string              `json:"priority_level" example:"must_have" enums:"must_have,nice_to_have,dream"`
+	Notes         string              `json:"notes,omitempty" example:"Preferred color: Blue"` // Only notes the owner made public
+	IsPurchased   bool                `json:"is_purchased" example:"false"`
+
+	Quantity          int32  `json:"quantity" example:"6"`
+	ReservedQuantity  int32  `json:"reserved_quantity" example:"2"`
+	ReservationStatus string `json:"reservation_status" example:"partially_reserved" enums:"available,partially_reserved,fully_reserved"`
+
+	Options  variant.Options `json:"options"`
+	Category string          `json:"category,omitempty" example:"Electronics"`
+}
+
+// SharedItemResponseFromService converts service output to API response
+func SharedItemResponseFromService(item *service.ItemOutput) SharedItemResponse {
+	return SharedItemResponse{
+		ID:            item.ID,
+		Title:         item.Name,
+		Description:   item.Description,
+		Link:          item.Link,
+		ImageURL:      item.ImageURL,
+		Images:        itemImageResponses(item.Images),
+		Price:         item.Price,
+		PriorityLevel: item.PriorityLevel,
+		Notes:         item.Notes,
+		IsPurchased:   item.IsPurchased,
+
+		Quantity:          item.Quantity,
+		ReservedQuantity:  item.ReservedQuantity,
+		ReservationStatus: item.ReservationStatus,
+
+		Options:  item.Options,
+		Category: item.Category,
+	}
+}
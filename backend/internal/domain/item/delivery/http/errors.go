@@ -2,20 +2,89 @@ package http
 
 import (
 	"errors"
+	"fmt"
 
 	"wish-list/internal/domain/item/service"
+	moderationservice "wish-list/internal/domain/moderation/service"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/aws"
 )
 
 // mapItemServiceError converts item service errors to AppErrors
 func mapItemServiceError(err error) error {
+	var rejectedErr *moderationservice.ContentRejectedError
+
 	switch {
+	case errors.As(err, &rejectedErr):
+		return apperrors.UnprocessableEntity(rejectedErr.Error())
 	case errors.Is(err, service.ErrItemNotFound):
 		return apperrors.NotFound("Item not found")
 	case errors.Is(err, service.ErrItemForbidden):
 		return apperrors.Forbidden("Access denied")
 	case errors.Is(err, service.ErrItemTitleRequired):
 		return apperrors.BadRequest("Title is required")
+	case errors.Is(err, service.ErrItemImageNotFound):
+		return apperrors.NotFound("Image not found")
+	case errors.Is(err, service.ErrItemImageLimitReached):
+		return apperrors.UnprocessableEntity(fmt.Sprintf("Item already has the maximum of %d images", service.MaxItemImages))
+	case errors.Is(err, service.ErrItemImageOrderInvalid):
+		return apperrors.BadRequest("Image order must list every image of the item exactly once")
+	case errors.Is(err, service.ErrItemPriorityInvalid):
+		return apperrors.BadRequest("Priority must be must_have, nice_to_have or dream")
+	case errors.Is(err, service.ErrItemNotesVisibilityInvalid):
+		return apperrors.BadRequest("Notes visibility must be private or public")
+	case errors.Is(err, service.ErrItemOptionsInvalid):
+		return apperrors.BadRequest("Options must list size, color or model values of 1-50 characters, at most 20 each")
+	case errors.Is(err, service.ErrItemQuantityBelowReserved):
+		return apperrors.Conflict("Quantity cannot be lower than the number of reserved units")
+	case errors.Is(err, service.ErrItemNotPurchased):
+		return apperrors.Conflict("Item has not been purchased")
+	case errors.Is(err, service.ErrItemAlreadyPurchased):
+		return apperrors.Conflict("Item is already purchased")
+	case errors.Is(err, service.ErrPurchaseProofForbidden):
+		return apperrors.Forbidden("Only the purchaser can attach a purchase proof")
+	case errors.Is(err, service.ErrPurchaseProofEmpty):
+		return apperrors.BadRequest("Provide an image or a tracking number")
+	case errors.Is(err, service.ErrPurchaseProofVisibility):
+		return apperrors.BadRequest("Visibility must be private, after_occasion or owner")
+	case errors.Is(err, service.ErrPurchaseProofNotAvailable):
+		return apperrors.NotFound("Purchase proof not found")
+	case errors.Is(err, service.ErrItemVersionConflict):
+		return apperrors.Conflict("Item was modified by another request")
+	default:
+		return apperrors.Internal("Failed to process request").Wrap(err)
+	}
+}
+
+// mapAttachmentServiceError converts attachment service errors to AppErrors
+func mapAttachmentServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrItemNotFound):
+		return apperrors.NotFound("Item not found")
+	case errors.Is(err, service.ErrAttachmentNotFound):
+		return apperrors.NotFound("Attachment not found")
+	case errors.Is(err, service.ErrAttachmentLimitReached):
+		return apperrors.UnprocessableEntity(fmt.Sprintf("Item already has the maximum of %d attachments", service.MaxItemAttachments))
+	case errors.Is(err, service.ErrAttachmentTooLarge):
+		return apperrors.BadRequest("File too large. Maximum size is 10MB.")
+	case errors.Is(err, service.ErrAttachmentType):
+		return apperrors.BadRequest("Invalid file type. Only PDF, JPEG, PNG and WebP files are allowed.")
+	case errors.Is(err, aws.ErrUnavailable):
+		return apperrors.ServiceUnavailable("Attachments are temporarily unavailable. Please try again later.")
+	default:
+		return apperrors.Internal("Failed to process request").Wrap(err)
+	}
+}
+
+// mapShareLinkServiceError converts share link service errors to AppErrors
+func mapShareLinkServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrItemNotFound):
+		return apperrors.NotFound("Item not found")
+	case errors.Is(err, service.ErrShareLinkNotFound):
+		return apperrors.NotFound("Share link not found")
+	case errors.Is(err, service.ErrShareLinkLimitReached):
+		return apperrors.UnprocessableEntity(fmt.Sprintf("Item already has the maximum of %d share links", service.MaxItemShareLinks))
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	nethttp "net/http"
 
 	"wish-list/internal/domain/item/delivery/http/dto"
@@ -8,10 +9,14 @@ import (
 	"wish-list/internal/domain/item/service"
 	"wish-list/internal/pkg/auth"
 	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/requestid"
 
 	"github.com/labstack/echo/v4"
 )
 
+// itemsResource names items in the fields[...] sparse fieldset parameter
+const itemsResource = "items"
+
 // Handler handles HTTP requests for gift items as independent resources
 type Handler struct {
 	service service.ItemServiceInterface
@@ -32,12 +37,13 @@ func NewHandler(svc service.ItemServiceInterface) *Handler {
 //	@Produce		json
 //	@Param			page			query		int							false	"Page number (default 1)"
 //	@Param			limit			query		int							false	"Items per page (default 10, max 100)"
-//	@Param			sort			query		string						false	"Sort field (created_at, updated_at, title, price)"
+//	@Param			sort			query		string						false	"Sort field (created_at, updated_at, title, price, priority)"
 //	@Param			order			query		string						false	"Sort order (asc, desc)"
 //	@Param			unattached		query		bool						false	"Filter items not attached to any wishlist"
 //	@Param			attached		query		bool						false	"Filter items attached to any wishlist"
 //	@Param			include_archived	query		bool						false	"Include archived items (default false)"
 //	@Param			search			query		string						false	"Search in title and description"
+//	@Param			fields			query		string						false	"Comma-separated item members to return; id is always included"
 //	@Success		200				{object}	dto.PaginatedItemsResponse	"List of items retrieved successfully"
 //	@Failure		400				{object}	map[string]string			"Invalid query parameters"
 //	@Failure		401				{object}	map[string]string			"Not authenticated"
@@ -59,6 +65,10 @@ func (h *Handler) GetMyItems(c echo.Context) error {
 		Page:            pagination.Page,
 		Limit:           pagination.Limit,
 	}
+	fields, err := helpers.ParseFields(c, itemsResource, dto.ItemResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
 
@@ -68,7 +78,12 @@ func (h *Handler) GetMyItems(c echo.Context) error {
 		return mapItemServiceError(err)
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.PaginatedItemsResponseFromService(result))
+	response := dto.PaginatedItemsResponseFromService(result)
+	for i := range response.Items {
+		response.Items[i].SelectFields(fields)
+	}
+
+	return c.JSON(nethttp.StatusOK, response)
 }
 
 // CreateItem godoc
@@ -82,6 +97,7 @@ func (h *Handler) GetMyItems(c echo.Context) error {
 //	@Success		201		{object}	dto.ItemResponse		"Item created successfully"
 //	@Failure		400		{object}	map[string]string		"Invalid request body"
 //	@Failure		401		{object}	map[string]string		"Not authenticated"
+//	@Failure		422		{object}	map[string]string		"Content rejected by moderation"
 //	@Failure		500		{object}	map[string]string		"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/items [post]
@@ -110,17 +126,23 @@ func (h *Handler) CreateItem(c echo.Context) error {
 //	@Description	Get a specific gift item by ID
 //	@Tags			Items
 //	@Produce		json
-//	@Param			id	path		string				true	"Item ID"
-//	@Success		200	{object}	dto.ItemResponse	"Item retrieved successfully"
-//	@Failure		401	{object}	map[string]string	"Not authenticated"
-//	@Failure		403	{object}	map[string]string	"Access denied"
-//	@Failure		404	{object}	map[string]string	"Item not found"
+//	@Param			id		path		string				true	"Item ID"
+//	@Param			fields	query		string				false	"Comma-separated members to return; id is always included"
+//	@Success		200		{object}	dto.ItemResponse	"Item retrieved successfully"
+//	@Failure		400		{object}	map[string]string	"Unknown field requested"
+//	@Failure		401		{object}	map[string]string	"Not authenticated"
+//	@Failure		403		{object}	map[string]string	"Access denied"
+//	@Failure		404		{object}	map[string]string	"Item not found"
 //	@Security		BearerAuth
 //	@Router			/items/{id} [get]
 func (h *Handler) GetItem(c echo.Context) error {
 	userID := auth.MustGetUserID(c)
 
 	itemID := c.Param("id")
+	fields, err := helpers.ParseFields(c, itemsResource, dto.ItemResponse{})
+	if err != nil {
+		return err
+	}
 	ctx := c.Request().Context()
 
 	// Get item via service
@@ -129,24 +151,32 @@ func (h *Handler) GetItem(c echo.Context) error {
 		return mapItemServiceError(err)
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
+	response := dto.ItemResponseFromService(item)
+	response.SelectFields(fields)
+
+	helpers.SetVersionETag(c, item.Version)
+	return c.JSON(nethttp.StatusOK, response)
 }
 
 // UpdateItem godoc
 //
 //	@Summary		Update gift item
-//	@Description	Update a gift item by ID
+//	@Description	Update a gift item by ID. Send the version from the ETag in If-Match (or the version field) to reject the update when the item changed since it was read.
 //	@Tags			Items
 //	@Accept			json
 //	@Produce		json
-//	@Param			id		path		string				true	"Item ID"
-//	@Param			item	body		dto.UpdateItemRequest	true	"Updated item data"
-//	@Success		200		{object}	dto.ItemResponse	"Item updated successfully"
-//	@Failure		400		{object}	map[string]string	"Invalid request body"
-//	@Failure		401		{object}	map[string]string	"Not authenticated"
-//	@Failure		403		{object}	map[string]string	"Access denied"
-//	@Failure		404		{object}	map[string]string	"Item not found"
-//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Param			id			path		string							true	"Item ID"
+//	@Param			If-Match	header		string							false	"Expected item version (ETag)"
+//	@Param			item		body		dto.UpdateItemRequest			true	"Updated item data"
+//	@Success		200			{object}	dto.ItemResponse				"Item updated successfully"
+//	@Failure		400			{object}	map[string]string				"Invalid request body"
+//	@Failure		401			{object}	map[string]string				"Not authenticated"
+//	@Failure		403			{object}	map[string]string				"Access denied"
+//	@Failure		404			{object}	map[string]string				"Item not found"
+//	@Failure		409			{object}	dto.ItemVersionConflictResponse	"Item was modified by another request, or quantity is below the reserved units"
+//	@Failure		422			{object}	map[string]string				"Content rejected by moderation"
+//	@Failure		428			{object}	map[string]string				"Neither If-Match nor version sent while REQUIRE_IF_MATCH is on"
+//	@Failure		500			{object}	map[string]string				"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/items/{id} [put]
 func (h *Handler) UpdateItem(c echo.Context) error {
@@ -159,14 +189,31 @@ func (h *Handler) UpdateItem(c echo.Context) error {
 		return err
 	}
 
+	version, err := helpers.ExpectedVersion(c, req.Version)
+	if err != nil {
+		return err
+	}
+	input := req.ToDomain()
+	input.Version = version
+
 	ctx := c.Request().Context()
 
 	// Update item via service
-	item, err := h.service.UpdateItem(ctx, itemID, userID, req.ToDomain())
+	item, err := h.service.UpdateItem(ctx, itemID, userID, input)
 	if err != nil {
+		var conflict *service.ItemVersionConflictError
+		if errors.As(err, &conflict) {
+			helpers.SetVersionETag(c, conflict.Current.Version)
+			return c.JSON(nethttp.StatusConflict, dto.ItemVersionConflictResponse{
+				Error:     "Item was modified by another request",
+				Current:   dto.ItemResponseFromService(conflict.Current),
+				RequestID: requestid.FromContext(ctx),
+			})
+		}
 		return mapItemServiceError(err)
 	}
 
+	helpers.SetVersionETag(c, item.Version)
 	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
 }
 
@@ -236,3 +283,161 @@ func (h *Handler) MarkItemAsPurchased(c echo.Context) error {
 
 	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
 }
+
+// SetPurchaseProof godoc
+//
+//	@Summary		Attach proof of purchase
+//	@Description	Attach an order confirmation screenshot (uploaded via POST /images/upload) and/or a tracking number to an item the user purchased. Replaces any earlier proof. visibility controls when the item's owner can see it: private never, after_occasion (default) the day after the latest occasion of the wish lists holding the item, owner right away.
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Item ID"
+//	@Param			proof	body		dto.SetPurchaseProofRequest		true	"Image URL, tracking number and visibility"
+//	@Success		200		{object}	dto.PurchaseProofResponse		"Purchase proof saved"
+//	@Failure		400		{object}	map[string]string				"Invalid request body, or neither image nor tracking number given"
+//	@Failure		401		{object}	map[string]string				"Not authenticated"
+//	@Failure		403		{object}	map[string]string				"The user did not purchase the item"
+//	@Failure		404		{object}	map[string]string				"Item not found"
+//	@Failure		409		{object}	map[string]string				"Item has not been purchased"
+//	@Failure		422		{object}	map[string]string				"Image rejected by moderation"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/purchase-proof [put]
+func (h *Handler) SetPurchaseProof(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	itemID := c.Param("id")
+
+	var req dto.SetPurchaseProofRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	proof, err := h.service.SetPurchaseProof(c.Request().Context(), itemID, userID, req.ToServiceInput())
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PurchaseProofResponseFromService(proof))
+}
+
+// GetPurchaseProof godoc
+//
+//	@Summary		Get proof of purchase
+//	@Description	Get the proof of purchase of an item. The purchaser always sees it; the item's owner only once its visibility allows, so the gift stays a surprise until then.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string						true	"Item ID"
+//	@Success		200	{object}	dto.PurchaseProofResponse	"Purchase proof"
+//	@Failure		401	{object}	map[string]string			"Not authenticated"
+//	@Failure		403	{object}	map[string]string			"Access denied"
+//	@Failure		404	{object}	map[string]string			"Item not found, or no proof shared with the owner yet"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/purchase-proof [get]
+func (h *Handler) GetPurchaseProof(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	proof, err := h.service.GetPurchaseProof(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PurchaseProofResponseFromService(proof))
+}
+
+// AddItemImage godoc
+//
+//	@Summary		Add gift item image
+//	@Description	Append an image to the item's gallery. The first image of the gallery is the item's image_url.
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Item ID"
+//	@Param			image	body		dto.AddItemImageRequest	true	"Image URL"
+//	@Success		201		{object}	dto.ItemResponse		"Image added"
+//	@Failure		400		{object}	map[string]string		"Invalid request body"
+//	@Failure		401		{object}	map[string]string		"Not authenticated"
+//	@Failure		403		{object}	map[string]string		"Access denied"
+//	@Failure		404		{object}	map[string]string		"Item not found"
+//	@Failure		422		{object}	map[string]string		"Image limit reached or content rejected by moderation"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/images [post]
+func (h *Handler) AddItemImage(c echo.Context) error {
+	var req dto.AddItemImageRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	item, err := h.service.AddItemImage(ctx, OwnedItem(c), req.URL)
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	helpers.SetVersionETag(c, item.Version)
+	return c.JSON(nethttp.StatusCreated, dto.ItemResponseFromService(item))
+}
+
+// ReorderItemImages godoc
+//
+//	@Summary		Reorder gift item images
+//	@Description	Rearrange the item's gallery. Every image ID must be listed exactly once; the first becomes the item's image_url.
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Item ID"
+//	@Param			order	body		dto.ReorderItemImagesRequest	true	"Image IDs in the new order"
+//	@Success		200		{object}	dto.ItemResponse				"Images reordered"
+//	@Failure		400		{object}	map[string]string				"Invalid request body or image IDs"
+//	@Failure		401		{object}	map[string]string				"Not authenticated"
+//	@Failure		403		{object}	map[string]string				"Access denied"
+//	@Failure		404		{object}	map[string]string				"Item not found"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/images/order [put]
+func (h *Handler) ReorderItemImages(c echo.Context) error {
+	var req dto.ReorderItemImagesRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	item, err := h.service.ReorderItemImages(ctx, OwnedItem(c), req.ImageIDs)
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	helpers.SetVersionETag(c, item.Version)
+	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
+}
+
+// RemoveItemImage godoc
+//
+//	@Summary		Remove gift item image
+//	@Description	Delete an image from the item's gallery. Removing the first image makes the next one the item's image_url.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id		path		string				true	"Item ID"
+//	@Param			imageId	path		string				true	"Image ID"
+//	@Success		200		{object}	dto.ItemResponse	"Image removed"
+//	@Failure		401		{object}	map[string]string	"Not authenticated"
+//	@Failure		403		{object}	map[string]string	"Access denied"
+//	@Failure		404		{object}	map[string]string	"Item or image not found"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/images/{imageId} [delete]
+func (h *Handler) RemoveItemImage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	item, err := h.service.RemoveItemImage(ctx, OwnedItem(c), c.Param("imageId"))
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	helpers.SetVersionETag(c, item.Version)
+	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
+}
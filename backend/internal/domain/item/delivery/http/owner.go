@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"wish-list/internal/app/middleware"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// ownedItemKey is the context key RequireItemOwner stores the gift item under
+const ownedItemKey = "owned_item"
+
+// ItemLoader loads gift items for RequireItemOwner
+type ItemLoader interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error)
+}
+
+// RequireItemOwner allows the request only when the authenticated user owns the gift
+// item in the :id path parameter. Handlers read it with OwnedItem.
+// It must run after the JWT middleware.
+func RequireItemOwner(items ItemLoader) echo.MiddlewareFunc {
+	return middleware.RequireOwner("id", ownedItemKey, "Item",
+		func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, pgtype.UUID, error) {
+			item, err := items.GetByID(ctx, id)
+			if errors.Is(err, repository.ErrGiftItemNotFound) {
+				return nil, pgtype.UUID{}, middleware.ErrResourceNotFound
+			}
+			if err != nil {
+				return nil, pgtype.UUID{}, err
+			}
+			return item, item.OwnerID, nil
+		})
+}
+
+// OwnedItem returns the gift item loaded by RequireItemOwner
+func OwnedItem(c echo.Context) *models.GiftItem {
+	item, _ := middleware.OwnedResource[*models.GiftItem](c, ownedItemKey)
+	return item
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/item/delivery/http/dto"
+	"wish-list/internal/domain/item/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReceivedHandler handles HTTP requests for gift items the owner received outside the app
+type ReceivedHandler struct {
+	service service.ReceivedServiceInterface
+}
+
+// NewReceivedHandler creates a new ReceivedHandler
+func NewReceivedHandler(svc service.ReceivedServiceInterface) *ReceivedHandler {
+	return &ReceivedHandler{
+		service: svc,
+	}
+}
+
+// MarkItemAsReceived godoc
+//
+//	@Summary		Mark gift item as received elsewhere
+//	@Description	Mark an item the owner already got outside the app as purchased, without a purchase price. Active reservations on it are released: registered givers get an in-app notification and guests an email saying the item is no longer needed.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string				true	"Item ID"
+//	@Success		200	{object}	dto.ItemResponse	"Item marked as received"
+//	@Failure		401	{object}	map[string]string	"Not authenticated"
+//	@Failure		403	{object}	map[string]string	"Access denied"
+//	@Failure		404	{object}	map[string]string	"Item not found"
+//	@Failure		409	{object}	map[string]string	"Item is already purchased"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/mark-received [post]
+func (h *ReceivedHandler) MarkItemAsReceived(c echo.Context) error {
+	item, err := h.service.MarkReceived(c.Request().Context(), OwnedItem(c))
+	if err != nil {
+		return mapItemServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.ItemResponseFromService(item))
+}
@@ -4,8 +4,9 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-// RegisterRoutes registers item domain HTTP routes
-func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+// RegisterRoutes registers item domain HTTP routes. itemOwnerMiddleware is
+// RequireItemOwner.
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware, itemOwnerMiddleware echo.MiddlewareFunc) {
 	// All item routes require authentication
 	items := e.Group("/api/items", authMiddleware)
 	items.GET("", h.GetMyItems)
@@ -14,4 +15,39 @@ func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc
 	items.PUT("/:id", h.UpdateItem)
 	items.DELETE("/:id", h.DeleteItem)
 	items.POST("/:id/mark-purchased", h.MarkItemAsPurchased)
+	items.PUT("/:id/purchase-proof", h.SetPurchaseProof)
+	items.GET("/:id/purchase-proof", h.GetPurchaseProof)
+	items.POST("/:id/images", h.AddItemImage, itemOwnerMiddleware)
+	items.PUT("/:id/images/order", h.ReorderItemImages, itemOwnerMiddleware)
+	items.DELETE("/:id/images/:imageId", h.RemoveItemImage, itemOwnerMiddleware)
+}
+
+// RegisterReceivedRoutes registers the route marking an item received outside the
+// app. itemOwnerMiddleware is RequireItemOwner.
+func RegisterReceivedRoutes(e *echo.Echo, h *ReceivedHandler, authMiddleware, itemOwnerMiddleware echo.MiddlewareFunc) {
+	e.POST("/api/items/:id/mark-received", h.MarkItemAsReceived, authMiddleware, itemOwnerMiddleware)
+}
+
+// RegisterAttachmentRoutes registers the item attachment routes. itemOwnerMiddleware
+// is RequireItemOwner.
+func RegisterAttachmentRoutes(e *echo.Echo, h *AttachmentHandler, authMiddleware, itemOwnerMiddleware echo.MiddlewareFunc) {
+	attachments := e.Group("/api/items/:id/attachments", authMiddleware, itemOwnerMiddleware)
+	attachments.GET("", h.ListAttachments)
+	attachments.POST("", h.UploadAttachment)
+	attachments.DELETE("/:attachmentId", h.DeleteAttachment)
+
+	// Givers read the attachments of items on public wishlists without signing in
+	e.GET("/api/public/wishlists/:slug/items/:itemId/attachments", h.ListPublicAttachments)
+}
+
+// RegisterShareLinkRoutes registers the routes sharing a single item.
+// itemOwnerMiddleware is RequireItemOwner.
+func RegisterShareLinkRoutes(e *echo.Echo, h *ShareLinkHandler, authMiddleware, itemOwnerMiddleware echo.MiddlewareFunc) {
+	links := e.Group("/api/items/:id/share-links", authMiddleware, itemOwnerMiddleware)
+	links.GET("", h.ListShareLinks)
+	links.POST("", h.CreateShareLink)
+	links.DELETE("/:linkId", h.RevokeShareLink)
+
+	// Anyone holding a share link sees the item without signing in
+	e.GET("/api/public/items/:token", h.GetSharedItem)
 }
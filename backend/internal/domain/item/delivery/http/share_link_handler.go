@@ -0,0 +1,112 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/item/delivery/http/dto"
+	"wish-list/internal/domain/item/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ShareLinkHandler handles HTTP requests for links sharing a single gift item
+type ShareLinkHandler struct {
+	service service.ShareLinkServiceInterface
+}
+
+// NewShareLinkHandler creates a new ShareLinkHandler
+func NewShareLinkHandler(svc service.ShareLinkServiceInterface) *ShareLinkHandler {
+	return &ShareLinkHandler{
+		service: svc,
+	}
+}
+
+// ListShareLinks godoc
+//
+//	@Summary		List gift item share links
+//	@Description	List the links that share the item on its own, oldest first.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string						true	"Item ID"
+//	@Success		200	{object}	dto.ShareLinkListResponse	"Share links"
+//	@Failure		401	{object}	map[string]string			"Not authenticated"
+//	@Failure		403	{object}	map[string]string			"Access denied"
+//	@Failure		404	{object}	map[string]string			"Item not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/share-links [get]
+func (h *ShareLinkHandler) ListShareLinks(c echo.Context) error {
+	links, err := h.service.ListShareLinks(c.Request().Context(), OwnedItem(c))
+	if err != nil {
+		return mapShareLinkServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.ShareLinkListResponseFromService(links))
+}
+
+// CreateShareLink godoc
+//
+//	@Summary		Share a gift item
+//	@Description	Create a link that shows the item and its reservation status to anyone holding it, whether or not the wish lists it is on are public. The link works until it is revoked. An item has at most 10 share links.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string					true	"Item ID"
+//	@Success		201	{object}	dto.ShareLinkResponse	"Share link created"
+//	@Failure		401	{object}	map[string]string		"Not authenticated"
+//	@Failure		403	{object}	map[string]string		"Access denied"
+//	@Failure		404	{object}	map[string]string		"Item not found"
+//	@Failure		422	{object}	map[string]string		"Share link limit reached"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/share-links [post]
+func (h *ShareLinkHandler) CreateShareLink(c echo.Context) error {
+	link, err := h.service.CreateShareLink(c.Request().Context(), OwnedItem(c))
+	if err != nil {
+		return mapShareLinkServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.ShareLinkResponseFromService(link))
+}
+
+// RevokeShareLink godoc
+//
+//	@Summary		Revoke a gift item share link
+//	@Description	Delete a share link. Its token stops working right away.
+//	@Tags			Items
+//	@Param			id			path	string	true	"Item ID"
+//	@Param			linkId		path	string	true	"Share Link ID"
+//	@Success		204			"Share link revoked"
+//	@Failure		401			{object}	map[string]string	"Not authenticated"
+//	@Failure		403			{object}	map[string]string	"Access denied"
+//	@Failure		404			{object}	map[string]string	"Item or share link not found"
+//	@Failure		500			{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/items/{id}/share-links/{linkId} [delete]
+func (h *ShareLinkHandler) RevokeShareLink(c echo.Context) error {
+	if err := h.service.RevokeShareLink(c.Request().Context(), OwnedItem(c), c.Param("linkId")); err != nil {
+		return mapShareLinkServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// GetSharedItem godoc
+//
+//	@Summary		Get a shared gift item
+//	@Description	Returns the item a share link was created for, with its reservation status. Who reserved or bought it is not shown, and notes only when the owner made them public. Archived items and revoked links are not found.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			token	path		string					true	"Share token"
+//	@Success		200		{object}	dto.SharedItemResponse	"Shared item"
+//	@Failure		404		{object}	map[string]string		"Share link not found"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Router			/public/items/{token} [get]
+func (h *ShareLinkHandler) GetSharedItem(c echo.Context) error {
+	item, err := h.service.GetSharedItem(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return mapShareLinkServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.SharedItemResponseFromService(item))
+}
@@ -0,0 +1,152 @@
+// Package mapper converts stored gift items into the fields every service
+// output of an item shares, so the item, wishlist and wishlist item views cannot
+// drift apart.
+package mapper
+
+import (
+	"time"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
+)
+
+// Image is a picture of an item's gallery
+type Image struct {
+	ID       string // Empty for an image only ever set through image_url
+	URL      string
+	Position int
+}
+
+// Item is a gift item as its owner sees it. Services embed it in their outputs
+// and add what only they load, such as the wishlist it was read through.
+type Item struct {
+	ID              string
+	OwnerID         string
+	Name            string
+	Description     string
+	Link            string
+	ImageURL        string   // Primary image, same as Images[0].URL
+	Images          []*Image // Ordered gallery
+	Price           float64
+	Priority        int    // Legacy 0-10 number
+	PriorityLevel   string // must_have, nice_to_have or dream
+	PriorityWeight  int    // Sort weight of PriorityLevel, higher is more wanted
+	Notes           string
+	NotesVisibility string // private or public; guests of public wishlists only see public notes
+	IsPurchased     bool
+	IsReserved      bool // True only when every unit is reserved
+	IsArchived      bool
+
+	Quantity          int32  // Units wanted
+	ReservedQuantity  int32  // Units held by active reservations
+	ReservationStatus string // available, partially_reserved or fully_reserved
+
+	Options  variant.Options
+	Category string // Section on the share page, empty for none
+
+	// Reservation the owner recorded for someone outside the app
+	IsManuallyReserved    bool
+	ManualReservedByName  string
+	ManualReservationNote string
+
+	Version   int32 // Sent back with an update to detect concurrent changes
+	CreatedAt string
+	UpdatedAt string
+
+	AvailabilityStatus    string // Result of the last product page check
+	AvailabilityCheckedAt string // Empty until the link was checked
+}
+
+// FromGiftItem converts a stored item; NULL columns become zero values. Until
+// the caller loads the gallery, the single image stands in for it.
+func FromGiftItem(item *models.GiftItem) Item {
+	level := item.Level()
+
+	output := Item{
+		ID:              item.ID.String(),
+		OwnerID:         item.OwnerID.String(),
+		Name:            item.Name,
+		Description:     database.TextToString(item.Description),
+		Link:            database.TextToString(item.Link),
+		ImageURL:        database.TextToString(item.ImageUrl),
+		Price:           database.NumericToFloat64(item.Price),
+		Priority:        int(item.Priority.Int32),
+		PriorityLevel:   level,
+		PriorityWeight:  priority.Weight(level),
+		Notes:           database.TextToString(item.Notes),
+		NotesVisibility: item.NotesAudience(),
+		IsPurchased:     item.PurchasedByUserID.Valid,
+		IsReserved:      IsReserved(item),
+		IsArchived:      item.ArchivedAt.Valid,
+
+		Quantity:          item.WantedQuantity(),
+		ReservedQuantity:  item.ReservedQuantity,
+		ReservationStatus: item.ReservationState(),
+
+		Options:  item.Options,
+		Category: database.TextToString(item.Category),
+
+		IsManuallyReserved:    item.ManualReservedByName.Valid || item.ManualReservedAt.Valid,
+		ManualReservedByName:  database.TextToString(item.ManualReservedByName),
+		ManualReservationNote: database.TextToString(item.ManualReservationNote),
+
+		Version:   item.Version,
+		CreatedAt: item.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: item.UpdatedAt.Time.Format(time.RFC3339),
+
+		AvailabilityStatus: item.AvailabilityStatus,
+	}
+
+	if output.ImageURL != "" {
+		output.Images = []*Image{{URL: output.ImageURL}}
+	}
+	if item.AvailabilityCheckedAt.Valid {
+		output.AvailabilityCheckedAt = item.AvailabilityCheckedAt.Time.Format(time.RFC3339)
+	}
+
+	return output
+}
+
+// IsReserved reports whether every unit of an item not yet purchased is reserved
+func IsReserved(item *models.GiftItem) bool {
+	if item == nil {
+		return false
+	}
+
+	if item.PurchasedByUserID.Valid || item.PurchasedAt.Valid {
+		return false
+	}
+
+	return item.ReservationState() == models.ReservationFullyReserved
+}
+
+// HideManualReservation clears who the owner recorded as reserving the item, for
+// views shown to anyone but the owner; the item still shows as reserved
+func (i *Item) HideManualReservation() {
+	i.ManualReservedByName = ""
+	i.ManualReservationNote = ""
+}
+
+// Gallery converts an item's stored images, already in order
+func Gallery(images []*models.GiftItemImage) []*Image {
+	gallery := make([]*Image, 0, len(images))
+	for _, image := range images {
+		gallery = append(gallery, &Image{
+			ID:       image.ID.String(),
+			URL:      image.URL,
+			Position: int(image.Position),
+		})
+	}
+	return gallery
+}
+
+// ImageURLs returns the URLs of a gallery in order; never nil
+func ImageURLs(images []*Image) []string {
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.URL)
+	}
+	return urls
+}
@@ -0,0 +1,134 @@
+package mapper
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/variant"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mapperTime = time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+	ownerID    = pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	buyerID    = pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+)
+
+// fullItem is a manually reserved item with every other column set, except
+// that it is not purchased
+func fullItem() *models.GiftItem {
+	return &models.GiftItem{
+		ID:                    ownerID,
+		OwnerID:               ownerID,
+		Name:                  "Headphones",
+		Description:           pgtype.Text{String: "Noise cancelling", Valid: true},
+		Link:                  pgtype.Text{String: "https://shop.example/headphones", Valid: true},
+		ImageUrl:              pgtype.Text{String: "https://cdn.example/headphones.jpg", Valid: true},
+		Price:                 pgtype.Numeric{Int: big.NewInt(19999), Exp: -2, Valid: true},
+		Priority:              pgtype.Int4{Int32: 9, Valid: true},
+		PriorityLevel:         "must_have",
+		Notes:                 pgtype.Text{String: "Black, please", Valid: true},
+		ManualReservedByName:  pgtype.Text{String: "Grandma", Valid: true},
+		ManualReservationNote: pgtype.Text{String: "Said so at dinner", Valid: true},
+		ManualReservedAt:      pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		ArchivedAt:            pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		CreatedAt:             pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		UpdatedAt:             pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		Version:               4,
+		AvailabilityStatus:    models.AvailabilityAvailable,
+		AvailabilityCheckedAt: pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		Quantity:              1,
+		ReservedQuantity:      1,
+		Options:               variant.Options{Color: []string{"Black"}},
+		Category:              pgtype.Text{String: "Audio", Valid: true},
+	}
+}
+
+func TestFromGiftItem(t *testing.T) {
+	t.Run("every column is mapped", func(t *testing.T) {
+		output := FromGiftItem(fullItem())
+
+		// A reserved item is not purchased, so IsPurchased stays false
+		v := reflect.ValueOf(output)
+		for i := range v.NumField() {
+			if name := v.Type().Field(i).Name; v.Field(i).IsZero() && name != "IsPurchased" {
+				t.Errorf("%s is not mapped", name)
+			}
+		}
+		assert.Equal(t, "Noise cancelling", output.Description)
+		assert.InDelta(t, 199.99, output.Price, 0.001)
+		assert.Equal(t, "must_have", output.PriorityLevel)
+		assert.Equal(t, 3, output.PriorityWeight)
+		assert.Equal(t, "Black, please", output.Notes)
+		assert.Equal(t, models.NotesVisibilityPrivate, output.NotesVisibility)
+		assert.True(t, output.IsReserved)
+		assert.True(t, output.IsArchived)
+		assert.True(t, output.IsManuallyReserved)
+		assert.Equal(t, "Grandma", output.ManualReservedByName)
+		assert.Equal(t, "Said so at dinner", output.ManualReservationNote)
+		assert.Equal(t, int32(4), output.Version)
+		assert.Equal(t, models.ReservationFullyReserved, output.ReservationStatus)
+		assert.Equal(t, "Audio", output.Category)
+		assert.Equal(t, "2026-03-14T09:30:00Z", output.CreatedAt)
+		assert.Equal(t, "2026-03-14T09:30:00Z", output.AvailabilityCheckedAt)
+		assert.Equal(t, []*Image{{URL: "https://cdn.example/headphones.jpg"}}, output.Images)
+	})
+
+	t.Run("purchased items are not reserved", func(t *testing.T) {
+		item := fullItem()
+		item.PurchasedByUserID = buyerID
+		item.PurchasedAt = pgtype.Timestamptz{Time: mapperTime, Valid: true}
+
+		output := FromGiftItem(item)
+
+		assert.True(t, output.IsPurchased)
+		assert.False(t, output.IsReserved)
+	})
+
+	t.Run("NULL columns become zero values", func(t *testing.T) {
+		output := FromGiftItem(&models.GiftItem{ID: ownerID, OwnerID: ownerID, Name: "Bare"})
+
+		assert.Empty(t, output.Description)
+		assert.Empty(t, output.ImageURL)
+		assert.Nil(t, output.Images)
+		assert.Zero(t, output.Price)
+		assert.Equal(t, "nice_to_have", output.PriorityLevel)
+		assert.Empty(t, output.Notes)
+		assert.False(t, output.IsPurchased)
+		assert.False(t, output.IsReserved)
+		assert.False(t, output.IsArchived)
+		assert.False(t, output.IsManuallyReserved)
+		assert.Empty(t, output.ManualReservedByName)
+		assert.Empty(t, output.ManualReservationNote)
+		assert.Empty(t, output.Category)
+		assert.Empty(t, output.AvailabilityCheckedAt)
+		assert.Equal(t, int32(1), output.Quantity)
+		assert.Equal(t, models.ReservationAvailable, output.ReservationStatus)
+	})
+}
+
+func TestHideManualReservation(t *testing.T) {
+	output := FromGiftItem(fullItem())
+	output.HideManualReservation()
+
+	assert.Empty(t, output.ManualReservedByName)
+	assert.Empty(t, output.ManualReservationNote)
+	assert.True(t, output.IsManuallyReserved, "the item still shows as reserved")
+}
+
+func TestGallery(t *testing.T) {
+	imageID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	gallery := Gallery([]*models.GiftItemImage{
+		{ID: imageID, URL: "https://cdn.example/a.jpg", Position: 0},
+		{URL: "https://cdn.example/b.jpg", Position: 1},
+	})
+
+	assert.Equal(t, &Image{ID: imageID.String(), URL: "https://cdn.example/a.jpg"}, gallery[0])
+	assert.Equal(t, []string{"https://cdn.example/a.jpg", "https://cdn.example/b.jpg"}, ImageURLs(gallery))
+	assert.Equal(t, []string{}, ImageURLs(nil))
+}
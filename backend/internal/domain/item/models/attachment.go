@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GiftItemAttachment is a file the owner attached to a gift item for givers,
+// e.g. a size chart or a specification PDF. The file is stored in S3 under
+// ObjectKey and is only served through presigned URLs.
+type GiftItemAttachment struct {
+	ID          pgtype.UUID        `db:"id"`
+	GiftItemID  pgtype.UUID        `db:"gift_item_id"`
+	FileName    string             `db:"file_name"`
+	ContentType string             `db:"content_type"`
+	SizeBytes   int64              `db:"size_bytes"`
+	ObjectKey   string             `db:"object_key"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+}
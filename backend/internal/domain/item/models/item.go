@@ -2,28 +2,137 @@ package models
 
 import (
 	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
 )
 
 type GiftItem struct {
-	ID                pgtype.UUID        `db:"id"`
-	OwnerID           pgtype.UUID        `db:"owner_id"` // Items belong to users, not wishlists
-	Name              string             `db:"name"`
-	Description       pgtype.Text        `db:"description"`
-	Link              pgtype.Text        `db:"link"`
-	ImageUrl          pgtype.Text        `db:"image_url"`
-	Price             pgtype.Numeric     `db:"price"`
-	Priority          pgtype.Int4        `db:"priority"`
-	ReservedByUserID  pgtype.UUID        `db:"reserved_by_user_id"`
-	ReservedAt        pgtype.Timestamptz `db:"reserved_at"`
-	PurchasedByUserID pgtype.UUID        `db:"purchased_by_user_id"`
-	PurchasedAt       pgtype.Timestamptz `db:"purchased_at"`
-	PurchasedPrice    pgtype.Numeric     `db:"purchased_price"`
-	Notes                  pgtype.Text        `db:"notes"`
-	Position               pgtype.Int4        `db:"position"`
-	ManualReservedByName   pgtype.Text        `db:"manual_reserved_by_name"`
-	ManualReservationNote  pgtype.Text        `db:"manual_reservation_note"`
-	ManualReservedAt       pgtype.Timestamptz `db:"manual_reserved_at"`
-	ArchivedAt             pgtype.Timestamptz `db:"archived_at"` // Soft delete
-	CreatedAt              pgtype.Timestamptz `db:"created_at"`
-	UpdatedAt              pgtype.Timestamptz `db:"updated_at"`
+	ID                            pgtype.UUID        `db:"id"`
+	OwnerID                       pgtype.UUID        `db:"owner_id"` // Items belong to users, not wishlists
+	Name                          string             `db:"name"`
+	Description                   pgtype.Text        `db:"description"`
+	Link                          pgtype.Text        `db:"link"`
+	ImageUrl                      pgtype.Text        `db:"image_url"`
+	Price                         pgtype.Numeric     `db:"price"`
+	Priority                      pgtype.Int4        `db:"priority"`       // Legacy 0-10 number, kept for older clients
+	PriorityLevel                 string             `db:"priority_level"` // must_have, nice_to_have or dream
+	ReservedByUserID              pgtype.UUID        `db:"reserved_by_user_id"`
+	ReservedAt                    pgtype.Timestamptz `db:"reserved_at"`
+	PurchasedByUserID             pgtype.UUID        `db:"purchased_by_user_id"`
+	PurchasedAt                   pgtype.Timestamptz `db:"purchased_at"`
+	PurchasedPrice                pgtype.Numeric     `db:"purchased_price"`
+	Notes                         pgtype.Text        `db:"notes"`
+	Position                      pgtype.Int4        `db:"position"`
+	ManualReservedByName          pgtype.Text        `db:"manual_reserved_by_name"`
+	EncryptedManualReservedByName pgtype.Text        `db:"encrypted_manual_reserved_by_name"` // PII encrypted
+	ManualReservationNote         pgtype.Text        `db:"manual_reservation_note"`
+	ManualReservedAt              pgtype.Timestamptz `db:"manual_reserved_at"`
+	ArchivedAt                    pgtype.Timestamptz `db:"archived_at"` // Soft delete
+	CreatedAt                     pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt                     pgtype.Timestamptz `db:"updated_at"`
+	Version                       int32              `db:"version"`                 // Optimistic locking, bumped on every update
+	AvailabilityStatus            string             `db:"availability_status"`     // Result of the last product page check
+	AvailabilityCheckedAt         pgtype.Timestamptz `db:"availability_checked_at"` // NULL until the link was checked
+	Quantity                      int32              `db:"quantity"`                // Units wanted, at least 1
+	ReservedQuantity              int32              `db:"reserved_quantity"`       // Units held by active reservations
+	Options                       variant.Options    `db:"options"`                 // Acceptable sizes, colors and models
+	Category                      pgtype.Text        `db:"category"`                // Free-form section on the share page
+	NotesVisibility               string             `db:"notes_visibility"`        // Whether guests see Notes, private by default
+}
+
+// Who may read an item's notes
+const (
+	NotesVisibilityPrivate = "private" // Only the owner
+	NotesVisibilityPublic  = "public"  // Also guests of the owner's public wishlists
+)
+
+// NotesAudience returns who may read the item's notes; rows read without the
+// notes_visibility column are private
+func (g *GiftItem) NotesAudience() string {
+	if g.NotesVisibility == NotesVisibilityPublic {
+		return NotesVisibilityPublic
+	}
+	return NotesVisibilityPrivate
+}
+
+// Availability statuses of an item's product page
+const (
+	AvailabilityUnknown    = "unknown"      // Not checked yet, no link, or the last check was inconclusive
+	AvailabilityAvailable  = "available"    // Page loads and does not report the product as unavailable
+	AvailabilityOutOfStock = "out_of_stock" // Page reports the product as sold out or discontinued
+	AvailabilityNotFound   = "not_found"    // Page is gone (404 or 410)
+)
+
+// SetPriority stores a priority level together with its legacy number. An empty
+// level is derived from number, for callers that only know the 0-10 scale.
+func (g *GiftItem) SetPriority(level string, number int32) {
+	if level == "" {
+		level = priority.FromNumber(number)
+	}
+	g.PriorityLevel = level
+	g.Priority = pgtype.Int4{Int32: number, Valid: true}
+}
+
+// Level returns the item's priority level; rows read without the
+// priority_level column fall back to the legacy number
+func (g *GiftItem) Level() string {
+	if g.PriorityLevel != "" {
+		return g.PriorityLevel
+	}
+	return priority.FromNumber(g.Priority.Int32)
+}
+
+// Reservation states derived from an item's quantities
+const (
+	ReservationAvailable         = "available"          // No unit is reserved
+	ReservationPartiallyReserved = "partially_reserved" // Some, but not all, units are reserved
+	ReservationFullyReserved     = "fully_reserved"     // Nothing left to reserve
+)
+
+// WantedQuantity returns the number of units wanted; rows read without the
+// quantity column count as a single unit
+func (g *GiftItem) WantedQuantity() int32 {
+	return max(g.Quantity, 1)
+}
+
+// RemainingQuantity returns how many units can still be reserved. An exclusive
+// reservation (the owner's manual one, or a registered user's hold on a
+// single-unit item) leaves nothing to reserve.
+func (g *GiftItem) RemainingQuantity() int32 {
+	if g.ManualReservedByName.Valid || g.ManualReservedAt.Valid || g.ReservedByUserID.Valid || g.ReservedAt.Valid {
+		return 0
+	}
+	return max(g.WantedQuantity()-g.ReservedQuantity, 0)
+}
+
+// ReservationState reports whether the item is available, partially or fully
+// reserved
+func (g *GiftItem) ReservationState() string {
+	switch remaining := g.RemainingQuantity(); {
+	case remaining == 0:
+		return ReservationFullyReserved
+	case remaining < g.WantedQuantity():
+		return ReservationPartiallyReserved
+	default:
+		return ReservationAvailable
+	}
+}
+
+// GiftItemGroup tallies the items of one section of a public wishlist
+type GiftItemGroup struct {
+	Key       string `db:"group_key"` // Priority level or category; empty for uncategorized items
+	Count     int    `db:"item_count"`
+	Reserved  int    `db:"reserved_count"`  // Items with nothing left to reserve
+	Available int    `db:"available_count"` // Items with units still open
+}
+
+// GiftItemImage is one entry of an item's ordered image gallery.
+// Position 0 is the primary image mirrored into GiftItem.ImageUrl.
+type GiftItemImage struct {
+	ID         pgtype.UUID        `db:"id"`
+	GiftItemID pgtype.UUID        `db:"gift_item_id"`
+	URL        string             `db:"url"`
+	Position   int32              `db:"position"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
 }
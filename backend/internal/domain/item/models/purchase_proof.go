@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Purchase proof visibility to the item's owner
+const (
+	ProofVisibilityPrivate       = "private"        // Only the purchaser
+	ProofVisibilityAfterOccasion = "after_occasion" // Once the item's occasions have passed
+	ProofVisibilityOwner         = "owner"          // Right away
+)
+
+// PurchaseProof is an order confirmation screenshot and/or tracking number the
+// purchaser attached to a purchased gift item
+type PurchaseProof struct {
+	GiftItemID      pgtype.UUID        `db:"gift_item_id"`
+	PurchaserUserID pgtype.UUID        `db:"purchaser_user_id"`
+	ImageURL        pgtype.Text        `db:"image_url"`
+	TrackingNumber  pgtype.Text        `db:"tracking_number"`
+	Visibility      string             `db:"visibility"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
+
+	// Latest occasion date of the wishlists holding the item; NULL when none has one.
+	// Read only, derived when the proof is loaded.
+	RevealDate pgtype.Date `db:"reveal_date"`
+}
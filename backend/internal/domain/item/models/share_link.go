@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GiftItemShareLink shares a single gift item with anyone holding its link.
+// The link's token is its ID signed with the server secret.
+type GiftItemShareLink struct {
+	ID         pgtype.UUID        `db:"id"`
+	GiftItemID pgtype.UUID        `db:"gift_item_id"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+}
@@ -0,0 +1,122 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_giftitem_attachment_repository_test.go -pkg service . GiftItemAttachmentRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/models"
+)
+
+// Sentinel errors for gift item attachment repository
+var (
+	ErrGiftItemAttachmentNotFound     = errors.New("gift item attachment not found")
+	ErrGiftItemAttachmentLimitReached = errors.New("gift item has the maximum number of attachments")
+)
+
+// GiftItemAttachmentRepositoryInterface defines operations on the files attached to an item
+type GiftItemAttachmentRepositoryInterface interface {
+	// ListByItem returns the item's attachments, oldest first
+	ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error)
+	// Add stores an attachment unless the item already has limit attachments
+	Add(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error)
+	// Remove deletes an attachment and returns it, so its file can be deleted too
+	Remove(ctx context.Context, itemID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error)
+}
+
+// GiftItemAttachmentRepository handles attachment-related database operations
+type GiftItemAttachmentRepository struct {
+	db *database.DB
+}
+
+// NewGiftItemAttachmentRepository creates a new GiftItemAttachmentRepository
+func NewGiftItemAttachmentRepository(db *database.DB) GiftItemAttachmentRepositoryInterface {
+	return &GiftItemAttachmentRepository{
+		db: db,
+	}
+}
+
+const giftItemAttachmentColumns = `id, gift_item_id, file_name, content_type, size_bytes, object_key, created_at`
+
+// ListByItem returns the item's attachments, oldest first
+func (r *GiftItemAttachmentRepository) ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+	var attachments []*models.GiftItemAttachment
+	err := r.db.SelectContext(ctx, &attachments,
+		`SELECT `+giftItemAttachmentColumns+`
+		 FROM gift_item_attachments
+		 WHERE gift_item_id = $1
+		 ORDER BY created_at, id`,
+		itemID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift item attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// Add stores an attachment. The item row is locked while counting so that
+// concurrent uploads cannot exceed limit.
+func (r *GiftItemAttachmentRepository) Add(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	if _, err := lockGalleryItem(ctx, tx, attachment.GiftItemID); err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count,
+		`SELECT COUNT(*) FROM gift_item_attachments WHERE gift_item_id = $1`, attachment.GiftItemID,
+	); err != nil {
+		return nil, fmt.Errorf("failed to count gift item attachments: %w", err)
+	}
+	if count >= limit {
+		return nil, ErrGiftItemAttachmentLimitReached
+	}
+
+	var created models.GiftItemAttachment
+	err = tx.QueryRowxContext(ctx,
+		`INSERT INTO gift_item_attachments (gift_item_id, file_name, content_type, size_bytes, object_key)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING `+giftItemAttachmentColumns,
+		attachment.GiftItemID, attachment.FileName, attachment.ContentType, attachment.SizeBytes, attachment.ObjectKey,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add gift item attachment: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit gift item attachment: %w", err)
+	}
+
+	return &created, nil
+}
+
+// Remove deletes an attachment of the item and returns the deleted row
+func (r *GiftItemAttachmentRepository) Remove(ctx context.Context, itemID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+	var removed models.GiftItemAttachment
+	err := r.db.GetContext(ctx, &removed,
+		`DELETE FROM gift_item_attachments
+		 WHERE id = $1 AND gift_item_id = $2
+		 RETURNING `+giftItemAttachmentColumns,
+		attachmentID, itemID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGiftItemAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to remove gift item attachment: %w", err)
+	}
+
+	return &removed, nil
+}
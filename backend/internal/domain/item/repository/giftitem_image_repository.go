@@ -0,0 +1,356 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_giftitem_image_repository_test.go -pkg service . GiftItemImageRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/logger"
+)
+
+// Sentinel errors for gift item image repository
+var (
+	ErrGiftItemImageNotFound      = errors.New("gift item image not found")
+	ErrGiftItemImageLimitReached  = errors.New("gift item has the maximum number of images")
+	ErrGiftItemImageOrderMismatch = errors.New("image order must list every image of the item exactly once")
+)
+
+// GiftItemImageRepositoryInterface defines operations on an item's image gallery.
+// Every change keeps gift_items.image_url equal to the first image.
+type GiftItemImageRepositoryInterface interface {
+	// GetByItemIDs returns the ordered images of each item, keyed by item ID
+	GetByItemIDs(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error)
+	// Add appends an image unless the item already has limit images
+	Add(ctx context.Context, itemID pgtype.UUID, url string, limit int) (*models.GiftItemImage, error)
+	// Remove deletes an image and closes the gap in positions
+	Remove(ctx context.Context, itemID, imageID pgtype.UUID) error
+	// Reorder sets positions to the order of imageIDs
+	Reorder(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error
+	// ReplacePrimary points the first image at url after image_url was changed directly
+	ReplacePrimary(ctx context.Context, itemID pgtype.UUID, url string) error
+}
+
+// GiftItemImageRepository handles gallery-related database operations
+type GiftItemImageRepository struct {
+	db *database.DB
+}
+
+// NewGiftItemImageRepository creates a new GiftItemImageRepository
+func NewGiftItemImageRepository(db *database.DB) GiftItemImageRepositoryInterface {
+	return &GiftItemImageRepository{
+		db: db,
+	}
+}
+
+const giftItemImageColumns = `id, gift_item_id, url, position, created_at`
+
+// GetByItemIDs returns the ordered images of each item in a single query
+func (r *GiftItemImageRepository) GetByItemIDs(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+	imagesByItem := make(map[string][]*models.GiftItemImage, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return imagesByItem, nil
+	}
+
+	itemIDStrings := make([]string, len(itemIDs))
+	for i, id := range itemIDs {
+		itemIDStrings[i] = id.String()
+	}
+
+	query, args, err := sqlx.In(
+		`SELECT `+giftItemImageColumns+`
+		 FROM gift_item_images
+		 WHERE gift_item_id::text IN (?)
+		 ORDER BY gift_item_id, position`,
+		itemIDStrings,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build gift item images query: %w", err)
+	}
+	query = r.db.Rebind(query)
+
+	var images []*models.GiftItemImage
+	if err := r.db.SelectContext(ctx, &images, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get gift item images: %w", err)
+	}
+
+	for _, image := range images {
+		key := image.GiftItemID.String()
+		imagesByItem[key] = append(imagesByItem[key], image)
+	}
+
+	return imagesByItem, nil
+}
+
+// Add appends an image to the gallery. An item whose image was only ever set
+// through image_url gets that image as its first gallery entry.
+func (r *GiftItemImageRepository) Add(ctx context.Context, itemID pgtype.UUID, url string, limit int) (*models.GiftItemImage, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	legacyURL, err := lockGalleryItem(ctx, tx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM gift_item_images WHERE gift_item_id = $1`, itemID); err != nil {
+		return nil, fmt.Errorf("failed to count gift item images: %w", err)
+	}
+
+	if count == 0 && legacyURL.Valid && legacyURL.String != "" {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO gift_item_images (gift_item_id, url, position) VALUES ($1, $2, 0)`,
+			itemID, legacyURL.String,
+		); err != nil {
+			return nil, fmt.Errorf("failed to keep existing gift item image: %w", err)
+		}
+		count = 1
+	}
+
+	if count >= limit {
+		return nil, ErrGiftItemImageLimitReached
+	}
+
+	var image models.GiftItemImage
+	err = tx.QueryRowxContext(ctx,
+		`INSERT INTO gift_item_images (gift_item_id, url, position) VALUES ($1, $2, $3)
+		 RETURNING `+giftItemImageColumns,
+		itemID, url, count,
+	).StructScan(&image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add gift item image: %w", err)
+	}
+
+	if err := syncPrimaryImage(ctx, tx, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit gift item image: %w", err)
+	}
+
+	return &image, nil
+}
+
+// Remove deletes an image and shifts the images after it up by one
+func (r *GiftItemImageRepository) Remove(ctx context.Context, itemID, imageID pgtype.UUID) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	if _, err := lockGalleryItem(ctx, tx, itemID); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM gift_item_images WHERE id = $1 AND gift_item_id = $2`,
+		imageID, itemID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove gift item image: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrGiftItemImageNotFound
+	}
+
+	if err := compactImagePositions(ctx, tx, itemID); err != nil {
+		return err
+	}
+	if err := syncPrimaryImage(ctx, tx, itemID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit gift item image removal: %w", err)
+	}
+
+	return nil
+}
+
+// Reorder assigns positions following imageIDs, which must name every image
+// of the item exactly once
+func (r *GiftItemImageRepository) Reorder(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	if _, err := lockGalleryItem(ctx, tx, itemID); err != nil {
+		return err
+	}
+
+	var existing []pgtype.UUID
+	if err := tx.SelectContext(ctx, &existing, `SELECT id FROM gift_item_images WHERE gift_item_id = $1`, itemID); err != nil {
+		return fmt.Errorf("failed to get gift item images: %w", err)
+	}
+
+	if len(existing) != len(imageIDs) {
+		return ErrGiftItemImageOrderMismatch
+	}
+	remaining := make(map[[16]byte]struct{}, len(existing))
+	for _, id := range existing {
+		remaining[id.Bytes] = struct{}{}
+	}
+	for _, id := range imageIDs {
+		if _, ok := remaining[id.Bytes]; !ok {
+			return ErrGiftItemImageOrderMismatch
+		}
+		delete(remaining, id.Bytes)
+	}
+
+	// Positions may collide until every row is updated; the unique constraint is deferred to commit
+	for position, id := range imageIDs {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE gift_item_images SET position = $3 WHERE id = $1 AND gift_item_id = $2`,
+			id, itemID, position,
+		); err != nil {
+			return fmt.Errorf("failed to reorder gift item images: %w", err)
+		}
+	}
+
+	if err := syncPrimaryImage(ctx, tx, itemID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit gift item image order: %w", err)
+	}
+
+	return nil
+}
+
+// ReplacePrimary mirrors a direct image_url change into the gallery: the first
+// image gets the new url, or is removed when url is empty. gift_items itself is
+// left untouched because the caller has already written image_url.
+func (r *GiftItemImageRepository) ReplacePrimary(ctx context.Context, itemID pgtype.UUID, url string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	if _, err := lockGalleryItem(ctx, tx, itemID); err != nil {
+		return err
+	}
+
+	if url == "" {
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM gift_item_images WHERE gift_item_id = $1 AND position = 0`, itemID,
+		); err != nil {
+			return fmt.Errorf("failed to remove primary gift item image: %w", err)
+		}
+		if err := compactImagePositions(ctx, tx, itemID); err != nil {
+			return err
+		}
+		// The next image, if any, is now primary
+		if err := syncPrimaryImage(ctx, tx, itemID); err != nil {
+			return err
+		}
+	} else {
+		result, err := tx.ExecContext(ctx,
+			`UPDATE gift_item_images SET url = $2 WHERE gift_item_id = $1 AND position = 0`,
+			itemID, url,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to replace primary gift item image: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO gift_item_images (gift_item_id, url, position) VALUES ($1, $2, 0)`,
+				itemID, url,
+			); err != nil {
+				return fmt.Errorf("failed to add primary gift item image: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit primary gift item image: %w", err)
+	}
+
+	return nil
+}
+
+// lockGalleryItem locks the item row so concurrent gallery changes serialize,
+// and returns its current image_url
+func lockGalleryItem(ctx context.Context, tx *sqlx.Tx, itemID pgtype.UUID) (pgtype.Text, error) {
+	var imageURL pgtype.Text
+	err := tx.GetContext(ctx, &imageURL,
+		`SELECT image_url FROM gift_items WHERE id = $1 AND archived_at IS NULL FOR UPDATE`, itemID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pgtype.Text{}, ErrGiftItemNotFound
+		}
+		return pgtype.Text{}, fmt.Errorf("failed to lock gift item: %w", err)
+	}
+	return imageURL, nil
+}
+
+// compactImagePositions renumbers the gallery to 0..n-1, keeping its order
+func compactImagePositions(ctx context.Context, tx *sqlx.Tx, itemID pgtype.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE gift_item_images g
+		SET position = ordered.new_position
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY position) - 1 AS new_position
+			FROM gift_item_images
+			WHERE gift_item_id = $1
+		) ordered
+		WHERE g.id = ordered.id AND g.position <> ordered.new_position
+	`, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to renumber gift item images: %w", err)
+	}
+	return nil
+}
+
+// syncPrimaryImage copies the first gallery image into gift_items.image_url
+// and bumps the item version so cached ETags go stale
+func syncPrimaryImage(ctx context.Context, tx *sqlx.Tx, itemID pgtype.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE gift_items SET
+			image_url = (
+				SELECT url FROM gift_item_images
+				WHERE gift_item_id = $1
+				ORDER BY position
+				LIMIT 1
+			),
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1
+	`, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to update primary image: %w", err)
+	}
+	return nil
+}
+
+func rollbackImageTx(ctx context.Context, tx *sqlx.Tx) {
+	if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+		logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+	}
+}
@@ -19,8 +19,15 @@ import (
 type GiftItemPurchaseRepositoryInterface interface {
 	// MarkAsPurchased marks a gift item as purchased
 	MarkAsPurchased(ctx context.Context, giftItemID, userID pgtype.UUID, purchasedPrice pgtype.Numeric) (*models.GiftItem, error)
+	// SaveProof creates or replaces the purchase proof of a gift item
+	SaveProof(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error)
+	// GetProof returns the purchase proof of a gift item
+	GetProof(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error)
 }
 
+// ErrPurchaseProofNotFound is returned when a gift item has no purchase proof
+var ErrPurchaseProofNotFound = errors.New("purchase proof not found")
+
 // GiftItemPurchaseRepository handles purchase-related database operations
 type GiftItemPurchaseRepository struct {
 	db *database.DB
@@ -36,7 +43,8 @@ func NewGiftItemPurchaseRepository(db *database.DB) GiftItemPurchaseRepositoryIn
 // giftItemColumnsPurchase is the standard column list for gift_items queries
 const giftItemColumnsPurchase = `id, owner_id, name, description, link, image_url, price, priority,
 	reserved_by_user_id, reserved_at, purchased_by_user_id, purchased_at,
-	purchased_price, notes, position, archived_at, created_at, updated_at`
+	purchased_price, notes, position, archived_at, created_at, updated_at, version,
+	availability_status, availability_checked_at, quantity, reserved_quantity, priority_level, options`
 
 // MarkAsPurchased marks a gift item as purchased
 func (r *GiftItemPurchaseRepository) MarkAsPurchased(ctx context.Context, giftItemID, userID pgtype.UUID, purchasedPrice pgtype.Numeric) (*models.GiftItem, error) {
@@ -47,6 +55,7 @@ func (r *GiftItemPurchaseRepository) MarkAsPurchased(ctx context.Context, giftIt
 			purchased_price = $4,
 			reserved_by_user_id = NULL,
 			reserved_at = NULL,
+			version = version + 1,
 			updated_at = NOW()
 		WHERE id = $1
 		RETURNING %s
@@ -70,3 +79,65 @@ func (r *GiftItemPurchaseRepository) MarkAsPurchased(ctx context.Context, giftIt
 
 	return &updatedGiftItem, nil
 }
+
+// purchaseProofRevealDate is the latest occasion date of the wishlists holding the item
+const purchaseProofRevealDate = `(
+	SELECT MAX(w.occasion_date)
+	FROM wishlist_items wi
+	JOIN wishlists w ON w.id = wi.wishlist_id
+	WHERE wi.gift_item_id = pp.gift_item_id
+) AS reveal_date`
+
+// SaveProof creates or replaces the purchase proof of a gift item
+func (r *GiftItemPurchaseRepository) SaveProof(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error) {
+	query := `
+		WITH pp AS (
+			INSERT INTO purchase_proofs (gift_item_id, purchaser_user_id, image_url, tracking_number, visibility)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (gift_item_id) DO UPDATE SET
+				purchaser_user_id = EXCLUDED.purchaser_user_id,
+				image_url = EXCLUDED.image_url,
+				tracking_number = EXCLUDED.tracking_number,
+				visibility = EXCLUDED.visibility,
+				updated_at = NOW()
+			RETURNING gift_item_id, purchaser_user_id, image_url, tracking_number, visibility, created_at, updated_at
+		)
+		SELECT pp.*, ` + purchaseProofRevealDate + `
+		FROM pp
+	`
+
+	var saved models.PurchaseProof
+	err := r.db.QueryRowxContext(ctx, query,
+		proof.GiftItemID,
+		proof.PurchaserUserID,
+		proof.ImageURL,
+		proof.TrackingNumber,
+		proof.Visibility,
+	).StructScan(&saved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save purchase proof: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// GetProof returns the purchase proof of a gift item
+func (r *GiftItemPurchaseRepository) GetProof(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error) {
+	query := `
+		SELECT
+			pp.gift_item_id, pp.purchaser_user_id, pp.image_url, pp.tracking_number, pp.visibility,
+			pp.created_at, pp.updated_at, ` + purchaseProofRevealDate + `
+		FROM purchase_proofs pp
+		WHERE pp.gift_item_id = $1
+	`
+
+	var proof models.PurchaseProof
+	if err := r.db.GetContext(ctx, &proof, query, giftItemID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPurchaseProofNotFound
+		}
+		return nil, fmt.Errorf("failed to get purchase proof: %w", err)
+	}
+
+	return &proof, nil
+}
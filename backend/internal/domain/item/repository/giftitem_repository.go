@@ -15,6 +15,8 @@ import (
 
 	"wish-list/internal/app/database"
 	"wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/variant"
 )
 
 // Sentinel errors for gift item repository
@@ -24,8 +26,10 @@ var (
 	ErrGiftItemNotAvailable      = errors.New("gift item is not available for manual reservation")
 	ErrGiftItemAlreadyArchived   = errors.New("item not found or already archived")
 	ErrGiftItemConcurrentReserve = errors.New("gift item was reserved by another transaction")
+	ErrGiftItemVersionConflict   = errors.New("gift item was modified by another request")
 	ErrInvalidSortField          = errors.New("invalid sort field")
 	ErrInvalidSortOrder          = errors.New("invalid sort order")
+	ErrInvalidGroupField         = errors.New("invalid group field")
 )
 
 // validSortFields defines allowed sort fields for SQL queries
@@ -34,6 +38,14 @@ var validSortFields = map[string]string{
 	"updated_at": "updated_at",
 	"title":      "name",
 	"price":      "price",
+	"priority":   "priority_weight",
+}
+
+// validGroupFields maps public group_by values to the grouping key and the
+// order of the resulting groups
+var validGroupFields = map[string]struct{ key, order string }{
+	"priority": {key: "gi.priority_level", order: "MAX(gi.priority_weight) DESC"},
+	"category": {key: "COALESCE(gi.category, '')", order: "group_key = '' ASC, group_key ASC"},
 }
 
 // validSortOrders defines allowed sort orders for SQL queries
@@ -45,15 +57,19 @@ var validSortOrders = map[string]bool{
 // giftItemColumns is the standard column list for gift_items queries
 const giftItemColumns = `id, owner_id, name, description, link, image_url, price, priority,
 	reserved_by_user_id, reserved_at, purchased_by_user_id, purchased_at,
-	purchased_price, notes, position, manual_reserved_by_name, manual_reservation_note,
-	manual_reserved_at, archived_at, created_at, updated_at`
+	purchased_price, notes, position, manual_reserved_by_name, encrypted_manual_reserved_by_name,
+	manual_reservation_note, manual_reserved_at, archived_at, created_at, updated_at, version,
+	availability_status, availability_checked_at, quantity, reserved_quantity, priority_level, options, category,
+	notes_visibility`
 
 // giftItemColumnsAliased is the column list prefixed with gi. alias
 const giftItemColumnsAliased = `gi.id, gi.owner_id, gi.name, gi.description, gi.link, gi.image_url,
 	gi.price, gi.priority, gi.reserved_by_user_id, gi.reserved_at,
 	gi.purchased_by_user_id, gi.purchased_at, gi.purchased_price,
-	gi.notes, gi.position, gi.manual_reserved_by_name, gi.manual_reservation_note,
-	gi.manual_reserved_at, gi.archived_at, gi.created_at, gi.updated_at`
+	gi.notes, gi.position, gi.manual_reserved_by_name, gi.encrypted_manual_reserved_by_name,
+	gi.manual_reservation_note, gi.manual_reserved_at, gi.archived_at, gi.created_at, gi.updated_at, gi.version,
+	gi.availability_status, gi.availability_checked_at, gi.quantity, gi.reserved_quantity, gi.priority_level, gi.options, gi.category,
+	gi.notes_visibility`
 
 // giftItemColumnsPublicAliased includes guest reservation fallback from reservations table.
 // For guest reservations, gift_items.reserved_* can remain NULL; this projection keeps
@@ -63,14 +79,16 @@ const giftItemColumnsPublicAliased = `gi.id, gi.owner_id, gi.name, gi.descriptio
 	COALESCE(gi.reserved_by_user_id, ar.reserved_by_user_id) AS reserved_by_user_id,
 	COALESCE(gi.reserved_at, ar.reserved_at) AS reserved_at,
 	gi.purchased_by_user_id, gi.purchased_at, gi.purchased_price,
-	gi.notes, gi.position, gi.manual_reserved_by_name, gi.manual_reservation_note,
-	gi.manual_reserved_at, gi.archived_at, gi.created_at, gi.updated_at`
+	gi.notes, gi.position, gi.manual_reserved_by_name, gi.encrypted_manual_reserved_by_name,
+	gi.manual_reservation_note, gi.manual_reserved_at, gi.archived_at, gi.created_at, gi.updated_at, gi.version,
+	gi.availability_status, gi.availability_checked_at, gi.quantity, gi.reserved_quantity, gi.priority_level, gi.options, gi.category,
+	gi.notes_visibility`
 
 // ItemFilters contains filter and pagination parameters for querying items
 type ItemFilters struct {
 	Page            int
 	Limit           int
-	Sort            string // created_at, updated_at, title, price
+	Sort            string // created_at, updated_at, title, price, priority
 	Order           string // asc, desc
 	Unattached      bool   // Items not attached to any wishlist
 	Attached        bool   // Items attached to any wishlist
@@ -95,6 +113,7 @@ type GiftItemRepositoryInterface interface {
 	GetByWishList(ctx context.Context, wishlistID pgtype.UUID) ([]*models.GiftItem, error)
 	GetPublicWishListGiftItems(ctx context.Context, publicSlug string) ([]*models.GiftItem, error)
 	GetPublicWishListGiftItemsPaginated(ctx context.Context, publicSlug string, limit, offset int) ([]*models.GiftItem, int, error)
+	GetPublicWishListGiftItemGroups(ctx context.Context, publicSlug, groupBy string) ([]*models.GiftItemGroup, error)
 	GetUnattached(ctx context.Context, ownerID pgtype.UUID) ([]*models.GiftItem, error)
 	Update(ctx context.Context, giftItem models.GiftItem) (*models.GiftItem, error)
 	UpdateWithNewSchema(ctx context.Context, giftItem *models.GiftItem) (*models.GiftItem, error)
@@ -102,20 +121,63 @@ type GiftItemRepositoryInterface interface {
 	Delete(ctx context.Context, id pgtype.UUID) error
 	DeleteWithExecutor(ctx context.Context, executor database.Executor, id pgtype.UUID) error
 	SoftDelete(ctx context.Context, id pgtype.UUID) error
+	PurgeArchivedBefore(ctx context.Context, before time.Time) (int64, error)
+	ListDueForAvailabilityCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.GiftItem, error)
+	UpdateAvailability(ctx context.Context, id pgtype.UUID, status string) error
+	GetReservedVariants(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error)
 }
 
 // GiftItemRepository implements GiftItemRepositoryInterface
 type GiftItemRepository struct {
-	db *database.DB
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
 }
 
 // NewGiftItemRepository creates a new GiftItemRepository
 func NewGiftItemRepository(db *database.DB) GiftItemRepositoryInterface {
 	return &GiftItemRepository{
-		db: db,
+		db:                db,
+		encryptionEnabled: false, // Encryption disabled by default for backward compatibility
+	}
+}
+
+// NewGiftItemRepositoryWithEncryption creates a new GiftItemRepository with encryption enabled
+func NewGiftItemRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) GiftItemRepositoryInterface {
+	return &GiftItemRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
 	}
 }
 
+// decryptGiftItemPII decrypts the manual reservation name in the gift item struct
+func (r *GiftItemRepository) decryptGiftItemPII(ctx context.Context, giftItem *models.GiftItem) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if giftItem.EncryptedManualReservedByName.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, giftItem.EncryptedManualReservedByName.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt manual reserved by name: %w", err)
+		}
+		giftItem.ManualReservedByName = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	return nil
+}
+
+// decryptGiftItemsPII decrypts PII for a slice of gift items
+func (r *GiftItemRepository) decryptGiftItemsPII(ctx context.Context, giftItems []*models.GiftItem) error {
+	for _, giftItem := range giftItems {
+		if err := r.decryptGiftItemPII(ctx, giftItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // CRUD operations
 // ---------------------------------------------------------------------------
@@ -124,9 +186,10 @@ func NewGiftItemRepository(db *database.DB) GiftItemRepositoryInterface {
 func (r *GiftItemRepository) CreateWithOwner(ctx context.Context, giftItem models.GiftItem) (*models.GiftItem, error) {
 	query := fmt.Sprintf(`
 		INSERT INTO gift_items (
-			owner_id, name, description, link, image_url, price, priority, notes, position
+			owner_id, name, description, link, image_url, price, priority, notes, position, quantity,
+			priority_level, options, category, notes_visibility
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 		) RETURNING %s
 	`, giftItemColumns)
 
@@ -144,11 +207,20 @@ func (r *GiftItemRepository) CreateWithOwner(ctx context.Context, giftItem model
 		giftItem.Priority,
 		giftItem.Notes,
 		giftItem.Position,
+		giftItem.WantedQuantity(),
+		giftItem.Level(),
+		giftItem.Options,
+		giftItem.Category,
+		giftItem.NotesAudience(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gift item: %w", err)
 	}
 
+	if err := r.decryptGiftItemPII(ctx, &created); err != nil {
+		return nil, err
+	}
+
 	return &created, nil
 }
 
@@ -169,6 +241,10 @@ func (r *GiftItemRepository) GetByID(ctx context.Context, id pgtype.UUID) (*mode
 		return nil, fmt.Errorf("failed to get gift item: %w", err)
 	}
 
+	if err := r.decryptGiftItemPII(ctx, &giftItem); err != nil {
+		return nil, err
+	}
+
 	return &giftItem, nil
 }
 
@@ -246,6 +322,10 @@ func (r *GiftItemRepository) GetByOwnerPaginated(ctx context.Context, ownerID pg
 		return nil, fmt.Errorf("failed to get items: %w", err)
 	}
 
+	if err := r.decryptGiftItemsPII(ctx, items); err != nil {
+		return nil, err
+	}
+
 	// Fetch wishlist IDs for all items in a single batch query (no N+1)
 	wishlistIDsMap := make(map[string][]string, len(items))
 	if len(items) > 0 {
@@ -308,10 +388,15 @@ func (r *GiftItemRepository) GetByWishList(ctx context.Context, wishlistID pgtyp
 		return nil, fmt.Errorf("failed to get gift items by wishlist: %w", err)
 	}
 
+	if err := r.decryptGiftItemsPII(ctx, giftItems); err != nil {
+		return nil, err
+	}
+
 	return giftItems, nil
 }
 
-// GetPublicWishListGiftItems retrieves gift items for a public wishlist by slug
+// GetPublicWishListGiftItems retrieves gift items for a public wishlist by slug.
+// Reads go to the read replica when one is attached.
 func (r *GiftItemRepository) GetPublicWishListGiftItems(ctx context.Context, publicSlug string) ([]*models.GiftItem, error) {
 	query := fmt.Sprintf(`
 		SELECT %s
@@ -333,15 +418,20 @@ func (r *GiftItemRepository) GetPublicWishListGiftItems(ctx context.Context, pub
 	`, giftItemColumnsPublicAliased)
 
 	var giftItems []*models.GiftItem
-	err := r.db.SelectContext(ctx, &giftItems, query, publicSlug)
+	err := r.db.Reader().SelectContext(ctx, &giftItems, query, publicSlug)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public wishlist gift items: %w", err)
 	}
 
+	if err := r.decryptGiftItemsPII(ctx, giftItems); err != nil {
+		return nil, err
+	}
+
 	return giftItems, nil
 }
 
-// GetPublicWishListGiftItemsPaginated retrieves paginated gift items for a public wishlist by slug
+// GetPublicWishListGiftItemsPaginated retrieves paginated gift items for a public wishlist by slug.
+// Reads go to the read replica when one is attached.
 // Returns the items, total count, and any error
 func (r *GiftItemRepository) GetPublicWishListGiftItemsPaginated(ctx context.Context, publicSlug string, limit, offset int) ([]*models.GiftItem, int, error) {
 	// Get total count
@@ -354,7 +444,7 @@ func (r *GiftItemRepository) GetPublicWishListGiftItemsPaginated(ctx context.Con
 		  AND gi.archived_at IS NULL
 	`
 	var totalCount int
-	if err := r.db.GetContext(ctx, &totalCount, countQuery, publicSlug); err != nil {
+	if err := r.db.Reader().GetContext(ctx, &totalCount, countQuery, publicSlug); err != nil {
 		return nil, 0, fmt.Errorf("failed to count public wishlist gift items: %w", err)
 	}
 
@@ -379,13 +469,68 @@ func (r *GiftItemRepository) GetPublicWishListGiftItemsPaginated(ctx context.Con
 	`, giftItemColumnsPublicAliased)
 
 	var giftItems []*models.GiftItem
-	if err := r.db.SelectContext(ctx, &giftItems, query, publicSlug, limit, offset); err != nil {
+	if err := r.db.Reader().SelectContext(ctx, &giftItems, query, publicSlug, limit, offset); err != nil {
 		return nil, 0, fmt.Errorf("failed to get public wishlist gift items: %w", err)
 	}
 
+	if err := r.decryptGiftItemsPII(ctx, giftItems); err != nil {
+		return nil, 0, err
+	}
+
 	return giftItems, totalCount, nil
 }
 
+// GetPublicWishListGiftItemGroups tallies the items of a public wishlist per
+// priority level or category. An item counts as reserved once nothing is left
+// to reserve, matching models.GiftItem.ReservationState; guest reservations
+// only recorded in the reservations table are taken into account.
+// Reads go to the read replica when one is attached.
+func (r *GiftItemRepository) GetPublicWishListGiftItemGroups(ctx context.Context, publicSlug, groupBy string) ([]*models.GiftItemGroup, error) {
+	group, ok := validGroupFields[groupBy]
+	if !ok {
+		return nil, ErrInvalidGroupField
+	}
+
+	query := fmt.Sprintf(`
+		WITH items AS (
+			SELECT %s AS group_key, gi.priority_weight,
+				(gi.manual_reserved_by_name IS NOT NULL
+					OR gi.encrypted_manual_reserved_by_name IS NOT NULL
+					OR gi.manual_reserved_at IS NOT NULL
+					OR gi.reserved_by_user_id IS NOT NULL
+					OR gi.reserved_at IS NOT NULL
+					OR ar.gift_item_id IS NOT NULL
+					OR gi.reserved_quantity >= GREATEST(gi.quantity, 1)) AS is_reserved
+			FROM gift_items gi
+			INNER JOIN wishlist_items wi ON wi.gift_item_id = gi.id
+			INNER JOIN wishlists w ON wi.wishlist_id = w.id
+			LEFT JOIN LATERAL (
+				SELECT r.gift_item_id
+				FROM reservations r
+				WHERE r.gift_item_id = gi.id
+				  AND r.status = 'active'
+				LIMIT 1
+			) ar ON true
+			WHERE w.public_slug = $1 AND w.is_public = true
+			  AND gi.archived_at IS NULL
+		)
+		SELECT group_key,
+			COUNT(*) AS item_count,
+			COUNT(*) FILTER (WHERE is_reserved) AS reserved_count,
+			COUNT(*) FILTER (WHERE NOT is_reserved) AS available_count
+		FROM items gi
+		GROUP BY group_key
+		ORDER BY %s
+	`, group.key, group.order)
+
+	var groups []*models.GiftItemGroup
+	if err := r.db.Reader().SelectContext(ctx, &groups, query, publicSlug); err != nil {
+		return nil, fmt.Errorf("failed to group public wishlist gift items: %w", err)
+	}
+
+	return groups, nil
+}
+
 // GetUnattached retrieves items not attached to any wishlist
 func (r *GiftItemRepository) GetUnattached(ctx context.Context, ownerID pgtype.UUID) ([]*models.GiftItem, error) {
 	query := fmt.Sprintf(`
@@ -405,10 +550,15 @@ func (r *GiftItemRepository) GetUnattached(ctx context.Context, ownerID pgtype.U
 		return nil, fmt.Errorf("failed to get unattached items: %w", err)
 	}
 
+	if err := r.decryptGiftItemsPII(ctx, items); err != nil {
+		return nil, err
+	}
+
 	return items, nil
 }
 
-// Update modifies an existing gift item (basic fields only)
+// Update modifies an existing gift item (basic fields only).
+// It fails with ErrGiftItemVersionConflict when giftItem.Version is stale.
 func (r *GiftItemRepository) Update(ctx context.Context, giftItem models.GiftItem) (*models.GiftItem, error) {
 	query := fmt.Sprintf(`
 		UPDATE gift_items SET
@@ -420,8 +570,16 @@ func (r *GiftItemRepository) Update(ctx context.Context, giftItem models.GiftIte
 			priority = $7,
 			notes = $8,
 			position = $9,
+			quantity = $11,
+			priority_level = $12,
+			options = $13,
+			category = $14,
+			notes_visibility = $15,
+			availability_status = CASE WHEN link IS DISTINCT FROM $4 THEN 'unknown' ELSE availability_status END,
+			availability_checked_at = CASE WHEN link IS DISTINCT FROM $4 THEN NULL ELSE availability_checked_at END,
+			version = version + 1,
 			updated_at = NOW()
-		WHERE id = $1 AND archived_at IS NULL
+		WHERE id = $1 AND archived_at IS NULL AND version = $10
 		RETURNING %s
 	`, giftItemColumns)
 
@@ -436,19 +594,31 @@ func (r *GiftItemRepository) Update(ctx context.Context, giftItem models.GiftIte
 		giftItem.Priority,
 		database.TextToString(giftItem.Notes),
 		giftItem.Position,
+		giftItem.Version,
+		giftItem.WantedQuantity(),
+		giftItem.Level(),
+		giftItem.Options,
+		giftItem.Category,
+		giftItem.NotesAudience(),
 	).StructScan(&updatedGiftItem)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrGiftItemNotFound
+			return nil, r.versionConflictOrNotFound(ctx, giftItem.ID)
 		}
 		return nil, fmt.Errorf("failed to update gift item: %w", err)
 	}
 
+	if err := r.decryptGiftItemPII(ctx, &updatedGiftItem); err != nil {
+		return nil, err
+	}
+
 	return &updatedGiftItem, nil
 }
 
-// UpdateWithNewSchema updates an item including reservation/purchase fields
+// UpdateWithNewSchema updates an item including reservation/purchase fields.
+// It fails with ErrGiftItemVersionConflict when giftItem.Version is stale, so a
+// concurrent reservation or edit is never overwritten by an older copy of the row.
 func (r *GiftItemRepository) UpdateWithNewSchema(ctx context.Context, giftItem *models.GiftItem) (*models.GiftItem, error) {
 	query := fmt.Sprintf(`
 		UPDATE gift_items
@@ -466,8 +636,16 @@ func (r *GiftItemRepository) UpdateWithNewSchema(ctx context.Context, giftItem *
 			purchased_by_user_id = $12,
 			purchased_at = $13,
 			purchased_price = $14,
-			updated_at = $15
-		WHERE id = $1 AND archived_at IS NULL
+			quantity = $17,
+			priority_level = $18,
+			options = $19,
+			category = $20,
+			notes_visibility = $21,
+			availability_status = CASE WHEN link IS DISTINCT FROM $4 THEN 'unknown' ELSE availability_status END,
+			availability_checked_at = CASE WHEN link IS DISTINCT FROM $4 THEN NULL ELSE availability_checked_at END,
+			updated_at = $15,
+			version = version + 1
+		WHERE id = $1 AND archived_at IS NULL AND version = $16
 		RETURNING %s
 	`, giftItemColumns)
 
@@ -491,11 +669,24 @@ func (r *GiftItemRepository) UpdateWithNewSchema(ctx context.Context, giftItem *
 		giftItem.PurchasedAt,
 		giftItem.PurchasedPrice,
 		time.Now(),
+		giftItem.Version,
+		giftItem.WantedQuantity(),
+		giftItem.Level(),
+		giftItem.Options,
+		giftItem.Category,
+		giftItem.NotesAudience(),
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, r.versionConflictOrNotFound(ctx, giftItem.ID)
+		}
 		return nil, fmt.Errorf("failed to update gift item: %w", err)
 	}
 
+	if err := r.decryptGiftItemPII(ctx, &updated); err != nil {
+		return nil, err
+	}
+
 	return &updated, nil
 }
 
@@ -505,8 +696,10 @@ func (r *GiftItemRepository) MarkManualReservation(ctx context.Context, itemID p
 	query := fmt.Sprintf(`
 		UPDATE gift_items gi
 		SET manual_reserved_by_name = $2,
+		    encrypted_manual_reserved_by_name = $4,
 		    manual_reservation_note = $3,
 		    manual_reserved_at = NOW(),
+		    version = gi.version + 1,
 		    updated_at = NOW()
 		WHERE gi.id = $1
 		  AND gi.archived_at IS NULL
@@ -515,6 +708,7 @@ func (r *GiftItemRepository) MarkManualReservation(ctx context.Context, itemID p
 		  AND gi.reserved_by_user_id IS NULL
 		  AND gi.reserved_at IS NULL
 		  AND gi.manual_reserved_by_name IS NULL
+		  AND gi.encrypted_manual_reserved_by_name IS NULL
 		  AND gi.manual_reserved_at IS NULL
 		  AND NOT EXISTS (
 			SELECT 1
@@ -530,8 +724,18 @@ func (r *GiftItemRepository) MarkManualReservation(ctx context.Context, itemID p
 		noteVal = *note
 	}
 
+	// Avoid persisting the plaintext name when encryption is enabled
+	var nameVal, encryptedNameVal any = reservedByName, nil
+	if r.encryptionEnabled && r.encryptionSvc != nil {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, reservedByName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt manual reserved by name: %w", err)
+		}
+		nameVal, encryptedNameVal = nil, encrypted
+	}
+
 	var updated models.GiftItem
-	err := r.db.GetContext(ctx, &updated, query, itemID, reservedByName, noteVal)
+	err := r.db.GetContext(ctx, &updated, query, itemID, nameVal, noteVal, encryptedNameVal)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			existsQuery := `SELECT EXISTS(SELECT 1 FROM gift_items WHERE id = $1 AND archived_at IS NULL)`
@@ -547,9 +751,26 @@ func (r *GiftItemRepository) MarkManualReservation(ctx context.Context, itemID p
 		return nil, fmt.Errorf("failed to mark manual reservation: %w", err)
 	}
 
+	if err := r.decryptGiftItemPII(ctx, &updated); err != nil {
+		return nil, err
+	}
+
 	return &updated, nil
 }
 
+// versionConflictOrNotFound classifies a conditional update that matched no rows
+func (r *GiftItemRepository) versionConflictOrNotFound(ctx context.Context, id pgtype.UUID) error {
+	existsQuery := `SELECT EXISTS(SELECT 1 FROM gift_items WHERE id = $1 AND archived_at IS NULL)`
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, existsQuery, id); err != nil {
+		return fmt.Errorf("failed to verify gift item existence: %w", err)
+	}
+	if !exists {
+		return ErrGiftItemNotFound
+	}
+	return ErrGiftItemVersionConflict
+}
+
 // Delete removes a gift item by ID
 func (r *GiftItemRepository) Delete(ctx context.Context, id pgtype.UUID) error {
 	return r.DeleteWithExecutor(ctx, r.db, id)
@@ -601,3 +822,92 @@ func (r *GiftItemRepository) SoftDelete(ctx context.Context, id pgtype.UUID) err
 
 	return nil
 }
+
+// PurgeArchivedBefore permanently deletes items archived before the given time.
+// Wishlist links and reservations of purged items are removed by ON DELETE CASCADE.
+// Purchased items are kept because they back the recipient's gift history.
+func (r *GiftItemRepository) PurgeArchivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM gift_items WHERE archived_at IS NOT NULL AND archived_at < $1 AND purchased_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge archived items: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// ListDueForAvailabilityCheck returns linked items that are neither archived nor purchased and
+// were never checked or last checked before checkedBefore, least recently checked first
+func (r *GiftItemRepository) ListDueForAvailabilityCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.GiftItem, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM gift_items
+		WHERE link IS NOT NULL AND link != ''
+		  AND archived_at IS NULL
+		  AND purchased_at IS NULL
+		  AND (availability_checked_at IS NULL OR availability_checked_at < $1)
+		ORDER BY availability_checked_at ASC NULLS FIRST
+		LIMIT $2
+	`, giftItemColumns)
+
+	var giftItems []*models.GiftItem
+	if err := r.db.SelectContext(ctx, &giftItems, query, checkedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list gift items due for availability check: %w", err)
+	}
+
+	if err := r.decryptGiftItemsPII(ctx, giftItems); err != nil {
+		return nil, err
+	}
+
+	return giftItems, nil
+}
+
+// UpdateAvailability records the result of a product page check. It does not bump the
+// version, so a background check never conflicts with an owner editing the item.
+func (r *GiftItemRepository) UpdateAvailability(ctx context.Context, id pgtype.UUID, status string) error {
+	query := `
+		UPDATE gift_items
+		SET availability_status = $2, availability_checked_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status)
+	if err != nil {
+		return fmt.Errorf("failed to update gift item availability: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrGiftItemNotFound
+	}
+
+	return nil
+}
+
+// GetReservedVariants returns the variants picked by the item's active or
+// fulfilled reservations, oldest first. Reservations without a variant are skipped.
+func (r *GiftItemRepository) GetReservedVariants(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error) {
+	query := `
+		SELECT variant
+		FROM reservations
+		WHERE gift_item_id = $1 AND variant IS NOT NULL AND status IN ('active', 'fulfilled')
+		ORDER BY reserved_at
+	`
+
+	var variants []variant.Choice
+	if err := r.db.SelectContext(ctx, &variants, query, id); err != nil {
+		return nil, fmt.Errorf("failed to get reserved variants: %w", err)
+	}
+
+	return variants, nil
+}
@@ -27,6 +27,19 @@ type GiftItemReservationRepositoryInterface interface {
 	ReserveIfNotReserved(ctx context.Context, giftItemID, userID pgtype.UUID) (*models.GiftItem, error)
 	// DeleteWithReservationNotification deletes a gift item and returns active reservations
 	DeleteWithReservationNotification(ctx context.Context, giftItemID pgtype.UUID) ([]*reservationmodels.Reservation, error)
+	// MarkReceived marks a gift item the owner got elsewhere as purchased and cancels its active reservations
+	MarkReceived(ctx context.Context, giftItemID, ownerID pgtype.UUID) (*models.GiftItem, []*ReleasedReservation, error)
+}
+
+// ErrGiftItemAlreadyPurchased is returned when marking an item received that is
+// already marked as purchased
+var ErrGiftItemAlreadyPurchased = errors.New("gift item is already purchased")
+
+// ReleasedReservation is a reservation canceled because the item is no longer
+// needed, with the title of the wishlist it was made on
+type ReleasedReservation struct {
+	reservationmodels.Reservation
+	WishlistTitle pgtype.Text `db:"wishlist_title"`
 }
 
 // GiftItemReservationRepository handles reservation-related database operations
@@ -44,7 +57,8 @@ func NewGiftItemReservationRepository(db *database.DB) GiftItemReservationReposi
 // giftItemColumns is the standard column list for gift_items queries
 const giftItemColumnsReservation = `id, owner_id, name, description, link, image_url, price, priority,
 	reserved_by_user_id, reserved_at, purchased_by_user_id, purchased_at,
-	purchased_price, notes, position, archived_at, created_at, updated_at`
+	purchased_price, notes, position, archived_at, created_at, updated_at, version,
+	availability_status, availability_checked_at, quantity, reserved_quantity, priority_level, options`
 
 // Reserve marks a gift item as reserved by a user
 func (r *GiftItemReservationRepository) Reserve(ctx context.Context, giftItemID, userID pgtype.UUID) (*models.GiftItem, error) {
@@ -52,6 +66,7 @@ func (r *GiftItemReservationRepository) Reserve(ctx context.Context, giftItemID,
 		UPDATE gift_items SET
 			reserved_by_user_id = $2,
 			reserved_at = $3,
+			version = version + 1,
 			updated_at = NOW()
 		WHERE id = $1
 		RETURNING %s
@@ -81,6 +96,7 @@ func (r *GiftItemReservationRepository) Unreserve(ctx context.Context, giftItemI
 		UPDATE gift_items SET
 			reserved_by_user_id = NULL,
 			reserved_at = NULL,
+			version = version + 1,
 			updated_at = NOW()
 		WHERE id = $1
 		RETURNING %s
@@ -107,7 +123,7 @@ func (r *GiftItemReservationRepository) ReserveIfNotReserved(ctx context.Context
 	}
 	defer func() {
 		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
-			logger.Warn("transaction rollback error", "error", rbErr)
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
 		}
 	}()
 
@@ -136,6 +152,7 @@ func (r *GiftItemReservationRepository) ReserveIfNotReserved(ctx context.Context
 		UPDATE gift_items SET
 			reserved_by_user_id = $2,
 			reserved_at = $3,
+			version = version + 1,
 			updated_at = NOW()
 		WHERE id = $1 AND reserved_by_user_id IS NULL
 		RETURNING %s
@@ -170,7 +187,7 @@ func (r *GiftItemReservationRepository) DeleteWithReservationNotification(ctx co
 	}
 	defer func() {
 		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
-			logger.Warn("transaction rollback error", "error", rbErr)
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
 		}
 	}()
 
@@ -209,3 +226,65 @@ func (r *GiftItemReservationRepository) DeleteWithReservationNotification(ctx co
 
 	return activeReservations, nil
 }
+
+// MarkReceived marks a gift item as purchased by its owner, without a price, and
+// cancels its active reservations in the same transaction. It returns the item
+// and the canceled reservations so their holders can be told. Guest PII is
+// returned as stored, encrypted or not.
+func (r *GiftItemReservationRepository) MarkReceived(ctx context.Context, giftItemID, ownerID pgtype.UUID) (*models.GiftItem, []*ReleasedReservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	markQuery := fmt.Sprintf(`
+		UPDATE gift_items SET
+			purchased_by_user_id = $2,
+			purchased_at = NOW(),
+			purchased_price = NULL,
+			reserved_by_user_id = NULL,
+			reserved_at = NULL,
+			reserved_quantity = 0,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1 AND owner_id = $2 AND purchased_by_user_id IS NULL
+		RETURNING %s
+	`, giftItemColumnsReservation)
+
+	var item models.GiftItem
+	if err := tx.QueryRowxContext(ctx, markQuery, giftItemID, ownerID).StructScan(&item); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrGiftItemAlreadyPurchased
+		}
+		return nil, nil, fmt.Errorf("failed to mark gift item as received: %w", err)
+	}
+
+	releaseQuery := `
+		UPDATE reservations SET
+			status = 'canceled',
+			canceled_at = NOW(),
+			cancel_reason = 'Owner received the item',
+			updated_at = NOW()
+		WHERE gift_item_id = $1 AND status = 'active'
+		RETURNING id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
+			guest_email, encrypted_guest_email, reservation_token, status, quantity,
+			reserved_at, expires_at, canceled_at, cancel_reason, notification_sent, updated_at,
+			(SELECT w.title FROM wishlists w WHERE w.id = reservations.wishlist_id) AS wishlist_title
+	`
+
+	var released []*ReleasedReservation
+	if err := tx.SelectContext(ctx, &released, releaseQuery, giftItemID); err != nil {
+		return nil, nil, fmt.Errorf("failed to release reservations: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &item, released, nil
+}
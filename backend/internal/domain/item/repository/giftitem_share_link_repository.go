@@ -0,0 +1,173 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_giftitem_share_link_repository_test.go -pkg service . GiftItemShareLinkRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/models"
+)
+
+// Sentinel errors for gift item share link repository
+var (
+	ErrGiftItemShareLinkNotFound     = errors.New("gift item share link not found")
+	ErrGiftItemShareLinkLimitReached = errors.New("gift item has the maximum number of share links")
+)
+
+// GiftItemShareLinkRepositoryInterface defines operations on the links sharing a single item
+type GiftItemShareLinkRepositoryInterface interface {
+	// ListByItem returns the item's share links, oldest first
+	ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemShareLink, error)
+	// Add creates a share link unless the item already has limit links
+	Add(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error)
+	// Remove deletes a share link of the item, revoking it
+	Remove(ctx context.Context, itemID, linkID pgtype.UUID) error
+	// GetSharedItem returns the item a share link points to, with guest
+	// reservations taken into account as on public wishlists
+	GetSharedItem(ctx context.Context, linkID pgtype.UUID) (*SharedGiftItem, error)
+}
+
+// SharedGiftItem is the item behind a share link, with what keeps it from being
+// shown publicly
+type SharedGiftItem struct {
+	models.GiftItem
+	TakenDown     bool `db:"taken_down"`     // On a wishlist an admin took down
+	OwnerInactive bool `db:"owner_inactive"` // The owner is deactivated or deleting their account
+}
+
+// GiftItemShareLinkRepository handles share link database operations
+type GiftItemShareLinkRepository struct {
+	db *database.DB
+}
+
+// NewGiftItemShareLinkRepository creates a new GiftItemShareLinkRepository
+func NewGiftItemShareLinkRepository(db *database.DB) GiftItemShareLinkRepositoryInterface {
+	return &GiftItemShareLinkRepository{
+		db: db,
+	}
+}
+
+const giftItemShareLinkColumns = `id, gift_item_id, created_at`
+
+// ListByItem returns the item's share links, oldest first
+func (r *GiftItemShareLinkRepository) ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemShareLink, error) {
+	var links []*models.GiftItemShareLink
+	err := r.db.SelectContext(ctx, &links,
+		`SELECT `+giftItemShareLinkColumns+`
+		 FROM gift_item_share_links
+		 WHERE gift_item_id = $1
+		 ORDER BY created_at, id`,
+		itemID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift item share links: %w", err)
+	}
+
+	return links, nil
+}
+
+// Add creates a share link. The item row is locked while counting so that
+// concurrent requests cannot exceed limit.
+func (r *GiftItemShareLinkRepository) Add(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackImageTx(ctx, tx)
+
+	if _, err := lockGalleryItem(ctx, tx, itemID); err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count,
+		`SELECT COUNT(*) FROM gift_item_share_links WHERE gift_item_id = $1`, itemID,
+	); err != nil {
+		return nil, fmt.Errorf("failed to count gift item share links: %w", err)
+	}
+	if count >= limit {
+		return nil, ErrGiftItemShareLinkLimitReached
+	}
+
+	var created models.GiftItemShareLink
+	err = tx.QueryRowxContext(ctx,
+		`INSERT INTO gift_item_share_links (gift_item_id)
+		 VALUES ($1)
+		 RETURNING `+giftItemShareLinkColumns,
+		itemID,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add gift item share link: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit gift item share link: %w", err)
+	}
+
+	return &created, nil
+}
+
+// Remove deletes a share link of the item
+func (r *GiftItemShareLinkRepository) Remove(ctx context.Context, itemID, linkID pgtype.UUID) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM gift_item_share_links WHERE id = $1 AND gift_item_id = $2`,
+		linkID, itemID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove gift item share link: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrGiftItemShareLinkNotFound
+	}
+
+	return nil
+}
+
+// GetSharedItem returns the item a share link points to. Archived items are not
+// shared. Reads go to the read replica when one is attached.
+func (r *GiftItemShareLinkRepository) GetSharedItem(ctx context.Context, linkID pgtype.UUID) (*SharedGiftItem, error) {
+	query := fmt.Sprintf(`
+		SELECT %s,
+			EXISTS (
+				SELECT 1
+				FROM wishlist_items wi
+				INNER JOIN wishlist_takedowns td ON td.wishlist_id = wi.wishlist_id
+				WHERE wi.gift_item_id = gi.id
+			) AS taken_down,
+			(u.deactivated_at IS NOT NULL OR u.deletion_requested_at IS NOT NULL) AS owner_inactive
+		FROM gift_item_share_links l
+		INNER JOIN gift_items gi ON gi.id = l.gift_item_id
+		INNER JOIN users u ON u.id = gi.owner_id
+		LEFT JOIN LATERAL (
+			SELECT r.reserved_by_user_id, r.reserved_at
+			FROM reservations r
+			WHERE r.gift_item_id = gi.id
+			  AND r.status = 'active'
+			ORDER BY r.reserved_at DESC
+			LIMIT 1
+		) ar ON true
+		WHERE l.id = $1
+		  AND gi.archived_at IS NULL
+	`, giftItemColumnsPublicAliased)
+
+	var item SharedGiftItem
+	if err := r.db.Reader().GetContext(ctx, &item, query, linkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGiftItemShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get shared gift item: %w", err)
+	}
+
+	return &item, nil
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// MaxItemAttachments is how many files can be attached to an item
+	MaxItemAttachments = 5
+	// MaxAttachmentSize is the largest file that can be attached, in bytes
+	MaxAttachmentSize = 10 * 1024 * 1024
+	// attachmentURLTTL is the lifetime of the download links handed out
+	attachmentURLTTL = 15 * time.Minute
+)
+
+// Sentinel errors for item attachments
+var (
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentLimitReached = errors.New("item has the maximum number of attachments")
+	ErrAttachmentTooLarge     = errors.New("attachment is too large")
+	ErrAttachmentType         = errors.New("attachments must be PDF, JPEG, PNG or WebP files")
+)
+
+// attachmentExtensions lists the file extensions accepted for each content type.
+// The content type is detected from the file itself, not taken from the client.
+var attachmentExtensions = map[string][]string{
+	"application/pdf": {".pdf"},
+	"image/jpeg":      {".jpg", ".jpeg"},
+	"image/png":       {".png"},
+	"image/webp":      {".webp"},
+}
+
+// AttachmentStorageInterface stores attachment files and signs their download links (cross-domain)
+type AttachmentStorageInterface interface {
+	UploadObject(ctx context.Context, key string, body io.Reader, contentType string) error
+	DeleteFile(ctx context.Context, fileKey string) error
+	GeneratePresignedURL(ctx context.Context, fileKey string, duration time.Duration) (string, error)
+}
+
+// PublicWishListRepositoryInterface defines what the attachment service needs to
+// resolve the public wishlists guests browse (cross-domain)
+type PublicWishListRepositoryInterface interface {
+	GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+}
+
+// AttachmentServiceInterface defines the interface for item attachment operations
+type AttachmentServiceInterface interface {
+	ListAttachments(ctx context.Context, item *models.GiftItem) ([]*AttachmentOutput, error)
+	UploadAttachment(ctx context.Context, item *models.GiftItem, upload AttachmentUpload) (*AttachmentOutput, error)
+	DeleteAttachment(ctx context.Context, item *models.GiftItem, attachmentID string) error
+	ListPublicAttachments(ctx context.Context, publicSlug, itemID string) ([]*AttachmentOutput, error)
+}
+
+// AttachmentService manages the files owners attach to their items for givers,
+// such as size charts or specification PDFs
+type AttachmentService struct {
+	repo             repository.GiftItemAttachmentRepositoryInterface
+	itemRepo         repository.GiftItemRepositoryInterface
+	wishlistItemRepo WishlistItemRepositoryInterface
+	wishLists        PublicWishListRepositoryInterface
+	storage          AttachmentStorageInterface
+}
+
+// NewAttachmentService creates a new AttachmentService
+func NewAttachmentService(
+	repo repository.GiftItemAttachmentRepositoryInterface,
+	itemRepo repository.GiftItemRepositoryInterface,
+	wishlistItemRepo WishlistItemRepositoryInterface,
+	wishLists PublicWishListRepositoryInterface,
+	storage AttachmentStorageInterface,
+) *AttachmentService {
+	return &AttachmentService{
+		repo:             repo,
+		itemRepo:         itemRepo,
+		wishlistItemRepo: wishlistItemRepo,
+		wishLists:        wishLists,
+		storage:          storage,
+	}
+}
+
+// AttachmentUpload is a file being attached to an item
+type AttachmentUpload struct {
+	FileName string
+	Size     int64
+	Body     io.Reader
+}
+
+// AttachmentOutput is an item attachment with a link to download it
+type AttachmentOutput struct {
+	ID                   string
+	GiftItemID           string
+	FileName             string
+	ContentType          string
+	SizeBytes            int64
+	CreatedAt            string
+	DownloadURL          string // Empty if the link could not be signed
+	DownloadURLExpiresAt time.Time
+}
+
+// ListAttachments returns the files attached to the owner's item
+func (s *AttachmentService) ListAttachments(ctx context.Context, item *models.GiftItem) ([]*AttachmentOutput, error) {
+	return s.listAttachments(ctx, item.ID)
+}
+
+// UploadAttachment validates the file's size and type and stores it for the item.
+// The type is detected from the content and must match the file extension.
+func (s *AttachmentService) UploadAttachment(ctx context.Context, item *models.GiftItem, upload AttachmentUpload) (*AttachmentOutput, error) {
+	if upload.Size > MaxAttachmentSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	fileName := filepath.Base(strings.TrimSpace(upload.FileName))
+	head := make([]byte, 512)
+	n, err := io.ReadFull(upload.Body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	extensions, ok := attachmentExtensions[contentType]
+	if n == 0 || !ok || !slices.Contains(extensions, strings.ToLower(filepath.Ext(fileName))) {
+		return nil, ErrAttachmentType
+	}
+
+	// Fail before uploading when the item is full; Add checks again under a lock
+	existing, err := s.repo.ListByItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item attachments: %w", err)
+	}
+	if len(existing) >= MaxItemAttachments {
+		return nil, ErrAttachmentLimitReached
+	}
+
+	key := fmt.Sprintf("attachments/%s/%d/%s", item.ID.String(), time.Now().UnixNano(), strings.ReplaceAll(fileName, " ", "_"))
+	body := io.MultiReader(bytes.NewReader(head), upload.Body)
+	if err := s.storage.UploadObject(ctx, key, body, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	attachment, err := s.repo.Add(ctx, models.GiftItemAttachment{
+		GiftItemID:  item.ID,
+		FileName:    fileName,
+		ContentType: contentType,
+		SizeBytes:   upload.Size,
+		ObjectKey:   key,
+	}, MaxItemAttachments)
+	if err != nil {
+		s.deleteFile(ctx, key)
+		return nil, mapAttachmentRepositoryError(err)
+	}
+
+	logger.InfoContext(ctx, "item attachment uploaded", "gift_item_id", item.ID.String(), "attachment_id", attachment.ID.String(), "content_type", contentType)
+
+	return s.toAttachmentOutput(ctx, attachment), nil
+}
+
+// DeleteAttachment removes a file from the owner's item
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, item *models.GiftItem, attachmentID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(attachmentID); err != nil {
+		return ErrAttachmentNotFound
+	}
+
+	attachment, err := s.repo.Remove(ctx, item.ID, id)
+	if err != nil {
+		return mapAttachmentRepositoryError(err)
+	}
+
+	s.deleteFile(ctx, attachment.ObjectKey)
+
+	return nil
+}
+
+// ListPublicAttachments returns the files attached to an item of a public
+// wishlist, for givers to read before buying it
+func (s *AttachmentService) ListPublicAttachments(ctx context.Context, publicSlug, itemID string) ([]*AttachmentOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(itemID); err != nil {
+		return nil, ErrItemNotFound
+	}
+
+	wishList, err := s.wishLists.GetByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	attached, err := s.wishlistItemRepo.IsAttached(ctx, wishList.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check item attachment: %w", err)
+	}
+	if !attached {
+		return nil, ErrItemNotFound
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrGiftItemNotFound) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get gift item: %w", err)
+	}
+	if item.ArchivedAt.Valid {
+		return nil, ErrItemNotFound
+	}
+
+	return s.listAttachments(ctx, item.ID)
+}
+
+func (s *AttachmentService) listAttachments(ctx context.Context, itemID pgtype.UUID) ([]*AttachmentOutput, error) {
+	attachments, err := s.repo.ListByItem(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item attachments: %w", err)
+	}
+
+	outputs := make([]*AttachmentOutput, len(attachments))
+	for i, attachment := range attachments {
+		outputs[i] = s.toAttachmentOutput(ctx, attachment)
+	}
+
+	return outputs, nil
+}
+
+// deleteFile removes a stored file nothing refers to any more. A failure only
+// leaves an orphaned object behind, so it is logged.
+func (s *AttachmentService) deleteFile(ctx context.Context, key string) {
+	if err := s.storage.DeleteFile(ctx, key); err != nil {
+		logger.WarnContext(ctx, "failed to delete item attachment file", "object_key", key, "error", err)
+	}
+}
+
+func (s *AttachmentService) toAttachmentOutput(ctx context.Context, attachment *models.GiftItemAttachment) *AttachmentOutput {
+	output := &AttachmentOutput{
+		ID:          attachment.ID.String(),
+		GiftItemID:  attachment.GiftItemID.String(),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		CreatedAt:   attachment.CreatedAt.Time.Format(time.RFC3339),
+	}
+
+	url, err := s.storage.GeneratePresignedURL(ctx, attachment.ObjectKey, attachmentURLTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to sign item attachment download link", "attachment_id", output.ID, "error", err)
+		return output
+	}
+	output.DownloadURL = url
+	output.DownloadURLExpiresAt = time.Now().Add(attachmentURLTTL)
+
+	return output
+}
+
+func mapAttachmentRepositoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrGiftItemNotFound):
+		return ErrItemNotFound
+	case errors.Is(err, repository.ErrGiftItemAttachmentNotFound):
+		return ErrAttachmentNotFound
+	case errors.Is(err, repository.ErrGiftItemAttachmentLimitReached):
+		return ErrAttachmentLimitReached
+	default:
+		return fmt.Errorf("failed to update item attachments: %w", err)
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const attachmentItemID = "00000000-0000-0000-0000-0000000000a1"
+
+// samplePDF is enough of a PDF for content type detection
+const samplePDF = "%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\n"
+
+func newAttachmentTestItem(t *testing.T) *models.GiftItem {
+	t.Helper()
+
+	item := &models.GiftItem{Name: "Running shoes"}
+	require.NoError(t, item.ID.Scan(attachmentItemID))
+	return item
+}
+
+func newAttachmentStorage() *AttachmentStorageInterfaceMock {
+	return &AttachmentStorageInterfaceMock{
+		UploadObjectFunc: func(ctx context.Context, key string, body io.Reader, contentType string) error {
+			_, err := io.Copy(io.Discard, body)
+			return err
+		},
+		DeleteFileFunc: func(ctx context.Context, fileKey string) error {
+			return nil
+		},
+		GeneratePresignedURLFunc: func(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+			return "https://bucket.s3.amazonaws.com/" + fileKey + "?X-Amz-Signature=abc", nil
+		},
+	}
+}
+
+func TestAttachmentService_UploadAttachment(t *testing.T) {
+	item := newAttachmentTestItem(t)
+
+	t.Run("stores a PDF under the item", func(t *testing.T) {
+		var stored string
+		storage := newAttachmentStorage()
+		storage.UploadObjectFunc = func(ctx context.Context, key string, body io.Reader, contentType string) error {
+			data, err := io.ReadAll(body)
+			stored = string(data)
+			assert.Equal(t, "application/pdf", contentType)
+			assert.True(t, strings.HasPrefix(key, "attachments/"+attachmentItemID+"/"))
+			assert.True(t, strings.HasSuffix(key, "/size_chart.pdf"))
+			return err
+		}
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+				return nil, nil
+			},
+			AddFunc: func(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error) {
+				assert.Equal(t, MaxItemAttachments, limit)
+				return &attachment, nil
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		out, err := svc.UploadAttachment(context.Background(), item, AttachmentUpload{
+			FileName: "size chart.pdf",
+			Size:     int64(len(samplePDF)),
+			Body:     strings.NewReader(samplePDF),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, samplePDF, stored, "the bytes read for type detection are uploaded too")
+		assert.Equal(t, "size chart.pdf", out.FileName)
+		assert.Equal(t, "application/pdf", out.ContentType)
+		assert.Contains(t, out.DownloadURL, "X-Amz-Signature")
+	})
+
+	t.Run("content must match an allowed type and the extension", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		svc := NewAttachmentService(&GiftItemAttachmentRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		for name, upload := range map[string]AttachmentUpload{
+			"script renamed to pdf": {FileName: "spec.pdf", Size: 20, Body: strings.NewReader("#!/bin/sh\necho hi\n")},
+			"pdf renamed to png":    {FileName: "spec.png", Size: int64(len(samplePDF)), Body: strings.NewReader(samplePDF)},
+			"empty file":            {FileName: "spec.pdf", Size: 0, Body: strings.NewReader("")},
+		} {
+			_, err := svc.UploadAttachment(context.Background(), item, upload)
+			assert.ErrorIs(t, err, ErrAttachmentType, name)
+		}
+		assert.Empty(t, storage.UploadObjectCalls())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		svc := NewAttachmentService(&GiftItemAttachmentRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		_, err := svc.UploadAttachment(context.Background(), item, AttachmentUpload{
+			FileName: "manual.pdf",
+			Size:     MaxAttachmentSize + 1,
+			Body:     strings.NewReader(samplePDF),
+		})
+		assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+		assert.Empty(t, storage.UploadObjectCalls())
+	})
+
+	t.Run("full item is refused before uploading", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+				return make([]*models.GiftItemAttachment, MaxItemAttachments), nil
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		_, err := svc.UploadAttachment(context.Background(), item, AttachmentUpload{
+			FileName: "spec.pdf",
+			Size:     int64(len(samplePDF)),
+			Body:     strings.NewReader(samplePDF),
+		})
+		assert.ErrorIs(t, err, ErrAttachmentLimitReached)
+		assert.Empty(t, storage.UploadObjectCalls())
+	})
+
+	t.Run("file is deleted when it cannot be recorded", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+				return nil, nil
+			},
+			AddFunc: func(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error) {
+				return nil, repository.ErrGiftItemAttachmentLimitReached
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		_, err := svc.UploadAttachment(context.Background(), item, AttachmentUpload{
+			FileName: "spec.pdf",
+			Size:     int64(len(samplePDF)),
+			Body:     strings.NewReader(samplePDF),
+		})
+		assert.ErrorIs(t, err, ErrAttachmentLimitReached)
+		require.Len(t, storage.DeleteFileCalls(), 1)
+		assert.Equal(t, storage.UploadObjectCalls()[0].Key, storage.DeleteFileCalls()[0].FileKey)
+	})
+}
+
+func TestAttachmentService_DeleteAttachment(t *testing.T) {
+	item := newAttachmentTestItem(t)
+
+	t.Run("removes the record and the file", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			RemoveFunc: func(ctx context.Context, itemID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+				return &models.GiftItemAttachment{ObjectKey: "attachments/a1/1/spec.pdf"}, nil
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		err := svc.DeleteAttachment(context.Background(), item, "00000000-0000-0000-0000-0000000000d1")
+		require.NoError(t, err)
+		require.Len(t, storage.DeleteFileCalls(), 1)
+		assert.Equal(t, "attachments/a1/1/spec.pdf", storage.DeleteFileCalls()[0].FileKey)
+	})
+
+	t.Run("storage failure is not the caller's problem", func(t *testing.T) {
+		storage := newAttachmentStorage()
+		storage.DeleteFileFunc = func(ctx context.Context, fileKey string) error {
+			return errors.New("storage temporarily unavailable")
+		}
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			RemoveFunc: func(ctx context.Context, itemID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+				return &models.GiftItemAttachment{ObjectKey: "attachments/a1/1/spec.pdf"}, nil
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, storage)
+
+		assert.NoError(t, svc.DeleteAttachment(context.Background(), item, "00000000-0000-0000-0000-0000000000d1"))
+	})
+
+	t.Run("unknown attachment", func(t *testing.T) {
+		repo := &GiftItemAttachmentRepositoryInterfaceMock{
+			RemoveFunc: func(ctx context.Context, itemID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+				return nil, repository.ErrGiftItemAttachmentNotFound
+			},
+		}
+		svc := NewAttachmentService(repo, &GiftItemRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, &PublicWishListRepositoryInterfaceMock{}, newAttachmentStorage())
+
+		assert.ErrorIs(t, svc.DeleteAttachment(context.Background(), item, "00000000-0000-0000-0000-0000000000d1"), ErrAttachmentNotFound)
+		assert.ErrorIs(t, svc.DeleteAttachment(context.Background(), item, "not-a-uuid"), ErrAttachmentNotFound)
+	})
+}
+
+func TestAttachmentService_ListPublicAttachments(t *testing.T) {
+	item := newAttachmentTestItem(t)
+	wishList := &wishlistmodels.WishList{}
+	require.NoError(t, wishList.ID.Scan("00000000-0000-0000-0000-0000000000e1"))
+
+	wishLists := &PublicWishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			if publicSlug != "birthday-2026" {
+				return nil, wishlistrepo.ErrWishListNotFound
+			}
+			return wishList, nil
+		},
+	}
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+	repo := &GiftItemAttachmentRepositoryInterfaceMock{
+		ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+			return []*models.GiftItemAttachment{
+				{GiftItemID: itemID, FileName: "size chart.pdf", ContentType: "application/pdf", ObjectKey: "attachments/a1/1/size_chart.pdf"},
+			}, nil
+		},
+	}
+
+	t.Run("item on the public wishlist", func(t *testing.T) {
+		wishlistItems := &WishlistItemRepositoryInterfaceMock{
+			IsAttachedFunc: func(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error) {
+				return true, nil
+			},
+		}
+		svc := NewAttachmentService(repo, itemRepo, wishlistItems, wishLists, newAttachmentStorage())
+
+		out, err := svc.ListPublicAttachments(context.Background(), "birthday-2026", attachmentItemID)
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "size chart.pdf", out[0].FileName)
+		assert.NotEmpty(t, out[0].DownloadURL)
+		assert.False(t, out[0].DownloadURLExpiresAt.IsZero())
+	})
+
+	t.Run("item not on the wishlist", func(t *testing.T) {
+		wishlistItems := &WishlistItemRepositoryInterfaceMock{
+			IsAttachedFunc: func(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+		}
+		svc := NewAttachmentService(repo, itemRepo, wishlistItems, wishLists, newAttachmentStorage())
+
+		_, err := svc.ListPublicAttachments(context.Background(), "birthday-2026", attachmentItemID)
+		assert.ErrorIs(t, err, ErrItemNotFound)
+	})
+
+	t.Run("private or unknown wishlist", func(t *testing.T) {
+		svc := NewAttachmentService(repo, itemRepo, &WishlistItemRepositoryInterfaceMock{}, wishLists, newAttachmentStorage())
+
+		_, err := svc.ListPublicAttachments(context.Background(), "someone-elses", attachmentItemID)
+		assert.ErrorIs(t, err, ErrItemNotFound)
+	})
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func BenchmarkItemService_GetMyItems(b *testing.B) {
+	ownerID := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	filters := repository.ItemFilters{Limit: 50, Sort: "price", Order: "asc", Attached: true, Search: "gift"}
+
+	items := make([]*models.GiftItem, 0, filters.Limit)
+	wishlistIDs := make(map[string][]string, filters.Limit)
+	for range filters.Limit {
+		item := makeGiftItem(ownerID)
+		items = append(items, item)
+		wishlistIDs[item.ID.String()] = []string{uuid.NewString()}
+	}
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByOwnerPaginatedFunc: func(ctx context.Context, oid pgtype.UUID, filters repository.ItemFilters) (*repository.PaginatedResult, error) {
+			return &repository.PaginatedResult{Items: items, TotalCount: 500, WishlistIDsMap: wishlistIDs}, nil
+		},
+	}
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+	ctx := context.Background()
+	userID := ownerID.String()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := svc.GetMyItems(ctx, userID, filters); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,4 +1,5 @@
 //go:generate go run github.com/matryer/moq@latest -out mock_wishlistitem_repository_test.go -pkg service . WishlistItemRepositoryInterface
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . ContentModeratorInterface ItemEventPublisherInterface AttachmentStorageInterface PublicWishListRepositoryInterface ItemShareTokenInterface ReservationEventLogInterface ReservationHolderNotifierInterface ItemReceivedEmailSenderInterface GuestEmailDecrypterInterface
 
 package service
 
@@ -6,22 +7,56 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"wish-list/internal/domain/item/mapper"
 	"wish-list/internal/domain/item/models"
 	"wish-list/internal/domain/item/repository"
+	partnermodels "wish-list/internal/domain/partner/models"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/metrics"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // Sentinel errors for items
 var (
-	ErrItemNotFound      = errors.New("item not found")
-	ErrItemForbidden     = errors.New("not authorized to access this item")
-	ErrInvalidItemUser   = errors.New("invalid user id")
-	ErrItemTitleRequired = errors.New("title is required")
+	ErrItemNotFound        = errors.New("item not found")
+	ErrItemForbidden       = errors.New("not authorized to access this item")
+	ErrInvalidItemUser     = errors.New("invalid user id")
+	ErrItemTitleRequired   = errors.New("title is required")
+	ErrItemVersionConflict = errors.New("item was modified by another request")
+
+	ErrItemQuantityBelowReserved  = errors.New("quantity cannot be lower than the number of reserved units")
+	ErrItemPriorityInvalid        = errors.New("priority must be must_have, nice_to_have or dream")
+	ErrItemOptionsInvalid         = variant.ErrInvalidOptions
+	ErrItemNotesVisibilityInvalid = errors.New("notes visibility must be private or public")
+
+	ErrItemImageNotFound     = errors.New("item image not found")
+	ErrItemImageLimitReached = errors.New("item has the maximum number of images")
+	ErrItemImageOrderInvalid = errors.New("image order must list every image of the item exactly once")
 )
 
+// MaxItemImages is how many images an item's gallery can hold
+const MaxItemImages = 10
+
+// ItemVersionConflictError is returned when an update was based on a stale version.
+// Current holds the item as it is now stored so clients can merge and retry.
+type ItemVersionConflictError struct {
+	Current *ItemOutput
+}
+
+func (e *ItemVersionConflictError) Error() string {
+	return ErrItemVersionConflict.Error()
+}
+
+func (e *ItemVersionConflictError) Unwrap() error {
+	return ErrItemVersionConflict
+}
+
 // WishlistItemRepositoryInterface defines what the item service needs from wishlist_item repository (cross-domain)
 type WishlistItemRepositoryInterface interface {
 	Attach(ctx context.Context, wishlistID, itemID pgtype.UUID) error
@@ -33,6 +68,18 @@ type WishlistItemRepositoryInterface interface {
 	DetachAll(ctx context.Context, itemID pgtype.UUID) error
 }
 
+// ContentModeratorInterface defines what the item service needs from the moderation service (cross-domain).
+// Rejected content fails with the moderation domain's ContentRejectedError, returned unchanged.
+type ContentModeratorInterface interface {
+	CheckContent(ctx context.Context, imageURL string, texts ...string) error
+}
+
+// ItemEventPublisherInterface defines what the item service needs to announce purchases,
+// e.g. to partner shops selling the item (cross-domain)
+type ItemEventPublisherInterface interface {
+	PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error
+}
+
 // ItemServiceInterface defines the interface for item-related operations
 type ItemServiceInterface interface {
 	GetMyItems(ctx context.Context, userID string, filters repository.ItemFilters) (*PaginatedItemsOutput, error)
@@ -41,22 +88,41 @@ type ItemServiceInterface interface {
 	UpdateItem(ctx context.Context, itemID string, userID string, input UpdateItemInput) (*ItemOutput, error)
 	SoftDeleteItem(ctx context.Context, itemID string, userID string) error
 	MarkPurchased(ctx context.Context, itemID string, userID string, purchasedPrice float64) (*ItemOutput, error)
+	AddItemImage(ctx context.Context, item *models.GiftItem, url string) (*ItemOutput, error)
+	RemoveItemImage(ctx context.Context, item *models.GiftItem, imageID string) (*ItemOutput, error)
+	ReorderItemImages(ctx context.Context, item *models.GiftItem, imageIDs []string) (*ItemOutput, error)
+	SetPurchaseProof(ctx context.Context, itemID, userID string, input PurchaseProofInput) (*PurchaseProofOutput, error)
+	GetPurchaseProof(ctx context.Context, itemID, userID string) (*PurchaseProofOutput, error)
 }
 
 // ItemService implements ItemServiceInterface
 type ItemService struct {
 	itemRepo         repository.GiftItemRepositoryInterface
+	imageRepo        repository.GiftItemImageRepositoryInterface
+	purchaseRepo     repository.GiftItemPurchaseRepositoryInterface
 	wishlistItemRepo WishlistItemRepositoryInterface
+	moderator        ContentModeratorInterface
+	itemEvents       ItemEventPublisherInterface
 }
 
-// NewItemService creates a new ItemService
+// NewItemService creates a new ItemService.
+// moderator may be nil, in which case item content is not screened.
+// itemEvents may be nil, in which case purchases are not announced.
 func NewItemService(
 	itemRepo repository.GiftItemRepositoryInterface,
+	imageRepo repository.GiftItemImageRepositoryInterface,
+	purchaseRepo repository.GiftItemPurchaseRepositoryInterface,
 	wishlistItemRepo WishlistItemRepositoryInterface,
+	moderator ContentModeratorInterface,
+	itemEvents ItemEventPublisherInterface,
 ) *ItemService {
 	return &ItemService{
 		itemRepo:         itemRepo,
+		imageRepo:        imageRepo,
+		purchaseRepo:     purchaseRepo,
 		wishlistItemRepo: wishlistItemRepo,
+		moderator:        moderator,
+		itemEvents:       itemEvents,
 	}
 }
 
@@ -64,44 +130,52 @@ func NewItemService(
 
 // CreateItemInput represents input for creating an item
 type CreateItemInput struct {
-	Title       string
-	Description string
-	Link        string
-	ImageURL    string
-	Price       float64
-	Priority    int32
-	Notes       string
+	Title           string
+	Description     string
+	Link            string
+	ImageURL        string
+	Price           float64
+	Priority        int32  // Legacy 0-10 number
+	PriorityLevel   string // Priority level; empty derives it from Priority
+	Notes           string
+	NotesVisibility string // private or public; empty keeps the notes private
+	Quantity        int32  // Units wanted; 0 means 1
+	Options         variant.Options
+	Category        string // Section on the share page; empty for none
 }
 
 // UpdateItemInput represents input for updating an item
 type UpdateItemInput struct {
-	Title       *string
-	Description *string
-	Link        *string
-	ImageURL    *string
-	Price       *float64
-	Priority    *int32
-	Notes       *string
+	Title           *string
+	Description     *string
+	Link            *string
+	ImageURL        *string
+	Price           *float64
+	Priority        *int32  // Legacy 0-10 number
+	PriorityLevel   *string // Priority level, set together with Priority; nil derives it from Priority
+	Notes           *string
+	NotesVisibility *string // private or public
+	Quantity        *int32
+	Options         *variant.Options // Replaces all options; empty options clear them
+	Category        *string          // Empty clears the category
+	Version         *int32           // Expected current version; nil skips the client-side check
 }
 
 // ItemOutput represents an item in service responses
 type ItemOutput struct {
-	ID          string
-	OwnerID     string
-	Name        string
-	Description string
-	Link        string
-	ImageURL    string
-	Price       float64
-	Priority    int
-	Notes       string
-	IsPurchased bool
-	IsArchived  bool
+	mapper.Item
 	WishlistIDs []string // IDs of wishlists this item is attached to (empty for standalone)
-	CreatedAt   string
-	UpdatedAt   string
+
+	// Variants picked by givers. Only filled in for the owner once the item is
+	// purchased, so what was bought stays a surprise until then.
+	ReservedVariants []variant.Choice
 }
 
+// ItemImageOutput represents one image of an item's gallery.
+// ID is empty for an image only ever set through image_url; such an image
+// joins the gallery the first time another image is added.
+type ItemImageOutput = mapper.Image
+
 // PaginatedItemsOutput represents paginated list of items
 type PaginatedItemsOutput struct {
 	Items      []*ItemOutput
@@ -145,12 +219,15 @@ func (s *ItemService) GetMyItems(ctx context.Context, userID string, filters rep
 	// Convert to output, attaching wishlist IDs from the batch-loaded map
 	items := make([]*ItemOutput, 0, len(result.Items))
 	for _, item := range result.Items {
-		output := s.convertToOutput(item)
+		output := itemToOutput(item)
 		if ids, ok := result.WishlistIDsMap[item.ID.String()]; ok {
 			output.WishlistIDs = ids
 		}
 		items = append(items, output)
 	}
+	if err := s.attachImages(ctx, items...); err != nil {
+		return nil, err
+	}
 
 	totalPages := int((result.TotalCount + int64(filters.Limit) - 1) / int64(filters.Limit))
 
@@ -176,16 +253,44 @@ func (s *ItemService) CreateItem(ctx context.Context, userID string, input Creat
 		return nil, ErrInvalidItemUser
 	}
 
+	if input.PriorityLevel != "" {
+		if _, err := priority.Parse(input.PriorityLevel); err != nil {
+			return nil, ErrItemPriorityInvalid
+		}
+	}
+	if input.NotesVisibility != "" && !validNotesVisibility(input.NotesVisibility) {
+		return nil, ErrItemNotesVisibilityInvalid
+	}
+
+	options, err := input.Options.Normalize()
+	if err != nil {
+		return nil, ErrItemOptionsInvalid
+	}
+
+	if s.moderator != nil {
+		texts := append([]string{input.Title, input.Description, input.Link}, options.Values()...)
+		if input.Category != "" {
+			texts = append(texts, input.Category)
+		}
+		if err := s.moderator.CheckContent(ctx, input.ImageURL, texts...); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create item model
 	item := models.GiftItem{
-		OwnerID:     ownerID,
-		Name:        input.Title,
-		Description: pgtype.Text{String: input.Description, Valid: input.Description != ""},
-		Link:        pgtype.Text{String: input.Link, Valid: input.Link != ""},
-		ImageUrl:    pgtype.Text{String: input.ImageURL, Valid: input.ImageURL != ""},
-		Priority:    pgtype.Int4{Int32: input.Priority, Valid: true},
-		Notes:       pgtype.Text{String: input.Notes, Valid: input.Notes != ""},
-	}
+		OwnerID:         ownerID,
+		Name:            input.Title,
+		Description:     pgtype.Text{String: input.Description, Valid: input.Description != ""},
+		Link:            pgtype.Text{String: input.Link, Valid: input.Link != ""},
+		ImageUrl:        pgtype.Text{String: input.ImageURL, Valid: input.ImageURL != ""},
+		Notes:           pgtype.Text{String: input.Notes, Valid: input.Notes != ""},
+		NotesVisibility: input.NotesVisibility,
+		Quantity:        max(input.Quantity, 1),
+		Options:         options,
+		Category:        categoryText(input.Category),
+	}
+	item.SetPriority(input.PriorityLevel, input.Priority)
 
 	// Set price if provided
 	if input.Price > 0 {
@@ -200,7 +305,7 @@ func (s *ItemService) CreateItem(ctx context.Context, userID string, input Creat
 		return nil, fmt.Errorf("failed to create item: %w", err)
 	}
 
-	return s.convertToOutput(createdItem), nil
+	return itemToOutput(createdItem), nil
 }
 
 // GetItem retrieves a specific item by ID
@@ -227,7 +332,18 @@ func (s *ItemService) GetItem(ctx context.Context, itemID, userID string) (*Item
 		return nil, ErrItemForbidden
 	}
 
-	return s.convertToOutput(item), nil
+	output := itemToOutput(item)
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
+	}
+	// The variants givers picked are revealed once the item is purchased
+	if item.PurchasedByUserID.Valid {
+		output.ReservedVariants, err = s.itemRepo.GetReservedVariants(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
 }
 
 // UpdateItem updates an existing item
@@ -254,6 +370,18 @@ func (s *ItemService) UpdateItem(ctx context.Context, itemID, userID string, inp
 		return nil, ErrItemForbidden
 	}
 
+	if input.Version != nil && *input.Version != item.Version {
+		return nil, &ItemVersionConflictError{Current: itemToOutput(item)}
+	}
+
+	if s.moderator != nil {
+		if err := s.moderateUpdate(ctx, input); err != nil {
+			return nil, err
+		}
+	}
+
+	previousImageURL := item.ImageUrl.String
+
 	// Update fields
 	if input.Title != nil {
 		item.Name = *input.Title
@@ -273,19 +401,63 @@ func (s *ItemService) UpdateItem(ctx context.Context, itemID, userID string, inp
 		}
 	}
 	if input.Priority != nil {
-		item.Priority = pgtype.Int4{Int32: *input.Priority, Valid: true}
+		level := ""
+		if input.PriorityLevel != nil {
+			if _, err := priority.Parse(*input.PriorityLevel); err != nil {
+				return nil, ErrItemPriorityInvalid
+			}
+			level = *input.PriorityLevel
+		}
+		item.SetPriority(level, *input.Priority)
 	}
 	if input.Notes != nil {
 		item.Notes = pgtype.Text{String: *input.Notes, Valid: *input.Notes != ""}
 	}
+	if input.NotesVisibility != nil {
+		if !validNotesVisibility(*input.NotesVisibility) {
+			return nil, ErrItemNotesVisibilityInvalid
+		}
+		item.NotesVisibility = *input.NotesVisibility
+	}
+	if input.Quantity != nil {
+		// Units already promised to guests cannot be taken back here
+		if *input.Quantity < item.ReservedQuantity {
+			return nil, ErrItemQuantityBelowReserved
+		}
+		item.Quantity = *input.Quantity
+	}
+	if input.Category != nil {
+		item.Category = categoryText(*input.Category)
+	}
+	if input.Options != nil {
+		options, err := input.Options.Normalize()
+		if err != nil {
+			return nil, ErrItemOptionsInvalid
+		}
+		item.Options = options
+	}
 
 	// Update in repository
 	updatedItem, err := s.itemRepo.UpdateWithNewSchema(ctx, item)
 	if err != nil {
+		if errors.Is(err, repository.ErrGiftItemVersionConflict) {
+			return nil, s.versionConflict(ctx, id)
+		}
 		return nil, fmt.Errorf("failed to update item: %w", err)
 	}
 
-	return s.convertToOutput(updatedItem), nil
+	// A new image_url replaces the primary image of the gallery
+	if input.ImageURL != nil && *input.ImageURL != previousImageURL {
+		if err := s.imageRepo.ReplacePrimary(ctx, id, *input.ImageURL); err != nil {
+			return nil, fmt.Errorf("failed to update item images: %w", err)
+		}
+	}
+
+	output := itemToOutput(updatedItem)
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
+	}
+	return output, nil
 }
 
 // SoftDeleteItem marks an item as archived
@@ -349,51 +521,176 @@ func (s *ItemService) MarkPurchased(ctx context.Context, itemID, userID string,
 	// Update in repository
 	updatedItem, err := s.itemRepo.UpdateWithNewSchema(ctx, item)
 	if err != nil {
+		if errors.Is(err, repository.ErrGiftItemVersionConflict) {
+			return nil, s.versionConflict(ctx, id)
+		}
 		return nil, fmt.Errorf("failed to mark item as purchased: %w", err)
 	}
+	metrics.PurchaseMarked()
+
+	// The purchase is already stored, so a failed announcement is only logged
+	if s.itemEvents != nil {
+		if err := s.itemEvents.PublishItemEvent(ctx, partnermodels.EventItemPurchased, id); err != nil {
+			logger.WarnContext(ctx, "failed to publish item event", "event", partnermodels.EventItemPurchased, "gift_item_id", itemID, "error", err)
+		}
+	}
+
+	output := itemToOutput(updatedItem)
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// AddItemImage appends an image to the gallery of an item the user owns, e.g. one
+// loaded by RequireItemOwner
+func (s *ItemService) AddItemImage(ctx context.Context, item *models.GiftItem, url string) (*ItemOutput, error) {
+	if s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, url); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.imageRepo.Add(ctx, item.ID, url, MaxItemImages); err != nil {
+		return nil, mapImageRepositoryError(err)
+	}
+
+	return s.reloadWithImages(ctx, item.ID)
+}
+
+// RemoveItemImage deletes an image from the item's gallery; removing the
+// first image promotes the next one to primary
+func (s *ItemService) RemoveItemImage(ctx context.Context, item *models.GiftItem, imageID string) (*ItemOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(imageID); err != nil {
+		return nil, ErrItemImageNotFound
+	}
 
-	return s.convertToOutput(updatedItem), nil
+	if err := s.imageRepo.Remove(ctx, item.ID, id); err != nil {
+		return nil, mapImageRepositoryError(err)
+	}
+
+	return s.reloadWithImages(ctx, item.ID)
 }
 
-// Helper function to convert models.GiftItem to ItemOutput
-func (s *ItemService) convertToOutput(item *models.GiftItem) *ItemOutput {
-	output := &ItemOutput{
-		ID:          item.ID.String(),
-		OwnerID:     item.OwnerID.String(),
-		Name:        item.Name,
-		Description: "",
-		Link:        "",
-		ImageURL:    "",
-		Price:       0,
-		Priority:    0,
-		Notes:       "",
-		IsPurchased: item.PurchasedByUserID.Valid,
-		IsArchived:  item.ArchivedAt.Valid,
-		CreatedAt:   item.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:   item.UpdatedAt.Time.Format(time.RFC3339),
-	}
-
-	// Handle nullable fields
-	if item.Description.Valid {
-		output.Description = item.Description.String
-	}
-	if item.Link.Valid {
-		output.Link = item.Link.String
-	}
-	if item.ImageUrl.Valid {
-		output.ImageURL = item.ImageUrl.String
-	}
-	if item.Price.Valid {
-		if priceValue, err := item.Price.Float64Value(); err == nil && priceValue.Valid {
-			output.Price = priceValue.Float64
+// ReorderItemImages rearranges the item's gallery; imageIDs must list every
+// image exactly once and the first becomes the primary image
+func (s *ItemService) ReorderItemImages(ctx context.Context, item *models.GiftItem, imageIDs []string) (*ItemOutput, error) {
+	ids := make([]pgtype.UUID, len(imageIDs))
+	for i, imageID := range imageIDs {
+		if err := ids[i].Scan(imageID); err != nil {
+			return nil, ErrItemImageOrderInvalid
 		}
 	}
-	if item.Priority.Valid {
-		output.Priority = int(item.Priority.Int32)
+
+	if err := s.imageRepo.Reorder(ctx, item.ID, ids); err != nil {
+		return nil, mapImageRepositoryError(err)
+	}
+
+	return s.reloadWithImages(ctx, item.ID)
+}
+
+// reloadWithImages returns the item as stored after a gallery change, which
+// also bumps its version and primary image
+func (s *ItemService) reloadWithImages(ctx context.Context, id pgtype.UUID) (*ItemOutput, error) {
+	item, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload item: %w", err)
+	}
+
+	output := itemToOutput(item)
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
 	}
-	if item.Notes.Valid {
-		output.Notes = item.Notes.String
+	return output, nil
+}
+
+// attachImages replaces the image_url fallback of each output with its stored gallery
+func (s *ItemService) attachImages(ctx context.Context, outputs ...*ItemOutput) error {
+	return attachItemImages(ctx, s.imageRepo, outputs...)
+}
+
+// attachItemImages replaces the image_url fallback of each output with its
+// gallery as stored in imageRepo
+func attachItemImages(ctx context.Context, imageRepo repository.GiftItemImageRepositoryInterface, outputs ...*ItemOutput) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	ids := make([]pgtype.UUID, 0, len(outputs))
+	for _, output := range outputs {
+		id := pgtype.UUID{}
+		if err := id.Scan(output.ID); err != nil {
+			return fmt.Errorf("invalid item id %q: %w", output.ID, err)
+		}
+		ids = append(ids, id)
+	}
+
+	imagesByItem, err := imageRepo.GetByItemIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get item images: %w", err)
+	}
+
+	for _, output := range outputs {
+		if images, ok := imagesByItem[output.ID]; ok {
+			output.Images = mapper.Gallery(images)
+		}
+	}
+	return nil
+}
+
+// mapImageRepositoryError converts gallery repository errors to service errors
+func mapImageRepositoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrGiftItemNotFound):
+		return ErrItemNotFound
+	case errors.Is(err, repository.ErrGiftItemImageNotFound):
+		return ErrItemImageNotFound
+	case errors.Is(err, repository.ErrGiftItemImageLimitReached):
+		return ErrItemImageLimitReached
+	case errors.Is(err, repository.ErrGiftItemImageOrderMismatch):
+		return ErrItemImageOrderInvalid
+	default:
+		return fmt.Errorf("failed to update item images: %w", err)
+	}
+}
+
+// versionConflict reloads the item that changed underneath an update
+func (s *ItemService) versionConflict(ctx context.Context, id pgtype.UUID) error {
+	current, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reload item after version conflict: %w", err)
+	}
+	return &ItemVersionConflictError{Current: itemToOutput(current)}
+}
+
+// categoryText trims a category; blank categories are stored as NULL
+func categoryText(category string) pgtype.Text {
+	category = strings.TrimSpace(category)
+	return pgtype.Text{String: category, Valid: category != ""}
+}
+
+// validNotesVisibility reports whether visibility is a known notes visibility
+func validNotesVisibility(visibility string) bool {
+	return visibility == models.NotesVisibilityPrivate || visibility == models.NotesVisibilityPublic
+}
+
+// moderateUpdate screens the changed public fields of an item
+func (s *ItemService) moderateUpdate(ctx context.Context, input UpdateItemInput) error {
+	var texts []string
+	for _, field := range []*string{input.Title, input.Description, input.Link, input.Category} {
+		if field != nil {
+			texts = append(texts, *field)
+		}
+	}
+	if input.Options != nil {
+		texts = append(texts, input.Options.Values()...)
+	}
+
+	imageURL := ""
+	if input.ImageURL != nil {
+		imageURL = *input.ImageURL
 	}
 
-	return output
+	return s.moderator.CheckContent(ctx, imageURL, texts...)
 }
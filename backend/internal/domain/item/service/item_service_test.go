@@ -9,6 +9,9 @@ import (
 
 	"wish-list/internal/domain/item/models"
 	"wish-list/internal/domain/item/repository"
+	partnermodels "wish-list/internal/domain/partner/models"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -61,7 +64,19 @@ func newItemService(
 	itemRepo *GiftItemRepositoryInterfaceMock,
 	wishlistItemRepo *WishlistItemRepositoryInterfaceMock,
 ) *ItemService {
-	return NewItemService(itemRepo, wishlistItemRepo)
+	return NewItemService(itemRepo, emptyImageRepo(), &GiftItemPurchaseRepositoryInterfaceMock{}, wishlistItemRepo, nil, nil)
+}
+
+// emptyImageRepo is a gallery repository in which no item has stored images
+func emptyImageRepo() *GiftItemImageRepositoryInterfaceMock {
+	return &GiftItemImageRepositoryInterfaceMock{
+		GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+			return map[string][]*models.GiftItemImage{}, nil
+		},
+		ReplacePrimaryFunc: func(ctx context.Context, itemID pgtype.UUID, url string) error {
+			return nil
+		},
+	}
 }
 
 func stringPtr(s string) *string    { return &s }
@@ -313,6 +328,59 @@ func TestItemService_CreateItem_Success(t *testing.T) {
 	assert.Len(t, itemRepo.CreateWithOwnerCalls(), 1)
 }
 
+func TestItemService_CreateItem_PriorityLevel(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		CreateWithOwnerFunc: func(ctx context.Context, gi models.GiftItem) (*models.GiftItem, error) {
+			return &gi, nil
+		},
+	}
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+
+	// A legacy number is mapped onto a level
+	result, err := svc.CreateItem(context.Background(), ownerStr, CreateItemInput{Title: "Bike", Priority: 8})
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.Priority)
+	assert.Equal(t, priority.MustHave, result.PriorityLevel)
+	assert.Equal(t, 3, result.PriorityWeight)
+
+	result, err = svc.CreateItem(context.Background(), ownerStr, CreateItemInput{Title: "Yacht", Priority: 2, PriorityLevel: priority.Dream})
+	require.NoError(t, err)
+	assert.Equal(t, priority.Dream, result.PriorityLevel)
+
+	_, err = svc.CreateItem(context.Background(), ownerStr, CreateItemInput{Title: "Socks", PriorityLevel: "urgent"})
+	require.ErrorIs(t, err, ErrItemPriorityInvalid)
+	assert.Len(t, itemRepo.CreateWithOwnerCalls(), 2)
+	assert.Equal(t, ownerID, itemRepo.CreateWithOwnerCalls()[0].GiftItem.OwnerID)
+}
+
+func TestItemService_CreateItem_NotesVisibility(t *testing.T) {
+	_, ownerStr := newValidPgtypeUUID(t)
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		CreateWithOwnerFunc: func(ctx context.Context, gi models.GiftItem) (*models.GiftItem, error) {
+			return &gi, nil
+		},
+	}
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+
+	// Notes stay private unless the owner shares them
+	result, err := svc.CreateItem(context.Background(), ownerStr, CreateItemInput{Title: "Bike", Notes: "Compare prices first"})
+	require.NoError(t, err)
+	assert.Equal(t, models.NotesVisibilityPrivate, result.NotesVisibility)
+
+	result, err = svc.CreateItem(context.Background(), ownerStr, CreateItemInput{
+		Title: "Scarf", Notes: "Anything but green", NotesVisibility: models.NotesVisibilityPublic,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.NotesVisibilityPublic, result.NotesVisibility)
+
+	_, err = svc.CreateItem(context.Background(), ownerStr, CreateItemInput{Title: "Socks", NotesVisibility: "friends"})
+	require.ErrorIs(t, err, ErrItemNotesVisibilityInvalid)
+	assert.Len(t, itemRepo.CreateWithOwnerCalls(), 2)
+}
+
 func TestItemService_CreateItem_EmptyTitle(t *testing.T) {
 	itemRepo := &GiftItemRepositoryInterfaceMock{}
 	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
@@ -409,6 +477,32 @@ func TestItemService_CreateItem_RepoError(t *testing.T) {
 // GetItem
 // ---------------------------------------------------------------------------
 
+func TestItemService_CreateItem_ContentRejected(t *testing.T) {
+	errRejected := errors.New("content rejected")
+	itemRepo := &GiftItemRepositoryInterfaceMock{}
+	moderator := &ContentModeratorInterfaceMock{
+		CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+			return errRejected
+		},
+	}
+
+	svc := NewItemService(itemRepo, emptyImageRepo(), &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, moderator, nil)
+	result, err := svc.CreateItem(context.Background(), uuid.New().String(), CreateItemInput{
+		Title:    "Scarf",
+		Link:     "https://shop.example/scarf",
+		ImageURL: "https://shop.example/scarf.jpg",
+		Notes:    "private notes are not screened",
+	})
+
+	assert.Nil(t, result)
+	require.ErrorIs(t, err, errRejected)
+	assert.Empty(t, itemRepo.CreateWithOwnerCalls())
+	require.Len(t, moderator.CheckContentCalls(), 1)
+	call := moderator.CheckContentCalls()[0]
+	assert.Equal(t, "https://shop.example/scarf.jpg", call.ImageURL)
+	assert.Equal(t, []string{"Scarf", "", "https://shop.example/scarf"}, call.Texts)
+}
+
 func TestItemService_GetItem_Success(t *testing.T) {
 	ownerID, ownerStr := newValidPgtypeUUID(t)
 	item := makeGiftItem(ownerID)
@@ -568,6 +662,35 @@ func TestItemService_UpdateItem_PartialUpdate(t *testing.T) {
 	require.NotNil(t, result)
 }
 
+func TestItemService_UpdateItem_Quantity(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	existingItem := makeGiftItem(ownerID)
+	existingItem.Quantity = 6
+	existingItem.ReservedQuantity = 2
+	itemIDStr := existingItem.ID.String()
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			item := *existingItem
+			return &item, nil
+		},
+		UpdateWithNewSchemaFunc: func(ctx context.Context, gi *models.GiftItem) (*models.GiftItem, error) {
+			return gi, nil
+		},
+	}
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+
+	result, err := svc.UpdateItem(context.Background(), itemIDStr, ownerStr, UpdateItemInput{Quantity: int32Ptr(4)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.Quantity)
+	assert.Equal(t, int32(2), result.ReservedQuantity)
+	assert.Equal(t, models.ReservationPartiallyReserved, result.ReservationStatus)
+
+	_, err = svc.UpdateItem(context.Background(), itemIDStr, ownerStr, UpdateItemInput{Quantity: int32Ptr(1)})
+	require.ErrorIs(t, err, ErrItemQuantityBelowReserved)
+	assert.Len(t, itemRepo.UpdateWithNewSchemaCalls(), 1)
+}
+
 func TestItemService_UpdateItem_ClearOptionalField(t *testing.T) {
 	ownerID, ownerStr := newValidPgtypeUUID(t)
 	existingItem := makeGiftItem(ownerID)
@@ -682,6 +805,99 @@ func TestItemService_UpdateItem_RepoError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to update item")
 }
 
+func TestItemService_UpdateItem_StaleClientVersion(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	existingItem := makeGiftItem(ownerID)
+	existingItem.Version = 3
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return existingItem, nil
+		},
+	}
+
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+	result, err := svc.UpdateItem(context.Background(), existingItem.ID.String(), ownerStr, UpdateItemInput{
+		Title:   stringPtr("X"),
+		Version: int32Ptr(2),
+	})
+
+	assert.Nil(t, result)
+	require.ErrorIs(t, err, ErrItemVersionConflict)
+	var conflict *ItemVersionConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int32(3), conflict.Current.Version)
+	assert.Equal(t, "Test Item", conflict.Current.Name)
+	assert.Empty(t, itemRepo.UpdateWithNewSchemaCalls())
+}
+
+func TestItemService_UpdateItem_ScreensChangedFields(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	existingItem := makeGiftItem(ownerID)
+	errRejected := errors.New("content rejected")
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return existingItem, nil
+		},
+	}
+	moderator := &ContentModeratorInterfaceMock{
+		CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+			return errRejected
+		},
+	}
+
+	svc := NewItemService(itemRepo, emptyImageRepo(), &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, moderator, nil)
+	result, err := svc.UpdateItem(context.Background(), existingItem.ID.String(), ownerStr, UpdateItemInput{
+		Description: stringPtr("new description"),
+	})
+
+	assert.Nil(t, result)
+	require.ErrorIs(t, err, errRejected)
+	assert.Empty(t, itemRepo.UpdateWithNewSchemaCalls())
+	call := moderator.CheckContentCalls()[0]
+	assert.Empty(t, call.ImageURL)
+	assert.Equal(t, []string{"new description"}, call.Texts)
+}
+
+func TestItemService_UpdateItem_ConcurrentUpdate(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	existingItem := makeGiftItem(ownerID)
+	existingItem.Version = 3
+
+	concurrentItem := *existingItem
+	concurrentItem.Name = "Changed elsewhere"
+	concurrentItem.Version = 4
+
+	getCalls := 0
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			getCalls++
+			if getCalls == 1 {
+				copied := *existingItem
+				return &copied, nil
+			}
+			return &concurrentItem, nil
+		},
+		UpdateWithNewSchemaFunc: func(ctx context.Context, gi *models.GiftItem) (*models.GiftItem, error) {
+			assert.Equal(t, int32(3), gi.Version)
+			return nil, repository.ErrGiftItemVersionConflict
+		},
+	}
+
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+	result, err := svc.UpdateItem(context.Background(), existingItem.ID.String(), ownerStr, UpdateItemInput{
+		Title:   stringPtr("X"),
+		Version: int32Ptr(3),
+	})
+
+	assert.Nil(t, result)
+	var conflict *ItemVersionConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int32(4), conflict.Current.Version)
+	assert.Equal(t, "Changed elsewhere", conflict.Current.Name)
+}
+
 // ---------------------------------------------------------------------------
 // SoftDeleteItem
 // ---------------------------------------------------------------------------
@@ -818,6 +1034,36 @@ func TestItemService_MarkPurchased_Success(t *testing.T) {
 	assert.Len(t, itemRepo.UpdateWithNewSchemaCalls(), 1)
 }
 
+func TestItemService_MarkPurchased_PublishesEvent(t *testing.T) {
+	ownerID, _ := newValidPgtypeUUID(t)
+	_, buyerStr := newValidPgtypeUUID(t)
+	existingItem := makeGiftItem(ownerID)
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return existingItem, nil
+		},
+		UpdateWithNewSchemaFunc: func(ctx context.Context, gi *models.GiftItem) (*models.GiftItem, error) {
+			return gi, nil
+		},
+	}
+	itemEvents := &ItemEventPublisherInterfaceMock{
+		PublishItemEventFunc: func(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+			return errors.New("outbox unavailable")
+		},
+	}
+
+	svc := NewItemService(itemRepo, emptyImageRepo(), &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, itemEvents)
+	result, err := svc.MarkPurchased(context.Background(), existingItem.ID.String(), buyerStr, 29.99)
+
+	// A failed announcement does not fail the purchase
+	require.NoError(t, err)
+	assert.True(t, result.IsPurchased)
+	require.Len(t, itemEvents.PublishItemEventCalls(), 1)
+	assert.Equal(t, partnermodels.EventItemPurchased, itemEvents.PublishItemEventCalls()[0].Event)
+	assert.Equal(t, existingItem.ID, itemEvents.PublishItemEventCalls()[0].GiftItemID)
+}
+
 func TestItemService_MarkPurchased_InvalidItemID(t *testing.T) {
 	itemRepo := &GiftItemRepositoryInterfaceMock{}
 	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
@@ -880,7 +1126,7 @@ func TestItemService_MarkPurchased_RepoUpdateError(t *testing.T) {
 }
 
 // ---------------------------------------------------------------------------
-// convertToOutput (tested through public methods)
+// itemToOutput (tested through public methods)
 // ---------------------------------------------------------------------------
 
 func TestItemService_ConvertToOutput_NullableFields(t *testing.T) {
@@ -935,6 +1181,9 @@ func TestItemService_ConvertToOutput_PurchasedAndArchived(t *testing.T) {
 		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
 			return item, nil
 		},
+		GetReservedVariantsFunc: func(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error) {
+			return []variant.Choice{{Size: "M"}}, nil
+		},
 	}
 
 	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
@@ -943,6 +1192,7 @@ func TestItemService_ConvertToOutput_PurchasedAndArchived(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, result.IsPurchased, "IsPurchased should be true when PurchasedByUserID is valid")
 	assert.True(t, result.IsArchived, "IsArchived should be true when ArchivedAt is valid")
+	assert.Equal(t, []variant.Choice{{Size: "M"}}, result.ReservedVariants, "variants are revealed once purchased")
 }
 
 func TestItemService_ConvertToOutput_PriceConversion(t *testing.T) {
@@ -970,3 +1220,258 @@ func TestItemService_ConvertToOutput_PriceConversion(t *testing.T) {
 	require.NoError(t, err)
 	assert.InDelta(t, 49.99, result.Price, 0.001)
 }
+
+// ---------------------------------------------------------------------------
+// Images
+// ---------------------------------------------------------------------------
+
+func makeGiftItemImage(itemID pgtype.UUID, url string, position int32) *models.GiftItemImage {
+	imageID := pgtype.UUID{}
+	_ = imageID.Scan(uuid.New().String())
+	return &models.GiftItemImage{ID: imageID, GiftItemID: itemID, URL: url, Position: position}
+}
+
+func TestItemService_GetItem_AttachesGallery(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	item := makeGiftItem(ownerID)
+	primary := makeGiftItemImage(item.ID, item.ImageUrl.String, 0)
+	second := makeGiftItemImage(item.ID, "https://example.com/img-2.jpg", 1)
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+	imageRepo := &GiftItemImageRepositoryInterfaceMock{
+		GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+			assert.Equal(t, []pgtype.UUID{item.ID}, itemIDs)
+			return map[string][]*models.GiftItemImage{item.ID.String(): {primary, second}}, nil
+		},
+	}
+
+	svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+	result, err := svc.GetItem(context.Background(), item.ID.String(), ownerStr)
+
+	require.NoError(t, err)
+	assert.Equal(t, item.ImageUrl.String, result.ImageURL)
+	require.Len(t, result.Images, 2)
+	assert.Equal(t, &ItemImageOutput{ID: primary.ID.String(), URL: primary.URL, Position: 0}, result.Images[0])
+	assert.Equal(t, &ItemImageOutput{ID: second.ID.String(), URL: second.URL, Position: 1}, result.Images[1])
+}
+
+func TestItemService_GetItem_FallsBackToImageURL(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+	item := makeGiftItem(ownerID)
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+
+	svc := newItemService(itemRepo, &WishlistItemRepositoryInterfaceMock{})
+	result, err := svc.GetItem(context.Background(), item.ID.String(), ownerStr)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*ItemImageOutput{{URL: item.ImageUrl.String}}, result.Images)
+}
+
+func TestItemService_UpdateItem_ReplacesPrimaryImage(t *testing.T) {
+	ownerID, ownerStr := newValidPgtypeUUID(t)
+
+	tests := []struct {
+		name        string
+		imageURL    *string
+		wantReplace bool
+	}{
+		{"new image url", stringPtr("https://example.com/new.jpg"), true},
+		{"cleared image url", stringPtr(""), true},
+		{"same image url", stringPtr("https://example.com/img.jpg"), false},
+		{"image url untouched", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := makeGiftItem(ownerID)
+			itemRepo := &GiftItemRepositoryInterfaceMock{
+				GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+					return item, nil
+				},
+				UpdateWithNewSchemaFunc: func(ctx context.Context, gi *models.GiftItem) (*models.GiftItem, error) {
+					return gi, nil
+				},
+			}
+			imageRepo := emptyImageRepo()
+
+			svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+			_, err := svc.UpdateItem(context.Background(), item.ID.String(), ownerStr, UpdateItemInput{ImageURL: tt.imageURL})
+
+			require.NoError(t, err)
+			if !tt.wantReplace {
+				assert.Empty(t, imageRepo.ReplacePrimaryCalls())
+				return
+			}
+			require.Len(t, imageRepo.ReplacePrimaryCalls(), 1)
+			assert.Equal(t, *tt.imageURL, imageRepo.ReplacePrimaryCalls()[0].URL)
+		})
+	}
+}
+
+func TestItemService_AddItemImage(t *testing.T) {
+	ownerID, _ := newValidPgtypeUUID(t)
+	item := makeGiftItem(ownerID)
+	url := "https://example.com/img-2.jpg"
+
+	newRepos := func() (*GiftItemRepositoryInterfaceMock, *GiftItemImageRepositoryInterfaceMock) {
+		itemRepo := &GiftItemRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+				return item, nil
+			},
+		}
+		imageRepo := emptyImageRepo()
+		imageRepo.AddFunc = func(ctx context.Context, itemID pgtype.UUID, imageURL string, limit int) (*models.GiftItemImage, error) {
+			return makeGiftItemImage(itemID, imageURL, 1), nil
+		}
+		return itemRepo, imageRepo
+	}
+
+	t.Run("appends image", func(t *testing.T) {
+		itemRepo, imageRepo := newRepos()
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		result, err := svc.AddItemImage(context.Background(), item, url)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Len(t, imageRepo.AddCalls(), 1)
+		assert.Equal(t, item.ID, imageRepo.AddCalls()[0].ItemID)
+		assert.Equal(t, url, imageRepo.AddCalls()[0].URL)
+		assert.Equal(t, MaxItemImages, imageRepo.AddCalls()[0].Limit)
+		assert.Len(t, itemRepo.GetByIDCalls(), 1, "item is reloaded after the gallery changed")
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		itemRepo, imageRepo := newRepos()
+		imageRepo.AddFunc = func(ctx context.Context, itemID pgtype.UUID, imageURL string, limit int) (*models.GiftItemImage, error) {
+			return nil, repository.ErrGiftItemImageLimitReached
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.AddItemImage(context.Background(), item, url)
+
+		assert.ErrorIs(t, err, ErrItemImageLimitReached)
+	})
+
+	t.Run("content rejected", func(t *testing.T) {
+		errRejected := errors.New("content rejected")
+		itemRepo, imageRepo := newRepos()
+		moderator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+				return errRejected
+			},
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, moderator, nil)
+
+		_, err := svc.AddItemImage(context.Background(), item, url)
+
+		assert.ErrorIs(t, err, errRejected)
+		assert.Empty(t, imageRepo.AddCalls())
+		require.Len(t, moderator.CheckContentCalls(), 1)
+		assert.Equal(t, url, moderator.CheckContentCalls()[0].ImageURL)
+	})
+}
+
+func TestItemService_RemoveItemImage(t *testing.T) {
+	ownerID, _ := newValidPgtypeUUID(t)
+	item := makeGiftItem(ownerID)
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+
+	t.Run("removes image", func(t *testing.T) {
+		imageID, imageStr := newValidPgtypeUUID(t)
+		imageRepo := emptyImageRepo()
+		imageRepo.RemoveFunc = func(ctx context.Context, itemID, id pgtype.UUID) error {
+			return nil
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.RemoveItemImage(context.Background(), item, imageStr)
+
+		require.NoError(t, err)
+		require.Len(t, imageRepo.RemoveCalls(), 1)
+		assert.Equal(t, imageID, imageRepo.RemoveCalls()[0].ImageID)
+	})
+
+	t.Run("unknown image", func(t *testing.T) {
+		imageRepo := emptyImageRepo()
+		imageRepo.RemoveFunc = func(ctx context.Context, itemID, id pgtype.UUID) error {
+			return repository.ErrGiftItemImageNotFound
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.RemoveItemImage(context.Background(), item, uuid.New().String())
+
+		assert.ErrorIs(t, err, ErrItemImageNotFound)
+	})
+
+	t.Run("malformed image id", func(t *testing.T) {
+		imageRepo := emptyImageRepo()
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.RemoveItemImage(context.Background(), item, "not-a-uuid")
+
+		assert.ErrorIs(t, err, ErrItemImageNotFound)
+		assert.Empty(t, imageRepo.RemoveCalls())
+	})
+}
+
+func TestItemService_ReorderItemImages(t *testing.T) {
+	ownerID, _ := newValidPgtypeUUID(t)
+	item := makeGiftItem(ownerID)
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+
+	t.Run("passes the new order", func(t *testing.T) {
+		first, firstStr := newValidPgtypeUUID(t)
+		second, secondStr := newValidPgtypeUUID(t)
+		imageRepo := emptyImageRepo()
+		imageRepo.ReorderFunc = func(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error {
+			return nil
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.ReorderItemImages(context.Background(), item, []string{secondStr, firstStr})
+
+		require.NoError(t, err)
+		require.Len(t, imageRepo.ReorderCalls(), 1)
+		assert.Equal(t, []pgtype.UUID{second, first}, imageRepo.ReorderCalls()[0].ImageIDs)
+	})
+
+	t.Run("incomplete order", func(t *testing.T) {
+		imageRepo := emptyImageRepo()
+		imageRepo.ReorderFunc = func(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error {
+			return repository.ErrGiftItemImageOrderMismatch
+		}
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.ReorderItemImages(context.Background(), item, []string{uuid.New().String()})
+
+		assert.ErrorIs(t, err, ErrItemImageOrderInvalid)
+	})
+
+	t.Run("malformed image id", func(t *testing.T) {
+		imageRepo := emptyImageRepo()
+		svc := NewItemService(itemRepo, imageRepo, &GiftItemPurchaseRepositoryInterfaceMock{}, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.ReorderItemImages(context.Background(), item, []string{"not-a-uuid"})
+
+		assert.ErrorIs(t, err, ErrItemImageOrderInvalid)
+		assert.Empty(t, imageRepo.ReorderCalls())
+	})
+}
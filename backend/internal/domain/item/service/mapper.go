@@ -0,0 +1,12 @@
+package service
+
+import (
+	"wish-list/internal/domain/item/mapper"
+	"wish-list/internal/domain/item/models"
+)
+
+// itemToOutput converts a stored item. Wishlist IDs, the gallery and reserved
+// variants are filled in by callers that load them.
+func itemToOutput(item *models.GiftItem) *ItemOutput {
+	return &ItemOutput{Item: mapper.FromGiftItem(item)}
+}
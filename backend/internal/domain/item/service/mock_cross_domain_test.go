@@ -0,0 +1,843 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"io"
+	"sync"
+	"time"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+)
+
+// Ensure, that ContentModeratorInterfaceMock does implement ContentModeratorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ContentModeratorInterface = &ContentModeratorInterfaceMock{}
+
+// ContentModeratorInterfaceMock is a mock implementation of ContentModeratorInterface.
+//
+//	func TestSomethingThatUsesContentModeratorInterface(t *testing.T) {
+//
+//		// make and configure a mocked ContentModeratorInterface
+//		mockedContentModeratorInterface := &ContentModeratorInterfaceMock{
+//			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+//				panic("mock out the CheckContent method")
+//			},
+//		}
+//
+//		// use mockedContentModeratorInterface in code that requires ContentModeratorInterface
+//		// and then make assertions.
+//
+//	}
+type ContentModeratorInterfaceMock struct {
+	// CheckContentFunc mocks the CheckContent method.
+	CheckContentFunc func(ctx context.Context, imageURL string, texts ...string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckContent holds details about calls to the CheckContent method.
+		CheckContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ImageURL is the imageURL argument value.
+			ImageURL string
+			// Texts is the texts argument value.
+			Texts []string
+		}
+	}
+	lockCheckContent sync.RWMutex
+}
+
+// CheckContent calls CheckContentFunc.
+func (mock *ContentModeratorInterfaceMock) CheckContent(ctx context.Context, imageURL string, texts ...string) error {
+	if mock.CheckContentFunc == nil {
+		panic("ContentModeratorInterfaceMock.CheckContentFunc: method is nil but ContentModeratorInterface.CheckContent was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}{
+		Ctx:      ctx,
+		ImageURL: imageURL,
+		Texts:    texts,
+	}
+	mock.lockCheckContent.Lock()
+	mock.calls.CheckContent = append(mock.calls.CheckContent, callInfo)
+	mock.lockCheckContent.Unlock()
+	return mock.CheckContentFunc(ctx, imageURL, texts...)
+}
+
+// CheckContentCalls gets all the calls that were made to CheckContent.
+// Check the length with:
+//
+//	len(mockedContentModeratorInterface.CheckContentCalls())
+func (mock *ContentModeratorInterfaceMock) CheckContentCalls() []struct {
+	Ctx      context.Context
+	ImageURL string
+	Texts    []string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}
+	mock.lockCheckContent.RLock()
+	calls = mock.calls.CheckContent
+	mock.lockCheckContent.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemEventPublisherInterfaceMock does implement ItemEventPublisherInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemEventPublisherInterface = &ItemEventPublisherInterfaceMock{}
+
+// ItemEventPublisherInterfaceMock is a mock implementation of ItemEventPublisherInterface.
+//
+//	func TestSomethingThatUsesItemEventPublisherInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemEventPublisherInterface
+//		mockedItemEventPublisherInterface := &ItemEventPublisherInterfaceMock{
+//			PublishItemEventFunc: func(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+//				panic("mock out the PublishItemEvent method")
+//			},
+//		}
+//
+//		// use mockedItemEventPublisherInterface in code that requires ItemEventPublisherInterface
+//		// and then make assertions.
+//
+//	}
+type ItemEventPublisherInterfaceMock struct {
+	// PublishItemEventFunc mocks the PublishItemEvent method.
+	PublishItemEventFunc func(ctx context.Context, event string, giftItemID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// PublishItemEvent holds details about calls to the PublishItemEvent method.
+		PublishItemEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event string
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+	}
+	lockPublishItemEvent sync.RWMutex
+}
+
+// PublishItemEvent calls PublishItemEventFunc.
+func (mock *ItemEventPublisherInterfaceMock) PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+	if mock.PublishItemEventFunc == nil {
+		panic("ItemEventPublisherInterfaceMock.PublishItemEventFunc: method is nil but ItemEventPublisherInterface.PublishItemEvent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Event      string
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		Event:      event,
+		GiftItemID: giftItemID,
+	}
+	mock.lockPublishItemEvent.Lock()
+	mock.calls.PublishItemEvent = append(mock.calls.PublishItemEvent, callInfo)
+	mock.lockPublishItemEvent.Unlock()
+	return mock.PublishItemEventFunc(ctx, event, giftItemID)
+}
+
+// PublishItemEventCalls gets all the calls that were made to PublishItemEvent.
+// Check the length with:
+//
+//	len(mockedItemEventPublisherInterface.PublishItemEventCalls())
+func (mock *ItemEventPublisherInterfaceMock) PublishItemEventCalls() []struct {
+	Ctx        context.Context
+	Event      string
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Event      string
+		GiftItemID pgtype.UUID
+	}
+	mock.lockPublishItemEvent.RLock()
+	calls = mock.calls.PublishItemEvent
+	mock.lockPublishItemEvent.RUnlock()
+	return calls
+}
+
+// Ensure, that AttachmentStorageInterfaceMock does implement AttachmentStorageInterface.
+// If this is not the case, regenerate this file with moq.
+var _ AttachmentStorageInterface = &AttachmentStorageInterfaceMock{}
+
+// AttachmentStorageInterfaceMock is a mock implementation of AttachmentStorageInterface.
+//
+//	func TestSomethingThatUsesAttachmentStorageInterface(t *testing.T) {
+//
+//		// make and configure a mocked AttachmentStorageInterface
+//		mockedAttachmentStorageInterface := &AttachmentStorageInterfaceMock{
+//			DeleteFileFunc: func(ctx context.Context, fileKey string) error {
+//				panic("mock out the DeleteFile method")
+//			},
+//			GeneratePresignedURLFunc: func(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+//				panic("mock out the GeneratePresignedURL method")
+//			},
+//			UploadObjectFunc: func(ctx context.Context, key string, body io.Reader, contentType string) error {
+//				panic("mock out the UploadObject method")
+//			},
+//		}
+//
+//		// use mockedAttachmentStorageInterface in code that requires AttachmentStorageInterface
+//		// and then make assertions.
+//
+//	}
+type AttachmentStorageInterfaceMock struct {
+	// DeleteFileFunc mocks the DeleteFile method.
+	DeleteFileFunc func(ctx context.Context, fileKey string) error
+
+	// GeneratePresignedURLFunc mocks the GeneratePresignedURL method.
+	GeneratePresignedURLFunc func(ctx context.Context, fileKey string, duration time.Duration) (string, error)
+
+	// UploadObjectFunc mocks the UploadObject method.
+	UploadObjectFunc func(ctx context.Context, key string, body io.Reader, contentType string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteFile holds details about calls to the DeleteFile method.
+		DeleteFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileKey is the fileKey argument value.
+			FileKey string
+		}
+		// GeneratePresignedURL holds details about calls to the GeneratePresignedURL method.
+		GeneratePresignedURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileKey is the fileKey argument value.
+			FileKey string
+			// Duration is the duration argument value.
+			Duration time.Duration
+		}
+		// UploadObject holds details about calls to the UploadObject method.
+		UploadObject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Body is the body argument value.
+			Body io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+		}
+	}
+	lockDeleteFile           sync.RWMutex
+	lockGeneratePresignedURL sync.RWMutex
+	lockUploadObject         sync.RWMutex
+}
+
+// DeleteFile calls DeleteFileFunc.
+func (mock *AttachmentStorageInterfaceMock) DeleteFile(ctx context.Context, fileKey string) error {
+	if mock.DeleteFileFunc == nil {
+		panic("AttachmentStorageInterfaceMock.DeleteFileFunc: method is nil but AttachmentStorageInterface.DeleteFile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		FileKey string
+	}{
+		Ctx:     ctx,
+		FileKey: fileKey,
+	}
+	mock.lockDeleteFile.Lock()
+	mock.calls.DeleteFile = append(mock.calls.DeleteFile, callInfo)
+	mock.lockDeleteFile.Unlock()
+	return mock.DeleteFileFunc(ctx, fileKey)
+}
+
+// DeleteFileCalls gets all the calls that were made to DeleteFile.
+// Check the length with:
+//
+//	len(mockedAttachmentStorageInterface.DeleteFileCalls())
+func (mock *AttachmentStorageInterfaceMock) DeleteFileCalls() []struct {
+	Ctx     context.Context
+	FileKey string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		FileKey string
+	}
+	mock.lockDeleteFile.RLock()
+	calls = mock.calls.DeleteFile
+	mock.lockDeleteFile.RUnlock()
+	return calls
+}
+
+// GeneratePresignedURL calls GeneratePresignedURLFunc.
+func (mock *AttachmentStorageInterfaceMock) GeneratePresignedURL(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
+	if mock.GeneratePresignedURLFunc == nil {
+		panic("AttachmentStorageInterfaceMock.GeneratePresignedURLFunc: method is nil but AttachmentStorageInterface.GeneratePresignedURL was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FileKey  string
+		Duration time.Duration
+	}{
+		Ctx:      ctx,
+		FileKey:  fileKey,
+		Duration: duration,
+	}
+	mock.lockGeneratePresignedURL.Lock()
+	mock.calls.GeneratePresignedURL = append(mock.calls.GeneratePresignedURL, callInfo)
+	mock.lockGeneratePresignedURL.Unlock()
+	return mock.GeneratePresignedURLFunc(ctx, fileKey, duration)
+}
+
+// GeneratePresignedURLCalls gets all the calls that were made to GeneratePresignedURL.
+// Check the length with:
+//
+//	len(mockedAttachmentStorageInterface.GeneratePresignedURLCalls())
+func (mock *AttachmentStorageInterfaceMock) GeneratePresignedURLCalls() []struct {
+	Ctx      context.Context
+	FileKey  string
+	Duration time.Duration
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FileKey  string
+		Duration time.Duration
+	}
+	mock.lockGeneratePresignedURL.RLock()
+	calls = mock.calls.GeneratePresignedURL
+	mock.lockGeneratePresignedURL.RUnlock()
+	return calls
+}
+
+// UploadObject calls UploadObjectFunc.
+func (mock *AttachmentStorageInterfaceMock) UploadObject(ctx context.Context, key string, body io.Reader, contentType string) error {
+	if mock.UploadObjectFunc == nil {
+		panic("AttachmentStorageInterfaceMock.UploadObjectFunc: method is nil but AttachmentStorageInterface.UploadObject was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Key         string
+		Body        io.Reader
+		ContentType string
+	}{
+		Ctx:         ctx,
+		Key:         key,
+		Body:        body,
+		ContentType: contentType,
+	}
+	mock.lockUploadObject.Lock()
+	mock.calls.UploadObject = append(mock.calls.UploadObject, callInfo)
+	mock.lockUploadObject.Unlock()
+	return mock.UploadObjectFunc(ctx, key, body, contentType)
+}
+
+// UploadObjectCalls gets all the calls that were made to UploadObject.
+// Check the length with:
+//
+//	len(mockedAttachmentStorageInterface.UploadObjectCalls())
+func (mock *AttachmentStorageInterfaceMock) UploadObjectCalls() []struct {
+	Ctx         context.Context
+	Key         string
+	Body        io.Reader
+	ContentType string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Key         string
+		Body        io.Reader
+		ContentType string
+	}
+	mock.lockUploadObject.RLock()
+	calls = mock.calls.UploadObject
+	mock.lockUploadObject.RUnlock()
+	return calls
+}
+
+// Ensure, that PublicWishListRepositoryInterfaceMock does implement PublicWishListRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ PublicWishListRepositoryInterface = &PublicWishListRepositoryInterfaceMock{}
+
+// PublicWishListRepositoryInterfaceMock is a mock implementation of PublicWishListRepositoryInterface.
+//
+//	func TestSomethingThatUsesPublicWishListRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked PublicWishListRepositoryInterface
+//		mockedPublicWishListRepositoryInterface := &PublicWishListRepositoryInterfaceMock{
+//			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+//				panic("mock out the GetByPublicSlug method")
+//			},
+//		}
+//
+//		// use mockedPublicWishListRepositoryInterface in code that requires PublicWishListRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type PublicWishListRepositoryInterfaceMock struct {
+	// GetByPublicSlugFunc mocks the GetByPublicSlug method.
+	GetByPublicSlugFunc func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByPublicSlug holds details about calls to the GetByPublicSlug method.
+		GetByPublicSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+	}
+	lockGetByPublicSlug sync.RWMutex
+}
+
+// GetByPublicSlug calls GetByPublicSlugFunc.
+func (mock *PublicWishListRepositoryInterfaceMock) GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+	if mock.GetByPublicSlugFunc == nil {
+		panic("PublicWishListRepositoryInterfaceMock.GetByPublicSlugFunc: method is nil but PublicWishListRepositoryInterface.GetByPublicSlug was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetByPublicSlug.Lock()
+	mock.calls.GetByPublicSlug = append(mock.calls.GetByPublicSlug, callInfo)
+	mock.lockGetByPublicSlug.Unlock()
+	return mock.GetByPublicSlugFunc(ctx, publicSlug)
+}
+
+// GetByPublicSlugCalls gets all the calls that were made to GetByPublicSlug.
+// Check the length with:
+//
+//	len(mockedPublicWishListRepositoryInterface.GetByPublicSlugCalls())
+func (mock *PublicWishListRepositoryInterfaceMock) GetByPublicSlugCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetByPublicSlug.RLock()
+	calls = mock.calls.GetByPublicSlug
+	mock.lockGetByPublicSlug.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemShareTokenInterfaceMock does implement ItemShareTokenInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemShareTokenInterface = &ItemShareTokenInterfaceMock{}
+
+// ItemShareTokenInterfaceMock is a mock implementation of ItemShareTokenInterface.
+//
+//	func TestSomethingThatUsesItemShareTokenInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemShareTokenInterface
+//		mockedItemShareTokenInterface := &ItemShareTokenInterfaceMock{
+//			GenerateItemShareTokenFunc: func(linkID string) string {
+//				panic("mock out the GenerateItemShareToken method")
+//			},
+//			ValidateItemShareTokenFunc: func(token string) (string, error) {
+//				panic("mock out the ValidateItemShareToken method")
+//			},
+//		}
+//
+//		// use mockedItemShareTokenInterface in code that requires ItemShareTokenInterface
+//		// and then make assertions.
+//
+//	}
+type ItemShareTokenInterfaceMock struct {
+	// GenerateItemShareTokenFunc mocks the GenerateItemShareToken method.
+	GenerateItemShareTokenFunc func(linkID string) string
+
+	// ValidateItemShareTokenFunc mocks the ValidateItemShareToken method.
+	ValidateItemShareTokenFunc func(token string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateItemShareToken holds details about calls to the GenerateItemShareToken method.
+		GenerateItemShareToken []struct {
+			// LinkID is the linkID argument value.
+			LinkID string
+		}
+		// ValidateItemShareToken holds details about calls to the ValidateItemShareToken method.
+		ValidateItemShareToken []struct {
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockGenerateItemShareToken sync.RWMutex
+	lockValidateItemShareToken sync.RWMutex
+}
+
+// GenerateItemShareToken calls GenerateItemShareTokenFunc.
+func (mock *ItemShareTokenInterfaceMock) GenerateItemShareToken(linkID string) string {
+	if mock.GenerateItemShareTokenFunc == nil {
+		panic("ItemShareTokenInterfaceMock.GenerateItemShareTokenFunc: method is nil but ItemShareTokenInterface.GenerateItemShareToken was just called")
+	}
+	callInfo := struct {
+		LinkID string
+	}{
+		LinkID: linkID,
+	}
+	mock.lockGenerateItemShareToken.Lock()
+	mock.calls.GenerateItemShareToken = append(mock.calls.GenerateItemShareToken, callInfo)
+	mock.lockGenerateItemShareToken.Unlock()
+	return mock.GenerateItemShareTokenFunc(linkID)
+}
+
+// GenerateItemShareTokenCalls gets all the calls that were made to GenerateItemShareToken.
+// Check the length with:
+//
+//	len(mockedItemShareTokenInterface.GenerateItemShareTokenCalls())
+func (mock *ItemShareTokenInterfaceMock) GenerateItemShareTokenCalls() []struct {
+	LinkID string
+} {
+	var calls []struct {
+		LinkID string
+	}
+	mock.lockGenerateItemShareToken.RLock()
+	calls = mock.calls.GenerateItemShareToken
+	mock.lockGenerateItemShareToken.RUnlock()
+	return calls
+}
+
+// ValidateItemShareToken calls ValidateItemShareTokenFunc.
+func (mock *ItemShareTokenInterfaceMock) ValidateItemShareToken(token string) (string, error) {
+	if mock.ValidateItemShareTokenFunc == nil {
+		panic("ItemShareTokenInterfaceMock.ValidateItemShareTokenFunc: method is nil but ItemShareTokenInterface.ValidateItemShareToken was just called")
+	}
+	callInfo := struct {
+		Token string
+	}{
+		Token: token,
+	}
+	mock.lockValidateItemShareToken.Lock()
+	mock.calls.ValidateItemShareToken = append(mock.calls.ValidateItemShareToken, callInfo)
+	mock.lockValidateItemShareToken.Unlock()
+	return mock.ValidateItemShareTokenFunc(token)
+}
+
+// ValidateItemShareTokenCalls gets all the calls that were made to ValidateItemShareToken.
+// Check the length with:
+//
+//	len(mockedItemShareTokenInterface.ValidateItemShareTokenCalls())
+func (mock *ItemShareTokenInterfaceMock) ValidateItemShareTokenCalls() []struct {
+	Token string
+} {
+	var calls []struct {
+		Token string
+	}
+	mock.lockValidateItemShareToken.RLock()
+	calls = mock.calls.ValidateItemShareToken
+	mock.lockValidateItemShareToken.RUnlock()
+	return calls
+}
+
+// Ensure, that ReservationEventLogInterfaceMock does implement ReservationEventLogInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ReservationEventLogInterface = &ReservationEventLogInterfaceMock{}
+
+// ReservationEventLogInterfaceMock is a mock implementation of ReservationEventLogInterface.
+//
+//	func TestSomethingThatUsesReservationEventLogInterface(t *testing.T) {
+//
+//		// make and configure a mocked ReservationEventLogInterface
+//		mockedReservationEventLogInterface := &ReservationEventLogInterfaceMock{
+//			AppendFunc: func(ctx context.Context, event reservationmodels.ReservationEvent) error {
+//				panic("mock out the Append method")
+//			},
+//		}
+//
+//		// use mockedReservationEventLogInterface in code that requires ReservationEventLogInterface
+//		// and then make assertions.
+//
+//	}
+type ReservationEventLogInterfaceMock struct {
+	// AppendFunc mocks the Append method.
+	AppendFunc func(ctx context.Context, event reservationmodels.ReservationEvent) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Append holds details about calls to the Append method.
+		Append []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event reservationmodels.ReservationEvent
+		}
+	}
+	lockAppend sync.RWMutex
+}
+
+// Append calls AppendFunc.
+func (mock *ReservationEventLogInterfaceMock) Append(ctx context.Context, event reservationmodels.ReservationEvent) error {
+	if mock.AppendFunc == nil {
+		panic("ReservationEventLogInterfaceMock.AppendFunc: method is nil but ReservationEventLogInterface.Append was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event reservationmodels.ReservationEvent
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockAppend.Lock()
+	mock.calls.Append = append(mock.calls.Append, callInfo)
+	mock.lockAppend.Unlock()
+	return mock.AppendFunc(ctx, event)
+}
+
+// AppendCalls gets all the calls that were made to Append.
+// Check the length with:
+//
+//	len(mockedReservationEventLogInterface.AppendCalls())
+func (mock *ReservationEventLogInterfaceMock) AppendCalls() []struct {
+	Ctx   context.Context
+	Event reservationmodels.ReservationEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event reservationmodels.ReservationEvent
+	}
+	mock.lockAppend.RLock()
+	calls = mock.calls.Append
+	mock.lockAppend.RUnlock()
+	return calls
+}
+
+// Ensure, that ReservationHolderNotifierInterfaceMock does implement ReservationHolderNotifierInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ReservationHolderNotifierInterface = &ReservationHolderNotifierInterfaceMock{}
+
+// ReservationHolderNotifierInterfaceMock is a mock implementation of ReservationHolderNotifierInterface.
+//
+//	func TestSomethingThatUsesReservationHolderNotifierInterface(t *testing.T) {
+//
+//		// make and configure a mocked ReservationHolderNotifierInterface
+//		mockedReservationHolderNotifierInterface := &ReservationHolderNotifierInterfaceMock{
+//			NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error {
+//				panic("mock out the Notify method")
+//			},
+//		}
+//
+//		// use mockedReservationHolderNotifierInterface in code that requires ReservationHolderNotifierInterface
+//		// and then make assertions.
+//
+//	}
+type ReservationHolderNotifierInterfaceMock struct {
+	// NotifyFunc mocks the Notify method.
+	NotifyFunc func(ctx context.Context, notification notificationmodels.Notification) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Notify holds details about calls to the Notify method.
+		Notify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Notification is the notification argument value.
+			Notification notificationmodels.Notification
+		}
+	}
+	lockNotify sync.RWMutex
+}
+
+// Notify calls NotifyFunc.
+func (mock *ReservationHolderNotifierInterfaceMock) Notify(ctx context.Context, notification notificationmodels.Notification) error {
+	if mock.NotifyFunc == nil {
+		panic("ReservationHolderNotifierInterfaceMock.NotifyFunc: method is nil but ReservationHolderNotifierInterface.Notify was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}{
+		Ctx:          ctx,
+		Notification: notification,
+	}
+	mock.lockNotify.Lock()
+	mock.calls.Notify = append(mock.calls.Notify, callInfo)
+	mock.lockNotify.Unlock()
+	return mock.NotifyFunc(ctx, notification)
+}
+
+// NotifyCalls gets all the calls that were made to Notify.
+// Check the length with:
+//
+//	len(mockedReservationHolderNotifierInterface.NotifyCalls())
+func (mock *ReservationHolderNotifierInterfaceMock) NotifyCalls() []struct {
+	Ctx          context.Context
+	Notification notificationmodels.Notification
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}
+	mock.lockNotify.RLock()
+	calls = mock.calls.Notify
+	mock.lockNotify.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemReceivedEmailSenderInterfaceMock does implement ItemReceivedEmailSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemReceivedEmailSenderInterface = &ItemReceivedEmailSenderInterfaceMock{}
+
+// ItemReceivedEmailSenderInterfaceMock is a mock implementation of ItemReceivedEmailSenderInterface.
+//
+//	func TestSomethingThatUsesItemReceivedEmailSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemReceivedEmailSenderInterface
+//		mockedItemReceivedEmailSenderInterface := &ItemReceivedEmailSenderInterfaceMock{
+//			SendItemReceivedEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string) error {
+//				panic("mock out the SendItemReceivedEmail method")
+//			},
+//		}
+//
+//		// use mockedItemReceivedEmailSenderInterface in code that requires ItemReceivedEmailSenderInterface
+//		// and then make assertions.
+//
+//	}
+type ItemReceivedEmailSenderInterfaceMock struct {
+	// SendItemReceivedEmailFunc mocks the SendItemReceivedEmail method.
+	SendItemReceivedEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendItemReceivedEmail holds details about calls to the SendItemReceivedEmail method.
+		SendItemReceivedEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// GiftItemName is the giftItemName argument value.
+			GiftItemName string
+			// WishlistTitle is the wishlistTitle argument value.
+			WishlistTitle string
+		}
+	}
+	lockSendItemReceivedEmail sync.RWMutex
+}
+
+// SendItemReceivedEmail calls SendItemReceivedEmailFunc.
+func (mock *ItemReceivedEmailSenderInterfaceMock) SendItemReceivedEmail(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string) error {
+	if mock.SendItemReceivedEmailFunc == nil {
+		panic("ItemReceivedEmailSenderInterfaceMock.SendItemReceivedEmailFunc: method is nil but ItemReceivedEmailSenderInterface.SendItemReceivedEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		GiftItemName   string
+		WishlistTitle  string
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		GiftItemName:   giftItemName,
+		WishlistTitle:  wishlistTitle,
+	}
+	mock.lockSendItemReceivedEmail.Lock()
+	mock.calls.SendItemReceivedEmail = append(mock.calls.SendItemReceivedEmail, callInfo)
+	mock.lockSendItemReceivedEmail.Unlock()
+	return mock.SendItemReceivedEmailFunc(ctx, recipientEmail, giftItemName, wishlistTitle)
+}
+
+// SendItemReceivedEmailCalls gets all the calls that were made to SendItemReceivedEmail.
+// Check the length with:
+//
+//	len(mockedItemReceivedEmailSenderInterface.SendItemReceivedEmailCalls())
+func (mock *ItemReceivedEmailSenderInterfaceMock) SendItemReceivedEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	GiftItemName   string
+	WishlistTitle  string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		GiftItemName   string
+		WishlistTitle  string
+	}
+	mock.lockSendItemReceivedEmail.RLock()
+	calls = mock.calls.SendItemReceivedEmail
+	mock.lockSendItemReceivedEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestEmailDecrypterInterfaceMock does implement GuestEmailDecrypterInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestEmailDecrypterInterface = &GuestEmailDecrypterInterfaceMock{}
+
+// GuestEmailDecrypterInterfaceMock is a mock implementation of GuestEmailDecrypterInterface.
+//
+//	func TestSomethingThatUsesGuestEmailDecrypterInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestEmailDecrypterInterface
+//		mockedGuestEmailDecrypterInterface := &GuestEmailDecrypterInterfaceMock{
+//			DecryptFunc: func(ctx context.Context, ciphertext string) (string, error) {
+//				panic("mock out the Decrypt method")
+//			},
+//		}
+//
+//		// use mockedGuestEmailDecrypterInterface in code that requires GuestEmailDecrypterInterface
+//		// and then make assertions.
+//
+//	}
+type GuestEmailDecrypterInterfaceMock struct {
+	// DecryptFunc mocks the Decrypt method.
+	DecryptFunc func(ctx context.Context, ciphertext string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Decrypt holds details about calls to the Decrypt method.
+		Decrypt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ciphertext is the ciphertext argument value.
+			Ciphertext string
+		}
+	}
+	lockDecrypt sync.RWMutex
+}
+
+// Decrypt calls DecryptFunc.
+func (mock *GuestEmailDecrypterInterfaceMock) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	if mock.DecryptFunc == nil {
+		panic("GuestEmailDecrypterInterfaceMock.DecryptFunc: method is nil but GuestEmailDecrypterInterface.Decrypt was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Ciphertext string
+	}{
+		Ctx:        ctx,
+		Ciphertext: ciphertext,
+	}
+	mock.lockDecrypt.Lock()
+	mock.calls.Decrypt = append(mock.calls.Decrypt, callInfo)
+	mock.lockDecrypt.Unlock()
+	return mock.DecryptFunc(ctx, ciphertext)
+}
+
+// DecryptCalls gets all the calls that were made to Decrypt.
+// Check the length with:
+//
+//	len(mockedGuestEmailDecrypterInterface.DecryptCalls())
+func (mock *GuestEmailDecrypterInterfaceMock) DecryptCalls() []struct {
+	Ctx        context.Context
+	Ciphertext string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Ciphertext string
+	}
+	mock.lockDecrypt.RLock()
+	calls = mock.calls.Decrypt
+	mock.lockDecrypt.RUnlock()
+	return calls
+}
@@ -0,0 +1,196 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+)
+
+// Ensure, that GiftItemAttachmentRepositoryInterfaceMock does implement repository.GiftItemAttachmentRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GiftItemAttachmentRepositoryInterface = &GiftItemAttachmentRepositoryInterfaceMock{}
+
+// GiftItemAttachmentRepositoryInterfaceMock is a mock implementation of repository.GiftItemAttachmentRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftItemAttachmentRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GiftItemAttachmentRepositoryInterface
+//		mockedGiftItemAttachmentRepositoryInterface := &GiftItemAttachmentRepositoryInterfaceMock{
+//			AddFunc: func(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error) {
+//				panic("mock out the Add method")
+//			},
+//			ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+//				panic("mock out the ListByItem method")
+//			},
+//			RemoveFunc: func(ctx context.Context, itemID pgtype.UUID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+//				panic("mock out the Remove method")
+//			},
+//		}
+//
+//		// use mockedGiftItemAttachmentRepositoryInterface in code that requires repository.GiftItemAttachmentRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemAttachmentRepositoryInterfaceMock struct {
+	// AddFunc mocks the Add method.
+	AddFunc func(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error)
+
+	// ListByItemFunc mocks the ListByItem method.
+	ListByItemFunc func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error)
+
+	// RemoveFunc mocks the Remove method.
+	RemoveFunc func(ctx context.Context, itemID pgtype.UUID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Attachment is the attachment argument value.
+			Attachment models.GiftItemAttachment
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListByItem holds details about calls to the ListByItem method.
+		ListByItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+		}
+		// Remove holds details about calls to the Remove method.
+		Remove []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// AttachmentID is the attachmentID argument value.
+			AttachmentID pgtype.UUID
+		}
+	}
+	lockAdd        sync.RWMutex
+	lockListByItem sync.RWMutex
+	lockRemove     sync.RWMutex
+}
+
+// Add calls AddFunc.
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) Add(ctx context.Context, attachment models.GiftItemAttachment, limit int) (*models.GiftItemAttachment, error) {
+	if mock.AddFunc == nil {
+		panic("GiftItemAttachmentRepositoryInterfaceMock.AddFunc: method is nil but GiftItemAttachmentRepositoryInterface.Add was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Attachment models.GiftItemAttachment
+		Limit      int
+	}{
+		Ctx:        ctx,
+		Attachment: attachment,
+		Limit:      limit,
+	}
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(ctx, attachment, limit)
+}
+
+// AddCalls gets all the calls that were made to Add.
+// Check the length with:
+//
+//	len(mockedGiftItemAttachmentRepositoryInterface.AddCalls())
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) AddCalls() []struct {
+	Ctx        context.Context
+	Attachment models.GiftItemAttachment
+	Limit      int
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Attachment models.GiftItemAttachment
+		Limit      int
+	}
+	mock.lockAdd.RLock()
+	calls = mock.calls.Add
+	mock.lockAdd.RUnlock()
+	return calls
+}
+
+// ListByItem calls ListByItemFunc.
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemAttachment, error) {
+	if mock.ListByItemFunc == nil {
+		panic("GiftItemAttachmentRepositoryInterfaceMock.ListByItemFunc: method is nil but GiftItemAttachmentRepositoryInterface.ListByItem was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+	}
+	mock.lockListByItem.Lock()
+	mock.calls.ListByItem = append(mock.calls.ListByItem, callInfo)
+	mock.lockListByItem.Unlock()
+	return mock.ListByItemFunc(ctx, itemID)
+}
+
+// ListByItemCalls gets all the calls that were made to ListByItem.
+// Check the length with:
+//
+//	len(mockedGiftItemAttachmentRepositoryInterface.ListByItemCalls())
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) ListByItemCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}
+	mock.lockListByItem.RLock()
+	calls = mock.calls.ListByItem
+	mock.lockListByItem.RUnlock()
+	return calls
+}
+
+// Remove calls RemoveFunc.
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) Remove(ctx context.Context, itemID pgtype.UUID, attachmentID pgtype.UUID) (*models.GiftItemAttachment, error) {
+	if mock.RemoveFunc == nil {
+		panic("GiftItemAttachmentRepositoryInterfaceMock.RemoveFunc: method is nil but GiftItemAttachmentRepositoryInterface.Remove was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ItemID       pgtype.UUID
+		AttachmentID pgtype.UUID
+	}{
+		Ctx:          ctx,
+		ItemID:       itemID,
+		AttachmentID: attachmentID,
+	}
+	mock.lockRemove.Lock()
+	mock.calls.Remove = append(mock.calls.Remove, callInfo)
+	mock.lockRemove.Unlock()
+	return mock.RemoveFunc(ctx, itemID, attachmentID)
+}
+
+// RemoveCalls gets all the calls that were made to Remove.
+// Check the length with:
+//
+//	len(mockedGiftItemAttachmentRepositoryInterface.RemoveCalls())
+func (mock *GiftItemAttachmentRepositoryInterfaceMock) RemoveCalls() []struct {
+	Ctx          context.Context
+	ItemID       pgtype.UUID
+	AttachmentID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ItemID       pgtype.UUID
+		AttachmentID pgtype.UUID
+	}
+	mock.lockRemove.RLock()
+	calls = mock.calls.Remove
+	mock.lockRemove.RUnlock()
+	return calls
+}
@@ -0,0 +1,314 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+)
+
+// Ensure, that GiftItemImageRepositoryInterfaceMock does implement repository.GiftItemImageRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GiftItemImageRepositoryInterface = &GiftItemImageRepositoryInterfaceMock{}
+
+// GiftItemImageRepositoryInterfaceMock is a mock implementation of repository.GiftItemImageRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftItemImageRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GiftItemImageRepositoryInterface
+//		mockedGiftItemImageRepositoryInterface := &GiftItemImageRepositoryInterfaceMock{
+//			AddFunc: func(ctx context.Context, itemID pgtype.UUID, url string, limit int) (*models.GiftItemImage, error) {
+//				panic("mock out the Add method")
+//			},
+//			GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+//				panic("mock out the GetByItemIDs method")
+//			},
+//			RemoveFunc: func(ctx context.Context, itemID pgtype.UUID, imageID pgtype.UUID) error {
+//				panic("mock out the Remove method")
+//			},
+//			ReorderFunc: func(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error {
+//				panic("mock out the Reorder method")
+//			},
+//			ReplacePrimaryFunc: func(ctx context.Context, itemID pgtype.UUID, url string) error {
+//				panic("mock out the ReplacePrimary method")
+//			},
+//		}
+//
+//		// use mockedGiftItemImageRepositoryInterface in code that requires repository.GiftItemImageRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemImageRepositoryInterfaceMock struct {
+	// AddFunc mocks the Add method.
+	AddFunc func(ctx context.Context, itemID pgtype.UUID, url string, limit int) (*models.GiftItemImage, error)
+
+	// GetByItemIDsFunc mocks the GetByItemIDs method.
+	GetByItemIDsFunc func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error)
+
+	// RemoveFunc mocks the Remove method.
+	RemoveFunc func(ctx context.Context, itemID pgtype.UUID, imageID pgtype.UUID) error
+
+	// ReorderFunc mocks the Reorder method.
+	ReorderFunc func(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error
+
+	// ReplacePrimaryFunc mocks the ReplacePrimary method.
+	ReplacePrimaryFunc func(ctx context.Context, itemID pgtype.UUID, url string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// URL is the url argument value.
+			URL string
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// GetByItemIDs holds details about calls to the GetByItemIDs method.
+		GetByItemIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemIDs is the itemIDs argument value.
+			ItemIDs []pgtype.UUID
+		}
+		// Remove holds details about calls to the Remove method.
+		Remove []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// ImageID is the imageID argument value.
+			ImageID pgtype.UUID
+		}
+		// Reorder holds details about calls to the Reorder method.
+		Reorder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// ImageIDs is the imageIDs argument value.
+			ImageIDs []pgtype.UUID
+		}
+		// ReplacePrimary holds details about calls to the ReplacePrimary method.
+		ReplacePrimary []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// URL is the url argument value.
+			URL string
+		}
+	}
+	lockAdd            sync.RWMutex
+	lockGetByItemIDs   sync.RWMutex
+	lockRemove         sync.RWMutex
+	lockReorder        sync.RWMutex
+	lockReplacePrimary sync.RWMutex
+}
+
+// Add calls AddFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) Add(ctx context.Context, itemID pgtype.UUID, url string, limit int) (*models.GiftItemImage, error) {
+	if mock.AddFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.AddFunc: method is nil but GiftItemImageRepositoryInterface.Add was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		URL    string
+		Limit  int
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+		URL:    url,
+		Limit:  limit,
+	}
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(ctx, itemID, url, limit)
+}
+
+// AddCalls gets all the calls that were made to Add.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.AddCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) AddCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+	URL    string
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		URL    string
+		Limit  int
+	}
+	mock.lockAdd.RLock()
+	calls = mock.calls.Add
+	mock.lockAdd.RUnlock()
+	return calls
+}
+
+// GetByItemIDs calls GetByItemIDsFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) GetByItemIDs(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+	if mock.GetByItemIDsFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.GetByItemIDsFunc: method is nil but GiftItemImageRepositoryInterface.GetByItemIDs was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ItemIDs: itemIDs,
+	}
+	mock.lockGetByItemIDs.Lock()
+	mock.calls.GetByItemIDs = append(mock.calls.GetByItemIDs, callInfo)
+	mock.lockGetByItemIDs.Unlock()
+	return mock.GetByItemIDsFunc(ctx, itemIDs)
+}
+
+// GetByItemIDsCalls gets all the calls that were made to GetByItemIDs.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.GetByItemIDsCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) GetByItemIDsCalls() []struct {
+	Ctx     context.Context
+	ItemIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}
+	mock.lockGetByItemIDs.RLock()
+	calls = mock.calls.GetByItemIDs
+	mock.lockGetByItemIDs.RUnlock()
+	return calls
+}
+
+// Remove calls RemoveFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) Remove(ctx context.Context, itemID pgtype.UUID, imageID pgtype.UUID) error {
+	if mock.RemoveFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.RemoveFunc: method is nil but GiftItemImageRepositoryInterface.Remove was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ItemID  pgtype.UUID
+		ImageID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ItemID:  itemID,
+		ImageID: imageID,
+	}
+	mock.lockRemove.Lock()
+	mock.calls.Remove = append(mock.calls.Remove, callInfo)
+	mock.lockRemove.Unlock()
+	return mock.RemoveFunc(ctx, itemID, imageID)
+}
+
+// RemoveCalls gets all the calls that were made to Remove.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.RemoveCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) RemoveCalls() []struct {
+	Ctx     context.Context
+	ItemID  pgtype.UUID
+	ImageID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ItemID  pgtype.UUID
+		ImageID pgtype.UUID
+	}
+	mock.lockRemove.RLock()
+	calls = mock.calls.Remove
+	mock.lockRemove.RUnlock()
+	return calls
+}
+
+// Reorder calls ReorderFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) Reorder(ctx context.Context, itemID pgtype.UUID, imageIDs []pgtype.UUID) error {
+	if mock.ReorderFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.ReorderFunc: method is nil but GiftItemImageRepositoryInterface.Reorder was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ItemID   pgtype.UUID
+		ImageIDs []pgtype.UUID
+	}{
+		Ctx:      ctx,
+		ItemID:   itemID,
+		ImageIDs: imageIDs,
+	}
+	mock.lockReorder.Lock()
+	mock.calls.Reorder = append(mock.calls.Reorder, callInfo)
+	mock.lockReorder.Unlock()
+	return mock.ReorderFunc(ctx, itemID, imageIDs)
+}
+
+// ReorderCalls gets all the calls that were made to Reorder.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.ReorderCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) ReorderCalls() []struct {
+	Ctx      context.Context
+	ItemID   pgtype.UUID
+	ImageIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ItemID   pgtype.UUID
+		ImageIDs []pgtype.UUID
+	}
+	mock.lockReorder.RLock()
+	calls = mock.calls.Reorder
+	mock.lockReorder.RUnlock()
+	return calls
+}
+
+// ReplacePrimary calls ReplacePrimaryFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) ReplacePrimary(ctx context.Context, itemID pgtype.UUID, url string) error {
+	if mock.ReplacePrimaryFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.ReplacePrimaryFunc: method is nil but GiftItemImageRepositoryInterface.ReplacePrimary was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		URL    string
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+		URL:    url,
+	}
+	mock.lockReplacePrimary.Lock()
+	mock.calls.ReplacePrimary = append(mock.calls.ReplacePrimary, callInfo)
+	mock.lockReplacePrimary.Unlock()
+	return mock.ReplacePrimaryFunc(ctx, itemID, url)
+}
+
+// ReplacePrimaryCalls gets all the calls that were made to ReplacePrimary.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.ReplacePrimaryCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) ReplacePrimaryCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+	URL    string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		URL    string
+	}
+	mock.lockReplacePrimary.RLock()
+	calls = mock.calls.ReplacePrimary
+	mock.lockReplacePrimary.RUnlock()
+	return calls
+}
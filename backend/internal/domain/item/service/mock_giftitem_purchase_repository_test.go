@@ -21,9 +21,15 @@ var _ repository.GiftItemPurchaseRepositoryInterface = &GiftItemPurchaseReposito
 //
 //		// make and configure a mocked repository.GiftItemPurchaseRepositoryInterface
 //		mockedGiftItemPurchaseRepositoryInterface := &GiftItemPurchaseRepositoryInterfaceMock{
+//			GetProofFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error) {
+//				panic("mock out the GetProof method")
+//			},
 //			MarkAsPurchasedFunc: func(ctx context.Context, giftItemID pgtype.UUID, userID pgtype.UUID, purchasedPrice pgtype.Numeric) (*models.GiftItem, error) {
 //				panic("mock out the MarkAsPurchased method")
 //			},
+//			SaveProofFunc: func(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error) {
+//				panic("mock out the SaveProof method")
+//			},
 //		}
 //
 //		// use mockedGiftItemPurchaseRepositoryInterface in code that requires repository.GiftItemPurchaseRepositoryInterface
@@ -31,11 +37,24 @@ var _ repository.GiftItemPurchaseRepositoryInterface = &GiftItemPurchaseReposito
 //
 //	}
 type GiftItemPurchaseRepositoryInterfaceMock struct {
+	// GetProofFunc mocks the GetProof method.
+	GetProofFunc func(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error)
+
 	// MarkAsPurchasedFunc mocks the MarkAsPurchased method.
 	MarkAsPurchasedFunc func(ctx context.Context, giftItemID pgtype.UUID, userID pgtype.UUID, purchasedPrice pgtype.Numeric) (*models.GiftItem, error)
 
+	// SaveProofFunc mocks the SaveProof method.
+	SaveProofFunc func(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetProof holds details about calls to the GetProof method.
+		GetProof []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
 		// MarkAsPurchased holds details about calls to the MarkAsPurchased method.
 		MarkAsPurchased []struct {
 			// Ctx is the ctx argument value.
@@ -47,8 +66,53 @@ type GiftItemPurchaseRepositoryInterfaceMock struct {
 			// PurchasedPrice is the purchasedPrice argument value.
 			PurchasedPrice pgtype.Numeric
 		}
+		// SaveProof holds details about calls to the SaveProof method.
+		SaveProof []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Proof is the proof argument value.
+			Proof models.PurchaseProof
+		}
 	}
+	lockGetProof        sync.RWMutex
 	lockMarkAsPurchased sync.RWMutex
+	lockSaveProof       sync.RWMutex
+}
+
+// GetProof calls GetProofFunc.
+func (mock *GiftItemPurchaseRepositoryInterfaceMock) GetProof(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error) {
+	if mock.GetProofFunc == nil {
+		panic("GiftItemPurchaseRepositoryInterfaceMock.GetProofFunc: method is nil but GiftItemPurchaseRepositoryInterface.GetProof was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+	}
+	mock.lockGetProof.Lock()
+	mock.calls.GetProof = append(mock.calls.GetProof, callInfo)
+	mock.lockGetProof.Unlock()
+	return mock.GetProofFunc(ctx, giftItemID)
+}
+
+// GetProofCalls gets all the calls that were made to GetProof.
+// Check the length with:
+//
+//	len(mockedGiftItemPurchaseRepositoryInterface.GetProofCalls())
+func (mock *GiftItemPurchaseRepositoryInterfaceMock) GetProofCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}
+	mock.lockGetProof.RLock()
+	calls = mock.calls.GetProof
+	mock.lockGetProof.RUnlock()
+	return calls
 }
 
 // MarkAsPurchased calls MarkAsPurchasedFunc.
@@ -94,3 +158,39 @@ func (mock *GiftItemPurchaseRepositoryInterfaceMock) MarkAsPurchasedCalls() []st
 	mock.lockMarkAsPurchased.RUnlock()
 	return calls
 }
+
+// SaveProof calls SaveProofFunc.
+func (mock *GiftItemPurchaseRepositoryInterfaceMock) SaveProof(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error) {
+	if mock.SaveProofFunc == nil {
+		panic("GiftItemPurchaseRepositoryInterfaceMock.SaveProofFunc: method is nil but GiftItemPurchaseRepositoryInterface.SaveProof was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Proof models.PurchaseProof
+	}{
+		Ctx:   ctx,
+		Proof: proof,
+	}
+	mock.lockSaveProof.Lock()
+	mock.calls.SaveProof = append(mock.calls.SaveProof, callInfo)
+	mock.lockSaveProof.Unlock()
+	return mock.SaveProofFunc(ctx, proof)
+}
+
+// SaveProofCalls gets all the calls that were made to SaveProof.
+// Check the length with:
+//
+//	len(mockedGiftItemPurchaseRepositoryInterface.SaveProofCalls())
+func (mock *GiftItemPurchaseRepositoryInterfaceMock) SaveProofCalls() []struct {
+	Ctx   context.Context
+	Proof models.PurchaseProof
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Proof models.PurchaseProof
+	}
+	mock.lockSaveProof.RLock()
+	calls = mock.calls.SaveProof
+	mock.lockSaveProof.RUnlock()
+	return calls
+}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"github.com/jackc/pgx/v5/pgtype"
 	"sync"
+	"time"
 	"wish-list/internal/app/database"
 	"wish-list/internal/domain/item/models"
 	"wish-list/internal/domain/item/repository"
+	"wish-list/internal/pkg/variant"
 )
 
 // Ensure, that GiftItemRepositoryInterfaceMock does implement repository.GiftItemRepositoryInterface.
@@ -40,21 +42,39 @@ var _ repository.GiftItemRepositoryInterface = &GiftItemRepositoryInterfaceMock{
 //			GetByWishListFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.GiftItem, error) {
 //				panic("mock out the GetByWishList method")
 //			},
+//			GetPublicWishListGiftItemGroupsFunc: func(ctx context.Context, publicSlug string, groupBy string) ([]*models.GiftItemGroup, error) {
+//				panic("mock out the GetPublicWishListGiftItemGroups method")
+//			},
 //			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*models.GiftItem, error) {
 //				panic("mock out the GetPublicWishListGiftItems method")
 //			},
 //			GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit int, offset int) ([]*models.GiftItem, int, error) {
 //				panic("mock out the GetPublicWishListGiftItemsPaginated method")
 //			},
+//			GetReservedVariantsFunc: func(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error) {
+//				panic("mock out the GetReservedVariants method")
+//			},
 //			GetUnattachedFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.GiftItem, error) {
 //				panic("mock out the GetUnattached method")
 //			},
+//			ListDueForAvailabilityCheckFunc: func(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.GiftItem, error) {
+//				panic("mock out the ListDueForAvailabilityCheck method")
+//			},
+//			MarkManualReservationFunc: func(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*models.GiftItem, error) {
+//				panic("mock out the MarkManualReservation method")
+//			},
+//			PurgeArchivedBeforeFunc: func(ctx context.Context, before time.Time) (int64, error) {
+//				panic("mock out the PurgeArchivedBefore method")
+//			},
 //			SoftDeleteFunc: func(ctx context.Context, id pgtype.UUID) error {
 //				panic("mock out the SoftDelete method")
 //			},
 //			UpdateFunc: func(ctx context.Context, giftItem models.GiftItem) (*models.GiftItem, error) {
 //				panic("mock out the Update method")
 //			},
+//			UpdateAvailabilityFunc: func(ctx context.Context, id pgtype.UUID, status string) error {
+//				panic("mock out the UpdateAvailability method")
+//			},
 //			UpdateWithNewSchemaFunc: func(ctx context.Context, giftItem *models.GiftItem) (*models.GiftItem, error) {
 //				panic("mock out the UpdateWithNewSchema method")
 //			},
@@ -83,27 +103,42 @@ type GiftItemRepositoryInterfaceMock struct {
 	// GetByWishListFunc mocks the GetByWishList method.
 	GetByWishListFunc func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.GiftItem, error)
 
+	// GetPublicWishListGiftItemGroupsFunc mocks the GetPublicWishListGiftItemGroups method.
+	GetPublicWishListGiftItemGroupsFunc func(ctx context.Context, publicSlug string, groupBy string) ([]*models.GiftItemGroup, error)
+
 	// GetPublicWishListGiftItemsFunc mocks the GetPublicWishListGiftItems method.
 	GetPublicWishListGiftItemsFunc func(ctx context.Context, publicSlug string) ([]*models.GiftItem, error)
 
 	// GetPublicWishListGiftItemsPaginatedFunc mocks the GetPublicWishListGiftItemsPaginated method.
 	GetPublicWishListGiftItemsPaginatedFunc func(ctx context.Context, publicSlug string, limit int, offset int) ([]*models.GiftItem, int, error)
 
+	// GetReservedVariantsFunc mocks the GetReservedVariants method.
+	GetReservedVariantsFunc func(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error)
+
 	// GetUnattachedFunc mocks the GetUnattached method.
 	GetUnattachedFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.GiftItem, error)
 
+	// ListDueForAvailabilityCheckFunc mocks the ListDueForAvailabilityCheck method.
+	ListDueForAvailabilityCheckFunc func(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.GiftItem, error)
+
+	// MarkManualReservationFunc mocks the MarkManualReservation method.
+	MarkManualReservationFunc func(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*models.GiftItem, error)
+
+	// PurgeArchivedBeforeFunc mocks the PurgeArchivedBefore method.
+	PurgeArchivedBeforeFunc func(ctx context.Context, before time.Time) (int64, error)
+
 	// SoftDeleteFunc mocks the SoftDelete method.
 	SoftDeleteFunc func(ctx context.Context, id pgtype.UUID) error
 
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, giftItem models.GiftItem) (*models.GiftItem, error)
 
+	// UpdateAvailabilityFunc mocks the UpdateAvailability method.
+	UpdateAvailabilityFunc func(ctx context.Context, id pgtype.UUID, status string) error
+
 	// UpdateWithNewSchemaFunc mocks the UpdateWithNewSchema method.
 	UpdateWithNewSchemaFunc func(ctx context.Context, giftItem *models.GiftItem) (*models.GiftItem, error)
 
-	// MarkManualReservationFunc mocks the MarkManualReservation method.
-	MarkManualReservationFunc func(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*models.GiftItem, error)
-
 	// calls tracks calls to the methods.
 	calls struct {
 		// CreateWithOwner holds details about calls to the CreateWithOwner method.
@@ -152,6 +187,15 @@ type GiftItemRepositoryInterfaceMock struct {
 			// WishlistID is the wishlistID argument value.
 			WishlistID pgtype.UUID
 		}
+		// GetPublicWishListGiftItemGroups holds details about calls to the GetPublicWishListGiftItemGroups method.
+		GetPublicWishListGiftItemGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+			// GroupBy is the groupBy argument value.
+			GroupBy string
+		}
 		// GetPublicWishListGiftItems holds details about calls to the GetPublicWishListGiftItems method.
 		GetPublicWishListGiftItems []struct {
 			// Ctx is the ctx argument value.
@@ -170,6 +214,13 @@ type GiftItemRepositoryInterfaceMock struct {
 			// Offset is the offset argument value.
 			Offset int
 		}
+		// GetReservedVariants holds details about calls to the GetReservedVariants method.
+		GetReservedVariants []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
 		// GetUnattached holds details about calls to the GetUnattached method.
 		GetUnattached []struct {
 			// Ctx is the ctx argument value.
@@ -177,6 +228,33 @@ type GiftItemRepositoryInterfaceMock struct {
 			// OwnerID is the ownerID argument value.
 			OwnerID pgtype.UUID
 		}
+		// ListDueForAvailabilityCheck holds details about calls to the ListDueForAvailabilityCheck method.
+		ListDueForAvailabilityCheck []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CheckedBefore is the checkedBefore argument value.
+			CheckedBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// MarkManualReservation holds details about calls to the MarkManualReservation method.
+		MarkManualReservation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// ReservedByName is the reservedByName argument value.
+			ReservedByName string
+			// Note is the note argument value.
+			Note *string
+		}
+		// PurgeArchivedBefore holds details about calls to the PurgeArchivedBefore method.
+		PurgeArchivedBefore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
 		// SoftDelete holds details about calls to the SoftDelete method.
 		SoftDelete []struct {
 			// Ctx is the ctx argument value.
@@ -191,6 +269,15 @@ type GiftItemRepositoryInterfaceMock struct {
 			// GiftItem is the giftItem argument value.
 			GiftItem models.GiftItem
 		}
+		// UpdateAvailability holds details about calls to the UpdateAvailability method.
+		UpdateAvailability []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Status is the status argument value.
+			Status string
+		}
 		// UpdateWithNewSchema holds details about calls to the UpdateWithNewSchema method.
 		UpdateWithNewSchema []struct {
 			// Ctx is the ctx argument value.
@@ -198,17 +285,6 @@ type GiftItemRepositoryInterfaceMock struct {
 			// GiftItem is the giftItem argument value.
 			GiftItem *models.GiftItem
 		}
-		// MarkManualReservation holds details about calls to the MarkManualReservation method.
-		MarkManualReservation []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-			// ItemID is the itemID argument value.
-			ItemID pgtype.UUID
-			// ReservedByName is the reservedByName argument value.
-			ReservedByName string
-			// Note is the note argument value.
-			Note *string
-		}
 	}
 	lockCreateWithOwner                     sync.RWMutex
 	lockDelete                              sync.RWMutex
@@ -216,13 +292,18 @@ type GiftItemRepositoryInterfaceMock struct {
 	lockGetByID                             sync.RWMutex
 	lockGetByOwnerPaginated                 sync.RWMutex
 	lockGetByWishList                       sync.RWMutex
+	lockGetPublicWishListGiftItemGroups     sync.RWMutex
 	lockGetPublicWishListGiftItems          sync.RWMutex
 	lockGetPublicWishListGiftItemsPaginated sync.RWMutex
+	lockGetReservedVariants                 sync.RWMutex
 	lockGetUnattached                       sync.RWMutex
+	lockListDueForAvailabilityCheck         sync.RWMutex
+	lockMarkManualReservation               sync.RWMutex
+	lockPurgeArchivedBefore                 sync.RWMutex
 	lockSoftDelete                          sync.RWMutex
 	lockUpdate                              sync.RWMutex
+	lockUpdateAvailability                  sync.RWMutex
 	lockUpdateWithNewSchema                 sync.RWMutex
-	lockMarkManualReservation               sync.RWMutex
 }
 
 // CreateWithOwner calls CreateWithOwnerFunc.
@@ -449,6 +530,46 @@ func (mock *GiftItemRepositoryInterfaceMock) GetByWishListCalls() []struct {
 	return calls
 }
 
+// GetPublicWishListGiftItemGroups calls GetPublicWishListGiftItemGroupsFunc.
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemGroups(ctx context.Context, publicSlug string, groupBy string) ([]*models.GiftItemGroup, error) {
+	if mock.GetPublicWishListGiftItemGroupsFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.GetPublicWishListGiftItemGroupsFunc: method is nil but GiftItemRepositoryInterface.GetPublicWishListGiftItemGroups was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+		GroupBy    string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+		GroupBy:    groupBy,
+	}
+	mock.lockGetPublicWishListGiftItemGroups.Lock()
+	mock.calls.GetPublicWishListGiftItemGroups = append(mock.calls.GetPublicWishListGiftItemGroups, callInfo)
+	mock.lockGetPublicWishListGiftItemGroups.Unlock()
+	return mock.GetPublicWishListGiftItemGroupsFunc(ctx, publicSlug, groupBy)
+}
+
+// GetPublicWishListGiftItemGroupsCalls gets all the calls that were made to GetPublicWishListGiftItemGroups.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.GetPublicWishListGiftItemGroupsCalls())
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemGroupsCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+	GroupBy    string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+		GroupBy    string
+	}
+	mock.lockGetPublicWishListGiftItemGroups.RLock()
+	calls = mock.calls.GetPublicWishListGiftItemGroups
+	mock.lockGetPublicWishListGiftItemGroups.RUnlock()
+	return calls
+}
+
 // GetPublicWishListGiftItems calls GetPublicWishListGiftItemsFunc.
 func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItems(ctx context.Context, publicSlug string) ([]*models.GiftItem, error) {
 	if mock.GetPublicWishListGiftItemsFunc == nil {
@@ -529,6 +650,42 @@ func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemsPaginated
 	return calls
 }
 
+// GetReservedVariants calls GetReservedVariantsFunc.
+func (mock *GiftItemRepositoryInterfaceMock) GetReservedVariants(ctx context.Context, id pgtype.UUID) ([]variant.Choice, error) {
+	if mock.GetReservedVariantsFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.GetReservedVariantsFunc: method is nil but GiftItemRepositoryInterface.GetReservedVariants was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetReservedVariants.Lock()
+	mock.calls.GetReservedVariants = append(mock.calls.GetReservedVariants, callInfo)
+	mock.lockGetReservedVariants.Unlock()
+	return mock.GetReservedVariantsFunc(ctx, id)
+}
+
+// GetReservedVariantsCalls gets all the calls that were made to GetReservedVariants.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.GetReservedVariantsCalls())
+func (mock *GiftItemRepositoryInterfaceMock) GetReservedVariantsCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetReservedVariants.RLock()
+	calls = mock.calls.GetReservedVariants
+	mock.lockGetReservedVariants.RUnlock()
+	return calls
+}
+
 // GetUnattached calls GetUnattachedFunc.
 func (mock *GiftItemRepositoryInterfaceMock) GetUnattached(ctx context.Context, ownerID pgtype.UUID) ([]*models.GiftItem, error) {
 	if mock.GetUnattachedFunc == nil {
@@ -565,6 +722,126 @@ func (mock *GiftItemRepositoryInterfaceMock) GetUnattachedCalls() []struct {
 	return calls
 }
 
+// ListDueForAvailabilityCheck calls ListDueForAvailabilityCheckFunc.
+func (mock *GiftItemRepositoryInterfaceMock) ListDueForAvailabilityCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.GiftItem, error) {
+	if mock.ListDueForAvailabilityCheckFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.ListDueForAvailabilityCheckFunc: method is nil but GiftItemRepositoryInterface.ListDueForAvailabilityCheck was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}{
+		Ctx:           ctx,
+		CheckedBefore: checkedBefore,
+		Limit:         limit,
+	}
+	mock.lockListDueForAvailabilityCheck.Lock()
+	mock.calls.ListDueForAvailabilityCheck = append(mock.calls.ListDueForAvailabilityCheck, callInfo)
+	mock.lockListDueForAvailabilityCheck.Unlock()
+	return mock.ListDueForAvailabilityCheckFunc(ctx, checkedBefore, limit)
+}
+
+// ListDueForAvailabilityCheckCalls gets all the calls that were made to ListDueForAvailabilityCheck.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.ListDueForAvailabilityCheckCalls())
+func (mock *GiftItemRepositoryInterfaceMock) ListDueForAvailabilityCheckCalls() []struct {
+	Ctx           context.Context
+	CheckedBefore time.Time
+	Limit         int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}
+	mock.lockListDueForAvailabilityCheck.RLock()
+	calls = mock.calls.ListDueForAvailabilityCheck
+	mock.lockListDueForAvailabilityCheck.RUnlock()
+	return calls
+}
+
+// MarkManualReservation calls MarkManualReservationFunc.
+func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservation(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*models.GiftItem, error) {
+	if mock.MarkManualReservationFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.MarkManualReservationFunc: method is nil but GiftItemRepositoryInterface.MarkManualReservation was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		ItemID         pgtype.UUID
+		ReservedByName string
+		Note           *string
+	}{
+		Ctx:            ctx,
+		ItemID:         itemID,
+		ReservedByName: reservedByName,
+		Note:           note,
+	}
+	mock.lockMarkManualReservation.Lock()
+	mock.calls.MarkManualReservation = append(mock.calls.MarkManualReservation, callInfo)
+	mock.lockMarkManualReservation.Unlock()
+	return mock.MarkManualReservationFunc(ctx, itemID, reservedByName, note)
+}
+
+// MarkManualReservationCalls gets all the calls that were made to MarkManualReservation.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.MarkManualReservationCalls())
+func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservationCalls() []struct {
+	Ctx            context.Context
+	ItemID         pgtype.UUID
+	ReservedByName string
+	Note           *string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		ItemID         pgtype.UUID
+		ReservedByName string
+		Note           *string
+	}
+	mock.lockMarkManualReservation.RLock()
+	calls = mock.calls.MarkManualReservation
+	mock.lockMarkManualReservation.RUnlock()
+	return calls
+}
+
+// PurgeArchivedBefore calls PurgeArchivedBeforeFunc.
+func (mock *GiftItemRepositoryInterfaceMock) PurgeArchivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	if mock.PurgeArchivedBeforeFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.PurgeArchivedBeforeFunc: method is nil but GiftItemRepositoryInterface.PurgeArchivedBefore was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockPurgeArchivedBefore.Lock()
+	mock.calls.PurgeArchivedBefore = append(mock.calls.PurgeArchivedBefore, callInfo)
+	mock.lockPurgeArchivedBefore.Unlock()
+	return mock.PurgeArchivedBeforeFunc(ctx, before)
+}
+
+// PurgeArchivedBeforeCalls gets all the calls that were made to PurgeArchivedBefore.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.PurgeArchivedBeforeCalls())
+func (mock *GiftItemRepositoryInterfaceMock) PurgeArchivedBeforeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockPurgeArchivedBefore.RLock()
+	calls = mock.calls.PurgeArchivedBefore
+	mock.lockPurgeArchivedBefore.RUnlock()
+	return calls
+}
+
 // SoftDelete calls SoftDeleteFunc.
 func (mock *GiftItemRepositoryInterfaceMock) SoftDelete(ctx context.Context, id pgtype.UUID) error {
 	if mock.SoftDeleteFunc == nil {
@@ -637,6 +914,46 @@ func (mock *GiftItemRepositoryInterfaceMock) UpdateCalls() []struct {
 	return calls
 }
 
+// UpdateAvailability calls UpdateAvailabilityFunc.
+func (mock *GiftItemRepositoryInterfaceMock) UpdateAvailability(ctx context.Context, id pgtype.UUID, status string) error {
+	if mock.UpdateAvailabilityFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.UpdateAvailabilityFunc: method is nil but GiftItemRepositoryInterface.UpdateAvailability was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Status string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Status: status,
+	}
+	mock.lockUpdateAvailability.Lock()
+	mock.calls.UpdateAvailability = append(mock.calls.UpdateAvailability, callInfo)
+	mock.lockUpdateAvailability.Unlock()
+	return mock.UpdateAvailabilityFunc(ctx, id, status)
+}
+
+// UpdateAvailabilityCalls gets all the calls that were made to UpdateAvailability.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.UpdateAvailabilityCalls())
+func (mock *GiftItemRepositoryInterfaceMock) UpdateAvailabilityCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	Status string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Status string
+	}
+	mock.lockUpdateAvailability.RLock()
+	calls = mock.calls.UpdateAvailability
+	mock.lockUpdateAvailability.RUnlock()
+	return calls
+}
+
 // UpdateWithNewSchema calls UpdateWithNewSchemaFunc.
 func (mock *GiftItemRepositoryInterfaceMock) UpdateWithNewSchema(ctx context.Context, giftItem *models.GiftItem) (*models.GiftItem, error) {
 	if mock.UpdateWithNewSchemaFunc == nil {
@@ -672,44 +989,3 @@ func (mock *GiftItemRepositoryInterfaceMock) UpdateWithNewSchemaCalls() []struct
 	mock.lockUpdateWithNewSchema.RUnlock()
 	return calls
 }
-
-// MarkManualReservation calls MarkManualReservationFunc.
-func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservation(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*models.GiftItem, error) {
-	if mock.MarkManualReservationFunc == nil {
-		panic("GiftItemRepositoryInterfaceMock.MarkManualReservationFunc: method is nil but GiftItemRepositoryInterface.MarkManualReservation was just called")
-	}
-	callInfo := struct {
-		Ctx            context.Context
-		ItemID         pgtype.UUID
-		ReservedByName string
-		Note           *string
-	}{
-		Ctx:            ctx,
-		ItemID:         itemID,
-		ReservedByName: reservedByName,
-		Note:           note,
-	}
-	mock.lockMarkManualReservation.Lock()
-	mock.calls.MarkManualReservation = append(mock.calls.MarkManualReservation, callInfo)
-	mock.lockMarkManualReservation.Unlock()
-	return mock.MarkManualReservationFunc(ctx, itemID, reservedByName, note)
-}
-
-// MarkManualReservationCalls gets all the calls that were made to MarkManualReservation.
-func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservationCalls() []struct {
-	Ctx            context.Context
-	ItemID         pgtype.UUID
-	ReservedByName string
-	Note           *string
-} {
-	var calls []struct {
-		Ctx            context.Context
-		ItemID         pgtype.UUID
-		ReservedByName string
-		Note           *string
-	}
-	mock.lockMarkManualReservation.RLock()
-	calls = mock.calls.MarkManualReservation
-	mock.lockMarkManualReservation.RUnlock()
-	return calls
-}
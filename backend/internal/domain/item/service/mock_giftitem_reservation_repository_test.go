@@ -25,6 +25,9 @@ var _ repository.GiftItemReservationRepositoryInterface = &GiftItemReservationRe
 //			DeleteWithReservationNotificationFunc: func(ctx context.Context, giftItemID pgtype.UUID) ([]*reservationmodels.Reservation, error) {
 //				panic("mock out the DeleteWithReservationNotification method")
 //			},
+//			MarkReceivedFunc: func(ctx context.Context, giftItemID pgtype.UUID, ownerID pgtype.UUID) (*models.GiftItem, []*repository.ReleasedReservation, error) {
+//				panic("mock out the MarkReceived method")
+//			},
 //			ReserveFunc: func(ctx context.Context, giftItemID pgtype.UUID, userID pgtype.UUID) (*models.GiftItem, error) {
 //				panic("mock out the Reserve method")
 //			},
@@ -44,6 +47,9 @@ type GiftItemReservationRepositoryInterfaceMock struct {
 	// DeleteWithReservationNotificationFunc mocks the DeleteWithReservationNotification method.
 	DeleteWithReservationNotificationFunc func(ctx context.Context, giftItemID pgtype.UUID) ([]*reservationmodels.Reservation, error)
 
+	// MarkReceivedFunc mocks the MarkReceived method.
+	MarkReceivedFunc func(ctx context.Context, giftItemID pgtype.UUID, ownerID pgtype.UUID) (*models.GiftItem, []*repository.ReleasedReservation, error)
+
 	// ReserveFunc mocks the Reserve method.
 	ReserveFunc func(ctx context.Context, giftItemID pgtype.UUID, userID pgtype.UUID) (*models.GiftItem, error)
 
@@ -62,6 +68,15 @@ type GiftItemReservationRepositoryInterfaceMock struct {
 			// GiftItemID is the giftItemID argument value.
 			GiftItemID pgtype.UUID
 		}
+		// MarkReceived holds details about calls to the MarkReceived method.
+		MarkReceived []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
 		// Reserve holds details about calls to the Reserve method.
 		Reserve []struct {
 			// Ctx is the ctx argument value.
@@ -89,6 +104,7 @@ type GiftItemReservationRepositoryInterfaceMock struct {
 		}
 	}
 	lockDeleteWithReservationNotification sync.RWMutex
+	lockMarkReceived                      sync.RWMutex
 	lockReserve                           sync.RWMutex
 	lockReserveIfNotReserved              sync.RWMutex
 	lockUnreserve                         sync.RWMutex
@@ -130,6 +146,46 @@ func (mock *GiftItemReservationRepositoryInterfaceMock) DeleteWithReservationNot
 	return calls
 }
 
+// MarkReceived calls MarkReceivedFunc.
+func (mock *GiftItemReservationRepositoryInterfaceMock) MarkReceived(ctx context.Context, giftItemID pgtype.UUID, ownerID pgtype.UUID) (*models.GiftItem, []*repository.ReleasedReservation, error) {
+	if mock.MarkReceivedFunc == nil {
+		panic("GiftItemReservationRepositoryInterfaceMock.MarkReceivedFunc: method is nil but GiftItemReservationRepositoryInterface.MarkReceived was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+		OwnerID    pgtype.UUID
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+		OwnerID:    ownerID,
+	}
+	mock.lockMarkReceived.Lock()
+	mock.calls.MarkReceived = append(mock.calls.MarkReceived, callInfo)
+	mock.lockMarkReceived.Unlock()
+	return mock.MarkReceivedFunc(ctx, giftItemID, ownerID)
+}
+
+// MarkReceivedCalls gets all the calls that were made to MarkReceived.
+// Check the length with:
+//
+//	len(mockedGiftItemReservationRepositoryInterface.MarkReceivedCalls())
+func (mock *GiftItemReservationRepositoryInterfaceMock) MarkReceivedCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+	OwnerID    pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+		OwnerID    pgtype.UUID
+	}
+	mock.lockMarkReceived.RLock()
+	calls = mock.calls.MarkReceived
+	mock.lockMarkReceived.RUnlock()
+	return calls
+}
+
 // Reserve calls ReserveFunc.
 func (mock *GiftItemReservationRepositoryInterfaceMock) Reserve(ctx context.Context, giftItemID pgtype.UUID, userID pgtype.UUID) (*models.GiftItem, error) {
 	if mock.ReserveFunc == nil {
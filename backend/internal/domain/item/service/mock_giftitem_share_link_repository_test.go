@@ -0,0 +1,246 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+)
+
+// Ensure, that GiftItemShareLinkRepositoryInterfaceMock does implement repository.GiftItemShareLinkRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GiftItemShareLinkRepositoryInterface = &GiftItemShareLinkRepositoryInterfaceMock{}
+
+// GiftItemShareLinkRepositoryInterfaceMock is a mock implementation of repository.GiftItemShareLinkRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftItemShareLinkRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GiftItemShareLinkRepositoryInterface
+//		mockedGiftItemShareLinkRepositoryInterface := &GiftItemShareLinkRepositoryInterfaceMock{
+//			AddFunc: func(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error) {
+//				panic("mock out the Add method")
+//			},
+//			GetSharedItemFunc: func(ctx context.Context, linkID pgtype.UUID) (*repository.SharedGiftItem, error) {
+//				panic("mock out the GetSharedItem method")
+//			},
+//			ListByItemFunc: func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemShareLink, error) {
+//				panic("mock out the ListByItem method")
+//			},
+//			RemoveFunc: func(ctx context.Context, itemID pgtype.UUID, linkID pgtype.UUID) error {
+//				panic("mock out the Remove method")
+//			},
+//		}
+//
+//		// use mockedGiftItemShareLinkRepositoryInterface in code that requires repository.GiftItemShareLinkRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemShareLinkRepositoryInterfaceMock struct {
+	// AddFunc mocks the Add method.
+	AddFunc func(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error)
+
+	// GetSharedItemFunc mocks the GetSharedItem method.
+	GetSharedItemFunc func(ctx context.Context, linkID pgtype.UUID) (*repository.SharedGiftItem, error)
+
+	// ListByItemFunc mocks the ListByItem method.
+	ListByItemFunc func(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemShareLink, error)
+
+	// RemoveFunc mocks the Remove method.
+	RemoveFunc func(ctx context.Context, itemID pgtype.UUID, linkID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// GetSharedItem holds details about calls to the GetSharedItem method.
+		GetSharedItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LinkID is the linkID argument value.
+			LinkID pgtype.UUID
+		}
+		// ListByItem holds details about calls to the ListByItem method.
+		ListByItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+		}
+		// Remove holds details about calls to the Remove method.
+		Remove []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+			// LinkID is the linkID argument value.
+			LinkID pgtype.UUID
+		}
+	}
+	lockAdd           sync.RWMutex
+	lockGetSharedItem sync.RWMutex
+	lockListByItem    sync.RWMutex
+	lockRemove        sync.RWMutex
+}
+
+// Add calls AddFunc.
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) Add(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error) {
+	if mock.AddFunc == nil {
+		panic("GiftItemShareLinkRepositoryInterfaceMock.AddFunc: method is nil but GiftItemShareLinkRepositoryInterface.Add was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		Limit  int
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+		Limit:  limit,
+	}
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(ctx, itemID, limit)
+}
+
+// AddCalls gets all the calls that were made to Add.
+// Check the length with:
+//
+//	len(mockedGiftItemShareLinkRepositoryInterface.AddCalls())
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) AddCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		Limit  int
+	}
+	mock.lockAdd.RLock()
+	calls = mock.calls.Add
+	mock.lockAdd.RUnlock()
+	return calls
+}
+
+// GetSharedItem calls GetSharedItemFunc.
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) GetSharedItem(ctx context.Context, linkID pgtype.UUID) (*repository.SharedGiftItem, error) {
+	if mock.GetSharedItemFunc == nil {
+		panic("GiftItemShareLinkRepositoryInterfaceMock.GetSharedItemFunc: method is nil but GiftItemShareLinkRepositoryInterface.GetSharedItem was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		LinkID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		LinkID: linkID,
+	}
+	mock.lockGetSharedItem.Lock()
+	mock.calls.GetSharedItem = append(mock.calls.GetSharedItem, callInfo)
+	mock.lockGetSharedItem.Unlock()
+	return mock.GetSharedItemFunc(ctx, linkID)
+}
+
+// GetSharedItemCalls gets all the calls that were made to GetSharedItem.
+// Check the length with:
+//
+//	len(mockedGiftItemShareLinkRepositoryInterface.GetSharedItemCalls())
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) GetSharedItemCalls() []struct {
+	Ctx    context.Context
+	LinkID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		LinkID pgtype.UUID
+	}
+	mock.lockGetSharedItem.RLock()
+	calls = mock.calls.GetSharedItem
+	mock.lockGetSharedItem.RUnlock()
+	return calls
+}
+
+// ListByItem calls ListByItemFunc.
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) ListByItem(ctx context.Context, itemID pgtype.UUID) ([]*models.GiftItemShareLink, error) {
+	if mock.ListByItemFunc == nil {
+		panic("GiftItemShareLinkRepositoryInterfaceMock.ListByItemFunc: method is nil but GiftItemShareLinkRepositoryInterface.ListByItem was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+	}
+	mock.lockListByItem.Lock()
+	mock.calls.ListByItem = append(mock.calls.ListByItem, callInfo)
+	mock.lockListByItem.Unlock()
+	return mock.ListByItemFunc(ctx, itemID)
+}
+
+// ListByItemCalls gets all the calls that were made to ListByItem.
+// Check the length with:
+//
+//	len(mockedGiftItemShareLinkRepositoryInterface.ListByItemCalls())
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) ListByItemCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}
+	mock.lockListByItem.RLock()
+	calls = mock.calls.ListByItem
+	mock.lockListByItem.RUnlock()
+	return calls
+}
+
+// Remove calls RemoveFunc.
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) Remove(ctx context.Context, itemID pgtype.UUID, linkID pgtype.UUID) error {
+	if mock.RemoveFunc == nil {
+		panic("GiftItemShareLinkRepositoryInterfaceMock.RemoveFunc: method is nil but GiftItemShareLinkRepositoryInterface.Remove was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		LinkID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+		LinkID: linkID,
+	}
+	mock.lockRemove.Lock()
+	mock.calls.Remove = append(mock.calls.Remove, callInfo)
+	mock.lockRemove.Unlock()
+	return mock.RemoveFunc(ctx, itemID, linkID)
+}
+
+// RemoveCalls gets all the calls that were made to Remove.
+// Check the length with:
+//
+//	len(mockedGiftItemShareLinkRepositoryInterface.RemoveCalls())
+func (mock *GiftItemShareLinkRepositoryInterfaceMock) RemoveCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+	LinkID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+		LinkID pgtype.UUID
+	}
+	mock.lockRemove.RLock()
+	calls = mock.calls.Remove
+	mock.lockRemove.RUnlock()
+	return calls
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Sentinel errors for purchase proofs
+var (
+	ErrItemNotPurchased          = errors.New("item has not been purchased")
+	ErrPurchaseProofForbidden    = errors.New("only the purchaser can attach a purchase proof")
+	ErrPurchaseProofEmpty        = errors.New("purchase proof needs an image or a tracking number")
+	ErrPurchaseProofVisibility   = errors.New("visibility must be private, after_occasion or owner")
+	ErrPurchaseProofNotAvailable = errors.New("purchase proof not found or not shared yet")
+)
+
+// PurchaseProofInput is what the purchaser attaches to a purchased item.
+// Saving replaces any earlier proof.
+type PurchaseProofInput struct {
+	ImageURL       string // URL returned by the image upload endpoint
+	TrackingNumber string
+	Visibility     string // Defaults to after_occasion, keeping the gift a surprise
+}
+
+// PurchaseProofOutput is a purchase proof as shown to the purchaser or the item's owner
+type PurchaseProofOutput struct {
+	GiftItemID     string
+	ImageURL       string
+	TrackingNumber string
+	Visibility     string
+	RevealDate     string // Date the owner can see an after_occasion proof from; empty if never
+	VisibleToOwner bool
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+// SetPurchaseProof attaches an order confirmation image and/or tracking number to
+// an item the user purchased
+func (s *ItemService) SetPurchaseProof(ctx context.Context, itemID, userID string, input PurchaseProofInput) (*PurchaseProofOutput, error) {
+	item, purchaserID, err := s.getItemForProof(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !item.PurchasedByUserID.Valid {
+		return nil, ErrItemNotPurchased
+	}
+	if item.PurchasedByUserID != purchaserID {
+		return nil, ErrPurchaseProofForbidden
+	}
+
+	imageURL := strings.TrimSpace(input.ImageURL)
+	trackingNumber := strings.TrimSpace(input.TrackingNumber)
+	if imageURL == "" && trackingNumber == "" {
+		return nil, ErrPurchaseProofEmpty
+	}
+
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = models.ProofVisibilityAfterOccasion
+	}
+	switch visibility {
+	case models.ProofVisibilityPrivate, models.ProofVisibilityAfterOccasion, models.ProofVisibilityOwner:
+	default:
+		return nil, ErrPurchaseProofVisibility
+	}
+
+	if imageURL != "" && s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, imageURL); err != nil {
+			return nil, err
+		}
+	}
+
+	proof, err := s.purchaseRepo.SaveProof(ctx, models.PurchaseProof{
+		GiftItemID:      item.ID,
+		PurchaserUserID: purchaserID,
+		ImageURL:        pgtype.Text{String: imageURL, Valid: imageURL != ""},
+		TrackingNumber:  pgtype.Text{String: trackingNumber, Valid: trackingNumber != ""},
+		Visibility:      visibility,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save purchase proof: %w", err)
+	}
+
+	return convertPurchaseProof(proof, time.Now()), nil
+}
+
+// GetPurchaseProof returns an item's purchase proof to its purchaser, or to the
+// item's owner once the purchaser's visibility setting allows it. A proof the
+// owner may not see yet is reported as missing so the surprise is kept.
+func (s *ItemService) GetPurchaseProof(ctx context.Context, itemID, userID string) (*PurchaseProofOutput, error) {
+	item, viewerID, err := s.getItemForProof(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+	isPurchaser := item.PurchasedByUserID.Valid && item.PurchasedByUserID == viewerID
+	if !isPurchaser && item.OwnerID != viewerID {
+		return nil, ErrItemForbidden
+	}
+
+	proof, err := s.purchaseRepo.GetProof(ctx, item.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPurchaseProofNotFound) {
+			return nil, ErrPurchaseProofNotAvailable
+		}
+		return nil, fmt.Errorf("failed to get purchase proof: %w", err)
+	}
+
+	output := convertPurchaseProof(proof, time.Now())
+	if !isPurchaser && !output.VisibleToOwner {
+		return nil, ErrPurchaseProofNotAvailable
+	}
+	return output, nil
+}
+
+// getItemForProof loads the item and parses the acting user's ID
+func (s *ItemService) getItemForProof(ctx context.Context, itemID, userID string) (*models.GiftItem, pgtype.UUID, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(itemID); err != nil {
+		return nil, pgtype.UUID{}, ErrItemNotFound
+	}
+
+	actorID := pgtype.UUID{}
+	if err := actorID.Scan(userID); err != nil {
+		return nil, pgtype.UUID{}, ErrInvalidItemUser
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, pgtype.UUID{}, ErrItemNotFound
+	}
+
+	return item, actorID, nil
+}
+
+// proofVisibleToOwner reports whether the item's owner may see the proof at now.
+// after_occasion proofs are revealed the day after the latest occasion of the
+// wishlists holding the item; without an occasion date they stay hidden.
+func proofVisibleToOwner(proof *models.PurchaseProof, now time.Time) bool {
+	switch proof.Visibility {
+	case models.ProofVisibilityOwner:
+		return true
+	case models.ProofVisibilityAfterOccasion:
+		if !proof.RevealDate.Valid {
+			return false
+		}
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		return proof.RevealDate.Time.Before(today)
+	default:
+		return false
+	}
+}
+
+func convertPurchaseProof(proof *models.PurchaseProof, now time.Time) *PurchaseProofOutput {
+	output := &PurchaseProofOutput{
+		GiftItemID:     proof.GiftItemID.String(),
+		ImageURL:       proof.ImageURL.String,
+		TrackingNumber: proof.TrackingNumber.String,
+		Visibility:     proof.Visibility,
+		VisibleToOwner: proofVisibleToOwner(proof, now),
+		CreatedAt:      proof.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:      proof.UpdatedAt.Time.Format(time.RFC3339),
+	}
+	if proof.Visibility == models.ProofVisibilityAfterOccasion && proof.RevealDate.Valid {
+		output.RevealDate = proof.RevealDate.Time.AddDate(0, 0, 1).Format(time.DateOnly)
+	}
+	return output
+}
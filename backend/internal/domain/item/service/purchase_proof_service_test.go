@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	proofItemID      = "00000000-0000-0000-0000-0000000000a1"
+	proofOwnerID     = "00000000-0000-0000-0000-0000000000b1"
+	proofPurchaserID = "00000000-0000-0000-0000-0000000000c1"
+)
+
+func newProofTestService(t *testing.T, purchaseRepo *GiftItemPurchaseRepositoryInterfaceMock, purchased bool) *ItemService {
+	t.Helper()
+
+	item := &models.GiftItem{Name: "Scarf"}
+	require.NoError(t, item.ID.Scan(proofItemID))
+	require.NoError(t, item.OwnerID.Scan(proofOwnerID))
+	if purchased {
+		require.NoError(t, item.PurchasedByUserID.Scan(proofPurchaserID))
+	}
+
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.GiftItem, error) {
+			return item, nil
+		},
+	}
+	return NewItemService(itemRepo, emptyImageRepo(), purchaseRepo, &WishlistItemRepositoryInterfaceMock{}, nil, nil)
+}
+
+func TestItemService_SetPurchaseProof(t *testing.T) {
+	t.Run("purchaser attaches tracking number with default visibility", func(t *testing.T) {
+		purchaseRepo := &GiftItemPurchaseRepositoryInterfaceMock{
+			SaveProofFunc: func(ctx context.Context, proof models.PurchaseProof) (*models.PurchaseProof, error) {
+				return &proof, nil
+			},
+		}
+		svc := newProofTestService(t, purchaseRepo, true)
+
+		out, err := svc.SetPurchaseProof(context.Background(), proofItemID, proofPurchaserID, PurchaseProofInput{TrackingNumber: " 1Z999 "})
+		require.NoError(t, err)
+		assert.Equal(t, "1Z999", out.TrackingNumber)
+		assert.Equal(t, models.ProofVisibilityAfterOccasion, out.Visibility)
+
+		saved := purchaseRepo.SaveProofCalls()[0].Proof
+		assert.False(t, saved.ImageURL.Valid)
+		assert.Equal(t, proofPurchaserID, saved.PurchaserUserID.String())
+	})
+
+	t.Run("only the purchaser can attach a proof", func(t *testing.T) {
+		svc := newProofTestService(t, &GiftItemPurchaseRepositoryInterfaceMock{}, true)
+
+		_, err := svc.SetPurchaseProof(context.Background(), proofItemID, proofOwnerID, PurchaseProofInput{TrackingNumber: "1Z999"})
+		assert.ErrorIs(t, err, ErrPurchaseProofForbidden)
+	})
+
+	t.Run("item must be purchased", func(t *testing.T) {
+		svc := newProofTestService(t, &GiftItemPurchaseRepositoryInterfaceMock{}, false)
+
+		_, err := svc.SetPurchaseProof(context.Background(), proofItemID, proofPurchaserID, PurchaseProofInput{TrackingNumber: "1Z999"})
+		assert.ErrorIs(t, err, ErrItemNotPurchased)
+	})
+
+	t.Run("needs image or tracking number", func(t *testing.T) {
+		svc := newProofTestService(t, &GiftItemPurchaseRepositoryInterfaceMock{}, true)
+
+		_, err := svc.SetPurchaseProof(context.Background(), proofItemID, proofPurchaserID, PurchaseProofInput{Visibility: models.ProofVisibilityOwner})
+		assert.ErrorIs(t, err, ErrPurchaseProofEmpty)
+	})
+}
+
+func TestItemService_GetPurchaseProof(t *testing.T) {
+	proofWith := func(visibility string, revealDate pgtype.Date) *GiftItemPurchaseRepositoryInterfaceMock {
+		return &GiftItemPurchaseRepositoryInterfaceMock{
+			GetProofFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error) {
+				return &models.PurchaseProof{
+					GiftItemID:     giftItemID,
+					TrackingNumber: pgtype.Text{String: "1Z999", Valid: true},
+					Visibility:     visibility,
+					RevealDate:     revealDate,
+				}, nil
+			},
+		}
+	}
+	future := pgtype.Date{Time: time.Now().AddDate(0, 1, 0), Valid: true}
+	past := pgtype.Date{Time: time.Now().AddDate(0, -1, 0), Valid: true}
+
+	t.Run("purchaser always sees the proof", func(t *testing.T) {
+		svc := newProofTestService(t, proofWith(models.ProofVisibilityPrivate, pgtype.Date{}), true)
+
+		out, err := svc.GetPurchaseProof(context.Background(), proofItemID, proofPurchaserID)
+		require.NoError(t, err)
+		assert.False(t, out.VisibleToOwner)
+	})
+
+	t.Run("owner does not see it before the occasion", func(t *testing.T) {
+		svc := newProofTestService(t, proofWith(models.ProofVisibilityAfterOccasion, future), true)
+
+		_, err := svc.GetPurchaseProof(context.Background(), proofItemID, proofOwnerID)
+		assert.ErrorIs(t, err, ErrPurchaseProofNotAvailable)
+	})
+
+	t.Run("owner sees it after the occasion", func(t *testing.T) {
+		svc := newProofTestService(t, proofWith(models.ProofVisibilityAfterOccasion, past), true)
+
+		out, err := svc.GetPurchaseProof(context.Background(), proofItemID, proofOwnerID)
+		require.NoError(t, err)
+		assert.Equal(t, "1Z999", out.TrackingNumber)
+	})
+
+	t.Run("owner sees a proof shared right away", func(t *testing.T) {
+		svc := newProofTestService(t, proofWith(models.ProofVisibilityOwner, pgtype.Date{}), true)
+
+		_, err := svc.GetPurchaseProof(context.Background(), proofItemID, proofOwnerID)
+		require.NoError(t, err)
+	})
+
+	t.Run("other users are denied", func(t *testing.T) {
+		svc := newProofTestService(t, proofWith(models.ProofVisibilityOwner, pgtype.Date{}), true)
+
+		_, err := svc.GetPurchaseProof(context.Background(), proofItemID, "00000000-0000-0000-0000-0000000000d1")
+		assert.ErrorIs(t, err, ErrItemForbidden)
+	})
+
+	t.Run("no proof", func(t *testing.T) {
+		purchaseRepo := &GiftItemPurchaseRepositoryInterfaceMock{
+			GetProofFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PurchaseProof, error) {
+				return nil, repository.ErrPurchaseProofNotFound
+			},
+		}
+		svc := newProofTestService(t, purchaseRepo, true)
+
+		_, err := svc.GetPurchaseProof(context.Background(), proofItemID, proofPurchaserID)
+		assert.ErrorIs(t, err, ErrPurchaseProofNotAvailable)
+	})
+}
+
+func TestProofVisibleToOwner(t *testing.T) {
+	now := time.Date(2026, 12, 25, 18, 0, 0, 0, time.UTC)
+	proof := &models.PurchaseProof{Visibility: models.ProofVisibilityAfterOccasion}
+
+	proof.RevealDate = pgtype.Date{Time: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Valid: true}
+	assert.False(t, proofVisibleToOwner(proof, now), "hidden on the occasion day itself")
+
+	proof.RevealDate = pgtype.Date{Time: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC), Valid: true}
+	assert.True(t, proofVisibleToOwner(proof, now))
+
+	proof.RevealDate = pgtype.Date{}
+	assert.False(t, proofVisibleToOwner(proof, now), "hidden without an occasion date")
+}
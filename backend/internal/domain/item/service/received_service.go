@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	partnermodels "wish-list/internal/domain/partner/models"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrItemAlreadyPurchased is returned when marking an item received that is
+// already marked as purchased
+var ErrItemAlreadyPurchased = errors.New("item is already purchased")
+
+// ReservationEventLogInterface records the reservations released when an item is
+// received (cross-domain)
+type ReservationEventLogInterface interface {
+	Append(ctx context.Context, event reservationmodels.ReservationEvent) error
+}
+
+// ReservationHolderNotifierInterface tells registered givers their reservation
+// was released (cross-domain)
+type ReservationHolderNotifierInterface interface {
+	Notify(ctx context.Context, notification notificationmodels.Notification) error
+}
+
+// ItemReceivedEmailSenderInterface tells guests their reservation was released
+// (cross-domain)
+type ItemReceivedEmailSenderInterface interface {
+	SendItemReceivedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error
+}
+
+// GuestEmailDecrypterInterface decrypts the guest emails of reservations stored
+// with encryption (cross-domain)
+type GuestEmailDecrypterInterface interface {
+	Decrypt(ctx context.Context, ciphertext string) (string, error)
+}
+
+// ReceivedServiceInterface defines the interface for items the owner received
+// outside the app
+type ReceivedServiceInterface interface {
+	MarkReceived(ctx context.Context, item *models.GiftItem) (*ItemOutput, error)
+}
+
+// ReceivedService lets owners take an item off their lists once they got it some
+// other way, releasing whoever had reserved it
+type ReceivedService struct {
+	repo       repository.GiftItemReservationRepositoryInterface
+	imageRepo  repository.GiftItemImageRepositoryInterface
+	events     ReservationEventLogInterface
+	notifier   ReservationHolderNotifierInterface
+	emails     ItemReceivedEmailSenderInterface
+	decrypter  GuestEmailDecrypterInterface
+	itemEvents ItemEventPublisherInterface
+}
+
+// NewReceivedService creates a new ReceivedService. decrypter is nil when guest
+// PII is stored unencrypted; itemEvents may be nil, in which case the item is
+// not announced as purchased.
+func NewReceivedService(
+	repo repository.GiftItemReservationRepositoryInterface,
+	imageRepo repository.GiftItemImageRepositoryInterface,
+	events ReservationEventLogInterface,
+	notifier ReservationHolderNotifierInterface,
+	emails ItemReceivedEmailSenderInterface,
+	decrypter GuestEmailDecrypterInterface,
+	itemEvents ItemEventPublisherInterface,
+) *ReceivedService {
+	return &ReceivedService{
+		repo:       repo,
+		imageRepo:  imageRepo,
+		events:     events,
+		notifier:   notifier,
+		emails:     emails,
+		decrypter:  decrypter,
+		itemEvents: itemEvents,
+	}
+}
+
+// MarkReceived marks an item the owner received outside the app as purchased,
+// without a price, and cancels its active reservations. Registered givers get an
+// in-app notification and guests an email saying the item is no longer needed.
+// The item is already stored when they are told, so failures are only logged.
+func (s *ReceivedService) MarkReceived(ctx context.Context, item *models.GiftItem) (*ItemOutput, error) {
+	updated, released, err := s.repo.MarkReceived(ctx, item.ID, item.OwnerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGiftItemAlreadyPurchased) {
+			return nil, ErrItemAlreadyPurchased
+		}
+		return nil, fmt.Errorf("failed to mark item as received: %w", err)
+	}
+
+	for _, reservation := range released {
+		s.recordRelease(ctx, &reservation.Reservation)
+		s.notifyHolder(ctx, updated.Name, reservation)
+	}
+
+	if s.itemEvents != nil {
+		if err := s.itemEvents.PublishItemEvent(ctx, partnermodels.EventItemPurchased, updated.ID); err != nil {
+			logger.WarnContext(ctx, "failed to publish item event", "event", partnermodels.EventItemPurchased, "gift_item_id", updated.ID.String(), "error", err)
+		}
+	}
+
+	output := itemToOutput(updated)
+	if err := attachItemImages(ctx, s.imageRepo, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// recordRelease appends the cancellation to the reservation's event log
+func (s *ReceivedService) recordRelease(ctx context.Context, reservation *reservationmodels.Reservation) {
+	if s.events == nil {
+		return
+	}
+	event := reservationmodels.ReservationEvent{
+		ReservationID: reservation.ID,
+		WishlistID:    reservation.WishlistID,
+		GiftItemID:    reservation.GiftItemID,
+		EventType:     reservationmodels.ReservationEventCanceled,
+		Status:        reservation.Status,
+		Reason:        pgtype.Text{String: reservationmodels.CancelReasonItemReceived, Valid: true},
+	}
+	if err := s.events.Append(ctx, event); err != nil {
+		logger.WarnContext(ctx, "failed to record reservation event", "event", event.EventType, "reservation_id", reservation.ID.String(), "error", err)
+	}
+}
+
+// notifyHolder tells the giver who held a released reservation that the item is
+// no longer needed: in-app when registered, by email when a guest
+func (s *ReceivedService) notifyHolder(ctx context.Context, itemName string, reservation *repository.ReleasedReservation) {
+	wishlistTitle := reservation.WishlistTitle.String
+
+	if reservation.ReservedByUserID.Valid {
+		if s.notifier == nil {
+			return
+		}
+		err := s.notifier.Notify(ctx, notificationmodels.Notification{
+			UserID:     reservation.ReservedByUserID,
+			Type:       notificationmodels.TypeReservationRemoved,
+			Title:      fmt.Sprintf("%q is no longer needed", itemName),
+			Body:       fmt.Sprintf("The owner of %q already received %q, so your reservation was released.", wishlistTitle, itemName),
+			WishlistID: reservation.WishlistID,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "failed to create reservation notification", "reservation_id", reservation.ID.String(), "error", err)
+		}
+		return
+	}
+
+	email, err := s.guestEmail(ctx, &reservation.Reservation)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to read guest email", "reservation_id", reservation.ID.String(), "error", err)
+		return
+	}
+	if email == "" || s.emails == nil {
+		return
+	}
+	if err := s.emails.SendItemReceivedEmail(ctx, email, itemName, wishlistTitle); err != nil {
+		logger.WarnContext(ctx, "failed to send item received email", "reservation_id", reservation.ID.String(), "error", err)
+	}
+}
+
+// guestEmail returns the guest's email, decrypting it when stored encrypted.
+// Guests who left no email return an empty string.
+func (s *ReceivedService) guestEmail(ctx context.Context, reservation *reservationmodels.Reservation) (string, error) {
+	if reservation.EncryptedGuestEmail.Valid && s.decrypter != nil {
+		return s.decrypter.Decrypt(ctx, reservation.EncryptedGuestEmail.String)
+	}
+	return reservation.GuestEmail.String, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+	notificationmodels "wish-list/internal/domain/notification/models"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceivedService_MarkReceived(t *testing.T) {
+	item := newAttachmentTestItem(t)
+	item.OwnerID = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	giverID := pgtype.UUID{Bytes: [16]byte{7}, Valid: true}
+	wishlistTitle := pgtype.Text{String: "Birthday", Valid: true}
+
+	t.Run("releases reservations and tells their holders", func(t *testing.T) {
+		repo := &GiftItemReservationRepositoryInterfaceMock{
+			MarkReceivedFunc: func(ctx context.Context, giftItemID, ownerID pgtype.UUID) (*models.GiftItem, []*repository.ReleasedReservation, error) {
+				assert.Equal(t, item.OwnerID, ownerID)
+				received := *item
+				received.PurchasedByUserID = ownerID
+				return &received, []*repository.ReleasedReservation{
+					{
+						Reservation:   reservationmodels.Reservation{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, GiftItemID: giftItemID, ReservedByUserID: giverID, Status: "canceled"},
+						WishlistTitle: wishlistTitle,
+					},
+					{
+						Reservation:   reservationmodels.Reservation{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, GiftItemID: giftItemID, EncryptedGuestEmail: pgtype.Text{String: "sealed", Valid: true}, Status: "canceled"},
+						WishlistTitle: wishlistTitle,
+					},
+					{
+						Reservation:   reservationmodels.Reservation{ID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, GiftItemID: giftItemID, Status: "canceled"},
+						WishlistTitle: wishlistTitle,
+					},
+				}, nil
+			},
+		}
+		events := &ReservationEventLogInterfaceMock{
+			AppendFunc: func(ctx context.Context, event reservationmodels.ReservationEvent) error { return nil },
+		}
+		notifier := &ReservationHolderNotifierInterfaceMock{
+			NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error { return nil },
+		}
+		emails := &ItemReceivedEmailSenderInterfaceMock{
+			SendItemReceivedEmailFunc: func(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error {
+				return errors.New("mailbox unavailable")
+			},
+		}
+		decrypter := &GuestEmailDecrypterInterfaceMock{
+			DecryptFunc: func(ctx context.Context, ciphertext string) (string, error) { return "jamie@example.com", nil },
+		}
+		svc := NewReceivedService(repo, newShareImageRepo(), events, notifier, emails, decrypter, nil)
+
+		output, err := svc.MarkReceived(context.Background(), item)
+		require.NoError(t, err, "a failed email does not undo the change")
+		assert.True(t, output.IsPurchased)
+
+		require.Len(t, events.AppendCalls(), 3)
+		event := events.AppendCalls()[0].Event
+		assert.Equal(t, reservationmodels.ReservationEventCanceled, event.EventType)
+		assert.Equal(t, pgtype.Text{String: reservationmodels.CancelReasonItemReceived, Valid: true}, event.Reason)
+
+		require.Len(t, notifier.NotifyCalls(), 1)
+		assert.Equal(t, giverID, notifier.NotifyCalls()[0].Notification.UserID)
+		assert.Equal(t, notificationmodels.TypeReservationRemoved, notifier.NotifyCalls()[0].Notification.Type)
+
+		require.Len(t, emails.SendItemReceivedEmailCalls(), 1, "guests without an email are skipped")
+		assert.Equal(t, "jamie@example.com", emails.SendItemReceivedEmailCalls()[0].RecipientEmail)
+		assert.Equal(t, "Birthday", emails.SendItemReceivedEmailCalls()[0].WishlistTitle)
+	})
+
+	t.Run("already purchased", func(t *testing.T) {
+		repo := &GiftItemReservationRepositoryInterfaceMock{
+			MarkReceivedFunc: func(ctx context.Context, giftItemID, ownerID pgtype.UUID) (*models.GiftItem, []*repository.ReleasedReservation, error) {
+				return nil, nil, repository.ErrGiftItemAlreadyPurchased
+			},
+		}
+		svc := NewReceivedService(repo, newShareImageRepo(), nil, nil, nil, nil, nil)
+
+		_, err := svc.MarkReceived(context.Background(), item)
+		assert.ErrorIs(t, err, ErrItemAlreadyPurchased)
+	})
+}
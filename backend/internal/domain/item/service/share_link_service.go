@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MaxItemShareLinks is how many share links an item can have at a time
+const MaxItemShareLinks = 10
+
+// Sentinel errors for item share links
+var (
+	ErrShareLinkNotFound     = errors.New("share link not found")
+	ErrShareLinkLimitReached = errors.New("item has the maximum number of share links")
+)
+
+// ItemShareTokenInterface signs and checks the tokens of item share links (cross-domain)
+type ItemShareTokenInterface interface {
+	GenerateItemShareToken(linkID string) string
+	ValidateItemShareToken(token string) (string, error)
+}
+
+// ShareLinkServiceInterface defines the interface for sharing single items
+type ShareLinkServiceInterface interface {
+	ListShareLinks(ctx context.Context, item *models.GiftItem) ([]*ShareLinkOutput, error)
+	CreateShareLink(ctx context.Context, item *models.GiftItem) (*ShareLinkOutput, error)
+	RevokeShareLink(ctx context.Context, item *models.GiftItem, linkID string) error
+	GetSharedItem(ctx context.Context, token string) (*ItemOutput, error)
+}
+
+// ShareLinkService lets owners share one item instead of a whole wishlist. A
+// shared item can be viewed whether or not the wishlists it is on are public.
+type ShareLinkService struct {
+	repo        repository.GiftItemShareLinkRepositoryInterface
+	imageRepo   repository.GiftItemImageRepositoryInterface
+	tokens      ItemShareTokenInterface
+	frontendURL string
+}
+
+// NewShareLinkService creates a new ShareLinkService
+func NewShareLinkService(
+	repo repository.GiftItemShareLinkRepositoryInterface,
+	imageRepo repository.GiftItemImageRepositoryInterface,
+	tokens ItemShareTokenInterface,
+	frontendURL string,
+) *ShareLinkService {
+	return &ShareLinkService{
+		repo:        repo,
+		imageRepo:   imageRepo,
+		tokens:      tokens,
+		frontendURL: frontendURL,
+	}
+}
+
+// ShareLinkOutput is a link sharing a single item
+type ShareLinkOutput struct {
+	ID        string
+	Token     string // Signed link ID, looked up with GetSharedItem
+	URL       string // Page of the web app showing the item
+	CreatedAt time.Time
+}
+
+// ListShareLinks returns the links sharing the owner's item
+func (s *ShareLinkService) ListShareLinks(ctx context.Context, item *models.GiftItem) ([]*ShareLinkOutput, error) {
+	links, err := s.repo.ListByItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item share links: %w", err)
+	}
+
+	outputs := make([]*ShareLinkOutput, len(links))
+	for i, link := range links {
+		outputs[i] = s.toShareLinkOutput(link)
+	}
+
+	return outputs, nil
+}
+
+// CreateShareLink creates a new link sharing the owner's item
+func (s *ShareLinkService) CreateShareLink(ctx context.Context, item *models.GiftItem) (*ShareLinkOutput, error) {
+	link, err := s.repo.Add(ctx, item.ID, MaxItemShareLinks)
+	if err != nil {
+		return nil, mapShareLinkRepositoryError(err)
+	}
+
+	logger.InfoContext(ctx, "item share link created", "gift_item_id", item.ID.String(), "share_link_id", link.ID.String())
+
+	return s.toShareLinkOutput(link), nil
+}
+
+// RevokeShareLink deletes a link sharing the owner's item; its token stops
+// working right away
+func (s *ShareLinkService) RevokeShareLink(ctx context.Context, item *models.GiftItem, linkID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(linkID); err != nil {
+		return ErrShareLinkNotFound
+	}
+
+	if err := s.repo.Remove(ctx, item.ID, id); err != nil {
+		return mapShareLinkRepositoryError(err)
+	}
+
+	logger.InfoContext(ctx, "item share link revoked", "gift_item_id", item.ID.String(), "share_link_id", linkID)
+
+	return nil
+}
+
+// GetSharedItem returns the item a share token was issued for, with its
+// reservation status. As on public wishlists, who reserved or bought the item
+// stays hidden and notes are left out unless the owner made them public. Items on
+// a wishlist taken down by moderation, or of an owner who was deactivated or is
+// deleting their account, are not shown.
+func (s *ShareLinkService) GetSharedItem(ctx context.Context, token string) (*ItemOutput, error) {
+	linkID, err := s.tokens.ValidateItemShareToken(token)
+	if err != nil {
+		return nil, ErrShareLinkNotFound
+	}
+	id := pgtype.UUID{}
+	if err := id.Scan(linkID); err != nil {
+		return nil, ErrShareLinkNotFound
+	}
+
+	shared, err := s.repo.GetSharedItem(ctx, id)
+	if err != nil {
+		return nil, mapShareLinkRepositoryError(err)
+	}
+	if shared.TakenDown || shared.OwnerInactive {
+		return nil, ErrShareLinkNotFound
+	}
+
+	output := itemToOutput(&shared.GiftItem)
+	if output.NotesVisibility != models.NotesVisibilityPublic {
+		output.Notes = ""
+	}
+	output.NotesVisibility = ""
+	output.HideManualReservation()
+	if err := attachItemImages(ctx, s.imageRepo, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *ShareLinkService) toShareLinkOutput(link *models.GiftItemShareLink) *ShareLinkOutput {
+	token := s.tokens.GenerateItemShareToken(link.ID.String())
+	return &ShareLinkOutput{
+		ID:        link.ID.String(),
+		Token:     token,
+		URL:       s.frontendURL + "/public/items/" + url.PathEscape(token),
+		CreatedAt: link.CreatedAt.Time,
+	}
+}
+
+func mapShareLinkRepositoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrGiftItemNotFound):
+		return ErrItemNotFound
+	case errors.Is(err, repository.ErrGiftItemShareLinkNotFound):
+		return ErrShareLinkNotFound
+	case errors.Is(err, repository.ErrGiftItemShareLinkLimitReached):
+		return ErrShareLinkLimitReached
+	default:
+		return fmt.Errorf("failed to update item share links: %w", err)
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/item/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shareLinkID = "00000000-0000-0000-0000-0000000000d1"
+
+var errBadShareToken = errors.New("invalid item share token")
+
+// newShareTokens signs a link ID by appending ".sig"
+func newShareTokens() *ItemShareTokenInterfaceMock {
+	return &ItemShareTokenInterfaceMock{
+		GenerateItemShareTokenFunc: func(linkID string) string {
+			return linkID + ".sig"
+		},
+		ValidateItemShareTokenFunc: func(token string) (string, error) {
+			linkID, ok := strings.CutSuffix(token, ".sig")
+			if !ok {
+				return "", errBadShareToken
+			}
+			return linkID, nil
+		},
+	}
+}
+
+func newShareImageRepo() *GiftItemImageRepositoryInterfaceMock {
+	return &GiftItemImageRepositoryInterfaceMock{
+		GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*models.GiftItemImage, error) {
+			return map[string][]*models.GiftItemImage{}, nil
+		},
+	}
+}
+
+func TestShareLinkService_CreateShareLink(t *testing.T) {
+	item := newAttachmentTestItem(t)
+
+	t.Run("returns the signed link", func(t *testing.T) {
+		repo := &GiftItemShareLinkRepositoryInterfaceMock{
+			AddFunc: func(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error) {
+				assert.Equal(t, item.ID, itemID)
+				assert.Equal(t, MaxItemShareLinks, limit)
+				link := &models.GiftItemShareLink{GiftItemID: itemID}
+				require.NoError(t, link.ID.Scan(shareLinkID))
+				return link, nil
+			},
+		}
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "https://wishlist.example")
+
+		link, err := svc.CreateShareLink(context.Background(), item)
+		require.NoError(t, err)
+		assert.Equal(t, shareLinkID, link.ID)
+		assert.Equal(t, shareLinkID+".sig", link.Token)
+		assert.Equal(t, "https://wishlist.example/public/items/"+shareLinkID+".sig", link.URL)
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		repo := &GiftItemShareLinkRepositoryInterfaceMock{
+			AddFunc: func(ctx context.Context, itemID pgtype.UUID, limit int) (*models.GiftItemShareLink, error) {
+				return nil, repository.ErrGiftItemShareLinkLimitReached
+			},
+		}
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		_, err := svc.CreateShareLink(context.Background(), item)
+		assert.ErrorIs(t, err, ErrShareLinkLimitReached)
+	})
+}
+
+func TestShareLinkService_RevokeShareLink(t *testing.T) {
+	item := newAttachmentTestItem(t)
+
+	t.Run("unknown link", func(t *testing.T) {
+		repo := &GiftItemShareLinkRepositoryInterfaceMock{
+			RemoveFunc: func(ctx context.Context, itemID, linkID pgtype.UUID) error {
+				return repository.ErrGiftItemShareLinkNotFound
+			},
+		}
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		err := svc.RevokeShareLink(context.Background(), item, shareLinkID)
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+	})
+
+	t.Run("malformed link ID", func(t *testing.T) {
+		repo := &GiftItemShareLinkRepositoryInterfaceMock{}
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		err := svc.RevokeShareLink(context.Background(), item, "not-a-uuid")
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+		assert.Empty(t, repo.RemoveCalls())
+	})
+}
+
+func TestShareLinkService_GetSharedItem(t *testing.T) {
+	newSharedRepo := func(shared *repository.SharedGiftItem) *GiftItemShareLinkRepositoryInterfaceMock {
+		return &GiftItemShareLinkRepositoryInterfaceMock{
+			GetSharedItemFunc: func(ctx context.Context, linkID pgtype.UUID) (*repository.SharedGiftItem, error) {
+				if linkID.String() != shareLinkID {
+					return nil, repository.ErrGiftItemShareLinkNotFound
+				}
+				return shared, nil
+			},
+		}
+	}
+	newRepo := func(item *models.GiftItem) *GiftItemShareLinkRepositoryInterfaceMock {
+		return newSharedRepo(&repository.SharedGiftItem{GiftItem: *item})
+	}
+
+	t.Run("shows the reservation status but not private notes", func(t *testing.T) {
+		item := newAttachmentTestItem(t)
+		item.Quantity = 3
+		item.ReservedQuantity = 1
+		item.Notes = pgtype.Text{String: "Size 42", Valid: true}
+		svc := NewShareLinkService(newRepo(item), newShareImageRepo(), newShareTokens(), "")
+
+		out, err := svc.GetSharedItem(context.Background(), shareLinkID+".sig")
+		require.NoError(t, err)
+		assert.Equal(t, "Running shoes", out.Name)
+		assert.Equal(t, models.ReservationPartiallyReserved, out.ReservationStatus)
+		assert.Empty(t, out.Notes)
+		assert.Empty(t, out.NotesVisibility)
+	})
+
+	t.Run("public notes are shown", func(t *testing.T) {
+		item := newAttachmentTestItem(t)
+		item.Notes = pgtype.Text{String: "Size 42", Valid: true}
+		item.NotesVisibility = models.NotesVisibilityPublic
+		svc := NewShareLinkService(newRepo(item), newShareImageRepo(), newShareTokens(), "")
+
+		out, err := svc.GetSharedItem(context.Background(), shareLinkID+".sig")
+		require.NoError(t, err)
+		assert.Equal(t, "Size 42", out.Notes)
+	})
+
+	t.Run("revoked link or forged token", func(t *testing.T) {
+		repo := newRepo(newAttachmentTestItem(t))
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		_, err := svc.GetSharedItem(context.Background(), "00000000-0000-0000-0000-0000000000d2.sig")
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+
+		_, err = svc.GetSharedItem(context.Background(), shareLinkID+".forged")
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+		assert.Len(t, repo.GetSharedItemCalls(), 1)
+	})
+
+	t.Run("item on a taken down wishlist", func(t *testing.T) {
+		repo := newSharedRepo(&repository.SharedGiftItem{GiftItem: *newAttachmentTestItem(t), TakenDown: true})
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		_, err := svc.GetSharedItem(context.Background(), shareLinkID+".sig")
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+	})
+
+	t.Run("owner deactivated or deleting their account", func(t *testing.T) {
+		repo := newSharedRepo(&repository.SharedGiftItem{GiftItem: *newAttachmentTestItem(t), OwnerInactive: true})
+		svc := NewShareLinkService(repo, newShareImageRepo(), newShareTokens(), "")
+
+		_, err := svc.GetSharedItem(context.Background(), shareLinkID+".sig")
+		assert.ErrorIs(t, err, ErrShareLinkNotFound)
+	})
+}
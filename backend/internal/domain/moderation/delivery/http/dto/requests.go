@@ -0,0 +1,10 @@
+package dto
+
+type ReportWishlistRequest struct {
+	Reason  string `json:"reason" validate:"required,oneof=spam offensive inappropriate_image scam other"`
+	Details string `json:"details" validate:"max=1000"`
+}
+
+type ReviewReportRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
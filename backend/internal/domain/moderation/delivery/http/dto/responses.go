@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"wish-list/internal/domain/moderation/service"
+)
+
+type ReportAcceptedResponse struct {
+	Message string `json:"message" validate:"required"`
+}
+
+type ReportResponse struct {
+	ID             string  `json:"id" validate:"required"`
+	WishlistID     string  `json:"wishlist_id" validate:"required"`
+	WishlistTitle  string  `json:"wishlist_title" validate:"required"`
+	PublicSlug     *string `json:"public_slug"`
+	OwnerID        string  `json:"owner_id" validate:"required"`
+	Reason         string  `json:"reason" validate:"required"`
+	Details        *string `json:"details"`
+	ReporterUserID *string `json:"reporter_user_id"` // Null for guest reports
+	Status         string  `json:"status" validate:"required"`
+	ReviewedAt     *string `json:"reviewed_at"`
+	CreatedAt      string  `json:"created_at" validate:"required"`
+}
+
+type ReportsListResponse struct {
+	Data       []ReportResponse `json:"data" validate:"required"`
+	Pagination map[string]any   `json:"pagination" validate:"required"`
+}
+
+func FromReportOutput(r *service.ReportOutput) ReportResponse {
+	resp := ReportResponse{
+		ID:            r.ID.String(),
+		WishlistID:    r.WishlistID.String(),
+		WishlistTitle: r.WishlistTitle,
+		OwnerID:       r.OwnerID.String(),
+		Reason:        r.Reason,
+		Status:        r.Status,
+		CreatedAt:     r.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if r.PublicSlug.Valid {
+		slug := r.PublicSlug.String
+		resp.PublicSlug = &slug
+	}
+
+	if r.Details.Valid {
+		details := r.Details.String
+		resp.Details = &details
+	}
+
+	if r.ReporterUserID.Valid {
+		reporterID := r.ReporterUserID.String()
+		resp.ReporterUserID = &reporterID
+	}
+
+	if r.ReviewedAt.Valid {
+		reviewedAt := r.ReviewedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.ReviewedAt = &reviewedAt
+	}
+
+	return resp
+}
+
+func FromReportOutputs(reports []*service.ReportOutput) []ReportResponse {
+	responses := make([]ReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = FromReportOutput(report)
+	}
+	return responses
+}
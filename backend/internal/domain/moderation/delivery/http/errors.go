@@ -0,0 +1,32 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/moderation/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapModerationServiceError converts moderation service errors to AppErrors
+func mapModerationServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidReason):
+		return apperrors.BadRequest("Invalid report reason")
+	case errors.Is(err, service.ErrInvalidReportStatus):
+		return apperrors.BadRequest("Invalid report status")
+	case errors.Is(err, service.ErrInvalidReportID):
+		return apperrors.BadRequest("Invalid report ID")
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Public wishlist not found")
+	case errors.Is(err, service.ErrReportNotFound):
+		return apperrors.NotFound("Report not found")
+	case errors.Is(err, service.ErrReportNotReviewable):
+		return apperrors.Conflict("Report is not pending review")
+	case errors.Is(err, service.ErrWishlistNotTakenDown):
+		return apperrors.Conflict("Wishlist is not taken down")
+	default:
+		return apperrors.Internal("Failed to process moderation request").Wrap(err)
+	}
+}
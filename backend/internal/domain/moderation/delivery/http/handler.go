@@ -0,0 +1,216 @@
+package http
+
+import (
+	"math"
+	nethttp "net/http"
+
+	"wish-list/internal/domain/moderation/delivery/http/dto"
+	"wish-list/internal/domain/moderation/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for content moderation
+type Handler struct {
+	service service.ModerationServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.ModerationServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// ReportWishlist godoc
+//
+//	@Summary		Report a public wishlist
+//	@Description	Flag a public wishlist with abusive or inappropriate content for admin review.
+//	@Tags			Moderation
+//	@Accept			json
+//	@Produce		json
+//	@Param			slug			path		string						true	"Public slug of the wishlist"
+//	@Param			request			body		dto.ReportWishlistRequest	true	"Report reason and details"
+//	@Param			X-Captcha-Token	header		string						false	"CAPTCHA response token (required for guests when CAPTCHA is enabled)"
+//	@Success		202				{object}	dto.ReportAcceptedResponse	"Report submitted"
+//	@Failure		400				{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string			"CAPTCHA verification failed"
+//	@Failure		404				{object}	map[string]string			"Public wishlist not found"
+//	@Failure		429				{object}	map[string]string			"Too many requests"
+//	@Failure		500				{object}	map[string]string			"Internal server error"
+//	@Router			/public/wishlists/{slug}/report [post]
+func (h *Handler) ReportWishlist(c echo.Context) error {
+	var req dto.ReportWishlistRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	// Registered reporters are recorded to help admins spot abuse of the report button
+	reporterID := pgtype.UUID{}
+	if userIDStr, _, _, authErr := auth.GetUserFromContext(c); authErr == nil {
+		userID, err := helpers.ParseUUID(c, userIDStr)
+		if err != nil {
+			return err
+		}
+		reporterID = userID
+	}
+
+	err := h.service.ReportWishlist(c.Request().Context(), service.ReportInput{
+		PublicSlug:     c.Param("slug"),
+		ReporterUserID: reporterID,
+		Reason:         req.Reason,
+		Details:        req.Details,
+	})
+	if err != nil {
+		return mapModerationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusAccepted, dto.ReportAcceptedResponse{
+		Message: "Thank you. The report will be reviewed by a moderator.",
+	})
+}
+
+// ListReports godoc
+//
+//	@Summary		List wishlist reports
+//	@Description	Admin review queue of reported public wishlists, oldest first.
+//	@Tags			Moderation
+//	@Produce		json
+//	@Param			status	query		string					false	"Report status (default pending)"	Enums(pending, dismissed, actioned)
+//	@Param			page	query		int						false	"Page number (default 1)"
+//	@Param			limit	query		int						false	"Items per page (default 10, max 100)"
+//	@Success		200		{object}	dto.ReportsListResponse	"List of reports"
+//	@Failure		400		{object}	map[string]string		"Invalid status"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		403		{object}	map[string]string		"Insufficient permissions"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/moderation/reports [get]
+func (h *Handler) ListReports(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+
+	reports, totalCount, err := h.service.ListReports(c.Request().Context(), c.QueryParam("status"), pagination.Limit, pagination.Offset)
+	if err != nil {
+		return mapModerationServiceError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(pagination.Limit)))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.ReportsListResponse{
+		Data: dto.FromReportOutputs(reports),
+		Pagination: map[string]any{
+			"page":       pagination.Page,
+			"limit":      pagination.Limit,
+			"total":      totalCount,
+			"totalPages": totalPages,
+		},
+	})
+}
+
+// DismissReport godoc
+//
+//	@Summary		Dismiss a wishlist report
+//	@Description	Close a pending report without action.
+//	@Tags			Moderation
+//	@Produce		json
+//	@Param			id	path		string				true	"Report ID"
+//	@Success		200	{object}	dto.ReportResponse	"Dismissed report"
+//	@Failure		400	{object}	map[string]string	"Invalid report ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Insufficient permissions"
+//	@Failure		404	{object}	map[string]string	"Report not found"
+//	@Failure		409	{object}	map[string]string	"Report is not pending review"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/moderation/reports/{id}/dismiss [post]
+func (h *Handler) DismissReport(c echo.Context) error {
+	input, err := h.bindReviewInput(c)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.service.DismissReport(c.Request().Context(), input)
+	if err != nil {
+		return mapModerationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReportOutput(report))
+}
+
+// TakeDown godoc
+//
+//	@Summary		Take down a reported wishlist
+//	@Description	Make the reported wishlist private and keep its owner from publishing it again until restored. Closes every pending report on the wishlist.
+//	@Tags			Moderation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Report ID"
+//	@Param			request	body		dto.ReviewReportRequest	false	"Internal note kept with the takedown"
+//	@Success		200		{object}	dto.ReportResponse		"Actioned report"
+//	@Failure		400		{object}	map[string]string		"Invalid report ID or note"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		403		{object}	map[string]string		"Insufficient permissions"
+//	@Failure		404		{object}	map[string]string		"Report not found"
+//	@Failure		409		{object}	map[string]string		"Report is not pending review"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/moderation/reports/{id}/takedown [post]
+func (h *Handler) TakeDown(c echo.Context) error {
+	input, err := h.bindReviewInput(c)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.service.TakeDown(c.Request().Context(), input)
+	if err != nil {
+		return mapModerationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReportOutput(report))
+}
+
+// RestoreWishlist godoc
+//
+//	@Summary		Restore a taken down wishlist
+//	@Description	Lift a takedown so the owner can make the wishlist public again. The wishlist stays private until the owner publishes it.
+//	@Tags			Moderation
+//	@Param			id	path	string	true	"Wishlist ID"
+//	@Success		204	"Takedown lifted"
+//	@Failure		400	{object}	map[string]string	"Invalid wishlist ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Insufficient permissions"
+//	@Failure		409	{object}	map[string]string	"Wishlist is not taken down"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/moderation/wishlists/{id}/restore [post]
+func (h *Handler) RestoreWishlist(c echo.Context) error {
+	if err := h.service.Restore(c.Request().Context(), c.Param("id")); err != nil {
+		return mapModerationServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+func (h *Handler) bindReviewInput(c echo.Context) (service.ReviewInput, error) {
+	var req dto.ReviewReportRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return service.ReviewInput{}, err
+	}
+
+	reviewerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return service.ReviewInput{}, err
+	}
+
+	return service.ReviewInput{
+		ReportID:   c.Param("id"),
+		ReviewerID: reviewerID,
+		Note:       req.Note,
+	}, nil
+}
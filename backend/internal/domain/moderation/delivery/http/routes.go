@@ -0,0 +1,31 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/app/middleware"
+	"wish-list/internal/pkg/auth"
+)
+
+// RegisterRoutes registers all moderation HTTP routes
+func RegisterRoutes(
+	e *echo.Echo,
+	h *Handler,
+	optionalAuthMiddleware echo.MiddlewareFunc,
+	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
+) {
+	// Public reports — rate limited per IP (3 req/min), CAPTCHA for guests only.
+	reportLimiter := middleware.NewReportRateLimiter()
+	e.POST("/api/public/wishlists/:slug/report", h.ReportWishlist,
+		middleware.AuthRateLimitMiddleware(reportLimiter, middleware.IPIdentifier),
+		optionalAuthMiddleware,
+		captchaMiddleware)
+
+	// Admin review queue
+	admin := e.Group("/api/admin/moderation", authMiddleware, auth.RequireUserType("admin"))
+	admin.GET("/reports", h.ListReports)
+	admin.POST("/reports/:id/dismiss", h.DismissReport)
+	admin.POST("/reports/:id/takedown", h.TakeDown)
+	admin.POST("/wishlists/:id/restore", h.RestoreWishlist)
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Report reasons
+const (
+	ReasonSpam               = "spam"
+	ReasonOffensive          = "offensive"
+	ReasonInappropriateImage = "inappropriate_image"
+	ReasonScam               = "scam"
+	ReasonOther              = "other"
+)
+
+// Report statuses
+const (
+	StatusPending   = "pending"
+	StatusDismissed = "dismissed"
+	StatusActioned  = "actioned"
+)
+
+// Report is a complaint about a public wishlist, joined with the wishlist it concerns
+type Report struct {
+	ID             pgtype.UUID        `db:"id"`
+	WishlistID     pgtype.UUID        `db:"wishlist_id"`
+	WishlistTitle  string             `db:"wishlist_title"`
+	PublicSlug     pgtype.Text        `db:"public_slug"` // NULL once the wishlist is no longer public
+	OwnerID        pgtype.UUID        `db:"owner_id"`
+	Reason         string             `db:"reason"`
+	Details        pgtype.Text        `db:"details"`
+	ReporterUserID pgtype.UUID        `db:"reporter_user_id"` // NULL for guest reports
+	Status         string             `db:"status"`
+	ReviewedBy     pgtype.UUID        `db:"reviewed_by"`
+	ReviewedAt     pgtype.Timestamptz `db:"reviewed_at"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+}
+
+// Takedown records that an admin removed a wishlist from public view
+type Takedown struct {
+	WishlistID  pgtype.UUID        `db:"wishlist_id"`
+	Reason      string             `db:"reason"`
+	Note        pgtype.Text        `db:"note"`
+	TakenDownBy pgtype.UUID        `db:"taken_down_by"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+}
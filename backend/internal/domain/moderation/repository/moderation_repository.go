@@ -0,0 +1,279 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_moderation_repository_test.go -pkg service . ModerationRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/moderation/models"
+	"wish-list/internal/pkg/logger"
+)
+
+// Sentinel errors for moderation repository
+var (
+	ErrWishlistNotFound = errors.New("wishlist not found")
+	ErrReportNotFound   = errors.New("report not found")
+	ErrTakedownNotFound = errors.New("takedown not found")
+)
+
+// reportColumns selects a report (r) joined with its wishlist (w)
+const reportColumns = `
+	r.id, r.wishlist_id, w.title AS wishlist_title, w.public_slug, w.owner_id,
+	r.reason, r.details, r.reporter_user_id, r.status, r.reviewed_by, r.reviewed_at, r.created_at
+`
+
+// ModerationRepositoryInterface defines the interface for moderation database operations
+type ModerationRepositoryInterface interface {
+	GetPublicWishlistID(ctx context.Context, publicSlug string) (pgtype.UUID, error)
+	CreateReport(ctx context.Context, report models.Report) (*models.Report, error)
+	GetReport(ctx context.Context, id pgtype.UUID) (*models.Report, error)
+	ListReports(ctx context.Context, status string, limit, offset int) ([]*models.Report, error)
+	CountReports(ctx context.Context, status string) (int, error)
+	DismissReport(ctx context.Context, id, reviewerID pgtype.UUID) error
+	TakeDown(ctx context.Context, takedown models.Takedown) (int64, error)
+	IsTakenDown(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
+	Restore(ctx context.Context, wishlistID pgtype.UUID) error
+}
+
+type ModerationRepository struct {
+	db *database.DB
+}
+
+func NewModerationRepository(db *database.DB) ModerationRepositoryInterface {
+	return &ModerationRepository{
+		db: db,
+	}
+}
+
+// GetPublicWishlistID resolves the slug of a wishlist that is currently public
+func (r *ModerationRepository) GetPublicWishlistID(ctx context.Context, publicSlug string) (pgtype.UUID, error) {
+	query := `SELECT id FROM wishlists WHERE public_slug = $1 AND is_public = true`
+
+	var id pgtype.UUID
+	if err := r.db.Reader().GetContext(ctx, &id, query, publicSlug); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pgtype.UUID{}, ErrWishlistNotFound
+		}
+		return pgtype.UUID{}, fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	return id, nil
+}
+
+// CreateReport inserts a new pending report
+func (r *ModerationRepository) CreateReport(ctx context.Context, report models.Report) (*models.Report, error) {
+	query := `
+		WITH r AS (
+			INSERT INTO wishlist_reports (wishlist_id, reason, details, reporter_user_id)
+			VALUES ($1, $2, $3, $4)
+			RETURNING *
+		)
+		SELECT ` + reportColumns + `
+		FROM r
+		JOIN wishlists w ON w.id = r.wishlist_id
+	`
+
+	var created models.Report
+	err := r.db.QueryRowxContext(ctx, query,
+		report.WishlistID,
+		report.Reason,
+		report.Details,
+		report.ReporterUserID,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetReport retrieves a report by ID
+func (r *ModerationRepository) GetReport(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+	query := `
+		SELECT ` + reportColumns + `
+		FROM wishlist_reports r
+		JOIN wishlists w ON w.id = r.wishlist_id
+		WHERE r.id = $1
+	`
+
+	var report models.Report
+	if err := r.db.GetContext(ctx, &report, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// ListReports returns reports with the given status, oldest first
+func (r *ModerationRepository) ListReports(ctx context.Context, status string, limit, offset int) ([]*models.Report, error) {
+	query := `
+		SELECT ` + reportColumns + `
+		FROM wishlist_reports r
+		JOIN wishlists w ON w.id = r.wishlist_id
+		WHERE r.status = $1
+		ORDER BY r.created_at ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	var reports []*models.Report
+	if err := r.db.SelectContext(ctx, &reports, query, status, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+// CountReports returns the number of reports with the given status
+func (r *ModerationRepository) CountReports(ctx context.Context, status string) (int, error) {
+	query := `SELECT COUNT(*) FROM wishlist_reports WHERE status = $1`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, status); err != nil {
+		return 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	return count, nil
+}
+
+// DismissReport closes a pending report without action.
+// Returns ErrReportNotFound if the report does not exist or was already reviewed.
+func (r *ModerationRepository) DismissReport(ctx context.Context, id, reviewerID pgtype.UUID) error {
+	query := `
+		UPDATE wishlist_reports SET
+			status = 'dismissed',
+			reviewed_by = $2,
+			reviewed_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to dismiss report: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrReportNotFound
+	}
+
+	return nil
+}
+
+// TakeDown makes the wishlist private, records the takedown and closes every pending
+// report on it as actioned. Taking down a wishlist again updates the recorded reason.
+// Returns the number of reports closed.
+func (r *ModerationRepository) TakeDown(ctx context.Context, takedown models.Takedown) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	hideQuery := `
+		UPDATE wishlists SET
+			is_public = false,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $1
+	`
+	result, err := tx.ExecContext(ctx, hideQuery, takedown.WishlistID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hide wishlist: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return 0, ErrWishlistNotFound
+	}
+
+	recordQuery := `
+		INSERT INTO wishlist_takedowns (wishlist_id, reason, note, taken_down_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (wishlist_id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			note = EXCLUDED.note,
+			taken_down_by = EXCLUDED.taken_down_by,
+			created_at = NOW()
+	`
+	if _, err := tx.ExecContext(ctx, recordQuery,
+		takedown.WishlistID,
+		takedown.Reason,
+		takedown.Note,
+		takedown.TakenDownBy,
+	); err != nil {
+		return 0, fmt.Errorf("failed to record takedown: %w", err)
+	}
+
+	closeQuery := `
+		UPDATE wishlist_reports SET
+			status = 'actioned',
+			reviewed_by = $2,
+			reviewed_at = NOW()
+		WHERE wishlist_id = $1 AND status = 'pending'
+	`
+	result, err = tx.ExecContext(ctx, closeQuery, takedown.WishlistID, takedown.TakenDownBy)
+	if err != nil {
+		return 0, fmt.Errorf("failed to close reports: %w", err)
+	}
+	closed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit takedown: %w", err)
+	}
+
+	return closed, nil
+}
+
+// IsTakenDown reports whether the wishlist is currently taken down
+func (r *ModerationRepository) IsTakenDown(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM wishlist_takedowns WHERE wishlist_id = $1)`
+
+	var takenDown bool
+	if err := r.db.GetContext(ctx, &takenDown, query, wishlistID); err != nil {
+		return false, fmt.Errorf("failed to check takedown: %w", err)
+	}
+
+	return takenDown, nil
+}
+
+// Restore lifts a takedown so the owner can make the wishlist public again.
+// The wishlist itself stays private until its owner publishes it.
+func (r *ModerationRepository) Restore(ctx context.Context, wishlistID pgtype.UUID) error {
+	query := `DELETE FROM wishlist_takedowns WHERE wishlist_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, wishlistID)
+	if err != nil {
+		return fmt.Errorf("failed to restore wishlist: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrTakedownNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that CacheInterfaceMock does implement CacheInterface.
+// If this is not the case, regenerate this file with moq.
+var _ CacheInterface = &CacheInterfaceMock{}
+
+// CacheInterfaceMock is a mock implementation of CacheInterface.
+//
+//	func TestSomethingThatUsesCacheInterface(t *testing.T) {
+//
+//		// make and configure a mocked CacheInterface
+//		mockedCacheInterface := &CacheInterfaceMock{
+//			DeleteFunc: func(ctx context.Context, key string) error {
+//				panic("mock out the Delete method")
+//			},
+//		}
+//
+//		// use mockedCacheInterface in code that requires CacheInterface
+//		// and then make assertions.
+//
+//	}
+type CacheInterfaceMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, key string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockDelete sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *CacheInterfaceMock) Delete(ctx context.Context, key string) error {
+	if mock.DeleteFunc == nil {
+		panic("CacheInterfaceMock.DeleteFunc: method is nil but CacheInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, key)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedCacheInterface.DeleteCalls())
+func (mock *CacheInterfaceMock) DeleteCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
@@ -0,0 +1,502 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/moderation/models"
+	"wish-list/internal/domain/moderation/repository"
+)
+
+// Ensure, that ModerationRepositoryInterfaceMock does implement repository.ModerationRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.ModerationRepositoryInterface = &ModerationRepositoryInterfaceMock{}
+
+// ModerationRepositoryInterfaceMock is a mock implementation of repository.ModerationRepositoryInterface.
+//
+//	func TestSomethingThatUsesModerationRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.ModerationRepositoryInterface
+//		mockedModerationRepositoryInterface := &ModerationRepositoryInterfaceMock{
+//			CountReportsFunc: func(ctx context.Context, status string) (int, error) {
+//				panic("mock out the CountReports method")
+//			},
+//			CreateReportFunc: func(ctx context.Context, report models.Report) (*models.Report, error) {
+//				panic("mock out the CreateReport method")
+//			},
+//			DismissReportFunc: func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID) error {
+//				panic("mock out the DismissReport method")
+//			},
+//			GetPublicWishlistIDFunc: func(ctx context.Context, publicSlug string) (pgtype.UUID, error) {
+//				panic("mock out the GetPublicWishlistID method")
+//			},
+//			GetReportFunc: func(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+//				panic("mock out the GetReport method")
+//			},
+//			IsTakenDownFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+//				panic("mock out the IsTakenDown method")
+//			},
+//			ListReportsFunc: func(ctx context.Context, status string, limit int, offset int) ([]*models.Report, error) {
+//				panic("mock out the ListReports method")
+//			},
+//			RestoreFunc: func(ctx context.Context, wishlistID pgtype.UUID) error {
+//				panic("mock out the Restore method")
+//			},
+//			TakeDownFunc: func(ctx context.Context, takedown models.Takedown) (int64, error) {
+//				panic("mock out the TakeDown method")
+//			},
+//		}
+//
+//		// use mockedModerationRepositoryInterface in code that requires repository.ModerationRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type ModerationRepositoryInterfaceMock struct {
+	// CountReportsFunc mocks the CountReports method.
+	CountReportsFunc func(ctx context.Context, status string) (int, error)
+
+	// CreateReportFunc mocks the CreateReport method.
+	CreateReportFunc func(ctx context.Context, report models.Report) (*models.Report, error)
+
+	// DismissReportFunc mocks the DismissReport method.
+	DismissReportFunc func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID) error
+
+	// GetPublicWishlistIDFunc mocks the GetPublicWishlistID method.
+	GetPublicWishlistIDFunc func(ctx context.Context, publicSlug string) (pgtype.UUID, error)
+
+	// GetReportFunc mocks the GetReport method.
+	GetReportFunc func(ctx context.Context, id pgtype.UUID) (*models.Report, error)
+
+	// IsTakenDownFunc mocks the IsTakenDown method.
+	IsTakenDownFunc func(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
+
+	// ListReportsFunc mocks the ListReports method.
+	ListReportsFunc func(ctx context.Context, status string, limit int, offset int) ([]*models.Report, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, wishlistID pgtype.UUID) error
+
+	// TakeDownFunc mocks the TakeDown method.
+	TakeDownFunc func(ctx context.Context, takedown models.Takedown) (int64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountReports holds details about calls to the CountReports method.
+		CountReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status string
+		}
+		// CreateReport holds details about calls to the CreateReport method.
+		CreateReport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Report is the report argument value.
+			Report models.Report
+		}
+		// DismissReport holds details about calls to the DismissReport method.
+		DismissReport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// ReviewerID is the reviewerID argument value.
+			ReviewerID pgtype.UUID
+		}
+		// GetPublicWishlistID holds details about calls to the GetPublicWishlistID method.
+		GetPublicWishlistID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+		// GetReport holds details about calls to the GetReport method.
+		GetReport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// IsTakenDown holds details about calls to the IsTakenDown method.
+		IsTakenDown []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// ListReports holds details about calls to the ListReports method.
+		ListReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status string
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// TakeDown holds details about calls to the TakeDown method.
+		TakeDown []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Takedown is the takedown argument value.
+			Takedown models.Takedown
+		}
+	}
+	lockCountReports        sync.RWMutex
+	lockCreateReport        sync.RWMutex
+	lockDismissReport       sync.RWMutex
+	lockGetPublicWishlistID sync.RWMutex
+	lockGetReport           sync.RWMutex
+	lockIsTakenDown         sync.RWMutex
+	lockListReports         sync.RWMutex
+	lockRestore             sync.RWMutex
+	lockTakeDown            sync.RWMutex
+}
+
+// CountReports calls CountReportsFunc.
+func (mock *ModerationRepositoryInterfaceMock) CountReports(ctx context.Context, status string) (int, error) {
+	if mock.CountReportsFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.CountReportsFunc: method is nil but ModerationRepositoryInterface.CountReports was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status string
+	}{
+		Ctx:    ctx,
+		Status: status,
+	}
+	mock.lockCountReports.Lock()
+	mock.calls.CountReports = append(mock.calls.CountReports, callInfo)
+	mock.lockCountReports.Unlock()
+	return mock.CountReportsFunc(ctx, status)
+}
+
+// CountReportsCalls gets all the calls that were made to CountReports.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.CountReportsCalls())
+func (mock *ModerationRepositoryInterfaceMock) CountReportsCalls() []struct {
+	Ctx    context.Context
+	Status string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status string
+	}
+	mock.lockCountReports.RLock()
+	calls = mock.calls.CountReports
+	mock.lockCountReports.RUnlock()
+	return calls
+}
+
+// CreateReport calls CreateReportFunc.
+func (mock *ModerationRepositoryInterfaceMock) CreateReport(ctx context.Context, report models.Report) (*models.Report, error) {
+	if mock.CreateReportFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.CreateReportFunc: method is nil but ModerationRepositoryInterface.CreateReport was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Report models.Report
+	}{
+		Ctx:    ctx,
+		Report: report,
+	}
+	mock.lockCreateReport.Lock()
+	mock.calls.CreateReport = append(mock.calls.CreateReport, callInfo)
+	mock.lockCreateReport.Unlock()
+	return mock.CreateReportFunc(ctx, report)
+}
+
+// CreateReportCalls gets all the calls that were made to CreateReport.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.CreateReportCalls())
+func (mock *ModerationRepositoryInterfaceMock) CreateReportCalls() []struct {
+	Ctx    context.Context
+	Report models.Report
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Report models.Report
+	}
+	mock.lockCreateReport.RLock()
+	calls = mock.calls.CreateReport
+	mock.lockCreateReport.RUnlock()
+	return calls
+}
+
+// DismissReport calls DismissReportFunc.
+func (mock *ModerationRepositoryInterfaceMock) DismissReport(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID) error {
+	if mock.DismissReportFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.DismissReportFunc: method is nil but ModerationRepositoryInterface.DismissReport was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		ReviewerID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		ReviewerID: reviewerID,
+	}
+	mock.lockDismissReport.Lock()
+	mock.calls.DismissReport = append(mock.calls.DismissReport, callInfo)
+	mock.lockDismissReport.Unlock()
+	return mock.DismissReportFunc(ctx, id, reviewerID)
+}
+
+// DismissReportCalls gets all the calls that were made to DismissReport.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.DismissReportCalls())
+func (mock *ModerationRepositoryInterfaceMock) DismissReportCalls() []struct {
+	Ctx        context.Context
+	ID         pgtype.UUID
+	ReviewerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		ReviewerID pgtype.UUID
+	}
+	mock.lockDismissReport.RLock()
+	calls = mock.calls.DismissReport
+	mock.lockDismissReport.RUnlock()
+	return calls
+}
+
+// GetPublicWishlistID calls GetPublicWishlistIDFunc.
+func (mock *ModerationRepositoryInterfaceMock) GetPublicWishlistID(ctx context.Context, publicSlug string) (pgtype.UUID, error) {
+	if mock.GetPublicWishlistIDFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.GetPublicWishlistIDFunc: method is nil but ModerationRepositoryInterface.GetPublicWishlistID was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetPublicWishlistID.Lock()
+	mock.calls.GetPublicWishlistID = append(mock.calls.GetPublicWishlistID, callInfo)
+	mock.lockGetPublicWishlistID.Unlock()
+	return mock.GetPublicWishlistIDFunc(ctx, publicSlug)
+}
+
+// GetPublicWishlistIDCalls gets all the calls that were made to GetPublicWishlistID.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.GetPublicWishlistIDCalls())
+func (mock *ModerationRepositoryInterfaceMock) GetPublicWishlistIDCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetPublicWishlistID.RLock()
+	calls = mock.calls.GetPublicWishlistID
+	mock.lockGetPublicWishlistID.RUnlock()
+	return calls
+}
+
+// GetReport calls GetReportFunc.
+func (mock *ModerationRepositoryInterfaceMock) GetReport(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+	if mock.GetReportFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.GetReportFunc: method is nil but ModerationRepositoryInterface.GetReport was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetReport.Lock()
+	mock.calls.GetReport = append(mock.calls.GetReport, callInfo)
+	mock.lockGetReport.Unlock()
+	return mock.GetReportFunc(ctx, id)
+}
+
+// GetReportCalls gets all the calls that were made to GetReport.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.GetReportCalls())
+func (mock *ModerationRepositoryInterfaceMock) GetReportCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetReport.RLock()
+	calls = mock.calls.GetReport
+	mock.lockGetReport.RUnlock()
+	return calls
+}
+
+// IsTakenDown calls IsTakenDownFunc.
+func (mock *ModerationRepositoryInterfaceMock) IsTakenDown(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+	if mock.IsTakenDownFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.IsTakenDownFunc: method is nil but ModerationRepositoryInterface.IsTakenDown was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockIsTakenDown.Lock()
+	mock.calls.IsTakenDown = append(mock.calls.IsTakenDown, callInfo)
+	mock.lockIsTakenDown.Unlock()
+	return mock.IsTakenDownFunc(ctx, wishlistID)
+}
+
+// IsTakenDownCalls gets all the calls that were made to IsTakenDown.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.IsTakenDownCalls())
+func (mock *ModerationRepositoryInterfaceMock) IsTakenDownCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockIsTakenDown.RLock()
+	calls = mock.calls.IsTakenDown
+	mock.lockIsTakenDown.RUnlock()
+	return calls
+}
+
+// ListReports calls ListReportsFunc.
+func (mock *ModerationRepositoryInterfaceMock) ListReports(ctx context.Context, status string, limit int, offset int) ([]*models.Report, error) {
+	if mock.ListReportsFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.ListReportsFunc: method is nil but ModerationRepositoryInterface.ListReports was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status string
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockListReports.Lock()
+	mock.calls.ListReports = append(mock.calls.ListReports, callInfo)
+	mock.lockListReports.Unlock()
+	return mock.ListReportsFunc(ctx, status, limit, offset)
+}
+
+// ListReportsCalls gets all the calls that were made to ListReports.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.ListReportsCalls())
+func (mock *ModerationRepositoryInterfaceMock) ListReportsCalls() []struct {
+	Ctx    context.Context
+	Status string
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status string
+		Limit  int
+		Offset int
+	}
+	mock.lockListReports.RLock()
+	calls = mock.calls.ListReports
+	mock.lockListReports.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *ModerationRepositoryInterfaceMock) Restore(ctx context.Context, wishlistID pgtype.UUID) error {
+	if mock.RestoreFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.RestoreFunc: method is nil but ModerationRepositoryInterface.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, wishlistID)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.RestoreCalls())
+func (mock *ModerationRepositoryInterfaceMock) RestoreCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
+// TakeDown calls TakeDownFunc.
+func (mock *ModerationRepositoryInterfaceMock) TakeDown(ctx context.Context, takedown models.Takedown) (int64, error) {
+	if mock.TakeDownFunc == nil {
+		panic("ModerationRepositoryInterfaceMock.TakeDownFunc: method is nil but ModerationRepositoryInterface.TakeDown was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Takedown models.Takedown
+	}{
+		Ctx:      ctx,
+		Takedown: takedown,
+	}
+	mock.lockTakeDown.Lock()
+	mock.calls.TakeDown = append(mock.calls.TakeDown, callInfo)
+	mock.lockTakeDown.Unlock()
+	return mock.TakeDownFunc(ctx, takedown)
+}
+
+// TakeDownCalls gets all the calls that were made to TakeDown.
+// Check the length with:
+//
+//	len(mockedModerationRepositoryInterface.TakeDownCalls())
+func (mock *ModerationRepositoryInterfaceMock) TakeDownCalls() []struct {
+	Ctx      context.Context
+	Takedown models.Takedown
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Takedown models.Takedown
+	}
+	mock.lockTakeDown.RLock()
+	calls = mock.calls.TakeDown
+	mock.lockTakeDown.RUnlock()
+	return calls
+}
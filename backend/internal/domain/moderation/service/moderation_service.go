@@ -0,0 +1,345 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . CacheInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"wish-list/internal/domain/moderation/models"
+	"wish-list/internal/domain/moderation/repository"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/moderation"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces - only methods actually used by ModerationService
+
+// CacheInterface defines cache methods used by moderation service
+type CacheInterface interface {
+	Delete(ctx context.Context, key string) error
+}
+
+var (
+	ErrContentRejected      = errors.New("content rejected")
+	ErrWishlistTakenDown    = errors.New("wishlist was taken down by a moderator")
+	ErrInvalidReason        = errors.New("invalid report reason")
+	ErrInvalidReportStatus  = errors.New("invalid report status")
+	ErrInvalidReportID      = errors.New("invalid report id")
+	ErrInvalidWishlistID    = errors.New("invalid wishlist id")
+	ErrWishlistNotFound     = errors.New("wishlist not found")
+	ErrReportNotFound       = errors.New("report not found")
+	ErrReportNotReviewable  = errors.New("report is not pending review")
+	ErrWishlistNotTakenDown = errors.New("wishlist is not taken down")
+)
+
+// ContentRejectedError is returned when text or an image fails moderation.
+// Reason tells the author what to change.
+type ContentRejectedError struct {
+	Reason string
+}
+
+func (e *ContentRejectedError) Error() string {
+	return ErrContentRejected.Error() + ": " + e.Reason
+}
+
+func (e *ContentRejectedError) Unwrap() error {
+	return ErrContentRejected
+}
+
+// ModerationServiceInterface defines the interface for content moderation operations
+type ModerationServiceInterface interface {
+	CheckContent(ctx context.Context, imageURL string, texts ...string) error
+	CheckPublishable(ctx context.Context, wishlistID pgtype.UUID) error
+	ReportWishlist(ctx context.Context, input ReportInput) error
+	ListReports(ctx context.Context, status string, limit, offset int) ([]*ReportOutput, int, error)
+	DismissReport(ctx context.Context, input ReviewInput) (*ReportOutput, error)
+	TakeDown(ctx context.Context, input ReviewInput) (*ReportOutput, error)
+	Restore(ctx context.Context, wishlistID string) error
+}
+
+type ModerationService struct {
+	repo   repository.ModerationRepositoryInterface
+	filter *moderation.TextFilter
+	images moderation.ImageModerator
+	cache  CacheInterface
+}
+
+// NewModerationService creates a new ModerationService.
+// images may be moderation.NoopImageModerator to skip image checks; cache may be nil.
+func NewModerationService(
+	repo repository.ModerationRepositoryInterface,
+	filter *moderation.TextFilter,
+	images moderation.ImageModerator,
+	cache CacheInterface,
+) *ModerationService {
+	return &ModerationService{
+		repo:   repo,
+		filter: filter,
+		images: images,
+		cache:  cache,
+	}
+}
+
+type ReportInput struct {
+	PublicSlug     string
+	ReporterUserID pgtype.UUID // Zero for guest reports
+	Reason         string
+	Details        string
+}
+
+type ReviewInput struct {
+	ReportID   string
+	ReviewerID pgtype.UUID
+	Note       *string // Kept with the takedown; ignored when dismissing
+}
+
+type ReportOutput struct {
+	ID             pgtype.UUID
+	WishlistID     pgtype.UUID
+	WishlistTitle  string
+	PublicSlug     pgtype.Text
+	OwnerID        pgtype.UUID
+	Reason         string
+	Details        pgtype.Text
+	ReporterUserID pgtype.UUID
+	Status         string
+	ReviewedAt     pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+// CheckContent screens texts against the blocklists and, when imageURL is set, the
+// image with the moderation provider. Fails with *ContentRejectedError. The provider
+// being unavailable does not block authors: the image is let through and the failure logged.
+func (s *ModerationService) CheckContent(ctx context.Context, imageURL string, texts ...string) error {
+	if err := s.filter.Check(texts...); err != nil {
+		return &ContentRejectedError{Reason: err.Error()}
+	}
+	if err := s.filter.Check(imageURL); err != nil {
+		return &ContentRejectedError{Reason: err.Error()}
+	}
+
+	if imageURL == "" {
+		return nil
+	}
+
+	verdict, err := s.images.CheckImage(ctx, imageURL)
+	if err != nil {
+		logger.WarnContext(ctx, "image moderation unavailable, image accepted unchecked",
+			"error", err)
+		return nil
+	}
+	if verdict.Flagged {
+		logger.InfoContext(ctx, "image rejected by moderation",
+			"labels", strings.Join(verdict.Labels, ","))
+		return &ContentRejectedError{Reason: "image was flagged as inappropriate"}
+	}
+
+	return nil
+}
+
+// CheckPublishable fails with ErrWishlistTakenDown while the wishlist is taken down
+func (s *ModerationService) CheckPublishable(ctx context.Context, wishlistID pgtype.UUID) error {
+	takenDown, err := s.repo.IsTakenDown(ctx, wishlistID)
+	if err != nil {
+		return fmt.Errorf("failed to check takedown: %w", err)
+	}
+	if takenDown {
+		return ErrWishlistTakenDown
+	}
+
+	return nil
+}
+
+// ReportWishlist files a report against a public wishlist for admin review
+func (s *ModerationService) ReportWishlist(ctx context.Context, input ReportInput) error {
+	switch input.Reason {
+	case models.ReasonSpam, models.ReasonOffensive, models.ReasonInappropriateImage, models.ReasonScam, models.ReasonOther:
+	default:
+		return ErrInvalidReason
+	}
+
+	wishlistID, err := s.repo.GetPublicWishlistID(ctx, input.PublicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrWishlistNotFound) {
+			return ErrWishlistNotFound
+		}
+		return fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	details := strings.TrimSpace(input.Details)
+	report, err := s.repo.CreateReport(ctx, models.Report{
+		WishlistID:     wishlistID,
+		Reason:         input.Reason,
+		Details:        pgtype.Text{String: details, Valid: details != ""},
+		ReporterUserID: input.ReporterUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+
+	logger.InfoContext(ctx, "wishlist reported",
+		"report_id", report.ID.String(),
+		"wishlist_id", wishlistID.String(),
+		"reason", input.Reason)
+
+	return nil
+}
+
+// ListReports returns the review queue: reports with the given status (default pending), oldest first
+func (s *ModerationService) ListReports(ctx context.Context, status string, limit, offset int) ([]*ReportOutput, int, error) {
+	if status == "" {
+		status = models.StatusPending
+	}
+
+	switch status {
+	case models.StatusPending, models.StatusDismissed, models.StatusActioned:
+	default:
+		return nil, 0, ErrInvalidReportStatus
+	}
+
+	total, err := s.repo.CountReports(ctx, status)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	reports, err := s.repo.ListReports(ctx, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	outputs := make([]*ReportOutput, len(reports))
+	for i, report := range reports {
+		outputs[i] = toReportOutput(report)
+	}
+
+	return outputs, total, nil
+}
+
+// DismissReport closes a pending report and leaves the wishlist as it is
+func (s *ModerationService) DismissReport(ctx context.Context, input ReviewInput) (*ReportOutput, error) {
+	report, err := s.getReviewableReport(ctx, input.ReportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DismissReport(ctx, report.ID, input.ReviewerID); err != nil {
+		if errors.Is(err, repository.ErrReportNotFound) {
+			return nil, ErrReportNotReviewable
+		}
+		return nil, fmt.Errorf("failed to dismiss report: %w", err)
+	}
+
+	logger.InfoContext(ctx, "report dismissed", "report_id", report.ID.String())
+
+	return s.getReport(ctx, report.ID)
+}
+
+// TakeDown makes the reported wishlist private, keeps its owner from publishing it
+// again and closes every pending report on it
+func (s *ModerationService) TakeDown(ctx context.Context, input ReviewInput) (*ReportOutput, error) {
+	report, err := s.getReviewableReport(ctx, input.ReportID)
+	if err != nil {
+		return nil, err
+	}
+
+	var note pgtype.Text
+	if input.Note != nil {
+		trimmed := strings.TrimSpace(*input.Note)
+		note = pgtype.Text{String: trimmed, Valid: trimmed != ""}
+	}
+
+	closed, err := s.repo.TakeDown(ctx, models.Takedown{
+		WishlistID:  report.WishlistID,
+		Reason:      report.Reason,
+		Note:        note,
+		TakenDownBy: input.ReviewerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to take down wishlist: %w", err)
+	}
+
+	if s.cache != nil && report.PublicSlug.Valid {
+		for _, format := range []string{"wishlist:public:%s", "wishlist:share-page:%s"} {
+			cacheKey := fmt.Sprintf(format, report.PublicSlug.String)
+			if err := s.cache.Delete(ctx, cacheKey); err != nil {
+				logger.WarnContext(ctx, "failed to invalidate wishlist cache", "error", err, "cache_key", cacheKey)
+			}
+		}
+	}
+
+	logger.InfoContext(ctx, "wishlist taken down",
+		"report_id", report.ID.String(),
+		"wishlist_id", report.WishlistID.String(),
+		"reports_closed", closed)
+
+	return s.getReport(ctx, report.ID)
+}
+
+// Restore lifts a takedown. The wishlist stays private until its owner publishes it again.
+func (s *ModerationService) Restore(ctx context.Context, wishlistID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(wishlistID); err != nil {
+		return ErrInvalidWishlistID
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrTakedownNotFound) {
+			return ErrWishlistNotTakenDown
+		}
+		return fmt.Errorf("failed to restore wishlist: %w", err)
+	}
+
+	logger.InfoContext(ctx, "wishlist restored", "wishlist_id", id.String())
+
+	return nil
+}
+
+func (s *ModerationService) getReviewableReport(ctx context.Context, reportID string) (*models.Report, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(reportID); err != nil {
+		return nil, ErrInvalidReportID
+	}
+
+	report, err := s.repo.GetReport(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrReportNotFound) {
+			return nil, ErrReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if report.Status != models.StatusPending {
+		return nil, ErrReportNotReviewable
+	}
+
+	return report, nil
+}
+
+func (s *ModerationService) getReport(ctx context.Context, id pgtype.UUID) (*ReportOutput, error) {
+	report, err := s.repo.GetReport(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return toReportOutput(report), nil
+}
+
+func toReportOutput(report *models.Report) *ReportOutput {
+	return &ReportOutput{
+		ID:             report.ID,
+		WishlistID:     report.WishlistID,
+		WishlistTitle:  report.WishlistTitle,
+		PublicSlug:     report.PublicSlug,
+		OwnerID:        report.OwnerID,
+		Reason:         report.Reason,
+		Details:        report.Details,
+		ReporterUserID: report.ReporterUserID,
+		Status:         report.Status,
+		ReviewedAt:     report.ReviewedAt,
+		CreatedAt:      report.CreatedAt,
+	}
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wish-list/internal/domain/moderation/models"
+	"wish-list/internal/domain/moderation/repository"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/moderation"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testReportID   = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testReviewerID = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testWishlistID = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+)
+
+// stubImageModerator returns a fixed verdict and records the checked URLs
+type stubImageModerator struct {
+	verdict moderation.Verdict
+	err     error
+	checked []string
+}
+
+func (m *stubImageModerator) CheckImage(_ context.Context, imageURL string) (moderation.Verdict, error) {
+	m.checked = append(m.checked, imageURL)
+	return m.verdict, m.err
+}
+
+func newTestService(repo *ModerationRepositoryInterfaceMock, images moderation.ImageModerator, cache CacheInterface) *ModerationService {
+	filter := moderation.NewTextFilter([]string{"darn"}, []string{"spam.example"})
+	return NewModerationService(repo, filter, images, cache)
+}
+
+func pendingReport() *models.Report {
+	return &models.Report{
+		ID:         testReportID,
+		WishlistID: testWishlistID,
+		PublicSlug: pgtype.Text{String: "birthday", Valid: true},
+		Reason:     models.ReasonOffensive,
+		Status:     models.StatusPending,
+	}
+}
+
+func TestModerationService_CheckContent(t *testing.T) {
+	t.Run("clean content passes", func(t *testing.T) {
+		images := &stubImageModerator{}
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, images, nil)
+
+		err := svc.CheckContent(context.Background(), "https://img.example/a.jpg", "Birthday", "Books please")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://img.example/a.jpg"}, images.checked)
+	})
+
+	t.Run("blocked word", func(t *testing.T) {
+		images := &stubImageModerator{}
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, images, nil)
+
+		err := svc.CheckContent(context.Background(), "https://img.example/a.jpg", "Darn list")
+
+		var rejected *ContentRejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.ErrorIs(t, err, ErrContentRejected)
+		assert.Empty(t, images.checked, "image check skipped once text is rejected")
+	})
+
+	t.Run("image on blocked domain", func(t *testing.T) {
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, &stubImageModerator{}, nil)
+
+		err := svc.CheckContent(context.Background(), "https://cdn.spam.example/a.jpg", "Birthday")
+
+		assert.ErrorIs(t, err, ErrContentRejected)
+	})
+
+	t.Run("flagged image", func(t *testing.T) {
+		images := &stubImageModerator{verdict: moderation.Verdict{Flagged: true, Labels: []string{"violence"}}}
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, images, nil)
+
+		err := svc.CheckContent(context.Background(), "https://img.example/a.jpg", "Birthday")
+
+		assert.ErrorIs(t, err, ErrContentRejected)
+	})
+
+	t.Run("provider failure lets image through", func(t *testing.T) {
+		images := &stubImageModerator{err: errors.New("timeout")}
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, images, nil)
+
+		err := svc.CheckContent(context.Background(), "https://img.example/a.jpg", "Birthday")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("no image skips provider", func(t *testing.T) {
+		images := &stubImageModerator{}
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, images, nil)
+
+		require.NoError(t, svc.CheckContent(context.Background(), "", "Birthday"))
+		assert.Empty(t, images.checked)
+	})
+}
+
+func TestModerationService_CheckPublishable(t *testing.T) {
+	t.Run("taken down", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			IsTakenDownFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+				return true, nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		assert.ErrorIs(t, svc.CheckPublishable(context.Background(), testWishlistID), ErrWishlistTakenDown)
+	})
+
+	t.Run("not taken down", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			IsTakenDownFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		assert.NoError(t, svc.CheckPublishable(context.Background(), testWishlistID))
+	})
+}
+
+func TestModerationService_ReportWishlist(t *testing.T) {
+	t.Run("creates pending report", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			GetPublicWishlistIDFunc: func(ctx context.Context, publicSlug string) (pgtype.UUID, error) {
+				return testWishlistID, nil
+			},
+			CreateReportFunc: func(ctx context.Context, report models.Report) (*models.Report, error) {
+				report.ID = testReportID
+				return &report, nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		err := svc.ReportWishlist(context.Background(), ReportInput{
+			PublicSlug: "birthday",
+			Reason:     models.ReasonSpam,
+			Details:    "  selling followers  ",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, repo.CreateReportCalls(), 1)
+		created := repo.CreateReportCalls()[0].Report
+		assert.Equal(t, testWishlistID, created.WishlistID)
+		assert.Equal(t, "selling followers", created.Details.String)
+		assert.False(t, created.ReporterUserID.Valid)
+	})
+
+	t.Run("invalid reason", func(t *testing.T) {
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, moderation.NoopImageModerator{}, nil)
+
+		err := svc.ReportWishlist(context.Background(), ReportInput{PublicSlug: "birthday", Reason: "boring"})
+
+		assert.ErrorIs(t, err, ErrInvalidReason)
+	})
+
+	t.Run("wishlist not public", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			GetPublicWishlistIDFunc: func(ctx context.Context, publicSlug string) (pgtype.UUID, error) {
+				return pgtype.UUID{}, repository.ErrWishlistNotFound
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		err := svc.ReportWishlist(context.Background(), ReportInput{PublicSlug: "gone", Reason: models.ReasonSpam})
+
+		assert.ErrorIs(t, err, ErrWishlistNotFound)
+	})
+}
+
+func TestModerationService_ListReports(t *testing.T) {
+	t.Run("defaults to pending", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			CountReportsFunc: func(ctx context.Context, status string) (int, error) {
+				return 1, nil
+			},
+			ListReportsFunc: func(ctx context.Context, status string, limit, offset int) ([]*models.Report, error) {
+				return []*models.Report{pendingReport()}, nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		reports, total, err := svc.ListReports(context.Background(), "", 10, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, reports, 1)
+		assert.Equal(t, testReportID, reports[0].ID)
+		assert.Equal(t, models.StatusPending, repo.ListReportsCalls()[0].Status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, moderation.NoopImageModerator{}, nil)
+
+		_, _, err := svc.ListReports(context.Background(), "archived", 10, 0)
+
+		assert.ErrorIs(t, err, ErrInvalidReportStatus)
+	})
+}
+
+func TestModerationService_TakeDown(t *testing.T) {
+	t.Run("takes down wishlist and invalidates cache", func(t *testing.T) {
+		actioned := pendingReport()
+		actioned.Status = models.StatusActioned
+		getCalls := 0
+		repo := &ModerationRepositoryInterfaceMock{
+			GetReportFunc: func(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+				getCalls++
+				if getCalls == 1 {
+					return pendingReport(), nil
+				}
+				return actioned, nil
+			},
+			TakeDownFunc: func(ctx context.Context, takedown models.Takedown) (int64, error) {
+				return 2, nil
+			},
+		}
+		cache := &CacheInterfaceMock{
+			DeleteFunc: func(ctx context.Context, key string) error {
+				return nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, cache)
+		note := " hate speech "
+
+		report, err := svc.TakeDown(context.Background(), ReviewInput{
+			ReportID:   testReportID.String(),
+			ReviewerID: testReviewerID,
+			Note:       &note,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusActioned, report.Status)
+		require.Len(t, repo.TakeDownCalls(), 1)
+		takedown := repo.TakeDownCalls()[0].Takedown
+		assert.Equal(t, testWishlistID, takedown.WishlistID)
+		assert.Equal(t, models.ReasonOffensive, takedown.Reason)
+		assert.Equal(t, "hate speech", takedown.Note.String)
+		assert.Equal(t, testReviewerID, takedown.TakenDownBy)
+		require.Len(t, cache.DeleteCalls(), 2)
+		assert.Equal(t, "wishlist:public:birthday", cache.DeleteCalls()[0].Key)
+		assert.Equal(t, "wishlist:share-page:birthday", cache.DeleteCalls()[1].Key)
+	})
+
+	t.Run("report already reviewed", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			GetReportFunc: func(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+				report := pendingReport()
+				report.Status = models.StatusDismissed
+				return report, nil
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		_, err := svc.TakeDown(context.Background(), ReviewInput{ReportID: testReportID.String(), ReviewerID: testReviewerID})
+
+		assert.ErrorIs(t, err, ErrReportNotReviewable)
+		assert.Empty(t, repo.TakeDownCalls())
+	})
+
+	t.Run("invalid report id", func(t *testing.T) {
+		svc := newTestService(&ModerationRepositoryInterfaceMock{}, moderation.NoopImageModerator{}, nil)
+
+		_, err := svc.TakeDown(context.Background(), ReviewInput{ReportID: "not-a-uuid"})
+
+		assert.ErrorIs(t, err, ErrInvalidReportID)
+	})
+}
+
+func TestModerationService_DismissReport(t *testing.T) {
+	t.Run("dismissed concurrently", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			GetReportFunc: func(ctx context.Context, id pgtype.UUID) (*models.Report, error) {
+				return pendingReport(), nil
+			},
+			DismissReportFunc: func(ctx context.Context, id, reviewerID pgtype.UUID) error {
+				return repository.ErrReportNotFound
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		_, err := svc.DismissReport(context.Background(), ReviewInput{ReportID: testReportID.String(), ReviewerID: testReviewerID})
+
+		assert.ErrorIs(t, err, ErrReportNotReviewable)
+	})
+}
+
+func TestModerationService_Restore(t *testing.T) {
+	t.Run("not taken down", func(t *testing.T) {
+		repo := &ModerationRepositoryInterfaceMock{
+			RestoreFunc: func(ctx context.Context, wishlistID pgtype.UUID) error {
+				return repository.ErrTakedownNotFound
+			},
+		}
+		svc := newTestService(repo, moderation.NoopImageModerator{}, nil)
+
+		err := svc.Restore(context.Background(), testWishlistID.String())
+
+		assert.ErrorIs(t, err, ErrWishlistNotTakenDown)
+	})
+}
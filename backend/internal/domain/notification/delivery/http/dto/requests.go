@@ -0,0 +1,12 @@
+package dto
+
+// UpdatePreferencesRequest changes notification preferences; omitted fields are left as they are
+type UpdatePreferencesRequest struct {
+	WeeklyDigest      *bool `json:"weekly_digest"`
+	PushNotifications *bool `json:"push_notifications"`
+}
+
+type RegisterDeviceRequest struct {
+	Platform string `json:"platform" validate:"required,oneof=android ios" enums:"android,ios"`
+	Token    string `json:"token" validate:"required,max=4096"`
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"wish-list/internal/domain/notification/service"
+)
+
+type PreferencesResponse struct {
+	WeeklyDigest      bool    `json:"weekly_digest" validate:"required"`
+	PushNotifications bool    `json:"push_notifications" validate:"required"`
+	UpdatedAt         *string `json:"updated_at"` // Null until the user first saves preferences
+}
+
+func FromPreferencesOutput(p *service.PreferencesOutput) PreferencesResponse {
+	resp := PreferencesResponse{
+		WeeklyDigest:      p.WeeklyDigest,
+		PushNotifications: p.PushNotifications,
+	}
+	if p.UpdatedAt.Valid {
+		updatedAt := p.UpdatedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
+
+type NotificationResponse struct {
+	ID         string  `json:"id" validate:"required"`
+	Type       string  `json:"type" validate:"required" enums:"reservation_removed,item_purchased,new_follower,new_comment,occasion_reminder,gift_delivered"`
+	Title      string  `json:"title" validate:"required"`
+	Body       string  `json:"body" validate:"required"`
+	WishlistID *string `json:"wishlist_id"`
+	Read       bool    `json:"read" validate:"required"`
+	ReadAt     *string `json:"read_at"`
+	CreatedAt  string  `json:"created_at" validate:"required"`
+}
+
+type NotificationListResponse struct {
+	Data        []NotificationResponse `json:"data" validate:"required"`
+	NextCursor  *string                `json:"next_cursor"` // Null on the last page
+	UnreadCount int                    `json:"unread_count" validate:"required" example:"3"`
+}
+
+func FromNotificationOutput(n *service.NotificationOutput) NotificationResponse {
+	resp := NotificationResponse{
+		ID:        n.ID.String(),
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Read:      n.ReadAt.Valid,
+		CreatedAt: n.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if n.WishlistID.Valid {
+		wishlistID := n.WishlistID.String()
+		resp.WishlistID = &wishlistID
+	}
+	if n.ReadAt.Valid {
+		readAt := n.ReadAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.ReadAt = &readAt
+	}
+	return resp
+}
+
+func FromNotificationListOutput(list *service.NotificationListOutput) NotificationListResponse {
+	resp := NotificationListResponse{
+		Data:        make([]NotificationResponse, len(list.Notifications)),
+		UnreadCount: list.UnreadCount,
+	}
+	for i, n := range list.Notifications {
+		resp.Data[i] = FromNotificationOutput(n)
+	}
+	if list.NextCursor != "" {
+		resp.NextCursor = &list.NextCursor
+	}
+	return resp
+}
+
+type DeviceResponse struct {
+	ID         string `json:"id" validate:"required"`
+	Platform   string `json:"platform" validate:"required" enums:"android,ios"`
+	CreatedAt  string `json:"created_at" validate:"required"`
+	LastSeenAt string `json:"last_seen_at" validate:"required"`
+}
+
+func FromDeviceOutput(d *service.DeviceOutput) DeviceResponse {
+	return DeviceResponse{
+		ID:         d.ID.String(),
+		Platform:   d.Platform,
+		CreatedAt:  d.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		LastSeenAt: d.LastSeenAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/notification/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapNotificationServiceError converts notification service errors to AppErrors
+func mapNotificationServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidNotificationID):
+		return apperrors.BadRequest("Invalid notification ID")
+	case errors.Is(err, service.ErrInvalidCursor):
+		return apperrors.BadRequest("Invalid cursor")
+	case errors.Is(err, service.ErrNotificationNotFound):
+		return apperrors.NotFound("Notification not found")
+	case errors.Is(err, service.ErrInvalidDeviceID):
+		return apperrors.BadRequest("Invalid device ID")
+	case errors.Is(err, service.ErrInvalidPlatform):
+		return apperrors.BadRequest("Platform must be android or ios")
+	case errors.Is(err, service.ErrDeviceTokenRequired):
+		return apperrors.BadRequest("Device token is required")
+	case errors.Is(err, service.ErrDeviceNotFound):
+		return apperrors.NotFound("Device not found")
+	default:
+		return apperrors.Internal("Failed to process notification").Wrap(err)
+	}
+}
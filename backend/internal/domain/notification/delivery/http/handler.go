@@ -0,0 +1,209 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/notification/delivery/http/dto"
+	"wish-list/internal/domain/notification/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for notification settings
+type Handler struct {
+	service service.NotificationServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.NotificationServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// GetPreferences godoc
+//
+//	@Summary		Get notification preferences
+//	@Description	Which optional emails the current user has opted in to. Every optional email is off until enabled.
+//	@Tags			Notifications
+//	@Produce		json
+//	@Success		200	{object}	dto.PreferencesResponse	"Notification preferences"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/notifications/preferences [get]
+func (h *Handler) GetPreferences(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	prefs, err := h.service.GetPreferences(c.Request().Context(), userID)
+	if err != nil {
+		return apperrors.Internal("Failed to get notification preferences").Wrap(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPreferencesOutput(prefs))
+}
+
+// UpdatePreferences godoc
+//
+//	@Summary		Update notification preferences
+//	@Description	Opt in to or out of optional emails such as the weekly digest, and turn push notifications on or off for all devices. Omitted fields are left unchanged.
+//	@Tags			Notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.UpdatePreferencesRequest	true	"Preferences to change"
+//	@Success		200		{object}	dto.PreferencesResponse			"Updated notification preferences"
+//	@Failure		400		{object}	map[string]string				"Invalid request body"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/notifications/preferences [put]
+func (h *Handler) UpdatePreferences(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdatePreferencesRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	prefs, err := h.service.UpdatePreferences(c.Request().Context(), userID, service.UpdatePreferencesInput{
+		WeeklyDigest:      req.WeeklyDigest,
+		PushNotifications: req.PushNotifications,
+	})
+	if err != nil {
+		return apperrors.Internal("Failed to update notification preferences").Wrap(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPreferencesOutput(prefs))
+}
+
+// ListNotifications godoc
+//
+//	@Summary		List notifications
+//	@Description	The current user's notification center, newest first, with the number of unread notifications. Pass next_cursor from the previous page as cursor to get the next one.
+//	@Tags			Notifications
+//	@Produce		json
+//	@Param			cursor	query		string							false	"Cursor from the previous page"
+//	@Param			limit	query		int								false	"Notifications per page (default 20, max 100)"
+//	@Success		200		{object}	dto.NotificationListResponse	"Page of notifications"
+//	@Failure		400		{object}	map[string]string				"Invalid cursor or limit"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/notifications [get]
+func (h *Handler) ListNotifications(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var limit int
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil {
+			return apperrors.BadRequest("limit must be a number")
+		}
+	}
+
+	list, err := h.service.ListNotifications(c.Request().Context(), userID, c.QueryParam("cursor"), limit)
+	if err != nil {
+		return mapNotificationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromNotificationListOutput(list))
+}
+
+// MarkNotificationRead godoc
+//
+//	@Summary		Mark a notification as read
+//	@Tags			Notifications
+//	@Produce		json
+//	@Param			id	path		string						true	"Notification ID"
+//	@Success		200	{object}	dto.NotificationResponse	"Notification marked as read"
+//	@Failure		400	{object}	map[string]string			"Invalid notification ID"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		404	{object}	map[string]string			"Notification not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/notifications/{id}/read [post]
+func (h *Handler) MarkNotificationRead(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	notification, err := h.service.MarkRead(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return mapNotificationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromNotificationOutput(notification))
+}
+
+// RegisterDevice godoc
+//
+//	@Summary		Register a device for push notifications
+//	@Description	Called by the mobile app with its FCM (android) or APNs (ios) token after sign-in and whenever the token changes. Registering a known token refreshes it.
+//	@Tags			Notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.RegisterDeviceRequest	true	"Device token"
+//	@Success		201		{object}	dto.DeviceResponse			"Device registered"
+//	@Failure		400		{object}	map[string]string			"Invalid request body"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/devices [post]
+func (h *Handler) RegisterDevice(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.RegisterDeviceRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	device, err := h.service.RegisterDevice(c.Request().Context(), userID, req.Platform, req.Token)
+	if err != nil {
+		return mapNotificationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromDeviceOutput(device))
+}
+
+// UnregisterDevice godoc
+//
+//	@Summary		Unregister a device
+//	@Description	Stop push notifications to a device, e.g. on sign-out.
+//	@Tags			Notifications
+//	@Param			id	path	string	true	"Device ID"
+//	@Success		204	"Device unregistered"
+//	@Failure		400	{object}	map[string]string	"Invalid device ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Device not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/devices/{id} [delete]
+func (h *Handler) UnregisterDevice(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.UnregisterDevice(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return mapNotificationServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
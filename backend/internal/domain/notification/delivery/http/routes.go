@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers notification HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	notifications := e.Group("/api/notifications", authMiddleware)
+	notifications.GET("/preferences", h.GetPreferences)
+	notifications.PUT("/preferences", h.UpdatePreferences)
+
+	// Notification center
+	center := e.Group("/api/protected/notifications", authMiddleware)
+	center.GET("", h.ListNotifications)
+	center.POST("/:id/read", h.MarkNotificationRead)
+
+	// Push notification devices
+	devices := e.Group("/api/protected/devices", authMiddleware)
+	devices.POST("", h.RegisterDevice)
+	devices.DELETE("/:id", h.UnregisterDevice)
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Preferences holds a user's opt-ins for optional emails and push notifications
+type Preferences struct {
+	UserID            pgtype.UUID        `db:"user_id"`
+	WeeklyDigest      bool               `db:"weekly_digest"`
+	PushNotifications bool               `db:"push_notifications"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at"`
+}
+
+// DefaultPreferences are used for users who never saved preferences: optional
+// emails are off, push is on for any device the user registers
+func DefaultPreferences(userID pgtype.UUID) *Preferences {
+	return &Preferences{UserID: userID, PushNotifications: true}
+}
+
+// DigestStats is the activity across an owner's wishlists during one digest period
+type DigestStats struct {
+	NewViews        int `db:"new_views"`
+	NewReservations int `db:"new_reservations"`
+	Purchases       int `db:"purchases"`
+	NewComments     int `db:"new_comments"`
+	NewSuggestions  int `db:"new_suggestions"`
+	Upcoming        []*UpcomingOccasion
+}
+
+// UpcomingOccasion is an owner's wishlist whose occasion falls shortly after the digest period
+type UpcomingOccasion struct {
+	WishlistID   pgtype.UUID `db:"wishlist_id"`
+	Title        string      `db:"title"`
+	OccasionDate pgtype.Date `db:"occasion_date"`
+}
+
+// IsEmpty reports whether there is nothing to tell the owner about
+func (s *DigestStats) IsEmpty() bool {
+	return s.NewViews == 0 && s.NewReservations == 0 && s.Purchases == 0 &&
+		s.NewComments == 0 && s.NewSuggestions == 0 && len(s.Upcoming) == 0
+}
+
+// Notification is an in-app message shown in a user's notification center
+type Notification struct {
+	ID         pgtype.UUID        `db:"id"`
+	UserID     pgtype.UUID        `db:"user_id"`
+	Type       string             `db:"type"`
+	Title      string             `db:"title"`
+	Body       string             `db:"body"`
+	WishlistID pgtype.UUID        `db:"wishlist_id"`
+	ReadAt     pgtype.Timestamptz `db:"read_at"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+}
+
+// Notification types stored in notifications.type
+const (
+	TypeReservationRemoved = "reservation_removed"
+	TypeItemPurchased      = "item_purchased"
+	TypeNewFollower        = "new_follower"
+	TypeNewComment         = "new_comment"
+	TypeOccasionReminder   = "occasion_reminder"
+	TypeGiftDelivered      = "gift_delivered"
+)
+
+// PendingPush is a notification waiting in the push outbox
+type PendingPush struct {
+	Notification
+	PushAttempts int  `db:"push_attempts"`
+	PushEnabled  bool `db:"push_enabled"` // The recipient's push preference
+}
+
+// Device is a mobile device registered to receive push notifications
+type Device struct {
+	ID         pgtype.UUID        `db:"id"`
+	UserID     pgtype.UUID        `db:"user_id"`
+	Platform   string             `db:"platform"` // push.PlatformAndroid or push.PlatformIOS
+	Token      string             `db:"token"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+	LastSeenAt pgtype.Timestamptz `db:"last_seen_at"`
+}
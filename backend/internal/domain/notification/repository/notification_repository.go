@@ -0,0 +1,379 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_notification_repository_test.go -pkg service . NotificationRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/notification/models"
+)
+
+// Sentinel errors for notification repository
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrDeviceNotFound       = errors.New("device not found")
+)
+
+const (
+	notificationColumns = `id, user_id, type, title, body, wishlist_id, read_at, created_at`
+	preferenceColumns   = `user_id, weekly_digest, push_notifications, updated_at`
+	deviceColumns       = `id, user_id, platform, token, created_at, last_seen_at`
+)
+
+// upcomingOccasionWindow is how far past the digest period occasions are listed as upcoming
+const upcomingOccasionWindow = 14 * 24 * time.Hour
+
+// NotificationRepositoryInterface defines the interface for notification database operations
+type NotificationRepositoryInterface interface {
+	GetPreferences(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error)
+	UpsertPreferences(ctx context.Context, prefs models.Preferences) (*models.Preferences, error)
+	ListDigestRecipients(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error)
+	GetDigestStats(ctx context.Context, ownerID pgtype.UUID, from, to time.Time) (*models.DigestStats, error)
+	ClaimDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error)
+	ReleaseDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error
+	Create(ctx context.Context, notification models.Notification) (*models.Notification, error)
+	ListByUser(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error)
+	CountUnread(ctx context.Context, userID pgtype.UUID) (int, error)
+	MarkRead(ctx context.Context, id, userID pgtype.UUID) (*models.Notification, error)
+	RegisterDevice(ctx context.Context, device models.Device) (*models.Device, error)
+	DeleteDevice(ctx context.Context, id, userID pgtype.UUID) error
+	ListDevices(ctx context.Context, userID pgtype.UUID) ([]*models.Device, error)
+	DeleteDeviceToken(ctx context.Context, token string) error
+	ListPendingPush(ctx context.Context, limit int) ([]*models.PendingPush, error)
+	MarkPushed(ctx context.Context, id pgtype.UUID) error
+	RecordPushFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+}
+
+type NotificationRepository struct {
+	db *database.DB
+}
+
+func NewNotificationRepository(db *database.DB) NotificationRepositoryInterface {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// GetPreferences returns the user's preferences, or the defaults if they never saved any
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error) {
+	query := `SELECT ` + preferenceColumns + ` FROM notification_preferences WHERE user_id = $1`
+
+	var prefs models.Preferences
+	err := r.db.GetContext(ctx, &prefs, query, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.DefaultPreferences(userID), nil
+		}
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return &prefs, nil
+}
+
+// UpsertPreferences saves the user's preferences
+func (r *NotificationRepository) UpsertPreferences(ctx context.Context, prefs models.Preferences) (*models.Preferences, error) {
+	query := `
+		INSERT INTO notification_preferences (user_id, weekly_digest, push_notifications)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			weekly_digest = EXCLUDED.weekly_digest,
+			push_notifications = EXCLUDED.push_notifications,
+			updated_at = NOW()
+		RETURNING ` + preferenceColumns
+
+	var saved models.Preferences
+	if err := r.db.QueryRowxContext(ctx, query, prefs.UserID, prefs.WeeklyDigest, prefs.PushNotifications).StructScan(&saved); err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// ListDigestRecipients returns active users opted in to the weekly digest who have
+// not been sent the digest for the period starting at periodStart
+func (r *NotificationRepository) ListDigestRecipients(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error) {
+	query := `
+		SELECT p.user_id
+		FROM notification_preferences p
+		JOIN users u ON u.id = p.user_id
+		WHERE p.weekly_digest
+		  AND u.deactivated_at IS NULL
+		  AND u.deletion_requested_at IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM digest_sends d WHERE d.user_id = p.user_id AND d.period_start = $1
+		  )
+		ORDER BY p.user_id
+	`
+
+	var userIDs []pgtype.UUID
+	if err := r.db.SelectContext(ctx, &userIDs, query, periodStart); err != nil {
+		return nil, fmt.Errorf("failed to list digest recipients: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// GetDigestStats counts activity on the owner's wishlists in [from, to) and lists
+// occasions in the two weeks after to
+func (r *NotificationRepository) GetDigestStats(ctx context.Context, ownerID pgtype.UUID, from, to time.Time) (*models.DigestStats, error) {
+	query := `
+		SELECT
+			(SELECT COALESCE(SUM(v.views), 0)
+			   FROM wishlist_daily_views v JOIN wishlists w ON w.id = v.wishlist_id
+			  WHERE w.owner_id = $1 AND v.day >= $2::date AND v.day < $3::date) AS new_views,
+			(SELECT COUNT(*)
+			   FROM reservations r JOIN wishlists w ON w.id = r.wishlist_id
+			  WHERE w.owner_id = $1 AND r.reserved_at >= $2 AND r.reserved_at < $3) AS new_reservations,
+			(SELECT COUNT(*)
+			   FROM gift_items gi
+			  WHERE gi.owner_id = $1 AND gi.purchased_at >= $2 AND gi.purchased_at < $3) AS purchases,
+			(SELECT COUNT(*)
+			   FROM item_comments c JOIN wishlists w ON w.id = c.wishlist_id
+			  WHERE w.owner_id = $1 AND c.created_at >= $2 AND c.created_at < $3
+			    AND c.author_user_id IS DISTINCT FROM w.owner_id) AS new_comments,
+			(SELECT COUNT(*)
+			   FROM item_suggestions s JOIN wishlists w ON w.id = s.wishlist_id
+			  WHERE w.owner_id = $1 AND s.created_at >= $2 AND s.created_at < $3) AS new_suggestions
+	`
+
+	var stats models.DigestStats
+	if err := r.db.GetContext(ctx, &stats, query, ownerID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get digest stats: %w", err)
+	}
+
+	upcomingQuery := `
+		SELECT id AS wishlist_id, title, occasion_date
+		FROM wishlists
+		WHERE owner_id = $1 AND occasion_date >= $2::date AND occasion_date < $3::date
+		ORDER BY occasion_date ASC
+		LIMIT 5
+	`
+
+	if err := r.db.SelectContext(ctx, &stats.Upcoming, upcomingQuery, ownerID, to, to.Add(upcomingOccasionWindow)); err != nil {
+		return nil, fmt.Errorf("failed to list upcoming occasions: %w", err)
+	}
+
+	return &stats, nil
+}
+
+// ClaimDigestSend records that the user's digest for the period is being sent. It
+// returns false if the digest was already claimed, so concurrent runs send it once.
+func (r *NotificationRepository) ClaimDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error) {
+	query := `INSERT INTO digest_sends (user_id, period_start) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query, userID, periodStart)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim digest send: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// ReleaseDigestSend drops a claim whose email could not be sent, so the next run retries it
+func (r *NotificationRepository) ReleaseDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error {
+	query := `DELETE FROM digest_sends WHERE user_id = $1 AND period_start = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, periodStart); err != nil {
+		return fmt.Errorf("failed to release digest send: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new unread notification
+func (r *NotificationRepository) Create(ctx context.Context, notification models.Notification) (*models.Notification, error) {
+	query := `
+		INSERT INTO notifications (user_id, type, title, body, wishlist_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + notificationColumns
+
+	var created models.Notification
+	err := r.db.QueryRowxContext(ctx, query,
+		notification.UserID,
+		notification.Type,
+		notification.Title,
+		notification.Body,
+		notification.WishlistID,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return &created, nil
+}
+
+// ListByUser returns up to limit of the user's notifications, newest first. When after
+// is set only notifications older than it are returned, so the last notification of a
+// page is the cursor for the next one.
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE user_id = $1`
+	args := []any{userID}
+	if after != nil {
+		query += ` AND (created_at, id) < ($2, $3)`
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	query += fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, limit)
+
+	var notifications []*models.Notification
+	if err := r.db.SelectContext(ctx, &notifications, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// CountUnread returns how many of the user's notifications are unread
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID pgtype.UUID) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// MarkRead marks one of the user's notifications as read. Marking a read notification
+// again keeps its original read time.
+func (r *NotificationRepository) MarkRead(ctx context.Context, id, userID pgtype.UUID) (*models.Notification, error) {
+	query := `
+		UPDATE notifications SET read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + notificationColumns
+
+	var notification models.Notification
+	err := r.db.QueryRowxContext(ctx, query, id, userID).StructScan(&notification)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// RegisterDevice saves a device token for the user. A token registered before,
+// by this or another user, is moved to this user and marked as seen.
+func (r *NotificationRepository) RegisterDevice(ctx context.Context, device models.Device) (*models.Device, error) {
+	query := `
+		INSERT INTO device_tokens (user_id, platform, token)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (token) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			platform = EXCLUDED.platform,
+			last_seen_at = NOW()
+		RETURNING ` + deviceColumns
+
+	var saved models.Device
+	if err := r.db.QueryRowxContext(ctx, query, device.UserID, device.Platform, device.Token).StructScan(&saved); err != nil {
+		return nil, fmt.Errorf("failed to register device: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// DeleteDevice removes one of the user's devices
+func (r *NotificationRepository) DeleteDevice(ctx context.Context, id, userID pgtype.UUID) error {
+	query := `DELETE FROM device_tokens WHERE id = $1 AND user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrDeviceNotFound
+	}
+
+	return nil
+}
+
+// ListDevices returns the user's registered devices
+func (r *NotificationRepository) ListDevices(ctx context.Context, userID pgtype.UUID) ([]*models.Device, error) {
+	query := `SELECT ` + deviceColumns + ` FROM device_tokens WHERE user_id = $1 ORDER BY created_at ASC`
+
+	var devices []*models.Device
+	if err := r.db.SelectContext(ctx, &devices, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	return devices, nil
+}
+
+// DeleteDeviceToken forgets a token the push provider no longer accepts
+func (r *NotificationRepository) DeleteDeviceToken(ctx context.Context, token string) error {
+	query := `DELETE FROM device_tokens WHERE token = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, token); err != nil {
+		return fmt.Errorf("failed to delete device token: %w", err)
+	}
+
+	return nil
+}
+
+// ListPendingPush returns the oldest notifications not yet pushed, with the recipient's push preference
+func (r *NotificationRepository) ListPendingPush(ctx context.Context, limit int) ([]*models.PendingPush, error) {
+	query := `
+		SELECT n.id, n.user_id, n.type, n.title, n.body, n.wishlist_id, n.read_at, n.created_at,
+			n.push_attempts, COALESCE(p.push_notifications, TRUE) AS push_enabled
+		FROM notifications n
+		LEFT JOIN notification_preferences p ON p.user_id = n.user_id
+		WHERE n.pushed_at IS NULL
+		ORDER BY n.created_at ASC
+		LIMIT $1
+	`
+
+	var pending []*models.PendingPush
+	if err := r.db.SelectContext(ctx, &pending, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to list pending push notifications: %w", err)
+	}
+
+	return pending, nil
+}
+
+// MarkPushed takes a notification out of the push outbox
+func (r *NotificationRepository) MarkPushed(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE notifications SET pushed_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark notification pushed: %w", err)
+	}
+
+	return nil
+}
+
+// RecordPushFailure counts a failed push attempt. After maxAttempts the notification
+// leaves the outbox; it stays visible in the notification center.
+func (r *NotificationRepository) RecordPushFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+	query := `
+		UPDATE notifications SET
+			push_attempts = push_attempts + 1,
+			pushed_at = CASE WHEN push_attempts + 1 >= $2 THEN NOW() END
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, maxAttempts); err != nil {
+		return fmt.Errorf("failed to record push failure: %w", err)
+	}
+
+	return nil
+}
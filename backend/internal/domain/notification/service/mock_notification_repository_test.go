@@ -0,0 +1,939 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/notification/models"
+	"wish-list/internal/domain/notification/repository"
+)
+
+// Ensure, that NotificationRepositoryInterfaceMock does implement repository.NotificationRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.NotificationRepositoryInterface = &NotificationRepositoryInterfaceMock{}
+
+// NotificationRepositoryInterfaceMock is a mock implementation of repository.NotificationRepositoryInterface.
+//
+//	func TestSomethingThatUsesNotificationRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.NotificationRepositoryInterface
+//		mockedNotificationRepositoryInterface := &NotificationRepositoryInterfaceMock{
+//			ClaimDigestSendFunc: func(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error) {
+//				panic("mock out the ClaimDigestSend method")
+//			},
+//			CountUnreadFunc: func(ctx context.Context, userID pgtype.UUID) (int, error) {
+//				panic("mock out the CountUnread method")
+//			},
+//			CreateFunc: func(ctx context.Context, notification models.Notification) (*models.Notification, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteDeviceFunc: func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+//				panic("mock out the DeleteDevice method")
+//			},
+//			DeleteDeviceTokenFunc: func(ctx context.Context, token string) error {
+//				panic("mock out the DeleteDeviceToken method")
+//			},
+//			GetDigestStatsFunc: func(ctx context.Context, ownerID pgtype.UUID, from time.Time, to time.Time) (*models.DigestStats, error) {
+//				panic("mock out the GetDigestStats method")
+//			},
+//			GetPreferencesFunc: func(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error) {
+//				panic("mock out the GetPreferences method")
+//			},
+//			ListByUserFunc: func(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error) {
+//				panic("mock out the ListByUser method")
+//			},
+//			ListDevicesFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.Device, error) {
+//				panic("mock out the ListDevices method")
+//			},
+//			ListDigestRecipientsFunc: func(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error) {
+//				panic("mock out the ListDigestRecipients method")
+//			},
+//			ListPendingPushFunc: func(ctx context.Context, limit int) ([]*models.PendingPush, error) {
+//				panic("mock out the ListPendingPush method")
+//			},
+//			MarkPushedFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the MarkPushed method")
+//			},
+//			MarkReadFunc: func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.Notification, error) {
+//				panic("mock out the MarkRead method")
+//			},
+//			RecordPushFailureFunc: func(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+//				panic("mock out the RecordPushFailure method")
+//			},
+//			RegisterDeviceFunc: func(ctx context.Context, device models.Device) (*models.Device, error) {
+//				panic("mock out the RegisterDevice method")
+//			},
+//			ReleaseDigestSendFunc: func(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error {
+//				panic("mock out the ReleaseDigestSend method")
+//			},
+//			UpsertPreferencesFunc: func(ctx context.Context, prefs models.Preferences) (*models.Preferences, error) {
+//				panic("mock out the UpsertPreferences method")
+//			},
+//		}
+//
+//		// use mockedNotificationRepositoryInterface in code that requires repository.NotificationRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type NotificationRepositoryInterfaceMock struct {
+	// ClaimDigestSendFunc mocks the ClaimDigestSend method.
+	ClaimDigestSendFunc func(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error)
+
+	// CountUnreadFunc mocks the CountUnread method.
+	CountUnreadFunc func(ctx context.Context, userID pgtype.UUID) (int, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, notification models.Notification) (*models.Notification, error)
+
+	// DeleteDeviceFunc mocks the DeleteDevice method.
+	DeleteDeviceFunc func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error
+
+	// DeleteDeviceTokenFunc mocks the DeleteDeviceToken method.
+	DeleteDeviceTokenFunc func(ctx context.Context, token string) error
+
+	// GetDigestStatsFunc mocks the GetDigestStats method.
+	GetDigestStatsFunc func(ctx context.Context, ownerID pgtype.UUID, from time.Time, to time.Time) (*models.DigestStats, error)
+
+	// GetPreferencesFunc mocks the GetPreferences method.
+	GetPreferencesFunc func(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error)
+
+	// ListByUserFunc mocks the ListByUser method.
+	ListByUserFunc func(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error)
+
+	// ListDevicesFunc mocks the ListDevices method.
+	ListDevicesFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.Device, error)
+
+	// ListDigestRecipientsFunc mocks the ListDigestRecipients method.
+	ListDigestRecipientsFunc func(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error)
+
+	// ListPendingPushFunc mocks the ListPendingPush method.
+	ListPendingPushFunc func(ctx context.Context, limit int) ([]*models.PendingPush, error)
+
+	// MarkPushedFunc mocks the MarkPushed method.
+	MarkPushedFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// MarkReadFunc mocks the MarkRead method.
+	MarkReadFunc func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.Notification, error)
+
+	// RecordPushFailureFunc mocks the RecordPushFailure method.
+	RecordPushFailureFunc func(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+
+	// RegisterDeviceFunc mocks the RegisterDevice method.
+	RegisterDeviceFunc func(ctx context.Context, device models.Device) (*models.Device, error)
+
+	// ReleaseDigestSendFunc mocks the ReleaseDigestSend method.
+	ReleaseDigestSendFunc func(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error
+
+	// UpsertPreferencesFunc mocks the UpsertPreferences method.
+	UpsertPreferencesFunc func(ctx context.Context, prefs models.Preferences) (*models.Preferences, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClaimDigestSend holds details about calls to the ClaimDigestSend method.
+		ClaimDigestSend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+		}
+		// CountUnread holds details about calls to the CountUnread method.
+		CountUnread []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Notification is the notification argument value.
+			Notification models.Notification
+		}
+		// DeleteDevice holds details about calls to the DeleteDevice method.
+		DeleteDevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// DeleteDeviceToken holds details about calls to the DeleteDeviceToken method.
+		DeleteDeviceToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// GetDigestStats holds details about calls to the GetDigestStats method.
+		GetDigestStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetPreferences holds details about calls to the GetPreferences method.
+		GetPreferences []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListByUser holds details about calls to the ListByUser method.
+		ListByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// After is the after argument value.
+			After *models.Notification
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListDevices holds details about calls to the ListDevices method.
+		ListDevices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListDigestRecipients holds details about calls to the ListDigestRecipients method.
+		ListDigestRecipients []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+		}
+		// ListPendingPush holds details about calls to the ListPendingPush method.
+		ListPendingPush []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// MarkPushed holds details about calls to the MarkPushed method.
+		MarkPushed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// MarkRead holds details about calls to the MarkRead method.
+		MarkRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// RecordPushFailure holds details about calls to the RecordPushFailure method.
+		RecordPushFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// MaxAttempts is the maxAttempts argument value.
+			MaxAttempts int
+		}
+		// RegisterDevice holds details about calls to the RegisterDevice method.
+		RegisterDevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Device is the device argument value.
+			Device models.Device
+		}
+		// ReleaseDigestSend holds details about calls to the ReleaseDigestSend method.
+		ReleaseDigestSend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+		}
+		// UpsertPreferences holds details about calls to the UpsertPreferences method.
+		UpsertPreferences []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Prefs is the prefs argument value.
+			Prefs models.Preferences
+		}
+	}
+	lockClaimDigestSend      sync.RWMutex
+	lockCountUnread          sync.RWMutex
+	lockCreate               sync.RWMutex
+	lockDeleteDevice         sync.RWMutex
+	lockDeleteDeviceToken    sync.RWMutex
+	lockGetDigestStats       sync.RWMutex
+	lockGetPreferences       sync.RWMutex
+	lockListByUser           sync.RWMutex
+	lockListDevices          sync.RWMutex
+	lockListDigestRecipients sync.RWMutex
+	lockListPendingPush      sync.RWMutex
+	lockMarkPushed           sync.RWMutex
+	lockMarkRead             sync.RWMutex
+	lockRecordPushFailure    sync.RWMutex
+	lockRegisterDevice       sync.RWMutex
+	lockReleaseDigestSend    sync.RWMutex
+	lockUpsertPreferences    sync.RWMutex
+}
+
+// ClaimDigestSend calls ClaimDigestSendFunc.
+func (mock *NotificationRepositoryInterfaceMock) ClaimDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) (bool, error) {
+	if mock.ClaimDigestSendFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ClaimDigestSendFunc: method is nil but NotificationRepositoryInterface.ClaimDigestSend was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		UserID      pgtype.UUID
+		PeriodStart time.Time
+	}{
+		Ctx:         ctx,
+		UserID:      userID,
+		PeriodStart: periodStart,
+	}
+	mock.lockClaimDigestSend.Lock()
+	mock.calls.ClaimDigestSend = append(mock.calls.ClaimDigestSend, callInfo)
+	mock.lockClaimDigestSend.Unlock()
+	return mock.ClaimDigestSendFunc(ctx, userID, periodStart)
+}
+
+// ClaimDigestSendCalls gets all the calls that were made to ClaimDigestSend.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ClaimDigestSendCalls())
+func (mock *NotificationRepositoryInterfaceMock) ClaimDigestSendCalls() []struct {
+	Ctx         context.Context
+	UserID      pgtype.UUID
+	PeriodStart time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		UserID      pgtype.UUID
+		PeriodStart time.Time
+	}
+	mock.lockClaimDigestSend.RLock()
+	calls = mock.calls.ClaimDigestSend
+	mock.lockClaimDigestSend.RUnlock()
+	return calls
+}
+
+// CountUnread calls CountUnreadFunc.
+func (mock *NotificationRepositoryInterfaceMock) CountUnread(ctx context.Context, userID pgtype.UUID) (int, error) {
+	if mock.CountUnreadFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.CountUnreadFunc: method is nil but NotificationRepositoryInterface.CountUnread was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockCountUnread.Lock()
+	mock.calls.CountUnread = append(mock.calls.CountUnread, callInfo)
+	mock.lockCountUnread.Unlock()
+	return mock.CountUnreadFunc(ctx, userID)
+}
+
+// CountUnreadCalls gets all the calls that were made to CountUnread.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.CountUnreadCalls())
+func (mock *NotificationRepositoryInterfaceMock) CountUnreadCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockCountUnread.RLock()
+	calls = mock.calls.CountUnread
+	mock.lockCountUnread.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *NotificationRepositoryInterfaceMock) Create(ctx context.Context, notification models.Notification) (*models.Notification, error) {
+	if mock.CreateFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.CreateFunc: method is nil but NotificationRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Notification models.Notification
+	}{
+		Ctx:          ctx,
+		Notification: notification,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, notification)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.CreateCalls())
+func (mock *NotificationRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx          context.Context
+	Notification models.Notification
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Notification models.Notification
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// DeleteDevice calls DeleteDeviceFunc.
+func (mock *NotificationRepositoryInterfaceMock) DeleteDevice(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+	if mock.DeleteDeviceFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.DeleteDeviceFunc: method is nil but NotificationRepositoryInterface.DeleteDevice was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		UserID: userID,
+	}
+	mock.lockDeleteDevice.Lock()
+	mock.calls.DeleteDevice = append(mock.calls.DeleteDevice, callInfo)
+	mock.lockDeleteDevice.Unlock()
+	return mock.DeleteDeviceFunc(ctx, id, userID)
+}
+
+// DeleteDeviceCalls gets all the calls that were made to DeleteDevice.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.DeleteDeviceCalls())
+func (mock *NotificationRepositoryInterfaceMock) DeleteDeviceCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockDeleteDevice.RLock()
+	calls = mock.calls.DeleteDevice
+	mock.lockDeleteDevice.RUnlock()
+	return calls
+}
+
+// DeleteDeviceToken calls DeleteDeviceTokenFunc.
+func (mock *NotificationRepositoryInterfaceMock) DeleteDeviceToken(ctx context.Context, token string) error {
+	if mock.DeleteDeviceTokenFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.DeleteDeviceTokenFunc: method is nil but NotificationRepositoryInterface.DeleteDeviceToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockDeleteDeviceToken.Lock()
+	mock.calls.DeleteDeviceToken = append(mock.calls.DeleteDeviceToken, callInfo)
+	mock.lockDeleteDeviceToken.Unlock()
+	return mock.DeleteDeviceTokenFunc(ctx, token)
+}
+
+// DeleteDeviceTokenCalls gets all the calls that were made to DeleteDeviceToken.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.DeleteDeviceTokenCalls())
+func (mock *NotificationRepositoryInterfaceMock) DeleteDeviceTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockDeleteDeviceToken.RLock()
+	calls = mock.calls.DeleteDeviceToken
+	mock.lockDeleteDeviceToken.RUnlock()
+	return calls
+}
+
+// GetDigestStats calls GetDigestStatsFunc.
+func (mock *NotificationRepositoryInterfaceMock) GetDigestStats(ctx context.Context, ownerID pgtype.UUID, from time.Time, to time.Time) (*models.DigestStats, error) {
+	if mock.GetDigestStatsFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.GetDigestStatsFunc: method is nil but NotificationRepositoryInterface.GetDigestStats was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		From    time.Time
+		To      time.Time
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+		From:    from,
+		To:      to,
+	}
+	mock.lockGetDigestStats.Lock()
+	mock.calls.GetDigestStats = append(mock.calls.GetDigestStats, callInfo)
+	mock.lockGetDigestStats.Unlock()
+	return mock.GetDigestStatsFunc(ctx, ownerID, from, to)
+}
+
+// GetDigestStatsCalls gets all the calls that were made to GetDigestStats.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.GetDigestStatsCalls())
+func (mock *NotificationRepositoryInterfaceMock) GetDigestStatsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+	From    time.Time
+	To      time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		From    time.Time
+		To      time.Time
+	}
+	mock.lockGetDigestStats.RLock()
+	calls = mock.calls.GetDigestStats
+	mock.lockGetDigestStats.RUnlock()
+	return calls
+}
+
+// GetPreferences calls GetPreferencesFunc.
+func (mock *NotificationRepositoryInterfaceMock) GetPreferences(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error) {
+	if mock.GetPreferencesFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.GetPreferencesFunc: method is nil but NotificationRepositoryInterface.GetPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetPreferences.Lock()
+	mock.calls.GetPreferences = append(mock.calls.GetPreferences, callInfo)
+	mock.lockGetPreferences.Unlock()
+	return mock.GetPreferencesFunc(ctx, userID)
+}
+
+// GetPreferencesCalls gets all the calls that were made to GetPreferences.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.GetPreferencesCalls())
+func (mock *NotificationRepositoryInterfaceMock) GetPreferencesCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetPreferences.RLock()
+	calls = mock.calls.GetPreferences
+	mock.lockGetPreferences.RUnlock()
+	return calls
+}
+
+// ListByUser calls ListByUserFunc.
+func (mock *NotificationRepositoryInterfaceMock) ListByUser(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error) {
+	if mock.ListByUserFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ListByUserFunc: method is nil but NotificationRepositoryInterface.ListByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		After  *models.Notification
+		Limit  int
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		After:  after,
+		Limit:  limit,
+	}
+	mock.lockListByUser.Lock()
+	mock.calls.ListByUser = append(mock.calls.ListByUser, callInfo)
+	mock.lockListByUser.Unlock()
+	return mock.ListByUserFunc(ctx, userID, after, limit)
+}
+
+// ListByUserCalls gets all the calls that were made to ListByUser.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ListByUserCalls())
+func (mock *NotificationRepositoryInterfaceMock) ListByUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	After  *models.Notification
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		After  *models.Notification
+		Limit  int
+	}
+	mock.lockListByUser.RLock()
+	calls = mock.calls.ListByUser
+	mock.lockListByUser.RUnlock()
+	return calls
+}
+
+// ListDevices calls ListDevicesFunc.
+func (mock *NotificationRepositoryInterfaceMock) ListDevices(ctx context.Context, userID pgtype.UUID) ([]*models.Device, error) {
+	if mock.ListDevicesFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ListDevicesFunc: method is nil but NotificationRepositoryInterface.ListDevices was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListDevices.Lock()
+	mock.calls.ListDevices = append(mock.calls.ListDevices, callInfo)
+	mock.lockListDevices.Unlock()
+	return mock.ListDevicesFunc(ctx, userID)
+}
+
+// ListDevicesCalls gets all the calls that were made to ListDevices.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ListDevicesCalls())
+func (mock *NotificationRepositoryInterfaceMock) ListDevicesCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListDevices.RLock()
+	calls = mock.calls.ListDevices
+	mock.lockListDevices.RUnlock()
+	return calls
+}
+
+// ListDigestRecipients calls ListDigestRecipientsFunc.
+func (mock *NotificationRepositoryInterfaceMock) ListDigestRecipients(ctx context.Context, periodStart time.Time) ([]pgtype.UUID, error) {
+	if mock.ListDigestRecipientsFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ListDigestRecipientsFunc: method is nil but NotificationRepositoryInterface.ListDigestRecipients was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PeriodStart time.Time
+	}{
+		Ctx:         ctx,
+		PeriodStart: periodStart,
+	}
+	mock.lockListDigestRecipients.Lock()
+	mock.calls.ListDigestRecipients = append(mock.calls.ListDigestRecipients, callInfo)
+	mock.lockListDigestRecipients.Unlock()
+	return mock.ListDigestRecipientsFunc(ctx, periodStart)
+}
+
+// ListDigestRecipientsCalls gets all the calls that were made to ListDigestRecipients.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ListDigestRecipientsCalls())
+func (mock *NotificationRepositoryInterfaceMock) ListDigestRecipientsCalls() []struct {
+	Ctx         context.Context
+	PeriodStart time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PeriodStart time.Time
+	}
+	mock.lockListDigestRecipients.RLock()
+	calls = mock.calls.ListDigestRecipients
+	mock.lockListDigestRecipients.RUnlock()
+	return calls
+}
+
+// ListPendingPush calls ListPendingPushFunc.
+func (mock *NotificationRepositoryInterfaceMock) ListPendingPush(ctx context.Context, limit int) ([]*models.PendingPush, error) {
+	if mock.ListPendingPushFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ListPendingPushFunc: method is nil but NotificationRepositoryInterface.ListPendingPush was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockListPendingPush.Lock()
+	mock.calls.ListPendingPush = append(mock.calls.ListPendingPush, callInfo)
+	mock.lockListPendingPush.Unlock()
+	return mock.ListPendingPushFunc(ctx, limit)
+}
+
+// ListPendingPushCalls gets all the calls that were made to ListPendingPush.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ListPendingPushCalls())
+func (mock *NotificationRepositoryInterfaceMock) ListPendingPushCalls() []struct {
+	Ctx   context.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+	}
+	mock.lockListPendingPush.RLock()
+	calls = mock.calls.ListPendingPush
+	mock.lockListPendingPush.RUnlock()
+	return calls
+}
+
+// MarkPushed calls MarkPushedFunc.
+func (mock *NotificationRepositoryInterfaceMock) MarkPushed(ctx context.Context, id pgtype.UUID) error {
+	if mock.MarkPushedFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.MarkPushedFunc: method is nil but NotificationRepositoryInterface.MarkPushed was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkPushed.Lock()
+	mock.calls.MarkPushed = append(mock.calls.MarkPushed, callInfo)
+	mock.lockMarkPushed.Unlock()
+	return mock.MarkPushedFunc(ctx, id)
+}
+
+// MarkPushedCalls gets all the calls that were made to MarkPushed.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.MarkPushedCalls())
+func (mock *NotificationRepositoryInterfaceMock) MarkPushedCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockMarkPushed.RLock()
+	calls = mock.calls.MarkPushed
+	mock.lockMarkPushed.RUnlock()
+	return calls
+}
+
+// MarkRead calls MarkReadFunc.
+func (mock *NotificationRepositoryInterfaceMock) MarkRead(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) (*models.Notification, error) {
+	if mock.MarkReadFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.MarkReadFunc: method is nil but NotificationRepositoryInterface.MarkRead was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		UserID: userID,
+	}
+	mock.lockMarkRead.Lock()
+	mock.calls.MarkRead = append(mock.calls.MarkRead, callInfo)
+	mock.lockMarkRead.Unlock()
+	return mock.MarkReadFunc(ctx, id, userID)
+}
+
+// MarkReadCalls gets all the calls that were made to MarkRead.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.MarkReadCalls())
+func (mock *NotificationRepositoryInterfaceMock) MarkReadCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockMarkRead.RLock()
+	calls = mock.calls.MarkRead
+	mock.lockMarkRead.RUnlock()
+	return calls
+}
+
+// RecordPushFailure calls RecordPushFailureFunc.
+func (mock *NotificationRepositoryInterfaceMock) RecordPushFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+	if mock.RecordPushFailureFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.RecordPushFailureFunc: method is nil but NotificationRepositoryInterface.RecordPushFailure was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		MaxAttempts: maxAttempts,
+	}
+	mock.lockRecordPushFailure.Lock()
+	mock.calls.RecordPushFailure = append(mock.calls.RecordPushFailure, callInfo)
+	mock.lockRecordPushFailure.Unlock()
+	return mock.RecordPushFailureFunc(ctx, id, maxAttempts)
+}
+
+// RecordPushFailureCalls gets all the calls that were made to RecordPushFailure.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.RecordPushFailureCalls())
+func (mock *NotificationRepositoryInterfaceMock) RecordPushFailureCalls() []struct {
+	Ctx         context.Context
+	ID          pgtype.UUID
+	MaxAttempts int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+	}
+	mock.lockRecordPushFailure.RLock()
+	calls = mock.calls.RecordPushFailure
+	mock.lockRecordPushFailure.RUnlock()
+	return calls
+}
+
+// RegisterDevice calls RegisterDeviceFunc.
+func (mock *NotificationRepositoryInterfaceMock) RegisterDevice(ctx context.Context, device models.Device) (*models.Device, error) {
+	if mock.RegisterDeviceFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.RegisterDeviceFunc: method is nil but NotificationRepositoryInterface.RegisterDevice was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Device models.Device
+	}{
+		Ctx:    ctx,
+		Device: device,
+	}
+	mock.lockRegisterDevice.Lock()
+	mock.calls.RegisterDevice = append(mock.calls.RegisterDevice, callInfo)
+	mock.lockRegisterDevice.Unlock()
+	return mock.RegisterDeviceFunc(ctx, device)
+}
+
+// RegisterDeviceCalls gets all the calls that were made to RegisterDevice.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.RegisterDeviceCalls())
+func (mock *NotificationRepositoryInterfaceMock) RegisterDeviceCalls() []struct {
+	Ctx    context.Context
+	Device models.Device
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Device models.Device
+	}
+	mock.lockRegisterDevice.RLock()
+	calls = mock.calls.RegisterDevice
+	mock.lockRegisterDevice.RUnlock()
+	return calls
+}
+
+// ReleaseDigestSend calls ReleaseDigestSendFunc.
+func (mock *NotificationRepositoryInterfaceMock) ReleaseDigestSend(ctx context.Context, userID pgtype.UUID, periodStart time.Time) error {
+	if mock.ReleaseDigestSendFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.ReleaseDigestSendFunc: method is nil but NotificationRepositoryInterface.ReleaseDigestSend was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		UserID      pgtype.UUID
+		PeriodStart time.Time
+	}{
+		Ctx:         ctx,
+		UserID:      userID,
+		PeriodStart: periodStart,
+	}
+	mock.lockReleaseDigestSend.Lock()
+	mock.calls.ReleaseDigestSend = append(mock.calls.ReleaseDigestSend, callInfo)
+	mock.lockReleaseDigestSend.Unlock()
+	return mock.ReleaseDigestSendFunc(ctx, userID, periodStart)
+}
+
+// ReleaseDigestSendCalls gets all the calls that were made to ReleaseDigestSend.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.ReleaseDigestSendCalls())
+func (mock *NotificationRepositoryInterfaceMock) ReleaseDigestSendCalls() []struct {
+	Ctx         context.Context
+	UserID      pgtype.UUID
+	PeriodStart time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		UserID      pgtype.UUID
+		PeriodStart time.Time
+	}
+	mock.lockReleaseDigestSend.RLock()
+	calls = mock.calls.ReleaseDigestSend
+	mock.lockReleaseDigestSend.RUnlock()
+	return calls
+}
+
+// UpsertPreferences calls UpsertPreferencesFunc.
+func (mock *NotificationRepositoryInterfaceMock) UpsertPreferences(ctx context.Context, prefs models.Preferences) (*models.Preferences, error) {
+	if mock.UpsertPreferencesFunc == nil {
+		panic("NotificationRepositoryInterfaceMock.UpsertPreferencesFunc: method is nil but NotificationRepositoryInterface.UpsertPreferences was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Prefs models.Preferences
+	}{
+		Ctx:   ctx,
+		Prefs: prefs,
+	}
+	mock.lockUpsertPreferences.Lock()
+	mock.calls.UpsertPreferences = append(mock.calls.UpsertPreferences, callInfo)
+	mock.lockUpsertPreferences.Unlock()
+	return mock.UpsertPreferencesFunc(ctx, prefs)
+}
+
+// UpsertPreferencesCalls gets all the calls that were made to UpsertPreferences.
+// Check the length with:
+//
+//	len(mockedNotificationRepositoryInterface.UpsertPreferencesCalls())
+func (mock *NotificationRepositoryInterfaceMock) UpsertPreferencesCalls() []struct {
+	Ctx   context.Context
+	Prefs models.Preferences
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Prefs models.Preferences
+	}
+	mock.lockUpsertPreferences.RLock()
+	calls = mock.calls.UpsertPreferences
+	mock.lockUpsertPreferences.RUnlock()
+	return calls
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/notification/models"
+	"wish-list/internal/domain/notification/repository"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/push"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Page sizes for the notification list
+const (
+	DefaultNotificationLimit = 20
+	MaxNotificationLimit     = 100
+)
+
+var (
+	ErrInvalidNotificationID = errors.New("invalid notification id")
+	ErrInvalidCursor         = errors.New("invalid cursor")
+	ErrNotificationNotFound  = errors.New("notification not found")
+	ErrInvalidDeviceID       = errors.New("invalid device id")
+	ErrInvalidPlatform       = errors.New("platform must be android or ios")
+	ErrDeviceTokenRequired   = errors.New("device token is required")
+	ErrDeviceNotFound        = errors.New("device not found")
+)
+
+// NotificationServiceInterface defines the interface for notification operations
+type NotificationServiceInterface interface {
+	GetPreferences(ctx context.Context, userID pgtype.UUID) (*PreferencesOutput, error)
+	UpdatePreferences(ctx context.Context, userID pgtype.UUID, input UpdatePreferencesInput) (*PreferencesOutput, error)
+	Notify(ctx context.Context, notification models.Notification) error
+	ListNotifications(ctx context.Context, userID pgtype.UUID, cursor string, limit int) (*NotificationListOutput, error)
+	MarkRead(ctx context.Context, userID pgtype.UUID, notificationID string) (*NotificationOutput, error)
+	RegisterDevice(ctx context.Context, userID pgtype.UUID, platform, token string) (*DeviceOutput, error)
+	UnregisterDevice(ctx context.Context, userID pgtype.UUID, deviceID string) error
+}
+
+type NotificationService struct {
+	repo repository.NotificationRepositoryInterface
+}
+
+func NewNotificationService(repo repository.NotificationRepositoryInterface) *NotificationService {
+	return &NotificationService{
+		repo: repo,
+	}
+}
+
+// UpdatePreferencesInput holds the preferences to change; nil fields are left as they are
+type UpdatePreferencesInput struct {
+	WeeklyDigest      *bool
+	PushNotifications *bool
+}
+
+type PreferencesOutput struct {
+	WeeklyDigest      bool
+	PushNotifications bool
+	UpdatedAt         pgtype.Timestamptz
+}
+
+// GetPreferences returns the user's notification preferences
+func (s *NotificationService) GetPreferences(ctx context.Context, userID pgtype.UUID) (*PreferencesOutput, error) {
+	prefs, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return toPreferencesOutput(prefs), nil
+}
+
+// UpdatePreferences changes the given notification preferences of the user
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID pgtype.UUID, input UpdatePreferencesInput) (*PreferencesOutput, error) {
+	prefs, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	if input.WeeklyDigest != nil {
+		prefs.WeeklyDigest = *input.WeeklyDigest
+	}
+	if input.PushNotifications != nil {
+		prefs.PushNotifications = *input.PushNotifications
+	}
+
+	saved, err := s.repo.UpsertPreferences(ctx, *prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return toPreferencesOutput(saved), nil
+}
+
+type NotificationOutput struct {
+	ID         pgtype.UUID
+	Type       string
+	Title      string
+	Body       string
+	WishlistID pgtype.UUID
+	ReadAt     pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+}
+
+// NotificationListOutput is one page of a user's notifications
+type NotificationListOutput struct {
+	Notifications []*NotificationOutput
+	NextCursor    string // Empty on the last page
+	UnreadCount   int
+}
+
+// Notify adds a notification to a user's notification center
+func (s *NotificationService) Notify(ctx context.Context, notification models.Notification) error {
+	created, err := s.repo.Create(ctx, notification)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	logger.DebugContext(ctx, "notification created",
+		"notification_id", created.ID.String(),
+		"type", created.Type)
+
+	return nil
+}
+
+// ListNotifications returns a page of the user's notifications, newest first, with the
+// number still unread. cursor is the NextCursor of the previous page, or empty for the first.
+func (s *NotificationService) ListNotifications(ctx context.Context, userID pgtype.UUID, cursor string, limit int) (*NotificationListOutput, error) {
+	if limit <= 0 {
+		limit = DefaultNotificationLimit
+	}
+	if limit > MaxNotificationLimit {
+		limit = MaxNotificationLimit
+	}
+
+	var after *models.Notification
+	if cursor != "" {
+		var err error
+		if after, err = decodeCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch one extra to know whether another page follows
+	notifications, err := s.repo.ListByUser(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	output := &NotificationListOutput{UnreadCount: unread}
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		output.NextCursor = encodeCursor(notifications[limit-1])
+	}
+
+	output.Notifications = make([]*NotificationOutput, len(notifications))
+	for i, notification := range notifications {
+		output.Notifications[i] = toNotificationOutput(notification)
+	}
+
+	return output, nil
+}
+
+// MarkRead marks one of the user's notifications as read
+func (s *NotificationService) MarkRead(ctx context.Context, userID pgtype.UUID, notificationID string) (*NotificationOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(notificationID); err != nil {
+		return nil, ErrInvalidNotificationID
+	}
+
+	notification, err := s.repo.MarkRead(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotificationNotFound) {
+			return nil, ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	return toNotificationOutput(notification), nil
+}
+
+type DeviceOutput struct {
+	ID         pgtype.UUID
+	Platform   string
+	CreatedAt  pgtype.Timestamptz
+	LastSeenAt pgtype.Timestamptz
+}
+
+// RegisterDevice registers a device token for push notifications. Registering the
+// same token again refreshes it and moves it to the caller.
+func (s *NotificationService) RegisterDevice(ctx context.Context, userID pgtype.UUID, platform, token string) (*DeviceOutput, error) {
+	platform = strings.ToLower(strings.TrimSpace(platform))
+	if platform != push.PlatformAndroid && platform != push.PlatformIOS {
+		return nil, ErrInvalidPlatform
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, ErrDeviceTokenRequired
+	}
+
+	device, err := s.repo.RegisterDevice(ctx, models.Device{
+		UserID:   userID,
+		Platform: platform,
+		Token:    token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register device: %w", err)
+	}
+
+	return &DeviceOutput{
+		ID:         device.ID,
+		Platform:   device.Platform,
+		CreatedAt:  device.CreatedAt,
+		LastSeenAt: device.LastSeenAt,
+	}, nil
+}
+
+// UnregisterDevice stops push notifications to one of the user's devices
+func (s *NotificationService) UnregisterDevice(ctx context.Context, userID pgtype.UUID, deviceID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(deviceID); err != nil {
+		return ErrInvalidDeviceID
+	}
+
+	if err := s.repo.DeleteDevice(ctx, id, userID); err != nil {
+		if errors.Is(err, repository.ErrDeviceNotFound) {
+			return ErrDeviceNotFound
+		}
+		return fmt.Errorf("failed to unregister device: %w", err)
+	}
+
+	return nil
+}
+
+func toPreferencesOutput(prefs *models.Preferences) *PreferencesOutput {
+	return &PreferencesOutput{
+		WeeklyDigest:      prefs.WeeklyDigest,
+		PushNotifications: prefs.PushNotifications,
+		UpdatedAt:         prefs.UpdatedAt,
+	}
+}
+
+// encodeCursor makes an opaque cursor pointing just past the given notification
+func encodeCursor(n *models.Notification) string {
+	raw := n.CreatedAt.Time.UTC().Format(time.RFC3339Nano) + "|" + n.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*models.Notification, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	after := &models.Notification{}
+	parsed, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	after.CreatedAt = pgtype.Timestamptz{Time: parsed, Valid: true}
+	if err := after.ID.Scan(id); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return after, nil
+}
+
+func toNotificationOutput(n *models.Notification) *NotificationOutput {
+	return &NotificationOutput{
+		ID:         n.ID,
+		Type:       n.Type,
+		Title:      n.Title,
+		Body:       n.Body,
+		WishlistID: n.WishlistID,
+		ReadAt:     n.ReadAt,
+		CreatedAt:  n.CreatedAt,
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/notification/models"
+	"wish-list/internal/domain/notification/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUserID = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+func newPreferencesRepo(stored models.Preferences) *NotificationRepositoryInterfaceMock {
+	return &NotificationRepositoryInterfaceMock{
+		GetPreferencesFunc: func(ctx context.Context, userID pgtype.UUID) (*models.Preferences, error) {
+			prefs := stored
+			return &prefs, nil
+		},
+		UpsertPreferencesFunc: func(ctx context.Context, prefs models.Preferences) (*models.Preferences, error) {
+			return &prefs, nil
+		},
+	}
+}
+
+func TestNotificationService_GetPreferences_Defaults(t *testing.T) {
+	svc := NewNotificationService(newPreferencesRepo(models.Preferences{UserID: testUserID}))
+
+	prefs, err := svc.GetPreferences(context.Background(), testUserID)
+
+	require.NoError(t, err)
+	assert.False(t, prefs.WeeklyDigest)
+	assert.False(t, prefs.UpdatedAt.Valid)
+}
+
+func TestNotificationService_UpdatePreferences(t *testing.T) {
+	repo := newPreferencesRepo(models.Preferences{UserID: testUserID})
+	svc := NewNotificationService(repo)
+
+	optIn := true
+	prefs, err := svc.UpdatePreferences(context.Background(), testUserID, UpdatePreferencesInput{WeeklyDigest: &optIn})
+
+	require.NoError(t, err)
+	assert.True(t, prefs.WeeklyDigest)
+	require.Len(t, repo.UpsertPreferencesCalls(), 1)
+	assert.Equal(t, testUserID, repo.UpsertPreferencesCalls()[0].Prefs.UserID)
+}
+
+func TestNotificationService_UpdatePreferences_OmittedFieldsUnchanged(t *testing.T) {
+	repo := newPreferencesRepo(models.Preferences{UserID: testUserID, WeeklyDigest: true})
+	svc := NewNotificationService(repo)
+
+	prefs, err := svc.UpdatePreferences(context.Background(), testUserID, UpdatePreferencesInput{})
+
+	require.NoError(t, err)
+	assert.True(t, prefs.WeeklyDigest)
+}
+
+func testNotifications(n int) []*models.Notification {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	notifications := make([]*models.Notification, n)
+	for i := range notifications {
+		notifications[i] = &models.Notification{
+			ID:        pgtype.UUID{Bytes: [16]byte{byte(i + 1)}, Valid: true},
+			UserID:    testUserID,
+			Type:      models.TypeNewComment,
+			CreatedAt: pgtype.Timestamptz{Time: base.Add(-time.Duration(i) * time.Minute), Valid: true},
+		}
+	}
+	return notifications
+}
+
+func TestNotificationService_ListNotifications_Pages(t *testing.T) {
+	all := testNotifications(3)
+	repo := &NotificationRepositoryInterfaceMock{
+		ListByUserFunc: func(ctx context.Context, userID pgtype.UUID, after *models.Notification, limit int) ([]*models.Notification, error) {
+			start := 0
+			if after != nil {
+				for i, n := range all {
+					if n.ID == after.ID && n.CreatedAt.Time.Equal(after.CreatedAt.Time) {
+						start = i + 1
+					}
+				}
+			}
+			return all[start:min(start+limit, len(all))], nil
+		},
+		CountUnreadFunc: func(ctx context.Context, userID pgtype.UUID) (int, error) {
+			return 3, nil
+		},
+	}
+	svc := NewNotificationService(repo)
+
+	first, err := svc.ListNotifications(context.Background(), testUserID, "", 2)
+	require.NoError(t, err)
+	require.Len(t, first.Notifications, 2)
+	assert.Equal(t, 3, first.UnreadCount)
+	require.NotEmpty(t, first.NextCursor)
+	assert.Equal(t, 3, repo.ListByUserCalls()[0].Limit)
+
+	second, err := svc.ListNotifications(context.Background(), testUserID, first.NextCursor, 2)
+	require.NoError(t, err)
+	require.Len(t, second.Notifications, 1)
+	assert.Equal(t, all[2].ID, second.Notifications[0].ID)
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestNotificationService_ListNotifications_InvalidCursor(t *testing.T) {
+	svc := NewNotificationService(&NotificationRepositoryInterfaceMock{})
+
+	_, err := svc.ListNotifications(context.Background(), testUserID, "not-a-cursor", 0)
+
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestNotificationService_MarkRead(t *testing.T) {
+	repo := &NotificationRepositoryInterfaceMock{
+		MarkReadFunc: func(ctx context.Context, id, userID pgtype.UUID) (*models.Notification, error) {
+			return nil, repository.ErrNotificationNotFound
+		},
+	}
+	svc := NewNotificationService(repo)
+
+	_, err := svc.MarkRead(context.Background(), testUserID, testUserID.String())
+	require.ErrorIs(t, err, ErrNotificationNotFound)
+
+	_, err = svc.MarkRead(context.Background(), testUserID, "nope")
+	require.ErrorIs(t, err, ErrInvalidNotificationID)
+}
+
+func TestNotificationService_RegisterDevice(t *testing.T) {
+	repo := &NotificationRepositoryInterfaceMock{
+		RegisterDeviceFunc: func(ctx context.Context, device models.Device) (*models.Device, error) {
+			device.ID = testUserID
+			return &device, nil
+		},
+	}
+	svc := NewNotificationService(repo)
+
+	device, err := svc.RegisterDevice(context.Background(), testUserID, " iOS ", " apns-token ")
+	require.NoError(t, err)
+	assert.Equal(t, "ios", device.Platform)
+	require.Len(t, repo.RegisterDeviceCalls(), 1)
+	assert.Equal(t, "apns-token", repo.RegisterDeviceCalls()[0].Device.Token)
+
+	_, err = svc.RegisterDevice(context.Background(), testUserID, "windows", "token")
+	require.ErrorIs(t, err, ErrInvalidPlatform)
+
+	_, err = svc.RegisterDevice(context.Background(), testUserID, "android", "  ")
+	require.ErrorIs(t, err, ErrDeviceTokenRequired)
+	assert.Len(t, repo.RegisterDeviceCalls(), 1)
+}
+
+func TestNotificationService_UnregisterDevice(t *testing.T) {
+	repo := &NotificationRepositoryInterfaceMock{
+		DeleteDeviceFunc: func(ctx context.Context, id, userID pgtype.UUID) error {
+			return repository.ErrDeviceNotFound
+		},
+	}
+	svc := NewNotificationService(repo)
+
+	require.ErrorIs(t, svc.UnregisterDevice(context.Background(), testUserID, testUserID.String()), ErrDeviceNotFound)
+	require.ErrorIs(t, svc.UnregisterDevice(context.Background(), testUserID, "nope"), ErrInvalidDeviceID)
+}
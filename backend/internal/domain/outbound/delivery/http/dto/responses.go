@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/outbound/service"
+)
+
+type ItemClickStatsResponse struct {
+	GiftItemID       string `json:"gift_item_id" validate:"required"`
+	Name             string `json:"name" validate:"required"`
+	Clicks           int    `json:"clicks" validate:"required"`
+	AffiliatedClicks int    `json:"affiliated_clicks"`
+	LastClickedAt    string `json:"last_clicked_at" validate:"required"`
+}
+
+// ClickStatsResponse is the click-through summary of the user's items since a point in time
+type ClickStatsResponse struct {
+	Since       string                   `json:"since" validate:"required"`
+	TotalClicks int                      `json:"total_clicks"`
+	Items       []ItemClickStatsResponse `json:"items" validate:"required"`
+}
+
+func FromClickStatsOutput(o *service.ClickStatsOutput) ClickStatsResponse {
+	items := make([]ItemClickStatsResponse, 0, len(o.Items))
+	for _, item := range o.Items {
+		items = append(items, ItemClickStatsResponse{
+			GiftItemID:       item.GiftItemID.String(),
+			Name:             item.Name,
+			Clicks:           item.Clicks,
+			AffiliatedClicks: item.AffiliatedClicks,
+			LastClickedAt:    item.LastClickedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	return ClickStatsResponse{
+		Since:       o.Since.Format(time.RFC3339),
+		TotalClicks: o.TotalClicks,
+		Items:       items,
+	}
+}
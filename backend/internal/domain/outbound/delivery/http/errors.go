@@ -0,0 +1,30 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/outbound/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapOutboundServiceError converts click-through service errors to AppErrors
+func mapOutboundServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidItemID):
+		return apperrors.BadRequest("Invalid item ID")
+	case errors.Is(err, service.ErrLinkNotFound):
+		return apperrors.NotFound("Item link not found")
+	default:
+		return apperrors.Internal("Failed to follow item link").Wrap(err)
+	}
+}
+
+// mapClickStatsError converts click statistics errors to AppErrors
+func mapClickStatsError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPeriod):
+		return apperrors.BadRequest("days must be between 1 and 365")
+	default:
+		return apperrors.Internal("Failed to get link click statistics").Wrap(err)
+	}
+}
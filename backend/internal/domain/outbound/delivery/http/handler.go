@@ -0,0 +1,103 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/outbound/delivery/http/dto"
+	"wish-list/internal/domain/outbound/service"
+	"wish-list/internal/pkg/analytics"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles click-through redirects to item links
+type Handler struct {
+	service          service.OutboundServiceInterface
+	analyticsService *analytics.AnalyticsService
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.OutboundServiceInterface, analyticsService *analytics.AnalyticsService) *Handler {
+	return &Handler{
+		service:          svc,
+		analyticsService: analyticsService,
+	}
+}
+
+// FollowItemLink godoc
+//
+//	@Summary		Follow an item link
+//	@Description	Redirects to the product link of a gift item on a public wishlist, with the merchant's affiliate tag applied. Each click is recorded for click-through analytics. Signed-in visitors are attributed when a token is sent.
+//	@Tags			Gift Items
+//	@Param			itemId	path	string				true	"Gift Item ID"
+//	@Success		302		"Redirect to the item link"
+//	@Failure		400		{object}	map[string]string	"Invalid item ID"
+//	@Failure		404		{object}	map[string]string	"Item not found on a public wishlist or has no link"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/out/{itemId} [get]
+func (h *Handler) FollowItemLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// Visitors may be signed in; guests are tracked anonymously
+	var userID pgtype.UUID
+	userIDStr, _, _, authErr := auth.GetUserFromContext(c)
+	if authErr == nil {
+		parsed, err := helpers.ParseUUID(c, userIDStr)
+		if err != nil {
+			return err
+		}
+		userID = parsed
+	}
+
+	output, err := h.service.Follow(ctx, c.Param("itemId"), userID)
+	if err != nil {
+		return mapOutboundServiceError(err)
+	}
+
+	_ = h.analyticsService.TrackItemLinkClicked(ctx, userIDStr, c.Param("itemId"), output.Merchant, output.Affiliated)
+
+	// Links change when owners edit items, so redirects must not be cached
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.Redirect(nethttp.StatusFound, output.URL)
+}
+
+// GetLinkClickStats godoc
+//
+//	@Summary		Get link click statistics
+//	@Description	Click-throughs on the current user's item links over the last days, per item and most clicked first. Premium only.
+//	@Tags			Gift Items
+//	@Produce		json
+//	@Param			days	query		int						false	"Period in days, 1-365 (default 30)"
+//	@Success		200		{object}	dto.ClickStatsResponse	"Link click statistics"
+//	@Failure		400		{object}	map[string]string		"Invalid period"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		402		{object}	map[string]string		"Premium plan required"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/analytics/link-clicks [get]
+func (h *Handler) GetLinkClickStats(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var days int
+	if raw := c.QueryParam("days"); raw != "" {
+		days, err = strconv.Atoi(raw)
+		if err != nil {
+			return apperrors.BadRequest("days must be a number")
+		}
+	}
+
+	stats, err := h.service.GetClickStats(c.Request().Context(), userID, days)
+	if err != nil {
+		return mapClickStatsError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromClickStatsOutput(stats))
+}
@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers click-through HTTP routes.
+// optionalAuthMiddleware attributes clicks to signed-in visitors; guests proceed without it.
+// premiumMiddleware gates click statistics behind the premium plan.
+func RegisterRoutes(e *echo.Echo, h *Handler, optionalAuthMiddleware, authMiddleware, premiumMiddleware echo.MiddlewareFunc) {
+	e.GET("/api/out/:itemId", h.FollowItemLink, optionalAuthMiddleware)
+
+	protected := e.Group("/api/protected", authMiddleware)
+	protected.GET("/analytics/link-clicks", h.GetLinkClickStats, premiumMiddleware)
+}
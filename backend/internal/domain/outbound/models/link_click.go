@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PublicLink is the product link of a gift item shown on at least one public wishlist
+type PublicLink struct {
+	GiftItemID pgtype.UUID `db:"gift_item_id"`
+	Link       string      `db:"link"`
+}
+
+// LinkClick is one click-through from a public page to a merchant
+type LinkClick struct {
+	ID         pgtype.UUID        `db:"id"`
+	GiftItemID pgtype.UUID        `db:"gift_item_id"`
+	UserID     pgtype.UUID        `db:"user_id"` // NULL for guests
+	Merchant   string             `db:"merchant"`
+	Affiliated bool               `db:"affiliated"`
+	ClickedAt  pgtype.Timestamptz `db:"clicked_at"`
+}
+
+// ItemClickStats summarizes click-throughs on one of an owner's gift items
+type ItemClickStats struct {
+	GiftItemID       pgtype.UUID        `db:"gift_item_id"`
+	Name             string             `db:"name"`
+	Clicks           int                `db:"clicks"`
+	AffiliatedClicks int                `db:"affiliated_clicks"`
+	LastClickedAt    pgtype.Timestamptz `db:"last_clicked_at"`
+}
@@ -0,0 +1,104 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_link_click_repository_test.go -pkg service . LinkClickRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/outbound/models"
+)
+
+// Sentinel errors for link click repository
+var (
+	ErrPublicLinkNotFound = errors.New("public item link not found")
+)
+
+// LinkClickRepositoryInterface defines the interface for click-through database operations
+type LinkClickRepositoryInterface interface {
+	GetPublicLink(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error)
+	RecordClick(ctx context.Context, click models.LinkClick) error
+	ListClickStats(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error)
+}
+
+type LinkClickRepository struct {
+	db *database.DB
+}
+
+func NewLinkClickRepository(db *database.DB) LinkClickRepositoryInterface {
+	return &LinkClickRepository{
+		db: db,
+	}
+}
+
+// GetPublicLink returns the link of an unarchived gift item that appears on at least one
+// public wishlist. Items that are private, archived or have no link are not found, so the
+// redirect endpoint cannot be used to reveal them.
+func (r *LinkClickRepository) GetPublicLink(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error) {
+	query := `
+		SELECT gi.id AS gift_item_id, gi.link
+		FROM gift_items gi
+		WHERE gi.id = $1
+		  AND gi.archived_at IS NULL
+		  AND gi.link IS NOT NULL AND gi.link <> ''
+		  AND EXISTS (
+			SELECT 1
+			FROM wishlist_items wi
+			JOIN wishlists w ON w.id = wi.wishlist_id
+			WHERE wi.gift_item_id = gi.id AND w.is_public = true
+		  )
+	`
+
+	var link models.PublicLink
+	if err := r.db.Reader().GetContext(ctx, &link, query, giftItemID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPublicLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get public item link: %w", err)
+	}
+
+	return &link, nil
+}
+
+// RecordClick stores one click-through
+func (r *LinkClickRepository) RecordClick(ctx context.Context, click models.LinkClick) error {
+	query := `
+		INSERT INTO item_link_clicks (gift_item_id, user_id, merchant, affiliated)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, click.GiftItemID, click.UserID, click.Merchant, click.Affiliated); err != nil {
+		return fmt.Errorf("failed to record link click: %w", err)
+	}
+
+	return nil
+}
+
+// ListClickStats returns per-item click counts since the given time for items the user owns,
+// most clicked first
+func (r *LinkClickRepository) ListClickStats(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error) {
+	query := `
+		SELECT gi.id AS gift_item_id, gi.name,
+		       COUNT(*) AS clicks,
+		       COUNT(*) FILTER (WHERE c.affiliated) AS affiliated_clicks,
+		       MAX(c.clicked_at) AS last_clicked_at
+		FROM item_link_clicks c
+		JOIN gift_items gi ON gi.id = c.gift_item_id
+		WHERE gi.owner_id = $1 AND c.clicked_at >= $2
+		GROUP BY gi.id, gi.name
+		ORDER BY clicks DESC, last_clicked_at DESC
+	`
+
+	var stats []*models.ItemClickStats
+	if err := r.db.SelectContext(ctx, &stats, query, ownerID, since); err != nil {
+		return nil, fmt.Errorf("failed to list link click stats: %w", err)
+	}
+
+	return stats, nil
+}
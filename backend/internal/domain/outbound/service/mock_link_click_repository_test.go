@@ -0,0 +1,191 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/outbound/models"
+	"wish-list/internal/domain/outbound/repository"
+)
+
+// Ensure, that LinkClickRepositoryInterfaceMock does implement repository.LinkClickRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.LinkClickRepositoryInterface = &LinkClickRepositoryInterfaceMock{}
+
+// LinkClickRepositoryInterfaceMock is a mock implementation of repository.LinkClickRepositoryInterface.
+//
+//	func TestSomethingThatUsesLinkClickRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.LinkClickRepositoryInterface
+//		mockedLinkClickRepositoryInterface := &LinkClickRepositoryInterfaceMock{
+//			GetPublicLinkFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error) {
+//				panic("mock out the GetPublicLink method")
+//			},
+//			ListClickStatsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error) {
+//				panic("mock out the ListClickStats method")
+//			},
+//			RecordClickFunc: func(ctx context.Context, click models.LinkClick) error {
+//				panic("mock out the RecordClick method")
+//			},
+//		}
+//
+//		// use mockedLinkClickRepositoryInterface in code that requires repository.LinkClickRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type LinkClickRepositoryInterfaceMock struct {
+	// GetPublicLinkFunc mocks the GetPublicLink method.
+	GetPublicLinkFunc func(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error)
+
+	// ListClickStatsFunc mocks the ListClickStats method.
+	ListClickStatsFunc func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error)
+
+	// RecordClickFunc mocks the RecordClick method.
+	RecordClickFunc func(ctx context.Context, click models.LinkClick) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPublicLink holds details about calls to the GetPublicLink method.
+		GetPublicLink []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+		// ListClickStats holds details about calls to the ListClickStats method.
+		ListClickStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// RecordClick holds details about calls to the RecordClick method.
+		RecordClick []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Click is the click argument value.
+			Click models.LinkClick
+		}
+	}
+	lockGetPublicLink  sync.RWMutex
+	lockListClickStats sync.RWMutex
+	lockRecordClick    sync.RWMutex
+}
+
+// GetPublicLink calls GetPublicLinkFunc.
+func (mock *LinkClickRepositoryInterfaceMock) GetPublicLink(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error) {
+	if mock.GetPublicLinkFunc == nil {
+		panic("LinkClickRepositoryInterfaceMock.GetPublicLinkFunc: method is nil but LinkClickRepositoryInterface.GetPublicLink was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+	}
+	mock.lockGetPublicLink.Lock()
+	mock.calls.GetPublicLink = append(mock.calls.GetPublicLink, callInfo)
+	mock.lockGetPublicLink.Unlock()
+	return mock.GetPublicLinkFunc(ctx, giftItemID)
+}
+
+// GetPublicLinkCalls gets all the calls that were made to GetPublicLink.
+// Check the length with:
+//
+//	len(mockedLinkClickRepositoryInterface.GetPublicLinkCalls())
+func (mock *LinkClickRepositoryInterfaceMock) GetPublicLinkCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}
+	mock.lockGetPublicLink.RLock()
+	calls = mock.calls.GetPublicLink
+	mock.lockGetPublicLink.RUnlock()
+	return calls
+}
+
+// ListClickStats calls ListClickStatsFunc.
+func (mock *LinkClickRepositoryInterfaceMock) ListClickStats(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error) {
+	if mock.ListClickStatsFunc == nil {
+		panic("LinkClickRepositoryInterfaceMock.ListClickStatsFunc: method is nil but LinkClickRepositoryInterface.ListClickStats was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+		Since:   since,
+	}
+	mock.lockListClickStats.Lock()
+	mock.calls.ListClickStats = append(mock.calls.ListClickStats, callInfo)
+	mock.lockListClickStats.Unlock()
+	return mock.ListClickStatsFunc(ctx, ownerID, since)
+}
+
+// ListClickStatsCalls gets all the calls that were made to ListClickStats.
+// Check the length with:
+//
+//	len(mockedLinkClickRepositoryInterface.ListClickStatsCalls())
+func (mock *LinkClickRepositoryInterfaceMock) ListClickStatsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+	Since   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}
+	mock.lockListClickStats.RLock()
+	calls = mock.calls.ListClickStats
+	mock.lockListClickStats.RUnlock()
+	return calls
+}
+
+// RecordClick calls RecordClickFunc.
+func (mock *LinkClickRepositoryInterfaceMock) RecordClick(ctx context.Context, click models.LinkClick) error {
+	if mock.RecordClickFunc == nil {
+		panic("LinkClickRepositoryInterfaceMock.RecordClickFunc: method is nil but LinkClickRepositoryInterface.RecordClick was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Click models.LinkClick
+	}{
+		Ctx:   ctx,
+		Click: click,
+	}
+	mock.lockRecordClick.Lock()
+	mock.calls.RecordClick = append(mock.calls.RecordClick, callInfo)
+	mock.lockRecordClick.Unlock()
+	return mock.RecordClickFunc(ctx, click)
+}
+
+// RecordClickCalls gets all the calls that were made to RecordClick.
+// Check the length with:
+//
+//	len(mockedLinkClickRepositoryInterface.RecordClickCalls())
+func (mock *LinkClickRepositoryInterfaceMock) RecordClickCalls() []struct {
+	Ctx   context.Context
+	Click models.LinkClick
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Click models.LinkClick
+	}
+	mock.lockRecordClick.RLock()
+	calls = mock.calls.RecordClick
+	mock.lockRecordClick.RUnlock()
+	return calls
+}
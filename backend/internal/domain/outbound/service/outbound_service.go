@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"wish-list/internal/domain/outbound/models"
+	"wish-list/internal/domain/outbound/repository"
+	"wish-list/internal/pkg/affiliate"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidItemID = errors.New("invalid item id")
+	ErrLinkNotFound  = errors.New("item link not found")
+	ErrInvalidPeriod = errors.New("period must be between 1 and 365 days")
+)
+
+// Click statistics periods, in days
+const (
+	defaultStatsDays = 30
+	maxStatsDays     = 365
+)
+
+// OutboundServiceInterface defines the interface for click-through operations
+type OutboundServiceInterface interface {
+	Follow(ctx context.Context, itemID string, userID pgtype.UUID) (*FollowOutput, error)
+	GetClickStats(ctx context.Context, ownerID pgtype.UUID, days int) (*ClickStatsOutput, error)
+}
+
+type OutboundService struct {
+	repo      repository.LinkClickRepositoryInterface
+	decorator *affiliate.Decorator
+}
+
+func NewOutboundService(repo repository.LinkClickRepositoryInterface, decorator *affiliate.Decorator) *OutboundService {
+	return &OutboundService{
+		repo:      repo,
+		decorator: decorator,
+	}
+}
+
+// FollowOutput is where a click-through should be redirected
+type FollowOutput struct {
+	GiftItemID pgtype.UUID
+	URL        string
+	Merchant   string
+	Affiliated bool
+}
+
+// ClickStatsOutput is the click-through summary of an owner's items over a period
+type ClickStatsOutput struct {
+	Since       time.Time
+	TotalClicks int
+	Items       []*models.ItemClickStats
+}
+
+// Follow resolves the decorated link of a public gift item and records the click.
+// userID is the signed-in visitor, if any. A click that cannot be recorded is logged
+// and does not stop the visitor from reaching the shop.
+func (s *OutboundService) Follow(ctx context.Context, itemID string, userID pgtype.UUID) (*FollowOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(itemID); err != nil {
+		return nil, ErrInvalidItemID
+	}
+
+	link, err := s.repo.GetPublicLink(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrPublicLinkNotFound) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get item link: %w", err)
+	}
+
+	// Stored links are not validated as URLs; never redirect to anything but http(s)
+	target, err := url.Parse(link.Link)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, ErrLinkNotFound
+	}
+
+	decorated := s.decorator.Decorate(link.Link)
+	output := &FollowOutput{
+		GiftItemID: link.GiftItemID,
+		URL:        decorated,
+		Merchant:   s.decorator.Merchant(link.Link),
+		Affiliated: decorated != link.Link,
+	}
+
+	click := models.LinkClick{
+		GiftItemID: link.GiftItemID,
+		UserID:     userID,
+		Merchant:   output.Merchant,
+		Affiliated: output.Affiliated,
+	}
+	if err := s.repo.RecordClick(ctx, click); err != nil {
+		logger.WarnContext(ctx, "failed to record link click", "gift_item_id", itemID, "error", err)
+	}
+
+	return output, nil
+}
+
+// GetClickStats summarizes click-throughs on the owner's items over the last days days.
+// Zero days means the default period of 30 days.
+func (s *OutboundService) GetClickStats(ctx context.Context, ownerID pgtype.UUID, days int) (*ClickStatsOutput, error) {
+	if days == 0 {
+		days = defaultStatsDays
+	}
+	if days < 1 || days > maxStatsDays {
+		return nil, ErrInvalidPeriod
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	items, err := s.repo.ListClickStats(ctx, ownerID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get click stats: %w", err)
+	}
+
+	output := &ClickStatsOutput{
+		Since: since,
+		Items: items,
+	}
+	for _, item := range items {
+		output.TotalClicks += item.Clicks
+	}
+
+	return output, nil
+}
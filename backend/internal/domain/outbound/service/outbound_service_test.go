@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/outbound/models"
+	"wish-list/internal/domain/outbound/repository"
+	"wish-list/internal/pkg/affiliate"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+const testItemID = "11111111-1111-1111-1111-111111111111"
+
+var testVisitorID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+
+func linkRepo(link string) *LinkClickRepositoryInterfaceMock {
+	return &LinkClickRepositoryInterfaceMock{
+		GetPublicLinkFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error) {
+			return &models.PublicLink{GiftItemID: giftItemID, Link: link}, nil
+		},
+		RecordClickFunc: func(ctx context.Context, click models.LinkClick) error {
+			return nil
+		},
+	}
+}
+
+func TestOutboundService_Follow(t *testing.T) {
+	decorator := affiliate.NewDecorator([]affiliate.Rule{{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"}})
+
+	t.Run("decorates the link and records the click", func(t *testing.T) {
+		repo := linkRepo("https://www.amazon.com/dp/B0001")
+		svc := NewOutboundService(repo, decorator)
+
+		output, err := svc.Follow(context.Background(), testItemID, testVisitorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.amazon.com/dp/B0001?tag=wishlist-20", output.URL)
+		assert.Equal(t, "amazon.com", output.Merchant)
+		assert.True(t, output.Affiliated)
+
+		require.Len(t, repo.RecordClickCalls(), 1)
+		click := repo.RecordClickCalls()[0].Click
+		assert.Equal(t, testVisitorID, click.UserID)
+		assert.Equal(t, "amazon.com", click.Merchant)
+		assert.True(t, click.Affiliated)
+	})
+
+	t.Run("merchant without rule is followed unchanged", func(t *testing.T) {
+		repo := linkRepo("https://shop.example.com/p/1")
+		svc := NewOutboundService(repo, decorator)
+
+		output, err := svc.Follow(context.Background(), testItemID, pgtype.UUID{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://shop.example.com/p/1", output.URL)
+		assert.Equal(t, "shop.example.com", output.Merchant)
+		assert.False(t, output.Affiliated)
+		assert.False(t, repo.RecordClickCalls()[0].Click.UserID.Valid)
+	})
+
+	t.Run("click that cannot be recorded still redirects", func(t *testing.T) {
+		repo := linkRepo("https://shop.example.com/p/1")
+		repo.RecordClickFunc = func(ctx context.Context, click models.LinkClick) error {
+			return errors.New("db down")
+		}
+		svc := NewOutboundService(repo, decorator)
+
+		output, err := svc.Follow(context.Background(), testItemID, pgtype.UUID{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://shop.example.com/p/1", output.URL)
+	})
+
+	t.Run("non-web link is not followed", func(t *testing.T) {
+		repo := linkRepo("javascript:alert(1)")
+		svc := NewOutboundService(repo, decorator)
+
+		_, err := svc.Follow(context.Background(), testItemID, pgtype.UUID{})
+
+		assert.ErrorIs(t, err, ErrLinkNotFound)
+		assert.Empty(t, repo.RecordClickCalls())
+	})
+
+	t.Run("item not on a public wishlist", func(t *testing.T) {
+		repo := &LinkClickRepositoryInterfaceMock{
+			GetPublicLinkFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*models.PublicLink, error) {
+				return nil, repository.ErrPublicLinkNotFound
+			},
+		}
+		svc := NewOutboundService(repo, decorator)
+
+		_, err := svc.Follow(context.Background(), testItemID, pgtype.UUID{})
+
+		assert.ErrorIs(t, err, ErrLinkNotFound)
+	})
+
+	t.Run("invalid item id", func(t *testing.T) {
+		svc := NewOutboundService(&LinkClickRepositoryInterfaceMock{}, decorator)
+
+		_, err := svc.Follow(context.Background(), "not-a-uuid", pgtype.UUID{})
+
+		assert.ErrorIs(t, err, ErrInvalidItemID)
+	})
+}
+
+func TestOutboundService_GetClickStats(t *testing.T) {
+	t.Run("sums clicks over the default period", func(t *testing.T) {
+		repo := &LinkClickRepositoryInterfaceMock{
+			ListClickStatsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemClickStats, error) {
+				return []*models.ItemClickStats{
+					{Name: "Headphones", Clicks: 5, AffiliatedClicks: 3},
+					{Name: "Book", Clicks: 2},
+				}, nil
+			},
+		}
+		svc := NewOutboundService(repo, nil)
+
+		stats, err := svc.GetClickStats(context.Background(), testVisitorID, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, stats.TotalClicks)
+		assert.Len(t, stats.Items, 2)
+		call := repo.ListClickStatsCalls()[0]
+		assert.Equal(t, testVisitorID, call.OwnerID)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), call.Since, time.Minute)
+	})
+
+	t.Run("period out of range", func(t *testing.T) {
+		svc := NewOutboundService(&LinkClickRepositoryInterfaceMock{}, nil)
+
+		_, err := svc.GetClickStats(context.Background(), testVisitorID, 400)
+
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"wish-list/internal/domain/partner/service"
+)
+
+// CreateKeyRequest represents the request to issue a partner API key
+type CreateKeyRequest struct {
+	Name               string   `json:"name" validate:"required,max=100" example:"Gift Shop Ltd"`
+	Scopes             []string `json:"scopes" validate:"required,min=1,dive,oneof=wishlists:read items:read webhooks:manage" example:"wishlists:read,items:read"`
+	RateLimitPerMinute *int     `json:"rate_limit_per_minute" validate:"omitempty,min=1,max=6000" example:"60"`               // Default 60
+	WebhookDomains     []string `json:"webhook_domains" validate:"omitempty,max=20,dive,required,max=255" example:"shop.com"` // Verified domains of the partner
+}
+
+func (r *CreateKeyRequest) ToServiceInput() service.CreateKeyInput {
+	return service.CreateKeyInput{
+		Name:               r.Name,
+		Scopes:             r.Scopes,
+		RateLimitPerMinute: r.RateLimitPerMinute,
+		WebhookDomains:     r.WebhookDomains,
+	}
+}
+
+// SetWebhookDomainsRequest replaces the domains a partner key may register webhooks for
+type SetWebhookDomainsRequest struct {
+	Domains []string `json:"domains" validate:"max=20,dive,required,max=255" example:"shop.com"`
+}
+
+// UpdateSharingRequest opts a wishlist in to or out of the partner API
+type UpdateSharingRequest struct {
+	Shared *bool `json:"shared" validate:"required" example:"true"`
+}
+
+// RegisterWebhookRequest subscribes a partner to events about items linking to its domain
+type RegisterWebhookRequest struct {
+	Domain string `json:"domain" validate:"required,max=255" example:"shop.com"`
+	URL    string `json:"url" validate:"required,url,max=2048" example:"https://shop.com/hooks/wishlist"`
+}
+
+func (r *RegisterWebhookRequest) ToServiceInput() service.RegisterWebhookInput {
+	return service.RegisterWebhookInput{
+		Domain: r.Domain,
+		URL:    r.URL,
+	}
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/partner/service"
+)
+
+// KeyResponse describes a partner API key without its secret
+type KeyResponse struct {
+	ID                 string   `json:"id" validate:"required"`
+	Name               string   `json:"name" validate:"required"`
+	Prefix             string   `json:"prefix" validate:"required"` // Leading characters of the key, to tell keys apart
+	Scopes             []string `json:"scopes" validate:"required"`
+	WebhookDomains     []string `json:"webhook_domains" validate:"required"` // Domains the key may register webhooks for
+	RateLimitPerMinute int      `json:"rate_limit_per_minute" validate:"required"`
+	LastUsedAt         *string  `json:"last_used_at,omitempty"`
+	CreatedAt          string   `json:"created_at" validate:"required"`
+}
+
+// CreatedKeyResponse includes the key itself, which is shown only once
+type CreatedKeyResponse struct {
+	KeyResponse
+	Key string `json:"key" validate:"required"`
+}
+
+type KeyListResponse struct {
+	Keys []KeyResponse `json:"keys" validate:"required"`
+}
+
+// UsageResponse is a key's request count per day (UTC); days without requests are omitted
+type UsageResponse struct {
+	KeyID string             `json:"key_id" validate:"required"`
+	Since string             `json:"since" validate:"required" example:"2026-09-16"`
+	Total int64              `json:"total" validate:"required"`
+	Days  []UsageDayResponse `json:"days" validate:"required"`
+}
+
+type UsageDayResponse struct {
+	Day      string `json:"day" validate:"required" example:"2026-10-15"`
+	Requests int64  `json:"requests" validate:"required"`
+}
+
+type SharingResponse struct {
+	Shared bool `json:"shared"`
+}
+
+func FromKeyOutput(o *service.KeyOutput) KeyResponse {
+	response := KeyResponse{
+		ID:                 o.ID.String(),
+		Name:               o.Name,
+		Prefix:             o.Prefix,
+		Scopes:             o.Scopes,
+		WebhookDomains:     o.WebhookDomains,
+		RateLimitPerMinute: o.RateLimitPerMinute,
+		CreatedAt:          o.CreatedAt.Format(time.RFC3339),
+	}
+	if o.LastUsedAt != nil {
+		lastUsedAt := o.LastUsedAt.Format(time.RFC3339)
+		response.LastUsedAt = &lastUsedAt
+	}
+	return response
+}
+
+func FromCreatedKeyOutput(o *service.CreatedKeyOutput) CreatedKeyResponse {
+	return CreatedKeyResponse{
+		KeyResponse: FromKeyOutput(&o.KeyOutput),
+		Key:         o.Key,
+	}
+}
+
+func FromKeyOutputs(outputs []*service.KeyOutput) KeyListResponse {
+	keys := make([]KeyResponse, 0, len(outputs))
+	for _, o := range outputs {
+		keys = append(keys, FromKeyOutput(o))
+	}
+	return KeyListResponse{Keys: keys}
+}
+
+func FromUsageOutput(o *service.UsageOutput) UsageResponse {
+	days := make([]UsageDayResponse, 0, len(o.Days))
+	for _, day := range o.Days {
+		days = append(days, UsageDayResponse{Day: day.Day.Format(time.DateOnly), Requests: day.Requests})
+	}
+	return UsageResponse{
+		KeyID: o.KeyID.String(),
+		Since: o.Since.Format(time.DateOnly),
+		Total: o.Total,
+		Days:  days,
+	}
+}
@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/partner/service"
+)
+
+// The V1 types are the partner API's public contract. They are deliberately separate
+// from the app's own responses: fields may be added, but never renamed or removed
+// without a new API version.
+
+// WishlistV1 is a shared wishlist as served to partners
+type WishlistV1 struct {
+	ID           string  `json:"id" validate:"required"`
+	Slug         string  `json:"slug" validate:"required" example:"anna-birthday"`
+	Title        string  `json:"title" validate:"required"`
+	Description  string  `json:"description"`
+	Occasion     string  `json:"occasion"`
+	OccasionDate *string `json:"occasion_date" example:"2026-12-31"` // null when the wishlist has no date
+	URL          string  `json:"url" validate:"required"`            // Public page of the wishlist
+	ItemCount    int64   `json:"item_count" validate:"required"`
+	UpdatedAt    string  `json:"updated_at" validate:"required"`
+}
+
+// ItemV1 is an item of a shared wishlist as served to partners
+type ItemV1 struct {
+	ID          string   `json:"id" validate:"required"`
+	Title       string   `json:"title" validate:"required"`
+	Description string   `json:"description"`
+	Link        string   `json:"link"`
+	ImageURL    string   `json:"image_url"`
+	Price       *float64 `json:"price"` // null when the owner set no price
+	Priority    int      `json:"priority"`
+	Available   bool     `json:"available"` // false once someone reserved or bought the item
+}
+
+type ItemListV1 struct {
+	Items []ItemV1 `json:"items" validate:"required"`
+	Total int      `json:"total" validate:"required"`
+	Page  int      `json:"page" validate:"required"`
+	Limit int      `json:"limit" validate:"required"`
+}
+
+// WebhookV1 is a webhook registered by the partner
+type WebhookV1 struct {
+	ID        string `json:"id" validate:"required"`
+	Domain    string `json:"domain" validate:"required" example:"shop.com"` // Subdomains match too
+	URL       string `json:"url" validate:"required"`
+	CreatedAt string `json:"created_at" validate:"required"`
+}
+
+// CreatedWebhookV1 includes the signing secret, which is shown only once
+type CreatedWebhookV1 struct {
+	WebhookV1
+	Secret string `json:"secret" validate:"required"`
+}
+
+type WebhookListV1 struct {
+	Webhooks []WebhookV1 `json:"webhooks" validate:"required"`
+}
+
+func WishlistV1FromOutput(o *service.WishlistOutput) WishlistV1 {
+	response := WishlistV1{
+		ID:          o.ID.String(),
+		Slug:        o.Slug,
+		Title:       o.Title,
+		Description: o.Description,
+		Occasion:    o.Occasion,
+		URL:         o.URL,
+		ItemCount:   o.ItemCount,
+		UpdatedAt:   o.UpdatedAt.Format(time.RFC3339),
+	}
+	if o.OccasionDate != nil {
+		date := o.OccasionDate.Format(time.DateOnly)
+		response.OccasionDate = &date
+	}
+	return response
+}
+
+func ItemListV1FromOutput(o *service.ItemsOutput) ItemListV1 {
+	items := make([]ItemV1, 0, len(o.Items))
+	for _, item := range o.Items {
+		items = append(items, ItemV1{
+			ID:          item.ID.String(),
+			Title:       item.Title,
+			Description: item.Description,
+			Link:        item.Link,
+			ImageURL:    item.ImageURL,
+			Price:       item.Price,
+			Priority:    item.Priority,
+			Available:   item.Available,
+		})
+	}
+	return ItemListV1{
+		Items: items,
+		Total: o.Total,
+		Page:  o.Page,
+		Limit: o.Limit,
+	}
+}
+
+func WebhookV1FromOutput(o *service.WebhookOutput) WebhookV1 {
+	return WebhookV1{
+		ID:        o.ID.String(),
+		Domain:    o.Domain,
+		URL:       o.URL,
+		CreatedAt: o.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func CreatedWebhookV1FromOutput(o *service.CreatedWebhookOutput) CreatedWebhookV1 {
+	return CreatedWebhookV1{
+		WebhookV1: WebhookV1FromOutput(&o.WebhookOutput),
+		Secret:    o.Secret,
+	}
+}
+
+func WebhookListV1FromOutputs(outputs []*service.WebhookOutput) WebhookListV1 {
+	webhooks := make([]WebhookV1, 0, len(outputs))
+	for _, o := range outputs {
+		webhooks = append(webhooks, WebhookV1FromOutput(o))
+	}
+	return WebhookListV1{Webhooks: webhooks}
+}
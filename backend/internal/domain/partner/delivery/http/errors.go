@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+
+	"wish-list/internal/domain/partner/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapPartnerServiceError converts partner service errors to AppErrors
+func mapPartnerServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidKeyID):
+		return apperrors.BadRequest("Invalid key ID")
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrNameRequired):
+		return apperrors.BadRequest("Partner name is required")
+	case errors.Is(err, service.ErrScopesRequired):
+		return apperrors.BadRequest("At least one scope is required")
+	case errors.Is(err, service.ErrInvalidScope):
+		return apperrors.BadRequest("Unknown scope")
+	case errors.Is(err, service.ErrInvalidRateLimit):
+		return apperrors.BadRequest("Rate limit must be between 1 and 6000 requests per minute")
+	case errors.Is(err, service.ErrInvalidPeriod):
+		return apperrors.BadRequest("Usage period must be between 1 and 90 days")
+	case errors.Is(err, service.ErrKeyNotFound):
+		return apperrors.NotFound("Partner API key not found")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Wishlist not found")
+	case errors.Is(err, service.ErrInvalidWebhookID):
+		return apperrors.BadRequest("Invalid webhook ID")
+	case errors.Is(err, service.ErrInvalidDomain):
+		return apperrors.BadRequest("Domain must be a host name such as shop.com")
+	case errors.Is(err, service.ErrInvalidWebhookURL):
+		return apperrors.BadRequest("Webhook URL must be an https URL")
+	case errors.Is(err, service.ErrTooManyWebhooks):
+		return apperrors.Conflict("Key has reached its webhook limit").
+			WithDetails(map[string]string{"limit": strconv.Itoa(service.MaxWebhooksPerKey)})
+	case errors.Is(err, service.ErrTooManyWebhookDomains):
+		return apperrors.BadRequest("Too many webhook domains").
+			WithDetails(map[string]string{"limit": strconv.Itoa(service.MaxWebhookDomainsPerKey)})
+	case errors.Is(err, service.ErrWebhookDomainNotAllowed):
+		return apperrors.Forbidden("Domain is not allowed for this API key; ask us to add it")
+	case errors.Is(err, service.ErrWebhookNotFound):
+		return apperrors.NotFound("Webhook not found")
+	default:
+		return apperrors.Internal("Partner API request failed").Wrap(err)
+	}
+}
@@ -0,0 +1,351 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/partner/delivery/http/dto"
+	"wish-list/internal/domain/partner/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for the partner API
+type Handler struct {
+	service service.PartnerServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.PartnerServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateKey godoc
+//
+//	@Summary		Create a partner API key
+//	@Description	Issues an API key for a partner such as a gift shop. The key is returned only in this response. Scopes: wishlists:read reads shared wishlists, items:read reads their items, webhooks:manage registers webhooks for the key's webhook domains.
+//	@Tags			Partner API Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			key	body		dto.CreateKeyRequest	true	"Partner name, scopes, rate limit and webhook domains"
+//	@Success		201	{object}	dto.CreatedKeyResponse	"Key created"
+//	@Failure		400	{object}	map[string]string		"Invalid request body"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Admins only"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/partner-keys [post]
+func (h *Handler) CreateKey(c echo.Context) error {
+	adminID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.CreateKeyRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	output, err := h.service.CreateKey(c.Request().Context(), adminID, req.ToServiceInput())
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromCreatedKeyOutput(output))
+}
+
+// ListKeys godoc
+//
+//	@Summary		List partner API keys
+//	@Description	All unrevoked partner keys, newest first
+//	@Tags			Partner API Admin
+//	@Produce		json
+//	@Success		200	{object}	dto.KeyListResponse	"Partner keys"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Admins only"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/partner-keys [get]
+func (h *Handler) ListKeys(c echo.Context) error {
+	keys, err := h.service.ListKeys(c.Request().Context())
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromKeyOutputs(keys))
+}
+
+// RevokeKey godoc
+//
+//	@Summary		Revoke a partner API key
+//	@Tags			Partner API Admin
+//	@Param			id	path	string	true	"Key ID"
+//	@Success		204	"Key revoked"
+//	@Failure		400	{object}	map[string]string	"Invalid key ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Admins only"
+//	@Failure		404	{object}	map[string]string	"Key not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/partner-keys/{id} [delete]
+func (h *Handler) RevokeKey(c echo.Context) error {
+	if err := h.service.RevokeKey(c.Request().Context(), c.Param("id")); err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// GetKeyUsage godoc
+//
+//	@Summary		Get partner API key usage
+//	@Description	Requests made with the key per day (UTC) over the last days, today included. Revoked keys stay reportable.
+//	@Tags			Partner API Admin
+//	@Produce		json
+//	@Param			id		path		string				true	"Key ID"
+//	@Param			days	query		int					false	"Period in days (default 30, max 90)"
+//	@Success		200		{object}	dto.UsageResponse	"Usage"
+//	@Failure		400		{object}	map[string]string	"Invalid key ID or period"
+//	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		403		{object}	map[string]string	"Admins only"
+//	@Failure		404		{object}	map[string]string	"Key not found"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/partner-keys/{id}/usage [get]
+func (h *Handler) GetKeyUsage(c echo.Context) error {
+	days := 0
+	if raw := c.QueryParam("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return apperrors.BadRequest("Usage period must be between 1 and 90 days")
+		}
+		days = parsed
+	}
+
+	usage, err := h.service.GetUsage(c.Request().Context(), c.Param("id"), days)
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromUsageOutput(usage))
+}
+
+// SetWebhookDomains godoc
+//
+//	@Summary		Set a partner API key's webhook domains
+//	@Description	Replaces the domains the key may register webhooks for, subdomains included. Only add domains the partner was verified to own. Webhooks for domains no longer listed are removed.
+//	@Tags			Partner API Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Key ID"
+//	@Param			domains	body		dto.SetWebhookDomainsRequest	true	"Allowed domains"
+//	@Success		200		{object}	dto.KeyResponse				"Key updated"
+//	@Failure		400		{object}	map[string]string			"Invalid key ID or domain"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Admins only"
+//	@Failure		404		{object}	map[string]string			"Key not found"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/partner-keys/{id}/webhook-domains [put]
+func (h *Handler) SetWebhookDomains(c echo.Context) error {
+	var req dto.SetWebhookDomainsRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	key, err := h.service.SetWebhookDomains(c.Request().Context(), c.Param("id"), req.Domains)
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromKeyOutput(key))
+}
+
+// UpdateSharing godoc
+//
+//	@Summary		Share a wishlist with partners
+//	@Description	Opts a wishlist in to (or out of) the partner API, which lets partners such as gift shops embed it. Partners only see wishlists that are also public, and never see who reserved or bought items.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Wish List ID"
+//	@Param			sharing	body		dto.UpdateSharingRequest	true	"Sharing setting"
+//	@Success		200		{object}	dto.SharingResponse			"Sharing updated"
+//	@Failure		400		{object}	map[string]string			"Invalid request body"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		404		{object}	map[string]string			"Wish list not found"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/partner-sharing [put]
+func (h *Handler) UpdateSharing(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateSharingRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.SetSharing(c.Request().Context(), ownerID, c.Param("id"), *req.Shared); err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.SharingResponse{Shared: *req.Shared})
+}
+
+// GetWishlist godoc
+//
+//	@Summary		Get a shared wishlist
+//	@Description	A public wishlist its owner shared with partners. Requires a partner API key with the wishlists:read scope. Responses carry X-RateLimit-Limit and X-RateLimit-Remaining headers.
+//	@Tags			Partner API
+//	@Produce		json
+//	@Param			slug	path		string				true	"Public slug"
+//	@Success		200		{object}	dto.WishlistV1		"Wishlist"
+//	@Failure		401		{object}	map[string]string	"Missing, invalid or revoked API key"
+//	@Failure		403		{object}	map[string]string	"API key lacks the wishlists:read scope"
+//	@Failure		404		{object}	map[string]string	"Wishlist not found or not shared"
+//	@Failure		429		{object}	map[string]string	"Rate limit exceeded"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		PartnerKeyAuth
+//	@Router			/partner/v1/wishlists/{slug} [get]
+func (h *Handler) GetWishlist(c echo.Context) error {
+	wishlist, err := h.service.GetWishlist(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.WishlistV1FromOutput(wishlist))
+}
+
+// ListItems godoc
+//
+//	@Summary		List items of a shared wishlist
+//	@Description	Items of a public wishlist its owner shared with partners, in the owner's order. Requires a partner API key with the items:read scope.
+//	@Tags			Partner API
+//	@Produce		json
+//	@Param			slug	path		string				true	"Public slug"
+//	@Param			page	query		int					false	"Page number (default 1)"
+//	@Param			limit	query		int					false	"Items per page (default 10, max 100)"
+//	@Success		200		{object}	dto.ItemListV1		"Items"
+//	@Failure		401		{object}	map[string]string	"Missing, invalid or revoked API key"
+//	@Failure		403		{object}	map[string]string	"API key lacks the items:read scope"
+//	@Failure		404		{object}	map[string]string	"Wishlist not found or not shared"
+//	@Failure		429		{object}	map[string]string	"Rate limit exceeded"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Security		PartnerKeyAuth
+//	@Router			/partner/v1/wishlists/{slug}/items [get]
+func (h *Handler) ListItems(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+
+	items, err := h.service.ListItems(c.Request().Context(), c.Param("slug"), pagination.Page, pagination.Limit)
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.ItemListV1FromOutput(items))
+}
+
+// RegisterWebhook godoc
+//
+//	@Summary		Register a webhook
+//	@Description	Subscribes to events about items that link to a product on the given domain or its subdomains and are on any public wishlist: item.reserved, item.released and item.purchased, e.g. to hold inventory. Events are POSTed as JSON to the URL with X-Webhook-Event, X-Webhook-ID and X-Webhook-Signature headers; the signature is "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>" with the secret>". Failed deliveries are retried. Registering a domain again replaces its URL and secret. The secret is returned only in this response. Requires a partner API key with the webhooks:manage scope; the domain must be one of the key's webhook domains or a subdomain of one.
+//	@Tags			Partner API
+//	@Accept			json
+//	@Produce		json
+//	@Param			webhook	body		dto.RegisterWebhookRequest	true	"Domain and callback URL"
+//	@Success		201		{object}	dto.CreatedWebhookV1		"Webhook registered"
+//	@Failure		400		{object}	map[string]string			"Invalid domain or URL"
+//	@Failure		401		{object}	map[string]string			"Missing, invalid or revoked API key"
+//	@Failure		403		{object}	map[string]string			"API key lacks the webhooks:manage scope or the domain is not allowed"
+//	@Failure		409		{object}	map[string]string			"Webhook limit reached"
+//	@Failure		429		{object}	map[string]string			"Rate limit exceeded"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		PartnerKeyAuth
+//	@Router			/partner/v1/webhooks [post]
+func (h *Handler) RegisterWebhook(c echo.Context) error {
+	keyID, err := partnerKeyID(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.RegisterWebhookRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	webhook, err := h.service.RegisterWebhook(c.Request().Context(), keyID, req.ToServiceInput())
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.CreatedWebhookV1FromOutput(webhook))
+}
+
+// ListWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Description	The webhooks registered with the API key, ordered by domain. Requires the webhooks:manage scope.
+//	@Tags			Partner API
+//	@Produce		json
+//	@Success		200	{object}	dto.WebhookListV1	"Webhooks"
+//	@Failure		401	{object}	map[string]string	"Missing, invalid or revoked API key"
+//	@Failure		403	{object}	map[string]string	"API key lacks the webhooks:manage scope"
+//	@Failure		429	{object}	map[string]string	"Rate limit exceeded"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		PartnerKeyAuth
+//	@Router			/partner/v1/webhooks [get]
+func (h *Handler) ListWebhooks(c echo.Context) error {
+	keyID, err := partnerKeyID(c)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := h.service.ListWebhooks(c.Request().Context(), keyID)
+	if err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.WebhookListV1FromOutputs(webhooks))
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Description	Stops sending events to the webhook; deliveries still queued are dropped. Requires the webhooks:manage scope.
+//	@Tags			Partner API
+//	@Param			id	path	string	true	"Webhook ID"
+//	@Success		204	"Webhook deleted"
+//	@Failure		400	{object}	map[string]string	"Invalid webhook ID"
+//	@Failure		401	{object}	map[string]string	"Missing, invalid or revoked API key"
+//	@Failure		403	{object}	map[string]string	"API key lacks the webhooks:manage scope"
+//	@Failure		404	{object}	map[string]string	"Webhook not found"
+//	@Failure		429	{object}	map[string]string	"Rate limit exceeded"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		PartnerKeyAuth
+//	@Router			/partner/v1/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(c echo.Context) error {
+	keyID, err := partnerKeyID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteWebhook(c.Request().Context(), keyID, c.Param("id")); err != nil {
+		return mapPartnerServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// partnerKeyID returns the ID of the key KeyMiddleware authenticated
+func partnerKeyID(c echo.Context) (pgtype.UUID, error) {
+	raw, _ := c.Get("partner_key_id").(string)
+	return helpers.ParseUUID(c, raw)
+}
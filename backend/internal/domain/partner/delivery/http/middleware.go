@@ -0,0 +1,55 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+
+	"wish-list/internal/app/middleware"
+	"wish-list/internal/domain/partner/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// KeyHeader carries the partner API key
+const KeyHeader = "X-API-Key"
+
+// KeyMiddleware authenticates partner requests with the API key in the X-API-Key
+// header, requires scope and applies the key's own per-minute rate limit.
+// Authenticated requests are metered, including those that are then rate limited.
+func KeyMiddleware(svc service.PartnerServiceInterface, limiter *middleware.AuthRateLimiter, scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rawKey := c.Request().Header.Get(KeyHeader)
+			if rawKey == "" {
+				return apperrors.Unauthorized("Missing " + KeyHeader + " header")
+			}
+
+			ctx := c.Request().Context()
+			key, err := svc.Authenticate(ctx, rawKey, scope)
+			switch {
+			case err == nil:
+			case errors.Is(err, service.ErrInvalidKey):
+				return apperrors.Unauthorized("Invalid or revoked API key")
+			case errors.Is(err, service.ErrInsufficientScope):
+				return apperrors.Forbidden("API key lacks the " + scope + " scope")
+			default:
+				return apperrors.Internal("Failed to authenticate API key").Wrap(err)
+			}
+
+			keyID := key.ID.String()
+			if !limiter.AllowWithLimit(keyID, key.RateLimitPerMinute) {
+				return apperrors.TooManyRequests("Rate limit exceeded. Please try again later.")
+			}
+			c.Response().Header().Set("X-RateLimit-Limit", strconv.Itoa(key.RateLimitPerMinute))
+			c.Response().Header().Set("X-RateLimit-Remaining", strconv.Itoa(limiter.RemainingWithLimit(keyID, key.RateLimitPerMinute)))
+
+			c.Set("partner_key_id", keyID)
+			ctx = logger.WithContext(ctx, "partner_key_id", keyID)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"time"
+
+	"wish-list/internal/app/middleware"
+	"wish-list/internal/domain/partner/models"
+	"wish-list/internal/pkg/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers partner API routes: key management for admins, sharing
+// for wishlist owners and the versioned read-only API for partners
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	admin := e.Group("/api/admin/partner-keys", authMiddleware, auth.RequireUserType("admin"))
+	admin.POST("", h.CreateKey)
+	admin.GET("", h.ListKeys)
+	admin.DELETE("/:id", h.RevokeKey)
+	admin.GET("/:id/usage", h.GetKeyUsage)
+	admin.PUT("/:id/webhook-domains", h.SetWebhookDomains)
+
+	e.PUT("/api/wishlists/:id/partner-sharing", h.UpdateSharing, authMiddleware)
+
+	// Each key has its own limit, so one window is shared by all keys
+	limiter := middleware.NewAuthRateLimiter(middleware.RateLimitConfig{Window: time.Minute})
+	v1 := e.Group("/api/partner/v1")
+	v1.GET("/wishlists/:slug", h.GetWishlist, KeyMiddleware(h.service, limiter, models.ScopeWishlistsRead))
+	v1.GET("/wishlists/:slug/items", h.ListItems, KeyMiddleware(h.service, limiter, models.ScopeItemsRead))
+
+	webhooks := v1.Group("/webhooks", KeyMiddleware(h.service, limiter, models.ScopeWebhooksManage))
+	webhooks.POST("", h.RegisterWebhook)
+	webhooks.GET("", h.ListWebhooks)
+	webhooks.DELETE("/:id", h.DeleteWebhook)
+}
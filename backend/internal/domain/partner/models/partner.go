@@ -0,0 +1,115 @@
+package models
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Scopes a partner API key can be granted
+const (
+	ScopeWishlistsRead  = "wishlists:read"  // Read shared wishlists
+	ScopeItemsRead      = "items:read"      // Read the items of shared wishlists
+	ScopeWebhooksManage = "webhooks:manage" // Register callbacks for the partner's product domains
+)
+
+// Scopes lists every scope in the order they are documented
+var Scopes = []string{ScopeWishlistsRead, ScopeItemsRead, ScopeWebhooksManage}
+
+// Item events sent to partner webhooks
+const (
+	EventItemReserved  = "item.reserved"  // Someone reserved units of the item
+	EventItemReleased  = "item.released"  // A reservation was canceled, so the units are free again
+	EventItemPurchased = "item.purchased" // The item was marked as purchased
+)
+
+// APIKey is a partner's API key. The key itself is never stored, only its hash.
+type APIKey struct {
+	ID                 pgtype.UUID        `db:"id"`
+	Name               string             `db:"name"`
+	KeyHash            string             `db:"key_hash"`
+	KeyPrefix          string             `db:"key_prefix"`
+	Scopes             string             `db:"scopes"`          // Space-separated
+	WebhookDomains     string             `db:"webhook_domains"` // Space-separated; set by admins
+	RateLimitPerMinute int32              `db:"rate_limit_per_minute"`
+	CreatedBy          pgtype.UUID        `db:"created_by"`
+	LastUsedAt         pgtype.Timestamptz `db:"last_used_at"`
+	RevokedAt          pgtype.Timestamptz `db:"revoked_at"`
+	CreatedAt          pgtype.Timestamptz `db:"created_at"`
+}
+
+// ScopeList returns the key's scopes
+func (k *APIKey) ScopeList() []string {
+	return strings.Fields(k.Scopes)
+}
+
+// HasScope reports whether the key was granted scope
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.ScopeList(), scope)
+}
+
+// WebhookDomainList returns the domains the key may register webhooks for
+func (k *APIKey) WebhookDomainList() []string {
+	return strings.Fields(k.WebhookDomains)
+}
+
+// AllowsWebhookDomain reports whether domain is one of the key's webhook domains or
+// a subdomain of one
+func (k *APIKey) AllowsWebhookDomain(domain string) bool {
+	return slices.ContainsFunc(k.WebhookDomainList(), func(allowed string) bool {
+		return domain == allowed || strings.HasSuffix(domain, "."+allowed)
+	})
+}
+
+// UsageDay is the number of requests a key made on one day (UTC)
+type UsageDay struct {
+	Day      pgtype.Date `db:"day"`
+	Requests int64       `db:"requests"`
+}
+
+// SharedWishlist is a public wishlist its owner shared with partners
+type SharedWishlist struct {
+	ID           pgtype.UUID        `db:"id"`
+	PublicSlug   string             `db:"public_slug"`
+	Title        string             `db:"title"`
+	Description  pgtype.Text        `db:"description"`
+	Occasion     pgtype.Text        `db:"occasion"`
+	OccasionDate pgtype.Date        `db:"occasion_date"`
+	ItemCount    int64              `db:"item_count"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
+}
+
+// SharedItem is an item of a shared wishlist, without who reserved or bought it
+type SharedItem struct {
+	ID          pgtype.UUID    `db:"id"`
+	Name        string         `db:"name"`
+	Description pgtype.Text    `db:"description"`
+	Link        pgtype.Text    `db:"link"`
+	ImageURL    pgtype.Text    `db:"image_url"`
+	Price       pgtype.Numeric `db:"price"`
+	Priority    pgtype.Int4    `db:"priority"`
+	Available   bool           `db:"available"` // Neither reserved nor purchased
+}
+
+// Webhook is a callback a partner registered for items linking to its domain
+type Webhook struct {
+	ID        pgtype.UUID        `db:"id"`
+	KeyID     pgtype.UUID        `db:"key_id"`
+	Domain    string             `db:"domain"` // Lowercase host without "www."; subdomains match too
+	URL       string             `db:"url"`
+	Secret    string             `db:"secret"` // HMAC signing secret
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+// WebhookDelivery is an event waiting in the webhook outbox, with where to send it
+type WebhookDelivery struct {
+	ID        pgtype.UUID        `db:"id"`
+	WebhookID pgtype.UUID        `db:"webhook_id"`
+	URL       string             `db:"url"`
+	Secret    string             `db:"secret"`
+	Event     string             `db:"event"`
+	Payload   []byte             `db:"payload"` // JSON body
+	Attempts  int                `db:"attempts"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
@@ -0,0 +1,461 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_partner_repository_test.go -pkg service . PartnerRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/partner/models"
+)
+
+// Sentinel errors for partner repository
+var (
+	ErrKeyNotFound      = errors.New("partner api key not found")
+	ErrWishlistNotFound = errors.New("wishlist not found")
+	ErrWebhookNotFound  = errors.New("partner webhook not found")
+)
+
+// PartnerRepositoryInterface defines the interface for partner API database operations
+type PartnerRepositoryInterface interface {
+	CreateKey(ctx context.Context, key models.APIKey) (*models.APIKey, error)
+	ListKeys(ctx context.Context) ([]*models.APIKey, error)
+	GetKey(ctx context.Context, id pgtype.UUID) (*models.APIKey, error)
+	GetActiveKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	RevokeKey(ctx context.Context, id pgtype.UUID) error
+	SetWebhookDomains(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error)
+	RecordUsage(ctx context.Context, keyID pgtype.UUID) error
+	ListUsage(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error)
+	SetPartnerShared(ctx context.Context, wishlistID, ownerID pgtype.UUID, shared bool) error
+	GetSharedWishlist(ctx context.Context, publicSlug string) (*models.SharedWishlist, error)
+	ListSharedItems(ctx context.Context, wishlistID pgtype.UUID, limit, offset int) ([]*models.SharedItem, int, error)
+	UpsertWebhook(ctx context.Context, webhook models.Webhook) (*models.Webhook, error)
+	ListWebhooks(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id, keyID pgtype.UUID) error
+	EnqueueItemEvent(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error)
+	ListPendingDeliveries(ctx context.Context, limit int) ([]*models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id pgtype.UUID) error
+	RecordDeliveryFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+}
+
+type PartnerRepository struct {
+	db *database.DB
+}
+
+func NewPartnerRepository(db *database.DB) PartnerRepositoryInterface {
+	return &PartnerRepository{
+		db: db,
+	}
+}
+
+const (
+	apiKeyColumns  = `id, name, key_hash, key_prefix, scopes, webhook_domains, rate_limit_per_minute, created_by, last_used_at, revoked_at, created_at`
+	webhookColumns = `id, key_id, domain, url, secret, created_at`
+)
+
+// CreateKey stores a new key
+func (r *PartnerRepository) CreateKey(ctx context.Context, key models.APIKey) (*models.APIKey, error) {
+	query := `
+		INSERT INTO partner_api_keys (name, key_hash, key_prefix, scopes, webhook_domains, rate_limit_per_minute, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + apiKeyColumns
+
+	var created models.APIKey
+	if err := r.db.QueryRowxContext(ctx, query,
+		key.Name, key.KeyHash, key.KeyPrefix, key.Scopes, key.WebhookDomains, key.RateLimitPerMinute, key.CreatedBy,
+	).StructScan(&created); err != nil {
+		return nil, fmt.Errorf("failed to create partner api key: %w", err)
+	}
+
+	return &created, nil
+}
+
+// ListKeys returns all unrevoked keys, newest first
+func (r *PartnerRepository) ListKeys(ctx context.Context) ([]*models.APIKey, error) {
+	query := `
+		SELECT ` + apiKeyColumns + `
+		FROM partner_api_keys
+		WHERE revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	var keys []*models.APIKey
+	if err := r.db.SelectContext(ctx, &keys, query); err != nil {
+		return nil, fmt.Errorf("failed to list partner api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// GetKey returns a key by ID, including revoked keys so their usage stays reportable
+func (r *PartnerRepository) GetKey(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM partner_api_keys WHERE id = $1`
+
+	var key models.APIKey
+	if err := r.db.GetContext(ctx, &key, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get partner api key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// GetActiveKeyByHash returns the unrevoked key with the given hash
+func (r *PartnerRepository) GetActiveKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM partner_api_keys WHERE key_hash = $1 AND revoked_at IS NULL`
+
+	var key models.APIKey
+	if err := r.db.GetContext(ctx, &key, query, keyHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get partner api key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// RevokeKey disables a key
+func (r *PartnerRepository) RevokeKey(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE partner_api_keys SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke partner api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// SetWebhookDomains replaces the domains an unrevoked key may register webhooks for.
+// The key's webhooks for domains no longer allowed are removed along with their
+// pending deliveries.
+func (r *PartnerRepository) SetWebhookDomains(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error) {
+	query := `
+		WITH updated AS (
+			UPDATE partner_api_keys SET webhook_domains = $2
+			WHERE id = $1 AND revoked_at IS NULL
+			RETURNING ` + apiKeyColumns + `
+		), removed AS (
+			DELETE FROM partner_webhooks pw
+			USING updated k
+			WHERE pw.key_id = k.id
+				AND NOT EXISTS (
+					SELECT 1 FROM unnest(string_to_array(k.webhook_domains, ' ')) AS allowed(domain)
+					WHERE pw.domain = allowed.domain OR pw.domain LIKE '%.' || allowed.domain
+				)
+		)
+		SELECT * FROM updated
+	`
+
+	var key models.APIKey
+	if err := r.db.GetContext(ctx, &key, query, id, domains); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to set partner webhook domains: %w", err)
+	}
+
+	return &key, nil
+}
+
+// RecordUsage counts one request against the key for the current UTC day and
+// records when the key was last used
+func (r *PartnerRepository) RecordUsage(ctx context.Context, keyID pgtype.UUID) error {
+	query := `
+		WITH usage AS (
+			INSERT INTO partner_api_usage (key_id, day, requests)
+			VALUES ($1, (NOW() AT TIME ZONE 'UTC')::date, 1)
+			ON CONFLICT (key_id, day) DO UPDATE SET requests = partner_api_usage.requests + 1
+		)
+		UPDATE partner_api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, keyID); err != nil {
+		return fmt.Errorf("failed to record partner api usage: %w", err)
+	}
+
+	return nil
+}
+
+// ListUsage returns the key's daily request counts since the given day, oldest first.
+// Days without requests are omitted.
+func (r *PartnerRepository) ListUsage(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error) {
+	query := `
+		SELECT day, requests
+		FROM partner_api_usage
+		WHERE key_id = $1 AND day >= $2::date
+		ORDER BY day
+	`
+
+	var days []*models.UsageDay
+	if err := r.db.SelectContext(ctx, &days, query, keyID, since.UTC().Format(time.DateOnly)); err != nil {
+		return nil, fmt.Errorf("failed to list partner api usage: %w", err)
+	}
+
+	return days, nil
+}
+
+// SetPartnerShared opts the owner's wishlist in to or out of the partner API.
+// Wishlists of other owners are not found.
+func (r *PartnerRepository) SetPartnerShared(ctx context.Context, wishlistID, ownerID pgtype.UUID, shared bool) error {
+	query := `UPDATE wishlists SET partner_shared = $3 WHERE id = $1 AND owner_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, wishlistID, ownerID, shared)
+	if err != nil {
+		return fmt.Errorf("failed to update partner sharing: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrWishlistNotFound
+	}
+
+	return nil
+}
+
+// GetSharedWishlist returns a public wishlist shared with partners by its slug.
+// Reads go to the read replica when one is attached.
+func (r *PartnerRepository) GetSharedWishlist(ctx context.Context, publicSlug string) (*models.SharedWishlist, error) {
+	query := `
+		SELECT
+			w.id, w.public_slug, w.title, w.description, w.occasion, w.occasion_date, w.updated_at,
+			(
+				SELECT COUNT(*)
+				FROM wishlist_items wi
+				JOIN gift_items gi ON gi.id = wi.gift_item_id AND gi.archived_at IS NULL
+				WHERE wi.wishlist_id = w.id
+			) AS item_count
+		FROM wishlists w
+		JOIN users u ON u.id = w.owner_id AND u.deactivated_at IS NULL
+		WHERE w.public_slug = $1 AND w.is_public = true AND w.partner_shared = true
+	`
+
+	var wishlist models.SharedWishlist
+	if err := r.db.Reader().GetContext(ctx, &wishlist, query, publicSlug); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get shared wishlist: %w", err)
+	}
+
+	return &wishlist, nil
+}
+
+// ListSharedItems returns a page of a shared wishlist's items in the owner's order,
+// with the total item count. Reads go to the read replica when one is attached.
+func (r *PartnerRepository) ListSharedItems(ctx context.Context, wishlistID pgtype.UUID, limit, offset int) ([]*models.SharedItem, int, error) {
+	countQuery := `
+		SELECT COUNT(*)
+		FROM wishlist_items wi
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = $1 AND gi.archived_at IS NULL
+	`
+
+	var total int
+	if err := r.db.Reader().GetContext(ctx, &total, countQuery, wishlistID); err != nil {
+		return nil, 0, fmt.Errorf("failed to count shared items: %w", err)
+	}
+
+	query := `
+		SELECT
+			gi.id, gi.name, gi.description, gi.link, gi.image_url, gi.price, gi.priority,
+			(
+				gi.purchased_by_user_id IS NULL
+				AND gi.manual_reserved_by_name IS NULL
+				AND gi.encrypted_manual_reserved_by_name IS NULL
+				AND NOT EXISTS (
+					SELECT 1 FROM reservations r
+					WHERE r.gift_item_id = gi.id AND r.status = 'active'
+				)
+			) AS available
+		FROM wishlist_items wi
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = $1 AND gi.archived_at IS NULL
+		ORDER BY gi.position ASC, gi.created_at ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	var items []*models.SharedItem
+	if err := r.db.Reader().SelectContext(ctx, &items, query, wishlistID, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list shared items: %w", err)
+	}
+
+	return items, total, nil
+}
+
+// UpsertWebhook registers a webhook, or replaces the URL and secret of the key's
+// webhook for the same domain
+func (r *PartnerRepository) UpsertWebhook(ctx context.Context, webhook models.Webhook) (*models.Webhook, error) {
+	query := `
+		INSERT INTO partner_webhooks (key_id, domain, url, secret)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key_id, domain) DO UPDATE SET url = EXCLUDED.url, secret = EXCLUDED.secret
+		RETURNING ` + webhookColumns
+
+	var saved models.Webhook
+	if err := r.db.QueryRowxContext(ctx, query, webhook.KeyID, webhook.Domain, webhook.URL, webhook.Secret).StructScan(&saved); err != nil {
+		return nil, fmt.Errorf("failed to save partner webhook: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// ListWebhooks returns the key's webhooks ordered by domain
+func (r *PartnerRepository) ListWebhooks(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM partner_webhooks WHERE key_id = $1 ORDER BY domain`
+
+	var webhooks []*models.Webhook
+	if err := r.db.SelectContext(ctx, &webhooks, query, keyID); err != nil {
+		return nil, fmt.Errorf("failed to list partner webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes one of the key's webhooks along with its pending deliveries
+func (r *PartnerRepository) DeleteWebhook(ctx context.Context, id, keyID pgtype.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM partner_webhooks WHERE id = $1 AND key_id = $2`, id, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to delete partner webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnqueueItemEvent queues a delivery of the event for every webhook of an unrevoked
+// key whose domain matches the host of the item's link and is still allowed on the
+// key, provided the item is on a
+// public wishlist. The payload snapshots the item now; slugs are only included for
+// wishlists shared with partners. Returns the number of deliveries queued.
+func (r *PartnerRepository) EnqueueItemEvent(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error) {
+	query := `
+		INSERT INTO partner_webhook_deliveries (webhook_id, event, payload)
+		SELECT pw.id, $2::text, jsonb_build_object(
+			'event', $2::text,
+			'occurred_at', NOW(),
+			'item', jsonb_build_object(
+				'id', gi.id,
+				'name', gi.name,
+				'link', gi.link,
+				'price', gi.price,
+				'quantity', gi.quantity,
+				'reserved_quantity', gi.reserved_quantity,
+				'purchased', gi.purchased_at IS NOT NULL
+			),
+			'wishlists', COALESCE((
+				SELECT jsonb_agg(w.public_slug ORDER BY w.public_slug)
+				FROM wishlist_items wi
+				JOIN wishlists w ON w.id = wi.wishlist_id
+				WHERE wi.gift_item_id = gi.id AND w.is_public = true AND w.partner_shared = true
+			), '[]'::jsonb)
+		)
+		FROM gift_items gi
+		CROSS JOIN LATERAL (
+			SELECT substring(lower(gi.link) FROM '^https?://(?:www\.)?([^/:?#]+)') AS host
+		) link
+		JOIN partner_webhooks pw ON link.host = pw.domain OR link.host LIKE '%.' || pw.domain
+		JOIN partner_api_keys k ON k.id = pw.key_id AND k.revoked_at IS NULL
+		WHERE gi.id = $1
+			AND EXISTS (
+				SELECT 1 FROM unnest(string_to_array(k.webhook_domains, ' ')) AS allowed(domain)
+				WHERE pw.domain = allowed.domain OR pw.domain LIKE '%.' || allowed.domain
+			)
+			AND EXISTS (
+				SELECT 1
+				FROM wishlist_items wi
+				JOIN wishlists w ON w.id = wi.wishlist_id
+				JOIN users u ON u.id = w.owner_id AND u.deactivated_at IS NULL
+				WHERE wi.gift_item_id = gi.id AND w.is_public = true
+			)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, giftItemID, event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue partner webhook deliveries: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// ListPendingDeliveries returns the oldest deliveries in the webhook outbox that are
+// due for an attempt
+func (r *PartnerRepository) ListPendingDeliveries(ctx context.Context, limit int) ([]*models.WebhookDelivery, error) {
+	query := `
+		SELECT d.id, d.webhook_id, pw.url, pw.secret, d.event, d.payload, d.attempts, d.created_at
+		FROM partner_webhook_deliveries d
+		JOIN partner_webhooks pw ON pw.id = d.webhook_id
+		WHERE d.delivered_at IS NULL AND d.failed_at IS NULL AND d.next_attempt_at <= NOW()
+		ORDER BY d.created_at ASC
+		LIMIT $1
+	`
+
+	var deliveries []*models.WebhookDelivery
+	if err := r.db.SelectContext(ctx, &deliveries, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to list pending partner webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// MarkDelivered takes a delivery out of the webhook outbox
+func (r *PartnerRepository) MarkDelivered(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE partner_webhook_deliveries SET attempts = attempts + 1, delivered_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark partner webhook delivered: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDeliveryFailure counts a failed delivery attempt and backs off exponentially:
+// the next attempt is 2, 4, 8... minutes later. After maxAttempts the delivery is
+// marked failed and leaves the outbox.
+func (r *PartnerRepository) RecordDeliveryFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+	query := `
+		UPDATE partner_webhook_deliveries SET
+			attempts = attempts + 1,
+			next_attempt_at = NOW() + make_interval(mins => power(2, attempts + 1)::int),
+			failed_at = CASE WHEN attempts + 1 >= $2 THEN NOW() END
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, maxAttempts); err != nil {
+		return fmt.Errorf("failed to record partner webhook failure: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,983 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/partner/models"
+	"wish-list/internal/domain/partner/repository"
+)
+
+// Ensure, that PartnerRepositoryInterfaceMock does implement repository.PartnerRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.PartnerRepositoryInterface = &PartnerRepositoryInterfaceMock{}
+
+// PartnerRepositoryInterfaceMock is a mock implementation of repository.PartnerRepositoryInterface.
+//
+//	func TestSomethingThatUsesPartnerRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.PartnerRepositoryInterface
+//		mockedPartnerRepositoryInterface := &PartnerRepositoryInterfaceMock{
+//			CreateKeyFunc: func(ctx context.Context, key models.APIKey) (*models.APIKey, error) {
+//				panic("mock out the CreateKey method")
+//			},
+//			DeleteWebhookFunc: func(ctx context.Context, id pgtype.UUID, keyID pgtype.UUID) error {
+//				panic("mock out the DeleteWebhook method")
+//			},
+//			EnqueueItemEventFunc: func(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error) {
+//				panic("mock out the EnqueueItemEvent method")
+//			},
+//			GetActiveKeyByHashFunc: func(ctx context.Context, keyHash string) (*models.APIKey, error) {
+//				panic("mock out the GetActiveKeyByHash method")
+//			},
+//			GetKeyFunc: func(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+//				panic("mock out the GetKey method")
+//			},
+//			GetSharedWishlistFunc: func(ctx context.Context, publicSlug string) (*models.SharedWishlist, error) {
+//				panic("mock out the GetSharedWishlist method")
+//			},
+//			ListKeysFunc: func(ctx context.Context) ([]*models.APIKey, error) {
+//				panic("mock out the ListKeys method")
+//			},
+//			ListPendingDeliveriesFunc: func(ctx context.Context, limit int) ([]*models.WebhookDelivery, error) {
+//				panic("mock out the ListPendingDeliveries method")
+//			},
+//			ListSharedItemsFunc: func(ctx context.Context, wishlistID pgtype.UUID, limit int, offset int) ([]*models.SharedItem, int, error) {
+//				panic("mock out the ListSharedItems method")
+//			},
+//			ListUsageFunc: func(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error) {
+//				panic("mock out the ListUsage method")
+//			},
+//			ListWebhooksFunc: func(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error) {
+//				panic("mock out the ListWebhooks method")
+//			},
+//			MarkDeliveredFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the MarkDelivered method")
+//			},
+//			RecordDeliveryFailureFunc: func(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+//				panic("mock out the RecordDeliveryFailure method")
+//			},
+//			RecordUsageFunc: func(ctx context.Context, keyID pgtype.UUID) error {
+//				panic("mock out the RecordUsage method")
+//			},
+//			RevokeKeyFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the RevokeKey method")
+//			},
+//			SetPartnerSharedFunc: func(ctx context.Context, wishlistID pgtype.UUID, ownerID pgtype.UUID, shared bool) error {
+//				panic("mock out the SetPartnerShared method")
+//			},
+//			SetWebhookDomainsFunc: func(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error) {
+//				panic("mock out the SetWebhookDomains method")
+//			},
+//			UpsertWebhookFunc: func(ctx context.Context, webhook models.Webhook) (*models.Webhook, error) {
+//				panic("mock out the UpsertWebhook method")
+//			},
+//		}
+//
+//		// use mockedPartnerRepositoryInterface in code that requires repository.PartnerRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type PartnerRepositoryInterfaceMock struct {
+	// CreateKeyFunc mocks the CreateKey method.
+	CreateKeyFunc func(ctx context.Context, key models.APIKey) (*models.APIKey, error)
+
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func(ctx context.Context, id pgtype.UUID, keyID pgtype.UUID) error
+
+	// EnqueueItemEventFunc mocks the EnqueueItemEvent method.
+	EnqueueItemEventFunc func(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error)
+
+	// GetActiveKeyByHashFunc mocks the GetActiveKeyByHash method.
+	GetActiveKeyByHashFunc func(ctx context.Context, keyHash string) (*models.APIKey, error)
+
+	// GetKeyFunc mocks the GetKey method.
+	GetKeyFunc func(ctx context.Context, id pgtype.UUID) (*models.APIKey, error)
+
+	// GetSharedWishlistFunc mocks the GetSharedWishlist method.
+	GetSharedWishlistFunc func(ctx context.Context, publicSlug string) (*models.SharedWishlist, error)
+
+	// ListKeysFunc mocks the ListKeys method.
+	ListKeysFunc func(ctx context.Context) ([]*models.APIKey, error)
+
+	// ListPendingDeliveriesFunc mocks the ListPendingDeliveries method.
+	ListPendingDeliveriesFunc func(ctx context.Context, limit int) ([]*models.WebhookDelivery, error)
+
+	// ListSharedItemsFunc mocks the ListSharedItems method.
+	ListSharedItemsFunc func(ctx context.Context, wishlistID pgtype.UUID, limit int, offset int) ([]*models.SharedItem, int, error)
+
+	// ListUsageFunc mocks the ListUsage method.
+	ListUsageFunc func(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error)
+
+	// ListWebhooksFunc mocks the ListWebhooks method.
+	ListWebhooksFunc func(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error)
+
+	// MarkDeliveredFunc mocks the MarkDelivered method.
+	MarkDeliveredFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// RecordDeliveryFailureFunc mocks the RecordDeliveryFailure method.
+	RecordDeliveryFailureFunc func(ctx context.Context, id pgtype.UUID, maxAttempts int) error
+
+	// RecordUsageFunc mocks the RecordUsage method.
+	RecordUsageFunc func(ctx context.Context, keyID pgtype.UUID) error
+
+	// RevokeKeyFunc mocks the RevokeKey method.
+	RevokeKeyFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// SetPartnerSharedFunc mocks the SetPartnerShared method.
+	SetPartnerSharedFunc func(ctx context.Context, wishlistID pgtype.UUID, ownerID pgtype.UUID, shared bool) error
+
+	// SetWebhookDomainsFunc mocks the SetWebhookDomains method.
+	SetWebhookDomainsFunc func(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error)
+
+	// UpsertWebhookFunc mocks the UpsertWebhook method.
+	UpsertWebhookFunc func(ctx context.Context, webhook models.Webhook) (*models.Webhook, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateKey holds details about calls to the CreateKey method.
+		CreateKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key models.APIKey
+		}
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// KeyID is the keyID argument value.
+			KeyID pgtype.UUID
+		}
+		// EnqueueItemEvent holds details about calls to the EnqueueItemEvent method.
+		EnqueueItemEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+			// Event is the event argument value.
+			Event string
+		}
+		// GetActiveKeyByHash holds details about calls to the GetActiveKeyByHash method.
+		GetActiveKeyByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeyHash is the keyHash argument value.
+			KeyHash string
+		}
+		// GetKey holds details about calls to the GetKey method.
+		GetKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetSharedWishlist holds details about calls to the GetSharedWishlist method.
+		GetSharedWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+		// ListKeys holds details about calls to the ListKeys method.
+		ListKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListPendingDeliveries holds details about calls to the ListPendingDeliveries method.
+		ListPendingDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListSharedItems holds details about calls to the ListSharedItems method.
+		ListSharedItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// ListUsage holds details about calls to the ListUsage method.
+		ListUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeyID is the keyID argument value.
+			KeyID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListWebhooks holds details about calls to the ListWebhooks method.
+		ListWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeyID is the keyID argument value.
+			KeyID pgtype.UUID
+		}
+		// MarkDelivered holds details about calls to the MarkDelivered method.
+		MarkDelivered []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// RecordDeliveryFailure holds details about calls to the RecordDeliveryFailure method.
+		RecordDeliveryFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// MaxAttempts is the maxAttempts argument value.
+			MaxAttempts int
+		}
+		// RecordUsage holds details about calls to the RecordUsage method.
+		RecordUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeyID is the keyID argument value.
+			KeyID pgtype.UUID
+		}
+		// RevokeKey holds details about calls to the RevokeKey method.
+		RevokeKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// SetPartnerShared holds details about calls to the SetPartnerShared method.
+		SetPartnerShared []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Shared is the shared argument value.
+			Shared bool
+		}
+		// SetWebhookDomains holds details about calls to the SetWebhookDomains method.
+		SetWebhookDomains []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Domains is the domains argument value.
+			Domains string
+		}
+		// UpsertWebhook holds details about calls to the UpsertWebhook method.
+		UpsertWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Webhook is the webhook argument value.
+			Webhook models.Webhook
+		}
+	}
+	lockCreateKey             sync.RWMutex
+	lockDeleteWebhook         sync.RWMutex
+	lockEnqueueItemEvent      sync.RWMutex
+	lockGetActiveKeyByHash    sync.RWMutex
+	lockGetKey                sync.RWMutex
+	lockGetSharedWishlist     sync.RWMutex
+	lockListKeys              sync.RWMutex
+	lockListPendingDeliveries sync.RWMutex
+	lockListSharedItems       sync.RWMutex
+	lockListUsage             sync.RWMutex
+	lockListWebhooks          sync.RWMutex
+	lockMarkDelivered         sync.RWMutex
+	lockRecordDeliveryFailure sync.RWMutex
+	lockRecordUsage           sync.RWMutex
+	lockRevokeKey             sync.RWMutex
+	lockSetPartnerShared      sync.RWMutex
+	lockSetWebhookDomains     sync.RWMutex
+	lockUpsertWebhook         sync.RWMutex
+}
+
+// CreateKey calls CreateKeyFunc.
+func (mock *PartnerRepositoryInterfaceMock) CreateKey(ctx context.Context, key models.APIKey) (*models.APIKey, error) {
+	if mock.CreateKeyFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.CreateKeyFunc: method is nil but PartnerRepositoryInterface.CreateKey was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key models.APIKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockCreateKey.Lock()
+	mock.calls.CreateKey = append(mock.calls.CreateKey, callInfo)
+	mock.lockCreateKey.Unlock()
+	return mock.CreateKeyFunc(ctx, key)
+}
+
+// CreateKeyCalls gets all the calls that were made to CreateKey.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.CreateKeyCalls())
+func (mock *PartnerRepositoryInterfaceMock) CreateKeyCalls() []struct {
+	Ctx context.Context
+	Key models.APIKey
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key models.APIKey
+	}
+	mock.lockCreateKey.RLock()
+	calls = mock.calls.CreateKey
+	mock.lockCreateKey.RUnlock()
+	return calls
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *PartnerRepositoryInterfaceMock) DeleteWebhook(ctx context.Context, id pgtype.UUID, keyID pgtype.UUID) error {
+	if mock.DeleteWebhookFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.DeleteWebhookFunc: method is nil but PartnerRepositoryInterface.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		KeyID pgtype.UUID
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		KeyID: keyID,
+	}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc(ctx, id, keyID)
+}
+
+// DeleteWebhookCalls gets all the calls that were made to DeleteWebhook.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.DeleteWebhookCalls())
+func (mock *PartnerRepositoryInterfaceMock) DeleteWebhookCalls() []struct {
+	Ctx   context.Context
+	ID    pgtype.UUID
+	KeyID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		KeyID pgtype.UUID
+	}
+	mock.lockDeleteWebhook.RLock()
+	calls = mock.calls.DeleteWebhook
+	mock.lockDeleteWebhook.RUnlock()
+	return calls
+}
+
+// EnqueueItemEvent calls EnqueueItemEventFunc.
+func (mock *PartnerRepositoryInterfaceMock) EnqueueItemEvent(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error) {
+	if mock.EnqueueItemEventFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.EnqueueItemEventFunc: method is nil but PartnerRepositoryInterface.EnqueueItemEvent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+		Event      string
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+		Event:      event,
+	}
+	mock.lockEnqueueItemEvent.Lock()
+	mock.calls.EnqueueItemEvent = append(mock.calls.EnqueueItemEvent, callInfo)
+	mock.lockEnqueueItemEvent.Unlock()
+	return mock.EnqueueItemEventFunc(ctx, giftItemID, event)
+}
+
+// EnqueueItemEventCalls gets all the calls that were made to EnqueueItemEvent.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.EnqueueItemEventCalls())
+func (mock *PartnerRepositoryInterfaceMock) EnqueueItemEventCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+	Event      string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+		Event      string
+	}
+	mock.lockEnqueueItemEvent.RLock()
+	calls = mock.calls.EnqueueItemEvent
+	mock.lockEnqueueItemEvent.RUnlock()
+	return calls
+}
+
+// GetActiveKeyByHash calls GetActiveKeyByHashFunc.
+func (mock *PartnerRepositoryInterfaceMock) GetActiveKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	if mock.GetActiveKeyByHashFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.GetActiveKeyByHashFunc: method is nil but PartnerRepositoryInterface.GetActiveKeyByHash was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		KeyHash string
+	}{
+		Ctx:     ctx,
+		KeyHash: keyHash,
+	}
+	mock.lockGetActiveKeyByHash.Lock()
+	mock.calls.GetActiveKeyByHash = append(mock.calls.GetActiveKeyByHash, callInfo)
+	mock.lockGetActiveKeyByHash.Unlock()
+	return mock.GetActiveKeyByHashFunc(ctx, keyHash)
+}
+
+// GetActiveKeyByHashCalls gets all the calls that were made to GetActiveKeyByHash.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.GetActiveKeyByHashCalls())
+func (mock *PartnerRepositoryInterfaceMock) GetActiveKeyByHashCalls() []struct {
+	Ctx     context.Context
+	KeyHash string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		KeyHash string
+	}
+	mock.lockGetActiveKeyByHash.RLock()
+	calls = mock.calls.GetActiveKeyByHash
+	mock.lockGetActiveKeyByHash.RUnlock()
+	return calls
+}
+
+// GetKey calls GetKeyFunc.
+func (mock *PartnerRepositoryInterfaceMock) GetKey(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+	if mock.GetKeyFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.GetKeyFunc: method is nil but PartnerRepositoryInterface.GetKey was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetKey.Lock()
+	mock.calls.GetKey = append(mock.calls.GetKey, callInfo)
+	mock.lockGetKey.Unlock()
+	return mock.GetKeyFunc(ctx, id)
+}
+
+// GetKeyCalls gets all the calls that were made to GetKey.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.GetKeyCalls())
+func (mock *PartnerRepositoryInterfaceMock) GetKeyCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetKey.RLock()
+	calls = mock.calls.GetKey
+	mock.lockGetKey.RUnlock()
+	return calls
+}
+
+// GetSharedWishlist calls GetSharedWishlistFunc.
+func (mock *PartnerRepositoryInterfaceMock) GetSharedWishlist(ctx context.Context, publicSlug string) (*models.SharedWishlist, error) {
+	if mock.GetSharedWishlistFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.GetSharedWishlistFunc: method is nil but PartnerRepositoryInterface.GetSharedWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetSharedWishlist.Lock()
+	mock.calls.GetSharedWishlist = append(mock.calls.GetSharedWishlist, callInfo)
+	mock.lockGetSharedWishlist.Unlock()
+	return mock.GetSharedWishlistFunc(ctx, publicSlug)
+}
+
+// GetSharedWishlistCalls gets all the calls that were made to GetSharedWishlist.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.GetSharedWishlistCalls())
+func (mock *PartnerRepositoryInterfaceMock) GetSharedWishlistCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetSharedWishlist.RLock()
+	calls = mock.calls.GetSharedWishlist
+	mock.lockGetSharedWishlist.RUnlock()
+	return calls
+}
+
+// ListKeys calls ListKeysFunc.
+func (mock *PartnerRepositoryInterfaceMock) ListKeys(ctx context.Context) ([]*models.APIKey, error) {
+	if mock.ListKeysFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.ListKeysFunc: method is nil but PartnerRepositoryInterface.ListKeys was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListKeys.Lock()
+	mock.calls.ListKeys = append(mock.calls.ListKeys, callInfo)
+	mock.lockListKeys.Unlock()
+	return mock.ListKeysFunc(ctx)
+}
+
+// ListKeysCalls gets all the calls that were made to ListKeys.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.ListKeysCalls())
+func (mock *PartnerRepositoryInterfaceMock) ListKeysCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListKeys.RLock()
+	calls = mock.calls.ListKeys
+	mock.lockListKeys.RUnlock()
+	return calls
+}
+
+// ListPendingDeliveries calls ListPendingDeliveriesFunc.
+func (mock *PartnerRepositoryInterfaceMock) ListPendingDeliveries(ctx context.Context, limit int) ([]*models.WebhookDelivery, error) {
+	if mock.ListPendingDeliveriesFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.ListPendingDeliveriesFunc: method is nil but PartnerRepositoryInterface.ListPendingDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockListPendingDeliveries.Lock()
+	mock.calls.ListPendingDeliveries = append(mock.calls.ListPendingDeliveries, callInfo)
+	mock.lockListPendingDeliveries.Unlock()
+	return mock.ListPendingDeliveriesFunc(ctx, limit)
+}
+
+// ListPendingDeliveriesCalls gets all the calls that were made to ListPendingDeliveries.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.ListPendingDeliveriesCalls())
+func (mock *PartnerRepositoryInterfaceMock) ListPendingDeliveriesCalls() []struct {
+	Ctx   context.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+	}
+	mock.lockListPendingDeliveries.RLock()
+	calls = mock.calls.ListPendingDeliveries
+	mock.lockListPendingDeliveries.RUnlock()
+	return calls
+}
+
+// ListSharedItems calls ListSharedItemsFunc.
+func (mock *PartnerRepositoryInterfaceMock) ListSharedItems(ctx context.Context, wishlistID pgtype.UUID, limit int, offset int) ([]*models.SharedItem, int, error) {
+	if mock.ListSharedItemsFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.ListSharedItemsFunc: method is nil but PartnerRepositoryInterface.ListSharedItems was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		Limit      int
+		Offset     int
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	mock.lockListSharedItems.Lock()
+	mock.calls.ListSharedItems = append(mock.calls.ListSharedItems, callInfo)
+	mock.lockListSharedItems.Unlock()
+	return mock.ListSharedItemsFunc(ctx, wishlistID, limit, offset)
+}
+
+// ListSharedItemsCalls gets all the calls that were made to ListSharedItems.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.ListSharedItemsCalls())
+func (mock *PartnerRepositoryInterfaceMock) ListSharedItemsCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+	Limit      int
+	Offset     int
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		Limit      int
+		Offset     int
+	}
+	mock.lockListSharedItems.RLock()
+	calls = mock.calls.ListSharedItems
+	mock.lockListSharedItems.RUnlock()
+	return calls
+}
+
+// ListUsage calls ListUsageFunc.
+func (mock *PartnerRepositoryInterfaceMock) ListUsage(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error) {
+	if mock.ListUsageFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.ListUsageFunc: method is nil but PartnerRepositoryInterface.ListUsage was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+		Since: since,
+	}
+	mock.lockListUsage.Lock()
+	mock.calls.ListUsage = append(mock.calls.ListUsage, callInfo)
+	mock.lockListUsage.Unlock()
+	return mock.ListUsageFunc(ctx, keyID, since)
+}
+
+// ListUsageCalls gets all the calls that were made to ListUsage.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.ListUsageCalls())
+func (mock *PartnerRepositoryInterfaceMock) ListUsageCalls() []struct {
+	Ctx   context.Context
+	KeyID pgtype.UUID
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+		Since time.Time
+	}
+	mock.lockListUsage.RLock()
+	calls = mock.calls.ListUsage
+	mock.lockListUsage.RUnlock()
+	return calls
+}
+
+// ListWebhooks calls ListWebhooksFunc.
+func (mock *PartnerRepositoryInterfaceMock) ListWebhooks(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error) {
+	if mock.ListWebhooksFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.ListWebhooksFunc: method is nil but PartnerRepositoryInterface.ListWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+	}
+	mock.lockListWebhooks.Lock()
+	mock.calls.ListWebhooks = append(mock.calls.ListWebhooks, callInfo)
+	mock.lockListWebhooks.Unlock()
+	return mock.ListWebhooksFunc(ctx, keyID)
+}
+
+// ListWebhooksCalls gets all the calls that were made to ListWebhooks.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.ListWebhooksCalls())
+func (mock *PartnerRepositoryInterfaceMock) ListWebhooksCalls() []struct {
+	Ctx   context.Context
+	KeyID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+	}
+	mock.lockListWebhooks.RLock()
+	calls = mock.calls.ListWebhooks
+	mock.lockListWebhooks.RUnlock()
+	return calls
+}
+
+// MarkDelivered calls MarkDeliveredFunc.
+func (mock *PartnerRepositoryInterfaceMock) MarkDelivered(ctx context.Context, id pgtype.UUID) error {
+	if mock.MarkDeliveredFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.MarkDeliveredFunc: method is nil but PartnerRepositoryInterface.MarkDelivered was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkDelivered.Lock()
+	mock.calls.MarkDelivered = append(mock.calls.MarkDelivered, callInfo)
+	mock.lockMarkDelivered.Unlock()
+	return mock.MarkDeliveredFunc(ctx, id)
+}
+
+// MarkDeliveredCalls gets all the calls that were made to MarkDelivered.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.MarkDeliveredCalls())
+func (mock *PartnerRepositoryInterfaceMock) MarkDeliveredCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockMarkDelivered.RLock()
+	calls = mock.calls.MarkDelivered
+	mock.lockMarkDelivered.RUnlock()
+	return calls
+}
+
+// RecordDeliveryFailure calls RecordDeliveryFailureFunc.
+func (mock *PartnerRepositoryInterfaceMock) RecordDeliveryFailure(ctx context.Context, id pgtype.UUID, maxAttempts int) error {
+	if mock.RecordDeliveryFailureFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.RecordDeliveryFailureFunc: method is nil but PartnerRepositoryInterface.RecordDeliveryFailure was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		MaxAttempts: maxAttempts,
+	}
+	mock.lockRecordDeliveryFailure.Lock()
+	mock.calls.RecordDeliveryFailure = append(mock.calls.RecordDeliveryFailure, callInfo)
+	mock.lockRecordDeliveryFailure.Unlock()
+	return mock.RecordDeliveryFailureFunc(ctx, id, maxAttempts)
+}
+
+// RecordDeliveryFailureCalls gets all the calls that were made to RecordDeliveryFailure.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.RecordDeliveryFailureCalls())
+func (mock *PartnerRepositoryInterfaceMock) RecordDeliveryFailureCalls() []struct {
+	Ctx         context.Context
+	ID          pgtype.UUID
+	MaxAttempts int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+	}
+	mock.lockRecordDeliveryFailure.RLock()
+	calls = mock.calls.RecordDeliveryFailure
+	mock.lockRecordDeliveryFailure.RUnlock()
+	return calls
+}
+
+// RecordUsage calls RecordUsageFunc.
+func (mock *PartnerRepositoryInterfaceMock) RecordUsage(ctx context.Context, keyID pgtype.UUID) error {
+	if mock.RecordUsageFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.RecordUsageFunc: method is nil but PartnerRepositoryInterface.RecordUsage was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+	}
+	mock.lockRecordUsage.Lock()
+	mock.calls.RecordUsage = append(mock.calls.RecordUsage, callInfo)
+	mock.lockRecordUsage.Unlock()
+	return mock.RecordUsageFunc(ctx, keyID)
+}
+
+// RecordUsageCalls gets all the calls that were made to RecordUsage.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.RecordUsageCalls())
+func (mock *PartnerRepositoryInterfaceMock) RecordUsageCalls() []struct {
+	Ctx   context.Context
+	KeyID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		KeyID pgtype.UUID
+	}
+	mock.lockRecordUsage.RLock()
+	calls = mock.calls.RecordUsage
+	mock.lockRecordUsage.RUnlock()
+	return calls
+}
+
+// RevokeKey calls RevokeKeyFunc.
+func (mock *PartnerRepositoryInterfaceMock) RevokeKey(ctx context.Context, id pgtype.UUID) error {
+	if mock.RevokeKeyFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.RevokeKeyFunc: method is nil but PartnerRepositoryInterface.RevokeKey was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRevokeKey.Lock()
+	mock.calls.RevokeKey = append(mock.calls.RevokeKey, callInfo)
+	mock.lockRevokeKey.Unlock()
+	return mock.RevokeKeyFunc(ctx, id)
+}
+
+// RevokeKeyCalls gets all the calls that were made to RevokeKey.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.RevokeKeyCalls())
+func (mock *PartnerRepositoryInterfaceMock) RevokeKeyCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockRevokeKey.RLock()
+	calls = mock.calls.RevokeKey
+	mock.lockRevokeKey.RUnlock()
+	return calls
+}
+
+// SetPartnerShared calls SetPartnerSharedFunc.
+func (mock *PartnerRepositoryInterfaceMock) SetPartnerShared(ctx context.Context, wishlistID pgtype.UUID, ownerID pgtype.UUID, shared bool) error {
+	if mock.SetPartnerSharedFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.SetPartnerSharedFunc: method is nil but PartnerRepositoryInterface.SetPartnerShared was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		OwnerID    pgtype.UUID
+		Shared     bool
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+		OwnerID:    ownerID,
+		Shared:     shared,
+	}
+	mock.lockSetPartnerShared.Lock()
+	mock.calls.SetPartnerShared = append(mock.calls.SetPartnerShared, callInfo)
+	mock.lockSetPartnerShared.Unlock()
+	return mock.SetPartnerSharedFunc(ctx, wishlistID, ownerID, shared)
+}
+
+// SetPartnerSharedCalls gets all the calls that were made to SetPartnerShared.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.SetPartnerSharedCalls())
+func (mock *PartnerRepositoryInterfaceMock) SetPartnerSharedCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+	OwnerID    pgtype.UUID
+	Shared     bool
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		OwnerID    pgtype.UUID
+		Shared     bool
+	}
+	mock.lockSetPartnerShared.RLock()
+	calls = mock.calls.SetPartnerShared
+	mock.lockSetPartnerShared.RUnlock()
+	return calls
+}
+
+// SetWebhookDomains calls SetWebhookDomainsFunc.
+func (mock *PartnerRepositoryInterfaceMock) SetWebhookDomains(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error) {
+	if mock.SetWebhookDomainsFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.SetWebhookDomainsFunc: method is nil but PartnerRepositoryInterface.SetWebhookDomains was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		Domains string
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		Domains: domains,
+	}
+	mock.lockSetWebhookDomains.Lock()
+	mock.calls.SetWebhookDomains = append(mock.calls.SetWebhookDomains, callInfo)
+	mock.lockSetWebhookDomains.Unlock()
+	return mock.SetWebhookDomainsFunc(ctx, id, domains)
+}
+
+// SetWebhookDomainsCalls gets all the calls that were made to SetWebhookDomains.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.SetWebhookDomainsCalls())
+func (mock *PartnerRepositoryInterfaceMock) SetWebhookDomainsCalls() []struct {
+	Ctx     context.Context
+	ID      pgtype.UUID
+	Domains string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      pgtype.UUID
+		Domains string
+	}
+	mock.lockSetWebhookDomains.RLock()
+	calls = mock.calls.SetWebhookDomains
+	mock.lockSetWebhookDomains.RUnlock()
+	return calls
+}
+
+// UpsertWebhook calls UpsertWebhookFunc.
+func (mock *PartnerRepositoryInterfaceMock) UpsertWebhook(ctx context.Context, webhook models.Webhook) (*models.Webhook, error) {
+	if mock.UpsertWebhookFunc == nil {
+		panic("PartnerRepositoryInterfaceMock.UpsertWebhookFunc: method is nil but PartnerRepositoryInterface.UpsertWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Webhook models.Webhook
+	}{
+		Ctx:     ctx,
+		Webhook: webhook,
+	}
+	mock.lockUpsertWebhook.Lock()
+	mock.calls.UpsertWebhook = append(mock.calls.UpsertWebhook, callInfo)
+	mock.lockUpsertWebhook.Unlock()
+	return mock.UpsertWebhookFunc(ctx, webhook)
+}
+
+// UpsertWebhookCalls gets all the calls that were made to UpsertWebhook.
+// Check the length with:
+//
+//	len(mockedPartnerRepositoryInterface.UpsertWebhookCalls())
+func (mock *PartnerRepositoryInterfaceMock) UpsertWebhookCalls() []struct {
+	Ctx     context.Context
+	Webhook models.Webhook
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Webhook models.Webhook
+	}
+	mock.lockUpsertWebhook.RLock()
+	calls = mock.calls.UpsertWebhook
+	mock.lockUpsertWebhook.RUnlock()
+	return calls
+}
@@ -0,0 +1,702 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/partner/models"
+	"wish-list/internal/domain/partner/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// KeyPrefix starts every partner API key, so leaked keys are easy to recognize and scan for
+	KeyPrefix = "wlp_"
+
+	// DefaultRateLimitPerMinute applies to keys created without an explicit limit
+	DefaultRateLimitPerMinute = 60
+
+	// MaxRateLimitPerMinute bounds the limit a key can be given
+	MaxRateLimitPerMinute = 6000
+
+	// MaxUsageDays bounds the usage report period
+	MaxUsageDays = 90
+
+	// WebhookSecretPrefix starts every webhook signing secret
+	WebhookSecretPrefix = "whsec_"
+
+	// MaxWebhooksPerKey bounds the domains one partner key can watch
+	MaxWebhooksPerKey = 20
+
+	// MaxWebhookDomainsPerKey bounds the domains an admin can allow on one key
+	MaxWebhookDomainsPerKey = 20
+
+	keyBytes          = 32
+	displayPrefixSize = 12
+	defaultUsageDays  = 30
+)
+
+var (
+	ErrInvalidKeyID      = errors.New("invalid key id")
+	ErrKeyNotFound       = errors.New("partner api key not found")
+	ErrNameRequired      = errors.New("partner name is required")
+	ErrScopesRequired    = errors.New("at least one scope is required")
+	ErrInvalidScope      = errors.New("unknown scope")
+	ErrInvalidRateLimit  = errors.New("rate limit must be between 1 and 6000 requests per minute")
+	ErrInvalidPeriod     = errors.New("usage period must be between 1 and 90 days")
+	ErrInvalidKey        = errors.New("invalid partner api key")
+	ErrInsufficientScope = errors.New("partner api key lacks the required scope")
+	ErrInvalidWishlistID = errors.New("invalid wishlist id")
+	ErrWishlistNotFound  = errors.New("wishlist not found")
+	ErrInvalidDomain     = errors.New("invalid domain")
+	ErrInvalidWebhookURL = errors.New("webhook url must be an https url")
+	ErrInvalidWebhookID  = errors.New("invalid webhook id")
+	ErrWebhookNotFound   = errors.New("partner webhook not found")
+	ErrTooManyWebhooks   = errors.New("too many webhooks for this key")
+
+	ErrTooManyWebhookDomains   = errors.New("too many webhook domains for this key")
+	ErrWebhookDomainNotAllowed = errors.New("domain is not allowed for this key's webhooks")
+	ErrInvalidEvent            = errors.New("unknown item event")
+)
+
+// PartnerServiceInterface defines the interface for partner API operations
+type PartnerServiceInterface interface {
+	CreateKey(ctx context.Context, createdBy pgtype.UUID, input CreateKeyInput) (*CreatedKeyOutput, error)
+	ListKeys(ctx context.Context) ([]*KeyOutput, error)
+	RevokeKey(ctx context.Context, keyID string) error
+	SetWebhookDomains(ctx context.Context, keyID string, domains []string) (*KeyOutput, error)
+	GetUsage(ctx context.Context, keyID string, days int) (*UsageOutput, error)
+	Authenticate(ctx context.Context, rawKey, scope string) (*KeyOutput, error)
+	SetSharing(ctx context.Context, ownerID pgtype.UUID, wishlistID string, shared bool) error
+	GetWishlist(ctx context.Context, publicSlug string) (*WishlistOutput, error)
+	ListItems(ctx context.Context, publicSlug string, page, limit int) (*ItemsOutput, error)
+	RegisterWebhook(ctx context.Context, keyID pgtype.UUID, input RegisterWebhookInput) (*CreatedWebhookOutput, error)
+	ListWebhooks(ctx context.Context, keyID pgtype.UUID) ([]*WebhookOutput, error)
+	DeleteWebhook(ctx context.Context, keyID pgtype.UUID, webhookID string) error
+	PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error
+}
+
+type PartnerService struct {
+	repo        repository.PartnerRepositoryInterface
+	frontendURL string
+}
+
+// NewPartnerService creates a partner service. frontendURL is the web app's base URL,
+// used to link partners to the public wishlist page.
+func NewPartnerService(repo repository.PartnerRepositoryInterface, frontendURL string) *PartnerService {
+	return &PartnerService{
+		repo:        repo,
+		frontendURL: strings.TrimSuffix(frontendURL, "/"),
+	}
+}
+
+type CreateKeyInput struct {
+	Name               string
+	Scopes             []string
+	RateLimitPerMinute *int     // nil = DefaultRateLimitPerMinute
+	WebhookDomains     []string // Domains the partner owns; webhooks can only be registered for these
+}
+
+// KeyOutput describes a key without its secret
+type KeyOutput struct {
+	ID                 pgtype.UUID
+	Name               string
+	Prefix             string
+	Scopes             []string
+	WebhookDomains     []string
+	RateLimitPerMinute int
+	LastUsedAt         *time.Time
+	CreatedAt          time.Time
+}
+
+// CreatedKeyOutput carries the key itself, which is only available at creation
+type CreatedKeyOutput struct {
+	KeyOutput
+	Key string
+}
+
+// UsageOutput is a key's request count per day over a period
+type UsageOutput struct {
+	KeyID pgtype.UUID
+	Since time.Time
+	Total int64
+	Days  []UsageDayOutput
+}
+
+type UsageDayOutput struct {
+	Day      time.Time
+	Requests int64
+}
+
+// WishlistOutput is a wishlist as exposed to partners
+type WishlistOutput struct {
+	ID           pgtype.UUID
+	Slug         string
+	Title        string
+	Description  string
+	Occasion     string
+	OccasionDate *time.Time
+	URL          string
+	ItemCount    int64
+	UpdatedAt    time.Time
+}
+
+// ItemOutput is an item as exposed to partners
+type ItemOutput struct {
+	ID          pgtype.UUID
+	Title       string
+	Description string
+	Link        string
+	ImageURL    string
+	Price       *float64
+	Priority    int
+	Available   bool
+}
+
+type ItemsOutput struct {
+	Items []*ItemOutput
+	Total int
+	Page  int
+	Limit int
+}
+
+type RegisterWebhookInput struct {
+	Domain string // Host of the partner's product pages, e.g. "shop.com"; a URL is accepted too
+	URL    string // HTTPS endpoint events are posted to
+}
+
+// WebhookOutput describes a webhook without its secret
+type WebhookOutput struct {
+	ID        pgtype.UUID
+	Domain    string
+	URL       string
+	CreatedAt time.Time
+}
+
+// CreatedWebhookOutput carries the signing secret, which is only available at registration
+type CreatedWebhookOutput struct {
+	WebhookOutput
+	Secret string
+}
+
+// CreateKey issues a new partner key. The returned key is not stored and cannot
+// be retrieved again.
+func (s *PartnerService) CreateKey(ctx context.Context, createdBy pgtype.UUID, input CreateKeyInput) (*CreatedKeyOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookDomains, err := normalizeWebhookDomains(input.WebhookDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := DefaultRateLimitPerMinute
+	if input.RateLimitPerMinute != nil {
+		rateLimit = *input.RateLimitPerMinute
+		if rateLimit < 1 || rateLimit > MaxRateLimitPerMinute {
+			return nil, ErrInvalidRateLimit
+		}
+	}
+
+	raw, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreateKey(ctx, models.APIKey{
+		Name:               name,
+		KeyHash:            hashKey(raw),
+		KeyPrefix:          raw[:displayPrefixSize],
+		Scopes:             strings.Join(scopes, " "),
+		WebhookDomains:     strings.Join(webhookDomains, " "),
+		RateLimitPerMinute: int32(rateLimit), //nolint:gosec // Bounded above
+		CreatedBy:          createdBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create partner api key: %w", err)
+	}
+
+	return &CreatedKeyOutput{
+		KeyOutput: *toKeyOutput(created),
+		Key:       raw,
+	}, nil
+}
+
+// ListKeys returns all unrevoked keys, newest first
+func (s *PartnerService) ListKeys(ctx context.Context) ([]*KeyOutput, error) {
+	keys, err := s.repo.ListKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partner api keys: %w", err)
+	}
+
+	outputs := make([]*KeyOutput, 0, len(keys))
+	for _, key := range keys {
+		outputs = append(outputs, toKeyOutput(key))
+	}
+
+	return outputs, nil
+}
+
+// RevokeKey disables a key immediately
+func (s *PartnerService) RevokeKey(ctx context.Context, keyID string) error {
+	id, err := parseUUID(keyID, ErrInvalidKeyID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.RevokeKey(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return ErrKeyNotFound
+		}
+		return fmt.Errorf("failed to revoke partner api key: %w", err)
+	}
+
+	return nil
+}
+
+// SetWebhookDomains replaces the domains a key may register webhooks for. Admins
+// add a domain once they have verified the partner owns it. Webhooks for domains
+// that are no longer allowed are removed.
+func (s *PartnerService) SetWebhookDomains(ctx context.Context, keyID string, domains []string) (*KeyOutput, error) {
+	id, err := parseUUID(keyID, ErrInvalidKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := normalizeWebhookDomains(domains)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.repo.SetWebhookDomains(ctx, id, strings.Join(normalized, " "))
+	if err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to set partner webhook domains: %w", err)
+	}
+
+	logger.InfoContext(ctx, "partner webhook domains updated", "key_id", keyID, "domains", normalized)
+
+	return toKeyOutput(key), nil
+}
+
+// GetUsage returns the key's daily request counts over the last days (UTC), today
+// included. Zero means the default period of 30 days.
+func (s *PartnerService) GetUsage(ctx context.Context, keyID string, days int) (*UsageOutput, error) {
+	id, err := parseUUID(keyID, ErrInvalidKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if days == 0 {
+		days = defaultUsageDays
+	}
+	if days < 1 || days > MaxUsageDays {
+		return nil, ErrInvalidPeriod
+	}
+
+	if _, err := s.repo.GetKey(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get partner api key: %w", err)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, 1-days)
+
+	usage, err := s.repo.ListUsage(ctx, id, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partner api usage: %w", err)
+	}
+
+	output := &UsageOutput{
+		KeyID: id,
+		Since: since,
+		Days:  make([]UsageDayOutput, 0, len(usage)),
+	}
+	for _, day := range usage {
+		output.Total += day.Requests
+		output.Days = append(output.Days, UsageDayOutput{Day: day.Day.Time, Requests: day.Requests})
+	}
+
+	return output, nil
+}
+
+// Authenticate resolves a raw key, provided it is active and was granted scope, and
+// meters the request. A failure to meter is logged, not returned.
+func (s *PartnerService) Authenticate(ctx context.Context, rawKey, scope string) (*KeyOutput, error) {
+	if !strings.HasPrefix(rawKey, KeyPrefix) {
+		return nil, ErrInvalidKey
+	}
+
+	key, err := s.repo.GetActiveKeyByHash(ctx, hashKey(rawKey))
+	if err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return nil, ErrInvalidKey
+		}
+		return nil, fmt.Errorf("failed to get partner api key: %w", err)
+	}
+
+	if !key.HasScope(scope) {
+		return nil, ErrInsufficientScope
+	}
+
+	if err := s.repo.RecordUsage(ctx, key.ID); err != nil {
+		logger.WarnContext(ctx, "failed to record partner api usage", "key_id", key.ID.String(), "error", err)
+	}
+
+	return toKeyOutput(key), nil
+}
+
+// SetSharing opts the owner's wishlist in to or out of the partner API. Partners
+// only see wishlists that are also public.
+func (s *PartnerService) SetSharing(ctx context.Context, ownerID pgtype.UUID, wishlistID string, shared bool) error {
+	id, err := parseUUID(wishlistID, ErrInvalidWishlistID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.SetPartnerShared(ctx, id, ownerID, shared); err != nil {
+		if errors.Is(err, repository.ErrWishlistNotFound) {
+			return ErrWishlistNotFound
+		}
+		return fmt.Errorf("failed to update partner sharing: %w", err)
+	}
+
+	return nil
+}
+
+// GetWishlist returns a shared public wishlist
+func (s *PartnerService) GetWishlist(ctx context.Context, publicSlug string) (*WishlistOutput, error) {
+	wishlist, err := s.getSharedWishlist(ctx, publicSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &WishlistOutput{
+		ID:          wishlist.ID,
+		Slug:        wishlist.PublicSlug,
+		Title:       wishlist.Title,
+		Description: wishlist.Description.String,
+		Occasion:    wishlist.Occasion.String,
+		URL:         s.frontendURL + "/public/" + url.PathEscape(wishlist.PublicSlug),
+		ItemCount:   wishlist.ItemCount,
+		UpdatedAt:   wishlist.UpdatedAt.Time,
+	}
+	if wishlist.OccasionDate.Valid {
+		date := wishlist.OccasionDate.Time
+		output.OccasionDate = &date
+	}
+
+	return output, nil
+}
+
+// ListItems returns a page of a shared public wishlist's items
+func (s *PartnerService) ListItems(ctx context.Context, publicSlug string, page, limit int) (*ItemsOutput, error) {
+	wishlist, err := s.getSharedWishlist(ctx, publicSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := s.repo.ListSharedItems(ctx, wishlist.ID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared items: %w", err)
+	}
+
+	output := &ItemsOutput{
+		Items: make([]*ItemOutput, 0, len(items)),
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}
+	for _, item := range items {
+		itemOutput := &ItemOutput{
+			ID:          item.ID,
+			Title:       item.Name,
+			Description: item.Description.String,
+			Link:        item.Link.String,
+			ImageURL:    item.ImageURL.String,
+			Priority:    int(item.Priority.Int32),
+			Available:   item.Available,
+		}
+		if price, err := item.Price.Float64Value(); err == nil && price.Valid {
+			itemOutput.Price = &price.Float64
+		}
+		output.Items = append(output.Items, itemOutput)
+	}
+
+	return output, nil
+}
+
+// RegisterWebhook subscribes the key to events about items linking to a domain, its
+// subdomains included. The domain must be one an admin allowed on the key, or a
+// subdomain of one, so a partner cannot watch another shop's items. Registering a
+// domain again replaces its URL and secret. The returned secret is not retrievable
+// later.
+func (s *PartnerService) RegisterWebhook(ctx context.Context, keyID pgtype.UUID, input RegisterWebhookInput) (*CreatedWebhookOutput, error) {
+	domain, err := normalizeDomain(input.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.repo.GetKey(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get partner api key: %w", err)
+	}
+	if !key.AllowsWebhookDomain(domain) {
+		return nil, ErrWebhookDomainNotAllowed
+	}
+
+	target, err := url.Parse(strings.TrimSpace(input.URL))
+	if err != nil || target.Scheme != "https" || target.Host == "" {
+		return nil, ErrInvalidWebhookURL
+	}
+
+	webhooks, err := s.repo.ListWebhooks(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partner webhooks: %w", err)
+	}
+	replacing := slices.ContainsFunc(webhooks, func(w *models.Webhook) bool { return w.Domain == domain })
+	if !replacing && len(webhooks) >= MaxWebhooksPerKey {
+		return nil, ErrTooManyWebhooks
+	}
+
+	secret, err := generateSecret(WebhookSecretPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.UpsertWebhook(ctx, models.Webhook{
+		KeyID:  keyID,
+		Domain: domain,
+		URL:    target.String(),
+		Secret: secret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save partner webhook: %w", err)
+	}
+
+	return &CreatedWebhookOutput{
+		WebhookOutput: *toWebhookOutput(saved),
+		Secret:        secret,
+	}, nil
+}
+
+// ListWebhooks returns the key's webhooks ordered by domain
+func (s *PartnerService) ListWebhooks(ctx context.Context, keyID pgtype.UUID) ([]*WebhookOutput, error) {
+	webhooks, err := s.repo.ListWebhooks(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partner webhooks: %w", err)
+	}
+
+	outputs := make([]*WebhookOutput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		outputs = append(outputs, toWebhookOutput(webhook))
+	}
+
+	return outputs, nil
+}
+
+// DeleteWebhook unsubscribes one of the key's webhooks; queued deliveries are dropped
+func (s *PartnerService) DeleteWebhook(ctx context.Context, keyID pgtype.UUID, webhookID string) error {
+	id, err := parseUUID(webhookID, ErrInvalidWebhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteWebhook(ctx, id, keyID); err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return ErrWebhookNotFound
+		}
+		return fmt.Errorf("failed to delete partner webhook: %w", err)
+	}
+
+	return nil
+}
+
+// PublishItemEvent queues the event for the webhooks watching the domain of the
+// item's link. Items without a matching webhook or public wishlist queue nothing.
+func (s *PartnerService) PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+	if event != models.EventItemReserved && event != models.EventItemReleased && event != models.EventItemPurchased {
+		return fmt.Errorf("%w: %q", ErrInvalidEvent, event)
+	}
+
+	queued, err := s.repo.EnqueueItemEvent(ctx, giftItemID, event)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue partner webhook deliveries: %w", err)
+	}
+	if queued > 0 {
+		logger.DebugContext(ctx, "queued partner webhook deliveries", "event", event, "gift_item_id", giftItemID.String(), "count", queued)
+	}
+
+	return nil
+}
+
+func (s *PartnerService) getSharedWishlist(ctx context.Context, publicSlug string) (*models.SharedWishlist, error) {
+	wishlist, err := s.repo.GetSharedWishlist(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrWishlistNotFound) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get shared wishlist: %w", err)
+	}
+	return wishlist, nil
+}
+
+// normalizeScopes validates the requested scopes and returns them deduplicated in documented order
+func normalizeScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, ErrScopesRequired
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(models.Scopes, scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+	}
+
+	scopes := make([]string, 0, len(models.Scopes))
+	for _, scope := range models.Scopes {
+		if slices.Contains(requested, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
+
+// normalizeWebhookDomains normalizes the domains allowed on a key, dropping
+// duplicates and sorting them
+func normalizeWebhookDomains(raw []string) ([]string, error) {
+	domains := make([]string, 0, len(raw))
+	for _, value := range raw {
+		domain, err := normalizeDomain(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, value)
+		}
+		domains = append(domains, domain)
+	}
+	slices.Sort(domains)
+	domains = slices.Compact(domains)
+
+	if len(domains) > MaxWebhookDomainsPerKey {
+		return nil, ErrTooManyWebhookDomains
+	}
+
+	return domains, nil
+}
+
+// generateKey returns a new random key with the recognizable prefix
+func generateKey() (string, error) {
+	return generateSecret(KeyPrefix)
+}
+
+// generateSecret returns 256 random bits, encoded, after prefix
+func generateSecret(prefix string) (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate partner secret: %w", err)
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// normalizeDomain reduces "shop.com", "WWW.Shop.com" or "https://www.shop.com/p/1"
+// to "shop.com". Single labels and IP addresses are rejected, as are labels with
+// characters a host name cannot have.
+func normalizeDomain(raw string) (string, error) {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", ErrInvalidDomain
+	}
+
+	domain := strings.TrimPrefix(u.Hostname(), "www.")
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 || len(domain) > 253 {
+		return "", ErrInvalidDomain
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "", ErrInvalidDomain
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return "", ErrInvalidDomain
+			}
+		}
+	}
+	// The top-level label of a host name is never numeric, which rules out IPv4 addresses
+	if strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+		return "", ErrInvalidDomain
+	}
+
+	return domain, nil
+}
+
+// hashKey returns the hex SHA-256 of a key. Keys carry 256 bits of entropy, so a
+// fast unsalted hash is sufficient and allows lookup by hash.
+func hashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseUUID(value string, invalid error) (pgtype.UUID, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(value); err != nil {
+		return pgtype.UUID{}, invalid
+	}
+	return id, nil
+}
+
+func toWebhookOutput(webhook *models.Webhook) *WebhookOutput {
+	return &WebhookOutput{
+		ID:        webhook.ID,
+		Domain:    webhook.Domain,
+		URL:       webhook.URL,
+		CreatedAt: webhook.CreatedAt.Time,
+	}
+}
+
+func toKeyOutput(key *models.APIKey) *KeyOutput {
+	output := &KeyOutput{
+		ID:                 key.ID,
+		Name:               key.Name,
+		Prefix:             key.KeyPrefix,
+		Scopes:             key.ScopeList(),
+		WebhookDomains:     key.WebhookDomainList(),
+		RateLimitPerMinute: int(key.RateLimitPerMinute),
+		CreatedAt:          key.CreatedAt.Time,
+	}
+	if key.LastUsedAt.Valid {
+		lastUsedAt := key.LastUsedAt.Time
+		output.LastUsedAt = &lastUsedAt
+	}
+	return output
+}
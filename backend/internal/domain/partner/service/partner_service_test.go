@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/partner/models"
+	"wish-list/internal/domain/partner/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testAdminID    = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testKeyID      = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+	testWishlistID = pgtype.UUID{Bytes: [16]byte{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, Valid: true}
+)
+
+func keyRepo() *PartnerRepositoryInterfaceMock {
+	return &PartnerRepositoryInterfaceMock{
+		CreateKeyFunc: func(ctx context.Context, key models.APIKey) (*models.APIKey, error) {
+			key.ID = testKeyID
+			key.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+			return &key, nil
+		},
+		RecordUsageFunc: func(ctx context.Context, keyID pgtype.UUID) error {
+			return nil
+		},
+	}
+}
+
+func TestPartnerService_CreateKey(t *testing.T) {
+	t.Run("returns the key once and stores only its hash", func(t *testing.T) {
+		repo := keyRepo()
+
+		output, err := NewPartnerService(repo, "").CreateKey(context.Background(), testAdminID, CreateKeyInput{
+			Name:           " Gift Shop ",
+			Scopes:         []string{models.ScopeItemsRead, models.ScopeWishlistsRead, models.ScopeWebhooksManage},
+			WebhookDomains: []string{"www.shop.example", "gifts.example", "Shop.example"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(output.Key, KeyPrefix))
+		assert.Equal(t, "Gift Shop", output.Name)
+		assert.Equal(t, []string{models.ScopeWishlistsRead, models.ScopeItemsRead, models.ScopeWebhooksManage}, output.Scopes)
+		assert.Equal(t, []string{"gifts.example", "shop.example"}, output.WebhookDomains)
+		assert.Equal(t, DefaultRateLimitPerMinute, output.RateLimitPerMinute)
+
+		stored := repo.CreateKeyCalls()[0].Key
+		assert.Equal(t, hashKey(output.Key), stored.KeyHash)
+		assert.Equal(t, testAdminID, stored.CreatedBy)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tooHigh := MaxRateLimitPerMinute + 1
+		tests := []struct {
+			name    string
+			input   CreateKeyInput
+			wantErr error
+		}{
+			{"blank name", CreateKeyInput{Name: " ", Scopes: []string{models.ScopeItemsRead}}, ErrNameRequired},
+			{"no scopes", CreateKeyInput{Name: "Shop"}, ErrScopesRequired},
+			{"write scope", CreateKeyInput{Name: "Shop", Scopes: []string{"items:write"}}, ErrInvalidScope},
+			{"rate limit too high", CreateKeyInput{Name: "Shop", Scopes: []string{models.ScopeItemsRead}, RateLimitPerMinute: &tooHigh}, ErrInvalidRateLimit},
+			{"invalid webhook domain", CreateKeyInput{Name: "Shop", Scopes: []string{models.ScopeWebhooksManage}, WebhookDomains: []string{"localhost"}}, ErrInvalidDomain},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := keyRepo()
+				_, err := NewPartnerService(repo, "").CreateKey(context.Background(), testAdminID, tt.input)
+
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.CreateKeyCalls())
+			})
+		}
+	})
+}
+
+func TestPartnerService_Authenticate(t *testing.T) {
+	const raw = KeyPrefix + "secret"
+
+	activeRepo := func() *PartnerRepositoryInterfaceMock {
+		repo := keyRepo()
+		repo.GetActiveKeyByHashFunc = func(ctx context.Context, keyHash string) (*models.APIKey, error) {
+			if keyHash != hashKey(raw) {
+				return nil, repository.ErrKeyNotFound
+			}
+			return &models.APIKey{ID: testKeyID, Scopes: models.ScopeWishlistsRead, RateLimitPerMinute: 120}, nil
+		}
+		return repo
+	}
+
+	t.Run("valid key is metered", func(t *testing.T) {
+		repo := activeRepo()
+
+		key, err := NewPartnerService(repo, "").Authenticate(context.Background(), raw, models.ScopeWishlistsRead)
+
+		require.NoError(t, err)
+		assert.Equal(t, testKeyID, key.ID)
+		assert.Equal(t, 120, key.RateLimitPerMinute)
+		require.Len(t, repo.RecordUsageCalls(), 1)
+	})
+
+	t.Run("missing scope is not metered", func(t *testing.T) {
+		repo := activeRepo()
+
+		_, err := NewPartnerService(repo, "").Authenticate(context.Background(), raw, models.ScopeItemsRead)
+
+		assert.ErrorIs(t, err, ErrInsufficientScope)
+		assert.Empty(t, repo.RecordUsageCalls())
+	})
+
+	t.Run("unknown or revoked key", func(t *testing.T) {
+		_, err := NewPartnerService(activeRepo(), "").Authenticate(context.Background(), KeyPrefix+"other", models.ScopeWishlistsRead)
+
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("personal api tokens are not partner keys", func(t *testing.T) {
+		repo := activeRepo()
+
+		_, err := NewPartnerService(repo, "").Authenticate(context.Background(), "wlt_secret", models.ScopeWishlistsRead)
+
+		assert.ErrorIs(t, err, ErrInvalidKey)
+		assert.Empty(t, repo.GetActiveKeyByHashCalls())
+	})
+
+	t.Run("metering failure does not reject the request", func(t *testing.T) {
+		repo := activeRepo()
+		repo.RecordUsageFunc = func(ctx context.Context, keyID pgtype.UUID) error {
+			return errors.New("db down")
+		}
+
+		_, err := NewPartnerService(repo, "").Authenticate(context.Background(), raw, models.ScopeWishlistsRead)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPartnerService_GetUsage(t *testing.T) {
+	repo := &PartnerRepositoryInterfaceMock{
+		GetKeyFunc: func(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+			return &models.APIKey{ID: id}, nil
+		},
+		ListUsageFunc: func(ctx context.Context, keyID pgtype.UUID, since time.Time) ([]*models.UsageDay, error) {
+			return []*models.UsageDay{
+				{Day: pgtype.Date{Time: since, Valid: true}, Requests: 40},
+				{Day: pgtype.Date{Time: since.AddDate(0, 0, 2), Valid: true}, Requests: 2},
+			}, nil
+		},
+	}
+	svc := NewPartnerService(repo, "")
+
+	t.Run("defaults to 30 days including today", func(t *testing.T) {
+		usage, err := svc.GetUsage(context.Background(), testKeyID.String(), 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), usage.Total)
+		assert.Len(t, usage.Days, 2)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		assert.Equal(t, today.AddDate(0, 0, -29), repo.ListUsageCalls()[0].Since)
+	})
+
+	t.Run("period too long", func(t *testing.T) {
+		_, err := svc.GetUsage(context.Background(), testKeyID.String(), MaxUsageDays+1)
+
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		repo.GetKeyFunc = func(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+			return nil, repository.ErrKeyNotFound
+		}
+
+		_, err := svc.GetUsage(context.Background(), testKeyID.String(), 7)
+
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestPartnerService_ListItems(t *testing.T) {
+	repo := &PartnerRepositoryInterfaceMock{
+		GetSharedWishlistFunc: func(ctx context.Context, publicSlug string) (*models.SharedWishlist, error) {
+			if publicSlug != "anna-birthday" {
+				return nil, repository.ErrWishlistNotFound
+			}
+			return &models.SharedWishlist{ID: testWishlistID, PublicSlug: publicSlug, Title: "Anna's birthday"}, nil
+		},
+		ListSharedItemsFunc: func(ctx context.Context, wishlistID pgtype.UUID, limit, offset int) ([]*models.SharedItem, int, error) {
+			return []*models.SharedItem{{
+				Name:      "Headphones",
+				Price:     pgtype.Numeric{Int: big.NewInt(19999), Exp: -2, Valid: true},
+				Available: true,
+			}}, 11, nil
+		},
+	}
+	svc := NewPartnerService(repo, "https://app.example.com")
+
+	t.Run("pages through items", func(t *testing.T) {
+		output, err := svc.ListItems(context.Background(), "anna-birthday", 2, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 11, output.Total)
+		require.Len(t, output.Items, 1)
+		assert.Equal(t, "Headphones", output.Items[0].Title)
+		require.NotNil(t, output.Items[0].Price)
+		assert.InDelta(t, 199.99, *output.Items[0].Price, 0.001)
+		assert.Equal(t, testWishlistID, repo.ListSharedItemsCalls()[0].WishlistID)
+		assert.Equal(t, 10, repo.ListSharedItemsCalls()[0].Offset)
+	})
+
+	t.Run("wishlist not shared", func(t *testing.T) {
+		_, err := svc.ListItems(context.Background(), "private-list", 1, 10)
+
+		assert.ErrorIs(t, err, ErrWishlistNotFound)
+	})
+
+	t.Run("wishlist links to the public page", func(t *testing.T) {
+		wishlist, err := svc.GetWishlist(context.Background(), "anna-birthday")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://app.example.com/public/anna-birthday", wishlist.URL)
+	})
+}
+
+func TestPartnerService_RegisterWebhook(t *testing.T) {
+	webhookRepo := func(allowed string, existing ...*models.Webhook) *PartnerRepositoryInterfaceMock {
+		return &PartnerRepositoryInterfaceMock{
+			GetKeyFunc: func(ctx context.Context, id pgtype.UUID) (*models.APIKey, error) {
+				return &models.APIKey{ID: id, WebhookDomains: allowed}, nil
+			},
+			ListWebhooksFunc: func(ctx context.Context, keyID pgtype.UUID) ([]*models.Webhook, error) {
+				return existing, nil
+			},
+			UpsertWebhookFunc: func(ctx context.Context, webhook models.Webhook) (*models.Webhook, error) {
+				return &webhook, nil
+			},
+		}
+	}
+
+	t.Run("normalizes the domain and returns the secret", func(t *testing.T) {
+		repo := webhookRepo("shop.example")
+
+		output, err := NewPartnerService(repo, "").RegisterWebhook(context.Background(), testKeyID, RegisterWebhookInput{
+			Domain: " https://WWW.Shop.example/catalog ",
+			URL:    "https://hooks.shop.example/wishlist",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "shop.example", output.Domain)
+		assert.True(t, strings.HasPrefix(output.Secret, WebhookSecretPrefix))
+
+		stored := repo.UpsertWebhookCalls()[0].Webhook
+		assert.Equal(t, testKeyID, stored.KeyID)
+		assert.Equal(t, output.Secret, stored.Secret)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			input   RegisterWebhookInput
+			wantErr error
+		}{
+			{"bare host name", RegisterWebhookInput{Domain: "localhost", URL: "https://hooks.shop.example"}, ErrInvalidDomain},
+			{"invalid characters", RegisterWebhookInput{Domain: "shop_1.example", URL: "https://hooks.shop.example"}, ErrInvalidDomain},
+			{"domain not allowed on the key", RegisterWebhookInput{Domain: "othershop.example", URL: "https://hooks.shop.example"}, ErrWebhookDomainNotAllowed},
+			{"suffix of an allowed domain is not a subdomain", RegisterWebhookInput{Domain: "myshop.example", URL: "https://hooks.shop.example"}, ErrWebhookDomainNotAllowed},
+			{"plain http url", RegisterWebhookInput{Domain: "shop.example", URL: "http://hooks.shop.example"}, ErrInvalidWebhookURL},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := webhookRepo("shop.example")
+				_, err := NewPartnerService(repo, "").RegisterWebhook(context.Background(), testKeyID, tt.input)
+
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.UpsertWebhookCalls())
+			})
+		}
+	})
+
+	t.Run("limit applies to new domains only", func(t *testing.T) {
+		existing := make([]*models.Webhook, MaxWebhooksPerKey)
+		for i := range existing {
+			existing[i] = &models.Webhook{Domain: fmt.Sprintf("shop%d.example", i)}
+		}
+		repo := webhookRepo("new.example shop0.example", existing...)
+		svc := NewPartnerService(repo, "")
+
+		_, err := svc.RegisterWebhook(context.Background(), testKeyID, RegisterWebhookInput{Domain: "new.example", URL: "https://hooks.new.example"})
+		assert.ErrorIs(t, err, ErrTooManyWebhooks)
+
+		_, err = svc.RegisterWebhook(context.Background(), testKeyID, RegisterWebhookInput{Domain: "shop0.example", URL: "https://hooks.shop0.example/v2"})
+		require.NoError(t, err)
+		assert.Len(t, repo.UpsertWebhookCalls(), 1)
+	})
+
+	t.Run("subdomains of an allowed domain", func(t *testing.T) {
+		repo := webhookRepo("shop.example")
+
+		output, err := NewPartnerService(repo, "").RegisterWebhook(context.Background(), testKeyID, RegisterWebhookInput{
+			Domain: "eu.shop.example",
+			URL:    "https://hooks.shop.example/eu",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "eu.shop.example", output.Domain)
+	})
+}
+
+func TestPartnerService_SetWebhookDomains(t *testing.T) {
+	repo := &PartnerRepositoryInterfaceMock{
+		SetWebhookDomainsFunc: func(ctx context.Context, id pgtype.UUID, domains string) (*models.APIKey, error) {
+			if id != testKeyID {
+				return nil, repository.ErrKeyNotFound
+			}
+			return &models.APIKey{ID: id, WebhookDomains: domains}, nil
+		},
+	}
+	svc := NewPartnerService(repo, "")
+
+	t.Run("stores normalized domains", func(t *testing.T) {
+		output, err := svc.SetWebhookDomains(context.Background(), testKeyID.String(), []string{"https://www.shop.example/", "gifts.example"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gifts.example", "shop.example"}, output.WebhookDomains)
+		assert.Equal(t, "gifts.example shop.example", repo.SetWebhookDomainsCalls()[0].Domains)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := svc.SetWebhookDomains(context.Background(), testWishlistID.String(), nil)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("too many domains", func(t *testing.T) {
+		domains := make([]string, MaxWebhookDomainsPerKey+1)
+		for i := range domains {
+			domains[i] = fmt.Sprintf("shop%d.example", i)
+		}
+
+		_, err := svc.SetWebhookDomains(context.Background(), testKeyID.String(), domains)
+		assert.ErrorIs(t, err, ErrTooManyWebhookDomains)
+	})
+}
+
+func TestPartnerService_PublishItemEvent(t *testing.T) {
+	repo := &PartnerRepositoryInterfaceMock{
+		EnqueueItemEventFunc: func(ctx context.Context, giftItemID pgtype.UUID, event string) (int64, error) {
+			return 2, nil
+		},
+	}
+	svc := NewPartnerService(repo, "")
+
+	t.Run("queues deliveries", func(t *testing.T) {
+		require.NoError(t, svc.PublishItemEvent(context.Background(), models.EventItemReserved, testWishlistID))
+
+		require.Len(t, repo.EnqueueItemEventCalls(), 1)
+		assert.Equal(t, models.EventItemReserved, repo.EnqueueItemEventCalls()[0].Event)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		err := svc.PublishItemEvent(context.Background(), "item.deleted", testWishlistID)
+
+		assert.ErrorIs(t, err, ErrInvalidEvent)
+		assert.Len(t, repo.EnqueueItemEventCalls(), 1)
+	})
+}
@@ -0,0 +1,13 @@
+package dto
+
+type PrivacyRequestCreateRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+type VerifyPrivacyRequestRequest struct {
+	Token string `json:"token" validate:"required,uuid"`
+}
+
+type ReviewPrivacyRequestRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"wish-list/internal/domain/privacy/service"
+)
+
+type PrivacyRequestAcceptedResponse struct {
+	Message string `json:"message" validate:"required"`
+}
+
+type PrivacyRequestResponse struct {
+	ID              string  `json:"id" validate:"required"`
+	RequestType     string  `json:"request_type" validate:"required"`
+	Email           *string `json:"email"`
+	Status          string  `json:"status" validate:"required"`
+	ExpiresAt       string  `json:"expires_at" validate:"required"`
+	VerifiedAt      *string `json:"verified_at"`
+	ReviewedAt      *string `json:"reviewed_at"`
+	ReviewNote      *string `json:"review_note"`
+	AffectedRecords *int32  `json:"affected_records"`
+	CompletedAt     *string `json:"completed_at"`
+	CreatedAt       string  `json:"created_at" validate:"required"`
+}
+
+type PrivacyRequestsListResponse struct {
+	Data       []PrivacyRequestResponse `json:"data" validate:"required"`
+	Pagination map[string]any           `json:"pagination" validate:"required"`
+}
+
+func FromPrivacyRequestOutput(r *service.PrivacyRequestOutput) PrivacyRequestResponse {
+	resp := PrivacyRequestResponse{
+		ID:          r.ID.String(),
+		RequestType: r.RequestType,
+		Email:       r.Email,
+		Status:      r.Status,
+		ExpiresAt:   r.ExpiresAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:   r.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if r.VerifiedAt.Valid {
+		verifiedAt := r.VerifiedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.VerifiedAt = &verifiedAt
+	}
+
+	if r.ReviewedAt.Valid {
+		reviewedAt := r.ReviewedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.ReviewedAt = &reviewedAt
+	}
+
+	if r.ReviewNote.Valid {
+		note := r.ReviewNote.String
+		resp.ReviewNote = &note
+	}
+
+	if r.AffectedRecords.Valid {
+		affected := r.AffectedRecords.Int32
+		resp.AffectedRecords = &affected
+	}
+
+	if r.CompletedAt.Valid {
+		completedAt := r.CompletedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.CompletedAt = &completedAt
+	}
+
+	return resp
+}
+
+func FromPrivacyRequestOutputs(outputs []*service.PrivacyRequestOutput) []PrivacyRequestResponse {
+	responses := make([]PrivacyRequestResponse, len(outputs))
+	for i, output := range outputs {
+		responses[i] = FromPrivacyRequestOutput(output)
+	}
+	return responses
+}
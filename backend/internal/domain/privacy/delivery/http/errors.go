@@ -0,0 +1,28 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/privacy/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapPrivacyServiceError converts privacy service errors to AppErrors
+func mapPrivacyServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidRequestType):
+		return apperrors.BadRequest("Invalid privacy request type")
+	case errors.Is(err, service.ErrInvalidRequestStatus):
+		return apperrors.BadRequest("Invalid privacy request status")
+	case errors.Is(err, service.ErrInvalidRequestID):
+		return apperrors.BadRequest("Invalid privacy request ID")
+	case errors.Is(err, service.ErrInvalidVerificationToken):
+		return apperrors.BadRequest("Invalid or expired verification token")
+	case errors.Is(err, service.ErrPrivacyRequestNotFound):
+		return apperrors.NotFound("Privacy request not found")
+	case errors.Is(err, service.ErrPrivacyRequestNotReviewable):
+		return apperrors.Conflict("Privacy request is not pending review")
+	default:
+		return apperrors.Internal("Failed to process privacy request").Wrap(err)
+	}
+}
@@ -0,0 +1,229 @@
+package http
+
+import (
+	"math"
+	nethttp "net/http"
+
+	"wish-list/internal/domain/privacy/delivery/http/dto"
+	"wish-list/internal/domain/privacy/models"
+	"wish-list/internal/domain/privacy/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// privacyRequestAcceptedMessage is returned regardless of whether any data exists for the email
+const privacyRequestAcceptedMessage = "If this email has guest reservations, a verification email has been sent"
+
+// Handler handles HTTP requests for guest privacy requests
+type Handler struct {
+	service service.PrivacyServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.PrivacyServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateErasureRequest godoc
+//
+//	@Summary		Request erasure of guest data
+//	@Description	Request anonymization of all guest reservations made with an email. A verification email is sent; after verification the request is queued for admin review.
+//	@Tags			Privacy
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.PrivacyRequestCreateRequest		true	"Guest email"
+//	@Param			X-Captcha-Token	header		string								false	"CAPTCHA response token (required when CAPTCHA is enabled)"
+//	@Success		202				{object}	dto.PrivacyRequestAcceptedResponse	"Request accepted"
+//	@Failure		400				{object}	map[string]string					"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string					"CAPTCHA verification failed"
+//	@Failure		500				{object}	map[string]string					"Internal server error"
+//	@Router			/public/privacy/erasure-request [post]
+func (h *Handler) CreateErasureRequest(c echo.Context) error {
+	return h.createRequest(c, models.RequestTypeErasure)
+}
+
+// CreateExportRequest godoc
+//
+//	@Summary		Request export of guest data
+//	@Description	Request an export of all guest reservations made with an email. A verification email is sent; after verification the request is queued for admin review.
+//	@Tags			Privacy
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.PrivacyRequestCreateRequest		true	"Guest email"
+//	@Param			X-Captcha-Token	header		string								false	"CAPTCHA response token (required when CAPTCHA is enabled)"
+//	@Success		202				{object}	dto.PrivacyRequestAcceptedResponse	"Request accepted"
+//	@Failure		400				{object}	map[string]string					"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string					"CAPTCHA verification failed"
+//	@Failure		500				{object}	map[string]string					"Internal server error"
+//	@Router			/public/privacy/export-request [post]
+func (h *Handler) CreateExportRequest(c echo.Context) error {
+	return h.createRequest(c, models.RequestTypeExport)
+}
+
+func (h *Handler) createRequest(c echo.Context, requestType string) error {
+	var req dto.PrivacyRequestCreateRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.SubmitRequest(c.Request().Context(), requestType, req.Email); err != nil {
+		return mapPrivacyServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusAccepted, dto.PrivacyRequestAcceptedResponse{
+		Message: privacyRequestAcceptedMessage,
+	})
+}
+
+// VerifyRequest godoc
+//
+//	@Summary		Verify a guest privacy request
+//	@Description	Confirm email ownership with the emailed verification token. The request is then queued for admin review.
+//	@Tags			Privacy
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.VerifyPrivacyRequestRequest		true	"Verification token"
+//	@Success		202		{object}	dto.PrivacyRequestAcceptedResponse	"Request verified and queued for review"
+//	@Failure		400		{object}	map[string]string					"Invalid or expired verification token"
+//	@Failure		500		{object}	map[string]string					"Internal server error"
+//	@Router			/public/privacy/verify [post]
+func (h *Handler) VerifyRequest(c echo.Context) error {
+	var req dto.VerifyPrivacyRequestRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if _, err := h.service.VerifyRequest(c.Request().Context(), req.Token); err != nil {
+		return mapPrivacyServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusAccepted, dto.PrivacyRequestAcceptedResponse{
+		Message: "Your request has been verified and will be processed shortly",
+	})
+}
+
+// ListRequests godoc
+//
+//	@Summary		List guest privacy requests
+//	@Description	Admin review queue of guest privacy requests, oldest first.
+//	@Tags			Privacy
+//	@Produce		json
+//	@Param			status	query		string							false	"Request status (default pending_review)"	Enums(pending_verification, pending_review, completed, rejected)
+//	@Param			page	query		int								false	"Page number (default 1)"
+//	@Param			limit	query		int								false	"Items per page (default 10, max 100)"
+//	@Success		200		{object}	dto.PrivacyRequestsListResponse	"List of privacy requests"
+//	@Failure		400		{object}	map[string]string				"Invalid status"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		403		{object}	map[string]string				"Insufficient permissions"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/privacy-requests [get]
+func (h *Handler) ListRequests(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+
+	requests, totalCount, err := h.service.ListRequests(c.Request().Context(), c.QueryParam("status"), pagination.Limit, pagination.Offset)
+	if err != nil {
+		return mapPrivacyServiceError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(pagination.Limit)))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PrivacyRequestsListResponse{
+		Data: dto.FromPrivacyRequestOutputs(requests),
+		Pagination: map[string]any{
+			"page":       pagination.Page,
+			"limit":      pagination.Limit,
+			"total":      totalCount,
+			"totalPages": totalPages,
+		},
+	})
+}
+
+// ApproveRequest godoc
+//
+//	@Summary		Approve a guest privacy request
+//	@Description	Execute a verified request: anonymize (erasure) or email an export of all guest reservations for the requester email.
+//	@Tags			Privacy
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Privacy request ID"
+//	@Param			request	body		dto.ReviewPrivacyRequestRequest	false	"Optional review note"
+//	@Success		200		{object}	dto.PrivacyRequestResponse		"Request completed"
+//	@Failure		400		{object}	map[string]string				"Invalid request"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		403		{object}	map[string]string				"Insufficient permissions"
+//	@Failure		404		{object}	map[string]string				"Privacy request not found"
+//	@Failure		409		{object}	map[string]string				"Privacy request is not pending review"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/privacy-requests/{id}/approve [post]
+func (h *Handler) ApproveRequest(c echo.Context) error {
+	input, err := h.bindReviewInput(c)
+	if err != nil {
+		return err
+	}
+
+	request, err := h.service.ApproveRequest(c.Request().Context(), input)
+	if err != nil {
+		return mapPrivacyServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPrivacyRequestOutput(request))
+}
+
+// RejectRequest godoc
+//
+//	@Summary		Reject a guest privacy request
+//	@Description	Close a verified request without modifying any reservations.
+//	@Tags			Privacy
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Privacy request ID"
+//	@Param			request	body		dto.ReviewPrivacyRequestRequest	false	"Optional review note"
+//	@Success		200		{object}	dto.PrivacyRequestResponse		"Request rejected"
+//	@Failure		400		{object}	map[string]string				"Invalid request"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		403		{object}	map[string]string				"Insufficient permissions"
+//	@Failure		404		{object}	map[string]string				"Privacy request not found"
+//	@Failure		409		{object}	map[string]string				"Privacy request is not pending review"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/privacy-requests/{id}/reject [post]
+func (h *Handler) RejectRequest(c echo.Context) error {
+	input, err := h.bindReviewInput(c)
+	if err != nil {
+		return err
+	}
+
+	request, err := h.service.RejectRequest(c.Request().Context(), input)
+	if err != nil {
+		return mapPrivacyServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPrivacyRequestOutput(request))
+}
+
+func (h *Handler) bindReviewInput(c echo.Context) (service.ReviewRequestInput, error) {
+	var req dto.ReviewPrivacyRequestRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return service.ReviewRequestInput{}, err
+	}
+
+	reviewerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return service.ReviewRequestInput{}, err
+	}
+
+	return service.ReviewRequestInput{
+		RequestID:  c.Param("id"),
+		ReviewerID: reviewerID,
+		Note:       req.Note,
+	}, nil
+}
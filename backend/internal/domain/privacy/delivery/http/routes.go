@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/pkg/auth"
+)
+
+// RegisterRoutes registers all privacy HTTP routes
+func RegisterRoutes(
+	e *echo.Echo,
+	h *Handler,
+	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
+) {
+	// Public guest privacy routes — ownership is proven by the emailed verification token.
+	public := e.Group("/api/public/privacy")
+	public.POST("/erasure-request", h.CreateErasureRequest, captchaMiddleware)
+	public.POST("/export-request", h.CreateExportRequest, captchaMiddleware)
+	public.POST("/verify", h.VerifyRequest)
+
+	// Admin review queue
+	admin := e.Group("/api/admin/privacy-requests", authMiddleware, auth.RequireUserType("admin"))
+	admin.GET("", h.ListRequests)
+	admin.POST("/:id/approve", h.ApproveRequest)
+	admin.POST("/:id/reject", h.RejectRequest)
+}
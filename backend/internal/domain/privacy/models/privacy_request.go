@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Privacy request types
+const (
+	RequestTypeErasure = "erasure"
+	RequestTypeExport  = "export"
+)
+
+// Privacy request statuses
+const (
+	StatusPendingVerification = "pending_verification"
+	StatusPendingReview       = "pending_review"
+	StatusCompleted           = "completed"
+	StatusRejected            = "rejected"
+)
+
+type PrivacyRequest struct {
+	ID                pgtype.UUID        `db:"id"`
+	RequestType       string             `db:"request_type"`
+	Email             pgtype.Text        `db:"email"`
+	EncryptedEmail    pgtype.Text        `db:"encrypted_email"` // PII encrypted
+	VerificationToken pgtype.UUID        `db:"verification_token"`
+	Status            string             `db:"status"`
+	ExpiresAt         pgtype.Timestamptz `db:"expires_at"`
+	VerifiedAt        pgtype.Timestamptz `db:"verified_at"`
+	ReviewedByUserID  pgtype.UUID        `db:"reviewed_by_user_id"`
+	ReviewedAt        pgtype.Timestamptz `db:"reviewed_at"`
+	ReviewNote        pgtype.Text        `db:"review_note"`
+	AffectedRecords   pgtype.Int4        `db:"affected_records"`
+	CompletedAt       pgtype.Timestamptz `db:"completed_at"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at"`
+}
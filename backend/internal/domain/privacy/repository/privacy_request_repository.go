@@ -0,0 +1,261 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_privacy_request_repository_test.go -pkg service . PrivacyRequestRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/privacy/models"
+	"wish-list/internal/pkg/encryption"
+)
+
+// Sentinel errors for privacy request repository
+var (
+	ErrPrivacyRequestNotFound = errors.New("privacy request not found")
+)
+
+const privacyRequestColumns = `
+	id, request_type, email, encrypted_email, verification_token, status, expires_at,
+	verified_at, reviewed_by_user_id, reviewed_at, review_note, affected_records,
+	completed_at, created_at, updated_at
+`
+
+// PrivacyRequestRepositoryInterface defines the interface for privacy request database operations
+type PrivacyRequestRepositoryInterface interface {
+	Create(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error)
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error)
+	MarkVerified(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error)
+	ListByStatus(ctx context.Context, status string, limit, offset int) ([]*models.PrivacyRequest, error)
+	CountByStatus(ctx context.Context, status string) (int, error)
+	Complete(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error)
+	Reject(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error)
+}
+
+type PrivacyRequestRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewPrivacyRequestRepository(db *database.DB) PrivacyRequestRepositoryInterface {
+	return &PrivacyRequestRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewPrivacyRequestRepositoryWithEncryption creates a new PrivacyRequestRepository with encryption enabled
+func NewPrivacyRequestRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) PrivacyRequestRepositoryInterface {
+	return &PrivacyRequestRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// encryptPrivacyRequestPII moves the requester email into the encrypted column
+func (r *PrivacyRequestRepository) encryptPrivacyRequestPII(ctx context.Context, request *models.PrivacyRequest) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if request.Email.Valid {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, request.Email.String)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt email: %w", err)
+		}
+		request.EncryptedEmail = pgtype.Text{String: encrypted, Valid: true}
+		request.Email = pgtype.Text{Valid: false}
+	}
+
+	return nil
+}
+
+// decryptPrivacyRequestPII restores the requester email from the encrypted column
+func (r *PrivacyRequestRepository) decryptPrivacyRequestPII(ctx context.Context, request *models.PrivacyRequest) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if request.EncryptedEmail.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, request.EncryptedEmail.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt email: %w", err)
+		}
+		request.Email = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	return nil
+}
+
+// Create inserts a new privacy request awaiting email verification
+func (r *PrivacyRequestRepository) Create(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error) {
+	if err := r.encryptPrivacyRequestPII(ctx, &request); err != nil {
+		return nil, fmt.Errorf("failed to encrypt privacy request PII: %w", err)
+	}
+
+	query := `
+		INSERT INTO privacy_requests (request_type, email, encrypted_email, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + privacyRequestColumns
+
+	var created models.PrivacyRequest
+	err := r.db.QueryRowxContext(ctx, query,
+		request.RequestType,
+		request.Email,
+		request.EncryptedEmail,
+		request.Status,
+		request.ExpiresAt,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create privacy request: %w", err)
+	}
+
+	if err := r.decryptPrivacyRequestPII(ctx, &created); err != nil {
+		return nil, fmt.Errorf("failed to decrypt privacy request PII: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a privacy request by ID
+func (r *PrivacyRequestRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+	query := `SELECT ` + privacyRequestColumns + ` FROM privacy_requests WHERE id = $1`
+
+	var request models.PrivacyRequest
+	if err := r.db.GetContext(ctx, &request, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPrivacyRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get privacy request: %w", err)
+	}
+
+	if err := r.decryptPrivacyRequestPII(ctx, &request); err != nil {
+		return nil, fmt.Errorf("failed to decrypt privacy request PII: %w", err)
+	}
+
+	return &request, nil
+}
+
+// MarkVerified moves an unexpired request awaiting verification into the admin review queue.
+// Returns ErrPrivacyRequestNotFound if the token is unknown, expired or already used.
+func (r *PrivacyRequestRepository) MarkVerified(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error) {
+	query := `
+		UPDATE privacy_requests SET
+			status = 'pending_review',
+			verified_at = NOW(),
+			updated_at = NOW()
+		WHERE verification_token = $1
+		  AND status = 'pending_verification'
+		  AND expires_at > NOW()
+		RETURNING ` + privacyRequestColumns
+
+	var request models.PrivacyRequest
+	if err := r.db.QueryRowxContext(ctx, query, token).StructScan(&request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPrivacyRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to verify privacy request: %w", err)
+	}
+
+	if err := r.decryptPrivacyRequestPII(ctx, &request); err != nil {
+		return nil, fmt.Errorf("failed to decrypt privacy request PII: %w", err)
+	}
+
+	return &request, nil
+}
+
+// ListByStatus returns privacy requests with the given status, oldest first
+func (r *PrivacyRequestRepository) ListByStatus(ctx context.Context, status string, limit, offset int) ([]*models.PrivacyRequest, error) {
+	query := `
+		SELECT ` + privacyRequestColumns + `
+		FROM privacy_requests
+		WHERE status = $1
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	var requests []*models.PrivacyRequest
+	if err := r.db.SelectContext(ctx, &requests, query, status, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list privacy requests: %w", err)
+	}
+
+	for _, request := range requests {
+		if err := r.decryptPrivacyRequestPII(ctx, request); err != nil {
+			return nil, fmt.Errorf("failed to decrypt privacy request PII: %w", err)
+		}
+	}
+
+	return requests, nil
+}
+
+// CountByStatus returns the number of privacy requests with the given status
+func (r *PrivacyRequestRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	query := `SELECT COUNT(*) FROM privacy_requests WHERE status = $1`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, status); err != nil {
+		return 0, fmt.Errorf("failed to count privacy requests: %w", err)
+	}
+
+	return count, nil
+}
+
+// Complete closes a request under review as completed.
+// The requester email is cleared once the request is closed; it is no longer needed.
+func (r *PrivacyRequestRepository) Complete(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error) {
+	query := `
+		UPDATE privacy_requests SET
+			status = 'completed',
+			reviewed_by_user_id = $2,
+			reviewed_at = NOW(),
+			review_note = $3,
+			affected_records = $4,
+			completed_at = NOW(),
+			email = NULL,
+			encrypted_email = NULL,
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending_review'
+		RETURNING ` + privacyRequestColumns
+
+	var request models.PrivacyRequest
+	if err := r.db.QueryRowxContext(ctx, query, id, reviewerID, note, affectedRecords).StructScan(&request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPrivacyRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to complete privacy request: %w", err)
+	}
+
+	return &request, nil
+}
+
+// Reject closes a request under review as rejected and clears the requester email
+func (r *PrivacyRequestRepository) Reject(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error) {
+	query := `
+		UPDATE privacy_requests SET
+			status = 'rejected',
+			reviewed_by_user_id = $2,
+			reviewed_at = NOW(),
+			review_note = $3,
+			email = NULL,
+			encrypted_email = NULL,
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending_review'
+		RETURNING ` + privacyRequestColumns
+
+	var request models.PrivacyRequest
+	if err := r.db.QueryRowxContext(ctx, query, id, reviewerID, note).StructScan(&request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPrivacyRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to reject privacy request: %w", err)
+	}
+
+	return &request, nil
+}
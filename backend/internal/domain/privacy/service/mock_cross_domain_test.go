@@ -0,0 +1,713 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"sync"
+	contactmodels "wish-list/internal/domain/contact/models"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	rsvpmodels "wish-list/internal/domain/rsvp/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+)
+
+// Ensure, that GuestReservationRepositoryInterfaceMock does implement GuestReservationRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestReservationRepositoryInterface = &GuestReservationRepositoryInterfaceMock{}
+
+// GuestReservationRepositoryInterfaceMock is a mock implementation of GuestReservationRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestReservationRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestReservationRepositoryInterface
+//		mockedGuestReservationRepositoryInterface := &GuestReservationRepositoryInterfaceMock{
+//			AnonymizeGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the AnonymizeGuestReservationsByEmail method")
+//			},
+//			ListGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*reservationmodels.Reservation, error) {
+//				panic("mock out the ListGuestReservationsByEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestReservationRepositoryInterface in code that requires GuestReservationRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestReservationRepositoryInterfaceMock struct {
+	// AnonymizeGuestReservationsByEmailFunc mocks the AnonymizeGuestReservationsByEmail method.
+	AnonymizeGuestReservationsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListGuestReservationsByEmailFunc mocks the ListGuestReservationsByEmail method.
+	ListGuestReservationsByEmailFunc func(ctx context.Context, guestEmail string) ([]*reservationmodels.Reservation, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AnonymizeGuestReservationsByEmail holds details about calls to the AnonymizeGuestReservationsByEmail method.
+		AnonymizeGuestReservationsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListGuestReservationsByEmail holds details about calls to the ListGuestReservationsByEmail method.
+		ListGuestReservationsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+	}
+	lockAnonymizeGuestReservationsByEmail sync.RWMutex
+	lockListGuestReservationsByEmail      sync.RWMutex
+}
+
+// AnonymizeGuestReservationsByEmail calls AnonymizeGuestReservationsByEmailFunc.
+func (mock *GuestReservationRepositoryInterfaceMock) AnonymizeGuestReservationsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.AnonymizeGuestReservationsByEmailFunc == nil {
+		panic("GuestReservationRepositoryInterfaceMock.AnonymizeGuestReservationsByEmailFunc: method is nil but GuestReservationRepositoryInterface.AnonymizeGuestReservationsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockAnonymizeGuestReservationsByEmail.Lock()
+	mock.calls.AnonymizeGuestReservationsByEmail = append(mock.calls.AnonymizeGuestReservationsByEmail, callInfo)
+	mock.lockAnonymizeGuestReservationsByEmail.Unlock()
+	return mock.AnonymizeGuestReservationsByEmailFunc(ctx, guestEmail)
+}
+
+// AnonymizeGuestReservationsByEmailCalls gets all the calls that were made to AnonymizeGuestReservationsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestReservationRepositoryInterface.AnonymizeGuestReservationsByEmailCalls())
+func (mock *GuestReservationRepositoryInterfaceMock) AnonymizeGuestReservationsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockAnonymizeGuestReservationsByEmail.RLock()
+	calls = mock.calls.AnonymizeGuestReservationsByEmail
+	mock.lockAnonymizeGuestReservationsByEmail.RUnlock()
+	return calls
+}
+
+// ListGuestReservationsByEmail calls ListGuestReservationsByEmailFunc.
+func (mock *GuestReservationRepositoryInterfaceMock) ListGuestReservationsByEmail(ctx context.Context, guestEmail string) ([]*reservationmodels.Reservation, error) {
+	if mock.ListGuestReservationsByEmailFunc == nil {
+		panic("GuestReservationRepositoryInterfaceMock.ListGuestReservationsByEmailFunc: method is nil but GuestReservationRepositoryInterface.ListGuestReservationsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestReservationsByEmail.Lock()
+	mock.calls.ListGuestReservationsByEmail = append(mock.calls.ListGuestReservationsByEmail, callInfo)
+	mock.lockListGuestReservationsByEmail.Unlock()
+	return mock.ListGuestReservationsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestReservationsByEmailCalls gets all the calls that were made to ListGuestReservationsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestReservationRepositoryInterface.ListGuestReservationsByEmailCalls())
+func (mock *GuestReservationRepositoryInterfaceMock) ListGuestReservationsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestReservationsByEmail.RLock()
+	calls = mock.calls.ListGuestReservationsByEmail
+	mock.lockListGuestReservationsByEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestGiftHistoryInterfaceMock does implement GuestGiftHistoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestGiftHistoryInterface = &GuestGiftHistoryInterfaceMock{}
+
+// GuestGiftHistoryInterfaceMock is a mock implementation of GuestGiftHistoryInterface.
+//
+//	func TestSomethingThatUsesGuestGiftHistoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestGiftHistoryInterface
+//		mockedGuestGiftHistoryInterface := &GuestGiftHistoryInterfaceMock{
+//			AnonymizeGuestGiverByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the AnonymizeGuestGiverByEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestGiftHistoryInterface in code that requires GuestGiftHistoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestGiftHistoryInterfaceMock struct {
+	// AnonymizeGuestGiverByEmailFunc mocks the AnonymizeGuestGiverByEmail method.
+	AnonymizeGuestGiverByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AnonymizeGuestGiverByEmail holds details about calls to the AnonymizeGuestGiverByEmail method.
+		AnonymizeGuestGiverByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+	}
+	lockAnonymizeGuestGiverByEmail sync.RWMutex
+}
+
+// AnonymizeGuestGiverByEmail calls AnonymizeGuestGiverByEmailFunc.
+func (mock *GuestGiftHistoryInterfaceMock) AnonymizeGuestGiverByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.AnonymizeGuestGiverByEmailFunc == nil {
+		panic("GuestGiftHistoryInterfaceMock.AnonymizeGuestGiverByEmailFunc: method is nil but GuestGiftHistoryInterface.AnonymizeGuestGiverByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockAnonymizeGuestGiverByEmail.Lock()
+	mock.calls.AnonymizeGuestGiverByEmail = append(mock.calls.AnonymizeGuestGiverByEmail, callInfo)
+	mock.lockAnonymizeGuestGiverByEmail.Unlock()
+	return mock.AnonymizeGuestGiverByEmailFunc(ctx, guestEmail)
+}
+
+// AnonymizeGuestGiverByEmailCalls gets all the calls that were made to AnonymizeGuestGiverByEmail.
+// Check the length with:
+//
+//	len(mockedGuestGiftHistoryInterface.AnonymizeGuestGiverByEmailCalls())
+func (mock *GuestGiftHistoryInterfaceMock) AnonymizeGuestGiverByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockAnonymizeGuestGiverByEmail.RLock()
+	calls = mock.calls.AnonymizeGuestGiverByEmail
+	mock.lockAnonymizeGuestGiverByEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestRSVPRepositoryInterfaceMock does implement GuestRSVPRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestRSVPRepositoryInterface = &GuestRSVPRepositoryInterfaceMock{}
+
+// GuestRSVPRepositoryInterfaceMock is a mock implementation of GuestRSVPRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestRSVPRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestRSVPRepositoryInterface
+//		mockedGuestRSVPRepositoryInterface := &GuestRSVPRepositoryInterfaceMock{
+//			DeleteGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestRSVPsByEmail method")
+//			},
+//			ListGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*rsvpmodels.RSVP, error) {
+//				panic("mock out the ListGuestRSVPsByEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestRSVPRepositoryInterface in code that requires GuestRSVPRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestRSVPRepositoryInterfaceMock struct {
+	// DeleteGuestRSVPsByEmailFunc mocks the DeleteGuestRSVPsByEmail method.
+	DeleteGuestRSVPsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListGuestRSVPsByEmailFunc mocks the ListGuestRSVPsByEmail method.
+	ListGuestRSVPsByEmailFunc func(ctx context.Context, guestEmail string) ([]*rsvpmodels.RSVP, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteGuestRSVPsByEmail holds details about calls to the DeleteGuestRSVPsByEmail method.
+		DeleteGuestRSVPsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListGuestRSVPsByEmail holds details about calls to the ListGuestRSVPsByEmail method.
+		ListGuestRSVPsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+	}
+	lockDeleteGuestRSVPsByEmail sync.RWMutex
+	lockListGuestRSVPsByEmail   sync.RWMutex
+}
+
+// DeleteGuestRSVPsByEmail calls DeleteGuestRSVPsByEmailFunc.
+func (mock *GuestRSVPRepositoryInterfaceMock) DeleteGuestRSVPsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestRSVPsByEmailFunc == nil {
+		panic("GuestRSVPRepositoryInterfaceMock.DeleteGuestRSVPsByEmailFunc: method is nil but GuestRSVPRepositoryInterface.DeleteGuestRSVPsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestRSVPsByEmail.Lock()
+	mock.calls.DeleteGuestRSVPsByEmail = append(mock.calls.DeleteGuestRSVPsByEmail, callInfo)
+	mock.lockDeleteGuestRSVPsByEmail.Unlock()
+	return mock.DeleteGuestRSVPsByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestRSVPsByEmailCalls gets all the calls that were made to DeleteGuestRSVPsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestRSVPRepositoryInterface.DeleteGuestRSVPsByEmailCalls())
+func (mock *GuestRSVPRepositoryInterfaceMock) DeleteGuestRSVPsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestRSVPsByEmail.RLock()
+	calls = mock.calls.DeleteGuestRSVPsByEmail
+	mock.lockDeleteGuestRSVPsByEmail.RUnlock()
+	return calls
+}
+
+// ListGuestRSVPsByEmail calls ListGuestRSVPsByEmailFunc.
+func (mock *GuestRSVPRepositoryInterfaceMock) ListGuestRSVPsByEmail(ctx context.Context, guestEmail string) ([]*rsvpmodels.RSVP, error) {
+	if mock.ListGuestRSVPsByEmailFunc == nil {
+		panic("GuestRSVPRepositoryInterfaceMock.ListGuestRSVPsByEmailFunc: method is nil but GuestRSVPRepositoryInterface.ListGuestRSVPsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestRSVPsByEmail.Lock()
+	mock.calls.ListGuestRSVPsByEmail = append(mock.calls.ListGuestRSVPsByEmail, callInfo)
+	mock.lockListGuestRSVPsByEmail.Unlock()
+	return mock.ListGuestRSVPsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestRSVPsByEmailCalls gets all the calls that were made to ListGuestRSVPsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestRSVPRepositoryInterface.ListGuestRSVPsByEmailCalls())
+func (mock *GuestRSVPRepositoryInterfaceMock) ListGuestRSVPsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestRSVPsByEmail.RLock()
+	calls = mock.calls.ListGuestRSVPsByEmail
+	mock.lockListGuestRSVPsByEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestInviteRepositoryInterfaceMock does implement GuestInviteRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestInviteRepositoryInterface = &GuestInviteRepositoryInterfaceMock{}
+
+// GuestInviteRepositoryInterfaceMock is a mock implementation of GuestInviteRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestInviteRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestInviteRepositoryInterface
+//		mockedGuestInviteRepositoryInterface := &GuestInviteRepositoryInterfaceMock{
+//			DeleteGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestInvitesByEmail method")
+//			},
+//			ListGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) ([]*wishlistmodels.WishListInvite, error) {
+//				panic("mock out the ListGuestInvitesByEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestInviteRepositoryInterface in code that requires GuestInviteRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestInviteRepositoryInterfaceMock struct {
+	// DeleteGuestInvitesByEmailFunc mocks the DeleteGuestInvitesByEmail method.
+	DeleteGuestInvitesByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListGuestInvitesByEmailFunc mocks the ListGuestInvitesByEmail method.
+	ListGuestInvitesByEmailFunc func(ctx context.Context, guestEmail string) ([]*wishlistmodels.WishListInvite, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteGuestInvitesByEmail holds details about calls to the DeleteGuestInvitesByEmail method.
+		DeleteGuestInvitesByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListGuestInvitesByEmail holds details about calls to the ListGuestInvitesByEmail method.
+		ListGuestInvitesByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+	}
+	lockDeleteGuestInvitesByEmail sync.RWMutex
+	lockListGuestInvitesByEmail   sync.RWMutex
+}
+
+// DeleteGuestInvitesByEmail calls DeleteGuestInvitesByEmailFunc.
+func (mock *GuestInviteRepositoryInterfaceMock) DeleteGuestInvitesByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestInvitesByEmailFunc == nil {
+		panic("GuestInviteRepositoryInterfaceMock.DeleteGuestInvitesByEmailFunc: method is nil but GuestInviteRepositoryInterface.DeleteGuestInvitesByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestInvitesByEmail.Lock()
+	mock.calls.DeleteGuestInvitesByEmail = append(mock.calls.DeleteGuestInvitesByEmail, callInfo)
+	mock.lockDeleteGuestInvitesByEmail.Unlock()
+	return mock.DeleteGuestInvitesByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestInvitesByEmailCalls gets all the calls that were made to DeleteGuestInvitesByEmail.
+// Check the length with:
+//
+//	len(mockedGuestInviteRepositoryInterface.DeleteGuestInvitesByEmailCalls())
+func (mock *GuestInviteRepositoryInterfaceMock) DeleteGuestInvitesByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestInvitesByEmail.RLock()
+	calls = mock.calls.DeleteGuestInvitesByEmail
+	mock.lockDeleteGuestInvitesByEmail.RUnlock()
+	return calls
+}
+
+// ListGuestInvitesByEmail calls ListGuestInvitesByEmailFunc.
+func (mock *GuestInviteRepositoryInterfaceMock) ListGuestInvitesByEmail(ctx context.Context, guestEmail string) ([]*wishlistmodels.WishListInvite, error) {
+	if mock.ListGuestInvitesByEmailFunc == nil {
+		panic("GuestInviteRepositoryInterfaceMock.ListGuestInvitesByEmailFunc: method is nil but GuestInviteRepositoryInterface.ListGuestInvitesByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestInvitesByEmail.Lock()
+	mock.calls.ListGuestInvitesByEmail = append(mock.calls.ListGuestInvitesByEmail, callInfo)
+	mock.lockListGuestInvitesByEmail.Unlock()
+	return mock.ListGuestInvitesByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestInvitesByEmailCalls gets all the calls that were made to ListGuestInvitesByEmail.
+// Check the length with:
+//
+//	len(mockedGuestInviteRepositoryInterface.ListGuestInvitesByEmailCalls())
+func (mock *GuestInviteRepositoryInterfaceMock) ListGuestInvitesByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestInvitesByEmail.RLock()
+	calls = mock.calls.ListGuestInvitesByEmail
+	mock.lockListGuestInvitesByEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestContactRepositoryInterfaceMock does implement GuestContactRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestContactRepositoryInterface = &GuestContactRepositoryInterfaceMock{}
+
+// GuestContactRepositoryInterfaceMock is a mock implementation of GuestContactRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestContactRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestContactRepositoryInterface
+//		mockedGuestContactRepositoryInterface := &GuestContactRepositoryInterfaceMock{
+//			DeleteGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestContactsByEmail method")
+//			},
+//			ListGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*contactmodels.Contact, error) {
+//				panic("mock out the ListGuestContactsByEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestContactRepositoryInterface in code that requires GuestContactRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestContactRepositoryInterfaceMock struct {
+	// DeleteGuestContactsByEmailFunc mocks the DeleteGuestContactsByEmail method.
+	DeleteGuestContactsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListGuestContactsByEmailFunc mocks the ListGuestContactsByEmail method.
+	ListGuestContactsByEmailFunc func(ctx context.Context, guestEmail string) ([]*contactmodels.Contact, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteGuestContactsByEmail holds details about calls to the DeleteGuestContactsByEmail method.
+		DeleteGuestContactsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListGuestContactsByEmail holds details about calls to the ListGuestContactsByEmail method.
+		ListGuestContactsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+	}
+	lockDeleteGuestContactsByEmail sync.RWMutex
+	lockListGuestContactsByEmail   sync.RWMutex
+}
+
+// DeleteGuestContactsByEmail calls DeleteGuestContactsByEmailFunc.
+func (mock *GuestContactRepositoryInterfaceMock) DeleteGuestContactsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestContactsByEmailFunc == nil {
+		panic("GuestContactRepositoryInterfaceMock.DeleteGuestContactsByEmailFunc: method is nil but GuestContactRepositoryInterface.DeleteGuestContactsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestContactsByEmail.Lock()
+	mock.calls.DeleteGuestContactsByEmail = append(mock.calls.DeleteGuestContactsByEmail, callInfo)
+	mock.lockDeleteGuestContactsByEmail.Unlock()
+	return mock.DeleteGuestContactsByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestContactsByEmailCalls gets all the calls that were made to DeleteGuestContactsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestContactRepositoryInterface.DeleteGuestContactsByEmailCalls())
+func (mock *GuestContactRepositoryInterfaceMock) DeleteGuestContactsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestContactsByEmail.RLock()
+	calls = mock.calls.DeleteGuestContactsByEmail
+	mock.lockDeleteGuestContactsByEmail.RUnlock()
+	return calls
+}
+
+// ListGuestContactsByEmail calls ListGuestContactsByEmailFunc.
+func (mock *GuestContactRepositoryInterfaceMock) ListGuestContactsByEmail(ctx context.Context, guestEmail string) ([]*contactmodels.Contact, error) {
+	if mock.ListGuestContactsByEmailFunc == nil {
+		panic("GuestContactRepositoryInterfaceMock.ListGuestContactsByEmailFunc: method is nil but GuestContactRepositoryInterface.ListGuestContactsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestContactsByEmail.Lock()
+	mock.calls.ListGuestContactsByEmail = append(mock.calls.ListGuestContactsByEmail, callInfo)
+	mock.lockListGuestContactsByEmail.Unlock()
+	return mock.ListGuestContactsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestContactsByEmailCalls gets all the calls that were made to ListGuestContactsByEmail.
+// Check the length with:
+//
+//	len(mockedGuestContactRepositoryInterface.ListGuestContactsByEmailCalls())
+func (mock *GuestContactRepositoryInterfaceMock) ListGuestContactsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestContactsByEmail.RLock()
+	calls = mock.calls.ListGuestContactsByEmail
+	mock.lockListGuestContactsByEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that PrivacyEmailSenderInterfaceMock does implement PrivacyEmailSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ PrivacyEmailSenderInterface = &PrivacyEmailSenderInterfaceMock{}
+
+// PrivacyEmailSenderInterfaceMock is a mock implementation of PrivacyEmailSenderInterface.
+//
+//	func TestSomethingThatUsesPrivacyEmailSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked PrivacyEmailSenderInterface
+//		mockedPrivacyEmailSenderInterface := &PrivacyEmailSenderInterfaceMock{
+//			SendGuestDataExportEmailFunc: func(ctx context.Context, recipientEmail string, exportJSON []byte) error {
+//				panic("mock out the SendGuestDataExportEmail method")
+//			},
+//			SendPrivacyVerificationEmailFunc: func(ctx context.Context, recipientEmail string, requestType string, verificationToken string) error {
+//				panic("mock out the SendPrivacyVerificationEmail method")
+//			},
+//		}
+//
+//		// use mockedPrivacyEmailSenderInterface in code that requires PrivacyEmailSenderInterface
+//		// and then make assertions.
+//
+//	}
+type PrivacyEmailSenderInterfaceMock struct {
+	// SendGuestDataExportEmailFunc mocks the SendGuestDataExportEmail method.
+	SendGuestDataExportEmailFunc func(ctx context.Context, recipientEmail string, exportJSON []byte) error
+
+	// SendPrivacyVerificationEmailFunc mocks the SendPrivacyVerificationEmail method.
+	SendPrivacyVerificationEmailFunc func(ctx context.Context, recipientEmail string, requestType string, verificationToken string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendGuestDataExportEmail holds details about calls to the SendGuestDataExportEmail method.
+		SendGuestDataExportEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// ExportJSON is the exportJSON argument value.
+			ExportJSON []byte
+		}
+		// SendPrivacyVerificationEmail holds details about calls to the SendPrivacyVerificationEmail method.
+		SendPrivacyVerificationEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// RequestType is the requestType argument value.
+			RequestType string
+			// VerificationToken is the verificationToken argument value.
+			VerificationToken string
+		}
+	}
+	lockSendGuestDataExportEmail     sync.RWMutex
+	lockSendPrivacyVerificationEmail sync.RWMutex
+}
+
+// SendGuestDataExportEmail calls SendGuestDataExportEmailFunc.
+func (mock *PrivacyEmailSenderInterfaceMock) SendGuestDataExportEmail(ctx context.Context, recipientEmail string, exportJSON []byte) error {
+	if mock.SendGuestDataExportEmailFunc == nil {
+		panic("PrivacyEmailSenderInterfaceMock.SendGuestDataExportEmailFunc: method is nil but PrivacyEmailSenderInterface.SendGuestDataExportEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		ExportJSON     []byte
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		ExportJSON:     exportJSON,
+	}
+	mock.lockSendGuestDataExportEmail.Lock()
+	mock.calls.SendGuestDataExportEmail = append(mock.calls.SendGuestDataExportEmail, callInfo)
+	mock.lockSendGuestDataExportEmail.Unlock()
+	return mock.SendGuestDataExportEmailFunc(ctx, recipientEmail, exportJSON)
+}
+
+// SendGuestDataExportEmailCalls gets all the calls that were made to SendGuestDataExportEmail.
+// Check the length with:
+//
+//	len(mockedPrivacyEmailSenderInterface.SendGuestDataExportEmailCalls())
+func (mock *PrivacyEmailSenderInterfaceMock) SendGuestDataExportEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	ExportJSON     []byte
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		ExportJSON     []byte
+	}
+	mock.lockSendGuestDataExportEmail.RLock()
+	calls = mock.calls.SendGuestDataExportEmail
+	mock.lockSendGuestDataExportEmail.RUnlock()
+	return calls
+}
+
+// SendPrivacyVerificationEmail calls SendPrivacyVerificationEmailFunc.
+func (mock *PrivacyEmailSenderInterfaceMock) SendPrivacyVerificationEmail(ctx context.Context, recipientEmail string, requestType string, verificationToken string) error {
+	if mock.SendPrivacyVerificationEmailFunc == nil {
+		panic("PrivacyEmailSenderInterfaceMock.SendPrivacyVerificationEmailFunc: method is nil but PrivacyEmailSenderInterface.SendPrivacyVerificationEmail was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		RecipientEmail    string
+		RequestType       string
+		VerificationToken string
+	}{
+		Ctx:               ctx,
+		RecipientEmail:    recipientEmail,
+		RequestType:       requestType,
+		VerificationToken: verificationToken,
+	}
+	mock.lockSendPrivacyVerificationEmail.Lock()
+	mock.calls.SendPrivacyVerificationEmail = append(mock.calls.SendPrivacyVerificationEmail, callInfo)
+	mock.lockSendPrivacyVerificationEmail.Unlock()
+	return mock.SendPrivacyVerificationEmailFunc(ctx, recipientEmail, requestType, verificationToken)
+}
+
+// SendPrivacyVerificationEmailCalls gets all the calls that were made to SendPrivacyVerificationEmail.
+// Check the length with:
+//
+//	len(mockedPrivacyEmailSenderInterface.SendPrivacyVerificationEmailCalls())
+func (mock *PrivacyEmailSenderInterfaceMock) SendPrivacyVerificationEmailCalls() []struct {
+	Ctx               context.Context
+	RecipientEmail    string
+	RequestType       string
+	VerificationToken string
+} {
+	var calls []struct {
+		Ctx               context.Context
+		RecipientEmail    string
+		RequestType       string
+		VerificationToken string
+	}
+	mock.lockSendPrivacyVerificationEmail.RLock()
+	calls = mock.calls.SendPrivacyVerificationEmail
+	mock.lockSendPrivacyVerificationEmail.RUnlock()
+	return calls
+}
@@ -0,0 +1,426 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/privacy/models"
+	"wish-list/internal/domain/privacy/repository"
+)
+
+// Ensure, that PrivacyRequestRepositoryInterfaceMock does implement repository.PrivacyRequestRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.PrivacyRequestRepositoryInterface = &PrivacyRequestRepositoryInterfaceMock{}
+
+// PrivacyRequestRepositoryInterfaceMock is a mock implementation of repository.PrivacyRequestRepositoryInterface.
+//
+//	func TestSomethingThatUsesPrivacyRequestRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.PrivacyRequestRepositoryInterface
+//		mockedPrivacyRequestRepositoryInterface := &PrivacyRequestRepositoryInterfaceMock{
+//			CompleteFunc: func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error) {
+//				panic("mock out the Complete method")
+//			},
+//			CountByStatusFunc: func(ctx context.Context, status string) (int, error) {
+//				panic("mock out the CountByStatus method")
+//			},
+//			CreateFunc: func(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error) {
+//				panic("mock out the Create method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByStatusFunc: func(ctx context.Context, status string, limit int, offset int) ([]*models.PrivacyRequest, error) {
+//				panic("mock out the ListByStatus method")
+//			},
+//			MarkVerifiedFunc: func(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error) {
+//				panic("mock out the MarkVerified method")
+//			},
+//			RejectFunc: func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error) {
+//				panic("mock out the Reject method")
+//			},
+//		}
+//
+//		// use mockedPrivacyRequestRepositoryInterface in code that requires repository.PrivacyRequestRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type PrivacyRequestRepositoryInterfaceMock struct {
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error)
+
+	// CountByStatusFunc mocks the CountByStatus method.
+	CountByStatusFunc func(ctx context.Context, status string) (int, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error)
+
+	// ListByStatusFunc mocks the ListByStatus method.
+	ListByStatusFunc func(ctx context.Context, status string, limit int, offset int) ([]*models.PrivacyRequest, error)
+
+	// MarkVerifiedFunc mocks the MarkVerified method.
+	MarkVerifiedFunc func(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error)
+
+	// RejectFunc mocks the Reject method.
+	RejectFunc func(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// ReviewerID is the reviewerID argument value.
+			ReviewerID pgtype.UUID
+			// Note is the note argument value.
+			Note pgtype.Text
+			// AffectedRecords is the affectedRecords argument value.
+			AffectedRecords int
+		}
+		// CountByStatus holds details about calls to the CountByStatus method.
+		CountByStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request models.PrivacyRequest
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// ListByStatus holds details about calls to the ListByStatus method.
+		ListByStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status string
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// MarkVerified holds details about calls to the MarkVerified method.
+		MarkVerified []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token pgtype.UUID
+		}
+		// Reject holds details about calls to the Reject method.
+		Reject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// ReviewerID is the reviewerID argument value.
+			ReviewerID pgtype.UUID
+			// Note is the note argument value.
+			Note pgtype.Text
+		}
+	}
+	lockComplete      sync.RWMutex
+	lockCountByStatus sync.RWMutex
+	lockCreate        sync.RWMutex
+	lockGetByID       sync.RWMutex
+	lockListByStatus  sync.RWMutex
+	lockMarkVerified  sync.RWMutex
+	lockReject        sync.RWMutex
+}
+
+// Complete calls CompleteFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) Complete(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error) {
+	if mock.CompleteFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.CompleteFunc: method is nil but PrivacyRequestRepositoryInterface.Complete was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		ID              pgtype.UUID
+		ReviewerID      pgtype.UUID
+		Note            pgtype.Text
+		AffectedRecords int
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		ReviewerID:      reviewerID,
+		Note:            note,
+		AffectedRecords: affectedRecords,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(ctx, id, reviewerID, note, affectedRecords)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.CompleteCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) CompleteCalls() []struct {
+	Ctx             context.Context
+	ID              pgtype.UUID
+	ReviewerID      pgtype.UUID
+	Note            pgtype.Text
+	AffectedRecords int
+} {
+	var calls []struct {
+		Ctx             context.Context
+		ID              pgtype.UUID
+		ReviewerID      pgtype.UUID
+		Note            pgtype.Text
+		AffectedRecords int
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
+}
+
+// CountByStatus calls CountByStatusFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) CountByStatus(ctx context.Context, status string) (int, error) {
+	if mock.CountByStatusFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.CountByStatusFunc: method is nil but PrivacyRequestRepositoryInterface.CountByStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status string
+	}{
+		Ctx:    ctx,
+		Status: status,
+	}
+	mock.lockCountByStatus.Lock()
+	mock.calls.CountByStatus = append(mock.calls.CountByStatus, callInfo)
+	mock.lockCountByStatus.Unlock()
+	return mock.CountByStatusFunc(ctx, status)
+}
+
+// CountByStatusCalls gets all the calls that were made to CountByStatus.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.CountByStatusCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) CountByStatusCalls() []struct {
+	Ctx    context.Context
+	Status string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status string
+	}
+	mock.lockCountByStatus.RLock()
+	calls = mock.calls.CountByStatus
+	mock.lockCountByStatus.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) Create(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error) {
+	if mock.CreateFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.CreateFunc: method is nil but PrivacyRequestRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request models.PrivacyRequest
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, request)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.CreateCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx     context.Context
+	Request models.PrivacyRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request models.PrivacyRequest
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+	if mock.GetByIDFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.GetByIDFunc: method is nil but PrivacyRequestRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.GetByIDCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByStatus calls ListByStatusFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) ListByStatus(ctx context.Context, status string, limit int, offset int) ([]*models.PrivacyRequest, error) {
+	if mock.ListByStatusFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.ListByStatusFunc: method is nil but PrivacyRequestRepositoryInterface.ListByStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status string
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockListByStatus.Lock()
+	mock.calls.ListByStatus = append(mock.calls.ListByStatus, callInfo)
+	mock.lockListByStatus.Unlock()
+	return mock.ListByStatusFunc(ctx, status, limit, offset)
+}
+
+// ListByStatusCalls gets all the calls that were made to ListByStatus.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.ListByStatusCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) ListByStatusCalls() []struct {
+	Ctx    context.Context
+	Status string
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status string
+		Limit  int
+		Offset int
+	}
+	mock.lockListByStatus.RLock()
+	calls = mock.calls.ListByStatus
+	mock.lockListByStatus.RUnlock()
+	return calls
+}
+
+// MarkVerified calls MarkVerifiedFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) MarkVerified(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error) {
+	if mock.MarkVerifiedFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.MarkVerifiedFunc: method is nil but PrivacyRequestRepositoryInterface.MarkVerified was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockMarkVerified.Lock()
+	mock.calls.MarkVerified = append(mock.calls.MarkVerified, callInfo)
+	mock.lockMarkVerified.Unlock()
+	return mock.MarkVerifiedFunc(ctx, token)
+}
+
+// MarkVerifiedCalls gets all the calls that were made to MarkVerified.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.MarkVerifiedCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) MarkVerifiedCalls() []struct {
+	Ctx   context.Context
+	Token pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}
+	mock.lockMarkVerified.RLock()
+	calls = mock.calls.MarkVerified
+	mock.lockMarkVerified.RUnlock()
+	return calls
+}
+
+// Reject calls RejectFunc.
+func (mock *PrivacyRequestRepositoryInterfaceMock) Reject(ctx context.Context, id pgtype.UUID, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error) {
+	if mock.RejectFunc == nil {
+		panic("PrivacyRequestRepositoryInterfaceMock.RejectFunc: method is nil but PrivacyRequestRepositoryInterface.Reject was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		ReviewerID pgtype.UUID
+		Note       pgtype.Text
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		ReviewerID: reviewerID,
+		Note:       note,
+	}
+	mock.lockReject.Lock()
+	mock.calls.Reject = append(mock.calls.Reject, callInfo)
+	mock.lockReject.Unlock()
+	return mock.RejectFunc(ctx, id, reviewerID, note)
+}
+
+// RejectCalls gets all the calls that were made to Reject.
+// Check the length with:
+//
+//	len(mockedPrivacyRequestRepositoryInterface.RejectCalls())
+func (mock *PrivacyRequestRepositoryInterfaceMock) RejectCalls() []struct {
+	Ctx        context.Context
+	ID         pgtype.UUID
+	ReviewerID pgtype.UUID
+	Note       pgtype.Text
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		ReviewerID pgtype.UUID
+		Note       pgtype.Text
+	}
+	mock.lockReject.RLock()
+	calls = mock.calls.Reject
+	mock.lockReject.RUnlock()
+	return calls
+}
@@ -0,0 +1,502 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GuestReservationRepositoryInterface GuestGiftHistoryInterface GuestRSVPRepositoryInterface GuestInviteRepositoryInterface GuestContactRepositoryInterface PrivacyEmailSenderInterface
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	contactmodels "wish-list/internal/domain/contact/models"
+	"wish-list/internal/domain/privacy/models"
+	"wish-list/internal/domain/privacy/repository"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	rsvpmodels "wish-list/internal/domain/rsvp/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// VerificationTTL is how long a privacy request can wait for email verification
+const VerificationTTL = 24 * time.Hour
+
+// Cross-domain interfaces - only methods actually used by PrivacyService
+
+// GuestReservationRepositoryInterface defines reservation repository methods used by privacy service
+type GuestReservationRepositoryInterface interface {
+	ListGuestReservationsByEmail(ctx context.Context, guestEmail string) ([]*reservationmodels.Reservation, error)
+	AnonymizeGuestReservationsByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+// GuestGiftHistoryInterface defines gift history repository methods used by privacy service
+type GuestGiftHistoryInterface interface {
+	AnonymizeGuestGiverByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+// GuestRSVPRepositoryInterface defines RSVP repository methods used by privacy service
+type GuestRSVPRepositoryInterface interface {
+	ListGuestRSVPsByEmail(ctx context.Context, guestEmail string) ([]*rsvpmodels.RSVP, error)
+	DeleteGuestRSVPsByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+// GuestInviteRepositoryInterface defines wishlist invite repository methods used by privacy service
+type GuestInviteRepositoryInterface interface {
+	ListGuestInvitesByEmail(ctx context.Context, guestEmail string) ([]*wishlistmodels.WishListInvite, error)
+	DeleteGuestInvitesByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+// GuestContactRepositoryInterface defines contact repository methods used by privacy service
+type GuestContactRepositoryInterface interface {
+	ListGuestContactsByEmail(ctx context.Context, guestEmail string) ([]*contactmodels.Contact, error)
+	DeleteGuestContactsByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+// PrivacyEmailSenderInterface defines email methods used by privacy service
+type PrivacyEmailSenderInterface interface {
+	SendPrivacyVerificationEmail(ctx context.Context, recipientEmail, requestType, verificationToken string) error
+	SendGuestDataExportEmail(ctx context.Context, recipientEmail string, exportJSON []byte) error
+}
+
+var (
+	ErrInvalidRequestType          = errors.New("invalid privacy request type")
+	ErrInvalidRequestStatus        = errors.New("invalid privacy request status")
+	ErrInvalidRequestID            = errors.New("invalid privacy request id")
+	ErrInvalidVerificationToken    = errors.New("invalid or expired verification token")
+	ErrPrivacyRequestNotFound      = errors.New("privacy request not found")
+	ErrPrivacyRequestNotReviewable = errors.New("privacy request is not pending review")
+)
+
+// PrivacyServiceInterface defines the interface for guest privacy request operations
+type PrivacyServiceInterface interface {
+	SubmitRequest(ctx context.Context, requestType, email string) error
+	VerifyRequest(ctx context.Context, token string) (*PrivacyRequestOutput, error)
+	ListRequests(ctx context.Context, status string, limit, offset int) ([]*PrivacyRequestOutput, int, error)
+	ApproveRequest(ctx context.Context, input ReviewRequestInput) (*PrivacyRequestOutput, error)
+	RejectRequest(ctx context.Context, input ReviewRequestInput) (*PrivacyRequestOutput, error)
+}
+
+type PrivacyService struct {
+	repo            repository.PrivacyRequestRepositoryInterface
+	reservationRepo GuestReservationRepositoryInterface
+	giftHistoryRepo GuestGiftHistoryInterface
+	rsvpRepo        GuestRSVPRepositoryInterface
+	inviteRepo      GuestInviteRepositoryInterface
+	contactRepo     GuestContactRepositoryInterface
+	emailSender     PrivacyEmailSenderInterface
+}
+
+func NewPrivacyService(
+	repo repository.PrivacyRequestRepositoryInterface,
+	reservationRepo GuestReservationRepositoryInterface,
+	giftHistoryRepo GuestGiftHistoryInterface,
+	rsvpRepo GuestRSVPRepositoryInterface,
+	inviteRepo GuestInviteRepositoryInterface,
+	contactRepo GuestContactRepositoryInterface,
+	emailSender PrivacyEmailSenderInterface,
+) *PrivacyService {
+	return &PrivacyService{
+		repo:            repo,
+		reservationRepo: reservationRepo,
+		giftHistoryRepo: giftHistoryRepo,
+		rsvpRepo:        rsvpRepo,
+		inviteRepo:      inviteRepo,
+		contactRepo:     contactRepo,
+		emailSender:     emailSender,
+	}
+}
+
+type ReviewRequestInput struct {
+	RequestID  string
+	ReviewerID pgtype.UUID
+	Note       *string
+}
+
+type PrivacyRequestOutput struct {
+	ID              pgtype.UUID
+	RequestType     string
+	Email           *string
+	Status          string
+	ExpiresAt       pgtype.Timestamptz
+	VerifiedAt      pgtype.Timestamptz
+	ReviewedAt      pgtype.Timestamptz
+	ReviewNote      pgtype.Text
+	AffectedRecords pgtype.Int4
+	CompletedAt     pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+}
+
+// GuestReservationExport is the per-reservation record included in a guest data export
+type GuestReservationExport struct {
+	ReservationID string     `json:"reservation_id"`
+	WishlistID    string     `json:"wishlist_id"`
+	GiftItemID    string     `json:"gift_item_id"`
+	GuestName     *string    `json:"guest_name"`
+	GuestEmail    *string    `json:"guest_email"`
+	Message       *string    `json:"message,omitempty"`
+	Status        string     `json:"status"`
+	ReservedAt    time.Time  `json:"reserved_at"`
+	CanceledAt    *time.Time `json:"canceled_at,omitempty"`
+	CancelReason  *string    `json:"cancel_reason,omitempty"`
+}
+
+// GuestRSVPExport is the per-answer record included in a guest data export
+type GuestRSVPExport struct {
+	RSVPID     string    `json:"rsvp_id"`
+	WishlistID string    `json:"wishlist_id"`
+	Name       string    `json:"name"`
+	Email      *string   `json:"email"`
+	Attending  bool      `json:"attending"`
+	Note       *string   `json:"note,omitempty"`
+	AnsweredAt time.Time `json:"answered_at"`
+}
+
+// GuestInviteExport is the per-invite record included in a guest data export
+type GuestInviteExport struct {
+	WishlistID string     `json:"wishlist_id"`
+	Email      string     `json:"email"`
+	SentAt     *time.Time `json:"sent_at,omitempty"`
+	VisitedAt  *time.Time `json:"visited_at,omitempty"`
+	InvitedAt  time.Time  `json:"invited_at"`
+}
+
+// GuestContactExport is a record of an owner saving the guest as a contact,
+// included in a guest data export. The owner's groups are left out.
+type GuestContactExport struct {
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// SubmitRequest records an erasure or export request and emails a verification token to the address.
+// It never reveals whether any reservations exist for the email.
+func (s *PrivacyService) SubmitRequest(ctx context.Context, requestType, email string) error {
+	if requestType != models.RequestTypeErasure && requestType != models.RequestTypeExport {
+		return ErrInvalidRequestType
+	}
+
+	normalizedEmail := strings.ToLower(strings.TrimSpace(email))
+
+	request, err := s.repo.Create(ctx, models.PrivacyRequest{
+		RequestType: requestType,
+		Email:       pgtype.Text{String: normalizedEmail, Valid: true},
+		Status:      models.StatusPendingVerification,
+		ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(VerificationTTL), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create privacy request: %w", err)
+	}
+
+	if err := s.emailSender.SendPrivacyVerificationEmail(ctx, normalizedEmail, requestType, request.VerificationToken.String()); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	logger.InfoContext(ctx, "privacy request submitted",
+		"request_id", request.ID.String(),
+		"request_type", requestType)
+
+	return nil
+}
+
+// VerifyRequest confirms email ownership and queues the request for admin review
+func (s *PrivacyService) VerifyRequest(ctx context.Context, token string) (*PrivacyRequestOutput, error) {
+	verificationToken := pgtype.UUID{}
+	if err := verificationToken.Scan(token); err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	request, err := s.repo.MarkVerified(ctx, verificationToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrPrivacyRequestNotFound) {
+			return nil, ErrInvalidVerificationToken
+		}
+		return nil, fmt.Errorf("failed to verify privacy request: %w", err)
+	}
+
+	return toPrivacyRequestOutput(request), nil
+}
+
+// ListRequests returns the admin review queue for the given status (default: pending review)
+func (s *PrivacyService) ListRequests(ctx context.Context, status string, limit, offset int) ([]*PrivacyRequestOutput, int, error) {
+	if status == "" {
+		status = models.StatusPendingReview
+	}
+
+	switch status {
+	case models.StatusPendingVerification, models.StatusPendingReview, models.StatusCompleted, models.StatusRejected:
+	default:
+		return nil, 0, ErrInvalidRequestStatus
+	}
+
+	total, err := s.repo.CountByStatus(ctx, status)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count privacy requests: %w", err)
+	}
+
+	requests, err := s.repo.ListByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list privacy requests: %w", err)
+	}
+
+	outputs := make([]*PrivacyRequestOutput, len(requests))
+	for i, request := range requests {
+		outputs[i] = toPrivacyRequestOutput(request)
+	}
+
+	return outputs, total, nil
+}
+
+// ApproveRequest executes a verified request: anonymizes or exports all guest reservations,
+// RSVPs, invites and saved contacts for the requester email, including gifts kept from
+// deleted wishlists on erasure, then closes the request as completed.
+func (s *PrivacyService) ApproveRequest(ctx context.Context, input ReviewRequestInput) (*PrivacyRequestOutput, error) {
+	request, err := s.getReviewableRequest(ctx, input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	email := request.Email.String
+
+	var affected int
+	switch request.RequestType {
+	case models.RequestTypeErasure:
+		affected, err = s.reservationRepo.AnonymizeGuestReservationsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize guest reservations: %w", err)
+		}
+
+		// Gifts snapshotted from deleted wishlists keep the guest's name and message
+		gifts, err := s.giftHistoryRepo.AnonymizeGuestGiverByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize guest gift history: %w", err)
+		}
+		affected += gifts
+
+		// An RSVP holds nothing but the guest's answer, so it is removed
+		rsvps, err := s.rsvpRepo.DeleteGuestRSVPsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete guest rsvps: %w", err)
+		}
+		affected += rsvps
+
+		// Invites and address book entries only exist to reach the guest by email
+		invites, err := s.inviteRepo.DeleteGuestInvitesByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete guest invites: %w", err)
+		}
+		affected += invites
+
+		contacts, err := s.contactRepo.DeleteGuestContactsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete guest contacts: %w", err)
+		}
+		affected += contacts
+
+	case models.RequestTypeExport:
+		reservations, err := s.reservationRepo.ListGuestReservationsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list guest reservations: %w", err)
+		}
+
+		rsvps, err := s.rsvpRepo.ListGuestRSVPsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list guest rsvps: %w", err)
+		}
+
+		invites, err := s.inviteRepo.ListGuestInvitesByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list guest invites: %w", err)
+		}
+
+		contacts, err := s.contactRepo.ListGuestContactsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list guest contacts: %w", err)
+		}
+
+		exportJSON, err := buildGuestDataExport(reservations, rsvps, invites, contacts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build guest data export: %w", err)
+		}
+
+		if err := s.emailSender.SendGuestDataExportEmail(ctx, email, exportJSON); err != nil {
+			return nil, fmt.Errorf("failed to send guest data export: %w", err)
+		}
+		affected = len(reservations) + len(rsvps) + len(invites) + len(contacts)
+
+	default:
+		return nil, ErrInvalidRequestType
+	}
+
+	completed, err := s.repo.Complete(ctx, request.ID, input.ReviewerID, noteToText(input.Note), affected)
+	if err != nil {
+		if errors.Is(err, repository.ErrPrivacyRequestNotFound) {
+			return nil, ErrPrivacyRequestNotReviewable
+		}
+		return nil, fmt.Errorf("failed to complete privacy request: %w", err)
+	}
+
+	logger.InfoContext(ctx, "privacy request completed",
+		"request_id", completed.ID.String(),
+		"request_type", completed.RequestType,
+		"affected_records", affected)
+
+	return toPrivacyRequestOutput(completed), nil
+}
+
+// RejectRequest closes a verified request without touching any reservations
+func (s *PrivacyService) RejectRequest(ctx context.Context, input ReviewRequestInput) (*PrivacyRequestOutput, error) {
+	request, err := s.getReviewableRequest(ctx, input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := s.repo.Reject(ctx, request.ID, input.ReviewerID, noteToText(input.Note))
+	if err != nil {
+		if errors.Is(err, repository.ErrPrivacyRequestNotFound) {
+			return nil, ErrPrivacyRequestNotReviewable
+		}
+		return nil, fmt.Errorf("failed to reject privacy request: %w", err)
+	}
+
+	logger.InfoContext(ctx, "privacy request rejected",
+		"request_id", rejected.ID.String(),
+		"request_type", rejected.RequestType)
+
+	return toPrivacyRequestOutput(rejected), nil
+}
+
+func (s *PrivacyService) getReviewableRequest(ctx context.Context, requestID string) (*models.PrivacyRequest, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(requestID); err != nil {
+		return nil, ErrInvalidRequestID
+	}
+
+	request, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrPrivacyRequestNotFound) {
+			return nil, ErrPrivacyRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get privacy request: %w", err)
+	}
+
+	if request.Status != models.StatusPendingReview || !request.Email.Valid {
+		return nil, ErrPrivacyRequestNotReviewable
+	}
+
+	return request, nil
+}
+
+func buildGuestDataExport(
+	reservations []*reservationmodels.Reservation,
+	rsvps []*rsvpmodels.RSVP,
+	invites []*wishlistmodels.WishListInvite,
+	contacts []*contactmodels.Contact,
+) ([]byte, error) {
+	records := make([]GuestReservationExport, len(reservations))
+	for i, r := range reservations {
+		record := GuestReservationExport{
+			ReservationID: r.ID.String(),
+			WishlistID:    r.WishlistID.String(),
+			GiftItemID:    r.GiftItemID.String(),
+			Status:        r.Status,
+			ReservedAt:    r.ReservedAt.Time,
+		}
+		if r.GuestName.Valid {
+			record.GuestName = &r.GuestName.String
+		}
+		if r.GuestEmail.Valid {
+			record.GuestEmail = &r.GuestEmail.String
+		}
+		if r.Message.Valid {
+			record.Message = &r.Message.String
+		}
+		if r.CanceledAt.Valid {
+			record.CanceledAt = &r.CanceledAt.Time
+		}
+		if r.CancelReason.Valid {
+			record.CancelReason = &r.CancelReason.String
+		}
+		records[i] = record
+	}
+
+	answers := make([]GuestRSVPExport, len(rsvps))
+	for i, r := range rsvps {
+		answer := GuestRSVPExport{
+			RSVPID:     r.ID.String(),
+			WishlistID: r.WishlistID.String(),
+			Name:       r.Name,
+			Attending:  r.Attending,
+			AnsweredAt: r.UpdatedAt.Time,
+		}
+		if r.Email.Valid {
+			answer.Email = &r.Email.String
+		}
+		if r.Note.Valid {
+			answer.Note = &r.Note.String
+		}
+		answers[i] = answer
+	}
+
+	inviteRecords := make([]GuestInviteExport, len(invites))
+	for i, invite := range invites {
+		record := GuestInviteExport{
+			WishlistID: invite.WishListID.String(),
+			Email:      invite.Email,
+			InvitedAt:  invite.CreatedAt.Time,
+		}
+		if invite.SentAt.Valid {
+			record.SentAt = &invite.SentAt.Time
+		}
+		if invite.VisitedAt.Valid {
+			record.VisitedAt = &invite.VisitedAt.Time
+		}
+		inviteRecords[i] = record
+	}
+
+	contactRecords := make([]GuestContactExport, len(contacts))
+	for i, contact := range contacts {
+		contactRecords[i] = GuestContactExport{
+			Name:    contact.Name,
+			Email:   contact.Email,
+			SavedAt: contact.CreatedAt.Time,
+		}
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"generated_at": time.Now().UTC(),
+		"reservations": records,
+		"rsvps":        answers,
+		"invites":      inviteRecords,
+		"contacts":     contactRecords,
+	}, "", "  ")
+}
+
+func noteToText(note *string) pgtype.Text {
+	if note == nil || strings.TrimSpace(*note) == "" {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: strings.TrimSpace(*note), Valid: true}
+}
+
+func toPrivacyRequestOutput(request *models.PrivacyRequest) *PrivacyRequestOutput {
+	output := &PrivacyRequestOutput{
+		ID:              request.ID,
+		RequestType:     request.RequestType,
+		Status:          request.Status,
+		ExpiresAt:       request.ExpiresAt,
+		VerifiedAt:      request.VerifiedAt,
+		ReviewedAt:      request.ReviewedAt,
+		ReviewNote:      request.ReviewNote,
+		AffectedRecords: request.AffectedRecords,
+		CompletedAt:     request.CompletedAt,
+		CreatedAt:       request.CreatedAt,
+	}
+	if request.Email.Valid {
+		email := request.Email.String
+		output.Email = &email
+	}
+	return output
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	contactmodels "wish-list/internal/domain/contact/models"
+	"wish-list/internal/domain/privacy/models"
+	"wish-list/internal/domain/privacy/repository"
+	reservationmodels "wish-list/internal/domain/reservation/models"
+	rsvpmodels "wish-list/internal/domain/rsvp/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testRequestID  = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testReviewerID = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testToken      = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+)
+
+func reviewableRequest(requestType string) *models.PrivacyRequest {
+	return &models.PrivacyRequest{
+		ID:          testRequestID,
+		RequestType: requestType,
+		Email:       pgtype.Text{String: "guest@example.com", Valid: true},
+		Status:      models.StatusPendingReview,
+	}
+}
+
+func TestPrivacyService_SubmitRequest(t *testing.T) {
+	t.Run("creates request and sends verification email", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, request models.PrivacyRequest) (*models.PrivacyRequest, error) {
+				request.ID = testRequestID
+				request.VerificationToken = testToken
+				return &request, nil
+			},
+		}
+		mockEmail := &PrivacyEmailSenderInterfaceMock{
+			SendPrivacyVerificationEmailFunc: func(ctx context.Context, recipientEmail, requestType, verificationToken string) error {
+				return nil
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, mockEmail)
+		err := svc.SubmitRequest(context.Background(), models.RequestTypeErasure, "  Guest@Example.COM ")
+
+		require.NoError(t, err)
+		require.Len(t, mockRepo.CreateCalls(), 1)
+		created := mockRepo.CreateCalls()[0].Request
+		assert.Equal(t, "guest@example.com", created.Email.String)
+		assert.Equal(t, models.StatusPendingVerification, created.Status)
+		assert.True(t, created.ExpiresAt.Valid)
+
+		require.Len(t, mockEmail.SendPrivacyVerificationEmailCalls(), 1)
+		call := mockEmail.SendPrivacyVerificationEmailCalls()[0]
+		assert.Equal(t, "guest@example.com", call.RecipientEmail)
+		assert.Equal(t, testToken.String(), call.VerificationToken)
+	})
+
+	t.Run("invalid request type", func(t *testing.T) {
+		svc := NewPrivacyService(&PrivacyRequestRepositoryInterfaceMock{}, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		err := svc.SubmitRequest(context.Background(), "delete-everything", "guest@example.com")
+
+		assert.ErrorIs(t, err, ErrInvalidRequestType)
+	})
+}
+
+func TestPrivacyService_VerifyRequest(t *testing.T) {
+	t.Run("valid token", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			MarkVerifiedFunc: func(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error) {
+				return reviewableRequest(models.RequestTypeExport), nil
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		output, err := svc.VerifyRequest(context.Background(), testToken.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusPendingReview, output.Status)
+	})
+
+	t.Run("unknown or expired token", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			MarkVerifiedFunc: func(ctx context.Context, token pgtype.UUID) (*models.PrivacyRequest, error) {
+				return nil, repository.ErrPrivacyRequestNotFound
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, err := svc.VerifyRequest(context.Background(), testToken.String())
+
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		svc := NewPrivacyService(&PrivacyRequestRepositoryInterfaceMock{}, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, err := svc.VerifyRequest(context.Background(), "not-a-uuid")
+
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	})
+}
+
+func TestPrivacyService_ListRequests(t *testing.T) {
+	t.Run("defaults to pending review", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			CountByStatusFunc: func(ctx context.Context, status string) (int, error) {
+				return 1, nil
+			},
+			ListByStatusFunc: func(ctx context.Context, status string, limit, offset int) ([]*models.PrivacyRequest, error) {
+				return []*models.PrivacyRequest{reviewableRequest(models.RequestTypeErasure)}, nil
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		requests, total, err := svc.ListRequests(context.Background(), "", 10, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, requests, 1)
+		assert.Equal(t, models.StatusPendingReview, mockRepo.ListByStatusCalls()[0].Status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		svc := NewPrivacyService(&PrivacyRequestRepositoryInterfaceMock{}, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, _, err := svc.ListRequests(context.Background(), "unknown", 10, 0)
+
+		assert.ErrorIs(t, err, ErrInvalidRequestStatus)
+	})
+}
+
+func TestPrivacyService_ApproveRequest(t *testing.T) {
+	completeFunc := func(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text, affectedRecords int) (*models.PrivacyRequest, error) {
+		return &models.PrivacyRequest{
+			ID:               id,
+			Status:           models.StatusCompleted,
+			ReviewedByUserID: reviewerID,
+			AffectedRecords:  pgtype.Int4{Int32: int32(affectedRecords), Valid: true},
+		}, nil
+	}
+
+	t.Run("erasure anonymizes guest reservations", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+				return reviewableRequest(models.RequestTypeErasure), nil
+			},
+			CompleteFunc: completeFunc,
+		}
+		mockReservations := &GuestReservationRepositoryInterfaceMock{
+			AnonymizeGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 3, nil
+			},
+		}
+
+		mockGifts := &GuestGiftHistoryInterfaceMock{
+			AnonymizeGuestGiverByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 1, nil
+			},
+		}
+		mockRSVPs := &GuestRSVPRepositoryInterfaceMock{
+			DeleteGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 2, nil
+			},
+		}
+		mockInvites := &GuestInviteRepositoryInterfaceMock{
+			DeleteGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 2, nil
+			},
+		}
+		mockContacts := &GuestContactRepositoryInterfaceMock{
+			DeleteGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 1, nil
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, mockReservations, mockGifts, mockRSVPs, mockInvites, mockContacts, &PrivacyEmailSenderInterfaceMock{})
+		output, err := svc.ApproveRequest(context.Background(), ReviewRequestInput{
+			RequestID:  testRequestID.String(),
+			ReviewerID: testReviewerID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusCompleted, output.Status)
+		assert.Equal(t, int32(9), output.AffectedRecords.Int32, "gifts kept from deleted wishlists, rsvps, invites and contacts count too")
+		require.Len(t, mockGifts.AnonymizeGuestGiverByEmailCalls(), 1)
+		assert.Equal(t, "guest@example.com", mockGifts.AnonymizeGuestGiverByEmailCalls()[0].GuestEmail)
+		require.Len(t, mockRSVPs.DeleteGuestRSVPsByEmailCalls(), 1)
+		assert.Equal(t, "guest@example.com", mockRSVPs.DeleteGuestRSVPsByEmailCalls()[0].GuestEmail)
+		require.Len(t, mockInvites.DeleteGuestInvitesByEmailCalls(), 1)
+		assert.Equal(t, "guest@example.com", mockInvites.DeleteGuestInvitesByEmailCalls()[0].GuestEmail)
+		require.Len(t, mockContacts.DeleteGuestContactsByEmailCalls(), 1)
+		assert.Equal(t, "guest@example.com", mockContacts.DeleteGuestContactsByEmailCalls()[0].GuestEmail)
+		require.Len(t, mockReservations.AnonymizeGuestReservationsByEmailCalls(), 1)
+		assert.Equal(t, "guest@example.com", mockReservations.AnonymizeGuestReservationsByEmailCalls()[0].GuestEmail)
+		assert.Equal(t, testReviewerID, mockRepo.CompleteCalls()[0].ReviewerID)
+	})
+
+	t.Run("export emails guest reservations", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+				return reviewableRequest(models.RequestTypeExport), nil
+			},
+			CompleteFunc: completeFunc,
+		}
+		mockReservations := &GuestReservationRepositoryInterfaceMock{
+			ListGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*reservationmodels.Reservation, error) {
+				return []*reservationmodels.Reservation{{
+					ID:         testToken,
+					GuestName:  pgtype.Text{String: "Guest", Valid: true},
+					GuestEmail: pgtype.Text{String: "guest@example.com", Valid: true},
+					Status:     "active",
+				}}, nil
+			},
+		}
+		mockEmail := &PrivacyEmailSenderInterfaceMock{
+			SendGuestDataExportEmailFunc: func(ctx context.Context, recipientEmail string, exportJSON []byte) error {
+				return nil
+			},
+		}
+
+		mockRSVPs := &GuestRSVPRepositoryInterfaceMock{
+			ListGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*rsvpmodels.RSVP, error) {
+				return []*rsvpmodels.RSVP{{
+					ID:        testToken,
+					Name:      "Guest",
+					Email:     pgtype.Text{String: "guest@example.com", Valid: true},
+					Attending: true,
+					Note:      pgtype.Text{String: "Bringing a cake", Valid: true},
+				}}, nil
+			},
+		}
+
+		mockInvites := &GuestInviteRepositoryInterfaceMock{
+			ListGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) ([]*wishlistmodels.WishListInvite, error) {
+				return []*wishlistmodels.WishListInvite{{
+					WishListID: testToken,
+					Email:      "guest@example.com",
+					SentAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				}}, nil
+			},
+		}
+		mockContacts := &GuestContactRepositoryInterfaceMock{
+			ListGuestContactsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*contactmodels.Contact, error) {
+				return []*contactmodels.Contact{{
+					Name:         "Aunt Guest",
+					Email:        "guest@example.com",
+					Relationship: pgtype.Text{String: "family", Valid: true},
+				}}, nil
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, mockReservations, &GuestGiftHistoryInterfaceMock{}, mockRSVPs, mockInvites, mockContacts, mockEmail)
+		output, err := svc.ApproveRequest(context.Background(), ReviewRequestInput{
+			RequestID:  testRequestID.String(),
+			ReviewerID: testReviewerID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), output.AffectedRecords.Int32)
+		require.Len(t, mockEmail.SendGuestDataExportEmailCalls(), 1)
+
+		var export struct {
+			Reservations []GuestReservationExport `json:"reservations"`
+			RSVPs        []GuestRSVPExport        `json:"rsvps"`
+			Invites      []GuestInviteExport      `json:"invites"`
+			Contacts     []GuestContactExport     `json:"contacts"`
+		}
+		require.NoError(t, json.Unmarshal(mockEmail.SendGuestDataExportEmailCalls()[0].ExportJSON, &export))
+		require.Len(t, export.Reservations, 1)
+		assert.Equal(t, "Guest", *export.Reservations[0].GuestName)
+		require.Len(t, export.RSVPs, 1)
+		assert.True(t, export.RSVPs[0].Attending)
+		assert.Equal(t, "Bringing a cake", *export.RSVPs[0].Note)
+		require.Len(t, export.Invites, 1)
+		assert.Equal(t, "guest@example.com", export.Invites[0].Email)
+		assert.NotNil(t, export.Invites[0].SentAt)
+		require.Len(t, export.Contacts, 1)
+		assert.Equal(t, "Aunt Guest", export.Contacts[0].Name)
+		assert.NotContains(t, string(mockEmail.SendGuestDataExportEmailCalls()[0].ExportJSON), "family", "the owner's groups stay private")
+	})
+
+	t.Run("request not pending review", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+				request := reviewableRequest(models.RequestTypeErasure)
+				request.Status = models.StatusPendingVerification
+				return request, nil
+			},
+		}
+		mockReservations := &GuestReservationRepositoryInterfaceMock{}
+
+		svc := NewPrivacyService(mockRepo, mockReservations, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, err := svc.ApproveRequest(context.Background(), ReviewRequestInput{RequestID: testRequestID.String()})
+
+		assert.ErrorIs(t, err, ErrPrivacyRequestNotReviewable)
+		assert.Empty(t, mockReservations.AnonymizeGuestReservationsByEmailCalls())
+	})
+
+	t.Run("anonymization failure leaves request open", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+				return reviewableRequest(models.RequestTypeErasure), nil
+			},
+		}
+		mockReservations := &GuestReservationRepositoryInterfaceMock{
+			AnonymizeGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+				return 0, errors.New("db down")
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, mockReservations, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, err := svc.ApproveRequest(context.Background(), ReviewRequestInput{RequestID: testRequestID.String()})
+
+		require.Error(t, err)
+		assert.Empty(t, mockRepo.CompleteCalls())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+				return nil, repository.ErrPrivacyRequestNotFound
+			},
+		}
+
+		svc := NewPrivacyService(mockRepo, &GuestReservationRepositoryInterfaceMock{}, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+		_, err := svc.ApproveRequest(context.Background(), ReviewRequestInput{RequestID: testRequestID.String()})
+
+		assert.ErrorIs(t, err, ErrPrivacyRequestNotFound)
+	})
+}
+
+func TestPrivacyService_RejectRequest(t *testing.T) {
+	note := "  duplicate request  "
+	mockRepo := &PrivacyRequestRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.PrivacyRequest, error) {
+			return reviewableRequest(models.RequestTypeErasure), nil
+		},
+		RejectFunc: func(ctx context.Context, id, reviewerID pgtype.UUID, note pgtype.Text) (*models.PrivacyRequest, error) {
+			return &models.PrivacyRequest{ID: id, Status: models.StatusRejected, ReviewNote: note}, nil
+		},
+	}
+	mockReservations := &GuestReservationRepositoryInterfaceMock{}
+
+	svc := NewPrivacyService(mockRepo, mockReservations, &GuestGiftHistoryInterfaceMock{}, &GuestRSVPRepositoryInterfaceMock{}, &GuestInviteRepositoryInterfaceMock{}, &GuestContactRepositoryInterfaceMock{}, &PrivacyEmailSenderInterfaceMock{})
+	output, err := svc.RejectRequest(context.Background(), ReviewRequestInput{
+		RequestID:  testRequestID.String(),
+		ReviewerID: testReviewerID,
+		Note:       &note,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusRejected, output.Status)
+	assert.Equal(t, "duplicate request", output.ReviewNote.String)
+	assert.Empty(t, mockReservations.AnonymizeGuestReservationsByEmailCalls())
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"wish-list/internal/domain/quota/service"
+)
+
+// QuotaUsageResponse is one limited resource with its limit and current usage
+type QuotaUsageResponse struct {
+	Limit int `json:"limit" validate:"required"`
+	Used  int `json:"used"`
+}
+
+// QuotaFeaturesResponse lists what the plan unlocks
+type QuotaFeaturesResponse struct {
+	CustomSlugs bool `json:"custom_slugs"` // Custom public slugs are kept without a random suffix
+	Analytics   bool `json:"analytics"`    // Link click statistics
+}
+
+type QuotaResponse struct {
+	Plan         string             `json:"plan" validate:"required" enums:"free,premium"`
+	WishLists    QuotaUsageResponse `json:"wishlists" validate:"required"`
+	ImageUploads QuotaUsageResponse `json:"image_uploads" validate:"required"` // Counted per calendar month (UTC)
+	// Items are limited per wish list, so only the limit is reported
+	ItemsPerWishListLimit int                   `json:"items_per_wishlist_limit" validate:"required"`
+	PeriodStart           string                `json:"period_start" validate:"required"`
+	PeriodEnd             string                `json:"period_end" validate:"required"`
+	Features              QuotaFeaturesResponse `json:"features" validate:"required"`
+}
+
+func FromUsageOutput(u *service.UsageOutput) QuotaResponse {
+	return QuotaResponse{
+		Plan: u.Plan,
+		WishLists: QuotaUsageResponse{
+			Limit: u.Limits.WishLists,
+			Used:  u.WishLists,
+		},
+		ImageUploads: QuotaUsageResponse{
+			Limit: u.Limits.ImageUploadsPerMonth,
+			Used:  u.ImageUploadsThisMonth,
+		},
+		ItemsPerWishListLimit: u.Limits.ItemsPerWishList,
+		PeriodStart:           u.PeriodStart.Format("2006-01-02T15:04:05Z07:00"),
+		PeriodEnd:             u.PeriodEnd.Format("2006-01-02T15:04:05Z07:00"),
+		Features: QuotaFeaturesResponse{
+			CustomSlugs: u.Features.CustomSlugs,
+			Analytics:   u.Features.Analytics,
+		},
+	}
+}
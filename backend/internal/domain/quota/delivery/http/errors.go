@@ -0,0 +1,18 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/quota/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapQuotaServiceError converts quota service errors to AppErrors
+func mapQuotaServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		return apperrors.NotFound("User not found")
+	default:
+		return apperrors.Internal("Failed to get quota usage").Wrap(err)
+	}
+}
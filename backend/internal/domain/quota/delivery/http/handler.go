@@ -0,0 +1,49 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/quota/delivery/http/dto"
+	"wish-list/internal/domain/quota/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for plan quotas
+type Handler struct {
+	service service.QuotaServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.QuotaServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// GetQuota godoc
+//
+//	@Summary		Get plan quotas
+//	@Description	The current user's plan with its limits and current usage. Creating a wish list, adding an item or uploading an image past a limit fails with 402 on the free plan (upgrade to lift it) and 422 on the premium plan.
+//	@Tags			User
+//	@Produce		json
+//	@Success		200	{object}	dto.QuotaResponse	"Plan quotas"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/quota [get]
+func (h *Handler) GetQuota(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	usage, err := h.service.GetUsage(c.Request().Context(), userID)
+	if err != nil {
+		return mapQuotaServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromUsageOutput(usage))
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	usermodels "wish-list/internal/domain/user/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Resources limited by a plan
+const (
+	ResourceWishLists     = "wishlists"
+	ResourceWishListItems = "wishlist_items"
+	ResourceImageUploads  = "image_uploads"
+)
+
+// Limits caps what a user on a plan may create
+type Limits struct {
+	WishLists            int
+	ItemsPerWishList     int
+	ImageUploadsPerMonth int
+}
+
+var planLimits = map[string]Limits{
+	usermodels.PlanFree: {
+		WishLists:            10,
+		ItemsPerWishList:     50,
+		ImageUploadsPerMonth: 30,
+	},
+	usermodels.PlanPremium: {
+		WishLists:            200,
+		ItemsPerWishList:     1000,
+		ImageUploadsPerMonth: 1000,
+	},
+}
+
+// LimitsForPlan returns the limits of a plan; unknown plans get the free limits
+func LimitsForPlan(plan string) Limits {
+	if limits, ok := planLimits[plan]; ok {
+		return limits
+	}
+	return planLimits[usermodels.PlanFree]
+}
+
+// Features lists what a plan unlocks besides higher limits
+type Features struct {
+	CustomSlugs bool // Custom public slugs are kept as chosen instead of getting a random suffix
+	Analytics   bool // Link click statistics
+}
+
+// FeaturesForPlan returns the features of a plan; unknown plans get none
+func FeaturesForPlan(plan string) Features {
+	if plan == usermodels.PlanPremium {
+		return Features{CustomSlugs: true, Analytics: true}
+	}
+	return Features{}
+}
+
+// ImageUpload is an image the user uploaded, as counted against the upload quota
+type ImageUpload struct {
+	ID        pgtype.UUID        `db:"id"`
+	URL       string             `db:"url"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
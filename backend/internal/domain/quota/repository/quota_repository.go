@@ -0,0 +1,106 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_quota_repository_test.go -pkg service . QuotaRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/quota/models"
+)
+
+// Sentinel errors for quota repository
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// QuotaRepositoryInterface defines the interface for quota usage database operations
+type QuotaRepositoryInterface interface {
+	GetUserPlan(ctx context.Context, userID pgtype.UUID) (string, error)
+	CountWishLists(ctx context.Context, ownerID pgtype.UUID) (int, error)
+	CountWishListItems(ctx context.Context, wishlistID pgtype.UUID) (int, error)
+	CountImageUploadsSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error)
+	RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error
+	ListImageUploads(ctx context.Context, userID pgtype.UUID) ([]*models.ImageUpload, error)
+}
+
+type QuotaRepository struct {
+	db *database.DB
+}
+
+func NewQuotaRepository(db *database.DB) QuotaRepositoryInterface {
+	return &QuotaRepository{
+		db: db,
+	}
+}
+
+// GetUserPlan returns the plan assigned to a user
+func (r *QuotaRepository) GetUserPlan(ctx context.Context, userID pgtype.UUID) (string, error) {
+	var plan string
+	if err := r.db.GetContext(ctx, &plan, `SELECT plan FROM users WHERE id = $1`, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		return "", fmt.Errorf("failed to get user plan: %w", err)
+	}
+	return plan, nil
+}
+
+// CountWishLists returns the number of wishlists a user owns
+func (r *QuotaRepository) CountWishLists(ctx context.Context, ownerID pgtype.UUID) (int, error) {
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM wishlists WHERE owner_id = $1`, ownerID); err != nil {
+		return 0, fmt.Errorf("failed to count wishlists: %w", err)
+	}
+	return count, nil
+}
+
+// CountWishListItems returns the number of unarchived items attached to a wishlist
+func (r *QuotaRepository) CountWishListItems(ctx context.Context, wishlistID pgtype.UUID) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM wishlist_items wi
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = $1 AND gi.archived_at IS NULL
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, wishlistID); err != nil {
+		return 0, fmt.Errorf("failed to count wishlist items: %w", err)
+	}
+	return count, nil
+}
+
+// CountImageUploadsSince returns the number of images a user uploaded since the given time
+func (r *QuotaRepository) CountImageUploadsSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM image_uploads WHERE user_id = $1 AND created_at >= $2`
+	if err := r.db.GetContext(ctx, &count, query, userID, since); err != nil {
+		return 0, fmt.Errorf("failed to count image uploads: %w", err)
+	}
+	return count, nil
+}
+
+// RecordImageUpload counts an uploaded image against the user's upload quota
+func (r *QuotaRepository) RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error {
+	if _, err := r.db.ExecContext(ctx, `INSERT INTO image_uploads (user_id, url) VALUES ($1, $2)`, userID, url); err != nil {
+		return fmt.Errorf("failed to record image upload: %w", err)
+	}
+	return nil
+}
+
+// ListImageUploads returns every image the user uploaded, oldest first
+func (r *QuotaRepository) ListImageUploads(ctx context.Context, userID pgtype.UUID) ([]*models.ImageUpload, error) {
+	var uploads []*models.ImageUpload
+	query := `SELECT id, url, created_at FROM image_uploads WHERE user_id = $1 ORDER BY created_at ASC`
+	if err := r.db.SelectContext(ctx, &uploads, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list image uploads: %w", err)
+	}
+	return uploads, nil
+}
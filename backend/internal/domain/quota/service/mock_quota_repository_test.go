@@ -0,0 +1,347 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/quota/models"
+	"wish-list/internal/domain/quota/repository"
+)
+
+// Ensure, that QuotaRepositoryInterfaceMock does implement repository.QuotaRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.QuotaRepositoryInterface = &QuotaRepositoryInterfaceMock{}
+
+// QuotaRepositoryInterfaceMock is a mock implementation of repository.QuotaRepositoryInterface.
+//
+//	func TestSomethingThatUsesQuotaRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.QuotaRepositoryInterface
+//		mockedQuotaRepositoryInterface := &QuotaRepositoryInterfaceMock{
+//			CountImageUploadsSinceFunc: func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+//				panic("mock out the CountImageUploadsSince method")
+//			},
+//			CountWishListItemsFunc: func(ctx context.Context, wishlistID pgtype.UUID) (int, error) {
+//				panic("mock out the CountWishListItems method")
+//			},
+//			CountWishListsFunc: func(ctx context.Context, ownerID pgtype.UUID) (int, error) {
+//				panic("mock out the CountWishLists method")
+//			},
+//			GetUserPlanFunc: func(ctx context.Context, userID pgtype.UUID) (string, error) {
+//				panic("mock out the GetUserPlan method")
+//			},
+//			ListImageUploadsFunc: func(ctx context.Context, userID pgtype.UUID) ([]*models.ImageUpload, error) {
+//				panic("mock out the ListImageUploads method")
+//			},
+//			RecordImageUploadFunc: func(ctx context.Context, userID pgtype.UUID, url string) error {
+//				panic("mock out the RecordImageUpload method")
+//			},
+//		}
+//
+//		// use mockedQuotaRepositoryInterface in code that requires repository.QuotaRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type QuotaRepositoryInterfaceMock struct {
+	// CountImageUploadsSinceFunc mocks the CountImageUploadsSince method.
+	CountImageUploadsSinceFunc func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error)
+
+	// CountWishListItemsFunc mocks the CountWishListItems method.
+	CountWishListItemsFunc func(ctx context.Context, wishlistID pgtype.UUID) (int, error)
+
+	// CountWishListsFunc mocks the CountWishLists method.
+	CountWishListsFunc func(ctx context.Context, ownerID pgtype.UUID) (int, error)
+
+	// GetUserPlanFunc mocks the GetUserPlan method.
+	GetUserPlanFunc func(ctx context.Context, userID pgtype.UUID) (string, error)
+
+	// ListImageUploadsFunc mocks the ListImageUploads method.
+	ListImageUploadsFunc func(ctx context.Context, userID pgtype.UUID) ([]*models.ImageUpload, error)
+
+	// RecordImageUploadFunc mocks the RecordImageUpload method.
+	RecordImageUploadFunc func(ctx context.Context, userID pgtype.UUID, url string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountImageUploadsSince holds details about calls to the CountImageUploadsSince method.
+		CountImageUploadsSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// CountWishListItems holds details about calls to the CountWishListItems method.
+		CountWishListItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// CountWishLists holds details about calls to the CountWishLists method.
+		CountWishLists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// GetUserPlan holds details about calls to the GetUserPlan method.
+		GetUserPlan []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListImageUploads holds details about calls to the ListImageUploads method.
+		ListImageUploads []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// RecordImageUpload holds details about calls to the RecordImageUpload method.
+		RecordImageUpload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// URL is the url argument value.
+			URL string
+		}
+	}
+	lockCountImageUploadsSince sync.RWMutex
+	lockCountWishListItems     sync.RWMutex
+	lockCountWishLists         sync.RWMutex
+	lockGetUserPlan            sync.RWMutex
+	lockListImageUploads       sync.RWMutex
+	lockRecordImageUpload      sync.RWMutex
+}
+
+// CountImageUploadsSince calls CountImageUploadsSinceFunc.
+func (mock *QuotaRepositoryInterfaceMock) CountImageUploadsSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+	if mock.CountImageUploadsSinceFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.CountImageUploadsSinceFunc: method is nil but QuotaRepositoryInterface.CountImageUploadsSince was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Since  time.Time
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Since:  since,
+	}
+	mock.lockCountImageUploadsSince.Lock()
+	mock.calls.CountImageUploadsSince = append(mock.calls.CountImageUploadsSince, callInfo)
+	mock.lockCountImageUploadsSince.Unlock()
+	return mock.CountImageUploadsSinceFunc(ctx, userID, since)
+}
+
+// CountImageUploadsSinceCalls gets all the calls that were made to CountImageUploadsSince.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.CountImageUploadsSinceCalls())
+func (mock *QuotaRepositoryInterfaceMock) CountImageUploadsSinceCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	Since  time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Since  time.Time
+	}
+	mock.lockCountImageUploadsSince.RLock()
+	calls = mock.calls.CountImageUploadsSince
+	mock.lockCountImageUploadsSince.RUnlock()
+	return calls
+}
+
+// CountWishListItems calls CountWishListItemsFunc.
+func (mock *QuotaRepositoryInterfaceMock) CountWishListItems(ctx context.Context, wishlistID pgtype.UUID) (int, error) {
+	if mock.CountWishListItemsFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.CountWishListItemsFunc: method is nil but QuotaRepositoryInterface.CountWishListItems was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockCountWishListItems.Lock()
+	mock.calls.CountWishListItems = append(mock.calls.CountWishListItems, callInfo)
+	mock.lockCountWishListItems.Unlock()
+	return mock.CountWishListItemsFunc(ctx, wishlistID)
+}
+
+// CountWishListItemsCalls gets all the calls that were made to CountWishListItems.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.CountWishListItemsCalls())
+func (mock *QuotaRepositoryInterfaceMock) CountWishListItemsCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockCountWishListItems.RLock()
+	calls = mock.calls.CountWishListItems
+	mock.lockCountWishListItems.RUnlock()
+	return calls
+}
+
+// CountWishLists calls CountWishListsFunc.
+func (mock *QuotaRepositoryInterfaceMock) CountWishLists(ctx context.Context, ownerID pgtype.UUID) (int, error) {
+	if mock.CountWishListsFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.CountWishListsFunc: method is nil but QuotaRepositoryInterface.CountWishLists was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockCountWishLists.Lock()
+	mock.calls.CountWishLists = append(mock.calls.CountWishLists, callInfo)
+	mock.lockCountWishLists.Unlock()
+	return mock.CountWishListsFunc(ctx, ownerID)
+}
+
+// CountWishListsCalls gets all the calls that were made to CountWishLists.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.CountWishListsCalls())
+func (mock *QuotaRepositoryInterfaceMock) CountWishListsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}
+	mock.lockCountWishLists.RLock()
+	calls = mock.calls.CountWishLists
+	mock.lockCountWishLists.RUnlock()
+	return calls
+}
+
+// GetUserPlan calls GetUserPlanFunc.
+func (mock *QuotaRepositoryInterfaceMock) GetUserPlan(ctx context.Context, userID pgtype.UUID) (string, error) {
+	if mock.GetUserPlanFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.GetUserPlanFunc: method is nil but QuotaRepositoryInterface.GetUserPlan was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetUserPlan.Lock()
+	mock.calls.GetUserPlan = append(mock.calls.GetUserPlan, callInfo)
+	mock.lockGetUserPlan.Unlock()
+	return mock.GetUserPlanFunc(ctx, userID)
+}
+
+// GetUserPlanCalls gets all the calls that were made to GetUserPlan.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.GetUserPlanCalls())
+func (mock *QuotaRepositoryInterfaceMock) GetUserPlanCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetUserPlan.RLock()
+	calls = mock.calls.GetUserPlan
+	mock.lockGetUserPlan.RUnlock()
+	return calls
+}
+
+// ListImageUploads calls ListImageUploadsFunc.
+func (mock *QuotaRepositoryInterfaceMock) ListImageUploads(ctx context.Context, userID pgtype.UUID) ([]*models.ImageUpload, error) {
+	if mock.ListImageUploadsFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.ListImageUploadsFunc: method is nil but QuotaRepositoryInterface.ListImageUploads was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListImageUploads.Lock()
+	mock.calls.ListImageUploads = append(mock.calls.ListImageUploads, callInfo)
+	mock.lockListImageUploads.Unlock()
+	return mock.ListImageUploadsFunc(ctx, userID)
+}
+
+// ListImageUploadsCalls gets all the calls that were made to ListImageUploads.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.ListImageUploadsCalls())
+func (mock *QuotaRepositoryInterfaceMock) ListImageUploadsCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockListImageUploads.RLock()
+	calls = mock.calls.ListImageUploads
+	mock.lockListImageUploads.RUnlock()
+	return calls
+}
+
+// RecordImageUpload calls RecordImageUploadFunc.
+func (mock *QuotaRepositoryInterfaceMock) RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error {
+	if mock.RecordImageUploadFunc == nil {
+		panic("QuotaRepositoryInterfaceMock.RecordImageUploadFunc: method is nil but QuotaRepositoryInterface.RecordImageUpload was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		URL    string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		URL:    url,
+	}
+	mock.lockRecordImageUpload.Lock()
+	mock.calls.RecordImageUpload = append(mock.calls.RecordImageUpload, callInfo)
+	mock.lockRecordImageUpload.Unlock()
+	return mock.RecordImageUploadFunc(ctx, userID, url)
+}
+
+// RecordImageUploadCalls gets all the calls that were made to RecordImageUpload.
+// Check the length with:
+//
+//	len(mockedQuotaRepositoryInterface.RecordImageUploadCalls())
+func (mock *QuotaRepositoryInterfaceMock) RecordImageUploadCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	URL    string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		URL    string
+	}
+	mock.lockRecordImageUpload.RLock()
+	calls = mock.calls.RecordImageUpload
+	mock.lockRecordImageUpload.RUnlock()
+	return calls
+}
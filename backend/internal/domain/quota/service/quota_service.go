@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/quota/models"
+	"wish-list/internal/domain/quota/repository"
+	usermodels "wish-list/internal/domain/user/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	ErrUserNotFound  = errors.New("user not found")
+)
+
+// resourceLabels describe each limited resource in client-facing messages
+var resourceLabels = map[string]string{
+	models.ResourceWishLists:     "wish lists",
+	models.ResourceWishListItems: "items per wish list",
+	models.ResourceImageUploads:  "image uploads per month",
+}
+
+// QuotaExceededError reports which limit of which plan was reached.
+// It matches ErrQuotaExceeded with errors.Is.
+type QuotaExceededError struct {
+	Resource string
+	Plan     string
+	Limit    int
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("The %s plan allows up to %d %s", e.Plan, e.Limit, resourceLabels[e.Resource])
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
+
+// Upgradable reports whether moving to a higher plan would lift the limit
+func (e *QuotaExceededError) Upgradable() bool {
+	return e.Plan != usermodels.PlanPremium
+}
+
+// QuotaServiceInterface defines the interface for plan quota operations
+type QuotaServiceInterface interface {
+	GetPlan(ctx context.Context, userID pgtype.UUID) (string, error)
+	AllowsCustomSlug(ctx context.Context, userID pgtype.UUID) (bool, error)
+	CheckWishListQuota(ctx context.Context, userID pgtype.UUID) error
+	CheckItemQuota(ctx context.Context, userID, wishlistID pgtype.UUID) error
+	CheckImageUploadQuota(ctx context.Context, userID pgtype.UUID) error
+	RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error
+	GetUsage(ctx context.Context, userID pgtype.UUID) (*UsageOutput, error)
+}
+
+type QuotaService struct {
+	repo repository.QuotaRepositoryInterface
+}
+
+func NewQuotaService(repo repository.QuotaRepositoryInterface) *QuotaService {
+	return &QuotaService{
+		repo: repo,
+	}
+}
+
+// UsageOutput is the user's plan with its limits and current usage
+type UsageOutput struct {
+	Plan                  string
+	Limits                models.Limits
+	Features              models.Features
+	WishLists             int
+	ImageUploadsThisMonth int
+	PeriodStart           time.Time // Start of the month image uploads are counted from (UTC)
+	PeriodEnd             time.Time
+}
+
+// GetPlan returns the plan the user is on
+func (s *QuotaService) GetPlan(ctx context.Context, userID pgtype.UUID) (string, error) {
+	plan, _, err := s.planLimits(ctx, userID)
+	return plan, err
+}
+
+// AllowsCustomSlug reports whether the user's custom public slugs are kept as chosen.
+// On other plans a random suffix is appended, as for generated slugs.
+func (s *QuotaService) AllowsCustomSlug(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	plan, err := s.GetPlan(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return models.FeaturesForPlan(plan).CustomSlugs, nil
+}
+
+// CheckWishListQuota fails with a QuotaExceededError when the user cannot create another wishlist
+func (s *QuotaService) CheckWishListQuota(ctx context.Context, userID pgtype.UUID) error {
+	plan, limits, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.repo.CountWishLists(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to count wishlists: %w", err)
+	}
+
+	return checkLimit(models.ResourceWishLists, plan, limits.WishLists, count)
+}
+
+// CheckItemQuota fails with a QuotaExceededError when another item cannot be added to
+// the wishlist. userID is the wishlist owner, whose plan applies.
+func (s *QuotaService) CheckItemQuota(ctx context.Context, userID, wishlistID pgtype.UUID) error {
+	plan, limits, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.repo.CountWishListItems(ctx, wishlistID)
+	if err != nil {
+		return fmt.Errorf("failed to count wishlist items: %w", err)
+	}
+
+	return checkLimit(models.ResourceWishListItems, plan, limits.ItemsPerWishList, count)
+}
+
+// CheckImageUploadQuota fails with a QuotaExceededError when the user used up this month's uploads
+func (s *QuotaService) CheckImageUploadQuota(ctx context.Context, userID pgtype.UUID) error {
+	plan, limits, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	monthStart, _ := currentMonth()
+	count, err := s.repo.CountImageUploadsSince(ctx, userID, monthStart)
+	if err != nil {
+		return fmt.Errorf("failed to count image uploads: %w", err)
+	}
+
+	return checkLimit(models.ResourceImageUploads, plan, limits.ImageUploadsPerMonth, count)
+}
+
+// RecordImageUpload counts an uploaded image against the user's monthly quota
+func (s *QuotaService) RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error {
+	if err := s.repo.RecordImageUpload(ctx, userID, url); err != nil {
+		return fmt.Errorf("failed to record image upload: %w", err)
+	}
+	return nil
+}
+
+// GetUsage returns the user's plan, its limits and how much of them is used
+func (s *QuotaService) GetUsage(ctx context.Context, userID pgtype.UUID) (*UsageOutput, error) {
+	plan, limits, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wishLists, err := s.repo.CountWishLists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count wishlists: %w", err)
+	}
+
+	monthStart, monthEnd := currentMonth()
+	uploads, err := s.repo.CountImageUploadsSince(ctx, userID, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count image uploads: %w", err)
+	}
+
+	return &UsageOutput{
+		Plan:                  plan,
+		Limits:                limits,
+		Features:              models.FeaturesForPlan(plan),
+		WishLists:             wishLists,
+		ImageUploadsThisMonth: uploads,
+		PeriodStart:           monthStart,
+		PeriodEnd:             monthEnd,
+	}, nil
+}
+
+func (s *QuotaService) planLimits(ctx context.Context, userID pgtype.UUID) (string, models.Limits, error) {
+	plan, err := s.repo.GetUserPlan(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return "", models.Limits{}, ErrUserNotFound
+		}
+		return "", models.Limits{}, fmt.Errorf("failed to get user plan: %w", err)
+	}
+	return plan, models.LimitsForPlan(plan), nil
+}
+
+func checkLimit(resource, plan string, limit, used int) error {
+	if used >= limit {
+		return &QuotaExceededError{Resource: resource, Plan: plan, Limit: limit}
+	}
+	return nil
+}
+
+// currentMonth returns the bounds of the current calendar month (UTC)
+func currentMonth() (time.Time, time.Time) {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/quota/models"
+	"wish-list/internal/domain/quota/repository"
+	usermodels "wish-list/internal/domain/user/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testUserID     = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testWishlistID = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+)
+
+func quotaRepo(plan string, wishLists, items, uploads int) *QuotaRepositoryInterfaceMock {
+	return &QuotaRepositoryInterfaceMock{
+		GetUserPlanFunc: func(ctx context.Context, userID pgtype.UUID) (string, error) {
+			return plan, nil
+		},
+		CountWishListsFunc: func(ctx context.Context, ownerID pgtype.UUID) (int, error) {
+			return wishLists, nil
+		},
+		CountWishListItemsFunc: func(ctx context.Context, wishlistID pgtype.UUID) (int, error) {
+			return items, nil
+		},
+		CountImageUploadsSinceFunc: func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+			return uploads, nil
+		},
+	}
+}
+
+func TestQuotaService_CheckWishListQuota(t *testing.T) {
+	free := models.LimitsForPlan(usermodels.PlanFree)
+
+	t.Run("under the limit", func(t *testing.T) {
+		svc := NewQuotaService(quotaRepo(usermodels.PlanFree, free.WishLists-1, 0, 0))
+		assert.NoError(t, svc.CheckWishListQuota(context.Background(), testUserID))
+	})
+
+	t.Run("free plan at the limit can upgrade", func(t *testing.T) {
+		svc := NewQuotaService(quotaRepo(usermodels.PlanFree, free.WishLists, 0, 0))
+
+		err := svc.CheckWishListQuota(context.Background(), testUserID)
+
+		require.ErrorIs(t, err, ErrQuotaExceeded)
+		var quotaErr *QuotaExceededError
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, models.ResourceWishLists, quotaErr.Resource)
+		assert.Equal(t, free.WishLists, quotaErr.Limit)
+		assert.True(t, quotaErr.Upgradable())
+		assert.Equal(t, "The free plan allows up to 10 wish lists", quotaErr.Error())
+	})
+
+	t.Run("premium plan has higher limits", func(t *testing.T) {
+		svc := NewQuotaService(quotaRepo(usermodels.PlanPremium, free.WishLists, 0, 0))
+		assert.NoError(t, svc.CheckWishListQuota(context.Background(), testUserID))
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		repo := quotaRepo(usermodels.PlanFree, 0, 0, 0)
+		repo.GetUserPlanFunc = func(ctx context.Context, userID pgtype.UUID) (string, error) {
+			return "", repository.ErrUserNotFound
+		}
+		svc := NewQuotaService(repo)
+
+		assert.ErrorIs(t, svc.CheckWishListQuota(context.Background(), testUserID), ErrUserNotFound)
+	})
+}
+
+func TestQuotaService_CheckItemQuota(t *testing.T) {
+	premium := models.LimitsForPlan(usermodels.PlanPremium)
+	repo := quotaRepo(usermodels.PlanPremium, 0, premium.ItemsPerWishList, 0)
+	svc := NewQuotaService(repo)
+
+	err := svc.CheckItemQuota(context.Background(), testUserID, testWishlistID)
+
+	var quotaErr *QuotaExceededError
+	require.ErrorAs(t, err, &quotaErr)
+	assert.Equal(t, models.ResourceWishListItems, quotaErr.Resource)
+	assert.False(t, quotaErr.Upgradable())
+	assert.Equal(t, testWishlistID, repo.CountWishListItemsCalls()[0].WishlistID)
+}
+
+func TestQuotaService_CheckImageUploadQuota(t *testing.T) {
+	free := models.LimitsForPlan(usermodels.PlanFree)
+	repo := quotaRepo(usermodels.PlanFree, 0, 0, free.ImageUploadsPerMonth)
+	svc := NewQuotaService(repo)
+
+	err := svc.CheckImageUploadQuota(context.Background(), testUserID)
+
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	since := repo.CountImageUploadsSinceCalls()[0].Since
+	assert.Equal(t, 1, since.Day())
+	assert.Equal(t, time.UTC, since.Location())
+}
+
+func TestQuotaService_GetUsage(t *testing.T) {
+	svc := NewQuotaService(quotaRepo(usermodels.PlanFree, 3, 0, 7))
+
+	usage, err := svc.GetUsage(context.Background(), testUserID)
+
+	require.NoError(t, err)
+	assert.Equal(t, usermodels.PlanFree, usage.Plan)
+	assert.Equal(t, models.LimitsForPlan(usermodels.PlanFree), usage.Limits)
+	assert.Equal(t, 3, usage.WishLists)
+	assert.Equal(t, 7, usage.ImageUploadsThisMonth)
+	assert.Equal(t, usage.PeriodStart.AddDate(0, 1, 0), usage.PeriodEnd)
+}
+
+func TestQuotaService_AllowsCustomSlug(t *testing.T) {
+	free, err := NewQuotaService(quotaRepo(usermodels.PlanFree, 0, 0, 0)).AllowsCustomSlug(context.Background(), testUserID)
+	require.NoError(t, err)
+	assert.False(t, free)
+
+	premium, err := NewQuotaService(quotaRepo(usermodels.PlanPremium, 0, 0, 0)).AllowsCustomSlug(context.Background(), testUserID)
+	require.NoError(t, err)
+	assert.True(t, premium)
+}
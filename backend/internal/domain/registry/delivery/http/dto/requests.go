@@ -0,0 +1,43 @@
+package dto
+
+import "wish-list/internal/domain/registry/service"
+
+type CreateRegistryRequest struct {
+	Title       string   `json:"title" validate:"required,max=200"`
+	Description string   `json:"description"`
+	PublicSlug  string   `json:"public_slug" validate:"omitempty,max=100"` // Kept as chosen on premium; other plans get a random suffix
+	IsPublic    *bool    `json:"is_public"`                                // Defaults to true
+	WishlistIDs []string `json:"wishlist_ids" validate:"max=20,dive,uuid"`
+}
+
+func (r *CreateRegistryRequest) ToServiceInput() service.CreateRegistryInput {
+	isPublic := true
+	if r.IsPublic != nil {
+		isPublic = *r.IsPublic
+	}
+	return service.CreateRegistryInput{
+		Title:       r.Title,
+		Description: r.Description,
+		PublicSlug:  r.PublicSlug,
+		IsPublic:    isPublic,
+		WishlistIDs: r.WishlistIDs,
+	}
+}
+
+type UpdateRegistryRequest struct {
+	Title       *string  `json:"title" validate:"omitempty,max=200"`
+	Description *string  `json:"description"`
+	PublicSlug  *string  `json:"public_slug" validate:"omitempty,max=100"` // Kept as chosen on premium; other plans get a random suffix
+	IsPublic    *bool    `json:"is_public"`
+	WishlistIDs []string `json:"wishlist_ids" validate:"omitempty,max=20,dive,uuid"` // Replaces the member lists when present
+}
+
+func (r *UpdateRegistryRequest) ToServiceInput() service.UpdateRegistryInput {
+	return service.UpdateRegistryInput{
+		Title:       r.Title,
+		Description: r.Description,
+		PublicSlug:  r.PublicSlug,
+		IsPublic:    r.IsPublic,
+		WishlistIDs: r.WishlistIDs,
+	}
+}
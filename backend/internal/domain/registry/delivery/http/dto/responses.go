@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"wish-list/internal/domain/registry/service"
+)
+
+type RegistryWishlistResponse struct {
+	ID           string  `json:"id" validate:"required"`
+	Title        string  `json:"title" validate:"required"`
+	Description  *string `json:"description"`
+	Occasion     *string `json:"occasion"`
+	OccasionDate *string `json:"occasion_date"`
+	IsPublic     bool    `json:"is_public"`
+	PublicSlug   *string `json:"public_slug"`
+	ItemCount    int64   `json:"item_count"`
+}
+
+type RegistryResponse struct {
+	ID          string                     `json:"id" validate:"required"`
+	OwnerID     string                     `json:"owner_id" validate:"required"`
+	Title       string                     `json:"title" validate:"required"`
+	Description *string                    `json:"description"`
+	PublicSlug  string                     `json:"public_slug" validate:"required"`
+	IsPublic    bool                       `json:"is_public"`
+	Wishlists   []RegistryWishlistResponse `json:"wishlists,omitempty"`
+	CreatedAt   string                     `json:"created_at" validate:"required"`
+	UpdatedAt   string                     `json:"updated_at" validate:"required"`
+}
+
+type RegistriesListResponse struct {
+	Data []RegistryResponse `json:"data" validate:"required"`
+}
+
+type RegistryItemResponse struct {
+	ID          string   `json:"id" validate:"required"`
+	WishlistID  string   `json:"wishlist_id" validate:"required"`
+	Name        string   `json:"name" validate:"required"`
+	Description *string  `json:"description"`
+	Link        *string  `json:"link"`
+	ImageURL    *string  `json:"image_url"`
+	Price       *float64 `json:"price"`
+	Priority    int32    `json:"priority"`
+	Position    int32    `json:"position"`
+	IsReserved  bool     `json:"is_reserved"`
+	IsPurchased bool     `json:"is_purchased"`
+	CreatedAt   string   `json:"created_at" validate:"required"`
+
+	PriorityLevel  string `json:"priority_level" enums:"must_have,nice_to_have,dream"`
+	PriorityWeight int32  `json:"priority_weight"`
+
+	AvailabilityStatus string `json:"availability_status" enums:"unknown,available,out_of_stock,not_found"`
+}
+
+// PublicRegistryResponse is a public registry page: its public wishlists and one page of their combined items
+type PublicRegistryResponse struct {
+	RegistryResponse
+	Items []RegistryItemResponse `json:"items" validate:"required"`
+	Total int                    `json:"total"`
+	Page  int                    `json:"page" validate:"required"`
+	Limit int                    `json:"limit" validate:"required"`
+	Pages int                    `json:"pages"`
+}
+
+func FromRegistryOutput(r *service.RegistryOutput) RegistryResponse {
+	resp := RegistryResponse{
+		ID:          r.ID.String(),
+		OwnerID:     r.OwnerID.String(),
+		Title:       r.Title,
+		Description: textPtr(r.Description.String, r.Description.Valid),
+		PublicSlug:  r.PublicSlug,
+		IsPublic:    r.IsPublic,
+		CreatedAt:   r.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   r.UpdatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if r.Wishlists != nil {
+		resp.Wishlists = make([]RegistryWishlistResponse, len(r.Wishlists))
+		for i, w := range r.Wishlists {
+			wishlist := RegistryWishlistResponse{
+				ID:          w.WishlistID.String(),
+				Title:       w.Title,
+				Description: textPtr(w.Description.String, w.Description.Valid),
+				Occasion:    textPtr(w.Occasion.String, w.Occasion.Valid),
+				IsPublic:    w.IsPublic,
+				PublicSlug:  textPtr(w.PublicSlug.String, w.PublicSlug.Valid),
+				ItemCount:   w.ItemCount,
+			}
+			if w.OccasionDate.Valid {
+				occasionDate := w.OccasionDate.Time.Format("2006-01-02")
+				wishlist.OccasionDate = &occasionDate
+			}
+			resp.Wishlists[i] = wishlist
+		}
+	}
+
+	return resp
+}
+
+func FromRegistryOutputs(outputs []*service.RegistryOutput) []RegistryResponse {
+	responses := make([]RegistryResponse, len(outputs))
+	for i, output := range outputs {
+		responses[i] = FromRegistryOutput(output)
+	}
+	return responses
+}
+
+func FromPublicRegistryOutput(r *service.PublicRegistryOutput, page, limit int) PublicRegistryResponse {
+	items := make([]RegistryItemResponse, len(r.Items))
+	for i, item := range r.Items {
+		items[i] = RegistryItemResponse{
+			ID:          item.ID.String(),
+			WishlistID:  item.WishlistID.String(),
+			Name:        item.Name,
+			Description: textPtr(item.Description.String, item.Description.Valid),
+			Link:        textPtr(item.Link.String, item.Link.Valid),
+			ImageURL:    textPtr(item.ImageURL.String, item.ImageURL.Valid),
+			Price:       item.Price,
+			Priority:    item.Priority,
+			Position:    item.Position,
+			IsReserved:  item.IsReserved,
+			IsPurchased: item.IsPurchased,
+			CreatedAt:   item.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+
+			PriorityLevel:  item.PriorityLevel,
+			PriorityWeight: item.PriorityWeight,
+
+			AvailabilityStatus: item.AvailabilityStatus,
+		}
+	}
+
+	return PublicRegistryResponse{
+		RegistryResponse: FromRegistryOutput(r.Registry),
+		Items:            items,
+		Total:            r.Total,
+		Page:             page,
+		Limit:            limit,
+		Pages:            (r.Total + limit - 1) / limit,
+	}
+}
+
+func textPtr(value string, valid bool) *string {
+	if !valid {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/registry/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapRegistryServiceError converts registry service errors to AppErrors
+func mapRegistryServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidRegistryID):
+		return apperrors.BadRequest("Invalid registry ID")
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrRegistryTitleRequired):
+		return apperrors.BadRequest("Title is required")
+	case errors.Is(err, service.ErrRegistryNotFound):
+		return apperrors.NotFound("Registry not found")
+	case errors.Is(err, service.ErrRegistryForbidden):
+		return apperrors.Forbidden("Access denied")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Wishlist not found")
+	case errors.Is(err, service.ErrWishlistNotOwned):
+		return apperrors.Forbidden("Registry wishlists must be your own wishlists")
+	case errors.Is(err, service.ErrDuplicateWishlist):
+		return apperrors.BadRequest("A wishlist can only be added to a registry once")
+	case errors.Is(err, service.ErrTooManyWishlists):
+		return apperrors.BadRequest("A registry can combine at most 20 wishlists")
+	case errors.Is(err, service.ErrSlugTaken):
+		return apperrors.Conflict("Public slug is already taken")
+	case errors.Is(err, service.ErrSlugInvalid):
+		return apperrors.BadRequest("Public slug must contain only lowercase letters, digits, and hyphens")
+	case errors.Is(err, service.ErrInvalidItemFilter):
+		return apperrors.BadRequest("Invalid item filter")
+	default:
+		return apperrors.Internal("Failed to process registry request").Wrap(err)
+	}
+}
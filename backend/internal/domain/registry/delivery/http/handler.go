@@ -0,0 +1,260 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/registry/delivery/http/dto"
+	"wish-list/internal/domain/registry/repository"
+	"wish-list/internal/domain/registry/service"
+	"wish-list/internal/pkg/affiliate"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for registries
+type Handler struct {
+	service service.RegistryServiceInterface
+	links   *affiliate.Decorator // Applied to item links on public pages; nil leaves them unchanged
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.RegistryServiceInterface, links *affiliate.Decorator) *Handler {
+	return &Handler{
+		service: svc,
+		links:   links,
+	}
+}
+
+// CreateRegistry godoc
+//
+//	@Summary		Create a registry
+//	@Description	Group several of your wishlists (e.g. wedding: home + honeymoon fund) into one registry page with its own public slug.
+//	@Tags			Registries
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateRegistryRequest	true	"Registry details"
+//	@Success		201		{object}	dto.RegistryResponse		"Registry created"
+//	@Failure		400		{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Wishlist belongs to another user"
+//	@Failure		404		{object}	map[string]string			"Wishlist not found"
+//	@Failure		409		{object}	map[string]string			"Public slug already taken"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/registries [post]
+func (h *Handler) CreateRegistry(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.CreateRegistryRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	registry, err := h.service.CreateRegistry(c.Request().Context(), ownerID, req.ToServiceInput())
+	if err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromRegistryOutput(registry))
+}
+
+// ListRegistries godoc
+//
+//	@Summary		List your registries
+//	@Tags			Registries
+//	@Produce		json
+//	@Success		200	{object}	dto.RegistriesListResponse	"List of registries"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/registries [get]
+func (h *Handler) ListRegistries(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	registries, err := h.service.ListRegistries(c.Request().Context(), ownerID)
+	if err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.RegistriesListResponse{
+		Data: dto.FromRegistryOutputs(registries),
+	})
+}
+
+// GetRegistry godoc
+//
+//	@Summary		Get a registry
+//	@Description	Get one of your registries with all of its member wishlists.
+//	@Tags			Registries
+//	@Produce		json
+//	@Param			id	path		string					true	"Registry ID"
+//	@Success		200	{object}	dto.RegistryResponse	"Registry"
+//	@Failure		400	{object}	map[string]string		"Invalid registry ID"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Not the registry owner"
+//	@Failure		404	{object}	map[string]string		"Registry not found"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/registries/{id} [get]
+func (h *Handler) GetRegistry(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	registry, err := h.service.GetRegistry(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromRegistryOutput(registry))
+}
+
+// UpdateRegistry godoc
+//
+//	@Summary		Update a registry
+//	@Description	Update registry details. When wishlist_ids is present it replaces the member wishlists in the given order.
+//	@Tags			Registries
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Registry ID"
+//	@Param			request	body		dto.UpdateRegistryRequest	true	"Fields to update"
+//	@Success		200		{object}	dto.RegistryResponse		"Registry updated"
+//	@Failure		400		{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Not the registry owner"
+//	@Failure		404		{object}	map[string]string			"Registry or wishlist not found"
+//	@Failure		409		{object}	map[string]string			"Public slug already taken"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/registries/{id} [put]
+func (h *Handler) UpdateRegistry(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateRegistryRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	registry, err := h.service.UpdateRegistry(c.Request().Context(), c.Param("id"), ownerID, req.ToServiceInput())
+	if err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromRegistryOutput(registry))
+}
+
+// DeleteRegistry godoc
+//
+//	@Summary		Delete a registry
+//	@Description	Delete a registry. Its member wishlists are kept.
+//	@Tags			Registries
+//	@Param			id	path		string				true	"Registry ID"
+//	@Success		204	{object}	nil					"Registry deleted"
+//	@Failure		400	{object}	map[string]string	"Invalid registry ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Not the registry owner"
+//	@Failure		404	{object}	map[string]string	"Registry not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/registries/{id} [delete]
+func (h *Handler) DeleteRegistry(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteRegistry(c.Request().Context(), c.Param("id"), ownerID); err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// GetPublicRegistry godoc
+//
+//	@Summary		Get a public registry page
+//	@Description	Get a public registry with its public member wishlists and their items combined into one filterable, paginated list. Item links carry the merchant's affiliate tag when one is configured.
+//	@Tags			Registries
+//	@Produce		json
+//	@Param			slug		path		string						true	"Public slug of the registry"
+//	@Param			wishlist_id	query		string						false	"Only items from this member wishlist"
+//	@Param			search		query		string						false	"Search in item name and description"
+//	@Param			status		query		string						false	"Item status"	Enums(available, reserved, purchased)
+//	@Param			min_price	query		number						false	"Minimum price"
+//	@Param			max_price	query		number						false	"Maximum price"
+//	@Param			sort		query		string						false	"Sort field (default position); priority sorts by level weight"	Enums(position, price, priority, name, created_at)
+//	@Param			order		query		string						false	"Sort order (default asc)"		Enums(asc, desc)
+//	@Param			page		query		int							false	"Page number (default 1)"
+//	@Param			limit		query		int							false	"Items per page (default 10, max 100)"
+//	@Success		200			{object}	dto.PublicRegistryResponse	"Public registry"
+//	@Failure		400			{object}	map[string]string			"Invalid filter"
+//	@Failure		404			{object}	map[string]string			"Registry not found"
+//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Router			/public/registries/{slug} [get]
+func (h *Handler) GetPublicRegistry(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+	filters := repository.ItemFilters{
+		Search: c.QueryParam("search"),
+		Status: c.QueryParam("status"),
+		Sort:   c.QueryParam("sort"),
+		Order:  c.QueryParam("order"),
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	}
+
+	if wishlistID := c.QueryParam("wishlist_id"); wishlistID != "" {
+		if err := filters.WishlistID.Scan(wishlistID); err != nil {
+			return apperrors.BadRequest("Invalid wishlist ID")
+		}
+	}
+
+	var err error
+	if filters.MinPrice, err = parsePriceParam(c, "min_price"); err != nil {
+		return err
+	}
+	if filters.MaxPrice, err = parsePriceParam(c, "max_price"); err != nil {
+		return err
+	}
+
+	registry, err := h.service.GetPublicRegistry(c.Request().Context(), c.Param("slug"), filters)
+	if err != nil {
+		return mapRegistryServiceError(err)
+	}
+
+	resp := dto.FromPublicRegistryOutput(registry, pagination.Page, pagination.Limit)
+	for _, item := range resp.Items {
+		if item.Link != nil {
+			*item.Link = h.links.Decorate(*item.Link)
+		}
+	}
+
+	return c.JSON(nethttp.StatusOK, resp)
+}
+
+func parsePriceParam(c echo.Context, name string) (*float64, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, apperrors.BadRequest("Invalid " + name)
+	}
+
+	return &value, nil
+}
@@ -0,0 +1,17 @@
+package http
+
+import "github.com/labstack/echo/v4"
+
+// RegisterRoutes registers all registry HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	// Authenticated registry routes
+	registries := e.Group("/api/registries", authMiddleware)
+	registries.POST("", h.CreateRegistry)
+	registries.GET("", h.ListRegistries)
+	registries.GET("/:id", h.GetRegistry)
+	registries.PUT("/:id", h.UpdateRegistry)
+	registries.DELETE("/:id", h.DeleteRegistry)
+
+	// Public registry page (no auth required)
+	e.GET("/api/public/registries/:slug", h.GetPublicRegistry)
+}
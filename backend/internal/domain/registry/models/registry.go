@@ -0,0 +1,52 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Registry groups several wishlists of one owner under a single public page
+type Registry struct {
+	ID          pgtype.UUID        `db:"id"`
+	OwnerID     pgtype.UUID        `db:"owner_id"`
+	Title       string             `db:"title"`
+	Description pgtype.Text        `db:"description"`
+	PublicSlug  string             `db:"public_slug"`
+	IsPublic    bool               `db:"is_public"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at"`
+}
+
+// RegistryWishlist is a member wishlist of a registry
+type RegistryWishlist struct {
+	WishlistID   pgtype.UUID `db:"wishlist_id"`
+	Title        string      `db:"title"`
+	Description  pgtype.Text `db:"description"`
+	Occasion     pgtype.Text `db:"occasion"`
+	OccasionDate pgtype.Date `db:"occasion_date"`
+	IsPublic     pgtype.Bool `db:"is_public"`
+	PublicSlug   pgtype.Text `db:"public_slug"`
+	Position     int32       `db:"position"`
+	ItemCount    int64       `db:"item_count"`
+}
+
+// RegistryItem is a gift item of a member wishlist as shown on the public registry page.
+// Reservation details are reduced to flags so guest and giver identities are never exposed.
+type RegistryItem struct {
+	ID          pgtype.UUID    `db:"id"`
+	WishlistID  pgtype.UUID    `db:"wishlist_id"`
+	Name        string         `db:"name"`
+	Description pgtype.Text    `db:"description"`
+	Link        pgtype.Text    `db:"link"`
+	ImageURL    pgtype.Text    `db:"image_url"`
+	Price       pgtype.Numeric `db:"price"`
+	Priority    pgtype.Int4    `db:"priority"`
+	Position    pgtype.Int4    `db:"position"`
+
+	PriorityLevel  string             `db:"priority_level"`
+	PriorityWeight int32              `db:"priority_weight"`
+	IsReserved     bool               `db:"is_reserved"`
+	IsPurchased    bool               `db:"is_purchased"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+
+	AvailabilityStatus string `db:"availability_status"`
+}
@@ -0,0 +1,394 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_registry_repository_test.go -pkg service . RegistryRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/registry/models"
+	"wish-list/internal/pkg/logger"
+)
+
+// Sentinel errors for registry repository
+var (
+	ErrRegistryNotFound = errors.New("registry not found")
+	ErrInvalidSortField = errors.New("invalid sort field")
+	ErrInvalidSortOrder = errors.New("invalid sort order")
+)
+
+// Item reservation statuses accepted by ItemFilters.Status
+const (
+	ItemStatusAvailable = "available"
+	ItemStatusReserved  = "reserved"
+	ItemStatusPurchased = "purchased"
+)
+
+const registryColumns = `id, owner_id, title, description, public_slug, is_public, created_at, updated_at`
+
+// validItemSortFields defines allowed sort fields for registry item queries
+var validItemSortFields = map[string]string{
+	"position":   "list_position, position",
+	"price":      "price",
+	"priority":   "priority_weight, priority",
+	"name":       "name",
+	"created_at": "created_at",
+}
+
+// validItemSortOrders defines allowed sort orders for registry item queries
+var validItemSortOrders = map[string]bool{
+	"ASC":  true,
+	"DESC": true,
+}
+
+// ItemFilters contains filter and pagination parameters for the combined item list of a registry
+type ItemFilters struct {
+	WishlistID pgtype.UUID // Only items of this member wishlist
+	Search     string      // Search in name and description
+	Status     string      // available, reserved, purchased; empty = all
+	MinPrice   *float64
+	MaxPrice   *float64
+	Sort       string // position (default), price, priority (by level weight), name, created_at
+	Order      string // asc (default), desc
+	Limit      int
+	Offset     int
+}
+
+// RegistryRepositoryInterface defines the interface for registry database operations
+type RegistryRepositoryInterface interface {
+	Create(ctx context.Context, registry models.Registry) (*models.Registry, error)
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.Registry, error)
+	GetByPublicSlug(ctx context.Context, publicSlug string) (*models.Registry, error)
+	ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.Registry, error)
+	IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error)
+	Update(ctx context.Context, registry models.Registry) (*models.Registry, error)
+	Delete(ctx context.Context, id pgtype.UUID) error
+	SetWishlists(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error
+	ListWishlists(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error)
+	ListPublicItems(ctx context.Context, registryID pgtype.UUID, filters ItemFilters) ([]*models.RegistryItem, int, error)
+}
+
+type RegistryRepository struct {
+	db *database.DB
+}
+
+func NewRegistryRepository(db *database.DB) RegistryRepositoryInterface {
+	return &RegistryRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new registry
+func (r *RegistryRepository) Create(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+	query := `
+		INSERT INTO registries (owner_id, title, description, public_slug, is_public)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + registryColumns
+
+	var created models.Registry
+	err := r.db.QueryRowxContext(ctx, query,
+		registry.OwnerID,
+		registry.Title,
+		registry.Description,
+		registry.PublicSlug,
+		registry.IsPublic,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a registry by ID
+func (r *RegistryRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.Registry, error) {
+	query := `SELECT ` + registryColumns + ` FROM registries WHERE id = $1`
+
+	var registry models.Registry
+	if err := r.db.GetContext(ctx, &registry, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	return &registry, nil
+}
+
+// GetByPublicSlug retrieves a public registry by its slug
+func (r *RegistryRepository) GetByPublicSlug(ctx context.Context, publicSlug string) (*models.Registry, error) {
+	query := `SELECT ` + registryColumns + ` FROM registries WHERE public_slug = $1 AND is_public = true`
+
+	// Public pages tolerate replication lag
+	var registry models.Registry
+	if err := r.db.Reader().GetContext(ctx, &registry, query, publicSlug); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to get registry by public slug: %w", err)
+	}
+
+	return &registry, nil
+}
+
+// ListByOwner retrieves all registries of a user, newest first
+func (r *RegistryRepository) ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.Registry, error) {
+	query := `SELECT ` + registryColumns + ` FROM registries WHERE owner_id = $1 ORDER BY created_at DESC`
+
+	var registries []*models.Registry
+	if err := r.db.SelectContext(ctx, &registries, query, ownerID); err != nil {
+		return nil, fmt.Errorf("failed to list registries: %w", err)
+	}
+
+	return registries, nil
+}
+
+// IsSlugTaken reports whether the given public slug is already used by another registry.
+// excludeID is the registry being updated so its own slug does not count as a conflict.
+func (r *RegistryRepository) IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM registries WHERE public_slug = $1 AND ($2::uuid IS NULL OR id <> $2))`
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, slug, excludeID); err != nil {
+		return false, fmt.Errorf("failed to check registry slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Update modifies the title, description, slug and visibility of a registry
+func (r *RegistryRepository) Update(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+	query := `
+		UPDATE registries SET
+			title = $2,
+			description = $3,
+			public_slug = $4,
+			is_public = $5,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + registryColumns
+
+	var updated models.Registry
+	err := r.db.QueryRowxContext(ctx, query,
+		registry.ID,
+		registry.Title,
+		registry.Description,
+		registry.PublicSlug,
+		registry.IsPublic,
+	).StructScan(&updated)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to update registry: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// Delete removes a registry; member links are removed by ON DELETE CASCADE, the wishlists themselves are kept
+func (r *RegistryRepository) Delete(ctx context.Context, id pgtype.UUID) error {
+	query := `DELETE FROM registries WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete registry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrRegistryNotFound
+	}
+
+	return nil
+}
+
+// SetWishlists replaces the member wishlists of a registry; their order in wishlistIDs is the display order
+func (r *RegistryRepository) SetWishlists(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM registry_wishlists WHERE registry_id = $1`, registryID); err != nil {
+		return fmt.Errorf("failed to clear registry wishlists: %w", err)
+	}
+
+	for position, wishlistID := range wishlistIDs {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO registry_wishlists (registry_id, wishlist_id, position) VALUES ($1, $2, $3)`,
+			registryID, wishlistID, position,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add wishlist to registry: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE registries SET updated_at = NOW() WHERE id = $1`, registryID); err != nil {
+		return fmt.Errorf("failed to touch registry: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit registry wishlists: %w", err)
+	}
+
+	return nil
+}
+
+// ListWishlists returns the member wishlists of a registry in display order with their active item counts.
+// With publicOnly, wishlists that are not public are left out.
+func (r *RegistryRepository) ListWishlists(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error) {
+	query := `
+		SELECT
+			w.id AS wishlist_id, w.title, w.description, w.occasion, w.occasion_date,
+			w.is_public, w.public_slug, rw.position,
+			(
+				SELECT COUNT(*)
+				FROM wishlist_items wi
+				JOIN gift_items gi ON gi.id = wi.gift_item_id
+				WHERE wi.wishlist_id = w.id AND gi.archived_at IS NULL
+			) AS item_count
+		FROM registry_wishlists rw
+		JOIN wishlists w ON w.id = rw.wishlist_id
+		WHERE rw.registry_id = $1 AND (NOT $2 OR w.is_public = true)
+		ORDER BY rw.position, rw.added_at
+	`
+
+	var wishlists []*models.RegistryWishlist
+	if err := r.db.Reader().SelectContext(ctx, &wishlists, query, registryID, publicOnly); err != nil {
+		return nil, fmt.Errorf("failed to list registry wishlists: %w", err)
+	}
+
+	return wishlists, nil
+}
+
+// ListPublicItems returns the items of all public member wishlists of a registry with filters and pagination.
+// An item attached to several member wishlists is listed once, under the first of them.
+func (r *RegistryRepository) ListPublicItems(ctx context.Context, registryID pgtype.UUID, filters ItemFilters) ([]*models.RegistryItem, int, error) {
+	sortField, ok := validItemSortFields[filters.Sort]
+	if filters.Sort == "" {
+		sortField, ok = validItemSortFields["position"], true
+	}
+	if !ok {
+		return nil, 0, ErrInvalidSortField
+	}
+
+	order := strings.ToUpper(filters.Order)
+	if order == "" {
+		order = "ASC"
+	}
+	if !validItemSortOrders[order] {
+		return nil, 0, ErrInvalidSortOrder
+	}
+
+	whereConditions := []string{"TRUE"}
+	args := []any{registryID}
+	argIndex := 2
+
+	if filters.WishlistID.Valid {
+		whereConditions = append(whereConditions, fmt.Sprintf("wishlist_id = $%d", argIndex))
+		args = append(args, filters.WishlistID)
+		argIndex++
+	}
+
+	if filters.Search != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("(name ILIKE $%d OR description ILIKE $%d)", argIndex, argIndex))
+		args = append(args, "%"+filters.Search+"%")
+		argIndex++
+	}
+
+	switch filters.Status {
+	case ItemStatusAvailable:
+		whereConditions = append(whereConditions, "NOT is_reserved AND NOT is_purchased")
+	case ItemStatusReserved:
+		whereConditions = append(whereConditions, "is_reserved")
+	case ItemStatusPurchased:
+		whereConditions = append(whereConditions, "is_purchased")
+	}
+
+	if filters.MinPrice != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("price >= $%d", argIndex))
+		args = append(args, *filters.MinPrice)
+		argIndex++
+	}
+
+	if filters.MaxPrice != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("price <= $%d", argIndex))
+		args = append(args, *filters.MaxPrice)
+		argIndex++
+	}
+
+	// Reserved covers registered, guest (reservations table) and manual reservations;
+	// purchased items are not reported as reserved.
+	itemsCTE := `
+		WITH entries AS (
+			SELECT DISTINCT ON (gi.id)
+				gi.id, wi.wishlist_id, gi.name, gi.description, gi.link, gi.image_url,
+				gi.price, gi.priority, gi.priority_level, gi.priority_weight,
+				gi.position, gi.created_at, gi.availability_status,
+				rw.position AS list_position,
+				gi.purchased_at IS NOT NULL AS is_purchased,
+				gi.purchased_at IS NULL AND (
+					gi.reserved_by_user_id IS NOT NULL
+					OR gi.manual_reserved_at IS NOT NULL
+					OR EXISTS (
+						SELECT 1 FROM reservations res
+						WHERE res.gift_item_id = gi.id AND res.status = 'active'
+					)
+				) AS is_reserved
+			FROM registry_wishlists rw
+			JOIN wishlists w ON w.id = rw.wishlist_id AND w.is_public = true
+			JOIN wishlist_items wi ON wi.wishlist_id = rw.wishlist_id
+			JOIN gift_items gi ON gi.id = wi.gift_item_id
+			WHERE rw.registry_id = $1 AND gi.archived_at IS NULL
+			ORDER BY gi.id, rw.position
+		)
+	`
+	whereClause := strings.Join(whereConditions, " AND ")
+
+	countQuery := fmt.Sprintf(`%s SELECT COUNT(*) FROM entries WHERE %s`, itemsCTE, whereClause)
+
+	var totalCount int
+	if err := r.db.Reader().GetContext(ctx, &totalCount, countQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count registry items: %w", err)
+	}
+
+	// Apply the direction to every sort column, then keep a stable order within ties
+	sortColumns := strings.Split(sortField, ", ")
+	for i, column := range sortColumns {
+		sortColumns[i] = fmt.Sprintf("%s %s NULLS LAST", column, order)
+	}
+
+	query := fmt.Sprintf(`%s
+		SELECT id, wishlist_id, name, description, link, image_url, price, priority,
+			priority_level, priority_weight, position, is_reserved, is_purchased, created_at, availability_status
+		FROM entries
+		WHERE %s
+		ORDER BY %s, list_position, position, id
+		LIMIT $%d OFFSET $%d
+	`, itemsCTE, whereClause, strings.Join(sortColumns, ", "), argIndex, argIndex+1)
+
+	args = append(args, filters.Limit, filters.Offset)
+
+	var items []*models.RegistryItem
+	if err := r.db.Reader().SelectContext(ctx, &items, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to get registry items: %w", err)
+	}
+
+	return items, totalCount, nil
+}
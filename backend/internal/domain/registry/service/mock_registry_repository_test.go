@@ -0,0 +1,558 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/registry/models"
+	"wish-list/internal/domain/registry/repository"
+)
+
+// Ensure, that RegistryRepositoryInterfaceMock does implement repository.RegistryRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.RegistryRepositoryInterface = &RegistryRepositoryInterfaceMock{}
+
+// RegistryRepositoryInterfaceMock is a mock implementation of repository.RegistryRepositoryInterface.
+//
+//	func TestSomethingThatUsesRegistryRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.RegistryRepositoryInterface
+//		mockedRegistryRepositoryInterface := &RegistryRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.Registry, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.Registry, error) {
+//				panic("mock out the GetByPublicSlug method")
+//			},
+//			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+//				panic("mock out the IsSlugTaken method")
+//			},
+//			ListByOwnerFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.Registry, error) {
+//				panic("mock out the ListByOwner method")
+//			},
+//			ListPublicItemsFunc: func(ctx context.Context, registryID pgtype.UUID, filters repository.ItemFilters) ([]*models.RegistryItem, int, error) {
+//				panic("mock out the ListPublicItems method")
+//			},
+//			ListWishlistsFunc: func(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error) {
+//				panic("mock out the ListWishlists method")
+//			},
+//			SetWishlistsFunc: func(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error {
+//				panic("mock out the SetWishlists method")
+//			},
+//			UpdateFunc: func(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedRegistryRepositoryInterface in code that requires repository.RegistryRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type RegistryRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, registry models.Registry) (*models.Registry, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*models.Registry, error)
+
+	// GetByPublicSlugFunc mocks the GetByPublicSlug method.
+	GetByPublicSlugFunc func(ctx context.Context, publicSlug string) (*models.Registry, error)
+
+	// IsSlugTakenFunc mocks the IsSlugTaken method.
+	IsSlugTakenFunc func(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error)
+
+	// ListByOwnerFunc mocks the ListByOwner method.
+	ListByOwnerFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.Registry, error)
+
+	// ListPublicItemsFunc mocks the ListPublicItems method.
+	ListPublicItemsFunc func(ctx context.Context, registryID pgtype.UUID, filters repository.ItemFilters) ([]*models.RegistryItem, int, error)
+
+	// ListWishlistsFunc mocks the ListWishlists method.
+	ListWishlistsFunc func(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error)
+
+	// SetWishlistsFunc mocks the SetWishlists method.
+	SetWishlistsFunc func(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, registry models.Registry) (*models.Registry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Registry is the registry argument value.
+			Registry models.Registry
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetByPublicSlug holds details about calls to the GetByPublicSlug method.
+		GetByPublicSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+		// IsSlugTaken holds details about calls to the IsSlugTaken method.
+		IsSlugTaken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Slug is the slug argument value.
+			Slug string
+			// ExcludeID is the excludeID argument value.
+			ExcludeID pgtype.UUID
+		}
+		// ListByOwner holds details about calls to the ListByOwner method.
+		ListByOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// ListPublicItems holds details about calls to the ListPublicItems method.
+		ListPublicItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RegistryID is the registryID argument value.
+			RegistryID pgtype.UUID
+			// Filters is the filters argument value.
+			Filters repository.ItemFilters
+		}
+		// ListWishlists holds details about calls to the ListWishlists method.
+		ListWishlists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RegistryID is the registryID argument value.
+			RegistryID pgtype.UUID
+			// PublicOnly is the publicOnly argument value.
+			PublicOnly bool
+		}
+		// SetWishlists holds details about calls to the SetWishlists method.
+		SetWishlists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RegistryID is the registryID argument value.
+			RegistryID pgtype.UUID
+			// WishlistIDs is the wishlistIDs argument value.
+			WishlistIDs []pgtype.UUID
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Registry is the registry argument value.
+			Registry models.Registry
+		}
+	}
+	lockCreate          sync.RWMutex
+	lockDelete          sync.RWMutex
+	lockGetByID         sync.RWMutex
+	lockGetByPublicSlug sync.RWMutex
+	lockIsSlugTaken     sync.RWMutex
+	lockListByOwner     sync.RWMutex
+	lockListPublicItems sync.RWMutex
+	lockListWishlists   sync.RWMutex
+	lockSetWishlists    sync.RWMutex
+	lockUpdate          sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *RegistryRepositoryInterfaceMock) Create(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+	if mock.CreateFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.CreateFunc: method is nil but RegistryRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Registry models.Registry
+	}{
+		Ctx:      ctx,
+		Registry: registry,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, registry)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.CreateCalls())
+func (mock *RegistryRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx      context.Context
+	Registry models.Registry
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Registry models.Registry
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *RegistryRepositoryInterfaceMock) Delete(ctx context.Context, id pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.DeleteFunc: method is nil but RegistryRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.DeleteCalls())
+func (mock *RegistryRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *RegistryRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*models.Registry, error) {
+	if mock.GetByIDFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.GetByIDFunc: method is nil but RegistryRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.GetByIDCalls())
+func (mock *RegistryRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// GetByPublicSlug calls GetByPublicSlugFunc.
+func (mock *RegistryRepositoryInterfaceMock) GetByPublicSlug(ctx context.Context, publicSlug string) (*models.Registry, error) {
+	if mock.GetByPublicSlugFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.GetByPublicSlugFunc: method is nil but RegistryRepositoryInterface.GetByPublicSlug was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetByPublicSlug.Lock()
+	mock.calls.GetByPublicSlug = append(mock.calls.GetByPublicSlug, callInfo)
+	mock.lockGetByPublicSlug.Unlock()
+	return mock.GetByPublicSlugFunc(ctx, publicSlug)
+}
+
+// GetByPublicSlugCalls gets all the calls that were made to GetByPublicSlug.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.GetByPublicSlugCalls())
+func (mock *RegistryRepositoryInterfaceMock) GetByPublicSlugCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetByPublicSlug.RLock()
+	calls = mock.calls.GetByPublicSlug
+	mock.lockGetByPublicSlug.RUnlock()
+	return calls
+}
+
+// IsSlugTaken calls IsSlugTakenFunc.
+func (mock *RegistryRepositoryInterfaceMock) IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+	if mock.IsSlugTakenFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.IsSlugTakenFunc: method is nil but RegistryRepositoryInterface.IsSlugTaken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Slug      string
+		ExcludeID pgtype.UUID
+	}{
+		Ctx:       ctx,
+		Slug:      slug,
+		ExcludeID: excludeID,
+	}
+	mock.lockIsSlugTaken.Lock()
+	mock.calls.IsSlugTaken = append(mock.calls.IsSlugTaken, callInfo)
+	mock.lockIsSlugTaken.Unlock()
+	return mock.IsSlugTakenFunc(ctx, slug, excludeID)
+}
+
+// IsSlugTakenCalls gets all the calls that were made to IsSlugTaken.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.IsSlugTakenCalls())
+func (mock *RegistryRepositoryInterfaceMock) IsSlugTakenCalls() []struct {
+	Ctx       context.Context
+	Slug      string
+	ExcludeID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Slug      string
+		ExcludeID pgtype.UUID
+	}
+	mock.lockIsSlugTaken.RLock()
+	calls = mock.calls.IsSlugTaken
+	mock.lockIsSlugTaken.RUnlock()
+	return calls
+}
+
+// ListByOwner calls ListByOwnerFunc.
+func (mock *RegistryRepositoryInterfaceMock) ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.Registry, error) {
+	if mock.ListByOwnerFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.ListByOwnerFunc: method is nil but RegistryRepositoryInterface.ListByOwner was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockListByOwner.Lock()
+	mock.calls.ListByOwner = append(mock.calls.ListByOwner, callInfo)
+	mock.lockListByOwner.Unlock()
+	return mock.ListByOwnerFunc(ctx, ownerID)
+}
+
+// ListByOwnerCalls gets all the calls that were made to ListByOwner.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.ListByOwnerCalls())
+func (mock *RegistryRepositoryInterfaceMock) ListByOwnerCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}
+	mock.lockListByOwner.RLock()
+	calls = mock.calls.ListByOwner
+	mock.lockListByOwner.RUnlock()
+	return calls
+}
+
+// ListPublicItems calls ListPublicItemsFunc.
+func (mock *RegistryRepositoryInterfaceMock) ListPublicItems(ctx context.Context, registryID pgtype.UUID, filters repository.ItemFilters) ([]*models.RegistryItem, int, error) {
+	if mock.ListPublicItemsFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.ListPublicItemsFunc: method is nil but RegistryRepositoryInterface.ListPublicItems was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RegistryID pgtype.UUID
+		Filters    repository.ItemFilters
+	}{
+		Ctx:        ctx,
+		RegistryID: registryID,
+		Filters:    filters,
+	}
+	mock.lockListPublicItems.Lock()
+	mock.calls.ListPublicItems = append(mock.calls.ListPublicItems, callInfo)
+	mock.lockListPublicItems.Unlock()
+	return mock.ListPublicItemsFunc(ctx, registryID, filters)
+}
+
+// ListPublicItemsCalls gets all the calls that were made to ListPublicItems.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.ListPublicItemsCalls())
+func (mock *RegistryRepositoryInterfaceMock) ListPublicItemsCalls() []struct {
+	Ctx        context.Context
+	RegistryID pgtype.UUID
+	Filters    repository.ItemFilters
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RegistryID pgtype.UUID
+		Filters    repository.ItemFilters
+	}
+	mock.lockListPublicItems.RLock()
+	calls = mock.calls.ListPublicItems
+	mock.lockListPublicItems.RUnlock()
+	return calls
+}
+
+// ListWishlists calls ListWishlistsFunc.
+func (mock *RegistryRepositoryInterfaceMock) ListWishlists(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error) {
+	if mock.ListWishlistsFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.ListWishlistsFunc: method is nil but RegistryRepositoryInterface.ListWishlists was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RegistryID pgtype.UUID
+		PublicOnly bool
+	}{
+		Ctx:        ctx,
+		RegistryID: registryID,
+		PublicOnly: publicOnly,
+	}
+	mock.lockListWishlists.Lock()
+	mock.calls.ListWishlists = append(mock.calls.ListWishlists, callInfo)
+	mock.lockListWishlists.Unlock()
+	return mock.ListWishlistsFunc(ctx, registryID, publicOnly)
+}
+
+// ListWishlistsCalls gets all the calls that were made to ListWishlists.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.ListWishlistsCalls())
+func (mock *RegistryRepositoryInterfaceMock) ListWishlistsCalls() []struct {
+	Ctx        context.Context
+	RegistryID pgtype.UUID
+	PublicOnly bool
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RegistryID pgtype.UUID
+		PublicOnly bool
+	}
+	mock.lockListWishlists.RLock()
+	calls = mock.calls.ListWishlists
+	mock.lockListWishlists.RUnlock()
+	return calls
+}
+
+// SetWishlists calls SetWishlistsFunc.
+func (mock *RegistryRepositoryInterfaceMock) SetWishlists(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error {
+	if mock.SetWishlistsFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.SetWishlistsFunc: method is nil but RegistryRepositoryInterface.SetWishlists was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		RegistryID  pgtype.UUID
+		WishlistIDs []pgtype.UUID
+	}{
+		Ctx:         ctx,
+		RegistryID:  registryID,
+		WishlistIDs: wishlistIDs,
+	}
+	mock.lockSetWishlists.Lock()
+	mock.calls.SetWishlists = append(mock.calls.SetWishlists, callInfo)
+	mock.lockSetWishlists.Unlock()
+	return mock.SetWishlistsFunc(ctx, registryID, wishlistIDs)
+}
+
+// SetWishlistsCalls gets all the calls that were made to SetWishlists.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.SetWishlistsCalls())
+func (mock *RegistryRepositoryInterfaceMock) SetWishlistsCalls() []struct {
+	Ctx         context.Context
+	RegistryID  pgtype.UUID
+	WishlistIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		RegistryID  pgtype.UUID
+		WishlistIDs []pgtype.UUID
+	}
+	mock.lockSetWishlists.RLock()
+	calls = mock.calls.SetWishlists
+	mock.lockSetWishlists.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *RegistryRepositoryInterfaceMock) Update(ctx context.Context, registry models.Registry) (*models.Registry, error) {
+	if mock.UpdateFunc == nil {
+		panic("RegistryRepositoryInterfaceMock.UpdateFunc: method is nil but RegistryRepositoryInterface.Update was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Registry models.Registry
+	}{
+		Ctx:      ctx,
+		Registry: registry,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, registry)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedRegistryRepositoryInterface.UpdateCalls())
+func (mock *RegistryRepositoryInterfaceMock) UpdateCalls() []struct {
+	Ctx      context.Context
+	Registry models.Registry
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Registry models.Registry
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
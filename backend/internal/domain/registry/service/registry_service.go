@@ -0,0 +1,510 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . WishListRepositoryInterface PlanCheckerInterface
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"wish-list/internal/domain/registry/models"
+	"wish-list/internal/domain/registry/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces - only methods actually used by RegistryService
+
+// WishListRepositoryInterface defines wishlist repository methods used by registry service
+type WishListRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+}
+
+// PlanCheckerInterface defines plan feature checks used by registry service
+type PlanCheckerInterface interface {
+	AllowsCustomSlug(ctx context.Context, userID pgtype.UUID) (bool, error)
+}
+
+// slugPattern accepts only lowercase letters, digits, and hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// MaxRegistryWishlists bounds how many wishlists one registry can combine
+const MaxRegistryWishlists = 20
+
+// maxSlugAttempts bounds retries when a generated slug collides with an existing one
+const maxSlugAttempts = 5
+
+var (
+	ErrInvalidRegistryID     = errors.New("invalid registry id")
+	ErrInvalidWishlistID     = errors.New("invalid wishlist id")
+	ErrRegistryTitleRequired = errors.New("title is required")
+	ErrRegistryNotFound      = errors.New("registry not found")
+	ErrRegistryForbidden     = errors.New("not authorized to access this registry")
+	ErrWishlistNotFound      = errors.New("wishlist not found")
+	ErrWishlistNotOwned      = errors.New("registry wishlists must belong to the registry owner")
+	ErrDuplicateWishlist     = errors.New("a wishlist can only be added to a registry once")
+	ErrTooManyWishlists      = errors.New("too many wishlists in registry")
+	ErrSlugTaken             = errors.New("public slug is already taken by another registry")
+	ErrSlugInvalid           = errors.New("public slug must contain only lowercase letters, digits, and hyphens")
+	ErrInvalidItemFilter     = errors.New("invalid item filter")
+)
+
+// RegistryServiceInterface defines the interface for registry operations
+type RegistryServiceInterface interface {
+	CreateRegistry(ctx context.Context, ownerID pgtype.UUID, input CreateRegistryInput) (*RegistryOutput, error)
+	GetRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID) (*RegistryOutput, error)
+	ListRegistries(ctx context.Context, ownerID pgtype.UUID) ([]*RegistryOutput, error)
+	UpdateRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID, input UpdateRegistryInput) (*RegistryOutput, error)
+	DeleteRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID) error
+	GetPublicRegistry(ctx context.Context, publicSlug string, filters repository.ItemFilters) (*PublicRegistryOutput, error)
+}
+
+type RegistryService struct {
+	repo         repository.RegistryRepositoryInterface
+	wishlistRepo WishListRepositoryInterface
+	plans        PlanCheckerInterface
+}
+
+func NewRegistryService(
+	repo repository.RegistryRepositoryInterface,
+	wishlistRepo WishListRepositoryInterface,
+	planChecker PlanCheckerInterface,
+) *RegistryService {
+	return &RegistryService{
+		repo:         repo,
+		wishlistRepo: wishlistRepo,
+		plans:        planChecker,
+	}
+}
+
+type CreateRegistryInput struct {
+	Title       string
+	Description string
+	PublicSlug  string // Generated from the title when empty
+	IsPublic    bool
+	WishlistIDs []string
+}
+
+type UpdateRegistryInput struct {
+	Title       *string
+	Description *string
+	PublicSlug  *string
+	IsPublic    *bool
+	WishlistIDs []string // nil = no change; empty = remove all wishlists
+}
+
+type RegistryWishlistOutput struct {
+	WishlistID   pgtype.UUID
+	Title        string
+	Description  pgtype.Text
+	Occasion     pgtype.Text
+	OccasionDate pgtype.Date
+	IsPublic     bool
+	PublicSlug   pgtype.Text
+	ItemCount    int64
+}
+
+type RegistryOutput struct {
+	ID          pgtype.UUID
+	OwnerID     pgtype.UUID
+	Title       string
+	Description pgtype.Text
+	PublicSlug  string
+	IsPublic    bool
+	Wishlists   []*RegistryWishlistOutput // nil when member lists were not loaded (registry listing)
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type RegistryItemOutput struct {
+	ID          pgtype.UUID
+	WishlistID  pgtype.UUID
+	Name        string
+	Description pgtype.Text
+	Link        pgtype.Text
+	ImageURL    pgtype.Text
+	Price       *float64
+	Priority    int32
+	Position    int32
+	IsReserved  bool
+
+	PriorityLevel  string
+	PriorityWeight int32
+	IsPurchased    bool
+	CreatedAt      pgtype.Timestamptz
+
+	AvailabilityStatus string
+}
+
+// PublicRegistryOutput is a public registry with its public member wishlists and one page of their combined items
+type PublicRegistryOutput struct {
+	Registry *RegistryOutput
+	Items    []*RegistryItemOutput
+	Total    int
+}
+
+func (s *RegistryService) CreateRegistry(ctx context.Context, ownerID pgtype.UUID, input CreateRegistryInput) (*RegistryOutput, error) {
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		return nil, ErrRegistryTitleRequired
+	}
+
+	wishlistIDs, err := s.validateWishlists(ctx, ownerID, input.WishlistIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	slug, err := s.resolveSlug(ctx, ownerID, input.PublicSlug, title, pgtype.UUID{})
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, models.Registry{
+		OwnerID:     ownerID,
+		Title:       title,
+		Description: pgtype.Text{String: input.Description, Valid: input.Description != ""},
+		PublicSlug:  slug,
+		IsPublic:    input.IsPublic,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry: %w", err)
+	}
+
+	if len(wishlistIDs) > 0 {
+		if err := s.repo.SetWishlists(ctx, created.ID, wishlistIDs); err != nil {
+			return nil, fmt.Errorf("failed to add wishlists to registry: %w", err)
+		}
+	}
+
+	return s.withWishlists(ctx, created, false)
+}
+
+func (s *RegistryService) GetRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID) (*RegistryOutput, error) {
+	registry, err := s.getOwnedRegistry(ctx, registryID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withWishlists(ctx, registry, false)
+}
+
+func (s *RegistryService) ListRegistries(ctx context.Context, ownerID pgtype.UUID) ([]*RegistryOutput, error) {
+	registries, err := s.repo.ListByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registries: %w", err)
+	}
+
+	outputs := make([]*RegistryOutput, 0, len(registries))
+	for _, registry := range registries {
+		outputs = append(outputs, toRegistryOutput(registry))
+	}
+
+	return outputs, nil
+}
+
+func (s *RegistryService) UpdateRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID, input UpdateRegistryInput) (*RegistryOutput, error) {
+	registry, err := s.getOwnedRegistry(ctx, registryID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Title != nil {
+		title := strings.TrimSpace(*input.Title)
+		if title == "" {
+			return nil, ErrRegistryTitleRequired
+		}
+		registry.Title = title
+	}
+	if input.Description != nil {
+		registry.Description = pgtype.Text{String: *input.Description, Valid: *input.Description != ""}
+	}
+	if input.IsPublic != nil {
+		registry.IsPublic = *input.IsPublic
+	}
+	// empty string → keep existing slug (do not clear it)
+	if input.PublicSlug != nil && strings.TrimSpace(*input.PublicSlug) != "" && strings.TrimSpace(*input.PublicSlug) != registry.PublicSlug {
+		slug, err := s.resolveSlug(ctx, ownerID, *input.PublicSlug, registry.Title, registry.ID)
+		if err != nil {
+			return nil, err
+		}
+		registry.PublicSlug = slug
+	}
+
+	var wishlistIDs []pgtype.UUID
+	if input.WishlistIDs != nil {
+		if wishlistIDs, err = s.validateWishlists(ctx, ownerID, input.WishlistIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.repo.Update(ctx, *registry)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistryNotFound) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to update registry: %w", err)
+	}
+
+	if input.WishlistIDs != nil {
+		if err := s.repo.SetWishlists(ctx, updated.ID, wishlistIDs); err != nil {
+			return nil, fmt.Errorf("failed to update registry wishlists: %w", err)
+		}
+	}
+
+	return s.withWishlists(ctx, updated, false)
+}
+
+// DeleteRegistry removes the registry page; its member wishlists are not touched
+func (s *RegistryService) DeleteRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID) error {
+	registry, err := s.getOwnedRegistry(ctx, registryID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, registry.ID); err != nil {
+		if errors.Is(err, repository.ErrRegistryNotFound) {
+			return ErrRegistryNotFound
+		}
+		return fmt.Errorf("failed to delete registry: %w", err)
+	}
+
+	return nil
+}
+
+// GetPublicRegistry returns a public registry with its public member wishlists and their combined,
+// filtered items. Private member wishlists are left out so they stay private.
+func (s *RegistryService) GetPublicRegistry(ctx context.Context, publicSlug string, filters repository.ItemFilters) (*PublicRegistryOutput, error) {
+	switch filters.Status {
+	case "", repository.ItemStatusAvailable, repository.ItemStatusReserved, repository.ItemStatusPurchased:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidItemFilter, filters.Status)
+	}
+	if filters.MinPrice != nil && filters.MaxPrice != nil && *filters.MinPrice > *filters.MaxPrice {
+		return nil, fmt.Errorf("%w: min_price is greater than max_price", ErrInvalidItemFilter)
+	}
+
+	registry, err := s.repo.GetByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistryNotFound) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	output, err := s.withWishlists(ctx, registry, true)
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := s.repo.ListPublicItems(ctx, registry.ID, filters)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSortField) || errors.Is(err, repository.ErrInvalidSortOrder) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidItemFilter, err)
+		}
+		return nil, fmt.Errorf("failed to get registry items: %w", err)
+	}
+
+	itemOutputs := make([]*RegistryItemOutput, 0, len(items))
+	for _, item := range items {
+		itemOutputs = append(itemOutputs, toRegistryItemOutput(item))
+	}
+
+	return &PublicRegistryOutput{
+		Registry: output,
+		Items:    itemOutputs,
+		Total:    total,
+	}, nil
+}
+
+// getOwnedRegistry loads a registry and verifies it belongs to ownerID
+func (s *RegistryService) getOwnedRegistry(ctx context.Context, registryID string, ownerID pgtype.UUID) (*models.Registry, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(registryID); err != nil {
+		return nil, ErrInvalidRegistryID
+	}
+
+	registry, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistryNotFound) {
+			return nil, ErrRegistryNotFound
+		}
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	if registry.OwnerID != ownerID {
+		return nil, ErrRegistryForbidden
+	}
+
+	return registry, nil
+}
+
+// validateWishlists parses the member wishlist IDs and checks they exist, are unique and belong to ownerID
+func (s *RegistryService) validateWishlists(ctx context.Context, ownerID pgtype.UUID, wishlistIDs []string) ([]pgtype.UUID, error) {
+	if len(wishlistIDs) > MaxRegistryWishlists {
+		return nil, ErrTooManyWishlists
+	}
+
+	ids := make([]pgtype.UUID, 0, len(wishlistIDs))
+	seen := make(map[pgtype.UUID]bool, len(wishlistIDs))
+	for _, rawID := range wishlistIDs {
+		id := pgtype.UUID{}
+		if err := id.Scan(rawID); err != nil {
+			return nil, ErrInvalidWishlistID
+		}
+		if seen[id] {
+			return nil, ErrDuplicateWishlist
+		}
+		seen[id] = true
+
+		wishList, err := s.wishlistRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+				return nil, ErrWishlistNotFound
+			}
+			return nil, fmt.Errorf("failed to get wishlist: %w", err)
+		}
+		if wishList.OwnerID != ownerID {
+			return nil, ErrWishlistNotOwned
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// resolveSlug validates a custom slug, or generates one from the title when customSlug is empty.
+// Custom slugs are kept as chosen on premium; other plans get a random suffix.
+func (s *RegistryService) resolveSlug(ctx context.Context, ownerID pgtype.UUID, customSlug, title string, excludeID pgtype.UUID) (string, error) {
+	customSlug = strings.TrimSpace(customSlug)
+	if customSlug != "" {
+		if !slugPattern.MatchString(customSlug) {
+			return "", ErrSlugInvalid
+		}
+
+		if s.plans != nil {
+			allowed, err := s.plans.AllowsCustomSlug(ctx, ownerID)
+			if err != nil {
+				return "", fmt.Errorf("failed to check plan: %w", err)
+			}
+			if !allowed {
+				customSlug = generateRegistrySlug(customSlug)
+			}
+		}
+
+		taken, err := s.repo.IsSlugTaken(ctx, customSlug, excludeID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug uniqueness: %w", err)
+		}
+		if taken {
+			return "", ErrSlugTaken
+		}
+
+		return customSlug, nil
+	}
+
+	for range maxSlugAttempts {
+		slug := generateRegistrySlug(title)
+
+		taken, err := s.repo.IsSlugTaken(ctx, slug, excludeID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug uniqueness: %w", err)
+		}
+		if !taken {
+			return slug, nil
+		}
+	}
+
+	return "", ErrSlugTaken
+}
+
+// withWishlists converts the registry and loads its member wishlists
+func (s *RegistryService) withWishlists(ctx context.Context, registry *models.Registry, publicOnly bool) (*RegistryOutput, error) {
+	wishlists, err := s.repo.ListWishlists(ctx, registry.ID, publicOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry wishlists: %w", err)
+	}
+
+	output := toRegistryOutput(registry)
+	output.Wishlists = make([]*RegistryWishlistOutput, 0, len(wishlists))
+	for _, w := range wishlists {
+		output.Wishlists = append(output.Wishlists, &RegistryWishlistOutput{
+			WishlistID:   w.WishlistID,
+			Title:        w.Title,
+			Description:  w.Description,
+			Occasion:     w.Occasion,
+			OccasionDate: w.OccasionDate,
+			IsPublic:     w.IsPublic.Bool,
+			PublicSlug:   w.PublicSlug,
+			ItemCount:    w.ItemCount,
+		})
+	}
+
+	return output, nil
+}
+
+func toRegistryOutput(registry *models.Registry) *RegistryOutput {
+	return &RegistryOutput{
+		ID:          registry.ID,
+		OwnerID:     registry.OwnerID,
+		Title:       registry.Title,
+		Description: registry.Description,
+		PublicSlug:  registry.PublicSlug,
+		IsPublic:    registry.IsPublic,
+		CreatedAt:   registry.CreatedAt,
+		UpdatedAt:   registry.UpdatedAt,
+	}
+}
+
+func toRegistryItemOutput(item *models.RegistryItem) *RegistryItemOutput {
+	output := &RegistryItemOutput{
+		ID:          item.ID,
+		WishlistID:  item.WishlistID,
+		Name:        item.Name,
+		Description: item.Description,
+		Link:        item.Link,
+		ImageURL:    item.ImageURL,
+		Priority:    item.Priority.Int32,
+		Position:    item.Position.Int32,
+		IsReserved:  item.IsReserved,
+		IsPurchased: item.IsPurchased,
+		CreatedAt:   item.CreatedAt,
+
+		PriorityLevel:  item.PriorityLevel,
+		PriorityWeight: item.PriorityWeight,
+
+		AvailabilityStatus: item.AvailabilityStatus,
+	}
+
+	if item.Price.Valid {
+		if value, err := item.Price.Float64Value(); err == nil && value.Valid {
+			output.Price = &value.Float64
+		}
+	}
+
+	return output
+}
+
+// generateRegistrySlug builds a slug from the title with a random numeric suffix
+func generateRegistrySlug(title string) string {
+	var sb strings.Builder
+	sb.Grow(len(title))
+	for _, r := range strings.ToLower(strings.ReplaceAll(title, " ", "-")) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			sb.WriteRune(r)
+		}
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(10000))
+	if err != nil {
+		return sb.String() + "-0000"
+	}
+
+	return fmt.Sprintf("%s-%04d", sb.String(), n.Int64())
+}
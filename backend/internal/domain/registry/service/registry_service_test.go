@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"wish-list/internal/domain/registry/models"
+	"wish-list/internal/domain/registry/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testRegistryID  = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testOwnerID     = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testOtherUserID = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+	testWishlistA   = pgtype.UUID{Bytes: [16]byte{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, Valid: true}
+	testWishlistB   = pgtype.UUID{Bytes: [16]byte{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, Valid: true}
+)
+
+type registryMocks struct {
+	repo      *RegistryRepositoryInterfaceMock
+	wishLists *WishListRepositoryInterfaceMock
+	plans     *PlanCheckerInterfaceMock
+}
+
+func newRegistryMocks() *registryMocks {
+	registry := &models.Registry{ID: testRegistryID, OwnerID: testOwnerID, Title: "Our wedding", PublicSlug: "our-wedding", IsPublic: true}
+	return &registryMocks{
+		repo: &RegistryRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, r models.Registry) (*models.Registry, error) {
+				r.ID = testRegistryID
+				return &r, nil
+			},
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.Registry, error) {
+				return registry, nil
+			},
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.Registry, error) {
+				return registry, nil
+			},
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			UpdateFunc: func(ctx context.Context, r models.Registry) (*models.Registry, error) {
+				return &r, nil
+			},
+			DeleteFunc: func(ctx context.Context, id pgtype.UUID) error {
+				return nil
+			},
+			SetWishlistsFunc: func(ctx context.Context, registryID pgtype.UUID, wishlistIDs []pgtype.UUID) error {
+				return nil
+			},
+			ListWishlistsFunc: func(ctx context.Context, registryID pgtype.UUID, publicOnly bool) ([]*models.RegistryWishlist, error) {
+				return []*models.RegistryWishlist{
+					{WishlistID: testWishlistA, Title: "Home", IsPublic: pgtype.Bool{Bool: true, Valid: true}},
+				}, nil
+			},
+			ListPublicItemsFunc: func(ctx context.Context, registryID pgtype.UUID, filters repository.ItemFilters) ([]*models.RegistryItem, int, error) {
+				return []*models.RegistryItem{{WishlistID: testWishlistA, Name: "Toaster"}}, 1, nil
+			},
+		},
+		wishLists: &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+				return &wishlistmodels.WishList{ID: id, OwnerID: testOwnerID}, nil
+			},
+		},
+		plans: &PlanCheckerInterfaceMock{
+			AllowsCustomSlugFunc: func(ctx context.Context, userID pgtype.UUID) (bool, error) {
+				return true, nil
+			},
+		},
+	}
+}
+
+func (m *registryMocks) service() *RegistryService {
+	return NewRegistryService(m.repo, m.wishLists, m.plans)
+}
+
+func TestRegistryService_CreateRegistry(t *testing.T) {
+	t.Run("creates registry with member wishlists in order", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		output, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{
+			Title:       "  Our wedding ",
+			PublicSlug:  "our-wedding",
+			IsPublic:    true,
+			WishlistIDs: []string{testWishlistB.String(), testWishlistA.String()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Our wedding", output.Title)
+		assert.Equal(t, "our-wedding", output.PublicSlug)
+		assert.Len(t, output.Wishlists, 1)
+		require.Len(t, m.repo.SetWishlistsCalls(), 1)
+		assert.Equal(t, []pgtype.UUID{testWishlistB, testWishlistA}, m.repo.SetWishlistsCalls()[0].WishlistIDs)
+		assert.False(t, m.repo.ListWishlistsCalls()[0].PublicOnly)
+	})
+
+	t.Run("generates slug from title when none given", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		output, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{Title: "Our Wedding!"})
+
+		require.NoError(t, err)
+		assert.Regexp(t, `^our-wedding-\d{4}$`, output.PublicSlug)
+		assert.Empty(t, m.repo.SetWishlistsCalls())
+	})
+
+	t.Run("custom slug gets a suffix below premium", func(t *testing.T) {
+		m := newRegistryMocks()
+		m.plans.AllowsCustomSlugFunc = func(ctx context.Context, userID pgtype.UUID) (bool, error) {
+			return false, nil
+		}
+
+		output, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{Title: "Our wedding", PublicSlug: "our-wedding"})
+
+		require.NoError(t, err)
+		assert.Regexp(t, `^our-wedding-\d{4}$`, output.PublicSlug)
+		assert.Equal(t, testOwnerID, m.plans.AllowsCustomSlugCalls()[0].UserID)
+	})
+
+	t.Run("rejects blank title", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		_, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{Title: "   "})
+
+		assert.ErrorIs(t, err, ErrRegistryTitleRequired)
+		assert.Empty(t, m.repo.CreateCalls())
+	})
+
+	t.Run("rejects wishlists owned by someone else", func(t *testing.T) {
+		m := newRegistryMocks()
+		m.wishLists.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return &wishlistmodels.WishList{ID: id, OwnerID: testOtherUserID}, nil
+		}
+
+		_, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{
+			Title:       "Our wedding",
+			WishlistIDs: []string{testWishlistA.String()},
+		})
+
+		assert.ErrorIs(t, err, ErrWishlistNotOwned)
+		assert.Empty(t, m.repo.CreateCalls())
+	})
+
+	t.Run("rejects missing wishlist", func(t *testing.T) {
+		m := newRegistryMocks()
+		m.wishLists.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return nil, wishlistrepo.ErrWishListNotFound
+		}
+
+		_, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{
+			Title:       "Our wedding",
+			WishlistIDs: []string{testWishlistA.String()},
+		})
+
+		assert.ErrorIs(t, err, ErrWishlistNotFound)
+	})
+
+	t.Run("rejects duplicate wishlists", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		_, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{
+			Title:       "Our wedding",
+			WishlistIDs: []string{testWishlistA.String(), testWishlistA.String()},
+		})
+
+		assert.ErrorIs(t, err, ErrDuplicateWishlist)
+	})
+
+	t.Run("rejects invalid or taken slug", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		_, err := m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{Title: "Our wedding", PublicSlug: "Our Wedding"})
+		assert.ErrorIs(t, err, ErrSlugInvalid)
+
+		m.repo.IsSlugTakenFunc = func(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+			return true, nil
+		}
+		_, err = m.service().CreateRegistry(context.Background(), testOwnerID, CreateRegistryInput{Title: "Our wedding", PublicSlug: "our-wedding"})
+		assert.ErrorIs(t, err, ErrSlugTaken)
+		assert.Empty(t, m.repo.CreateCalls())
+	})
+}
+
+func TestRegistryService_UpdateRegistry(t *testing.T) {
+	t.Run("replaces member wishlists only when given", func(t *testing.T) {
+		m := newRegistryMocks()
+		title := "Honeymoon"
+
+		output, err := m.service().UpdateRegistry(context.Background(), testRegistryID.String(), testOwnerID, UpdateRegistryInput{Title: &title})
+		require.NoError(t, err)
+		assert.Equal(t, "Honeymoon", output.Title)
+		assert.Empty(t, m.repo.SetWishlistsCalls())
+
+		_, err = m.service().UpdateRegistry(context.Background(), testRegistryID.String(), testOwnerID, UpdateRegistryInput{WishlistIDs: []string{}})
+		require.NoError(t, err)
+		require.Len(t, m.repo.SetWishlistsCalls(), 1)
+		assert.Empty(t, m.repo.SetWishlistsCalls()[0].WishlistIDs)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		_, err := m.service().UpdateRegistry(context.Background(), testRegistryID.String(), testOtherUserID, UpdateRegistryInput{})
+
+		assert.ErrorIs(t, err, ErrRegistryForbidden)
+		assert.Empty(t, m.repo.UpdateCalls())
+	})
+
+	t.Run("maps missing registry", func(t *testing.T) {
+		m := newRegistryMocks()
+		m.repo.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*models.Registry, error) {
+			return nil, repository.ErrRegistryNotFound
+		}
+
+		_, err := m.service().UpdateRegistry(context.Background(), testRegistryID.String(), testOwnerID, UpdateRegistryInput{})
+
+		assert.ErrorIs(t, err, ErrRegistryNotFound)
+	})
+}
+
+func TestRegistryService_DeleteRegistry(t *testing.T) {
+	t.Run("deletes own registry", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		err := m.service().DeleteRegistry(context.Background(), testRegistryID.String(), testOwnerID)
+
+		require.NoError(t, err)
+		assert.Len(t, m.repo.DeleteCalls(), 1)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		err := m.service().DeleteRegistry(context.Background(), testRegistryID.String(), testOtherUserID)
+
+		assert.ErrorIs(t, err, ErrRegistryForbidden)
+		assert.Empty(t, m.repo.DeleteCalls())
+	})
+
+	t.Run("rejects invalid id", func(t *testing.T) {
+		m := newRegistryMocks()
+
+		err := m.service().DeleteRegistry(context.Background(), "not-a-uuid", testOwnerID)
+
+		assert.ErrorIs(t, err, ErrInvalidRegistryID)
+	})
+}
+
+func TestRegistryService_GetPublicRegistry(t *testing.T) {
+	t.Run("returns public wishlists and combined items", func(t *testing.T) {
+		m := newRegistryMocks()
+		filters := repository.ItemFilters{Status: repository.ItemStatusAvailable, Limit: 10}
+
+		output, err := m.service().GetPublicRegistry(context.Background(), "our-wedding", filters)
+
+		require.NoError(t, err)
+		assert.Len(t, output.Registry.Wishlists, 1)
+		assert.Len(t, output.Items, 1)
+		assert.Equal(t, 1, output.Total)
+		assert.True(t, m.repo.ListWishlistsCalls()[0].PublicOnly)
+		assert.Equal(t, filters, m.repo.ListPublicItemsCalls()[0].Filters)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		m := newRegistryMocks()
+		minPrice, maxPrice := 50.0, 10.0
+
+		_, err := m.service().GetPublicRegistry(context.Background(), "our-wedding", repository.ItemFilters{Status: "lost"})
+		assert.ErrorIs(t, err, ErrInvalidItemFilter)
+
+		_, err = m.service().GetPublicRegistry(context.Background(), "our-wedding", repository.ItemFilters{MinPrice: &minPrice, MaxPrice: &maxPrice})
+		assert.ErrorIs(t, err, ErrInvalidItemFilter)
+
+		m.repo.ListPublicItemsFunc = func(ctx context.Context, registryID pgtype.UUID, filters repository.ItemFilters) ([]*models.RegistryItem, int, error) {
+			return nil, 0, repository.ErrInvalidSortField
+		}
+		_, err = m.service().GetPublicRegistry(context.Background(), "our-wedding", repository.ItemFilters{Sort: "color"})
+		assert.ErrorIs(t, err, ErrInvalidItemFilter)
+	})
+
+	t.Run("maps missing registry", func(t *testing.T) {
+		m := newRegistryMocks()
+		m.repo.GetByPublicSlugFunc = func(ctx context.Context, publicSlug string) (*models.Registry, error) {
+			return nil, repository.ErrRegistryNotFound
+		}
+
+		_, err := m.service().GetPublicRegistry(context.Background(), "missing", repository.ItemFilters{})
+
+		assert.ErrorIs(t, err, ErrRegistryNotFound)
+	})
+}
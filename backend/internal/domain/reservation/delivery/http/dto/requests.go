@@ -1,26 +1,74 @@
 package dto
 
 import (
+	"time"
+
 	"wish-list/internal/domain/reservation/service"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type CreateReservationRequest struct {
-	GuestName  *string `json:"guest_name" validate:"omitempty,max=200"`
-	GuestEmail *string `json:"guest_email" validate:"omitempty,email"`
+	GuestName  *string        `json:"guest_name" validate:"omitempty,max=200"`
+	GuestEmail *string        `json:"guest_email" validate:"omitempty,email"`
+	Quantity   int32          `json:"quantity" validate:"omitempty,min=1"`  // Units to reserve, defaults to 1
+	Variant    variant.Choice `json:"variant"`                              // Size, color and model being bought, from the item's options
+	Message    *string        `json:"message" validate:"omitempty,max=500"` // Note to the owner, hidden from them until the occasion
+	Website    string         `json:"website"`                              // Honeypot: hidden from people, so only bots fill it in. Leave empty.
+	ViewedAt   *time.Time     `json:"viewed_at"`                            // When the giver opened the shared list, for conversion metrics
 }
 
-func (r *CreateReservationRequest) ToServiceInput(wishListID, giftItemID string, userID pgtype.UUID) service.CreateReservationInput {
+func (r *CreateReservationRequest) ToServiceInput(wishListID, giftItemID string, userID pgtype.UUID, clientIP string) service.CreateReservationInput {
 	return service.CreateReservationInput{
+		ClientIP:   clientIP,
 		WishListID: wishListID,
 		GiftItemID: giftItemID,
 		UserID:     userID,
 		GuestName:  r.GuestName,
 		GuestEmail: r.GuestEmail,
+		Quantity:   r.Quantity,
+		Variant:    r.Variant,
+		Message:    r.Message,
+		ViewedAt:   r.ViewedAt,
 	}
 }
 
 type CancelReservationRequest struct {
 	ReservationToken *string `json:"reservation_token" validate:"omitempty,uuid"`
+	Reason           string  `json:"reason" validate:"omitempty,oneof=changed_mind bought_elsewhere too_expensive other" enums:"changed_mind,bought_elsewhere,too_expensive,other" example:"bought_elsewhere"` // Optional, why the giver is canceling
+}
+
+type ConfirmReservationRequest struct {
+	ConfirmationToken string `json:"confirmation_token" validate:"required,uuid"`
+}
+
+// ConfirmPurchaseRequest optionally records what the guest paid; the body may be empty
+type ConfirmPurchaseRequest struct {
+	PurchasedPrice *float64 `json:"purchased_price,omitempty" validate:"omitempty,gte=0" example:"249.50"`
+}
+
+type ClaimReservationRequest struct {
+	ReservationToken string `json:"reservation_token" validate:"required,uuid"`
+}
+
+type TransferReservationRequest struct {
+	RecipientEmail string `json:"recipient_email" validate:"required,email"`
+}
+
+type SetBudgetRequest struct {
+	Period     string  `json:"period" validate:"required,oneof=monthly occasion"`
+	WishlistID *string `json:"wishlist_id" validate:"required_if=Period occasion,omitempty,uuid"`
+	Amount     float64 `json:"amount" validate:"required,gt=0"`
+}
+
+func (r *SetBudgetRequest) ToServiceInput() service.SetBudgetInput {
+	input := service.SetBudgetInput{
+		Period: r.Period,
+		Amount: r.Amount,
+	}
+	if r.WishlistID != nil {
+		input.WishlistID = *r.WishlistID
+	}
+	return input
 }
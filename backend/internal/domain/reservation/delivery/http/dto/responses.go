@@ -5,21 +5,25 @@ import (
 
 	"wish-list/internal/domain/reservation/repository"
 	"wish-list/internal/domain/reservation/service"
+	"wish-list/internal/pkg/variant"
 )
 
 type CreateReservationResponse struct {
-	ID               string  `json:"id" validate:"required"`
-	GiftItemID       string  `json:"gift_item_id" validate:"required"`
-	ReservedByUserID *string `json:"reserved_by_user_id"`
-	GuestName        *string `json:"guest_name"`
-	GuestEmail       *string `json:"guest_email" validate:"email"`
-	ReservationToken string  `json:"reservation_token" validate:"required"`
-	Status           string  `json:"status" validate:"required"`
-	ReservedAt       string  `json:"reserved_at" validate:"required"`
-	ExpiresAt        *string `json:"expires_at"`
-	CanceledAt       *string `json:"canceled_at"`
-	CanceledReason   *string `json:"cancel_reason"`
-	NotificationSent bool    `json:"notification_sent" validate:"required"`
+	ID               string          `json:"id" validate:"required"`
+	GiftItemID       string          `json:"gift_item_id" validate:"required"`
+	ReservedByUserID *string         `json:"reserved_by_user_id"`
+	GuestName        *string         `json:"guest_name"`
+	GuestEmail       *string         `json:"guest_email" validate:"email"`
+	ReservationToken string          `json:"reservation_token" validate:"required"`
+	Status           string          `json:"status" validate:"required"`
+	Quantity         int32           `json:"quantity" validate:"required"`
+	Variant          *variant.Choice `json:"variant,omitempty"`
+	Message          *string         `json:"message"`
+	ReservedAt       string          `json:"reserved_at" validate:"required"`
+	ExpiresAt        *string         `json:"expires_at"`
+	CanceledAt       *string         `json:"canceled_at"`
+	CanceledReason   *string         `json:"cancel_reason"`
+	NotificationSent bool            `json:"notification_sent" validate:"required"`
 }
 
 func FromReservationOutput(r *service.ReservationOutput) *CreateReservationResponse {
@@ -32,12 +36,18 @@ func FromReservationOutput(r *service.ReservationOutput) *CreateReservationRespo
 		GiftItemID:       r.GiftItemID.String(),
 		GuestName:        r.GuestName,
 		GuestEmail:       r.GuestEmail,
+		Message:          r.Message,
 		ReservationToken: r.ReservationToken.String(),
 		Status:           r.Status,
+		Quantity:         max(r.Quantity, 1),
 		ReservedAt:       r.ReservedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 		NotificationSent: r.NotificationSent.Bool,
 	}
 
+	if !r.Variant.IsEmpty() {
+		resp.Variant = &r.Variant
+	}
+
 	if r.ReservedByUserID.Valid {
 		userIDStr := r.ReservedByUserID.String()
 		resp.ReservedByUserID = &userIDStr
@@ -80,6 +90,9 @@ type ReservationDetailsResponse struct {
 	GiftItem   GiftItemSummary `json:"gift_item" validate:"required"`
 	Wishlist   WishListSummary `json:"wishlist" validate:"required"`
 	Status     string          `json:"status" validate:"required"`
+	Quantity   int32           `json:"quantity" validate:"required"`
+	Variant    *variant.Choice `json:"variant,omitempty"`
+	Message    *string         `json:"message"`
 	ReservedAt string          `json:"reserved_at" validate:"required"`
 	ExpiresAt  *string         `json:"expires_at"`
 }
@@ -116,6 +129,7 @@ func FromReservationDetail(res repository.ReservationDetail) ReservationDetailsR
 		GiftItem:   itemSummary,
 		Wishlist:   listSummary,
 		Status:     res.Status,
+		Quantity:   max(res.Quantity, 1),
 		ReservedAt: res.ReservedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
@@ -124,6 +138,14 @@ func FromReservationDetail(res repository.ReservationDetail) ReservationDetailsR
 		detail.ExpiresAt = &expiresAtStr
 	}
 
+	if !res.Variant.IsEmpty() {
+		detail.Variant = &res.Variant
+	}
+
+	if res.Message.Valid {
+		detail.Message = &res.Message.String
+	}
+
 	return detail
 }
 
@@ -136,10 +158,13 @@ func FromReservationDetails(details []repository.ReservationDetail) []Reservatio
 }
 
 type ReservationStatusResponse struct {
-	IsReserved     bool    `json:"is_reserved" validate:"required"`
-	ReservedByName *string `json:"reserved_by_name"`
-	ReservedAt     *string `json:"reserved_at"`
-	Status         string  `json:"status" validate:"required"`
+	IsReserved        bool    `json:"is_reserved" validate:"required"`
+	ReservedByName    *string `json:"reserved_by_name"`
+	ReservedAt        *string `json:"reserved_at"`
+	Status            string  `json:"status" validate:"required"`
+	ReservationStatus string  `json:"reservation_status" validate:"required" enums:"available,partially_reserved,fully_reserved" example:"partially_reserved"`
+	Quantity          int32   `json:"quantity" validate:"required" example:"3"`
+	RemainingQuantity int32   `json:"remaining_quantity" validate:"required" example:"2"`
 }
 
 func FromReservationStatusOutput(s *service.ReservationStatusOutput) *ReservationStatusResponse {
@@ -148,9 +173,12 @@ func FromReservationStatusOutput(s *service.ReservationStatusOutput) *Reservatio
 	}
 
 	resp := &ReservationStatusResponse{
-		IsReserved:     s.IsReserved,
-		ReservedByName: s.ReservedByName,
-		Status:         s.Status,
+		IsReserved:        s.IsReserved,
+		ReservedByName:    s.ReservedByName,
+		Status:            s.Status,
+		ReservationStatus: s.ReservationStatus,
+		Quantity:          s.Quantity,
+		RemainingQuantity: s.RemainingQuantity,
 	}
 
 	if s.ReservedAt != nil {
@@ -161,7 +189,178 @@ func FromReservationStatusOutput(s *service.ReservationStatusOutput) *Reservatio
 	return resp
 }
 
+// ItemReservationStatusResponse is the reservation status of one item of a public wishlist
+type ItemReservationStatusResponse struct {
+	GiftItemID string `json:"gift_item_id" validate:"required"`
+	ReservationStatusResponse
+}
+
+// ReservationStatusesResponse lists the reservation status of every item of a public wishlist
+type ReservationStatusesResponse struct {
+	Items []ItemReservationStatusResponse `json:"items" validate:"required"`
+}
+
+func FromItemReservationStatusOutputs(statuses []*service.ItemReservationStatusOutput) *ReservationStatusesResponse {
+	resp := &ReservationStatusesResponse{
+		Items: make([]ItemReservationStatusResponse, 0, len(statuses)),
+	}
+	for _, s := range statuses {
+		resp.Items = append(resp.Items, ItemReservationStatusResponse{
+			GiftItemID:                s.GiftItemID,
+			ReservationStatusResponse: *FromReservationStatusOutput(&s.ReservationStatusOutput),
+		})
+	}
+	return resp
+}
+
 type UserReservationsResponse struct {
 	Data       []ReservationDetailsResponse `json:"data" validate:"required"`
 	Pagination any                          `json:"pagination" validate:"required"`
+	Budgets    []BudgetResponse             `json:"budgets" validate:"required"`
+}
+
+// BudgetResponse is a giver budget with the running spend against it
+type BudgetResponse struct {
+	ID            string   `json:"id" validate:"required"`
+	Period        string   `json:"period" validate:"required" enums:"monthly,occasion"`
+	WishlistID    *string  `json:"wishlist_id"`
+	WishlistTitle *string  `json:"wishlist_title"`
+	PeriodStart   *string  `json:"period_start"`
+	PeriodEnd     *string  `json:"period_end"`
+	Amount        float64  `json:"amount" validate:"required"`
+	Spent         float64  `json:"spent" validate:"required"`
+	Reserved      float64  `json:"reserved" validate:"required"`
+	Remaining     float64  `json:"remaining" validate:"required"`
+	Warnings      []string `json:"warnings" validate:"required" enums:"near_limit,exceeded,reservations_exceed_budget"`
+}
+
+func FromBudgetOutput(b *service.BudgetOutput) BudgetResponse {
+	resp := BudgetResponse{
+		ID:            b.ID.String(),
+		Period:        b.Period,
+		WishlistTitle: b.WishlistTitle,
+		Amount:        b.Amount,
+		Spent:         b.Spent,
+		Reserved:      b.Reserved,
+		Remaining:     b.Remaining,
+		Warnings:      b.Warnings,
+	}
+
+	if b.WishlistID.Valid {
+		wishlistID := b.WishlistID.String()
+		resp.WishlistID = &wishlistID
+	}
+
+	if b.PeriodStart != nil {
+		periodStart := b.PeriodStart.Format("2006-01-02T15:04:05Z07:00")
+		resp.PeriodStart = &periodStart
+	}
+
+	if b.PeriodEnd != nil {
+		periodEnd := b.PeriodEnd.Format("2006-01-02T15:04:05Z07:00")
+		resp.PeriodEnd = &periodEnd
+	}
+
+	return resp
+}
+
+func FromBudgetOutputs(budgets []*service.BudgetOutput) []BudgetResponse {
+	responses := make([]BudgetResponse, 0, len(budgets))
+	for _, b := range budgets {
+		responses = append(responses, FromBudgetOutput(b))
+	}
+	return responses
+}
+
+// ReservationEventResponse is one step in a reservation's timeline
+type ReservationEventResponse struct {
+	EventType  string `json:"event_type" validate:"required" enums:"created,canceled,expired,fulfilled,claimed,transferred"`
+	Status     string `json:"status" validate:"required"`
+	Reason     string `json:"reason,omitempty" enums:"changed_mind,bought_elsewhere,too_expensive,other"` // Why it was canceled, when given
+	OccurredAt string `json:"occurred_at" validate:"required"`
+}
+
+// ReservationTimelineResponse is a reservation's history, oldest first
+type ReservationTimelineResponse struct {
+	Events []ReservationEventResponse `json:"events" validate:"required"`
+}
+
+func FromReservationEventOutputs(events []*service.ReservationEventOutput) *ReservationTimelineResponse {
+	resp := &ReservationTimelineResponse{
+		Events: make([]ReservationEventResponse, 0, len(events)),
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, ReservationEventResponse{
+			EventType:  e.EventType,
+			Status:     e.Status,
+			Reason:     e.Reason,
+			OccurredAt: e.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+	return resp
+}
+
+// ConfirmationRequiredResponse tells a guest that their reservation is held until
+// they confirm it with the code emailed to them
+type ConfirmationRequiredResponse struct {
+	ConfirmationRequired bool   `json:"confirmation_required" validate:"required"`
+	Message              string `json:"message" validate:"required"`
+}
+
+// ClaimGuestReservationsResponse reports how many guest reservations were linked
+type ClaimGuestReservationsResponse struct {
+	Linked int `json:"linked"`
+}
+
+// GuestLinkFailureResponse is a guest reservation linking job that ran out of retries
+type GuestLinkFailureResponse struct {
+	ID        string `json:"id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+	Attempts  int    `json:"attempts" validate:"required"`
+	LastError string `json:"last_error"`
+	FailedAt  string `json:"failed_at" validate:"required"`
+	CreatedAt string `json:"created_at" validate:"required"`
+}
+
+type GuestLinkFailuresResponse struct {
+	Data       []GuestLinkFailureResponse `json:"data" validate:"required"`
+	Pagination any                        `json:"pagination" validate:"required"`
+}
+
+func FromGuestLinkFailureOutputs(failures []*service.GuestLinkFailureOutput) []GuestLinkFailureResponse {
+	responses := make([]GuestLinkFailureResponse, 0, len(failures))
+	for _, f := range failures {
+		responses = append(responses, GuestLinkFailureResponse{
+			ID:        f.ID.String(),
+			UserID:    f.UserID.String(),
+			Attempts:  f.Attempts,
+			LastError: f.LastError,
+			FailedAt:  f.FailedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CreatedAt: f.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+	return responses
+}
+
+// GuestPurchaseResponse describes the reservation behind a purchase link
+type GuestPurchaseResponse struct {
+	ReservationID string  `json:"reservation_id" validate:"required"`
+	GiftItemName  string  `json:"gift_item_name" validate:"required"`
+	WishlistTitle string  `json:"wishlist_title" validate:"required"`
+	Purchased     bool    `json:"purchased" validate:"required"`
+	PurchasedAt   *string `json:"purchased_at,omitempty"`
+}
+
+func FromGuestPurchaseOutput(p *service.GuestPurchaseOutput) GuestPurchaseResponse {
+	response := GuestPurchaseResponse{
+		ReservationID: p.ReservationID,
+		GiftItemName:  p.GiftItemName,
+		WishlistTitle: p.WishlistTitle,
+		Purchased:     p.Purchased,
+	}
+	if p.Purchased {
+		purchasedAt := p.PurchasedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.PurchasedAt = &purchasedAt
+	}
+	return response
 }
@@ -2,9 +2,11 @@ package http
 
 import (
 	"errors"
+	"strconv"
 
 	"wish-list/internal/domain/reservation/service"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/emailcheck"
 )
 
 // mapReservationServiceError converts reservation service errors to AppErrors
@@ -18,14 +20,80 @@ func mapReservationServiceError(err error) error {
 		return apperrors.NotFound("Gift item not found in wishlist")
 	case errors.Is(err, service.ErrGiftItemNotInPublicWishlist):
 		return apperrors.NotFound("Gift item not found in public wishlist")
+	case errors.Is(err, service.ErrPublicWishlistNotFound):
+		return apperrors.NotFound("Wish list not found")
 	case errors.Is(err, service.ErrGiftItemAlreadyReserved):
 		return apperrors.Conflict("Gift item is already reserved")
+	case errors.Is(err, service.ErrNotEnoughQuantity):
+		appErr := apperrors.Conflict("Not enough units left to reserve")
+		var unavailable *service.QuantityUnavailableError
+		if errors.As(err, &unavailable) {
+			appErr = appErr.WithDetails(map[string]string{"remaining": strconv.Itoa(int(unavailable.Remaining))})
+		}
+		return appErr
+	case errors.Is(err, service.ErrInvalidReservationQuantity):
+		return apperrors.BadRequest("Quantity must be between 1 and the number of units wanted")
+	case errors.Is(err, service.ErrInvalidVariant):
+		// The message names the option and its allowed values
+		return apperrors.BadRequest(err.Error())
 	case errors.Is(err, service.ErrGuestInfoRequired):
 		return apperrors.BadRequest("Guest name is required")
+	case errors.Is(err, service.ErrGuestEmailRejected):
+		return apperrors.UnprocessableEntity(emailcheck.Describe(err))
+	case errors.Is(err, service.ErrGuestReservationLimit):
+		appErr := apperrors.TooManyRequests("Too many reservations. Please try again later.")
+		var limitErr *service.GuestLimitError
+		if errors.As(err, &limitErr) {
+			appErr = appErr.WithDetails(map[string]string{
+				"limit":               limitErr.Subject,
+				"retry_after_seconds": strconv.Itoa(int(limitErr.RetryAfter.Seconds())),
+			})
+		}
+		return appErr
+	case errors.Is(err, service.ErrGuestEmailRequired):
+		return apperrors.UnprocessableEntity("An email address is required to confirm this reservation")
+	case errors.Is(err, service.ErrInvalidConfirmationToken):
+		return apperrors.NotFound("Confirmation code is invalid or has expired")
+	case errors.Is(err, service.ErrInvalidPurchaseLink):
+		return apperrors.NotFound("This link is invalid or has expired")
+	case errors.Is(err, service.ErrPurchaseLinkCanceled):
+		return apperrors.Conflict("This reservation was canceled or has expired")
+	case errors.Is(err, service.ErrInvalidPurchasePrice):
+		return apperrors.BadRequest("Purchase price cannot be negative")
 	case errors.Is(err, service.ErrReservationNotFound):
 		return apperrors.NotFound("Reservation not found")
+	case errors.Is(err, service.ErrInvalidReservationID):
+		return apperrors.BadRequest("Invalid reservation ID")
+	case errors.Is(err, service.ErrReservationNotActive):
+		return apperrors.Conflict("Only active reservations can be transferred")
+	case errors.Is(err, service.ErrReservationAlreadyClaimed):
+		return apperrors.Conflict("Reservation already belongs to an account")
+	case errors.Is(err, service.ErrTransferRecipientNotFound):
+		return apperrors.NotFound("No account found for this email")
+	case errors.Is(err, service.ErrTransferToSelf):
+		return apperrors.BadRequest("Reservation already belongs to this account")
+	case errors.Is(err, service.ErrTransferToOwner):
+		return apperrors.UnprocessableEntity("Reservations cannot be transferred to the wish list owner")
+	case errors.Is(err, service.ErrAccountNotFound):
+		return apperrors.NotFound("User not found")
+	case errors.Is(err, service.ErrEmailNotVerified):
+		return apperrors.Forbidden("Verify your email address to claim the reservations made with it")
 	case errors.Is(err, service.ErrMissingUserOrToken):
 		return apperrors.BadRequest("Either user ID or reservation token must be provided")
+	case errors.Is(err, service.ErrInvalidCancelReason):
+		return apperrors.BadRequest("Cancellation reason must be changed_mind, bought_elsewhere, too_expensive or other")
+	case errors.Is(err, service.ErrInvalidBudgetPeriod):
+		return apperrors.BadRequest("Budget period must be monthly or occasion")
+	case errors.Is(err, service.ErrInvalidBudgetAmount):
+		return apperrors.BadRequest("Budget amount must be greater than zero")
+	case errors.Is(err, service.ErrBudgetWishlistRequired):
+		return apperrors.BadRequest("Wishlist ID is required for occasion budgets")
+	case errors.Is(err, service.ErrBudgetWishlistNotFound):
+		return apperrors.NotFound("Wish list not found")
+	case errors.Is(err, service.ErrInvalidBudgetID):
+		return apperrors.BadRequest("Invalid budget ID")
+	case errors.Is(err, service.ErrBudgetNotFound):
+		return apperrors.NotFound("Budget not found")
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
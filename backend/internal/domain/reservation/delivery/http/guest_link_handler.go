@@ -0,0 +1,90 @@
+package http
+
+import (
+	"math"
+	nethttp "net/http"
+
+	"wish-list/internal/domain/reservation/delivery/http/dto"
+	"wish-list/internal/domain/reservation/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GuestLinkHandler handles HTTP requests for linking guest reservations to accounts
+type GuestLinkHandler struct {
+	service service.GuestLinkServiceInterface
+}
+
+// NewGuestLinkHandler creates a new GuestLinkHandler
+func NewGuestLinkHandler(svc service.GuestLinkServiceInterface) *GuestLinkHandler {
+	return &GuestLinkHandler{
+		service: svc,
+	}
+}
+
+// ClaimGuestReservations godoc
+//
+//	@Summary		Claim guest reservations made with your email
+//	@Description	Move the active reservations made as a guest with the authenticated user's email to their account. Registration does this in the background; this retries it right away, e.g. when it failed. The email must be verified.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Success		200	{object}	dto.ClaimGuestReservationsResponse	"Number of reservations linked"
+//	@Failure		401	{object}	map[string]string					"Unauthorized"
+//	@Failure		403	{object}	map[string]string					"Email not verified"
+//	@Failure		404	{object}	map[string]string					"User not found"
+//	@Failure		500	{object}	map[string]string					"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/claim-guest-reservations [post]
+func (h *GuestLinkHandler) ClaimGuestReservations(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	linked, err := h.service.ClaimByEmail(c.Request().Context(), userID)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.ClaimGuestReservationsResponse{Linked: linked})
+}
+
+// ListFailedLinks godoc
+//
+//	@Summary		List failed guest reservation links
+//	@Description	Admin list of accounts whose guest reservations could not be linked after every retry, most recent first. Resolved once the user claims them.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Param			page	query		int								false	"Page number (default 1)"
+//	@Param			limit	query		int								false	"Items per page (default 10, max 100)"
+//	@Success		200		{object}	dto.GuestLinkFailuresResponse	"Failed links"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		403		{object}	map[string]string				"Insufficient permissions"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/guest-reservation-links [get]
+func (h *GuestLinkHandler) ListFailedLinks(c echo.Context) error {
+	pagination := helpers.ParsePagination(c)
+
+	failures, totalCount, err := h.service.ListFailed(c.Request().Context(), pagination.Limit, pagination.Offset)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(pagination.Limit)))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.GuestLinkFailuresResponse{
+		Data: dto.FromGuestLinkFailureOutputs(failures),
+		Pagination: map[string]any{
+			"page":       pagination.Page,
+			"limit":      pagination.Limit,
+			"total":      totalCount,
+			"totalPages": totalPages,
+		},
+	})
+}
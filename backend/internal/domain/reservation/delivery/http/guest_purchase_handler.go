@@ -0,0 +1,75 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/reservation/delivery/http/dto"
+	"wish-list/internal/domain/reservation/service"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GuestPurchaseHandler handles HTTP requests for guests marking their reservations bought
+type GuestPurchaseHandler struct {
+	service service.GuestPurchaseServiceInterface
+}
+
+// NewGuestPurchaseHandler creates a new GuestPurchaseHandler
+func NewGuestPurchaseHandler(svc service.GuestPurchaseServiceInterface) *GuestPurchaseHandler {
+	return &GuestPurchaseHandler{
+		service: svc,
+	}
+}
+
+// GetPurchase godoc
+//
+//	@Summary		Describe a purchase link
+//	@Description	Called with the signed token from the "I bought it" link in a purchase reminder email; no sign-in needed. Returns the reserved gift and whether it was already marked as bought, so the guest can confirm it and enter a price. Changes nothing.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Param			token	path		string						true	"Token from the reminder email"
+//	@Success		200		{object}	dto.GuestPurchaseResponse	"Reservation behind the link"
+//	@Failure		404		{object}	map[string]string			"Link is invalid or has expired"
+//	@Failure		409		{object}	map[string]string			"Reservation was canceled or has expired"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Router			/public/purchase-confirm/{token} [get]
+func (h *GuestPurchaseHandler) GetPurchase(c echo.Context) error {
+	purchase, err := h.service.GetPurchase(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromGuestPurchaseOutput(purchase))
+}
+
+// ConfirmPurchase godoc
+//
+//	@Summary		Mark a reserved gift as bought
+//	@Description	Called with the signed token from the "I bought it" link in a purchase reminder email; no sign-in needed. Marks the reservation and the wish list owner's item as purchased, just as the owner marking it would, unless the owner already did. The price is optional. Sending the token again is harmless.
+//	@Tags			Reservations
+//	@Accept			json
+//	@Produce		json
+//	@Param			token		path		string						true	"Token from the reminder email"
+//	@Param			purchase	body		dto.ConfirmPurchaseRequest	false	"What the guest paid"
+//	@Success		200			{object}	dto.GuestPurchaseResponse	"Gift marked as bought"
+//	@Failure		400			{object}	map[string]string			"Invalid request body"
+//	@Failure		404			{object}	map[string]string			"Link is invalid or has expired"
+//	@Failure		409			{object}	map[string]string			"Reservation was canceled or has expired"
+//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Router			/public/purchase-confirm/{token} [post]
+func (h *GuestPurchaseHandler) ConfirmPurchase(c echo.Context) error {
+	var req dto.ConfirmPurchaseRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	purchase, err := h.service.ConfirmPurchase(c.Request().Context(), c.Param("token"), req.PurchasedPrice)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromGuestPurchaseOutput(purchase))
+}
@@ -1,8 +1,10 @@
 package http
 
 import (
+	"errors"
 	"math"
 	nethttp "net/http"
+	"strconv"
 	"strings"
 
 	"wish-list/internal/domain/reservation/delivery/http/dto"
@@ -30,15 +32,21 @@ func NewHandler(svc service.ReservationServiceInterface) *Handler {
 // CreateReservation godoc
 //
 //	@Summary		Create a reservation for a gift item
-//	@Description	Create a reservation for a gift item. Can be done by authenticated users or guests (with name, email optional).
+//	@Description	Create a reservation for a gift item. Can be done by authenticated users or guests (with name, email optional). An optional message to the owner stays hidden from them until the occasion has passed (it then appears in their gift history) and is repeated in the purchase confirmation email. Guest reservations that look like abuse are not made right away: the guest is emailed a code to confirm them with at POST /public/reservations/confirm.
 //	@Tags			Reservations
 //	@Accept			json
 //	@Produce		json
 //	@Param			wishlistId			path		string							true	"Wish List ID"
 //	@Param			itemId				path		string							true	"Gift Item ID"
-//	@Param			reservation_request	body		dto.CreateReservationRequest		false	"Reservation information (guest name required, email optional)"
+//	@Param			reservation_request	body		dto.CreateReservationRequest		false	"Reservation information (guest name required, email, quantity and message optional)"
+//	@Param			X-Captcha-Token		header		string							false	"CAPTCHA response token (required for guests when CAPTCHA is enabled)"
 //	@Success		200					{object}	dto.CreateReservationResponse	"Reservation created successfully"
+//	@Success		202					{object}	dto.ConfirmationRequiredResponse	"Guest must confirm the reservation with the emailed code"
 //	@Failure		400					{object}	map[string]string				"Invalid request body or validation error (guests need name)"
+//	@Failure		403					{object}	map[string]string				"CAPTCHA verification failed"
+//	@Failure		409					{object}	map[string]string				"Item already reserved or not enough units left (details.remaining)"
+//	@Failure		422					{object}	map[string]string				"Guest email rejected (malformed, disposable or undeliverable), or needed to confirm the reservation"
+//	@Failure		429					{object}	map[string]string				"Too many guest reservations from this email or address (details.retry_after_seconds)"
 //	@Failure		500					{object}	map[string]string				"Internal server error"
 //	@Router			/public/reservations/wishlist/{wishlistId}/item/{itemId} [post]
 func (h *Handler) CreateReservation(c echo.Context) error {
@@ -65,17 +73,29 @@ func (h *Handler) CreateReservation(c echo.Context) error {
 			return parseErr
 		}
 
-		reservation, err = h.service.CreateReservation(ctx, req.ToServiceInput(wishListID, giftItemID, userID))
+		reservation, err = h.service.CreateReservation(ctx, req.ToServiceInput(wishListID, giftItemID, userID, c.RealIP()))
 	} else {
 		// Guest reservation
 		if req.GuestName == nil || strings.TrimSpace(*req.GuestName) == "" {
 			return apperrors.BadRequest("Guest name is required for unauthenticated reservations")
 		}
 
-		reservation, err = h.service.CreateReservation(ctx, req.ToServiceInput(wishListID, giftItemID, pgtype.UUID{Valid: false}))
+		input := req.ToServiceInput(wishListID, giftItemID, pgtype.UUID{Valid: false}, c.RealIP())
+		input.Honeypot = req.Website
+		reservation, err = h.service.CreateReservation(ctx, input)
 	}
 
+	if errors.Is(err, service.ErrGuestConfirmationRequired) {
+		return c.JSON(nethttp.StatusAccepted, dto.ConfirmationRequiredResponse{
+			ConfirmationRequired: true,
+			Message:              "Check your email for the code to confirm this reservation",
+		})
+	}
 	if err != nil {
+		var limitErr *service.GuestLimitError
+		if errors.As(err, &limitErr) {
+			c.Response().Header().Set("Retry-After", strconv.Itoa(int(limitErr.RetryAfter.Seconds())))
+		}
 		return mapReservationServiceError(err)
 	}
 
@@ -85,7 +105,7 @@ func (h *Handler) CreateReservation(c echo.Context) error {
 // CancelReservation godoc
 //
 //	@Summary		Cancel a reservation for a gift item
-//	@Description	Cancel a reservation for a gift item. Can be done by authenticated users or guests (with reservation token).
+//	@Description	Cancel a reservation for a gift item. Can be done by authenticated users or guests (with reservation token). An optional reason (changed_mind, bought_elsewhere, too_expensive or other) is kept, without the giver's details, for the owner's analytics.
 //	@Tags			Reservations
 //	@Accept			json
 //	@Produce		json
@@ -126,6 +146,7 @@ func (h *Handler) CancelReservation(c echo.Context) error {
 			GiftItemID:       giftItemID,
 			UserID:           userID,
 			ReservationToken: nil,
+			Reason:           req.Reason,
 		})
 	} else {
 		// Guest cancellation with token
@@ -143,6 +164,7 @@ func (h *Handler) CancelReservation(c echo.Context) error {
 			GiftItemID:       giftItemID,
 			UserID:           pgtype.UUID{Valid: false},
 			ReservationToken: &token,
+			Reason:           req.Reason,
 		})
 	}
 
@@ -156,7 +178,7 @@ func (h *Handler) CancelReservation(c echo.Context) error {
 // GetUserReservations godoc
 //
 //	@Summary		Get all reservations made by the authenticated user
-//	@Description	Get all reservations made by the authenticated user with pagination.
+//	@Description	Get all reservations made by the authenticated user with pagination, together with the user's budgets, their running spend (sum of purchase prices) and warnings when a budget is nearly used up or exceeded.
 //	@Tags			Reservations
 //	@Produce		json
 //	@Param			page	query		int								false	"Page number (default 1)"
@@ -188,6 +210,11 @@ func (h *Handler) GetUserReservations(c echo.Context) error {
 		return apperrors.Internal("Failed to get user reservations").Wrap(err)
 	}
 
+	budgets, err := h.service.GetBudgetSummaries(ctx, userID)
+	if err != nil {
+		return apperrors.Internal("Failed to get budgets").Wrap(err)
+	}
+
 	// Calculate total pages
 	totalPages := int(math.Ceil(float64(totalCount) / float64(pagination.Limit)))
 	if totalPages == 0 {
@@ -202,11 +229,211 @@ func (h *Handler) GetUserReservations(c echo.Context) error {
 			"total":      totalCount,
 			"totalPages": totalPages,
 		},
+		Budgets: dto.FromBudgetOutputs(budgets),
 	}
 
 	return c.JSON(nethttp.StatusOK, response)
 }
 
+// ConfirmReservation godoc
+//
+//	@Summary		Confirm a held guest reservation
+//	@Description	Make a guest reservation that was held for confirmation, using the code emailed to the guest. The item may have been reserved by someone else in the meantime. Codes work once and expire after 24 hours.
+//	@Tags			Reservations
+//	@Accept			json
+//	@Produce		json
+//	@Param			confirm_request	body		dto.ConfirmReservationRequest	true	"Confirmation code from the email"
+//	@Success		200				{object}	dto.CreateReservationResponse	"Reservation created successfully"
+//	@Failure		400				{object}	map[string]string				"Invalid request body or validation error"
+//	@Failure		404				{object}	map[string]string				"Confirmation code is invalid or has expired"
+//	@Failure		409				{object}	map[string]string				"Item already reserved or not enough units left"
+//	@Failure		429				{object}	map[string]string				"Too many guest reservations from this email or address"
+//	@Failure		500				{object}	map[string]string				"Internal server error"
+//	@Router			/public/reservations/confirm [post]
+func (h *Handler) ConfirmReservation(c echo.Context) error {
+	var req dto.ConfirmReservationRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	reservation, err := h.service.ConfirmGuestReservation(c.Request().Context(), req.ConfirmationToken, c.RealIP())
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReservationOutput(reservation))
+}
+
+// ClaimReservation godoc
+//
+//	@Summary		Claim a guest reservation
+//	@Description	Move a reservation made as a guest to the authenticated user's account, using the management token issued when it was made. The guest email does not have to match the account's. The reservation no longer expires once claimed.
+//	@Tags			Reservations
+//	@Accept			json
+//	@Produce		json
+//	@Param			claim_request	body		dto.ClaimReservationRequest		true	"Management token of the guest reservation"
+//	@Success		200				{object}	dto.CreateReservationResponse	"Reservation claimed"
+//	@Failure		400				{object}	map[string]string				"Invalid request body or validation error"
+//	@Failure		401				{object}	map[string]string				"Unauthorized"
+//	@Failure		404				{object}	map[string]string				"Reservation not found"
+//	@Failure		409				{object}	map[string]string				"Reservation is not active or already belongs to an account"
+//	@Failure		422				{object}	map[string]string				"The user owns the wish list"
+//	@Failure		500				{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/reservations/claim [post]
+func (h *Handler) ClaimReservation(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.ClaimReservationRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	token, err := helpers.ParseUUID(c, req.ReservationToken)
+	if err != nil {
+		return err
+	}
+
+	reservation, err := h.service.ClaimGuestReservation(c.Request().Context(), userID, token)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReservationOutput(reservation))
+}
+
+// TransferReservation godoc
+//
+//	@Summary		Hand a reservation to another user
+//	@Description	Transfer one of the authenticated user's active reservations to another registered user, identified by email, e.g. when they offer to buy the gift instead. The reservation gets a new management token.
+//	@Tags			Reservations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string							true	"Reservation ID"
+//	@Param			transfer_request	body		dto.TransferReservationRequest	true	"Email of the user taking over the reservation"
+//	@Success		200					{object}	dto.CreateReservationResponse	"Reservation transferred"
+//	@Failure		400					{object}	map[string]string				"Invalid reservation ID, request body, or the recipient already holds it"
+//	@Failure		401					{object}	map[string]string				"Unauthorized"
+//	@Failure		404					{object}	map[string]string				"Reservation or recipient not found"
+//	@Failure		409					{object}	map[string]string				"Reservation is not active"
+//	@Failure		422					{object}	map[string]string				"The recipient owns the wish list"
+//	@Failure		500					{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/reservations/{id}/transfer [post]
+func (h *Handler) TransferReservation(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.TransferReservationRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	reservation, err := h.service.TransferReservation(c.Request().Context(), service.TransferReservationInput{
+		ReservationID:  c.Param("id"),
+		FromUserID:     userID,
+		RecipientEmail: req.RecipientEmail,
+	})
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReservationOutput(reservation))
+}
+
+// GetReservationTimeline godoc
+//
+//	@Summary		Get the history of a reservation
+//	@Description	Get every change of one of the authenticated user's reservations, oldest first: when it was made, claimed from a guest, transferred, canceled or expired. Entries never say who made a change.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Param			id	path		string								true	"Reservation ID"
+//	@Success		200	{object}	dto.ReservationTimelineResponse		"Reservation history"
+//	@Failure		400	{object}	map[string]string					"Invalid reservation ID"
+//	@Failure		401	{object}	map[string]string					"Unauthorized"
+//	@Failure		404	{object}	map[string]string					"Reservation not found"
+//	@Failure		500	{object}	map[string]string					"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/reservations/{id}/timeline [get]
+func (h *Handler) GetReservationTimeline(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	events, err := h.service.GetReservationTimeline(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReservationEventOutputs(events))
+}
+
+// SetBudget godoc
+//
+//	@Summary		Set a gift budget
+//	@Description	Create or update the authenticated user's monthly budget or the budget for one occasion (wish list). Setting a budget for a period that already has one replaces its amount.
+//	@Tags			Reservations
+//	@Accept			json
+//	@Produce		json
+//	@Param			budget_request	body		dto.SetBudgetRequest	true	"Budget period, wish list (occasion budgets only) and amount"
+//	@Success		200				{object}	dto.BudgetResponse		"Budget saved with its running spend"
+//	@Failure		400				{object}	map[string]string		"Invalid request body or validation error"
+//	@Failure		401				{object}	map[string]string		"Unauthorized"
+//	@Failure		404				{object}	map[string]string		"Wish list not found"
+//	@Failure		500				{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/reservations/budgets [put]
+func (h *Handler) SetBudget(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.SetBudgetRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	budget, err := h.service.SetBudget(c.Request().Context(), userID, req.ToServiceInput())
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromBudgetOutput(budget))
+}
+
+// DeleteBudget godoc
+//
+//	@Summary		Delete a gift budget
+//	@Description	Delete one of the authenticated user's budgets.
+//	@Tags			Reservations
+//	@Param			id	path		string				true	"Budget ID"
+//	@Success		204	{object}	nil					"Budget deleted"
+//	@Failure		400	{object}	map[string]string	"Invalid budget ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Budget not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/reservations/budgets/{id} [delete]
+func (h *Handler) DeleteBudget(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteBudget(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
 // GetGuestReservations godoc
 //
 //	@Summary		Get reservations made by a guest using a token
@@ -238,6 +465,60 @@ func (h *Handler) GetGuestReservations(c echo.Context) error {
 	return c.JSON(nethttp.StatusOK, dto.FromReservationDetails(reservations))
 }
 
+// GetGuestReservationTimeline godoc
+//
+//	@Summary		Get the history of a guest reservation
+//	@Description	Get every change of the reservation a guest manages with their reservation token, oldest first.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Param			token	query		string								true	"Reservation token"
+//	@Success		200		{object}	dto.ReservationTimelineResponse		"Reservation history"
+//	@Failure		400		{object}	map[string]string					"Invalid request parameters"
+//	@Failure		404		{object}	map[string]string					"Reservation not found"
+//	@Failure		500		{object}	map[string]string					"Internal server error"
+//	@Router			/guest/reservations/timeline [get]
+func (h *Handler) GetGuestReservationTimeline(c echo.Context) error {
+	tokenStr := c.QueryParam("token")
+	if tokenStr == "" {
+		return apperrors.BadRequest("Token parameter is required")
+	}
+
+	token, err := helpers.ParseUUID(c, tokenStr)
+	if err != nil {
+		return err
+	}
+
+	events, err := h.service.GetGuestReservationTimeline(c.Request().Context(), token)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReservationEventOutputs(events))
+}
+
+// GetReservationStatuses godoc
+//
+//	@Summary		Get the reservation status of all gift items in a public wish list
+//	@Description	Get the reservation status of every gift item in a public wish list in one request.
+//	@Tags			Reservations
+//	@Produce		json
+//	@Param			slug	path		string							true	"Public wish list slug"
+//	@Success		200		{object}	dto.ReservationStatusesResponse	"Reservation statuses retrieved successfully"
+//	@Failure		404		{object}	map[string]string				"Wish list not found"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Router			/public/reservations/list/{slug} [get]
+func (h *Handler) GetReservationStatuses(c echo.Context) error {
+	publicSlug := c.Param("slug")
+
+	ctx := c.Request().Context()
+	statuses, err := h.service.GetReservationStatuses(ctx, publicSlug)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromItemReservationStatusOutputs(statuses))
+}
+
 // GetReservationStatus godoc
 //
 //	@Summary		Get the reservation status for a gift item in a public wish list
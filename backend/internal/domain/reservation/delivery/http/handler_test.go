@@ -97,6 +97,14 @@ func (m *MockReservationService) GetReservationStatus(ctx context.Context, publi
 	return args.Get(0).(*service.ReservationStatusOutput), args.Error(1)
 }
 
+func (m *MockReservationService) GetReservationStatuses(ctx context.Context, publicSlug string) ([]*service.ItemReservationStatusOutput, error) {
+	args := m.Called(ctx, publicSlug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.ItemReservationStatusOutput), args.Error(1)
+}
+
 func (m *MockReservationService) GetUserReservations(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]repository.ReservationDetail, error) {
 	args := m.Called(ctx, userID, limit, offset)
 	if args.Get(0) == nil {
@@ -118,6 +126,59 @@ func (m *MockReservationService) CountUserReservations(ctx context.Context, user
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockReservationService) SetBudget(ctx context.Context, userID pgtype.UUID, input service.SetBudgetInput) (*service.BudgetOutput, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BudgetOutput), args.Error(1)
+}
+
+func (m *MockReservationService) DeleteBudget(ctx context.Context, userID pgtype.UUID, budgetID string) error {
+	args := m.Called(ctx, userID, budgetID)
+	return args.Error(0)
+}
+
+func (m *MockReservationService) GetBudgetSummaries(ctx context.Context, userID pgtype.UUID) ([]*service.BudgetOutput, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.BudgetOutput), args.Error(1)
+}
+
+func (m *MockReservationService) ClaimGuestReservation(ctx context.Context, userID, token pgtype.UUID) (*service.ReservationOutput, error) {
+	args := m.Called(ctx, userID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.ReservationOutput), args.Error(1)
+}
+
+func (m *MockReservationService) TransferReservation(ctx context.Context, input service.TransferReservationInput) (*service.ReservationOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.ReservationOutput), args.Error(1)
+}
+
+func (m *MockReservationService) GetReservationTimeline(ctx context.Context, userID pgtype.UUID, reservationID string) ([]*service.ReservationEventOutput, error) {
+	args := m.Called(ctx, userID, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.ReservationEventOutput), args.Error(1)
+}
+
+func (m *MockReservationService) GetGuestReservationTimeline(ctx context.Context, token pgtype.UUID) ([]*service.ReservationEventOutput, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.ReservationEventOutput), args.Error(1)
+}
+
 // T062a: Unit tests for reservation cancellation endpoint (valid cancellation, unauthorized cancellation)
 func TestReservationHandler_CancelReservation(t *testing.T) {
 	t.Run("valid cancellation by authenticated user", func(t *testing.T) {
@@ -622,3 +683,553 @@ func TestReservationHandler_GetReservationStatus(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestReservationHandler_GetReservationStatuses(t *testing.T) {
+	t.Run("returns statuses for all items", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		reservedBy := "Jane Doe"
+		reservedAt := time.Now()
+		statuses := []*service.ItemReservationStatusOutput{
+			{
+				GiftItemID:              "item-1",
+				ReservationStatusOutput: service.ReservationStatusOutput{IsReserved: false, Status: "available"},
+			},
+			{
+				GiftItemID: "item-2",
+				ReservationStatusOutput: service.ReservationStatusOutput{
+					IsReserved:     true,
+					ReservedByName: &reservedBy,
+					ReservedAt:     &reservedAt,
+					Status:         "active",
+				},
+			},
+		}
+
+		mockService.On("GetReservationStatuses", mock.Anything, "public-slug").Return(statuses, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/public/reservations/list/:slug", nil,
+			[]string{"slug"}, []string{"public-slug"}, nil)
+
+		err := handler.GetReservationStatuses(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response dto.ReservationStatusesResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, "item-1", response.Items[0].GiftItemID)
+		assert.False(t, response.Items[0].IsReserved)
+		assert.Equal(t, "item-2", response.Items[1].GiftItemID)
+		assert.True(t, response.Items[1].IsReserved)
+		require.NotNil(t, response.Items[1].ReservedByName)
+		assert.Equal(t, "Jane Doe", *response.Items[1].ReservedByName)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unknown wishlist", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		mockService.On("GetReservationStatuses", mock.Anything, "missing").
+			Return(nil, service.ErrPublicWishlistNotFound)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/public/reservations/list/:slug", nil,
+			[]string{"slug"}, []string{"missing"}, nil)
+
+		err := handler.GetReservationStatuses(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestReservationHandler_SetBudget(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	wishlistID := "223e4567-e89b-12d3-a456-426614174000"
+	authCtx := &AuthContext{UserID: userID, Email: "giver@example.com", UserType: "user"}
+
+	t.Run("saves occasion budget", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		budgetWishlistID := pgtype.UUID{}
+		require.NoError(t, budgetWishlistID.Scan(wishlistID))
+		title := "Mom's Birthday"
+
+		mockService.On("SetBudget", mock.Anything, mock.Anything, service.SetBudgetInput{
+			Period:     "occasion",
+			WishlistID: wishlistID,
+			Amount:     100,
+		}).Return(&service.BudgetOutput{
+			ID:            pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+			Period:        "occasion",
+			WishlistID:    budgetWishlistID,
+			WishlistTitle: &title,
+			Amount:        100,
+			Spent:         85,
+			Remaining:     15,
+			Warnings:      []string{service.BudgetWarningNearLimit},
+		}, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodPut, "/api/reservations/budgets",
+			map[string]any{"period": "occasion", "wishlist_id": wishlistID, "amount": 100}, nil, nil, authCtx)
+
+		err := handler.SetBudget(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response dto.BudgetResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.NotNil(t, response.WishlistID)
+		assert.Equal(t, wishlistID, *response.WishlistID)
+		assert.InDelta(t, 85.0, response.Spent, 0.001)
+		assert.Equal(t, []string{service.BudgetWarningNearLimit}, response.Warnings)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("occasion budget requires wishlist", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPut, "/api/reservations/budgets",
+			map[string]any{"period": "occasion", "amount": 100}, nil, nil, authCtx)
+
+		err := handler.SetBudget(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+		mockService.AssertNotCalled(t, "SetBudget", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects unknown period", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPut, "/api/reservations/budgets",
+			map[string]any{"period": "weekly", "amount": 100}, nil, nil, authCtx)
+
+		err := handler.SetBudget(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+	})
+
+	t.Run("unknown wishlist", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		mockService.On("SetBudget", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, service.ErrBudgetWishlistNotFound)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPut, "/api/reservations/budgets",
+			map[string]any{"period": "occasion", "wishlist_id": wishlistID, "amount": 100}, nil, nil, authCtx)
+
+		err := handler.SetBudget(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+	})
+}
+
+func TestReservationHandler_GetUserReservationsBudgets(t *testing.T) {
+	e := setupTestEcho()
+	mockService := new(MockReservationService)
+	handler := NewHandler(mockService)
+
+	mockService.On("CountUserReservations", mock.Anything, mock.Anything).Return(0, nil)
+	mockService.On("GetUserReservations", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]repository.ReservationDetail{}, nil)
+	mockService.On("GetBudgetSummaries", mock.Anything, mock.Anything).Return([]*service.BudgetOutput{
+		{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Period: "monthly", Amount: 50, Spent: 60, Warnings: []string{service.BudgetWarningExceeded}},
+	}, nil)
+
+	c, rec := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/reservations/user", nil, nil, nil,
+		&AuthContext{UserID: "123e4567-e89b-12d3-a456-426614174000", Email: "giver@example.com", UserType: "user"})
+
+	err := handler.GetUserReservations(c)
+	require.NoError(t, err)
+	assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+	var response dto.UserReservationsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Budgets, 1)
+	assert.Equal(t, "monthly", response.Budgets[0].Period)
+	assert.Equal(t, []string{service.BudgetWarningExceeded}, response.Budgets[0].Warnings)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestReservationHandler_TransferReservation(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	reservationID := "323e4567-e89b-12d3-a456-426614174000"
+	authCtx := &AuthContext{UserID: userID, Email: "giver@example.com", UserType: "user"}
+
+	t.Run("transfers to recipient", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		fromUserID := pgtype.UUID{}
+		require.NoError(t, fromUserID.Scan(userID))
+		recipientID := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+
+		mockService.On("TransferReservation", mock.Anything, service.TransferReservationInput{
+			ReservationID:  reservationID,
+			FromUserID:     fromUserID,
+			RecipientEmail: "friend@example.com",
+		}).Return(&service.ReservationOutput{
+			ID:               pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+			ReservedByUserID: recipientID,
+			Status:           "active",
+		}, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodPost, "/api/reservations/"+reservationID+"/transfer",
+			map[string]any{"recipient_email": "friend@example.com"}, []string{"id"}, []string{reservationID}, authCtx)
+
+		err := handler.TransferReservation(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("recipient owns the wishlist", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		mockService.On("TransferReservation", mock.Anything, mock.Anything).Return(nil, service.ErrTransferToOwner)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/api/reservations/"+reservationID+"/transfer",
+			map[string]any{"recipient_email": "owner@example.com"}, []string{"id"}, []string{reservationID}, authCtx)
+
+		err := handler.TransferReservation(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusUnprocessableEntity, appErr.Code)
+	})
+
+	t.Run("claim requires token", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/api/reservations/claim",
+			map[string]any{}, nil, nil, authCtx)
+
+		err := handler.ClaimReservation(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+		mockService.AssertNotCalled(t, "ClaimGuestReservation", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReservationHandler_GetReservationTimeline(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	reservationID := "323e4567-e89b-12d3-a456-426614174000"
+	authCtx := &AuthContext{UserID: userID, Email: "giver@example.com", UserType: "user"}
+
+	t.Run("returns the events oldest first", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		holderID := pgtype.UUID{}
+		require.NoError(t, holderID.Scan(userID))
+		reservedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		mockService.On("GetReservationTimeline", mock.Anything, holderID, reservationID).Return([]*service.ReservationEventOutput{
+			{EventType: "created", Status: "active", OccurredAt: reservedAt},
+			{EventType: "canceled", Status: "canceled", OccurredAt: reservedAt.Add(time.Hour)},
+		}, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/reservations/"+reservationID+"/timeline",
+			nil, []string{"id"}, []string{reservationID}, authCtx)
+
+		err := handler.GetReservationTimeline(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"events":[
+			{"event_type":"created","status":"active","occurred_at":"2026-03-01T12:00:00Z"},
+			{"event_type":"canceled","status":"canceled","occurred_at":"2026-03-01T13:00:00Z"}
+		]}`, rec.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("someone else's reservation", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		mockService.On("GetReservationTimeline", mock.Anything, mock.Anything, reservationID).Return(nil, service.ErrReservationNotFound)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/reservations/"+reservationID+"/timeline",
+			nil, []string{"id"}, []string{reservationID}, authCtx)
+
+		err := handler.GetReservationTimeline(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+	})
+
+	t.Run("guest timeline requires token", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/guest/reservations/timeline", nil, nil, nil, nil)
+
+		err := handler.GetGuestReservationTimeline(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+		mockService.AssertNotCalled(t, "GetGuestReservationTimeline", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReservationHandler_GuestConfirmation(t *testing.T) {
+	t.Run("suspicious reservation is held for confirmation", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		guestName := "John Doe"
+		guestEmail := "john@example.com"
+		reqBody := dto.CreateReservationRequest{
+			GuestName:  &guestName,
+			GuestEmail: &guestEmail,
+			Website:    "http://spam.example",
+		}
+
+		mockService.
+			On("CreateReservation", mock.Anything, mock.AnythingOfType("service.CreateReservationInput")).
+			Run(func(args mock.Arguments) {
+				input := args.Get(1).(service.CreateReservationInput)
+				assert.Equal(t, "http://spam.example", input.Honeypot)
+			}).
+			Return(nil, service.ErrGuestConfirmationRequired)
+
+		jsonBody, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(nethttp.MethodPost, "/wishlists/list-123/items/item-456/reserve", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("wishlistId", "itemId")
+		c.SetParamValues("list-123", "item-456")
+
+		err := handler.CreateReservation(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusAccepted, rec.Code)
+
+		var response dto.ConfirmationRequiredResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.ConfirmationRequired)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("confirming creates the reservation", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		token := "123e4567-e89b-12d3-a456-426614174000"
+		guestName := "John Doe"
+		mockService.On("ConfirmGuestReservation", mock.Anything, token, mock.Anything).Return(&service.ReservationOutput{
+			GiftItemID: pgtype.UUID{Valid: true},
+			GuestName:  &guestName,
+			Status:     "active",
+		}, nil)
+
+		jsonBody, _ := json.Marshal(dto.ConfirmReservationRequest{ConfirmationToken: token})
+		req := httptest.NewRequest(nethttp.MethodPost, "/api/public/reservations/confirm", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ConfirmReservation(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("expired confirmation code", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockReservationService)
+		handler := NewHandler(mockService)
+
+		token := "123e4567-e89b-12d3-a456-426614174000"
+		mockService.On("ConfirmGuestReservation", mock.Anything, token, mock.Anything).Return(nil, service.ErrInvalidConfirmationToken)
+
+		jsonBody, _ := json.Marshal(dto.ConfirmReservationRequest{ConfirmationToken: token})
+		req := httptest.NewRequest(nethttp.MethodPost, "/api/public/reservations/confirm", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ConfirmReservation(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+	})
+}
+
+func (m *MockReservationService) ConfirmGuestReservation(ctx context.Context, token, clientIP string) (*service.ReservationOutput, error) {
+	args := m.Called(ctx, token, clientIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.ReservationOutput), args.Error(1)
+}
+
+// MockGuestLinkService implements the GuestLinkServiceInterface for testing
+type MockGuestLinkService struct {
+	mock.Mock
+}
+
+func (m *MockGuestLinkService) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockGuestLinkService) ClaimByEmail(ctx context.Context, userID pgtype.UUID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockGuestLinkService) ListFailed(ctx context.Context, limit, offset int) ([]*service.GuestLinkFailureOutput, int, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*service.GuestLinkFailureOutput), args.Int(1), args.Error(2)
+}
+
+func TestGuestLinkHandler_ClaimGuestReservations(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	authCtx := &AuthContext{UserID: userID, Email: "giver@example.com", UserType: "user"}
+
+	t.Run("returns the number linked", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockGuestLinkService)
+		handler := NewGuestLinkHandler(mockService)
+
+		expectedID := pgtype.UUID{}
+		require.NoError(t, expectedID.Scan(userID))
+		mockService.On("ClaimByEmail", mock.Anything, expectedID).Return(3, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodPost, "/api/protected/claim-guest-reservations", nil, nil, nil, authCtx)
+
+		err := handler.ClaimGuestReservations(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"linked":3}`, rec.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockGuestLinkService)
+		handler := NewGuestLinkHandler(mockService)
+
+		mockService.On("ClaimByEmail", mock.Anything, mock.Anything).Return(0, service.ErrEmailNotVerified)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/api/protected/claim-guest-reservations", nil, nil, nil, authCtx)
+
+		err := handler.ClaimGuestReservations(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusForbidden, appErr.Code)
+	})
+}
+
+// MockShoppingListService implements the ShoppingListServiceInterface for testing
+type MockShoppingListService struct {
+	mock.Mock
+}
+
+func (m *MockShoppingListService) ExportGuestShoppingList(ctx context.Context, token pgtype.UUID) ([]byte, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func TestShoppingListHandler_ExportGuestShoppingList(t *testing.T) {
+	token := "123e4567-e89b-12d3-a456-426614174000"
+
+	t.Run("returns the PDF", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockShoppingListService)
+		handler := NewShoppingListHandler(mockService)
+
+		tokenUUID := pgtype.UUID{}
+		require.NoError(t, tokenUUID.Scan(token))
+		mockService.On("ExportGuestShoppingList", mock.Anything, tokenUUID).Return([]byte("%PDF-1.4"), nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/guest/reservations/export?token="+token, nil, nil, nil, nil)
+
+		err := handler.ExportGuestShoppingList(c)
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "shopping-list.pdf")
+		assert.Equal(t, "%PDF-1.4", rec.Body.String())
+	})
+
+	t.Run("nothing reserved", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockShoppingListService)
+		handler := NewShoppingListHandler(mockService)
+
+		mockService.On("ExportGuestShoppingList", mock.Anything, mock.Anything).Return(nil, service.ErrReservationNotFound)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/guest/reservations/export?token="+token, nil, nil, nil, nil)
+
+		err := handler.ExportGuestShoppingList(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+	})
+
+	t.Run("requires token", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockShoppingListService)
+		handler := NewShoppingListHandler(mockService)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodGet, "/api/guest/reservations/export", nil, nil, nil, nil)
+
+		err := handler.ExportGuestShoppingList(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+		mockService.AssertNotCalled(t, "ExportGuestShoppingList", mock.Anything, mock.Anything)
+	})
+}
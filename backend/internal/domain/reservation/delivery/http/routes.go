@@ -1,6 +1,10 @@
 package http
 
-import "github.com/labstack/echo/v4"
+import (
+	"wish-list/internal/pkg/auth"
+
+	"github.com/labstack/echo/v4"
+)
 
 // RegisterRoutes registers all reservation HTTP routes
 func RegisterRoutes(
@@ -8,19 +12,52 @@ func RegisterRoutes(
 	h *Handler,
 	optionalAuthMiddleware echo.MiddlewareFunc,
 	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
 ) {
 	// Public reservation routes — guests and authenticated users.
 	// optionalAuthMiddleware sets user context when token is present; guests proceed without it.
+	// captchaMiddleware must run after it so that only guest reservations are challenged.
 	public := e.Group("/api/public")
-	public.POST("/reservations/wishlist/:wishlistId/item/:itemId", h.CreateReservation, optionalAuthMiddleware)
+	public.POST("/reservations/wishlist/:wishlistId/item/:itemId", h.CreateReservation, optionalAuthMiddleware, captchaMiddleware)
 	public.DELETE("/reservations/wishlist/:wishlistId/item/:itemId", h.CancelReservation, optionalAuthMiddleware)
+	public.POST("/reservations/confirm", h.ConfirmReservation)
+	public.GET("/reservations/list/:slug", h.GetReservationStatuses)
 	public.GET("/reservations/list/:slug/item/:itemId", h.GetReservationStatus)
 
 	// Authenticated-only reservation routes (mobile / registered users).
 	authenticated := e.Group("/api/reservations", authMiddleware)
 	authenticated.GET("/user", h.GetUserReservations)
+	authenticated.POST("/claim", h.ClaimReservation)
+	authenticated.POST("/:id/transfer", h.TransferReservation)
+	authenticated.GET("/:id/timeline", h.GetReservationTimeline)
+	authenticated.PUT("/budgets", h.SetBudget)
+	authenticated.DELETE("/budgets/:id", h.DeleteBudget)
 
 	// Guest reservation routes — no auth required, token-based.
 	guest := e.Group("/api/guest")
 	guest.GET("/reservations", h.GetGuestReservations)
+	guest.GET("/reservations/timeline", h.GetGuestReservationTimeline)
+}
+
+// RegisterGuestLinkRoutes registers the routes for linking guest reservations to accounts
+func RegisterGuestLinkRoutes(e *echo.Echo, h *GuestLinkHandler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected", authMiddleware)
+	protected.POST("/claim-guest-reservations", h.ClaimGuestReservations)
+
+	admin := e.Group("/api/admin/guest-reservation-links", authMiddleware, auth.RequireUserType("admin"))
+	admin.GET("", h.ListFailedLinks)
+}
+
+// RegisterShoppingListRoutes registers the routes for printable reservation lists
+func RegisterShoppingListRoutes(e *echo.Echo, h *ShoppingListHandler) {
+	guest := e.Group("/api/guest")
+	guest.GET("/reservations/export", h.ExportGuestShoppingList)
+}
+
+// RegisterGuestPurchaseRoutes registers the routes behind the "I bought it" link in
+// purchase reminder emails. The signed token stands in for a login.
+func RegisterGuestPurchaseRoutes(e *echo.Echo, h *GuestPurchaseHandler) {
+	public := e.Group("/api/public")
+	public.GET("/purchase-confirm/:token", h.GetPurchase)
+	public.POST("/purchase-confirm/:token", h.ConfirmPurchase)
 }
@@ -0,0 +1,57 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/reservation/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/pdf"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ShoppingListHandler handles HTTP requests for printable reservation lists
+type ShoppingListHandler struct {
+	service service.ShoppingListServiceInterface
+}
+
+// NewShoppingListHandler creates a new ShoppingListHandler
+func NewShoppingListHandler(svc service.ShoppingListServiceInterface) *ShoppingListHandler {
+	return &ShoppingListHandler{
+		service: svc,
+	}
+}
+
+// ExportGuestShoppingList godoc
+//
+//	@Summary		Export a guest's shopping list as a PDF
+//	@Description	Printable PDF of the active reservations a guest manages with their reservation token, grouped by wish list: item picture, name, variant, price, shop link and the list's occasion date. For shopping offline.
+//	@Tags			Reservations
+//	@Produce		application/pdf
+//	@Param			token	query		string				true	"Reservation token"
+//	@Success		200		{file}		file				"Shopping list PDF"
+//	@Failure		400		{object}	map[string]string	"Invalid request parameters"
+//	@Failure		404		{object}	map[string]string	"No active reservations"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/guest/reservations/export [get]
+func (h *ShoppingListHandler) ExportGuestShoppingList(c echo.Context) error {
+	tokenStr := c.QueryParam("token")
+	if tokenStr == "" {
+		return apperrors.BadRequest("Token parameter is required")
+	}
+
+	token, err := helpers.ParseUUID(c, tokenStr)
+	if err != nil {
+		return err
+	}
+
+	doc, err := h.service.ExportGuestShoppingList(c.Request().Context(), token)
+	if err != nil {
+		return mapReservationServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="shopping-list.pdf"`)
+	return c.Blob(nethttp.StatusOK, pdf.ContentType, doc)
+}
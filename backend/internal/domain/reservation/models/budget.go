@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Budget periods
+const (
+	BudgetPeriodMonthly  = "monthly"
+	BudgetPeriodOccasion = "occasion"
+)
+
+// Budget is a spending limit a giver sets for themselves, either per month or per wishlist (occasion)
+type Budget struct {
+	ID         pgtype.UUID        `db:"id"`
+	UserID     pgtype.UUID        `db:"user_id"`
+	Period     string             `db:"period"`
+	WishlistID pgtype.UUID        `db:"wishlist_id"` // NULL for monthly budgets
+	Amount     pgtype.Numeric     `db:"amount"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at"`
+}
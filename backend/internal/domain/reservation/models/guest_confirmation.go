@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/pkg/variant"
+)
+
+// GuestConfirmation is a guest reservation held until the guest confirms it with
+// the code emailed to them
+type GuestConfirmation struct {
+	Token            pgtype.UUID        `db:"confirmation_token"`
+	WishlistID       pgtype.UUID        `db:"wishlist_id"`
+	GiftItemID       pgtype.UUID        `db:"gift_item_id"`
+	Details          pgtype.Text        `db:"details"`           // GuestConfirmationDetails as JSON
+	EncryptedDetails pgtype.Text        `db:"encrypted_details"` // PII encrypted
+	Score            int                `db:"score"`
+	ExpiresAt        pgtype.Timestamptz `db:"expires_at"`
+	CreatedAt        pgtype.Timestamptz `db:"created_at"`
+}
+
+// GuestConfirmationDetails is the guest's part of a held reservation request
+type GuestConfirmationDetails struct {
+	GuestName  string         `json:"guest_name"`
+	GuestEmail string         `json:"guest_email"`
+	Quantity   int32          `json:"quantity,omitempty"`
+	Variant    variant.Choice `json:"variant"`
+	Message    *string        `json:"message,omitempty"`
+}
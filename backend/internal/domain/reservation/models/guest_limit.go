@@ -0,0 +1,33 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Subjects guest reservations are capped by
+const (
+	GuestSubjectEmail = "email"
+	GuestSubjectIP    = "ip"
+)
+
+// GuestSubject identifies who made a guest reservation without storing the email
+// or address itself
+type GuestSubject struct {
+	Type string
+	Hash string
+}
+
+// NewGuestSubject hashes a normalized email or client address
+func NewGuestSubject(subjectType, value string) GuestSubject {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return GuestSubject{Type: subjectType, Hash: hex.EncodeToString(sum[:])}
+}
+
+// GuestUsage is how many guest reservations a subject made within a window
+type GuestUsage struct {
+	Count  int       `db:"count"`
+	Oldest time.Time `db:"oldest"` // Zero when Count is 0
+}
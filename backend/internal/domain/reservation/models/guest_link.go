@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GuestLink is a queued job linking a new account's guest reservations by its email
+type GuestLink struct {
+	ID            pgtype.UUID        `db:"id"`
+	UserID        pgtype.UUID        `db:"user_id"`
+	Email         string             `db:"email"` // The user's current email, joined in for the job; never stored
+	Attempts      int                `db:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at"`
+	LastError     pgtype.Text        `db:"last_error"`
+	LinkedCount   pgtype.Int4        `db:"linked_count"`
+	CompletedAt   pgtype.Timestamptz `db:"completed_at"`
+	FailedAt      pgtype.Timestamptz `db:"failed_at"` // Set once the retries are used up
+	CreatedAt     pgtype.Timestamptz `db:"created_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PurchaseReminder is a guest's active reservation of a gift not bought yet,
+// with the occasion of its wishlist coming up
+type PurchaseReminder struct {
+	ReservationID       pgtype.UUID `db:"reservation_id"`
+	GuestName           pgtype.Text `db:"guest_name"`
+	EncryptedGuestName  pgtype.Text `db:"encrypted_guest_name"` // PII encrypted
+	GuestEmail          pgtype.Text `db:"guest_email"`
+	EncryptedGuestEmail pgtype.Text `db:"encrypted_guest_email"` // PII encrypted
+	GiftItemName        string      `db:"gift_item_name"`
+	GiftItemLink        pgtype.Text `db:"gift_item_link"`
+	WishlistTitle       string      `db:"wishlist_title"`
+	OccasionDate        pgtype.Date `db:"occasion_date"`
+}
+
+// GuestPurchase is an active reservation behind a purchase link, and whether
+// its guest said they bought it
+type GuestPurchase struct {
+	ReservationID    pgtype.UUID        `db:"reservation_id"`
+	GiftItemID       pgtype.UUID        `db:"gift_item_id"`
+	ReservedByUserID pgtype.UUID        `db:"reserved_by_user_id"`
+	GiftItemName     string             `db:"gift_item_name"`
+	WishlistTitle    string             `db:"wishlist_title"`
+	PurchasedAt      pgtype.Timestamptz `db:"purchased_at"`        // Null until the guest marks it bought
+	ItemPurchased    bool               `db:"gift_item_purchased"` // Owner's item already marked purchased
+}
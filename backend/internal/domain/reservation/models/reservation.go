@@ -2,6 +2,8 @@ package models
 
 import (
 	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/pkg/variant"
 )
 
 type Reservation struct {
@@ -15,6 +17,10 @@ type Reservation struct {
 	EncryptedGuestEmail pgtype.Text        `db:"encrypted_guest_email"` // PII encrypted
 	ReservationToken    pgtype.UUID        `db:"reservation_token"`
 	Status              string             `db:"status"`
+	Quantity            int32              `db:"quantity"`          // Units of the gift item held by this reservation
+	Variant             variant.Choice     `db:"variant"`           // Size, color and model the giver picked, if any
+	Message             pgtype.Text        `db:"message"`           // Giver's note to the owner, hidden until the occasion
+	EncryptedMessage    pgtype.Text        `db:"encrypted_message"` // PII encrypted
 	ReservedAt          pgtype.Timestamptz `db:"reserved_at"`
 	ExpiresAt           pgtype.Timestamptz `db:"expires_at"`
 	CanceledAt          pgtype.Timestamptz `db:"canceled_at"`
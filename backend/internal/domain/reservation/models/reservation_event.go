@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Reservation event types. Every change of status or holder appends one.
+const (
+	ReservationEventCreated     = "created"
+	ReservationEventCanceled    = "canceled"
+	ReservationEventExpired     = "expired"
+	ReservationEventClaimed     = "claimed"     // A guest moved it to their account
+	ReservationEventTransferred = "transferred" // Handed to another registered user
+)
+
+// Reasons a reservation was canceled for
+const (
+	CancelReasonChangedMind     = "changed_mind"
+	CancelReasonBoughtElsewhere = "bought_elsewhere"
+	CancelReasonTooExpensive    = "too_expensive"
+	CancelReasonOther           = "other"
+
+	// CancelReasonItemReceived is recorded when the owner got the item
+	// elsewhere and its reservations were released; givers cannot pick it
+	CancelReasonItemReceived = "item_received"
+)
+
+// CancelReasons lists the reasons givers can pick when canceling
+var CancelReasons = []string{CancelReasonChangedMind, CancelReasonBoughtElsewhere, CancelReasonTooExpensive, CancelReasonOther}
+
+// ReservationEvent is one entry of a reservation's append-only history. It names
+// no giver, so it is kept after the reservation itself is gone.
+type ReservationEvent struct {
+	ID            int64       `db:"id"`
+	ReservationID pgtype.UUID `db:"reservation_id"`
+	WishlistID    pgtype.UUID `db:"wishlist_id"`
+	GiftItemID    pgtype.UUID `db:"gift_item_id"`
+	EventType     string      `db:"event_type"`
+	Status        string      `db:"status"` // Status of the reservation after the event
+	Reason        pgtype.Text `db:"reason"` // Why it was canceled; NULL when not given or not a cancellation
+	OccurredAt    time.Time   `db:"occurred_at"`
+}
+
+// ReservationDrift is a reservation whose status disagrees with its event log
+type ReservationDrift struct {
+	ReservationID pgtype.UUID `db:"reservation_id"`
+	Status        string      `db:"status"`
+	EventStatus   pgtype.Text `db:"event_status"` // Status after the latest event; NULL without events
+}
@@ -0,0 +1,157 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_budget_repository_test.go -pkg service . BudgetRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+)
+
+// Sentinel errors for budget repository
+var (
+	ErrBudgetNotFound         = errors.New("budget not found")
+	ErrBudgetWishlistNotFound = errors.New("budget wishlist not found")
+)
+
+const budgetColumns = `id, user_id, period, wishlist_id, amount, created_at, updated_at`
+
+// BudgetRepositoryInterface defines the interface for giver budget database operations
+type BudgetRepositoryInterface interface {
+	Upsert(ctx context.Context, budget models.Budget) (*models.Budget, error)
+	Delete(ctx context.Context, id, userID pgtype.UUID) error
+	ListSpendByUser(ctx context.Context, userID pgtype.UUID, monthStart, monthEnd time.Time) ([]*BudgetSpend, error)
+}
+
+// BudgetSpend is a budget together with what the giver has spent and has reserved against it.
+// Spent sums purchase prices; Reserved sums the list prices of items the giver reserved but has not purchased yet.
+type BudgetSpend struct {
+	models.Budget
+	WishlistTitle pgtype.Text    `db:"wishlist_title"`
+	Spent         pgtype.Numeric `db:"spent"`
+	Reserved      pgtype.Numeric `db:"reserved"`
+}
+
+type BudgetRepository struct {
+	db *database.DB
+}
+
+func NewBudgetRepository(db *database.DB) BudgetRepositoryInterface {
+	return &BudgetRepository{
+		db: db,
+	}
+}
+
+// Upsert creates the budget or replaces the amount of the user's existing budget for the same period (and wishlist).
+// Returns ErrBudgetWishlistNotFound when an occasion budget references a wishlist that does not exist.
+func (r *BudgetRepository) Upsert(ctx context.Context, budget models.Budget) (*models.Budget, error) {
+	var (
+		query string
+		args  []any
+	)
+
+	if budget.Period == models.BudgetPeriodOccasion {
+		query = `
+			INSERT INTO giver_budgets (user_id, period, wishlist_id, amount)
+			SELECT $1, $2, w.id, $4 FROM wishlists w WHERE w.id = $3
+			ON CONFLICT (user_id, wishlist_id) WHERE wishlist_id IS NOT NULL
+			DO UPDATE SET amount = EXCLUDED.amount, updated_at = NOW()
+			RETURNING ` + budgetColumns
+		args = []any{budget.UserID, budget.Period, budget.WishlistID, budget.Amount}
+	} else {
+		query = `
+			INSERT INTO giver_budgets (user_id, period, amount)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) WHERE wishlist_id IS NULL
+			DO UPDATE SET amount = EXCLUDED.amount, updated_at = NOW()
+			RETURNING ` + budgetColumns
+		args = []any{budget.UserID, budget.Period, budget.Amount}
+	}
+
+	var saved models.Budget
+	if err := r.db.QueryRowxContext(ctx, query, args...).StructScan(&saved); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrBudgetWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to save budget: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// Delete removes a budget owned by the user
+func (r *BudgetRepository) Delete(ctx context.Context, id, userID pgtype.UUID) error {
+	query := `DELETE FROM giver_budgets WHERE id = $1 AND user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+// ListSpendByUser returns the user's budgets with their running spend.
+// Monthly budgets count purchases and reservations made in [monthStart, monthEnd);
+// occasion budgets count those on the budget's wishlist regardless of date.
+func (r *BudgetRepository) ListSpendByUser(ctx context.Context, userID pgtype.UUID, monthStart, monthEnd time.Time) ([]*BudgetSpend, error) {
+	query := `
+		SELECT
+			b.id, b.user_id, b.period, b.wishlist_id, b.amount, b.created_at, b.updated_at,
+			w.title AS wishlist_title,
+			spent.total AS spent,
+			reserved.total AS reserved
+		FROM giver_budgets b
+		LEFT JOIN wishlists w ON w.id = b.wishlist_id
+		CROSS JOIN LATERAL (
+			-- Items purchased before purchased_price was recorded fall back to the list price
+			SELECT COALESCE(SUM(COALESCE(gi.purchased_price, gi.price)), 0) AS total
+			FROM gift_items gi
+			WHERE gi.purchased_by_user_id = b.user_id
+				AND (
+					(b.wishlist_id IS NULL AND gi.purchased_at >= $2 AND gi.purchased_at < $3)
+					OR EXISTS (
+						SELECT 1 FROM wishlist_items wi
+						WHERE wi.gift_item_id = gi.id AND wi.wishlist_id = b.wishlist_id
+					)
+				)
+		) spent
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(gi.price), 0) AS total
+			FROM reservations res
+			JOIN gift_items gi ON gi.id = res.gift_item_id
+			WHERE res.reserved_by_user_id = b.user_id
+				AND res.status = 'active'
+				AND gi.purchased_at IS NULL
+				AND (
+					(b.wishlist_id IS NULL AND res.reserved_at >= $2 AND res.reserved_at < $3)
+					OR res.wishlist_id = b.wishlist_id
+				)
+		) reserved
+		WHERE b.user_id = $1
+		ORDER BY b.period, b.created_at
+	`
+
+	var budgets []*BudgetSpend
+	if err := r.db.SelectContext(ctx, &budgets, query, userID, monthStart, monthEnd); err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,123 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_guest_confirmation_repository_test.go -pkg service . GuestConfirmationRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/pkg/encryption"
+)
+
+var ErrGuestConfirmationNotFound = errors.New("guest reservation confirmation not found")
+
+// GuestConfirmationRepositoryInterface defines the interface for held guest reservations
+type GuestConfirmationRepositoryInterface interface {
+	Create(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error)
+	Take(ctx context.Context, token pgtype.UUID) (*models.GuestConfirmation, error)
+}
+
+type GuestConfirmationRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewGuestConfirmationRepository(db *database.DB) GuestConfirmationRepositoryInterface {
+	return &GuestConfirmationRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewGuestConfirmationRepositoryWithEncryption creates a new GuestConfirmationRepository with encryption enabled
+func NewGuestConfirmationRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) GuestConfirmationRepositoryInterface {
+	return &GuestConfirmationRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+const guestConfirmationColumns = `confirmation_token, wishlist_id, gift_item_id, details, encrypted_details, score, expires_at, created_at`
+
+// Create holds a guest reservation and purges the holds that expired
+func (r *GuestConfirmationRepository) Create(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error) {
+	if r.encryptionEnabled && r.encryptionSvc != nil && confirmation.Details.Valid {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, confirmation.Details.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt guest confirmation details: %w", err)
+		}
+		confirmation.EncryptedDetails = pgtype.Text{String: encrypted, Valid: true}
+		// Avoid persisting plaintext when encryption is enabled
+		confirmation.Details = pgtype.Text{Valid: false}
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM guest_reservation_confirmations WHERE expires_at < NOW()`); err != nil {
+		return nil, fmt.Errorf("failed to purge guest reservation confirmations: %w", err)
+	}
+
+	query := `
+		INSERT INTO guest_reservation_confirmations (wishlist_id, gift_item_id, details, encrypted_details, score, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + guestConfirmationColumns
+
+	var created models.GuestConfirmation
+	err := r.db.QueryRowxContext(ctx, query,
+		confirmation.WishlistID,
+		confirmation.GiftItemID,
+		confirmation.Details,
+		confirmation.EncryptedDetails,
+		confirmation.Score,
+		confirmation.ExpiresAt,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create guest reservation confirmation: %w", err)
+	}
+
+	if err := r.decryptDetails(ctx, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// Take removes and returns the hold with the token, so a code confirms one
+// reservation only. Expired holds are not found.
+func (r *GuestConfirmationRepository) Take(ctx context.Context, token pgtype.UUID) (*models.GuestConfirmation, error) {
+	query := `
+		DELETE FROM guest_reservation_confirmations
+		WHERE confirmation_token = $1 AND expires_at > NOW()
+		RETURNING ` + guestConfirmationColumns
+
+	var confirmation models.GuestConfirmation
+	if err := r.db.GetContext(ctx, &confirmation, query, token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGuestConfirmationNotFound
+		}
+		return nil, fmt.Errorf("failed to take guest reservation confirmation: %w", err)
+	}
+
+	if err := r.decryptDetails(ctx, &confirmation); err != nil {
+		return nil, err
+	}
+	return &confirmation, nil
+}
+
+func (r *GuestConfirmationRepository) decryptDetails(ctx context.Context, confirmation *models.GuestConfirmation) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil || !confirmation.EncryptedDetails.Valid {
+		return nil
+	}
+
+	decrypted, err := r.encryptionSvc.Decrypt(ctx, confirmation.EncryptedDetails.String)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt guest confirmation details: %w", err)
+	}
+	confirmation.Details = pgtype.Text{String: decrypted, Valid: true}
+	return nil
+}
@@ -0,0 +1,66 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_guest_limit_repository_test.go -pkg service . GuestLimitRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+)
+
+// GuestLimitRepositoryInterface defines the interface for guest reservation cap database operations
+type GuestLimitRepositoryInterface interface {
+	Usage(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error)
+	Record(ctx context.Context, reservationID pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error
+}
+
+type GuestLimitRepository struct {
+	db *database.DB
+}
+
+func NewGuestLimitRepository(db *database.DB) GuestLimitRepositoryInterface {
+	return &GuestLimitRepository{
+		db: db,
+	}
+}
+
+// Usage counts the subject's guest reservations since the given time
+func (r *GuestLimitRepository) Usage(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+	query := `
+		SELECT COUNT(*) AS count, COALESCE(MIN(created_at), 'epoch'::timestamptz) AS oldest
+		FROM guest_reservation_events
+		WHERE subject_type = $1 AND subject_hash = $2 AND created_at >= $3
+	`
+
+	var usage models.GuestUsage
+	if err := r.db.GetContext(ctx, &usage, query, subject.Type, subject.Hash, since); err != nil {
+		return nil, fmt.Errorf("failed to count guest reservations: %w", err)
+	}
+	if usage.Count == 0 {
+		usage.Oldest = time.Time{}
+	}
+
+	return &usage, nil
+}
+
+// Record counts a guest reservation against each subject and purges events that
+// are older than every window
+func (r *GuestLimitRepository) Record(ctx context.Context, reservationID pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error {
+	query := `INSERT INTO guest_reservation_events (subject_type, subject_hash, reservation_id) VALUES ($1, $2, $3)`
+	for _, subject := range subjects {
+		if _, err := r.db.ExecContext(ctx, query, subject.Type, subject.Hash, reservationID); err != nil {
+			return fmt.Errorf("failed to record guest reservation: %w", err)
+		}
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM guest_reservation_events WHERE created_at < $1`, purgeBefore); err != nil {
+		return fmt.Errorf("failed to purge guest reservation events: %w", err)
+	}
+
+	return nil
+}
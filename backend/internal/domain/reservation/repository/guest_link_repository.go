@@ -0,0 +1,150 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_guest_link_repository_test.go -pkg service . GuestLinkRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+)
+
+// GuestLinkRepositoryInterface defines the interface for the guest reservation linking queue
+type GuestLinkRepositoryInterface interface {
+	Enqueue(ctx context.Context, userID pgtype.UUID) error
+	ListDue(ctx context.Context, limit int) ([]*models.GuestLink, error)
+	MarkLinked(ctx context.Context, id pgtype.UUID, linked int) error
+	RecordFailure(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error
+	CompleteForUser(ctx context.Context, userID pgtype.UUID, linked int) error
+	ListFailed(ctx context.Context, limit, offset int) ([]*models.GuestLink, int, error)
+}
+
+type GuestLinkRepository struct {
+	db *database.DB
+}
+
+func NewGuestLinkRepository(db *database.DB) GuestLinkRepositoryInterface {
+	return &GuestLinkRepository{
+		db: db,
+	}
+}
+
+// Enqueue queues linking the user's guest reservations. A job already waiting for
+// the user is kept as it is.
+func (r *GuestLinkRepository) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	query := `
+		INSERT INTO guest_reservation_links (user_id) VALUES ($1)
+		ON CONFLICT (user_id) WHERE completed_at IS NULL AND failed_at IS NULL DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("failed to queue guest reservation link: %w", err)
+	}
+
+	return nil
+}
+
+// ListDue returns the oldest jobs that are due for an attempt, with the user's
+// current email
+func (r *GuestLinkRepository) ListDue(ctx context.Context, limit int) ([]*models.GuestLink, error) {
+	query := `
+		SELECT l.id, l.user_id, u.email, l.attempts, l.next_attempt_at, l.last_error, l.linked_count,
+			l.completed_at, l.failed_at, l.created_at
+		FROM guest_reservation_links l
+		JOIN users u ON u.id = l.user_id
+		WHERE l.completed_at IS NULL AND l.failed_at IS NULL AND l.next_attempt_at <= NOW()
+		ORDER BY l.created_at ASC
+		LIMIT $1
+	`
+
+	var links []*models.GuestLink
+	if err := r.db.SelectContext(ctx, &links, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to list due guest reservation links: %w", err)
+	}
+
+	return links, nil
+}
+
+// MarkLinked completes a job with the number of reservations it linked
+func (r *GuestLinkRepository) MarkLinked(ctx context.Context, id pgtype.UUID, linked int) error {
+	query := `
+		UPDATE guest_reservation_links SET
+			attempts = attempts + 1,
+			linked_count = $2,
+			last_error = NULL,
+			completed_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, linked); err != nil {
+		return fmt.Errorf("failed to mark guest reservations linked: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed attempt and backs off exponentially: the next
+// attempt is 2, 4, 8... minutes later. After maxAttempts the job is marked failed
+// and leaves the queue.
+func (r *GuestLinkRepository) RecordFailure(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error {
+	query := `
+		UPDATE guest_reservation_links SET
+			attempts = attempts + 1,
+			next_attempt_at = NOW() + make_interval(mins => power(2, attempts + 1)::int),
+			last_error = $3,
+			failed_at = CASE WHEN attempts + 1 >= $2 THEN NOW() END
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, maxAttempts, lastError); err != nil {
+		return fmt.Errorf("failed to record guest reservation link failure: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteForUser closes the user's waiting and failed jobs after their guest
+// reservations were linked some other way
+func (r *GuestLinkRepository) CompleteForUser(ctx context.Context, userID pgtype.UUID, linked int) error {
+	query := `
+		UPDATE guest_reservation_links SET
+			linked_count = $2,
+			completed_at = NOW()
+		WHERE user_id = $1 AND completed_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, linked); err != nil {
+		return fmt.Errorf("failed to complete guest reservation links: %w", err)
+	}
+
+	return nil
+}
+
+// ListFailed returns the jobs out of retries that nothing completed since, most
+// recent first, and how many there are in total. The email is left empty.
+func (r *GuestLinkRepository) ListFailed(ctx context.Context, limit, offset int) ([]*models.GuestLink, int, error) {
+	where := `FROM guest_reservation_links WHERE failed_at IS NOT NULL AND completed_at IS NULL`
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) `+where); err != nil {
+		return nil, 0, fmt.Errorf("failed to count failed guest reservation links: %w", err)
+	}
+
+	query := `
+		SELECT id, user_id, '' AS email, attempts, next_attempt_at, last_error, linked_count,
+			completed_at, failed_at, created_at
+		` + where + `
+		ORDER BY failed_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	var links []*models.GuestLink
+	if err := r.db.SelectContext(ctx, &links, query, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list failed guest reservation links: %w", err)
+	}
+
+	return links, total, nil
+}
@@ -0,0 +1,186 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_purchase_reminder_repository_test.go -pkg service . PurchaseReminderRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/pkg/encryption"
+)
+
+// PurchaseReminderRepositoryInterface defines the database operations for reminding
+// guests of the gifts they reserved but have not bought
+type PurchaseReminderRepositoryInterface interface {
+	ListDue(ctx context.Context, from, to time.Time) ([]*models.PurchaseReminder, error)
+	MarkReminded(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error
+	GetPurchase(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error)
+	MarkPurchased(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error)
+}
+
+type PurchaseReminderRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewPurchaseReminderRepository(db *database.DB) PurchaseReminderRepositoryInterface {
+	return &PurchaseReminderRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewPurchaseReminderRepositoryWithEncryption creates a new PurchaseReminderRepository with encryption enabled
+func NewPurchaseReminderRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) PurchaseReminderRepositoryInterface {
+	return &PurchaseReminderRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// ListDue returns the active guest reservations whose wishlist's occasion date is
+// between from and to, that neither the guest nor the owner marked as bought, and
+// whose guest has not been reminded of that date yet
+func (r *PurchaseReminderRepository) ListDue(ctx context.Context, from, to time.Time) ([]*models.PurchaseReminder, error) {
+	query := `
+		SELECT
+			r.id AS reservation_id,
+			r.guest_name,
+			r.encrypted_guest_name,
+			r.guest_email,
+			r.encrypted_guest_email,
+			gi.name AS gift_item_name,
+			gi.link AS gift_item_link,
+			w.title AS wishlist_title,
+			w.occasion_date
+		FROM reservations r
+		JOIN gift_items gi ON gi.id = r.gift_item_id
+		JOIN wishlists w ON w.id = r.wishlist_id
+		WHERE r.status = 'active'
+		  AND r.reserved_by_user_id IS NULL
+		  AND (r.guest_email IS NOT NULL OR r.encrypted_guest_email IS NOT NULL)
+		  AND r.purchased_at IS NULL
+		  AND gi.purchased_at IS NULL
+		  AND w.occasion_date BETWEEN $1 AND $2
+		  AND r.purchase_reminded_for IS DISTINCT FROM w.occasion_date
+		ORDER BY w.occasion_date ASC
+		LIMIT 500
+	`
+
+	var due []*models.PurchaseReminder
+	if err := r.db.SelectContext(ctx, &due, query, from, to); err != nil {
+		return nil, fmt.Errorf("failed to list reservations due for purchase reminder: %w", err)
+	}
+
+	for _, reminder := range due {
+		if err := r.decryptGuestPII(ctx, reminder); err != nil {
+			return nil, err
+		}
+	}
+
+	return due, nil
+}
+
+// MarkReminded records that the reservation's guest was reminded of occasionDate
+func (r *PurchaseReminderRepository) MarkReminded(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error {
+	query := `UPDATE reservations SET purchase_reminded_for = $2 WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, reservationID, occasionDate); err != nil {
+		return fmt.Errorf("failed to mark purchase reminder sent: %w", err)
+	}
+	return nil
+}
+
+// guestPurchaseColumns selects a GuestPurchase from a reservation aliased r
+const guestPurchaseColumns = `
+	r.id AS reservation_id,
+	r.gift_item_id,
+	r.reserved_by_user_id,
+	gi.name AS gift_item_name,
+	w.title AS wishlist_title,
+	r.purchased_at,
+	gi.purchased_at IS NOT NULL AS gift_item_purchased`
+
+// GetPurchase returns the reservation behind a purchase link. Returns
+// ErrReservationNotActive when the reservation was canceled or has expired.
+func (r *PurchaseReminderRepository) GetPurchase(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+	query := `
+		SELECT` + guestPurchaseColumns + `
+		FROM reservations r
+		JOIN gift_items gi ON gi.id = r.gift_item_id
+		JOIN wishlists w ON w.id = r.wishlist_id
+		WHERE r.id = $1 AND r.status = 'active'
+	`
+
+	var purchase models.GuestPurchase
+	if err := r.db.GetContext(ctx, &purchase, query, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReservationNotActive
+		}
+		return nil, fmt.Errorf("failed to get reservation purchase: %w", err)
+	}
+
+	return &purchase, nil
+}
+
+// MarkPurchased records that the guest bought the reserved gift. Marking it again
+// keeps the first time. Returns ErrReservationNotActive when the reservation was
+// canceled or has expired.
+func (r *PurchaseReminderRepository) MarkPurchased(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+	query := `
+		WITH r AS (
+			UPDATE reservations SET
+				purchased_at = COALESCE(purchased_at, NOW()),
+				updated_at = NOW()
+			WHERE id = $1 AND status = 'active'
+			RETURNING id, gift_item_id, wishlist_id, reserved_by_user_id, purchased_at
+		)
+		SELECT` + guestPurchaseColumns + `
+		FROM r
+		JOIN gift_items gi ON gi.id = r.gift_item_id
+		JOIN wishlists w ON w.id = r.wishlist_id
+	`
+
+	var purchase models.GuestPurchase
+	if err := r.db.GetContext(ctx, &purchase, query, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReservationNotActive
+		}
+		return nil, fmt.Errorf("failed to mark reservation purchased: %w", err)
+	}
+
+	return &purchase, nil
+}
+
+func (r *PurchaseReminderRepository) decryptGuestPII(ctx context.Context, reminder *models.PurchaseReminder) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if reminder.EncryptedGuestName.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, reminder.EncryptedGuestName.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt guest name: %w", err)
+		}
+		reminder.GuestName = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	if reminder.EncryptedGuestEmail.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, reminder.EncryptedGuestEmail.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt guest email: %w", err)
+		}
+		reminder.GuestEmail = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	return nil
+}
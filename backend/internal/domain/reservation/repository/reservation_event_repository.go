@@ -0,0 +1,97 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_reservation_event_repository_test.go -pkg service . ReservationEventRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/models"
+)
+
+// ReservationEventRepositoryInterface defines the interface for the reservation event log
+type ReservationEventRepositoryInterface interface {
+	Append(ctx context.Context, event models.ReservationEvent) error
+	ListByReservation(ctx context.Context, reservationID pgtype.UUID) ([]*models.ReservationEvent, error)
+	ListDrift(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error)
+}
+
+type ReservationEventRepository struct {
+	db *database.DB
+}
+
+func NewReservationEventRepository(db *database.DB) ReservationEventRepositoryInterface {
+	return &ReservationEventRepository{
+		db: db,
+	}
+}
+
+// Append adds an event to the log. Events are never updated or deleted.
+func (r *ReservationEventRepository) Append(ctx context.Context, event models.ReservationEvent) error {
+	query := `
+		INSERT INTO reservation_events (reservation_id, wishlist_id, gift_item_id, event_type, status, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		event.ReservationID,
+		event.WishlistID,
+		event.GiftItemID,
+		event.EventType,
+		event.Status,
+		event.Reason,
+	); err != nil {
+		return fmt.Errorf("failed to append reservation event: %w", err)
+	}
+
+	return nil
+}
+
+// ListByReservation returns the reservation's events, oldest first
+func (r *ReservationEventRepository) ListByReservation(ctx context.Context, reservationID pgtype.UUID) ([]*models.ReservationEvent, error) {
+	query := `
+		SELECT id, reservation_id, wishlist_id, gift_item_id, event_type, status, reason, occurred_at
+		FROM reservation_events
+		WHERE reservation_id = $1
+		ORDER BY id
+	`
+
+	var events []*models.ReservationEvent
+	if err := r.db.SelectContext(ctx, &events, query, reservationID); err != nil {
+		return nil, fmt.Errorf("failed to list reservation events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListDrift returns up to limit reservations whose status differs from the status
+// after their latest event, or that have no events at all. Reservations changed
+// after settledBefore are skipped, since their event may still be on its way.
+func (r *ReservationEventRepository) ListDrift(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error) {
+	query := `
+		SELECT res.id AS reservation_id, res.status, latest.status AS event_status
+		FROM reservations res
+		LEFT JOIN LATERAL (
+			SELECT e.status
+			FROM reservation_events e
+			WHERE e.reservation_id = res.id
+			ORDER BY e.id DESC
+			LIMIT 1
+		) latest ON TRUE
+		WHERE res.updated_at < $1
+		  AND latest.status IS DISTINCT FROM res.status
+		ORDER BY res.updated_at
+		LIMIT $2
+	`
+
+	var drift []*models.ReservationDrift
+	if err := r.db.Reader().SelectContext(ctx, &drift, query, settledBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to compare reservations with their events: %w", err)
+	}
+
+	return drift, nil
+}
@@ -10,18 +10,41 @@ import (
 	"strings"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
 
 	"wish-list/internal/app/database"
 	"wish-list/internal/domain/reservation/models"
 	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/variant"
 )
 
 // Sentinel errors for reservation repository
 var (
-	ErrReservationNotFound = errors.New("reservation not found")
-	ErrNoActiveReservation = errors.New("no active reservation found")
+	ErrReservationNotFound    = errors.New("reservation not found")
+	ErrNoActiveReservation    = errors.New("no active reservation found")
+	ErrPublicWishListNotFound = errors.New("public wishlist not found")
+	ErrGiftItemNotFound       = errors.New("gift item not found")
+	ErrInsufficientQuantity   = errors.New("not enough units left to reserve")
+	ErrReservationNotActive   = errors.New("reservation is not active")
+	ErrReservationClaimed     = errors.New("reservation already belongs to an account")
+	ErrRecipientOwnsWishlist  = errors.New("recipient owns the wishlist")
 )
 
+// InsufficientQuantityError is returned by Create when the gift item has fewer
+// unreserved units than requested. It unwraps to ErrInsufficientQuantity.
+type InsufficientQuantityError struct {
+	Remaining int32
+}
+
+func (e *InsufficientQuantityError) Error() string {
+	return fmt.Sprintf("%s: %d remaining", ErrInsufficientQuantity, e.Remaining)
+}
+
+func (e *InsufficientQuantityError) Unwrap() error {
+	return ErrInsufficientQuantity
+}
+
 // ReservationRepositoryInterface defines the interface for reservation database operations
 type ReservationRepositoryInterface interface {
 	Create(ctx context.Context, reservation models.Reservation) (*models.Reservation, error)
@@ -29,6 +52,8 @@ type ReservationRepositoryInterface interface {
 	GetByToken(ctx context.Context, token pgtype.UUID) (*models.Reservation, error)
 	GetByGiftItem(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Reservation, error)
 	GetActiveReservationForGiftItem(ctx context.Context, giftItemID pgtype.UUID) (*models.Reservation, error)
+	HasActiveReservationsForWishlist(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
+	ListPublicWishListReservationStatuses(ctx context.Context, publicSlug string) ([]PublicReservationStatus, error)
 	GetReservationsByUser(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]*models.Reservation, error)
 	UpdateStatus(ctx context.Context, reservationID pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error)
 	UpdateStatusByToken(ctx context.Context, token pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error)
@@ -36,6 +61,10 @@ type ReservationRepositoryInterface interface {
 	ListGuestReservationsWithDetails(ctx context.Context, token pgtype.UUID) ([]ReservationDetail, error)
 	CountUserReservations(ctx context.Context, userID pgtype.UUID) (int, error)
 	LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
+	ListGuestReservationsByEmail(ctx context.Context, guestEmail string) ([]*models.Reservation, error)
+	AnonymizeGuestReservationsByEmail(ctx context.Context, guestEmail string) (int, error)
+	ClaimByToken(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error)
+	TransferToUser(ctx context.Context, reservationID, fromUserID, toUserID pgtype.UUID) (*models.Reservation, error)
 }
 
 type ReservationDetail struct {
@@ -53,15 +82,37 @@ type ReservationDetail struct {
 	CanceledAt          pgtype.Timestamptz
 	CancelReason        pgtype.Text
 	NotificationSent    pgtype.Bool
+	Quantity            int32
+	Variant             variant.Choice
+	Message             pgtype.Text
+	EncryptedMessage    pgtype.Text `db:"encrypted_message"` // PII encrypted
 	GiftItemName        pgtype.Text
 	GiftItemImageURL    pgtype.Text
 	GiftItemPrice       pgtype.Numeric
+	GiftItemLink        pgtype.Text
 	WishlistID          pgtype.UUID
 	WishlistTitle       pgtype.Text
+	OccasionDate        pgtype.Date
 	OwnerFirstName      pgtype.Text
 	OwnerLastName       pgtype.Text
 }
 
+// PublicReservationStatus is an item of a public wishlist with its stock and its latest
+// active reservation, if any. The reservation fields are NULL when the item is not reserved.
+type PublicReservationStatus struct {
+	GiftItemID         pgtype.UUID        `db:"gift_item_id"`
+	Quantity           int32              `db:"quantity"`          // Units wanted
+	ReservedQuantity   int32              `db:"reserved_quantity"` // Units held by active reservations, expired ones included
+	ExpiredQuantity    int32              `db:"expired_quantity"`  // Units held by active reservations past their expiry
+	Unavailable        bool               `db:"unavailable"`       // Purchased or manually reserved by the owner
+	ReservedByUserID   pgtype.UUID        `db:"reserved_by_user_id"`
+	GuestName          pgtype.Text        `db:"guest_name"`
+	EncryptedGuestName pgtype.Text        `db:"encrypted_guest_name"` // PII encrypted
+	Status             pgtype.Text        `db:"status"`
+	ReservedAt         pgtype.Timestamptz `db:"reserved_at"`
+	ExpiresAt          pgtype.Timestamptz `db:"expires_at"`
+}
+
 type ReservationRepository struct {
 	db                *database.DB
 	encryptionSvc     *encryption.Service
@@ -112,6 +163,17 @@ func (r *ReservationRepository) encryptReservationPII(ctx context.Context, reser
 		reservation.GuestEmail = pgtype.Text{Valid: false}
 	}
 
+	// Encrypt giver message
+	if reservation.Message.Valid {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, reservation.Message.String)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt reservation message: %w", err)
+		}
+		reservation.EncryptedMessage = pgtype.Text{String: encrypted, Valid: true}
+		// Avoid persisting plaintext when encryption is enabled
+		reservation.Message = pgtype.Text{Valid: false}
+	}
+
 	return nil
 }
 
@@ -139,6 +201,15 @@ func (r *ReservationRepository) decryptReservationPII(ctx context.Context, reser
 		reservation.GuestEmail = pgtype.Text{String: decrypted, Valid: true}
 	}
 
+	// Decrypt giver message
+	if reservation.EncryptedMessage.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, reservation.EncryptedMessage.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt reservation message: %w", err)
+		}
+		reservation.Message = pgtype.Text{String: decrypted, Valid: true}
+	}
+
 	return nil
 }
 
@@ -166,30 +237,119 @@ func (r *ReservationRepository) decryptReservationDetailPII(ctx context.Context,
 		detail.GuestEmail = pgtype.Text{String: decrypted, Valid: true}
 	}
 
+	// Decrypt giver message
+	if detail.EncryptedMessage.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, detail.EncryptedMessage.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt reservation message: %w", err)
+		}
+		detail.Message = pgtype.Text{String: decrypted, Valid: true}
+	}
+
 	return nil
 }
 
-// Create inserts a new reservation into the database
+// Create inserts a new reservation into the database. The gift item row is
+// locked while its reserved_quantity is checked and increased by the
+// reservation's quantity, so concurrent guests cannot over-reserve an item.
+// Active reservations of the item that are past their expiry are expired first,
+// releasing their units, as the public status already reports those units open.
+// Items that are purchased or manually reserved cannot be reserved at all.
 func (r *ReservationRepository) Create(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
 	// Encrypt guest PII before inserting
 	if err := r.encryptReservationPII(ctx, &reservation); err != nil {
 		return nil, fmt.Errorf("failed to encrypt reservation PII: %w", err)
 	}
 
+	if reservation.Quantity < 1 {
+		reservation.Quantity = 1
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	var stock struct {
+		Quantity             int32              `db:"quantity"`
+		ReservedQuantity     int32              `db:"reserved_quantity"`
+		PurchasedByUserID    pgtype.UUID        `db:"purchased_by_user_id"`
+		ManualReservedByName pgtype.Text        `db:"manual_reserved_by_name"`
+		ManualReservedAt     pgtype.Timestamptz `db:"manual_reserved_at"`
+	}
+	lockQuery := `
+		SELECT quantity, reserved_quantity, purchased_by_user_id, manual_reserved_by_name, manual_reserved_at
+		FROM gift_items
+		WHERE id = $1
+		FOR UPDATE
+	`
+	if err := tx.GetContext(ctx, &stock, lockQuery, reservation.GiftItemID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGiftItemNotFound
+		}
+		return nil, fmt.Errorf("failed to lock gift item: %w", err)
+	}
+
+	expireQuery := `
+		WITH expired AS (
+			UPDATE reservations SET
+				status = 'expired',
+				updated_at = NOW()
+			WHERE gift_item_id = $1 AND status = 'active' AND expires_at < NOW()
+			RETURNING id, wishlist_id, gift_item_id, quantity
+		), events AS (
+			INSERT INTO reservation_events (reservation_id, wishlist_id, gift_item_id, event_type, status)
+			SELECT id, wishlist_id, gift_item_id, 'expired', 'expired' FROM expired
+		)
+		SELECT COALESCE(SUM(GREATEST(quantity, 1)), 0)::int FROM expired
+	`
+	var released int32
+	if err := tx.GetContext(ctx, &released, expireQuery, reservation.GiftItemID); err != nil {
+		return nil, fmt.Errorf("failed to expire reservations: %w", err)
+	}
+	stock.ReservedQuantity = max(stock.ReservedQuantity-released, 0)
+
+	// A purchased or manually reserved item has no units left, whatever its quantity
+	remaining := max(stock.Quantity-stock.ReservedQuantity, 0)
+	if stock.PurchasedByUserID.Valid || stock.ManualReservedByName.Valid || stock.ManualReservedAt.Valid {
+		remaining = 0
+	}
+	if reservation.Quantity > remaining {
+		return nil, &InsufficientQuantityError{Remaining: remaining}
+	}
+
+	holdQuery := `
+		UPDATE gift_items SET
+			reserved_quantity = $2,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, holdQuery, reservation.GiftItemID, stock.ReservedQuantity+reservation.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to update reserved quantity: %w", err)
+	}
+
 	query := `
 		INSERT INTO reservations (
 			wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
-			guest_email, encrypted_guest_email, status, reserved_at, expires_at
+			guest_email, encrypted_guest_email, status, reserved_at, expires_at, quantity, variant,
+			message, encrypted_message
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 		) RETURNING
 			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
 			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
 	`
 
 	var createdReservation models.Reservation
-	err := r.db.QueryRowxContext(ctx, query,
+	err = tx.QueryRowxContext(ctx, query,
 		reservation.WishlistID,
 		reservation.GiftItemID,
 		reservation.ReservedByUserID,
@@ -200,12 +360,20 @@ func (r *ReservationRepository) Create(ctx context.Context, reservation models.R
 		reservation.Status,
 		reservation.ReservedAt,
 		reservation.ExpiresAt,
+		reservation.Quantity,
+		reservation.Variant,
+		reservation.Message,
+		reservation.EncryptedMessage,
 	).StructScan(&createdReservation)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create reservation: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
 	// Decrypt guest PII before returning
 	if err := r.decryptReservationPII(ctx, &createdReservation); err != nil {
 		return nil, fmt.Errorf("failed to decrypt reservation PII: %w", err)
@@ -220,7 +388,8 @@ func (r *ReservationRepository) GetByID(ctx context.Context, id pgtype.UUID) (*m
 		SELECT
 			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
 			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
 		FROM reservations
 		WHERE id = $1
 	`
@@ -248,7 +417,8 @@ func (r *ReservationRepository) GetByToken(ctx context.Context, token pgtype.UUI
 		SELECT
 			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
 			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
 		FROM reservations
 		WHERE reservation_token = $1
 	`
@@ -276,7 +446,8 @@ func (r *ReservationRepository) GetByGiftItem(ctx context.Context, giftItemID pg
 		SELECT
 			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
 			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
 		FROM reservations
 		WHERE gift_item_id = $1
 		ORDER BY reserved_at DESC
@@ -304,7 +475,8 @@ func (r *ReservationRepository) GetActiveReservationForGiftItem(ctx context.Cont
 		SELECT
 			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
 			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
 		FROM reservations
 		WHERE gift_item_id = $1 AND status = 'active'
 		LIMIT 1
@@ -327,12 +499,103 @@ func (r *ReservationRepository) GetActiveReservationForGiftItem(ctx context.Cont
 	return &reservation, nil
 }
 
+// HasActiveReservationsForWishlist reports whether any non-archived item of the wishlist
+// has an active reservation
+func (r *ReservationRepository) HasActiveReservationsForWishlist(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM wishlist_items wi
+			JOIN gift_items gi ON gi.id = wi.gift_item_id AND gi.archived_at IS NULL
+			JOIN reservations r ON r.gift_item_id = gi.id AND r.status = 'active'
+			WHERE wi.wishlist_id = $1
+		)
+	`
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, wishlistID); err != nil {
+		return false, fmt.Errorf("failed to check active reservations for wishlist: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ListPublicWishListReservationStatuses returns every item of a public wishlist together with
+// its stock and latest active reservation in a single query. Returns ErrPublicWishListNotFound
+// when no public wishlist has the slug.
+func (r *ReservationRepository) ListPublicWishListReservationStatuses(ctx context.Context, publicSlug string) ([]PublicReservationStatus, error) {
+	// The LEFT JOIN from wishlists yields one row with a NULL item for an empty wishlist,
+	// which tells an empty list apart from an unknown slug
+	query := `
+		SELECT
+			gi.id AS gift_item_id,
+			GREATEST(COALESCE(gi.quantity, 1), 1) AS quantity,
+			COALESCE(gi.reserved_quantity, 0) AS reserved_quantity,
+			COALESCE((
+				SELECT SUM(r.quantity)
+				FROM reservations r
+				WHERE r.gift_item_id = gi.id
+				  AND r.status = 'active'
+				  AND r.expires_at < NOW()
+			), 0)::int AS expired_quantity,
+			COALESCE(gi.purchased_by_user_id IS NOT NULL
+				OR gi.manual_reserved_by_name IS NOT NULL
+				OR gi.manual_reserved_at IS NOT NULL, false) AS unavailable,
+			ar.reserved_by_user_id,
+			ar.guest_name,
+			ar.encrypted_guest_name,
+			ar.status,
+			ar.reserved_at,
+			ar.expires_at
+		FROM wishlists w
+		LEFT JOIN wishlist_items wi ON wi.wishlist_id = w.id
+		LEFT JOIN gift_items gi ON gi.id = wi.gift_item_id AND gi.archived_at IS NULL
+		LEFT JOIN LATERAL (
+			SELECT r.reserved_by_user_id, r.guest_name, r.encrypted_guest_name, r.status, r.reserved_at, r.expires_at
+			FROM reservations r
+			WHERE r.gift_item_id = gi.id
+			  AND r.status = 'active'
+			ORDER BY r.reserved_at DESC
+			LIMIT 1
+		) ar ON true
+		WHERE w.public_slug = $1 AND w.is_public = true
+		ORDER BY gi.position ASC
+	`
+
+	var rows []PublicReservationStatus
+	if err := r.db.Reader().SelectContext(ctx, &rows, query, publicSlug); err != nil {
+		return nil, fmt.Errorf("failed to list public wishlist reservation statuses: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrPublicWishListNotFound
+	}
+
+	statuses := make([]PublicReservationStatus, 0, len(rows))
+	for i := range rows {
+		if !rows[i].GiftItemID.Valid {
+			continue
+		}
+		if r.encryptionEnabled && r.encryptionSvc != nil && rows[i].EncryptedGuestName.Valid {
+			decrypted, err := r.encryptionSvc.Decrypt(ctx, rows[i].EncryptedGuestName.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt guest name: %w", err)
+			}
+			rows[i].GuestName = pgtype.Text{String: decrypted, Valid: true}
+		}
+		statuses = append(statuses, rows[i])
+	}
+
+	return statuses, nil
+}
+
 // GetReservationsByUser retrieves reservations made by a user
 func (r *ReservationRepository) GetReservationsByUser(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]*models.Reservation, error) {
 	query := `
 		SELECT r.id, r.wishlist_id, r.gift_item_id, r.reserved_by_user_id, r.guest_name, r.encrypted_guest_name,
 			r.guest_email, r.encrypted_guest_email, r.reservation_token, r.status, r.reserved_at,
-			r.expires_at, r.canceled_at, r.cancel_reason, r.notification_sent, r.updated_at
+			r.expires_at, r.canceled_at, r.cancel_reason, r.notification_sent, r.updated_at, r.quantity, r.variant,
+			r.message, r.encrypted_message
 		FROM reservations r
 		JOIN gift_items gi ON r.gift_item_id = gi.id
 		WHERE r.reserved_by_user_id = $1 AND r.status = 'active'
@@ -356,19 +619,42 @@ func (r *ReservationRepository) GetReservationsByUser(ctx context.Context, userI
 	return reservations, nil
 }
 
-// UpdateStatus updates the status of a reservation
+// UpdateStatus updates the status of a reservation. Moving an active
+// reservation to any other status releases its units on the gift item.
 func (r *ReservationRepository) UpdateStatus(ctx context.Context, reservationID pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error) {
 	query := `
-		UPDATE reservations SET
-			status = $2,
-			canceled_at = CASE WHEN $2 = 'canceled' THEN $3 ELSE canceled_at END,
-			cancel_reason = CASE WHEN $2 = 'canceled' THEN $4 ELSE cancel_reason END,
-			updated_at = NOW()
-		WHERE id = $1
-		RETURNING
-			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
-			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+		WITH prev AS (
+			SELECT id, gift_item_id, status, quantity
+			FROM reservations
+			WHERE id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE reservations r SET
+				status = $2,
+				canceled_at = CASE WHEN $2 = 'canceled' THEN $3 ELSE r.canceled_at END,
+				cancel_reason = CASE WHEN $2 = 'canceled' THEN $4 ELSE r.cancel_reason END,
+				updated_at = NOW()
+			FROM prev
+			WHERE r.id = prev.id
+			RETURNING
+				r.id, r.wishlist_id, r.gift_item_id, r.reserved_by_user_id, r.guest_name, r.encrypted_guest_name,
+				r.guest_email, r.encrypted_guest_email, r.reservation_token, r.status, r.reserved_at,
+				r.expires_at, r.canceled_at, r.cancel_reason, r.notification_sent, r.updated_at, r.quantity, r.variant,
+				r.message, r.encrypted_message
+		), released AS (
+			-- Leaving the active state gives the reserved units back to the item
+			UPDATE gift_items gi SET
+				reserved_quantity = GREATEST(gi.reserved_quantity - prev.quantity, 0),
+				reserved_by_user_id = CASE WHEN gi.reserved_quantity - prev.quantity <= 0 THEN NULL ELSE gi.reserved_by_user_id END,
+				reserved_at = CASE WHEN gi.reserved_quantity - prev.quantity <= 0 THEN NULL ELSE gi.reserved_at END,
+				version = gi.version + 1,
+				updated_at = NOW()
+			FROM prev
+			WHERE gi.id = prev.gift_item_id
+			  AND prev.status = 'active'
+			  AND $2 <> 'active'
+		)
+		SELECT * FROM updated
 	`
 
 	var updatedReservation models.Reservation
@@ -397,16 +683,38 @@ func (r *ReservationRepository) UpdateStatus(ctx context.Context, reservationID
 // UpdateStatusByToken updates the status of a reservation by token
 func (r *ReservationRepository) UpdateStatusByToken(ctx context.Context, token pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error) {
 	query := `
-		UPDATE reservations SET
-			status = $2,
-			canceled_at = CASE WHEN $2 = 'canceled' THEN $3 ELSE canceled_at END,
-			cancel_reason = CASE WHEN $2 = 'canceled' THEN $4 ELSE cancel_reason END,
-			updated_at = NOW()
-		WHERE reservation_token = $1
-		RETURNING
-			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
-			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
-			expires_at, canceled_at, cancel_reason, notification_sent, updated_at
+		WITH prev AS (
+			SELECT id, gift_item_id, status, quantity
+			FROM reservations
+			WHERE reservation_token = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE reservations r SET
+				status = $2,
+				canceled_at = CASE WHEN $2 = 'canceled' THEN $3 ELSE r.canceled_at END,
+				cancel_reason = CASE WHEN $2 = 'canceled' THEN $4 ELSE r.cancel_reason END,
+				updated_at = NOW()
+			FROM prev
+			WHERE r.id = prev.id
+			RETURNING
+				r.id, r.wishlist_id, r.gift_item_id, r.reserved_by_user_id, r.guest_name, r.encrypted_guest_name,
+				r.guest_email, r.encrypted_guest_email, r.reservation_token, r.status, r.reserved_at,
+				r.expires_at, r.canceled_at, r.cancel_reason, r.notification_sent, r.updated_at, r.quantity, r.variant,
+				r.message, r.encrypted_message
+		), released AS (
+			-- Leaving the active state gives the reserved units back to the item
+			UPDATE gift_items gi SET
+				reserved_quantity = GREATEST(gi.reserved_quantity - prev.quantity, 0),
+				reserved_by_user_id = CASE WHEN gi.reserved_quantity - prev.quantity <= 0 THEN NULL ELSE gi.reserved_by_user_id END,
+				reserved_at = CASE WHEN gi.reserved_quantity - prev.quantity <= 0 THEN NULL ELSE gi.reserved_at END,
+				version = gi.version + 1,
+				updated_at = NOW()
+			FROM prev
+			WHERE gi.id = prev.gift_item_id
+			  AND prev.status = 'active'
+			  AND $2 <> 'active'
+		)
+		SELECT * FROM updated
 	`
 
 	var updatedReservation models.Reservation
@@ -450,11 +758,17 @@ func (r *ReservationRepository) ListUserReservationsWithDetails(ctx context.Cont
 			r.canceled_at,
 			r.cancel_reason,
 			r.notification_sent,
+			r.quantity,
+			r.variant,
+			r.message,
+			r.encrypted_message,
 			gi.name as gift_item_name,
 			gi.image_url as gift_item_image_url,
 			gi.price as gift_item_price,
+			gi.link as gift_item_link,
 			w.id as wishlist_id,
 			w.title as wishlist_title,
+			w.occasion_date,
 			u.first_name as owner_first_name,
 			u.last_name as owner_last_name
 		FROM reservations r
@@ -501,11 +815,17 @@ func (r *ReservationRepository) ListGuestReservationsWithDetails(ctx context.Con
 			r.canceled_at,
 			r.cancel_reason,
 			r.notification_sent,
+			r.quantity,
+			r.variant,
+			r.message,
+			r.encrypted_message,
 			gi.name as gift_item_name,
 			gi.image_url as gift_item_image_url,
 			gi.price as gift_item_price,
+			gi.link as gift_item_link,
 			w.id as wishlist_id,
 			w.title as wishlist_title,
+			w.occasion_date,
 			u.first_name as owner_first_name,
 			u.last_name as owner_last_name
 		FROM reservations r
@@ -657,3 +977,268 @@ func (r *ReservationRepository) LinkGuestReservationsToUserByEmail(ctx context.C
 
 	return linkedCount, nil
 }
+
+// ListGuestReservationsByEmail returns every reservation (any status) made with the given guest email.
+// Used for GDPR data export of guest PII.
+func (r *ReservationRepository) ListGuestReservationsByEmail(ctx context.Context, guestEmail string) ([]*models.Reservation, error) {
+	normalizedEmail := strings.ToLower(strings.TrimSpace(guestEmail))
+	if normalizedEmail == "" {
+		return nil, nil
+	}
+
+	// Fast path when encryption is disabled: match directly in SQL.
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		query := `
+			SELECT
+				id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
+				guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
+				expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+				message, encrypted_message
+			FROM reservations
+			WHERE guest_email IS NOT NULL
+			  AND LOWER(TRIM(guest_email)) = $1
+			ORDER BY reserved_at DESC
+		`
+
+		var reservations []*models.Reservation
+		if err := r.db.SelectContext(ctx, &reservations, query, normalizedEmail); err != nil {
+			return nil, fmt.Errorf("failed to list guest reservations by email: %w", err)
+		}
+
+		return reservations, nil
+	}
+
+	// Encryption-enabled path: fetch candidate rows, decrypt, compare in app layer.
+	query := `
+		SELECT
+			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
+			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
+		FROM reservations
+		WHERE guest_email IS NOT NULL OR encrypted_guest_email IS NOT NULL
+		ORDER BY reserved_at DESC
+	`
+
+	var candidates []*models.Reservation
+	if err := r.db.SelectContext(ctx, &candidates, query); err != nil {
+		return nil, fmt.Errorf("failed to load guest reservation candidates: %w", err)
+	}
+
+	var matched []*models.Reservation
+	for _, reservation := range candidates {
+		if err := r.decryptReservationPII(ctx, reservation); err != nil {
+			// Skip corrupted row to keep matching best-effort.
+			continue
+		}
+
+		if reservation.GuestEmail.Valid && strings.ToLower(strings.TrimSpace(reservation.GuestEmail.String)) == normalizedEmail {
+			matched = append(matched, reservation)
+		}
+	}
+
+	return matched, nil
+}
+
+// AnonymizeGuestReservationsByEmail removes guest name, email and message (plaintext
+// and encrypted) from every reservation made with the given guest email. Reservation rows
+// are kept so wishlist owners still see which items are taken. Returns the number of anonymized rows.
+func (r *ReservationRepository) AnonymizeGuestReservationsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	reservations, err := r.ListGuestReservationsByEmail(ctx, guestEmail)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(reservations) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]pgtype.UUID, len(reservations))
+	for i, reservation := range reservations {
+		ids[i] = reservation.ID
+	}
+
+	query := `
+		UPDATE reservations
+		SET guest_name = NULL,
+		    encrypted_guest_name = NULL,
+		    guest_email = NULL,
+		    encrypted_guest_email = NULL,
+		    message = NULL,
+		    encrypted_message = NULL,
+		    updated_at = NOW()
+		WHERE id = ANY($1::uuid[])
+	`
+
+	result, err := r.db.ExecContext(ctx, query, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize guest reservations: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for anonymization: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+// reservationHolder is a reservation locked for a change of hands, with the
+// owner of its wishlist
+type reservationHolder struct {
+	ID               pgtype.UUID `db:"id"`
+	GiftItemID       pgtype.UUID `db:"gift_item_id"`
+	ReservedByUserID pgtype.UUID `db:"reserved_by_user_id"`
+	Status           string      `db:"status"`
+	OwnerID          pgtype.UUID `db:"owner_id"`
+}
+
+// lockReservationHolder locks the reservation matching where (a condition on r)
+// for the rest of tx
+func lockReservationHolder(ctx context.Context, tx *sqlx.Tx, where string, arg any) (*reservationHolder, error) {
+	query := `
+		SELECT r.id, r.gift_item_id, r.reserved_by_user_id, r.status, w.owner_id
+		FROM reservations r
+		JOIN wishlists w ON w.id = r.wishlist_id
+		WHERE ` + where + `
+		FOR UPDATE OF r
+	`
+
+	var holder reservationHolder
+	if err := tx.GetContext(ctx, &holder, query, arg); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("failed to lock reservation: %w", err)
+	}
+	return &holder, nil
+}
+
+// ClaimByToken attaches the active guest reservation identified by its management
+// token to a user account and drops the guest expiry. Unlike
+// LinkGuestReservationsToUserByEmail it does not require the emails to match, as
+// holding the token proves the guest made the reservation.
+func (r *ReservationRepository) ClaimByToken(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	holder, err := lockReservationHolder(ctx, tx, "r.reservation_token = $1", token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case holder.Status != "active":
+		return nil, ErrReservationNotActive
+	case holder.ReservedByUserID.Valid:
+		return nil, ErrReservationClaimed
+	case holder.OwnerID == userID:
+		return nil, ErrRecipientOwnsWishlist
+	}
+
+	query := `
+		UPDATE reservations SET
+			reserved_by_user_id = $2,
+			expires_at = NULL,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
+			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
+	`
+
+	var claimed models.Reservation
+	if err := tx.QueryRowxContext(ctx, query, holder.ID, userID).StructScan(&claimed); err != nil {
+		return nil, fmt.Errorf("failed to claim reservation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation claim: %w", err)
+	}
+
+	if err := r.decryptReservationPII(ctx, &claimed); err != nil {
+		return nil, fmt.Errorf("failed to decrypt reservation PII: %w", err)
+	}
+
+	return &claimed, nil
+}
+
+// TransferToUser hands an active reservation held by fromUserID to toUserID.
+// Guest details left over from a claimed guest reservation and the previous
+// holder's message are removed, and the management token is rotated, so the
+// previous holder loses access to it.
+func (r *ReservationRepository) TransferToUser(ctx context.Context, reservationID, fromUserID, toUserID pgtype.UUID) (*models.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	holder, err := lockReservationHolder(ctx, tx, "r.id = $1", reservationID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case holder.ReservedByUserID != fromUserID:
+		return nil, ErrReservationNotFound
+	case holder.Status != "active":
+		return nil, ErrReservationNotActive
+	case holder.OwnerID == toUserID:
+		return nil, ErrRecipientOwnsWishlist
+	}
+
+	query := `
+		UPDATE reservations SET
+			reserved_by_user_id = $2,
+			guest_name = NULL,
+			encrypted_guest_name = NULL,
+			guest_email = NULL,
+			encrypted_guest_email = NULL,
+			message = NULL,
+			encrypted_message = NULL,
+			reservation_token = gen_random_uuid(),
+			expires_at = NULL,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, wishlist_id, gift_item_id, reserved_by_user_id, guest_name, encrypted_guest_name,
+			guest_email, encrypted_guest_email, reservation_token, status, reserved_at,
+			expires_at, canceled_at, cancel_reason, notification_sent, updated_at, quantity, variant,
+			message, encrypted_message
+	`
+
+	var transferred models.Reservation
+	if err := tx.QueryRowxContext(ctx, query, holder.ID, toUserID).StructScan(&transferred); err != nil {
+		return nil, fmt.Errorf("failed to transfer reservation: %w", err)
+	}
+
+	// Single-unit items also record the reserving user on the item itself
+	itemQuery := `
+		UPDATE gift_items SET
+			reserved_by_user_id = $3,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1 AND reserved_by_user_id = $2
+	`
+	if _, err := tx.ExecContext(ctx, itemQuery, holder.GiftItemID, fromUserID, toUserID); err != nil {
+		return nil, fmt.Errorf("failed to transfer gift item reservation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation transfer: %w", err)
+	}
+
+	return &transferred, nil
+}
@@ -168,6 +168,38 @@ func TestReservationRepository_GuestPIIEncryption(t *testing.T) {
 		}
 	})
 
+	t.Run("encrypt and decrypt giver message", func(t *testing.T) {
+		repo := setupTestReservationRepository(t, true)
+		ctx := context.Background()
+
+		reservation := models.Reservation{
+			GuestName: pgtype.Text{String: "Aunt Maria", Valid: true},
+			Message:   pgtype.Text{String: "Will bring it Saturday", Valid: true},
+		}
+
+		err := repo.encryptReservationPII(ctx, &reservation)
+		if err != nil {
+			t.Fatalf("encryptReservationPII failed: %v", err)
+		}
+
+		// Only the encrypted column is persisted
+		if reservation.Message.Valid {
+			t.Error("expected plaintext Message to be cleared when encryption enabled")
+		}
+		if !reservation.EncryptedMessage.Valid || reservation.EncryptedMessage.String == "" {
+			t.Error("expected EncryptedMessage to be populated")
+		}
+
+		err = repo.decryptReservationPII(ctx, &reservation)
+		if err != nil {
+			t.Fatalf("decryptReservationPII failed: %v", err)
+		}
+
+		if reservation.Message.String != "Will bring it Saturday" {
+			t.Errorf("expected message 'Will bring it Saturday', got %q", reservation.Message.String)
+		}
+	})
+
 	t.Run("encrypt with empty optional fields", func(t *testing.T) {
 		repo := setupTestReservationRepository(t, true)
 		ctx := context.Background()
@@ -190,6 +222,9 @@ func TestReservationRepository_GuestPIIEncryption(t *testing.T) {
 		if reservation.EncryptedGuestEmail.Valid {
 			t.Error("expected EncryptedGuestEmail to be invalid when GuestEmail is invalid")
 		}
+		if reservation.EncryptedMessage.Valid {
+			t.Error("expected EncryptedMessage to be invalid when Message is invalid")
+		}
 	})
 
 	t.Run("handle special characters in guest info", func(t *testing.T) {
@@ -381,3 +416,28 @@ func TestReservationRepository_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestReservationRepository_GuestEmailPrivacyOperations(t *testing.T) {
+	t.Run("blank email matches no reservations", func(t *testing.T) {
+		// No database is configured: a blank email must short-circuit before any query
+		for _, withEncryption := range []bool{false, true} {
+			repo := setupTestReservationRepository(t, withEncryption)
+
+			reservations, err := repo.ListGuestReservationsByEmail(context.Background(), "   ")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(reservations) != 0 {
+				t.Errorf("expected no reservations, got %d", len(reservations))
+			}
+
+			affected, err := repo.AnonymizeGuestReservationsByEmail(context.Background(), "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if affected != 0 {
+				t.Errorf("expected 0 anonymized reservations, got %d", affected)
+			}
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func BenchmarkReservationService_CreateReservation(b *testing.B) {
+	wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+
+	// The reserved item is the last of a 50 item wishlist, the worst case for the
+	// ownership check
+	wishlistItems := make([]*itemmodels.GiftItem, 0, 50)
+	for i := range 50 {
+		wishlistItems = append(wishlistItems, &itemmodels.GiftItem{
+			ID: pgtype.UUID{Bytes: [16]byte{1, byte(i)}, Valid: true},
+		})
+	}
+	giftItemID := wishlistItems[len(wishlistItems)-1].ID
+
+	giftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return wishlistItems, nil
+		},
+	}
+	repo := &ReservationRepositoryInterfaceMock{
+		GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+			return nil, repository.ErrNoActiveReservation
+		},
+		CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+			reservation.ID = pgtype.UUID{Bytes: [16]byte{3, 4, 5}, Valid: true}
+			return &reservation, nil
+		},
+	}
+	svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	guestName := "Load Test Guest"
+	input := CreateReservationInput{
+		WishListID: wishlistID.String(),
+		GiftItemID: giftItemID.String(),
+		GuestName:  &guestName,
+	}
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := svc.CreateReservation(ctx, input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Budget warnings reported alongside a giver's running spend
+const (
+	// BudgetWarningNearLimit: purchases reached budgetNearLimitRatio of the budget
+	BudgetWarningNearLimit = "near_limit"
+	// BudgetWarningExceeded: purchases went over the budget
+	BudgetWarningExceeded = "exceeded"
+	// BudgetWarningReservationsExceed: purchases plus still-open reservations would go over the budget
+	BudgetWarningReservationsExceed = "reservations_exceed_budget"
+)
+
+const budgetNearLimitRatio = 0.8
+
+var (
+	ErrInvalidBudgetPeriod    = errors.New("budget period must be monthly or occasion")
+	ErrInvalidBudgetAmount    = errors.New("budget amount must be greater than zero")
+	ErrBudgetWishlistRequired = errors.New("wishlist id is required for occasion budgets")
+	ErrBudgetWishlistNotFound = errors.New("budget wishlist not found")
+	ErrInvalidBudgetID        = errors.New("invalid budget id")
+	ErrBudgetNotFound         = errors.New("budget not found")
+)
+
+type SetBudgetInput struct {
+	Period     string
+	WishlistID string // Required for occasion budgets, ignored for monthly ones
+	Amount     float64
+}
+
+type BudgetOutput struct {
+	ID            pgtype.UUID
+	Period        string
+	WishlistID    pgtype.UUID
+	WishlistTitle *string
+	PeriodStart   *time.Time // Start of the current month for monthly budgets
+	PeriodEnd     *time.Time
+	Amount        float64
+	Spent         float64
+	Reserved      float64
+	Remaining     float64
+	Warnings      []string
+}
+
+// SetBudget creates the giver's budget for the period, or replaces its amount if one already exists
+func (s *ReservationService) SetBudget(ctx context.Context, userID pgtype.UUID, input SetBudgetInput) (*BudgetOutput, error) {
+	if input.Amount <= 0 || math.IsInf(input.Amount, 0) || math.IsNaN(input.Amount) {
+		return nil, ErrInvalidBudgetAmount
+	}
+
+	budget := models.Budget{
+		UserID: userID,
+		Period: input.Period,
+		Amount: pgtype.Numeric{Int: big.NewInt(int64(math.Round(input.Amount * 100))), Exp: -2, Valid: true},
+	}
+
+	switch input.Period {
+	case models.BudgetPeriodMonthly:
+	case models.BudgetPeriodOccasion:
+		if input.WishlistID == "" {
+			return nil, ErrBudgetWishlistRequired
+		}
+		if err := budget.WishlistID.Scan(input.WishlistID); err != nil {
+			return nil, ErrInvalidReservationWishlist
+		}
+	default:
+		return nil, ErrInvalidBudgetPeriod
+	}
+
+	saved, err := s.budgetRepo.Upsert(ctx, budget)
+	if err != nil {
+		if errors.Is(err, repository.ErrBudgetWishlistNotFound) {
+			return nil, ErrBudgetWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to save budget: %w", err)
+	}
+
+	// Re-read the budget with its running spend so the response matches GET /reservations/user
+	budgets, err := s.GetBudgetSummaries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range budgets {
+		if b.ID == saved.ID {
+			return b, nil
+		}
+	}
+
+	return nil, ErrBudgetNotFound
+}
+
+// DeleteBudget removes one of the giver's budgets
+func (s *ReservationService) DeleteBudget(ctx context.Context, userID pgtype.UUID, budgetID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(budgetID); err != nil {
+		return ErrInvalidBudgetID
+	}
+
+	if err := s.budgetRepo.Delete(ctx, id, userID); err != nil {
+		if errors.Is(err, repository.ErrBudgetNotFound) {
+			return ErrBudgetNotFound
+		}
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
+
+// GetBudgetSummaries returns the giver's budgets with running spend and warnings.
+// Monthly budgets cover the current calendar month (UTC).
+func (s *ReservationService) GetBudgetSummaries(ctx context.Context, userID pgtype.UUID) ([]*BudgetOutput, error) {
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	budgets, err := s.budgetRepo.ListSpendByUser(ctx, userID, monthStart, monthEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+
+	outputs := make([]*BudgetOutput, 0, len(budgets))
+	for _, b := range budgets {
+		output := &BudgetOutput{
+			ID:         b.ID,
+			Period:     b.Period,
+			WishlistID: b.WishlistID,
+			Amount:     numericToFloat(b.Amount),
+			Spent:      numericToFloat(b.Spent),
+			Reserved:   numericToFloat(b.Reserved),
+		}
+
+		if b.WishlistTitle.Valid {
+			output.WishlistTitle = &b.WishlistTitle.String
+		}
+		if b.Period == models.BudgetPeriodMonthly {
+			output.PeriodStart = &monthStart
+			output.PeriodEnd = &monthEnd
+		}
+
+		output.Remaining = math.Max(output.Amount-output.Spent, 0)
+		output.Warnings = budgetWarnings(output)
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+func budgetWarnings(b *BudgetOutput) []string {
+	warnings := []string{}
+
+	switch {
+	case b.Spent > b.Amount:
+		warnings = append(warnings, BudgetWarningExceeded)
+	case b.Spent >= b.Amount*budgetNearLimitRatio:
+		warnings = append(warnings, BudgetWarningNearLimit)
+	}
+
+	if b.Spent <= b.Amount && b.Spent+b.Reserved > b.Amount {
+		warnings = append(warnings, BudgetWarningReservationsExceed)
+	}
+
+	return warnings
+}
+
+func numericToFloat(n pgtype.Numeric) float64 {
+	if !n.Valid {
+		return 0
+	}
+	value, err := n.Float64Value()
+	if err != nil || !value.Valid {
+		return 0
+	}
+	return value.Float64
+}
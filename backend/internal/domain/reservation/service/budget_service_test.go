@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBudgetUserID     = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	testBudgetID         = pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+	testBudgetWishlistID = pgtype.UUID{Bytes: [16]byte{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, Valid: true}
+)
+
+func cents(amount int64) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(amount), Exp: -2, Valid: true}
+}
+
+func budgetSpend(period string, amount, spent, reserved int64) *repository.BudgetSpend {
+	spend := &repository.BudgetSpend{
+		Budget: models.Budget{
+			ID:     testBudgetID,
+			UserID: testBudgetUserID,
+			Period: period,
+			Amount: cents(amount),
+		},
+		Spent:    cents(spent),
+		Reserved: cents(reserved),
+	}
+	if period == models.BudgetPeriodOccasion {
+		spend.WishlistID = testBudgetWishlistID
+		spend.WishlistTitle = pgtype.Text{String: "Birthday", Valid: true}
+	}
+	return spend
+}
+
+func TestReservationService_GetBudgetSummaries(t *testing.T) {
+	tests := []struct {
+		name              string
+		amount            int64
+		spent             int64
+		reserved          int64
+		expectedWarnings  []string
+		expectedRemaining float64
+	}{
+		{name: "within budget", amount: 10000, spent: 2000, reserved: 1000, expectedWarnings: []string{}, expectedRemaining: 80},
+		{name: "near limit", amount: 10000, spent: 8500, expectedWarnings: []string{BudgetWarningNearLimit}, expectedRemaining: 15},
+		{name: "exceeded", amount: 10000, spent: 12000, reserved: 5000, expectedWarnings: []string{BudgetWarningExceeded}, expectedRemaining: 0},
+		{name: "reservations would exceed", amount: 10000, spent: 5000, reserved: 6000, expectedWarnings: []string{BudgetWarningReservationsExceed}, expectedRemaining: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budgetRepo := &BudgetRepositoryInterfaceMock{
+				ListSpendByUserFunc: func(ctx context.Context, userID pgtype.UUID, monthStart, monthEnd time.Time) ([]*repository.BudgetSpend, error) {
+					return []*repository.BudgetSpend{budgetSpend(models.BudgetPeriodOccasion, tt.amount, tt.spent, tt.reserved)}, nil
+				},
+			}
+
+			service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+			budgets, err := service.GetBudgetSummaries(context.Background(), testBudgetUserID)
+
+			require.NoError(t, err)
+			require.Len(t, budgets, 1)
+			assert.Equal(t, tt.expectedWarnings, budgets[0].Warnings)
+			assert.InDelta(t, tt.expectedRemaining, budgets[0].Remaining, 0.001)
+			assert.Equal(t, testBudgetWishlistID, budgets[0].WishlistID)
+			require.NotNil(t, budgets[0].WishlistTitle)
+			assert.Nil(t, budgets[0].PeriodStart)
+		})
+	}
+
+	t.Run("monthly budget covers the current month", func(t *testing.T) {
+		budgetRepo := &BudgetRepositoryInterfaceMock{
+			ListSpendByUserFunc: func(ctx context.Context, userID pgtype.UUID, monthStart, monthEnd time.Time) ([]*repository.BudgetSpend, error) {
+				return []*repository.BudgetSpend{budgetSpend(models.BudgetPeriodMonthly, 5000, 0, 0)}, nil
+			},
+		}
+
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+		budgets, err := service.GetBudgetSummaries(context.Background(), testBudgetUserID)
+
+		require.NoError(t, err)
+		require.Len(t, budgetRepo.ListSpendByUserCalls(), 1)
+		call := budgetRepo.ListSpendByUserCalls()[0]
+		assert.Equal(t, 1, call.MonthStart.Day())
+		assert.Equal(t, call.MonthStart.AddDate(0, 1, 0), call.MonthEnd)
+		assert.False(t, call.MonthStart.After(time.Now()))
+		require.Len(t, budgets, 1)
+		require.NotNil(t, budgets[0].PeriodStart)
+		assert.Equal(t, call.MonthStart, *budgets[0].PeriodStart)
+	})
+}
+
+func TestReservationService_SetBudget(t *testing.T) {
+	t.Run("saves occasion budget and returns its spend", func(t *testing.T) {
+		budgetRepo := &BudgetRepositoryInterfaceMock{
+			UpsertFunc: func(ctx context.Context, budget models.Budget) (*models.Budget, error) {
+				budget.ID = testBudgetID
+				return &budget, nil
+			},
+			ListSpendByUserFunc: func(ctx context.Context, userID pgtype.UUID, monthStart, monthEnd time.Time) ([]*repository.BudgetSpend, error) {
+				return []*repository.BudgetSpend{budgetSpend(models.BudgetPeriodOccasion, 7550, 1000, 0)}, nil
+			},
+		}
+
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+		budget, err := service.SetBudget(context.Background(), testBudgetUserID, SetBudgetInput{
+			Period:     models.BudgetPeriodOccasion,
+			WishlistID: testBudgetWishlistID.String(),
+			Amount:     75.5,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, budgetRepo.UpsertCalls(), 1)
+		saved := budgetRepo.UpsertCalls()[0].Budget
+		assert.Equal(t, testBudgetUserID, saved.UserID)
+		assert.Equal(t, testBudgetWishlistID, saved.WishlistID)
+		assert.Equal(t, int64(7550), saved.Amount.Int.Int64())
+		assert.InDelta(t, 10.0, budget.Spent, 0.001)
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			input         SetBudgetInput
+			expectedError error
+		}{
+			{name: "unknown period", input: SetBudgetInput{Period: "weekly", Amount: 10}, expectedError: ErrInvalidBudgetPeriod},
+			{name: "zero amount", input: SetBudgetInput{Period: models.BudgetPeriodMonthly}, expectedError: ErrInvalidBudgetAmount},
+			{name: "occasion without wishlist", input: SetBudgetInput{Period: models.BudgetPeriodOccasion, Amount: 10}, expectedError: ErrBudgetWishlistRequired},
+			{name: "invalid wishlist id", input: SetBudgetInput{Period: models.BudgetPeriodOccasion, WishlistID: "nope", Amount: 10}, expectedError: ErrInvalidReservationWishlist},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				budgetRepo := &BudgetRepositoryInterfaceMock{}
+				service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+
+				_, err := service.SetBudget(context.Background(), testBudgetUserID, tt.input)
+
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, budgetRepo.UpsertCalls())
+			})
+		}
+	})
+
+	t.Run("unknown wishlist", func(t *testing.T) {
+		budgetRepo := &BudgetRepositoryInterfaceMock{
+			UpsertFunc: func(ctx context.Context, budget models.Budget) (*models.Budget, error) {
+				return nil, repository.ErrBudgetWishlistNotFound
+			},
+		}
+
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.SetBudget(context.Background(), testBudgetUserID, SetBudgetInput{
+			Period:     models.BudgetPeriodOccasion,
+			WishlistID: testBudgetWishlistID.String(),
+			Amount:     10,
+		})
+
+		require.ErrorIs(t, err, ErrBudgetWishlistNotFound)
+	})
+}
+
+func TestReservationService_DeleteBudget(t *testing.T) {
+	t.Run("deletes own budget", func(t *testing.T) {
+		budgetRepo := &BudgetRepositoryInterfaceMock{
+			DeleteFunc: func(ctx context.Context, id, userID pgtype.UUID) error {
+				return nil
+			},
+		}
+
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+		err := service.DeleteBudget(context.Background(), testBudgetUserID, testBudgetID.String())
+
+		require.NoError(t, err)
+		require.Len(t, budgetRepo.DeleteCalls(), 1)
+		assert.Equal(t, testBudgetID, budgetRepo.DeleteCalls()[0].ID)
+		assert.Equal(t, testBudgetUserID, budgetRepo.DeleteCalls()[0].UserID)
+	})
+
+	t.Run("budget of another user", func(t *testing.T) {
+		budgetRepo := &BudgetRepositoryInterfaceMock{
+			DeleteFunc: func(ctx context.Context, id, userID pgtype.UUID) error {
+				return repository.ErrBudgetNotFound
+			},
+		}
+
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, budgetRepo, nil, nil, nil, nil, nil, nil, nil)
+		err := service.DeleteBudget(context.Background(), testBudgetUserID, testBudgetID.String())
+
+		require.ErrorIs(t, err, ErrBudgetNotFound)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		service := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, &BudgetRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil, nil)
+		err := service.DeleteBudget(context.Background(), testBudgetUserID, "nope")
+
+		require.ErrorIs(t, err, ErrInvalidBudgetID)
+	})
+}
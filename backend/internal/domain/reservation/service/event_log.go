@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/metrics"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// EventCheckInterval is how often reservations are compared with their event log
+	EventCheckInterval = time.Hour
+
+	// eventSettleTime leaves recent changes out of the comparison while their
+	// event is still being written
+	eventSettleTime = 10 * time.Minute
+
+	// eventCheckBatch caps the drift reported per check
+	eventCheckBatch = 500
+)
+
+// ReservationEventOutput is one step in a reservation's timeline
+type ReservationEventOutput struct {
+	EventType  string
+	Status     string
+	Reason     string // Why it was canceled; empty when not given
+	OccurredAt time.Time
+}
+
+// recordEvent appends a state change to the reservation's event log and counts
+// it in the business metrics. The change already happened, so a failure is logged
+// rather than returned; the consistency check reports the reservation until its
+// history is repaired.
+func (s *ReservationService) recordEvent(ctx context.Context, reservation *models.Reservation, eventType string) {
+	s.appendEvent(ctx, reservation, eventType, pgtype.Text{})
+}
+
+// recordCancellation records a cancellation with the reason the giver picked,
+// if any
+func (s *ReservationService) recordCancellation(ctx context.Context, reservation *models.Reservation, reason string) {
+	s.appendEvent(ctx, reservation, models.ReservationEventCanceled, pgtype.Text{String: reason, Valid: reason != ""})
+}
+
+func (s *ReservationService) appendEvent(ctx context.Context, reservation *models.Reservation, eventType string, reason pgtype.Text) {
+	countEvent(reservation, eventType)
+	if s.events == nil {
+		return
+	}
+	event := models.ReservationEvent{
+		ReservationID: reservation.ID,
+		WishlistID:    reservation.WishlistID,
+		GiftItemID:    reservation.GiftItemID,
+		EventType:     eventType,
+		Status:        reservation.Status,
+		Reason:        reason,
+	}
+	if err := s.events.Append(ctx, event); err != nil {
+		logger.WarnContext(ctx, "failed to record reservation event", "event", eventType, "reservation_id", reservation.ID.String(), "error", err)
+	}
+}
+
+// countEvent reports the funnel steps among the state changes to the business metrics
+func countEvent(reservation *models.Reservation, eventType string) {
+	guest := !reservation.ReservedByUserID.Valid
+	switch eventType {
+	case models.ReservationEventCreated:
+		metrics.ReservationCreated(guest)
+	case models.ReservationEventCanceled:
+		metrics.ReservationCanceled(guest)
+	case models.ReservationEventExpired:
+		metrics.ReservationExpired()
+	}
+}
+
+// countViewToReservation reports how long after opening the shared list the giver
+// reserved, when the client told when that was
+func countViewToReservation(viewedAt *time.Time) {
+	if viewedAt != nil {
+		metrics.ReservationAfterView(time.Since(*viewedAt))
+	}
+}
+
+// GetReservationTimeline returns the history of one of the user's reservations,
+// oldest first. A reservation handed to someone else is no longer the user's.
+func (s *ReservationService) GetReservationTimeline(ctx context.Context, userID pgtype.UUID, reservationID string) ([]*ReservationEventOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(reservationID); err != nil {
+		return nil, ErrInvalidReservationID
+	}
+
+	reservation, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	if reservation.ReservedByUserID != userID {
+		return nil, ErrReservationNotFound
+	}
+
+	return s.timeline(ctx, reservation.ID)
+}
+
+// GetGuestReservationTimeline returns the history of the reservation the guest
+// manages with token, oldest first
+func (s *ReservationService) GetGuestReservationTimeline(ctx context.Context, token pgtype.UUID) ([]*ReservationEventOutput, error) {
+	reservation, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	return s.timeline(ctx, reservation.ID)
+}
+
+func (s *ReservationService) timeline(ctx context.Context, reservationID pgtype.UUID) ([]*ReservationEventOutput, error) {
+	outputs := []*ReservationEventOutput{}
+	if s.events == nil {
+		return outputs, nil
+	}
+
+	events, err := s.events.ListByReservation(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservation events: %w", err)
+	}
+	for _, event := range events {
+		outputs = append(outputs, &ReservationEventOutput{
+			EventType:  event.EventType,
+			Status:     event.Status,
+			Reason:     event.Reason.String,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+
+	return outputs, nil
+}
+
+// CheckEventConsistency compares the status of each reservation with the status
+// its event log ends in and logs every reservation where they differ. It returns
+// how many were found, at most eventCheckBatch.
+func (s *ReservationService) CheckEventConsistency(ctx context.Context) (int, error) {
+	if s.events == nil {
+		return 0, nil
+	}
+
+	drift, err := s.events.ListDrift(ctx, time.Now().Add(-eventSettleTime), eventCheckBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check reservation events: %w", err)
+	}
+	for _, d := range drift {
+		logger.WarnContext(ctx, "reservation status disagrees with its events",
+			"reservation_id", d.ReservationID.String(),
+			"status", d.Status,
+			"event_status", d.EventStatus.String)
+	}
+
+	return len(drift), nil
+}
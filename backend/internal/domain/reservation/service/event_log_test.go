@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/metrics"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEventLog() *ReservationEventRepositoryInterfaceMock {
+	return &ReservationEventRepositoryInterfaceMock{
+		AppendFunc: func(ctx context.Context, event models.ReservationEvent) error {
+			return nil
+		},
+	}
+}
+
+func TestReservationService_RecordsEvents(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	token := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	reservation := &models.Reservation{
+		ID:         pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		WishlistID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		GiftItemID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true},
+	}
+	withStatus := func(status string) *models.Reservation {
+		changed := *reservation
+		changed.Status = status
+		return &changed
+	}
+
+	t.Run("claim", func(t *testing.T) {
+		events := newTestEventLog()
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ClaimByTokenFunc: func(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error) {
+				return withStatus("active"), nil
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.ClaimGuestReservation(context.Background(), userID, token)
+		require.NoError(t, err)
+		require.Len(t, events.AppendCalls(), 1)
+		assert.Equal(t, models.ReservationEvent{
+			ReservationID: reservation.ID,
+			WishlistID:    reservation.WishlistID,
+			GiftItemID:    reservation.GiftItemID,
+			EventType:     models.ReservationEventClaimed,
+			Status:        "active",
+		}, events.AppendCalls()[0].Event)
+	})
+
+	t.Run("guest cancellation", func(t *testing.T) {
+		events := newTestEventLog()
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			UpdateStatusByTokenFunc: func(ctx context.Context, token pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error) {
+				return withStatus(status), nil
+			},
+		}
+		giftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{{ID: reservation.GiftItemID}}, nil
+			},
+		}
+		svc := NewReservationService(mockRepo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.CancelReservation(context.Background(), CancelReservationInput{
+			WishListID:       reservation.WishlistID.String(),
+			GiftItemID:       reservation.GiftItemID.String(),
+			ReservationToken: &token,
+			Reason:           models.CancelReasonBoughtElsewhere,
+		})
+		require.NoError(t, err)
+		require.Len(t, events.AppendCalls(), 1)
+		assert.Equal(t, models.ReservationEventCanceled, events.AppendCalls()[0].Event.EventType)
+		assert.Equal(t, "canceled", events.AppendCalls()[0].Event.Status)
+		assert.Equal(t, pgtype.Text{String: models.CancelReasonBoughtElsewhere, Valid: true}, events.AppendCalls()[0].Event.Reason)
+	})
+
+	t.Run("unknown cancellation reason", func(t *testing.T) {
+		events := newTestEventLog()
+		mockRepo := &ReservationRepositoryInterfaceMock{}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.CancelReservation(context.Background(), CancelReservationInput{
+			WishListID:       reservation.WishlistID.String(),
+			GiftItemID:       reservation.GiftItemID.String(),
+			ReservationToken: &token,
+			Reason:           "the giver's own words",
+		})
+		require.ErrorIs(t, err, ErrInvalidCancelReason)
+		assert.Empty(t, mockRepo.UpdateStatusByTokenCalls())
+		assert.Empty(t, events.AppendCalls())
+	})
+
+	t.Run("a failed append does not undo the change", func(t *testing.T) {
+		events := &ReservationEventRepositoryInterfaceMock{
+			AppendFunc: func(ctx context.Context, event models.ReservationEvent) error {
+				return errors.New("connection reset")
+			},
+		}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ClaimByTokenFunc: func(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error) {
+				return withStatus("active"), nil
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.ClaimGuestReservation(context.Background(), userID, token)
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed changes are not recorded", func(t *testing.T) {
+		events := newTestEventLog()
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ClaimByTokenFunc: func(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrReservationClaimed
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.ClaimGuestReservation(context.Background(), userID, token)
+		require.ErrorIs(t, err, ErrReservationAlreadyClaimed)
+		assert.Empty(t, events.AppendCalls())
+	})
+}
+
+func TestReservationService_GetReservationTimeline(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	reservationID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	reservedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	events := &ReservationEventRepositoryInterfaceMock{
+		ListByReservationFunc: func(ctx context.Context, id pgtype.UUID) ([]*models.ReservationEvent, error) {
+			assert.Equal(t, reservationID, id)
+			return []*models.ReservationEvent{
+				{ReservationID: id, EventType: models.ReservationEventCreated, Status: "active", OccurredAt: reservedAt},
+				{ReservationID: id, EventType: models.ReservationEventCanceled, Status: "canceled", OccurredAt: reservedAt.Add(time.Hour)},
+			}, nil
+		},
+	}
+	newRepo := func(holder pgtype.UUID) *ReservationRepositoryInterfaceMock {
+		return &ReservationRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return &models.Reservation{ID: id, ReservedByUserID: holder, Status: "canceled"}, nil
+			},
+		}
+	}
+
+	t.Run("the holder sees the history", func(t *testing.T) {
+		svc := NewReservationService(newRepo(userID), &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		out, err := svc.GetReservationTimeline(context.Background(), userID, reservationID.String())
+		require.NoError(t, err)
+		assert.Equal(t, []*ReservationEventOutput{
+			{EventType: "created", Status: "active", OccurredAt: reservedAt},
+			{EventType: "canceled", Status: "canceled", OccurredAt: reservedAt.Add(time.Hour)},
+		}, out)
+	})
+
+	t.Run("someone else's reservation", func(t *testing.T) {
+		other := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+		svc := NewReservationService(newRepo(other), &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.GetReservationTimeline(context.Background(), userID, reservationID.String())
+		assert.ErrorIs(t, err, ErrReservationNotFound)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		_, err := svc.GetReservationTimeline(context.Background(), userID, "not-a-uuid")
+		assert.ErrorIs(t, err, ErrInvalidReservationID)
+	})
+}
+
+func TestReservationService_CheckEventConsistency(t *testing.T) {
+	t.Run("counts reservations that disagree with their events", func(t *testing.T) {
+		events := &ReservationEventRepositoryInterfaceMock{
+			ListDriftFunc: func(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error) {
+				assert.WithinDuration(t, time.Now().Add(-eventSettleTime), settledBefore, time.Minute)
+				assert.Equal(t, eventCheckBatch, limit)
+				return []*models.ReservationDrift{
+					{ReservationID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Status: "canceled", EventStatus: pgtype.Text{String: "active", Valid: true}},
+					{ReservationID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Status: "active"},
+				}, nil
+			},
+		}
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, events, nil)
+
+		drift, err := svc.CheckEventConsistency(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, drift)
+	})
+
+	t.Run("without an event log", func(t *testing.T) {
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		drift, err := svc.CheckEventConsistency(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, drift)
+	})
+}
+
+// scrapeMetric reads one series from the business metrics
+func scrapeMetric(t *testing.T, series string) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for line := range strings.Lines(rec.Body.String()) {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), series+" "); ok {
+			return value
+		}
+	}
+	t.Fatalf("series %s not exported", series)
+	return ""
+}
+
+func TestReservationService_CountsFunnelEvents(t *testing.T) {
+	guestCreated := `wishlist_reservations_created_total{actor="guest"}`
+	userCanceled := `wishlist_reservations_canceled_total{actor="user"}`
+	viewCount := "wishlist_view_to_reservation_seconds_count"
+	before := map[string]string{}
+	for _, series := range []string{guestCreated, userCanceled, viewCount} {
+		before[series] = scrapeMetric(t, series)
+	}
+	increased := func(series string) bool {
+		was, err := strconv.Atoi(before[series])
+		require.NoError(t, err)
+		now, err := strconv.Atoi(scrapeMetric(t, series))
+		require.NoError(t, err)
+		return now == was+1
+	}
+
+	svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc.recordEvent(context.Background(), &models.Reservation{}, models.ReservationEventCreated)
+	svc.recordEvent(context.Background(), &models.Reservation{ReservedByUserID: pgtype.UUID{Valid: true}}, models.ReservationEventCanceled)
+	viewedAt := time.Now().Add(-time.Minute)
+	countViewToReservation(&viewedAt)
+
+	assert.True(t, increased(guestCreated))
+	assert.True(t, increased(userCanceled))
+	assert.True(t, increased(viewCount))
+}
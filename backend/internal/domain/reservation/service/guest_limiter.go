@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrGuestReservationLimit is returned when a guest email or client address has
+// made too many reservations within the window
+var ErrGuestReservationLimit = errors.New("too many guest reservations")
+
+// GuestLimitError reports which cap a guest reservation hit and when the guest
+// may try again. It unwraps to ErrGuestReservationLimit.
+type GuestLimitError struct {
+	Subject    string // models.GuestSubjectEmail or models.GuestSubjectIP
+	Limit      int
+	RetryAfter time.Duration
+}
+
+func (e *GuestLimitError) Error() string {
+	return fmt.Sprintf("%s: %d per %s reached", ErrGuestReservationLimit, e.Limit, e.Subject)
+}
+
+func (e *GuestLimitError) Unwrap() error {
+	return ErrGuestReservationLimit
+}
+
+// GuestReservationLimits caps guest reservations per email and per client address
+// within Window. A cap of 0 is not enforced.
+type GuestReservationLimits struct {
+	PerEmail int
+	PerIP    int
+	Window   time.Duration
+}
+
+// GuestLimiter enforces GuestReservationLimits. Unlike the HTTP rate limiter it
+// counts completed reservations across all instances, so throwaway emails from one
+// address and one email from many addresses are both caught.
+type GuestLimiter struct {
+	repo      repository.GuestLimitRepositoryInterface
+	limits    GuestReservationLimits
+	timeNowFn func() time.Time
+}
+
+// NewGuestLimiter creates a GuestLimiter
+func NewGuestLimiter(repo repository.GuestLimitRepositoryInterface, limits GuestReservationLimits) *GuestLimiter {
+	return &GuestLimiter{
+		repo:      repo,
+		limits:    limits,
+		timeNowFn: time.Now,
+	}
+}
+
+// Check returns a *GuestLimitError when the guest's email or address has used up
+// its cap. Either may be empty.
+func (l *GuestLimiter) Check(ctx context.Context, email, clientIP string) error {
+	now := l.timeNowFn()
+	since := now.Add(-l.limits.Window)
+
+	for _, c := range l.caps(email, clientIP) {
+		usage, err := l.repo.Usage(ctx, c.subject, since)
+		if err != nil {
+			return fmt.Errorf("failed to check guest reservation limit: %w", err)
+		}
+		if usage.Count < c.limit {
+			continue
+		}
+
+		// The guest may reserve again once their oldest reservation leaves the window
+		retryAfter := usage.Oldest.Add(l.limits.Window).Sub(now)
+		if retryAfter < time.Second {
+			retryAfter = time.Second
+		}
+		return &GuestLimitError{Subject: c.subject.Type, Limit: c.limit, RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// Record counts a reservation against the guest's email and address
+func (l *GuestLimiter) Record(ctx context.Context, reservationID pgtype.UUID, email, clientIP string) error {
+	caps := l.caps(email, clientIP)
+	if len(caps) == 0 {
+		return nil
+	}
+
+	subjects := make([]models.GuestSubject, 0, len(caps))
+	for _, c := range caps {
+		subjects = append(subjects, c.subject)
+	}
+
+	return l.repo.Record(ctx, reservationID, subjects, l.timeNowFn().Add(-l.limits.Window))
+}
+
+type guestCap struct {
+	subject models.GuestSubject
+	limit   int
+}
+
+// caps returns the enforced caps that apply to the guest
+func (l *GuestLimiter) caps(email, clientIP string) []guestCap {
+	var caps []guestCap
+	if email != "" && l.limits.PerEmail > 0 {
+		caps = append(caps, guestCap{models.NewGuestSubject(models.GuestSubjectEmail, email), l.limits.PerEmail})
+	}
+	if clientIP != "" && l.limits.PerIP > 0 {
+		caps = append(caps, guestCap{models.NewGuestSubject(models.GuestSubjectIP, clientIP), l.limits.PerIP})
+	}
+	return caps
+}
+
+// checkGuestLimit applies the guest caps, if configured, and audits rejections
+func (s *ReservationService) checkGuestLimit(ctx context.Context, input CreateReservationInput, email string) error {
+	if s.guestLimiter == nil {
+		return nil
+	}
+
+	err := s.guestLimiter.Check(ctx, email, input.ClientIP)
+	var limitErr *GuestLimitError
+	if errors.As(err, &limitErr) {
+		logger.WarnContext(ctx, "guest reservation limit reached",
+			"audit", true,
+			"subject", limitErr.Subject,
+			"limit", limitErr.Limit,
+			"wishlist_id", input.WishListID,
+			"gift_item_id", input.GiftItemID)
+	}
+	return err
+}
+
+// recordGuestReservation counts a created guest reservation. A failure only
+// loosens the cap, so it is logged rather than failing the reservation.
+func (s *ReservationService) recordGuestReservation(ctx context.Context, reservationID pgtype.UUID, input CreateReservationInput, email string) {
+	if s.guestLimiter == nil {
+		return
+	}
+	if err := s.guestLimiter.Record(ctx, reservationID, email, input.ClientIP); err != nil {
+		logger.WarnContext(ctx, "failed to record guest reservation for limits", "reservation_id", reservationID.String(), "error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGuestLimiter(repo *GuestLimitRepositoryInterfaceMock, limits GuestReservationLimits, now time.Time) *GuestLimiter {
+	limiter := NewGuestLimiter(repo, limits)
+	limiter.timeNowFn = func() time.Time { return now }
+	return limiter
+}
+
+func TestGuestLimiter_Check(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	limits := GuestReservationLimits{PerEmail: 3, PerIP: 5, Window: 24 * time.Hour}
+
+	t.Run("under both caps", func(t *testing.T) {
+		repo := &GuestLimitRepositoryInterfaceMock{
+			UsageFunc: func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+				assert.Equal(t, now.Add(-24*time.Hour), since)
+				return &models.GuestUsage{Count: 2, Oldest: now.Add(-time.Hour)}, nil
+			},
+		}
+
+		err := newTestGuestLimiter(repo, limits, now).Check(context.Background(), "guest@example.com", "203.0.113.7")
+		require.NoError(t, err)
+		assert.Len(t, repo.UsageCalls(), 2)
+	})
+
+	t.Run("email cap reached", func(t *testing.T) {
+		repo := &GuestLimitRepositoryInterfaceMock{
+			UsageFunc: func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+				return &models.GuestUsage{Count: 3, Oldest: now.Add(-20 * time.Hour)}, nil
+			},
+		}
+
+		err := newTestGuestLimiter(repo, limits, now).Check(context.Background(), "guest@example.com", "203.0.113.7")
+		require.ErrorIs(t, err, ErrGuestReservationLimit)
+
+		var limitErr *GuestLimitError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, models.GuestSubjectEmail, limitErr.Subject)
+		assert.Equal(t, 3, limitErr.Limit)
+		assert.Equal(t, 4*time.Hour, limitErr.RetryAfter)
+	})
+
+	t.Run("address cap reached", func(t *testing.T) {
+		repo := &GuestLimitRepositoryInterfaceMock{
+			UsageFunc: func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+				if subject.Type == models.GuestSubjectEmail {
+					return &models.GuestUsage{Count: 1, Oldest: now.Add(-time.Hour)}, nil
+				}
+				return &models.GuestUsage{Count: 5, Oldest: now.Add(-23 * time.Hour)}, nil
+			},
+		}
+
+		err := newTestGuestLimiter(repo, limits, now).Check(context.Background(), "fresh@example.com", "203.0.113.7")
+
+		var limitErr *GuestLimitError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, models.GuestSubjectIP, limitErr.Subject)
+		assert.Equal(t, time.Hour, limitErr.RetryAfter)
+	})
+
+	t.Run("zero caps are not enforced", func(t *testing.T) {
+		repo := &GuestLimitRepositoryInterfaceMock{}
+
+		err := newTestGuestLimiter(repo, GuestReservationLimits{Window: time.Hour}, now).Check(context.Background(), "guest@example.com", "203.0.113.7")
+		require.NoError(t, err)
+		assert.Empty(t, repo.UsageCalls())
+	})
+}
+
+func TestGuestLimiter_Record(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	reservationID := pgtype.UUID{Bytes: [16]byte{7}, Valid: true}
+
+	repo := &GuestLimitRepositoryInterfaceMock{
+		RecordFunc: func(ctx context.Context, id pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error {
+			return nil
+		},
+	}
+	limiter := newTestGuestLimiter(repo, GuestReservationLimits{PerEmail: 3, PerIP: 5, Window: 24 * time.Hour}, now)
+
+	require.NoError(t, limiter.Record(context.Background(), reservationID, " Guest@Example.com", "203.0.113.7"))
+	require.Len(t, repo.RecordCalls(), 1)
+
+	call := repo.RecordCalls()[0]
+	assert.Equal(t, reservationID, call.ReservationID)
+	assert.Equal(t, now.Add(-24*time.Hour), call.PurgeBefore)
+	assert.Equal(t, []models.GuestSubject{
+		models.NewGuestSubject(models.GuestSubjectEmail, "guest@example.com"),
+		models.NewGuestSubject(models.GuestSubjectIP, "203.0.113.7"),
+	}, call.Subjects)
+	assert.NotContains(t, call.Subjects[0].Hash, "example")
+}
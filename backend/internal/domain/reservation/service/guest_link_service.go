@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	userrepository "wish-list/internal/domain/user/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// GuestLinkInterval is how often the guest reservation linking queue is worked through
+	GuestLinkInterval = 30 * time.Second
+
+	// guestLinkBatchSize caps the jobs run per pass
+	guestLinkBatchSize = 100
+
+	// guestLinkMaxAttempts is how often a job is tried before it is listed as failed.
+	// With the repository's backoff the last attempt is about an hour after the first.
+	guestLinkMaxAttempts = 6
+
+	// guestLinkErrorLength caps the error kept for admins
+	guestLinkErrorLength = 500
+)
+
+var (
+	ErrAccountNotFound  = errors.New("account not found")
+	ErrEmailNotVerified = errors.New("email address is not verified")
+)
+
+// GuestLinkServiceInterface defines the interface for linking guest reservations to new accounts
+type GuestLinkServiceInterface interface {
+	Enqueue(ctx context.Context, userID pgtype.UUID) error
+	ClaimByEmail(ctx context.Context, userID pgtype.UUID) (int, error)
+	ListFailed(ctx context.Context, limit, offset int) ([]*GuestLinkFailureOutput, int, error)
+}
+
+// GuestLinkService attaches the guest reservations made with an account's email to
+// that account. Registration only queues the work, so a database hiccup cannot
+// lose it: a background job retries it, and users can also claim them by hand.
+type GuestLinkService struct {
+	repo         repository.GuestLinkRepositoryInterface
+	reservations repository.ReservationRepositoryInterface
+	users        UserRepositoryInterface
+}
+
+// NewGuestLinkService creates a new GuestLinkService
+func NewGuestLinkService(repo repository.GuestLinkRepositoryInterface, reservations repository.ReservationRepositoryInterface, users UserRepositoryInterface) *GuestLinkService {
+	return &GuestLinkService{
+		repo:         repo,
+		reservations: reservations,
+		users:        users,
+	}
+}
+
+// GuestLinkFailureOutput is a linking job that ran out of retries
+type GuestLinkFailureOutput struct {
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+	Attempts  int
+	LastError string
+	FailedAt  time.Time
+	CreatedAt time.Time
+}
+
+// Enqueue queues linking the user's guest reservations. Callers check that the
+// user's email is verified, since anyone could register with someone else's.
+func (s *GuestLinkService) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	return s.repo.Enqueue(ctx, userID)
+}
+
+// LinkPending runs the jobs that are due. A job that fails is retried with backoff
+// up to guestLinkMaxAttempts, then left for admins.
+func (s *GuestLinkService) LinkPending(ctx context.Context) error {
+	due, err := s.repo.ListDue(ctx, guestLinkBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to find guest reservation links: %w", err)
+	}
+
+	for _, link := range due {
+		if err := s.link(ctx, link); err != nil {
+			logger.ErrorContext(ctx, "failed to record guest reservation link", "link_id", link.ID.String(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *GuestLinkService) link(ctx context.Context, link *models.GuestLink) error {
+	linked, err := s.reservations.LinkGuestReservationsToUserByEmail(ctx, link.Email, link.UserID)
+	if err != nil {
+		logger.WarnContext(ctx, "guest reservation link failed",
+			"link_id", link.ID.String(), "user_id", link.UserID.String(),
+			"attempt", link.Attempts+1, "error", err)
+		return s.repo.RecordFailure(ctx, link.ID, guestLinkMaxAttempts, truncateError(err.Error()))
+	}
+	return s.repo.MarkLinked(ctx, link.ID, linked)
+}
+
+// ClaimByEmail links the guest reservations made with the user's email right away,
+// for users whose queued linking failed. It returns how many were linked.
+func (s *GuestLinkService) ClaimByEmail(ctx context.Context, userID pgtype.UUID) (int, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userrepository.ErrUserNotFound) {
+			return 0, ErrAccountNotFound
+		}
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.IsVerified.Valid || !user.IsVerified.Bool {
+		return 0, ErrEmailNotVerified
+	}
+
+	linked, err := s.reservations.LinkGuestReservationsToUserByEmail(ctx, user.Email, user.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to link guest reservations: %w", err)
+	}
+	if err := s.repo.CompleteForUser(ctx, user.ID, linked); err != nil {
+		// The reservations are linked; a queued job finding nothing left is harmless
+		logger.WarnContext(ctx, "failed to close guest reservation links", "user_id", user.ID.String(), "error", err)
+	}
+
+	return linked, nil
+}
+
+// ListFailed returns the linking jobs out of retries, most recent first, and how
+// many there are in total
+func (s *GuestLinkService) ListFailed(ctx context.Context, limit, offset int) ([]*GuestLinkFailureOutput, int, error) {
+	links, total, err := s.repo.ListFailed(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list failed guest reservation links: %w", err)
+	}
+
+	outputs := make([]*GuestLinkFailureOutput, 0, len(links))
+	for _, link := range links {
+		outputs = append(outputs, &GuestLinkFailureOutput{
+			ID:        link.ID,
+			UserID:    link.UserID,
+			Attempts:  link.Attempts,
+			LastError: link.LastError.String,
+			FailedAt:  link.FailedAt.Time,
+			CreatedAt: link.CreatedAt.Time,
+		})
+	}
+
+	return outputs, total, nil
+}
+
+func truncateError(msg string) string {
+	if len(msg) <= guestLinkErrorLength {
+		return msg
+	}
+	return msg[:guestLinkErrorLength]
+}
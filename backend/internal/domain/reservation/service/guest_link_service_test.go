@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"wish-list/internal/domain/reservation/models"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepository "wish-list/internal/domain/user/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestLinkService_LinkPending(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	linkID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	newQueue := func() *GuestLinkRepositoryInterfaceMock {
+		return &GuestLinkRepositoryInterfaceMock{
+			ListDueFunc: func(ctx context.Context, limit int) ([]*models.GuestLink, error) {
+				assert.Equal(t, guestLinkBatchSize, limit)
+				return []*models.GuestLink{{ID: linkID, UserID: userID, Email: "guest@example.com", Attempts: 2}}, nil
+			},
+			MarkLinkedFunc: func(ctx context.Context, id pgtype.UUID, linked int) error {
+				return nil
+			},
+			RecordFailureFunc: func(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error {
+				return nil
+			},
+		}
+	}
+
+	t.Run("links by the account's email", func(t *testing.T) {
+		queue := newQueue()
+		reservations := &ReservationRepositoryInterfaceMock{
+			LinkGuestReservationsToUserByEmailFunc: func(ctx context.Context, guestEmail string, id pgtype.UUID) (int, error) {
+				assert.Equal(t, "guest@example.com", guestEmail)
+				assert.Equal(t, userID, id)
+				return 2, nil
+			},
+		}
+		svc := NewGuestLinkService(queue, reservations, &UserRepositoryInterfaceMock{})
+
+		require.NoError(t, svc.LinkPending(context.Background()))
+		require.Len(t, queue.MarkLinkedCalls(), 1)
+		assert.Equal(t, linkID, queue.MarkLinkedCalls()[0].ID)
+		assert.Equal(t, 2, queue.MarkLinkedCalls()[0].Linked)
+		assert.Empty(t, queue.RecordFailureCalls())
+	})
+
+	t.Run("a failure is recorded for a retry", func(t *testing.T) {
+		queue := newQueue()
+		reservations := &ReservationRepositoryInterfaceMock{
+			LinkGuestReservationsToUserByEmailFunc: func(ctx context.Context, guestEmail string, id pgtype.UUID) (int, error) {
+				return 0, errors.New(strings.Repeat("x", guestLinkErrorLength+10))
+			},
+		}
+		svc := NewGuestLinkService(queue, reservations, &UserRepositoryInterfaceMock{})
+
+		require.NoError(t, svc.LinkPending(context.Background()))
+		require.Len(t, queue.RecordFailureCalls(), 1)
+		assert.Equal(t, guestLinkMaxAttempts, queue.RecordFailureCalls()[0].MaxAttempts)
+		assert.Len(t, queue.RecordFailureCalls()[0].LastError, guestLinkErrorLength)
+		assert.Empty(t, queue.MarkLinkedCalls())
+	})
+}
+
+func TestGuestLinkService_ClaimByEmail(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	newUsers := func(verified bool) *UserRepositoryInterfaceMock {
+		return &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				return &usermodels.User{ID: id, Email: "guest@example.com", IsVerified: pgtype.Bool{Bool: verified, Valid: true}}, nil
+			},
+		}
+	}
+
+	t.Run("links and closes the queued jobs", func(t *testing.T) {
+		queue := &GuestLinkRepositoryInterfaceMock{
+			CompleteForUserFunc: func(ctx context.Context, id pgtype.UUID, linked int) error {
+				return nil
+			},
+		}
+		reservations := &ReservationRepositoryInterfaceMock{
+			LinkGuestReservationsToUserByEmailFunc: func(ctx context.Context, guestEmail string, id pgtype.UUID) (int, error) {
+				return 3, nil
+			},
+		}
+		svc := NewGuestLinkService(queue, reservations, newUsers(true))
+
+		linked, err := svc.ClaimByEmail(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, linked)
+		require.Len(t, queue.CompleteForUserCalls(), 1)
+		assert.Equal(t, userID, queue.CompleteForUserCalls()[0].UserID)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		reservations := &ReservationRepositoryInterfaceMock{}
+		svc := NewGuestLinkService(&GuestLinkRepositoryInterfaceMock{}, reservations, newUsers(false))
+
+		_, err := svc.ClaimByEmail(context.Background(), userID)
+		require.ErrorIs(t, err, ErrEmailNotVerified)
+		assert.Empty(t, reservations.LinkGuestReservationsToUserByEmailCalls())
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		users := &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				return nil, userrepository.ErrUserNotFound
+			},
+		}
+		svc := NewGuestLinkService(&GuestLinkRepositoryInterfaceMock{}, &ReservationRepositoryInterfaceMock{}, users)
+
+		_, err := svc.ClaimByEmail(context.Background(), userID)
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+}
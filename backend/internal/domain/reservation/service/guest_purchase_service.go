@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidPurchaseLink  = errors.New("invalid or expired purchase link")
+	ErrPurchaseLinkCanceled = errors.New("reservation behind the purchase link is no longer active")
+	ErrInvalidPurchasePrice = errors.New("purchase price cannot be negative")
+)
+
+// PurchaseTokenValidatorInterface checks the signed links in purchase reminder emails
+type PurchaseTokenValidatorInterface interface {
+	ValidatePurchaseToken(token string) (string, error)
+}
+
+// GiftItemPurchaseMarkerInterface marks the owner's gift item purchased on behalf of
+// the reservation holder (cross-domain)
+type GiftItemPurchaseMarkerInterface interface {
+	MarkGiftItemAsPurchasedByHolder(ctx context.Context, giftItemID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) error
+}
+
+// GuestPurchaseServiceInterface defines the interface for guests marking their reservations bought
+type GuestPurchaseServiceInterface interface {
+	GetPurchase(ctx context.Context, token string) (*GuestPurchaseOutput, error)
+	ConfirmPurchase(ctx context.Context, token string, purchasedPrice *float64) (*GuestPurchaseOutput, error)
+}
+
+// GuestPurchaseService lets a guest say they bought a reserved gift from the signed
+// link in their purchase reminder, without signing in. The purchase is recorded on
+// the reservation and on the owner's item, as if the owner had marked it.
+type GuestPurchaseService struct {
+	repo   repository.PurchaseReminderRepositoryInterface
+	tokens PurchaseTokenValidatorInterface
+	items  GiftItemPurchaseMarkerInterface
+}
+
+// NewGuestPurchaseService creates a new GuestPurchaseService
+func NewGuestPurchaseService(
+	repo repository.PurchaseReminderRepositoryInterface,
+	tokens PurchaseTokenValidatorInterface,
+	items GiftItemPurchaseMarkerInterface,
+) *GuestPurchaseService {
+	return &GuestPurchaseService{
+		repo:   repo,
+		tokens: tokens,
+		items:  items,
+	}
+}
+
+// GuestPurchaseOutput is the reservation behind a purchase link
+type GuestPurchaseOutput struct {
+	ReservationID string
+	GiftItemName  string
+	WishlistTitle string
+	Purchased     bool
+	PurchasedAt   time.Time // Zero until the guest marks it bought
+}
+
+// GetPurchase describes the reservation the token was issued for, so the guest can
+// check the gift before confirming. It changes nothing, which keeps the link safe
+// from mail scanners that open every link in a message.
+func (s *GuestPurchaseService) GetPurchase(ctx context.Context, token string) (*GuestPurchaseOutput, error) {
+	id, err := s.reservationID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	purchase, err := s.repo.GetPurchase(ctx, id)
+	if err != nil {
+		return nil, mapGuestPurchaseError(err)
+	}
+
+	return guestPurchaseToOutput(purchase), nil
+}
+
+// ConfirmPurchase marks the reservation the token was issued for as bought, along
+// with the owner's item unless the owner marked it already. purchasedPrice is
+// optional. Following the link again is harmless and reports the first purchase.
+func (s *GuestPurchaseService) ConfirmPurchase(ctx context.Context, token string, purchasedPrice *float64) (*GuestPurchaseOutput, error) {
+	id, err := s.reservationID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	price := pgtype.Numeric{}
+	if purchasedPrice != nil {
+		if *purchasedPrice < 0 {
+			return nil, ErrInvalidPurchasePrice
+		}
+		if err := price.Scan(strconv.FormatFloat(*purchasedPrice, 'f', -1, 64)); err != nil {
+			return nil, ErrInvalidPurchasePrice
+		}
+	}
+
+	purchase, err := s.repo.GetPurchase(ctx, id)
+	if err != nil {
+		return nil, mapGuestPurchaseError(err)
+	}
+	if purchase.PurchasedAt.Valid {
+		return guestPurchaseToOutput(purchase), nil
+	}
+
+	// The item goes first so that a failure part way leaves the reservation
+	// unmarked and following the link again finishes the job
+	if !purchase.ItemPurchased {
+		if err := s.items.MarkGiftItemAsPurchasedByHolder(ctx, purchase.GiftItemID, purchase.ReservedByUserID, price); err != nil {
+			return nil, fmt.Errorf("failed to mark gift item purchased: %w", err)
+		}
+	}
+
+	purchase, err = s.repo.MarkPurchased(ctx, id)
+	if err != nil {
+		return nil, mapGuestPurchaseError(err)
+	}
+
+	return guestPurchaseToOutput(purchase), nil
+}
+
+func (s *GuestPurchaseService) reservationID(token string) (pgtype.UUID, error) {
+	id := pgtype.UUID{}
+
+	reservationID, err := s.tokens.ValidatePurchaseToken(token)
+	if err != nil {
+		return id, ErrInvalidPurchaseLink
+	}
+	if err := id.Scan(reservationID); err != nil {
+		return id, ErrInvalidPurchaseLink
+	}
+
+	return id, nil
+}
+
+func mapGuestPurchaseError(err error) error {
+	if errors.Is(err, repository.ErrReservationNotActive) {
+		return ErrPurchaseLinkCanceled
+	}
+	return err
+}
+
+func guestPurchaseToOutput(purchase *models.GuestPurchase) *GuestPurchaseOutput {
+	return &GuestPurchaseOutput{
+		ReservationID: purchase.ReservationID.String(),
+		GiftItemName:  purchase.GiftItemName,
+		WishlistTitle: purchase.WishlistTitle,
+		Purchased:     purchase.PurchasedAt.Valid,
+		PurchasedAt:   purchase.PurchasedAt.Time,
+	}
+}
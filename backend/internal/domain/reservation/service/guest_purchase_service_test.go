@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestPurchaseService_ConfirmPurchase(t *testing.T) {
+	reservationID := "0c8f5b4e-6a2d-4f1b-9d3e-2b7a1c5e8f90"
+	giftItemID := pgtype.UUID{Bytes: [16]byte{7}, Valid: true}
+	validTokens := &PurchaseTokenValidatorInterfaceMock{
+		ValidatePurchaseTokenFunc: func(token string) (string, error) {
+			if token != "signed" {
+				return "", errors.New("bad signature")
+			}
+			return reservationID, nil
+		},
+	}
+	pending := func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+		return &models.GuestPurchase{
+			ReservationID: id,
+			GiftItemID:    giftItemID,
+			GiftItemName:  "Espresso machine",
+			WishlistTitle: "Housewarming",
+		}, nil
+	}
+
+	t.Run("marks the reservation and the item bought", func(t *testing.T) {
+		purchasedAt := time.Date(2026, 12, 20, 9, 0, 0, 0, time.UTC)
+		repo := &PurchaseReminderRepositoryInterfaceMock{
+			GetPurchaseFunc: pending,
+			MarkPurchasedFunc: func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+				assert.Equal(t, reservationID, id.String())
+				return &models.GuestPurchase{
+					ReservationID: id,
+					GiftItemID:    giftItemID,
+					GiftItemName:  "Espresso machine",
+					WishlistTitle: "Housewarming",
+					PurchasedAt:   pgtype.Timestamptz{Time: purchasedAt, Valid: true},
+				}, nil
+			},
+		}
+		items := &GiftItemPurchaseMarkerInterfaceMock{
+			MarkGiftItemAsPurchasedByHolderFunc: func(ctx context.Context, id, purchaserID pgtype.UUID, price pgtype.Numeric) error {
+				return nil
+			},
+		}
+		svc := NewGuestPurchaseService(repo, validTokens, items)
+
+		price := 249.5
+		out, err := svc.ConfirmPurchase(context.Background(), "signed", &price)
+		require.NoError(t, err)
+		assert.Equal(t, reservationID, out.ReservationID)
+		assert.True(t, out.Purchased)
+		assert.Equal(t, purchasedAt, out.PurchasedAt)
+
+		require.Len(t, items.MarkGiftItemAsPurchasedByHolderCalls(), 1)
+		call := items.MarkGiftItemAsPurchasedByHolderCalls()[0]
+		assert.Equal(t, giftItemID, call.GiftItemID)
+		assert.False(t, call.PurchaserID.Valid)
+		stored, err := call.PurchasedPrice.Float64Value()
+		require.NoError(t, err)
+		assert.InDelta(t, 249.5, stored.Float64, 0.001)
+	})
+
+	t.Run("price is optional", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{GetPurchaseFunc: pending, MarkPurchasedFunc: pending}
+		items := &GiftItemPurchaseMarkerInterfaceMock{
+			MarkGiftItemAsPurchasedByHolderFunc: func(ctx context.Context, id, purchaserID pgtype.UUID, price pgtype.Numeric) error {
+				assert.False(t, price.Valid)
+				return nil
+			},
+		}
+		svc := NewGuestPurchaseService(repo, validTokens, items)
+
+		_, err := svc.ConfirmPurchase(context.Background(), "signed", nil)
+		require.NoError(t, err)
+		assert.Len(t, items.MarkGiftItemAsPurchasedByHolderCalls(), 1)
+	})
+
+	t.Run("leaves an item the owner marked alone", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{
+			GetPurchaseFunc: func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+				return &models.GuestPurchase{ReservationID: id, GiftItemID: giftItemID, ItemPurchased: true}, nil
+			},
+			MarkPurchasedFunc: pending,
+		}
+		items := &GiftItemPurchaseMarkerInterfaceMock{}
+		svc := NewGuestPurchaseService(repo, validTokens, items)
+
+		_, err := svc.ConfirmPurchase(context.Background(), "signed", nil)
+		require.NoError(t, err)
+		assert.Empty(t, items.MarkGiftItemAsPurchasedByHolderCalls())
+		assert.Len(t, repo.MarkPurchasedCalls(), 1)
+	})
+
+	t.Run("following the link again changes nothing", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{
+			GetPurchaseFunc: func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+				return &models.GuestPurchase{
+					ReservationID: id,
+					GiftItemID:    giftItemID,
+					PurchasedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+					ItemPurchased: true,
+				}, nil
+			},
+		}
+		items := &GiftItemPurchaseMarkerInterfaceMock{}
+		svc := NewGuestPurchaseService(repo, validTokens, items)
+
+		out, err := svc.ConfirmPurchase(context.Background(), "signed", nil)
+		require.NoError(t, err)
+		assert.True(t, out.Purchased)
+		assert.Empty(t, repo.MarkPurchasedCalls())
+		assert.Empty(t, items.MarkGiftItemAsPurchasedByHolderCalls())
+	})
+
+	t.Run("invalid link", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{}
+		svc := NewGuestPurchaseService(repo, validTokens, &GiftItemPurchaseMarkerInterfaceMock{})
+
+		_, err := svc.ConfirmPurchase(context.Background(), "forged", nil)
+		assert.ErrorIs(t, err, ErrInvalidPurchaseLink)
+		assert.Empty(t, repo.GetPurchaseCalls())
+	})
+
+	t.Run("negative price", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{}
+		svc := NewGuestPurchaseService(repo, validTokens, &GiftItemPurchaseMarkerInterfaceMock{})
+
+		price := -1.0
+		_, err := svc.ConfirmPurchase(context.Background(), "signed", &price)
+		assert.ErrorIs(t, err, ErrInvalidPurchasePrice)
+		assert.Empty(t, repo.GetPurchaseCalls())
+	})
+
+	t.Run("reservation canceled since", func(t *testing.T) {
+		repo := &PurchaseReminderRepositoryInterfaceMock{
+			GetPurchaseFunc: func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+				return nil, repository.ErrReservationNotActive
+			},
+		}
+		items := &GiftItemPurchaseMarkerInterfaceMock{}
+		svc := NewGuestPurchaseService(repo, validTokens, items)
+
+		_, err := svc.ConfirmPurchase(context.Background(), "signed", nil)
+		assert.ErrorIs(t, err, ErrPurchaseLinkCanceled)
+		assert.Empty(t, items.MarkGiftItemAsPurchasedByHolderCalls())
+	})
+}
+
+func TestGuestPurchaseService_GetPurchase(t *testing.T) {
+	tokens := &PurchaseTokenValidatorInterfaceMock{
+		ValidatePurchaseTokenFunc: func(token string) (string, error) {
+			return "0c8f5b4e-6a2d-4f1b-9d3e-2b7a1c5e8f90", nil
+		},
+	}
+	repo := &PurchaseReminderRepositoryInterfaceMock{
+		GetPurchaseFunc: func(ctx context.Context, id pgtype.UUID) (*models.GuestPurchase, error) {
+			return &models.GuestPurchase{ReservationID: id, GiftItemName: "Espresso machine", WishlistTitle: "Housewarming"}, nil
+		},
+	}
+	items := &GiftItemPurchaseMarkerInterfaceMock{}
+	svc := NewGuestPurchaseService(repo, tokens, items)
+
+	out, err := svc.GetPurchase(context.Background(), "signed")
+	require.NoError(t, err)
+	assert.Equal(t, "Espresso machine", out.GiftItemName)
+	assert.False(t, out.Purchased)
+	assert.Empty(t, repo.MarkPurchasedCalls())
+	assert.Empty(t, items.MarkGiftItemAsPurchasedByHolderCalls())
+}
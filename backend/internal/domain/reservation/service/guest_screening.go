@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Points a guest reservation's abuse score gains per signal
+const (
+	abuseScoreHoneypot            = 100 // A form field hidden from people was filled in
+	abuseScoreListedIP            = 40  // The client address has a bad reputation
+	abuseScorePerIPReservation    = 10  // Per reservation from the address within abuseVelocityWindow
+	abuseScorePerEmailReservation = 15  // Per reservation with the email within abuseVelocityWindow
+)
+
+const (
+	// abuseVelocityWindow is how far back reservations count towards the score
+	abuseVelocityWindow = time.Hour
+
+	// guestConfirmationTTL is how long a held reservation waits for its confirmation
+	guestConfirmationTTL = 24 * time.Hour
+)
+
+var (
+	ErrGuestConfirmationRequired = errors.New("guest reservation must be confirmed by email")
+	ErrGuestEmailRequired        = errors.New("guest email is required to confirm the reservation")
+	ErrInvalidConfirmationToken  = errors.New("invalid or expired confirmation token")
+)
+
+// IPReputationInterface flags client addresses with a bad reputation
+type IPReputationInterface interface {
+	Listed(ip string) bool
+}
+
+// GuestConfirmationSenderInterface emails guests the code confirming a held reservation
+type GuestConfirmationSenderInterface interface {
+	SendGuestReservationConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, confirmationToken string) error
+}
+
+// AbuseScore is how suspicious a guest reservation looks, with the signals behind it
+type AbuseScore struct {
+	Total             int
+	Honeypot          bool
+	ListedIP          bool
+	IPReservations    int
+	EmailReservations int
+}
+
+// GuestScreening scores guest reservations instead of challenging every guest
+// with a CAPTCHA. Reservations reaching the threshold are not rejected but held
+// until the guest confirms them by email, which a real giver can do and a script
+// with throwaway addresses mostly cannot.
+//
+// The reservation counts are the guest caps' counters, so they are only kept for
+// the subjects that have a cap configured.
+type GuestScreening struct {
+	usage         repository.GuestLimitRepositoryInterface
+	reputation    IPReputationInterface
+	confirmations repository.GuestConfirmationRepositoryInterface
+	sender        GuestConfirmationSenderInterface
+	threshold     int
+	timeNowFn     func() time.Time
+}
+
+// NewGuestScreening creates a GuestScreening holding reservations that score at
+// least threshold
+func NewGuestScreening(
+	usage repository.GuestLimitRepositoryInterface,
+	reputation IPReputationInterface,
+	confirmations repository.GuestConfirmationRepositoryInterface,
+	sender GuestConfirmationSenderInterface,
+	threshold int,
+) *GuestScreening {
+	return &GuestScreening{
+		usage:         usage,
+		reputation:    reputation,
+		confirmations: confirmations,
+		sender:        sender,
+		threshold:     threshold,
+		timeNowFn:     time.Now,
+	}
+}
+
+// Score rates a guest reservation from its honeypot field, the client address's
+// reputation and how many reservations the address and email made recently.
+// email and clientIP may be empty.
+func (g *GuestScreening) Score(ctx context.Context, email, clientIP, honeypot string) (*AbuseScore, error) {
+	score := &AbuseScore{}
+	if honeypot != "" {
+		score.Honeypot = true
+		score.Total += abuseScoreHoneypot
+	}
+	if clientIP != "" && g.reputation != nil && g.reputation.Listed(clientIP) {
+		score.ListedIP = true
+		score.Total += abuseScoreListedIP
+	}
+
+	since := g.timeNowFn().Add(-abuseVelocityWindow)
+	if clientIP != "" {
+		usage, err := g.usage.Usage(ctx, models.NewGuestSubject(models.GuestSubjectIP, clientIP), since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count guest reservations: %w", err)
+		}
+		score.IPReservations = usage.Count
+		score.Total += usage.Count * abuseScorePerIPReservation
+	}
+	if email != "" {
+		usage, err := g.usage.Usage(ctx, models.NewGuestSubject(models.GuestSubjectEmail, email), since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count guest reservations: %w", err)
+		}
+		score.EmailReservations = usage.Count
+		score.Total += usage.Count * abuseScorePerEmailReservation
+	}
+
+	return score, nil
+}
+
+// Hold keeps the reservation request until the guest confirms it and emails them
+// the confirmation code
+func (g *GuestScreening) Hold(ctx context.Context, input CreateReservationInput, giftItemName string, details models.GuestConfirmationDetails, score int) error {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode guest reservation: %w", err)
+	}
+
+	wishlistID := pgtype.UUID{}
+	if err := wishlistID.Scan(input.WishListID); err != nil {
+		return ErrInvalidReservationWishlist
+	}
+	giftItemID := pgtype.UUID{}
+	if err := giftItemID.Scan(input.GiftItemID); err != nil {
+		return ErrInvalidGiftItemID
+	}
+
+	held, err := g.confirmations.Create(ctx, models.GuestConfirmation{
+		WishlistID: wishlistID,
+		GiftItemID: giftItemID,
+		Details:    pgtype.Text{String: string(data), Valid: true},
+		Score:      score,
+		ExpiresAt:  pgtype.Timestamptz{Time: g.timeNowFn().Add(guestConfirmationTTL), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hold guest reservation: %w", err)
+	}
+
+	if err := g.sender.SendGuestReservationConfirmationEmail(ctx, details.GuestEmail, giftItemName, held.Token.String()); err != nil {
+		return fmt.Errorf("failed to send guest reservation confirmation: %w", err)
+	}
+	return nil
+}
+
+// screenGuestReservation scores a guest reservation and logs the score for tuning.
+// One reaching the threshold is held for confirmation and ErrGuestConfirmationRequired
+// returned; without an email to confirm with, ErrGuestEmailRequired. Reservations
+// being confirmed are not scored again.
+func (s *ReservationService) screenGuestReservation(ctx context.Context, input CreateReservationInput, giftItemName, guestName, email string) error {
+	if s.screening == nil || input.confirmed {
+		return nil
+	}
+
+	score, err := s.screening.Score(ctx, email, input.ClientIP, input.Honeypot)
+	if err != nil {
+		return fmt.Errorf("failed to score guest reservation: %w", err)
+	}
+	held := score.Total >= s.screening.threshold
+	logger.InfoContext(ctx, "guest reservation abuse score",
+		"audit", true,
+		"score", score.Total,
+		"threshold", s.screening.threshold,
+		"held", held,
+		"honeypot", score.Honeypot,
+		"listed_ip", score.ListedIP,
+		"ip_reservations", score.IPReservations,
+		"email_reservations", score.EmailReservations,
+		"wishlist_id", input.WishListID,
+		"gift_item_id", input.GiftItemID)
+	if !held {
+		return nil
+	}
+	if email == "" {
+		return ErrGuestEmailRequired
+	}
+
+	details := models.GuestConfirmationDetails{
+		GuestName:  guestName,
+		GuestEmail: email,
+		Quantity:   input.Quantity,
+		Variant:    input.Variant,
+		Message:    input.Message,
+	}
+	if err := s.screening.Hold(ctx, input, giftItemName, details, score.Total); err != nil {
+		return err
+	}
+	return ErrGuestConfirmationRequired
+}
+
+// ConfirmGuestReservation creates the reservation held for the confirmation
+// token. The item's availability and the guest caps are checked again, as the
+// item may have been reserved in the meantime.
+func (s *ReservationService) ConfirmGuestReservation(ctx context.Context, token, clientIP string) (*ReservationOutput, error) {
+	confirmationToken := pgtype.UUID{}
+	if err := confirmationToken.Scan(token); err != nil || s.screening == nil {
+		return nil, ErrInvalidConfirmationToken
+	}
+
+	held, err := s.screening.confirmations.Take(ctx, confirmationToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrGuestConfirmationNotFound) {
+			return nil, ErrInvalidConfirmationToken
+		}
+		return nil, fmt.Errorf("failed to get guest reservation confirmation: %w", err)
+	}
+
+	var details models.GuestConfirmationDetails
+	if err := json.Unmarshal([]byte(held.Details.String), &details); err != nil {
+		return nil, fmt.Errorf("failed to decode guest reservation: %w", err)
+	}
+
+	return s.CreateReservation(ctx, CreateReservationInput{
+		WishListID: held.WishlistID.String(),
+		GiftItemID: held.GiftItemID.String(),
+		GuestName:  &details.GuestName,
+		GuestEmail: &details.GuestEmail,
+		Quantity:   details.Quantity,
+		Variant:    details.Variant,
+		Message:    details.Message,
+		ClientIP:   clientIP,
+		confirmed:  true,
+	})
+}
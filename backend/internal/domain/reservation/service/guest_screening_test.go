@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/abuse"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGuestScreening(t *testing.T, usage *GuestLimitRepositoryInterfaceMock, confirmations *GuestConfirmationRepositoryInterfaceMock, sender *GuestConfirmationSenderInterfaceMock) *GuestScreening {
+	reputation, err := abuse.NewIPReputation([]string{"203.0.113.0/24"})
+	require.NoError(t, err)
+	return NewGuestScreening(usage, reputation, confirmations, sender, 50)
+}
+
+func usageOf(ip, email int) *GuestLimitRepositoryInterfaceMock {
+	return &GuestLimitRepositoryInterfaceMock{
+		UsageFunc: func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+			if subject.Type == models.GuestSubjectIP {
+				return &models.GuestUsage{Count: ip}, nil
+			}
+			return &models.GuestUsage{Count: email}, nil
+		},
+	}
+}
+
+func TestGuestScreening_Score(t *testing.T) {
+	tests := []struct {
+		name     string
+		usage    *GuestLimitRepositoryInterfaceMock
+		ip       string
+		honeypot string
+		want     AbuseScore
+	}{
+		{"clean guest", usageOf(0, 0), "192.0.2.1", "", AbuseScore{}},
+		{"honeypot filled in", usageOf(0, 0), "192.0.2.1", "http://spam.example", AbuseScore{Total: 100, Honeypot: true}},
+		{"listed address", usageOf(0, 0), "203.0.113.9", "", AbuseScore{Total: 40, ListedIP: true}},
+		{"busy address and email", usageOf(2, 1), "192.0.2.1", "", AbuseScore{Total: 35, IPReservations: 2, EmailReservations: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screening := newTestGuestScreening(t, tt.usage, nil, nil)
+
+			score, err := screening.Score(context.Background(), "guest@example.com", tt.ip, tt.honeypot)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *score)
+		})
+	}
+
+	t.Run("counts the last hour", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		usage := usageOf(0, 0)
+		screening := newTestGuestScreening(t, usage, nil, nil)
+		screening.timeNowFn = func() time.Time { return now }
+
+		_, err := screening.Score(context.Background(), "", "192.0.2.1", "")
+		require.NoError(t, err)
+		require.Len(t, usage.UsageCalls(), 1)
+		assert.Equal(t, now.Add(-time.Hour), usage.UsageCalls()[0].Since)
+	})
+}
+
+func TestReservationService_ScreensGuestReservations(t *testing.T) {
+	giftItemID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	wishlistID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	token := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	guestName := "Test Guest"
+	guestEmail := "guest@example.com"
+
+	giftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, id pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{{ID: giftItemID, Name: "Espresso machine"}}, nil
+		},
+	}
+	newRepo := func() *ReservationRepositoryInterfaceMock {
+		return &ReservationRepositoryInterfaceMock{
+			GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrNoActiveReservation
+			},
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				reservation.Status = "active"
+				return &reservation, nil
+			},
+		}
+	}
+	newConfirmations := func() *GuestConfirmationRepositoryInterfaceMock {
+		return &GuestConfirmationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error) {
+				confirmation.Token = token
+				return &confirmation, nil
+			},
+		}
+	}
+	newSender := func() *GuestConfirmationSenderInterfaceMock {
+		return &GuestConfirmationSenderInterfaceMock{
+			SendGuestReservationConfirmationEmailFunc: func(ctx context.Context, recipientEmail, giftItemName, confirmationToken string) error {
+				return nil
+			},
+		}
+	}
+	input := func(email *string, honeypot string) CreateReservationInput {
+		return CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			GuestEmail: email,
+			ClientIP:   "192.0.2.1",
+			Honeypot:   honeypot,
+		}
+	}
+
+	t.Run("a low score reserves right away", func(t *testing.T) {
+		repo := newRepo()
+		confirmations := newConfirmations()
+		screening := newTestGuestScreening(t, usageOf(0, 0), confirmations, newSender())
+		svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, screening)
+
+		_, err := svc.CreateReservation(context.Background(), input(&guestEmail, ""))
+		require.NoError(t, err)
+		assert.Len(t, repo.CreateCalls(), 1)
+		assert.Empty(t, confirmations.CreateCalls())
+	})
+
+	t.Run("a high score is held and emailed", func(t *testing.T) {
+		repo := newRepo()
+		confirmations := newConfirmations()
+		sender := newSender()
+		screening := newTestGuestScreening(t, usageOf(0, 0), confirmations, sender)
+		svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, screening)
+
+		_, err := svc.CreateReservation(context.Background(), input(&guestEmail, "bot"))
+		require.ErrorIs(t, err, ErrGuestConfirmationRequired)
+		assert.Empty(t, repo.CreateCalls())
+
+		require.Len(t, confirmations.CreateCalls(), 1)
+		held := confirmations.CreateCalls()[0].Confirmation
+		assert.Equal(t, wishlistID, held.WishlistID)
+		assert.Equal(t, giftItemID, held.GiftItemID)
+		assert.Equal(t, 100, held.Score)
+		var details models.GuestConfirmationDetails
+		require.NoError(t, json.Unmarshal([]byte(held.Details.String), &details))
+		assert.Equal(t, models.GuestConfirmationDetails{GuestName: guestName, GuestEmail: guestEmail}, details)
+
+		require.Len(t, sender.SendGuestReservationConfirmationEmailCalls(), 1)
+		call := sender.SendGuestReservationConfirmationEmailCalls()[0]
+		assert.Equal(t, guestEmail, call.RecipientEmail)
+		assert.Equal(t, "Espresso machine", call.GiftItemName)
+		assert.Equal(t, token.String(), call.ConfirmationToken)
+	})
+
+	t.Run("a high score without an email", func(t *testing.T) {
+		repo := newRepo()
+		screening := newTestGuestScreening(t, usageOf(0, 0), newConfirmations(), newSender())
+		svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, screening)
+
+		_, err := svc.CreateReservation(context.Background(), input(nil, "bot"))
+		require.ErrorIs(t, err, ErrGuestEmailRequired)
+		assert.Empty(t, repo.CreateCalls())
+	})
+
+	t.Run("confirming creates the held reservation", func(t *testing.T) {
+		repo := newRepo()
+		usage := usageOf(5, 5)
+		details, err := json.Marshal(models.GuestConfirmationDetails{GuestName: guestName, GuestEmail: guestEmail, Quantity: 1})
+		require.NoError(t, err)
+		confirmations := &GuestConfirmationRepositoryInterfaceMock{
+			TakeFunc: func(ctx context.Context, tok pgtype.UUID) (*models.GuestConfirmation, error) {
+				assert.Equal(t, token, tok)
+				return &models.GuestConfirmation{
+					Token:      tok,
+					WishlistID: wishlistID,
+					GiftItemID: giftItemID,
+					Details:    pgtype.Text{String: string(details), Valid: true},
+				}, nil
+			},
+		}
+		screening := newTestGuestScreening(t, usage, confirmations, newSender())
+		svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, screening)
+
+		out, err := svc.ConfirmGuestReservation(context.Background(), token.String(), "192.0.2.1")
+		require.NoError(t, err)
+		assert.Equal(t, "active", out.Status)
+		require.Len(t, repo.CreateCalls(), 1)
+		assert.Equal(t, guestEmail, repo.CreateCalls()[0].Reservation.GuestEmail.String)
+		assert.Empty(t, usage.UsageCalls(), "confirmed reservations are not scored again")
+	})
+
+	t.Run("unknown or expired code", func(t *testing.T) {
+		confirmations := &GuestConfirmationRepositoryInterfaceMock{
+			TakeFunc: func(ctx context.Context, tok pgtype.UUID) (*models.GuestConfirmation, error) {
+				return nil, repository.ErrGuestConfirmationNotFound
+			},
+		}
+		screening := newTestGuestScreening(t, usageOf(0, 0), confirmations, newSender())
+		svc := NewReservationService(newRepo(), giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, screening)
+
+		_, err := svc.ConfirmGuestReservation(context.Background(), token.String(), "192.0.2.1")
+		assert.ErrorIs(t, err, ErrInvalidConfirmationToken)
+
+		_, err = svc.ConfirmGuestReservation(context.Background(), "not-a-token", "192.0.2.1")
+		assert.ErrorIs(t, err, ErrInvalidConfirmationToken)
+	})
+}
@@ -0,0 +1,203 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that BudgetRepositoryInterfaceMock does implement repository.BudgetRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.BudgetRepositoryInterface = &BudgetRepositoryInterfaceMock{}
+
+// BudgetRepositoryInterfaceMock is a mock implementation of repository.BudgetRepositoryInterface.
+//
+//	func TestSomethingThatUsesBudgetRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.BudgetRepositoryInterface
+//		mockedBudgetRepositoryInterface := &BudgetRepositoryInterfaceMock{
+//			DeleteFunc: func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			ListSpendByUserFunc: func(ctx context.Context, userID pgtype.UUID, monthStart time.Time, monthEnd time.Time) ([]*repository.BudgetSpend, error) {
+//				panic("mock out the ListSpendByUser method")
+//			},
+//			UpsertFunc: func(ctx context.Context, budget models.Budget) (*models.Budget, error) {
+//				panic("mock out the Upsert method")
+//			},
+//		}
+//
+//		// use mockedBudgetRepositoryInterface in code that requires repository.BudgetRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type BudgetRepositoryInterfaceMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error
+
+	// ListSpendByUserFunc mocks the ListSpendByUser method.
+	ListSpendByUserFunc func(ctx context.Context, userID pgtype.UUID, monthStart time.Time, monthEnd time.Time) ([]*repository.BudgetSpend, error)
+
+	// UpsertFunc mocks the Upsert method.
+	UpsertFunc func(ctx context.Context, budget models.Budget) (*models.Budget, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListSpendByUser holds details about calls to the ListSpendByUser method.
+		ListSpendByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// MonthStart is the monthStart argument value.
+			MonthStart time.Time
+			// MonthEnd is the monthEnd argument value.
+			MonthEnd time.Time
+		}
+		// Upsert holds details about calls to the Upsert method.
+		Upsert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Budget is the budget argument value.
+			Budget models.Budget
+		}
+	}
+	lockDelete          sync.RWMutex
+	lockListSpendByUser sync.RWMutex
+	lockUpsert          sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *BudgetRepositoryInterfaceMock) Delete(ctx context.Context, id pgtype.UUID, userID pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("BudgetRepositoryInterfaceMock.DeleteFunc: method is nil but BudgetRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		UserID: userID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id, userID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedBudgetRepositoryInterface.DeleteCalls())
+func (mock *BudgetRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// ListSpendByUser calls ListSpendByUserFunc.
+func (mock *BudgetRepositoryInterfaceMock) ListSpendByUser(ctx context.Context, userID pgtype.UUID, monthStart time.Time, monthEnd time.Time) ([]*repository.BudgetSpend, error) {
+	if mock.ListSpendByUserFunc == nil {
+		panic("BudgetRepositoryInterfaceMock.ListSpendByUserFunc: method is nil but BudgetRepositoryInterface.ListSpendByUser was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		MonthStart time.Time
+		MonthEnd   time.Time
+	}{
+		Ctx:        ctx,
+		UserID:     userID,
+		MonthStart: monthStart,
+		MonthEnd:   monthEnd,
+	}
+	mock.lockListSpendByUser.Lock()
+	mock.calls.ListSpendByUser = append(mock.calls.ListSpendByUser, callInfo)
+	mock.lockListSpendByUser.Unlock()
+	return mock.ListSpendByUserFunc(ctx, userID, monthStart, monthEnd)
+}
+
+// ListSpendByUserCalls gets all the calls that were made to ListSpendByUser.
+// Check the length with:
+//
+//	len(mockedBudgetRepositoryInterface.ListSpendByUserCalls())
+func (mock *BudgetRepositoryInterfaceMock) ListSpendByUserCalls() []struct {
+	Ctx        context.Context
+	UserID     pgtype.UUID
+	MonthStart time.Time
+	MonthEnd   time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		MonthStart time.Time
+		MonthEnd   time.Time
+	}
+	mock.lockListSpendByUser.RLock()
+	calls = mock.calls.ListSpendByUser
+	mock.lockListSpendByUser.RUnlock()
+	return calls
+}
+
+// Upsert calls UpsertFunc.
+func (mock *BudgetRepositoryInterfaceMock) Upsert(ctx context.Context, budget models.Budget) (*models.Budget, error) {
+	if mock.UpsertFunc == nil {
+		panic("BudgetRepositoryInterfaceMock.UpsertFunc: method is nil but BudgetRepositoryInterface.Upsert was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Budget models.Budget
+	}{
+		Ctx:    ctx,
+		Budget: budget,
+	}
+	mock.lockUpsert.Lock()
+	mock.calls.Upsert = append(mock.calls.Upsert, callInfo)
+	mock.lockUpsert.Unlock()
+	return mock.UpsertFunc(ctx, budget)
+}
+
+// UpsertCalls gets all the calls that were made to Upsert.
+// Check the length with:
+//
+//	len(mockedBudgetRepositoryInterface.UpsertCalls())
+func (mock *BudgetRepositoryInterfaceMock) UpsertCalls() []struct {
+	Ctx    context.Context
+	Budget models.Budget
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Budget models.Budget
+	}
+	mock.lockUpsert.RLock()
+	calls = mock.calls.Upsert
+	mock.lockUpsert.RUnlock()
+	return calls
+}
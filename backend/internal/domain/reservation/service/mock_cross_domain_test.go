@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"sync"
 	itemmodels "wish-list/internal/domain/item/models"
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/pkg/pdf"
 )
 
 // Ensure, that GiftItemRepositoryInterfaceMock does implement GiftItemRepositoryInterface.
@@ -131,3 +133,659 @@ func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemsCalls() [
 	mock.lockGetPublicWishListGiftItems.RUnlock()
 	return calls
 }
+
+// Ensure, that EmailValidatorInterfaceMock does implement EmailValidatorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ EmailValidatorInterface = &EmailValidatorInterfaceMock{}
+
+// EmailValidatorInterfaceMock is a mock implementation of EmailValidatorInterface.
+//
+//	func TestSomethingThatUsesEmailValidatorInterface(t *testing.T) {
+//
+//		// make and configure a mocked EmailValidatorInterface
+//		mockedEmailValidatorInterface := &EmailValidatorInterfaceMock{
+//			ValidateFunc: func(ctx context.Context, address string) error {
+//				panic("mock out the Validate method")
+//			},
+//		}
+//
+//		// use mockedEmailValidatorInterface in code that requires EmailValidatorInterface
+//		// and then make assertions.
+//
+//	}
+type EmailValidatorInterfaceMock struct {
+	// ValidateFunc mocks the Validate method.
+	ValidateFunc func(ctx context.Context, address string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Validate holds details about calls to the Validate method.
+		Validate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Address is the address argument value.
+			Address string
+		}
+	}
+	lockValidate sync.RWMutex
+}
+
+// Validate calls ValidateFunc.
+func (mock *EmailValidatorInterfaceMock) Validate(ctx context.Context, address string) error {
+	if mock.ValidateFunc == nil {
+		panic("EmailValidatorInterfaceMock.ValidateFunc: method is nil but EmailValidatorInterface.Validate was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address string
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockValidate.Lock()
+	mock.calls.Validate = append(mock.calls.Validate, callInfo)
+	mock.lockValidate.Unlock()
+	return mock.ValidateFunc(ctx, address)
+}
+
+// ValidateCalls gets all the calls that were made to Validate.
+// Check the length with:
+//
+//	len(mockedEmailValidatorInterface.ValidateCalls())
+func (mock *EmailValidatorInterfaceMock) ValidateCalls() []struct {
+	Ctx     context.Context
+	Address string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address string
+	}
+	mock.lockValidate.RLock()
+	calls = mock.calls.Validate
+	mock.lockValidate.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRepositoryInterfaceMock does implement UserRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ UserRepositoryInterface = &UserRepositoryInterfaceMock{}
+
+// UserRepositoryInterfaceMock is a mock implementation of UserRepositoryInterface.
+//
+//	func TestSomethingThatUsesUserRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked UserRepositoryInterface
+//		mockedUserRepositoryInterface := &UserRepositoryInterfaceMock{
+//			GetByEmailFunc: func(ctx context.Context, email string) (*usermodels.User, error) {
+//				panic("mock out the GetByEmail method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+//				panic("mock out the GetByID method")
+//			},
+//		}
+//
+//		// use mockedUserRepositoryInterface in code that requires UserRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryInterfaceMock struct {
+	// GetByEmailFunc mocks the GetByEmail method.
+	GetByEmailFunc func(ctx context.Context, email string) (*usermodels.User, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByEmail holds details about calls to the GetByEmail method.
+		GetByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email string
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockGetByEmail sync.RWMutex
+	lockGetByID    sync.RWMutex
+}
+
+// GetByEmail calls GetByEmailFunc.
+func (mock *UserRepositoryInterfaceMock) GetByEmail(ctx context.Context, email string) (*usermodels.User, error) {
+	if mock.GetByEmailFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByEmailFunc: method is nil but UserRepositoryInterface.GetByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Email string
+	}{
+		Ctx:   ctx,
+		Email: email,
+	}
+	mock.lockGetByEmail.Lock()
+	mock.calls.GetByEmail = append(mock.calls.GetByEmail, callInfo)
+	mock.lockGetByEmail.Unlock()
+	return mock.GetByEmailFunc(ctx, email)
+}
+
+// GetByEmailCalls gets all the calls that were made to GetByEmail.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByEmailCalls())
+func (mock *UserRepositoryInterfaceMock) GetByEmailCalls() []struct {
+	Ctx   context.Context
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Email string
+	}
+	mock.lockGetByEmail.RLock()
+	calls = mock.calls.GetByEmail
+	mock.lockGetByEmail.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *UserRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+	if mock.GetByIDFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByIDFunc: method is nil but UserRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByIDCalls())
+func (mock *UserRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemEventPublisherInterfaceMock does implement ItemEventPublisherInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemEventPublisherInterface = &ItemEventPublisherInterfaceMock{}
+
+// ItemEventPublisherInterfaceMock is a mock implementation of ItemEventPublisherInterface.
+//
+//	func TestSomethingThatUsesItemEventPublisherInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemEventPublisherInterface
+//		mockedItemEventPublisherInterface := &ItemEventPublisherInterfaceMock{
+//			PublishItemEventFunc: func(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+//				panic("mock out the PublishItemEvent method")
+//			},
+//		}
+//
+//		// use mockedItemEventPublisherInterface in code that requires ItemEventPublisherInterface
+//		// and then make assertions.
+//
+//	}
+type ItemEventPublisherInterfaceMock struct {
+	// PublishItemEventFunc mocks the PublishItemEvent method.
+	PublishItemEventFunc func(ctx context.Context, event string, giftItemID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// PublishItemEvent holds details about calls to the PublishItemEvent method.
+		PublishItemEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event string
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+	}
+	lockPublishItemEvent sync.RWMutex
+}
+
+// PublishItemEvent calls PublishItemEventFunc.
+func (mock *ItemEventPublisherInterfaceMock) PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error {
+	if mock.PublishItemEventFunc == nil {
+		panic("ItemEventPublisherInterfaceMock.PublishItemEventFunc: method is nil but ItemEventPublisherInterface.PublishItemEvent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Event      string
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		Event:      event,
+		GiftItemID: giftItemID,
+	}
+	mock.lockPublishItemEvent.Lock()
+	mock.calls.PublishItemEvent = append(mock.calls.PublishItemEvent, callInfo)
+	mock.lockPublishItemEvent.Unlock()
+	return mock.PublishItemEventFunc(ctx, event, giftItemID)
+}
+
+// PublishItemEventCalls gets all the calls that were made to PublishItemEvent.
+// Check the length with:
+//
+//	len(mockedItemEventPublisherInterface.PublishItemEventCalls())
+func (mock *ItemEventPublisherInterfaceMock) PublishItemEventCalls() []struct {
+	Ctx        context.Context
+	Event      string
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Event      string
+		GiftItemID pgtype.UUID
+	}
+	mock.lockPublishItemEvent.RLock()
+	calls = mock.calls.PublishItemEvent
+	mock.lockPublishItemEvent.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemActivityRecorderInterfaceMock does implement ItemActivityRecorderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemActivityRecorderInterface = &ItemActivityRecorderInterfaceMock{}
+
+// ItemActivityRecorderInterfaceMock is a mock implementation of ItemActivityRecorderInterface.
+//
+//	func TestSomethingThatUsesItemActivityRecorderInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemActivityRecorderInterface
+//		mockedItemActivityRecorderInterface := &ItemActivityRecorderInterfaceMock{
+//			RecordReservationAttemptFunc: func(ctx context.Context, giftItemID pgtype.UUID) error {
+//				panic("mock out the RecordReservationAttempt method")
+//			},
+//		}
+//
+//		// use mockedItemActivityRecorderInterface in code that requires ItemActivityRecorderInterface
+//		// and then make assertions.
+//
+//	}
+type ItemActivityRecorderInterfaceMock struct {
+	// RecordReservationAttemptFunc mocks the RecordReservationAttempt method.
+	RecordReservationAttemptFunc func(ctx context.Context, giftItemID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RecordReservationAttempt holds details about calls to the RecordReservationAttempt method.
+		RecordReservationAttempt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+	}
+	lockRecordReservationAttempt sync.RWMutex
+}
+
+// RecordReservationAttempt calls RecordReservationAttemptFunc.
+func (mock *ItemActivityRecorderInterfaceMock) RecordReservationAttempt(ctx context.Context, giftItemID pgtype.UUID) error {
+	if mock.RecordReservationAttemptFunc == nil {
+		panic("ItemActivityRecorderInterfaceMock.RecordReservationAttemptFunc: method is nil but ItemActivityRecorderInterface.RecordReservationAttempt was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+	}
+	mock.lockRecordReservationAttempt.Lock()
+	mock.calls.RecordReservationAttempt = append(mock.calls.RecordReservationAttempt, callInfo)
+	mock.lockRecordReservationAttempt.Unlock()
+	return mock.RecordReservationAttemptFunc(ctx, giftItemID)
+}
+
+// RecordReservationAttemptCalls gets all the calls that were made to RecordReservationAttempt.
+// Check the length with:
+//
+//	len(mockedItemActivityRecorderInterface.RecordReservationAttemptCalls())
+func (mock *ItemActivityRecorderInterfaceMock) RecordReservationAttemptCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}
+	mock.lockRecordReservationAttempt.RLock()
+	calls = mock.calls.RecordReservationAttempt
+	mock.lockRecordReservationAttempt.RUnlock()
+	return calls
+}
+
+// Ensure, that GuestConfirmationSenderInterfaceMock does implement GuestConfirmationSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GuestConfirmationSenderInterface = &GuestConfirmationSenderInterfaceMock{}
+
+// GuestConfirmationSenderInterfaceMock is a mock implementation of GuestConfirmationSenderInterface.
+//
+//	func TestSomethingThatUsesGuestConfirmationSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked GuestConfirmationSenderInterface
+//		mockedGuestConfirmationSenderInterface := &GuestConfirmationSenderInterfaceMock{
+//			SendGuestReservationConfirmationEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, confirmationToken string) error {
+//				panic("mock out the SendGuestReservationConfirmationEmail method")
+//			},
+//		}
+//
+//		// use mockedGuestConfirmationSenderInterface in code that requires GuestConfirmationSenderInterface
+//		// and then make assertions.
+//
+//	}
+type GuestConfirmationSenderInterfaceMock struct {
+	// SendGuestReservationConfirmationEmailFunc mocks the SendGuestReservationConfirmationEmail method.
+	SendGuestReservationConfirmationEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, confirmationToken string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendGuestReservationConfirmationEmail holds details about calls to the SendGuestReservationConfirmationEmail method.
+		SendGuestReservationConfirmationEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// GiftItemName is the giftItemName argument value.
+			GiftItemName string
+			// ConfirmationToken is the confirmationToken argument value.
+			ConfirmationToken string
+		}
+	}
+	lockSendGuestReservationConfirmationEmail sync.RWMutex
+}
+
+// SendGuestReservationConfirmationEmail calls SendGuestReservationConfirmationEmailFunc.
+func (mock *GuestConfirmationSenderInterfaceMock) SendGuestReservationConfirmationEmail(ctx context.Context, recipientEmail string, giftItemName string, confirmationToken string) error {
+	if mock.SendGuestReservationConfirmationEmailFunc == nil {
+		panic("GuestConfirmationSenderInterfaceMock.SendGuestReservationConfirmationEmailFunc: method is nil but GuestConfirmationSenderInterface.SendGuestReservationConfirmationEmail was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		RecipientEmail    string
+		GiftItemName      string
+		ConfirmationToken string
+	}{
+		Ctx:               ctx,
+		RecipientEmail:    recipientEmail,
+		GiftItemName:      giftItemName,
+		ConfirmationToken: confirmationToken,
+	}
+	mock.lockSendGuestReservationConfirmationEmail.Lock()
+	mock.calls.SendGuestReservationConfirmationEmail = append(mock.calls.SendGuestReservationConfirmationEmail, callInfo)
+	mock.lockSendGuestReservationConfirmationEmail.Unlock()
+	return mock.SendGuestReservationConfirmationEmailFunc(ctx, recipientEmail, giftItemName, confirmationToken)
+}
+
+// SendGuestReservationConfirmationEmailCalls gets all the calls that were made to SendGuestReservationConfirmationEmail.
+// Check the length with:
+//
+//	len(mockedGuestConfirmationSenderInterface.SendGuestReservationConfirmationEmailCalls())
+func (mock *GuestConfirmationSenderInterfaceMock) SendGuestReservationConfirmationEmailCalls() []struct {
+	Ctx               context.Context
+	RecipientEmail    string
+	GiftItemName      string
+	ConfirmationToken string
+} {
+	var calls []struct {
+		Ctx               context.Context
+		RecipientEmail    string
+		GiftItemName      string
+		ConfirmationToken string
+	}
+	mock.lockSendGuestReservationConfirmationEmail.RLock()
+	calls = mock.calls.SendGuestReservationConfirmationEmail
+	mock.lockSendGuestReservationConfirmationEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that ThumbnailFetcherInterfaceMock does implement ThumbnailFetcherInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ThumbnailFetcherInterface = &ThumbnailFetcherInterfaceMock{}
+
+// ThumbnailFetcherInterfaceMock is a mock implementation of ThumbnailFetcherInterface.
+//
+//	func TestSomethingThatUsesThumbnailFetcherInterface(t *testing.T) {
+//
+//		// make and configure a mocked ThumbnailFetcherInterface
+//		mockedThumbnailFetcherInterface := &ThumbnailFetcherInterfaceMock{
+//			ThumbnailFunc: func(ctx context.Context, link string, maxSize int) (*pdf.Image, error) {
+//				panic("mock out the Thumbnail method")
+//			},
+//		}
+//
+//		// use mockedThumbnailFetcherInterface in code that requires ThumbnailFetcherInterface
+//		// and then make assertions.
+//
+//	}
+type ThumbnailFetcherInterfaceMock struct {
+	// ThumbnailFunc mocks the Thumbnail method.
+	ThumbnailFunc func(ctx context.Context, link string, maxSize int) (*pdf.Image, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Thumbnail holds details about calls to the Thumbnail method.
+		Thumbnail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Link is the link argument value.
+			Link string
+			// MaxSize is the maxSize argument value.
+			MaxSize int
+		}
+	}
+	lockThumbnail sync.RWMutex
+}
+
+// Thumbnail calls ThumbnailFunc.
+func (mock *ThumbnailFetcherInterfaceMock) Thumbnail(ctx context.Context, link string, maxSize int) (*pdf.Image, error) {
+	if mock.ThumbnailFunc == nil {
+		panic("ThumbnailFetcherInterfaceMock.ThumbnailFunc: method is nil but ThumbnailFetcherInterface.Thumbnail was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Link    string
+		MaxSize int
+	}{
+		Ctx:     ctx,
+		Link:    link,
+		MaxSize: maxSize,
+	}
+	mock.lockThumbnail.Lock()
+	mock.calls.Thumbnail = append(mock.calls.Thumbnail, callInfo)
+	mock.lockThumbnail.Unlock()
+	return mock.ThumbnailFunc(ctx, link, maxSize)
+}
+
+// ThumbnailCalls gets all the calls that were made to Thumbnail.
+// Check the length with:
+//
+//	len(mockedThumbnailFetcherInterface.ThumbnailCalls())
+func (mock *ThumbnailFetcherInterfaceMock) ThumbnailCalls() []struct {
+	Ctx     context.Context
+	Link    string
+	MaxSize int
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Link    string
+		MaxSize int
+	}
+	mock.lockThumbnail.RLock()
+	calls = mock.calls.Thumbnail
+	mock.lockThumbnail.RUnlock()
+	return calls
+}
+
+// Ensure, that PurchaseTokenValidatorInterfaceMock does implement PurchaseTokenValidatorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ PurchaseTokenValidatorInterface = &PurchaseTokenValidatorInterfaceMock{}
+
+// PurchaseTokenValidatorInterfaceMock is a mock implementation of PurchaseTokenValidatorInterface.
+//
+//	func TestSomethingThatUsesPurchaseTokenValidatorInterface(t *testing.T) {
+//
+//		// make and configure a mocked PurchaseTokenValidatorInterface
+//		mockedPurchaseTokenValidatorInterface := &PurchaseTokenValidatorInterfaceMock{
+//			ValidatePurchaseTokenFunc: func(token string) (string, error) {
+//				panic("mock out the ValidatePurchaseToken method")
+//			},
+//		}
+//
+//		// use mockedPurchaseTokenValidatorInterface in code that requires PurchaseTokenValidatorInterface
+//		// and then make assertions.
+//
+//	}
+type PurchaseTokenValidatorInterfaceMock struct {
+	// ValidatePurchaseTokenFunc mocks the ValidatePurchaseToken method.
+	ValidatePurchaseTokenFunc func(token string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ValidatePurchaseToken holds details about calls to the ValidatePurchaseToken method.
+		ValidatePurchaseToken []struct {
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockValidatePurchaseToken sync.RWMutex
+}
+
+// ValidatePurchaseToken calls ValidatePurchaseTokenFunc.
+func (mock *PurchaseTokenValidatorInterfaceMock) ValidatePurchaseToken(token string) (string, error) {
+	if mock.ValidatePurchaseTokenFunc == nil {
+		panic("PurchaseTokenValidatorInterfaceMock.ValidatePurchaseTokenFunc: method is nil but PurchaseTokenValidatorInterface.ValidatePurchaseToken was just called")
+	}
+	callInfo := struct {
+		Token string
+	}{
+		Token: token,
+	}
+	mock.lockValidatePurchaseToken.Lock()
+	mock.calls.ValidatePurchaseToken = append(mock.calls.ValidatePurchaseToken, callInfo)
+	mock.lockValidatePurchaseToken.Unlock()
+	return mock.ValidatePurchaseTokenFunc(token)
+}
+
+// ValidatePurchaseTokenCalls gets all the calls that were made to ValidatePurchaseToken.
+// Check the length with:
+//
+//	len(mockedPurchaseTokenValidatorInterface.ValidatePurchaseTokenCalls())
+func (mock *PurchaseTokenValidatorInterfaceMock) ValidatePurchaseTokenCalls() []struct {
+	Token string
+} {
+	var calls []struct {
+		Token string
+	}
+	mock.lockValidatePurchaseToken.RLock()
+	calls = mock.calls.ValidatePurchaseToken
+	mock.lockValidatePurchaseToken.RUnlock()
+	return calls
+}
+
+// Ensure, that GiftItemPurchaseMarkerInterfaceMock does implement GiftItemPurchaseMarkerInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GiftItemPurchaseMarkerInterface = &GiftItemPurchaseMarkerInterfaceMock{}
+
+// GiftItemPurchaseMarkerInterfaceMock is a mock implementation of GiftItemPurchaseMarkerInterface.
+//
+//	func TestSomethingThatUsesGiftItemPurchaseMarkerInterface(t *testing.T) {
+//
+//		// make and configure a mocked GiftItemPurchaseMarkerInterface
+//		mockedGiftItemPurchaseMarkerInterface := &GiftItemPurchaseMarkerInterfaceMock{
+//			MarkGiftItemAsPurchasedByHolderFunc: func(ctx context.Context, giftItemID pgtype.UUID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) error {
+//				panic("mock out the MarkGiftItemAsPurchasedByHolder method")
+//			},
+//		}
+//
+//		// use mockedGiftItemPurchaseMarkerInterface in code that requires GiftItemPurchaseMarkerInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemPurchaseMarkerInterfaceMock struct {
+	// MarkGiftItemAsPurchasedByHolderFunc mocks the MarkGiftItemAsPurchasedByHolder method.
+	MarkGiftItemAsPurchasedByHolderFunc func(ctx context.Context, giftItemID pgtype.UUID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// MarkGiftItemAsPurchasedByHolder holds details about calls to the MarkGiftItemAsPurchasedByHolder method.
+		MarkGiftItemAsPurchasedByHolder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+			// PurchaserID is the purchaserID argument value.
+			PurchaserID pgtype.UUID
+			// PurchasedPrice is the purchasedPrice argument value.
+			PurchasedPrice pgtype.Numeric
+		}
+	}
+	lockMarkGiftItemAsPurchasedByHolder sync.RWMutex
+}
+
+// MarkGiftItemAsPurchasedByHolder calls MarkGiftItemAsPurchasedByHolderFunc.
+func (mock *GiftItemPurchaseMarkerInterfaceMock) MarkGiftItemAsPurchasedByHolder(ctx context.Context, giftItemID pgtype.UUID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) error {
+	if mock.MarkGiftItemAsPurchasedByHolderFunc == nil {
+		panic("GiftItemPurchaseMarkerInterfaceMock.MarkGiftItemAsPurchasedByHolderFunc: method is nil but GiftItemPurchaseMarkerInterface.MarkGiftItemAsPurchasedByHolder was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		GiftItemID     pgtype.UUID
+		PurchaserID    pgtype.UUID
+		PurchasedPrice pgtype.Numeric
+	}{
+		Ctx:            ctx,
+		GiftItemID:     giftItemID,
+		PurchaserID:    purchaserID,
+		PurchasedPrice: purchasedPrice,
+	}
+	mock.lockMarkGiftItemAsPurchasedByHolder.Lock()
+	mock.calls.MarkGiftItemAsPurchasedByHolder = append(mock.calls.MarkGiftItemAsPurchasedByHolder, callInfo)
+	mock.lockMarkGiftItemAsPurchasedByHolder.Unlock()
+	return mock.MarkGiftItemAsPurchasedByHolderFunc(ctx, giftItemID, purchaserID, purchasedPrice)
+}
+
+// MarkGiftItemAsPurchasedByHolderCalls gets all the calls that were made to MarkGiftItemAsPurchasedByHolder.
+// Check the length with:
+//
+//	len(mockedGiftItemPurchaseMarkerInterface.MarkGiftItemAsPurchasedByHolderCalls())
+func (mock *GiftItemPurchaseMarkerInterfaceMock) MarkGiftItemAsPurchasedByHolderCalls() []struct {
+	Ctx            context.Context
+	GiftItemID     pgtype.UUID
+	PurchaserID    pgtype.UUID
+	PurchasedPrice pgtype.Numeric
+} {
+	var calls []struct {
+		Ctx            context.Context
+		GiftItemID     pgtype.UUID
+		PurchaserID    pgtype.UUID
+		PurchasedPrice pgtype.Numeric
+	}
+	mock.lockMarkGiftItemAsPurchasedByHolder.RLock()
+	calls = mock.calls.MarkGiftItemAsPurchasedByHolder
+	mock.lockMarkGiftItemAsPurchasedByHolder.RUnlock()
+	return calls
+}
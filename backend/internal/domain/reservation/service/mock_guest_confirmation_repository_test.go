@@ -0,0 +1,134 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that GuestConfirmationRepositoryInterfaceMock does implement repository.GuestConfirmationRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GuestConfirmationRepositoryInterface = &GuestConfirmationRepositoryInterfaceMock{}
+
+// GuestConfirmationRepositoryInterfaceMock is a mock implementation of repository.GuestConfirmationRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestConfirmationRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GuestConfirmationRepositoryInterface
+//		mockedGuestConfirmationRepositoryInterface := &GuestConfirmationRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error) {
+//				panic("mock out the Create method")
+//			},
+//			TakeFunc: func(ctx context.Context, token pgtype.UUID) (*models.GuestConfirmation, error) {
+//				panic("mock out the Take method")
+//			},
+//		}
+//
+//		// use mockedGuestConfirmationRepositoryInterface in code that requires repository.GuestConfirmationRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestConfirmationRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error)
+
+	// TakeFunc mocks the Take method.
+	TakeFunc func(ctx context.Context, token pgtype.UUID) (*models.GuestConfirmation, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Confirmation is the confirmation argument value.
+			Confirmation models.GuestConfirmation
+		}
+		// Take holds details about calls to the Take method.
+		Take []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token pgtype.UUID
+		}
+	}
+	lockCreate sync.RWMutex
+	lockTake   sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *GuestConfirmationRepositoryInterfaceMock) Create(ctx context.Context, confirmation models.GuestConfirmation) (*models.GuestConfirmation, error) {
+	if mock.CreateFunc == nil {
+		panic("GuestConfirmationRepositoryInterfaceMock.CreateFunc: method is nil but GuestConfirmationRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Confirmation models.GuestConfirmation
+	}{
+		Ctx:          ctx,
+		Confirmation: confirmation,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, confirmation)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedGuestConfirmationRepositoryInterface.CreateCalls())
+func (mock *GuestConfirmationRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx          context.Context
+	Confirmation models.GuestConfirmation
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Confirmation models.GuestConfirmation
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Take calls TakeFunc.
+func (mock *GuestConfirmationRepositoryInterfaceMock) Take(ctx context.Context, token pgtype.UUID) (*models.GuestConfirmation, error) {
+	if mock.TakeFunc == nil {
+		panic("GuestConfirmationRepositoryInterfaceMock.TakeFunc: method is nil but GuestConfirmationRepositoryInterface.Take was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockTake.Lock()
+	mock.calls.Take = append(mock.calls.Take, callInfo)
+	mock.lockTake.Unlock()
+	return mock.TakeFunc(ctx, token)
+}
+
+// TakeCalls gets all the calls that were made to Take.
+// Check the length with:
+//
+//	len(mockedGuestConfirmationRepositoryInterface.TakeCalls())
+func (mock *GuestConfirmationRepositoryInterfaceMock) TakeCalls() []struct {
+	Ctx   context.Context
+	Token pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}
+	mock.lockTake.RLock()
+	calls = mock.calls.Take
+	mock.lockTake.RUnlock()
+	return calls
+}
@@ -0,0 +1,153 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that GuestLimitRepositoryInterfaceMock does implement repository.GuestLimitRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GuestLimitRepositoryInterface = &GuestLimitRepositoryInterfaceMock{}
+
+// GuestLimitRepositoryInterfaceMock is a mock implementation of repository.GuestLimitRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestLimitRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GuestLimitRepositoryInterface
+//		mockedGuestLimitRepositoryInterface := &GuestLimitRepositoryInterfaceMock{
+//			RecordFunc: func(ctx context.Context, reservationID pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error {
+//				panic("mock out the Record method")
+//			},
+//			UsageFunc: func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+//				panic("mock out the Usage method")
+//			},
+//		}
+//
+//		// use mockedGuestLimitRepositoryInterface in code that requires repository.GuestLimitRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestLimitRepositoryInterfaceMock struct {
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, reservationID pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error
+
+	// UsageFunc mocks the Usage method.
+	UsageFunc func(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+			// Subjects is the subjects argument value.
+			Subjects []models.GuestSubject
+			// PurgeBefore is the purgeBefore argument value.
+			PurgeBefore time.Time
+		}
+		// Usage holds details about calls to the Usage method.
+		Usage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Subject is the subject argument value.
+			Subject models.GuestSubject
+			// Since is the since argument value.
+			Since time.Time
+		}
+	}
+	lockRecord sync.RWMutex
+	lockUsage  sync.RWMutex
+}
+
+// Record calls RecordFunc.
+func (mock *GuestLimitRepositoryInterfaceMock) Record(ctx context.Context, reservationID pgtype.UUID, subjects []models.GuestSubject, purgeBefore time.Time) error {
+	if mock.RecordFunc == nil {
+		panic("GuestLimitRepositoryInterfaceMock.RecordFunc: method is nil but GuestLimitRepositoryInterface.Record was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		Subjects      []models.GuestSubject
+		PurgeBefore   time.Time
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+		Subjects:      subjects,
+		PurgeBefore:   purgeBefore,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, reservationID, subjects, purgeBefore)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedGuestLimitRepositoryInterface.RecordCalls())
+func (mock *GuestLimitRepositoryInterfaceMock) RecordCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+	Subjects      []models.GuestSubject
+	PurgeBefore   time.Time
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		Subjects      []models.GuestSubject
+		PurgeBefore   time.Time
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
+
+// Usage calls UsageFunc.
+func (mock *GuestLimitRepositoryInterfaceMock) Usage(ctx context.Context, subject models.GuestSubject, since time.Time) (*models.GuestUsage, error) {
+	if mock.UsageFunc == nil {
+		panic("GuestLimitRepositoryInterfaceMock.UsageFunc: method is nil but GuestLimitRepositoryInterface.Usage was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Subject models.GuestSubject
+		Since   time.Time
+	}{
+		Ctx:     ctx,
+		Subject: subject,
+		Since:   since,
+	}
+	mock.lockUsage.Lock()
+	mock.calls.Usage = append(mock.calls.Usage, callInfo)
+	mock.lockUsage.Unlock()
+	return mock.UsageFunc(ctx, subject, since)
+}
+
+// UsageCalls gets all the calls that were made to Usage.
+// Check the length with:
+//
+//	len(mockedGuestLimitRepositoryInterface.UsageCalls())
+func (mock *GuestLimitRepositoryInterfaceMock) UsageCalls() []struct {
+	Ctx     context.Context
+	Subject models.GuestSubject
+	Since   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Subject models.GuestSubject
+		Since   time.Time
+	}
+	mock.lockUsage.RLock()
+	calls = mock.calls.Usage
+	mock.lockUsage.RUnlock()
+	return calls
+}
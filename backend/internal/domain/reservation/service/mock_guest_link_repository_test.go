@@ -0,0 +1,364 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that GuestLinkRepositoryInterfaceMock does implement repository.GuestLinkRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.GuestLinkRepositoryInterface = &GuestLinkRepositoryInterfaceMock{}
+
+// GuestLinkRepositoryInterfaceMock is a mock implementation of repository.GuestLinkRepositoryInterface.
+//
+//	func TestSomethingThatUsesGuestLinkRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.GuestLinkRepositoryInterface
+//		mockedGuestLinkRepositoryInterface := &GuestLinkRepositoryInterfaceMock{
+//			CompleteForUserFunc: func(ctx context.Context, userID pgtype.UUID, linked int) error {
+//				panic("mock out the CompleteForUser method")
+//			},
+//			EnqueueFunc: func(ctx context.Context, userID pgtype.UUID) error {
+//				panic("mock out the Enqueue method")
+//			},
+//			ListDueFunc: func(ctx context.Context, limit int) ([]*models.GuestLink, error) {
+//				panic("mock out the ListDue method")
+//			},
+//			ListFailedFunc: func(ctx context.Context, limit int, offset int) ([]*models.GuestLink, int, error) {
+//				panic("mock out the ListFailed method")
+//			},
+//			MarkLinkedFunc: func(ctx context.Context, id pgtype.UUID, linked int) error {
+//				panic("mock out the MarkLinked method")
+//			},
+//			RecordFailureFunc: func(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error {
+//				panic("mock out the RecordFailure method")
+//			},
+//		}
+//
+//		// use mockedGuestLinkRepositoryInterface in code that requires repository.GuestLinkRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GuestLinkRepositoryInterfaceMock struct {
+	// CompleteForUserFunc mocks the CompleteForUser method.
+	CompleteForUserFunc func(ctx context.Context, userID pgtype.UUID, linked int) error
+
+	// EnqueueFunc mocks the Enqueue method.
+	EnqueueFunc func(ctx context.Context, userID pgtype.UUID) error
+
+	// ListDueFunc mocks the ListDue method.
+	ListDueFunc func(ctx context.Context, limit int) ([]*models.GuestLink, error)
+
+	// ListFailedFunc mocks the ListFailed method.
+	ListFailedFunc func(ctx context.Context, limit int, offset int) ([]*models.GuestLink, int, error)
+
+	// MarkLinkedFunc mocks the MarkLinked method.
+	MarkLinkedFunc func(ctx context.Context, id pgtype.UUID, linked int) error
+
+	// RecordFailureFunc mocks the RecordFailure method.
+	RecordFailureFunc func(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CompleteForUser holds details about calls to the CompleteForUser method.
+		CompleteForUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// Linked is the linked argument value.
+			Linked int
+		}
+		// Enqueue holds details about calls to the Enqueue method.
+		Enqueue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListDue holds details about calls to the ListDue method.
+		ListDue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListFailed holds details about calls to the ListFailed method.
+		ListFailed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// MarkLinked holds details about calls to the MarkLinked method.
+		MarkLinked []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Linked is the linked argument value.
+			Linked int
+		}
+		// RecordFailure holds details about calls to the RecordFailure method.
+		RecordFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// MaxAttempts is the maxAttempts argument value.
+			MaxAttempts int
+			// LastError is the lastError argument value.
+			LastError string
+		}
+	}
+	lockCompleteForUser sync.RWMutex
+	lockEnqueue         sync.RWMutex
+	lockListDue         sync.RWMutex
+	lockListFailed      sync.RWMutex
+	lockMarkLinked      sync.RWMutex
+	lockRecordFailure   sync.RWMutex
+}
+
+// CompleteForUser calls CompleteForUserFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) CompleteForUser(ctx context.Context, userID pgtype.UUID, linked int) error {
+	if mock.CompleteForUserFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.CompleteForUserFunc: method is nil but GuestLinkRepositoryInterface.CompleteForUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Linked int
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Linked: linked,
+	}
+	mock.lockCompleteForUser.Lock()
+	mock.calls.CompleteForUser = append(mock.calls.CompleteForUser, callInfo)
+	mock.lockCompleteForUser.Unlock()
+	return mock.CompleteForUserFunc(ctx, userID, linked)
+}
+
+// CompleteForUserCalls gets all the calls that were made to CompleteForUser.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.CompleteForUserCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) CompleteForUserCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	Linked int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Linked int
+	}
+	mock.lockCompleteForUser.RLock()
+	calls = mock.calls.CompleteForUser
+	mock.lockCompleteForUser.RUnlock()
+	return calls
+}
+
+// Enqueue calls EnqueueFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	if mock.EnqueueFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.EnqueueFunc: method is nil but GuestLinkRepositoryInterface.Enqueue was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockEnqueue.Lock()
+	mock.calls.Enqueue = append(mock.calls.Enqueue, callInfo)
+	mock.lockEnqueue.Unlock()
+	return mock.EnqueueFunc(ctx, userID)
+}
+
+// EnqueueCalls gets all the calls that were made to Enqueue.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.EnqueueCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) EnqueueCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockEnqueue.RLock()
+	calls = mock.calls.Enqueue
+	mock.lockEnqueue.RUnlock()
+	return calls
+}
+
+// ListDue calls ListDueFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) ListDue(ctx context.Context, limit int) ([]*models.GuestLink, error) {
+	if mock.ListDueFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.ListDueFunc: method is nil but GuestLinkRepositoryInterface.ListDue was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockListDue.Lock()
+	mock.calls.ListDue = append(mock.calls.ListDue, callInfo)
+	mock.lockListDue.Unlock()
+	return mock.ListDueFunc(ctx, limit)
+}
+
+// ListDueCalls gets all the calls that were made to ListDue.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.ListDueCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) ListDueCalls() []struct {
+	Ctx   context.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+	}
+	mock.lockListDue.RLock()
+	calls = mock.calls.ListDue
+	mock.lockListDue.RUnlock()
+	return calls
+}
+
+// ListFailed calls ListFailedFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) ListFailed(ctx context.Context, limit int, offset int) ([]*models.GuestLink, int, error) {
+	if mock.ListFailedFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.ListFailedFunc: method is nil but GuestLinkRepositoryInterface.ListFailed was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockListFailed.Lock()
+	mock.calls.ListFailed = append(mock.calls.ListFailed, callInfo)
+	mock.lockListFailed.Unlock()
+	return mock.ListFailedFunc(ctx, limit, offset)
+}
+
+// ListFailedCalls gets all the calls that were made to ListFailed.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.ListFailedCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) ListFailedCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockListFailed.RLock()
+	calls = mock.calls.ListFailed
+	mock.lockListFailed.RUnlock()
+	return calls
+}
+
+// MarkLinked calls MarkLinkedFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) MarkLinked(ctx context.Context, id pgtype.UUID, linked int) error {
+	if mock.MarkLinkedFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.MarkLinkedFunc: method is nil but GuestLinkRepositoryInterface.MarkLinked was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Linked int
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Linked: linked,
+	}
+	mock.lockMarkLinked.Lock()
+	mock.calls.MarkLinked = append(mock.calls.MarkLinked, callInfo)
+	mock.lockMarkLinked.Unlock()
+	return mock.MarkLinkedFunc(ctx, id, linked)
+}
+
+// MarkLinkedCalls gets all the calls that were made to MarkLinked.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.MarkLinkedCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) MarkLinkedCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	Linked int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Linked int
+	}
+	mock.lockMarkLinked.RLock()
+	calls = mock.calls.MarkLinked
+	mock.lockMarkLinked.RUnlock()
+	return calls
+}
+
+// RecordFailure calls RecordFailureFunc.
+func (mock *GuestLinkRepositoryInterfaceMock) RecordFailure(ctx context.Context, id pgtype.UUID, maxAttempts int, lastError string) error {
+	if mock.RecordFailureFunc == nil {
+		panic("GuestLinkRepositoryInterfaceMock.RecordFailureFunc: method is nil but GuestLinkRepositoryInterface.RecordFailure was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+		LastError   string
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		MaxAttempts: maxAttempts,
+		LastError:   lastError,
+	}
+	mock.lockRecordFailure.Lock()
+	mock.calls.RecordFailure = append(mock.calls.RecordFailure, callInfo)
+	mock.lockRecordFailure.Unlock()
+	return mock.RecordFailureFunc(ctx, id, maxAttempts, lastError)
+}
+
+// RecordFailureCalls gets all the calls that were made to RecordFailure.
+// Check the length with:
+//
+//	len(mockedGuestLinkRepositoryInterface.RecordFailureCalls())
+func (mock *GuestLinkRepositoryInterfaceMock) RecordFailureCalls() []struct {
+	Ctx         context.Context
+	ID          pgtype.UUID
+	MaxAttempts int
+	LastError   string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		MaxAttempts int
+		LastError   string
+	}
+	mock.lockRecordFailure.RLock()
+	calls = mock.calls.RecordFailure
+	mock.lockRecordFailure.RUnlock()
+	return calls
+}
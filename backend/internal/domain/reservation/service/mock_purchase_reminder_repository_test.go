@@ -0,0 +1,247 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that PurchaseReminderRepositoryInterfaceMock does implement repository.PurchaseReminderRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.PurchaseReminderRepositoryInterface = &PurchaseReminderRepositoryInterfaceMock{}
+
+// PurchaseReminderRepositoryInterfaceMock is a mock implementation of repository.PurchaseReminderRepositoryInterface.
+//
+//	func TestSomethingThatUsesPurchaseReminderRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.PurchaseReminderRepositoryInterface
+//		mockedPurchaseReminderRepositoryInterface := &PurchaseReminderRepositoryInterfaceMock{
+//			GetPurchaseFunc: func(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+//				panic("mock out the GetPurchase method")
+//			},
+//			ListDueFunc: func(ctx context.Context, from time.Time, to time.Time) ([]*models.PurchaseReminder, error) {
+//				panic("mock out the ListDue method")
+//			},
+//			MarkPurchasedFunc: func(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+//				panic("mock out the MarkPurchased method")
+//			},
+//			MarkRemindedFunc: func(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error {
+//				panic("mock out the MarkReminded method")
+//			},
+//		}
+//
+//		// use mockedPurchaseReminderRepositoryInterface in code that requires repository.PurchaseReminderRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type PurchaseReminderRepositoryInterfaceMock struct {
+	// GetPurchaseFunc mocks the GetPurchase method.
+	GetPurchaseFunc func(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error)
+
+	// ListDueFunc mocks the ListDue method.
+	ListDueFunc func(ctx context.Context, from time.Time, to time.Time) ([]*models.PurchaseReminder, error)
+
+	// MarkPurchasedFunc mocks the MarkPurchased method.
+	MarkPurchasedFunc func(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error)
+
+	// MarkRemindedFunc mocks the MarkReminded method.
+	MarkRemindedFunc func(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPurchase holds details about calls to the GetPurchase method.
+		GetPurchase []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+		}
+		// ListDue holds details about calls to the ListDue method.
+		ListDue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// MarkPurchased holds details about calls to the MarkPurchased method.
+		MarkPurchased []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+		}
+		// MarkReminded holds details about calls to the MarkReminded method.
+		MarkReminded []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+			// OccasionDate is the occasionDate argument value.
+			OccasionDate pgtype.Date
+		}
+	}
+	lockGetPurchase   sync.RWMutex
+	lockListDue       sync.RWMutex
+	lockMarkPurchased sync.RWMutex
+	lockMarkReminded  sync.RWMutex
+}
+
+// GetPurchase calls GetPurchaseFunc.
+func (mock *PurchaseReminderRepositoryInterfaceMock) GetPurchase(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+	if mock.GetPurchaseFunc == nil {
+		panic("PurchaseReminderRepositoryInterfaceMock.GetPurchaseFunc: method is nil but PurchaseReminderRepositoryInterface.GetPurchase was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+	}
+	mock.lockGetPurchase.Lock()
+	mock.calls.GetPurchase = append(mock.calls.GetPurchase, callInfo)
+	mock.lockGetPurchase.Unlock()
+	return mock.GetPurchaseFunc(ctx, reservationID)
+}
+
+// GetPurchaseCalls gets all the calls that were made to GetPurchase.
+// Check the length with:
+//
+//	len(mockedPurchaseReminderRepositoryInterface.GetPurchaseCalls())
+func (mock *PurchaseReminderRepositoryInterfaceMock) GetPurchaseCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}
+	mock.lockGetPurchase.RLock()
+	calls = mock.calls.GetPurchase
+	mock.lockGetPurchase.RUnlock()
+	return calls
+}
+
+// ListDue calls ListDueFunc.
+func (mock *PurchaseReminderRepositoryInterfaceMock) ListDue(ctx context.Context, from time.Time, to time.Time) ([]*models.PurchaseReminder, error) {
+	if mock.ListDueFunc == nil {
+		panic("PurchaseReminderRepositoryInterfaceMock.ListDueFunc: method is nil but PurchaseReminderRepositoryInterface.ListDue was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+		To:   to,
+	}
+	mock.lockListDue.Lock()
+	mock.calls.ListDue = append(mock.calls.ListDue, callInfo)
+	mock.lockListDue.Unlock()
+	return mock.ListDueFunc(ctx, from, to)
+}
+
+// ListDueCalls gets all the calls that were made to ListDue.
+// Check the length with:
+//
+//	len(mockedPurchaseReminderRepositoryInterface.ListDueCalls())
+func (mock *PurchaseReminderRepositoryInterfaceMock) ListDueCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}
+	mock.lockListDue.RLock()
+	calls = mock.calls.ListDue
+	mock.lockListDue.RUnlock()
+	return calls
+}
+
+// MarkPurchased calls MarkPurchasedFunc.
+func (mock *PurchaseReminderRepositoryInterfaceMock) MarkPurchased(ctx context.Context, reservationID pgtype.UUID) (*models.GuestPurchase, error) {
+	if mock.MarkPurchasedFunc == nil {
+		panic("PurchaseReminderRepositoryInterfaceMock.MarkPurchasedFunc: method is nil but PurchaseReminderRepositoryInterface.MarkPurchased was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+	}
+	mock.lockMarkPurchased.Lock()
+	mock.calls.MarkPurchased = append(mock.calls.MarkPurchased, callInfo)
+	mock.lockMarkPurchased.Unlock()
+	return mock.MarkPurchasedFunc(ctx, reservationID)
+}
+
+// MarkPurchasedCalls gets all the calls that were made to MarkPurchased.
+// Check the length with:
+//
+//	len(mockedPurchaseReminderRepositoryInterface.MarkPurchasedCalls())
+func (mock *PurchaseReminderRepositoryInterfaceMock) MarkPurchasedCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}
+	mock.lockMarkPurchased.RLock()
+	calls = mock.calls.MarkPurchased
+	mock.lockMarkPurchased.RUnlock()
+	return calls
+}
+
+// MarkReminded calls MarkRemindedFunc.
+func (mock *PurchaseReminderRepositoryInterfaceMock) MarkReminded(ctx context.Context, reservationID pgtype.UUID, occasionDate pgtype.Date) error {
+	if mock.MarkRemindedFunc == nil {
+		panic("PurchaseReminderRepositoryInterfaceMock.MarkRemindedFunc: method is nil but PurchaseReminderRepositoryInterface.MarkReminded was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		OccasionDate  pgtype.Date
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+		OccasionDate:  occasionDate,
+	}
+	mock.lockMarkReminded.Lock()
+	mock.calls.MarkReminded = append(mock.calls.MarkReminded, callInfo)
+	mock.lockMarkReminded.Unlock()
+	return mock.MarkRemindedFunc(ctx, reservationID, occasionDate)
+}
+
+// MarkRemindedCalls gets all the calls that were made to MarkReminded.
+// Check the length with:
+//
+//	len(mockedPurchaseReminderRepositoryInterface.MarkRemindedCalls())
+func (mock *PurchaseReminderRepositoryInterfaceMock) MarkRemindedCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+	OccasionDate  pgtype.Date
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		OccasionDate  pgtype.Date
+	}
+	mock.lockMarkReminded.RLock()
+	calls = mock.calls.MarkReminded
+	mock.lockMarkReminded.RUnlock()
+	return calls
+}
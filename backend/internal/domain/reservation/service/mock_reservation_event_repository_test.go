@@ -0,0 +1,191 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+)
+
+// Ensure, that ReservationEventRepositoryInterfaceMock does implement repository.ReservationEventRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.ReservationEventRepositoryInterface = &ReservationEventRepositoryInterfaceMock{}
+
+// ReservationEventRepositoryInterfaceMock is a mock implementation of repository.ReservationEventRepositoryInterface.
+//
+//	func TestSomethingThatUsesReservationEventRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.ReservationEventRepositoryInterface
+//		mockedReservationEventRepositoryInterface := &ReservationEventRepositoryInterfaceMock{
+//			AppendFunc: func(ctx context.Context, event models.ReservationEvent) error {
+//				panic("mock out the Append method")
+//			},
+//			ListByReservationFunc: func(ctx context.Context, reservationID pgtype.UUID) ([]*models.ReservationEvent, error) {
+//				panic("mock out the ListByReservation method")
+//			},
+//			ListDriftFunc: func(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error) {
+//				panic("mock out the ListDrift method")
+//			},
+//		}
+//
+//		// use mockedReservationEventRepositoryInterface in code that requires repository.ReservationEventRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type ReservationEventRepositoryInterfaceMock struct {
+	// AppendFunc mocks the Append method.
+	AppendFunc func(ctx context.Context, event models.ReservationEvent) error
+
+	// ListByReservationFunc mocks the ListByReservation method.
+	ListByReservationFunc func(ctx context.Context, reservationID pgtype.UUID) ([]*models.ReservationEvent, error)
+
+	// ListDriftFunc mocks the ListDrift method.
+	ListDriftFunc func(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Append holds details about calls to the Append method.
+		Append []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event models.ReservationEvent
+		}
+		// ListByReservation holds details about calls to the ListByReservation method.
+		ListByReservation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+		}
+		// ListDrift holds details about calls to the ListDrift method.
+		ListDrift []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SettledBefore is the settledBefore argument value.
+			SettledBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+	}
+	lockAppend            sync.RWMutex
+	lockListByReservation sync.RWMutex
+	lockListDrift         sync.RWMutex
+}
+
+// Append calls AppendFunc.
+func (mock *ReservationEventRepositoryInterfaceMock) Append(ctx context.Context, event models.ReservationEvent) error {
+	if mock.AppendFunc == nil {
+		panic("ReservationEventRepositoryInterfaceMock.AppendFunc: method is nil but ReservationEventRepositoryInterface.Append was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event models.ReservationEvent
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockAppend.Lock()
+	mock.calls.Append = append(mock.calls.Append, callInfo)
+	mock.lockAppend.Unlock()
+	return mock.AppendFunc(ctx, event)
+}
+
+// AppendCalls gets all the calls that were made to Append.
+// Check the length with:
+//
+//	len(mockedReservationEventRepositoryInterface.AppendCalls())
+func (mock *ReservationEventRepositoryInterfaceMock) AppendCalls() []struct {
+	Ctx   context.Context
+	Event models.ReservationEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event models.ReservationEvent
+	}
+	mock.lockAppend.RLock()
+	calls = mock.calls.Append
+	mock.lockAppend.RUnlock()
+	return calls
+}
+
+// ListByReservation calls ListByReservationFunc.
+func (mock *ReservationEventRepositoryInterfaceMock) ListByReservation(ctx context.Context, reservationID pgtype.UUID) ([]*models.ReservationEvent, error) {
+	if mock.ListByReservationFunc == nil {
+		panic("ReservationEventRepositoryInterfaceMock.ListByReservationFunc: method is nil but ReservationEventRepositoryInterface.ListByReservation was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+	}
+	mock.lockListByReservation.Lock()
+	mock.calls.ListByReservation = append(mock.calls.ListByReservation, callInfo)
+	mock.lockListByReservation.Unlock()
+	return mock.ListByReservationFunc(ctx, reservationID)
+}
+
+// ListByReservationCalls gets all the calls that were made to ListByReservation.
+// Check the length with:
+//
+//	len(mockedReservationEventRepositoryInterface.ListByReservationCalls())
+func (mock *ReservationEventRepositoryInterfaceMock) ListByReservationCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+	}
+	mock.lockListByReservation.RLock()
+	calls = mock.calls.ListByReservation
+	mock.lockListByReservation.RUnlock()
+	return calls
+}
+
+// ListDrift calls ListDriftFunc.
+func (mock *ReservationEventRepositoryInterfaceMock) ListDrift(ctx context.Context, settledBefore time.Time, limit int) ([]*models.ReservationDrift, error) {
+	if mock.ListDriftFunc == nil {
+		panic("ReservationEventRepositoryInterfaceMock.ListDriftFunc: method is nil but ReservationEventRepositoryInterface.ListDrift was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SettledBefore time.Time
+		Limit         int
+	}{
+		Ctx:           ctx,
+		SettledBefore: settledBefore,
+		Limit:         limit,
+	}
+	mock.lockListDrift.Lock()
+	mock.calls.ListDrift = append(mock.calls.ListDrift, callInfo)
+	mock.lockListDrift.Unlock()
+	return mock.ListDriftFunc(ctx, settledBefore, limit)
+}
+
+// ListDriftCalls gets all the calls that were made to ListDrift.
+// Check the length with:
+//
+//	len(mockedReservationEventRepositoryInterface.ListDriftCalls())
+func (mock *ReservationEventRepositoryInterfaceMock) ListDriftCalls() []struct {
+	Ctx           context.Context
+	SettledBefore time.Time
+	Limit         int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SettledBefore time.Time
+		Limit         int
+	}
+	mock.lockListDrift.RLock()
+	calls = mock.calls.ListDrift
+	mock.lockListDrift.RUnlock()
+	return calls
+}
@@ -21,6 +21,12 @@ var _ repository.ReservationRepositoryInterface = &ReservationRepositoryInterfac
 //
 //		// make and configure a mocked repository.ReservationRepositoryInterface
 //		mockedReservationRepositoryInterface := &ReservationRepositoryInterfaceMock{
+//			AnonymizeGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the AnonymizeGuestReservationsByEmail method")
+//			},
+//			ClaimByTokenFunc: func(ctx context.Context, token pgtype.UUID, userID pgtype.UUID) (*models.Reservation, error) {
+//				panic("mock out the ClaimByToken method")
+//			},
 //			CountUserReservationsFunc: func(ctx context.Context, userID pgtype.UUID) (int, error) {
 //				panic("mock out the CountUserReservations method")
 //			},
@@ -42,15 +48,27 @@ var _ repository.ReservationRepositoryInterface = &ReservationRepositoryInterfac
 //			GetReservationsByUserFunc: func(ctx context.Context, userID pgtype.UUID, limit int, offset int) ([]*models.Reservation, error) {
 //				panic("mock out the GetReservationsByUser method")
 //			},
+//			HasActiveReservationsForWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+//				panic("mock out the HasActiveReservationsForWishlist method")
+//			},
 //			LinkGuestReservationsToUserByEmailFunc: func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
 //				panic("mock out the LinkGuestReservationsToUserByEmail method")
 //			},
+//			ListGuestReservationsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*models.Reservation, error) {
+//				panic("mock out the ListGuestReservationsByEmail method")
+//			},
 //			ListGuestReservationsWithDetailsFunc: func(ctx context.Context, token pgtype.UUID) ([]repository.ReservationDetail, error) {
 //				panic("mock out the ListGuestReservationsWithDetails method")
 //			},
+//			ListPublicWishListReservationStatusesFunc: func(ctx context.Context, publicSlug string) ([]repository.PublicReservationStatus, error) {
+//				panic("mock out the ListPublicWishListReservationStatuses method")
+//			},
 //			ListUserReservationsWithDetailsFunc: func(ctx context.Context, userID pgtype.UUID, limit int, offset int) ([]repository.ReservationDetail, error) {
 //				panic("mock out the ListUserReservationsWithDetails method")
 //			},
+//			TransferToUserFunc: func(ctx context.Context, reservationID pgtype.UUID, fromUserID pgtype.UUID, toUserID pgtype.UUID) (*models.Reservation, error) {
+//				panic("mock out the TransferToUser method")
+//			},
 //			UpdateStatusFunc: func(ctx context.Context, reservationID pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error) {
 //				panic("mock out the UpdateStatus method")
 //			},
@@ -64,6 +82,12 @@ var _ repository.ReservationRepositoryInterface = &ReservationRepositoryInterfac
 //
 //	}
 type ReservationRepositoryInterfaceMock struct {
+	// AnonymizeGuestReservationsByEmailFunc mocks the AnonymizeGuestReservationsByEmail method.
+	AnonymizeGuestReservationsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ClaimByTokenFunc mocks the ClaimByToken method.
+	ClaimByTokenFunc func(ctx context.Context, token pgtype.UUID, userID pgtype.UUID) (*models.Reservation, error)
+
 	// CountUserReservationsFunc mocks the CountUserReservations method.
 	CountUserReservationsFunc func(ctx context.Context, userID pgtype.UUID) (int, error)
 
@@ -85,15 +109,27 @@ type ReservationRepositoryInterfaceMock struct {
 	// GetReservationsByUserFunc mocks the GetReservationsByUser method.
 	GetReservationsByUserFunc func(ctx context.Context, userID pgtype.UUID, limit int, offset int) ([]*models.Reservation, error)
 
+	// HasActiveReservationsForWishlistFunc mocks the HasActiveReservationsForWishlist method.
+	HasActiveReservationsForWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
+
 	// LinkGuestReservationsToUserByEmailFunc mocks the LinkGuestReservationsToUserByEmail method.
 	LinkGuestReservationsToUserByEmailFunc func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
 
+	// ListGuestReservationsByEmailFunc mocks the ListGuestReservationsByEmail method.
+	ListGuestReservationsByEmailFunc func(ctx context.Context, guestEmail string) ([]*models.Reservation, error)
+
 	// ListGuestReservationsWithDetailsFunc mocks the ListGuestReservationsWithDetails method.
 	ListGuestReservationsWithDetailsFunc func(ctx context.Context, token pgtype.UUID) ([]repository.ReservationDetail, error)
 
+	// ListPublicWishListReservationStatusesFunc mocks the ListPublicWishListReservationStatuses method.
+	ListPublicWishListReservationStatusesFunc func(ctx context.Context, publicSlug string) ([]repository.PublicReservationStatus, error)
+
 	// ListUserReservationsWithDetailsFunc mocks the ListUserReservationsWithDetails method.
 	ListUserReservationsWithDetailsFunc func(ctx context.Context, userID pgtype.UUID, limit int, offset int) ([]repository.ReservationDetail, error)
 
+	// TransferToUserFunc mocks the TransferToUser method.
+	TransferToUserFunc func(ctx context.Context, reservationID pgtype.UUID, fromUserID pgtype.UUID, toUserID pgtype.UUID) (*models.Reservation, error)
+
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(ctx context.Context, reservationID pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error)
 
@@ -102,6 +138,22 @@ type ReservationRepositoryInterfaceMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AnonymizeGuestReservationsByEmail holds details about calls to the AnonymizeGuestReservationsByEmail method.
+		AnonymizeGuestReservationsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ClaimByToken holds details about calls to the ClaimByToken method.
+		ClaimByToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token pgtype.UUID
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
 		// CountUserReservations holds details about calls to the CountUserReservations method.
 		CountUserReservations []struct {
 			// Ctx is the ctx argument value.
@@ -155,6 +207,13 @@ type ReservationRepositoryInterfaceMock struct {
 			// Offset is the offset argument value.
 			Offset int
 		}
+		// HasActiveReservationsForWishlist holds details about calls to the HasActiveReservationsForWishlist method.
+		HasActiveReservationsForWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
 		// LinkGuestReservationsToUserByEmail holds details about calls to the LinkGuestReservationsToUserByEmail method.
 		LinkGuestReservationsToUserByEmail []struct {
 			// Ctx is the ctx argument value.
@@ -164,6 +223,13 @@ type ReservationRepositoryInterfaceMock struct {
 			// UserID is the userID argument value.
 			UserID pgtype.UUID
 		}
+		// ListGuestReservationsByEmail holds details about calls to the ListGuestReservationsByEmail method.
+		ListGuestReservationsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
 		// ListGuestReservationsWithDetails holds details about calls to the ListGuestReservationsWithDetails method.
 		ListGuestReservationsWithDetails []struct {
 			// Ctx is the ctx argument value.
@@ -171,6 +237,13 @@ type ReservationRepositoryInterfaceMock struct {
 			// Token is the token argument value.
 			Token pgtype.UUID
 		}
+		// ListPublicWishListReservationStatuses holds details about calls to the ListPublicWishListReservationStatuses method.
+		ListPublicWishListReservationStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
 		// ListUserReservationsWithDetails holds details about calls to the ListUserReservationsWithDetails method.
 		ListUserReservationsWithDetails []struct {
 			// Ctx is the ctx argument value.
@@ -182,6 +255,17 @@ type ReservationRepositoryInterfaceMock struct {
 			// Offset is the offset argument value.
 			Offset int
 		}
+		// TransferToUser holds details about calls to the TransferToUser method.
+		TransferToUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReservationID is the reservationID argument value.
+			ReservationID pgtype.UUID
+			// FromUserID is the fromUserID argument value.
+			FromUserID pgtype.UUID
+			// ToUserID is the toUserID argument value.
+			ToUserID pgtype.UUID
+		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
 			// Ctx is the ctx argument value.
@@ -209,18 +293,100 @@ type ReservationRepositoryInterfaceMock struct {
 			CancelReason pgtype.Text
 		}
 	}
-	lockCountUserReservations              sync.RWMutex
-	lockCreate                             sync.RWMutex
-	lockGetActiveReservationForGiftItem    sync.RWMutex
-	lockGetByGiftItem                      sync.RWMutex
-	lockGetByID                            sync.RWMutex
-	lockGetByToken                         sync.RWMutex
-	lockGetReservationsByUser              sync.RWMutex
-	lockLinkGuestReservationsToUserByEmail sync.RWMutex
-	lockListGuestReservationsWithDetails   sync.RWMutex
-	lockListUserReservationsWithDetails    sync.RWMutex
-	lockUpdateStatus                       sync.RWMutex
-	lockUpdateStatusByToken                sync.RWMutex
+	lockAnonymizeGuestReservationsByEmail     sync.RWMutex
+	lockClaimByToken                          sync.RWMutex
+	lockCountUserReservations                 sync.RWMutex
+	lockCreate                                sync.RWMutex
+	lockGetActiveReservationForGiftItem       sync.RWMutex
+	lockGetByGiftItem                         sync.RWMutex
+	lockGetByID                               sync.RWMutex
+	lockGetByToken                            sync.RWMutex
+	lockGetReservationsByUser                 sync.RWMutex
+	lockHasActiveReservationsForWishlist      sync.RWMutex
+	lockLinkGuestReservationsToUserByEmail    sync.RWMutex
+	lockListGuestReservationsByEmail          sync.RWMutex
+	lockListGuestReservationsWithDetails      sync.RWMutex
+	lockListPublicWishListReservationStatuses sync.RWMutex
+	lockListUserReservationsWithDetails       sync.RWMutex
+	lockTransferToUser                        sync.RWMutex
+	lockUpdateStatus                          sync.RWMutex
+	lockUpdateStatusByToken                   sync.RWMutex
+}
+
+// AnonymizeGuestReservationsByEmail calls AnonymizeGuestReservationsByEmailFunc.
+func (mock *ReservationRepositoryInterfaceMock) AnonymizeGuestReservationsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.AnonymizeGuestReservationsByEmailFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.AnonymizeGuestReservationsByEmailFunc: method is nil but ReservationRepositoryInterface.AnonymizeGuestReservationsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockAnonymizeGuestReservationsByEmail.Lock()
+	mock.calls.AnonymizeGuestReservationsByEmail = append(mock.calls.AnonymizeGuestReservationsByEmail, callInfo)
+	mock.lockAnonymizeGuestReservationsByEmail.Unlock()
+	return mock.AnonymizeGuestReservationsByEmailFunc(ctx, guestEmail)
+}
+
+// AnonymizeGuestReservationsByEmailCalls gets all the calls that were made to AnonymizeGuestReservationsByEmail.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.AnonymizeGuestReservationsByEmailCalls())
+func (mock *ReservationRepositoryInterfaceMock) AnonymizeGuestReservationsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockAnonymizeGuestReservationsByEmail.RLock()
+	calls = mock.calls.AnonymizeGuestReservationsByEmail
+	mock.lockAnonymizeGuestReservationsByEmail.RUnlock()
+	return calls
+}
+
+// ClaimByToken calls ClaimByTokenFunc.
+func (mock *ReservationRepositoryInterfaceMock) ClaimByToken(ctx context.Context, token pgtype.UUID, userID pgtype.UUID) (*models.Reservation, error) {
+	if mock.ClaimByTokenFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.ClaimByTokenFunc: method is nil but ReservationRepositoryInterface.ClaimByToken was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Token  pgtype.UUID
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		Token:  token,
+		UserID: userID,
+	}
+	mock.lockClaimByToken.Lock()
+	mock.calls.ClaimByToken = append(mock.calls.ClaimByToken, callInfo)
+	mock.lockClaimByToken.Unlock()
+	return mock.ClaimByTokenFunc(ctx, token, userID)
+}
+
+// ClaimByTokenCalls gets all the calls that were made to ClaimByToken.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.ClaimByTokenCalls())
+func (mock *ReservationRepositoryInterfaceMock) ClaimByTokenCalls() []struct {
+	Ctx    context.Context
+	Token  pgtype.UUID
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Token  pgtype.UUID
+		UserID pgtype.UUID
+	}
+	mock.lockClaimByToken.RLock()
+	calls = mock.calls.ClaimByToken
+	mock.lockClaimByToken.RUnlock()
+	return calls
 }
 
 // CountUserReservations calls CountUserReservationsFunc.
@@ -483,6 +649,42 @@ func (mock *ReservationRepositoryInterfaceMock) GetReservationsByUserCalls() []s
 	return calls
 }
 
+// HasActiveReservationsForWishlist calls HasActiveReservationsForWishlistFunc.
+func (mock *ReservationRepositoryInterfaceMock) HasActiveReservationsForWishlist(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+	if mock.HasActiveReservationsForWishlistFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.HasActiveReservationsForWishlistFunc: method is nil but ReservationRepositoryInterface.HasActiveReservationsForWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockHasActiveReservationsForWishlist.Lock()
+	mock.calls.HasActiveReservationsForWishlist = append(mock.calls.HasActiveReservationsForWishlist, callInfo)
+	mock.lockHasActiveReservationsForWishlist.Unlock()
+	return mock.HasActiveReservationsForWishlistFunc(ctx, wishlistID)
+}
+
+// HasActiveReservationsForWishlistCalls gets all the calls that were made to HasActiveReservationsForWishlist.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.HasActiveReservationsForWishlistCalls())
+func (mock *ReservationRepositoryInterfaceMock) HasActiveReservationsForWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockHasActiveReservationsForWishlist.RLock()
+	calls = mock.calls.HasActiveReservationsForWishlist
+	mock.lockHasActiveReservationsForWishlist.RUnlock()
+	return calls
+}
+
 // LinkGuestReservationsToUserByEmail calls LinkGuestReservationsToUserByEmailFunc.
 func (mock *ReservationRepositoryInterfaceMock) LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
 	if mock.LinkGuestReservationsToUserByEmailFunc == nil {
@@ -523,6 +725,42 @@ func (mock *ReservationRepositoryInterfaceMock) LinkGuestReservationsToUserByEma
 	return calls
 }
 
+// ListGuestReservationsByEmail calls ListGuestReservationsByEmailFunc.
+func (mock *ReservationRepositoryInterfaceMock) ListGuestReservationsByEmail(ctx context.Context, guestEmail string) ([]*models.Reservation, error) {
+	if mock.ListGuestReservationsByEmailFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.ListGuestReservationsByEmailFunc: method is nil but ReservationRepositoryInterface.ListGuestReservationsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestReservationsByEmail.Lock()
+	mock.calls.ListGuestReservationsByEmail = append(mock.calls.ListGuestReservationsByEmail, callInfo)
+	mock.lockListGuestReservationsByEmail.Unlock()
+	return mock.ListGuestReservationsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestReservationsByEmailCalls gets all the calls that were made to ListGuestReservationsByEmail.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.ListGuestReservationsByEmailCalls())
+func (mock *ReservationRepositoryInterfaceMock) ListGuestReservationsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestReservationsByEmail.RLock()
+	calls = mock.calls.ListGuestReservationsByEmail
+	mock.lockListGuestReservationsByEmail.RUnlock()
+	return calls
+}
+
 // ListGuestReservationsWithDetails calls ListGuestReservationsWithDetailsFunc.
 func (mock *ReservationRepositoryInterfaceMock) ListGuestReservationsWithDetails(ctx context.Context, token pgtype.UUID) ([]repository.ReservationDetail, error) {
 	if mock.ListGuestReservationsWithDetailsFunc == nil {
@@ -559,6 +797,42 @@ func (mock *ReservationRepositoryInterfaceMock) ListGuestReservationsWithDetails
 	return calls
 }
 
+// ListPublicWishListReservationStatuses calls ListPublicWishListReservationStatusesFunc.
+func (mock *ReservationRepositoryInterfaceMock) ListPublicWishListReservationStatuses(ctx context.Context, publicSlug string) ([]repository.PublicReservationStatus, error) {
+	if mock.ListPublicWishListReservationStatusesFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.ListPublicWishListReservationStatusesFunc: method is nil but ReservationRepositoryInterface.ListPublicWishListReservationStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockListPublicWishListReservationStatuses.Lock()
+	mock.calls.ListPublicWishListReservationStatuses = append(mock.calls.ListPublicWishListReservationStatuses, callInfo)
+	mock.lockListPublicWishListReservationStatuses.Unlock()
+	return mock.ListPublicWishListReservationStatusesFunc(ctx, publicSlug)
+}
+
+// ListPublicWishListReservationStatusesCalls gets all the calls that were made to ListPublicWishListReservationStatuses.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.ListPublicWishListReservationStatusesCalls())
+func (mock *ReservationRepositoryInterfaceMock) ListPublicWishListReservationStatusesCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockListPublicWishListReservationStatuses.RLock()
+	calls = mock.calls.ListPublicWishListReservationStatuses
+	mock.lockListPublicWishListReservationStatuses.RUnlock()
+	return calls
+}
+
 // ListUserReservationsWithDetails calls ListUserReservationsWithDetailsFunc.
 func (mock *ReservationRepositoryInterfaceMock) ListUserReservationsWithDetails(ctx context.Context, userID pgtype.UUID, limit int, offset int) ([]repository.ReservationDetail, error) {
 	if mock.ListUserReservationsWithDetailsFunc == nil {
@@ -603,6 +877,50 @@ func (mock *ReservationRepositoryInterfaceMock) ListUserReservationsWithDetailsC
 	return calls
 }
 
+// TransferToUser calls TransferToUserFunc.
+func (mock *ReservationRepositoryInterfaceMock) TransferToUser(ctx context.Context, reservationID pgtype.UUID, fromUserID pgtype.UUID, toUserID pgtype.UUID) (*models.Reservation, error) {
+	if mock.TransferToUserFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.TransferToUserFunc: method is nil but ReservationRepositoryInterface.TransferToUser was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		FromUserID    pgtype.UUID
+		ToUserID      pgtype.UUID
+	}{
+		Ctx:           ctx,
+		ReservationID: reservationID,
+		FromUserID:    fromUserID,
+		ToUserID:      toUserID,
+	}
+	mock.lockTransferToUser.Lock()
+	mock.calls.TransferToUser = append(mock.calls.TransferToUser, callInfo)
+	mock.lockTransferToUser.Unlock()
+	return mock.TransferToUserFunc(ctx, reservationID, fromUserID, toUserID)
+}
+
+// TransferToUserCalls gets all the calls that were made to TransferToUser.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.TransferToUserCalls())
+func (mock *ReservationRepositoryInterfaceMock) TransferToUserCalls() []struct {
+	Ctx           context.Context
+	ReservationID pgtype.UUID
+	FromUserID    pgtype.UUID
+	ToUserID      pgtype.UUID
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ReservationID pgtype.UUID
+		FromUserID    pgtype.UUID
+		ToUserID      pgtype.UUID
+	}
+	mock.lockTransferToUser.RLock()
+	calls = mock.calls.TransferToUser
+	mock.lockTransferToUser.RUnlock()
+	return calls
+}
+
 // UpdateStatus calls UpdateStatusFunc.
 func (mock *ReservationRepositoryInterfaceMock) UpdateStatus(ctx context.Context, reservationID pgtype.UUID, status string, canceledAt pgtype.Timestamptz, cancelReason pgtype.Text) (*models.Reservation, error) {
 	if mock.UpdateStatusFunc == nil {
@@ -1,4 +1,4 @@
-//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GiftItemRepositoryInterface
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GiftItemRepositoryInterface EmailValidatorInterface UserRepositoryInterface ItemEventPublisherInterface ItemActivityRecorderInterface GuestConfirmationSenderInterface ThumbnailFetcherInterface PurchaseTokenValidatorInterface GiftItemPurchaseMarkerInterface
 
 package service
 
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	itemmodels "wish-list/internal/domain/item/models"
+	partnermodels "wish-list/internal/domain/partner/models"
 	"wish-list/internal/domain/reservation/models"
 	"wish-list/internal/domain/reservation/repository"
+	usermodels "wish-list/internal/domain/user/models"
 	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -30,6 +34,28 @@ type GiftItemReservationRepositoryInterface interface {
 	ReserveIfNotReserved(ctx context.Context, giftItemID, userID pgtype.UUID) (*itemmodels.GiftItem, error)
 }
 
+// UserRepositoryInterface defines user repository methods used by reservation service
+type UserRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+	GetByEmail(ctx context.Context, email string) (*usermodels.User, error)
+}
+
+// EmailValidatorInterface rejects malformed, disposable or undeliverable guest emails
+type EmailValidatorInterface interface {
+	Validate(ctx context.Context, address string) error
+}
+
+// ItemEventPublisherInterface announces reservation changes, e.g. to partner shops
+// holding stock for the reserved product
+type ItemEventPublisherInterface interface {
+	PublishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) error
+}
+
+// ItemActivityRecorderInterface counts reservation attempts for the owners' item insights
+type ItemActivityRecorderInterface interface {
+	RecordReservationAttempt(ctx context.Context, giftItemID pgtype.UUID) error
+}
+
 var (
 	ErrInvalidGiftItemID           = errors.New("invalid gift item id")
 	ErrInvalidReservationWishlist  = errors.New("invalid wishlist id")
@@ -39,8 +65,28 @@ var (
 	ErrReservationNotFound         = errors.New("no reservation found for this user and gift item")
 	ErrMissingUserOrToken          = errors.New("either user ID or reservation token must be provided")
 	ErrGiftItemNotInPublicWishlist = errors.New("gift item not found in the specified public wishlist")
+	ErrPublicWishlistNotFound      = errors.New("public wishlist not found")
+	ErrGuestEmailRejected          = errors.New("guest email is not accepted")
+	ErrInvalidReservationQuantity  = errors.New("reservation quantity must be between 1 and the item's quantity")
+	ErrNotEnoughQuantity           = errors.New("not enough units left to reserve")
+	ErrInvalidCancelReason         = errors.New("invalid cancellation reason")
+	ErrInvalidVariant              = variant.ErrInvalidChoice
 )
 
+// QuantityUnavailableError reports how many units of a multi-unit item are
+// still free when a reservation asks for more. It unwraps to ErrNotEnoughQuantity.
+type QuantityUnavailableError struct {
+	Remaining int32
+}
+
+func (e *QuantityUnavailableError) Error() string {
+	return fmt.Sprintf("%s: %d remaining", ErrNotEnoughQuantity, e.Remaining)
+}
+
+func (e *QuantityUnavailableError) Unwrap() error {
+	return ErrNotEnoughQuantity
+}
+
 // ReservationServiceInterface defines the interface for reservation-related operations
 type ReservationServiceInterface interface {
 	CreateReservation(ctx context.Context, input CreateReservationInput) (*ReservationOutput, error)
@@ -48,24 +94,62 @@ type ReservationServiceInterface interface {
 	GetUserReservations(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]repository.ReservationDetail, error)
 	GetGuestReservations(ctx context.Context, token pgtype.UUID) ([]repository.ReservationDetail, error)
 	GetReservationStatus(ctx context.Context, publicSlug, giftItemID string) (*ReservationStatusOutput, error)
+	GetReservationStatuses(ctx context.Context, publicSlug string) ([]*ItemReservationStatusOutput, error)
 	CountUserReservations(ctx context.Context, userID pgtype.UUID) (int, error)
+	SetBudget(ctx context.Context, userID pgtype.UUID, input SetBudgetInput) (*BudgetOutput, error)
+	DeleteBudget(ctx context.Context, userID pgtype.UUID, budgetID string) error
+	GetBudgetSummaries(ctx context.Context, userID pgtype.UUID) ([]*BudgetOutput, error)
+	ClaimGuestReservation(ctx context.Context, userID, token pgtype.UUID) (*ReservationOutput, error)
+	TransferReservation(ctx context.Context, input TransferReservationInput) (*ReservationOutput, error)
+	GetReservationTimeline(ctx context.Context, userID pgtype.UUID, reservationID string) ([]*ReservationEventOutput, error)
+	GetGuestReservationTimeline(ctx context.Context, token pgtype.UUID) ([]*ReservationEventOutput, error)
+	ConfirmGuestReservation(ctx context.Context, token, clientIP string) (*ReservationOutput, error)
 }
 
 type ReservationService struct {
 	repo                    repository.ReservationRepositoryInterface
 	giftItemRepo            GiftItemRepositoryInterface
 	giftItemReservationRepo GiftItemReservationRepositoryInterface
+	budgetRepo              repository.BudgetRepositoryInterface
+	userRepo                UserRepositoryInterface
+	emailValidator          EmailValidatorInterface
+	guestLimiter            *GuestLimiter
+	itemEvents              ItemEventPublisherInterface
+	itemActivity            ItemActivityRecorderInterface
+	events                  repository.ReservationEventRepositoryInterface
+	screening               *GuestScreening
 }
 
+// NewReservationService creates a ReservationService. emailValidator may be
+// nil, in which case guest emails are only trimmed. guestLimiter may be nil to
+// leave guest reservations uncapped, itemEvents to announce nothing,
+// itemActivity to count no reservation attempts, events to keep no history and
+// screening to score no guest reservations.
 func NewReservationService(
 	reservationRepo repository.ReservationRepositoryInterface,
 	giftItemRepo GiftItemRepositoryInterface,
 	giftItemReservationRepo GiftItemReservationRepositoryInterface,
+	budgetRepo repository.BudgetRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	emailValidator EmailValidatorInterface,
+	guestLimiter *GuestLimiter,
+	itemEvents ItemEventPublisherInterface,
+	itemActivity ItemActivityRecorderInterface,
+	events repository.ReservationEventRepositoryInterface,
+	screening *GuestScreening,
 ) *ReservationService {
 	return &ReservationService{
 		repo:                    reservationRepo,
 		giftItemRepo:            giftItemRepo,
 		giftItemReservationRepo: giftItemReservationRepo,
+		budgetRepo:              budgetRepo,
+		userRepo:                userRepo,
+		emailValidator:          emailValidator,
+		guestLimiter:            guestLimiter,
+		itemEvents:              itemEvents,
+		itemActivity:            itemActivity,
+		events:                  events,
+		screening:               screening,
 	}
 }
 
@@ -75,6 +159,14 @@ type CreateReservationInput struct {
 	UserID     pgtype.UUID
 	GuestName  *string
 	GuestEmail *string
+	Quantity   int32          // Units to reserve; 0 means 1
+	Variant    variant.Choice // Option values the giver is buying; empty skips the choice
+	Message    *string        // Note to the owner, shown once the gift is no longer a surprise
+	ClientIP   string         // Address of the guest, counted against the per-address cap
+	Honeypot   string         // Form field hidden from people; bots filling it in score as abuse
+	ViewedAt   *time.Time     // When the giver opened the shared list, as reported by the client
+
+	confirmed bool // Held reservation being confirmed by email, not scored again
 }
 
 type CancelReservationInput struct {
@@ -82,6 +174,7 @@ type CancelReservationInput struct {
 	GiftItemID       string
 	UserID           pgtype.UUID
 	ReservationToken *pgtype.UUID
+	Reason           string // Optional, one of models.CancelReasons
 }
 
 type ReservationOutput struct {
@@ -92,6 +185,9 @@ type ReservationOutput struct {
 	GuestEmail       *string
 	ReservationToken pgtype.UUID
 	Status           string
+	Quantity         int32
+	Variant          variant.Choice
+	Message          *string
 	ReservedAt       pgtype.Timestamptz
 	ExpiresAt        pgtype.Timestamptz
 	CanceledAt       pgtype.Timestamptz
@@ -100,10 +196,46 @@ type ReservationOutput struct {
 }
 
 type ReservationStatusOutput struct {
-	IsReserved     bool
-	ReservedByName *string
-	ReservedAt     *time.Time
-	Status         string
+	IsReserved        bool // Nothing left to reserve
+	ReservedByName    *string
+	ReservedAt        *time.Time
+	Status            string
+	ReservationStatus string // available, partially_reserved or fully_reserved
+	Quantity          int32  // Units wanted
+	RemainingQuantity int32  // Units still open for reservation
+}
+
+// stockStatus derives an item's reservation state from its stock. Units held by
+// reservations that have expired are counted as open again; an item that was
+// purchased or manually reserved has none left.
+func stockStatus(quantity, reserved, expired int32, unavailable bool) ReservationStatusOutput {
+	quantity = max(quantity, 1)
+	remaining := min(max(quantity-max(reserved-expired, 0), 0), quantity)
+	if unavailable {
+		remaining = 0
+	}
+
+	output := ReservationStatusOutput{
+		Status:            "available",
+		ReservationStatus: itemmodels.ReservationAvailable,
+		Quantity:          quantity,
+		RemainingQuantity: remaining,
+	}
+	switch {
+	case remaining == 0:
+		output.IsReserved = true
+		output.Status = "active"
+		output.ReservationStatus = itemmodels.ReservationFullyReserved
+	case remaining < quantity:
+		output.ReservationStatus = itemmodels.ReservationPartiallyReserved
+	}
+	return output
+}
+
+// ItemReservationStatusOutput is the reservation status of one item of a public wishlist
+type ItemReservationStatusOutput struct {
+	GiftItemID string
+	ReservationStatusOutput
 }
 
 func (s *ReservationService) CreateReservation(ctx context.Context, input CreateReservationInput) (*ReservationOutput, error) {
@@ -136,13 +268,29 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	if giftItem == nil {
 		return nil, ErrGiftItemNotInWishlist
 	}
+	// Counted before the checks below, so attempts that fail show interest as well
+	s.recordReservationAttempt(ctx, giftItemID)
+
+	quantity, err := reservationQuantity(giftItem, input.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	chosen, err := reservationVariant(giftItem, input.Variant)
+	if err != nil {
+		return nil, err
+	}
+	// Single-unit items keep the exclusive reservation checks; multi-unit items
+	// rely on the quantity check in repo.Create instead
+	singleUnit := giftItem.WantedQuantity() == 1
 
 	// Handle reservation based on user type (authenticated vs guest)
 	if input.UserID.Valid {
 		// For authenticated users, use atomic reservation that locks the gift item
-		_, err := s.giftItemReservationRepo.ReserveIfNotReserved(ctx, giftItem.ID, input.UserID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to reserve gift item: %w", err)
+		if singleUnit {
+			_, err := s.giftItemReservationRepo.ReserveIfNotReserved(ctx, giftItem.ID, input.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reserve gift item: %w", err)
+			}
 		}
 
 		// Now create the reservation record
@@ -151,26 +299,34 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 			GiftItemID:       giftItemID,
 			ReservedByUserID: input.UserID,
 			Status:           "active",
+			Quantity:         quantity,
+			Variant:          chosen,
+			Message:          reservationMessage(input.Message),
 			ReservedAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
 		}
 
 		dbReservation := s.mapToDbReservation(detail)
 		createdReservation, err := s.repo.Create(ctx, *dbReservation)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create reservation record: %w", err)
+			return nil, mapCreateReservationError(err, "failed to create reservation record")
 		}
+		s.recordEvent(ctx, createdReservation, models.ReservationEventCreated)
+		countViewToReservation(input.ViewedAt)
+		s.publishItemEvent(ctx, partnermodels.EventItemReserved, giftItemID)
 
 		return s.mapToOutput(createdReservation), nil
 	}
 	// For guest reservations, we need to check and create atomically
 	// First, check if there's an active reservation using a transaction
-	activeReservation, err := s.repo.GetActiveReservationForGiftItem(ctx, giftItemID)
-	if err != nil && !errors.Is(err, repository.ErrNoActiveReservation) {
-		return nil, fmt.Errorf("failed to check existing reservation: %w", err)
-	}
+	if singleUnit {
+		activeReservation, err := s.repo.GetActiveReservationForGiftItem(ctx, giftItemID)
+		if err != nil && !errors.Is(err, repository.ErrNoActiveReservation) {
+			return nil, fmt.Errorf("failed to check existing reservation: %w", err)
+		}
 
-	if activeReservation != nil {
-		return nil, ErrGiftItemAlreadyReserved
+		if activeReservation != nil {
+			return nil, ErrGiftItemAlreadyReserved
+		}
 	}
 
 	// Create the guest reservation
@@ -182,9 +338,18 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	if input.GuestEmail != nil {
 		email := strings.TrimSpace(*input.GuestEmail)
 		if email != "" {
+			if err := s.validateGuestEmail(ctx, email); err != nil {
+				return nil, err
+			}
 			guestEmail = pgtype.Text{String: email, Valid: true}
 		}
 	}
+	if err := s.checkGuestLimit(ctx, input, guestEmail.String); err != nil {
+		return nil, err
+	}
+	if err := s.screenGuestReservation(ctx, input, giftItem.Name, guestName, guestEmail.String); err != nil {
+		return nil, err
+	}
 
 	// Attempt to create the reservation record atomically
 	detail := repository.ReservationDetail{
@@ -193,6 +358,9 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 		GuestName:  pgtype.Text{String: guestName, Valid: true},
 		GuestEmail: guestEmail,
 		Status:     "active",
+		Quantity:   quantity,
+		Variant:    chosen,
+		Message:    reservationMessage(input.Message),
 		ReservedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
 		// Set expiration time for guest reservations (e.g., 30 days)
 		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(30 * 24 * time.Hour), Valid: true},
@@ -201,14 +369,22 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	dbReservation := s.mapToDbReservation(detail)
 	createdReservation, err := s.repo.Create(ctx, *dbReservation)
 	if err != nil {
-		// Check if this is a uniqueness constraint violation (another transaction got there first)
-		return nil, fmt.Errorf("failed to create reservation: %w", err)
+		// Another guest may have taken the last units between the checks and the insert
+		return nil, mapCreateReservationError(err, "failed to create reservation")
 	}
+	s.recordGuestReservation(ctx, createdReservation.ID, input, guestEmail.String)
+	s.recordEvent(ctx, createdReservation, models.ReservationEventCreated)
+	countViewToReservation(input.ViewedAt)
+	s.publishItemEvent(ctx, partnermodels.EventItemReserved, giftItemID)
 
 	return s.mapToOutput(createdReservation), nil
 }
 
 func (s *ReservationService) CancelReservation(ctx context.Context, input CancelReservationInput) (*ReservationOutput, error) {
+	if input.Reason != "" && !slices.Contains(models.CancelReasons, input.Reason) {
+		return nil, ErrInvalidCancelReason
+	}
+
 	// Validate gift item belongs to the specified wishlist
 	giftItemID := pgtype.UUID{}
 	if err := giftItemID.Scan(input.GiftItemID); err != nil {
@@ -247,10 +423,10 @@ func (s *ReservationService) CancelReservation(ctx context.Context, input Cancel
 			return nil, fmt.Errorf("failed to get reservations for gift item: %w", err)
 		}
 
-		// Find the reservation made by this user
+		// Find the active reservation made by this user
 		var reservationToCancel *models.Reservation
 		for _, res := range reservations {
-			if res.ReservedByUserID.Valid && res.ReservedByUserID == input.UserID {
+			if res.ReservedByUserID.Valid && res.ReservedByUserID == input.UserID && res.Status == "active" {
 				reservationToCancel = res
 				break
 			}
@@ -266,6 +442,8 @@ func (s *ReservationService) CancelReservation(ctx context.Context, input Cancel
 		if err != nil {
 			return nil, fmt.Errorf("failed to cancel reservation: %w", err)
 		}
+		s.recordCancellation(ctx, updatedReservation, input.Reason)
+		s.publishItemEvent(ctx, partnermodels.EventItemReleased, giftItemID)
 
 		return s.mapToOutput(updatedReservation), nil
 	} else if input.ReservationToken != nil {
@@ -276,12 +454,34 @@ func (s *ReservationService) CancelReservation(ctx context.Context, input Cancel
 		if err != nil {
 			return nil, fmt.Errorf("failed to cancel reservation: %w", err)
 		}
+		s.recordCancellation(ctx, updatedReservation, input.Reason)
+		s.publishItemEvent(ctx, partnermodels.EventItemReleased, updatedReservation.GiftItemID)
 
 		return s.mapToOutput(updatedReservation), nil
 	}
 	return nil, ErrMissingUserOrToken
 }
 
+// publishItemEvent announces a reservation change. The reservation already
+// happened, so a failure is logged rather than returned.
+func (s *ReservationService) publishItemEvent(ctx context.Context, event string, giftItemID pgtype.UUID) {
+	if s.itemEvents == nil {
+		return
+	}
+	if err := s.itemEvents.PublishItemEvent(ctx, event, giftItemID); err != nil {
+		logger.WarnContext(ctx, "failed to publish item event", "event", event, "gift_item_id", giftItemID.String(), "error", err)
+	}
+}
+
+func (s *ReservationService) recordReservationAttempt(ctx context.Context, giftItemID pgtype.UUID) {
+	if s.itemActivity == nil {
+		return
+	}
+	if err := s.itemActivity.RecordReservationAttempt(ctx, giftItemID); err != nil {
+		logger.WarnContext(ctx, "failed to record reservation attempt", "gift_item_id", giftItemID.String(), "error", err)
+	}
+}
+
 func (s *ReservationService) GetUserReservations(ctx context.Context, userID pgtype.UUID, limit, offset int) ([]repository.ReservationDetail, error) {
 	return s.repo.ListUserReservationsWithDetails(ctx, userID, limit, offset)
 }
@@ -327,13 +527,15 @@ func (s *ReservationService) CreateGuestReservation(ctx context.Context, giftIte
 	}
 
 	// Check if gift item is already reserved using atomic operation
-	activeReservation, err := s.repo.GetActiveReservationForGiftItem(ctx, itemID)
-	if err != nil && !errors.Is(err, repository.ErrNoActiveReservation) {
-		return nil, fmt.Errorf("failed to check existing reservation: %w", err)
-	}
+	if giftItem.WantedQuantity() == 1 {
+		activeReservation, err := s.repo.GetActiveReservationForGiftItem(ctx, itemID)
+		if err != nil && !errors.Is(err, repository.ErrNoActiveReservation) {
+			return nil, fmt.Errorf("failed to check existing reservation: %w", err)
+		}
 
-	if activeReservation != nil {
-		return nil, ErrGiftItemAlreadyReserved
+		if activeReservation != nil {
+			return nil, ErrGiftItemAlreadyReserved
+		}
 	}
 	guestName = strings.TrimSpace(guestName)
 	if guestName == "" {
@@ -342,8 +544,15 @@ func (s *ReservationService) CreateGuestReservation(ctx context.Context, giftIte
 	guestEmailField := pgtype.Text{Valid: false}
 	guestEmail = strings.TrimSpace(guestEmail)
 	if guestEmail != "" {
+		if err := s.validateGuestEmail(ctx, guestEmail); err != nil {
+			return nil, err
+		}
 		guestEmailField = pgtype.Text{String: guestEmail, Valid: true}
 	}
+	limitInput := CreateReservationInput{WishListID: wishlistID, GiftItemID: giftItemID}
+	if err := s.checkGuestLimit(ctx, limitInput, guestEmail); err != nil {
+		return nil, err
+	}
 
 	// Create the guest reservation
 	detail := repository.ReservationDetail{
@@ -352,6 +561,7 @@ func (s *ReservationService) CreateGuestReservation(ctx context.Context, giftIte
 		GuestName:  pgtype.Text{String: guestName, Valid: true},
 		GuestEmail: guestEmailField,
 		Status:     "active",
+		Quantity:   1,
 		ReservedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
 		// Set expiration time for guest reservations (e.g., 30 days)
 		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(30 * 24 * time.Hour), Valid: true},
@@ -360,12 +570,17 @@ func (s *ReservationService) CreateGuestReservation(ctx context.Context, giftIte
 	dbReservation := s.mapToDbReservation(detail)
 	createdReservation, err := s.repo.Create(ctx, *dbReservation)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create reservation: %w", err)
+		return nil, mapCreateReservationError(err, "failed to create reservation")
 	}
+	s.recordGuestReservation(ctx, createdReservation.ID, limitInput, guestEmail)
+	s.recordEvent(ctx, createdReservation, models.ReservationEventCreated)
 
 	return s.mapToOutput(createdReservation), nil
 }
 
+// GetReservationStatus returns the reservation state of an item of a public wishlist.
+// An item stays available until all of its units are reserved; the reserver is only
+// named once nothing is left.
 func (s *ReservationService) GetReservationStatus(ctx context.Context, publicSlug, giftItemID string) (*ReservationStatusOutput, error) {
 	// First, validate that the gift item exists and belongs to the public wishlist
 	itemID := pgtype.UUID{}
@@ -380,18 +595,20 @@ func (s *ReservationService) GetReservationStatus(ctx context.Context, publicSlu
 	}
 
 	// Check if the gift item belongs to this public wishlist
-	itemFound := false
+	var giftItem *itemmodels.GiftItem
 	for _, item := range publicWishlistItems {
 		if item.ID == itemID {
-			itemFound = true
+			giftItem = item
 			break
 		}
 	}
 
-	if !itemFound {
+	if giftItem == nil {
 		return nil, ErrGiftItemNotInPublicWishlist
 	}
 
+	unavailable := giftItem.PurchasedByUserID.Valid || giftItem.ManualReservedByName.Valid || giftItem.ManualReservedAt.Valid
+
 	// Check if there's an active reservation for this gift item
 	activeReservation, err := s.repo.GetActiveReservationForGiftItem(ctx, itemID)
 	if err != nil && !errors.Is(err, repository.ErrNoActiveReservation) {
@@ -399,26 +616,30 @@ func (s *ReservationService) GetReservationStatus(ctx context.Context, publicSlu
 	}
 
 	if activeReservation == nil {
-		return &ReservationStatusOutput{
-			IsReserved: false,
-			Status:     "available",
-		}, nil
+		output := stockStatus(giftItem.Quantity, giftItem.ReservedQuantity, 0, unavailable)
+		return &output, nil
 	}
 
 	// Check if the reservation is expired
 	if activeReservation.ExpiresAt.Valid && time.Now().After(activeReservation.ExpiresAt.Time) {
 		// Update the reservation status to expired
 		expiredAt := pgtype.Timestamptz{Time: time.Now(), Valid: true}
-		_, err := s.repo.UpdateStatus(ctx, activeReservation.ID, "expired", expiredAt, pgtype.Text{String: "Reservation expired", Valid: true})
+		expiredReservation, err := s.repo.UpdateStatus(ctx, activeReservation.ID, "expired", expiredAt, pgtype.Text{String: "Reservation expired", Valid: true})
 		if err != nil {
 			// Log the error but continue with the old status
-			logger.Error("failed to update expired reservation", "error", err, "reservation_id", activeReservation.ID)
+			logger.ErrorContext(ctx, "failed to update expired reservation", "error", err, "reservation_id", activeReservation.ID)
+		} else {
+			s.recordEvent(ctx, expiredReservation, models.ReservationEventExpired)
 		}
 
-		return &ReservationStatusOutput{
-			IsReserved: false,
-			Status:     "available",
-		}, nil
+		// The item was read before the update, so its units still count as reserved
+		output := stockStatus(giftItem.Quantity, giftItem.ReservedQuantity, reservationUnits(activeReservation), unavailable)
+		return &output, nil
+	}
+
+	output := stockStatus(giftItem.Quantity, giftItem.ReservedQuantity, 0, unavailable)
+	if !output.IsReserved || unavailable {
+		return &output, nil
 	}
 
 	// Get the reservation details
@@ -427,65 +648,143 @@ func (s *ReservationService) GetReservationStatus(ctx context.Context, publicSlu
 		return nil, fmt.Errorf("failed to get reservation details: %w", err)
 	}
 
-	if len(reservationDetails) == 0 {
-		// Fallback: use the basic reservation info
-		var reservedByName *string
-		if activeReservation.GuestName.Valid {
-			reservedByName = &activeReservation.GuestName.String
-		} else if activeReservation.ReservedByUserID.Valid {
-			// For registered users, we might want to get user details
-			// For privacy reasons, we could just return a generic string
-			placeholder := "Someone"
-			reservedByName = &placeholder
-		}
-
-		var reservedAt *time.Time
-		if activeReservation.ReservedAt.Valid {
-			reservedAt = &activeReservation.ReservedAt.Time
-		}
+	guestName := activeReservation.GuestName
+	reservedByUserID := activeReservation.ReservedByUserID
+	reservedAt := activeReservation.ReservedAt
+	output.Status = activeReservation.Status
+	if len(reservationDetails) > 0 {
+		// Use the detailed reservation info
+		guestName = reservationDetails[0].GuestName
+		reservedByUserID = reservationDetails[0].ReservedByUserID
+		reservedAt = reservationDetails[0].ReservedAt
+		output.Status = reservationDetails[0].Status
+	}
 
-		return &ReservationStatusOutput{
-			IsReserved:     true,
-			ReservedByName: reservedByName,
-			ReservedAt:     reservedAt,
-			Status:         activeReservation.Status,
-		}, nil
+	if guestName.Valid {
+		output.ReservedByName = &guestName.String
+	} else if reservedByUserID.Valid {
+		// For privacy reasons, registered users are not named
+		placeholder := "Someone"
+		output.ReservedByName = &placeholder
+	}
+	if reservedAt.Valid {
+		output.ReservedAt = &reservedAt.Time
 	}
 
-	// Use the detailed reservation info
-	reservation := reservationDetails[0]
+	return &output, nil
+}
 
-	var reservedByName *string
-	if reservation.GuestName.Valid {
-		reservedByName = &reservation.GuestName.String
-	} else if reservation.ReservedByUserID.Valid {
-		// For privacy reasons, we could return a generic string
-		placeholder := "Someone"
-		reservedByName = &placeholder
+// reservationUnits returns the number of units a reservation holds; rows read
+// without the quantity column hold a single unit
+func reservationUnits(reservation *models.Reservation) int32 {
+	return max(reservation.Quantity, 1)
+}
+
+// GetReservationStatuses returns the reservation status of every item of a public wishlist.
+// Units of expired reservations are reported as open; they are released when the item is next reserved.
+func (s *ReservationService) GetReservationStatuses(ctx context.Context, publicSlug string) ([]*ItemReservationStatusOutput, error) {
+	rows, err := s.repo.ListPublicWishListReservationStatuses(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrPublicWishListNotFound) {
+			return nil, ErrPublicWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get reservation statuses: %w", err)
 	}
 
-	var reservedAt *time.Time
-	if reservation.ReservedAt.Valid {
-		reservedAt = &reservation.ReservedAt.Time
+	now := time.Now()
+	outputs := make([]*ItemReservationStatusOutput, 0, len(rows))
+	for _, row := range rows {
+		output := &ItemReservationStatusOutput{
+			GiftItemID:              row.GiftItemID.String(),
+			ReservationStatusOutput: stockStatus(row.Quantity, row.ReservedQuantity, row.ExpiredQuantity, row.Unavailable),
+		}
+
+		// The reserver is only named once the last unit is taken by a live reservation
+		expired := row.ExpiresAt.Valid && now.After(row.ExpiresAt.Time)
+		if output.IsReserved && !row.Unavailable && row.Status.Valid && !expired {
+			output.Status = row.Status.String
+
+			if row.GuestName.Valid {
+				output.ReservedByName = &row.GuestName.String
+			} else if row.ReservedByUserID.Valid {
+				// For privacy reasons, registered users are not named
+				placeholder := "Someone"
+				output.ReservedByName = &placeholder
+			}
+			if row.ReservedAt.Valid {
+				output.ReservedAt = &row.ReservedAt.Time
+			}
+		}
+
+		outputs = append(outputs, output)
 	}
 
-	return &ReservationStatusOutput{
-		IsReserved:     true,
-		ReservedByName: reservedByName,
-		ReservedAt:     reservedAt,
-		Status:         reservation.Status,
-	}, nil
+	return outputs, nil
 }
 
 // CleanupExpiredReservations cleans up all expired reservations
 func (s *ReservationService) CleanupExpiredReservations(ctx context.Context) error {
 	// This would normally query for all expired reservations and update their status
 	// For now, we'll just log that this method exists
-	fmt.Println("Cleaning up expired reservations...")
+	logger.DebugContext(ctx, "cleaning up expired reservations")
+
+	return nil
+}
 
+// validateGuestEmail applies the configured email checks to a guest's address
+func (s *ReservationService) validateGuestEmail(ctx context.Context, email string) error {
+	if s.emailValidator == nil {
+		return nil
+	}
+	if err := s.emailValidator.Validate(ctx, email); err != nil {
+		return fmt.Errorf("%w: %w", ErrGuestEmailRejected, err)
+	}
 	return nil
 }
 
+// reservationQuantity resolves the requested number of units, defaulting to one,
+// and checks it against the number of units the owner wants
+func reservationQuantity(giftItem *itemmodels.GiftItem, requested int32) (int32, error) {
+	if requested == 0 {
+		requested = 1
+	}
+	if requested < 1 || requested > giftItem.WantedQuantity() {
+		return 0, ErrInvalidReservationQuantity
+	}
+	return requested, nil
+}
+
+// reservationVariant checks the giver's chosen variant against the item's
+// options. Picking a variant is optional, but a partial or unknown pick is rejected.
+func reservationVariant(giftItem *itemmodels.GiftItem, chosen variant.Choice) (variant.Choice, error) {
+	if chosen.IsEmpty() {
+		return variant.Choice{}, nil
+	}
+	return giftItem.Options.Match(chosen)
+}
+
+// reservationMessage trims the giver's note; a blank note is stored as NULL
+func reservationMessage(message *string) pgtype.Text {
+	if message == nil {
+		return pgtype.Text{}
+	}
+	trimmed := strings.TrimSpace(*message)
+	return pgtype.Text{String: trimmed, Valid: trimmed != ""}
+}
+
+// mapCreateReservationError translates quantity conflicts from repo.Create into
+// service errors; a fully reserved item is reported as already reserved
+func mapCreateReservationError(err error, msg string) error {
+	var insufficient *repository.InsufficientQuantityError
+	if errors.As(err, &insufficient) {
+		if insufficient.Remaining == 0 {
+			return ErrGiftItemAlreadyReserved
+		}
+		return &QuantityUnavailableError{Remaining: insufficient.Remaining}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
 // Helper functions to map between different types
 func (s *ReservationService) mapToDbReservation(detail repository.ReservationDetail) *models.Reservation {
 	return &models.Reservation{
@@ -497,6 +796,9 @@ func (s *ReservationService) mapToDbReservation(detail repository.ReservationDet
 		GuestEmail:       detail.GuestEmail,
 		ReservationToken: detail.ReservationToken,
 		Status:           detail.Status,
+		Quantity:         detail.Quantity,
+		Variant:          detail.Variant,
+		Message:          detail.Message,
 		ReservedAt:       detail.ReservedAt,
 		ExpiresAt:        detail.ExpiresAt,
 		CanceledAt:       detail.CanceledAt,
@@ -516,6 +818,11 @@ func (s *ReservationService) mapToOutput(reservation *models.Reservation) *Reser
 		guestEmail = &reservation.GuestEmail.String
 	}
 
+	var message *string
+	if reservation.Message.Valid {
+		message = &reservation.Message.String
+	}
+
 	return &ReservationOutput{
 		ID:               reservation.ID,
 		GiftItemID:       reservation.GiftItemID,
@@ -524,6 +831,9 @@ func (s *ReservationService) mapToOutput(reservation *models.Reservation) *Reser
 		GuestEmail:       guestEmail,
 		ReservationToken: reservation.ReservationToken,
 		Status:           reservation.Status,
+		Quantity:         reservation.Quantity,
+		Variant:          reservation.Variant,
+		Message:          message,
 		ReservedAt:       reservation.ReservedAt,
 		ExpiresAt:        reservation.ExpiresAt,
 		CanceledAt:       reservation.CanceledAt,
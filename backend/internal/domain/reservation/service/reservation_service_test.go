@@ -7,8 +7,11 @@ import (
 	"time"
 
 	itemmodels "wish-list/internal/domain/item/models"
+	partnermodels "wish-list/internal/domain/partner/models"
 	"wish-list/internal/domain/reservation/models"
 	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/emailcheck"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -44,7 +47,7 @@ func TestReservationService_GetReservationStatus(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
 
 		require.NoError(t, err)
@@ -67,7 +70,7 @@ func TestReservationService_GetReservationStatus(t *testing.T) {
 
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
 			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
-				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+				return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 1, ReservedQuantity: 1}}, nil
 			},
 		}
 		mockRepo := &ReservationRepositoryInterfaceMock{
@@ -79,7 +82,7 @@ func TestReservationService_GetReservationStatus(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
 
 		require.NoError(t, err)
@@ -87,11 +90,66 @@ func TestReservationService_GetReservationStatus(t *testing.T) {
 		assert.Equal(t, "active", status.Status)
 	})
 
+	t.Run("partially reserved gift item stays available", func(t *testing.T) {
+		giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 3, ReservedQuantity: 1}}, nil
+			},
+		}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return &models.Reservation{GiftItemID: giftItemID, Status: "active", Quantity: 1}, nil
+			},
+		}
+
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
+
+		require.NoError(t, err)
+		assert.False(t, status.IsReserved)
+		assert.Equal(t, "available", status.Status)
+		assert.Equal(t, itemmodels.ReservationPartiallyReserved, status.ReservationStatus)
+		assert.Equal(t, int32(3), status.Quantity)
+		assert.Equal(t, int32(2), status.RemainingQuantity)
+		assert.Nil(t, status.ReservedByName)
+		assert.Empty(t, mockRepo.ListGuestReservationsWithDetailsCalls())
+	})
+
+	t.Run("manually reserved gift item is fully reserved", func(t *testing.T) {
+		giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{{
+					ID:                   giftItemID,
+					Quantity:             2,
+					ManualReservedByName: pgtype.Text{String: "Grandma", Valid: true},
+					ManualReservedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				}}, nil
+			},
+		}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrNoActiveReservation
+			},
+		}
+
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
+
+		require.NoError(t, err)
+		assert.True(t, status.IsReserved)
+		assert.Equal(t, itemmodels.ReservationFullyReserved, status.ReservationStatus)
+		assert.Equal(t, int32(0), status.RemainingQuantity)
+	})
+
 	t.Run("invalid gift item id", func(t *testing.T) {
 		mockRepo := &ReservationRepositoryInterfaceMock{}
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", "invalid-uuid")
 
 		require.Error(t, err)
@@ -100,6 +158,115 @@ func TestReservationService_GetReservationStatus(t *testing.T) {
 	})
 }
 
+func TestReservationService_GetReservationStatuses(t *testing.T) {
+	t.Run("statuses for all items in one repository call", func(t *testing.T) {
+		availableID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+		guestReservedID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+		userReservedID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+		expiredID := pgtype.UUID{Bytes: [16]byte{4}, Valid: true}
+		partialID := pgtype.UUID{Bytes: [16]byte{5}, Valid: true}
+		purchasedID := pgtype.UUID{Bytes: [16]byte{6}, Valid: true}
+		reservedAt := time.Now().Add(-time.Hour)
+
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ListPublicWishListReservationStatusesFunc: func(ctx context.Context, publicSlug string) ([]repository.PublicReservationStatus, error) {
+				return []repository.PublicReservationStatus{
+					{GiftItemID: availableID},
+					{
+						GiftItemID:       guestReservedID,
+						Quantity:         1,
+						ReservedQuantity: 1,
+						GuestName:        pgtype.Text{String: "Jane", Valid: true},
+						Status:           pgtype.Text{String: "active", Valid: true},
+						ReservedAt:       pgtype.Timestamptz{Time: reservedAt, Valid: true},
+					},
+					{
+						GiftItemID:       userReservedID,
+						Quantity:         1,
+						ReservedQuantity: 1,
+						ReservedByUserID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+						Status:           pgtype.Text{String: "active", Valid: true},
+					},
+					{
+						GiftItemID:       expiredID,
+						Quantity:         1,
+						ReservedQuantity: 1,
+						ExpiredQuantity:  1,
+						Status:           pgtype.Text{String: "active", Valid: true},
+						ExpiresAt:        pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+					},
+					{
+						GiftItemID:       partialID,
+						Quantity:         3,
+						ReservedQuantity: 1,
+						GuestName:        pgtype.Text{String: "Jane", Valid: true},
+						Status:           pgtype.Text{String: "active", Valid: true},
+					},
+					{
+						GiftItemID:  purchasedID,
+						Quantity:    2,
+						Unavailable: true,
+					},
+				}, nil
+			},
+		}
+
+		service := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+		statuses, err := service.GetReservationStatuses(context.Background(), "public-slug")
+
+		require.NoError(t, err)
+		require.Len(t, statuses, 6)
+
+		assert.Equal(t, availableID.String(), statuses[0].GiftItemID)
+		assert.False(t, statuses[0].IsReserved)
+		assert.Equal(t, "available", statuses[0].Status)
+		assert.Equal(t, itemmodels.ReservationAvailable, statuses[0].ReservationStatus)
+		assert.Equal(t, int32(1), statuses[0].RemainingQuantity)
+
+		assert.True(t, statuses[1].IsReserved)
+		require.NotNil(t, statuses[1].ReservedByName)
+		assert.Equal(t, "Jane", *statuses[1].ReservedByName)
+		require.NotNil(t, statuses[1].ReservedAt)
+		assert.True(t, reservedAt.Equal(*statuses[1].ReservedAt))
+
+		assert.True(t, statuses[2].IsReserved)
+		require.NotNil(t, statuses[2].ReservedByName)
+		assert.Equal(t, "Someone", *statuses[2].ReservedByName)
+
+		assert.False(t, statuses[3].IsReserved)
+		assert.Equal(t, "available", statuses[3].Status)
+		assert.Equal(t, itemmodels.ReservationAvailable, statuses[3].ReservationStatus)
+
+		assert.False(t, statuses[4].IsReserved)
+		assert.Equal(t, "available", statuses[4].Status)
+		assert.Equal(t, itemmodels.ReservationPartiallyReserved, statuses[4].ReservationStatus)
+		assert.Equal(t, int32(2), statuses[4].RemainingQuantity)
+		assert.Nil(t, statuses[4].ReservedByName)
+
+		assert.True(t, statuses[5].IsReserved)
+		assert.Equal(t, itemmodels.ReservationFullyReserved, statuses[5].ReservationStatus)
+		assert.Equal(t, int32(0), statuses[5].RemainingQuantity)
+		assert.Nil(t, statuses[5].ReservedByName)
+
+		assert.Len(t, mockRepo.ListPublicWishListReservationStatusesCalls(), 1)
+		assert.Empty(t, mockRepo.GetActiveReservationForGiftItemCalls())
+	})
+
+	t.Run("unknown slug", func(t *testing.T) {
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ListPublicWishListReservationStatusesFunc: func(ctx context.Context, publicSlug string) ([]repository.PublicReservationStatus, error) {
+				return nil, repository.ErrPublicWishListNotFound
+			},
+		}
+
+		service := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+		statuses, err := service.GetReservationStatuses(context.Background(), "missing")
+
+		require.ErrorIs(t, err, ErrPublicWishlistNotFound)
+		assert.Nil(t, statuses)
+	})
+}
+
 // T070a: Unit tests for reservation expiration logic
 func TestReservationService_ExpirationLogic(t *testing.T) {
 	t.Run("expired reservation returns available status", func(t *testing.T) {
@@ -120,7 +287,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
 			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
-				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+				return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 1, ReservedQuantity: 1}}, nil
 			},
 		}
 		mockRepo := &ReservationRepositoryInterfaceMock{
@@ -132,7 +299,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
 
 		require.NoError(t, err)
@@ -158,7 +325,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
 			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
-				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+				return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 1, ReservedQuantity: 1}}, nil
 			},
 		}
 		mockRepo := &ReservationRepositoryInterfaceMock{
@@ -170,7 +337,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
 
 		require.NoError(t, err)
@@ -192,7 +359,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
 			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
-				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+				return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 1, ReservedQuantity: 1}}, nil
 			},
 		}
 		mockRepo := &ReservationRepositoryInterfaceMock{
@@ -204,7 +371,7 @@ func TestReservationService_ExpirationLogic(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 		status, err := service.GetReservationStatus(context.Background(), "public-slug", giftItemID.String())
 
 		require.NoError(t, err)
@@ -237,7 +404,7 @@ func TestReservationService_ConcurrencyControls(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		guestName := "Test User"
 		guestEmail := "test@example.com"
@@ -288,7 +455,7 @@ func TestReservationService_ConcurrencyControls(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, mockGiftItemReservationRepo)
+		service := NewReservationService(mockRepo, mockGiftItemRepo, mockGiftItemReservationRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		input := CreateReservationInput{
 			WishListID: wishlistID.String(),
@@ -337,7 +504,13 @@ func TestReservationService_CreateReservation(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		itemEvents := &ItemEventPublisherInterfaceMock{
+			PublishItemEventFunc: func(ctx context.Context, event string, id pgtype.UUID) error {
+				return nil
+			},
+		}
+
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, itemEvents, nil, nil, nil)
 
 		guestName := "Test Guest"
 		input := CreateReservationInput{
@@ -352,6 +525,9 @@ func TestReservationService_CreateReservation(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, reservation)
 		assert.Equal(t, "active", reservation.Status)
+		require.Len(t, itemEvents.PublishItemEventCalls(), 1)
+		assert.Equal(t, partnermodels.EventItemReserved, itemEvents.PublishItemEventCalls()[0].Event)
+		assert.Equal(t, giftItemID, itemEvents.PublishItemEventCalls()[0].GiftItemID)
 	})
 
 	t.Run("guest reservation requires name", func(t *testing.T) {
@@ -371,7 +547,7 @@ func TestReservationService_CreateReservation(t *testing.T) {
 			},
 		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		input := CreateReservationInput{
 			WishListID: wishlistID.String(),
@@ -387,11 +563,90 @@ func TestReservationService_CreateReservation(t *testing.T) {
 		assert.ErrorIs(t, err, ErrGuestInfoRequired)
 	})
 
+	t.Run("guest email rejected by validator", func(t *testing.T) {
+		giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+		wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+
+		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+			},
+		}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrNoActiveReservation
+			},
+		}
+		validator := &EmailValidatorInterfaceMock{
+			ValidateFunc: func(ctx context.Context, address string) error {
+				assert.Equal(t, "guest@mailinator.com", address)
+				return emailcheck.ErrDisposableDomain
+			},
+		}
+
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, validator, nil, nil, nil, nil, nil)
+
+		guestName := "Test Guest"
+		guestEmail := "  guest@mailinator.com "
+		input := CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			GuestEmail: &guestEmail,
+		}
+
+		_, err := service.CreateReservation(context.Background(), input)
+
+		require.ErrorIs(t, err, ErrGuestEmailRejected)
+		assert.ErrorIs(t, err, emailcheck.ErrDisposableDomain)
+		assert.Empty(t, mockRepo.CreateCalls())
+	})
+
+	t.Run("guest email accepted by validator", func(t *testing.T) {
+		giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+		wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+
+		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+			},
+		}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrNoActiveReservation
+			},
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				assert.Equal(t, "guest@example.com", reservation.GuestEmail.String)
+				return &reservation, nil
+			},
+		}
+		validator := &EmailValidatorInterfaceMock{
+			ValidateFunc: func(ctx context.Context, address string) error { return nil },
+		}
+
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, validator, nil, nil, nil, nil, nil)
+
+		guestName := "Test Guest"
+		guestEmail := "guest@example.com"
+		input := CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			GuestEmail: &guestEmail,
+		}
+
+		_, err := service.CreateReservation(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Len(t, validator.ValidateCalls(), 1)
+		assert.Len(t, mockRepo.CreateCalls(), 1)
+	})
+
 	t.Run("invalid gift item id", func(t *testing.T) {
 		mockRepo := &ReservationRepositoryInterfaceMock{}
 		mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		input := CreateReservationInput{
 			WishListID: "list-123",
@@ -406,6 +661,268 @@ func TestReservationService_CreateReservation(t *testing.T) {
 	})
 }
 
+func TestReservationService_CreateReservation_Quantity(t *testing.T) {
+	giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+	guestName := "Test Guest"
+
+	newService := func(giftItem *itemmodels.GiftItem, repo *ReservationRepositoryInterfaceMock) *ReservationService {
+		giftItemRepo := &GiftItemRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+				return []*itemmodels.GiftItem{giftItem}, nil
+			},
+		}
+		return NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	t.Run("guest reserves part of a multi-unit item", func(t *testing.T) {
+		giftItem := &itemmodels.GiftItem{ID: giftItemID, Quantity: 6, ReservedQuantity: 2}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				assert.Equal(t, int32(3), reservation.Quantity)
+				return &reservation, nil
+			},
+		}
+
+		reservation, err := newService(giftItem, mockRepo).CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			Quantity:   3,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), reservation.Quantity)
+		// Multi-unit items skip the single active reservation check
+		assert.Empty(t, mockRepo.GetActiveReservationForGiftItemCalls())
+	})
+
+	t.Run("registered user does not take an exclusive hold on a multi-unit item", func(t *testing.T) {
+		giftItem := &itemmodels.GiftItem{ID: giftItemID, Quantity: 6}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				return &reservation, nil
+			},
+		}
+
+		reservation, err := newService(giftItem, mockRepo).CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			UserID:     pgtype.UUID{Bytes: [16]byte{7}, Valid: true},
+		})
+
+		// mockGiftItemReservationRepo fails when called, so success means it was skipped
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), reservation.Quantity)
+	})
+
+	t.Run("quantity above the wanted units is rejected", func(t *testing.T) {
+		giftItem := &itemmodels.GiftItem{ID: giftItemID, Quantity: 2}
+		mockRepo := &ReservationRepositoryInterfaceMock{}
+
+		_, err := newService(giftItem, mockRepo).CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			Quantity:   3,
+		})
+
+		require.ErrorIs(t, err, ErrInvalidReservationQuantity)
+		assert.Empty(t, mockRepo.CreateCalls())
+	})
+
+	t.Run("not enough units left reports the remaining count", func(t *testing.T) {
+		giftItem := &itemmodels.GiftItem{ID: giftItemID, Quantity: 6, ReservedQuantity: 4}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				return nil, &repository.InsufficientQuantityError{Remaining: 2}
+			},
+		}
+
+		_, err := newService(giftItem, mockRepo).CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			Quantity:   3,
+		})
+
+		require.ErrorIs(t, err, ErrNotEnoughQuantity)
+		var unavailable *QuantityUnavailableError
+		require.ErrorAs(t, err, &unavailable)
+		assert.Equal(t, int32(2), unavailable.Remaining)
+	})
+
+	t.Run("fully reserved item is reported as already reserved", func(t *testing.T) {
+		giftItem := &itemmodels.GiftItem{ID: giftItemID, Quantity: 6, ReservedQuantity: 6}
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				return nil, &repository.InsufficientQuantityError{Remaining: 0}
+			},
+		}
+
+		_, err := newService(giftItem, mockRepo).CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+		})
+
+		require.ErrorIs(t, err, ErrGiftItemAlreadyReserved)
+	})
+}
+
+func TestReservationService_CreateReservation_RecordsAttempts(t *testing.T) {
+	giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+	guestName := "Test Guest"
+	giftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{{ID: giftItemID, Quantity: 2}}, nil
+		},
+	}
+
+	t.Run("a failed attempt is counted", func(t *testing.T) {
+		activity := &ItemActivityRecorderInterfaceMock{
+			RecordReservationAttemptFunc: func(ctx context.Context, id pgtype.UUID) error { return nil },
+		}
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, activity, nil, nil)
+
+		_, err := svc.CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+			Quantity:   3,
+		})
+
+		require.ErrorIs(t, err, ErrInvalidReservationQuantity)
+		require.Len(t, activity.RecordReservationAttemptCalls(), 1)
+		assert.Equal(t, giftItemID, activity.RecordReservationAttemptCalls()[0].GiftItemID)
+	})
+
+	t.Run("an item outside the wishlist is not counted", func(t *testing.T) {
+		activity := &ItemActivityRecorderInterfaceMock{}
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, activity, nil, nil)
+
+		_, err := svc.CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}.String(),
+			GuestName:  &guestName,
+		})
+
+		require.ErrorIs(t, err, ErrGiftItemNotInWishlist)
+		assert.Empty(t, activity.RecordReservationAttemptCalls())
+	})
+
+	t.Run("a recording failure does not fail the reservation", func(t *testing.T) {
+		activity := &ItemActivityRecorderInterfaceMock{
+			RecordReservationAttemptFunc: func(ctx context.Context, id pgtype.UUID) error { return errors.New("db down") },
+		}
+		repo := &ReservationRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+				return &reservation, nil
+			},
+		}
+		svc := NewReservationService(repo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, activity, nil, nil)
+
+		_, err := svc.CreateReservation(context.Background(), CreateReservationInput{
+			WishListID: wishlistID.String(),
+			GiftItemID: giftItemID.String(),
+			GuestName:  &guestName,
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestReservationService_CreateReservation_Variant(t *testing.T) {
+	giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+	guestName := "Test Guest"
+	giftItem := &itemmodels.GiftItem{
+		ID:      giftItemID,
+		Options: variant.Options{Size: []string{"M", "L"}, Color: []string{"Navy"}},
+	}
+
+	giftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{giftItem}, nil
+		},
+	}
+	mockRepo := &ReservationRepositoryInterfaceMock{
+		GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+			return nil, repository.ErrNoActiveReservation
+		},
+		CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+			return &reservation, nil
+		},
+	}
+	svc := NewReservationService(mockRepo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	reservation, err := svc.CreateReservation(context.Background(), CreateReservationInput{
+		WishListID: wishlistID.String(),
+		GiftItemID: giftItemID.String(),
+		GuestName:  &guestName,
+		Variant:    variant.Choice{Size: "l", Color: "navy"},
+	})
+	require.NoError(t, err)
+	// Stored as the owner spelled the options
+	assert.Equal(t, variant.Choice{Size: "L", Color: "Navy"}, reservation.Variant)
+
+	_, err = svc.CreateReservation(context.Background(), CreateReservationInput{
+		WishListID: wishlistID.String(),
+		GiftItemID: giftItemID.String(),
+		GuestName:  &guestName,
+		Variant:    variant.Choice{Size: "XL", Color: "Navy"},
+	})
+	require.ErrorIs(t, err, ErrInvalidVariant)
+	assert.Len(t, mockRepo.CreateCalls(), 1)
+}
+
+func stringPtr(s string) *string { return &s }
+
+func TestReservationService_CreateReservation_Message(t *testing.T) {
+	giftItemID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	wishlistID := pgtype.UUID{Bytes: [16]byte{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, Valid: true}
+	guestName := "Aunt Maria"
+
+	giftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wlID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{{ID: giftItemID}}, nil
+		},
+	}
+	mockRepo := &ReservationRepositoryInterfaceMock{
+		GetActiveReservationForGiftItemFunc: func(ctx context.Context, id pgtype.UUID) (*models.Reservation, error) {
+			return nil, repository.ErrNoActiveReservation
+		},
+		CreateFunc: func(ctx context.Context, reservation models.Reservation) (*models.Reservation, error) {
+			return &reservation, nil
+		},
+	}
+	svc := NewReservationService(mockRepo, giftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		message *string
+		want    *string
+	}{
+		{name: "message is trimmed", message: stringPtr("  Will bring it Saturday \n"), want: stringPtr("Will bring it Saturday")},
+		{name: "blank message is dropped", message: stringPtr("   "), want: nil},
+		{name: "no message", message: nil, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reservation, err := svc.CreateReservation(context.Background(), CreateReservationInput{
+				WishListID: wishlistID.String(),
+				GiftItemID: giftItemID.String(),
+				GuestName:  &guestName,
+				Message:    tt.message,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, reservation.Message)
+		})
+	}
+}
+
 // Test CancelReservation function
 func TestReservationService_CancelReservation(t *testing.T) {
 	t.Run("successful cancellation by guest with token", func(t *testing.T) {
@@ -416,6 +933,7 @@ func TestReservationService_CancelReservation(t *testing.T) {
 		giftItem := &itemmodels.GiftItem{ID: giftItemID}
 		canceledReservation := &models.Reservation{
 			ID:               pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true},
+			GiftItemID:       giftItemID,
 			ReservationToken: token,
 			Status:           "canceled",
 		}
@@ -430,8 +948,13 @@ func TestReservationService_CancelReservation(t *testing.T) {
 				return canceledReservation, nil
 			},
 		}
+		itemEvents := &ItemEventPublisherInterfaceMock{
+			PublishItemEventFunc: func(ctx context.Context, event string, id pgtype.UUID) error {
+				return errors.New("outbox unavailable")
+			},
+		}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, itemEvents, nil, nil, nil)
 
 		input := CancelReservationInput{
 			WishListID:       wishlistID.String(),
@@ -446,6 +969,10 @@ func TestReservationService_CancelReservation(t *testing.T) {
 		assert.NotNil(t, reservation)
 		assert.Equal(t, "canceled", reservation.Status)
 		assert.Len(t, mockRepo.UpdateStatusByTokenCalls(), 1)
+		// A failed announcement does not undo the cancellation
+		require.Len(t, itemEvents.PublishItemEventCalls(), 1)
+		assert.Equal(t, partnermodels.EventItemReleased, itemEvents.PublishItemEventCalls()[0].Event)
+		assert.Equal(t, giftItemID, itemEvents.PublishItemEventCalls()[0].GiftItemID)
 	})
 
 	t.Run("cancellation requires user ID or token", func(t *testing.T) {
@@ -461,7 +988,7 @@ func TestReservationService_CancelReservation(t *testing.T) {
 		}
 		mockRepo := &ReservationRepositoryInterfaceMock{}
 
-		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{})
+		service := NewReservationService(mockRepo, mockGiftItemRepo, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		input := CancelReservationInput{
 			WishListID:       wishlistID.String(),
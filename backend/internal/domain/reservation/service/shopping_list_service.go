@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/pdf"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// shoppingListThumbnailSize is the longest side of item pictures, in pixels
+	shoppingListThumbnailSize = 160
+
+	// shoppingListDownloads caps the pictures downloaded at the same time
+	shoppingListDownloads = 4
+)
+
+// ThumbnailFetcherInterface downloads item pictures for printed documents
+type ThumbnailFetcherInterface interface {
+	Thumbnail(ctx context.Context, link string, maxSize int) (*pdf.Image, error)
+}
+
+// ShoppingListServiceInterface defines the interface for printable reservation lists
+type ShoppingListServiceInterface interface {
+	ExportGuestShoppingList(ctx context.Context, token pgtype.UUID) ([]byte, error)
+}
+
+// ShoppingListService prints what a giver still has to buy, for shopping offline
+type ShoppingListService struct {
+	repo       repository.ReservationRepositoryInterface
+	thumbnails ThumbnailFetcherInterface
+}
+
+// NewShoppingListService creates a new ShoppingListService. thumbnails may be nil
+// to print the lists without pictures.
+func NewShoppingListService(repo repository.ReservationRepositoryInterface, thumbnails ThumbnailFetcherInterface) *ShoppingListService {
+	return &ShoppingListService{
+		repo:       repo,
+		thumbnails: thumbnails,
+	}
+}
+
+// ExportGuestShoppingList renders the active reservations a guest manages with
+// their reservation token as a PDF, grouped by wish list. Pictures that cannot be
+// downloaded are left out rather than failing the export.
+func (s *ShoppingListService) ExportGuestShoppingList(ctx context.Context, token pgtype.UUID) ([]byte, error) {
+	details, err := s.repo.ListGuestReservationsWithDetails(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guest reservations: %w", err)
+	}
+
+	// Group by wish list, in the order the lists first appear
+	var order []pgtype.UUID
+	groups := make(map[pgtype.UUID][]repository.ReservationDetail)
+	for _, detail := range details {
+		if detail.Status != "active" {
+			continue
+		}
+		if _, ok := groups[detail.WishlistID]; !ok {
+			order = append(order, detail.WishlistID)
+		}
+		groups[detail.WishlistID] = append(groups[detail.WishlistID], detail)
+	}
+	if len(order) == 0 {
+		return nil, ErrReservationNotFound
+	}
+
+	var items []repository.ReservationDetail
+	for _, wishlistID := range order {
+		items = append(items, groups[wishlistID]...)
+	}
+	thumbnails := s.downloadThumbnails(ctx, items)
+
+	doc := pdf.New("Shopping list")
+	doc.Add(pdf.Block{Lines: []pdf.Line{{Text: "Shopping list", Size: 20, Bold: true}}})
+	for i, item := range items {
+		if i == 0 || item.WishlistID != items[i-1].WishlistID {
+			doc.Add(pdf.Block{Lines: wishlistLines(item)})
+		}
+		doc.Add(pdf.Block{
+			Image: thumbnails[i],
+			Lines: itemLines(item),
+		})
+	}
+
+	return doc.Bytes(), nil
+}
+
+// downloadThumbnails downloads the items' pictures a few at a time. The result
+// holds nil for items without a picture that could be downloaded.
+func (s *ShoppingListService) downloadThumbnails(ctx context.Context, items []repository.ReservationDetail) []*pdf.Image {
+	thumbnails := make([]*pdf.Image, len(items))
+	slots := make(chan struct{}, shoppingListDownloads)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			thumbnails[i] = s.thumbnail(ctx, item)
+		}()
+	}
+	wg.Wait()
+	return thumbnails
+}
+
+// thumbnail downloads the item's picture, or returns nil
+func (s *ShoppingListService) thumbnail(ctx context.Context, item repository.ReservationDetail) *pdf.Image {
+	if s.thumbnails == nil || item.GiftItemImageURL.String == "" {
+		return nil
+	}
+	img, err := s.thumbnails.Thumbnail(ctx, item.GiftItemImageURL.String, shoppingListThumbnailSize)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to download gift item picture for shopping list",
+			"gift_item_id", item.GiftItemID.String(), "error", err)
+		return nil
+	}
+	return img
+}
+
+// wishlistLines heads the items of a wish list with its title, owner and occasion date
+func wishlistLines(item repository.ReservationDetail) []pdf.Line {
+	title := item.WishlistTitle.String
+	if title == "" {
+		title = "Wish list"
+	}
+	lines := []pdf.Line{{Text: title, Size: 14, Bold: true}}
+
+	var details []string
+	if owner := strings.TrimSpace(item.OwnerFirstName.String + " " + item.OwnerLastName.String); owner != "" {
+		details = append(details, "For "+owner)
+	}
+	if item.OccasionDate.Valid {
+		details = append(details, "Occasion on "+item.OccasionDate.Time.Format("January 2, 2006"))
+	}
+	if len(details) > 0 {
+		lines = append(lines, pdf.Line{Text: strings.Join(details, " · ")})
+	}
+	return lines
+}
+
+// itemLines describes a reserved item: name, what to buy, price and shop link
+func itemLines(item repository.ReservationDetail) []pdf.Line {
+	lines := []pdf.Line{{Text: item.GiftItemName.String, Bold: true}}
+
+	var details []string
+	if item.Quantity > 1 {
+		details = append(details, fmt.Sprintf("Quantity: %d", item.Quantity))
+	}
+	for _, option := range []struct{ name, value string }{
+		{"Size", item.Variant.Size},
+		{"Color", item.Variant.Color},
+		{"Model", item.Variant.Model},
+	} {
+		if option.value != "" {
+			details = append(details, option.name+": "+option.value)
+		}
+	}
+	if item.GiftItemPrice.Valid {
+		details = append(details, fmt.Sprintf("Price: %.2f", database.NumericToFloat64(item.GiftItemPrice)))
+	}
+	if len(details) > 0 {
+		lines = append(lines, pdf.Line{Text: strings.Join(details, " · ")})
+	}
+
+	if item.GiftItemLink.String != "" {
+		lines = append(lines, pdf.Line{Text: item.GiftItemLink.String, URL: item.GiftItemLink.String, Size: 9})
+	}
+	return lines
+}
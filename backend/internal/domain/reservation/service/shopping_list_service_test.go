@@ -0,0 +1,100 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/reservation/repository"
+	"wish-list/internal/pkg/pdf"
+	"wish-list/internal/pkg/variant"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShoppingListService_ExportGuestShoppingList(t *testing.T) {
+	token := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	birthday := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	wedding := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	details := []repository.ReservationDetail{
+		{
+			Status:           "active",
+			GiftItemName:     pgtype.Text{String: "Espresso machine", Valid: true},
+			GiftItemImageURL: pgtype.Text{String: "https://cdn.example/espresso.png", Valid: true},
+			GiftItemPrice:    pgtype.Numeric{Int: big.NewInt(19999), Exp: -2, Valid: true},
+			GiftItemLink:     pgtype.Text{String: "https://shop.example/espresso", Valid: true},
+			Quantity:         2,
+			Variant:          variant.Choice{Color: "Red"},
+			WishlistID:       birthday,
+			WishlistTitle:    pgtype.Text{String: "Birthday", Valid: true},
+			OwnerFirstName:   pgtype.Text{String: "Anna", Valid: true},
+			OccasionDate:     pgtype.Date{Time: time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		},
+		{
+			Status:        "canceled",
+			GiftItemName:  pgtype.Text{String: "Toaster", Valid: true},
+			WishlistID:    wedding,
+			WishlistTitle: pgtype.Text{String: "Wedding", Valid: true},
+		},
+		{
+			Status:           "active",
+			GiftItemName:     pgtype.Text{String: "Cookbook", Valid: true},
+			GiftItemImageURL: pgtype.Text{String: "https://cdn.example/broken.png", Valid: true},
+			WishlistID:       birthday,
+			WishlistTitle:    pgtype.Text{String: "Birthday", Valid: true},
+		},
+	}
+	repo := &ReservationRepositoryInterfaceMock{
+		ListGuestReservationsWithDetailsFunc: func(ctx context.Context, tok pgtype.UUID) ([]repository.ReservationDetail, error) {
+			assert.Equal(t, token, tok)
+			return details, nil
+		},
+	}
+
+	var picture bytes.Buffer
+	require.NoError(t, png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 8, 8))))
+	thumb, err := pdf.NewThumbnail(picture.Bytes(), 8)
+	require.NoError(t, err)
+	thumbnails := &ThumbnailFetcherInterfaceMock{
+		ThumbnailFunc: func(ctx context.Context, link string, maxSize int) (*pdf.Image, error) {
+			if strings.Contains(link, "broken") {
+				return nil, errors.New("status 404")
+			}
+			return thumb, nil
+		},
+	}
+
+	data, err := NewShoppingListService(repo, thumbnails).ExportGuestShoppingList(context.Background(), token)
+	require.NoError(t, err)
+
+	out := string(data)
+	assert.True(t, strings.HasPrefix(out, "%PDF-"))
+	assert.Contains(t, out, "(Birthday)")
+	assert.Contains(t, out, `(For Anna \267 Occasion on December 1, 2026)`)
+	assert.Contains(t, out, "(Espresso machine)")
+	assert.Contains(t, out, `(Quantity: 2 \267 Color: Red \267 Price: 199.99)`)
+	assert.Contains(t, out, "/URI (https://shop.example/espresso)")
+	assert.Contains(t, out, "(Cookbook)")
+	assert.NotContains(t, out, "(Wedding)", "only active reservations are listed")
+	assert.Equal(t, 1, strings.Count(out, "/Subtype /Image"), "a picture that cannot be downloaded is left out")
+	assert.Len(t, thumbnails.ThumbnailCalls(), 2)
+}
+
+func TestShoppingListService_ExportGuestShoppingList_NothingReserved(t *testing.T) {
+	repo := &ReservationRepositoryInterfaceMock{
+		ListGuestReservationsWithDetailsFunc: func(ctx context.Context, token pgtype.UUID) ([]repository.ReservationDetail, error) {
+			return []repository.ReservationDetail{{Status: "canceled"}}, nil
+		},
+	}
+
+	_, err := NewShoppingListService(repo, nil).ExportGuestShoppingList(context.Background(), pgtype.UUID{Valid: true})
+	assert.ErrorIs(t, err, ErrReservationNotFound)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	userrepository "wish-list/internal/domain/user/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidReservationID      = errors.New("invalid reservation id")
+	ErrReservationNotActive      = errors.New("only active reservations can change hands")
+	ErrReservationAlreadyClaimed = errors.New("reservation already belongs to an account")
+	ErrTransferRecipientNotFound = errors.New("no account found for the recipient")
+	ErrTransferToSelf            = errors.New("reservation already belongs to the recipient")
+	ErrTransferToOwner           = errors.New("reservations cannot be handed to the wishlist owner")
+)
+
+type TransferReservationInput struct {
+	ReservationID  string
+	FromUserID     pgtype.UUID
+	RecipientEmail string
+}
+
+// ClaimGuestReservation moves a guest reservation to the user's account. The
+// guest proves it is theirs with the management token issued when they reserved,
+// so it works even when they registered with a different email.
+func (s *ReservationService) ClaimGuestReservation(ctx context.Context, userID, token pgtype.UUID) (*ReservationOutput, error) {
+	claimed, err := s.repo.ClaimByToken(ctx, token, userID)
+	if err != nil {
+		return nil, mapTransferError(err, "failed to claim reservation")
+	}
+	s.recordEvent(ctx, claimed, models.ReservationEventClaimed)
+
+	logger.InfoContext(ctx, "guest reservation claimed",
+		"audit", true,
+		"reservation_id", claimed.ID.String(),
+		"user_id", userID.String())
+
+	return s.mapToOutput(claimed), nil
+}
+
+// TransferReservation hands one of the user's active reservations to another
+// registered user, e.g. when a friend offers to buy the gift instead
+func (s *ReservationService) TransferReservation(ctx context.Context, input TransferReservationInput) (*ReservationOutput, error) {
+	reservationID := pgtype.UUID{}
+	if err := reservationID.Scan(input.ReservationID); err != nil {
+		return nil, ErrInvalidReservationID
+	}
+
+	recipient, err := s.userRepo.GetByEmail(ctx, strings.TrimSpace(input.RecipientEmail))
+	if err != nil {
+		if errors.Is(err, userrepository.ErrUserNotFound) {
+			return nil, ErrTransferRecipientNotFound
+		}
+		return nil, fmt.Errorf("failed to find recipient: %w", err)
+	}
+	if recipient.DeactivatedAt.Valid {
+		return nil, ErrTransferRecipientNotFound
+	}
+	if recipient.ID == input.FromUserID {
+		return nil, ErrTransferToSelf
+	}
+
+	transferred, err := s.repo.TransferToUser(ctx, reservationID, input.FromUserID, recipient.ID)
+	if err != nil {
+		return nil, mapTransferError(err, "failed to transfer reservation")
+	}
+	s.recordEvent(ctx, transferred, models.ReservationEventTransferred)
+
+	logger.InfoContext(ctx, "reservation transferred",
+		"audit", true,
+		"reservation_id", transferred.ID.String(),
+		"from_user_id", input.FromUserID.String(),
+		"to_user_id", recipient.ID.String())
+
+	return s.mapToOutput(transferred), nil
+}
+
+// mapTransferError translates repository errors from a change of hands into service errors
+func mapTransferError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrReservationNotFound):
+		return ErrReservationNotFound
+	case errors.Is(err, repository.ErrReservationNotActive):
+		return ErrReservationNotActive
+	case errors.Is(err, repository.ErrReservationClaimed):
+		return ErrReservationAlreadyClaimed
+	case errors.Is(err, repository.ErrRecipientOwnsWishlist):
+		return ErrTransferToOwner
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"wish-list/internal/domain/reservation/models"
+	"wish-list/internal/domain/reservation/repository"
+	usermodels "wish-list/internal/domain/user/models"
+	userrepository "wish-list/internal/domain/user/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationService_ClaimGuestReservation(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	token := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+
+	t.Run("moves the reservation to the account", func(t *testing.T) {
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			ClaimByTokenFunc: func(ctx context.Context, gotToken, gotUserID pgtype.UUID) (*models.Reservation, error) {
+				assert.Equal(t, token, gotToken)
+				assert.Equal(t, userID, gotUserID)
+				return &models.Reservation{
+					ID:               pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+					ReservedByUserID: userID,
+					ReservationToken: token,
+					Status:           "active",
+				}, nil
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		out, err := svc.ClaimGuestReservation(context.Background(), userID, token)
+		require.NoError(t, err)
+		assert.Equal(t, userID, out.ReservedByUserID)
+	})
+
+	t.Run("maps repository errors", func(t *testing.T) {
+		cases := map[error]error{
+			repository.ErrReservationNotFound:   ErrReservationNotFound,
+			repository.ErrReservationNotActive:  ErrReservationNotActive,
+			repository.ErrReservationClaimed:    ErrReservationAlreadyClaimed,
+			repository.ErrRecipientOwnsWishlist: ErrTransferToOwner,
+		}
+		for repoErr, want := range cases {
+			mockRepo := &ReservationRepositoryInterfaceMock{
+				ClaimByTokenFunc: func(ctx context.Context, token, userID pgtype.UUID) (*models.Reservation, error) {
+					return nil, repoErr
+				},
+			}
+			svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := svc.ClaimGuestReservation(context.Background(), userID, token)
+			assert.ErrorIs(t, err, want)
+		}
+	})
+}
+
+func TestReservationService_TransferReservation(t *testing.T) {
+	fromUserID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	recipientID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	reservationID := "00000000-0000-0000-0000-000000000003"
+
+	recipientRepo := func(user *usermodels.User, err error) *UserRepositoryInterfaceMock {
+		return &UserRepositoryInterfaceMock{
+			GetByEmailFunc: func(ctx context.Context, email string) (*usermodels.User, error) {
+				assert.Equal(t, "friend@example.com", email)
+				return user, err
+			},
+		}
+	}
+
+	t.Run("hands the reservation to the recipient", func(t *testing.T) {
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			TransferToUserFunc: func(ctx context.Context, id, from, to pgtype.UUID) (*models.Reservation, error) {
+				assert.Equal(t, reservationID, id.String())
+				assert.Equal(t, fromUserID, from)
+				assert.Equal(t, recipientID, to)
+				return &models.Reservation{ID: id, ReservedByUserID: to, Status: "active"}, nil
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, recipientRepo(&usermodels.User{ID: recipientID}, nil), nil, nil, nil, nil, nil, nil)
+
+		out, err := svc.TransferReservation(context.Background(), TransferReservationInput{
+			ReservationID:  reservationID,
+			FromUserID:     fromUserID,
+			RecipientEmail: " friend@example.com ",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, recipientID, out.ReservedByUserID)
+	})
+
+	t.Run("invalid reservation id", func(t *testing.T) {
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, &UserRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.TransferReservation(context.Background(), TransferReservationInput{ReservationID: "nope", FromUserID: fromUserID, RecipientEmail: "friend@example.com"})
+		assert.ErrorIs(t, err, ErrInvalidReservationID)
+	})
+
+	t.Run("unknown recipient", func(t *testing.T) {
+		mockRepo := &ReservationRepositoryInterfaceMock{}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, recipientRepo(nil, userrepository.ErrUserNotFound), nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.TransferReservation(context.Background(), TransferReservationInput{ReservationID: reservationID, FromUserID: fromUserID, RecipientEmail: "friend@example.com"})
+		assert.ErrorIs(t, err, ErrTransferRecipientNotFound)
+		assert.Empty(t, mockRepo.TransferToUserCalls())
+	})
+
+	t.Run("deactivated recipient", func(t *testing.T) {
+		recipient := &usermodels.User{ID: recipientID, DeactivatedAt: pgtype.Timestamptz{Valid: true}}
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, recipientRepo(recipient, nil), nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.TransferReservation(context.Background(), TransferReservationInput{ReservationID: reservationID, FromUserID: fromUserID, RecipientEmail: "friend@example.com"})
+		assert.ErrorIs(t, err, ErrTransferRecipientNotFound)
+	})
+
+	t.Run("recipient is the current holder", func(t *testing.T) {
+		svc := NewReservationService(&ReservationRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, recipientRepo(&usermodels.User{ID: fromUserID}, nil), nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.TransferReservation(context.Background(), TransferReservationInput{ReservationID: reservationID, FromUserID: fromUserID, RecipientEmail: "friend@example.com"})
+		assert.ErrorIs(t, err, ErrTransferToSelf)
+	})
+
+	t.Run("recipient owns the wishlist", func(t *testing.T) {
+		mockRepo := &ReservationRepositoryInterfaceMock{
+			TransferToUserFunc: func(ctx context.Context, id, from, to pgtype.UUID) (*models.Reservation, error) {
+				return nil, repository.ErrRecipientOwnsWishlist
+			},
+		}
+		svc := NewReservationService(mockRepo, &GiftItemRepositoryInterfaceMock{}, &mockGiftItemReservationRepo{}, nil, recipientRepo(&usermodels.User{ID: recipientID}, nil), nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.TransferReservation(context.Background(), TransferReservationInput{ReservationID: reservationID, FromUserID: fromUserID, RecipientEmail: "friend@example.com"})
+		assert.ErrorIs(t, err, ErrTransferToOwner)
+	})
+}
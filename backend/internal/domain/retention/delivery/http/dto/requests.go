@@ -0,0 +1,6 @@
+package dto
+
+// UpdatePolicyRequest sets the retention period of a data class
+type UpdatePolicyRequest struct {
+	Months *int `json:"months" validate:"required,min=0,max=120" example:"12"` // 0 keeps the data
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/retention/service"
+)
+
+// PolicyResponse is the retention period in force for a data class
+type PolicyResponse struct {
+	DataClass string  `json:"data_class" validate:"required" example:"guest_pii" enums:"guest_pii,audit_log,analytics"`
+	Months    int     `json:"months" validate:"required" example:"12"` // 0 keeps the data
+	Source    string  `json:"source" validate:"required" example:"config" enums:"config,admin"`
+	UpdatedAt *string `json:"updated_at"` // Set when an admin changed the period
+}
+
+type PolicyListResponse struct {
+	Data []PolicyResponse `json:"data" validate:"required"`
+}
+
+// TargetResponse counts the expired records of one table
+type TargetResponse struct {
+	Table   string `json:"table" validate:"required" example:"reservations"`
+	Action  string `json:"action" validate:"required" example:"anonymize" enums:"anonymize,delete"`
+	Records int64  `json:"records" validate:"required" example:"42"`
+}
+
+// ClassReportResponse is what a data class's policy would change now
+type ClassReportResponse struct {
+	Policy  PolicyResponse   `json:"policy" validate:"required"`
+	Cutoff  *string          `json:"cutoff"` // Records from before it are expired; null when the data is kept
+	Targets []TargetResponse `json:"targets" validate:"required"`
+}
+
+// ReportResponse is a dry run of the retention job
+type ReportResponse struct {
+	GeneratedAt string                `json:"generated_at" validate:"required"`
+	Classes     []ClassReportResponse `json:"classes" validate:"required"`
+}
+
+func FromPolicyOutput(p *service.PolicyOutput) PolicyResponse {
+	resp := PolicyResponse{
+		DataClass: p.DataClass,
+		Months:    p.Months,
+		Source:    p.Source,
+	}
+	if !p.UpdatedAt.IsZero() {
+		updatedAt := p.UpdatedAt.Format(time.RFC3339)
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
+
+func FromPolicyOutputs(outputs []*service.PolicyOutput) PolicyListResponse {
+	resp := PolicyListResponse{Data: make([]PolicyResponse, len(outputs))}
+	for i, output := range outputs {
+		resp.Data[i] = FromPolicyOutput(output)
+	}
+	return resp
+}
+
+func FromReportOutput(r *service.ReportOutput) ReportResponse {
+	resp := ReportResponse{
+		GeneratedAt: r.GeneratedAt.Format(time.RFC3339),
+		Classes:     make([]ClassReportResponse, len(r.Classes)),
+	}
+	for i, class := range r.Classes {
+		classResp := ClassReportResponse{
+			Policy:  FromPolicyOutput(&class.Policy),
+			Targets: make([]TargetResponse, len(class.Targets)),
+		}
+		if !class.Cutoff.IsZero() {
+			cutoff := class.Cutoff.Format(time.RFC3339)
+			classResp.Cutoff = &cutoff
+		}
+		for j, target := range class.Targets {
+			classResp.Targets[j] = TargetResponse{Table: target.Table, Action: target.Action, Records: target.Records}
+		}
+		resp.Classes[i] = classResp
+	}
+	return resp
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+
+	"wish-list/internal/domain/retention/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapRetentionServiceError converts retention service errors to AppErrors
+func mapRetentionServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnknownDataClass):
+		return apperrors.NotFound("Data class must be guest_pii, audit_log or analytics")
+	case errors.Is(err, service.ErrInvalidRetention):
+		return apperrors.BadRequest(fmt.Sprintf("Months must be between 0 and %d", service.MaxRetentionMonths))
+	default:
+		return apperrors.Internal("Retention request failed").Wrap(err)
+	}
+}
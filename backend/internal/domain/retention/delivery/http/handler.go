@@ -0,0 +1,103 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/retention/delivery/http/dto"
+	"wish-list/internal/domain/retention/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for data retention
+type Handler struct {
+	service service.RetentionServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.RetentionServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// ListPolicies godoc
+//
+//	@Summary		List retention policies
+//	@Description	The retention period in force for each data class: guest_pii (guest names, emails and messages, counted from the wishlist's occasion), audit_log (reservation events) and analytics (views, link clicks and item stats). Periods come from the RETENTION_*_MONTHS settings unless an admin set them.
+//	@Tags			Retention
+//	@Produce		json
+//	@Success		200	{object}	dto.PolicyListResponse	"Retention policies"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Insufficient permissions"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/retention/policies [get]
+func (h *Handler) ListPolicies(c echo.Context) error {
+	policies, err := h.service.ListPolicies(c.Request().Context())
+	if err != nil {
+		return mapRetentionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPolicyOutputs(policies))
+}
+
+// UpdatePolicy godoc
+//
+//	@Summary		Set a retention policy
+//	@Description	Override the configured retention period of a data class. 0 keeps the data. The change applies from the next daily run; check its effect with the report first.
+//	@Tags			Retention
+//	@Accept			json
+//	@Produce		json
+//	@Param			class	path		string						true	"Data class"	Enums(guest_pii, audit_log, analytics)
+//	@Param			policy	body		dto.UpdatePolicyRequest		true	"Retention period"
+//	@Success		200		{object}	dto.PolicyResponse			"Policy saved"
+//	@Failure		400		{object}	map[string]string			"Invalid months"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Insufficient permissions"
+//	@Failure		404		{object}	map[string]string			"Unknown data class"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/retention/policies/{class} [put]
+func (h *Handler) UpdatePolicy(c echo.Context) error {
+	adminID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdatePolicyRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	policy, err := h.service.UpdatePolicy(c.Request().Context(), adminID, c.Param("class"), *req.Months)
+	if err != nil {
+		return mapRetentionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromPolicyOutput(policy))
+}
+
+// GetReport godoc
+//
+//	@Summary		Dry-run the retention policies
+//	@Description	Count, per data class and table, the records the retention job would anonymize or delete if it ran now. Nothing is changed.
+//	@Tags			Retention
+//	@Produce		json
+//	@Success		200	{object}	dto.ReportResponse	"Retention report"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Insufficient permissions"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/retention/report [get]
+func (h *Handler) GetReport(c echo.Context) error {
+	report, err := h.service.Report(c.Request().Context())
+	if err != nil {
+		return mapRetentionServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromReportOutput(report))
+}
@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/pkg/auth"
+)
+
+// RegisterRoutes registers data retention routes for admins
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	admin := e.Group("/api/admin/retention", authMiddleware, auth.RequireUserType("admin"))
+	admin.GET("/policies", h.ListPolicies)
+	admin.PUT("/policies/:class", h.UpdatePolicy)
+	admin.GET("/report", h.GetReport)
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Data classes retention periods are set for
+const (
+	DataClassGuestPII  = "guest_pii" // Guest names, emails and messages, counted from the wishlist's occasion
+	DataClassAuditLog  = "audit_log" // Reservation events
+	DataClassAnalytics = "analytics" // Page views, link clicks and item stats
+)
+
+// DataClasses lists every data class in report order
+var DataClasses = []string{DataClassGuestPII, DataClassAuditLog, DataClassAnalytics}
+
+// Retention actions taken on expired records
+const (
+	ActionAnonymize = "anonymize" // The personal fields are cleared, the record is kept
+	ActionDelete    = "delete"
+)
+
+// Policy is a retention period an admin set for a data class
+type Policy struct {
+	DataClass       string             `db:"data_class"`
+	Months          int                `db:"months"` // 0 keeps the data
+	UpdatedByUserID pgtype.UUID        `db:"updated_by_user_id"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
+}
+
+// TargetCount is how many records of a table are past their retention period,
+// or were anonymized or deleted for it
+type TargetCount struct {
+	Table   string
+	Action  string
+	Records int64
+}
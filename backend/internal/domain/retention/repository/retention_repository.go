@@ -0,0 +1,245 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_retention_repository_test.go -pkg service . RetentionRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/retention/models"
+	"wish-list/internal/pkg/logger"
+)
+
+// ErrUnknownDataClass is returned for a data class without retention targets
+var ErrUnknownDataClass = errors.New("unknown data class")
+
+// retentionTarget is a table holding data of a class. Records matching where are
+// past the retention period: they are anonymized with set, or deleted when set
+// is empty.
+type retentionTarget struct {
+	table  string
+	action string
+	where  string // Condition on t; $1 is the cutoff
+	set    string
+}
+
+// guestOccasionPassed selects guest data on wishlists whose occasion was before the
+// cutoff; the record's last change stands in for lists without an occasion date
+const guestOccasionPassed = `EXISTS (
+	SELECT 1 FROM wishlists w
+	WHERE w.id = t.wishlist_id AND COALESCE(w.occasion_date::timestamptz, t.updated_at) < $1
+)`
+
+// itemOccasionPassed selects items whose latest occasion among the wishlists they
+// are on was before the cutoff; the item's last change stands in when none has a date
+const itemOccasionPassed = `COALESCE((
+	SELECT MAX(w.occasion_date) FROM wishlist_items wi
+	JOIN wishlists w ON w.id = wi.wishlist_id
+	WHERE wi.gift_item_id = t.id
+)::timestamptz, t.updated_at) < $1`
+
+// anonymousGuestName replaces expired guest names in columns that cannot be NULL;
+// it matches the label gift history shows for givers without a name
+const anonymousGuestName = `'Anonymous'`
+
+// retentionTargets lists the tables each data class is kept in. Guest reservations
+// are anonymized rather than deleted so owners still see which items were taken.
+// Gifts kept from deleted wishlists have no wishlist to take the occasion from, so
+// they are matched on their own snapshot of it. Emails that failed to send hold
+// the guest's address and name too; they are kept no longer than the guest data.
+var retentionTargets = map[string][]retentionTarget{
+	models.DataClassGuestPII: {
+		{
+			table:  "reservations",
+			action: models.ActionAnonymize,
+			where: `t.reserved_by_user_id IS NULL
+				AND (t.guest_name IS NOT NULL OR t.encrypted_guest_name IS NOT NULL
+					OR t.guest_email IS NOT NULL OR t.encrypted_guest_email IS NOT NULL
+					OR t.message IS NOT NULL OR t.encrypted_message IS NOT NULL)
+				AND ` + guestOccasionPassed,
+			set: `guest_name = NULL, encrypted_guest_name = NULL,
+				guest_email = NULL, encrypted_guest_email = NULL,
+				message = NULL, encrypted_message = NULL,
+				updated_at = NOW()`,
+		},
+		{
+			// Only a guest giver leaves an email; the name of a registered purchaser is theirs
+			table:  "gift_history",
+			action: models.ActionAnonymize,
+			where: `(t.giver_user_id IS NULL OR t.giver_email IS NOT NULL OR t.encrypted_giver_email IS NOT NULL)
+				AND ((t.giver_user_id IS NULL AND (t.giver_name IS NOT NULL OR t.encrypted_giver_name IS NOT NULL))
+					OR t.giver_email IS NOT NULL OR t.encrypted_giver_email IS NOT NULL
+					OR t.giver_message IS NOT NULL OR t.encrypted_giver_message IS NOT NULL)
+				AND COALESCE(t.occasion_date::timestamptz, t.given_at) < $1`,
+			set: `giver_name = CASE WHEN giver_user_id IS NULL THEN NULL ELSE giver_name END,
+				encrypted_giver_name = CASE WHEN giver_user_id IS NULL THEN NULL ELSE encrypted_giver_name END,
+				giver_email = NULL, encrypted_giver_email = NULL,
+				giver_message = NULL, encrypted_giver_message = NULL`,
+		},
+		{
+			table:  "item_comments",
+			action: models.ActionAnonymize,
+			where:  `t.author_user_id IS NULL AND t.author_name <> ` + anonymousGuestName + ` AND ` + guestOccasionPassed,
+			set:    `author_name = ` + anonymousGuestName + `, updated_at = NOW()`,
+		},
+		{
+			table:  "item_suggestions",
+			action: models.ActionAnonymize,
+			where:  `t.suggested_by_user_id IS NULL AND t.suggester_name <> ` + anonymousGuestName + ` AND ` + guestOccasionPassed,
+			set:    `suggester_name = ` + anonymousGuestName + `, updated_at = NOW()`,
+		},
+		{
+			// Items the owner marked as reserved by someone outside the app stay reserved
+			table:  "gift_items",
+			action: models.ActionAnonymize,
+			where: `(t.manual_reserved_by_name IS NOT NULL OR t.encrypted_manual_reserved_by_name IS NOT NULL)
+				AND ` + itemOccasionPassed,
+			set: `manual_reserved_by_name = NULL, encrypted_manual_reserved_by_name = NULL,
+				version = version + 1, updated_at = NOW()`,
+		},
+		{table: "rsvps", action: models.ActionDelete, where: guestOccasionPassed},
+		{table: "wishlist_invites", action: models.ActionDelete, where: guestOccasionPassed},
+		{table: "failed_emails", action: models.ActionDelete, where: `t.created_at < $1`},
+	},
+	models.DataClassAuditLog: {
+		{table: "reservation_events", action: models.ActionDelete, where: `t.occurred_at < $1`},
+	},
+	models.DataClassAnalytics: {
+		{table: "item_link_clicks", action: models.ActionDelete, where: `t.clicked_at < $1`},
+		{table: "wishlist_daily_views", action: models.ActionDelete, where: `t.day < $1::date`},
+		{table: "gift_item_daily_stats", action: models.ActionDelete, where: `t.day < $1::date`},
+	},
+}
+
+// RetentionRepositoryInterface defines the interface for retention database operations
+type RetentionRepositoryInterface interface {
+	ListPolicies(ctx context.Context) ([]*models.Policy, error)
+	SavePolicy(ctx context.Context, policy models.Policy) (*models.Policy, error)
+	CountExpired(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error)
+	Enforce(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error)
+}
+
+type RetentionRepository struct {
+	db *database.DB
+}
+
+func NewRetentionRepository(db *database.DB) RetentionRepositoryInterface {
+	return &RetentionRepository{
+		db: db,
+	}
+}
+
+// ListPolicies returns the retention periods admins set, by data class
+func (r *RetentionRepository) ListPolicies(ctx context.Context) ([]*models.Policy, error) {
+	query := `
+		SELECT data_class, months, updated_by_user_id, updated_at
+		FROM retention_policies
+		ORDER BY data_class
+	`
+
+	var policies []*models.Policy
+	if err := r.db.SelectContext(ctx, &policies, query); err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// SavePolicy sets the retention period of a data class, replacing an earlier one
+func (r *RetentionRepository) SavePolicy(ctx context.Context, policy models.Policy) (*models.Policy, error) {
+	query := `
+		INSERT INTO retention_policies (data_class, months, updated_by_user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (data_class) DO UPDATE SET
+			months = EXCLUDED.months,
+			updated_by_user_id = EXCLUDED.updated_by_user_id,
+			updated_at = NOW()
+		RETURNING data_class, months, updated_by_user_id, updated_at
+	`
+
+	var saved models.Policy
+	if err := r.db.GetContext(ctx, &saved, query, policy.DataClass, policy.Months, policy.UpdatedByUserID); err != nil {
+		return nil, fmt.Errorf("failed to save retention policy: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// CountExpired counts the records of a data class that are past a retention period
+// ending at cutoff, per table, without changing them
+func (r *RetentionRepository) CountExpired(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+	targets, ok := retentionTargets[dataClass]
+	if !ok {
+		return nil, ErrUnknownDataClass
+	}
+
+	counts := make([]models.TargetCount, len(targets))
+	for i, target := range targets {
+		query := `SELECT COUNT(*) FROM ` + target.table + ` t WHERE ` + target.where
+
+		var records int64
+		if err := r.db.GetContext(ctx, &records, query, cutoff); err != nil {
+			return nil, fmt.Errorf("failed to count expired %s: %w", target.table, err)
+		}
+		counts[i] = models.TargetCount{Table: target.table, Action: target.action, Records: records}
+	}
+
+	return counts, nil
+}
+
+// Enforce anonymizes or deletes the records of a data class that are past a
+// retention period ending at cutoff, in one transaction, and returns how many
+// were changed per table
+func (r *RetentionRepository) Enforce(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+	targets, ok := retentionTargets[dataClass]
+	if !ok {
+		return nil, ErrUnknownDataClass
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackRetentionTx(ctx, tx)
+
+	// Lets the append-only reservation events be purged, for this transaction only
+	if _, err := tx.ExecContext(ctx, `SELECT set_config('app.retention_purge', 'on', true)`); err != nil {
+		return nil, fmt.Errorf("failed to allow retention purge: %w", err)
+	}
+
+	counts := make([]models.TargetCount, len(targets))
+	for i, target := range targets {
+		query := `DELETE FROM ` + target.table + ` t WHERE ` + target.where
+		if target.set != "" {
+			query = `UPDATE ` + target.table + ` t SET ` + target.set + ` WHERE ` + target.where
+		}
+
+		result, err := tx.ExecContext(ctx, query, cutoff)
+		if err != nil {
+			return nil, fmt.Errorf("failed to %s expired %s: %w", target.action, target.table, err)
+		}
+		records, err := result.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		counts[i] = models.TargetCount{Table: target.table, Action: target.action, Records: records}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit retention: %w", err)
+	}
+
+	return counts, nil
+}
+
+func rollbackRetentionTx(ctx context.Context, tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		logger.WarnContext(ctx, "transaction rollback error", "error", err)
+	}
+}
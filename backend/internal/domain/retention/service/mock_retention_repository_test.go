@@ -0,0 +1,240 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+	"wish-list/internal/domain/retention/models"
+	"wish-list/internal/domain/retention/repository"
+)
+
+// Ensure, that RetentionRepositoryInterfaceMock does implement repository.RetentionRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.RetentionRepositoryInterface = &RetentionRepositoryInterfaceMock{}
+
+// RetentionRepositoryInterfaceMock is a mock implementation of repository.RetentionRepositoryInterface.
+//
+//	func TestSomethingThatUsesRetentionRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.RetentionRepositoryInterface
+//		mockedRetentionRepositoryInterface := &RetentionRepositoryInterfaceMock{
+//			CountExpiredFunc: func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+//				panic("mock out the CountExpired method")
+//			},
+//			EnforceFunc: func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+//				panic("mock out the Enforce method")
+//			},
+//			ListPoliciesFunc: func(ctx context.Context) ([]*models.Policy, error) {
+//				panic("mock out the ListPolicies method")
+//			},
+//			SavePolicyFunc: func(ctx context.Context, policy models.Policy) (*models.Policy, error) {
+//				panic("mock out the SavePolicy method")
+//			},
+//		}
+//
+//		// use mockedRetentionRepositoryInterface in code that requires repository.RetentionRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type RetentionRepositoryInterfaceMock struct {
+	// CountExpiredFunc mocks the CountExpired method.
+	CountExpiredFunc func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error)
+
+	// EnforceFunc mocks the Enforce method.
+	EnforceFunc func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error)
+
+	// ListPoliciesFunc mocks the ListPolicies method.
+	ListPoliciesFunc func(ctx context.Context) ([]*models.Policy, error)
+
+	// SavePolicyFunc mocks the SavePolicy method.
+	SavePolicyFunc func(ctx context.Context, policy models.Policy) (*models.Policy, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountExpired holds details about calls to the CountExpired method.
+		CountExpired []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DataClass is the dataClass argument value.
+			DataClass string
+			// Cutoff is the cutoff argument value.
+			Cutoff time.Time
+		}
+		// Enforce holds details about calls to the Enforce method.
+		Enforce []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DataClass is the dataClass argument value.
+			DataClass string
+			// Cutoff is the cutoff argument value.
+			Cutoff time.Time
+		}
+		// ListPolicies holds details about calls to the ListPolicies method.
+		ListPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// SavePolicy holds details about calls to the SavePolicy method.
+		SavePolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Policy is the policy argument value.
+			Policy models.Policy
+		}
+	}
+	lockCountExpired sync.RWMutex
+	lockEnforce      sync.RWMutex
+	lockListPolicies sync.RWMutex
+	lockSavePolicy   sync.RWMutex
+}
+
+// CountExpired calls CountExpiredFunc.
+func (mock *RetentionRepositoryInterfaceMock) CountExpired(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+	if mock.CountExpiredFunc == nil {
+		panic("RetentionRepositoryInterfaceMock.CountExpiredFunc: method is nil but RetentionRepositoryInterface.CountExpired was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		DataClass string
+		Cutoff    time.Time
+	}{
+		Ctx:       ctx,
+		DataClass: dataClass,
+		Cutoff:    cutoff,
+	}
+	mock.lockCountExpired.Lock()
+	mock.calls.CountExpired = append(mock.calls.CountExpired, callInfo)
+	mock.lockCountExpired.Unlock()
+	return mock.CountExpiredFunc(ctx, dataClass, cutoff)
+}
+
+// CountExpiredCalls gets all the calls that were made to CountExpired.
+// Check the length with:
+//
+//	len(mockedRetentionRepositoryInterface.CountExpiredCalls())
+func (mock *RetentionRepositoryInterfaceMock) CountExpiredCalls() []struct {
+	Ctx       context.Context
+	DataClass string
+	Cutoff    time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		DataClass string
+		Cutoff    time.Time
+	}
+	mock.lockCountExpired.RLock()
+	calls = mock.calls.CountExpired
+	mock.lockCountExpired.RUnlock()
+	return calls
+}
+
+// Enforce calls EnforceFunc.
+func (mock *RetentionRepositoryInterfaceMock) Enforce(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+	if mock.EnforceFunc == nil {
+		panic("RetentionRepositoryInterfaceMock.EnforceFunc: method is nil but RetentionRepositoryInterface.Enforce was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		DataClass string
+		Cutoff    time.Time
+	}{
+		Ctx:       ctx,
+		DataClass: dataClass,
+		Cutoff:    cutoff,
+	}
+	mock.lockEnforce.Lock()
+	mock.calls.Enforce = append(mock.calls.Enforce, callInfo)
+	mock.lockEnforce.Unlock()
+	return mock.EnforceFunc(ctx, dataClass, cutoff)
+}
+
+// EnforceCalls gets all the calls that were made to Enforce.
+// Check the length with:
+//
+//	len(mockedRetentionRepositoryInterface.EnforceCalls())
+func (mock *RetentionRepositoryInterfaceMock) EnforceCalls() []struct {
+	Ctx       context.Context
+	DataClass string
+	Cutoff    time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		DataClass string
+		Cutoff    time.Time
+	}
+	mock.lockEnforce.RLock()
+	calls = mock.calls.Enforce
+	mock.lockEnforce.RUnlock()
+	return calls
+}
+
+// ListPolicies calls ListPoliciesFunc.
+func (mock *RetentionRepositoryInterfaceMock) ListPolicies(ctx context.Context) ([]*models.Policy, error) {
+	if mock.ListPoliciesFunc == nil {
+		panic("RetentionRepositoryInterfaceMock.ListPoliciesFunc: method is nil but RetentionRepositoryInterface.ListPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListPolicies.Lock()
+	mock.calls.ListPolicies = append(mock.calls.ListPolicies, callInfo)
+	mock.lockListPolicies.Unlock()
+	return mock.ListPoliciesFunc(ctx)
+}
+
+// ListPoliciesCalls gets all the calls that were made to ListPolicies.
+// Check the length with:
+//
+//	len(mockedRetentionRepositoryInterface.ListPoliciesCalls())
+func (mock *RetentionRepositoryInterfaceMock) ListPoliciesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListPolicies.RLock()
+	calls = mock.calls.ListPolicies
+	mock.lockListPolicies.RUnlock()
+	return calls
+}
+
+// SavePolicy calls SavePolicyFunc.
+func (mock *RetentionRepositoryInterfaceMock) SavePolicy(ctx context.Context, policy models.Policy) (*models.Policy, error) {
+	if mock.SavePolicyFunc == nil {
+		panic("RetentionRepositoryInterfaceMock.SavePolicyFunc: method is nil but RetentionRepositoryInterface.SavePolicy was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Policy models.Policy
+	}{
+		Ctx:    ctx,
+		Policy: policy,
+	}
+	mock.lockSavePolicy.Lock()
+	mock.calls.SavePolicy = append(mock.calls.SavePolicy, callInfo)
+	mock.lockSavePolicy.Unlock()
+	return mock.SavePolicyFunc(ctx, policy)
+}
+
+// SavePolicyCalls gets all the calls that were made to SavePolicy.
+// Check the length with:
+//
+//	len(mockedRetentionRepositoryInterface.SavePolicyCalls())
+func (mock *RetentionRepositoryInterfaceMock) SavePolicyCalls() []struct {
+	Ctx    context.Context
+	Policy models.Policy
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Policy models.Policy
+	}
+	mock.lockSavePolicy.RLock()
+	calls = mock.calls.SavePolicy
+	mock.lockSavePolicy.RUnlock()
+	return calls
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"wish-list/internal/domain/retention/models"
+	"wish-list/internal/domain/retention/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RetentionInterval is how often retention policies are enforced
+const RetentionInterval = 24 * time.Hour
+
+// MaxRetentionMonths bounds the period an admin can set
+const MaxRetentionMonths = 120
+
+// Where a policy's period comes from
+const (
+	SourceConfig = "config" // RETENTION_*_MONTHS setting
+	SourceAdmin  = "admin"  // Set by an admin, overriding the setting
+)
+
+var (
+	ErrUnknownDataClass = errors.New("data class must be guest_pii, audit_log or analytics")
+	ErrInvalidRetention = errors.New("retention months out of range")
+)
+
+// RetentionServiceInterface defines the interface for data retention operations
+type RetentionServiceInterface interface {
+	ListPolicies(ctx context.Context) ([]*PolicyOutput, error)
+	UpdatePolicy(ctx context.Context, adminID pgtype.UUID, dataClass string, months int) (*PolicyOutput, error)
+	Report(ctx context.Context) (*ReportOutput, error)
+}
+
+// RetentionService keeps each class of data only as long as its policy says:
+// guest details are anonymized some months after the wishlist's occasion, audit
+// and analytics records are deleted some months after they were recorded.
+// Periods default to the configured months and can be overridden by admins.
+type RetentionService struct {
+	repo     repository.RetentionRepositoryInterface
+	defaults map[string]int
+}
+
+// NewRetentionService creates a retention service. defaults holds the configured
+// months per data class; a class left out is kept forever unless an admin sets it.
+func NewRetentionService(repo repository.RetentionRepositoryInterface, defaults map[string]int) *RetentionService {
+	return &RetentionService{
+		repo:     repo,
+		defaults: defaults,
+	}
+}
+
+// PolicyOutput is the retention period in force for a data class
+type PolicyOutput struct {
+	DataClass string
+	Months    int // 0 keeps the data
+	Source    string
+	UpdatedAt time.Time // Zero unless set by an admin
+}
+
+// ReportOutput lists what enforcing the policies would anonymize or delete now
+type ReportOutput struct {
+	GeneratedAt time.Time
+	Classes     []ClassReportOutput
+}
+
+// ClassReportOutput is the part of a report for one data class
+type ClassReportOutput struct {
+	Policy  PolicyOutput
+	Cutoff  time.Time // Records from before it are expired; zero when the data is kept
+	Targets []TargetOutput
+}
+
+// TargetOutput counts the expired records of one table
+type TargetOutput struct {
+	Table   string
+	Action  string
+	Records int64
+}
+
+// ListPolicies returns the policy in force for every data class
+func (s *RetentionService) ListPolicies(ctx context.Context) ([]*PolicyOutput, error) {
+	policies, err := s.repo.ListPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+
+	outputs := make([]*PolicyOutput, len(models.DataClasses))
+	for i, dataClass := range models.DataClasses {
+		outputs[i] = &PolicyOutput{DataClass: dataClass, Months: s.defaults[dataClass], Source: SourceConfig}
+		for _, policy := range policies {
+			if policy.DataClass == dataClass {
+				outputs[i] = toPolicyOutput(policy)
+			}
+		}
+	}
+
+	return outputs, nil
+}
+
+// UpdatePolicy sets the retention period of a data class, overriding the
+// configured one. It takes effect on the next scheduled run.
+func (s *RetentionService) UpdatePolicy(ctx context.Context, adminID pgtype.UUID, dataClass string, months int) (*PolicyOutput, error) {
+	if !slices.Contains(models.DataClasses, dataClass) {
+		return nil, ErrUnknownDataClass
+	}
+	if months < 0 || months > MaxRetentionMonths {
+		return nil, ErrInvalidRetention
+	}
+
+	saved, err := s.repo.SavePolicy(ctx, models.Policy{DataClass: dataClass, Months: months, UpdatedByUserID: adminID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save retention policy: %w", err)
+	}
+
+	logger.InfoContext(ctx, "retention policy updated",
+		"audit", true,
+		"data_class", dataClass,
+		"months", months,
+		"admin_id", adminID.String(),
+	)
+
+	return toPolicyOutput(saved), nil
+}
+
+// Report is a dry run of EnforcePolicies: it counts what would be anonymized or
+// deleted now, without changing anything
+func (s *RetentionService) Report(ctx context.Context) (*ReportOutput, error) {
+	policies, err := s.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &ReportOutput{GeneratedAt: now, Classes: make([]ClassReportOutput, len(policies))}
+	for i, policy := range policies {
+		class := ClassReportOutput{Policy: *policy, Targets: []TargetOutput{}}
+		if policy.Months > 0 {
+			class.Cutoff = retentionCutoff(now, policy.Months)
+			counts, err := s.repo.CountExpired(ctx, policy.DataClass, class.Cutoff)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count expired %s: %w", policy.DataClass, err)
+			}
+			class.Targets = toTargetOutputs(counts)
+		}
+		report.Classes[i] = class
+	}
+
+	return report, nil
+}
+
+// EnforcePolicies anonymizes or deletes every record past its data class's
+// retention period and returns how many records were changed. A class that
+// fails is logged and retried on the next run.
+func (s *RetentionService) EnforcePolicies(ctx context.Context) (int64, error) {
+	policies, err := s.ListPolicies(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	var total int64
+	for _, policy := range policies {
+		if policy.Months == 0 {
+			continue
+		}
+
+		cutoff := retentionCutoff(now, policy.Months)
+		counts, err := s.repo.Enforce(ctx, policy.DataClass, cutoff)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to enforce retention policy", "data_class", policy.DataClass, "error", err)
+			continue
+		}
+
+		for _, count := range counts {
+			if count.Records == 0 {
+				continue
+			}
+			total += count.Records
+			logger.InfoContext(ctx, "retention policy enforced",
+				"audit", true,
+				"data_class", policy.DataClass,
+				"table", count.Table,
+				"action", count.Action,
+				"records", count.Records,
+				"cutoff", cutoff.Format(time.RFC3339),
+			)
+		}
+	}
+
+	return total, nil
+}
+
+// retentionCutoff is the start of the retention period of months ending at now
+func retentionCutoff(now time.Time, months int) time.Time {
+	return now.AddDate(0, -months, 0)
+}
+
+func toPolicyOutput(policy *models.Policy) *PolicyOutput {
+	return &PolicyOutput{
+		DataClass: policy.DataClass,
+		Months:    policy.Months,
+		Source:    SourceAdmin,
+		UpdatedAt: policy.UpdatedAt.Time,
+	}
+}
+
+func toTargetOutputs(counts []models.TargetCount) []TargetOutput {
+	outputs := make([]TargetOutput, len(counts))
+	for i, count := range counts {
+		outputs[i] = TargetOutput{Table: count.Table, Action: count.Action, Records: count.Records}
+	}
+	return outputs
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/retention/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var retentionDefaults = map[string]int{
+	models.DataClassGuestPII:  12,
+	models.DataClassAuditLog:  24,
+	models.DataClassAnalytics: 0,
+}
+
+func newRetentionRepo(policies ...*models.Policy) *RetentionRepositoryInterfaceMock {
+	return &RetentionRepositoryInterfaceMock{
+		ListPoliciesFunc: func(ctx context.Context) ([]*models.Policy, error) {
+			return policies, nil
+		},
+	}
+}
+
+func TestRetentionService_ListPolicies(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	repo := newRetentionRepo(&models.Policy{
+		DataClass: models.DataClassAuditLog,
+		Months:    6,
+		UpdatedAt: pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	})
+	svc := NewRetentionService(repo, retentionDefaults)
+
+	policies, err := svc.ListPolicies(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, policies, 3)
+	assert.Equal(t, PolicyOutput{DataClass: models.DataClassGuestPII, Months: 12, Source: SourceConfig}, *policies[0])
+	assert.Equal(t, PolicyOutput{DataClass: models.DataClassAuditLog, Months: 6, Source: SourceAdmin, UpdatedAt: updatedAt}, *policies[1])
+	assert.Equal(t, PolicyOutput{DataClass: models.DataClassAnalytics, Months: 0, Source: SourceConfig}, *policies[2])
+}
+
+func TestRetentionService_UpdatePolicy(t *testing.T) {
+	adminID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+
+	t.Run("overrides the setting", func(t *testing.T) {
+		repo := &RetentionRepositoryInterfaceMock{
+			SavePolicyFunc: func(ctx context.Context, policy models.Policy) (*models.Policy, error) {
+				assert.Equal(t, adminID, policy.UpdatedByUserID)
+				return &policy, nil
+			},
+		}
+		svc := NewRetentionService(repo, retentionDefaults)
+
+		policy, err := svc.UpdatePolicy(context.Background(), adminID, models.DataClassAnalytics, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 3, policy.Months)
+		assert.Equal(t, SourceAdmin, policy.Source)
+	})
+
+	t.Run("unknown data class", func(t *testing.T) {
+		repo := &RetentionRepositoryInterfaceMock{}
+		svc := NewRetentionService(repo, retentionDefaults)
+
+		_, err := svc.UpdatePolicy(context.Background(), adminID, "sessions", 3)
+		assert.ErrorIs(t, err, ErrUnknownDataClass)
+		assert.Empty(t, repo.SavePolicyCalls())
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		repo := &RetentionRepositoryInterfaceMock{}
+		svc := NewRetentionService(repo, retentionDefaults)
+
+		_, err := svc.UpdatePolicy(context.Background(), adminID, models.DataClassGuestPII, MaxRetentionMonths+1)
+		assert.ErrorIs(t, err, ErrInvalidRetention)
+		_, err = svc.UpdatePolicy(context.Background(), adminID, models.DataClassGuestPII, -1)
+		assert.ErrorIs(t, err, ErrInvalidRetention)
+		assert.Empty(t, repo.SavePolicyCalls())
+	})
+}
+
+func TestRetentionService_Report(t *testing.T) {
+	repo := newRetentionRepo()
+	repo.CountExpiredFunc = func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+		return []models.TargetCount{{Table: "reservation_events", Action: models.ActionDelete, Records: 4}}, nil
+	}
+	svc := NewRetentionService(repo, retentionDefaults)
+
+	report, err := svc.Report(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, report.Classes, 3)
+	assert.Equal(t, report.GeneratedAt.AddDate(0, -24, 0), report.Classes[1].Cutoff)
+	assert.Equal(t, []TargetOutput{{Table: "reservation_events", Action: models.ActionDelete, Records: 4}}, report.Classes[1].Targets)
+	assert.True(t, report.Classes[2].Cutoff.IsZero(), "kept data has no cutoff")
+	assert.Empty(t, report.Classes[2].Targets)
+	assert.Len(t, repo.CountExpiredCalls(), 2)
+	assert.Empty(t, repo.EnforceCalls(), "a report changes nothing")
+}
+
+func TestRetentionService_EnforcePolicies(t *testing.T) {
+	repo := newRetentionRepo()
+	repo.EnforceFunc = func(ctx context.Context, dataClass string, cutoff time.Time) ([]models.TargetCount, error) {
+		if dataClass == models.DataClassGuestPII {
+			return nil, errors.New("connection reset")
+		}
+		return []models.TargetCount{
+			{Table: "reservation_events", Action: models.ActionDelete, Records: 5},
+			{Table: "other", Action: models.ActionDelete, Records: 0},
+		}, nil
+	}
+	svc := NewRetentionService(repo, retentionDefaults)
+
+	total, err := svc.EnforcePolicies(context.Background())
+	require.NoError(t, err, "a failed class is retried next run")
+	assert.Equal(t, int64(5), total)
+
+	calls := repo.EnforceCalls()
+	require.Len(t, calls, 2, "kept data is skipped")
+	assert.Equal(t, models.DataClassGuestPII, calls[0].DataClass)
+	assert.Equal(t, models.DataClassAuditLog, calls[1].DataClass)
+}
@@ -0,0 +1,8 @@
+package dto
+
+type CreateRSVPRequest struct {
+	Name      string  `json:"name" validate:"required,max=100" example:"Aunt May"`
+	Email     *string `json:"email" validate:"omitempty,email,max=255" example:"may@example.com"` // Answering again with the same email updates the answer
+	Attending *bool   `json:"attending" validate:"required" example:"true"`
+	Note      *string `json:"note" validate:"omitempty,max=500" example:"Bringing a plus one"`
+}
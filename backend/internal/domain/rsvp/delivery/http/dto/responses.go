@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"wish-list/internal/domain/rsvp/service"
+)
+
+type RSVPResponse struct {
+	ID         string  `json:"id" validate:"required"`
+	WishlistID string  `json:"wishlist_id" validate:"required"`
+	Name       string  `json:"name" validate:"required"`
+	Email      *string `json:"email"`
+	Attending  bool    `json:"attending" validate:"required"`
+	Note       *string `json:"note"`
+	CreatedAt  string  `json:"created_at" validate:"required"`
+	UpdatedAt  string  `json:"updated_at" validate:"required"`
+}
+
+type RSVPListResponse struct {
+	Data         []RSVPResponse `json:"data" validate:"required"`
+	Attending    int            `json:"attending" validate:"required" example:"12"`
+	NotAttending int            `json:"not_attending" validate:"required" example:"3"`
+}
+
+// PublicRSVPResponse confirms a guest's answer without echoing their email back
+type PublicRSVPResponse struct {
+	ID        string `json:"id" validate:"required"`
+	Name      string `json:"name" validate:"required"`
+	Attending bool   `json:"attending" validate:"required"`
+}
+
+func FromRSVPOutput(r *service.RSVPOutput) RSVPResponse {
+	resp := RSVPResponse{
+		ID:         r.ID.String(),
+		WishlistID: r.WishlistID.String(),
+		Name:       r.Name,
+		Attending:  r.Attending,
+		CreatedAt:  r.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  r.UpdatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if r.Email.Valid {
+		email := r.Email.String
+		resp.Email = &email
+	}
+
+	if r.Note.Valid {
+		note := r.Note.String
+		resp.Note = &note
+	}
+
+	return resp
+}
+
+func FromRSVPListOutput(list *service.RSVPListOutput) RSVPListResponse {
+	data := make([]RSVPResponse, len(list.RSVPs))
+	for i, rsvp := range list.RSVPs {
+		data[i] = FromRSVPOutput(rsvp)
+	}
+	return RSVPListResponse{
+		Data:         data,
+		Attending:    list.Attending,
+		NotAttending: list.NotAttending,
+	}
+}
+
+func FromRSVPOutputPublic(r *service.RSVPOutput) PublicRSVPResponse {
+	return PublicRSVPResponse{
+		ID:        r.ID.String(),
+		Name:      r.Name,
+		Attending: r.Attending,
+	}
+}
+
+// WriteRSVPsCSV writes the answers as CSV with a header row
+func WriteRSVPsCSV(w io.Writer, list *service.RSVPListOutput) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"name", "email", "attending", "note", "answered_at"}); err != nil {
+		return err
+	}
+	for _, rsvp := range list.RSVPs {
+		record := []string{
+			csvSafe(rsvp.Name),
+			csvSafe(rsvp.Email.String),
+			strconv.FormatBool(rsvp.Attending),
+			csvSafe(rsvp.Note.String),
+			rsvp.UpdatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvSafe keeps guest-entered text from being read as a formula by spreadsheet apps
+func csvSafe(s string) string {
+	if s != "" && (s[0] == '=' || s[0] == '+' || s[0] == '-' || s[0] == '@') {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/rsvp/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapRSVPServiceError converts RSVP service errors to AppErrors
+func mapRSVPServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrRSVPNameRequired):
+		return apperrors.BadRequest("Name is required")
+	case errors.Is(err, service.ErrRSVPClosed):
+		return apperrors.Conflict("This wishlist has no upcoming occasion to RSVP to")
+	case errors.Is(err, service.ErrPublicWishlistNotFound):
+		return apperrors.NotFound("Public wishlist not found")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Wishlist not found")
+	case errors.Is(err, service.ErrRSVPAccessDenied):
+		return apperrors.Forbidden("Only the wishlist owner can see RSVPs")
+	default:
+		return apperrors.Internal("Failed to process RSVP").Wrap(err)
+	}
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"bytes"
+	nethttp "net/http"
+
+	"wish-list/internal/domain/rsvp/delivery/http/dto"
+	"wish-list/internal/domain/rsvp/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for occasion RSVPs
+type Handler struct {
+	service service.RSVPServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.RSVPServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateRSVP godoc
+//
+//	@Summary		RSVP to the occasion of a public wishlist
+//	@Description	Tell the owner whether you are attending. No account is needed; answering again with the same email replaces the earlier answer. Only open while the occasion date has not passed.
+//	@Tags			RSVPs
+//	@Accept			json
+//	@Produce		json
+//	@Param			slug			path		string					true	"Public slug of the wishlist"
+//	@Param			request			body		dto.CreateRSVPRequest	true	"RSVP"
+//	@Param			X-Captcha-Token	header		string					false	"CAPTCHA response token (required when CAPTCHA is enabled)"
+//	@Success		201				{object}	dto.PublicRSVPResponse	"RSVP recorded"
+//	@Failure		400				{object}	map[string]string		"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string		"CAPTCHA verification failed"
+//	@Failure		404				{object}	map[string]string		"Public wishlist not found"
+//	@Failure		409				{object}	map[string]string		"No upcoming occasion"
+//	@Failure		429				{object}	map[string]string		"Too many requests"
+//	@Failure		500				{object}	map[string]string		"Internal server error"
+//	@Router			/public/wishlists/{slug}/rsvps [post]
+func (h *Handler) CreateRSVP(c echo.Context) error {
+	var req dto.CreateRSVPRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	rsvp, err := h.service.SubmitRSVP(c.Request().Context(), service.SubmitRSVPInput{
+		PublicSlug: c.Param("slug"),
+		Name:       req.Name,
+		Email:      req.Email,
+		Attending:  *req.Attending,
+		Note:       req.Note,
+	})
+	if err != nil {
+		return mapRSVPServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromRSVPOutputPublic(rsvp))
+}
+
+// ListRSVPs godoc
+//
+//	@Summary		List RSVPs for a wishlist
+//	@Description	Owner view of every answer to the wishlist's occasion, oldest first, with attendance counts.
+//	@Tags			RSVPs
+//	@Produce		json
+//	@Param			id	path		string					true	"Wishlist ID"
+//	@Success		200	{object}	dto.RSVPListResponse	"List of RSVPs"
+//	@Failure		400	{object}	map[string]string		"Invalid wishlist ID"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Not the wishlist owner"
+//	@Failure		404	{object}	map[string]string		"Wishlist not found"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/rsvps [get]
+func (h *Handler) ListRSVPs(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	list, err := h.service.ListRSVPs(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapRSVPServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromRSVPListOutput(list))
+}
+
+// ExportRSVPs godoc
+//
+//	@Summary		Export RSVPs as CSV
+//	@Description	Download every answer to the wishlist's occasion as a CSV file with name, email, attending, note and answered_at columns.
+//	@Tags			RSVPs
+//	@Produce		text/csv
+//	@Param			id	path		string				true	"Wishlist ID"
+//	@Success		200	{string}	string				"CSV file"
+//	@Failure		400	{object}	map[string]string	"Invalid wishlist ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Not the wishlist owner"
+//	@Failure		404	{object}	map[string]string	"Wishlist not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/rsvps/export [get]
+func (h *Handler) ExportRSVPs(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	list, err := h.service.ListRSVPs(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapRSVPServiceError(err)
+	}
+
+	var buf bytes.Buffer
+	if err := dto.WriteRSVPsCSV(&buf, list); err != nil {
+		return apperrors.Internal("Failed to export RSVPs").Wrap(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="rsvps.csv"`)
+	return c.Blob(nethttp.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/app/middleware"
+)
+
+// RegisterRoutes registers all RSVP HTTP routes
+func RegisterRoutes(
+	e *echo.Echo,
+	h *Handler,
+	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
+) {
+	// Public RSVP form — rate limited per IP (5 req/min), no account needed.
+	rsvpLimiter := middleware.NewRSVPRateLimiter()
+	e.POST("/api/public/wishlists/:slug/rsvps", h.CreateRSVP,
+		middleware.AuthRateLimitMiddleware(rsvpLimiter, middleware.IPIdentifier),
+		captchaMiddleware)
+
+	// Owner view
+	e.GET("/api/wishlists/:id/rsvps", h.ListRSVPs, authMiddleware)
+	e.GET("/api/wishlists/:id/rsvps/export", h.ExportRSVPs, authMiddleware)
+}
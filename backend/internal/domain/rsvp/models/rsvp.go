@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RSVP is a guest's answer to an occasion invitation on a public wishlist
+type RSVP struct {
+	ID             pgtype.UUID        `db:"id"`
+	WishlistID     pgtype.UUID        `db:"wishlist_id"`
+	Name           string             `db:"name"`
+	EncryptedName  pgtype.Text        `db:"encrypted_name"`  // PII encrypted
+	Email          pgtype.Text        `db:"email"`           // Optional; identifies the guest when they answer again
+	EncryptedEmail pgtype.Text        `db:"encrypted_email"` // PII encrypted
+	Attending      bool               `db:"attending"`
+	Note           pgtype.Text        `db:"note"`
+	EncryptedNote  pgtype.Text        `db:"encrypted_note"` // PII encrypted
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `db:"updated_at"`
+}
+
+// ReminderDue is a wishlist whose occasion is close and whose owner has not been
+// sent the RSVP reminder for it yet
+type ReminderDue struct {
+	WishlistID   pgtype.UUID `db:"wishlist_id"`
+	OwnerID      pgtype.UUID `db:"owner_id"`
+	Title        string      `db:"title"`
+	OccasionDate pgtype.Date `db:"occasion_date"`
+}
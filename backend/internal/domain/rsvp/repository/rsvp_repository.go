@@ -0,0 +1,298 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_rsvp_repository_test.go -pkg service . RSVPRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/rsvp/models"
+	"wish-list/internal/pkg/encryption"
+)
+
+// The name is NULL once encrypted; models.RSVP keeps the decrypted value
+const rsvpColumns = `id, wishlist_id, COALESCE(name, '') AS name, encrypted_name, email, encrypted_email,
+	attending, note, encrypted_note, created_at, updated_at`
+
+// RSVPRepositoryInterface defines the interface for RSVP database operations
+type RSVPRepositoryInterface interface {
+	Upsert(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error)
+	ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error)
+	ListDueForReminder(ctx context.Context, from, to time.Time) ([]*models.ReminderDue, error)
+	MarkReminded(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error
+	ListGuestRSVPsByEmail(ctx context.Context, guestEmail string) ([]*models.RSVP, error)
+	DeleteGuestRSVPsByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+type RSVPRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewRSVPRepository(db *database.DB) RSVPRepositoryInterface {
+	return &RSVPRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewRSVPRepositoryWithEncryption creates a new RSVPRepository that stores the
+// guest's name, email and note encrypted
+func NewRSVPRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) RSVPRepositoryInterface {
+	return &RSVPRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// encryptRSVPPII moves the guest's name, email and note into the encrypted columns
+// and returns the hash of the email, if any
+func (r *RSVPRepository) encryptRSVPPII(ctx context.Context, rsvp *models.RSVP) (pgtype.Text, error) {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{}, nil
+	}
+
+	encryptedName, err := r.encryptionSvc.Encrypt(ctx, rsvp.Name)
+	if err != nil {
+		return pgtype.Text{}, fmt.Errorf("failed to encrypt rsvp name: %w", err)
+	}
+	rsvp.EncryptedName = pgtype.Text{String: encryptedName, Valid: true}
+	rsvp.Name = ""
+
+	var emailHash pgtype.Text
+	if rsvp.Email.Valid {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, rsvp.Email.String)
+		if err != nil {
+			return pgtype.Text{}, fmt.Errorf("failed to encrypt rsvp email: %w", err)
+		}
+		rsvp.EncryptedEmail = pgtype.Text{String: encrypted, Valid: true}
+		emailHash = pgtype.Text{String: r.encryptionSvc.HashEmail(rsvp.Email.String), Valid: true}
+		rsvp.Email = pgtype.Text{Valid: false}
+	}
+
+	if rsvp.Note.Valid {
+		encrypted, err := r.encryptionSvc.Encrypt(ctx, rsvp.Note.String)
+		if err != nil {
+			return pgtype.Text{}, fmt.Errorf("failed to encrypt rsvp note: %w", err)
+		}
+		rsvp.EncryptedNote = pgtype.Text{String: encrypted, Valid: true}
+		rsvp.Note = pgtype.Text{Valid: false}
+	}
+
+	return emailHash, nil
+}
+
+// decryptRSVPPII fills the guest's name, email and note from the encrypted columns
+func (r *RSVPRepository) decryptRSVPPII(ctx context.Context, rsvp *models.RSVP) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return nil
+	}
+
+	if rsvp.EncryptedName.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, rsvp.EncryptedName.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt rsvp name: %w", err)
+		}
+		rsvp.Name = decrypted
+	}
+
+	if rsvp.EncryptedEmail.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, rsvp.EncryptedEmail.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt rsvp email: %w", err)
+		}
+		rsvp.Email = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	if rsvp.EncryptedNote.Valid {
+		decrypted, err := r.encryptionSvc.Decrypt(ctx, rsvp.EncryptedNote.String)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt rsvp note: %w", err)
+		}
+		rsvp.Note = pgtype.Text{String: decrypted, Valid: true}
+	}
+
+	return nil
+}
+
+// emailHash returns the hash stored for guestEmail, or NULL when encryption is disabled
+func (r *RSVPRepository) emailHash(guestEmail string) pgtype.Text {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: r.encryptionSvc.HashEmail(guestEmail), Valid: true}
+}
+
+// Upsert records an answer. An answer with an email replaces the guest's earlier
+// answer on the same wishlist, matched case-insensitively; answers without an
+// email are always added. With encryption enabled the earlier answer is found by
+// the email's hash, or by its plaintext if it was stored before encryption.
+func (r *RSVPRepository) Upsert(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error) {
+	email := rsvp.Email
+	emailHash, err := r.encryptRSVPPII(ctx, &rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []any{
+		rsvp.WishlistID,
+		pgtype.Text{String: rsvp.Name, Valid: rsvp.Name != ""},
+		rsvp.EncryptedName,
+		rsvp.Email,
+		rsvp.EncryptedEmail,
+		emailHash,
+		rsvp.Attending,
+		rsvp.Note,
+		rsvp.EncryptedNote,
+	}
+
+	var saved models.RSVP
+	if emailHash.Valid {
+		// An answer stored in plaintext before encryption was enabled is encrypted in place
+		legacyQuery := `
+			UPDATE rsvps SET
+				name = $2, encrypted_name = $3, email = $4, encrypted_email = $5, email_hash = $6,
+				attending = $7, note = $8, encrypted_note = $9, updated_at = NOW()
+			WHERE wishlist_id = $1 AND email_hash IS NULL AND LOWER(email) = LOWER($10)
+			RETURNING ` + rsvpColumns
+
+		err := r.db.QueryRowxContext(ctx, legacyQuery, append(args, email.String)...).StructScan(&saved)
+		switch {
+		case err == nil:
+			if err := r.decryptRSVPPII(ctx, &saved); err != nil {
+				return nil, err
+			}
+			return &saved, nil
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("failed to save rsvp: %w", err)
+		}
+	}
+
+	conflict := `(wishlist_id, LOWER(email)) WHERE email IS NOT NULL`
+	if emailHash.Valid {
+		conflict = `(wishlist_id, email_hash) WHERE email_hash IS NOT NULL`
+	}
+
+	query := `
+		INSERT INTO rsvps (wishlist_id, name, encrypted_name, email, encrypted_email, email_hash, attending, note, encrypted_note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT ` + conflict + ` DO UPDATE SET
+			name = EXCLUDED.name,
+			encrypted_name = EXCLUDED.encrypted_name,
+			encrypted_email = EXCLUDED.encrypted_email,
+			attending = EXCLUDED.attending,
+			note = EXCLUDED.note,
+			encrypted_note = EXCLUDED.encrypted_note,
+			updated_at = NOW()
+		RETURNING ` + rsvpColumns
+
+	if err := r.db.QueryRowxContext(ctx, query, args...).StructScan(&saved); err != nil {
+		return nil, fmt.Errorf("failed to save rsvp: %w", err)
+	}
+	if err := r.decryptRSVPPII(ctx, &saved); err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// ListByWishlist returns the answers on a wishlist, oldest first
+func (r *RSVPRepository) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error) {
+	query := `SELECT ` + rsvpColumns + ` FROM rsvps WHERE wishlist_id = $1 ORDER BY created_at ASC`
+
+	var rsvps []*models.RSVP
+	if err := r.db.SelectContext(ctx, &rsvps, query, wishlistID); err != nil {
+		return nil, fmt.Errorf("failed to list rsvps: %w", err)
+	}
+
+	for _, rsvp := range rsvps {
+		if err := r.decryptRSVPPII(ctx, rsvp); err != nil {
+			return nil, err
+		}
+	}
+
+	return rsvps, nil
+}
+
+// ListGuestRSVPsByEmail returns every answer a guest gave with the email, on any
+// wishlist, oldest first
+func (r *RSVPRepository) ListGuestRSVPsByEmail(ctx context.Context, guestEmail string) ([]*models.RSVP, error) {
+	query := `
+		SELECT ` + rsvpColumns + `
+		FROM rsvps
+		WHERE LOWER(email) = LOWER($1) OR email_hash = $2
+		ORDER BY created_at ASC
+	`
+
+	var rsvps []*models.RSVP
+	if err := r.db.SelectContext(ctx, &rsvps, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail)); err != nil {
+		return nil, fmt.Errorf("failed to list guest rsvps: %w", err)
+	}
+
+	for _, rsvp := range rsvps {
+		if err := r.decryptRSVPPII(ctx, rsvp); err != nil {
+			return nil, err
+		}
+	}
+
+	return rsvps, nil
+}
+
+// DeleteGuestRSVPsByEmail deletes every answer a guest gave with the email and
+// returns how many were removed
+func (r *RSVPRepository) DeleteGuestRSVPsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	query := `DELETE FROM rsvps WHERE LOWER(email) = LOWER($1) OR email_hash = $2`
+
+	result, err := r.db.ExecContext(ctx, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete guest rsvps: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// ListDueForReminder returns wishlists with at least one RSVP whose occasion date
+// is between from and to and whose owner has not been reminded of that date yet
+func (r *RSVPRepository) ListDueForReminder(ctx context.Context, from, to time.Time) ([]*models.ReminderDue, error) {
+	query := `
+		SELECT w.id AS wishlist_id, w.owner_id, w.title, w.occasion_date
+		FROM wishlists w
+		WHERE w.occasion_date BETWEEN $1 AND $2
+		  AND w.rsvp_reminded_for IS DISTINCT FROM w.occasion_date
+		  AND EXISTS (SELECT 1 FROM rsvps r WHERE r.wishlist_id = w.id)
+		ORDER BY w.occasion_date ASC
+		LIMIT 500
+	`
+
+	var due []*models.ReminderDue
+	if err := r.db.SelectContext(ctx, &due, query, from, to); err != nil {
+		return nil, fmt.Errorf("failed to list wishlists due for rsvp reminder: %w", err)
+	}
+
+	return due, nil
+}
+
+// MarkReminded records that the owner was reminded of the wishlist's occasion on occasionDate
+func (r *RSVPRepository) MarkReminded(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error {
+	query := `UPDATE wishlists SET rsvp_reminded_for = $2 WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, wishlistID, occasionDate); err != nil {
+		return fmt.Errorf("failed to mark rsvp reminder sent: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/rsvp/models"
+	"wish-list/internal/domain/rsvp/repository"
+)
+
+// Ensure, that RSVPRepositoryInterfaceMock does implement repository.RSVPRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.RSVPRepositoryInterface = &RSVPRepositoryInterfaceMock{}
+
+// RSVPRepositoryInterfaceMock is a mock implementation of repository.RSVPRepositoryInterface.
+//
+//	func TestSomethingThatUsesRSVPRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.RSVPRepositoryInterface
+//		mockedRSVPRepositoryInterface := &RSVPRepositoryInterfaceMock{
+//			DeleteGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestRSVPsByEmail method")
+//			},
+//			ListByWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error) {
+//				panic("mock out the ListByWishlist method")
+//			},
+//			ListDueForReminderFunc: func(ctx context.Context, from time.Time, to time.Time) ([]*models.ReminderDue, error) {
+//				panic("mock out the ListDueForReminder method")
+//			},
+//			ListGuestRSVPsByEmailFunc: func(ctx context.Context, guestEmail string) ([]*models.RSVP, error) {
+//				panic("mock out the ListGuestRSVPsByEmail method")
+//			},
+//			MarkRemindedFunc: func(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error {
+//				panic("mock out the MarkReminded method")
+//			},
+//			UpsertFunc: func(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error) {
+//				panic("mock out the Upsert method")
+//			},
+//		}
+//
+//		// use mockedRSVPRepositoryInterface in code that requires repository.RSVPRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type RSVPRepositoryInterfaceMock struct {
+	// DeleteGuestRSVPsByEmailFunc mocks the DeleteGuestRSVPsByEmail method.
+	DeleteGuestRSVPsByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListByWishlistFunc mocks the ListByWishlist method.
+	ListByWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error)
+
+	// ListDueForReminderFunc mocks the ListDueForReminder method.
+	ListDueForReminderFunc func(ctx context.Context, from time.Time, to time.Time) ([]*models.ReminderDue, error)
+
+	// ListGuestRSVPsByEmailFunc mocks the ListGuestRSVPsByEmail method.
+	ListGuestRSVPsByEmailFunc func(ctx context.Context, guestEmail string) ([]*models.RSVP, error)
+
+	// MarkRemindedFunc mocks the MarkReminded method.
+	MarkRemindedFunc func(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error
+
+	// UpsertFunc mocks the Upsert method.
+	UpsertFunc func(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteGuestRSVPsByEmail holds details about calls to the DeleteGuestRSVPsByEmail method.
+		DeleteGuestRSVPsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListByWishlist holds details about calls to the ListByWishlist method.
+		ListByWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// ListDueForReminder holds details about calls to the ListDueForReminder method.
+		ListDueForReminder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// ListGuestRSVPsByEmail holds details about calls to the ListGuestRSVPsByEmail method.
+		ListGuestRSVPsByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// MarkReminded holds details about calls to the MarkReminded method.
+		MarkReminded []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// OccasionDate is the occasionDate argument value.
+			OccasionDate pgtype.Date
+		}
+		// Upsert holds details about calls to the Upsert method.
+		Upsert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rsvp is the rsvp argument value.
+			Rsvp models.RSVP
+		}
+	}
+	lockDeleteGuestRSVPsByEmail sync.RWMutex
+	lockListByWishlist          sync.RWMutex
+	lockListDueForReminder      sync.RWMutex
+	lockListGuestRSVPsByEmail   sync.RWMutex
+	lockMarkReminded            sync.RWMutex
+	lockUpsert                  sync.RWMutex
+}
+
+// DeleteGuestRSVPsByEmail calls DeleteGuestRSVPsByEmailFunc.
+func (mock *RSVPRepositoryInterfaceMock) DeleteGuestRSVPsByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestRSVPsByEmailFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.DeleteGuestRSVPsByEmailFunc: method is nil but RSVPRepositoryInterface.DeleteGuestRSVPsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestRSVPsByEmail.Lock()
+	mock.calls.DeleteGuestRSVPsByEmail = append(mock.calls.DeleteGuestRSVPsByEmail, callInfo)
+	mock.lockDeleteGuestRSVPsByEmail.Unlock()
+	return mock.DeleteGuestRSVPsByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestRSVPsByEmailCalls gets all the calls that were made to DeleteGuestRSVPsByEmail.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.DeleteGuestRSVPsByEmailCalls())
+func (mock *RSVPRepositoryInterfaceMock) DeleteGuestRSVPsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestRSVPsByEmail.RLock()
+	calls = mock.calls.DeleteGuestRSVPsByEmail
+	mock.lockDeleteGuestRSVPsByEmail.RUnlock()
+	return calls
+}
+
+// ListByWishlist calls ListByWishlistFunc.
+func (mock *RSVPRepositoryInterfaceMock) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error) {
+	if mock.ListByWishlistFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.ListByWishlistFunc: method is nil but RSVPRepositoryInterface.ListByWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockListByWishlist.Lock()
+	mock.calls.ListByWishlist = append(mock.calls.ListByWishlist, callInfo)
+	mock.lockListByWishlist.Unlock()
+	return mock.ListByWishlistFunc(ctx, wishlistID)
+}
+
+// ListByWishlistCalls gets all the calls that were made to ListByWishlist.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.ListByWishlistCalls())
+func (mock *RSVPRepositoryInterfaceMock) ListByWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockListByWishlist.RLock()
+	calls = mock.calls.ListByWishlist
+	mock.lockListByWishlist.RUnlock()
+	return calls
+}
+
+// ListDueForReminder calls ListDueForReminderFunc.
+func (mock *RSVPRepositoryInterfaceMock) ListDueForReminder(ctx context.Context, from time.Time, to time.Time) ([]*models.ReminderDue, error) {
+	if mock.ListDueForReminderFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.ListDueForReminderFunc: method is nil but RSVPRepositoryInterface.ListDueForReminder was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+		To:   to,
+	}
+	mock.lockListDueForReminder.Lock()
+	mock.calls.ListDueForReminder = append(mock.calls.ListDueForReminder, callInfo)
+	mock.lockListDueForReminder.Unlock()
+	return mock.ListDueForReminderFunc(ctx, from, to)
+}
+
+// ListDueForReminderCalls gets all the calls that were made to ListDueForReminder.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.ListDueForReminderCalls())
+func (mock *RSVPRepositoryInterfaceMock) ListDueForReminderCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}
+	mock.lockListDueForReminder.RLock()
+	calls = mock.calls.ListDueForReminder
+	mock.lockListDueForReminder.RUnlock()
+	return calls
+}
+
+// ListGuestRSVPsByEmail calls ListGuestRSVPsByEmailFunc.
+func (mock *RSVPRepositoryInterfaceMock) ListGuestRSVPsByEmail(ctx context.Context, guestEmail string) ([]*models.RSVP, error) {
+	if mock.ListGuestRSVPsByEmailFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.ListGuestRSVPsByEmailFunc: method is nil but RSVPRepositoryInterface.ListGuestRSVPsByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestRSVPsByEmail.Lock()
+	mock.calls.ListGuestRSVPsByEmail = append(mock.calls.ListGuestRSVPsByEmail, callInfo)
+	mock.lockListGuestRSVPsByEmail.Unlock()
+	return mock.ListGuestRSVPsByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestRSVPsByEmailCalls gets all the calls that were made to ListGuestRSVPsByEmail.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.ListGuestRSVPsByEmailCalls())
+func (mock *RSVPRepositoryInterfaceMock) ListGuestRSVPsByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestRSVPsByEmail.RLock()
+	calls = mock.calls.ListGuestRSVPsByEmail
+	mock.lockListGuestRSVPsByEmail.RUnlock()
+	return calls
+}
+
+// MarkReminded calls MarkRemindedFunc.
+func (mock *RSVPRepositoryInterfaceMock) MarkReminded(ctx context.Context, wishlistID pgtype.UUID, occasionDate pgtype.Date) error {
+	if mock.MarkRemindedFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.MarkRemindedFunc: method is nil but RSVPRepositoryInterface.MarkReminded was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		WishlistID   pgtype.UUID
+		OccasionDate pgtype.Date
+	}{
+		Ctx:          ctx,
+		WishlistID:   wishlistID,
+		OccasionDate: occasionDate,
+	}
+	mock.lockMarkReminded.Lock()
+	mock.calls.MarkReminded = append(mock.calls.MarkReminded, callInfo)
+	mock.lockMarkReminded.Unlock()
+	return mock.MarkRemindedFunc(ctx, wishlistID, occasionDate)
+}
+
+// MarkRemindedCalls gets all the calls that were made to MarkReminded.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.MarkRemindedCalls())
+func (mock *RSVPRepositoryInterfaceMock) MarkRemindedCalls() []struct {
+	Ctx          context.Context
+	WishlistID   pgtype.UUID
+	OccasionDate pgtype.Date
+} {
+	var calls []struct {
+		Ctx          context.Context
+		WishlistID   pgtype.UUID
+		OccasionDate pgtype.Date
+	}
+	mock.lockMarkReminded.RLock()
+	calls = mock.calls.MarkReminded
+	mock.lockMarkReminded.RUnlock()
+	return calls
+}
+
+// Upsert calls UpsertFunc.
+func (mock *RSVPRepositoryInterfaceMock) Upsert(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error) {
+	if mock.UpsertFunc == nil {
+		panic("RSVPRepositoryInterfaceMock.UpsertFunc: method is nil but RSVPRepositoryInterface.Upsert was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rsvp models.RSVP
+	}{
+		Ctx:  ctx,
+		Rsvp: rsvp,
+	}
+	mock.lockUpsert.Lock()
+	mock.calls.Upsert = append(mock.calls.Upsert, callInfo)
+	mock.lockUpsert.Unlock()
+	return mock.UpsertFunc(ctx, rsvp)
+}
+
+// UpsertCalls gets all the calls that were made to Upsert.
+// Check the length with:
+//
+//	len(mockedRSVPRepositoryInterface.UpsertCalls())
+func (mock *RSVPRepositoryInterfaceMock) UpsertCalls() []struct {
+	Ctx  context.Context
+	Rsvp models.RSVP
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rsvp models.RSVP
+	}
+	mock.lockUpsert.RLock()
+	calls = mock.calls.Upsert
+	mock.lockUpsert.RUnlock()
+	return calls
+}
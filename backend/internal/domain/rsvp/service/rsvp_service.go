@@ -0,0 +1,176 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . WishListRepositoryInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/rsvp/models"
+	"wish-list/internal/domain/rsvp/repository"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces - only methods actually used by RSVPService
+
+// WishListRepositoryInterface defines wishlist repository methods used by RSVP service
+type WishListRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+	GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+}
+
+var (
+	ErrInvalidWishlistID      = errors.New("invalid wishlist id")
+	ErrRSVPNameRequired       = errors.New("name is required")
+	ErrRSVPClosed             = errors.New("wishlist has no upcoming occasion to RSVP to")
+	ErrPublicWishlistNotFound = errors.New("public wishlist not found")
+	ErrWishlistNotFound       = errors.New("wishlist not found")
+	ErrRSVPAccessDenied       = errors.New("only the wishlist owner can see RSVPs")
+)
+
+// RSVPServiceInterface defines the interface for RSVP operations
+type RSVPServiceInterface interface {
+	SubmitRSVP(ctx context.Context, input SubmitRSVPInput) (*RSVPOutput, error)
+	ListRSVPs(ctx context.Context, wishlistID string, ownerID pgtype.UUID) (*RSVPListOutput, error)
+}
+
+type RSVPService struct {
+	repo         repository.RSVPRepositoryInterface
+	wishListRepo WishListRepositoryInterface
+}
+
+func NewRSVPService(repo repository.RSVPRepositoryInterface, wishListRepo WishListRepositoryInterface) *RSVPService {
+	return &RSVPService{
+		repo:         repo,
+		wishListRepo: wishListRepo,
+	}
+}
+
+type SubmitRSVPInput struct {
+	PublicSlug string
+	Name       string
+	Email      *string
+	Attending  bool
+	Note       *string
+}
+
+type RSVPOutput struct {
+	ID         pgtype.UUID
+	WishlistID pgtype.UUID
+	Name       string
+	Email      pgtype.Text
+	Attending  bool
+	Note       pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+// RSVPListOutput is the owner's view of the answers on a wishlist
+type RSVPListOutput struct {
+	RSVPs        []*RSVPOutput
+	Attending    int
+	NotAttending int
+}
+
+// SubmitRSVP records a guest's answer for the occasion of a public wishlist. RSVPs
+// are only open while the occasion date is today or later.
+func (s *RSVPService) SubmitRSVP(ctx context.Context, input SubmitRSVPInput) (*RSVPOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, ErrRSVPNameRequired
+	}
+
+	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, input.PublicSlug)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrPublicWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if !wishList.OccasionDate.Valid || wishList.OccasionDate.Time.Before(today) {
+		return nil, ErrRSVPClosed
+	}
+
+	rsvp := models.RSVP{
+		WishlistID: wishList.ID,
+		Name:       name,
+		Attending:  input.Attending,
+	}
+	if input.Email != nil && strings.TrimSpace(*input.Email) != "" {
+		rsvp.Email = pgtype.Text{String: strings.TrimSpace(*input.Email), Valid: true}
+	}
+	if input.Note != nil && strings.TrimSpace(*input.Note) != "" {
+		rsvp.Note = pgtype.Text{String: strings.TrimSpace(*input.Note), Valid: true}
+	}
+
+	saved, err := s.repo.Upsert(ctx, rsvp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save rsvp: %w", err)
+	}
+
+	logger.InfoContext(ctx, "rsvp submitted",
+		"rsvp_id", saved.ID.String(),
+		"wishlist_id", wishList.ID.String(),
+		"attending", saved.Attending)
+
+	return toRSVPOutput(saved), nil
+}
+
+// ListRSVPs returns every answer on the owner's wishlist with attendance counts
+func (s *RSVPService) ListRSVPs(ctx context.Context, wishlistID string, ownerID pgtype.UUID) (*RSVPListOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(wishlistID); err != nil {
+		return nil, ErrInvalidWishlistID
+	}
+
+	wishList, err := s.wishListRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	if wishList.OwnerID != ownerID {
+		return nil, ErrRSVPAccessDenied
+	}
+
+	rsvps, err := s.repo.ListByWishlist(ctx, wishList.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rsvps: %w", err)
+	}
+
+	output := &RSVPListOutput{RSVPs: make([]*RSVPOutput, len(rsvps))}
+	for i, rsvp := range rsvps {
+		output.RSVPs[i] = toRSVPOutput(rsvp)
+		if rsvp.Attending {
+			output.Attending++
+		} else {
+			output.NotAttending++
+		}
+	}
+
+	return output, nil
+}
+
+func toRSVPOutput(rsvp *models.RSVP) *RSVPOutput {
+	return &RSVPOutput{
+		ID:         rsvp.ID,
+		WishlistID: rsvp.WishlistID,
+		Name:       rsvp.Name,
+		Email:      rsvp.Email,
+		Attending:  rsvp.Attending,
+		Note:       rsvp.Note,
+		CreatedAt:  rsvp.CreatedAt,
+		UpdatedAt:  rsvp.UpdatedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/rsvp/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testWishlistID = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testOwnerID    = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testOtherID    = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+)
+
+func wishListWithOccasion(occasion pgtype.Date) *WishListRepositoryInterfaceMock {
+	wishList := &wishlistmodels.WishList{ID: testWishlistID, OwnerID: testOwnerID, Title: "Birthday", OccasionDate: occasion}
+	return &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return wishList, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return wishList, nil
+		},
+	}
+}
+
+func daysFromToday(days int) pgtype.Date {
+	return pgtype.Date{Time: time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, days), Valid: true}
+}
+
+func TestRSVPService_SubmitRSVP(t *testing.T) {
+	repo := &RSVPRepositoryInterfaceMock{
+		UpsertFunc: func(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error) {
+			rsvp.ID = testOtherID
+			return &rsvp, nil
+		},
+	}
+	svc := NewRSVPService(repo, wishListWithOccasion(daysFromToday(10)))
+
+	email := "  Guest@Example.com "
+	blank := "   "
+	out, err := svc.SubmitRSVP(context.Background(), SubmitRSVPInput{
+		PublicSlug: "birthday",
+		Name:       " Anna ",
+		Email:      &email,
+		Attending:  true,
+		Note:       &blank,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Anna", out.Name)
+	assert.Equal(t, "Guest@Example.com", out.Email.String)
+	assert.False(t, out.Note.Valid)
+	require.Len(t, repo.UpsertCalls(), 1)
+	assert.Equal(t, testWishlistID, repo.UpsertCalls()[0].Rsvp.WishlistID)
+}
+
+func TestRSVPService_SubmitRSVP_OccasionToday(t *testing.T) {
+	repo := &RSVPRepositoryInterfaceMock{
+		UpsertFunc: func(ctx context.Context, rsvp models.RSVP) (*models.RSVP, error) {
+			return &rsvp, nil
+		},
+	}
+	svc := NewRSVPService(repo, wishListWithOccasion(daysFromToday(0)))
+
+	_, err := svc.SubmitRSVP(context.Background(), SubmitRSVPInput{PublicSlug: "birthday", Name: "Anna"})
+
+	require.NoError(t, err)
+}
+
+func TestRSVPService_SubmitRSVP_Closed(t *testing.T) {
+	tests := []struct {
+		name     string
+		occasion pgtype.Date
+	}{
+		{name: "past occasion", occasion: daysFromToday(-1)},
+		{name: "no occasion date", occasion: pgtype.Date{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &RSVPRepositoryInterfaceMock{}
+			svc := NewRSVPService(repo, wishListWithOccasion(tt.occasion))
+
+			_, err := svc.SubmitRSVP(context.Background(), SubmitRSVPInput{PublicSlug: "birthday", Name: "Anna"})
+
+			require.ErrorIs(t, err, ErrRSVPClosed)
+			assert.Empty(t, repo.UpsertCalls())
+		})
+	}
+}
+
+func TestRSVPService_SubmitRSVP_Validation(t *testing.T) {
+	wishLists := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return nil, wishlistrepo.ErrWishListNotFound
+		},
+	}
+	svc := NewRSVPService(&RSVPRepositoryInterfaceMock{}, wishLists)
+
+	_, err := svc.SubmitRSVP(context.Background(), SubmitRSVPInput{PublicSlug: "birthday", Name: "  "})
+	require.ErrorIs(t, err, ErrRSVPNameRequired)
+
+	_, err = svc.SubmitRSVP(context.Background(), SubmitRSVPInput{PublicSlug: "missing", Name: "Anna"})
+	require.ErrorIs(t, err, ErrPublicWishlistNotFound)
+}
+
+func TestRSVPService_ListRSVPs(t *testing.T) {
+	repo := &RSVPRepositoryInterfaceMock{
+		ListByWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*models.RSVP, error) {
+			return []*models.RSVP{
+				{Name: "Anna", Attending: true},
+				{Name: "Boris", Attending: false},
+				{Name: "Clara", Attending: true},
+			}, nil
+		},
+	}
+	svc := NewRSVPService(repo, wishListWithOccasion(daysFromToday(10)))
+
+	out, err := svc.ListRSVPs(context.Background(), testWishlistID.String(), testOwnerID)
+
+	require.NoError(t, err)
+	assert.Len(t, out.RSVPs, 3)
+	assert.Equal(t, 2, out.Attending)
+	assert.Equal(t, 1, out.NotAttending)
+}
+
+func TestRSVPService_ListRSVPs_AccessDenied(t *testing.T) {
+	repo := &RSVPRepositoryInterfaceMock{}
+	svc := NewRSVPService(repo, wishListWithOccasion(daysFromToday(10)))
+
+	_, err := svc.ListRSVPs(context.Background(), testWishlistID.String(), testOtherID)
+	require.ErrorIs(t, err, ErrRSVPAccessDenied)
+	assert.Empty(t, repo.ListByWishlistCalls())
+
+	_, err = svc.ListRSVPs(context.Background(), "not-a-uuid", testOwnerID)
+	require.ErrorIs(t, err, ErrInvalidWishlistID)
+}
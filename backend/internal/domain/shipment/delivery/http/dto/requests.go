@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"wish-list/internal/domain/shipment/service"
+)
+
+// AddShipmentRequest is a parcel the purchaser of a gift item wants to track
+type AddShipmentRequest struct {
+	GiftItemID     string `json:"gift_item_id" validate:"required,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Carrier        string `json:"carrier" validate:"required,max=50" example:"ups"`
+	TrackingNumber string `json:"tracking_number" validate:"required,max=100" example:"1Z999AA10123456784"`
+}
+
+// ToServiceInput converts the request to service input
+func (r *AddShipmentRequest) ToServiceInput() service.AddShipmentInput {
+	return service.AddShipmentInput{
+		GiftItemID:     r.GiftItemID,
+		Carrier:        r.Carrier,
+		TrackingNumber: r.TrackingNumber,
+	}
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/domain/shipment/service"
+)
+
+type ShipmentResponse struct {
+	ID             string  `json:"id" validate:"required"`
+	GiftItemID     string  `json:"gift_item_id" validate:"required"`
+	Carrier        string  `json:"carrier" validate:"required"`
+	TrackingNumber string  `json:"tracking_number" validate:"required"`
+	Status         string  `json:"status" validate:"required" enums:"pending,in_transit,out_for_delivery,delivered,exception"`
+	StatusDetail   *string `json:"status_detail"`   // Latest checkpoint reported by the carrier
+	LastCheckedAt  *string `json:"last_checked_at"` // Null until the carrier was first asked
+	DeliveredAt    *string `json:"delivered_at"`
+	CreatedAt      string  `json:"created_at" validate:"required"`
+}
+
+type ShipmentListResponse struct {
+	Data []ShipmentResponse `json:"data" validate:"required"`
+}
+
+// ReceivedItemResponse is a purchased gift item on its owner's purchased-items view
+type ReceivedItemResponse struct {
+	GiftItemID     string  `json:"gift_item_id" validate:"required"`
+	Name           string  `json:"name" validate:"required"`
+	PurchasedAt    *string `json:"purchased_at"`
+	ShipmentStatus *string `json:"shipment_status" enums:"pending,in_transit,out_for_delivery,delivered,exception"` // Null without a shipment or while the gift is a surprise
+	DeliveredAt    *string `json:"delivered_at"`
+}
+
+type ReceivedItemListResponse struct {
+	Data []ReceivedItemResponse `json:"data" validate:"required"`
+}
+
+func FromShipmentOutput(s *service.ShipmentOutput) ShipmentResponse {
+	resp := ShipmentResponse{
+		ID:             s.ID.String(),
+		GiftItemID:     s.GiftItemID.String(),
+		Carrier:        s.Carrier,
+		TrackingNumber: s.TrackingNumber,
+		Status:         s.Status,
+		LastCheckedAt:  formatTimestamp(s.LastCheckedAt),
+		DeliveredAt:    formatTimestamp(s.DeliveredAt),
+		CreatedAt:      s.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if s.StatusDetail != "" {
+		resp.StatusDetail = &s.StatusDetail
+	}
+	return resp
+}
+
+func FromShipmentOutputs(shipments []*service.ShipmentOutput) ShipmentListResponse {
+	data := make([]ShipmentResponse, 0, len(shipments))
+	for _, s := range shipments {
+		data = append(data, FromShipmentOutput(s))
+	}
+	return ShipmentListResponse{Data: data}
+}
+
+func FromReceivedItemOutputs(items []*service.ReceivedItemOutput) ReceivedItemListResponse {
+	data := make([]ReceivedItemResponse, 0, len(items))
+	for _, item := range items {
+		resp := ReceivedItemResponse{
+			GiftItemID:  item.GiftItemID.String(),
+			Name:        item.Name,
+			PurchasedAt: formatTimestamp(item.PurchasedAt),
+			DeliveredAt: formatTimestamp(item.DeliveredAt),
+		}
+		if item.ShipmentStatus != "" {
+			resp.ShipmentStatus = &item.ShipmentStatus
+		}
+		data = append(data, resp)
+	}
+	return ReceivedItemListResponse{Data: data}
+}
+
+func formatTimestamp(ts pgtype.Timestamptz) *string {
+	if !ts.Valid {
+		return nil
+	}
+	formatted := ts.Time.Format("2006-01-02T15:04:05Z07:00")
+	return &formatted
+}
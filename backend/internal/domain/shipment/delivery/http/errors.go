@@ -0,0 +1,32 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/shipment/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapShipmentServiceError converts shipment service errors to AppErrors
+func mapShipmentServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidShipmentID):
+		return apperrors.BadRequest("Invalid shipment ID")
+	case errors.Is(err, service.ErrShipmentNotFound):
+		return apperrors.NotFound("Shipment not found")
+	case errors.Is(err, service.ErrShipmentExists):
+		return apperrors.Conflict("This tracking number is already added to the item")
+	case errors.Is(err, service.ErrItemNotFound):
+		return apperrors.NotFound("Gift item not found")
+	case errors.Is(err, service.ErrItemNotPurchased):
+		return apperrors.Conflict("Gift item has not been purchased")
+	case errors.Is(err, service.ErrShipmentForbidden):
+		return apperrors.Forbidden("Only the purchaser can track this item")
+	case errors.Is(err, service.ErrUnsupportedCarrier):
+		return apperrors.BadRequest("Carrier is not supported")
+	case errors.Is(err, service.ErrInvalidTrackingInput):
+		return apperrors.BadRequest("Tracking number is required and must be at most 100 characters")
+	default:
+		return apperrors.Internal("Failed to process shipment").Wrap(err)
+	}
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/shipment/delivery/http/dto"
+	"wish-list/internal/domain/shipment/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for gift shipments
+type Handler struct {
+	service service.ShipmentServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.ShipmentServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// AddShipment godoc
+//
+//	@Summary		Track a gift shipment
+//	@Description	Add a carrier and tracking number to an item the current user purchased. The parcel is polled in the background and the item's owner is notified once it is delivered; if the gift is on a wishlist with an upcoming occasion, the notice waits until the day after the occasion.
+//	@Tags			Shipments
+//	@Accept			json
+//	@Produce		json
+//	@Param			shipment	body		dto.AddShipmentRequest	true	"Shipment"
+//	@Success		201			{object}	dto.ShipmentResponse	"Shipment added"
+//	@Failure		400			{object}	map[string]string		"Invalid request or unsupported carrier"
+//	@Failure		401			{object}	map[string]string		"Unauthorized"
+//	@Failure		403			{object}	map[string]string		"Not the purchaser"
+//	@Failure		404			{object}	map[string]string		"Gift item not found"
+//	@Failure		409			{object}	map[string]string		"Item not purchased or tracking number already added"
+//	@Failure		500			{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/shipments [post]
+func (h *Handler) AddShipment(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	var req dto.AddShipmentRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	shipment, err := h.service.AddShipment(c.Request().Context(), userID, req.ToServiceInput())
+	if err != nil {
+		return mapShipmentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromShipmentOutput(shipment))
+}
+
+// ListShipments godoc
+//
+//	@Summary		List a gift's shipments
+//	@Description	Shipments of an item the current user purchased, with their latest tracking status
+//	@Tags			Shipments
+//	@Produce		json
+//	@Param			itemId	path		string						true	"Gift item ID"
+//	@Success		200		{object}	dto.ShipmentListResponse	"Shipments"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Not the purchaser"
+//	@Failure		404		{object}	map[string]string			"Gift item not found"
+//	@Failure		409		{object}	map[string]string			"Item not purchased"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/shipments/item/{itemId} [get]
+func (h *Handler) ListShipments(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	shipments, err := h.service.ListShipments(c.Request().Context(), userID, c.Param("itemId"))
+	if err != nil {
+		return mapShipmentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromShipmentOutputs(shipments))
+}
+
+// DeleteShipment godoc
+//
+//	@Summary		Stop tracking a shipment
+//	@Description	Remove one of the current user's shipments
+//	@Tags			Shipments
+//	@Param			id	path	string	true	"Shipment ID"
+//	@Success		204	"Shipment removed"
+//	@Failure		400	{object}	map[string]string	"Invalid shipment ID"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		404	{object}	map[string]string	"Shipment not found"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/shipments/{id} [delete]
+func (h *Handler) DeleteShipment(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteShipment(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return mapShipmentServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
+
+// ListReceived godoc
+//
+//	@Summary		List purchased gifts and their delivery status
+//	@Description	The current user's purchased gift items with the status of each item's latest shipment. Tracking numbers are never shown, and the status is left out until the day after the occasion of the wishlists holding the item.
+//	@Tags			Shipments
+//	@Produce		json
+//	@Success		200	{object}	dto.ReceivedItemListResponse	"Purchased items"
+//	@Failure		401	{object}	map[string]string				"Unauthorized"
+//	@Failure		500	{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/shipments/received [get]
+func (h *Handler) ListReceived(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	items, err := h.service.ListReceived(c.Request().Context(), userID)
+	if err != nil {
+		return mapShipmentServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromReceivedItemOutputs(items))
+}
@@ -0,0 +1,14 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers shipment HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	shipments := e.Group("/api/shipments", authMiddleware)
+	shipments.POST("", h.AddShipment)
+	shipments.GET("/received", h.ListReceived)
+	shipments.GET("/item/:itemId", h.ListShipments)
+	shipments.DELETE("/:id", h.DeleteShipment)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Shipment statuses, mirroring carrier.Status*
+const (
+	StatusPending        = "pending" // Not picked up by the carrier yet, or not checked yet
+	StatusInTransit      = "in_transit"
+	StatusOutForDelivery = "out_for_delivery"
+	StatusDelivered      = "delivered"
+	StatusException      = "exception" // Failed attempt, return to sender, etc.
+)
+
+// Shipment is a parcel the purchaser of a gift item is tracking
+type Shipment struct {
+	ID              pgtype.UUID        `db:"id"`
+	GiftItemID      pgtype.UUID        `db:"gift_item_id"`
+	PurchaserUserID pgtype.UUID        `db:"purchaser_user_id"`
+	Carrier         string             `db:"carrier"`
+	TrackingNumber  string             `db:"tracking_number"`
+	Status          string             `db:"status"`
+	StatusDetail    pgtype.Text        `db:"status_detail"`
+	LastCheckedAt   pgtype.Timestamptz `db:"last_checked_at"`
+	DeliveredAt     pgtype.Timestamptz `db:"delivered_at"`
+	OwnerNotifiedAt pgtype.Timestamptz `db:"owner_notified_at"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
+}
+
+// ReceivedItem is a purchased gift item as its owner sees it: the delivery status
+// of its latest shipment, without carrier or tracking details
+type ReceivedItem struct {
+	GiftItemID     pgtype.UUID        `db:"gift_item_id"`
+	Name           string             `db:"name"`
+	PurchasedAt    pgtype.Timestamptz `db:"purchased_at"`
+	ShipmentStatus pgtype.Text        `db:"shipment_status"` // Null when the purchaser added no shipment
+	DeliveredAt    pgtype.Timestamptz `db:"delivered_at"`
+	RevealDate     pgtype.Date        `db:"reveal_date"` // Latest occasion of the wishlists holding the item
+}
+
+// DeliveryNotice is a delivered shipment whose item owner can be told about it
+type DeliveryNotice struct {
+	ShipmentID pgtype.UUID `db:"shipment_id"`
+	GiftItemID pgtype.UUID `db:"gift_item_id"`
+	OwnerID    pgtype.UUID `db:"owner_id"`
+	ItemName   string      `db:"item_name"`
+}
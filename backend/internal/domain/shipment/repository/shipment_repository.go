@@ -0,0 +1,210 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_shipment_repository_test.go -pkg service . ShipmentRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/shipment/models"
+)
+
+const shipmentColumns = `id, gift_item_id, purchaser_user_id, carrier, tracking_number, status, status_detail,
+	last_checked_at, delivered_at, owner_notified_at, created_at, updated_at`
+
+// revealDate is the latest occasion date of the wishlists holding gi
+const revealDate = `(
+	SELECT MAX(w.occasion_date)
+	FROM wishlist_items wi
+	JOIN wishlists w ON w.id = wi.wishlist_id
+	WHERE wi.gift_item_id = gi.id
+) AS reveal_date`
+
+var (
+	// ErrShipmentNotFound is returned when the shipment does not exist or belongs to another user
+	ErrShipmentNotFound = errors.New("shipment not found")
+	// ErrShipmentExists is returned when the tracking number is already on the item
+	ErrShipmentExists = errors.New("shipment already exists")
+)
+
+// ShipmentRepositoryInterface defines the interface for shipment database operations
+type ShipmentRepositoryInterface interface {
+	Create(ctx context.Context, shipment models.Shipment) (*models.Shipment, error)
+	ListByItem(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Shipment, error)
+	Delete(ctx context.Context, id, purchaserID pgtype.UUID) error
+	ListDueForTracking(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.Shipment, error)
+	UpdateStatus(ctx context.Context, id pgtype.UUID, status, detail string, deliveredAt pgtype.Timestamptz) error
+	ListPendingNotices(ctx context.Context, revealedBefore time.Time, limit int) ([]*models.DeliveryNotice, error)
+	MarkOwnerNotified(ctx context.Context, id pgtype.UUID) error
+	ListReceived(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error)
+}
+
+type ShipmentRepository struct {
+	db *database.DB
+}
+
+func NewShipmentRepository(db *database.DB) ShipmentRepositoryInterface {
+	return &ShipmentRepository{
+		db: db,
+	}
+}
+
+// Create adds a shipment. It returns ErrShipmentExists when the same carrier and
+// tracking number are already on the item.
+func (r *ShipmentRepository) Create(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+	query := `
+		INSERT INTO shipments (gift_item_id, purchaser_user_id, carrier, tracking_number)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (gift_item_id, carrier, tracking_number) DO NOTHING
+		RETURNING ` + shipmentColumns
+
+	var created models.Shipment
+	err := r.db.QueryRowxContext(ctx, query,
+		shipment.GiftItemID, shipment.PurchaserUserID, shipment.Carrier, shipment.TrackingNumber,
+	).StructScan(&created)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrShipmentExists
+		}
+		return nil, fmt.Errorf("failed to create shipment: %w", err)
+	}
+
+	return &created, nil
+}
+
+// ListByItem returns the shipments of a gift item, oldest first
+func (r *ShipmentRepository) ListByItem(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Shipment, error) {
+	query := `SELECT ` + shipmentColumns + ` FROM shipments WHERE gift_item_id = $1 ORDER BY created_at ASC`
+
+	var shipments []*models.Shipment
+	if err := r.db.SelectContext(ctx, &shipments, query, giftItemID); err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+
+	return shipments, nil
+}
+
+// Delete removes one of the purchaser's shipments
+func (r *ShipmentRepository) Delete(ctx context.Context, id, purchaserID pgtype.UUID) error {
+	query := `DELETE FROM shipments WHERE id = $1 AND purchaser_user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, purchaserID)
+	if err != nil {
+		return fmt.Errorf("failed to delete shipment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrShipmentNotFound
+	}
+
+	return nil
+}
+
+// ListDueForTracking returns undelivered shipments not checked since checkedBefore,
+// least recently checked first
+func (r *ShipmentRepository) ListDueForTracking(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.Shipment, error) {
+	query := `
+		SELECT ` + shipmentColumns + `
+		FROM shipments
+		WHERE status <> 'delivered' AND (last_checked_at IS NULL OR last_checked_at < $1)
+		ORDER BY last_checked_at ASC NULLS FIRST
+		LIMIT $2
+	`
+
+	var shipments []*models.Shipment
+	if err := r.db.SelectContext(ctx, &shipments, query, checkedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list shipments due for tracking: %w", err)
+	}
+
+	return shipments, nil
+}
+
+// UpdateStatus records the result of a carrier check
+func (r *ShipmentRepository) UpdateStatus(ctx context.Context, id pgtype.UUID, status, detail string, deliveredAt pgtype.Timestamptz) error {
+	query := `
+		UPDATE shipments
+		SET status = $2, status_detail = NULLIF($3, ''), delivered_at = $4,
+			last_checked_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, status, detail, deliveredAt); err != nil {
+		return fmt.Errorf("failed to update shipment status: %w", err)
+	}
+
+	return nil
+}
+
+// ListPendingNotices returns delivered shipments whose item owner has not been
+// notified yet, oldest delivery first. Items on a wishlist whose occasion is on or
+// after revealedBefore are left out so the gift stays a surprise.
+func (r *ShipmentRepository) ListPendingNotices(ctx context.Context, revealedBefore time.Time, limit int) ([]*models.DeliveryNotice, error) {
+	query := `
+		SELECT s.id AS shipment_id, gi.id AS gift_item_id, gi.owner_id, gi.name AS item_name
+		FROM shipments s
+		JOIN gift_items gi ON gi.id = s.gift_item_id
+		WHERE s.status = 'delivered' AND s.owner_notified_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1
+				FROM wishlist_items wi
+				JOIN wishlists w ON w.id = wi.wishlist_id
+				WHERE wi.gift_item_id = gi.id AND w.occasion_date >= $1::date
+			)
+		ORDER BY s.delivered_at ASC
+		LIMIT $2
+	`
+
+	var notices []*models.DeliveryNotice
+	if err := r.db.SelectContext(ctx, &notices, query, revealedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list pending delivery notices: %w", err)
+	}
+
+	return notices, nil
+}
+
+// MarkOwnerNotified records that the item's owner was told about the delivery
+func (r *ShipmentRepository) MarkOwnerNotified(ctx context.Context, id pgtype.UUID) error {
+	query := `UPDATE shipments SET owner_notified_at = NOW(), updated_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark shipment owner notified: %w", err)
+	}
+
+	return nil
+}
+
+// ListReceived returns the owner's purchased gift items with the status of each
+// item's most recent shipment, most recently purchased first
+func (r *ShipmentRepository) ListReceived(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error) {
+	query := `
+		SELECT gi.id AS gift_item_id, gi.name, gi.purchased_at,
+			s.status AS shipment_status, s.delivered_at, ` + revealDate + `
+		FROM gift_items gi
+		LEFT JOIN LATERAL (
+			SELECT status, delivered_at
+			FROM shipments
+			WHERE gift_item_id = gi.id
+			ORDER BY created_at DESC
+			LIMIT 1
+		) s ON TRUE
+		WHERE gi.owner_id = $1 AND gi.purchased_by_user_id IS NOT NULL AND gi.archived_at IS NULL
+		ORDER BY gi.purchased_at DESC
+	`
+
+	var items []*models.ReceivedItem
+	if err := r.db.SelectContext(ctx, &items, query, ownerID); err != nil {
+		return nil, fmt.Errorf("failed to list received items: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,471 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/shipment/models"
+	"wish-list/internal/domain/shipment/repository"
+)
+
+// Ensure, that ShipmentRepositoryInterfaceMock does implement repository.ShipmentRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.ShipmentRepositoryInterface = &ShipmentRepositoryInterfaceMock{}
+
+// ShipmentRepositoryInterfaceMock is a mock implementation of repository.ShipmentRepositoryInterface.
+//
+//	func TestSomethingThatUsesShipmentRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.ShipmentRepositoryInterface
+//		mockedShipmentRepositoryInterface := &ShipmentRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id pgtype.UUID, purchaserID pgtype.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			ListByItemFunc: func(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Shipment, error) {
+//				panic("mock out the ListByItem method")
+//			},
+//			ListDueForTrackingFunc: func(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.Shipment, error) {
+//				panic("mock out the ListDueForTracking method")
+//			},
+//			ListPendingNoticesFunc: func(ctx context.Context, revealedBefore time.Time, limit int) ([]*models.DeliveryNotice, error) {
+//				panic("mock out the ListPendingNotices method")
+//			},
+//			ListReceivedFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error) {
+//				panic("mock out the ListReceived method")
+//			},
+//			MarkOwnerNotifiedFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the MarkOwnerNotified method")
+//			},
+//			UpdateStatusFunc: func(ctx context.Context, id pgtype.UUID, status string, detail string, deliveredAt pgtype.Timestamptz) error {
+//				panic("mock out the UpdateStatus method")
+//			},
+//		}
+//
+//		// use mockedShipmentRepositoryInterface in code that requires repository.ShipmentRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type ShipmentRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, shipment models.Shipment) (*models.Shipment, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id pgtype.UUID, purchaserID pgtype.UUID) error
+
+	// ListByItemFunc mocks the ListByItem method.
+	ListByItemFunc func(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Shipment, error)
+
+	// ListDueForTrackingFunc mocks the ListDueForTracking method.
+	ListDueForTrackingFunc func(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.Shipment, error)
+
+	// ListPendingNoticesFunc mocks the ListPendingNotices method.
+	ListPendingNoticesFunc func(ctx context.Context, revealedBefore time.Time, limit int) ([]*models.DeliveryNotice, error)
+
+	// ListReceivedFunc mocks the ListReceived method.
+	ListReceivedFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error)
+
+	// MarkOwnerNotifiedFunc mocks the MarkOwnerNotified method.
+	MarkOwnerNotifiedFunc func(ctx context.Context, id pgtype.UUID) error
+
+	// UpdateStatusFunc mocks the UpdateStatus method.
+	UpdateStatusFunc func(ctx context.Context, id pgtype.UUID, status string, detail string, deliveredAt pgtype.Timestamptz) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Shipment is the shipment argument value.
+			Shipment models.Shipment
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// PurchaserID is the purchaserID argument value.
+			PurchaserID pgtype.UUID
+		}
+		// ListByItem holds details about calls to the ListByItem method.
+		ListByItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+		// ListDueForTracking holds details about calls to the ListDueForTracking method.
+		ListDueForTracking []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CheckedBefore is the checkedBefore argument value.
+			CheckedBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListPendingNotices holds details about calls to the ListPendingNotices method.
+		ListPendingNotices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RevealedBefore is the revealedBefore argument value.
+			RevealedBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListReceived holds details about calls to the ListReceived method.
+		ListReceived []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+		}
+		// MarkOwnerNotified holds details about calls to the MarkOwnerNotified method.
+		MarkOwnerNotified []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// UpdateStatus holds details about calls to the UpdateStatus method.
+		UpdateStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Status is the status argument value.
+			Status string
+			// Detail is the detail argument value.
+			Detail string
+			// DeliveredAt is the deliveredAt argument value.
+			DeliveredAt pgtype.Timestamptz
+		}
+	}
+	lockCreate             sync.RWMutex
+	lockDelete             sync.RWMutex
+	lockListByItem         sync.RWMutex
+	lockListDueForTracking sync.RWMutex
+	lockListPendingNotices sync.RWMutex
+	lockListReceived       sync.RWMutex
+	lockMarkOwnerNotified  sync.RWMutex
+	lockUpdateStatus       sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *ShipmentRepositoryInterfaceMock) Create(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+	if mock.CreateFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.CreateFunc: method is nil but ShipmentRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Shipment models.Shipment
+	}{
+		Ctx:      ctx,
+		Shipment: shipment,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, shipment)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.CreateCalls())
+func (mock *ShipmentRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx      context.Context
+	Shipment models.Shipment
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Shipment models.Shipment
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ShipmentRepositoryInterfaceMock) Delete(ctx context.Context, id pgtype.UUID, purchaserID pgtype.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.DeleteFunc: method is nil but ShipmentRepositoryInterface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		PurchaserID pgtype.UUID
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		PurchaserID: purchaserID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id, purchaserID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.DeleteCalls())
+func (mock *ShipmentRepositoryInterfaceMock) DeleteCalls() []struct {
+	Ctx         context.Context
+	ID          pgtype.UUID
+	PurchaserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		PurchaserID pgtype.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// ListByItem calls ListByItemFunc.
+func (mock *ShipmentRepositoryInterfaceMock) ListByItem(ctx context.Context, giftItemID pgtype.UUID) ([]*models.Shipment, error) {
+	if mock.ListByItemFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.ListByItemFunc: method is nil but ShipmentRepositoryInterface.ListByItem was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		GiftItemID: giftItemID,
+	}
+	mock.lockListByItem.Lock()
+	mock.calls.ListByItem = append(mock.calls.ListByItem, callInfo)
+	mock.lockListByItem.Unlock()
+	return mock.ListByItemFunc(ctx, giftItemID)
+}
+
+// ListByItemCalls gets all the calls that were made to ListByItem.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.ListByItemCalls())
+func (mock *ShipmentRepositoryInterfaceMock) ListByItemCalls() []struct {
+	Ctx        context.Context
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GiftItemID pgtype.UUID
+	}
+	mock.lockListByItem.RLock()
+	calls = mock.calls.ListByItem
+	mock.lockListByItem.RUnlock()
+	return calls
+}
+
+// ListDueForTracking calls ListDueForTrackingFunc.
+func (mock *ShipmentRepositoryInterfaceMock) ListDueForTracking(ctx context.Context, checkedBefore time.Time, limit int) ([]*models.Shipment, error) {
+	if mock.ListDueForTrackingFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.ListDueForTrackingFunc: method is nil but ShipmentRepositoryInterface.ListDueForTracking was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}{
+		Ctx:           ctx,
+		CheckedBefore: checkedBefore,
+		Limit:         limit,
+	}
+	mock.lockListDueForTracking.Lock()
+	mock.calls.ListDueForTracking = append(mock.calls.ListDueForTracking, callInfo)
+	mock.lockListDueForTracking.Unlock()
+	return mock.ListDueForTrackingFunc(ctx, checkedBefore, limit)
+}
+
+// ListDueForTrackingCalls gets all the calls that were made to ListDueForTracking.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.ListDueForTrackingCalls())
+func (mock *ShipmentRepositoryInterfaceMock) ListDueForTrackingCalls() []struct {
+	Ctx           context.Context
+	CheckedBefore time.Time
+	Limit         int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}
+	mock.lockListDueForTracking.RLock()
+	calls = mock.calls.ListDueForTracking
+	mock.lockListDueForTracking.RUnlock()
+	return calls
+}
+
+// ListPendingNotices calls ListPendingNoticesFunc.
+func (mock *ShipmentRepositoryInterfaceMock) ListPendingNotices(ctx context.Context, revealedBefore time.Time, limit int) ([]*models.DeliveryNotice, error) {
+	if mock.ListPendingNoticesFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.ListPendingNoticesFunc: method is nil but ShipmentRepositoryInterface.ListPendingNotices was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RevealedBefore time.Time
+		Limit          int
+	}{
+		Ctx:            ctx,
+		RevealedBefore: revealedBefore,
+		Limit:          limit,
+	}
+	mock.lockListPendingNotices.Lock()
+	mock.calls.ListPendingNotices = append(mock.calls.ListPendingNotices, callInfo)
+	mock.lockListPendingNotices.Unlock()
+	return mock.ListPendingNoticesFunc(ctx, revealedBefore, limit)
+}
+
+// ListPendingNoticesCalls gets all the calls that were made to ListPendingNotices.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.ListPendingNoticesCalls())
+func (mock *ShipmentRepositoryInterfaceMock) ListPendingNoticesCalls() []struct {
+	Ctx            context.Context
+	RevealedBefore time.Time
+	Limit          int
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RevealedBefore time.Time
+		Limit          int
+	}
+	mock.lockListPendingNotices.RLock()
+	calls = mock.calls.ListPendingNotices
+	mock.lockListPendingNotices.RUnlock()
+	return calls
+}
+
+// ListReceived calls ListReceivedFunc.
+func (mock *ShipmentRepositoryInterfaceMock) ListReceived(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error) {
+	if mock.ListReceivedFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.ListReceivedFunc: method is nil but ShipmentRepositoryInterface.ListReceived was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockListReceived.Lock()
+	mock.calls.ListReceived = append(mock.calls.ListReceived, callInfo)
+	mock.lockListReceived.Unlock()
+	return mock.ListReceivedFunc(ctx, ownerID)
+}
+
+// ListReceivedCalls gets all the calls that were made to ListReceived.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.ListReceivedCalls())
+func (mock *ShipmentRepositoryInterfaceMock) ListReceivedCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+	}
+	mock.lockListReceived.RLock()
+	calls = mock.calls.ListReceived
+	mock.lockListReceived.RUnlock()
+	return calls
+}
+
+// MarkOwnerNotified calls MarkOwnerNotifiedFunc.
+func (mock *ShipmentRepositoryInterfaceMock) MarkOwnerNotified(ctx context.Context, id pgtype.UUID) error {
+	if mock.MarkOwnerNotifiedFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.MarkOwnerNotifiedFunc: method is nil but ShipmentRepositoryInterface.MarkOwnerNotified was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkOwnerNotified.Lock()
+	mock.calls.MarkOwnerNotified = append(mock.calls.MarkOwnerNotified, callInfo)
+	mock.lockMarkOwnerNotified.Unlock()
+	return mock.MarkOwnerNotifiedFunc(ctx, id)
+}
+
+// MarkOwnerNotifiedCalls gets all the calls that were made to MarkOwnerNotified.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.MarkOwnerNotifiedCalls())
+func (mock *ShipmentRepositoryInterfaceMock) MarkOwnerNotifiedCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockMarkOwnerNotified.RLock()
+	calls = mock.calls.MarkOwnerNotified
+	mock.lockMarkOwnerNotified.RUnlock()
+	return calls
+}
+
+// UpdateStatus calls UpdateStatusFunc.
+func (mock *ShipmentRepositoryInterfaceMock) UpdateStatus(ctx context.Context, id pgtype.UUID, status string, detail string, deliveredAt pgtype.Timestamptz) error {
+	if mock.UpdateStatusFunc == nil {
+		panic("ShipmentRepositoryInterfaceMock.UpdateStatusFunc: method is nil but ShipmentRepositoryInterface.UpdateStatus was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		Status      string
+		Detail      string
+		DeliveredAt pgtype.Timestamptz
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		Status:      status,
+		Detail:      detail,
+		DeliveredAt: deliveredAt,
+	}
+	mock.lockUpdateStatus.Lock()
+	mock.calls.UpdateStatus = append(mock.calls.UpdateStatus, callInfo)
+	mock.lockUpdateStatus.Unlock()
+	return mock.UpdateStatusFunc(ctx, id, status, detail, deliveredAt)
+}
+
+// UpdateStatusCalls gets all the calls that were made to UpdateStatus.
+// Check the length with:
+//
+//	len(mockedShipmentRepositoryInterface.UpdateStatusCalls())
+func (mock *ShipmentRepositoryInterfaceMock) UpdateStatusCalls() []struct {
+	Ctx         context.Context
+	ID          pgtype.UUID
+	Status      string
+	Detail      string
+	DeliveredAt pgtype.Timestamptz
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          pgtype.UUID
+		Status      string
+		Detail      string
+		DeliveredAt pgtype.Timestamptz
+	}
+	mock.lockUpdateStatus.RLock()
+	calls = mock.calls.UpdateStatus
+	mock.lockUpdateStatus.RUnlock()
+	return calls
+}
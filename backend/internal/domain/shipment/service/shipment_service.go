@@ -0,0 +1,240 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GiftItemRepositoryInterface CarrierCheckerInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/shipment/models"
+	"wish-list/internal/domain/shipment/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxTrackingNumberLength matches shipments.tracking_number
+const maxTrackingNumberLength = 100
+
+var (
+	ErrInvalidShipmentID    = errors.New("invalid shipment id")
+	ErrShipmentNotFound     = errors.New("shipment not found")
+	ErrShipmentExists       = errors.New("tracking number already added to this item")
+	ErrItemNotFound         = errors.New("gift item not found")
+	ErrItemNotPurchased     = errors.New("gift item has not been purchased")
+	ErrShipmentForbidden    = errors.New("only the purchaser can track the item's shipments")
+	ErrUnsupportedCarrier   = errors.New("carrier is not supported")
+	ErrInvalidTrackingInput = errors.New("tracking number is required and must be at most 100 characters")
+)
+
+// Cross-domain interfaces — only methods used by ShipmentService
+
+// GiftItemRepositoryInterface defines gift item methods needed by the shipment service
+type GiftItemRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error)
+}
+
+// CarrierCheckerInterface reports which carriers the tracking job can poll
+type CarrierCheckerInterface interface {
+	Supports(carrierCode string) bool
+}
+
+// ShipmentServiceInterface defines the interface for shipment operations
+type ShipmentServiceInterface interface {
+	AddShipment(ctx context.Context, userID pgtype.UUID, input AddShipmentInput) (*ShipmentOutput, error)
+	ListShipments(ctx context.Context, userID pgtype.UUID, itemID string) ([]*ShipmentOutput, error)
+	DeleteShipment(ctx context.Context, userID pgtype.UUID, shipmentID string) error
+	ListReceived(ctx context.Context, ownerID pgtype.UUID) ([]*ReceivedItemOutput, error)
+}
+
+type ShipmentService struct {
+	repo     repository.ShipmentRepositoryInterface
+	itemRepo GiftItemRepositoryInterface
+	carriers CarrierCheckerInterface
+}
+
+// NewShipmentService creates a new shipment service. carriers may be nil to accept
+// any carrier code; such shipments are stored but never polled.
+func NewShipmentService(
+	repo repository.ShipmentRepositoryInterface,
+	itemRepo GiftItemRepositoryInterface,
+	carriers CarrierCheckerInterface,
+) *ShipmentService {
+	return &ShipmentService{
+		repo:     repo,
+		itemRepo: itemRepo,
+		carriers: carriers,
+	}
+}
+
+// AddShipmentInput is a parcel the purchaser wants to track
+type AddShipmentInput struct {
+	GiftItemID     string
+	Carrier        string
+	TrackingNumber string
+}
+
+// ShipmentOutput is a shipment as shown to the purchaser
+type ShipmentOutput struct {
+	ID             pgtype.UUID
+	GiftItemID     pgtype.UUID
+	Carrier        string
+	TrackingNumber string
+	Status         string
+	StatusDetail   string
+	LastCheckedAt  pgtype.Timestamptz
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+// ReceivedItemOutput is a purchased item as shown to its owner. The delivery status
+// is left out while the gift is still a surprise.
+type ReceivedItemOutput struct {
+	GiftItemID     pgtype.UUID
+	Name           string
+	PurchasedAt    pgtype.Timestamptz
+	ShipmentStatus string
+	DeliveredAt    pgtype.Timestamptz
+}
+
+// AddShipment starts tracking a parcel for an item the user purchased
+func (s *ShipmentService) AddShipment(ctx context.Context, userID pgtype.UUID, input AddShipmentInput) (*ShipmentOutput, error) {
+	carrierCode := strings.ToLower(strings.TrimSpace(input.Carrier))
+	if carrierCode == "" || (s.carriers != nil && !s.carriers.Supports(carrierCode)) {
+		return nil, ErrUnsupportedCarrier
+	}
+
+	trackingNumber := strings.ToUpper(strings.Join(strings.Fields(input.TrackingNumber), ""))
+	if trackingNumber == "" || len(trackingNumber) > maxTrackingNumberLength {
+		return nil, ErrInvalidTrackingInput
+	}
+
+	item, err := s.getPurchasedItem(ctx, userID, input.GiftItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := s.repo.Create(ctx, models.Shipment{
+		GiftItemID:      item.ID,
+		PurchaserUserID: userID,
+		Carrier:         carrierCode,
+		TrackingNumber:  trackingNumber,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrShipmentExists) {
+			return nil, ErrShipmentExists
+		}
+		return nil, fmt.Errorf("failed to create shipment: %w", err)
+	}
+
+	return toShipmentOutput(shipment), nil
+}
+
+// ListShipments returns the shipments of an item the user purchased
+func (s *ShipmentService) ListShipments(ctx context.Context, userID pgtype.UUID, itemID string) ([]*ShipmentOutput, error) {
+	item, err := s.getPurchasedItem(ctx, userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	shipments, err := s.repo.ListByItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+
+	outputs := make([]*ShipmentOutput, 0, len(shipments))
+	for _, shipment := range shipments {
+		outputs = append(outputs, toShipmentOutput(shipment))
+	}
+	return outputs, nil
+}
+
+// DeleteShipment stops tracking one of the user's shipments
+func (s *ShipmentService) DeleteShipment(ctx context.Context, userID pgtype.UUID, shipmentID string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(shipmentID); err != nil {
+		return ErrInvalidShipmentID
+	}
+
+	if err := s.repo.Delete(ctx, id, userID); err != nil {
+		if errors.Is(err, repository.ErrShipmentNotFound) {
+			return ErrShipmentNotFound
+		}
+		return fmt.Errorf("failed to delete shipment: %w", err)
+	}
+
+	return nil
+}
+
+// ListReceived returns the owner's purchased items with their delivery status
+func (s *ShipmentService) ListReceived(ctx context.Context, ownerID pgtype.UUID) ([]*ReceivedItemOutput, error) {
+	items, err := s.repo.ListReceived(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list received items: %w", err)
+	}
+
+	now := time.Now()
+	outputs := make([]*ReceivedItemOutput, 0, len(items))
+	for _, item := range items {
+		output := &ReceivedItemOutput{
+			GiftItemID:  item.GiftItemID,
+			Name:        item.Name,
+			PurchasedAt: item.PurchasedAt,
+		}
+		if Revealed(item.RevealDate, now) {
+			output.ShipmentStatus = item.ShipmentStatus.String
+			output.DeliveredAt = item.DeliveredAt
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
+
+// Revealed reports whether delivery news about a gift may reach its owner at now:
+// the day after the latest occasion of the wishlists holding it, or right away when
+// none of them has an occasion date
+func Revealed(revealDate pgtype.Date, now time.Time) bool {
+	if !revealDate.Valid {
+		return true
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return revealDate.Time.Before(today)
+}
+
+// getPurchasedItem loads an item and checks userID purchased it
+func (s *ShipmentService) getPurchasedItem(ctx context.Context, userID pgtype.UUID, itemID string) (*itemmodels.GiftItem, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(itemID); err != nil {
+		return nil, ErrItemNotFound
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrItemNotFound
+	}
+	if !item.PurchasedByUserID.Valid {
+		return nil, ErrItemNotPurchased
+	}
+	if item.PurchasedByUserID != userID {
+		return nil, ErrShipmentForbidden
+	}
+
+	return item, nil
+}
+
+func toShipmentOutput(shipment *models.Shipment) *ShipmentOutput {
+	return &ShipmentOutput{
+		ID:             shipment.ID,
+		GiftItemID:     shipment.GiftItemID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         shipment.Status,
+		StatusDetail:   shipment.StatusDetail.String,
+		LastCheckedAt:  shipment.LastCheckedAt,
+		DeliveredAt:    shipment.DeliveredAt,
+		CreatedAt:      shipment.CreatedAt,
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/shipment/models"
+	"wish-list/internal/domain/shipment/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testItemID = "00000000-0000-0000-0000-0000000000a1"
+
+var (
+	testPurchaserID = pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	testOwnerID     = pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+)
+
+func purchasedItemRepo(t *testing.T, purchaserID pgtype.UUID) *GiftItemRepositoryInterfaceMock {
+	t.Helper()
+
+	item := &itemmodels.GiftItem{OwnerID: testOwnerID, PurchasedByUserID: purchaserID}
+	require.NoError(t, item.ID.Scan(testItemID))
+	return &GiftItemRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error) {
+			return item, nil
+		},
+	}
+}
+
+func supportedCarriers(codes ...string) *CarrierCheckerInterfaceMock {
+	return &CarrierCheckerInterfaceMock{
+		SupportsFunc: func(carrierCode string) bool {
+			for _, code := range codes {
+				if code == carrierCode {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func TestShipmentService_AddShipment(t *testing.T) {
+	t.Run("purchaser adds a normalized tracking number", func(t *testing.T) {
+		repo := &ShipmentRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+				shipment.Status = models.StatusPending
+				return &shipment, nil
+			},
+		}
+		svc := NewShipmentService(repo, purchasedItemRepo(t, testPurchaserID), supportedCarriers("ups"))
+
+		out, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{
+			GiftItemID:     testItemID,
+			Carrier:        " UPS ",
+			TrackingNumber: "1z 999 aa1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ups", out.Carrier)
+		assert.Equal(t, "1Z999AA1", out.TrackingNumber)
+		assert.Equal(t, models.StatusPending, out.Status)
+		assert.Equal(t, testPurchaserID, repo.CreateCalls()[0].Shipment.PurchaserUserID)
+	})
+
+	t.Run("unsupported carrier", func(t *testing.T) {
+		svc := NewShipmentService(&ShipmentRepositoryInterfaceMock{}, purchasedItemRepo(t, testPurchaserID), supportedCarriers("ups"))
+
+		_, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{GiftItemID: testItemID, Carrier: "pigeon", TrackingNumber: "1"})
+		assert.ErrorIs(t, err, ErrUnsupportedCarrier)
+	})
+
+	t.Run("any carrier without a checker", func(t *testing.T) {
+		repo := &ShipmentRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+				return &shipment, nil
+			},
+		}
+		svc := NewShipmentService(repo, purchasedItemRepo(t, testPurchaserID), nil)
+
+		_, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{GiftItemID: testItemID, Carrier: "pigeon", TrackingNumber: "1"})
+		require.NoError(t, err)
+	})
+
+	t.Run("only the purchaser can add a shipment", func(t *testing.T) {
+		svc := NewShipmentService(&ShipmentRepositoryInterfaceMock{}, purchasedItemRepo(t, testPurchaserID), nil)
+
+		_, err := svc.AddShipment(context.Background(), testOwnerID, AddShipmentInput{GiftItemID: testItemID, Carrier: "ups", TrackingNumber: "1"})
+		assert.ErrorIs(t, err, ErrShipmentForbidden)
+	})
+
+	t.Run("item must be purchased", func(t *testing.T) {
+		svc := NewShipmentService(&ShipmentRepositoryInterfaceMock{}, purchasedItemRepo(t, pgtype.UUID{}), nil)
+
+		_, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{GiftItemID: testItemID, Carrier: "ups", TrackingNumber: "1"})
+		assert.ErrorIs(t, err, ErrItemNotPurchased)
+	})
+
+	t.Run("tracking number required", func(t *testing.T) {
+		svc := NewShipmentService(&ShipmentRepositoryInterfaceMock{}, purchasedItemRepo(t, testPurchaserID), nil)
+
+		_, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{GiftItemID: testItemID, Carrier: "ups", TrackingNumber: "  "})
+		assert.ErrorIs(t, err, ErrInvalidTrackingInput)
+	})
+
+	t.Run("duplicate tracking number", func(t *testing.T) {
+		repo := &ShipmentRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, shipment models.Shipment) (*models.Shipment, error) {
+				return nil, repository.ErrShipmentExists
+			},
+		}
+		svc := NewShipmentService(repo, purchasedItemRepo(t, testPurchaserID), nil)
+
+		_, err := svc.AddShipment(context.Background(), testPurchaserID, AddShipmentInput{GiftItemID: testItemID, Carrier: "ups", TrackingNumber: "1"})
+		assert.ErrorIs(t, err, ErrShipmentExists)
+	})
+}
+
+func TestShipmentService_DeleteShipment(t *testing.T) {
+	svc := NewShipmentService(&ShipmentRepositoryInterfaceMock{}, &GiftItemRepositoryInterfaceMock{}, nil)
+	assert.ErrorIs(t, svc.DeleteShipment(context.Background(), testPurchaserID, "nope"), ErrInvalidShipmentID)
+
+	repo := &ShipmentRepositoryInterfaceMock{
+		DeleteFunc: func(ctx context.Context, id, purchaserID pgtype.UUID) error {
+			return repository.ErrShipmentNotFound
+		},
+	}
+	svc = NewShipmentService(repo, &GiftItemRepositoryInterfaceMock{}, nil)
+	assert.ErrorIs(t, svc.DeleteShipment(context.Background(), testPurchaserID, testItemID), ErrShipmentNotFound)
+}
+
+func TestShipmentService_ListReceived_HidesStatusUntilReveal(t *testing.T) {
+	delivered := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	repo := &ShipmentRepositoryInterfaceMock{
+		ListReceivedFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.ReceivedItem, error) {
+			return []*models.ReceivedItem{
+				{
+					Name:           "No occasion",
+					ShipmentStatus: pgtype.Text{String: models.StatusDelivered, Valid: true},
+					DeliveredAt:    delivered,
+				},
+				{
+					Name:           "Upcoming birthday",
+					ShipmentStatus: pgtype.Text{String: models.StatusDelivered, Valid: true},
+					DeliveredAt:    delivered,
+					RevealDate:     pgtype.Date{Time: time.Now().AddDate(0, 1, 0), Valid: true},
+				},
+			}, nil
+		},
+	}
+	svc := NewShipmentService(repo, &GiftItemRepositoryInterfaceMock{}, nil)
+
+	items, err := svc.ListReceived(context.Background(), testOwnerID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, models.StatusDelivered, items[0].ShipmentStatus)
+	assert.True(t, items[0].DeliveredAt.Valid)
+	assert.Empty(t, items[1].ShipmentStatus)
+	assert.False(t, items[1].DeliveredAt.Valid)
+}
+
+func TestRevealed(t *testing.T) {
+	now := time.Date(2026, 12, 25, 18, 0, 0, 0, time.UTC)
+
+	assert.True(t, Revealed(pgtype.Date{}, now), "no occasion date")
+	assert.False(t, Revealed(pgtype.Date{Time: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Valid: true}, now), "occasion day itself")
+	assert.True(t, Revealed(pgtype.Date{Time: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC), Valid: true}, now))
+}
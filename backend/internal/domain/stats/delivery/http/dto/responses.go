@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"time"
+
+	"wish-list/internal/domain/stats/service"
+)
+
+type StatsResponse struct {
+	WishLists      int     `json:"wishlists"`
+	Items          int     `json:"items"`           // Items on the user's lists, archived ones left out
+	TotalValue     float64 `json:"total_value"`     // Sum of price × quantity of those items
+	ItemsReserved  int     `json:"items_reserved"`  // Own items someone reserved that are not purchased yet
+	ItemsPurchased int     `json:"items_purchased"` // Own items marked as purchased
+	Reservations   int     `json:"reservations"`    // Active or fulfilled reservations made on other people's lists
+	Spend          float64 `json:"spend"`           // What the user paid for gifts for others
+	ComputedAt     string  `json:"computed_at" validate:"required"`
+}
+
+func FromStatsOutput(s *service.StatsOutput) StatsResponse {
+	return StatsResponse{
+		WishLists:      s.WishLists,
+		Items:          s.Items,
+		TotalValue:     s.TotalValue,
+		ItemsReserved:  s.ItemsReserved,
+		ItemsPurchased: s.ItemsPurchased,
+		Reservations:   s.Reservations,
+		Spend:          s.Spend,
+		ComputedAt:     s.ComputedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+type ActivityResponse struct {
+	Since         string                  `json:"since" validate:"required"` // First day of the period (YYYY-MM-DD, UTC)
+	Days          []DailyActivityResponse `json:"days" validate:"required"`
+	ActiveUsers   ActiveUsersResponse     `json:"active_users" validate:"required"`
+	CancelReasons []CancelReasonResponse  `json:"cancel_reasons" validate:"required"` // Cancellations over the period by reason, most common first
+}
+
+type DailyActivityResponse struct {
+	Day           string `json:"day" validate:"required"`
+	Signups       int    `json:"signups"`
+	Reservations  int    `json:"reservations"`  // Reservations made that day, including ones canceled later
+	Cancellations int    `json:"cancellations"` // Reservations canceled that day
+	Expirations   int    `json:"expirations"`   // Guest reservations that expired that day
+}
+
+// ActiveUsersResponse counts accounts by their last sign-in. Only the last sign-in
+// is stored, so these are current figures with no history.
+type ActiveUsersResponse struct {
+	Day   int `json:"day"`
+	Week  int `json:"week"`
+	Month int `json:"month"`
+}
+
+type TopWishlistResponse struct {
+	ID         string `json:"id" validate:"required"`
+	Title      string `json:"title" validate:"required"`
+	PublicSlug string `json:"public_slug" validate:"required"`
+	Views      int    `json:"views"` // Page views over the period
+}
+
+type TopWishlistsResponse struct {
+	Wishlists []TopWishlistResponse `json:"wishlists" validate:"required"`
+}
+
+type BacklogResponse struct {
+	PendingReports         int `json:"pending_reports"`          // Moderation reports waiting for review
+	PendingWebhooks        int `json:"pending_webhooks"`         // Partner webhook deliveries not sent yet
+	FailedWebhooks         int `json:"failed_webhooks"`          // Partner webhook deliveries that ran out of retries
+	FailedDataExports      int `json:"failed_data_exports"`      // Data exports that could not be built
+	FailedReservationLinks int `json:"failed_reservation_links"` // Accounts whose guest reservations could not be linked
+}
+
+// ItemInsightsResponse is the public interest in the owner's items. It holds
+// counts only; nothing says who viewed or reserved an item.
+type ItemInsightsResponse struct {
+	Since         string                 `json:"since" validate:"required"` // First day of the period (YYYY-MM-DD, UTC)
+	Items         []ItemInsightResponse  `json:"items" validate:"required"`
+	CancelReasons []CancelReasonResponse `json:"cancel_reasons" validate:"required"` // Why givers canceled on the owner's items, most common first
+}
+
+// CancelReasonResponse counts the cancellations that gave one reason
+type CancelReasonResponse struct {
+	Reason string `json:"reason" validate:"required" enums:"changed_mind,bought_elsewhere,too_expensive,other,item_received,unspecified"`
+	Count  int    `json:"count"`
+}
+
+type ItemInsightResponse struct {
+	GiftItemID          string `json:"gift_item_id" validate:"required"`
+	Name                string `json:"name" validate:"required"`
+	Views               int    `json:"views"`                               // Times the item was listed on its public wishlist
+	ReservationAttempts int    `json:"reservation_attempts"`                // Reservation requests, including ones that failed
+	Reservations        int    `json:"reservations"`                        // Reservations made, including ones canceled later
+	MedianTimeToReserve *int64 `json:"median_seconds_to_reserve,omitempty"` // From adding the item to being reserved
+}
+
+func FromActivityOutput(a *service.ActivityOutput) ActivityResponse {
+	days := make([]DailyActivityResponse, 0, len(a.Days))
+	for _, day := range a.Days {
+		days = append(days, DailyActivityResponse{
+			Day:           day.Day.Format(time.DateOnly),
+			Signups:       day.Signups,
+			Reservations:  day.Reservations,
+			Cancellations: day.Cancellations,
+			Expirations:   day.Expirations,
+		})
+	}
+
+	return ActivityResponse{
+		Since: a.Since.Format(time.DateOnly),
+		Days:  days,
+		ActiveUsers: ActiveUsersResponse{
+			Day:   a.ActiveUsers.Day,
+			Week:  a.ActiveUsers.Week,
+			Month: a.ActiveUsers.Month,
+		},
+		CancelReasons: fromCancelReasonOutputs(a.CancelReasons),
+	}
+}
+
+func FromTopWishlistOutputs(wishlists []*service.TopWishlistOutput) TopWishlistsResponse {
+	response := TopWishlistsResponse{Wishlists: make([]TopWishlistResponse, 0, len(wishlists))}
+	for _, wishlist := range wishlists {
+		response.Wishlists = append(response.Wishlists, TopWishlistResponse{
+			ID:         wishlist.ID.String(),
+			Title:      wishlist.Title,
+			PublicSlug: wishlist.PublicSlug,
+			Views:      wishlist.Views,
+		})
+	}
+	return response
+}
+
+func FromBacklogOutput(b *service.BacklogOutput) BacklogResponse {
+	return BacklogResponse{
+		PendingReports:         b.PendingReports,
+		PendingWebhooks:        b.PendingWebhooks,
+		FailedWebhooks:         b.FailedWebhooks,
+		FailedDataExports:      b.FailedDataExports,
+		FailedReservationLinks: b.FailedReservationLinks,
+	}
+}
+
+func FromItemInsightsOutput(o *service.ItemInsightsOutput) ItemInsightsResponse {
+	items := make([]ItemInsightResponse, 0, len(o.Items))
+	for _, item := range o.Items {
+		response := ItemInsightResponse{
+			GiftItemID:          item.GiftItemID.String(),
+			Name:                item.Name,
+			Views:               item.Views,
+			ReservationAttempts: item.ReservationAttempts,
+			Reservations:        item.Reservations,
+		}
+		if item.MedianTimeToReserve != nil {
+			seconds := int64(item.MedianTimeToReserve.Seconds())
+			response.MedianTimeToReserve = &seconds
+		}
+		items = append(items, response)
+	}
+	return ItemInsightsResponse{
+		Since:         o.Since.Format(time.DateOnly),
+		Items:         items,
+		CancelReasons: fromCancelReasonOutputs(o.CancelReasons),
+	}
+}
+
+func fromCancelReasonOutputs(reasons []service.CancelReasonOutput) []CancelReasonResponse {
+	responses := make([]CancelReasonResponse, 0, len(reasons))
+	for _, reason := range reasons {
+		responses = append(responses, CancelReasonResponse{Reason: reason.Reason, Count: reason.Count})
+	}
+	return responses
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"errors"
+
+	"wish-list/internal/domain/stats/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapStatsServiceError converts stats service errors to AppErrors
+func mapStatsServiceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPeriod):
+		return apperrors.BadRequest("Period must be between 1 and 90 days")
+	case errors.Is(err, service.ErrInvalidLimit):
+		return apperrors.BadRequest("Limit must be between 1 and 50")
+	default:
+		return apperrors.Internal("Failed to get statistics").Wrap(err)
+	}
+}
@@ -0,0 +1,178 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/stats/delivery/http/dto"
+	"wish-list/internal/domain/stats/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for user statistics and the admin metrics
+type Handler struct {
+	service service.StatsServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.StatsServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// GetStats godoc
+//
+//	@Summary		Get profile statistics
+//	@Description	Totals for the current user's dashboard: wish lists, items and their value, how many of them were reserved or purchased, reservations made for others and what was spent on them. Values are cached for up to a minute.
+//	@Tags			User
+//	@Produce		json
+//	@Success		200	{object}	dto.StatsResponse	"User statistics"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/stats [get]
+func (h *Handler) GetStats(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	stats, err := h.service.GetUserStats(c.Request().Context(), userID)
+	if err != nil {
+		return mapStatsServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromStatsOutput(stats))
+}
+
+// GetItemInsights godoc
+//
+//	@Summary		Get item insights
+//	@Description	How much interest the current user's items drew over the last days, today included: public views, reservation attempts (failed ones included), reservations and the median time from adding an item to its reservation. Only counts are returned, so nothing reveals who viewed or reserved an item. Items without activity in the period are left out. Cancellations on the user's items are counted by the reason the giver picked, if any.
+//	@Tags			User
+//	@Produce		json
+//	@Param			days	query		int							false	"Period in days (default 30, max 90)"
+//	@Success		200		{object}	dto.ItemInsightsResponse	"Item insights"
+//	@Failure		400		{object}	map[string]string			"Invalid period"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/protected/stats/items [get]
+func (h *Handler) GetItemInsights(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+	days, err := queryInt(c, "days", "Period must be between 1 and 90 days")
+	if err != nil {
+		return err
+	}
+
+	insights, err := h.service.GetItemInsights(c.Request().Context(), userID, days)
+	if err != nil {
+		return mapStatsServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromItemInsightsOutput(insights))
+}
+
+// GetActivity godoc
+//
+//	@Summary		Get site activity
+//	@Description	Signups, reservations, cancellations and expirations per day (UTC) over the last days, today included, how many users signed in within the last day, week and month, and the period's cancellations by the reason givers picked.
+//	@Tags			Admin Metrics
+//	@Produce		json
+//	@Param			days	query		int						false	"Period in days (default 30, max 90)"
+//	@Success		200		{object}	dto.ActivityResponse	"Activity"
+//	@Failure		400		{object}	map[string]string		"Invalid period"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		403		{object}	map[string]string		"Admins only"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/metrics/activity [get]
+func (h *Handler) GetActivity(c echo.Context) error {
+	days, err := queryInt(c, "days", "Period must be between 1 and 90 days")
+	if err != nil {
+		return err
+	}
+
+	activity, err := h.service.GetActivity(c.Request().Context(), days)
+	if err != nil {
+		return mapStatsServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromActivityOutput(activity))
+}
+
+// ListTopWishlists godoc
+//
+//	@Summary		List the most viewed wishlists
+//	@Description	Public wishlists ranked by page views over the last days, today included. Wishlists that are no longer public are left out.
+//	@Tags			Admin Metrics
+//	@Produce		json
+//	@Param			days	query		int							false	"Period in days (default 30, max 90)"
+//	@Param			limit	query		int							false	"Number of wishlists (default 10, max 50)"
+//	@Success		200		{object}	dto.TopWishlistsResponse	"Top wishlists"
+//	@Failure		400		{object}	map[string]string			"Invalid period or limit"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Admins only"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/metrics/top-wishlists [get]
+func (h *Handler) ListTopWishlists(c echo.Context) error {
+	days, err := queryInt(c, "days", "Period must be between 1 and 90 days")
+	if err != nil {
+		return err
+	}
+	limit, err := queryInt(c, "limit", "Limit must be between 1 and 50")
+	if err != nil {
+		return err
+	}
+
+	wishlists, err := h.service.ListTopWishlists(c.Request().Context(), days, limit)
+	if err != nil {
+		return mapStatsServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromTopWishlistOutputs(wishlists))
+}
+
+// GetBacklog godoc
+//
+//	@Summary		Get the admin backlog
+//	@Description	Moderation reports waiting for review, partner webhook deliveries still queued or out of retries, and failed data exports. Emails are not queued, so their failures only show in the logs.
+//	@Tags			Admin Metrics
+//	@Produce		json
+//	@Success		200	{object}	dto.BacklogResponse	"Backlog"
+//	@Failure		401	{object}	map[string]string	"Unauthorized"
+//	@Failure		403	{object}	map[string]string	"Admins only"
+//	@Failure		500	{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/metrics/backlog [get]
+func (h *Handler) GetBacklog(c echo.Context) error {
+	backlog, err := h.service.GetBacklog(c.Request().Context())
+	if err != nil {
+		return mapStatsServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromBacklogOutput(backlog))
+}
+
+// queryInt reads an optional integer query parameter; 0 when it is missing
+func queryInt(c echo.Context, name, message string) (int, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, apperrors.BadRequest(message)
+	}
+	return value, nil
+}
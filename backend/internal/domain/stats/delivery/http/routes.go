@@ -0,0 +1,19 @@
+package http
+
+import (
+	"wish-list/internal/pkg/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes registers user statistics, item insights and admin metrics HTTP routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected", authMiddleware)
+	protected.GET("/stats", h.GetStats)
+	protected.GET("/stats/items", h.GetItemInsights)
+
+	admin := e.Group("/api/admin/metrics", authMiddleware, auth.RequireUserType("admin"))
+	admin.GET("/activity", h.GetActivity)
+	admin.GET("/top-wishlists", h.ListTopWishlists)
+	admin.GET("/backlog", h.GetBacklog)
+}
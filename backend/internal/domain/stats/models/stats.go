@@ -0,0 +1,68 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UserStats are the totals shown on a user's profile dashboard
+type UserStats struct {
+	WishLists      int            `db:"wishlists"`
+	Items          int            `db:"items"`           // Unarchived items the user owns
+	TotalValue     pgtype.Numeric `db:"total_value"`     // Sum of price × quantity of those items
+	ItemsReserved  int            `db:"items_reserved"`  // Own items reserved by someone but not purchased yet
+	ItemsPurchased int            `db:"items_purchased"` // Own items marked as purchased
+	Reservations   int            `db:"reservations"`    // Active or fulfilled reservations the user made on other people's items
+	Spend          pgtype.Numeric `db:"spend"`           // What the user paid for other people's items
+}
+
+// DailyActivity is what happened across the site on one day
+type DailyActivity struct {
+	Day           pgtype.Date `db:"day"`
+	Signups       int         `db:"signups"`
+	Reservations  int         `db:"reservations"`  // Reservations made that day, canceled ones included
+	Cancellations int         `db:"cancellations"` // Reservations canceled that day
+	Expirations   int         `db:"expirations"`   // Guest reservations that expired that day
+}
+
+// ActiveUsers counts the users who signed in recently. Only the last sign-in is
+// stored, so these are current figures rather than a history.
+type ActiveUsers struct {
+	Day   int `db:"day"`   // Signed in within the last 24 hours
+	Week  int `db:"week"`  // ... the last 7 days
+	Month int `db:"month"` // ... the last 30 days
+}
+
+// TopWishlist is a public wishlist ranked by its page views over a period
+type TopWishlist struct {
+	ID         pgtype.UUID `db:"id"`
+	Title      string      `db:"title"`
+	PublicSlug pgtype.Text `db:"public_slug"`
+	Views      int         `db:"views"`
+}
+
+// Backlog counts the work waiting for admins and the deliveries that gave up
+type Backlog struct {
+	PendingReports         int `db:"pending_reports"`
+	PendingWebhooks        int `db:"pending_webhooks"`
+	FailedWebhooks         int `db:"failed_webhooks"` // Partner webhook deliveries out of retries
+	FailedDataExports      int `db:"failed_data_exports"`
+	FailedReservationLinks int `db:"failed_reservation_links"` // Guest reservation linking out of retries
+}
+
+// ItemInsight is the public interest in one of the owner's items over a period.
+// It counts activity only and never says who viewed or reserved the item.
+type ItemInsight struct {
+	GiftItemID          pgtype.UUID `db:"gift_item_id"`
+	Name                string      `db:"name"`
+	Views               int         `db:"views"`
+	ReservationAttempts int         `db:"reservation_attempts"`
+	Reservations        int         `db:"reservations"`              // Reservations made in the period, canceled ones included
+	MedianTimeToReserve pgtype.Int8 `db:"median_seconds_to_reserve"` // From adding the item to its reservations, in seconds; NULL without one
+}
+
+// CancelReasonCount is how many cancellations gave a reason. Cancellations
+// without one count as "unspecified".
+type CancelReasonCount struct {
+	Reason string `db:"reason"`
+	Count  int    `db:"count"`
+}
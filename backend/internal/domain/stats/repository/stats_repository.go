@@ -0,0 +1,307 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_stats_repository_test.go -pkg service . StatsRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/stats/models"
+)
+
+// StatsRepositoryInterface defines the interface for user statistics database operations
+type StatsRepositoryInterface interface {
+	GetUserStats(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error)
+	ListDailyActivity(ctx context.Context, since time.Time) ([]*models.DailyActivity, error)
+	GetActiveUsers(ctx context.Context) (*models.ActiveUsers, error)
+	ListTopWishlists(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error)
+	GetBacklog(ctx context.Context) (*models.Backlog, error)
+	RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error
+	RecordReservationAttempt(ctx context.Context, itemID pgtype.UUID) error
+	ListItemInsights(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error)
+	ListCancelReasons(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error)
+}
+
+type StatsRepository struct {
+	db *database.DB
+}
+
+func NewStatsRepository(db *database.DB) StatsRepositoryInterface {
+	return &StatsRepository{
+		db: db,
+	}
+}
+
+// GetUserStats aggregates the user's totals in a single round trip.
+// An item counts as reserved the same way the public list shows it: a manual
+// reservation, a legacy reserved_by_user_id or an active reservation.
+func (r *StatsRepository) GetUserStats(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM wishlists WHERE owner_id = $1) AS wishlists,
+			own.items,
+			own.total_value,
+			own.items_reserved,
+			own.items_purchased,
+			given.reservations,
+			spent.spend
+		FROM (
+			SELECT
+				COUNT(*) AS items,
+				COALESCE(SUM(gi.price * gi.quantity), 0) AS total_value,
+				COUNT(*) FILTER (
+					WHERE gi.purchased_at IS NULL AND (
+						gi.manual_reserved_at IS NOT NULL
+						OR gi.reserved_by_user_id IS NOT NULL
+						OR gi.reserved_quantity > 0
+					)
+				) AS items_reserved,
+				COUNT(*) FILTER (WHERE gi.purchased_at IS NOT NULL) AS items_purchased
+			FROM gift_items gi
+			WHERE gi.owner_id = $1 AND gi.archived_at IS NULL
+		) own
+		CROSS JOIN (
+			SELECT COUNT(*) AS reservations
+			FROM reservations res
+			JOIN gift_items gi ON gi.id = res.gift_item_id
+			WHERE res.reserved_by_user_id = $1
+				AND res.status IN ('active', 'fulfilled')
+				AND gi.owner_id <> $1
+		) given
+		CROSS JOIN (
+			-- Items purchased before purchased_price was recorded fall back to the list price
+			SELECT COALESCE(SUM(COALESCE(gi.purchased_price, gi.price)), 0) AS spend
+			FROM gift_items gi
+			WHERE gi.purchased_by_user_id = $1 AND gi.owner_id <> $1
+		) spent
+	`
+
+	var stats models.UserStats
+	if err := r.db.GetContext(ctx, &stats, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+
+	return &stats, nil
+}
+
+// ListDailyActivity returns site-wide signups, reservations, cancellations and
+// expirations for every day from since through today, oldest first. Days without
+// activity are included as zeros.
+func (r *StatsRepository) ListDailyActivity(ctx context.Context, since time.Time) ([]*models.DailyActivity, error) {
+	query := `
+		SELECT
+			d.day::date AS day,
+			COALESCE(s.signups, 0) AS signups,
+			COALESCE(res.reservations, 0) AS reservations,
+			COALESCE(ev.cancellations, 0) AS cancellations,
+			COALESCE(ev.expirations, 0) AS expirations
+		FROM generate_series($1::date, CURRENT_DATE, INTERVAL '1 day') AS d(day)
+		LEFT JOIN (
+			SELECT created_at::date AS day, COUNT(*) AS signups
+			FROM users
+			WHERE created_at >= $1::date
+			GROUP BY 1
+		) s ON s.day = d.day::date
+		LEFT JOIN (
+			SELECT reserved_at::date AS day, COUNT(*) AS reservations
+			FROM reservations
+			WHERE reserved_at >= $1::date
+			GROUP BY 1
+		) res ON res.day = d.day::date
+		LEFT JOIN (
+			SELECT occurred_at::date AS day,
+				COUNT(*) FILTER (WHERE event_type = 'canceled') AS cancellations,
+				COUNT(*) FILTER (WHERE event_type = 'expired') AS expirations
+			FROM reservation_events
+			WHERE occurred_at >= $1::date
+			GROUP BY 1
+		) ev ON ev.day = d.day::date
+		ORDER BY d.day
+	`
+
+	var days []*models.DailyActivity
+	if err := r.db.SelectContext(ctx, &days, query, since.UTC().Format(time.DateOnly)); err != nil {
+		return nil, fmt.Errorf("failed to list daily activity: %w", err)
+	}
+
+	return days, nil
+}
+
+// GetActiveUsers counts the accounts that signed in within the last day, week and
+// month. Deactivated accounts are left out.
+func (r *StatsRepository) GetActiveUsers(ctx context.Context) (*models.ActiveUsers, error) {
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE last_login_at >= NOW() - INTERVAL '1 day') AS day,
+			COUNT(*) FILTER (WHERE last_login_at >= NOW() - INTERVAL '7 days') AS week,
+			COUNT(*) AS month
+		FROM users
+		WHERE last_login_at >= NOW() - INTERVAL '30 days' AND deactivated_at IS NULL
+	`
+
+	var active models.ActiveUsers
+	if err := r.db.GetContext(ctx, &active, query); err != nil {
+		return nil, fmt.Errorf("failed to count active users: %w", err)
+	}
+
+	return &active, nil
+}
+
+// ListTopWishlists ranks the wishlists that are public now by their page views
+// since the given day, most viewed first
+func (r *StatsRepository) ListTopWishlists(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error) {
+	query := `
+		SELECT w.id, w.title, w.public_slug, SUM(v.views) AS views
+		FROM wishlist_daily_views v
+		JOIN wishlists w ON w.id = v.wishlist_id
+		WHERE v.day >= $1::date AND w.is_public = true
+		GROUP BY w.id
+		ORDER BY views DESC, w.id
+		LIMIT $2
+	`
+
+	var wishlists []*models.TopWishlist
+	if err := r.db.SelectContext(ctx, &wishlists, query, since.UTC().Format(time.DateOnly), limit); err != nil {
+		return nil, fmt.Errorf("failed to list top wishlists: %w", err)
+	}
+
+	return wishlists, nil
+}
+
+// GetBacklog counts pending moderation reports and queued or failed background work
+func (r *StatsRepository) GetBacklog(ctx context.Context) (*models.Backlog, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM wishlist_reports WHERE status = 'pending') AS pending_reports,
+			(SELECT COUNT(*) FROM partner_webhook_deliveries
+				WHERE delivered_at IS NULL AND failed_at IS NULL) AS pending_webhooks,
+			(SELECT COUNT(*) FROM partner_webhook_deliveries WHERE failed_at IS NOT NULL) AS failed_webhooks,
+			(SELECT COUNT(*) FROM data_exports WHERE status = 'failed') AS failed_data_exports,
+			(SELECT COUNT(*) FROM guest_reservation_links
+				WHERE failed_at IS NOT NULL AND completed_at IS NULL) AS failed_reservation_links
+	`
+
+	var backlog models.Backlog
+	if err := r.db.GetContext(ctx, &backlog, query); err != nil {
+		return nil, fmt.Errorf("failed to get backlog: %w", err)
+	}
+
+	return &backlog, nil
+}
+
+// RecordItemViews adds one view to today's entry of each item. Unknown ids are
+// ignored, so a deleted item never fails the page that listed it.
+func (r *StatsRepository) RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+
+	itemIDStrings := make([]string, len(itemIDs))
+	for i, id := range itemIDs {
+		itemIDStrings[i] = id.String()
+	}
+
+	query, args, err := sqlx.In(
+		`INSERT INTO gift_item_daily_stats (gift_item_id, day, views)
+		 SELECT id, CURRENT_DATE, 1 FROM gift_items WHERE id::text IN (?)
+		 ON CONFLICT (gift_item_id, day) DO UPDATE SET views = gift_item_daily_stats.views + 1`,
+		itemIDStrings,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to build item views query: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to record item views: %w", err)
+	}
+
+	return nil
+}
+
+// RecordReservationAttempt adds one reservation attempt to today's entry of the item
+func (r *StatsRepository) RecordReservationAttempt(ctx context.Context, itemID pgtype.UUID) error {
+	query := `
+		INSERT INTO gift_item_daily_stats (gift_item_id, day, reservation_attempts)
+		SELECT id, CURRENT_DATE, 1 FROM gift_items WHERE id = $1
+		ON CONFLICT (gift_item_id, day) DO UPDATE
+		SET reservation_attempts = gift_item_daily_stats.reservation_attempts + 1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, itemID); err != nil {
+		return fmt.Errorf("failed to record reservation attempt: %w", err)
+	}
+
+	return nil
+}
+
+// ListItemInsights returns the owner's unarchived items that were viewed or reserved
+// since the given day, most viewed first. Time to reserve runs from the item's
+// creation to each reservation made in the period; who reserved is never read.
+func (r *StatsRepository) ListItemInsights(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error) {
+	query := `
+		WITH activity AS (
+			SELECT s.gift_item_id, SUM(s.views) AS views, SUM(s.reservation_attempts) AS reservation_attempts
+			FROM gift_item_daily_stats s
+			JOIN gift_items gi ON gi.id = s.gift_item_id
+			WHERE gi.owner_id = $1 AND s.day >= $2::date
+			GROUP BY s.gift_item_id
+		), reserved AS (
+			SELECT res.gift_item_id, COUNT(*) AS reservations,
+				PERCENTILE_CONT(0.5) WITHIN GROUP (
+					ORDER BY EXTRACT(EPOCH FROM res.reserved_at - gi.created_at)
+				) AS median_seconds_to_reserve
+			FROM reservations res
+			JOIN gift_items gi ON gi.id = res.gift_item_id
+			WHERE gi.owner_id = $1 AND res.reserved_at >= $2::date
+			GROUP BY res.gift_item_id
+		)
+		SELECT
+			gi.id AS gift_item_id,
+			gi.name,
+			COALESCE(a.views, 0) AS views,
+			COALESCE(a.reservation_attempts, 0) AS reservation_attempts,
+			COALESCE(rv.reservations, 0) AS reservations,
+			rv.median_seconds_to_reserve::bigint AS median_seconds_to_reserve
+		FROM gift_items gi
+		LEFT JOIN activity a ON a.gift_item_id = gi.id
+		LEFT JOIN reserved rv ON rv.gift_item_id = gi.id
+		WHERE gi.owner_id = $1 AND gi.archived_at IS NULL
+			AND (a.gift_item_id IS NOT NULL OR rv.gift_item_id IS NOT NULL)
+		ORDER BY views DESC, reservation_attempts DESC, gi.id
+	`
+
+	var insights []*models.ItemInsight
+	if err := r.db.SelectContext(ctx, &insights, query, ownerID, since.UTC().Format(time.DateOnly)); err != nil {
+		return nil, fmt.Errorf("failed to list item insights: %w", err)
+	}
+
+	return insights, nil
+}
+
+// ListCancelReasons counts the cancellations since the given day by reason, most
+// common first. An invalid ownerID counts them site-wide, otherwise only those of
+// the owner's items. Reasons come from the event log, which names no giver.
+func (r *StatsRepository) ListCancelReasons(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error) {
+	query := `
+		SELECT COALESCE(e.reason, 'unspecified') AS reason, COUNT(*) AS count
+		FROM reservation_events e
+		WHERE e.event_type = 'canceled' AND e.occurred_at >= $2::date
+			AND ($1::uuid IS NULL OR EXISTS (
+				SELECT 1 FROM gift_items gi WHERE gi.id = e.gift_item_id AND gi.owner_id = $1
+			))
+		GROUP BY 1
+		ORDER BY count DESC, reason
+	`
+
+	var reasons []*models.CancelReasonCount
+	if err := r.db.SelectContext(ctx, &reasons, query, ownerID, since.UTC().Format(time.DateOnly)); err != nil {
+		return nil, fmt.Errorf("failed to list cancellation reasons: %w", err)
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,491 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/stats/models"
+	"wish-list/internal/domain/stats/repository"
+)
+
+// Ensure, that StatsRepositoryInterfaceMock does implement repository.StatsRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.StatsRepositoryInterface = &StatsRepositoryInterfaceMock{}
+
+// StatsRepositoryInterfaceMock is a mock implementation of repository.StatsRepositoryInterface.
+//
+//	func TestSomethingThatUsesStatsRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.StatsRepositoryInterface
+//		mockedStatsRepositoryInterface := &StatsRepositoryInterfaceMock{
+//			GetActiveUsersFunc: func(ctx context.Context) (*models.ActiveUsers, error) {
+//				panic("mock out the GetActiveUsers method")
+//			},
+//			GetBacklogFunc: func(ctx context.Context) (*models.Backlog, error) {
+//				panic("mock out the GetBacklog method")
+//			},
+//			GetUserStatsFunc: func(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error) {
+//				panic("mock out the GetUserStats method")
+//			},
+//			ListCancelReasonsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error) {
+//				panic("mock out the ListCancelReasons method")
+//			},
+//			ListDailyActivityFunc: func(ctx context.Context, since time.Time) ([]*models.DailyActivity, error) {
+//				panic("mock out the ListDailyActivity method")
+//			},
+//			ListItemInsightsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error) {
+//				panic("mock out the ListItemInsights method")
+//			},
+//			ListTopWishlistsFunc: func(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error) {
+//				panic("mock out the ListTopWishlists method")
+//			},
+//			RecordItemViewsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) error {
+//				panic("mock out the RecordItemViews method")
+//			},
+//			RecordReservationAttemptFunc: func(ctx context.Context, itemID pgtype.UUID) error {
+//				panic("mock out the RecordReservationAttempt method")
+//			},
+//		}
+//
+//		// use mockedStatsRepositoryInterface in code that requires repository.StatsRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type StatsRepositoryInterfaceMock struct {
+	// GetActiveUsersFunc mocks the GetActiveUsers method.
+	GetActiveUsersFunc func(ctx context.Context) (*models.ActiveUsers, error)
+
+	// GetBacklogFunc mocks the GetBacklog method.
+	GetBacklogFunc func(ctx context.Context) (*models.Backlog, error)
+
+	// GetUserStatsFunc mocks the GetUserStats method.
+	GetUserStatsFunc func(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error)
+
+	// ListCancelReasonsFunc mocks the ListCancelReasons method.
+	ListCancelReasonsFunc func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error)
+
+	// ListDailyActivityFunc mocks the ListDailyActivity method.
+	ListDailyActivityFunc func(ctx context.Context, since time.Time) ([]*models.DailyActivity, error)
+
+	// ListItemInsightsFunc mocks the ListItemInsights method.
+	ListItemInsightsFunc func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error)
+
+	// ListTopWishlistsFunc mocks the ListTopWishlists method.
+	ListTopWishlistsFunc func(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error)
+
+	// RecordItemViewsFunc mocks the RecordItemViews method.
+	RecordItemViewsFunc func(ctx context.Context, itemIDs []pgtype.UUID) error
+
+	// RecordReservationAttemptFunc mocks the RecordReservationAttempt method.
+	RecordReservationAttemptFunc func(ctx context.Context, itemID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetActiveUsers holds details about calls to the GetActiveUsers method.
+		GetActiveUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetBacklog holds details about calls to the GetBacklog method.
+		GetBacklog []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetUserStats holds details about calls to the GetUserStats method.
+		GetUserStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// ListCancelReasons holds details about calls to the ListCancelReasons method.
+		ListCancelReasons []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListDailyActivity holds details about calls to the ListDailyActivity method.
+		ListDailyActivity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListItemInsights holds details about calls to the ListItemInsights method.
+		ListItemInsights []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListTopWishlists holds details about calls to the ListTopWishlists method.
+		ListTopWishlists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// RecordItemViews holds details about calls to the RecordItemViews method.
+		RecordItemViews []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemIDs is the itemIDs argument value.
+			ItemIDs []pgtype.UUID
+		}
+		// RecordReservationAttempt holds details about calls to the RecordReservationAttempt method.
+		RecordReservationAttempt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID pgtype.UUID
+		}
+	}
+	lockGetActiveUsers           sync.RWMutex
+	lockGetBacklog               sync.RWMutex
+	lockGetUserStats             sync.RWMutex
+	lockListCancelReasons        sync.RWMutex
+	lockListDailyActivity        sync.RWMutex
+	lockListItemInsights         sync.RWMutex
+	lockListTopWishlists         sync.RWMutex
+	lockRecordItemViews          sync.RWMutex
+	lockRecordReservationAttempt sync.RWMutex
+}
+
+// GetActiveUsers calls GetActiveUsersFunc.
+func (mock *StatsRepositoryInterfaceMock) GetActiveUsers(ctx context.Context) (*models.ActiveUsers, error) {
+	if mock.GetActiveUsersFunc == nil {
+		panic("StatsRepositoryInterfaceMock.GetActiveUsersFunc: method is nil but StatsRepositoryInterface.GetActiveUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetActiveUsers.Lock()
+	mock.calls.GetActiveUsers = append(mock.calls.GetActiveUsers, callInfo)
+	mock.lockGetActiveUsers.Unlock()
+	return mock.GetActiveUsersFunc(ctx)
+}
+
+// GetActiveUsersCalls gets all the calls that were made to GetActiveUsers.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.GetActiveUsersCalls())
+func (mock *StatsRepositoryInterfaceMock) GetActiveUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetActiveUsers.RLock()
+	calls = mock.calls.GetActiveUsers
+	mock.lockGetActiveUsers.RUnlock()
+	return calls
+}
+
+// GetBacklog calls GetBacklogFunc.
+func (mock *StatsRepositoryInterfaceMock) GetBacklog(ctx context.Context) (*models.Backlog, error) {
+	if mock.GetBacklogFunc == nil {
+		panic("StatsRepositoryInterfaceMock.GetBacklogFunc: method is nil but StatsRepositoryInterface.GetBacklog was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetBacklog.Lock()
+	mock.calls.GetBacklog = append(mock.calls.GetBacklog, callInfo)
+	mock.lockGetBacklog.Unlock()
+	return mock.GetBacklogFunc(ctx)
+}
+
+// GetBacklogCalls gets all the calls that were made to GetBacklog.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.GetBacklogCalls())
+func (mock *StatsRepositoryInterfaceMock) GetBacklogCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetBacklog.RLock()
+	calls = mock.calls.GetBacklog
+	mock.lockGetBacklog.RUnlock()
+	return calls
+}
+
+// GetUserStats calls GetUserStatsFunc.
+func (mock *StatsRepositoryInterfaceMock) GetUserStats(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error) {
+	if mock.GetUserStatsFunc == nil {
+		panic("StatsRepositoryInterfaceMock.GetUserStatsFunc: method is nil but StatsRepositoryInterface.GetUserStats was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetUserStats.Lock()
+	mock.calls.GetUserStats = append(mock.calls.GetUserStats, callInfo)
+	mock.lockGetUserStats.Unlock()
+	return mock.GetUserStatsFunc(ctx, userID)
+}
+
+// GetUserStatsCalls gets all the calls that were made to GetUserStats.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.GetUserStatsCalls())
+func (mock *StatsRepositoryInterfaceMock) GetUserStatsCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockGetUserStats.RLock()
+	calls = mock.calls.GetUserStats
+	mock.lockGetUserStats.RUnlock()
+	return calls
+}
+
+// ListCancelReasons calls ListCancelReasonsFunc.
+func (mock *StatsRepositoryInterfaceMock) ListCancelReasons(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error) {
+	if mock.ListCancelReasonsFunc == nil {
+		panic("StatsRepositoryInterfaceMock.ListCancelReasonsFunc: method is nil but StatsRepositoryInterface.ListCancelReasons was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+		Since:   since,
+	}
+	mock.lockListCancelReasons.Lock()
+	mock.calls.ListCancelReasons = append(mock.calls.ListCancelReasons, callInfo)
+	mock.lockListCancelReasons.Unlock()
+	return mock.ListCancelReasonsFunc(ctx, ownerID, since)
+}
+
+// ListCancelReasonsCalls gets all the calls that were made to ListCancelReasons.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.ListCancelReasonsCalls())
+func (mock *StatsRepositoryInterfaceMock) ListCancelReasonsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+	Since   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}
+	mock.lockListCancelReasons.RLock()
+	calls = mock.calls.ListCancelReasons
+	mock.lockListCancelReasons.RUnlock()
+	return calls
+}
+
+// ListDailyActivity calls ListDailyActivityFunc.
+func (mock *StatsRepositoryInterfaceMock) ListDailyActivity(ctx context.Context, since time.Time) ([]*models.DailyActivity, error) {
+	if mock.ListDailyActivityFunc == nil {
+		panic("StatsRepositoryInterfaceMock.ListDailyActivityFunc: method is nil but StatsRepositoryInterface.ListDailyActivity was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListDailyActivity.Lock()
+	mock.calls.ListDailyActivity = append(mock.calls.ListDailyActivity, callInfo)
+	mock.lockListDailyActivity.Unlock()
+	return mock.ListDailyActivityFunc(ctx, since)
+}
+
+// ListDailyActivityCalls gets all the calls that were made to ListDailyActivity.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.ListDailyActivityCalls())
+func (mock *StatsRepositoryInterfaceMock) ListDailyActivityCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListDailyActivity.RLock()
+	calls = mock.calls.ListDailyActivity
+	mock.lockListDailyActivity.RUnlock()
+	return calls
+}
+
+// ListItemInsights calls ListItemInsightsFunc.
+func (mock *StatsRepositoryInterfaceMock) ListItemInsights(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error) {
+	if mock.ListItemInsightsFunc == nil {
+		panic("StatsRepositoryInterfaceMock.ListItemInsightsFunc: method is nil but StatsRepositoryInterface.ListItemInsights was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+		Since:   since,
+	}
+	mock.lockListItemInsights.Lock()
+	mock.calls.ListItemInsights = append(mock.calls.ListItemInsights, callInfo)
+	mock.lockListItemInsights.Unlock()
+	return mock.ListItemInsightsFunc(ctx, ownerID, since)
+}
+
+// ListItemInsightsCalls gets all the calls that were made to ListItemInsights.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.ListItemInsightsCalls())
+func (mock *StatsRepositoryInterfaceMock) ListItemInsightsCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+	Since   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}
+	mock.lockListItemInsights.RLock()
+	calls = mock.calls.ListItemInsights
+	mock.lockListItemInsights.RUnlock()
+	return calls
+}
+
+// ListTopWishlists calls ListTopWishlistsFunc.
+func (mock *StatsRepositoryInterfaceMock) ListTopWishlists(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error) {
+	if mock.ListTopWishlistsFunc == nil {
+		panic("StatsRepositoryInterfaceMock.ListTopWishlistsFunc: method is nil but StatsRepositoryInterface.ListTopWishlists was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+		Limit int
+	}{
+		Ctx:   ctx,
+		Since: since,
+		Limit: limit,
+	}
+	mock.lockListTopWishlists.Lock()
+	mock.calls.ListTopWishlists = append(mock.calls.ListTopWishlists, callInfo)
+	mock.lockListTopWishlists.Unlock()
+	return mock.ListTopWishlistsFunc(ctx, since, limit)
+}
+
+// ListTopWishlistsCalls gets all the calls that were made to ListTopWishlists.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.ListTopWishlistsCalls())
+func (mock *StatsRepositoryInterfaceMock) ListTopWishlistsCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+		Limit int
+	}
+	mock.lockListTopWishlists.RLock()
+	calls = mock.calls.ListTopWishlists
+	mock.lockListTopWishlists.RUnlock()
+	return calls
+}
+
+// RecordItemViews calls RecordItemViewsFunc.
+func (mock *StatsRepositoryInterfaceMock) RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error {
+	if mock.RecordItemViewsFunc == nil {
+		panic("StatsRepositoryInterfaceMock.RecordItemViewsFunc: method is nil but StatsRepositoryInterface.RecordItemViews was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ItemIDs: itemIDs,
+	}
+	mock.lockRecordItemViews.Lock()
+	mock.calls.RecordItemViews = append(mock.calls.RecordItemViews, callInfo)
+	mock.lockRecordItemViews.Unlock()
+	return mock.RecordItemViewsFunc(ctx, itemIDs)
+}
+
+// RecordItemViewsCalls gets all the calls that were made to RecordItemViews.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.RecordItemViewsCalls())
+func (mock *StatsRepositoryInterfaceMock) RecordItemViewsCalls() []struct {
+	Ctx     context.Context
+	ItemIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}
+	mock.lockRecordItemViews.RLock()
+	calls = mock.calls.RecordItemViews
+	mock.lockRecordItemViews.RUnlock()
+	return calls
+}
+
+// RecordReservationAttempt calls RecordReservationAttemptFunc.
+func (mock *StatsRepositoryInterfaceMock) RecordReservationAttempt(ctx context.Context, itemID pgtype.UUID) error {
+	if mock.RecordReservationAttemptFunc == nil {
+		panic("StatsRepositoryInterfaceMock.RecordReservationAttemptFunc: method is nil but StatsRepositoryInterface.RecordReservationAttempt was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+	}
+	mock.lockRecordReservationAttempt.Lock()
+	mock.calls.RecordReservationAttempt = append(mock.calls.RecordReservationAttempt, callInfo)
+	mock.lockRecordReservationAttempt.Unlock()
+	return mock.RecordReservationAttemptFunc(ctx, itemID)
+}
+
+// RecordReservationAttemptCalls gets all the calls that were made to RecordReservationAttempt.
+// Check the length with:
+//
+//	len(mockedStatsRepositoryInterface.RecordReservationAttemptCalls())
+func (mock *StatsRepositoryInterfaceMock) RecordReservationAttemptCalls() []struct {
+	Ctx    context.Context
+	ItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ItemID pgtype.UUID
+	}
+	mock.lockRecordReservationAttempt.RLock()
+	calls = mock.calls.RecordReservationAttempt
+	mock.lockRecordReservationAttempt.RUnlock()
+	return calls
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/stats/models"
+	"wish-list/internal/domain/stats/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StatsCacheTTL is how long computed statistics are served from cache. The shared
+// cache keeps entries longer, so entries carry their ComputedAt and older ones are
+// recomputed; the dashboard lags behind by at most this much.
+const StatsCacheTTL = time.Minute
+
+// Limits for the admin metrics
+const (
+	DefaultMetricsDays  = 30
+	MaxMetricsDays      = 90
+	DefaultTopWishlists = 10
+	MaxTopWishlists     = 50
+)
+
+var (
+	ErrInvalidPeriod = errors.New("period must be between 1 and 90 days")
+	ErrInvalidLimit  = errors.New("limit must be between 1 and 50")
+)
+
+// Cross-domain interfaces - only methods actually used by StatsService
+
+// CacheInterface defines cache methods used by stats service
+type CacheInterface interface {
+	Get(ctx context.Context, key string, dest any) error
+	Set(ctx context.Context, key string, value any) error
+}
+
+// StatsServiceInterface defines the interface for user statistics operations
+type StatsServiceInterface interface {
+	GetUserStats(ctx context.Context, userID pgtype.UUID) (*StatsOutput, error)
+	GetActivity(ctx context.Context, days int) (*ActivityOutput, error)
+	ListTopWishlists(ctx context.Context, days, limit int) ([]*TopWishlistOutput, error)
+	GetBacklog(ctx context.Context) (*BacklogOutput, error)
+	GetItemInsights(ctx context.Context, ownerID pgtype.UUID, days int) (*ItemInsightsOutput, error)
+	RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error
+	RecordReservationAttempt(ctx context.Context, itemID pgtype.UUID) error
+}
+
+type StatsService struct {
+	repo  repository.StatsRepositoryInterface
+	cache CacheInterface
+}
+
+// NewStatsService creates a new StatsService. cache may be nil, in which case
+// statistics are computed on every request.
+func NewStatsService(repo repository.StatsRepositoryInterface, cache CacheInterface) *StatsService {
+	return &StatsService{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// StatsOutput are the user's totals. Amounts add up prices as entered, whatever
+// the currency of the shop.
+type StatsOutput struct {
+	WishLists      int
+	Items          int
+	TotalValue     float64
+	ItemsReserved  int
+	ItemsPurchased int
+	Reservations   int
+	Spend          float64
+	ComputedAt     time.Time
+}
+
+// ActivityOutput is site-wide activity for the admin dashboard
+type ActivityOutput struct {
+	Since         time.Time
+	Days          []DailyActivityOutput // One entry per day (UTC), oldest first
+	ActiveUsers   ActiveUsersOutput
+	CancelReasons []CancelReasonOutput // Over the whole period, most common first
+}
+
+type DailyActivityOutput struct {
+	Day           time.Time
+	Signups       int
+	Reservations  int
+	Cancellations int
+	Expirations   int
+}
+
+// ActiveUsersOutput counts accounts by how recently they signed in
+type ActiveUsersOutput struct {
+	Day   int
+	Week  int
+	Month int
+}
+
+type TopWishlistOutput struct {
+	ID         pgtype.UUID
+	Title      string
+	PublicSlug string
+	Views      int
+}
+
+// BacklogOutput is the work waiting for admins and the deliveries that gave up
+type BacklogOutput struct {
+	PendingReports         int
+	PendingWebhooks        int
+	FailedWebhooks         int
+	FailedDataExports      int
+	FailedReservationLinks int
+}
+
+// ItemInsightsOutput is the public interest in the owner's items over a period
+type ItemInsightsOutput struct {
+	Since         time.Time
+	Items         []ItemInsightOutput  // Items viewed or reserved in the period, most viewed first
+	CancelReasons []CancelReasonOutput // Why givers canceled on the owner's items, most common first
+}
+
+// CancelReasonOutput counts the cancellations that gave one reason; "unspecified"
+// counts those that gave none
+type CancelReasonOutput struct {
+	Reason string
+	Count  int
+}
+
+// ItemInsightOutput counts activity on one item without saying whose it was
+type ItemInsightOutput struct {
+	GiftItemID          pgtype.UUID
+	Name                string
+	Views               int
+	ReservationAttempts int
+	Reservations        int
+	MedianTimeToReserve *time.Duration // nil when nobody reserved the item in the period
+}
+
+// GetUserStats returns the user's totals, computed at most StatsCacheTTL ago
+func (s *StatsService) GetUserStats(ctx context.Context, userID pgtype.UUID) (*StatsOutput, error) {
+	cacheKey := fmt.Sprintf("stats:user:%s", userID.String())
+	if s.cache != nil {
+		var cached StatsOutput
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil && time.Since(cached.ComputedAt) < StatsCacheTTL {
+			return &cached, nil
+		}
+	}
+
+	stats, err := s.repo.GetUserStats(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+
+	output := &StatsOutput{
+		WishLists:      stats.WishLists,
+		Items:          stats.Items,
+		TotalValue:     database.NumericToFloat64(stats.TotalValue),
+		ItemsReserved:  stats.ItemsReserved,
+		ItemsPurchased: stats.ItemsPurchased,
+		Reservations:   stats.Reservations,
+		Spend:          database.NumericToFloat64(stats.Spend),
+		ComputedAt:     time.Now().UTC(),
+	}
+
+	if s.cache != nil {
+		_ = s.cache.Set(ctx, cacheKey, output)
+	}
+
+	return output, nil
+}
+
+// GetActivity returns signups and reservations per day over the last days, today
+// included, and the current active user counts. days 0 means DefaultMetricsDays.
+func (s *StatsService) GetActivity(ctx context.Context, days int) (*ActivityOutput, error) {
+	since, err := metricsSince(days)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.repo.ListDailyActivity(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daily activity: %w", err)
+	}
+
+	active, err := s.repo.GetActiveUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active users: %w", err)
+	}
+
+	reasons, err := s.repo.ListCancelReasons(ctx, pgtype.UUID{}, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cancellation reasons: %w", err)
+	}
+
+	output := &ActivityOutput{
+		Since: since,
+		Days:  make([]DailyActivityOutput, 0, len(daily)),
+		ActiveUsers: ActiveUsersOutput{
+			Day:   active.Day,
+			Week:  active.Week,
+			Month: active.Month,
+		},
+		CancelReasons: toCancelReasonOutputs(reasons),
+	}
+	for _, day := range daily {
+		output.Days = append(output.Days, DailyActivityOutput{
+			Day:           day.Day.Time,
+			Signups:       day.Signups,
+			Reservations:  day.Reservations,
+			Cancellations: day.Cancellations,
+			Expirations:   day.Expirations,
+		})
+	}
+
+	return output, nil
+}
+
+// ListTopWishlists returns the most viewed public wishlists over the last days.
+// days 0 means DefaultMetricsDays, limit 0 DefaultTopWishlists.
+func (s *StatsService) ListTopWishlists(ctx context.Context, days, limit int) ([]*TopWishlistOutput, error) {
+	since, err := metricsSince(days)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit == 0 {
+		limit = DefaultTopWishlists
+	}
+	if limit < 1 || limit > MaxTopWishlists {
+		return nil, ErrInvalidLimit
+	}
+
+	wishlists, err := s.repo.ListTopWishlists(ctx, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top wishlists: %w", err)
+	}
+
+	outputs := make([]*TopWishlistOutput, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		outputs = append(outputs, &TopWishlistOutput{
+			ID:         wishlist.ID,
+			Title:      wishlist.Title,
+			PublicSlug: wishlist.PublicSlug.String,
+			Views:      wishlist.Views,
+		})
+	}
+
+	return outputs, nil
+}
+
+// GetBacklog returns pending moderation reports and queued or failed background work
+func (s *StatsService) GetBacklog(ctx context.Context) (*BacklogOutput, error) {
+	backlog, err := s.repo.GetBacklog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backlog: %w", err)
+	}
+
+	return &BacklogOutput{
+		PendingReports:         backlog.PendingReports,
+		PendingWebhooks:        backlog.PendingWebhooks,
+		FailedWebhooks:         backlog.FailedWebhooks,
+		FailedDataExports:      backlog.FailedDataExports,
+		FailedReservationLinks: backlog.FailedReservationLinks,
+	}, nil
+}
+
+// GetItemInsights returns views, reservation attempts and time to reserve of the
+// owner's items over the last days, today included. days 0 means DefaultMetricsDays.
+func (s *StatsService) GetItemInsights(ctx context.Context, ownerID pgtype.UUID, days int) (*ItemInsightsOutput, error) {
+	since, err := metricsSince(days)
+	if err != nil {
+		return nil, err
+	}
+
+	insights, err := s.repo.ListItemInsights(ctx, ownerID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item insights: %w", err)
+	}
+
+	reasons, err := s.repo.ListCancelReasons(ctx, ownerID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cancellation reasons: %w", err)
+	}
+
+	output := &ItemInsightsOutput{
+		Since:         since,
+		Items:         make([]ItemInsightOutput, 0, len(insights)),
+		CancelReasons: toCancelReasonOutputs(reasons),
+	}
+	for _, insight := range insights {
+		item := ItemInsightOutput{
+			GiftItemID:          insight.GiftItemID,
+			Name:                insight.Name,
+			Views:               insight.Views,
+			ReservationAttempts: insight.ReservationAttempts,
+			Reservations:        insight.Reservations,
+		}
+		if insight.MedianTimeToReserve.Valid {
+			median := time.Duration(insight.MedianTimeToReserve.Int64) * time.Second
+			item.MedianTimeToReserve = &median
+		}
+		output.Items = append(output.Items, item)
+	}
+
+	return output, nil
+}
+
+// RecordItemViews counts a public page listing the given items
+func (s *StatsService) RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error {
+	return s.repo.RecordItemViews(ctx, itemIDs)
+}
+
+// RecordReservationAttempt counts a request to reserve the item, whatever its outcome
+func (s *StatsService) RecordReservationAttempt(ctx context.Context, itemID pgtype.UUID) error {
+	return s.repo.RecordReservationAttempt(ctx, itemID)
+}
+
+func toCancelReasonOutputs(reasons []*models.CancelReasonCount) []CancelReasonOutput {
+	outputs := make([]CancelReasonOutput, 0, len(reasons))
+	for _, reason := range reasons {
+		outputs = append(outputs, CancelReasonOutput{Reason: reason.Reason, Count: reason.Count})
+	}
+	return outputs
+}
+
+// metricsSince returns the first day (UTC) of a period of days ending today
+func metricsSince(days int) (time.Time, error) {
+	if days == 0 {
+		days = DefaultMetricsDays
+	}
+	if days < 1 || days > MaxMetricsDays {
+		return time.Time{}, ErrInvalidPeriod
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	return today.AddDate(0, 0, 1-days), nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/stats/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUserID = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+
+func statsRepo() *StatsRepositoryInterfaceMock {
+	return &StatsRepositoryInterfaceMock{
+		GetUserStatsFunc: func(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error) {
+			return &models.UserStats{
+				WishLists:      2,
+				Items:          7,
+				TotalValue:     pgtype.Numeric{Int: big.NewInt(125050), Exp: -2, Valid: true},
+				ItemsReserved:  3,
+				ItemsPurchased: 1,
+				Reservations:   4,
+				Spend:          pgtype.Numeric{Int: big.NewInt(0), Valid: true},
+			}, nil
+		},
+	}
+}
+
+// memoryCache stores values as JSON, as the Redis cache does
+type memoryCache map[string][]byte
+
+func (c memoryCache) Get(ctx context.Context, key string, dest any) error {
+	data, ok := c[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c memoryCache) Set(ctx context.Context, key string, value any) error {
+	data, err := json.Marshal(value)
+	c[key] = data
+	return err
+}
+
+func TestStatsService_GetUserStats(t *testing.T) {
+	t.Run("maps the aggregates", func(t *testing.T) {
+		svc := NewStatsService(statsRepo(), nil)
+
+		stats, err := svc.GetUserStats(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.WishLists)
+		assert.Equal(t, 7, stats.Items)
+		assert.InDelta(t, 1250.50, stats.TotalValue, 0.001)
+		assert.Equal(t, 3, stats.ItemsReserved)
+		assert.Equal(t, 1, stats.ItemsPurchased)
+		assert.Equal(t, 4, stats.Reservations)
+		assert.Zero(t, stats.Spend)
+		assert.WithinDuration(t, time.Now(), stats.ComputedAt, time.Second)
+	})
+
+	t.Run("serves fresh values from cache", func(t *testing.T) {
+		repo := statsRepo()
+		svc := NewStatsService(repo, memoryCache{})
+
+		first, err := svc.GetUserStats(context.Background(), testUserID)
+		require.NoError(t, err)
+		second, err := svc.GetUserStats(context.Background(), testUserID)
+		require.NoError(t, err)
+
+		assert.Len(t, repo.GetUserStatsCalls(), 1)
+		assert.Equal(t, first.Items, second.Items)
+		assert.True(t, first.ComputedAt.Equal(second.ComputedAt))
+	})
+
+	t.Run("recomputes values older than the TTL", func(t *testing.T) {
+		repo := statsRepo()
+		cache := memoryCache{}
+		require.NoError(t, cache.Set(context.Background(), "stats:user:"+testUserID.String(),
+			StatsOutput{Items: 99, ComputedAt: time.Now().Add(-StatsCacheTTL - time.Second)}))
+		svc := NewStatsService(repo, cache)
+
+		stats, err := svc.GetUserStats(context.Background(), testUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, stats.Items)
+		assert.Len(t, repo.GetUserStatsCalls(), 1)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := statsRepo()
+		repo.GetUserStatsFunc = func(ctx context.Context, userID pgtype.UUID) (*models.UserStats, error) {
+			return nil, errors.New("connection refused")
+		}
+		svc := NewStatsService(repo, memoryCache{})
+
+		_, err := svc.GetUserStats(context.Background(), testUserID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestStatsService_GetActivity(t *testing.T) {
+	repo := &StatsRepositoryInterfaceMock{
+		ListDailyActivityFunc: func(ctx context.Context, since time.Time) ([]*models.DailyActivity, error) {
+			return []*models.DailyActivity{
+				{Day: pgtype.Date{Time: since, Valid: true}, Signups: 3, Reservations: 5, Cancellations: 2, Expirations: 1},
+			}, nil
+		},
+		GetActiveUsersFunc: func(ctx context.Context) (*models.ActiveUsers, error) {
+			return &models.ActiveUsers{Day: 4, Week: 10, Month: 25}, nil
+		},
+		ListCancelReasonsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error) {
+			return []*models.CancelReasonCount{{Reason: "bought_elsewhere", Count: 2}, {Reason: "unspecified", Count: 1}}, nil
+		},
+	}
+	svc := NewStatsService(repo, nil)
+
+	t.Run("defaults to 30 days including today", func(t *testing.T) {
+		activity, err := svc.GetActivity(context.Background(), 0)
+
+		require.NoError(t, err)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		assert.Equal(t, today.AddDate(0, 0, -29), activity.Since)
+		require.Len(t, activity.Days, 1)
+		assert.Equal(t, 3, activity.Days[0].Signups)
+		assert.Equal(t, 5, activity.Days[0].Reservations)
+		assert.Equal(t, 2, activity.Days[0].Cancellations)
+		assert.Equal(t, 1, activity.Days[0].Expirations)
+		assert.Equal(t, ActiveUsersOutput{Day: 4, Week: 10, Month: 25}, activity.ActiveUsers)
+		assert.Equal(t, []CancelReasonOutput{{Reason: "bought_elsewhere", Count: 2}, {Reason: "unspecified", Count: 1}}, activity.CancelReasons)
+		assert.False(t, repo.ListCancelReasonsCalls()[0].OwnerID.Valid, "site-wide")
+	})
+
+	t.Run("period too long", func(t *testing.T) {
+		_, err := svc.GetActivity(context.Background(), MaxMetricsDays+1)
+
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
+
+func TestStatsService_ListTopWishlists(t *testing.T) {
+	repo := &StatsRepositoryInterfaceMock{
+		ListTopWishlistsFunc: func(ctx context.Context, since time.Time, limit int) ([]*models.TopWishlist, error) {
+			return []*models.TopWishlist{
+				{Title: "Birthday", PublicSlug: pgtype.Text{String: "birthday", Valid: true}, Views: 120},
+			}, nil
+		},
+	}
+	svc := NewStatsService(repo, nil)
+
+	t.Run("defaults to the top 10", func(t *testing.T) {
+		wishlists, err := svc.ListTopWishlists(context.Background(), 7, 0)
+
+		require.NoError(t, err)
+		require.Len(t, wishlists, 1)
+		assert.Equal(t, "birthday", wishlists[0].PublicSlug)
+		assert.Equal(t, 120, wishlists[0].Views)
+		assert.Equal(t, DefaultTopWishlists, repo.ListTopWishlistsCalls()[0].Limit)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := svc.ListTopWishlists(context.Background(), 0, MaxTopWishlists+1)
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+
+		_, err = svc.ListTopWishlists(context.Background(), -1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
+
+func TestStatsService_GetItemInsights(t *testing.T) {
+	repo := &StatsRepositoryInterfaceMock{
+		ListItemInsightsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.ItemInsight, error) {
+			return []*models.ItemInsight{
+				{Name: "Headphones", Views: 40, ReservationAttempts: 3, Reservations: 1, MedianTimeToReserve: pgtype.Int8{Int64: 7200, Valid: true}},
+				{Name: "Book", Views: 12},
+			}, nil
+		},
+		ListCancelReasonsFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) ([]*models.CancelReasonCount, error) {
+			return []*models.CancelReasonCount{{Reason: "too_expensive", Count: 1}}, nil
+		},
+	}
+	svc := NewStatsService(repo, nil)
+
+	t.Run("reports the owner's items", func(t *testing.T) {
+		insights, err := svc.GetItemInsights(context.Background(), testUserID, 0)
+
+		require.NoError(t, err)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		assert.Equal(t, today.AddDate(0, 0, -29), insights.Since)
+		assert.Equal(t, testUserID, repo.ListItemInsightsCalls()[0].OwnerID)
+		require.Len(t, insights.Items, 2)
+		assert.Equal(t, 3, insights.Items[0].ReservationAttempts)
+		require.NotNil(t, insights.Items[0].MedianTimeToReserve)
+		assert.Equal(t, 2*time.Hour, *insights.Items[0].MedianTimeToReserve)
+		assert.Nil(t, insights.Items[1].MedianTimeToReserve, "never reserved")
+		assert.Equal(t, testUserID, repo.ListCancelReasonsCalls()[0].OwnerID)
+		assert.Equal(t, []CancelReasonOutput{{Reason: "too_expensive", Count: 1}}, insights.CancelReasons)
+	})
+
+	t.Run("period too long", func(t *testing.T) {
+		_, err := svc.GetItemInsights(context.Background(), testUserID, MaxMetricsDays+1)
+
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
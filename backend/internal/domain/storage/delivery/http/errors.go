@@ -0,0 +1,22 @@
+package http
+
+import (
+	"errors"
+
+	quotaservice "wish-list/internal/domain/quota/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapUploadQuotaError converts upload quota check errors to AppErrors
+func mapUploadQuotaError(err error) error {
+	var quotaErr *quotaservice.QuotaExceededError
+
+	switch {
+	case errors.As(err, &quotaErr) && quotaErr.Upgradable():
+		return apperrors.PaymentRequired(quotaErr.Error())
+	case errors.As(err, &quotaErr):
+		return apperrors.UnprocessableEntity(quotaErr.Error())
+	default:
+		return apperrors.Internal("Failed to check upload quota").Wrap(err)
+	}
+}
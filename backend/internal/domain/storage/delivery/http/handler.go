@@ -1,6 +1,8 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"io"
 	"mime/multipart"
 	nethttp "net/http"
@@ -8,28 +10,39 @@ import (
 	"strings"
 	"wish-list/internal/domain/storage/delivery/http/dto"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/auth"
 	"wish-list/internal/pkg/aws"
+	"wish-list/internal/pkg/helpers"
 	"wish-list/internal/pkg/logger"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/labstack/echo/v4"
 )
 
+// UploadQuotaInterface defines the plan quota operations used for image uploads (cross-domain)
+type UploadQuotaInterface interface {
+	CheckImageUploadQuota(ctx context.Context, userID pgtype.UUID) error
+	RecordImageUpload(ctx context.Context, userID pgtype.UUID, url string) error
+}
+
 // Handler handles S3 storage operations
 type Handler struct {
 	s3Client *aws.S3Client
+	quota    UploadQuotaInterface
 }
 
 // NewHandler creates a new storage handler
-func NewHandler(s3Client *aws.S3Client) *Handler {
+func NewHandler(s3Client *aws.S3Client, quota UploadQuotaInterface) *Handler {
 	return &Handler{
 		s3Client: s3Client,
+		quota:    quota,
 	}
 }
 
 // UploadImage godoc
 //
 //	@Summary		Upload an image to S3
-//	@Description	Upload an image file to S3 storage. The user must be authenticated.
+//	@Description	Upload an image file to S3 storage. The user must be authenticated. Uploads count against the monthly image upload quota of the user's plan.
 //	@Tags			S3 Upload
 //	@Accept			mpfd
 //	@Produce		json
@@ -37,10 +50,18 @@ func NewHandler(s3Client *aws.S3Client) *Handler {
 //	@Success		200		{object}	dto.UploadImageResponse	"Image uploaded successfully, returns URL"
 //	@Failure		400		{object}	map[string]string	"Invalid file or file too large"
 //	@Failure		401		{object}	map[string]string	"Unauthorized"
+//	@Failure		402		{object}	map[string]string	"Monthly upload quota of the free plan reached"
+//	@Failure		422		{object}	map[string]string	"Monthly upload quota of the premium plan reached"
 //	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Failure		503		{object}	map[string]string	"Image storage temporarily unavailable"
 //	@Security		BearerAuth
 //	@Router			/images/upload [post]
 func (h *Handler) UploadImage(c echo.Context) error {
+	userID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
 	// Get the file from the form data
 	file, err := c.FormFile("image")
 	if err != nil {
@@ -63,24 +84,38 @@ func (h *Handler) UploadImage(c echo.Context) error {
 		return apperrors.BadRequest("File too large. Maximum size is 10MB.")
 	}
 
+	ctx := c.Request().Context()
+
+	if err := h.quota.CheckImageUploadQuota(ctx, userID); err != nil {
+		return mapUploadQuotaError(err)
+	}
+
 	// Handle GIF file processing
-	if err := h.processGifFile(src, file.Filename); err != nil {
+	if err := h.processGifFile(ctx, src, file.Filename); err != nil {
 		return err
 	}
 
 	// Upload to S3
-	url, err := h.s3Client.UploadFile(c.Request().Context(), src, file.Filename, file.Header.Get("Content-Type"))
+	url, err := h.s3Client.UploadFile(ctx, src, file.Filename, file.Header.Get("Content-Type"))
+	if errors.Is(err, aws.ErrUnavailable) {
+		return apperrors.ServiceUnavailable("Image uploads are temporarily unavailable. Please try again later.")
+	}
 	if err != nil {
 		return apperrors.Internal("Failed to upload image to S3").Wrap(err)
 	}
 
+	// The image is already stored; an upload that is not counted is only logged
+	if err := h.quota.RecordImageUpload(ctx, userID, url); err != nil {
+		logger.WarnContext(ctx, "failed to record image upload", "user_id", userID.String(), "error", err)
+	}
+
 	return c.JSON(nethttp.StatusOK, dto.UploadImageResponse{
 		URL: url,
 	})
 }
 
 // processGifFile handles GIF-specific processing (animation check)
-func (h *Handler) processGifFile(src multipart.File, filename string) error {
+func (h *Handler) processGifFile(ctx context.Context, src multipart.File, filename string) error {
 	ext := strings.ToLower(filepath.Ext(filename))
 	if ext != ".gif" {
 		return nil // Not a GIF, nothing to process
@@ -88,7 +123,7 @@ func (h *Handler) processGifFile(src multipart.File, filename string) error {
 
 	isAnimated, err := aws.IsAnimatedGif(src)
 	if err != nil {
-		logger.Warn("could not check if GIF is animated", "error", err, "filename", filename)
+		logger.WarnContext(ctx, "could not check if GIF is animated", "error", err, "filename", filename)
 		// Reset file pointer to beginning since we read it during animation check
 		if seeker, ok := src.(io.Seeker); ok {
 			_, seekErr := seeker.Seek(0, 0)
@@ -102,7 +137,7 @@ func (h *Handler) processGifFile(src multipart.File, filename string) error {
 
 	if isAnimated {
 		// Log that we have an animated GIF - this is allowed per FR-011
-		logger.Info("animated GIF uploaded", "filename", filename)
+		logger.InfoContext(ctx, "animated GIF uploaded", "filename", filename)
 	}
 
 	return nil
@@ -0,0 +1,8 @@
+package dto
+
+type CreateSuggestionRequest struct {
+	SuggesterName string   `json:"suggester_name" validate:"required,max=100" example:"Aunt May"`
+	Name          string   `json:"name" validate:"required,min=1,max=255" example:"Wool scarf"`
+	Link          *string  `json:"link" validate:"omitempty,url" example:"https://example.com/scarf"`
+	Price         *float64 `json:"price" validate:"omitempty,gte=0" example:"29.99"`
+}
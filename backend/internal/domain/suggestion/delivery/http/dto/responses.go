@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"wish-list/internal/domain/suggestion/service"
+)
+
+type SuggestionResponse struct {
+	ID            string   `json:"id" validate:"required"`
+	WishlistID    string   `json:"wishlist_id" validate:"required"`
+	SuggesterName string   `json:"suggester_name" validate:"required"`
+	Name          string   `json:"name" validate:"required"`
+	Link          *string  `json:"link"`
+	Price         *float64 `json:"price"`
+	Status        string   `json:"status" validate:"required"`
+	GiftItemID    *string  `json:"gift_item_id"`
+	DecidedAt     *string  `json:"decided_at"`
+	CreatedAt     string   `json:"created_at" validate:"required"`
+}
+
+type SuggestionsListResponse struct {
+	Data []SuggestionResponse `json:"data" validate:"required"`
+}
+
+func FromSuggestionOutput(s *service.SuggestionOutput) SuggestionResponse {
+	resp := SuggestionResponse{
+		ID:            s.ID.String(),
+		WishlistID:    s.WishlistID.String(),
+		SuggesterName: s.SuggesterName,
+		Name:          s.Name,
+		Price:         s.Price,
+		Status:        s.Status,
+		CreatedAt:     s.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if s.Link.Valid {
+		link := s.Link.String
+		resp.Link = &link
+	}
+
+	if s.GiftItemID.Valid {
+		giftItemID := s.GiftItemID.String()
+		resp.GiftItemID = &giftItemID
+	}
+
+	if s.DecidedAt.Valid {
+		decidedAt := s.DecidedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+		resp.DecidedAt = &decidedAt
+	}
+
+	return resp
+}
+
+func FromSuggestionOutputs(outputs []*service.SuggestionOutput) []SuggestionResponse {
+	responses := make([]SuggestionResponse, len(outputs))
+	for i, output := range outputs {
+		responses[i] = FromSuggestionOutput(output)
+	}
+	return responses
+}
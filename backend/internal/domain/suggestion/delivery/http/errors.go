@@ -0,0 +1,43 @@
+package http
+
+import (
+	"errors"
+
+	quotaservice "wish-list/internal/domain/quota/service"
+	"wish-list/internal/domain/suggestion/service"
+	"wish-list/internal/pkg/apperrors"
+)
+
+// mapSuggestionServiceError converts suggestion service errors to AppErrors
+func mapSuggestionServiceError(err error) error {
+	var quotaErr *quotaservice.QuotaExceededError
+
+	switch {
+	case errors.As(err, &quotaErr) && quotaErr.Upgradable():
+		return apperrors.PaymentRequired(quotaErr.Error())
+	case errors.As(err, &quotaErr):
+		return apperrors.UnprocessableEntity(quotaErr.Error())
+	case errors.Is(err, service.ErrInvalidSuggestionID):
+		return apperrors.BadRequest("Invalid suggestion ID")
+	case errors.Is(err, service.ErrInvalidWishlistID):
+		return apperrors.BadRequest("Invalid wishlist ID")
+	case errors.Is(err, service.ErrInvalidSuggestionStatus):
+		return apperrors.BadRequest("Invalid suggestion status")
+	case errors.Is(err, service.ErrSuggestionNameRequired):
+		return apperrors.BadRequest("Item name and your name are required")
+	case errors.Is(err, service.ErrInvalidSuggestionPrice):
+		return apperrors.BadRequest("Invalid price")
+	case errors.Is(err, service.ErrSuggestionNotFound):
+		return apperrors.NotFound("Suggestion not found")
+	case errors.Is(err, service.ErrPublicWishlistNotFound):
+		return apperrors.NotFound("Public wishlist not found")
+	case errors.Is(err, service.ErrWishlistNotFound):
+		return apperrors.NotFound("Wishlist not found")
+	case errors.Is(err, service.ErrSuggestionAccessDenied):
+		return apperrors.Forbidden("Only the wishlist owner can review suggestions")
+	case errors.Is(err, service.ErrSuggestionAlreadyDecided):
+		return apperrors.Conflict("Suggestion has already been accepted or declined")
+	default:
+		return apperrors.Internal("Failed to process suggestion").Wrap(err)
+	}
+}
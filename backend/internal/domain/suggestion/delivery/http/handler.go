@@ -0,0 +1,165 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/suggestion/delivery/http/dto"
+	"wish-list/internal/domain/suggestion/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler handles HTTP requests for item suggestions
+type Handler struct {
+	service service.SuggestionServiceInterface
+}
+
+// NewHandler creates a new Handler
+func NewHandler(svc service.SuggestionServiceInterface) *Handler {
+	return &Handler{
+		service: svc,
+	}
+}
+
+// CreateSuggestion godoc
+//
+//	@Summary		Suggest an item for a public wishlist
+//	@Description	Propose a gift item to the wishlist owner. The owner is notified by email and can accept it as a new item or decline it.
+//	@Tags			Suggestions
+//	@Accept			json
+//	@Produce		json
+//	@Param			slug			path		string						true	"Public slug of the wishlist"
+//	@Param			request			body		dto.CreateSuggestionRequest	true	"Suggested item"
+//	@Param			X-Captcha-Token	header		string						false	"CAPTCHA response token (required for guests when CAPTCHA is enabled)"
+//	@Success		201				{object}	dto.SuggestionResponse		"Suggestion submitted"
+//	@Failure		400				{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		403				{object}	map[string]string			"CAPTCHA verification failed"
+//	@Failure		404				{object}	map[string]string			"Public wishlist not found"
+//	@Failure		429				{object}	map[string]string			"Too many requests"
+//	@Failure		500				{object}	map[string]string			"Internal server error"
+//	@Router			/public/wishlists/{slug}/suggestions [post]
+func (h *Handler) CreateSuggestion(c echo.Context) error {
+	var req dto.CreateSuggestionRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	// Registered suggesters are recorded so they can be told about the owner's decision
+	suggestedBy := pgtype.UUID{}
+	if userIDStr, _, _, authErr := auth.GetUserFromContext(c); authErr == nil {
+		userID, err := helpers.ParseUUID(c, userIDStr)
+		if err != nil {
+			return err
+		}
+		suggestedBy = userID
+	}
+
+	suggestion, err := h.service.SubmitSuggestion(c.Request().Context(), service.SubmitSuggestionInput{
+		PublicSlug:        c.Param("slug"),
+		SuggestedByUserID: suggestedBy,
+		SuggesterName:     req.SuggesterName,
+		Name:              req.Name,
+		Link:              req.Link,
+		Price:             req.Price,
+	})
+	if err != nil {
+		return mapSuggestionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromSuggestionOutput(suggestion))
+}
+
+// ListSuggestions godoc
+//
+//	@Summary		List suggestions for a wishlist
+//	@Description	Owner inbox of item suggestions, newest first.
+//	@Tags			Suggestions
+//	@Produce		json
+//	@Param			id		path		string						true	"Wishlist ID"
+//	@Param			status	query		string						false	"Suggestion status (default pending)"	Enums(pending, accepted, declined)
+//	@Success		200		{object}	dto.SuggestionsListResponse	"List of suggestions"
+//	@Failure		400		{object}	map[string]string			"Invalid wishlist ID or status"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Not the wishlist owner"
+//	@Failure		404		{object}	map[string]string			"Wishlist not found"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/suggestions [get]
+func (h *Handler) ListSuggestions(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	suggestions, err := h.service.ListSuggestions(c.Request().Context(), c.Param("id"), ownerID, c.QueryParam("status"))
+	if err != nil {
+		return mapSuggestionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.SuggestionsListResponse{
+		Data: dto.FromSuggestionOutputs(suggestions),
+	})
+}
+
+// AcceptSuggestion godoc
+//
+//	@Summary		Accept a suggestion
+//	@Description	Convert a pending suggestion into a new gift item on the wishlist.
+//	@Tags			Suggestions
+//	@Produce		json
+//	@Param			id	path		string					true	"Suggestion ID"
+//	@Success		200	{object}	dto.SuggestionResponse	"Suggestion accepted"
+//	@Failure		400	{object}	map[string]string		"Invalid suggestion ID"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		402	{object}	map[string]string		"Item limit of the free plan reached"
+//	@Failure		404	{object}	map[string]string		"Suggestion not found"
+//	@Failure		409	{object}	map[string]string		"Suggestion already decided"
+//	@Failure		422	{object}	map[string]string		"Item limit of the premium plan reached"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/suggestions/{id}/accept [post]
+func (h *Handler) AcceptSuggestion(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	suggestion, err := h.service.AcceptSuggestion(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapSuggestionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSuggestionOutput(suggestion))
+}
+
+// DeclineSuggestion godoc
+//
+//	@Summary		Decline a suggestion
+//	@Description	Close a pending suggestion without adding it to the wishlist.
+//	@Tags			Suggestions
+//	@Produce		json
+//	@Param			id	path		string					true	"Suggestion ID"
+//	@Success		200	{object}	dto.SuggestionResponse	"Suggestion declined"
+//	@Failure		400	{object}	map[string]string		"Invalid suggestion ID"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		404	{object}	map[string]string		"Suggestion not found"
+//	@Failure		409	{object}	map[string]string		"Suggestion already decided"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/suggestions/{id}/decline [post]
+func (h *Handler) DeclineSuggestion(c echo.Context) error {
+	ownerID, err := helpers.ParseUUID(c, auth.MustGetUserID(c))
+	if err != nil {
+		return err
+	}
+
+	suggestion, err := h.service.DeclineSuggestion(c.Request().Context(), c.Param("id"), ownerID)
+	if err != nil {
+		return mapSuggestionServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSuggestionOutput(suggestion))
+}
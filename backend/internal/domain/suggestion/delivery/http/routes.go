@@ -0,0 +1,29 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"wish-list/internal/app/middleware"
+)
+
+// RegisterRoutes registers all item suggestion HTTP routes
+func RegisterRoutes(
+	e *echo.Echo,
+	h *Handler,
+	optionalAuthMiddleware echo.MiddlewareFunc,
+	authMiddleware echo.MiddlewareFunc,
+	captchaMiddleware echo.MiddlewareFunc,
+) {
+	// Public suggestion box — rate limited per IP (5 req/min), CAPTCHA for guests only.
+	suggestionLimiter := middleware.NewSuggestionRateLimiter()
+	e.POST("/api/public/wishlists/:slug/suggestions", h.CreateSuggestion,
+		middleware.AuthRateLimitMiddleware(suggestionLimiter, middleware.IPIdentifier),
+		optionalAuthMiddleware,
+		captchaMiddleware)
+
+	// Owner inbox
+	e.GET("/api/wishlists/:id/suggestions", h.ListSuggestions, authMiddleware)
+	suggestions := e.Group("/api/suggestions", authMiddleware)
+	suggestions.POST("/:id/accept", h.AcceptSuggestion)
+	suggestions.POST("/:id/decline", h.DeclineSuggestion)
+}
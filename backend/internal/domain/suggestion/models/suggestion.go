@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Suggestion statuses
+const (
+	StatusPending  = "pending"
+	StatusAccepted = "accepted"
+	StatusDeclined = "declined"
+)
+
+type Suggestion struct {
+	ID                pgtype.UUID        `db:"id"`
+	WishlistID        pgtype.UUID        `db:"wishlist_id"`
+	SuggestedByUserID pgtype.UUID        `db:"suggested_by_user_id"` // NULL for guest suggestions
+	SuggesterName     string             `db:"suggester_name"`
+	Name              string             `db:"name"`
+	Link              pgtype.Text        `db:"link"`
+	Price             pgtype.Numeric     `db:"price"`
+	Status            string             `db:"status"`
+	GiftItemID        pgtype.UUID        `db:"gift_item_id"` // Set when accepted
+	DecidedAt         pgtype.Timestamptz `db:"decided_at"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at"`
+}
@@ -0,0 +1,144 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_suggestion_repository_test.go -pkg service . SuggestionRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/suggestion/models"
+)
+
+// Sentinel errors for suggestion repository
+var (
+	ErrSuggestionNotFound = errors.New("suggestion not found")
+)
+
+const suggestionColumns = `
+	id, wishlist_id, suggested_by_user_id, suggester_name, name, link, price,
+	status, gift_item_id, decided_at, created_at, updated_at
+`
+
+// SuggestionRepositoryInterface defines the interface for item suggestion database operations
+type SuggestionRepositoryInterface interface {
+	Create(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error)
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error)
+	ListByWishlist(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error)
+	MarkAccepted(ctx context.Context, id, giftItemID pgtype.UUID) (*models.Suggestion, error)
+	MarkDeclined(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error)
+}
+
+type SuggestionRepository struct {
+	db *database.DB
+}
+
+func NewSuggestionRepository(db *database.DB) SuggestionRepositoryInterface {
+	return &SuggestionRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new pending suggestion
+func (r *SuggestionRepository) Create(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error) {
+	query := `
+		INSERT INTO item_suggestions (wishlist_id, suggested_by_user_id, suggester_name, name, link, price)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + suggestionColumns
+
+	var created models.Suggestion
+	err := r.db.QueryRowxContext(ctx, query,
+		suggestion.WishlistID,
+		suggestion.SuggestedByUserID,
+		suggestion.SuggesterName,
+		suggestion.Name,
+		suggestion.Link,
+		suggestion.Price,
+	).StructScan(&created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create suggestion: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a suggestion by ID
+func (r *SuggestionRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+	query := `SELECT ` + suggestionColumns + ` FROM item_suggestions WHERE id = $1`
+
+	var suggestion models.Suggestion
+	if err := r.db.GetContext(ctx, &suggestion, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSuggestionNotFound
+		}
+		return nil, fmt.Errorf("failed to get suggestion: %w", err)
+	}
+
+	return &suggestion, nil
+}
+
+// ListByWishlist returns the suggestions on a wishlist with the given status, newest first
+func (r *SuggestionRepository) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error) {
+	query := `
+		SELECT ` + suggestionColumns + `
+		FROM item_suggestions
+		WHERE wishlist_id = $1 AND status = $2
+		ORDER BY created_at DESC
+	`
+
+	var suggestions []*models.Suggestion
+	if err := r.db.SelectContext(ctx, &suggestions, query, wishlistID, status); err != nil {
+		return nil, fmt.Errorf("failed to list suggestions: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+// MarkAccepted closes a pending suggestion and links the gift item created from it.
+// Returns ErrSuggestionNotFound if the suggestion does not exist or was already decided.
+func (r *SuggestionRepository) MarkAccepted(ctx context.Context, id, giftItemID pgtype.UUID) (*models.Suggestion, error) {
+	query := `
+		UPDATE item_suggestions SET
+			status = 'accepted',
+			gift_item_id = $2,
+			decided_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + suggestionColumns
+
+	var suggestion models.Suggestion
+	if err := r.db.QueryRowxContext(ctx, query, id, giftItemID).StructScan(&suggestion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSuggestionNotFound
+		}
+		return nil, fmt.Errorf("failed to accept suggestion: %w", err)
+	}
+
+	return &suggestion, nil
+}
+
+// MarkDeclined closes a pending suggestion as declined.
+// Returns ErrSuggestionNotFound if the suggestion does not exist or was already decided.
+func (r *SuggestionRepository) MarkDeclined(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+	query := `
+		UPDATE item_suggestions SET
+			status = 'declined',
+			decided_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + suggestionColumns
+
+	var suggestion models.Suggestion
+	if err := r.db.QueryRowxContext(ctx, query, id).StructScan(&suggestion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSuggestionNotFound
+		}
+		return nil, fmt.Errorf("failed to decline suggestion: %w", err)
+	}
+
+	return &suggestion, nil
+}
@@ -0,0 +1,449 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistitemservice "wish-list/internal/domain/wishlist_item/service"
+)
+
+// Ensure, that WishListRepositoryInterfaceMock does implement WishListRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ WishListRepositoryInterface = &WishListRepositoryInterfaceMock{}
+
+// WishListRepositoryInterfaceMock is a mock implementation of WishListRepositoryInterface.
+//
+//	func TestSomethingThatUsesWishListRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked WishListRepositoryInterface
+//		mockedWishListRepositoryInterface := &WishListRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+//				panic("mock out the GetByPublicSlug method")
+//			},
+//		}
+//
+//		// use mockedWishListRepositoryInterface in code that requires WishListRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type WishListRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+
+	// GetByPublicSlugFunc mocks the GetByPublicSlug method.
+	GetByPublicSlugFunc func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// GetByPublicSlug holds details about calls to the GetByPublicSlug method.
+		GetByPublicSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
+	}
+	lockGetByID         sync.RWMutex
+	lockGetByPublicSlug sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+	if mock.GetByIDFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByIDFunc: method is nil but WishListRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByIDCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// GetByPublicSlug calls GetByPublicSlugFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+	if mock.GetByPublicSlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByPublicSlugFunc: method is nil but WishListRepositoryInterface.GetByPublicSlug was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetByPublicSlug.Lock()
+	mock.calls.GetByPublicSlug = append(mock.calls.GetByPublicSlug, callInfo)
+	mock.lockGetByPublicSlug.Unlock()
+	return mock.GetByPublicSlugFunc(ctx, publicSlug)
+}
+
+// GetByPublicSlugCalls gets all the calls that were made to GetByPublicSlug.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByPublicSlugCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByPublicSlugCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetByPublicSlug.RLock()
+	calls = mock.calls.GetByPublicSlug
+	mock.lockGetByPublicSlug.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRepositoryInterfaceMock does implement UserRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ UserRepositoryInterface = &UserRepositoryInterfaceMock{}
+
+// UserRepositoryInterfaceMock is a mock implementation of UserRepositoryInterface.
+//
+//	func TestSomethingThatUsesUserRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked UserRepositoryInterface
+//		mockedUserRepositoryInterface := &UserRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+//				panic("mock out the GetByID method")
+//			},
+//		}
+//
+//		// use mockedUserRepositoryInterface in code that requires UserRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockGetByID sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *UserRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+	if mock.GetByIDFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByIDFunc: method is nil but UserRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByIDCalls())
+func (mock *UserRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Ensure, that WishlistItemCreatorInterfaceMock does implement WishlistItemCreatorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ WishlistItemCreatorInterface = &WishlistItemCreatorInterfaceMock{}
+
+// WishlistItemCreatorInterfaceMock is a mock implementation of WishlistItemCreatorInterface.
+//
+//	func TestSomethingThatUsesWishlistItemCreatorInterface(t *testing.T) {
+//
+//		// make and configure a mocked WishlistItemCreatorInterface
+//		mockedWishlistItemCreatorInterface := &WishlistItemCreatorInterfaceMock{
+//			CreateItemInWishlistFunc: func(ctx context.Context, wishlistID string, userID string, input wishlistitemservice.CreateItemInput) (*wishlistitemservice.ItemOutput, error) {
+//				panic("mock out the CreateItemInWishlist method")
+//			},
+//		}
+//
+//		// use mockedWishlistItemCreatorInterface in code that requires WishlistItemCreatorInterface
+//		// and then make assertions.
+//
+//	}
+type WishlistItemCreatorInterfaceMock struct {
+	// CreateItemInWishlistFunc mocks the CreateItemInWishlist method.
+	CreateItemInWishlistFunc func(ctx context.Context, wishlistID string, userID string, input wishlistitemservice.CreateItemInput) (*wishlistitemservice.ItemOutput, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateItemInWishlist holds details about calls to the CreateItemInWishlist method.
+		CreateItemInWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID string
+			// UserID is the userID argument value.
+			UserID string
+			// Input is the input argument value.
+			Input wishlistitemservice.CreateItemInput
+		}
+	}
+	lockCreateItemInWishlist sync.RWMutex
+}
+
+// CreateItemInWishlist calls CreateItemInWishlistFunc.
+func (mock *WishlistItemCreatorInterfaceMock) CreateItemInWishlist(ctx context.Context, wishlistID string, userID string, input wishlistitemservice.CreateItemInput) (*wishlistitemservice.ItemOutput, error) {
+	if mock.CreateItemInWishlistFunc == nil {
+		panic("WishlistItemCreatorInterfaceMock.CreateItemInWishlistFunc: method is nil but WishlistItemCreatorInterface.CreateItemInWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID string
+		UserID     string
+		Input      wishlistitemservice.CreateItemInput
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+		UserID:     userID,
+		Input:      input,
+	}
+	mock.lockCreateItemInWishlist.Lock()
+	mock.calls.CreateItemInWishlist = append(mock.calls.CreateItemInWishlist, callInfo)
+	mock.lockCreateItemInWishlist.Unlock()
+	return mock.CreateItemInWishlistFunc(ctx, wishlistID, userID, input)
+}
+
+// CreateItemInWishlistCalls gets all the calls that were made to CreateItemInWishlist.
+// Check the length with:
+//
+//	len(mockedWishlistItemCreatorInterface.CreateItemInWishlistCalls())
+func (mock *WishlistItemCreatorInterfaceMock) CreateItemInWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID string
+	UserID     string
+	Input      wishlistitemservice.CreateItemInput
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID string
+		UserID     string
+		Input      wishlistitemservice.CreateItemInput
+	}
+	mock.lockCreateItemInWishlist.RLock()
+	calls = mock.calls.CreateItemInWishlist
+	mock.lockCreateItemInWishlist.RUnlock()
+	return calls
+}
+
+// Ensure, that SuggestionEmailSenderInterfaceMock does implement SuggestionEmailSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ SuggestionEmailSenderInterface = &SuggestionEmailSenderInterfaceMock{}
+
+// SuggestionEmailSenderInterfaceMock is a mock implementation of SuggestionEmailSenderInterface.
+//
+//	func TestSomethingThatUsesSuggestionEmailSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked SuggestionEmailSenderInterface
+//		mockedSuggestionEmailSenderInterface := &SuggestionEmailSenderInterfaceMock{
+//			SendNewSuggestionEmailFunc: func(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, suggesterName string) error {
+//				panic("mock out the SendNewSuggestionEmail method")
+//			},
+//			SendSuggestionDecisionEmailFunc: func(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, accepted bool) error {
+//				panic("mock out the SendSuggestionDecisionEmail method")
+//			},
+//		}
+//
+//		// use mockedSuggestionEmailSenderInterface in code that requires SuggestionEmailSenderInterface
+//		// and then make assertions.
+//
+//	}
+type SuggestionEmailSenderInterfaceMock struct {
+	// SendNewSuggestionEmailFunc mocks the SendNewSuggestionEmail method.
+	SendNewSuggestionEmailFunc func(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, suggesterName string) error
+
+	// SendSuggestionDecisionEmailFunc mocks the SendSuggestionDecisionEmail method.
+	SendSuggestionDecisionEmailFunc func(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, accepted bool) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendNewSuggestionEmail holds details about calls to the SendNewSuggestionEmail method.
+		SendNewSuggestionEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// SuggestionName is the suggestionName argument value.
+			SuggestionName string
+			// WishlistTitle is the wishlistTitle argument value.
+			WishlistTitle string
+			// SuggesterName is the suggesterName argument value.
+			SuggesterName string
+		}
+		// SendSuggestionDecisionEmail holds details about calls to the SendSuggestionDecisionEmail method.
+		SendSuggestionDecisionEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// SuggestionName is the suggestionName argument value.
+			SuggestionName string
+			// WishlistTitle is the wishlistTitle argument value.
+			WishlistTitle string
+			// Accepted is the accepted argument value.
+			Accepted bool
+		}
+	}
+	lockSendNewSuggestionEmail      sync.RWMutex
+	lockSendSuggestionDecisionEmail sync.RWMutex
+}
+
+// SendNewSuggestionEmail calls SendNewSuggestionEmailFunc.
+func (mock *SuggestionEmailSenderInterfaceMock) SendNewSuggestionEmail(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, suggesterName string) error {
+	if mock.SendNewSuggestionEmailFunc == nil {
+		panic("SuggestionEmailSenderInterfaceMock.SendNewSuggestionEmailFunc: method is nil but SuggestionEmailSenderInterface.SendNewSuggestionEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		SuggestionName string
+		WishlistTitle  string
+		SuggesterName  string
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		SuggestionName: suggestionName,
+		WishlistTitle:  wishlistTitle,
+		SuggesterName:  suggesterName,
+	}
+	mock.lockSendNewSuggestionEmail.Lock()
+	mock.calls.SendNewSuggestionEmail = append(mock.calls.SendNewSuggestionEmail, callInfo)
+	mock.lockSendNewSuggestionEmail.Unlock()
+	return mock.SendNewSuggestionEmailFunc(ctx, recipientEmail, suggestionName, wishlistTitle, suggesterName)
+}
+
+// SendNewSuggestionEmailCalls gets all the calls that were made to SendNewSuggestionEmail.
+// Check the length with:
+//
+//	len(mockedSuggestionEmailSenderInterface.SendNewSuggestionEmailCalls())
+func (mock *SuggestionEmailSenderInterfaceMock) SendNewSuggestionEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	SuggestionName string
+	WishlistTitle  string
+	SuggesterName  string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		SuggestionName string
+		WishlistTitle  string
+		SuggesterName  string
+	}
+	mock.lockSendNewSuggestionEmail.RLock()
+	calls = mock.calls.SendNewSuggestionEmail
+	mock.lockSendNewSuggestionEmail.RUnlock()
+	return calls
+}
+
+// SendSuggestionDecisionEmail calls SendSuggestionDecisionEmailFunc.
+func (mock *SuggestionEmailSenderInterfaceMock) SendSuggestionDecisionEmail(ctx context.Context, recipientEmail string, suggestionName string, wishlistTitle string, accepted bool) error {
+	if mock.SendSuggestionDecisionEmailFunc == nil {
+		panic("SuggestionEmailSenderInterfaceMock.SendSuggestionDecisionEmailFunc: method is nil but SuggestionEmailSenderInterface.SendSuggestionDecisionEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		SuggestionName string
+		WishlistTitle  string
+		Accepted       bool
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		SuggestionName: suggestionName,
+		WishlistTitle:  wishlistTitle,
+		Accepted:       accepted,
+	}
+	mock.lockSendSuggestionDecisionEmail.Lock()
+	mock.calls.SendSuggestionDecisionEmail = append(mock.calls.SendSuggestionDecisionEmail, callInfo)
+	mock.lockSendSuggestionDecisionEmail.Unlock()
+	return mock.SendSuggestionDecisionEmailFunc(ctx, recipientEmail, suggestionName, wishlistTitle, accepted)
+}
+
+// SendSuggestionDecisionEmailCalls gets all the calls that were made to SendSuggestionDecisionEmail.
+// Check the length with:
+//
+//	len(mockedSuggestionEmailSenderInterface.SendSuggestionDecisionEmailCalls())
+func (mock *SuggestionEmailSenderInterfaceMock) SendSuggestionDecisionEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	SuggestionName string
+	WishlistTitle  string
+	Accepted       bool
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		SuggestionName string
+		WishlistTitle  string
+		Accepted       bool
+	}
+	mock.lockSendSuggestionDecisionEmail.RLock()
+	calls = mock.calls.SendSuggestionDecisionEmail
+	mock.lockSendSuggestionDecisionEmail.RUnlock()
+	return calls
+}
@@ -0,0 +1,296 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"wish-list/internal/domain/suggestion/models"
+	"wish-list/internal/domain/suggestion/repository"
+)
+
+// Ensure, that SuggestionRepositoryInterfaceMock does implement repository.SuggestionRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.SuggestionRepositoryInterface = &SuggestionRepositoryInterfaceMock{}
+
+// SuggestionRepositoryInterfaceMock is a mock implementation of repository.SuggestionRepositoryInterface.
+//
+//	func TestSomethingThatUsesSuggestionRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.SuggestionRepositoryInterface
+//		mockedSuggestionRepositoryInterface := &SuggestionRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error) {
+//				panic("mock out the Create method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error) {
+//				panic("mock out the ListByWishlist method")
+//			},
+//			MarkAcceptedFunc: func(ctx context.Context, id pgtype.UUID, giftItemID pgtype.UUID) (*models.Suggestion, error) {
+//				panic("mock out the MarkAccepted method")
+//			},
+//			MarkDeclinedFunc: func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+//				panic("mock out the MarkDeclined method")
+//			},
+//		}
+//
+//		// use mockedSuggestionRepositoryInterface in code that requires repository.SuggestionRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type SuggestionRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error)
+
+	// ListByWishlistFunc mocks the ListByWishlist method.
+	ListByWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error)
+
+	// MarkAcceptedFunc mocks the MarkAccepted method.
+	MarkAcceptedFunc func(ctx context.Context, id pgtype.UUID, giftItemID pgtype.UUID) (*models.Suggestion, error)
+
+	// MarkDeclinedFunc mocks the MarkDeclined method.
+	MarkDeclinedFunc func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Suggestion is the suggestion argument value.
+			Suggestion models.Suggestion
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// ListByWishlist holds details about calls to the ListByWishlist method.
+		ListByWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+			// Status is the status argument value.
+			Status string
+		}
+		// MarkAccepted holds details about calls to the MarkAccepted method.
+		MarkAccepted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// GiftItemID is the giftItemID argument value.
+			GiftItemID pgtype.UUID
+		}
+		// MarkDeclined holds details about calls to the MarkDeclined method.
+		MarkDeclined []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockCreate         sync.RWMutex
+	lockGetByID        sync.RWMutex
+	lockListByWishlist sync.RWMutex
+	lockMarkAccepted   sync.RWMutex
+	lockMarkDeclined   sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *SuggestionRepositoryInterfaceMock) Create(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error) {
+	if mock.CreateFunc == nil {
+		panic("SuggestionRepositoryInterfaceMock.CreateFunc: method is nil but SuggestionRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Suggestion models.Suggestion
+	}{
+		Ctx:        ctx,
+		Suggestion: suggestion,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, suggestion)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedSuggestionRepositoryInterface.CreateCalls())
+func (mock *SuggestionRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx        context.Context
+	Suggestion models.Suggestion
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Suggestion models.Suggestion
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *SuggestionRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+	if mock.GetByIDFunc == nil {
+		panic("SuggestionRepositoryInterfaceMock.GetByIDFunc: method is nil but SuggestionRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedSuggestionRepositoryInterface.GetByIDCalls())
+func (mock *SuggestionRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByWishlist calls ListByWishlistFunc.
+func (mock *SuggestionRepositoryInterfaceMock) ListByWishlist(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error) {
+	if mock.ListByWishlistFunc == nil {
+		panic("SuggestionRepositoryInterfaceMock.ListByWishlistFunc: method is nil but SuggestionRepositoryInterface.ListByWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		Status     string
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+		Status:     status,
+	}
+	mock.lockListByWishlist.Lock()
+	mock.calls.ListByWishlist = append(mock.calls.ListByWishlist, callInfo)
+	mock.lockListByWishlist.Unlock()
+	return mock.ListByWishlistFunc(ctx, wishlistID, status)
+}
+
+// ListByWishlistCalls gets all the calls that were made to ListByWishlist.
+// Check the length with:
+//
+//	len(mockedSuggestionRepositoryInterface.ListByWishlistCalls())
+func (mock *SuggestionRepositoryInterfaceMock) ListByWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+	Status     string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+		Status     string
+	}
+	mock.lockListByWishlist.RLock()
+	calls = mock.calls.ListByWishlist
+	mock.lockListByWishlist.RUnlock()
+	return calls
+}
+
+// MarkAccepted calls MarkAcceptedFunc.
+func (mock *SuggestionRepositoryInterfaceMock) MarkAccepted(ctx context.Context, id pgtype.UUID, giftItemID pgtype.UUID) (*models.Suggestion, error) {
+	if mock.MarkAcceptedFunc == nil {
+		panic("SuggestionRepositoryInterfaceMock.MarkAcceptedFunc: method is nil but SuggestionRepositoryInterface.MarkAccepted was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		GiftItemID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		GiftItemID: giftItemID,
+	}
+	mock.lockMarkAccepted.Lock()
+	mock.calls.MarkAccepted = append(mock.calls.MarkAccepted, callInfo)
+	mock.lockMarkAccepted.Unlock()
+	return mock.MarkAcceptedFunc(ctx, id, giftItemID)
+}
+
+// MarkAcceptedCalls gets all the calls that were made to MarkAccepted.
+// Check the length with:
+//
+//	len(mockedSuggestionRepositoryInterface.MarkAcceptedCalls())
+func (mock *SuggestionRepositoryInterfaceMock) MarkAcceptedCalls() []struct {
+	Ctx        context.Context
+	ID         pgtype.UUID
+	GiftItemID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         pgtype.UUID
+		GiftItemID pgtype.UUID
+	}
+	mock.lockMarkAccepted.RLock()
+	calls = mock.calls.MarkAccepted
+	mock.lockMarkAccepted.RUnlock()
+	return calls
+}
+
+// MarkDeclined calls MarkDeclinedFunc.
+func (mock *SuggestionRepositoryInterfaceMock) MarkDeclined(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+	if mock.MarkDeclinedFunc == nil {
+		panic("SuggestionRepositoryInterfaceMock.MarkDeclinedFunc: method is nil but SuggestionRepositoryInterface.MarkDeclined was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkDeclined.Lock()
+	mock.calls.MarkDeclined = append(mock.calls.MarkDeclined, callInfo)
+	mock.lockMarkDeclined.Unlock()
+	return mock.MarkDeclinedFunc(ctx, id)
+}
+
+// MarkDeclinedCalls gets all the calls that were made to MarkDeclined.
+// Check the length with:
+//
+//	len(mockedSuggestionRepositoryInterface.MarkDeclinedCalls())
+func (mock *SuggestionRepositoryInterfaceMock) MarkDeclinedCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockMarkDeclined.RLock()
+	calls = mock.calls.MarkDeclined
+	mock.lockMarkDeclined.RUnlock()
+	return calls
+}
@@ -0,0 +1,351 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . WishListRepositoryInterface UserRepositoryInterface WishlistItemCreatorInterface SuggestionEmailSenderInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"wish-list/internal/domain/suggestion/models"
+	"wish-list/internal/domain/suggestion/repository"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	wishlistitemservice "wish-list/internal/domain/wishlist_item/service"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Cross-domain interfaces - only methods actually used by SuggestionService
+
+// WishListRepositoryInterface defines wishlist repository methods used by suggestion service
+type WishListRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
+	GetByPublicSlug(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error)
+}
+
+// UserRepositoryInterface defines user repository methods used by suggestion service
+type UserRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+}
+
+// WishlistItemCreatorInterface creates the gift item for an accepted suggestion
+type WishlistItemCreatorInterface interface {
+	CreateItemInWishlist(ctx context.Context, wishlistID, userID string, input wishlistitemservice.CreateItemInput) (*wishlistitemservice.ItemOutput, error)
+}
+
+// SuggestionEmailSenderInterface defines email methods used by suggestion service
+type SuggestionEmailSenderInterface interface {
+	SendNewSuggestionEmail(ctx context.Context, recipientEmail, suggestionName, wishlistTitle, suggesterName string) error
+	SendSuggestionDecisionEmail(ctx context.Context, recipientEmail, suggestionName, wishlistTitle string, accepted bool) error
+}
+
+var (
+	ErrInvalidSuggestionID      = errors.New("invalid suggestion id")
+	ErrInvalidWishlistID        = errors.New("invalid wishlist id")
+	ErrInvalidSuggestionStatus  = errors.New("invalid suggestion status")
+	ErrSuggestionNameRequired   = errors.New("suggestion name and suggester name are required")
+	ErrInvalidSuggestionPrice   = errors.New("invalid suggestion price")
+	ErrSuggestionNotFound       = errors.New("suggestion not found")
+	ErrSuggestionAlreadyDecided = errors.New("suggestion has already been accepted or declined")
+	ErrPublicWishlistNotFound   = errors.New("public wishlist not found")
+	ErrWishlistNotFound         = errors.New("wishlist not found")
+	ErrSuggestionAccessDenied   = errors.New("only the wishlist owner can review suggestions")
+)
+
+// SuggestionServiceInterface defines the interface for item suggestion operations
+type SuggestionServiceInterface interface {
+	SubmitSuggestion(ctx context.Context, input SubmitSuggestionInput) (*SuggestionOutput, error)
+	ListSuggestions(ctx context.Context, wishlistID string, ownerID pgtype.UUID, status string) ([]*SuggestionOutput, error)
+	AcceptSuggestion(ctx context.Context, suggestionID string, ownerID pgtype.UUID) (*SuggestionOutput, error)
+	DeclineSuggestion(ctx context.Context, suggestionID string, ownerID pgtype.UUID) (*SuggestionOutput, error)
+}
+
+type SuggestionService struct {
+	repo         repository.SuggestionRepositoryInterface
+	wishListRepo WishListRepositoryInterface
+	userRepo     UserRepositoryInterface
+	itemCreator  WishlistItemCreatorInterface
+	emailSender  SuggestionEmailSenderInterface
+}
+
+func NewSuggestionService(
+	repo repository.SuggestionRepositoryInterface,
+	wishListRepo WishListRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	itemCreator WishlistItemCreatorInterface,
+	emailSender SuggestionEmailSenderInterface,
+) *SuggestionService {
+	return &SuggestionService{
+		repo:         repo,
+		wishListRepo: wishListRepo,
+		userRepo:     userRepo,
+		itemCreator:  itemCreator,
+		emailSender:  emailSender,
+	}
+}
+
+type SubmitSuggestionInput struct {
+	PublicSlug        string
+	SuggestedByUserID pgtype.UUID // Invalid for guests
+	SuggesterName     string
+	Name              string
+	Link              *string
+	Price             *float64
+}
+
+type SuggestionOutput struct {
+	ID            pgtype.UUID
+	WishlistID    pgtype.UUID
+	SuggesterName string
+	Name          string
+	Link          pgtype.Text
+	Price         *float64
+	Status        string
+	GiftItemID    pgtype.UUID
+	DecidedAt     pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+// SubmitSuggestion records an item proposal for a public wishlist and notifies the owner by email.
+// A failed notification does not fail the suggestion.
+func (s *SuggestionService) SubmitSuggestion(ctx context.Context, input SubmitSuggestionInput) (*SuggestionOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	suggesterName := strings.TrimSpace(input.SuggesterName)
+	if name == "" || suggesterName == "" {
+		return nil, ErrSuggestionNameRequired
+	}
+
+	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, input.PublicSlug)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrPublicWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get public wishlist: %w", err)
+	}
+
+	suggestion := models.Suggestion{
+		WishlistID:        wishList.ID,
+		SuggestedByUserID: input.SuggestedByUserID,
+		SuggesterName:     suggesterName,
+		Name:              name,
+	}
+	if input.Link != nil && strings.TrimSpace(*input.Link) != "" {
+		suggestion.Link = pgtype.Text{String: strings.TrimSpace(*input.Link), Valid: true}
+	}
+	if input.Price != nil && *input.Price > 0 {
+		if err := suggestion.Price.Scan(fmt.Sprintf("%f", *input.Price)); err != nil {
+			return nil, ErrInvalidSuggestionPrice
+		}
+	}
+
+	created, err := s.repo.Create(ctx, suggestion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create suggestion: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item suggestion submitted",
+		"suggestion_id", created.ID.String(),
+		"wishlist_id", wishList.ID.String())
+
+	if owner, err := s.userRepo.GetByID(ctx, wishList.OwnerID); err != nil {
+		logger.WarnContext(ctx, "failed to load wishlist owner for suggestion notification",
+			"wishlist_id", wishList.ID.String(),
+			"error", err)
+	} else if err := s.emailSender.SendNewSuggestionEmail(ctx, owner.Email, created.Name, wishList.Title, suggesterName); err != nil {
+		logger.WarnContext(ctx, "failed to send new suggestion notification",
+			"wishlist_id", wishList.ID.String(),
+			"error", err)
+	}
+
+	return toSuggestionOutput(created), nil
+}
+
+// ListSuggestions returns the owner's suggestion inbox for a wishlist (default: pending)
+func (s *SuggestionService) ListSuggestions(ctx context.Context, wishlistID string, ownerID pgtype.UUID, status string) ([]*SuggestionOutput, error) {
+	if status == "" {
+		status = models.StatusPending
+	}
+
+	switch status {
+	case models.StatusPending, models.StatusAccepted, models.StatusDeclined:
+	default:
+		return nil, ErrInvalidSuggestionStatus
+	}
+
+	id := pgtype.UUID{}
+	if err := id.Scan(wishlistID); err != nil {
+		return nil, ErrInvalidWishlistID
+	}
+
+	wishList, err := s.wishListRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, wishlistrepo.ErrWishListNotFound) {
+			return nil, ErrWishlistNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	if wishList.OwnerID != ownerID {
+		return nil, ErrSuggestionAccessDenied
+	}
+
+	suggestions, err := s.repo.ListByWishlist(ctx, wishList.ID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suggestions: %w", err)
+	}
+
+	outputs := make([]*SuggestionOutput, len(suggestions))
+	for i, suggestion := range suggestions {
+		outputs[i] = toSuggestionOutput(suggestion)
+	}
+
+	return outputs, nil
+}
+
+// AcceptSuggestion converts a pending suggestion into a gift item on the wishlist
+func (s *SuggestionService) AcceptSuggestion(ctx context.Context, suggestionID string, ownerID pgtype.UUID) (*SuggestionOutput, error) {
+	suggestion, wishList, err := s.getPendingSuggestion(ctx, suggestionID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemInput := wishlistitemservice.CreateItemInput{
+		Title: suggestion.Name,
+	}
+	if suggestion.Link.Valid {
+		link := suggestion.Link.String
+		itemInput.Link = &link
+	}
+	itemInput.Price = numericToFloat(suggestion.Price)
+
+	item, err := s.itemCreator.CreateItemInWishlist(ctx, wishList.ID.String(), ownerID.String(), itemInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gift item from suggestion: %w", err)
+	}
+
+	giftItemID := pgtype.UUID{}
+	if err := giftItemID.Scan(item.ID); err != nil {
+		return nil, fmt.Errorf("failed to parse created gift item id: %w", err)
+	}
+
+	accepted, err := s.repo.MarkAccepted(ctx, suggestion.ID, giftItemID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSuggestionNotFound) {
+			return nil, ErrSuggestionAlreadyDecided
+		}
+		return nil, fmt.Errorf("failed to accept suggestion: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item suggestion accepted",
+		"suggestion_id", accepted.ID.String(),
+		"gift_item_id", item.ID)
+
+	s.notifySuggester(ctx, accepted, wishList, true)
+
+	return toSuggestionOutput(accepted), nil
+}
+
+// DeclineSuggestion closes a pending suggestion without creating an item
+func (s *SuggestionService) DeclineSuggestion(ctx context.Context, suggestionID string, ownerID pgtype.UUID) (*SuggestionOutput, error) {
+	suggestion, wishList, err := s.getPendingSuggestion(ctx, suggestionID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	declined, err := s.repo.MarkDeclined(ctx, suggestion.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSuggestionNotFound) {
+			return nil, ErrSuggestionAlreadyDecided
+		}
+		return nil, fmt.Errorf("failed to decline suggestion: %w", err)
+	}
+
+	logger.InfoContext(ctx, "item suggestion declined", "suggestion_id", declined.ID.String())
+
+	s.notifySuggester(ctx, declined, wishList, false)
+
+	return toSuggestionOutput(declined), nil
+}
+
+// getPendingSuggestion loads a suggestion that the caller may still decide on
+func (s *SuggestionService) getPendingSuggestion(ctx context.Context, suggestionID string, ownerID pgtype.UUID) (*models.Suggestion, *wishlistmodels.WishList, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(suggestionID); err != nil {
+		return nil, nil, ErrInvalidSuggestionID
+	}
+
+	suggestion, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrSuggestionNotFound) {
+			return nil, nil, ErrSuggestionNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get suggestion: %w", err)
+	}
+
+	wishList, err := s.wishListRepo.GetByID(ctx, suggestion.WishlistID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	// Don't reveal suggestions on other users' wishlists
+	if wishList.OwnerID != ownerID {
+		return nil, nil, ErrSuggestionNotFound
+	}
+
+	if suggestion.Status != models.StatusPending {
+		return nil, nil, ErrSuggestionAlreadyDecided
+	}
+
+	return suggestion, wishList, nil
+}
+
+// notifySuggester emails registered suggesters about the owner's decision; guests leave no address
+func (s *SuggestionService) notifySuggester(ctx context.Context, suggestion *models.Suggestion, wishList *wishlistmodels.WishList, accepted bool) {
+	if !suggestion.SuggestedByUserID.Valid {
+		return
+	}
+
+	suggester, err := s.userRepo.GetByID(ctx, suggestion.SuggestedByUserID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to load suggester for decision notification",
+			"suggestion_id", suggestion.ID.String(),
+			"error", err)
+		return
+	}
+
+	if err := s.emailSender.SendSuggestionDecisionEmail(ctx, suggester.Email, suggestion.Name, wishList.Title, accepted); err != nil {
+		logger.WarnContext(ctx, "failed to send suggestion decision notification",
+			"suggestion_id", suggestion.ID.String(),
+			"error", err)
+	}
+}
+
+func numericToFloat(n pgtype.Numeric) *float64 {
+	if !n.Valid {
+		return nil
+	}
+	value, err := n.Float64Value()
+	if err != nil || !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
+
+func toSuggestionOutput(suggestion *models.Suggestion) *SuggestionOutput {
+	return &SuggestionOutput{
+		ID:            suggestion.ID,
+		WishlistID:    suggestion.WishlistID,
+		SuggesterName: suggestion.SuggesterName,
+		Name:          suggestion.Name,
+		Link:          suggestion.Link,
+		Price:         numericToFloat(suggestion.Price),
+		Status:        suggestion.Status,
+		GiftItemID:    suggestion.GiftItemID,
+		DecidedAt:     suggestion.DecidedAt,
+		CreatedAt:     suggestion.CreatedAt,
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wish-list/internal/domain/item/mapper"
+	"wish-list/internal/domain/suggestion/models"
+	usermodels "wish-list/internal/domain/user/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	wishlistrepo "wish-list/internal/domain/wishlist/repository"
+	wishlistitemservice "wish-list/internal/domain/wishlist_item/service"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	logger.Initialize("test")
+}
+
+var (
+	testWishlistID   = pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	testOwnerID      = pgtype.UUID{Bytes: [16]byte{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, Valid: true}
+	testSuggestionID = pgtype.UUID{Bytes: [16]byte{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, Valid: true}
+	testSuggesterID  = pgtype.UUID{Bytes: [16]byte{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, Valid: true}
+	testGiftItemID   = pgtype.UUID{Bytes: [16]byte{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, Valid: true}
+)
+
+type suggestionMocks struct {
+	repo      *SuggestionRepositoryInterfaceMock
+	wishLists *WishListRepositoryInterfaceMock
+	users     *UserRepositoryInterfaceMock
+	creator   *WishlistItemCreatorInterfaceMock
+	email     *SuggestionEmailSenderInterfaceMock
+}
+
+func newSuggestionMocks() *suggestionMocks {
+	wishList := &wishlistmodels.WishList{ID: testWishlistID, OwnerID: testOwnerID, Title: "Birthday"}
+	return &suggestionMocks{
+		repo: &SuggestionRepositoryInterfaceMock{},
+		wishLists: &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+				return wishList, nil
+			},
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error) {
+				return wishList, nil
+			},
+		},
+		users: &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				if id == testOwnerID {
+					return &usermodels.User{ID: id, Email: "owner@example.com"}, nil
+				}
+				return &usermodels.User{ID: id, Email: "friend@example.com"}, nil
+			},
+		},
+		creator: &WishlistItemCreatorInterfaceMock{},
+		email: &SuggestionEmailSenderInterfaceMock{
+			SendNewSuggestionEmailFunc: func(ctx context.Context, recipientEmail, suggestionName, wishlistTitle, suggesterName string) error {
+				return nil
+			},
+			SendSuggestionDecisionEmailFunc: func(ctx context.Context, recipientEmail, suggestionName, wishlistTitle string, accepted bool) error {
+				return nil
+			},
+		},
+	}
+}
+
+func (m *suggestionMocks) service() *SuggestionService {
+	return NewSuggestionService(m.repo, m.wishLists, m.users, m.creator, m.email)
+}
+
+func pendingSuggestion(suggestedBy pgtype.UUID) func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+	return func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+		suggestion := &models.Suggestion{
+			ID:                id,
+			WishlistID:        testWishlistID,
+			SuggestedByUserID: suggestedBy,
+			Name:              "Wool scarf",
+			Link:              pgtype.Text{String: "https://example.com/scarf", Valid: true},
+			Status:            models.StatusPending,
+		}
+		_ = suggestion.Price.Scan("29.99")
+		return suggestion, nil
+	}
+}
+
+func TestSuggestionService_SubmitSuggestion(t *testing.T) {
+	price := 29.99
+	input := SubmitSuggestionInput{
+		PublicSlug:    "birthday",
+		SuggesterName: " Aunt May ",
+		Name:          " Wool scarf ",
+		Price:         &price,
+	}
+
+	t.Run("creates suggestion and notifies owner", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.CreateFunc = func(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error) {
+			suggestion.ID = testSuggestionID
+			suggestion.Status = models.StatusPending
+			return &suggestion, nil
+		}
+
+		output, err := m.service().SubmitSuggestion(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Wool scarf", output.Name)
+		assert.Equal(t, "Aunt May", output.SuggesterName)
+		require.NotNil(t, output.Price)
+		assert.InDelta(t, 29.99, *output.Price, 0.001)
+
+		require.Len(t, m.email.SendNewSuggestionEmailCalls(), 1)
+		assert.Equal(t, "owner@example.com", m.email.SendNewSuggestionEmailCalls()[0].RecipientEmail)
+	})
+
+	t.Run("notification failure does not fail the suggestion", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.CreateFunc = func(ctx context.Context, suggestion models.Suggestion) (*models.Suggestion, error) {
+			return &suggestion, nil
+		}
+		m.email.SendNewSuggestionEmailFunc = func(ctx context.Context, recipientEmail, suggestionName, wishlistTitle, suggesterName string) error {
+			return errors.New("smtp down")
+		}
+
+		_, err := m.service().SubmitSuggestion(context.Background(), input)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("name required", func(t *testing.T) {
+		m := newSuggestionMocks()
+		emptyInput := input
+		emptyInput.Name = "  "
+
+		_, err := m.service().SubmitSuggestion(context.Background(), emptyInput)
+
+		assert.ErrorIs(t, err, ErrSuggestionNameRequired)
+	})
+
+	t.Run("wishlist not public", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.wishLists.GetByPublicSlugFunc = func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return nil, wishlistrepo.ErrWishListNotFound
+		}
+
+		_, err := m.service().SubmitSuggestion(context.Background(), input)
+
+		assert.ErrorIs(t, err, ErrPublicWishlistNotFound)
+	})
+}
+
+func TestSuggestionService_ListSuggestions(t *testing.T) {
+	t.Run("defaults to pending", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.ListByWishlistFunc = func(ctx context.Context, wishlistID pgtype.UUID, status string) ([]*models.Suggestion, error) {
+			return []*models.Suggestion{{ID: testSuggestionID, Status: status}}, nil
+		}
+
+		suggestions, err := m.service().ListSuggestions(context.Background(), testWishlistID.String(), testOwnerID, "")
+
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, models.StatusPending, m.repo.ListByWishlistCalls()[0].Status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		m := newSuggestionMocks()
+
+		_, err := m.service().ListSuggestions(context.Background(), testWishlistID.String(), testOwnerID, "maybe")
+
+		assert.ErrorIs(t, err, ErrInvalidSuggestionStatus)
+	})
+
+	t.Run("non-owner is denied", func(t *testing.T) {
+		m := newSuggestionMocks()
+
+		_, err := m.service().ListSuggestions(context.Background(), testWishlistID.String(), testSuggesterID, "")
+
+		assert.ErrorIs(t, err, ErrSuggestionAccessDenied)
+	})
+}
+
+func TestSuggestionService_AcceptSuggestion(t *testing.T) {
+	t.Run("creates item and notifies registered suggester", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.GetByIDFunc = pendingSuggestion(testSuggesterID)
+		m.creator.CreateItemInWishlistFunc = func(ctx context.Context, wishlistID, userID string, input wishlistitemservice.CreateItemInput) (*wishlistitemservice.ItemOutput, error) {
+			return &wishlistitemservice.ItemOutput{Item: mapper.Item{ID: testGiftItemID.String()}}, nil
+		}
+		m.repo.MarkAcceptedFunc = func(ctx context.Context, id, giftItemID pgtype.UUID) (*models.Suggestion, error) {
+			return &models.Suggestion{ID: id, SuggestedByUserID: testSuggesterID, Name: "Wool scarf", Status: models.StatusAccepted, GiftItemID: giftItemID}, nil
+		}
+
+		output, err := m.service().AcceptSuggestion(context.Background(), testSuggestionID.String(), testOwnerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusAccepted, output.Status)
+		assert.Equal(t, testGiftItemID, output.GiftItemID)
+
+		require.Len(t, m.creator.CreateItemInWishlistCalls(), 1)
+		call := m.creator.CreateItemInWishlistCalls()[0]
+		assert.Equal(t, testWishlistID.String(), call.WishlistID)
+		assert.Equal(t, testOwnerID.String(), call.UserID)
+		assert.Equal(t, "Wool scarf", call.Input.Title)
+		require.NotNil(t, call.Input.Link)
+		require.NotNil(t, call.Input.Price)
+		assert.InDelta(t, 29.99, *call.Input.Price, 0.001)
+
+		require.Len(t, m.email.SendSuggestionDecisionEmailCalls(), 1)
+		decision := m.email.SendSuggestionDecisionEmailCalls()[0]
+		assert.Equal(t, "friend@example.com", decision.RecipientEmail)
+		assert.True(t, decision.Accepted)
+	})
+
+	t.Run("already decided", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.GetByIDFunc = func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+			return &models.Suggestion{ID: id, WishlistID: testWishlistID, Status: models.StatusDeclined}, nil
+		}
+
+		_, err := m.service().AcceptSuggestion(context.Background(), testSuggestionID.String(), testOwnerID)
+
+		assert.ErrorIs(t, err, ErrSuggestionAlreadyDecided)
+		assert.Empty(t, m.creator.CreateItemInWishlistCalls())
+	})
+
+	t.Run("other user's suggestion is not found", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.GetByIDFunc = pendingSuggestion(pgtype.UUID{})
+
+		_, err := m.service().AcceptSuggestion(context.Background(), testSuggestionID.String(), testSuggesterID)
+
+		assert.ErrorIs(t, err, ErrSuggestionNotFound)
+	})
+}
+
+func TestSuggestionService_DeclineSuggestion(t *testing.T) {
+	t.Run("guest suggestion declined without email", func(t *testing.T) {
+		m := newSuggestionMocks()
+		m.repo.GetByIDFunc = pendingSuggestion(pgtype.UUID{})
+		m.repo.MarkDeclinedFunc = func(ctx context.Context, id pgtype.UUID) (*models.Suggestion, error) {
+			return &models.Suggestion{ID: id, Status: models.StatusDeclined}, nil
+		}
+
+		output, err := m.service().DeclineSuggestion(context.Background(), testSuggestionID.String(), testOwnerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusDeclined, output.Status)
+		assert.Empty(t, m.email.SendSuggestionDecisionEmailCalls())
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		m := newSuggestionMocks()
+
+		_, err := m.service().DeclineSuggestion(context.Background(), "nope", testOwnerID)
+
+		assert.ErrorIs(t, err, ErrInvalidSuggestionID)
+	})
+}
@@ -53,3 +53,22 @@ func (r *UpdateProfileRequest) ToDomain() userservice.UpdateProfileInput {
 		AvatarUrl: r.AvatarUrl,
 	}
 }
+
+// UpdatePublicProfileRequest represents the public profile settings update request.
+// Omitted fields are kept; empty strings clear them.
+type UpdatePublicProfileRequest struct {
+	Username    *string `json:"username" validate:"omitempty,max=30" example:"anna_k"`
+	DisplayName *string `json:"display_name" validate:"omitempty,max=50" example:"Anna"`
+	Bio         *string `json:"bio" validate:"omitempty,max=500"`
+	ShowAvatar  *bool   `json:"show_avatar"`
+}
+
+// ToDomain converts the request DTO to a service input
+func (r *UpdatePublicProfileRequest) ToDomain() userservice.UpdatePublicProfileInput {
+	return userservice.UpdatePublicProfileInput{
+		Username:    r.Username,
+		DisplayName: r.DisplayName,
+		Bio:         r.Bio,
+		ShowAvatar:  r.ShowAvatar,
+	}
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	userservice "wish-list/internal/domain/user/service"
 )
 
@@ -16,6 +18,9 @@ type UserResponse struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 	AvatarUrl string `json:"avatar_url"`
+	Plan      string `json:"plan" enums:"free,premium"`
+	// Set while a user-requested account deletion is pending
+	DeletionRequestedAt *string `json:"deletion_requested_at,omitempty"`
 }
 
 // UserResponseFromDomain maps service layer UserOutput to handler layer UserResponse
@@ -23,13 +28,26 @@ func UserResponseFromDomain(user *userservice.UserOutput) *UserResponse {
 	if user == nil {
 		return nil
 	}
-	return &UserResponse{
+	resp := &UserResponse{
 		ID:        user.ID,
 		Email:     user.Email,
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		AvatarUrl: user.AvatarUrl,
+		Plan:      user.Plan,
+	}
+	if user.DeletionRequestedAt != nil {
+		requestedAt := user.DeletionRequestedAt.Format(time.RFC3339)
+		resp.DeletionRequestedAt = &requestedAt
 	}
+	return resp
+}
+
+// AccountDeletionResponse describes a scheduled account deletion
+type AccountDeletionResponse struct {
+	Message string `json:"message" validate:"required"`
+	// Time after which the account and all its data are permanently deleted
+	ScheduledDeletionAt string `json:"scheduled_deletion_at" validate:"required"`
 }
 
 // AuthResponse contains user info with access and refresh tokens
@@ -145,3 +163,63 @@ func ExportUserDataResponseFromMap(data map[string]any) ExportUserDataResponse {
 
 	return response
 }
+
+// PublicProfileSettingsResponse is the public profile as its owner edits it
+type PublicProfileSettingsResponse struct {
+	Username    string `json:"username" example:"anna_k"` // Empty while the profile is unpublished
+	DisplayName string `json:"display_name" example:"Anna"`
+	Bio         string `json:"bio"`
+	ShowAvatar  bool   `json:"show_avatar"`
+}
+
+// PublicProfileSettingsResponseFromDomain maps service layer settings to the response
+func PublicProfileSettingsResponseFromDomain(settings *userservice.PublicProfileSettingsOutput) *PublicProfileSettingsResponse {
+	return &PublicProfileSettingsResponse{
+		Username:    settings.Username,
+		DisplayName: settings.DisplayName,
+		Bio:         settings.Bio,
+		ShowAvatar:  settings.ShowAvatar,
+	}
+}
+
+// PublicWishlistResponse is a wishlist listed on a public profile
+type PublicWishlistResponse struct {
+	Title        string `json:"title" validate:"required"`
+	Occasion     string `json:"occasion,omitempty" example:"Birthday"`
+	OccasionDate string `json:"occasion_date,omitempty" example:"2026-12-24"`
+	PublicSlug   string `json:"public_slug" validate:"required"`
+	ItemCount    int    `json:"item_count" example:"5"`
+}
+
+// PublicProfileResponse is a user's public profile
+type PublicProfileResponse struct {
+	Username    string                    `json:"username" validate:"required" example:"anna_k"`
+	DisplayName string                    `json:"display_name" validate:"required" example:"Anna"`
+	Bio         string                    `json:"bio,omitempty"`
+	AvatarUrl   string                    `json:"avatar_url,omitempty"`
+	Wishlists   []*PublicWishlistResponse `json:"wishlists" validate:"required"`
+}
+
+// PublicProfileResponseFromDomain maps a service layer public profile to the response
+func PublicProfileResponseFromDomain(profile *userservice.PublicProfileOutput) *PublicProfileResponse {
+	resp := &PublicProfileResponse{
+		Username:    profile.Username,
+		DisplayName: profile.DisplayName,
+		Bio:         profile.Bio,
+		AvatarUrl:   profile.AvatarUrl,
+		Wishlists:   make([]*PublicWishlistResponse, 0, len(profile.Wishlists)),
+	}
+	for _, w := range profile.Wishlists {
+		wishlist := &PublicWishlistResponse{
+			Title:      w.Title,
+			Occasion:   w.Occasion,
+			PublicSlug: w.PublicSlug,
+			ItemCount:  w.ItemCount,
+		}
+		if w.OccasionDate != nil {
+			wishlist.OccasionDate = w.OccasionDate.Format(time.DateOnly)
+		}
+		resp.Wishlists = append(resp.Wishlists, wishlist)
+	}
+	return resp
+}
@@ -3,12 +3,19 @@ package http
 import (
 	"errors"
 
+	moderationservice "wish-list/internal/domain/moderation/service"
 	userservice "wish-list/internal/domain/user/service"
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/emailcheck"
 )
 
+// usernameField names the username in validation details, matching the request validator
+const usernameField = "Username"
+
 // mapUserServiceError converts user service errors to AppErrors
 func mapUserServiceError(err error) error {
+	var rejectedErr *moderationservice.ContentRejectedError
+
 	switch {
 	case errors.Is(err, userservice.ErrUserAlreadyExists):
 		return apperrors.Conflict("User with this email already exists")
@@ -18,6 +25,21 @@ func mapUserServiceError(err error) error {
 		return apperrors.Unauthorized("Current password is incorrect")
 	case errors.Is(err, userservice.ErrInvalidCredentials):
 		return apperrors.Unauthorized("Invalid credentials")
+	case errors.Is(err, userservice.ErrAccountPendingDeletion):
+		return apperrors.Forbidden("Account is scheduled for deletion. Cancel the deletion to sign in again")
+	case errors.Is(err, userservice.ErrNoPendingDeletion):
+		return apperrors.Conflict("Account has no pending deletion")
+	case errors.Is(err, userservice.ErrEmailRejected):
+		return apperrors.UnprocessableEntity(emailcheck.Describe(err))
+	case errors.Is(err, userservice.ErrUsernameTaken):
+		return apperrors.Conflict("This username is already taken. Please choose a different one.").
+			WithDetails(map[string]string{usernameField: "is already taken"})
+	case errors.Is(err, userservice.ErrUsernameInvalid):
+		return apperrors.NewValidationError(map[string]string{usernameField: "must be 3 to 30 lowercase letters, digits, hyphens or underscores"})
+	case errors.Is(err, userservice.ErrUsernameReserved):
+		return apperrors.NewValidationError(map[string]string{usernameField: "is reserved"})
+	case errors.As(err, &rejectedErr):
+		return apperrors.UnprocessableEntity(rejectedErr.Error())
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
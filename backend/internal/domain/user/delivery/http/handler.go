@@ -2,6 +2,7 @@ package http
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	nethttp "net/http"
 	"time"
@@ -40,10 +41,13 @@ func NewHandler(service userservice.UserServiceInterface, tokenManager *auth.Tok
 //	@Tags			Authentication
 //	@Accept			json
 //	@Produce		json
-//	@Param			user	body		dto.RegisterRequest		true	"User registration information"
+//	@Param			user			body		dto.RegisterRequest		true	"User registration information"
+//	@Param			X-Captcha-Token	header		string					false	"CAPTCHA response token (required when CAPTCHA is enabled)"
 //	@Success		201		{object}	dto.AuthResponse		"User created successfully"
 //	@Failure		400		{object}	map[string]string		"Invalid request body or validation error"
+//	@Failure		403		{object}	map[string]string		"CAPTCHA verification failed"
 //	@Failure		409		{object}	map[string]string		"User with this email already exists"
+//	@Failure		422		{object}	map[string]string		"Email address rejected (malformed, disposable or undeliverable)"
 //	@Failure		500		{object}	map[string]string		"Internal server error"
 //	@Router			/auth/register [post]
 func (h *Handler) Register(c echo.Context) error {
@@ -59,14 +63,14 @@ func (h *Handler) Register(c echo.Context) error {
 	}
 
 	// Generate access token (15 minutes)
-	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, "user")
+	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Could not generate access token").Wrap(err)
 	}
 
 	// Generate refresh token (7 days)
 	tokenID := fmt.Sprintf("%s-%d", user.ID, time.Now().Unix())
-	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, "user", tokenID)
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, user.UserType, tokenID)
 	if err != nil {
 		return apperrors.Internal("Could not generate refresh token").Wrap(err)
 	}
@@ -97,6 +101,7 @@ func (h *Handler) Register(c echo.Context) error {
 //	@Success		200			{object}	dto.AuthResponse		"Login successful"
 //	@Failure		400			{object}	map[string]string		"Invalid request body or validation error"
 //	@Failure		401			{object}	map[string]string		"Invalid credentials"
+//	@Failure		403			{object}	map[string]string		"Account is scheduled for deletion"
 //	@Failure		500			{object}	map[string]string		"Internal server error"
 //	@Router			/auth/login [post]
 func (h *Handler) Login(c echo.Context) error {
@@ -107,6 +112,10 @@ func (h *Handler) Login(c echo.Context) error {
 
 	ctx := c.Request().Context()
 	user, err := h.service.Login(ctx, req.ToDomain())
+	if errors.Is(err, userservice.ErrAccountPendingDeletion) {
+		// Credentials were valid; tell the user how to recover the account
+		return mapUserServiceError(err)
+	}
 	if err != nil {
 		// Log the error server-side for debugging with redacted email (avoid PII in logs)
 		emailHash := fmt.Sprintf("%x", sha256.Sum256([]byte(req.Email)))[:16]
@@ -116,14 +125,14 @@ func (h *Handler) Login(c echo.Context) error {
 	}
 
 	// Generate access token (15 minutes)
-	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, "user")
+	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, user.UserType)
 	if err != nil {
 		return apperrors.Internal("Could not generate access token").Wrap(err)
 	}
 
 	// Generate refresh token (7 days)
 	tokenID := fmt.Sprintf("%s-%d", user.ID, time.Now().Unix())
-	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, "user", tokenID)
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, user.UserType, tokenID)
 	if err != nil {
 		return apperrors.Internal("Could not generate refresh token").Wrap(err)
 	}
@@ -203,12 +212,13 @@ func (h *Handler) UpdateProfile(c echo.Context) error {
 // DeleteAccount godoc
 //
 // @Summary      Delete user account
-// @Description  Delete the authenticated user's account and all associated data. This action is irreversible.
+// @Description  Schedule deletion of the authenticated user's account and all associated data. The deletion can be canceled until the grace period (ACCOUNT_DELETION_GRACE_DAYS) expires; login is blocked meanwhile. With no grace period the account is deleted immediately.
 // @Tags         User
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Success      204  {object}  nil  "Account deleted successfully"
+// @Success      202  {object}  dto.AccountDeletionResponse  "Account deletion scheduled"
+// @Success      204  {object}  nil  "Account deleted immediately (no grace period configured)"
 // @Failure      401  {object}  map[string]string  "Unauthorized"
 // @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /protected/account [delete]
@@ -216,18 +226,99 @@ func (h *Handler) DeleteAccount(c echo.Context) error {
 	userID := auth.MustGetUserID(c)
 
 	ctx := c.Request().Context()
-	err := h.accountCleanupService.DeleteUserAccount(ctx, userID, "user_requested_deletion")
+	scheduledAt, err := h.accountCleanupService.RequestAccountDeletion(ctx, userID)
 	if err != nil {
 		return apperrors.Internal("Failed to delete account").Wrap(err)
 	}
 
-	return c.NoContent(nethttp.StatusNoContent)
+	// End the session on this device; other sessions are rejected on refresh
+	c.SetCookie(auth.ClearRefreshTokenCookie())
+
+	if scheduledAt.IsZero() {
+		return c.NoContent(nethttp.StatusNoContent)
+	}
+
+	return c.JSON(nethttp.StatusAccepted, dto.AccountDeletionResponse{
+		Message:             "Account deletion scheduled",
+		ScheduledDeletionAt: scheduledAt.Format(time.RFC3339),
+	})
+}
+
+// CancelAccountDeletion godoc
+//
+//	@Summary		Cancel a pending account deletion
+//	@Description	Cancel a scheduled account deletion using the account credentials and sign in. This is the only way to sign in to an account pending deletion.
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			credentials	body		dto.LoginRequest		true	"User login credentials"
+//	@Success		200			{object}	dto.AuthResponse		"Deletion canceled, login successful"
+//	@Failure		400			{object}	map[string]string		"Invalid request body or validation error"
+//	@Failure		401			{object}	map[string]string		"Invalid credentials"
+//	@Failure		409			{object}	map[string]string		"Account has no pending deletion"
+//	@Failure		500			{object}	map[string]string		"Internal server error"
+//	@Router			/auth/account/cancel-deletion [post]
+func (h *Handler) CancelAccountDeletion(c echo.Context) error {
+	var req dto.LoginRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	user, err := h.service.CancelAccountDeletion(ctx, req.ToDomain())
+	if err != nil {
+		return mapUserServiceError(err)
+	}
+
+	accessToken, err := h.tokenManager.GenerateAccessToken(user.ID, user.Email, user.UserType)
+	if err != nil {
+		return apperrors.Internal("Could not generate access token").Wrap(err)
+	}
+
+	tokenID := fmt.Sprintf("%s-%d", user.ID, time.Now().Unix())
+	refreshToken, err := h.tokenManager.GenerateRefreshToken(user.ID, user.Email, user.UserType, tokenID)
+	if err != nil {
+		return apperrors.Internal("Could not generate refresh token").Wrap(err)
+	}
+
+	c.SetCookie(auth.NewRefreshTokenCookie(refreshToken))
+
+	return c.JSON(nethttp.StatusOK, dto.AuthResponse{
+		User:         dto.UserResponseFromDomain(user),
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	})
+}
+
+// CancelAccountDeletionAuthenticated godoc
+//
+//	@Summary		Cancel a pending account deletion
+//	@Description	Cancel a scheduled account deletion from a session that is still active.
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.UserResponse		"Deletion canceled"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		404	{object}	map[string]string		"User not found"
+//	@Failure		409	{object}	map[string]string		"Account has no pending deletion"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Router			/protected/account/cancel-deletion [post]
+func (h *Handler) CancelAccountDeletionAuthenticated(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	user, err := h.service.CancelAccountDeletionByID(c.Request().Context(), userID)
+	if err != nil {
+		return mapUserServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.UserResponseFromDomain(user))
 }
 
 // ExportUserData godoc
 //
 // @Summary      Export user data
-// @Description  Export the authenticated user's data in JSON format for compliance and personal records
+// @Description  Export the authenticated user's profile and wishlists inline as JSON. Superseded by POST /protected/exports, which builds a downloadable archive that also contains reservations, images and account activity.
+// @Deprecated
 // @Tags         User
 // @Accept       json
 // @Produce      json
@@ -95,6 +95,28 @@ func (m *MockUserService) DeleteUser(ctx context.Context, userID string) error {
 	return args.Error(0)
 }
 
+func (m *MockUserService) CancelAccountDeletion(ctx context.Context, input userservice.LoginUserInput) (*userservice.UserOutput, error) {
+	args := m.Called(ctx, input)
+	v := args.Get(0)
+	if v != nil {
+		if result, ok := v.(*userservice.UserOutput); ok {
+			return result, args.Error(1)
+		}
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockUserService) CancelAccountDeletionByID(ctx context.Context, userID string) (*userservice.UserOutput, error) {
+	args := m.Called(ctx, userID)
+	v := args.Get(0)
+	if v != nil {
+		if result, ok := v.(*userservice.UserOutput); ok {
+			return result, args.Error(1)
+		}
+	}
+	return nil, args.Error(1)
+}
+
 func TestUserHandler_Register(t *testing.T) {
 	e := setupTestEcho()
 
@@ -365,6 +387,115 @@ func TestUserHandler_Login_Unauthorized(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestUserHandler_Login_PendingDeletion(t *testing.T) {
+	e := setupTestEcho()
+
+	mockService := new(MockUserService)
+	tokenManager := auth.NewTokenManager("test-secret")
+	analyticsService := analytics.NewAnalyticsService(false)
+	handler := NewHandler(mockService, tokenManager, nil, analyticsService)
+
+	reqBody := dto.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+
+	jsonBody, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(nethttp.MethodPost, "/api/auth/login", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService.On("Login", mock.Anything, userservice.LoginUserInput{
+		Email:    reqBody.Email,
+		Password: reqBody.Password,
+	}).Return((*userservice.UserOutput)(nil), userservice.ErrAccountPendingDeletion)
+
+	err := handler.Login(c)
+
+	require.Error(t, err)
+	var appErr *apperrors.AppError
+	require.ErrorAs(t, err, &appErr, "Error should be apperrors.AppError")
+	assert.Equal(t, nethttp.StatusForbidden, appErr.Code)
+	assert.Contains(t, appErr.Message, "scheduled for deletion")
+
+	mockService.AssertExpectations(t)
+}
+
+func TestUserHandler_CancelAccountDeletion(t *testing.T) {
+	t.Run("valid credentials cancel deletion and sign in", func(t *testing.T) {
+		e := setupTestEcho()
+
+		mockService := new(MockUserService)
+		tokenManager := auth.NewTokenManager("test-secret")
+		analyticsService := analytics.NewAnalyticsService(false)
+		handler := NewHandler(mockService, tokenManager, nil, analyticsService)
+
+		reqBody := dto.LoginRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		jsonBody, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(nethttp.MethodPost, "/api/auth/account/cancel-deletion", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.On("CancelAccountDeletion", mock.Anything, userservice.LoginUserInput{
+			Email:    reqBody.Email,
+			Password: reqBody.Password,
+		}).Return(&userservice.UserOutput{
+			ID:    "123e4567-e89b-12d3-a456-426614174000",
+			Email: reqBody.Email,
+		}, nil)
+
+		err := handler.CancelAccountDeletion(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response dto.AuthResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.NotEmpty(t, response.AccessToken)
+		assert.Nil(t, response.User.DeletionRequestedAt)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("no pending deletion", func(t *testing.T) {
+		e := setupTestEcho()
+
+		mockService := new(MockUserService)
+		tokenManager := auth.NewTokenManager("test-secret")
+		analyticsService := analytics.NewAnalyticsService(false)
+		handler := NewHandler(mockService, tokenManager, nil, analyticsService)
+
+		reqBody := dto.LoginRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		jsonBody, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(nethttp.MethodPost, "/api/auth/account/cancel-deletion", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.On("CancelAccountDeletion", mock.Anything, mock.Anything).
+			Return((*userservice.UserOutput)(nil), userservice.ErrNoPendingDeletion)
+
+		err := handler.CancelAccountDeletion(c)
+
+		require.Error(t, err)
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusConflict, appErr.Code)
+
+		mockService.AssertExpectations(t)
+	})
+}
+
 func TestUserHandler_GetProfile(t *testing.T) {
 	t.Run("authenticated user retrieves own profile", func(t *testing.T) {
 		e := setupTestEcho()
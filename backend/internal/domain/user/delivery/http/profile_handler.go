@@ -0,0 +1,100 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/user/delivery/http/dto"
+	userservice "wish-list/internal/domain/user/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ProfileHandler handles HTTP requests for public user profiles
+type ProfileHandler struct {
+	service userservice.PublicProfileServiceInterface
+}
+
+// NewProfileHandler creates a new ProfileHandler
+func NewProfileHandler(svc userservice.PublicProfileServiceInterface) *ProfileHandler {
+	return &ProfileHandler{
+		service: svc,
+	}
+}
+
+// GetSettings godoc
+//
+//	@Summary		Get public profile settings
+//	@Description	Get the authenticated user's username, display name, bio and avatar visibility. Without a username there is no public profile.
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	dto.PublicProfileSettingsResponse	"Public profile settings"
+//	@Failure		401	{object}	map[string]string					"Unauthorized"
+//	@Failure		404	{object}	map[string]string					"User not found"
+//	@Failure		500	{object}	map[string]string					"Internal server error"
+//	@Router			/protected/profile/public [get]
+func (h *ProfileHandler) GetSettings(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	settings, err := h.service.GetSettings(c.Request().Context(), userID)
+	if err != nil {
+		return mapUserServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PublicProfileSettingsResponseFromDomain(settings))
+}
+
+// UpdateSettings godoc
+//
+//	@Summary		Update public profile settings
+//	@Description	Choose a username to publish a public profile, or clear it to unpublish. Usernames are 3-30 lowercase letters, digits, hyphens or underscores and unique regardless of case; one given up stays reserved for its previous owner for 30 days and their vanity links keep working. The profile shows the display name, bio and, if show_avatar is set, the avatar; first and last names stay private. Omitted fields are kept.
+//	@Tags			User
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			profile	body		dto.UpdatePublicProfileRequest		true	"Public profile settings"
+//	@Success		200		{object}	dto.PublicProfileSettingsResponse	"Updated public profile settings"
+//	@Failure		400		{object}	map[string]string					"Invalid request body, invalid or reserved username"
+//	@Failure		401		{object}	map[string]string					"Unauthorized"
+//	@Failure		404		{object}	map[string]string					"User not found"
+//	@Failure		409		{object}	map[string]string					"Username is already taken"
+//	@Failure		422		{object}	map[string]string					"Content rejected by moderation"
+//	@Failure		500		{object}	map[string]string					"Internal server error"
+//	@Router			/protected/profile/public [put]
+func (h *ProfileHandler) UpdateSettings(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	var req dto.UpdatePublicProfileRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	settings, err := h.service.UpdateSettings(c.Request().Context(), userID, req.ToDomain())
+	if err != nil {
+		return mapUserServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PublicProfileSettingsResponseFromDomain(settings))
+}
+
+// GetPublicProfile godoc
+//
+//	@Summary		Get a public user profile
+//	@Description	Get the profile a user published under a username, case-insensitively: display name (the username if unset), bio, avatar if shown, and the wishlists they made public and discoverable, upcoming occasions first.
+//	@Tags			User
+//	@Produce		json
+//	@Param			username	path		string						true	"Username"
+//	@Success		200			{object}	dto.PublicProfileResponse	"Public profile"
+//	@Failure		404			{object}	map[string]string			"Profile not found"
+//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Router			/public/users/{username} [get]
+func (h *ProfileHandler) GetPublicProfile(c echo.Context) error {
+	profile, err := h.service.GetPublicProfile(c.Request().Context(), c.Param("username"))
+	if err != nil {
+		return mapUserServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.PublicProfileResponseFromDomain(profile))
+}
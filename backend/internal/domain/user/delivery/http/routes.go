@@ -5,16 +5,30 @@ import (
 )
 
 // RegisterRoutes registers user domain HTTP routes
-func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
-	// Public auth routes
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware, captchaMiddleware echo.MiddlewareFunc) {
+	// Public auth routes; registration is CAPTCHA-protected against bot sign-ups
 	auth := e.Group("/api/auth")
-	auth.POST("/register", h.Register)
+	auth.POST("/register", h.Register, captchaMiddleware)
 	auth.POST("/login", h.Login)
+	// Accounts pending deletion cannot log in; cancellation is authenticated by credentials instead
+	auth.POST("/account/cancel-deletion", h.CancelAccountDeletion)
 
 	// Protected user routes
 	protected := e.Group("/api/protected", authMiddleware)
 	protected.GET("/profile", h.GetProfile)
 	protected.PUT("/profile", h.UpdateProfile)
 	protected.DELETE("/account", h.DeleteAccount)
+	protected.POST("/account/cancel-deletion", h.CancelAccountDeletionAuthenticated)
 	protected.GET("/export-data", h.ExportUserData)
 }
+
+// RegisterProfileRoutes registers the public profile routes: settings for the
+// signed-in user and the profile itself for anyone
+func RegisterProfileRoutes(e *echo.Echo, h *ProfileHandler, authMiddleware echo.MiddlewareFunc) {
+	protected := e.Group("/api/protected", authMiddleware)
+	protected.GET("/profile/public", h.GetSettings)
+	protected.PUT("/profile/public", h.UpdateSettings)
+
+	public := e.Group("/api/public")
+	public.GET("/users/:username", h.GetPublicProfile)
+}
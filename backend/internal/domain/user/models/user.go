@@ -5,18 +5,37 @@ import (
 )
 
 type User struct {
-	ID                 pgtype.UUID        `db:"id"`
-	Email              string             `db:"email"`
-	EncryptedEmail     pgtype.Text        `db:"encrypted_email"` // PII encrypted
-	PasswordHash       pgtype.Text        `db:"password_hash"`   // Never expose password hashes
-	FirstName          pgtype.Text        `db:"first_name"`
-	EncryptedFirstName pgtype.Text        `db:"encrypted_first_name"` // PII encrypted
-	LastName           pgtype.Text        `db:"last_name"`
-	EncryptedLastName  pgtype.Text        `db:"encrypted_last_name"` // PII encrypted
-	AvatarUrl          pgtype.Text        `db:"avatar_url"`
-	IsVerified         pgtype.Bool        `db:"is_verified"`
-	CreatedAt          pgtype.Timestamptz `db:"created_at"`
-	UpdatedAt          pgtype.Timestamptz `db:"updated_at"`
-	LastLoginAt        pgtype.Timestamptz `db:"last_login_at"`
-	DeactivatedAt      pgtype.Timestamptz `db:"deactivated_at"`
+	ID                  pgtype.UUID        `db:"id"`
+	Email               string             `db:"email"`
+	EncryptedEmail      pgtype.Text        `db:"encrypted_email"` // PII encrypted
+	PasswordHash        pgtype.Text        `db:"password_hash"`   // Never expose password hashes
+	FirstName           pgtype.Text        `db:"first_name"`
+	EncryptedFirstName  pgtype.Text        `db:"encrypted_first_name"` // PII encrypted
+	LastName            pgtype.Text        `db:"last_name"`
+	EncryptedLastName   pgtype.Text        `db:"encrypted_last_name"` // PII encrypted
+	AvatarUrl           pgtype.Text        `db:"avatar_url"`
+	IsVerified          pgtype.Bool        `db:"is_verified"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at"`
+	LastLoginAt         pgtype.Timestamptz `db:"last_login_at"`
+	DeactivatedAt       pgtype.Timestamptz `db:"deactivated_at"`
+	DeletionRequestedAt pgtype.Timestamptz `db:"deletion_requested_at"` // Pending user-requested deletion
+	UserType            string             `db:"user_type"`             // "user" or "admin"
+	Plan                string             `db:"plan"`                  // "free" or "premium"; determines quotas
+	Username            pgtype.Text        `db:"username"`              // Lowercase; addresses the public profile
+	DisplayName         pgtype.Text        `db:"display_name"`
+	Bio                 pgtype.Text        `db:"bio"`
+	ShowAvatar          bool               `db:"show_avatar"` // Whether the public profile shows the avatar
 }
+
+// User types stored in users.user_type and issued in access tokens
+const (
+	UserTypeUser  = "user"
+	UserTypeAdmin = "admin"
+)
+
+// Plans stored in users.plan
+const (
+	PlanFree    = "free"
+	PlanPremium = "premium"
+)
@@ -19,6 +19,10 @@ var (
 	ErrUserNotFound = errors.New("user not found")
 )
 
+// UsernameReservationPeriod is how long a username a user gave up stays
+// unavailable to other users
+const UsernameReservationPeriod = 30 * 24 * time.Hour
+
 //go:generate go run github.com/matryer/moq@latest -out ../service/mock_user_repository_test.go -pkg service . UserRepositoryInterface
 
 // UserRepositoryInterface defines the interface for user database operations
@@ -31,6 +35,14 @@ type UserRepositoryInterface interface {
 	DeleteWithExecutor(ctx context.Context, executor database.Executor, id pgtype.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*models.User, error)
 	ListInactiveSince(ctx context.Context, since time.Time) ([]*models.User, error)
+	RequestDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error)
+	CancelDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error)
+	ListDeletionRequestedBefore(ctx context.Context, before time.Time) ([]*models.User, error)
+	SetUserType(ctx context.Context, id pgtype.UUID, userType string) (*models.User, error)
+	SetPlan(ctx context.Context, id pgtype.UUID, plan string) (*models.User, error)
+	GetByUsername(ctx context.Context, username string) (*models.User, error)
+	IsUsernameTaken(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error)
+	UpdatePublicProfile(ctx context.Context, user models.User) (*models.User, error)
 }
 
 type UserRepository struct {
@@ -134,6 +146,11 @@ func (r *UserRepository) Create(ctx context.Context, user models.User) (*models.
 		isVerified = pgtype.Bool{Bool: false, Valid: true}
 	}
 
+	userType := user.UserType
+	if userType == "" {
+		userType = models.UserTypeUser
+	}
+
 	// Encrypt PII before inserting
 	if err := r.encryptUserPII(ctx, &user); err != nil {
 		return nil, fmt.Errorf("failed to encrypt user PII: %w", err)
@@ -142,13 +159,14 @@ func (r *UserRepository) Create(ctx context.Context, user models.User) (*models.
 	query := `
 		INSERT INTO users (
 			email, password_hash, first_name, last_name, avatar_url, is_verified,
-			encrypted_email, encrypted_first_name, encrypted_last_name
+			encrypted_email, encrypted_first_name, encrypted_last_name, user_type
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		) RETURNING
 			id, email, encrypted_email, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 	`
 
 	var createdUser models.User
@@ -162,6 +180,7 @@ func (r *UserRepository) Create(ctx context.Context, user models.User) (*models.
 		user.EncryptedEmail,
 		user.EncryptedFirstName,
 		user.EncryptedLastName,
+		userType,
 	).StructScan(&createdUser)
 
 	if err != nil {
@@ -182,7 +201,8 @@ func (r *UserRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.U
 		SELECT
 			id, email, encrypted_email, password_hash, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 		FROM users
 		WHERE id = $1
 	`
@@ -210,7 +230,8 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.
 		SELECT
 			id, email, encrypted_email, password_hash, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 		FROM users
 		WHERE email = $1
 	`
@@ -254,7 +275,8 @@ func (r *UserRepository) Update(ctx context.Context, user models.User) (*models.
 		RETURNING
 			id, email, encrypted_email, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 	`
 
 	var updatedUser models.User
@@ -317,7 +339,8 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*models
 		SELECT
 			id, email, encrypted_email, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 		FROM users
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -345,7 +368,8 @@ func (r *UserRepository) ListInactiveSince(ctx context.Context, since time.Time)
 		SELECT
 			id, email, encrypted_email, first_name, encrypted_first_name,
 			last_name, encrypted_last_name, avatar_url, is_verified,
-			created_at, updated_at, last_login_at, deactivated_at
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
 		FROM users
 		WHERE last_login_at < $1 OR (last_login_at IS NULL AND created_at < $1)
 		ORDER BY created_at DESC
@@ -366,3 +390,266 @@ func (r *UserRepository) ListInactiveSince(ctx context.Context, since time.Time)
 
 	return users, nil
 }
+
+// RequestDeletion marks a user account as pending deletion.
+// Repeated requests keep the original timestamp so the grace period is not extended.
+func (r *UserRepository) RequestDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+	query := `
+		UPDATE users SET
+			deletion_requested_at = COALESCE(deletion_requested_at, NOW()),
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+	`
+
+	var user models.User
+	err := r.db.QueryRowxContext(ctx, query, id).StructScan(&user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to request user deletion: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &user, nil
+}
+
+// CancelDeletion clears a pending deletion request
+func (r *UserRepository) CancelDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+	query := `
+		UPDATE users SET
+			deletion_requested_at = NULL,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+	`
+
+	var user models.User
+	err := r.db.QueryRowxContext(ctx, query, id).StructScan(&user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to cancel user deletion: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &user, nil
+}
+
+// ListDeletionRequestedBefore retrieves users whose deletion was requested before the given time
+func (r *UserRepository) ListDeletionRequestedBefore(ctx context.Context, before time.Time) ([]*models.User, error) {
+	query := `
+		SELECT
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+		FROM users
+		WHERE deletion_requested_at IS NOT NULL AND deletion_requested_at < $1
+		ORDER BY deletion_requested_at ASC
+	`
+
+	var users []*models.User
+	err := r.db.SelectContext(ctx, &users, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users pending deletion: %w", err)
+	}
+
+	// Decrypt PII for all users
+	for _, user := range users {
+		if err := r.decryptUserPII(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+		}
+	}
+
+	return users, nil
+}
+
+// SetUserType changes the account type issued in the user's tokens
+func (r *UserRepository) SetUserType(ctx context.Context, id pgtype.UUID, userType string) (*models.User, error) {
+	query := `
+		UPDATE users SET
+			user_type = $2,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+	`
+
+	var user models.User
+	err := r.db.QueryRowxContext(ctx, query, id, userType).StructScan(&user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to set user type: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &user, nil
+}
+
+// SetPlan changes the plan that determines the user's quotas
+func (r *UserRepository) SetPlan(ctx context.Context, id pgtype.UUID, plan string) (*models.User, error) {
+	query := `
+		UPDATE users SET
+			plan = $2,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+	`
+
+	var user models.User
+	err := r.db.QueryRowxContext(ctx, query, id, plan).StructScan(&user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to set plan: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetByUsername retrieves a user by the lowercase username of their public profile
+func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	query := `
+		SELECT
+			id, email, encrypted_email, first_name, encrypted_first_name,
+			last_name, encrypted_last_name, avatar_url, is_verified,
+			created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+			username, display_name, bio, show_avatar
+		FROM users
+		WHERE username = $1
+	`
+
+	var user models.User
+	err := r.db.Reader().GetContext(ctx, &user, query, username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &user, nil
+}
+
+// IsUsernameTaken reports whether another user already has the username, or gave
+// it up less than UsernameReservationPeriod ago. excludeID is the user being
+// updated so their own usernames do not count as a conflict.
+func (r *UserRepository) IsUsernameTaken(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM users WHERE username = $1 AND id IS DISTINCT FROM $2
+		) OR EXISTS(
+			SELECT 1 FROM username_history
+			WHERE username = $1 AND retired_at > $3 AND user_id IS DISTINCT FROM $2
+		)
+	`
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, username, excludeID, time.Now().Add(-UsernameReservationPeriod)); err != nil {
+		return false, fmt.Errorf("failed to check username uniqueness: %w", err)
+	}
+	return exists, nil
+}
+
+// UpdatePublicProfile sets the username, display name, bio and avatar visibility.
+// A username the user gives up is recorded in their username history; one they
+// take back is removed from it.
+func (r *UserRepository) UpdatePublicProfile(ctx context.Context, user models.User) (*models.User, error) {
+	query := `
+		WITH previous AS (
+			SELECT username FROM users WHERE id = $1
+		), updated AS (
+			UPDATE users SET
+				username = $2,
+				display_name = $3,
+				bio = $4,
+				show_avatar = $5,
+				updated_at = NOW()
+			WHERE id = $1
+			RETURNING
+				id, email, encrypted_email, first_name, encrypted_first_name,
+				last_name, encrypted_last_name, avatar_url, is_verified,
+				created_at, updated_at, last_login_at, deactivated_at, deletion_requested_at, user_type, plan,
+				username, display_name, bio, show_avatar
+		), retired AS (
+			INSERT INTO username_history (username, user_id)
+			SELECT previous.username, updated.id
+			FROM previous, updated
+			WHERE previous.username IS NOT NULL
+			  AND previous.username IS DISTINCT FROM updated.username
+			ON CONFLICT (username) DO UPDATE SET
+				user_id = EXCLUDED.user_id,
+				retired_at = NOW()
+		), reclaimed AS (
+			DELETE FROM username_history h
+			USING updated
+			WHERE h.username = updated.username
+		)
+		SELECT * FROM updated
+	`
+
+	var updatedUser models.User
+	err := r.db.QueryRowxContext(ctx, query,
+		user.ID,
+		user.Username,
+		user.DisplayName,
+		user.Bio,
+		user.ShowAvatar,
+	).StructScan(&updatedUser)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update public profile: %w", err)
+	}
+
+	// Decrypt PII before returning
+	if err := r.decryptUserPII(ctx, &updatedUser); err != nil {
+		return nil, fmt.Errorf("failed to decrypt user PII: %w", err)
+	}
+
+	return &updatedUser, nil
+}
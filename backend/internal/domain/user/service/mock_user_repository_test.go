@@ -23,6 +23,9 @@ var _ repository.UserRepositoryInterface = &UserRepositoryInterfaceMock{}
 //
 //		// make and configure a mocked repository.UserRepositoryInterface
 //		mockedUserRepositoryInterface := &UserRepositoryInterfaceMock{
+//			CancelDeletionFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+//				panic("mock out the CancelDeletion method")
+//			},
 //			CreateFunc: func(ctx context.Context, user models.User) (*models.User, error) {
 //				panic("mock out the Create method")
 //			},
@@ -38,15 +41,36 @@ var _ repository.UserRepositoryInterface = &UserRepositoryInterfaceMock{}
 //			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
 //				panic("mock out the GetByID method")
 //			},
+//			GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+//				panic("mock out the GetByUsername method")
+//			},
+//			IsUsernameTakenFunc: func(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error) {
+//				panic("mock out the IsUsernameTaken method")
+//			},
 //			ListFunc: func(ctx context.Context, limit int, offset int) ([]*models.User, error) {
 //				panic("mock out the List method")
 //			},
+//			ListDeletionRequestedBeforeFunc: func(ctx context.Context, before time.Time) ([]*models.User, error) {
+//				panic("mock out the ListDeletionRequestedBefore method")
+//			},
 //			ListInactiveSinceFunc: func(ctx context.Context, since time.Time) ([]*models.User, error) {
 //				panic("mock out the ListInactiveSince method")
 //			},
+//			RequestDeletionFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+//				panic("mock out the RequestDeletion method")
+//			},
+//			SetPlanFunc: func(ctx context.Context, id pgtype.UUID, plan string) (*models.User, error) {
+//				panic("mock out the SetPlan method")
+//			},
+//			SetUserTypeFunc: func(ctx context.Context, id pgtype.UUID, userType string) (*models.User, error) {
+//				panic("mock out the SetUserType method")
+//			},
 //			UpdateFunc: func(ctx context.Context, user models.User) (*models.User, error) {
 //				panic("mock out the Update method")
 //			},
+//			UpdatePublicProfileFunc: func(ctx context.Context, user models.User) (*models.User, error) {
+//				panic("mock out the UpdatePublicProfile method")
+//			},
 //		}
 //
 //		// use mockedUserRepositoryInterface in code that requires repository.UserRepositoryInterface
@@ -54,6 +78,9 @@ var _ repository.UserRepositoryInterface = &UserRepositoryInterfaceMock{}
 //
 //	}
 type UserRepositoryInterfaceMock struct {
+	// CancelDeletionFunc mocks the CancelDeletion method.
+	CancelDeletionFunc func(ctx context.Context, id pgtype.UUID) (*models.User, error)
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, user models.User) (*models.User, error)
 
@@ -69,17 +96,45 @@ type UserRepositoryInterfaceMock struct {
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*models.User, error)
 
+	// GetByUsernameFunc mocks the GetByUsername method.
+	GetByUsernameFunc func(ctx context.Context, username string) (*models.User, error)
+
+	// IsUsernameTakenFunc mocks the IsUsernameTaken method.
+	IsUsernameTakenFunc func(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error)
+
 	// ListFunc mocks the List method.
 	ListFunc func(ctx context.Context, limit int, offset int) ([]*models.User, error)
 
+	// ListDeletionRequestedBeforeFunc mocks the ListDeletionRequestedBefore method.
+	ListDeletionRequestedBeforeFunc func(ctx context.Context, before time.Time) ([]*models.User, error)
+
 	// ListInactiveSinceFunc mocks the ListInactiveSince method.
 	ListInactiveSinceFunc func(ctx context.Context, since time.Time) ([]*models.User, error)
 
+	// RequestDeletionFunc mocks the RequestDeletion method.
+	RequestDeletionFunc func(ctx context.Context, id pgtype.UUID) (*models.User, error)
+
+	// SetPlanFunc mocks the SetPlan method.
+	SetPlanFunc func(ctx context.Context, id pgtype.UUID, plan string) (*models.User, error)
+
+	// SetUserTypeFunc mocks the SetUserType method.
+	SetUserTypeFunc func(ctx context.Context, id pgtype.UUID, userType string) (*models.User, error)
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, user models.User) (*models.User, error)
 
+	// UpdatePublicProfileFunc mocks the UpdatePublicProfile method.
+	UpdatePublicProfileFunc func(ctx context.Context, user models.User) (*models.User, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// CancelDeletion holds details about calls to the CancelDeletion method.
+		CancelDeletion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Ctx is the ctx argument value.
@@ -117,6 +172,22 @@ type UserRepositoryInterfaceMock struct {
 			// ID is the id argument value.
 			ID pgtype.UUID
 		}
+		// GetByUsername holds details about calls to the GetByUsername method.
+		GetByUsername []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+		}
+		// IsUsernameTaken holds details about calls to the IsUsernameTaken method.
+		IsUsernameTaken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// ExcludeID is the excludeID argument value.
+			ExcludeID pgtype.UUID
+		}
 		// List holds details about calls to the List method.
 		List []struct {
 			// Ctx is the ctx argument value.
@@ -126,6 +197,13 @@ type UserRepositoryInterfaceMock struct {
 			// Offset is the offset argument value.
 			Offset int
 		}
+		// ListDeletionRequestedBefore holds details about calls to the ListDeletionRequestedBefore method.
+		ListDeletionRequestedBefore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
 		// ListInactiveSince holds details about calls to the ListInactiveSince method.
 		ListInactiveSince []struct {
 			// Ctx is the ctx argument value.
@@ -133,6 +211,31 @@ type UserRepositoryInterfaceMock struct {
 			// Since is the since argument value.
 			Since time.Time
 		}
+		// RequestDeletion holds details about calls to the RequestDeletion method.
+		RequestDeletion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+		// SetPlan holds details about calls to the SetPlan method.
+		SetPlan []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Plan is the plan argument value.
+			Plan string
+		}
+		// SetUserType holds details about calls to the SetUserType method.
+		SetUserType []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// UserType is the userType argument value.
+			UserType string
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -140,15 +243,66 @@ type UserRepositoryInterfaceMock struct {
 			// User is the user argument value.
 			User models.User
 		}
+		// UpdatePublicProfile holds details about calls to the UpdatePublicProfile method.
+		UpdatePublicProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User models.User
+		}
 	}
-	lockCreate             sync.RWMutex
-	lockDelete             sync.RWMutex
-	lockDeleteWithExecutor sync.RWMutex
-	lockGetByEmail         sync.RWMutex
-	lockGetByID            sync.RWMutex
-	lockList               sync.RWMutex
-	lockListInactiveSince  sync.RWMutex
-	lockUpdate             sync.RWMutex
+	lockCancelDeletion              sync.RWMutex
+	lockCreate                      sync.RWMutex
+	lockDelete                      sync.RWMutex
+	lockDeleteWithExecutor          sync.RWMutex
+	lockGetByEmail                  sync.RWMutex
+	lockGetByID                     sync.RWMutex
+	lockGetByUsername               sync.RWMutex
+	lockIsUsernameTaken             sync.RWMutex
+	lockList                        sync.RWMutex
+	lockListDeletionRequestedBefore sync.RWMutex
+	lockListInactiveSince           sync.RWMutex
+	lockRequestDeletion             sync.RWMutex
+	lockSetPlan                     sync.RWMutex
+	lockSetUserType                 sync.RWMutex
+	lockUpdate                      sync.RWMutex
+	lockUpdatePublicProfile         sync.RWMutex
+}
+
+// CancelDeletion calls CancelDeletionFunc.
+func (mock *UserRepositoryInterfaceMock) CancelDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+	if mock.CancelDeletionFunc == nil {
+		panic("UserRepositoryInterfaceMock.CancelDeletionFunc: method is nil but UserRepositoryInterface.CancelDeletion was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCancelDeletion.Lock()
+	mock.calls.CancelDeletion = append(mock.calls.CancelDeletion, callInfo)
+	mock.lockCancelDeletion.Unlock()
+	return mock.CancelDeletionFunc(ctx, id)
+}
+
+// CancelDeletionCalls gets all the calls that were made to CancelDeletion.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.CancelDeletionCalls())
+func (mock *UserRepositoryInterfaceMock) CancelDeletionCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockCancelDeletion.RLock()
+	calls = mock.calls.CancelDeletion
+	mock.lockCancelDeletion.RUnlock()
+	return calls
 }
 
 // Create calls CreateFunc.
@@ -335,6 +489,82 @@ func (mock *UserRepositoryInterfaceMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// GetByUsername calls GetByUsernameFunc.
+func (mock *UserRepositoryInterfaceMock) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	if mock.GetByUsernameFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByUsernameFunc: method is nil but UserRepositoryInterface.GetByUsername was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+	}{
+		Ctx:      ctx,
+		Username: username,
+	}
+	mock.lockGetByUsername.Lock()
+	mock.calls.GetByUsername = append(mock.calls.GetByUsername, callInfo)
+	mock.lockGetByUsername.Unlock()
+	return mock.GetByUsernameFunc(ctx, username)
+}
+
+// GetByUsernameCalls gets all the calls that were made to GetByUsername.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByUsernameCalls())
+func (mock *UserRepositoryInterfaceMock) GetByUsernameCalls() []struct {
+	Ctx      context.Context
+	Username string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+	}
+	mock.lockGetByUsername.RLock()
+	calls = mock.calls.GetByUsername
+	mock.lockGetByUsername.RUnlock()
+	return calls
+}
+
+// IsUsernameTaken calls IsUsernameTakenFunc.
+func (mock *UserRepositoryInterfaceMock) IsUsernameTaken(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error) {
+	if mock.IsUsernameTakenFunc == nil {
+		panic("UserRepositoryInterfaceMock.IsUsernameTakenFunc: method is nil but UserRepositoryInterface.IsUsernameTaken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Username  string
+		ExcludeID pgtype.UUID
+	}{
+		Ctx:       ctx,
+		Username:  username,
+		ExcludeID: excludeID,
+	}
+	mock.lockIsUsernameTaken.Lock()
+	mock.calls.IsUsernameTaken = append(mock.calls.IsUsernameTaken, callInfo)
+	mock.lockIsUsernameTaken.Unlock()
+	return mock.IsUsernameTakenFunc(ctx, username, excludeID)
+}
+
+// IsUsernameTakenCalls gets all the calls that were made to IsUsernameTaken.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.IsUsernameTakenCalls())
+func (mock *UserRepositoryInterfaceMock) IsUsernameTakenCalls() []struct {
+	Ctx       context.Context
+	Username  string
+	ExcludeID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Username  string
+		ExcludeID pgtype.UUID
+	}
+	mock.lockIsUsernameTaken.RLock()
+	calls = mock.calls.IsUsernameTaken
+	mock.lockIsUsernameTaken.RUnlock()
+	return calls
+}
+
 // List calls ListFunc.
 func (mock *UserRepositoryInterfaceMock) List(ctx context.Context, limit int, offset int) ([]*models.User, error) {
 	if mock.ListFunc == nil {
@@ -375,6 +605,42 @@ func (mock *UserRepositoryInterfaceMock) ListCalls() []struct {
 	return calls
 }
 
+// ListDeletionRequestedBefore calls ListDeletionRequestedBeforeFunc.
+func (mock *UserRepositoryInterfaceMock) ListDeletionRequestedBefore(ctx context.Context, before time.Time) ([]*models.User, error) {
+	if mock.ListDeletionRequestedBeforeFunc == nil {
+		panic("UserRepositoryInterfaceMock.ListDeletionRequestedBeforeFunc: method is nil but UserRepositoryInterface.ListDeletionRequestedBefore was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockListDeletionRequestedBefore.Lock()
+	mock.calls.ListDeletionRequestedBefore = append(mock.calls.ListDeletionRequestedBefore, callInfo)
+	mock.lockListDeletionRequestedBefore.Unlock()
+	return mock.ListDeletionRequestedBeforeFunc(ctx, before)
+}
+
+// ListDeletionRequestedBeforeCalls gets all the calls that were made to ListDeletionRequestedBefore.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.ListDeletionRequestedBeforeCalls())
+func (mock *UserRepositoryInterfaceMock) ListDeletionRequestedBeforeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockListDeletionRequestedBefore.RLock()
+	calls = mock.calls.ListDeletionRequestedBefore
+	mock.lockListDeletionRequestedBefore.RUnlock()
+	return calls
+}
+
 // ListInactiveSince calls ListInactiveSinceFunc.
 func (mock *UserRepositoryInterfaceMock) ListInactiveSince(ctx context.Context, since time.Time) ([]*models.User, error) {
 	if mock.ListInactiveSinceFunc == nil {
@@ -411,6 +677,122 @@ func (mock *UserRepositoryInterfaceMock) ListInactiveSinceCalls() []struct {
 	return calls
 }
 
+// RequestDeletion calls RequestDeletionFunc.
+func (mock *UserRepositoryInterfaceMock) RequestDeletion(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+	if mock.RequestDeletionFunc == nil {
+		panic("UserRepositoryInterfaceMock.RequestDeletionFunc: method is nil but UserRepositoryInterface.RequestDeletion was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRequestDeletion.Lock()
+	mock.calls.RequestDeletion = append(mock.calls.RequestDeletion, callInfo)
+	mock.lockRequestDeletion.Unlock()
+	return mock.RequestDeletionFunc(ctx, id)
+}
+
+// RequestDeletionCalls gets all the calls that were made to RequestDeletion.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.RequestDeletionCalls())
+func (mock *UserRepositoryInterfaceMock) RequestDeletionCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockRequestDeletion.RLock()
+	calls = mock.calls.RequestDeletion
+	mock.lockRequestDeletion.RUnlock()
+	return calls
+}
+
+// SetPlan calls SetPlanFunc.
+func (mock *UserRepositoryInterfaceMock) SetPlan(ctx context.Context, id pgtype.UUID, plan string) (*models.User, error) {
+	if mock.SetPlanFunc == nil {
+		panic("UserRepositoryInterfaceMock.SetPlanFunc: method is nil but UserRepositoryInterface.SetPlan was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   pgtype.UUID
+		Plan string
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Plan: plan,
+	}
+	mock.lockSetPlan.Lock()
+	mock.calls.SetPlan = append(mock.calls.SetPlan, callInfo)
+	mock.lockSetPlan.Unlock()
+	return mock.SetPlanFunc(ctx, id, plan)
+}
+
+// SetPlanCalls gets all the calls that were made to SetPlan.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.SetPlanCalls())
+func (mock *UserRepositoryInterfaceMock) SetPlanCalls() []struct {
+	Ctx  context.Context
+	ID   pgtype.UUID
+	Plan string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   pgtype.UUID
+		Plan string
+	}
+	mock.lockSetPlan.RLock()
+	calls = mock.calls.SetPlan
+	mock.lockSetPlan.RUnlock()
+	return calls
+}
+
+// SetUserType calls SetUserTypeFunc.
+func (mock *UserRepositoryInterfaceMock) SetUserType(ctx context.Context, id pgtype.UUID, userType string) (*models.User, error) {
+	if mock.SetUserTypeFunc == nil {
+		panic("UserRepositoryInterfaceMock.SetUserTypeFunc: method is nil but UserRepositoryInterface.SetUserType was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ID       pgtype.UUID
+		UserType string
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		UserType: userType,
+	}
+	mock.lockSetUserType.Lock()
+	mock.calls.SetUserType = append(mock.calls.SetUserType, callInfo)
+	mock.lockSetUserType.Unlock()
+	return mock.SetUserTypeFunc(ctx, id, userType)
+}
+
+// SetUserTypeCalls gets all the calls that were made to SetUserType.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.SetUserTypeCalls())
+func (mock *UserRepositoryInterfaceMock) SetUserTypeCalls() []struct {
+	Ctx      context.Context
+	ID       pgtype.UUID
+	UserType string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       pgtype.UUID
+		UserType string
+	}
+	mock.lockSetUserType.RLock()
+	calls = mock.calls.SetUserType
+	mock.lockSetUserType.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *UserRepositoryInterfaceMock) Update(ctx context.Context, user models.User) (*models.User, error) {
 	if mock.UpdateFunc == nil {
@@ -446,3 +828,39 @@ func (mock *UserRepositoryInterfaceMock) UpdateCalls() []struct {
 	mock.lockUpdate.RUnlock()
 	return calls
 }
+
+// UpdatePublicProfile calls UpdatePublicProfileFunc.
+func (mock *UserRepositoryInterfaceMock) UpdatePublicProfile(ctx context.Context, user models.User) (*models.User, error) {
+	if mock.UpdatePublicProfileFunc == nil {
+		panic("UserRepositoryInterfaceMock.UpdatePublicProfileFunc: method is nil but UserRepositoryInterface.UpdatePublicProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		User models.User
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockUpdatePublicProfile.Lock()
+	mock.calls.UpdatePublicProfile = append(mock.calls.UpdatePublicProfile, callInfo)
+	mock.lockUpdatePublicProfile.Unlock()
+	return mock.UpdatePublicProfileFunc(ctx, user)
+}
+
+// UpdatePublicProfileCalls gets all the calls that were made to UpdatePublicProfile.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.UpdatePublicProfileCalls())
+func (mock *UserRepositoryInterfaceMock) UpdatePublicProfileCalls() []struct {
+	Ctx  context.Context
+	User models.User
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User models.User
+	}
+	mock.lockUpdatePublicProfile.RLock()
+	calls = mock.calls.UpdatePublicProfile
+	mock.lockUpdatePublicProfile.RUnlock()
+	return calls
+}
@@ -0,0 +1,265 @@
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . PublicWishlistListerInterface ContentModeratorInterface
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	discoverymodels "wish-list/internal/domain/discovery/models"
+	"wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/user/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrUsernameInvalid  = errors.New("username must be 3 to 30 lowercase letters, digits, hyphens or underscores")
+	ErrUsernameTaken    = errors.New("username is already taken")
+	ErrUsernameReserved = errors.New("username is reserved")
+)
+
+// usernamePattern accepts lowercase letters, digits, hyphens and underscores
+var usernamePattern = regexp.MustCompile(`^[a-z0-9_-]{3,30}$`)
+
+// reservedUsernames cannot be chosen: they could pass for the app or its staff
+var reservedUsernames = map[string]struct{}{
+	"admin": {}, "administrator": {}, "api": {}, "app": {}, "help": {}, "moderator": {},
+	"official": {}, "root": {}, "security": {}, "staff": {}, "support": {}, "system": {},
+	"team": {}, "wishlist": {},
+}
+
+// PublicWishlistListerInterface lists the wishlists shown on a public profile
+type PublicWishlistListerInterface interface {
+	ListByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*discoverymodels.WishlistSummary, error)
+}
+
+// ContentModeratorInterface screens the public profile texts.
+// Rejected content fails with the moderation domain's ContentRejectedError, returned unchanged.
+type ContentModeratorInterface interface {
+	CheckContent(ctx context.Context, imageURL string, texts ...string) error
+}
+
+// PublicProfileServiceInterface defines the interface for public user profiles
+type PublicProfileServiceInterface interface {
+	GetSettings(ctx context.Context, userID string) (*PublicProfileSettingsOutput, error)
+	UpdateSettings(ctx context.Context, userID string, input UpdatePublicProfileInput) (*PublicProfileSettingsOutput, error)
+	GetPublicProfile(ctx context.Context, username string) (*PublicProfileOutput, error)
+}
+
+// PublicProfileService lets users publish a profile under a unique username. It
+// shows a display name, bio and, if allowed, the avatar, but never the first and
+// last name, and lists the user's wishlists that are public and discoverable.
+type PublicProfileService struct {
+	repo      repository.UserRepositoryInterface
+	wishlists PublicWishlistListerInterface
+	moderator ContentModeratorInterface
+}
+
+// NewPublicProfileService creates a new public profile service. moderator may be
+// nil to skip screening.
+func NewPublicProfileService(repo repository.UserRepositoryInterface, wishlists PublicWishlistListerInterface, moderator ContentModeratorInterface) *PublicProfileService {
+	return &PublicProfileService{
+		repo:      repo,
+		wishlists: wishlists,
+		moderator: moderator,
+	}
+}
+
+// UpdatePublicProfileInput changes the public profile; nil fields are kept and
+// empty strings clear them. Clearing the username unpublishes the profile.
+type UpdatePublicProfileInput struct {
+	Username    *string
+	DisplayName *string
+	Bio         *string
+	ShowAvatar  *bool
+}
+
+// PublicProfileSettingsOutput is the public profile as its owner edits it
+type PublicProfileSettingsOutput struct {
+	Username    string
+	DisplayName string
+	Bio         string
+	ShowAvatar  bool
+}
+
+// PublicProfileOutput is a public profile as anyone sees it
+type PublicProfileOutput struct {
+	Username    string
+	DisplayName string // Falls back to the username
+	Bio         string
+	AvatarUrl   string // Empty unless the user shows it
+	Wishlists   []*PublicWishlistOutput
+}
+
+// PublicWishlistOutput is a wishlist listed on a public profile
+type PublicWishlistOutput struct {
+	Title        string
+	Occasion     string
+	OccasionDate *time.Time
+	PublicSlug   string
+	ItemCount    int
+}
+
+// GetSettings returns the user's public profile settings
+func (s *PublicProfileService) GetSettings(ctx context.Context, userID string) (*PublicProfileSettingsOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return toPublicProfileSettingsOutput(user), nil
+}
+
+// UpdateSettings validates and saves the user's public profile settings
+func (s *PublicProfileService) UpdateSettings(ctx context.Context, userID string, input UpdatePublicProfileInput) (*PublicProfileSettingsOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if input.Username != nil {
+		username := strings.ToLower(strings.TrimSpace(*input.Username))
+		if username != "" && username != user.Username.String {
+			if err := s.claimUsername(ctx, id, username); err != nil {
+				return nil, err
+			}
+		}
+		user.Username = optionalText(username)
+	}
+	if input.DisplayName != nil {
+		user.DisplayName = optionalText(strings.TrimSpace(*input.DisplayName))
+	}
+	if input.Bio != nil {
+		user.Bio = optionalText(strings.TrimSpace(*input.Bio))
+	}
+	if input.ShowAvatar != nil {
+		user.ShowAvatar = *input.ShowAvatar
+	}
+
+	if s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, "", user.Username.String, user.DisplayName.String, user.Bio.String); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedUser, err := s.repo.UpdatePublicProfile(ctx, *user)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update public profile: %w", err)
+	}
+
+	return toPublicProfileSettingsOutput(updatedUser), nil
+}
+
+// claimUsername checks that username is well formed and free for the user
+func (s *PublicProfileService) claimUsername(ctx context.Context, userID pgtype.UUID, username string) error {
+	if !usernamePattern.MatchString(username) {
+		return ErrUsernameInvalid
+	}
+	if _, reserved := reservedUsernames[username]; reserved {
+		return ErrUsernameReserved
+	}
+
+	taken, err := s.repo.IsUsernameTaken(ctx, username, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check username uniqueness: %w", err)
+	}
+	if taken {
+		return ErrUsernameTaken
+	}
+	return nil
+}
+
+// GetPublicProfile returns the profile published under username. Deactivated
+// accounts and accounts pending deletion have none.
+func (s *PublicProfileService) GetPublicProfile(ctx context.Context, username string) (*PublicProfileOutput, error) {
+	username = strings.ToLower(username)
+	if !usernamePattern.MatchString(username) {
+		return nil, ErrUserNotFound
+	}
+
+	user, err := s.repo.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.DeactivatedAt.Valid || user.DeletionRequestedAt.Valid {
+		return nil, ErrUserNotFound
+	}
+
+	wishlists, err := s.wishlists.ListByOwner(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlists: %w", err)
+	}
+
+	output := &PublicProfileOutput{
+		Username:    user.Username.String,
+		DisplayName: user.DisplayName.String,
+		Bio:         user.Bio.String,
+		Wishlists:   make([]*PublicWishlistOutput, 0, len(wishlists)),
+	}
+	if output.DisplayName == "" {
+		output.DisplayName = output.Username
+	}
+	if user.ShowAvatar {
+		output.AvatarUrl = user.AvatarUrl.String
+	}
+	for _, wishlist := range wishlists {
+		output.Wishlists = append(output.Wishlists, toPublicWishlistOutput(wishlist))
+	}
+
+	return output, nil
+}
+
+func toPublicProfileSettingsOutput(user *models.User) *PublicProfileSettingsOutput {
+	return &PublicProfileSettingsOutput{
+		Username:    user.Username.String,
+		DisplayName: user.DisplayName.String,
+		Bio:         user.Bio.String,
+		ShowAvatar:  user.ShowAvatar,
+	}
+}
+
+func toPublicWishlistOutput(wishlist *discoverymodels.WishlistSummary) *PublicWishlistOutput {
+	output := &PublicWishlistOutput{
+		Title:      wishlist.Title,
+		Occasion:   wishlist.Occasion.String,
+		PublicSlug: wishlist.PublicSlug,
+		ItemCount:  wishlist.ItemCount,
+	}
+	if wishlist.OccasionDate.Valid {
+		date := wishlist.OccasionDate.Time
+		output.OccasionDate = &date
+	}
+	return output
+}
+
+// optionalText stores an empty string as NULL
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	discoverymodels "wish-list/internal/domain/discovery/models"
+	"wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/user/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPublicProfileService_UpdateSettings(t *testing.T) {
+	userID := testUUID()
+
+	newRepo := func(user models.User, taken bool) *UserRepositoryInterfaceMock {
+		return &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+				return &user, nil
+			},
+			IsUsernameTakenFunc: func(ctx context.Context, username string, excludeID pgtype.UUID) (bool, error) {
+				assert.Equal(t, user.ID, excludeID)
+				return taken, nil
+			},
+			UpdatePublicProfileFunc: func(ctx context.Context, u models.User) (*models.User, error) {
+				return &u, nil
+			},
+		}
+	}
+
+	t.Run("publishes a profile with a lowercase username", func(t *testing.T) {
+		repo := newRepo(models.User{ID: pgUUID(t, userID), FirstName: pgText("Anna")}, false)
+		svc := NewPublicProfileService(repo, nil, nil)
+
+		out, err := svc.UpdateSettings(context.Background(), userID, UpdatePublicProfileInput{
+			Username:    ptr(" Anna_K "),
+			DisplayName: ptr("Anna"),
+			Bio:         ptr("Collects vinyl"),
+			ShowAvatar:  ptr(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &PublicProfileSettingsOutput{Username: "anna_k", DisplayName: "Anna", Bio: "Collects vinyl", ShowAvatar: true}, out)
+		require.Len(t, repo.IsUsernameTakenCalls(), 1)
+		assert.Equal(t, "anna_k", repo.IsUsernameTakenCalls()[0].Username)
+	})
+
+	t.Run("keeps omitted fields and clears empty ones", func(t *testing.T) {
+		repo := newRepo(models.User{
+			ID:          pgUUID(t, userID),
+			Username:    pgText("anna_k"),
+			DisplayName: pgText("Anna"),
+			Bio:         pgText("Collects vinyl"),
+		}, false)
+		svc := NewPublicProfileService(repo, nil, nil)
+
+		out, err := svc.UpdateSettings(context.Background(), userID, UpdatePublicProfileInput{Bio: ptr("")})
+		require.NoError(t, err)
+		assert.Equal(t, "anna_k", out.Username)
+		assert.Empty(t, out.Bio)
+		assert.False(t, repo.UpdatePublicProfileCalls()[0].User.Bio.Valid)
+		assert.Empty(t, repo.IsUsernameTakenCalls(), "an unchanged username is not checked")
+	})
+
+	t.Run("clearing the username unpublishes the profile", func(t *testing.T) {
+		repo := newRepo(models.User{ID: pgUUID(t, userID), Username: pgText("anna_k")}, false)
+		svc := NewPublicProfileService(repo, nil, nil)
+
+		out, err := svc.UpdateSettings(context.Background(), userID, UpdatePublicProfileInput{Username: ptr("")})
+		require.NoError(t, err)
+		assert.Empty(t, out.Username)
+		assert.False(t, repo.UpdatePublicProfileCalls()[0].User.Username.Valid)
+	})
+
+	for name, tc := range map[string]struct {
+		username string
+		taken    bool
+		wantErr  error
+	}{
+		"too short":       {username: "ab", wantErr: ErrUsernameInvalid},
+		"invalid letters": {username: "anna.k", wantErr: ErrUsernameInvalid},
+		"reserved":        {username: "Admin", wantErr: ErrUsernameReserved},
+		"taken":           {username: "anna_k", taken: true, wantErr: ErrUsernameTaken},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(models.User{ID: pgUUID(t, userID)}, tc.taken)
+			svc := NewPublicProfileService(repo, nil, nil)
+
+			_, err := svc.UpdateSettings(context.Background(), userID, UpdatePublicProfileInput{Username: ptr(tc.username)})
+			require.ErrorIs(t, err, tc.wantErr)
+			assert.Empty(t, repo.UpdatePublicProfileCalls())
+		})
+	}
+
+	t.Run("moderation rejects the bio", func(t *testing.T) {
+		rejected := errors.New("content rejected")
+		repo := newRepo(models.User{ID: pgUUID(t, userID)}, false)
+		moderator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+				assert.Contains(t, texts, "buy followers")
+				return rejected
+			},
+		}
+		svc := NewPublicProfileService(repo, nil, moderator)
+
+		_, err := svc.UpdateSettings(context.Background(), userID, UpdatePublicProfileInput{Bio: ptr("buy followers")})
+		require.ErrorIs(t, err, rejected)
+		assert.Empty(t, repo.UpdatePublicProfileCalls())
+	})
+
+	t.Run("invalid user id", func(t *testing.T) {
+		svc := NewPublicProfileService(&UserRepositoryInterfaceMock{}, nil, nil)
+
+		_, err := svc.UpdateSettings(context.Background(), "not-a-uuid", UpdatePublicProfileInput{})
+		assert.ErrorIs(t, err, ErrInvalidUserID)
+	})
+}
+
+func TestPublicProfileService_GetPublicProfile(t *testing.T) {
+	ownerID := pgUUID(t, testUUID())
+	user := &models.User{
+		ID:         ownerID,
+		FirstName:  pgText("Anna"),
+		LastName:   pgText("Kowalska"),
+		AvatarUrl:  pgText("https://cdn.example.com/anna.png"),
+		Username:   pgText("anna_k"),
+		Bio:        pgText("Collects vinyl"),
+		ShowAvatar: false,
+	}
+	wishlists := &PublicWishlistListerInterfaceMock{
+		ListByOwnerFunc: func(ctx context.Context, id pgtype.UUID) ([]*discoverymodels.WishlistSummary, error) {
+			assert.Equal(t, ownerID, id)
+			return []*discoverymodels.WishlistSummary{{
+				Title:        "Birthday",
+				OccasionDate: pgtype.Date{Valid: true},
+				PublicSlug:   "birthday-abc",
+				ItemCount:    3,
+			}}, nil
+		},
+	}
+
+	t.Run("shows the public fields only", func(t *testing.T) {
+		repo := &UserRepositoryInterfaceMock{
+			GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+				assert.Equal(t, "anna_k", username)
+				return user, nil
+			},
+		}
+		svc := NewPublicProfileService(repo, wishlists, nil)
+
+		out, err := svc.GetPublicProfile(context.Background(), "Anna_K")
+		require.NoError(t, err)
+		assert.Equal(t, "anna_k", out.Username)
+		assert.Equal(t, "anna_k", out.DisplayName, "falls back to the username, never the real name")
+		assert.Equal(t, "Collects vinyl", out.Bio)
+		assert.Empty(t, out.AvatarUrl, "the avatar is hidden unless shown")
+		require.Len(t, out.Wishlists, 1)
+		assert.Equal(t, "birthday-abc", out.Wishlists[0].PublicSlug)
+		assert.NotNil(t, out.Wishlists[0].OccasionDate)
+	})
+
+	t.Run("shows the avatar when allowed", func(t *testing.T) {
+		shown := *user
+		shown.ShowAvatar = true
+		shown.DisplayName = pgText("Anna K.")
+		repo := &UserRepositoryInterfaceMock{
+			GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+				return &shown, nil
+			},
+		}
+		svc := NewPublicProfileService(repo, wishlists, nil)
+
+		out, err := svc.GetPublicProfile(context.Background(), "anna_k")
+		require.NoError(t, err)
+		assert.Equal(t, "Anna K.", out.DisplayName)
+		assert.Equal(t, "https://cdn.example.com/anna.png", out.AvatarUrl)
+	})
+
+	t.Run("unknown username", func(t *testing.T) {
+		repo := &UserRepositoryInterfaceMock{
+			GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+				return nil, repository.ErrUserNotFound
+			},
+		}
+		svc := NewPublicProfileService(repo, wishlists, nil)
+
+		_, err := svc.GetPublicProfile(context.Background(), "nobody")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("malformed username is not looked up", func(t *testing.T) {
+		repo := &UserRepositoryInterfaceMock{}
+		svc := NewPublicProfileService(repo, wishlists, nil)
+
+		_, err := svc.GetPublicProfile(context.Background(), "a%20b")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+		assert.Empty(t, repo.GetByUsernameCalls())
+	})
+
+	t.Run("account pending deletion has no profile", func(t *testing.T) {
+		pending := *user
+		pending.DeletionRequestedAt = pgtype.Timestamptz{Valid: true}
+		repo := &UserRepositoryInterfaceMock{
+			GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+				return &pending, nil
+			},
+		}
+		svc := NewPublicProfileService(repo, wishlists, nil)
+
+		_, err := svc.GetPublicProfile(context.Background(), "anna_k")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
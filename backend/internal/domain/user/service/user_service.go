@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"wish-list/internal/domain/user/models"
 	"wish-list/internal/domain/user/repository"
@@ -15,12 +16,15 @@ import (
 
 // Sentinel errors
 var (
-	ErrUserAlreadyExists   = errors.New("user with this email already exists")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrInvalidPassword     = errors.New("invalid password")
-	ErrCredentialsRequired = errors.New("email and password are required")
-	ErrInvalidCredentials  = errors.New("invalid email or password")
-	ErrInvalidUserID       = errors.New("invalid user id")
+	ErrUserAlreadyExists      = errors.New("user with this email already exists")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrInvalidPassword        = errors.New("invalid password")
+	ErrCredentialsRequired    = errors.New("email and password are required")
+	ErrInvalidCredentials     = errors.New("invalid email or password")
+	ErrInvalidUserID          = errors.New("invalid user id")
+	ErrAccountPendingDeletion = errors.New("account is scheduled for deletion")
+	ErrNoPendingDeletion      = errors.New("account has no pending deletion")
+	ErrEmailRejected          = errors.New("email address is not accepted")
 )
 
 // UserServiceInterface defines the interface for user-related operations
@@ -32,21 +36,31 @@ type UserServiceInterface interface {
 	ChangeEmail(ctx context.Context, userID, currentPassword, newEmail string) error
 	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
 	DeleteUser(ctx context.Context, userID string) error
+	CancelAccountDeletion(ctx context.Context, input LoginUserInput) (*UserOutput, error)
+	CancelAccountDeletionByID(ctx context.Context, userID string) (*UserOutput, error)
 }
 
 // UserService implements business logic for user operations.
 type UserService struct {
 	repo              repository.UserRepositoryInterface
+	emailValidator    EmailValidator
 	reservationLinker GuestReservationLinker
 }
 
-// GuestReservationLinker links guest reservations to an authenticated user by email.
+// EmailValidator rejects malformed, disposable or undeliverable addresses.
+type EmailValidator interface {
+	Validate(ctx context.Context, address string) error
+}
+
+// GuestReservationLinker queues linking the guest reservations made with a user's
+// email to the user.
 type GuestReservationLinker interface {
-	LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
+	Enqueue(ctx context.Context, userID pgtype.UUID) error
 }
 
-// NewUserService creates a new UserService instance.
-func NewUserService(repo repository.UserRepositoryInterface, reservationLinker ...GuestReservationLinker) *UserService {
+// NewUserService creates a new UserService instance. emailValidator may be nil
+// to accept any address that passes request validation.
+func NewUserService(repo repository.UserRepositoryInterface, emailValidator EmailValidator, reservationLinker ...GuestReservationLinker) *UserService {
 	var linker GuestReservationLinker
 	if len(reservationLinker) > 0 {
 		linker = reservationLinker[0]
@@ -54,6 +68,7 @@ func NewUserService(repo repository.UserRepositoryInterface, reservationLinker .
 
 	return &UserService{
 		repo:              repo,
+		emailValidator:    emailValidator,
 		reservationLinker: linker,
 	}
 }
@@ -91,11 +106,14 @@ type UpdateProfileInput struct {
 
 // UserOutput represents the user data returned by service operations.
 type UserOutput struct {
-	ID        string
-	Email     string
-	FirstName string
-	LastName  string
-	AvatarUrl string
+	ID                  string
+	Email               string
+	FirstName           string
+	LastName            string
+	AvatarUrl           string
+	UserType            string     // "user" or "admin"; issued in access tokens
+	Plan                string     // "free" or "premium"
+	DeletionRequestedAt *time.Time // Set while a user-requested deletion is pending
 }
 
 // Register creates a new user account with the provided registration data.
@@ -104,6 +122,9 @@ func (s *UserService) Register(ctx context.Context, input RegisterUserInput) (*U
 	if input.Email == "" || input.Password == "" {
 		return nil, ErrCredentialsRequired
 	}
+	if err := s.validateEmail(ctx, input.Email); err != nil {
+		return nil, err
+	}
 
 	// Check if user already exists
 	existingUser, err := s.repo.GetByEmail(ctx, input.Email)
@@ -155,9 +176,9 @@ func (s *UserService) Register(ctx context.Context, input RegisterUserInput) (*U
 	}
 
 	if s.reservationLinker != nil && createdUser.ID.Valid && createdUser.IsVerified.Valid && createdUser.IsVerified.Bool {
-		if _, linkErr := s.reservationLinker.LinkGuestReservationsToUserByEmail(ctx, createdUser.Email, createdUser.ID); linkErr != nil {
-			// Best-effort linking: registration should not fail if linking fails.
-			logger.Warn("failed to link guest reservations", "user_id", createdUser.ID.String(), "error", linkErr)
+		if linkErr := s.reservationLinker.Enqueue(ctx, createdUser.ID); linkErr != nil {
+			// Best-effort: registration should not fail, and the user can still claim them.
+			logger.WarnContext(ctx, "failed to queue guest reservation linking", "user_id", createdUser.ID.String(), "error", linkErr)
 		}
 	}
 
@@ -167,6 +188,8 @@ func (s *UserService) Register(ctx context.Context, input RegisterUserInput) (*U
 		FirstName: createdUser.FirstName.String,
 		LastName:  createdUser.LastName.String,
 		AvatarUrl: createdUser.AvatarUrl.String,
+		UserType:  createdUser.UserType,
+		Plan:      createdUser.Plan,
 	}
 
 	return output, nil
@@ -195,15 +218,12 @@ func (s *UserService) Login(ctx context.Context, input LoginUserInput) (*UserOut
 		return nil, ErrInvalidCredentials
 	}
 
-	output := &UserOutput{
-		ID:        user.ID.String(),
-		Email:     user.Email,
-		FirstName: user.FirstName.String,
-		LastName:  user.LastName.String,
-		AvatarUrl: user.AvatarUrl.String,
+	// Accounts pending deletion can only cancel the deletion (see CancelAccountDeletion)
+	if user.DeletionRequestedAt.Valid {
+		return nil, ErrAccountPendingDeletion
 	}
 
-	return output, nil
+	return toUserOutput(user), nil
 }
 
 // GetUser retrieves a user by their ID.
@@ -221,15 +241,7 @@ func (s *UserService) GetUser(ctx context.Context, userID string) (*UserOutput,
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	output := &UserOutput{
-		ID:        user.ID.String(),
-		Email:     user.Email,
-		FirstName: user.FirstName.String,
-		LastName:  user.LastName.String,
-		AvatarUrl: user.AvatarUrl.String,
-	}
-
-	return output, nil
+	return toUserOutput(user), nil
 }
 
 // DeleteUser permanently removes a user account.
@@ -245,6 +257,94 @@ func (s *UserService) DeleteUser(ctx context.Context, userID string) error {
 	return nil
 }
 
+// CancelAccountDeletion clears a pending deletion after verifying the account credentials.
+// This is the only action available to an account pending deletion, since Login is blocked.
+func (s *UserService) CancelAccountDeletion(ctx context.Context, input LoginUserInput) (*UserOutput, error) {
+	if input.Email == "" || input.Password == "" {
+		return nil, ErrCredentialsRequired
+	}
+
+	user, err := s.repo.GetByEmail(ctx, input.Email)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	if !user.PasswordHash.Valid {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash.String), []byte(input.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.cancelDeletion(ctx, user)
+}
+
+// CancelAccountDeletionByID clears a pending deletion for an already authenticated user.
+func (s *UserService) CancelAccountDeletionByID(ctx context.Context, userID string) (*UserOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(userID); err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return s.cancelDeletion(ctx, user)
+}
+
+func (s *UserService) cancelDeletion(ctx context.Context, user *models.User) (*UserOutput, error) {
+	if !user.DeletionRequestedAt.Valid {
+		return nil, ErrNoPendingDeletion
+	}
+
+	updatedUser, err := s.repo.CancelDeletion(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+
+	logger.InfoContext(ctx, "account deletion canceled", "user_id", updatedUser.ID.String())
+
+	return toUserOutput(updatedUser), nil
+}
+
+// validateEmail applies the configured email checks to an address a user
+// wants to sign in with
+func (s *UserService) validateEmail(ctx context.Context, email string) error {
+	if s.emailValidator == nil {
+		return nil
+	}
+	if err := s.emailValidator.Validate(ctx, email); err != nil {
+		return fmt.Errorf("%w: %w", ErrEmailRejected, err)
+	}
+	return nil
+}
+
+func toUserOutput(user *models.User) *UserOutput {
+	output := &UserOutput{
+		ID:        user.ID.String(),
+		Email:     user.Email,
+		FirstName: user.FirstName.String,
+		LastName:  user.LastName.String,
+		AvatarUrl: user.AvatarUrl.String,
+		UserType:  user.UserType,
+		Plan:      user.Plan,
+	}
+	if user.DeletionRequestedAt.Valid {
+		requestedAt := user.DeletionRequestedAt.Time
+		output.DeletionRequestedAt = &requestedAt
+	}
+	return output
+}
+
 // UpdateProfile updates only non-sensitive profile information (firstName, lastName, avatarUrl)
 func (s *UserService) UpdateProfile(ctx context.Context, userID string, input UpdateProfileInput) (*UserOutput, error) {
 	id := pgtype.UUID{}
@@ -288,6 +388,7 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID string, input Up
 		FirstName: updatedUser.FirstName.String,
 		LastName:  updatedUser.LastName.String,
 		AvatarUrl: updatedUser.AvatarUrl.String,
+		Plan:      updatedUser.Plan,
 	}
 
 	return output, nil
@@ -313,6 +414,9 @@ func (s *UserService) ChangeEmail(ctx context.Context, userID, currentPassword,
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash.String), []byte(currentPassword)); err != nil {
 		return ErrInvalidPassword
 	}
+	if err := s.validateEmail(ctx, newEmail); err != nil {
+		return err
+	}
 
 	// Check if new email is already in use by another account
 	existingUser, err := s.repo.GetByEmail(ctx, newEmail)
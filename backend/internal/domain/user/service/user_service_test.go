@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"wish-list/internal/domain/user/models"
 	"wish-list/internal/domain/user/repository"
+	"wish-list/internal/pkg/emailcheck"
 	"wish-list/internal/pkg/logger"
 
 	"github.com/google/uuid"
@@ -63,34 +65,35 @@ func makeDBUser(id pgtype.UUID, email, passwordHash, firstName, lastName, avatar
 }
 
 type guestReservationLinkerMock struct {
-	linkFunc func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error)
-	calls    []struct {
-		guestEmail string
-		userID     pgtype.UUID
-	}
+	enqueueFunc func(ctx context.Context, userID pgtype.UUID) error
+	calls       []pgtype.UUID
 }
 
-func (m *guestReservationLinkerMock) LinkGuestReservationsToUserByEmail(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
-	m.calls = append(m.calls, struct {
-		guestEmail string
-		userID     pgtype.UUID
-	}{
-		guestEmail: guestEmail,
-		userID:     userID,
-	})
+func (m *guestReservationLinkerMock) Enqueue(ctx context.Context, userID pgtype.UUID) error {
+	m.calls = append(m.calls, userID)
 
-	if m.linkFunc == nil {
-		return 0, nil
+	if m.enqueueFunc == nil {
+		return nil
 	}
 
-	return m.linkFunc(ctx, guestEmail, userID)
+	return m.enqueueFunc(ctx, userID)
+}
+
+type emailValidatorMock struct {
+	err   error
+	calls []string
+}
+
+func (m *emailValidatorMock) Validate(_ context.Context, address string) error {
+	m.calls = append(m.calls, address)
+	return m.err
 }
 
 // --- Register tests ---
 
 func TestUserService_Register(t *testing.T) {
 	t.Run("returns ErrCredentialsRequired when email is empty", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "",
@@ -101,7 +104,7 @@ func TestUserService_Register(t *testing.T) {
 	})
 
 	t.Run("returns ErrCredentialsRequired when password is empty", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -111,6 +114,22 @@ func TestUserService_Register(t *testing.T) {
 		assert.ErrorIs(t, err, ErrCredentialsRequired)
 	})
 
+	t.Run("returns ErrEmailRejected when validator rejects the address", func(t *testing.T) {
+		mockRepo := &UserRepositoryInterfaceMock{}
+		validator := &emailValidatorMock{err: emailcheck.ErrDisposableDomain}
+		svc := NewUserService(mockRepo, validator)
+
+		_, err := svc.Register(context.Background(), RegisterUserInput{
+			Email:    "user@mailinator.com",
+			Password: "secret123",
+		})
+
+		require.ErrorIs(t, err, ErrEmailRejected)
+		assert.ErrorIs(t, err, emailcheck.ErrDisposableDomain)
+		assert.Equal(t, []string{"user@mailinator.com"}, validator.calls)
+		assert.Empty(t, mockRepo.GetByEmailCalls())
+	})
+
 	t.Run("returns ErrUserAlreadyExists when email is taken", func(t *testing.T) {
 		existingID := pgUUID(t, testUUID())
 		existingUser := makeDBUser(existingID, "user@example.com", "hash", "John", "Doe", "")
@@ -120,7 +139,7 @@ func TestUserService_Register(t *testing.T) {
 				return &existingUser, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -139,7 +158,7 @@ func TestUserService_Register(t *testing.T) {
 				return nil, dbErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -176,7 +195,7 @@ func TestUserService_Register(t *testing.T) {
 				return &created, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:     "user@example.com",
@@ -205,7 +224,7 @@ func TestUserService_Register(t *testing.T) {
 				return nil, errors.New("database write failure")
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -216,13 +235,9 @@ func TestUserService_Register(t *testing.T) {
 		assert.Len(t, mockRepo.CreateCalls(), 1)
 	})
 
-	t.Run("links guest reservations by email only for verified user", func(t *testing.T) {
+	t.Run("queues guest reservation linking only for verified user", func(t *testing.T) {
 		createdID := pgUUID(t, testUUID())
-		linker := &guestReservationLinkerMock{
-			linkFunc: func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
-				return 2, nil
-			},
-		}
+		linker := &guestReservationLinkerMock{}
 
 		mockRepo := &UserRepositoryInterfaceMock{
 			GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
@@ -234,7 +249,7 @@ func TestUserService_Register(t *testing.T) {
 				return &created, nil
 			},
 		}
-		svc := NewUserService(mockRepo, linker)
+		svc := NewUserService(mockRepo, nil, linker)
 
 		output, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:     "user@example.com",
@@ -246,11 +261,10 @@ func TestUserService_Register(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, output)
 		require.Len(t, linker.calls, 1)
-		assert.Equal(t, "user@example.com", linker.calls[0].guestEmail)
-		assert.Equal(t, createdID, linker.calls[0].userID)
+		assert.Equal(t, createdID, linker.calls[0])
 	})
 
-	t.Run("does not link guest reservations for unverified user", func(t *testing.T) {
+	t.Run("does not queue guest reservation linking for unverified user", func(t *testing.T) {
 		createdID := pgUUID(t, testUUID())
 		linker := &guestReservationLinkerMock{}
 
@@ -264,7 +278,7 @@ func TestUserService_Register(t *testing.T) {
 				return &created, nil
 			},
 		}
-		svc := NewUserService(mockRepo, linker)
+		svc := NewUserService(mockRepo, nil, linker)
 
 		output, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -276,11 +290,11 @@ func TestUserService_Register(t *testing.T) {
 		require.Len(t, linker.calls, 0)
 	})
 
-	t.Run("does not fail registration when guest reservation linking fails", func(t *testing.T) {
+	t.Run("does not fail registration when queueing guest reservation linking fails", func(t *testing.T) {
 		createdID := pgUUID(t, testUUID())
 		linker := &guestReservationLinkerMock{
-			linkFunc: func(ctx context.Context, guestEmail string, userID pgtype.UUID) (int, error) {
-				return 0, errors.New("linking failed")
+			enqueueFunc: func(ctx context.Context, userID pgtype.UUID) error {
+				return errors.New("linking failed")
 			},
 		}
 
@@ -294,7 +308,7 @@ func TestUserService_Register(t *testing.T) {
 				return &created, nil
 			},
 		}
-		svc := NewUserService(mockRepo, linker)
+		svc := NewUserService(mockRepo, nil, linker)
 
 		output, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -323,7 +337,7 @@ func TestUserService_Register(t *testing.T) {
 				return &created, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.Register(context.Background(), RegisterUserInput{
 			Email:    "user@example.com",
@@ -340,7 +354,7 @@ func TestUserService_Register(t *testing.T) {
 
 func TestUserService_Login(t *testing.T) {
 	t.Run("returns ErrCredentialsRequired when email is empty", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "",
@@ -351,7 +365,7 @@ func TestUserService_Login(t *testing.T) {
 	})
 
 	t.Run("returns ErrCredentialsRequired when password is empty", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "user@example.com",
@@ -367,7 +381,7 @@ func TestUserService_Login(t *testing.T) {
 				return nil, repository.ErrUserNotFound
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "unknown@example.com",
@@ -393,7 +407,7 @@ func TestUserService_Login(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "user@example.com",
@@ -413,7 +427,7 @@ func TestUserService_Login(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "user@example.com",
@@ -433,7 +447,7 @@ func TestUserService_Login(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "user@example.com",
@@ -455,7 +469,7 @@ func TestUserService_Login(t *testing.T) {
 				return nil, errors.New("database timeout")
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.Login(context.Background(), LoginUserInput{
 			Email:    "user@example.com",
@@ -465,13 +479,127 @@ func TestUserService_Login(t *testing.T) {
 		// Login converts ALL repo errors to ErrInvalidCredentials to avoid user enumeration
 		assert.ErrorIs(t, err, ErrInvalidCredentials)
 	})
+
+	t.Run("returns ErrAccountPendingDeletion when deletion was requested", func(t *testing.T) {
+		userID := pgUUID(t, testUUID())
+		hash := testHashPassword(t, "correct-password")
+		user := makeDBUser(userID, "user@example.com", hash, "John", "Doe", "")
+		user.DeletionRequestedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		mockRepo := &UserRepositoryInterfaceMock{
+			GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+				return &user, nil
+			},
+		}
+		svc := NewUserService(mockRepo, nil)
+
+		_, err := svc.Login(context.Background(), LoginUserInput{
+			Email:    "user@example.com",
+			Password: "correct-password",
+		})
+
+		assert.ErrorIs(t, err, ErrAccountPendingDeletion)
+	})
+
+	t.Run("pending deletion is not revealed for wrong password", func(t *testing.T) {
+		userID := pgUUID(t, testUUID())
+		hash := testHashPassword(t, "correct-password")
+		user := makeDBUser(userID, "user@example.com", hash, "John", "Doe", "")
+		user.DeletionRequestedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		mockRepo := &UserRepositoryInterfaceMock{
+			GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+				return &user, nil
+			},
+		}
+		svc := NewUserService(mockRepo, nil)
+
+		_, err := svc.Login(context.Background(), LoginUserInput{
+			Email:    "user@example.com",
+			Password: "wrong-password",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
+
+// --- CancelAccountDeletion tests ---
+
+func TestUserService_CancelAccountDeletion(t *testing.T) {
+	t.Run("clears pending deletion with valid credentials", func(t *testing.T) {
+		userID := pgUUID(t, testUUID())
+		hash := testHashPassword(t, "correct-password")
+		user := makeDBUser(userID, "user@example.com", hash, "John", "Doe", "")
+		user.DeletionRequestedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		mockRepo := &UserRepositoryInterfaceMock{
+			GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+				return &user, nil
+			},
+			CancelDeletionFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+				canceled := user
+				canceled.DeletionRequestedAt = pgtype.Timestamptz{}
+				return &canceled, nil
+			},
+		}
+		svc := NewUserService(mockRepo, nil)
+
+		output, err := svc.CancelAccountDeletion(context.Background(), LoginUserInput{
+			Email:    "user@example.com",
+			Password: "correct-password",
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.DeletionRequestedAt)
+		require.Len(t, mockRepo.CancelDeletionCalls(), 1)
+		assert.Equal(t, userID, mockRepo.CancelDeletionCalls()[0].ID)
+	})
+
+	t.Run("returns ErrInvalidCredentials for wrong password", func(t *testing.T) {
+		userID := pgUUID(t, testUUID())
+		hash := testHashPassword(t, "correct-password")
+		user := makeDBUser(userID, "user@example.com", hash, "John", "Doe", "")
+		user.DeletionRequestedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		mockRepo := &UserRepositoryInterfaceMock{
+			GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+				return &user, nil
+			},
+		}
+		svc := NewUserService(mockRepo, nil)
+
+		_, err := svc.CancelAccountDeletion(context.Background(), LoginUserInput{
+			Email:    "user@example.com",
+			Password: "wrong-password",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		assert.Empty(t, mockRepo.CancelDeletionCalls())
+	})
+
+	t.Run("returns ErrNoPendingDeletion when no deletion was requested", func(t *testing.T) {
+		userID := pgUUID(t, testUUID())
+		user := makeDBUser(userID, "user@example.com", "", "John", "Doe", "")
+
+		mockRepo := &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.User, error) {
+				return &user, nil
+			},
+		}
+		svc := NewUserService(mockRepo, nil)
+
+		_, err := svc.CancelAccountDeletionByID(context.Background(), userID.String())
+
+		assert.ErrorIs(t, err, ErrNoPendingDeletion)
+		assert.Empty(t, mockRepo.CancelDeletionCalls())
+	})
 }
 
 // --- GetUser tests ---
 
 func TestUserService_GetUser(t *testing.T) {
 	t.Run("returns ErrInvalidUserID for invalid UUID", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.GetUser(context.Background(), "not-a-uuid")
 
@@ -479,7 +607,7 @@ func TestUserService_GetUser(t *testing.T) {
 	})
 
 	t.Run("returns ErrInvalidUserID for empty string", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.GetUser(context.Background(), "")
 
@@ -492,7 +620,7 @@ func TestUserService_GetUser(t *testing.T) {
 				return nil, repository.ErrUserNotFound
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 		validID := testUUID()
 
 		_, err := svc.GetUser(context.Background(), validID)
@@ -507,7 +635,7 @@ func TestUserService_GetUser(t *testing.T) {
 				return nil, dbErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.GetUser(context.Background(), testUUID())
 
@@ -525,7 +653,7 @@ func TestUserService_GetUser(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.GetUser(context.Background(), userIDStr)
 
@@ -544,7 +672,7 @@ func TestUserService_GetUser(t *testing.T) {
 
 func TestUserService_UpdateProfile(t *testing.T) {
 	t.Run("returns ErrInvalidUserID for invalid UUID", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		_, err := svc.UpdateProfile(context.Background(), "bad-id", UpdateProfileInput{})
 
@@ -558,7 +686,7 @@ func TestUserService_UpdateProfile(t *testing.T) {
 				return nil, repoErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.UpdateProfile(context.Background(), testUUID(), UpdateProfileInput{})
 
@@ -595,7 +723,7 @@ func TestUserService_UpdateProfile(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.UpdateProfile(context.Background(), userIDStr, UpdateProfileInput{
 			FirstName: &newFirst,
@@ -628,7 +756,7 @@ func TestUserService_UpdateProfile(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.UpdateProfile(context.Background(), userIDStr, UpdateProfileInput{
 			FirstName: &newFirst,
@@ -658,7 +786,7 @@ func TestUserService_UpdateProfile(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		output, err := svc.UpdateProfile(context.Background(), userIDStr, UpdateProfileInput{})
 
@@ -680,7 +808,7 @@ func TestUserService_UpdateProfile(t *testing.T) {
 				return nil, errors.New("write failure")
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		_, err := svc.UpdateProfile(context.Background(), userIDStr, UpdateProfileInput{})
 
@@ -695,7 +823,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 	const currentPassword = "current-password"
 
 	t.Run("returns ErrInvalidUserID for invalid UUID", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		err := svc.ChangeEmail(context.Background(), "bad-id", currentPassword, "new@example.com")
 
@@ -709,7 +837,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return nil, repoErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), testUUID(), currentPassword, "new@example.com")
 
@@ -730,7 +858,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, currentPassword, "new@example.com")
 
@@ -748,7 +876,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, "wrong-password", "new@example.com")
 
@@ -772,7 +900,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return &otherUser, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, currentPassword, "new@example.com")
 
@@ -799,7 +927,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return &u, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, currentPassword, "same@example.com")
 
@@ -824,7 +952,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return &u, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, currentPassword, "new@example.com")
 
@@ -850,7 +978,7 @@ func TestUserService_ChangeEmail(t *testing.T) {
 				return nil, errors.New("update failed")
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangeEmail(context.Background(), userIDStr, currentPassword, "new@example.com")
 
@@ -866,7 +994,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 	const newPassword = "new-password"
 
 	t.Run("returns ErrInvalidUserID for invalid UUID", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		err := svc.ChangePassword(context.Background(), "not-uuid", currentPassword, newPassword)
 
@@ -880,7 +1008,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 				return nil, repoErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangePassword(context.Background(), testUUID(), currentPassword, newPassword)
 
@@ -901,7 +1029,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangePassword(context.Background(), userIDStr, currentPassword, newPassword)
 
@@ -919,7 +1047,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 				return &user, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangePassword(context.Background(), userIDStr, "wrong-password", newPassword)
 
@@ -949,7 +1077,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 				return &u, nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangePassword(context.Background(), userIDStr, currentPassword, newPassword)
 
@@ -971,7 +1099,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 				return nil, errors.New("write error")
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.ChangePassword(context.Background(), userIDStr, currentPassword, newPassword)
 
@@ -983,7 +1111,7 @@ func TestUserService_ChangePassword(t *testing.T) {
 
 func TestUserService_DeleteUser(t *testing.T) {
 	t.Run("returns ErrInvalidUserID for invalid UUID", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		err := svc.DeleteUser(context.Background(), "invalid")
 
@@ -991,7 +1119,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 	})
 
 	t.Run("returns ErrInvalidUserID for empty string", func(t *testing.T) {
-		svc := NewUserService(&UserRepositoryInterfaceMock{})
+		svc := NewUserService(&UserRepositoryInterfaceMock{}, nil)
 
 		err := svc.DeleteUser(context.Background(), "")
 
@@ -1008,7 +1136,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 				return nil
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.DeleteUser(context.Background(), userIDStr)
 
@@ -1023,7 +1151,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 				return repoErr
 			},
 		}
-		svc := NewUserService(mockRepo)
+		svc := NewUserService(mockRepo, nil)
 
 		err := svc.DeleteUser(context.Background(), testUUID())
 
@@ -1,6 +1,9 @@
 package dto
 
-import "wish-list/internal/domain/wishlist/service"
+import (
+	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/priority"
+)
 
 type CreateWishListRequest struct {
 	Title        string `json:"title" validate:"required,max=200"`
@@ -8,6 +11,9 @@ type CreateWishListRequest struct {
 	Occasion     string `json:"occasion"`
 	OccasionDate string `json:"occasion_date"`
 	IsPublic     bool   `json:"is_public"`
+	PublicSlug   string `json:"public_slug" validate:"omitempty,max=100"`     // Kept as chosen on premium; other plans get a random suffix
+	Recurrence   string `json:"recurrence" validate:"omitempty,oneof=yearly"` // Requires occasion_date
+	Discoverable bool   `json:"discoverable"`                                 // List in public search and trending while public
 }
 
 func (r *CreateWishListRequest) ToServiceInput() service.CreateWishListInput {
@@ -17,6 +23,9 @@ func (r *CreateWishListRequest) ToServiceInput() service.CreateWishListInput {
 		Occasion:     r.Occasion,
 		OccasionDate: r.OccasionDate,
 		IsPublic:     r.IsPublic,
+		PublicSlug:   r.PublicSlug,
+		Recurrence:   r.Recurrence,
+		Discoverable: r.Discoverable,
 	}
 }
 
@@ -26,7 +35,10 @@ type UpdateWishListRequest struct {
 	Occasion     *string `json:"occasion"`
 	OccasionDate *string `json:"occasion_date"`
 	IsPublic     *bool   `json:"is_public"`
-	PublicSlug   *string `json:"public_slug" validate:"omitempty,max=100"`
+	PublicSlug   *string `json:"public_slug" validate:"omitempty,max=100"`     // Kept as chosen on premium; other plans get a random suffix
+	Recurrence   *string `json:"recurrence" validate:"omitempty,oneof=yearly"` // Empty string stops recurring
+	Discoverable *bool   `json:"discoverable"`                                 // List in public search and trending while public
+	Version      *int32  `json:"version,omitempty" validate:"omitempty,gte=1"` // Alternative to the If-Match header
 }
 
 func (r *UpdateWishListRequest) ToServiceInput() service.UpdateWishListInput {
@@ -37,57 +49,125 @@ func (r *UpdateWishListRequest) ToServiceInput() service.UpdateWishListInput {
 		OccasionDate: r.OccasionDate,
 		IsPublic:     r.IsPublic,
 		PublicSlug:   r.PublicSlug,
+		Recurrence:   r.Recurrence,
+		Discoverable: r.Discoverable,
+		Version:      r.Version,
+	}
+}
+
+type MergeWishListsRequest struct {
+	SourceID      string `json:"source_id" validate:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Mode          string `json:"mode" validate:"omitempty,oneof=move copy" enums:"move,copy" example:"move"` // Defaults to move
+	ArchiveSource bool   `json:"archive_source"`                                                             // Mark the source as merged and unpublish it
+}
+
+func (r *MergeWishListsRequest) ToServiceInput() service.MergeWishListsInput {
+	return service.MergeWishListsInput{
+		SourceID:      r.SourceID,
+		Mode:          r.Mode,
+		ArchiveSource: r.ArchiveSource,
+	}
+}
+
+type RollOverUnpurchasedRequest struct {
+	Title        string `json:"title" validate:"omitempty,max=200" example:"Birthday 2027"` // Defaults to the current title, with its year moved along
+	OccasionDate string `json:"occasion_date" example:"2027-03-14T00:00:00Z"`               // Defaults to the next yearly anniversary of the current date
+}
+
+func (r *RollOverUnpurchasedRequest) ToServiceInput() service.RollOverUnpurchasedInput {
+	return service.RollOverUnpurchasedInput{
+		Title:        r.Title,
+		OccasionDate: r.OccasionDate,
 	}
 }
 
 type CreateGiftItemRequest struct {
-	Name        string  `json:"name" validate:"required,max=255"`
-	Description string  `json:"description"`
-	Link        string  `json:"link" validate:"omitempty,url"`
-	ImageURL    string  `json:"image_url" validate:"omitempty,url"`
-	Price       float64 `json:"price" validate:"omitempty,min=0"`
-	Priority    int     `json:"priority" validate:"omitempty,min=0,max=10"`
-	Notes       string  `json:"notes"`
-	Position    int     `json:"position" validate:"omitempty,min=0"`
+	Name        string          `json:"name" validate:"required,max=255"`
+	Description string          `json:"description"`
+	Link        string          `json:"link" validate:"omitempty,url"`
+	ImageURL    string          `json:"image_url" validate:"omitempty,url"`
+	Price       float64         `json:"price" validate:"omitempty,min=0"`
+	Priority    *priority.Value `json:"priority" swaggertype:"string" enums:"must_have,nice_to_have,dream"` // Level name, or a legacy 0-10 number
+	Notes       string          `json:"notes"`
+	Position    int             `json:"position" validate:"omitempty,min=0"`
 }
 
 func (r *CreateGiftItemRequest) ToServiceInput() service.CreateGiftItemInput {
-	return service.CreateGiftItemInput{
+	input := service.CreateGiftItemInput{
 		Name:        r.Name,
 		Description: r.Description,
 		Link:        r.Link,
 		ImageURL:    r.ImageURL,
 		Price:       r.Price,
-		Priority:    r.Priority,
 		Notes:       r.Notes,
 		Position:    r.Position,
 	}
+	if r.Priority != nil {
+		input.Priority = int(r.Priority.Number)
+		input.PriorityLevel = r.Priority.Level
+	}
+	return input
 }
 
 type UpdateGiftItemRequest struct {
-	Name        *string  `json:"name" validate:"omitempty,max=255"`
-	Description *string  `json:"description"`
-	Link        *string  `json:"link" validate:"omitempty,url"`
-	ImageURL    *string  `json:"image_url" validate:"omitempty,url"`
-	Price       *float64 `json:"price" validate:"omitempty,min=0"`
-	Priority    *int     `json:"priority" validate:"omitempty,min=0,max=10"`
-	Notes       *string  `json:"notes"`
-	Position    *int     `json:"position" validate:"omitempty,min=0"`
+	Name        *string         `json:"name" validate:"omitempty,max=255"`
+	Description *string         `json:"description"`
+	Link        *string         `json:"link" validate:"omitempty,url"`
+	ImageURL    *string         `json:"image_url" validate:"omitempty,url"`
+	Price       *float64        `json:"price" validate:"omitempty,min=0"`
+	Priority    *priority.Value `json:"priority" swaggertype:"string" enums:"must_have,nice_to_have,dream"` // Level name, or a legacy 0-10 number
+	Notes       *string         `json:"notes"`
+	Position    *int            `json:"position" validate:"omitempty,min=0"`
 }
 
 func (r *UpdateGiftItemRequest) ToServiceInput() service.UpdateGiftItemInput {
-	return service.UpdateGiftItemInput{
+	input := service.UpdateGiftItemInput{
 		Name:        r.Name,
 		Description: r.Description,
 		Link:        r.Link,
 		ImageURL:    r.ImageURL,
 		Price:       r.Price,
-		Priority:    r.Priority,
 		Notes:       r.Notes,
 		Position:    r.Position,
 	}
+	if r.Priority != nil {
+		number := int(r.Priority.Number)
+		input.Priority = &number
+		input.PriorityLevel = &r.Priority.Level
+	}
+	return input
 }
 
 type PurchaseRequest struct {
 	PurchasedPrice float64 `json:"purchased_price"`
 }
+
+// EditHeartbeatRequest is sent periodically by an open wishlist editor
+type EditHeartbeatRequest struct {
+	Version *int32 `json:"version" example:"3"` // Version the editor loaded; If-Match takes precedence
+}
+
+// RecordViewRequest is sent by the share page when it is opened
+type RecordViewRequest struct {
+	SessionID string `json:"session_id" validate:"required,max=64" example:"3f2b9c1e-7d4a-4e8b-9a61-0c5d2e7f8a90"` // Chosen by the client, stable for the browser session
+}
+
+// ImportWishListRequest names the public wish list to copy to the user's account
+type ImportWishListRequest struct {
+	PublicSlug string `json:"public_slug" validate:"required,max=255" example:"baby-registry"`
+}
+
+// InviteGuestsRequest lists the guests to email the wish list's share link to
+type InviteGuestsRequest struct {
+	Emails  []string `json:"emails" validate:"required_without=Groups,max=50,dive,required,email" example:"jamie@example.com,sam@example.com"`
+	Groups  []string `json:"groups" validate:"max=10,dive,required,max=50" example:"family"`                 // Relationships of saved contacts to invite
+	Message string   `json:"message" validate:"max=1000" example:"We finally moved in, come see the place!"` // Added to the email
+}
+
+func (r *InviteGuestsRequest) ToServiceInput() service.InviteGuestsInput {
+	return service.InviteGuestsInput{
+		Emails:  r.Emails,
+		Groups:  r.Groups,
+		Message: r.Message,
+	}
+}
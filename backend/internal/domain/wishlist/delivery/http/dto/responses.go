@@ -2,8 +2,12 @@ package dto
 
 import (
 	"fmt"
+	"time"
 
+	"wish-list/internal/domain/item/mapper"
 	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/fieldset"
+	"wish-list/internal/pkg/variant"
 )
 
 // WishListResponse is the handler-level DTO for wishlist data
@@ -16,10 +20,63 @@ type WishListResponse struct {
 	OccasionDate string `json:"occasion_date"`
 	IsPublic     bool   `json:"is_public"`
 	PublicSlug   string `json:"public_slug"`
+	VanitySlug   string `json:"vanity_slug,omitempty" example:"birthday"` // Also served at /u/{username}/{vanity_slug}
 	ViewCount    string `json:"view_count" validate:"required"`
 	ItemCount    int    `json:"item_count" example:"5"`
 	CreatedAt    string `json:"created_at" validate:"required"`
 	UpdatedAt    string `json:"updated_at" validate:"required"`
+	Version      int32  `json:"version" example:"1"`
+	Recurrence   string `json:"recurrence,omitempty" example:"yearly"`
+	Discoverable bool   `json:"discoverable"`             // Listed in public search and trending while public
+	RolledOverTo string `json:"rolled_over_to,omitempty"` // ID of the list created for the next occurrence
+	MergedInto   string `json:"merged_into,omitempty"`    // ID of the list this one was merged into and archived
+
+	fields fieldset.Set // Sparse fieldset; the zero set encodes every member
+}
+
+// SelectFields limits the JSON encoding to a sparse fieldset
+func (r *WishListResponse) SelectFields(fields fieldset.Set) {
+	r.fields = fields
+}
+
+// MarshalJSON encodes the members selected with SelectFields
+func (r WishListResponse) MarshalJSON() ([]byte, error) {
+	type plain WishListResponse
+	return r.fields.Marshal(plain(r))
+}
+
+// MergeWishListsResponse is the target wish list after a merge
+type MergeWishListsResponse struct {
+	WishList    *WishListResponse `json:"wishlist"`
+	ItemsMerged int64             `json:"items_merged" example:"7"` // Items newly added; items the target already had are skipped
+}
+
+// RollOverResponse is the list started from the items nobody gave
+type RollOverResponse struct {
+	WishList     *WishListResponse `json:"wishlist"`
+	ItemsCarried int64             `json:"items_carried" example:"4"`
+}
+
+// WishListVersionConflictResponse is returned with 409 when an update was based on a stale version
+type WishListVersionConflictResponse struct {
+	Error     string           `json:"error" example:"Wish list was modified by another request"`
+	Current   WishListResponse `json:"current"`
+	RequestID string           `json:"request_id,omitempty"`
+}
+
+// WishListSlugMovedResponse is returned with 301 when a public slug was retired;
+// Location points at the same resource under the current slug
+type WishListSlugMovedResponse struct {
+	Error    string `json:"error" example:"Wish list has moved to a new address"`
+	Slug     string `json:"slug" example:"my-birthday-2026"`
+	Location string `json:"location" example:"/api/public/wishlists/my-birthday-2026"`
+}
+
+// WishListClosedResponse is returned with 410 when a public link was closed some
+// time after the wishlist's occasion
+type WishListClosedResponse struct {
+	Error   string `json:"error" example:"This wish list is closed"`
+	Message string `json:"message" example:"The occasion has passed and this wish list is no longer shared."`
 }
 
 func FromWishListOutput(wl *service.WishListOutput) *WishListResponse {
@@ -35,10 +92,16 @@ func FromWishListOutput(wl *service.WishListOutput) *WishListResponse {
 		OccasionDate: wl.OccasionDate,
 		IsPublic:     wl.IsPublic,
 		PublicSlug:   wl.PublicSlug,
+		VanitySlug:   wl.VanitySlug,
 		ViewCount:    fmt.Sprintf("%d", wl.ViewCount),
 		ItemCount:    int(wl.ItemCount),
 		CreatedAt:    wl.CreatedAt,
 		UpdatedAt:    wl.UpdatedAt,
+		Version:      wl.Version,
+		Recurrence:   wl.Recurrence,
+		Discoverable: wl.Discoverable,
+		RolledOverTo: wl.RolledOverTo,
+		MergedInto:   wl.MergedInto,
 	}
 }
 
@@ -55,24 +118,49 @@ func FromWishListOutputs(wishlists []*service.WishListOutput) []*WishListRespons
 
 // GiftItemResponse is the handler-level DTO for gift item data
 type GiftItemResponse struct {
-	ID                string  `json:"id" validate:"required"`
-	WishlistID        string  `json:"wishlist_id" validate:"required"`
-	Name              string  `json:"name" validate:"required"`
-	Description       string  `json:"description"`
-	Link              string  `json:"link"`
-	ImageURL          string  `json:"image_url"`
-	Price             float64 `json:"price"`
-	Priority          int     `json:"priority"`
-	ReservedByUserID  string  `json:"reserved_by_user_id"`
-	ReservedAt        string  `json:"reserved_at"`
-	IsReserved        bool    `json:"is_reserved"`
-	PurchasedByUserID string  `json:"purchased_by_user_id"`
-	PurchasedAt       string  `json:"purchased_at"`
-	PurchasedPrice    float64 `json:"purchased_price"`
-	Notes             string  `json:"notes"`
-	Position          int     `json:"position"`
-	CreatedAt         string  `json:"created_at" validate:"required"`
-	UpdatedAt         string  `json:"updated_at" validate:"required"`
+	ID                string          `json:"id" validate:"required"`
+	WishlistID        string          `json:"wishlist_id" validate:"required"`
+	Name              string          `json:"name" validate:"required"`
+	Description       string          `json:"description"`
+	Link              string          `json:"link"`
+	ImageURL          string          `json:"image_url"` // Primary image, same as images[0]
+	Images            []string        `json:"images"`
+	Price             float64         `json:"price"`
+	Priority          int             `json:"priority"` // Legacy 0-10 number
+	PriorityLevel     string          `json:"priority_level" example:"must_have" enums:"must_have,nice_to_have,dream"`
+	PriorityWeight    int             `json:"priority_weight" example:"3"`
+	ReservedByUserID  string          `json:"reserved_by_user_id"`
+	ReservedAt        string          `json:"reserved_at"`
+	IsReserved        bool            `json:"is_reserved"` // True only when every unit is reserved
+	Quantity          int32           `json:"quantity" example:"6"`
+	ReservedQuantity  int32           `json:"reserved_quantity" example:"2"`
+	ReservationStatus string          `json:"reservation_status" example:"partially_reserved" enums:"available,partially_reserved,fully_reserved"`
+	Options           variant.Options `json:"options"` // Acceptable sizes, colors and models
+	Category          string          `json:"category,omitempty" example:"Electronics"`
+	PurchasedByUserID string          `json:"purchased_by_user_id"`
+	PurchasedAt       string          `json:"purchased_at"`
+	PurchasedPrice    float64         `json:"purchased_price"`
+	Notes             string          `json:"notes"`
+	NotesVisibility   string          `json:"notes_visibility,omitempty" enums:"private,public"` // Left out on public endpoints
+	Position          int             `json:"position"`
+	CreatedAt         string          `json:"created_at" validate:"required"`
+	UpdatedAt         string          `json:"updated_at" validate:"required"`
+
+	AvailabilityStatus    string `json:"availability_status" example:"available" enums:"unknown,available,out_of_stock,not_found"`
+	AvailabilityCheckedAt string `json:"availability_checked_at,omitempty"`
+
+	fields fieldset.Set // Sparse fieldset; the zero set encodes every member
+}
+
+// SelectFields limits the JSON encoding to a sparse fieldset
+func (r *GiftItemResponse) SelectFields(fields fieldset.Set) {
+	r.fields = fields
+}
+
+// MarshalJSON encodes the members selected with SelectFields
+func (r GiftItemResponse) MarshalJSON() ([]byte, error) {
+	type plain GiftItemResponse
+	return r.fields.Marshal(plain(r))
 }
 
 func FromGiftItemOutput(item *service.GiftItemOutput) *GiftItemResponse {
@@ -86,18 +174,30 @@ func FromGiftItemOutput(item *service.GiftItemOutput) *GiftItemResponse {
 		Description:       item.Description,
 		Link:              item.Link,
 		ImageURL:          item.ImageURL,
+		Images:            mapper.ImageURLs(item.Images),
 		Price:             item.Price,
 		Priority:          item.Priority,
+		PriorityLevel:     item.PriorityLevel,
+		PriorityWeight:    item.PriorityWeight,
 		ReservedByUserID:  item.ReservedByUserID,
 		ReservedAt:        item.ReservedAt,
 		IsReserved:        item.IsReserved,
+		Quantity:          item.Quantity,
+		ReservedQuantity:  item.ReservedQuantity,
+		ReservationStatus: item.ReservationStatus,
+		Options:           item.Options,
+		Category:          item.Category,
 		PurchasedByUserID: item.PurchasedByUserID,
 		PurchasedAt:       item.PurchasedAt,
 		PurchasedPrice:    item.PurchasedPrice,
 		Notes:             item.Notes,
+		NotesVisibility:   item.NotesVisibility,
 		Position:          item.Position,
 		CreatedAt:         item.CreatedAt,
 		UpdatedAt:         item.UpdatedAt,
+
+		AvailabilityStatus:    item.AvailabilityStatus,
+		AvailabilityCheckedAt: item.AvailabilityCheckedAt,
 	}
 }
 
@@ -119,3 +219,273 @@ type GetGiftItemsResponse struct {
 	Limit int                 `json:"limit" validate:"required"`
 	Pages int                 `json:"pages" validate:"required"`
 }
+
+// SharePageResponse is everything the public share page renders on first load
+type SharePageResponse struct {
+	WishList   *WishListResponse   `json:"wishlist" validate:"required"`
+	OwnerName  string              `json:"owner_name,omitempty" example:"Alice"` // Owner's first name, when set
+	Items      []*GiftItemResponse `json:"items" validate:"required"`            // First page of items, in position order
+	Total      int                 `json:"total" validate:"required"`            // Items on the list; fetch the rest through gift-items
+	Limit      int                 `json:"limit" validate:"required" example:"100"`
+	ComputedAt string              `json:"computed_at" validate:"required"`
+}
+
+// FromSharePageOutput converts the share page output to the response
+func FromSharePageOutput(page *service.SharePageOutput) SharePageResponse {
+	return SharePageResponse{
+		WishList:   FromWishListOutput(page.WishList),
+		OwnerName:  page.OwnerName,
+		Items:      FromGiftItemOutputs(page.Items),
+		Total:      page.TotalItems,
+		Limit:      service.SharePageItems,
+		ComputedAt: page.ComputedAt.Format(time.RFC3339),
+	}
+}
+
+// GiftItemGroupResponse is one section of a public wish list's items
+type GiftItemGroupResponse struct {
+	Key       string              `json:"key" example:"must_have"` // Priority level or category; empty for uncategorized items
+	Count     int                 `json:"count" validate:"required" example:"4"`
+	Reserved  int                 `json:"reserved" validate:"required" example:"1"`  // Items with nothing left to reserve
+	Available int                 `json:"available" validate:"required" example:"3"` // Items with units still open
+	Items     []*GiftItemResponse `json:"items" validate:"required"`
+}
+
+// GetGiftItemGroupsResponse is a public wish list's items grouped for the share page
+type GetGiftItemGroupsResponse struct {
+	GroupBy string                   `json:"group_by" validate:"required" enums:"priority,category" example:"priority"`
+	Groups  []*GiftItemGroupResponse `json:"groups" validate:"required"`
+}
+
+// FromGiftItemGroupOutputs converts grouped service output to the response
+func FromGiftItemGroupOutputs(groupBy string, groups []*service.GiftItemGroupOutput) GetGiftItemGroupsResponse {
+	response := GetGiftItemGroupsResponse{
+		GroupBy: groupBy,
+		Groups:  make([]*GiftItemGroupResponse, len(groups)),
+	}
+	for i, group := range groups {
+		response.Groups[i] = &GiftItemGroupResponse{
+			Key:       group.Key,
+			Count:     group.Count,
+			Reserved:  group.Reserved,
+			Available: group.Available,
+			Items:     FromGiftItemOutputs(group.Items),
+		}
+	}
+	return response
+}
+
+// EditSessionResponse is another session editing the same wish list
+type EditSessionResponse struct {
+	SessionID  string `json:"session_id" validate:"required"`
+	LastSeenAt string `json:"last_seen_at" validate:"required"`
+}
+
+// EditPresenceResponse tells an editor who else is editing and whether it is stale
+type EditPresenceResponse struct {
+	CurrentVersion      int32                 `json:"current_version" validate:"required"`
+	UpdatedAt           string                `json:"updated_at" validate:"required"`
+	ModifiedSinceLoaded bool                  `json:"modified_since_loaded"` // Saved elsewhere since the editor loaded it; reload before saving
+	OtherSessions       []EditSessionResponse `json:"other_sessions" validate:"required"`
+}
+
+func FromEditPresenceOutput(p *service.EditPresenceOutput) EditPresenceResponse {
+	others := make([]EditSessionResponse, 0, len(p.OtherSessions))
+	for _, session := range p.OtherSessions {
+		others = append(others, EditSessionResponse{
+			SessionID:  session.ID,
+			LastSeenAt: session.LastSeen.UTC().Format(time.RFC3339),
+		})
+	}
+	return EditPresenceResponse{
+		CurrentVersion:      p.CurrentVersion,
+		UpdatedAt:           p.UpdatedAt.Time.Format(time.RFC3339),
+		ModifiedSinceLoaded: p.ModifiedSinceLoaded,
+		OtherSessions:       others,
+	}
+}
+
+// ViewResponse is the approximate view count of a public wish list
+type ViewResponse struct {
+	Views int64 `json:"views" validate:"required" example:"128"` // Includes views from the last minute that are not stored yet
+}
+
+func FromViewOutput(v *service.ViewOutput) ViewResponse {
+	return ViewResponse{Views: v.Views}
+}
+
+// SnapshotItemResponse is an item as it was when the snapshot was taken
+type SnapshotItemResponse struct {
+	ID                string  `json:"id" validate:"required"`
+	Name              string  `json:"name" validate:"required"`
+	Description       string  `json:"description,omitempty"`
+	Link              string  `json:"link,omitempty"`
+	ImageURL          string  `json:"image_url,omitempty"`
+	Price             float64 `json:"price,omitempty"`
+	PriorityLevel     string  `json:"priority_level" example:"must_have" enums:"must_have,nice_to_have,dream"`
+	Category          string  `json:"category,omitempty"`
+	Notes             string  `json:"notes,omitempty"`
+	Quantity          int32   `json:"quantity" validate:"required"`
+	ReservedQuantity  int32   `json:"reserved_quantity"`
+	ReservationStatus string  `json:"reservation_status" example:"partially_reserved" enums:"available,partially_reserved,fully_reserved"`
+	PurchasedAt       string  `json:"purchased_at,omitempty"`
+	PurchasedPrice    float64 `json:"purchased_price,omitempty"`
+}
+
+// SnapshotResponse is a wish list as it was on date
+type SnapshotResponse struct {
+	WishListID   string                 `json:"wishlist_id" validate:"required"`
+	Date         string                 `json:"date" validate:"required" example:"2026-12-24"`
+	Title        string                 `json:"title" validate:"required"`
+	Occasion     string                 `json:"occasion,omitempty"`
+	OccasionDate string                 `json:"occasion_date,omitempty" example:"2026-12-24"`
+	Items        []SnapshotItemResponse `json:"items" validate:"required"`
+	CreatedAt    string                 `json:"created_at" validate:"required"`
+}
+
+func FromSnapshotOutput(s *service.SnapshotOutput) SnapshotResponse {
+	items := make([]SnapshotItemResponse, 0, len(s.Items))
+	for _, item := range s.Items {
+		items = append(items, SnapshotItemResponse{
+			ID:                item.ID,
+			Name:              item.Name,
+			Description:       item.Description,
+			Link:              item.Link,
+			ImageURL:          item.ImageURL,
+			Price:             item.Price,
+			PriorityLevel:     item.PriorityLevel,
+			Category:          item.Category,
+			Notes:             item.Notes,
+			Quantity:          item.Quantity,
+			ReservedQuantity:  item.ReservedQuantity,
+			ReservationStatus: item.ReservationStatus,
+			PurchasedAt:       item.PurchasedAt,
+			PurchasedPrice:    item.PurchasedPrice,
+		})
+	}
+	return SnapshotResponse{
+		WishListID:   s.WishListID,
+		Date:         s.Date,
+		Title:        s.Title,
+		Occasion:     s.Occasion,
+		OccasionDate: s.OccasionDate,
+		Items:        items,
+		CreatedAt:    s.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// SnapshotSummaryResponse describes a snapshot without its items
+type SnapshotSummaryResponse struct {
+	Date      string `json:"date" validate:"required" example:"2026-12-24"`
+	Title     string `json:"title" validate:"required"`
+	ItemCount int    `json:"item_count"`
+	CreatedAt string `json:"created_at" validate:"required"`
+}
+
+// ListSnapshotsResponse lists a wish list's snapshots, newest first
+type ListSnapshotsResponse struct {
+	Snapshots []SnapshotSummaryResponse `json:"snapshots" validate:"required"`
+}
+
+func FromSnapshotSummaryOutputs(snapshots []*service.SnapshotSummaryOutput) ListSnapshotsResponse {
+	summaries := make([]SnapshotSummaryResponse, 0, len(snapshots))
+	for _, s := range snapshots {
+		summaries = append(summaries, SnapshotSummaryResponse{
+			Date:      s.Date,
+			Title:     s.Title,
+			ItemCount: s.ItemCount,
+			CreatedAt: s.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return ListSnapshotsResponse{Snapshots: summaries}
+}
+
+// ImportSourceResponse attributes an imported wish list to the list it was copied from
+type ImportSourceResponse struct {
+	WishListID string `json:"wishlist_id,omitempty"`                             // Omitted once the source was deleted
+	Title      string `json:"title" validate:"required" example:"Baby registry"` // Title of the source when it was copied
+	OwnerName  string `json:"owner_name,omitempty" example:"Alice"`              // First name of the source's owner
+	PublicSlug string `json:"public_slug,omitempty" example:"baby-registry"`     // Set while the source is still public
+	ImportedAt string `json:"imported_at" validate:"required" example:"2026-10-15T09:30:00Z"`
+}
+
+func FromImportSourceOutput(s *service.ImportSourceOutput) ImportSourceResponse {
+	return ImportSourceResponse{
+		WishListID: s.WishListID,
+		Title:      s.Title,
+		OwnerName:  s.OwnerName,
+		PublicSlug: s.PublicSlug,
+		ImportedAt: s.ImportedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// ImportResponse is the private wish list created by copying a public one
+type ImportResponse struct {
+	WishList      *WishListResponse    `json:"wishlist"`
+	ItemsImported int32                `json:"items_imported" example:"12"`
+	Source        ImportSourceResponse `json:"source"`
+}
+
+func FromImportOutput(out *service.ImportOutput) ImportResponse {
+	return ImportResponse{
+		WishList:      FromWishListOutput(out.WishList),
+		ItemsImported: out.ItemsImported,
+		Source:        FromImportSourceOutput(out.Source),
+	}
+}
+
+// InviteResponse is a guest invited to a wish list by email
+type InviteResponse struct {
+	ID         string `json:"id" validate:"required"`
+	Email      string `json:"email" validate:"required" example:"jamie@example.com"`
+	Status     string `json:"status" validate:"required" enums:"pending,sent,failed" example:"sent"`
+	SentAt     string `json:"sent_at,omitempty" example:"2026-10-15T09:30:00Z"`
+	VisitedAt  string `json:"visited_at,omitempty" example:"2026-10-15T11:02:00Z"` // First visit through the invite link
+	VisitCount int32  `json:"visit_count" example:"2"`
+}
+
+func FromInviteOutput(invite *service.InviteOutput) InviteResponse {
+	resp := InviteResponse{
+		ID:         invite.ID,
+		Email:      invite.Email,
+		Status:     invite.Status,
+		VisitCount: invite.VisitCount,
+	}
+	if !invite.SentAt.IsZero() {
+		resp.SentAt = invite.SentAt.UTC().Format(time.RFC3339)
+	}
+	if !invite.VisitedAt.IsZero() {
+		resp.VisitedAt = invite.VisitedAt.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
+
+// InviteGuestsResponse reports an invite request
+type InviteGuestsResponse struct {
+	Invites []InviteResponse `json:"invites" validate:"required"` // Guests emailed now
+	Skipped []string         `json:"skipped" validate:"required"` // Addresses already emailed within the last 24 hours
+}
+
+func FromInviteResultOutput(result *service.InviteResultOutput) InviteGuestsResponse {
+	resp := InviteGuestsResponse{
+		Invites: make([]InviteResponse, 0, len(result.Invites)),
+		Skipped: append([]string{}, result.Skipped...),
+	}
+	for _, invite := range result.Invites {
+		resp.Invites = append(resp.Invites, FromInviteOutput(invite))
+	}
+	return resp
+}
+
+// ListInvitesResponse lists the guests invited to a wish list, most recently invited first
+type ListInvitesResponse struct {
+	Invites []InviteResponse `json:"invites" validate:"required"`
+}
+
+func FromInviteOutputs(invites []*service.InviteOutput) ListInvitesResponse {
+	resp := ListInvitesResponse{Invites: make([]InviteResponse, 0, len(invites))}
+	for _, invite := range invites {
+		resp.Invites = append(resp.Invites, FromInviteOutput(invite))
+	}
+	return resp
+}
@@ -2,14 +2,31 @@ package http
 
 import (
 	"errors"
+	"fmt"
 
+	moderationservice "wish-list/internal/domain/moderation/service"
+	quotaservice "wish-list/internal/domain/quota/service"
 	"wish-list/internal/domain/wishlist/service"
 	"wish-list/internal/pkg/apperrors"
 )
 
+// slugField names the slug in validation details, matching the request validator
+const slugField = "PublicSlug"
+
 // mapWishlistServiceError converts wishlist service errors to AppErrors
 func mapWishlistServiceError(err error) error {
+	var quotaErr *quotaservice.QuotaExceededError
+	var rejectedErr *moderationservice.ContentRejectedError
+
 	switch {
+	case errors.As(err, &quotaErr) && quotaErr.Upgradable():
+		return apperrors.PaymentRequired(quotaErr.Error())
+	case errors.As(err, &quotaErr):
+		return apperrors.UnprocessableEntity(quotaErr.Error())
+	case errors.As(err, &rejectedErr):
+		return apperrors.UnprocessableEntity(rejectedErr.Error())
+	case errors.Is(err, moderationservice.ErrWishlistTakenDown):
+		return apperrors.Forbidden("This wish list was taken down by a moderator and cannot be made public")
 	case errors.Is(err, service.ErrWishListNotFound):
 		return apperrors.NotFound("Wish list not found")
 	case errors.Is(err, service.ErrWishListForbidden):
@@ -17,9 +34,58 @@ func mapWishlistServiceError(err error) error {
 	case errors.Is(err, service.ErrWishListTitleRequired):
 		return apperrors.BadRequest("Title is required")
 	case errors.Is(err, service.ErrSlugTaken):
-		return apperrors.Conflict("This URL slug is already taken. Please choose a different one.")
+		return apperrors.Conflict("This URL slug is already taken. Please choose a different one.").
+			WithDetails(map[string]string{slugField: "is already taken"})
+	case errors.Is(err, service.ErrSlugReserved):
+		return apperrors.NewValidationError(map[string]string{slugField: "is reserved"})
+	case errors.Is(err, service.ErrWishListVersionConflict):
+		return apperrors.Conflict("Wish list was modified by another request")
 	case errors.Is(err, service.ErrSlugInvalid):
-		return apperrors.BadRequest("Slug must contain only lowercase letters, digits, and hyphens (e.g. my-birthday-2026)")
+		return apperrors.NewValidationError(map[string]string{
+			slugField: "must contain only lowercase letters, digits, and hyphens (e.g. my-birthday-2026)",
+		})
+	case errors.Is(err, service.ErrInvalidRecurrence):
+		return apperrors.BadRequest("Recurrence must be yearly or empty")
+	case errors.Is(err, service.ErrRecurrenceRequiresDate):
+		return apperrors.BadRequest("A recurring wish list needs an occasion date")
+	case errors.Is(err, service.ErrInvalidWishListSort):
+		return apperrors.BadRequest("Sort must be occasion_date, created_at or title")
+	case errors.Is(err, service.ErrInvalidWishListFilter):
+		return apperrors.BadRequest("Filter must be upcoming, past or no_date")
+	case errors.Is(err, service.ErrInvalidWishListID):
+		return apperrors.BadRequest("Invalid wish list ID")
+	case errors.Is(err, service.ErrInvalidMergeMode):
+		return apperrors.BadRequest("Mode must be move or copy")
+	case errors.Is(err, service.ErrMergeIntoItself):
+		return apperrors.BadRequest("A wish list cannot be merged into itself")
+	case errors.Is(err, service.ErrWishListMerged):
+		return apperrors.Conflict("Wish list was already merged into another and is archived")
+	case errors.Is(err, service.ErrWishListRolledOver):
+		return apperrors.Conflict("Wish list was already rolled over")
+	case errors.Is(err, service.ErrInvalidOccasionDate):
+		return apperrors.BadRequest("Occasion date must be an RFC 3339 timestamp")
+	case errors.Is(err, service.ErrInvalidEditSession), errors.Is(err, service.ErrInvalidViewSession):
+		return apperrors.BadRequest("session_id is required and must be at most 64 characters")
+	case errors.Is(err, service.ErrInvalidSnapshotDate):
+		return apperrors.BadRequest("Snapshot date must be written as YYYY-MM-DD")
+	case errors.Is(err, service.ErrSnapshotNotFound):
+		return apperrors.NotFound("No snapshot of this wish list on that date")
+	case errors.Is(err, service.ErrImportOwnWishList):
+		return apperrors.BadRequest("You cannot import your own wish list")
+	case errors.Is(err, service.ErrImportLimitReached):
+		return apperrors.TooManyRequests(fmt.Sprintf("You can import at most %d wish lists a day", service.MaxImportsPerDay))
+	case errors.Is(err, service.ErrImportNotFound):
+		return apperrors.NotFound("This wish list was not imported from another list")
+	case errors.Is(err, service.ErrInviteWishListNotPublic):
+		return apperrors.Conflict("Make the wish list public before inviting guests")
+	case errors.Is(err, service.ErrInvalidInviteEmails):
+		return apperrors.BadRequest(fmt.Sprintf("Emails must be 1 to %d valid addresses", service.MaxInvitesPerRequest))
+	case errors.Is(err, service.ErrInvalidInviteGroups):
+		return apperrors.BadRequest("Groups must be at most 10 relationship names")
+	case errors.Is(err, service.ErrInviteLimitReached):
+		return apperrors.TooManyRequests(fmt.Sprintf("You can send at most %d invites a day", service.MaxInvitesPerDay))
+	case errors.Is(err, service.ErrInviteNotFound):
+		return apperrors.NotFound("Invite not found")
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
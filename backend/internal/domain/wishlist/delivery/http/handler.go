@@ -1,40 +1,57 @@
 package http
 
 import (
+	"errors"
 	nethttp "net/http"
+	"net/url"
 
 	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/repository"
 	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/affiliate"
 	"wish-list/internal/pkg/apperrors"
 	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/fieldset"
 	"wish-list/internal/pkg/helpers"
+	"wish-list/internal/pkg/requestid"
 
 	"github.com/labstack/echo/v4"
 )
 
+// Resource names of the fields[...] sparse fieldset parameter
+const (
+	wishListsResource = "wishlists"
+	itemsResource     = "items"
+)
+
 // Handler handles HTTP requests for wishlists
 type Handler struct {
 	service service.WishListServiceInterface
+	links   *affiliate.Decorator // Applied to item links on public pages; nil leaves them unchanged
 }
 
 // NewHandler creates a new Handler
-func NewHandler(svc service.WishListServiceInterface) *Handler {
+func NewHandler(svc service.WishListServiceInterface, links *affiliate.Decorator) *Handler {
 	return &Handler{
 		service: svc,
+		links:   links,
 	}
 }
 
 // CreateWishList godoc
 //
 //	@Summary		Create a new wish list
-//	@Description	Create a new wish list for the authenticated user
+//	@Description	Create a new wish list for the authenticated user. A custom public_slug must use lowercase letters, digits and hyphens and may not be a reserved word.
 //	@Tags			Wish Lists
 //	@Accept			json
 //	@Produce		json
 //	@Param			wish_list	body		dto.CreateWishListRequest	true	"Wish list creation information"
 //	@Success		201			{object}	dto.WishListResponse		"Wish list created successfully"
-//	@Failure		400			{object}	map[string]string			"Invalid request body or validation error"
+//	@Failure		400			{object}	map[string]string			"Invalid request body or validation error, including invalid or reserved slugs"
 //	@Failure		401			{object}	map[string]string			"Unauthorized"
+//	@Failure		402			{object}	map[string]string			"Wish list limit of the free plan reached"
+//	@Failure		409			{object}	map[string]string			"Public slug already taken"
+//	@Failure		422			{object}	map[string]string			"Wish list limit of the premium plan reached or content rejected by moderation"
 //	@Failure		500			{object}	map[string]string			"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/wishlists [post]
@@ -61,14 +78,20 @@ func (h *Handler) CreateWishList(c echo.Context) error {
 //	@Description	Get a wish list by its ID. If the wish list is private, the user must be the owner.
 //	@Tags			Wish Lists
 //	@Produce		json
-//	@Param			id	path		string					true	"Wish List ID"
-//	@Success		200	{object}	dto.WishListResponse	"Wish list retrieved successfully"
-//	@Failure		403	{object}	map[string]string		"Access denied"
-//	@Failure		404	{object}	map[string]string		"Wish list not found"
+//	@Param			id		path		string					true	"Wish List ID"
+//	@Param			fields	query		string					false	"Comma-separated members to return; id is always included"
+//	@Success		200		{object}	dto.WishListResponse	"Wish list retrieved successfully"
+//	@Failure		400		{object}	map[string]string		"Unknown field requested"
+//	@Failure		403		{object}	map[string]string		"Access denied"
+//	@Failure		404		{object}	map[string]string		"Wish list not found"
 //	@Security		BearerAuth
 //	@Router			/wishlists/{id} [get]
 func (h *Handler) GetWishList(c echo.Context) error {
 	wishListID := c.Param("id")
+	fields, err := helpers.ParseFields(c, wishListsResource, dto.WishListResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
 	wishList, err := h.service.GetWishList(ctx, wishListID)
@@ -85,47 +108,75 @@ func (h *Handler) GetWishList(c echo.Context) error {
 		return apperrors.Forbidden("Access denied")
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.FromWishListOutput(wishList))
+	response := dto.FromWishListOutput(wishList)
+	response.SelectFields(fields)
+
+	helpers.SetVersionETag(c, wishList.Version)
+	return c.JSON(nethttp.StatusOK, response)
 }
 
 // GetWishListsByOwner godoc
 //
 //	@Summary		Get all wish lists owned by the authenticated user
-//	@Description	Get all wish lists owned by the currently authenticated user. Includes item_count for each wishlist.
+//	@Description	Get all wish lists owned by the currently authenticated user. Includes item_count for each wishlist. Sorting by occasion_date lists the next occasion first, then past occasions, then wish lists without a date. Also served at /ext/wishlists for integrations holding a personal API token with the wishlists:read scope.
 //	@Tags			Wish Lists
 //	@Produce		json
-//	@Success		200	{array}		dto.WishListResponse	"List of wish lists retrieved successfully (includes item_count)"
-//	@Failure		401	{object}	map[string]string		"Unauthorized"
-//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Param			sort	query		string					false	"Sort field (created_at, occasion_date, title; default created_at)"
+//	@Param			filter	query		string					false	"Occasion date filter (upcoming, past, no_date)"
+//	@Param			fields	query		string					false	"Comma-separated members to return; id is always included"
+//	@Success		200		{array}		dto.WishListResponse	"List of wish lists retrieved successfully (includes item_count)"
+//	@Failure		400		{object}	map[string]string		"Invalid sort, filter or fields"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		403		{object}	map[string]string		"API token lacks the wishlists:read scope"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
 //	@Security		BearerAuth
+//	@Security		APITokenAuth
 //	@Router			/wishlists [get]
+//	@Router			/ext/wishlists [get]
 func (h *Handler) GetWishListsByOwner(c echo.Context) error {
 	userID := auth.MustGetUserID(c)
+	filters := repository.WishListFilters{
+		Sort:   c.QueryParam("sort"),
+		Filter: c.QueryParam("filter"),
+	}
+	fields, err := helpers.ParseFields(c, wishListsResource, dto.WishListResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
-	wishLists, err := h.service.GetWishListsByOwner(ctx, userID)
+	wishLists, err := h.service.GetWishListsByOwner(ctx, userID, filters)
 	if err != nil {
 		return mapWishlistServiceError(err)
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.FromWishListOutputs(wishLists))
+	responses := dto.FromWishListOutputs(wishLists)
+	for _, response := range responses {
+		response.SelectFields(fields)
+	}
+
+	return c.JSON(nethttp.StatusOK, responses)
 }
 
 // UpdateWishList godoc
 //
 //	@Summary		Update a wish list
-//	@Description	Update a wish list by its ID. The user must be the owner of the wish list.
+//	@Description	Update a wish list by its ID. The user must be the owner of the wish list. Send the version from the ETag in If-Match (or the version field) to reject the update when the wish list changed since it was read.
 //	@Tags			Wish Lists
 //	@Accept			json
 //	@Produce		json
-//	@Param			id			path		string						true	"Wish List ID"
-//	@Param			wish_list	body		dto.UpdateWishListRequest	true	"Wish list update information"
-//	@Success		200			{object}	dto.WishListResponse		"Wish list updated successfully"
-//	@Failure		400			{object}	map[string]string			"Invalid request body or validation error"
-//	@Failure		401			{object}	map[string]string			"Unauthorized"
-//	@Failure		403			{object}	map[string]string			"Forbidden"
-//	@Failure		404			{object}	map[string]string			"Wish list not found"
-//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Param			id			path		string								true	"Wish List ID"
+//	@Param			If-Match	header		string								false	"Expected wish list version (ETag)"
+//	@Param			wish_list	body		dto.UpdateWishListRequest			true	"Wish list update information"
+//	@Success		200			{object}	dto.WishListResponse				"Wish list updated successfully"
+//	@Failure		400			{object}	map[string]string					"Invalid request body or validation error, including invalid or reserved slugs"
+//	@Failure		401			{object}	map[string]string					"Unauthorized"
+//	@Failure		403			{object}	map[string]string					"Forbidden, or the wish list was taken down and cannot be made public"
+//	@Failure		404			{object}	map[string]string					"Wish list not found"
+//	@Failure		409			{object}	dto.WishListVersionConflictResponse	"Wish list was modified by another request, or the public slug is taken"
+//	@Failure		422			{object}	map[string]string					"Content rejected by moderation"
+//	@Failure		428			{object}	map[string]string					"Neither If-Match nor version sent while REQUIRE_IF_MATCH is on"
+//	@Failure		500			{object}	map[string]string					"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/wishlists/{id} [put]
 func (h *Handler) UpdateWishList(c echo.Context) error {
@@ -138,12 +189,29 @@ func (h *Handler) UpdateWishList(c echo.Context) error {
 		return err
 	}
 
+	version, err := helpers.ExpectedVersion(c, req.Version)
+	if err != nil {
+		return err
+	}
+	input := req.ToServiceInput()
+	input.Version = version
+
 	ctx := c.Request().Context()
-	wishList, err := h.service.UpdateWishList(ctx, wishListID, userID, req.ToServiceInput())
+	wishList, err := h.service.UpdateWishList(ctx, wishListID, userID, input)
 	if err != nil {
+		var conflict *service.WishListVersionConflictError
+		if errors.As(err, &conflict) {
+			helpers.SetVersionETag(c, conflict.Current.Version)
+			return c.JSON(nethttp.StatusConflict, dto.WishListVersionConflictResponse{
+				Error:     "Wish list was modified by another request",
+				Current:   *dto.FromWishListOutput(conflict.Current),
+				RequestID: requestid.FromContext(ctx),
+			})
+		}
 		return mapWishlistServiceError(err)
 	}
 
+	helpers.SetVersionETag(c, wishList.Version)
 	return c.JSON(nethttp.StatusOK, dto.FromWishListOutput(wishList))
 }
 
@@ -174,53 +242,205 @@ func (h *Handler) DeleteWishList(c echo.Context) error {
 	return c.NoContent(nethttp.StatusNoContent)
 }
 
+// MergeWishLists godoc
+//
+//	@Summary		Merge another wish list into this one
+//	@Description	Moves or copies every item of the source wish list into this one in a single transaction. Moved items take their reservations and comments along; items already on this list are skipped. With archive_source the source is marked as merged and unpublished. Both lists must belong to the user.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Target Wish List ID"
+//	@Param			request	body		dto.MergeWishListsRequest	true	"Source wish list and merge options"
+//	@Success		200		{object}	dto.MergeWishListsResponse	"Wish lists merged"
+//	@Failure		400		{object}	map[string]string			"Invalid request body, or source and target are the same"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Forbidden"
+//	@Failure		404		{object}	map[string]string			"Wish list not found"
+//	@Failure		409		{object}	map[string]string			"A wish list was already merged into another"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/merge [post]
+func (h *Handler) MergeWishLists(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	wishListID := c.Param("id")
+
+	var req dto.MergeWishListsRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	result, err := h.service.MergeWishLists(ctx, wishListID, userID, req.ToServiceInput())
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.MergeWishListsResponse{
+		WishList:    dto.FromWishListOutput(result.WishList),
+		ItemsMerged: result.ItemsMerged,
+	})
+}
+
+// RollOverUnpurchased godoc
+//
+//	@Summary		Start a new wish list from the gifts nobody gave
+//	@Description	Creates a private wish list with the items of this one that were neither reserved nor purchased, and marks this one as rolled over. Leftovers of expired or canceled reservations on those items are cleared. The body is optional: the title keeps the current one with its year moved along, and a dated occasion moves to its next yearly anniversary.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Wish List ID"
+//	@Param			request	body		dto.RollOverUnpurchasedRequest	false	"Title and date of the new wish list"
+//	@Success		201		{object}	dto.RollOverResponse			"New wish list created"
+//	@Failure		400		{object}	map[string]string				"Invalid request body"
+//	@Failure		401		{object}	map[string]string				"Unauthorized"
+//	@Failure		402		{object}	map[string]string				"Wish list limit of the free plan reached"
+//	@Failure		403		{object}	map[string]string				"Forbidden"
+//	@Failure		404		{object}	map[string]string				"Wish list not found"
+//	@Failure		409		{object}	map[string]string				"Wish list was already rolled over or merged"
+//	@Failure		422		{object}	map[string]string				"Wish list limit of the premium plan reached or content rejected by moderation"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/rollover-unpurchased [post]
+func (h *Handler) RollOverUnpurchased(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	wishListID := c.Param("id")
+
+	var req dto.RollOverUnpurchasedRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	result, err := h.service.RollOverUnpurchased(ctx, wishListID, userID, req.ToServiceInput())
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.RollOverResponse{
+		WishList:     dto.FromWishListOutput(result.WishList),
+		ItemsCarried: result.ItemsCarried,
+	})
+}
+
 // GetWishListByPublicSlug godoc
 //
 //	@Summary		Get a public wish list by its slug
-//	@Description	Get a public wish list by its public slug. The wish list must be marked as public.
+//	@Description	Get a public wish list by its public slug. The wish list must be marked as public. A slug the owner has since changed redirects to the current slug. Some time after the occasion of a list that does not recur, its link is closed and answers 410.
 //	@Tags			Wish Lists
 //	@Produce		json
-//	@Param			slug	path		string					true	"Public Slug"
-//	@Success		200		{object}	dto.WishListResponse	"Public wish list retrieved successfully"
-//	@Failure		404		{object}	map[string]string		"Wish list not found"
+//	@Param			slug	path		string							true	"Public Slug"
+//	@Param			fields	query		string							false	"Comma-separated members to return; id is always included"
+//	@Success		200		{object}	dto.WishListResponse			"Public wish list retrieved successfully"
+//	@Success		301		{object}	dto.WishListSlugMovedResponse	"Slug was changed; Location holds the current URL"
+//	@Failure		400		{object}	map[string]string				"Unknown field requested"
+//	@Failure		404		{object}	map[string]string				"Wish list not found"
+//	@Failure		410		{object}	dto.WishListClosedResponse		"Wish list was closed after its occasion"
 //	@Router			/public/wishlists/{slug} [get]
 func (h *Handler) GetWishListByPublicSlug(c echo.Context) error {
 	publicSlug := c.Param("slug")
+	fields, err := helpers.ParseFields(c, wishListsResource, dto.WishListResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
 	wishList, err := h.service.GetWishListByPublicSlug(ctx, publicSlug)
 	if err != nil {
+		var moved *service.WishListSlugMovedError
+		if errors.As(err, &moved) {
+			return redirectToCurrentSlug(c, moved.CurrentSlug, "")
+		}
+		if errors.Is(err, service.ErrWishListClosed) {
+			return wishListClosed(c)
+		}
 		return mapWishlistServiceError(err)
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.FromWishListOutput(wishList))
+	response := dto.FromWishListOutput(wishList)
+	response.SelectFields(fields)
+
+	return c.JSON(nethttp.StatusOK, response)
+}
+
+// GetWishListByVanitySlug godoc
+//
+//	@Summary		Get a public wish list by its vanity link
+//	@Description	Resolve a premium owner's vanity link /u/{username}/{slug}. The slug is scoped to the owner and has no random suffix; both parts match case-insensitively. The response carries public_slug, under which the items and share page are fetched.
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			username	path		string					true	"Owner's username"
+//	@Param			slug		path		string					true	"Vanity slug"
+//	@Param			fields		query		string					false	"Comma-separated members to return; id is always included"
+//	@Success		200			{object}	dto.WishListResponse	"Public wish list retrieved successfully"
+//	@Failure		400			{object}	map[string]string		"Unknown field requested"
+//	@Failure		404			{object}	map[string]string		"Wish list not found"
+//	@Router			/public/u/{username}/{slug} [get]
+func (h *Handler) GetWishListByVanitySlug(c echo.Context) error {
+	fields, err := helpers.ParseFields(c, wishListsResource, dto.WishListResponse{})
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	wishList, err := h.service.GetWishListByVanitySlug(ctx, c.Param("username"), c.Param("slug"))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	response := dto.FromWishListOutput(wishList)
+	response.SelectFields(fields)
+
+	return c.JSON(nethttp.StatusOK, response)
 }
 
 // GetGiftItemsByPublicSlug godoc
 //
 //	@Summary		Get gift items for a public wish list by slug
-//	@Description	Get all gift items for a public wish list by its public slug with pagination support.
+//	@Description	Get all gift items for a public wish list by its public slug with pagination support. Item links carry the merchant's affiliate tag when one is configured. With group_by set, pagination is ignored and the response is a dto.GetGiftItemGroupsResponse: every item grouped by priority level (highest first) or category (uncategorized last), with per-group counts of reserved and available items.
 //	@Tags			Gift Items
 //	@Produce		json
-//	@Param			slug	path		string						true	"Public Slug"
-//	@Param			page	query		int							false	"Page number (default 1)"
-//	@Param			limit	query		int							false	"Items per page (default 10, max 100)"
-//	@Success		200		{object}	dto.GetGiftItemsResponse	"Gift items retrieved successfully"
-//	@Failure		404		{object}	map[string]string			"Wish list not found or not public"
-//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Param			slug		path		string						true	"Public Slug"
+//	@Param			page		query		int							false	"Page number (default 1)"
+//	@Param			limit		query		int							false	"Items per page (default 10, max 100)"
+//	@Param			group_by	query		string						false	"Group items by priority or category"	Enums(priority, category)
+//	@Param			fields		query		string						false	"Comma-separated item members to return; id is always included"
+//	@Success		200			{object}	dto.GetGiftItemsResponse		"Gift items retrieved successfully"
+//	@Success		301			{object}	dto.WishListSlugMovedResponse	"Slug was changed; Location holds the current URL"
+//	@Failure		400			{object}	map[string]string				"Invalid group_by or fields"
+//	@Failure		404			{object}	map[string]string				"Wish list not found or not public"
+//	@Failure		410			{object}	dto.WishListClosedResponse		"Wish list was closed after its occasion"
+//	@Failure		500			{object}	map[string]string				"Internal server error"
 //	@Router			/public/wishlists/{slug}/gift-items [get]
 func (h *Handler) GetGiftItemsByPublicSlug(c echo.Context) error {
 	publicSlug := c.Param("slug")
 	pagination := helpers.ParsePagination(c)
+	groupBy := c.QueryParam("group_by")
+	fields, err := helpers.ParseFields(c, itemsResource, dto.GiftItemResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
 
 	// Verify the wishlist exists and is public
-	_, err := h.service.GetWishListByPublicSlug(ctx, publicSlug)
+	_, err = h.service.GetWishListByPublicSlug(ctx, publicSlug)
 	if err != nil {
+		var moved *service.WishListSlugMovedError
+		if errors.As(err, &moved) {
+			return redirectToCurrentSlug(c, moved.CurrentSlug, "/gift-items")
+		}
+		if errors.Is(err, service.ErrWishListClosed) {
+			return wishListClosed(c)
+		}
 		return apperrors.NotFound("Wish list not found or not public")
 	}
 
+	if groupBy != "" {
+		return h.getGiftItemGroups(c, publicSlug, groupBy, fields)
+	}
+
 	// Use database-level pagination for better performance
 	offset := (pagination.Page - 1) * pagination.Limit
 	giftItems, totalCount, err := h.service.GetGiftItemsByPublicSlugPaginated(ctx, publicSlug, pagination.Limit, offset)
@@ -232,14 +452,102 @@ func (h *Handler) GetGiftItemsByPublicSlug(c echo.Context) error {
 		giftItems = []*service.GiftItemOutput{}
 	}
 
+	items := dto.FromGiftItemOutputs(giftItems)
+	for _, item := range items {
+		item.Link = h.links.Decorate(item.Link)
+		item.SelectFields(fields)
+	}
+
 	// Calculate total pages
 	pages := (totalCount + pagination.Limit - 1) / pagination.Limit
 
 	return c.JSON(nethttp.StatusOK, dto.GetGiftItemsResponse{
-		Items: dto.FromGiftItemOutputs(giftItems),
+		Items: items,
 		Total: totalCount,
 		Page:  pagination.Page,
 		Limit: pagination.Limit,
 		Pages: pages,
 	})
 }
+
+// GetSharePage godoc
+//
+//	@Summary		Get a public wish list's share page in one call
+//	@Description	Everything the share page renders on first load, for server-side rendering: the wish list, its owner's first name and the first 100 items with their reservation status. Further items come from the gift items endpoint. The payload is cached for up to 30 seconds, so a reservation may take that long to show. Counts as a view of its items; a view of the wish list itself is registered with POST /public/wishlists/{slug}/view. A slug the owner has since changed redirects to the current slug.
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			slug	path		string							true	"Public Slug"
+//	@Success		200		{object}	dto.SharePageResponse			"Share page"
+//	@Success		301		{object}	dto.WishListSlugMovedResponse	"Slug was changed; Location holds the current URL"
+//	@Failure		404		{object}	map[string]string				"Wish list not found"
+//	@Failure		410		{object}	dto.WishListClosedResponse		"Wish list was closed after its occasion"
+//	@Failure		500		{object}	map[string]string				"Internal server error"
+//	@Router			/public/wishlists/{slug}/full [get]
+func (h *Handler) GetSharePage(c echo.Context) error {
+	ctx := c.Request().Context()
+	page, err := h.service.GetSharePage(ctx, c.Param("slug"))
+	if err != nil {
+		var moved *service.WishListSlugMovedError
+		if errors.As(err, &moved) {
+			return redirectToCurrentSlug(c, moved.CurrentSlug, "/full")
+		}
+		if errors.Is(err, service.ErrWishListClosed) {
+			return wishListClosed(c)
+		}
+		return mapWishlistServiceError(err)
+	}
+
+	response := dto.FromSharePageOutput(page)
+	for _, item := range response.Items {
+		item.Link = h.links.Decorate(item.Link)
+	}
+
+	return c.JSON(nethttp.StatusOK, response)
+}
+
+// getGiftItemGroups answers a public items request that asked for group_by
+func (h *Handler) getGiftItemGroups(c echo.Context, publicSlug, groupBy string, fields fieldset.Set) error {
+	groups, err := h.service.GetGiftItemGroupsByPublicSlug(c.Request().Context(), publicSlug, groupBy)
+	if errors.Is(err, service.ErrInvalidGiftItemGroup) {
+		return apperrors.BadRequest("group_by must be priority or category")
+	}
+	if err != nil {
+		return apperrors.Internal("Failed to get gift items").Wrap(err)
+	}
+
+	response := dto.FromGiftItemGroupOutputs(groupBy, groups)
+	for _, group := range response.Groups {
+		for _, item := range group.Items {
+			item.Link = h.links.Decorate(item.Link)
+			item.SelectFields(fields)
+		}
+	}
+
+	return c.JSON(nethttp.StatusOK, response)
+}
+
+// redirectToCurrentSlug answers a request for a retired slug with a permanent
+// redirect to the same public resource under the wishlist's current slug.
+// The JSON body lets clients that do not follow redirects update their links.
+func redirectToCurrentSlug(c echo.Context, currentSlug, suffix string) error {
+	location := "/api/public/wishlists/" + url.PathEscape(currentSlug) + suffix
+	if query := c.QueryString(); query != "" {
+		location += "?" + query
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, location)
+	return c.JSON(nethttp.StatusMovedPermanently, dto.WishListSlugMovedResponse{
+		Error:    "Wish list has moved to a new address",
+		Slug:     currentSlug,
+		Location: location,
+	})
+}
+
+// wishListClosed answers a request for a public link that was closed after the
+// wishlist's occasion, so the page can say so instead of showing a broken link
+func wishListClosed(c echo.Context) error {
+	return c.JSON(nethttp.StatusGone, dto.WishListClosedResponse{
+		Error:   "This wish list is closed",
+		Message: "The occasion has passed and this wish list is no longer shared.",
+	})
+}
@@ -7,10 +7,14 @@ import (
 	nethttp "net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"wish-list/internal/app/middleware"
+	"wish-list/internal/domain/item/mapper"
 	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/repository"
 	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/affiliate"
 	"wish-list/internal/pkg/apperrors"
 	"wish-list/internal/pkg/validation"
 
@@ -109,8 +113,24 @@ func (m *MockWishListService) GetWishListByPublicSlug(ctx context.Context, publi
 	return args.Get(0).(*service.WishListOutput), args.Error(1)
 }
 
-func (m *MockWishListService) GetWishListsByOwner(ctx context.Context, userID string) ([]*service.WishListOutput, error) {
-	args := m.Called(ctx, userID)
+func (m *MockWishListService) GetWishListByVanitySlug(ctx context.Context, username, slug string) (*service.WishListOutput, error) {
+	args := m.Called(ctx, username, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.WishListOutput), args.Error(1)
+}
+
+func (m *MockWishListService) GetSharePage(ctx context.Context, publicSlug string) (*service.SharePageOutput, error) {
+	args := m.Called(ctx, publicSlug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.SharePageOutput), args.Error(1)
+}
+
+func (m *MockWishListService) GetWishListsByOwner(ctx context.Context, userID string, filters repository.WishListFilters) ([]*service.WishListOutput, error) {
+	args := m.Called(ctx, userID, filters)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -130,6 +150,22 @@ func (m *MockWishListService) DeleteWishList(ctx context.Context, wishListID, us
 	return args.Error(0)
 }
 
+func (m *MockWishListService) MergeWishLists(ctx context.Context, targetID, userID string, input service.MergeWishListsInput) (*service.MergeWishListsOutput, error) {
+	args := m.Called(ctx, targetID, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.MergeWishListsOutput), args.Error(1)
+}
+
+func (m *MockWishListService) RollOverUnpurchased(ctx context.Context, wishListID, userID string, input service.RollOverUnpurchasedInput) (*service.RollOverOutput, error) {
+	args := m.Called(ctx, wishListID, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.RollOverOutput), args.Error(1)
+}
+
 func (m *MockWishListService) CreateGiftItem(ctx context.Context, wishListID string, input service.CreateGiftItemInput) (*service.GiftItemOutput, error) {
 	args := m.Called(ctx, wishListID, input)
 	if args.Get(0) == nil {
@@ -162,6 +198,14 @@ func (m *MockWishListService) GetGiftItemsByPublicSlugPaginated(ctx context.Cont
 	return args.Get(0).([]*service.GiftItemOutput), args.Int(1), args.Error(2)
 }
 
+func (m *MockWishListService) GetGiftItemGroupsByPublicSlug(ctx context.Context, publicSlug, groupBy string) ([]*service.GiftItemGroupOutput, error) {
+	args := m.Called(ctx, publicSlug, groupBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.GiftItemGroupOutput), args.Error(1)
+}
+
 func (m *MockWishListService) UpdateGiftItem(ctx context.Context, giftItemID string, input service.UpdateGiftItemInput) (*service.GiftItemOutput, error) {
 	args := m.Called(ctx, giftItemID, input)
 	if args.Get(0) == nil {
@@ -188,7 +232,7 @@ func TestHandler_GetWishListByPublicSlug(t *testing.T) {
 	t.Run("valid slug returns wish list", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		expectedWishList := &service.WishListOutput{
 			ID:          "123e4567-e89b-12d3-a456-426614174000",
@@ -223,10 +267,56 @@ func TestHandler_GetWishListByPublicSlug(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("fields limits the response members", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "birthday-2026").
+			Return(&service.WishListOutput{
+				ID:          "123e4567-e89b-12d3-a456-426614174000",
+				Title:       "Birthday Wish List",
+				Description: "My birthday gifts",
+				PublicSlug:  "birthday-2026",
+				IsPublic:    true,
+			}, nil)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026?fields=title,occasion_date", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		err := handler.GetWishListByPublicSlug(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"id":"123e4567-e89b-12d3-a456-426614174000","title":"Birthday Wish List","occasion_date":""}`, rec.Body.String())
+	})
+
+	t.Run("unknown field is rejected", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026?fields=password", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		err := handler.GetWishListByPublicSlug(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+		mockService.AssertNotCalled(t, "GetWishListByPublicSlug", mock.Anything, mock.Anything)
+	})
+
 	t.Run("invalid slug returns not found", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		mockService.On("GetWishListByPublicSlug", mock.Anything, "non-existent-slug").
 			Return((*service.WishListOutput)(nil), service.ErrWishListNotFound)
@@ -252,7 +342,7 @@ func TestHandler_GetWishListByPublicSlug(t *testing.T) {
 	t.Run("deleted list returns not found", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		mockService.On("GetWishListByPublicSlug", mock.Anything, "deleted-list").
 			Return((*service.WishListOutput)(nil), service.ErrWishListNotFound)
@@ -278,7 +368,7 @@ func TestHandler_GetWishListByPublicSlug(t *testing.T) {
 	t.Run("public wish list with special characters in slug", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		expectedWishList := &service.WishListOutput{
 			ID:         "123e4567-e89b-12d3-a456-426614174000",
@@ -303,14 +393,265 @@ func TestHandler_GetWishListByPublicSlug(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("retired slug redirects to the current slug", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "old-birthday").
+			Return((*service.WishListOutput)(nil), &service.WishListSlugMovedError{CurrentSlug: "new-birthday"})
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/old-birthday", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("old-birthday")
+
+		err := handler.GetWishListByPublicSlug(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusMovedPermanently, rec.Code)
+		assert.Equal(t, "/api/public/wishlists/new-birthday", rec.Header().Get(echo.HeaderLocation))
+
+		var response dto.WishListSlugMovedResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "new-birthday", response.Slug)
+		assert.Equal(t, "/api/public/wishlists/new-birthday", response.Location)
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestHandler_GetSharePage(t *testing.T) {
+	t.Run("returns the aggregate and counts a view", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		page := &service.SharePageOutput{
+			WishList:   &service.WishListOutput{ID: "123e4567-e89b-12d3-a456-426614174000", Title: "Birthday Wish List", PublicSlug: "birthday-2026"},
+			OwnerName:  "Alice",
+			Items:      []*service.GiftItemOutput{{Item: mapper.Item{ID: "123e4567-e89b-12d3-a456-426614174002", Name: "Camera", ReservationStatus: "fully_reserved", IsReserved: true}}},
+			TotalItems: 101,
+			ComputedAt: time.Now(),
+		}
+		mockService.On("GetSharePage", mock.Anything, "birthday-2026").Return(page, nil)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026/full", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		require.NoError(t, handler.GetSharePage(c))
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response struct {
+			WishList  dto.WishListResponse    `json:"wishlist"`
+			OwnerName string                  `json:"owner_name"`
+			Items     []*dto.GiftItemResponse `json:"items"`
+			Total     int                     `json:"total"`
+			Limit     int                     `json:"limit"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "Birthday Wish List", response.WishList.Title)
+		assert.Equal(t, "Alice", response.OwnerName)
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, "fully_reserved", response.Items[0].ReservationStatus)
+		assert.Equal(t, 101, response.Total)
+		assert.Equal(t, service.SharePageItems, response.Limit)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("retired slug redirects to the current slug", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetSharePage", mock.Anything, "old-birthday").
+			Return(nil, &service.WishListSlugMovedError{CurrentSlug: "new-birthday"})
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/old-birthday/full", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("old-birthday")
+
+		require.NoError(t, handler.GetSharePage(c))
+		assert.Equal(t, nethttp.StatusMovedPermanently, rec.Code)
+		assert.Equal(t, "/api/public/wishlists/new-birthday/full", rec.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("closed slug", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetSharePage", mock.Anything, "birthday-2025").Return(nil, service.ErrWishListClosed)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2025/full", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2025")
+
+		require.NoError(t, handler.GetSharePage(c))
+		assert.Equal(t, nethttp.StatusGone, rec.Code)
+
+		var response dto.WishListClosedResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "This wish list is closed", response.Error)
+	})
+
+	t.Run("unknown slug", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetSharePage", mock.Anything, "missing").Return(nil, service.ErrWishListNotFound)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/missing/full", nethttp.NoBody)
+		c := e.NewContext(req, httptest.NewRecorder())
+		c.SetParamNames("slug")
+		c.SetParamValues("missing")
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, handler.GetSharePage(c), &appErr)
+		assert.Equal(t, nethttp.StatusNotFound, appErr.Code)
+	})
 }
 
 // T048a: Unit tests for wish list update/delete endpoints
+func TestHandler_GetGiftItemsByPublicSlug(t *testing.T) {
+	t.Run("item links carry affiliate tags", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		links := affiliate.NewDecorator([]affiliate.Rule{{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"}})
+		handler := NewHandler(mockService, links)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "birthday-2026").
+			Return(&service.WishListOutput{PublicSlug: "birthday-2026", IsPublic: true}, nil)
+		mockService.On("GetGiftItemsByPublicSlugPaginated", mock.Anything, "birthday-2026", 10, 0).
+			Return([]*service.GiftItemOutput{
+				{Item: mapper.Item{ID: "item-1", Name: "Headphones", Link: "https://www.amazon.com/dp/B0001"}},
+				{Item: mapper.Item{ID: "item-2", Name: "Book", Link: "https://books.example.com/42"}},
+			}, 2, nil)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026/gift-items", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		err := handler.GetGiftItemsByPublicSlug(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response dto.GetGiftItemsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, "https://www.amazon.com/dp/B0001?tag=wishlist-20", response.Items[0].Link)
+		assert.Equal(t, "https://books.example.com/42", response.Items[1].Link)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("group_by returns grouped items", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		links := affiliate.NewDecorator([]affiliate.Rule{{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"}})
+		handler := NewHandler(mockService, links)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "birthday-2026").
+			Return(&service.WishListOutput{PublicSlug: "birthday-2026", IsPublic: true}, nil)
+		mockService.On("GetGiftItemGroupsByPublicSlug", mock.Anything, "birthday-2026", "priority").
+			Return([]*service.GiftItemGroupOutput{
+				{Key: "must_have", Count: 1, Available: 1, Items: []*service.GiftItemOutput{
+					{Item: mapper.Item{ID: "item-1", Name: "Headphones", Link: "https://www.amazon.com/dp/B0001"}},
+				}},
+				{Key: "dream", Count: 1, Reserved: 1, Items: []*service.GiftItemOutput{
+					{Item: mapper.Item{ID: "item-2", Name: "Boat", IsReserved: true}},
+				}},
+			}, nil)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026/gift-items?group_by=priority", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		err := handler.GetGiftItemsByPublicSlug(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+
+		var response dto.GetGiftItemGroupsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "priority", response.GroupBy)
+		require.Len(t, response.Groups, 2)
+		assert.Equal(t, "must_have", response.Groups[0].Key)
+		assert.Equal(t, 1, response.Groups[0].Available)
+		assert.Equal(t, "https://www.amazon.com/dp/B0001?tag=wishlist-20", response.Groups[0].Items[0].Link)
+		assert.Equal(t, 1, response.Groups[1].Reserved)
+
+		mockService.AssertNotCalled(t, "GetGiftItemsByPublicSlugPaginated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unknown group_by is rejected", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "birthday-2026").
+			Return(&service.WishListOutput{PublicSlug: "birthday-2026", IsPublic: true}, nil)
+		mockService.On("GetGiftItemGroupsByPublicSlug", mock.Anything, "birthday-2026", "price").
+			Return(nil, service.ErrInvalidGiftItemGroup)
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/birthday-2026/gift-items?group_by=price", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("birthday-2026")
+
+		err := handler.GetGiftItemsByPublicSlug(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+	})
+
+	t.Run("retired slug redirects with the query string", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		mockService.On("GetWishListByPublicSlug", mock.Anything, "old-birthday").
+			Return((*service.WishListOutput)(nil), &service.WishListSlugMovedError{CurrentSlug: "new-birthday"})
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/public/wishlists/old-birthday/gift-items?page=2&limit=5", nethttp.NoBody)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("old-birthday")
+
+		err := handler.GetGiftItemsByPublicSlug(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusMovedPermanently, rec.Code)
+		assert.Equal(t, "/api/public/wishlists/new-birthday/gift-items?page=2&limit=5", rec.Header().Get(echo.HeaderLocation))
+		mockService.AssertNotCalled(t, "GetGiftItemsByPublicSlugPaginated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestHandler_UpdateWishList(t *testing.T) {
 	t.Run("owner can update own wishlist", func(t *testing.T) {
 		e := setupTestEcho()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		authCtx := DefaultAuthContext()
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
@@ -352,7 +693,7 @@ func TestHandler_UpdateWishList(t *testing.T) {
 		// Without middleware, MustGetUserID returns "" and service returns Forbidden.
 		e := setupTestEcho()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
 
@@ -381,7 +722,7 @@ func TestHandler_UpdateWishList(t *testing.T) {
 	t.Run("update with service error", func(t *testing.T) {
 		e := setupTestEcho()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		authCtx := DefaultAuthContext()
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
@@ -414,7 +755,7 @@ func TestHandler_DeleteWishList(t *testing.T) {
 	t.Run("owner can delete own wishlist", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		authCtx := DefaultAuthContext()
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
@@ -438,7 +779,7 @@ func TestHandler_DeleteWishList(t *testing.T) {
 		// Without middleware, MustGetUserID returns "" and service returns Forbidden.
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
 
@@ -462,7 +803,7 @@ func TestHandler_DeleteWishList(t *testing.T) {
 	t.Run("delete with service error", func(t *testing.T) {
 		e := echo.New()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		authCtx := DefaultAuthContext()
 		wishListID := "123e4567-e89b-12d3-a456-426614174000"
@@ -486,12 +827,170 @@ func TestHandler_DeleteWishList(t *testing.T) {
 	})
 }
 
+func TestHandler_MergeWishLists(t *testing.T) {
+	targetID := "123e4567-e89b-12d3-a456-426614174001"
+	sourceID := "123e4567-e89b-12d3-a456-426614174002"
+
+	t.Run("returns the target and merged count", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		input := service.MergeWishListsInput{SourceID: sourceID, Mode: service.MergeModeCopy, ArchiveSource: true}
+		mockService.On("MergeWishLists", mock.Anything, targetID, authCtx.UserID, input).
+			Return(&service.MergeWishListsOutput{WishList: &service.WishListOutput{ID: targetID, Title: "Birthday"}, ItemsMerged: 3}, nil)
+
+		body := map[string]any{"source_id": sourceID, "mode": "copy", "archive_source": true}
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodPost, "/wishlists/"+targetID+"/merge", body,
+			[]string{"id"}, []string{targetID}, &authCtx)
+
+		err := handler.MergeWishLists(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		var response dto.MergeWishListsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, targetID, response.WishList.ID)
+		assert.Equal(t, int64(3), response.ItemsMerged)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validates the request", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		for _, body := range []map[string]any{{"mode": "move"}, {"source_id": "not-a-uuid"}, {"source_id": sourceID, "mode": "link"}} {
+			c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/wishlists/"+targetID+"/merge", body,
+				[]string{"id"}, []string{targetID}, &authCtx)
+
+			err := handler.MergeWishLists(c)
+
+			var appErr *apperrors.AppError
+			require.ErrorAs(t, err, &appErr, body)
+			assert.Equal(t, nethttp.StatusBadRequest, appErr.Code, body)
+		}
+		mockService.AssertNotCalled(t, "MergeWishLists")
+	})
+
+	t.Run("maps an archived source to conflict", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		mockService.On("MergeWishLists", mock.Anything, targetID, authCtx.UserID, mock.Anything).
+			Return(nil, service.ErrWishListMerged)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/wishlists/"+targetID+"/merge", map[string]any{"source_id": sourceID},
+			[]string{"id"}, []string{targetID}, &authCtx)
+
+		err := handler.MergeWishLists(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusConflict, appErr.Code)
+	})
+}
+
+func TestHandler_RollOverUnpurchased(t *testing.T) {
+	wishListID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("works without a body", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		mockService.On("RollOverUnpurchased", mock.Anything, wishListID, authCtx.UserID, service.RollOverUnpurchasedInput{}).
+			Return(&service.RollOverOutput{WishList: &service.WishListOutput{ID: "new-list", Title: "Birthday 2027"}, ItemsCarried: 4}, nil)
+
+		c, rec := CreateTestContextWithParams(e, nethttp.MethodPost, "/wishlists/"+wishListID+"/rollover-unpurchased", nil,
+			[]string{"id"}, []string{wishListID}, &authCtx)
+
+		err := handler.RollOverUnpurchased(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusCreated, rec.Code)
+		var response dto.RollOverResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "new-list", response.WishList.ID)
+		assert.Equal(t, int64(4), response.ItemsCarried)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("maps an earlier rollover to conflict", func(t *testing.T) {
+		e := setupTestEcho()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		input := service.RollOverUnpurchasedInput{Title: "Next birthday"}
+		mockService.On("RollOverUnpurchased", mock.Anything, wishListID, authCtx.UserID, input).
+			Return(nil, service.ErrWishListRolledOver)
+
+		c, _ := CreateTestContextWithParams(e, nethttp.MethodPost, "/wishlists/"+wishListID+"/rollover-unpurchased", map[string]any{"title": "Next birthday"},
+			[]string{"id"}, []string{wishListID}, &authCtx)
+
+		err := handler.RollOverUnpurchased(c)
+
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, nethttp.StatusConflict, appErr.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestHandler_GetWishListsByOwner(t *testing.T) {
+	t.Run("passes sort and filter query params", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+		filters := repository.WishListFilters{Sort: "occasion_date", Filter: "upcoming"}
+
+		mockService.On("GetWishListsByOwner", mock.Anything, authCtx.UserID, filters).
+			Return([]*service.WishListOutput{}, nil)
+
+		c, rec := CreateTestContext(e, nethttp.MethodGet, "/wishlists?sort=occasion_date&filter=upcoming", nil, &authCtx)
+
+		err := handler.GetWishListsByOwner(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid sort returns bad request", func(t *testing.T) {
+		e := echo.New()
+		mockService := new(MockWishListService)
+		handler := NewHandler(mockService, nil)
+
+		authCtx := DefaultAuthContext()
+
+		mockService.On("GetWishListsByOwner", mock.Anything, authCtx.UserID, repository.WishListFilters{Sort: "price"}).
+			Return(nil, service.ErrInvalidWishListSort)
+
+		c, _ := CreateTestContext(e, nethttp.MethodGet, "/wishlists?sort=price", nil, &authCtx)
+
+		err := handler.GetWishListsByOwner(c)
+
+		require.Error(t, err)
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr, "Error should be apperrors.AppError")
+		assert.Equal(t, nethttp.StatusBadRequest, appErr.Code)
+	})
+}
+
 // T048a: Additional authorization tests for wish list update/delete endpoints
 func TestHandler_UpdateWishList_AuthorizationChecks(t *testing.T) {
 	t.Run("update non-existent wishlist returns not found", func(t *testing.T) {
 		e := setupTestEcho()
 		mockService := new(MockWishListService)
-		handler := NewHandler(mockService)
+		handler := NewHandler(mockService, nil)
 
 		title := "New Title"
 		reqBody := dto.UpdateWishListRequest{
@@ -518,3 +1017,25 @@ func TestHandler_UpdateWishList_AuthorizationChecks(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestMapWishlistServiceError_SlugErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantMsg  string
+	}{
+		{name: "invalid", err: service.ErrSlugInvalid, wantCode: nethttp.StatusBadRequest, wantMsg: "must contain only lowercase letters"},
+		{name: "reserved", err: service.ErrSlugReserved, wantCode: nethttp.StatusBadRequest, wantMsg: "is reserved"},
+		{name: "taken", err: service.ErrSlugTaken, wantCode: nethttp.StatusConflict, wantMsg: "is already taken"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var appErr *apperrors.AppError
+			require.ErrorAs(t, mapWishlistServiceError(tt.err), &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Contains(t, appErr.Details["PublicSlug"], tt.wantMsg)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/auth"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ImportHandler handles HTTP requests copying public wishlists into the user's account
+type ImportHandler struct {
+	service service.ImportServiceInterface
+}
+
+// NewImportHandler creates a new ImportHandler
+func NewImportHandler(svc service.ImportServiceInterface) *ImportHandler {
+	return &ImportHandler{
+		service: svc,
+	}
+}
+
+// ImportWishList godoc
+//
+//	@Summary		Import a public wish list
+//	@Description	Copies someone else's public wish list to the user's account as a new private list. Item names, descriptions, links, prices and images are copied, at most 50 items; who reserved or bought them is not. The occasion date is not copied. A user can import at most 10 wish lists a day, and the copy counts against their plan's wish list limit.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			import	body		dto.ImportWishListRequest	true	"Public wish list to copy"
+//	@Success		201		{object}	dto.ImportResponse			"Wish list imported"
+//	@Failure		400		{object}	map[string]string			"Invalid request or own wish list"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		402		{object}	map[string]string			"Wish list limit of the plan reached"
+//	@Failure		404		{object}	map[string]string			"Wish list not found"
+//	@Failure		429		{object}	map[string]string			"Daily import limit reached"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/import [post]
+func (h *ImportHandler) ImportWishList(c echo.Context) error {
+	var req dto.ImportWishListRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	out, err := h.service.ImportWishList(c.Request().Context(), auth.MustGetUserID(c), req.PublicSlug)
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.FromImportOutput(out))
+}
+
+// GetImportSource godoc
+//
+//	@Summary		Get where an imported wish list came from
+//	@Description	Returns the public wish list this list was copied from: its title at the time, its owner's first name, and its slug while it is still public
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			id	path		string						true	"Wish List ID"
+//	@Success		200	{object}	dto.ImportSourceResponse	"Source of the import"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		403	{object}	map[string]string			"Not the owner"
+//	@Failure		404	{object}	map[string]string			"Wish list not found or not imported"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/import-source [get]
+func (h *ImportHandler) GetImportSource(c echo.Context) error {
+	source, err := h.service.GetImportSource(c.Request().Context(), OwnedWishList(c))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromImportSourceOutput(source))
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// InviteHandler handles HTTP requests inviting guests to a wishlist by email
+type InviteHandler struct {
+	service service.InviteServiceInterface
+}
+
+// NewInviteHandler creates a new InviteHandler
+func NewInviteHandler(svc service.InviteServiceInterface) *InviteHandler {
+	return &InviteHandler{
+		service: svc,
+	}
+}
+
+// InviteGuests godoc
+//
+//	@Summary		Invite guests by email
+//	@Description	Emails the share link of a public wish list to up to 50 addresses, with an optional personal message. Each guest gets their own link, so the owner can see who visited. Addresses already emailed for this wish list within the last 24 hours are skipped. A user can send at most 200 invites a day. An email that could not be sent is reported with status failed; inviting the address again retries it.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Wish List ID"
+//	@Param			invite	body		dto.InviteGuestsRequest		true	"Guests to invite"
+//	@Success		200		{object}	dto.InviteGuestsResponse	"Invites sent"
+//	@Failure		400		{object}	map[string]string			"Invalid addresses"
+//	@Failure		401		{object}	map[string]string			"Unauthorized"
+//	@Failure		403		{object}	map[string]string			"Not the owner"
+//	@Failure		404		{object}	map[string]string			"Wish list not found"
+//	@Failure		409		{object}	map[string]string			"Wish list is not public"
+//	@Failure		422		{object}	map[string]string			"Message rejected by moderation"
+//	@Failure		429		{object}	map[string]string			"Daily invite limit reached"
+//	@Failure		500		{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/invite [post]
+func (h *InviteHandler) InviteGuests(c echo.Context) error {
+	var req dto.InviteGuestsRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	result, err := h.service.InviteGuests(c.Request().Context(), OwnedWishList(c), req.ToServiceInput())
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromInviteResultOutput(result))
+}
+
+// ListInvites godoc
+//
+//	@Summary		List invited guests
+//	@Description	Lists the guests invited to the wish list by email, most recently invited first, with whether their email was sent and when they first visited through their link
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			id	path		string					true	"Wish List ID"
+//	@Success		200	{object}	dto.ListInvitesResponse	"Invited guests"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Not the owner"
+//	@Failure		404	{object}	map[string]string		"Wish list not found"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/invites [get]
+func (h *InviteHandler) ListInvites(c echo.Context) error {
+	invites, err := h.service.ListInvites(c.Request().Context(), OwnedWishList(c))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromInviteOutputs(invites))
+}
+
+// RecordInviteVisit godoc
+//
+//	@Summary		Count a visit through an invite link
+//	@Description	Called by the share page when it was opened from an invite email, with the token from the link's invite parameter. Opening the link alone counts nothing, so mail scanners following links do not mark guests as visited. Rate limited per client address.
+//	@Tags			Wish Lists
+//	@Param			token	path	string	true	"Invite token"
+//	@Success		204		"Visit counted"
+//	@Failure		404		{object}	map[string]string	"Invite not found"
+//	@Failure		429		{object}	map[string]string	"Too many requests"
+//	@Failure		500		{object}	map[string]string	"Internal server error"
+//	@Router			/public/invites/{token}/visit [post]
+func (h *InviteHandler) RecordInviteVisit(c echo.Context) error {
+	if err := h.service.RecordVisit(c.Request().Context(), c.Param("token")); err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"wish-list/internal/app/middleware"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// ownedWishListKey is the context key RequireWishListOwner stores the wishlist under
+const ownedWishListKey = "owned_wishlist"
+
+// WishListLoader loads wishlists for RequireWishListOwner
+type WishListLoader interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*models.WishList, error)
+}
+
+// RequireWishListOwner allows the request only when the authenticated user owns the
+// wishlist in the :id path parameter. Handlers read it with OwnedWishList.
+// It must run after the JWT middleware.
+func RequireWishListOwner(wishLists WishListLoader) echo.MiddlewareFunc {
+	return middleware.RequireOwner("id", ownedWishListKey, "Wish list",
+		func(ctx context.Context, id pgtype.UUID) (*models.WishList, pgtype.UUID, error) {
+			wishList, err := wishLists.GetByID(ctx, id)
+			if errors.Is(err, repository.ErrWishListNotFound) {
+				return nil, pgtype.UUID{}, middleware.ErrResourceNotFound
+			}
+			if err != nil {
+				return nil, pgtype.UUID{}, err
+			}
+			return wishList, wishList.OwnerID, nil
+		})
+}
+
+// OwnedWishList returns the wishlist loaded by RequireWishListOwner
+func OwnedWishList(c echo.Context) *models.WishList {
+	wishList, _ := middleware.OwnedResource[*models.WishList](c, ownedWishListKey)
+	return wishList
+}
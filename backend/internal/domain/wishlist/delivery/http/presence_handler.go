@@ -0,0 +1,98 @@
+package http
+
+import (
+	nethttp "net/http"
+	"strconv"
+
+	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ModifiedSinceLoadedHeader mirrors modified_since_loaded for clients that only
+// inspect headers
+const ModifiedSinceLoadedHeader = "X-Modified-Since-Loaded"
+
+// PresenceHandler handles HTTP requests for wishlist edit presence
+type PresenceHandler struct {
+	service service.EditPresenceServiceInterface
+}
+
+// NewPresenceHandler creates a new PresenceHandler
+func NewPresenceHandler(svc service.EditPresenceServiceInterface) *PresenceHandler {
+	return &PresenceHandler{
+		service: svc,
+	}
+}
+
+// Heartbeat godoc
+//
+//	@Summary		Report an open wish list editor
+//	@Description	Sent by an open editor about every 15 seconds with a client-chosen session ID that is stable while the editor is open. Returns the owner's other sessions editing the same wish list and whether it was saved elsewhere since the editor loaded the given version, so the client can warn before overwriting. Updates are still checked against If-Match; this only warns early. A session drops out 45 seconds after its last heartbeat.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Wish List ID"
+//	@Param			sessionId	path		string						true	"Editor session ID"
+//	@Param			If-Match	header		string						false	"Version the editor loaded (ETag)"
+//	@Param			heartbeat	body		dto.EditHeartbeatRequest	false	"Version the editor loaded, if not sent in If-Match"
+//	@Success		200			{object}	dto.EditPresenceResponse	"Other editors and staleness; X-Modified-Since-Loaded mirrors the flag"
+//	@Failure		400			{object}	map[string]string			"Invalid session ID or missing version"
+//	@Failure		401			{object}	map[string]string			"Unauthorized"
+//	@Failure		403			{object}	map[string]string			"Not the owner"
+//	@Failure		404			{object}	map[string]string			"Wish list not found"
+//	@Failure		500			{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/editors/{sessionId} [put]
+func (h *PresenceHandler) Heartbeat(c echo.Context) error {
+	var req dto.EditHeartbeatRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	version, err := helpers.ExpectedVersion(c, req.Version)
+	if err != nil {
+		return err
+	}
+	if version == nil {
+		return apperrors.BadRequest("Send the loaded version in If-Match or the version field")
+	}
+
+	out, err := h.service.Heartbeat(c.Request().Context(), OwnedWishList(c), service.EditHeartbeatInput{
+		SessionID:     c.Param("sessionId"),
+		LoadedVersion: *version,
+	})
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	helpers.SetVersionETag(c, out.CurrentVersion)
+	c.Response().Header().Set(ModifiedSinceLoadedHeader, strconv.FormatBool(out.ModifiedSinceLoaded))
+	return c.JSON(nethttp.StatusOK, dto.FromEditPresenceOutput(out))
+}
+
+// Leave godoc
+//
+//	@Summary		Close a wish list editor
+//	@Description	Removes the session from the wish list's editors right away instead of waiting for it to time out
+//	@Tags			Wish Lists
+//	@Param			id			path	string	true	"Wish List ID"
+//	@Param			sessionId	path	string	true	"Editor session ID"
+//	@Success		204			"Session removed"
+//	@Failure		400			{object}	map[string]string	"Invalid session ID"
+//	@Failure		401			{object}	map[string]string	"Unauthorized"
+//	@Failure		403			{object}	map[string]string	"Not the owner"
+//	@Failure		404			{object}	map[string]string	"Wish list not found"
+//	@Failure		500			{object}	map[string]string	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/editors/{sessionId} [delete]
+func (h *PresenceHandler) Leave(c echo.Context) error {
+	if err := h.service.Leave(c.Request().Context(), OwnedWishList(c), c.Param("sessionId")); err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.NoContent(nethttp.StatusNoContent)
+}
@@ -1,9 +1,14 @@
 package http
 
-import "github.com/labstack/echo/v4"
+import (
+	"github.com/labstack/echo/v4"
 
-// RegisterRoutes registers all wishlist HTTP routes
-func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc) {
+	"wish-list/internal/app/middleware"
+)
+
+// RegisterRoutes registers all wishlist HTTP routes. wishlistsTokenMiddleware
+// authenticates integration routes with a personal API token.
+func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware, wishlistsTokenMiddleware echo.MiddlewareFunc) {
 	// Authenticated wishlist routes
 	wishlists := e.Group("/api/wishlists", authMiddleware)
 	wishlists.POST("", h.CreateWishList)
@@ -11,9 +16,61 @@ func RegisterRoutes(e *echo.Echo, h *Handler, authMiddleware echo.MiddlewareFunc
 	wishlists.GET("/:id", h.GetWishList)
 	wishlists.PUT("/:id", h.UpdateWishList)
 	wishlists.DELETE("/:id", h.DeleteWishList)
+	wishlists.POST("/:id/merge", h.MergeWishLists)
+	wishlists.POST("/:id/rollover-unpurchased", h.RollOverUnpurchased)
 
 	// Public wishlist routes (no auth required)
 	public := e.Group("/api/public")
 	public.GET("/wishlists/:slug", h.GetWishListByPublicSlug)
 	public.GET("/wishlists/:slug/gift-items", h.GetGiftItemsByPublicSlug)
+	public.GET("/wishlists/:slug/full", h.GetSharePage)
+	public.GET("/u/:username/:slug", h.GetWishListByVanitySlug)
+
+	// Integration routes (personal API token required), e.g. for the browser
+	// extension to offer a wishlist picker
+	ext := e.Group("/api/ext", wishlistsTokenMiddleware)
+	ext.GET("/wishlists", h.GetWishListsByOwner)
+}
+
+// RegisterPresenceRoutes registers the owner's wishlist edit presence routes.
+// wishListOwnerMiddleware is RequireWishListOwner.
+func RegisterPresenceRoutes(e *echo.Echo, h *PresenceHandler, authMiddleware, wishListOwnerMiddleware echo.MiddlewareFunc) {
+	editors := e.Group("/api/wishlists/:id/editors", authMiddleware, wishListOwnerMiddleware)
+	editors.PUT("/:sessionId", h.Heartbeat)
+	editors.DELETE("/:sessionId", h.Leave)
+}
+
+// RegisterViewRoutes registers the route the share page counts its views with
+func RegisterViewRoutes(e *echo.Echo, h *ViewHandler) {
+	viewLimiter := middleware.NewViewRateLimiter()
+	e.POST("/api/public/wishlists/:slug/view", h.RecordView,
+		middleware.AuthRateLimitMiddleware(viewLimiter, middleware.IPIdentifier))
+}
+
+// RegisterSnapshotRoutes registers the owner's wishlist snapshot routes.
+// wishListOwnerMiddleware is RequireWishListOwner.
+func RegisterSnapshotRoutes(e *echo.Echo, h *SnapshotHandler, authMiddleware, wishListOwnerMiddleware echo.MiddlewareFunc) {
+	snapshots := e.Group("/api/wishlists/:id/snapshots", authMiddleware, wishListOwnerMiddleware)
+	snapshots.GET("", h.ListSnapshots)
+	snapshots.POST("", h.TakeSnapshot)
+	snapshots.GET("/:date", h.GetSnapshot)
+}
+
+// RegisterImportRoutes registers the routes copying public wishlists into the
+// user's account. wishListOwnerMiddleware is RequireWishListOwner.
+func RegisterImportRoutes(e *echo.Echo, h *ImportHandler, authMiddleware, wishListOwnerMiddleware echo.MiddlewareFunc) {
+	e.POST("/api/wishlists/import", h.ImportWishList, authMiddleware)
+	e.GET("/api/wishlists/:id/import-source", h.GetImportSource, authMiddleware, wishListOwnerMiddleware)
+}
+
+// RegisterInviteRoutes registers the routes inviting guests to a wishlist by
+// email. wishListOwnerMiddleware is RequireWishListOwner.
+func RegisterInviteRoutes(e *echo.Echo, h *InviteHandler, authMiddleware, wishListOwnerMiddleware echo.MiddlewareFunc) {
+	invites := e.Group("/api/wishlists/:id", authMiddleware, wishListOwnerMiddleware)
+	invites.POST("/invite", h.InviteGuests)
+	invites.GET("/invites", h.ListInvites)
+
+	visitLimiter := middleware.NewInviteVisitRateLimiter()
+	e.POST("/api/public/invites/:token/visit", h.RecordInviteVisit,
+		middleware.AuthRateLimitMiddleware(visitLimiter, middleware.IPIdentifier))
 }
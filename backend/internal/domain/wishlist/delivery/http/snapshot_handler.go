@@ -0,0 +1,98 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SnapshotHandler handles HTTP requests for wishlist snapshots
+type SnapshotHandler struct {
+	service service.SnapshotServiceInterface
+}
+
+// NewSnapshotHandler creates a new SnapshotHandler
+func NewSnapshotHandler(svc service.SnapshotServiceInterface) *SnapshotHandler {
+	return &SnapshotHandler{
+		service: svc,
+	}
+}
+
+// ListSnapshots godoc
+//
+//	@Summary		List wish list snapshots
+//	@Description	Lists the days the wish list was frozen, newest first. A snapshot is taken on the occasion date and whenever the owner asks for one.
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			id	path		string						true	"Wish List ID"
+//	@Success		200	{object}	dto.ListSnapshotsResponse	"Snapshots without their items"
+//	@Failure		401	{object}	map[string]string			"Unauthorized"
+//	@Failure		403	{object}	map[string]string			"Not the owner"
+//	@Failure		404	{object}	map[string]string			"Wish list not found"
+//	@Failure		500	{object}	map[string]string			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/snapshots [get]
+func (h *SnapshotHandler) ListSnapshots(c echo.Context) error {
+	snapshots, err := h.service.ListSnapshots(c.Request().Context(), OwnedWishList(c))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSnapshotSummaryOutputs(snapshots))
+}
+
+// TakeSnapshot godoc
+//
+//	@Summary		Snapshot a wish list
+//	@Description	Freezes the wish list with its items' reservation and purchase state under today's date (UTC), so later edits do not change it. A wish list is snapshotted at most once a day; asking again returns that day's snapshot.
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			id	path		string					true	"Wish List ID"
+//	@Success		201	{object}	dto.SnapshotResponse	"Snapshot taken"
+//	@Success		200	{object}	dto.SnapshotResponse	"Already snapshotted today"
+//	@Failure		401	{object}	map[string]string		"Unauthorized"
+//	@Failure		403	{object}	map[string]string		"Not the owner"
+//	@Failure		404	{object}	map[string]string		"Wish list not found"
+//	@Failure		500	{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/snapshots [post]
+func (h *SnapshotHandler) TakeSnapshot(c echo.Context) error {
+	snapshot, created, err := h.service.TakeSnapshot(c.Request().Context(), OwnedWishList(c))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	status := nethttp.StatusOK
+	if created {
+		status = nethttp.StatusCreated
+	}
+	return c.JSON(status, dto.FromSnapshotOutput(snapshot))
+}
+
+// GetSnapshot godoc
+//
+//	@Summary		Get a wish list snapshot
+//	@Description	Returns the wish list as it was frozen on the given day, whatever was edited since
+//	@Tags			Wish Lists
+//	@Produce		json
+//	@Param			id		path		string					true	"Wish List ID"
+//	@Param			date	path		string					true	"Snapshot date (YYYY-MM-DD)"
+//	@Success		200		{object}	dto.SnapshotResponse	"Wish list as it was"
+//	@Failure		400		{object}	map[string]string		"Invalid date"
+//	@Failure		401		{object}	map[string]string		"Unauthorized"
+//	@Failure		403		{object}	map[string]string		"Not the owner"
+//	@Failure		404		{object}	map[string]string		"Wish list or snapshot not found"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/wishlists/{id}/snapshots/{date} [get]
+func (h *SnapshotHandler) GetSnapshot(c echo.Context) error {
+	snapshot, err := h.service.GetSnapshot(c.Request().Context(), OwnedWishList(c), c.Param("date"))
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusOK, dto.FromSnapshotOutput(snapshot))
+}
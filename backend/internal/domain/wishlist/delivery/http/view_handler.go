@@ -0,0 +1,53 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"wish-list/internal/domain/wishlist/delivery/http/dto"
+	"wish-list/internal/domain/wishlist/service"
+	"wish-list/internal/pkg/helpers"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ViewHandler handles HTTP requests counting public wishlist views
+type ViewHandler struct {
+	service service.ViewServiceInterface
+}
+
+// NewViewHandler creates a new ViewHandler
+func NewViewHandler(svc service.ViewServiceInterface) *ViewHandler {
+	return &ViewHandler{
+		service: svc,
+	}
+}
+
+// RecordView godoc
+//
+//	@Summary		Count a view of a public wish list
+//	@Description	Called by the share page once it has loaded the wish list, with a client-chosen session ID that is stable for the browser session. Repeat views by the same session within 30 minutes count once. Fetching the wish list does not count as a view. Returns the view count to display; it includes views not stored yet and is approximate. Rate limited per client address.
+//	@Tags			Wish Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			slug	path		string					true	"Public Slug"
+//	@Param			view	body		dto.RecordViewRequest	true	"Viewer session"
+//	@Success		200		{object}	dto.ViewResponse		"View counted"
+//	@Failure		400		{object}	map[string]string		"Invalid session ID"
+//	@Failure		404		{object}	map[string]string		"Wish list not found"
+//	@Failure		429		{object}	map[string]string		"Too many requests"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Router			/public/wishlists/{slug}/view [post]
+func (h *ViewHandler) RecordView(c echo.Context) error {
+	var req dto.RecordViewRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	out, err := h.service.RecordView(c.Request().Context(), c.Param("slug"), req.SessionID)
+	if err != nil {
+		return mapWishlistServiceError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(nethttp.StatusOK, dto.FromViewOutput(out))
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// WishListImport records a wishlist a user copied from someone else's public
+// wishlist, for attribution. WishListID and the source IDs are NULL once the
+// list they point at was deleted.
+type WishListImport struct {
+	ID               pgtype.UUID        `db:"id"`
+	WishListID       pgtype.UUID        `db:"wishlist_id"` // The copy
+	ImportedBy       pgtype.UUID        `db:"imported_by"`
+	SourceWishListID pgtype.UUID        `db:"source_wishlist_id"`
+	SourceOwnerID    pgtype.UUID        `db:"source_owner_id"`
+	SourceTitle      string             `db:"source_title"` // Title of the source when it was copied
+	ItemsImported    int32              `db:"items_imported"`
+	CreatedAt        pgtype.Timestamptz `db:"created_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// WishListInvite is a guest the owner invited to a wishlist by email
+type WishListInvite struct {
+	ID             pgtype.UUID        `db:"id"`
+	WishListID     pgtype.UUID        `db:"wishlist_id"`
+	Email          string             `db:"email"`
+	EncryptedEmail pgtype.Text        `db:"encrypted_email"` // PII encrypted
+	Token          pgtype.UUID        `db:"token"`           // Carried by the invite link to track visits
+	Status         string             `db:"status"`
+	SentAt         pgtype.Timestamptz `db:"sent_at"`
+	VisitedAt      pgtype.Timestamptz `db:"visited_at"` // First visit through the invite link
+	VisitCount     int32              `db:"visit_count"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `db:"updated_at"`
+}
+
+// Delivery states of an invite email
+const (
+	InviteStatusPending = "pending" // Not sent yet
+	InviteStatusSent    = "sent"
+	InviteStatusFailed  = "failed" // The email could not be sent; inviting again retries
+)
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Snapshot is a wishlist frozen as it was on one day, with its items'
+// reservation and purchase state at the time
+type Snapshot struct {
+	WishListID   pgtype.UUID        `db:"wishlist_id"`
+	SnapshotDate pgtype.Date        `db:"snapshot_date"`
+	Title        string             `db:"title"`
+	Occasion     pgtype.Text        `db:"occasion"`
+	OccasionDate pgtype.Date        `db:"occasion_date"`
+	Items        SnapshotItems      `db:"items"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+}
+
+// SnapshotSummary describes a snapshot without its items
+type SnapshotSummary struct {
+	SnapshotDate pgtype.Date        `db:"snapshot_date"`
+	Title        string             `db:"title"`
+	ItemCount    int                `db:"item_count"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+}
+
+// SnapshotItem is an item as it was when the snapshot was taken. Givers are
+// not recorded, only whether and when the item was reserved or bought.
+type SnapshotItem struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	Description       string  `json:"description,omitempty"`
+	Link              string  `json:"link,omitempty"`
+	ImageURL          string  `json:"image_url,omitempty"`
+	Price             float64 `json:"price,omitempty"`
+	PriorityLevel     string  `json:"priority_level"`
+	Category          string  `json:"category,omitempty"`
+	Notes             string  `json:"notes,omitempty"`
+	Quantity          int32   `json:"quantity"`
+	ReservedQuantity  int32   `json:"reserved_quantity"`
+	ReservationStatus string  `json:"reservation_status"`
+	PurchasedAt       string  `json:"purchased_at,omitempty"` // RFC 3339; empty when not bought
+	PurchasedPrice    float64 `json:"purchased_price,omitempty"`
+}
+
+// SnapshotItems are the items of a snapshot, stored as a JSONB array
+type SnapshotItems []SnapshotItem
+
+// Scan implements sql.Scanner for a JSONB column
+func (s *SnapshotItems) Scan(src any) error {
+	*s = nil
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into snapshot items", src)
+	}
+	return json.Unmarshal(data, s)
+}
+
+// Value implements driver.Valuer; no items are stored as an empty array
+func (s SnapshotItems) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -1,6 +1,10 @@
 package models
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -16,6 +20,29 @@ type WishList struct {
 	ViewCount    pgtype.Int4        `db:"view_count"`
 	CreatedAt    pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
+	Version      int32              `db:"version"`           // Optimistic locking, bumped on every owner edit
+	Recurrence   pgtype.Text        `db:"recurrence"`        // RecurrenceYearly, or NULL for a one-off occasion
+	RolledOverTo pgtype.UUID        `db:"rolled_over_to_id"` // List created for the next occurrence
+	MergedInto   pgtype.UUID        `db:"merged_into_id"`    // List this one was merged into and archived
+	Discoverable bool               `db:"discoverable"`      // Owner opted in to public search and trending; only applies while public
+	VanitySlug   pgtype.Text        `db:"vanity_slug"`       // Premium custom slug scoped to the owner, served under their username
+}
+
+// RecurrenceYearly repeats the occasion every year on the same date
+const RecurrenceYearly = "yearly"
+
+// NextOccurrence returns the first yearly anniversary of previous that is not before today
+func NextOccurrence(previous, today time.Time) time.Time {
+	next := previous.AddDate(1, 0, 0)
+	for next.Before(today) {
+		next = next.AddDate(1, 0, 0)
+	}
+	return next
+}
+
+// RolloverTitle moves a year in the title along with the occasion, e.g. "Birthday 2025" → "Birthday 2026"
+func RolloverTitle(title string, previousYear, nextYear int) string {
+	return strings.ReplaceAll(title, strconv.Itoa(previousYear), strconv.Itoa(nextYear))
 }
 
 // WishListWithItemCount extends WishList with item count (from JOIN query)
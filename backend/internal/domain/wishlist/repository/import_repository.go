@@ -0,0 +1,178 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_import_repository_test.go -pkg service . ImportRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/logger"
+)
+
+var ErrImportNotFound = errors.New("wishlist import not found")
+
+// importColumns is the column list for wishlist_imports queries
+const importColumns = `id, wishlist_id, imported_by, source_wishlist_id, source_owner_id, source_title, items_imported, created_at`
+
+// ImportRepositoryInterface defines the database operations for copying public
+// wishlists into another user's account
+type ImportRepositoryInterface interface {
+	Import(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error)
+	CountSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error)
+	GetByWishList(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error)
+}
+
+type ImportRepository struct {
+	db *database.DB
+}
+
+func NewImportRepository(db *database.DB) ImportRepositoryInterface {
+	return &ImportRepository{
+		db: db,
+	}
+}
+
+// Import creates next for its owner and fills it with copies of at most itemLimit
+// active items of source, in source order, in one transaction. The copies belong
+// to next's owner and carry the item's description, link, images, price, priority,
+// quantity, options and category; notes and anything about reservations or
+// purchases stay behind. The import is recorded for attribution.
+func (r *ImportRepository) Import(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	insertQuery := `
+		INSERT INTO wishlists (
+			owner_id, title, description, occasion, occasion_date, is_public, public_slug, recurrence, discoverable, vanity_slug
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		) RETURNING
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+	`
+
+	var created models.WishList
+	err = tx.QueryRowxContext(ctx, insertQuery,
+		next.OwnerID,
+		next.Title,
+		database.TextToString(next.Description),
+		database.TextToString(next.Occasion),
+		next.OccasionDate,
+		next.IsPublic,
+		next.PublicSlug,
+		next.Recurrence,
+		next.Discoverable,
+		next.VanitySlug,
+	).StructScan(&created)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create imported wishlist: %w", err)
+	}
+
+	// New IDs are assigned up front so the gallery of each copy can be matched to
+	// its original. Foreign keys are checked once the whole statement has run.
+	copyQuery := `
+		WITH source AS MATERIALIZED (
+			SELECT gen_random_uuid() AS new_id, gi.*
+			FROM wishlist_items wi
+			JOIN gift_items gi ON gi.id = wi.gift_item_id
+			WHERE wi.wishlist_id = $1 AND gi.archived_at IS NULL
+			ORDER BY gi.position NULLS LAST, gi.created_at, gi.id
+			LIMIT $4
+		), copies AS (
+			INSERT INTO gift_items (
+				id, owner_id, name, description, link, image_url, price, priority, position, quantity,
+				priority_level, options, category
+			)
+			SELECT
+				new_id, $2, name, description, link, image_url, price, priority, position, quantity,
+				priority_level, options, category
+			FROM source
+			RETURNING id
+		), links AS (
+			INSERT INTO wishlist_items (wishlist_id, gift_item_id)
+			SELECT $3, id FROM copies
+		), images AS (
+			INSERT INTO gift_item_images (gift_item_id, url, position)
+			SELECT s.new_id, img.url, img.position
+			FROM source s
+			JOIN gift_item_images img ON img.gift_item_id = s.id
+		)
+		SELECT COUNT(*) FROM copies
+	`
+	var copied int32
+	if err := tx.GetContext(ctx, &copied, copyQuery, source.ID, next.OwnerID, created.ID, itemLimit); err != nil {
+		return nil, nil, fmt.Errorf("failed to copy wishlist items: %w", err)
+	}
+
+	recordQuery := `
+		INSERT INTO wishlist_imports (wishlist_id, imported_by, source_wishlist_id, source_owner_id, source_title, items_imported)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + importColumns
+
+	var imported models.WishListImport
+	err = tx.QueryRowxContext(ctx, recordQuery,
+		created.ID,
+		next.OwnerID,
+		source.ID,
+		source.OwnerID,
+		source.Title,
+		copied,
+	).StructScan(&imported)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record wishlist import: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit wishlist import: %w", err)
+	}
+
+	return &created, &imported, nil
+}
+
+// CountSince returns how many wishlists the user imported since since, including
+// copies deleted afterwards
+func (r *ImportRepository) CountSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count,
+		`SELECT COUNT(*) FROM wishlist_imports WHERE imported_by = $1 AND created_at >= $2`,
+		userID, since,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count wishlist imports: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByWishList returns where the wishlist was copied from. ErrImportNotFound
+// is returned for lists that were not imported.
+func (r *ImportRepository) GetByWishList(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+	query := `
+		SELECT ` + importColumns + `
+		FROM wishlist_imports
+		WHERE wishlist_id = $1
+	`
+
+	var imported models.WishListImport
+	if err := r.db.GetContext(ctx, &imported, query, wishListID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrImportNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist import: %w", err)
+	}
+
+	return &imported, nil
+}
@@ -0,0 +1,285 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_invite_repository_test.go -pkg service . InviteRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/encryption"
+	"wish-list/internal/pkg/logger"
+)
+
+var ErrInviteNotFound = errors.New("wishlist invite not found")
+
+// inviteColumns is the column list for wishlist_invites queries. The email is
+// NULL once encrypted; models.WishListInvite keeps the decrypted value.
+const inviteColumns = `id, wishlist_id, COALESCE(email, '') AS email, encrypted_email, token, status, sent_at, visited_at, visit_count, created_at, updated_at`
+
+// InviteRepositoryInterface defines the database operations for inviting guests to a wishlist by email
+type InviteRepositoryInterface interface {
+	Prepare(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error)
+	SetStatus(ctx context.Context, id pgtype.UUID, status string) error
+	CountSentSince(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error)
+	ListByWishList(ctx context.Context, wishListID pgtype.UUID) ([]*models.WishListInvite, error)
+	RecordVisit(ctx context.Context, token pgtype.UUID) (*models.WishListInvite, error)
+	ListGuestInvitesByEmail(ctx context.Context, guestEmail string) ([]*models.WishListInvite, error)
+	DeleteGuestInvitesByEmail(ctx context.Context, guestEmail string) (int, error)
+}
+
+type InviteRepository struct {
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
+}
+
+func NewInviteRepository(db *database.DB) InviteRepositoryInterface {
+	return &InviteRepository{
+		db:                db,
+		encryptionEnabled: false,
+	}
+}
+
+// NewInviteRepositoryWithEncryption creates a new InviteRepository that stores
+// the invited guests' emails encrypted
+func NewInviteRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) InviteRepositoryInterface {
+	return &InviteRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
+// encryptInviteEmail returns the values stored for email: the plaintext, or with
+// encryption enabled its ciphertext and hash
+func (r *InviteRepository) encryptInviteEmail(ctx context.Context, email string) (plaintext, encrypted, hash pgtype.Text, err error) {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{String: email, Valid: true}, pgtype.Text{}, pgtype.Text{}, nil
+	}
+
+	ciphertext, err := r.encryptionSvc.Encrypt(ctx, email)
+	if err != nil {
+		return pgtype.Text{}, pgtype.Text{}, pgtype.Text{}, fmt.Errorf("failed to encrypt invite email: %w", err)
+	}
+	return pgtype.Text{}, pgtype.Text{String: ciphertext, Valid: true}, pgtype.Text{String: r.encryptionSvc.HashEmail(email), Valid: true}, nil
+}
+
+// decryptInviteEmail fills the invite's email from the encrypted column
+func (r *InviteRepository) decryptInviteEmail(ctx context.Context, invite *models.WishListInvite) error {
+	if !r.encryptionEnabled || r.encryptionSvc == nil || !invite.EncryptedEmail.Valid {
+		return nil
+	}
+
+	decrypted, err := r.encryptionSvc.Decrypt(ctx, invite.EncryptedEmail.String)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt invite email: %w", err)
+	}
+	invite.Email = decrypted
+
+	return nil
+}
+
+// Prepare returns the invites to send for emails, creating those that do not
+// exist yet and marking them pending. Addresses whose invite was sent after
+// resendBefore are left out, so repeated requests do not flood anyone's inbox.
+func (r *InviteRepository) Prepare(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	conflict := `(wishlist_id, email)`
+	if r.encryptionEnabled {
+		conflict = `(wishlist_id, email_hash) WHERE email_hash IS NOT NULL`
+	}
+
+	// An invite stored in plaintext before encryption was enabled is encrypted in place
+	legacyQuery := `
+		UPDATE wishlist_invites SET email = NULL, encrypted_email = $3, email_hash = $4
+		WHERE wishlist_id = $1 AND email_hash IS NULL AND email = $2
+	`
+
+	query := `
+		INSERT INTO wishlist_invites (wishlist_id, email, encrypted_email, email_hash)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT ` + conflict + ` DO UPDATE SET
+			status = 'pending',
+			updated_at = NOW()
+		WHERE wishlist_invites.status <> 'sent' OR wishlist_invites.sent_at < $5
+		RETURNING ` + inviteColumns
+
+	invites := make([]*models.WishListInvite, 0, len(emails))
+	for _, email := range emails {
+		plaintext, encrypted, hash, err := r.encryptInviteEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		if hash.Valid {
+			if _, err := tx.ExecContext(ctx, legacyQuery, wishListID, email, encrypted, hash); err != nil {
+				return nil, fmt.Errorf("failed to encrypt wishlist invite: %w", err)
+			}
+		}
+
+		var invite models.WishListInvite
+		err = tx.GetContext(ctx, &invite, query, wishListID, plaintext, encrypted, hash, resendBefore)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue // Sent recently
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare wishlist invite: %w", err)
+		}
+		if err := r.decryptInviteEmail(ctx, &invite); err != nil {
+			return nil, err
+		}
+		invites = append(invites, &invite)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit wishlist invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// emailHash returns the hash stored for guestEmail, or NULL when encryption is disabled
+func (r *InviteRepository) emailHash(guestEmail string) pgtype.Text {
+	if !r.encryptionEnabled || r.encryptionSvc == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: r.encryptionSvc.HashEmail(guestEmail), Valid: true}
+}
+
+// SetStatus records whether an invite email was sent
+func (r *InviteRepository) SetStatus(ctx context.Context, id pgtype.UUID, status string) error {
+	query := `
+		UPDATE wishlist_invites SET
+			status = $2,
+			sent_at = CASE WHEN $2 = 'sent' THEN NOW() ELSE sent_at END,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, status); err != nil {
+		return fmt.Errorf("failed to update wishlist invite status: %w", err)
+	}
+
+	return nil
+}
+
+// CountSentSince counts the invite emails sent since the given time for all of
+// the owner's wishlists
+func (r *InviteRepository) CountSentSince(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM wishlist_invites i
+		INNER JOIN wishlists w ON w.id = i.wishlist_id
+		WHERE w.owner_id = $1 AND i.sent_at >= $2
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, ownerID, since); err != nil {
+		return 0, fmt.Errorf("failed to count wishlist invites: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListByWishList returns the wishlist's invites, most recently invited first
+func (r *InviteRepository) ListByWishList(ctx context.Context, wishListID pgtype.UUID) ([]*models.WishListInvite, error) {
+	query := `SELECT ` + inviteColumns + `
+		FROM wishlist_invites
+		WHERE wishlist_id = $1
+		ORDER BY updated_at DESC, email, id`
+
+	var invites []*models.WishListInvite
+	if err := r.db.SelectContext(ctx, &invites, query, wishListID); err != nil {
+		return nil, fmt.Errorf("failed to get wishlist invites: %w", err)
+	}
+
+	for _, invite := range invites {
+		if err := r.decryptInviteEmail(ctx, invite); err != nil {
+			return nil, err
+		}
+	}
+
+	return invites, nil
+}
+
+// RecordVisit counts a visit through the invite link with the given token,
+// keeping the time of the first one
+func (r *InviteRepository) RecordVisit(ctx context.Context, token pgtype.UUID) (*models.WishListInvite, error) {
+	query := `
+		UPDATE wishlist_invites SET
+			visited_at = COALESCE(visited_at, NOW()),
+			visit_count = visit_count + 1
+		WHERE token = $1
+		RETURNING ` + inviteColumns
+
+	var invite models.WishListInvite
+	if err := r.db.GetContext(ctx, &invite, query, token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInviteNotFound
+		}
+		return nil, fmt.Errorf("failed to record wishlist invite visit: %w", err)
+	}
+	if err := r.decryptInviteEmail(ctx, &invite); err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// ListGuestInvitesByEmail returns every invite sent to the email, on any
+// wishlist, oldest first
+func (r *InviteRepository) ListGuestInvitesByEmail(ctx context.Context, guestEmail string) ([]*models.WishListInvite, error) {
+	query := `
+		SELECT ` + inviteColumns + `
+		FROM wishlist_invites
+		WHERE email = LOWER($1) OR email_hash = $2
+		ORDER BY created_at ASC
+	`
+
+	var invites []*models.WishListInvite
+	if err := r.db.SelectContext(ctx, &invites, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail)); err != nil {
+		return nil, fmt.Errorf("failed to list guest invites: %w", err)
+	}
+
+	for _, invite := range invites {
+		if err := r.decryptInviteEmail(ctx, invite); err != nil {
+			return nil, err
+		}
+	}
+
+	return invites, nil
+}
+
+// DeleteGuestInvitesByEmail deletes every invite sent to the email and returns
+// how many were removed
+func (r *InviteRepository) DeleteGuestInvitesByEmail(ctx context.Context, guestEmail string) (int, error) {
+	query := `DELETE FROM wishlist_invites WHERE email = LOWER($1) OR email_hash = $2`
+
+	result, err := r.db.ExecContext(ctx, query, strings.TrimSpace(guestEmail), r.emailHash(guestEmail))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete guest invites: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(deleted), nil
+}
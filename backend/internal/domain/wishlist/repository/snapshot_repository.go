@@ -0,0 +1,131 @@
+//go:generate go run github.com/matryer/moq@latest -out ../service/mock_snapshot_repository_test.go -pkg service . SnapshotRepositoryInterface
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/wishlist/models"
+)
+
+var ErrSnapshotNotFound = errors.New("wishlist snapshot not found")
+
+// snapshotColumns is the column list for wishlist_snapshots queries
+const snapshotColumns = `wishlist_id, snapshot_date, title, occasion, occasion_date, items, created_at`
+
+// SnapshotRepositoryInterface defines the database operations for wishlist snapshots
+type SnapshotRepositoryInterface interface {
+	Create(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error)
+	Get(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error)
+	List(ctx context.Context, wishListID pgtype.UUID) ([]*models.SnapshotSummary, error)
+	ListDueWishLists(ctx context.Context, from, to time.Time) ([]*models.WishList, error)
+}
+
+type SnapshotRepository struct {
+	db *database.DB
+}
+
+func NewSnapshotRepository(db *database.DB) SnapshotRepositoryInterface {
+	return &SnapshotRepository{
+		db: db,
+	}
+}
+
+// Create stores snapshot unless the wishlist already has one for its date, in
+// which case that one is returned. The bool reports whether snapshot was stored.
+func (r *SnapshotRepository) Create(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error) {
+	query := `
+		INSERT INTO wishlist_snapshots (wishlist_id, snapshot_date, title, occasion, occasion_date, items)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (wishlist_id, snapshot_date) DO NOTHING
+		RETURNING ` + snapshotColumns
+
+	var created models.Snapshot
+	err := r.db.GetContext(ctx, &created, query,
+		snapshot.WishListID,
+		snapshot.SnapshotDate,
+		snapshot.Title,
+		snapshot.Occasion,
+		snapshot.OccasionDate,
+		snapshot.Items,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		existing, err := r.Get(ctx, snapshot.WishListID, snapshot.SnapshotDate.Time)
+		return existing, false, err
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create wishlist snapshot: %w", err)
+	}
+
+	return &created, true, nil
+}
+
+// Get returns the wishlist's snapshot of date
+func (r *SnapshotRepository) Get(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error) {
+	query := `
+		SELECT ` + snapshotColumns + `
+		FROM wishlist_snapshots
+		WHERE wishlist_id = $1 AND snapshot_date = $2
+	`
+
+	var snapshot models.Snapshot
+	if err := r.db.GetContext(ctx, &snapshot, query, wishListID, pgtype.Date{Time: date, Valid: true}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist snapshot: %w", err)
+	}
+
+	return &snapshot, nil
+}
+
+// List returns the wishlist's snapshots without their items, newest first
+func (r *SnapshotRepository) List(ctx context.Context, wishListID pgtype.UUID) ([]*models.SnapshotSummary, error) {
+	query := `
+		SELECT snapshot_date, title, jsonb_array_length(items) AS item_count, created_at
+		FROM wishlist_snapshots
+		WHERE wishlist_id = $1
+		ORDER BY snapshot_date DESC
+	`
+
+	var snapshots []*models.SnapshotSummary
+	if err := r.db.SelectContext(ctx, &snapshots, query, wishListID); err != nil {
+		return nil, fmt.Errorf("failed to list wishlist snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// ListDueWishLists returns the wishlists whose occasion date lies between from
+// and to and that have no snapshot of that date yet. Lists merged into another
+// are left out, their items having moved.
+func (r *SnapshotRepository) ListDueWishLists(ctx context.Context, from, to time.Time) ([]*models.WishList, error) {
+	query := `
+		SELECT
+			w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count,
+			w.created_at, w.updated_at, w.version, w.recurrence, w.rolled_over_to_id, w.merged_into_id, w.discoverable, w.vanity_slug
+		FROM wishlists w
+		WHERE w.occasion_date BETWEEN $1 AND $2
+		  AND w.merged_into_id IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM wishlist_snapshots s
+			WHERE s.wishlist_id = w.id AND s.snapshot_date = w.occasion_date
+		  )
+		ORDER BY w.occasion_date ASC
+		LIMIT 500
+	`
+
+	var wishLists []*models.WishList
+	if err := r.db.SelectContext(ctx, &wishLists, query, pgtype.Date{Time: from, Valid: true}, pgtype.Date{Time: to, Valid: true}); err != nil {
+		return nil, fmt.Errorf("failed to list wishlists due for a snapshot: %w", err)
+	}
+
+	return wishLists, nil
+}
@@ -7,30 +7,84 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"wish-list/internal/app/database"
 	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/logger"
 )
 
 // Sentinel errors for wishlist repository
+// SlugReservationPeriod is how long a slug an owner stopped using stays
+// unavailable to other users
+const SlugReservationPeriod = 30 * 24 * time.Hour
+
 var (
-	ErrWishListNotFound = errors.New("wishlist not found")
+	ErrWishListNotFound        = errors.New("wishlist not found")
+	ErrWishListVersionConflict = errors.New("wishlist was modified by another request")
+	ErrWishListRolledOver      = errors.New("wishlist was already rolled over")
+	ErrWishListMerged          = errors.New("wishlist was already merged into another")
+	ErrInvalidSortField        = errors.New("invalid sort field")
+	ErrInvalidFilter           = errors.New("invalid filter")
 )
 
+// WishListFilters narrows and orders the owner's wishlists
+type WishListFilters struct {
+	Sort   string // created_at (default), occasion_date, title
+	Filter string // upcoming, past, no_date; empty for all
+}
+
+// validWishListSorts maps sort values to ORDER BY clauses. occasion_date lists the
+// next occasion first: upcoming dates ascending, then past dates most recent first,
+// then wishlists without a date.
+var validWishListSorts = map[string]string{
+	"created_at": "w.created_at DESC",
+	"occasion_date": `w.occasion_date IS NULL,
+			w.occasion_date < CURRENT_DATE,
+			CASE WHEN w.occasion_date >= CURRENT_DATE THEN w.occasion_date END ASC,
+			w.occasion_date DESC,
+			w.created_at DESC`,
+	"title": "LOWER(w.title) ASC, w.created_at DESC",
+}
+
+// validWishListFilters maps filter values to WHERE conditions
+var validWishListFilters = map[string]string{
+	"upcoming": "w.occasion_date >= CURRENT_DATE",
+	"past":     "w.occasion_date < CURRENT_DATE",
+	"no_date":  "w.occasion_date IS NULL",
+}
+
+// MergeOptions says what a merge does with the source wishlist
+type MergeOptions struct {
+	Move    bool // Take the items off the source; otherwise they stay on both lists
+	Archive bool // Mark the source as merged and unpublish it
+}
+
 // WishListRepositoryInterface defines the interface for wishlist database operations
 type WishListRepositoryInterface interface {
 	Create(ctx context.Context, wishList models.WishList) (*models.WishList, error)
 	GetByID(ctx context.Context, id pgtype.UUID) (*models.WishList, error)
 	GetByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishList, error)
 	GetByPublicSlug(ctx context.Context, publicSlug string) (*models.WishList, error)
-	GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishListWithItemCount, error)
-	IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error)
+	GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID, filters WishListFilters) ([]*models.WishListWithItemCount, error)
+	IsSlugTaken(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error)
+	GetByRetiredSlug(ctx context.Context, slug string) (*models.WishList, error)
+	IsSlugClosed(ctx context.Context, slug string) (bool, error)
+	GetByVanitySlug(ctx context.Context, username, slug string) (*models.WishList, error)
+	IsVanitySlugTaken(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error)
+	ListPublicWithInvalidSlug(ctx context.Context) ([]*models.WishList, error)
+	ListExpiredPublic(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error)
+	ClosePublicSlug(ctx context.Context, id pgtype.UUID) error
 	Update(ctx context.Context, wishList models.WishList) (*models.WishList, error)
 	Delete(ctx context.Context, id pgtype.UUID) error
 	DeleteWithExecutor(ctx context.Context, executor database.Executor, id pgtype.UUID) error
-	IncrementViewCount(ctx context.Context, id pgtype.UUID) error
+	AddViews(ctx context.Context, id pgtype.UUID, views int64) error
+	ListDueForRollover(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error)
+	RollOver(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error)
+	RollOverUnpurchased(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error)
+	Merge(ctx context.Context, sourceID, targetID pgtype.UUID, opts MergeOptions) (int64, error)
 }
 
 type WishListRepository struct {
@@ -47,11 +101,11 @@ func NewWishListRepository(db *database.DB) WishListRepositoryInterface {
 func (r *WishListRepository) Create(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
 	query := `
 		INSERT INTO wishlists (
-			owner_id, title, description, occasion, occasion_date, is_public, public_slug
+			owner_id, title, description, occasion, occasion_date, is_public, public_slug, recurrence, discoverable, vanity_slug
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		) RETURNING
-			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
 	`
 
 	var createdWishList models.WishList
@@ -63,6 +117,9 @@ func (r *WishListRepository) Create(ctx context.Context, wishList models.WishLis
 		wishList.OccasionDate,
 		wishList.IsPublic,
 		wishList.PublicSlug, // Pass pgtype.Text directly to preserve NULL
+		wishList.Recurrence,
+		wishList.Discoverable,
+		wishList.VanitySlug,
 	).StructScan(&createdWishList)
 
 	if err != nil {
@@ -76,7 +133,7 @@ func (r *WishListRepository) Create(ctx context.Context, wishList models.WishLis
 func (r *WishListRepository) GetByID(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
 	query := `
 		SELECT
-			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
 		FROM wishlists
 		WHERE id = $1
 	`
@@ -97,13 +154,14 @@ func (r *WishListRepository) GetByID(ctx context.Context, id pgtype.UUID) (*mode
 func (r *WishListRepository) GetByPublicSlug(ctx context.Context, publicSlug string) (*models.WishList, error) {
 	query := `
 		SELECT
-			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
 		FROM wishlists
 		WHERE public_slug = $1 AND is_public = true
 	`
 
+	// Public pages tolerate replication lag
 	var wishList models.WishList
-	err := r.db.GetContext(ctx, &wishList, query, publicSlug)
+	err := r.db.Reader().GetContext(ctx, &wishList, query, publicSlug)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrWishListNotFound
@@ -118,7 +176,7 @@ func (r *WishListRepository) GetByPublicSlug(ctx context.Context, publicSlug str
 func (r *WishListRepository) GetByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishList, error) {
 	query := `
 		SELECT
-			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
 		FROM wishlists
 		WHERE owner_id = $1
 		ORDER BY created_at DESC
@@ -134,42 +192,240 @@ func (r *WishListRepository) GetByOwner(ctx context.Context, ownerID pgtype.UUID
 	return wishLists, nil
 }
 
-// Update modifies an existing wishlist
-// IsSlugTaken reports whether the given public slug is already used by another wishlist.
-// excludeID is the wishlist being updated so its own slug does not count as a conflict.
-func (r *WishListRepository) IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+// IsSlugTaken reports whether the given public slug is already used by another wishlist,
+// or was retired by another owner less than SlugReservationPeriod ago.
+// excludeID is the wishlist being updated so its own slug does not count as a conflict;
+// ownerID may reclaim slugs they retired. Either may be invalid when unknown.
+func (r *WishListRepository) IsSlugTaken(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM wishlists WHERE public_slug = $1 AND id IS DISTINCT FROM $2
+		) OR EXISTS(
+			SELECT 1 FROM wishlist_slug_history
+			WHERE slug = $1 AND retired_at > $3 AND owner_id IS DISTINCT FROM $4
+		)
+	`
+
 	var exists bool
+	err := r.db.GetContext(ctx, &exists, query, slug, excludeID, time.Now().Add(-SlugReservationPeriod), ownerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check slug uniqueness: %w", err)
+	}
+	return exists, nil
+}
 
-	if excludeID.Valid {
-		query := `SELECT EXISTS(SELECT 1 FROM wishlists WHERE public_slug = $1 AND id != $2)`
-		err := r.db.GetContext(ctx, &exists, query, slug, excludeID)
-		if err != nil {
-			return false, fmt.Errorf("failed to check slug uniqueness: %w", err)
+// GetByRetiredSlug retrieves the public wishlist that used to be published under
+// the given slug
+func (r *WishListRepository) GetByRetiredSlug(ctx context.Context, slug string) (*models.WishList, error) {
+	query := `
+		SELECT
+			w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at, w.version, w.recurrence, w.rolled_over_to_id, w.merged_into_id, w.discoverable, w.vanity_slug
+		FROM wishlist_slug_history h
+		JOIN wishlists w ON w.id = h.wishlist_id
+		WHERE h.slug = $1 AND w.is_public = true AND w.public_slug IS NOT NULL
+	`
+
+	var wishList models.WishList
+	err := r.db.Reader().GetContext(ctx, &wishList, query, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWishListNotFound
 		}
-		return exists, nil
+		return nil, fmt.Errorf("failed to get wishlist by retired slug: %w", err)
+	}
+
+	return &wishList, nil
+}
+
+// IsSlugClosed reports whether the given slug belonged to a wishlist whose public
+// link was closed by ClosePublicSlug and that has not been published again
+func (r *WishListRepository) IsSlugClosed(ctx context.Context, slug string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM wishlist_slug_history h
+			JOIN wishlists w ON w.id = h.wishlist_id
+			WHERE h.slug = $1 AND w.closed_at IS NOT NULL AND w.is_public = false
+		)
+	`
+
+	var closed bool
+	if err := r.db.Reader().GetContext(ctx, &closed, query, slug); err != nil {
+		return false, fmt.Errorf("failed to check closed slug: %w", err)
 	}
+	return closed, nil
+}
 
-	query := `SELECT EXISTS(SELECT 1 FROM wishlists WHERE public_slug = $1)`
-	err := r.db.GetContext(ctx, &exists, query, slug)
+// GetByVanitySlug retrieves the public wishlist published under slug by the user
+// with the given username. A username the user gave up still resolves unless
+// another user has taken it since.
+func (r *WishListRepository) GetByVanitySlug(ctx context.Context, username, slug string) (*models.WishList, error) {
+	query := `
+		SELECT
+			w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at, w.version, w.recurrence, w.rolled_over_to_id, w.merged_into_id, w.discoverable, w.vanity_slug
+		FROM wishlists w
+		JOIN users u ON u.id = w.owner_id
+		WHERE (u.username = $1 OR EXISTS (
+				SELECT 1 FROM username_history h WHERE h.username = $1 AND h.user_id = u.id
+			))
+		  AND u.deactivated_at IS NULL
+		  AND w.vanity_slug = $2 AND w.is_public = true AND w.public_slug IS NOT NULL
+		ORDER BY (u.username = $1) IS TRUE DESC
+		LIMIT 1
+	`
+
+	var wishList models.WishList
+	err := r.db.Reader().GetContext(ctx, &wishList, query, username, slug)
 	if err != nil {
-		return false, fmt.Errorf("failed to check slug uniqueness: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWishListNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist by vanity slug: %w", err)
+	}
+
+	return &wishList, nil
+}
+
+// IsVanitySlugTaken reports whether another wishlist of the owner uses the vanity slug.
+// excludeID is the wishlist being updated and may be invalid when unknown.
+func (r *WishListRepository) IsVanitySlugTaken(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM wishlists WHERE owner_id = $1 AND vanity_slug = $2 AND id IS DISTINCT FROM $3)`
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, ownerID, slug, excludeID); err != nil {
+		return false, fmt.Errorf("failed to check vanity slug uniqueness: %w", err)
 	}
 	return exists, nil
 }
 
+// ListPublicWithInvalidSlug retrieves public wishlists whose slug is missing or
+// contains characters other than lowercase letters, digits and hyphens
+func (r *WishListRepository) ListPublicWithInvalidSlug(ctx context.Context) ([]*models.WishList, error) {
+	query := `
+		SELECT
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+		FROM wishlists
+		WHERE is_public = true
+		  AND (public_slug IS NULL OR public_slug !~ '^[a-z0-9-]+$')
+		ORDER BY created_at ASC
+	`
+
+	var wishLists []*models.WishList
+	if err := r.db.SelectContext(ctx, &wishLists, query); err != nil {
+		return nil, fmt.Errorf("failed to list wishlists with invalid slugs: %w", err)
+	}
+
+	return wishLists, nil
+}
+
+// ListExpiredPublic retrieves wishlists still holding a public slug whose occasion
+// date is on or before occasionBefore and that will not come round again: one-off
+// lists, and recurring lists already rolled over or merged into another
+func (r *WishListRepository) ListExpiredPublic(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+	query := `
+		SELECT
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+		FROM wishlists
+		WHERE public_slug <> ''
+		  AND closed_at IS NULL
+		  AND occasion_date <= $1
+		  AND (recurrence IS NULL OR rolled_over_to_id IS NOT NULL OR merged_into_id IS NOT NULL)
+		ORDER BY occasion_date ASC
+		LIMIT 500
+	`
+
+	var wishLists []*models.WishList
+	if err := r.db.SelectContext(ctx, &wishLists, query, occasionBefore); err != nil {
+		return nil, fmt.Errorf("failed to list expired public wishlists: %w", err)
+	}
+
+	return wishLists, nil
+}
+
+// ClosePublicSlug unpublishes a wishlist and moves its public slug to the slug
+// history, marking the list closed so the old link can say so. The vanity slug is
+// released too. ErrWishListNotFound is returned when the wishlist has no public
+// slug or was closed already.
+func (r *WishListRepository) ClosePublicSlug(ctx context.Context, id pgtype.UUID) error {
+	query := `
+		WITH previous AS (
+			SELECT id, owner_id, public_slug FROM wishlists
+			WHERE id = $1 AND public_slug <> '' AND closed_at IS NULL
+			FOR UPDATE
+		), closed AS (
+			UPDATE wishlists w SET
+				is_public = false,
+				public_slug = NULL,
+				vanity_slug = NULL,
+				closed_at = NOW(),
+				version = version + 1,
+				updated_at = NOW()
+			FROM previous
+			WHERE w.id = previous.id
+			RETURNING w.id
+		)
+		INSERT INTO wishlist_slug_history (slug, wishlist_id, owner_id)
+		SELECT previous.public_slug, previous.id, previous.owner_id
+		FROM previous, closed
+		ON CONFLICT (slug) DO UPDATE SET
+			wishlist_id = EXCLUDED.wishlist_id,
+			owner_id = EXCLUDED.owner_id,
+			retired_at = NOW()
+		RETURNING wishlist_id
+	`
+
+	var closedID pgtype.UUID
+	if err := r.db.GetContext(ctx, &closedID, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrWishListNotFound
+		}
+		return fmt.Errorf("failed to close public slug: %w", err)
+	}
+
+	return nil
+}
+
+// Update modifies an existing wishlist. The write only applies when the stored version
+// still equals wishList.Version; otherwise ErrWishListVersionConflict is returned.
+// A slug the wishlist stops using is recorded in its slug history so old links keep
+// resolving; a slug it takes back is removed from the history.
 func (r *WishListRepository) Update(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
 	query := `
-		UPDATE wishlists SET
-			title = $2,
-			description = $3,
-			occasion = $4,
-			occasion_date = $5,
-			is_public = $6,
-			public_slug = $7,
-			updated_at = NOW()
-		WHERE id = $1
-		RETURNING
-			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at
+		WITH previous AS (
+			SELECT public_slug FROM wishlists WHERE id = $1 AND version = $8
+		), updated AS (
+			UPDATE wishlists SET
+				title = $2,
+				description = $3,
+				occasion = $4,
+				occasion_date = $5,
+				is_public = $6,
+				public_slug = $7,
+				recurrence = $9,
+				discoverable = $10,
+				vanity_slug = $11,
+				closed_at = CASE WHEN $6 THEN NULL ELSE closed_at END,
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1 AND version = $8
+			RETURNING
+				id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+		), retired AS (
+			INSERT INTO wishlist_slug_history (slug, wishlist_id, owner_id)
+			SELECT previous.public_slug, updated.id, updated.owner_id
+			FROM previous, updated
+			WHERE previous.public_slug <> ''
+			  AND previous.public_slug IS DISTINCT FROM updated.public_slug
+			ON CONFLICT (slug) DO UPDATE SET
+				wishlist_id = EXCLUDED.wishlist_id,
+				owner_id = EXCLUDED.owner_id,
+				retired_at = NOW()
+		), reclaimed AS (
+			DELETE FROM wishlist_slug_history h
+			USING updated
+			WHERE h.slug = updated.public_slug
+		)
+		SELECT * FROM updated
 	`
 
 	var updatedWishList models.WishList
@@ -181,11 +437,23 @@ func (r *WishListRepository) Update(ctx context.Context, wishList models.WishLis
 		wishList.OccasionDate,
 		wishList.IsPublic,
 		wishList.PublicSlug, // Pass pgtype.Text directly to preserve NULL
+		wishList.Version,
+		wishList.Recurrence,
+		wishList.Discoverable,
+		wishList.VanitySlug,
 	).StructScan(&updatedWishList)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrWishListNotFound
+			existsQuery := `SELECT EXISTS(SELECT 1 FROM wishlists WHERE id = $1)`
+			var exists bool
+			if existsErr := r.db.GetContext(ctx, &exists, existsQuery, wishList.ID); existsErr != nil {
+				return nil, fmt.Errorf("failed to verify wishlist existence: %w", existsErr)
+			}
+			if !exists {
+				return nil, ErrWishListNotFound
+			}
+			return nil, ErrWishListVersionConflict
 		}
 		return nil, fmt.Errorf("failed to update wishlist: %w", err)
 	}
@@ -219,13 +487,21 @@ func (r *WishListRepository) DeleteWithExecutor(ctx context.Context, executor da
 	return nil
 }
 
-// IncrementViewCount increases the view count for a wishlist
-func (r *WishListRepository) IncrementViewCount(ctx context.Context, id pgtype.UUID) error {
-	query := `UPDATE wishlists SET view_count = view_count + 1 WHERE id = $1`
+// AddViews adds views to the view count of a wishlist and to today's entry in its
+// daily view history
+func (r *WishListRepository) AddViews(ctx context.Context, id pgtype.UUID, views int64) error {
+	query := `
+		WITH viewed AS (
+			UPDATE wishlists SET view_count = view_count + $2 WHERE id = $1 RETURNING id
+		)
+		INSERT INTO wishlist_daily_views (wishlist_id, day, views)
+		SELECT id, CURRENT_DATE, $2 FROM viewed
+		ON CONFLICT (wishlist_id, day) DO UPDATE SET views = wishlist_daily_views.views + EXCLUDED.views
+	`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, id, views)
 	if err != nil {
-		return fmt.Errorf("failed to increment view count: %w", err)
+		return fmt.Errorf("failed to add views: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -240,20 +516,39 @@ func (r *WishListRepository) IncrementViewCount(ctx context.Context, id pgtype.U
 	return nil
 }
 
-// GetByOwnerWithItemCount retrieves wishlists by owner ID with item counts in a single query
-func (r *WishListRepository) GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishListWithItemCount, error) {
-	query := `
+// GetByOwnerWithItemCount retrieves wishlists by owner ID with item counts in a single query,
+// filtered and sorted by filters
+func (r *WishListRepository) GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID, filters WishListFilters) ([]*models.WishListWithItemCount, error) {
+	sort := filters.Sort
+	if sort == "" {
+		sort = "created_at"
+	}
+	orderClause, ok := validWishListSorts[sort]
+	if !ok {
+		return nil, ErrInvalidSortField
+	}
+
+	whereClause := "w.owner_id = $1"
+	if filters.Filter != "" {
+		condition, ok := validWishListFilters[filters.Filter]
+		if !ok {
+			return nil, ErrInvalidFilter
+		}
+		whereClause += " AND " + condition
+	}
+
+	query := fmt.Sprintf(`
 		SELECT
-			w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at,
+			w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at, w.version, w.recurrence, w.rolled_over_to_id, w.merged_into_id, w.discoverable, w.vanity_slug,
 			COUNT(gi.id) AS item_count
 		FROM wishlists w
 		LEFT JOIN wishlist_items wi ON wi.wishlist_id = w.id
 		LEFT JOIN gift_items gi ON gi.id = wi.gift_item_id AND gi.archived_at IS NULL
-		WHERE w.owner_id = $1
-		GROUP BY w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at
-		ORDER BY w.created_at DESC
+		WHERE %s
+		GROUP BY w.id, w.owner_id, w.title, w.description, w.occasion, w.occasion_date, w.is_public, w.public_slug, w.view_count, w.created_at, w.updated_at, w.version, w.recurrence, w.rolled_over_to_id, w.merged_into_id, w.discoverable, w.vanity_slug
+		ORDER BY %s
 		LIMIT 100
-	`
+	`, whereClause, orderClause)
 
 	var wishLists []*models.WishListWithItemCount
 	err := r.db.SelectContext(ctx, &wishLists, query, ownerID)
@@ -263,3 +558,253 @@ func (r *WishListRepository) GetByOwnerWithItemCount(ctx context.Context, ownerI
 
 	return wishLists, nil
 }
+
+// ListDueForRollover retrieves recurring wishlists that have not been rolled over yet and
+// whose occasion date is on or before occasionBefore
+func (r *WishListRepository) ListDueForRollover(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+	query := `
+		SELECT
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+		FROM wishlists
+		WHERE recurrence IS NOT NULL
+		  AND rolled_over_to_id IS NULL
+		  AND merged_into_id IS NULL
+		  AND occasion_date <= $1
+		ORDER BY occasion_date ASC
+		LIMIT 500
+	`
+
+	var wishLists []*models.WishList
+	if err := r.db.SelectContext(ctx, &wishLists, query, occasionBefore); err != nil {
+		return nil, fmt.Errorf("failed to list wishlists due for rollover: %w", err)
+	}
+
+	return wishLists, nil
+}
+
+// RollOver creates next as the following occurrence of the source wishlist, links every
+// active item of the source that has not been purchased to it, and marks the source as
+// rolled over. Returns the new wishlist and the number of items carried over.
+// ErrWishListRolledOver is returned when the source was rolled over concurrently.
+func (r *WishListRepository) RollOver(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+	return r.rollOver(ctx, sourceID, next, false)
+}
+
+// RollOverUnpurchased is RollOver for items nobody gave: items that are reserved, including
+// manually, or were bought stay behind. Reservation leftovers on the carried items, such as
+// the holder of a reservation that expired, are cleared so they start out available.
+func (r *WishListRepository) RollOverUnpurchased(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+	return r.rollOver(ctx, sourceID, next, true)
+}
+
+func (r *WishListRepository) rollOver(ctx context.Context, sourceID pgtype.UUID, next models.WishList, unreservedOnly bool) (*models.WishList, int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	// The vanity slug moves on to the next occurrence, so the owner's vanity link
+	// keeps pointing at the current list
+	vanityQuery := `
+		WITH source AS (
+			SELECT id, vanity_slug FROM wishlists WHERE id = $1 FOR UPDATE
+		)
+		UPDATE wishlists w SET vanity_slug = NULL
+		FROM source
+		WHERE w.id = source.id
+		RETURNING source.vanity_slug
+	`
+	if err := tx.GetContext(ctx, &next.VanitySlug, vanityQuery, sourceID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, fmt.Errorf("failed to release vanity slug: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO wishlists (
+			owner_id, title, description, occasion, occasion_date, is_public, public_slug, recurrence, discoverable, vanity_slug
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		) RETURNING
+			id, owner_id, title, description, occasion, occasion_date, is_public, public_slug, view_count, created_at, updated_at, version, recurrence, rolled_over_to_id, merged_into_id, discoverable, vanity_slug
+	`
+
+	var created models.WishList
+	err = tx.QueryRowxContext(ctx, insertQuery,
+		next.OwnerID,
+		next.Title,
+		database.TextToString(next.Description),
+		database.TextToString(next.Occasion),
+		next.OccasionDate,
+		next.IsPublic,
+		next.PublicSlug,
+		next.Recurrence,
+		next.Discoverable,
+		next.VanitySlug,
+	).StructScan(&created)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create next occurrence: %w", err)
+	}
+
+	markQuery := `
+		UPDATE wishlists SET rolled_over_to_id = $2, updated_at = NOW()
+		WHERE id = $1 AND rolled_over_to_id IS NULL
+	`
+	result, err := tx.ExecContext(ctx, markQuery, sourceID, created.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to mark wishlist as rolled over: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, 0, ErrWishListRolledOver
+	}
+
+	carryQuery := `
+		INSERT INTO wishlist_items (wishlist_id, gift_item_id)
+		SELECT $2, wi.gift_item_id
+		FROM wishlist_items wi
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = $1
+		  AND gi.archived_at IS NULL
+		  AND gi.purchased_at IS NULL
+	`
+	if unreservedOnly {
+		carryQuery += `
+		  AND gi.purchased_by_user_id IS NULL
+		  AND gi.manual_reserved_at IS NULL
+		  AND gi.manual_reserved_by_name IS NULL
+		  AND gi.encrypted_manual_reserved_by_name IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM reservations r
+			WHERE r.gift_item_id = gi.id AND r.status IN ('active', 'fulfilled')
+		  )
+		`
+	}
+	result, err = tx.ExecContext(ctx, carryQuery, sourceID, created.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to carry over items: %w", err)
+	}
+	carried, err := result.RowsAffected()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if unreservedOnly {
+		// Nothing on the new list is reserved, so any holder or count left behind is stale
+		clearQuery := `
+			UPDATE gift_items gi SET
+				reserved_by_user_id = NULL,
+				reserved_at = NULL,
+				reserved_quantity = 0,
+				version = version + 1,
+				updated_at = NOW()
+			FROM wishlist_items wi
+			WHERE wi.wishlist_id = $1
+			  AND wi.gift_item_id = gi.id
+			  AND (gi.reserved_by_user_id IS NOT NULL OR gi.reserved_at IS NOT NULL OR gi.reserved_quantity <> 0)
+		`
+		if _, err := tx.ExecContext(ctx, clearQuery, created.ID); err != nil {
+			return nil, 0, fmt.Errorf("failed to clear stale reservations: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, fmt.Errorf("failed to commit rollover: %w", err)
+	}
+
+	return &created, carried, nil
+}
+
+// Merge links every active item of the source wishlist to the target in one transaction.
+// Items already on the target are skipped. Moving also takes the items off the source and
+// carries their reservations and comments along, so givers keep what they reserved.
+// Returns the number of items newly added to the target. ErrWishListMerged is returned
+// when the source is archived but was merged concurrently.
+func (r *WishListRepository) Merge(ctx context.Context, sourceID, targetID pgtype.UUID, opts MergeOptions) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			logger.WarnContext(ctx, "transaction rollback error", "error", rbErr)
+		}
+	}()
+
+	// Keeping added_at preserves the order the owner added the items in
+	copyQuery := `
+		INSERT INTO wishlist_items (wishlist_id, gift_item_id, added_at)
+		SELECT $2, wi.gift_item_id, wi.added_at
+		FROM wishlist_items wi
+		JOIN gift_items gi ON gi.id = wi.gift_item_id
+		WHERE wi.wishlist_id = $1
+		  AND gi.archived_at IS NULL
+		ON CONFLICT (wishlist_id, gift_item_id) DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, copyQuery, sourceID, targetID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge items: %w", err)
+	}
+	merged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if opts.Move {
+		movedItems := `
+			SELECT wi.gift_item_id
+			FROM wishlist_items wi
+			JOIN gift_items gi ON gi.id = wi.gift_item_id
+			WHERE wi.wishlist_id = $1
+			  AND gi.archived_at IS NULL
+		`
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE reservations SET wishlist_id = $2, updated_at = NOW()
+			WHERE wishlist_id = $1 AND gift_item_id IN (`+movedItems+`)
+		`, sourceID, targetID); err != nil {
+			return 0, fmt.Errorf("failed to move reservations: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE item_comments SET wishlist_id = $2
+			WHERE wishlist_id = $1 AND gift_item_id IN (`+movedItems+`)
+		`, sourceID, targetID); err != nil {
+			return 0, fmt.Errorf("failed to move comments: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM wishlist_items
+			WHERE wishlist_id = $1 AND gift_item_id IN (`+movedItems+`)
+		`, sourceID); err != nil {
+			return 0, fmt.Errorf("failed to detach moved items: %w", err)
+		}
+	}
+
+	if opts.Archive {
+		archiveQuery := `
+			UPDATE wishlists SET merged_into_id = $2, is_public = false, version = version + 1, updated_at = NOW()
+			WHERE id = $1 AND merged_into_id IS NULL
+		`
+		result, err := tx.ExecContext(ctx, archiveQuery, sourceID, targetID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to archive merged wishlist: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return 0, ErrWishListMerged
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit merge: %w", err)
+	}
+
+	return merged, nil
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -160,6 +162,20 @@ func TestWishListRepository_GetByOwner(t *testing.T) {
 	})
 }
 
+func TestWishListRepository_GetByOwnerWithItemCount_RejectsUnknownOptions(t *testing.T) {
+	repo := &WishListRepository{}
+
+	_, err := repo.GetByOwnerWithItemCount(context.Background(), pgtype.UUID{Valid: true}, WishListFilters{Sort: "price"})
+	if !errors.Is(err, ErrInvalidSortField) {
+		t.Errorf("expected ErrInvalidSortField, got %v", err)
+	}
+
+	_, err = repo.GetByOwnerWithItemCount(context.Background(), pgtype.UUID{Valid: true}, WishListFilters{Filter: "soon"})
+	if !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("expected ErrInvalidFilter, got %v", err)
+	}
+}
+
 func TestWishListRepository_Update(t *testing.T) {
 	t.Run("update wishlist preserves ID", func(t *testing.T) {
 		originalID := pgtype.UUID{Valid: true}
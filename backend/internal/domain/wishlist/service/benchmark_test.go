@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// benchmarkPageSize is the number of gift items on a benchmarked public page
+const benchmarkPageSize = 50
+
+func benchmarkPublicWishList() *wishlistmodels.WishList {
+	now := time.Now().UTC()
+	return &wishlistmodels.WishList{
+		ID:           pgtype.UUID{Bytes: [16]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}, Valid: true},
+		OwnerID:      pgtype.UUID{Bytes: [16]byte{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8}, Valid: true},
+		Title:        "Birthday",
+		Description:  pgtype.Text{String: "Things I would love this year", Valid: true},
+		Occasion:     pgtype.Text{String: "birthday", Valid: true},
+		OccasionDate: pgtype.Date{Time: now.AddDate(0, 1, 0), Valid: true},
+		IsPublic:     pgtype.Bool{Bool: true, Valid: true},
+		PublicSlug:   pgtype.Text{String: "benchmark-birthday", Valid: true},
+		ViewCount:    pgtype.Int4{Int32: 42, Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+		Version:      3,
+	}
+}
+
+func benchmarkGiftItems(n int) []*itemmodels.GiftItem {
+	now := time.Now().UTC()
+	items := make([]*itemmodels.GiftItem, 0, n)
+	for i := range n {
+		item := &itemmodels.GiftItem{
+			ID:          pgtype.UUID{Bytes: [16]byte{1, byte(i >> 8), byte(i)}, Valid: true},
+			OwnerID:     pgtype.UUID{Bytes: [16]byte{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8}, Valid: true},
+			Name:        "Gift",
+			Description: pgtype.Text{String: "A description long enough to look like a real one", Valid: true},
+			Link:        pgtype.Text{String: "https://example.com/gift", Valid: true},
+			ImageUrl:    pgtype.Text{String: "https://example.com/gift.jpg", Valid: true},
+			Price:       pgtype.Numeric{Int: big.NewInt(int64(1999 + i)), Exp: -2, Valid: true},
+			Priority:    pgtype.Int4{Int32: int32(i % 10), Valid: true}, //nolint:gosec // Bounded by the modulo
+			CreatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
+			UpdatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
+		}
+		// Every third item is reserved, as on a typical shared list
+		if i%3 == 0 {
+			item.ReservedAt = pgtype.Timestamptz{Time: now, Valid: true}
+			item.ReservedQuantity = 1
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func BenchmarkWishListService_GetWishListByPublicSlug(b *testing.B) {
+	wishList := benchmarkPublicWishList()
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return wishList, nil
+		},
+	}
+	svc := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := svc.GetWishListByPublicSlug(ctx, "benchmark-birthday"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWishListService_GetGiftItemsByPublicSlugPaginated(b *testing.B) {
+	wishList := benchmarkPublicWishList()
+	items := benchmarkGiftItems(benchmarkPageSize)
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return wishList, nil
+		},
+	}
+	mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit, offset int) ([]*itemmodels.GiftItem, int, error) {
+			return items, len(items), nil
+		},
+	}
+	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, _, err := svc.GetGiftItemsByPublicSlugPaginated(ctx, "benchmark-birthday", benchmarkPageSize, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
 
+	"wish-list/internal/domain/item/mapper"
 	itemmodels "wish-list/internal/domain/item/models"
 	wishlistmodels "wish-list/internal/domain/wishlist/models"
 
@@ -101,7 +103,7 @@ func TestWishListService_CreateGiftItem(t *testing.T) {
 				}
 			}
 
-			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil)
+			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := service.CreateGiftItem(context.Background(), tt.wishlistID, tt.input)
 
@@ -180,7 +182,7 @@ func TestWishListService_GetGiftItem(t *testing.T) {
 				}
 			}
 
-			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil)
+			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := service.GetGiftItem(context.Background(), tt.giftItemID)
 
@@ -241,7 +243,7 @@ func TestWishListService_GetGiftItemsByPublicSlugPaginated_MapsGuestReservationS
 		},
 	}
 
-	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil)
+	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	items, total, err := svc.GetGiftItemsByPublicSlugPaginated(context.Background(), "public-slug", 10, 0)
 	require.NoError(t, err)
@@ -250,3 +252,130 @@ func TestWishListService_GetGiftItemsByPublicSlugPaginated_MapsGuestReservationS
 	assert.NotEmpty(t, items[0].ReservedAt)
 	assert.Empty(t, items[0].ReservedByUserID)
 }
+
+func TestWishListService_GetGiftItemsByPublicSlugPaginated_Images(t *testing.T) {
+	galleryItemID := pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+	legacyItemID := pgtype.UUID{Bytes: [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, Valid: true}
+	bareItemID := pgtype.UUID{Bytes: [16]byte{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, Valid: true}
+
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return &wishlistmodels.WishList{
+				ID:       pgtype.UUID{Bytes: [16]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}, Valid: true},
+				IsPublic: pgtype.Bool{Bool: true, Valid: true},
+			}, nil
+		},
+	}
+	mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit, offset int) ([]*itemmodels.GiftItem, int, error) {
+			return []*itemmodels.GiftItem{
+				{ID: galleryItemID, Name: "Gallery", ImageUrl: pgtype.Text{String: "https://example.com/a.jpg", Valid: true}},
+				{ID: legacyItemID, Name: "Legacy", ImageUrl: pgtype.Text{String: "https://example.com/legacy.jpg", Valid: true}},
+				{ID: bareItemID, Name: "No image"},
+			}, 3, nil
+		},
+	}
+	mockImages := &GiftItemImageRepositoryInterfaceMock{
+		GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*itemmodels.GiftItemImage, error) {
+			assert.Equal(t, []pgtype.UUID{galleryItemID, legacyItemID, bareItemID}, itemIDs)
+			return map[string][]*itemmodels.GiftItemImage{
+				galleryItemID.String(): {
+					{GiftItemID: galleryItemID, URL: "https://example.com/a.jpg", Position: 0},
+					{GiftItemID: galleryItemID, URL: "https://example.com/b.jpg", Position: 1},
+				},
+			}, nil
+		},
+	}
+
+	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, mockImages, nil, nil, nil)
+
+	items, _, err := svc.GetGiftItemsByPublicSlugPaginated(context.Background(), "public-slug", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Len(t, mockImages.GetByItemIDsCalls(), 1, "galleries are loaded in one batch")
+	assert.Equal(t, []string{"https://example.com/a.jpg", "https://example.com/b.jpg"}, mapper.ImageURLs(items[0].Images))
+	assert.Equal(t, "https://example.com/a.jpg", items[0].ImageURL)
+	assert.Equal(t, []string{"https://example.com/legacy.jpg"}, mapper.ImageURLs(items[1].Images))
+	assert.Empty(t, items[2].Images)
+}
+
+func TestWishListService_GetGiftItemsByPublicSlugPaginated_RecordsViews(t *testing.T) {
+	firstItemID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	secondItemID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return &wishlistmodels.WishList{ID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}}, nil
+		},
+	}
+	mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit, offset int) ([]*itemmodels.GiftItem, int, error) {
+			return []*itemmodels.GiftItem{{ID: firstItemID, Name: "First"}, {ID: secondItemID, Name: "Second"}}, 2, nil
+		},
+	}
+	mockActivity := &ItemActivityRecorderInterfaceMock{
+		RecordItemViewsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) error {
+			return errors.New("db down")
+		},
+	}
+
+	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockActivity, nil)
+
+	items, _, err := svc.GetGiftItemsByPublicSlugPaginated(context.Background(), "public-slug", 10, 0)
+	require.NoError(t, err, "a failure to count views does not fail the page")
+	assert.Len(t, items, 2)
+	require.Len(t, mockActivity.RecordItemViewsCalls(), 1)
+	assert.Equal(t, []pgtype.UUID{firstItemID, secondItemID}, mockActivity.RecordItemViewsCalls()[0].ItemIDs)
+}
+
+func TestWishListService_GetGiftItemGroupsByPublicSlug(t *testing.T) {
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*wishlistmodels.WishList, error) {
+			return &wishlistmodels.WishList{
+				ID:       pgtype.UUID{Bytes: [16]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}, Valid: true},
+				IsPublic: pgtype.Bool{Bool: true, Valid: true},
+			}, nil
+		},
+	}
+	mockGiftItemRepo := &GiftItemRepositoryInterfaceMock{
+		GetPublicWishListGiftItemGroupsFunc: func(ctx context.Context, publicSlug, groupBy string) ([]*itemmodels.GiftItemGroup, error) {
+			assert.Equal(t, GroupByCategory, groupBy)
+			return []*itemmodels.GiftItemGroup{
+				{Key: "Books", Count: 2, Reserved: 1, Available: 1},
+				{Key: "", Count: 1, Available: 1},
+			}, nil
+		},
+		GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{
+				{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Name: "Novel", Category: pgtype.Text{String: "Books", Valid: true}, ReservedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+				{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Name: "Socks"},
+				{ID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, Name: "Atlas", Category: pgtype.Text{String: "Books", Valid: true}},
+			}, nil
+		},
+	}
+
+	svc := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("items are placed in their groups in position order", func(t *testing.T) {
+		groups, err := svc.GetGiftItemGroupsByPublicSlug(context.Background(), "public-slug", GroupByCategory)
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+
+		assert.Equal(t, "Books", groups[0].Key)
+		assert.Equal(t, 2, groups[0].Count)
+		assert.Equal(t, 1, groups[0].Reserved)
+		require.Len(t, groups[0].Items, 2)
+		assert.Equal(t, "Novel", groups[0].Items[0].Name)
+		assert.True(t, groups[0].Items[0].IsReserved)
+		assert.Equal(t, "Atlas", groups[0].Items[1].Name)
+
+		assert.Empty(t, groups[1].Key)
+		require.Len(t, groups[1].Items, 1)
+		assert.Equal(t, "Socks", groups[1].Items[0].Name)
+	})
+
+	t.Run("unknown grouping is rejected", func(t *testing.T) {
+		_, err := svc.GetGiftItemGroupsByPublicSlug(context.Background(), "public-slug", "price")
+		require.ErrorIs(t, err, ErrInvalidGiftItemGroup)
+	})
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// MaxImportedItems is how many items a copy of someone else's wishlist takes
+	// along, the free plan's limit of items per list
+	MaxImportedItems = 50
+	// MaxImportsPerDay is how many wishlists a user can copy in 24 hours
+	MaxImportsPerDay = 10
+)
+
+var (
+	ErrImportOwnWishList  = errors.New("cannot import your own wishlist")
+	ErrImportLimitReached = errors.New("daily wishlist import limit reached")
+	ErrImportNotFound     = errors.New("wishlist was not imported from another list")
+)
+
+// ImportServiceInterface defines the interface for copying public wishlists into the user's account
+type ImportServiceInterface interface {
+	ImportWishList(ctx context.Context, userID, publicSlug string) (*ImportOutput, error)
+	GetImportSource(ctx context.Context, wishList *models.WishList) (*ImportSourceOutput, error)
+}
+
+// ImportService lets a signed-in viewer of someone else's public wishlist copy
+// it to their own account as a new private list, e.g. to start from a friend's
+// baby registry. Only what guests see of the items is copied, never who reserved
+// or bought them, and the copy remembers where it came from.
+type ImportService struct {
+	imports   repository.ImportRepositoryInterface
+	wishLists repository.WishListRepositoryInterface
+	users     UserRepositoryInterface
+	quota     QuotaCheckerInterface
+}
+
+// NewImportService creates a new wishlist import service.
+// quota may be nil, in which case imports are not checked against the user's plan.
+func NewImportService(
+	imports repository.ImportRepositoryInterface,
+	wishLists repository.WishListRepositoryInterface,
+	users UserRepositoryInterface,
+	quota QuotaCheckerInterface,
+) *ImportService {
+	return &ImportService{
+		imports:   imports,
+		wishLists: wishLists,
+		users:     users,
+		quota:     quota,
+	}
+}
+
+// ImportOutput is the list created by an import
+type ImportOutput struct {
+	WishList      *WishListOutput // The copy, private until the user shares it
+	ItemsImported int32
+	Source        *ImportSourceOutput
+}
+
+// ImportSourceOutput attributes an imported list to the list it was copied from
+type ImportSourceOutput struct {
+	WishListID string // Empty once the source was deleted
+	Title      string // Title of the source when it was copied
+	OwnerName  string // First name of the source's owner; empty when unknown
+	PublicSlug string // Set while the source is still public
+	ImportedAt time.Time
+}
+
+// ImportWishList copies the public wishlist at publicSlug to the user's account.
+// Users cannot copy their own lists, copy at most MaxImportsPerDay lists a day
+// and take at most MaxImportedItems items along.
+func (s *ImportService) ImportWishList(ctx context.Context, userID, publicSlug string) (*ImportOutput, error) {
+	ownerID := pgtype.UUID{}
+	if err := ownerID.Scan(userID); err != nil {
+		return nil, ErrInvalidWishListUserID
+	}
+
+	source, err := s.wishLists.GetByPublicSlug(ctx, publicSlug)
+	if errors.Is(err, repository.ErrWishListNotFound) {
+		return nil, ErrWishListNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist by public slug from repository: %w", err)
+	}
+	if source.OwnerID == ownerID {
+		return nil, ErrImportOwnWishList
+	}
+
+	imported, err := s.imports.CountSince(ctx, ownerID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count wishlist imports: %w", err)
+	}
+	if imported >= MaxImportsPerDay {
+		return nil, ErrImportLimitReached
+	}
+
+	if s.quota != nil {
+		if err := s.quota.CheckWishListQuota(ctx, ownerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The occasion date is the source owner's; the copy starts without one
+	next := models.WishList{
+		OwnerID:     ownerID,
+		Title:       source.Title,
+		Description: source.Description,
+		Occasion:    source.Occasion,
+		IsPublic:    pgtype.Bool{Bool: false, Valid: true},
+	}
+	created, record, err := s.imports.Import(ctx, source, next, MaxImportedItems)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import wishlist: %w", err)
+	}
+
+	logger.InfoContext(ctx, "wishlist imported",
+		"wishlist_id", created.ID.String(), "source_wishlist_id", source.ID.String(), "items", record.ItemsImported)
+
+	output := wishListToOutput(created)
+	output.ItemCount = int64(record.ItemsImported)
+
+	return &ImportOutput{
+		WishList:      output,
+		ItemsImported: record.ItemsImported,
+		Source:        s.sourceOutput(ctx, record, source),
+	}, nil
+}
+
+// GetImportSource returns where an imported wishlist was copied from. Callers
+// pass a wishlist the user is known to own, e.g. from RequireWishListOwner.
+func (s *ImportService) GetImportSource(ctx context.Context, wishList *models.WishList) (*ImportSourceOutput, error) {
+	record, err := s.imports.GetByWishList(ctx, wishList.ID)
+	if errors.Is(err, repository.ErrImportNotFound) {
+		return nil, ErrImportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist import: %w", err)
+	}
+
+	var source *models.WishList
+	if record.SourceWishListID.Valid {
+		source, err = s.wishLists.GetByID(ctx, record.SourceWishListID)
+		if err != nil && !errors.Is(err, repository.ErrWishListNotFound) {
+			return nil, fmt.Errorf("failed to get source wishlist from repository: %w", err)
+		}
+	}
+
+	return s.sourceOutput(ctx, record, source), nil
+}
+
+// sourceOutput describes the source of an import. source is the source list as
+// it is now, nil once deleted; the owner's name is left out when it cannot be
+// looked up.
+func (s *ImportService) sourceOutput(ctx context.Context, record *models.WishListImport, source *models.WishList) *ImportSourceOutput {
+	output := &ImportSourceOutput{
+		Title:      record.SourceTitle,
+		ImportedAt: record.CreatedAt.Time,
+	}
+	if source != nil {
+		output.WishListID = source.ID.String()
+		if source.IsPublic.Bool && source.PublicSlug.Valid {
+			output.PublicSlug = source.PublicSlug.String
+		}
+	}
+
+	if record.SourceOwnerID.Valid && s.users != nil {
+		owner, err := s.users.GetByID(ctx, record.SourceOwnerID)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to get source owner of imported wishlist", "error", err, "import_id", record.ID.String())
+		} else {
+			output.OwnerName = owner.FirstName.String
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportService_ImportWishList(t *testing.T) {
+	sourceOwnerID := "00000000-0000-0000-0000-0000000000b1"
+	importerID := "00000000-0000-0000-0000-0000000000c1"
+
+	source := &models.WishList{
+		Title:       "Baby registry",
+		Description: pgtype.Text{String: "Everything for the first months", Valid: true},
+		OccasionDate: pgtype.Date{
+			Time:  time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+			Valid: true,
+		},
+		IsPublic:   pgtype.Bool{Bool: true, Valid: true},
+		PublicSlug: pgtype.Text{String: "baby-registry", Valid: true},
+	}
+	require.NoError(t, source.ID.Scan("00000000-0000-0000-0000-0000000000a1"))
+	require.NoError(t, source.OwnerID.Scan(sourceOwnerID))
+
+	wishLists := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+			if publicSlug != "baby-registry" {
+				return nil, repository.ErrWishListNotFound
+			}
+			return source, nil
+		},
+	}
+	users := &UserRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+			return &usermodels.User{ID: id, FirstName: pgtype.Text{String: "Alice", Valid: true}}, nil
+		},
+	}
+	newImports := func(importedToday int) *ImportRepositoryInterfaceMock {
+		return &ImportRepositoryInterfaceMock{
+			CountSinceFunc: func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+				return importedToday, nil
+			},
+			ImportFunc: func(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error) {
+				created := next
+				created.ID = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+				return &created, &models.WishListImport{
+					WishListID:       created.ID,
+					SourceWishListID: source.ID,
+					SourceOwnerID:    source.OwnerID,
+					SourceTitle:      source.Title,
+					ItemsImported:    12,
+				}, nil
+			},
+		}
+	}
+
+	t.Run("copies the list as a private list with attribution", func(t *testing.T) {
+		imports := newImports(0)
+		quota := &QuotaCheckerInterfaceMock{
+			CheckWishListQuotaFunc: func(ctx context.Context, userID pgtype.UUID) error { return nil },
+		}
+		svc := NewImportService(imports, wishLists, users, quota)
+
+		out, err := svc.ImportWishList(context.Background(), importerID, "baby-registry")
+		require.NoError(t, err)
+		assert.Equal(t, int32(12), out.ItemsImported)
+		assert.Equal(t, int64(12), out.WishList.ItemCount)
+		assert.Equal(t, importerID, out.WishList.OwnerID)
+		assert.False(t, out.WishList.IsPublic)
+		assert.Equal(t, "Baby registry", out.Source.Title)
+		assert.Equal(t, "Alice", out.Source.OwnerName)
+		assert.Equal(t, "baby-registry", out.Source.PublicSlug)
+
+		call := imports.ImportCalls()[0]
+		assert.Equal(t, MaxImportedItems, call.ItemLimit)
+		assert.False(t, call.Next.OccasionDate.Valid, "the occasion date is the source owner's")
+		assert.Equal(t, "Everything for the first months", call.Next.Description.String)
+	})
+
+	t.Run("own list", func(t *testing.T) {
+		imports := newImports(0)
+		svc := NewImportService(imports, wishLists, users, nil)
+
+		_, err := svc.ImportWishList(context.Background(), sourceOwnerID, "baby-registry")
+		assert.ErrorIs(t, err, ErrImportOwnWishList)
+		assert.Empty(t, imports.ImportCalls())
+	})
+
+	t.Run("daily limit", func(t *testing.T) {
+		imports := newImports(MaxImportsPerDay)
+		svc := NewImportService(imports, wishLists, users, nil)
+
+		_, err := svc.ImportWishList(context.Background(), importerID, "baby-registry")
+		assert.ErrorIs(t, err, ErrImportLimitReached)
+		assert.Empty(t, imports.ImportCalls())
+	})
+
+	t.Run("plan quota", func(t *testing.T) {
+		imports := newImports(0)
+		quotaErr := errors.New("wishlist quota exceeded")
+		quota := &QuotaCheckerInterfaceMock{
+			CheckWishListQuotaFunc: func(ctx context.Context, userID pgtype.UUID) error { return quotaErr },
+		}
+		svc := NewImportService(imports, wishLists, users, quota)
+
+		_, err := svc.ImportWishList(context.Background(), importerID, "baby-registry")
+		assert.ErrorIs(t, err, quotaErr)
+		assert.Empty(t, imports.ImportCalls())
+	})
+
+	t.Run("private or unknown list", func(t *testing.T) {
+		svc := NewImportService(newImports(0), wishLists, users, nil)
+
+		_, err := svc.ImportWishList(context.Background(), importerID, "someone-elses")
+		assert.ErrorIs(t, err, ErrWishListNotFound)
+	})
+}
+
+func TestImportService_GetImportSource(t *testing.T) {
+	copied := &models.WishList{ID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}}
+	sourceID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+
+	t.Run("source made private since", func(t *testing.T) {
+		imports := &ImportRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+				return &models.WishListImport{SourceWishListID: sourceID, SourceTitle: "Baby registry"}, nil
+			},
+		}
+		wishLists := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				return &models.WishList{ID: id, Title: "Renamed", PublicSlug: pgtype.Text{String: "baby-registry", Valid: true}}, nil
+			},
+		}
+		svc := NewImportService(imports, wishLists, &UserRepositoryInterfaceMock{}, nil)
+
+		out, err := svc.GetImportSource(context.Background(), copied)
+		require.NoError(t, err)
+		assert.Equal(t, "Baby registry", out.Title, "the title at the time of the import is kept")
+		assert.Equal(t, sourceID.String(), out.WishListID)
+		assert.Empty(t, out.PublicSlug)
+		assert.Empty(t, out.OwnerName)
+	})
+
+	t.Run("source deleted since", func(t *testing.T) {
+		imports := &ImportRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+				return &models.WishListImport{SourceTitle: "Baby registry"}, nil
+			},
+		}
+		wishLists := &WishListRepositoryInterfaceMock{}
+		svc := NewImportService(imports, wishLists, &UserRepositoryInterfaceMock{}, nil)
+
+		out, err := svc.GetImportSource(context.Background(), copied)
+		require.NoError(t, err)
+		assert.Empty(t, out.WishListID)
+		assert.Empty(t, wishLists.GetByIDCalls())
+	})
+
+	t.Run("list was not imported", func(t *testing.T) {
+		imports := &ImportRepositoryInterfaceMock{
+			GetByWishListFunc: func(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+				return nil, repository.ErrImportNotFound
+			},
+		}
+		svc := NewImportService(imports, &WishListRepositoryInterfaceMock{}, &UserRepositoryInterfaceMock{}, nil)
+
+		_, err := svc.GetImportSource(context.Background(), copied)
+		assert.ErrorIs(t, err, ErrImportNotFound)
+	})
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// MaxInvitesPerRequest is how many addresses can be invited at once
+	MaxInvitesPerRequest = 50
+	// MaxInvitesPerDay is how many invite emails a user can send in 24 hours,
+	// across all of their wishlists
+	MaxInvitesPerDay = 200
+	// maxInviteGroups is how many contact groups can be invited at once
+	maxInviteGroups = 10
+	// inviteResendAfter is how long an address is not emailed again for the same wishlist
+	inviteResendAfter = 24 * time.Hour
+)
+
+var (
+	ErrInviteWishListNotPublic = errors.New("only public wishlists can be shared by invite")
+	ErrInviteLimitReached      = errors.New("daily invite limit reached")
+	ErrInvalidInviteEmails     = errors.New("invite emails must be 1 to 50 valid addresses")
+	ErrInvalidInviteGroups     = errors.New("invite groups must be at most 10 names")
+	ErrInviteNotFound          = errors.New("invite not found")
+)
+
+// InviteEmailSenderInterface defines the email sent to invited guests (cross-domain)
+type InviteEmailSenderInterface interface {
+	SendWishlistInviteEmail(ctx context.Context, recipientEmail, wishlistTitle, ownerName, message, link string) error
+}
+
+// InviteContactsInterface looks up the owner's saved contact groups (cross-domain)
+type InviteContactsInterface interface {
+	ListEmailsByRelationships(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error)
+}
+
+// InviteServiceInterface defines the interface for inviting guests to a wishlist by email
+type InviteServiceInterface interface {
+	InviteGuests(ctx context.Context, wishList *models.WishList, input InviteGuestsInput) (*InviteResultOutput, error)
+	ListInvites(ctx context.Context, wishList *models.WishList) ([]*InviteOutput, error)
+	RecordVisit(ctx context.Context, token string) error
+}
+
+// InviteService emails the share link of a public wishlist to guests the owner
+// names, and tracks which of them visited it through their link
+type InviteService struct {
+	repo        repository.InviteRepositoryInterface
+	users       UserRepositoryInterface
+	emails      InviteEmailSenderInterface
+	contacts    InviteContactsInterface
+	moderator   ContentModeratorInterface
+	frontendURL string
+}
+
+// NewInviteService creates a new invite service. Links point at the web app at
+// frontendURL. moderator may be nil, in which case messages are not checked.
+func NewInviteService(
+	repo repository.InviteRepositoryInterface,
+	users UserRepositoryInterface,
+	emails InviteEmailSenderInterface,
+	contacts InviteContactsInterface,
+	moderator ContentModeratorInterface,
+	frontendURL string,
+) *InviteService {
+	return &InviteService{
+		repo:        repo,
+		users:       users,
+		emails:      emails,
+		contacts:    contacts,
+		moderator:   moderator,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// InviteGuestsInput names the guests to invite
+type InviteGuestsInput struct {
+	Emails  []string
+	Groups  []string // Relationships of saved contacts, e.g. "family"; their emails are added to Emails
+	Message string   // Personal note added to the email, optional
+}
+
+// InviteOutput is an invited guest and what became of their invite
+type InviteOutput struct {
+	ID         string
+	Email      string
+	Status     string // pending, sent or failed
+	SentAt     time.Time
+	VisitedAt  time.Time // Zero until the guest opened their link
+	VisitCount int32
+}
+
+// InviteResultOutput reports an invite request
+type InviteResultOutput struct {
+	Invites []*InviteOutput // Guests emailed now, sent or failed
+	Skipped []string        // Addresses already emailed within the last 24 hours
+}
+
+// InviteGuests emails the wishlist's share link to each address and to the
+// owner's contacts in the given groups. Every email carries its own link, so
+// visits can be told apart. Addresses invited within the last 24 hours are skipped.
+func (s *InviteService) InviteGuests(ctx context.Context, wishList *models.WishList, input InviteGuestsInput) (*InviteResultOutput, error) {
+	if !wishList.IsPublic.Bool || !wishList.PublicSlug.Valid {
+		return nil, ErrInviteWishListNotPublic
+	}
+
+	emails := input.Emails
+	if len(input.Groups) > 0 {
+		groupEmails, err := s.groupEmails(ctx, wishList.OwnerID, input.Groups)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(slices.Clone(emails), groupEmails...)
+	}
+
+	emails, err := normalizeInviteEmails(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	message := strings.TrimSpace(input.Message)
+	if message != "" && s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, "", message); err != nil {
+			return nil, err
+		}
+	}
+
+	sent, err := s.repo.CountSentSince(ctx, wishList.OwnerID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count sent invites: %w", err)
+	}
+	if sent+len(emails) > MaxInvitesPerDay {
+		return nil, ErrInviteLimitReached
+	}
+
+	invites, err := s.repo.Prepare(ctx, wishList.ID, emails, time.Now().Add(-inviteResendAfter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare invites: %w", err)
+	}
+
+	ownerName := s.ownerName(ctx, wishList.OwnerID)
+	result := &InviteResultOutput{Invites: make([]*InviteOutput, 0, len(invites))}
+	for _, invite := range invites {
+		result.Invites = append(result.Invites, s.send(ctx, wishList, invite, ownerName, message))
+	}
+	for _, email := range emails {
+		if !slices.ContainsFunc(invites, func(invite *models.WishListInvite) bool { return invite.Email == email }) {
+			result.Skipped = append(result.Skipped, email)
+		}
+	}
+
+	logger.InfoContext(ctx, "wishlist invites sent",
+		"wishlist_id", wishList.ID.String(), "invited", len(result.Invites), "skipped", len(result.Skipped))
+
+	return result, nil
+}
+
+// ListInvites returns the guests invited to the wishlist, most recently invited first
+func (s *InviteService) ListInvites(ctx context.Context, wishList *models.WishList) ([]*InviteOutput, error) {
+	invites, err := s.repo.ListByWishList(ctx, wishList.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invites: %w", err)
+	}
+
+	outputs := make([]*InviteOutput, len(invites))
+	for i, invite := range invites {
+		outputs[i] = inviteToOutput(invite)
+	}
+
+	return outputs, nil
+}
+
+// RecordVisit counts a visit to the share page through an invite link
+func (s *InviteService) RecordVisit(ctx context.Context, token string) error {
+	id := pgtype.UUID{}
+	if err := id.Scan(token); err != nil {
+		return ErrInviteNotFound
+	}
+
+	if _, err := s.repo.RecordVisit(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrInviteNotFound) {
+			return ErrInviteNotFound
+		}
+		return fmt.Errorf("failed to record invite visit: %w", err)
+	}
+
+	return nil
+}
+
+// send emails one invite and records whether it went out. A failed email is
+// reported in the invite's status rather than failing the whole request.
+func (s *InviteService) send(ctx context.Context, wishList *models.WishList, invite *models.WishListInvite, ownerName, message string) *InviteOutput {
+	link := s.frontendURL + "/public/" + url.PathEscape(wishList.PublicSlug.String) + "?invite=" + url.QueryEscape(invite.Token.String())
+
+	status := models.InviteStatusSent
+	if err := s.emails.SendWishlistInviteEmail(ctx, invite.Email, wishList.Title, ownerName, message, link); err != nil {
+		logger.WarnContext(ctx, "failed to send wishlist invite email", "invite_id", invite.ID.String(), "error", err)
+		status = models.InviteStatusFailed
+	}
+
+	if err := s.repo.SetStatus(ctx, invite.ID, status); err != nil {
+		logger.WarnContext(ctx, "failed to record wishlist invite status", "invite_id", invite.ID.String(), "error", err)
+	}
+
+	output := inviteToOutput(invite)
+	output.Status = status
+	if status == models.InviteStatusSent {
+		output.SentAt = time.Now()
+	}
+	return output
+}
+
+// groupEmails returns the emails of the owner's contacts saved with any of the
+// relationships
+func (s *InviteService) groupEmails(ctx context.Context, ownerID pgtype.UUID, groups []string) ([]string, error) {
+	if len(groups) > maxInviteGroups {
+		return nil, ErrInvalidInviteGroups
+	}
+
+	relationships := make([]string, 0, len(groups))
+	for _, group := range groups {
+		if group = strings.ToLower(strings.TrimSpace(group)); group != "" && !slices.Contains(relationships, group) {
+			relationships = append(relationships, group)
+		}
+	}
+	if len(relationships) == 0 {
+		return nil, ErrInvalidInviteGroups
+	}
+
+	emails, err := s.contacts.ListEmailsByRelationships(ctx, ownerID, relationships)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact group emails: %w", err)
+	}
+
+	return emails, nil
+}
+
+// ownerName is how the invite email names the owner; empty when unknown
+func (s *InviteService) ownerName(ctx context.Context, ownerID pgtype.UUID) string {
+	owner, err := s.users.GetByID(ctx, ownerID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get wishlist owner for invites", "error", err)
+		return ""
+	}
+	if owner.DisplayName.Valid && owner.DisplayName.String != "" {
+		return owner.DisplayName.String
+	}
+	return owner.FirstName.String
+}
+
+// normalizeInviteEmails lowercases and deduplicates addresses, in request order.
+// Only bare addresses are accepted, not "Name <address>".
+func normalizeInviteEmails(emails []string) ([]string, error) {
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email {
+			return nil, ErrInvalidInviteEmails
+		}
+		if !slices.Contains(normalized, email) {
+			normalized = append(normalized, email)
+		}
+	}
+	if len(normalized) == 0 || len(normalized) > MaxInvitesPerRequest {
+		return nil, ErrInvalidInviteEmails
+	}
+	return normalized, nil
+}
+
+func inviteToOutput(invite *models.WishListInvite) *InviteOutput {
+	return &InviteOutput{
+		ID:         invite.ID.String(),
+		Email:      invite.Email,
+		Status:     invite.Status,
+		SentAt:     invite.SentAt.Time,
+		VisitedAt:  invite.VisitedAt.Time,
+		VisitCount: invite.VisitCount,
+	}
+}
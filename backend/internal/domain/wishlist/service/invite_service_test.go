@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInviteTestWishList(t *testing.T) *models.WishList {
+	t.Helper()
+
+	wishList := &models.WishList{
+		Title:      "Housewarming",
+		IsPublic:   pgtype.Bool{Bool: true, Valid: true},
+		PublicSlug: pgtype.Text{String: "housewarming", Valid: true},
+	}
+	require.NoError(t, wishList.ID.Scan("00000000-0000-0000-0000-0000000000a1"))
+	require.NoError(t, wishList.OwnerID.Scan("00000000-0000-0000-0000-0000000000b1"))
+	return wishList
+}
+
+// newInviteRepo prepares an invite for every address except those in recent
+func newInviteRepo(sentToday int, recent ...string) *InviteRepositoryInterfaceMock {
+	return &InviteRepositoryInterfaceMock{
+		CountSentSinceFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error) {
+			return sentToday, nil
+		},
+		PrepareFunc: func(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error) {
+			var invites []*models.WishListInvite
+			for i, email := range emails {
+				if slices.Contains(recent, email) {
+					continue
+				}
+				invites = append(invites, &models.WishListInvite{
+					ID:     pgtype.UUID{Bytes: [16]byte{byte(i + 1)}, Valid: true},
+					Email:  email,
+					Token:  pgtype.UUID{Bytes: [16]byte{0xee, byte(i + 1)}, Valid: true},
+					Status: models.InviteStatusPending,
+				})
+			}
+			return invites, nil
+		},
+		SetStatusFunc: func(ctx context.Context, id pgtype.UUID, status string) error {
+			return nil
+		},
+	}
+}
+
+func newInviteUsers() *UserRepositoryInterfaceMock {
+	return &UserRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+			return &usermodels.User{ID: id, FirstName: pgtype.Text{String: "Alice", Valid: true}}, nil
+		},
+	}
+}
+
+func TestInviteService_InviteGuests(t *testing.T) {
+	t.Run("emails each guest their own link", func(t *testing.T) {
+		repo := newInviteRepo(0, "sam@example.com")
+		var links []string
+		emails := &InviteEmailSenderInterfaceMock{
+			SendWishlistInviteEmailFunc: func(ctx context.Context, recipientEmail, wishlistTitle, ownerName, message, link string) error {
+				assert.Equal(t, "Housewarming", wishlistTitle)
+				assert.Equal(t, "Alice", ownerName)
+				assert.Equal(t, "Come over!", message)
+				links = append(links, link)
+				if recipientEmail == "broken@example.com" {
+					return errors.New("mailbox unavailable")
+				}
+				return nil
+			},
+		}
+		svc := NewInviteService(repo, newInviteUsers(), emails, nil, nil, "https://app.example.com/")
+
+		result, err := svc.InviteGuests(context.Background(), newInviteTestWishList(t), InviteGuestsInput{
+			Emails:  []string{" Jamie@Example.com", "jamie@example.com", "sam@example.com", "broken@example.com"},
+			Message: " Come over! ",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Invites, 2)
+		assert.Equal(t, "jamie@example.com", result.Invites[0].Email)
+		assert.Equal(t, models.InviteStatusSent, result.Invites[0].Status)
+		assert.Equal(t, models.InviteStatusFailed, result.Invites[1].Status)
+		assert.Equal(t, []string{"sam@example.com"}, result.Skipped)
+
+		require.Len(t, links, 2)
+		assert.True(t, strings.HasPrefix(links[0], "https://app.example.com/public/housewarming?invite="))
+		assert.NotEqual(t, links[0], links[1])
+
+		statuses := repo.SetStatusCalls()
+		require.Len(t, statuses, 2)
+		assert.Equal(t, models.InviteStatusSent, statuses[0].Status)
+		assert.Equal(t, models.InviteStatusFailed, statuses[1].Status)
+	})
+
+	t.Run("private wishlist", func(t *testing.T) {
+		wishList := newInviteTestWishList(t)
+		wishList.IsPublic = pgtype.Bool{Bool: false, Valid: true}
+		repo := newInviteRepo(0)
+		svc := NewInviteService(repo, newInviteUsers(), &InviteEmailSenderInterfaceMock{}, nil, nil, "")
+
+		_, err := svc.InviteGuests(context.Background(), wishList, InviteGuestsInput{Emails: []string{"jamie@example.com"}})
+		assert.ErrorIs(t, err, ErrInviteWishListNotPublic)
+		assert.Empty(t, repo.PrepareCalls())
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		svc := NewInviteService(newInviteRepo(0), newInviteUsers(), &InviteEmailSenderInterfaceMock{}, nil, nil, "")
+
+		_, err := svc.InviteGuests(context.Background(), newInviteTestWishList(t), InviteGuestsInput{
+			Emails: []string{"jamie@example.com", "Sam <sam@example.com>"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidInviteEmails)
+	})
+
+	t.Run("saved contact group", func(t *testing.T) {
+		repo := newInviteRepo(0)
+		contacts := &InviteContactsInterfaceMock{
+			ListEmailsByRelationshipsFunc: func(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+				assert.Equal(t, []string{"family"}, relationships)
+				return []string{"may@example.com", "jamie@example.com"}, nil
+			},
+		}
+		emails := &InviteEmailSenderInterfaceMock{
+			SendWishlistInviteEmailFunc: func(ctx context.Context, recipientEmail, wishlistTitle, ownerName, message, link string) error {
+				return nil
+			},
+		}
+		svc := NewInviteService(repo, newInviteUsers(), emails, contacts, nil, "")
+
+		result, err := svc.InviteGuests(context.Background(), newInviteTestWishList(t), InviteGuestsInput{
+			Emails: []string{"jamie@example.com"},
+			Groups: []string{" Family", "family"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, repo.PrepareCalls(), 1)
+		assert.Equal(t, []string{"jamie@example.com", "may@example.com"}, repo.PrepareCalls()[0].Emails)
+		assert.Len(t, result.Invites, 2)
+	})
+
+	t.Run("daily limit", func(t *testing.T) {
+		repo := newInviteRepo(MaxInvitesPerDay - 1)
+		svc := NewInviteService(repo, newInviteUsers(), &InviteEmailSenderInterfaceMock{}, nil, nil, "")
+
+		_, err := svc.InviteGuests(context.Background(), newInviteTestWishList(t), InviteGuestsInput{
+			Emails: []string{"jamie@example.com", "sam@example.com"},
+		})
+		assert.ErrorIs(t, err, ErrInviteLimitReached)
+		assert.Empty(t, repo.PrepareCalls())
+	})
+
+	t.Run("rejected message", func(t *testing.T) {
+		rejected := errors.New("message rejected")
+		moderator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error { return rejected },
+		}
+		repo := newInviteRepo(0)
+		svc := NewInviteService(repo, newInviteUsers(), &InviteEmailSenderInterfaceMock{}, nil, moderator, "")
+
+		_, err := svc.InviteGuests(context.Background(), newInviteTestWishList(t), InviteGuestsInput{
+			Emails:  []string{"jamie@example.com"},
+			Message: "buy cheap pills",
+		})
+		assert.ErrorIs(t, err, rejected)
+		assert.Empty(t, repo.PrepareCalls())
+	})
+}
+
+func TestInviteService_RecordVisit(t *testing.T) {
+	token := "00000000-0000-0000-0000-0000000000e1"
+	repo := &InviteRepositoryInterfaceMock{
+		RecordVisitFunc: func(ctx context.Context, got pgtype.UUID) (*models.WishListInvite, error) {
+			if got.String() != token {
+				return nil, repository.ErrInviteNotFound
+			}
+			return &models.WishListInvite{Token: got, VisitCount: 1}, nil
+		},
+	}
+	svc := NewInviteService(repo, newInviteUsers(), &InviteEmailSenderInterfaceMock{}, nil, nil, "")
+
+	require.NoError(t, svc.RecordVisit(context.Background(), token))
+	assert.ErrorIs(t, svc.RecordVisit(context.Background(), "00000000-0000-0000-0000-0000000000e2"), ErrInviteNotFound)
+	assert.ErrorIs(t, svc.RecordVisit(context.Background(), "not-a-token"), ErrInviteNotFound)
+	assert.Len(t, repo.RecordVisitCalls(), 2)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"time"
+
+	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/mapper"
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/wishlist/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// wishListToOutput converts a stored wishlist; NULL columns become zero values
+func wishListToOutput(wishList *models.WishList) *WishListOutput {
+	output := &WishListOutput{
+		ID:           wishList.ID.String(),
+		OwnerID:      wishList.OwnerID.String(),
+		Title:        wishList.Title,
+		Description:  database.TextToString(wishList.Description),
+		Occasion:     database.TextToString(wishList.Occasion),
+		IsPublic:     wishList.IsPublic.Bool,
+		PublicSlug:   database.TextToString(wishList.PublicSlug),
+		VanitySlug:   database.TextToString(wishList.VanitySlug),
+		ViewCount:    int64(wishList.ViewCount.Int32),
+		CreatedAt:    wishList.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:    wishList.UpdatedAt.Time.Format(time.RFC3339),
+		Version:      wishList.Version,
+		Recurrence:   database.TextToString(wishList.Recurrence),
+		Discoverable: wishList.Discoverable,
+		RolledOverTo: formatUUID(wishList.RolledOverTo),
+		MergedInto:   formatUUID(wishList.MergedInto),
+	}
+	if wishList.OccasionDate.Valid {
+		output.OccasionDate = wishList.OccasionDate.Time.Format(time.RFC3339)
+	}
+	return output
+}
+
+// giftItemToOutput converts a stored item as its owner sees it. wishListID is
+// the list the item was read through, empty when it was read on its own.
+// The gallery is attached separately, see attachImages.
+func giftItemToOutput(wishListID string, giftItem *itemmodels.GiftItem) *GiftItemOutput {
+	return &GiftItemOutput{
+		Item:              mapper.FromGiftItem(giftItem),
+		WishlistID:        wishListID,
+		ReservedByUserID:  formatUUID(giftItem.ReservedByUserID),
+		ReservedAt:        formatTimestamp(giftItem.ReservedAt),
+		PurchasedByUserID: formatUUID(giftItem.PurchasedByUserID),
+		PurchasedAt:       formatTimestamp(giftItem.PurchasedAt),
+		PurchasedPrice:    database.NumericToFloat64(giftItem.PurchasedPrice),
+		Position:          int(giftItem.Position.Int32),
+	}
+}
+
+// publicGiftItemOutput converts an item of a public wishlist for the share page.
+// Who reserved or bought the item stays hidden; only when is shown. Notes are
+// left out unless the owner made them public.
+func publicGiftItemOutput(wishListID string, giftItem *itemmodels.GiftItem) *GiftItemOutput {
+	output := giftItemToOutput(wishListID, giftItem)
+	output.ReservedByUserID = ""
+	output.PurchasedByUserID = ""
+	output.HideManualReservation()
+	if output.NotesVisibility != itemmodels.NotesVisibilityPublic {
+		output.Notes = ""
+	}
+	output.NotesVisibility = ""
+	return output
+}
+
+// formatTimestamp renders a timestamp as RFC 3339; NULL becomes ""
+func formatTimestamp(ts pgtype.Timestamptz) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.Format(time.RFC3339)
+}
+
+// formatUUID renders a UUID; NULL becomes ""
+func formatUUID(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"math/big"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/item/mapper"
+	itemmodels "wish-list/internal/domain/item/models"
+	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/variant"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+var mapperTime = time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+
+func mapperUUID(b byte) pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b}, Valid: true}
+}
+
+// assertAllFieldsSet fails for every output field left at its zero value, so
+// a field added to the output but not to the mapper is caught here
+func assertAllFieldsSet(t *testing.T, output any, skip ...string) {
+	t.Helper()
+	v := reflect.ValueOf(output).Elem()
+	for i := range v.NumField() {
+		name := v.Type().Field(i).Name
+		if v.Field(i).IsZero() && !slices.Contains(skip, name) {
+			t.Errorf("%s is not mapped", name)
+		}
+	}
+}
+
+func fullWishList() *wishlistmodels.WishList {
+	return &wishlistmodels.WishList{
+		ID:           mapperUUID(1),
+		OwnerID:      mapperUUID(2),
+		Title:        "Birthday",
+		Description:  pgtype.Text{String: "Turning 30", Valid: true},
+		Occasion:     pgtype.Text{String: "birthday", Valid: true},
+		OccasionDate: pgtype.Date{Time: mapperTime, Valid: true},
+		IsPublic:     pgtype.Bool{Bool: true, Valid: true},
+		PublicSlug:   pgtype.Text{String: "birthday-2026", Valid: true},
+		VanitySlug:   pgtype.Text{String: "birthday", Valid: true},
+		ViewCount:    pgtype.Int4{Int32: 12, Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		Version:      3,
+		Recurrence:   pgtype.Text{String: wishlistmodels.RecurrenceYearly, Valid: true},
+		RolledOverTo: mapperUUID(3),
+		MergedInto:   mapperUUID(6),
+		Discoverable: true,
+	}
+}
+
+// fullGiftItem is a purchased item with every column set
+func fullGiftItem() *itemmodels.GiftItem {
+	return &itemmodels.GiftItem{
+		ID:                    mapperUUID(4),
+		OwnerID:               mapperUUID(2),
+		Name:                  "Headphones",
+		Description:           pgtype.Text{String: "Noise cancelling", Valid: true},
+		Link:                  pgtype.Text{String: "https://shop.example/headphones", Valid: true},
+		ImageUrl:              pgtype.Text{String: "https://cdn.example/headphones.jpg", Valid: true},
+		Price:                 pgtype.Numeric{Int: big.NewInt(19999), Exp: -2, Valid: true},
+		Priority:              pgtype.Int4{Int32: 9, Valid: true},
+		PriorityLevel:         "must_have",
+		ReservedByUserID:      mapperUUID(5),
+		ReservedAt:            pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		PurchasedByUserID:     mapperUUID(5),
+		PurchasedAt:           pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		PurchasedPrice:        pgtype.Numeric{Int: big.NewInt(17999), Exp: -2, Valid: true},
+		Notes:                 pgtype.Text{String: "Black, please", Valid: true},
+		Position:              pgtype.Int4{Int32: 2, Valid: true},
+		CreatedAt:             pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		UpdatedAt:             pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		AvailabilityStatus:    itemmodels.AvailabilityAvailable,
+		AvailabilityCheckedAt: pgtype.Timestamptz{Time: mapperTime, Valid: true},
+		Quantity:              1,
+		ReservedQuantity:      1,
+		Options:               variant.Options{Color: []string{"Black"}},
+		Category:              pgtype.Text{String: "Audio", Valid: true},
+	}
+}
+
+func TestWishListToOutput(t *testing.T) {
+	t.Run("every column is mapped", func(t *testing.T) {
+		output := wishListToOutput(fullWishList())
+
+		assertAllFieldsSet(t, output, "ItemCount")
+		assert.Equal(t, &WishListOutput{
+			ID:           mapperUUID(1).String(),
+			OwnerID:      mapperUUID(2).String(),
+			Title:        "Birthday",
+			Description:  "Turning 30",
+			Occasion:     "birthday",
+			OccasionDate: "2026-03-14T09:30:00Z",
+			IsPublic:     true,
+			PublicSlug:   "birthday-2026",
+			VanitySlug:   "birthday",
+			ViewCount:    12,
+			CreatedAt:    "2026-03-14T09:30:00Z",
+			UpdatedAt:    "2026-03-14T09:30:00Z",
+			Version:      3,
+			Recurrence:   "yearly",
+			Discoverable: true,
+			RolledOverTo: mapperUUID(3).String(),
+			MergedInto:   mapperUUID(6).String(),
+		}, output)
+	})
+
+	t.Run("NULL columns become zero values", func(t *testing.T) {
+		output := wishListToOutput(&wishlistmodels.WishList{ID: mapperUUID(1), OwnerID: mapperUUID(2), Title: "Bare"})
+
+		assert.Empty(t, output.Description)
+		assert.Empty(t, output.Occasion)
+		assert.Empty(t, output.OccasionDate)
+		assert.False(t, output.IsPublic)
+		assert.Empty(t, output.PublicSlug)
+		assert.Zero(t, output.ViewCount)
+		assert.Empty(t, output.Recurrence)
+		assert.Empty(t, output.RolledOverTo)
+		assert.Empty(t, output.MergedInto)
+	})
+}
+
+func TestGiftItemToOutput(t *testing.T) {
+	t.Run("every column is mapped", func(t *testing.T) {
+		output := giftItemToOutput("wishlist-1", fullGiftItem())
+
+		// The shared fields are covered by the item mapper's tests
+		assertAllFieldsSet(t, output)
+		assert.Equal(t, mapper.FromGiftItem(fullGiftItem()), output.Item)
+		assert.Equal(t, "wishlist-1", output.WishlistID)
+		assert.Equal(t, "Noise cancelling", output.Description)
+		assert.InDelta(t, 199.99, output.Price, 0.001)
+		assert.InDelta(t, 179.99, output.PurchasedPrice, 0.001)
+		assert.Equal(t, 9, output.Priority)
+		assert.Equal(t, "must_have", output.PriorityLevel)
+		assert.Equal(t, 3, output.PriorityWeight)
+		assert.Equal(t, mapperUUID(5).String(), output.ReservedByUserID)
+		assert.Equal(t, mapperUUID(5).String(), output.PurchasedByUserID)
+		assert.Equal(t, "2026-03-14T09:30:00Z", output.PurchasedAt)
+		assert.Equal(t, "Black, please", output.Notes)
+		assert.Equal(t, itemmodels.NotesVisibilityPrivate, output.NotesVisibility)
+		assert.Equal(t, 2, output.Position)
+		assert.Equal(t, "Audio", output.Category)
+		assert.Equal(t, itemmodels.ReservationFullyReserved, output.ReservationStatus)
+		assert.Equal(t, "2026-03-14T09:30:00Z", output.AvailabilityCheckedAt)
+	})
+
+	t.Run("NULL columns become zero values", func(t *testing.T) {
+		output := giftItemToOutput("", &itemmodels.GiftItem{ID: mapperUUID(4), OwnerID: mapperUUID(2), Name: "Bare"})
+
+		assert.Empty(t, output.Description)
+		assert.Empty(t, output.Link)
+		assert.Empty(t, output.ImageURL)
+		assert.Zero(t, output.Price)
+		assert.Equal(t, "nice_to_have", output.PriorityLevel)
+		assert.Empty(t, output.ReservedByUserID)
+		assert.Empty(t, output.ReservedAt)
+		assert.Empty(t, output.PurchasedAt)
+		assert.Zero(t, output.PurchasedPrice)
+		assert.Empty(t, output.Notes)
+		assert.Empty(t, output.Category)
+		assert.Empty(t, output.AvailabilityCheckedAt)
+		assert.Equal(t, int32(1), output.Quantity)
+	})
+}
+
+func TestPublicGiftItemOutput(t *testing.T) {
+	t.Run("private notes are left out", func(t *testing.T) {
+		output := publicGiftItemOutput("wishlist-1", fullGiftItem())
+
+		assert.Empty(t, output.ReservedByUserID)
+		assert.Empty(t, output.PurchasedByUserID)
+		assert.Equal(t, "2026-03-14T09:30:00Z", output.ReservedAt)
+		assert.Empty(t, output.Notes)
+		assert.Empty(t, output.NotesVisibility)
+	})
+
+	t.Run("who the owner recorded as reserving the item is left out", func(t *testing.T) {
+		giftItem := fullGiftItem()
+		giftItem.PurchasedByUserID = pgtype.UUID{}
+		giftItem.PurchasedAt = pgtype.Timestamptz{}
+		giftItem.ManualReservedByName = pgtype.Text{String: "Grandma", Valid: true}
+		giftItem.ManualReservationNote = pgtype.Text{String: "Said so at dinner", Valid: true}
+		output := publicGiftItemOutput("wishlist-1", giftItem)
+
+		assert.True(t, output.IsManuallyReserved)
+		assert.Empty(t, output.ManualReservedByName)
+		assert.Empty(t, output.ManualReservationNote)
+	})
+
+	t.Run("public notes are shown", func(t *testing.T) {
+		giftItem := fullGiftItem()
+		giftItem.NotesVisibility = itemmodels.NotesVisibilityPublic
+		output := publicGiftItemOutput("wishlist-1", giftItem)
+
+		assert.Equal(t, "Black, please", output.Notes)
+		assert.Empty(t, output.NotesVisibility)
+	})
+}
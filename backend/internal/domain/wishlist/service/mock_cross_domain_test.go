@@ -7,8 +7,12 @@ import (
 	"context"
 	"github.com/jackc/pgx/v5/pgtype"
 	"sync"
+	"time"
 	itemmodels "wish-list/internal/domain/item/models"
+	notificationmodels "wish-list/internal/domain/notification/models"
 	reservationmodels "wish-list/internal/domain/reservation/models"
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/pkg/presence"
 )
 
 // Ensure, that GiftItemRepositoryInterfaceMock does implement GiftItemRepositoryInterface.
@@ -30,6 +34,12 @@ var _ GiftItemRepositoryInterface = &GiftItemRepositoryInterfaceMock{}
 //			GetByWishListFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
 //				panic("mock out the GetByWishList method")
 //			},
+//			GetPublicWishListGiftItemGroupsFunc: func(ctx context.Context, publicSlug string, groupBy string) ([]*itemmodels.GiftItemGroup, error) {
+//				panic("mock out the GetPublicWishListGiftItemGroups method")
+//			},
+//			GetPublicWishListGiftItemsFunc: func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
+//				panic("mock out the GetPublicWishListGiftItems method")
+//			},
 //			GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit int, offset int) ([]*itemmodels.GiftItem, int, error) {
 //				panic("mock out the GetPublicWishListGiftItemsPaginated method")
 //			},
@@ -52,6 +62,12 @@ type GiftItemRepositoryInterfaceMock struct {
 	// GetByWishListFunc mocks the GetByWishList method.
 	GetByWishListFunc func(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error)
 
+	// GetPublicWishListGiftItemGroupsFunc mocks the GetPublicWishListGiftItemGroups method.
+	GetPublicWishListGiftItemGroupsFunc func(ctx context.Context, publicSlug string, groupBy string) ([]*itemmodels.GiftItemGroup, error)
+
+	// GetPublicWishListGiftItemsFunc mocks the GetPublicWishListGiftItems method.
+	GetPublicWishListGiftItemsFunc func(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error)
+
 	// GetPublicWishListGiftItemsPaginatedFunc mocks the GetPublicWishListGiftItemsPaginated method.
 	GetPublicWishListGiftItemsPaginatedFunc func(ctx context.Context, publicSlug string, limit int, offset int) ([]*itemmodels.GiftItem, int, error)
 
@@ -81,6 +97,22 @@ type GiftItemRepositoryInterfaceMock struct {
 			// WishlistID is the wishlistID argument value.
 			WishlistID pgtype.UUID
 		}
+		// GetPublicWishListGiftItemGroups holds details about calls to the GetPublicWishListGiftItemGroups method.
+		GetPublicWishListGiftItemGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+			// GroupBy is the groupBy argument value.
+			GroupBy string
+		}
+		// GetPublicWishListGiftItems holds details about calls to the GetPublicWishListGiftItems method.
+		GetPublicWishListGiftItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicSlug is the publicSlug argument value.
+			PublicSlug string
+		}
 		// GetPublicWishListGiftItemsPaginated holds details about calls to the GetPublicWishListGiftItemsPaginated method.
 		GetPublicWishListGiftItemsPaginated []struct {
 			// Ctx is the ctx argument value.
@@ -103,6 +135,8 @@ type GiftItemRepositoryInterfaceMock struct {
 	lockCreateWithOwner                     sync.RWMutex
 	lockGetByID                             sync.RWMutex
 	lockGetByWishList                       sync.RWMutex
+	lockGetPublicWishListGiftItemGroups     sync.RWMutex
+	lockGetPublicWishListGiftItems          sync.RWMutex
 	lockGetPublicWishListGiftItemsPaginated sync.RWMutex
 	lockUpdate                              sync.RWMutex
 }
@@ -215,6 +249,82 @@ func (mock *GiftItemRepositoryInterfaceMock) GetByWishListCalls() []struct {
 	return calls
 }
 
+// GetPublicWishListGiftItemGroups calls GetPublicWishListGiftItemGroupsFunc.
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemGroups(ctx context.Context, publicSlug string, groupBy string) ([]*itemmodels.GiftItemGroup, error) {
+	if mock.GetPublicWishListGiftItemGroupsFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.GetPublicWishListGiftItemGroupsFunc: method is nil but GiftItemRepositoryInterface.GetPublicWishListGiftItemGroups was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+		GroupBy    string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+		GroupBy:    groupBy,
+	}
+	mock.lockGetPublicWishListGiftItemGroups.Lock()
+	mock.calls.GetPublicWishListGiftItemGroups = append(mock.calls.GetPublicWishListGiftItemGroups, callInfo)
+	mock.lockGetPublicWishListGiftItemGroups.Unlock()
+	return mock.GetPublicWishListGiftItemGroupsFunc(ctx, publicSlug, groupBy)
+}
+
+// GetPublicWishListGiftItemGroupsCalls gets all the calls that were made to GetPublicWishListGiftItemGroups.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.GetPublicWishListGiftItemGroupsCalls())
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemGroupsCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+	GroupBy    string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+		GroupBy    string
+	}
+	mock.lockGetPublicWishListGiftItemGroups.RLock()
+	calls = mock.calls.GetPublicWishListGiftItemGroups
+	mock.lockGetPublicWishListGiftItemGroups.RUnlock()
+	return calls
+}
+
+// GetPublicWishListGiftItems calls GetPublicWishListGiftItemsFunc.
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItems(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error) {
+	if mock.GetPublicWishListGiftItemsFunc == nil {
+		panic("GiftItemRepositoryInterfaceMock.GetPublicWishListGiftItemsFunc: method is nil but GiftItemRepositoryInterface.GetPublicWishListGiftItems was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicSlug string
+	}{
+		Ctx:        ctx,
+		PublicSlug: publicSlug,
+	}
+	mock.lockGetPublicWishListGiftItems.Lock()
+	mock.calls.GetPublicWishListGiftItems = append(mock.calls.GetPublicWishListGiftItems, callInfo)
+	mock.lockGetPublicWishListGiftItems.Unlock()
+	return mock.GetPublicWishListGiftItemsFunc(ctx, publicSlug)
+}
+
+// GetPublicWishListGiftItemsCalls gets all the calls that were made to GetPublicWishListGiftItems.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.GetPublicWishListGiftItemsCalls())
+func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemsCalls() []struct {
+	Ctx        context.Context
+	PublicSlug string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicSlug string
+	}
+	mock.lockGetPublicWishListGiftItems.RLock()
+	calls = mock.calls.GetPublicWishListGiftItems
+	mock.lockGetPublicWishListGiftItems.RUnlock()
+	return calls
+}
+
 // GetPublicWishListGiftItemsPaginated calls GetPublicWishListGiftItemsPaginatedFunc.
 func (mock *GiftItemRepositoryInterfaceMock) GetPublicWishListGiftItemsPaginated(ctx context.Context, publicSlug string, limit int, offset int) ([]*itemmodels.GiftItem, int, error) {
 	if mock.GetPublicWishListGiftItemsPaginatedFunc == nil {
@@ -308,6 +418,9 @@ var _ ReservationRepositoryInterface = &ReservationRepositoryInterfaceMock{}
 //			GetActiveReservationForGiftItemFunc: func(ctx context.Context, giftItemID pgtype.UUID) (*reservationmodels.Reservation, error) {
 //				panic("mock out the GetActiveReservationForGiftItem method")
 //			},
+//			HasActiveReservationsForWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+//				panic("mock out the HasActiveReservationsForWishlist method")
+//			},
 //		}
 //
 //		// use mockedReservationRepositoryInterface in code that requires ReservationRepositoryInterface
@@ -318,6 +431,9 @@ type ReservationRepositoryInterfaceMock struct {
 	// GetActiveReservationForGiftItemFunc mocks the GetActiveReservationForGiftItem method.
 	GetActiveReservationForGiftItemFunc func(ctx context.Context, giftItemID pgtype.UUID) (*reservationmodels.Reservation, error)
 
+	// HasActiveReservationsForWishlistFunc mocks the HasActiveReservationsForWishlist method.
+	HasActiveReservationsForWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetActiveReservationForGiftItem holds details about calls to the GetActiveReservationForGiftItem method.
@@ -327,8 +443,16 @@ type ReservationRepositoryInterfaceMock struct {
 			// GiftItemID is the giftItemID argument value.
 			GiftItemID pgtype.UUID
 		}
+		// HasActiveReservationsForWishlist holds details about calls to the HasActiveReservationsForWishlist method.
+		HasActiveReservationsForWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
 	}
-	lockGetActiveReservationForGiftItem sync.RWMutex
+	lockGetActiveReservationForGiftItem  sync.RWMutex
+	lockHasActiveReservationsForWishlist sync.RWMutex
 }
 
 // GetActiveReservationForGiftItem calls GetActiveReservationForGiftItemFunc.
@@ -367,6 +491,42 @@ func (mock *ReservationRepositoryInterfaceMock) GetActiveReservationForGiftItemC
 	return calls
 }
 
+// HasActiveReservationsForWishlist calls HasActiveReservationsForWishlistFunc.
+func (mock *ReservationRepositoryInterfaceMock) HasActiveReservationsForWishlist(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+	if mock.HasActiveReservationsForWishlistFunc == nil {
+		panic("ReservationRepositoryInterfaceMock.HasActiveReservationsForWishlistFunc: method is nil but ReservationRepositoryInterface.HasActiveReservationsForWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockHasActiveReservationsForWishlist.Lock()
+	mock.calls.HasActiveReservationsForWishlist = append(mock.calls.HasActiveReservationsForWishlist, callInfo)
+	mock.lockHasActiveReservationsForWishlist.Unlock()
+	return mock.HasActiveReservationsForWishlistFunc(ctx, wishlistID)
+}
+
+// HasActiveReservationsForWishlistCalls gets all the calls that were made to HasActiveReservationsForWishlist.
+// Check the length with:
+//
+//	len(mockedReservationRepositoryInterface.HasActiveReservationsForWishlistCalls())
+func (mock *ReservationRepositoryInterfaceMock) HasActiveReservationsForWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockHasActiveReservationsForWishlist.RLock()
+	calls = mock.calls.HasActiveReservationsForWishlist
+	mock.lockHasActiveReservationsForWishlist.RUnlock()
+	return calls
+}
+
 // Ensure, that EmailServiceInterfaceMock does implement EmailServiceInterface.
 // If this is not the case, regenerate this file with moq.
 var _ EmailServiceInterface = &EmailServiceInterfaceMock{}
@@ -377,7 +537,7 @@ var _ EmailServiceInterface = &EmailServiceInterfaceMock{}
 //
 //		// make and configure a mocked EmailServiceInterface
 //		mockedEmailServiceInterface := &EmailServiceInterfaceMock{
-//			SendGiftPurchasedConfirmationEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string) error {
+//			SendGiftPurchasedConfirmationEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string, message string) error {
 //				panic("mock out the SendGiftPurchasedConfirmationEmail method")
 //			},
 //			SendReservationRemovedEmailFunc: func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string) error {
@@ -391,7 +551,7 @@ var _ EmailServiceInterface = &EmailServiceInterfaceMock{}
 //	}
 type EmailServiceInterfaceMock struct {
 	// SendGiftPurchasedConfirmationEmailFunc mocks the SendGiftPurchasedConfirmationEmail method.
-	SendGiftPurchasedConfirmationEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string) error
+	SendGiftPurchasedConfirmationEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string, message string) error
 
 	// SendReservationRemovedEmailFunc mocks the SendReservationRemovedEmail method.
 	SendReservationRemovedEmailFunc func(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string) error
@@ -410,6 +570,8 @@ type EmailServiceInterfaceMock struct {
 			WishlistTitle string
 			// GuestName is the guestName argument value.
 			GuestName string
+			// Message is the message argument value.
+			Message string
 		}
 		// SendReservationRemovedEmail holds details about calls to the SendReservationRemovedEmail method.
 		SendReservationRemovedEmail []struct {
@@ -428,7 +590,7 @@ type EmailServiceInterfaceMock struct {
 }
 
 // SendGiftPurchasedConfirmationEmail calls SendGiftPurchasedConfirmationEmailFunc.
-func (mock *EmailServiceInterfaceMock) SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string) error {
+func (mock *EmailServiceInterfaceMock) SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail string, giftItemName string, wishlistTitle string, guestName string, message string) error {
 	if mock.SendGiftPurchasedConfirmationEmailFunc == nil {
 		panic("EmailServiceInterfaceMock.SendGiftPurchasedConfirmationEmailFunc: method is nil but EmailServiceInterface.SendGiftPurchasedConfirmationEmail was just called")
 	}
@@ -438,17 +600,19 @@ func (mock *EmailServiceInterfaceMock) SendGiftPurchasedConfirmationEmail(ctx co
 		GiftItemName   string
 		WishlistTitle  string
 		GuestName      string
+		Message        string
 	}{
 		Ctx:            ctx,
 		RecipientEmail: recipientEmail,
 		GiftItemName:   giftItemName,
 		WishlistTitle:  wishlistTitle,
 		GuestName:      guestName,
+		Message:        message,
 	}
 	mock.lockSendGiftPurchasedConfirmationEmail.Lock()
 	mock.calls.SendGiftPurchasedConfirmationEmail = append(mock.calls.SendGiftPurchasedConfirmationEmail, callInfo)
 	mock.lockSendGiftPurchasedConfirmationEmail.Unlock()
-	return mock.SendGiftPurchasedConfirmationEmailFunc(ctx, recipientEmail, giftItemName, wishlistTitle, guestName)
+	return mock.SendGiftPurchasedConfirmationEmailFunc(ctx, recipientEmail, giftItemName, wishlistTitle, guestName, message)
 }
 
 // SendGiftPurchasedConfirmationEmailCalls gets all the calls that were made to SendGiftPurchasedConfirmationEmail.
@@ -461,6 +625,7 @@ func (mock *EmailServiceInterfaceMock) SendGiftPurchasedConfirmationEmailCalls()
 	GiftItemName   string
 	WishlistTitle  string
 	GuestName      string
+	Message        string
 } {
 	var calls []struct {
 		Ctx            context.Context
@@ -468,6 +633,7 @@ func (mock *EmailServiceInterfaceMock) SendGiftPurchasedConfirmationEmailCalls()
 		GiftItemName   string
 		WishlistTitle  string
 		GuestName      string
+		Message        string
 	}
 	mock.lockSendGiftPurchasedConfirmationEmail.RLock()
 	calls = mock.calls.SendGiftPurchasedConfirmationEmail
@@ -702,3 +868,1111 @@ func (mock *CacheInterfaceMock) SetCalls() []struct {
 	mock.lockSet.RUnlock()
 	return calls
 }
+
+// Ensure, that GiftHistoryRecorderInterfaceMock does implement GiftHistoryRecorderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GiftHistoryRecorderInterface = &GiftHistoryRecorderInterfaceMock{}
+
+// GiftHistoryRecorderInterfaceMock is a mock implementation of GiftHistoryRecorderInterface.
+//
+//	func TestSomethingThatUsesGiftHistoryRecorderInterface(t *testing.T) {
+//
+//		// make and configure a mocked GiftHistoryRecorderInterface
+//		mockedGiftHistoryRecorderInterface := &GiftHistoryRecorderInterfaceMock{
+//			SnapshotWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+//				panic("mock out the SnapshotWishlist method")
+//			},
+//		}
+//
+//		// use mockedGiftHistoryRecorderInterface in code that requires GiftHistoryRecorderInterface
+//		// and then make assertions.
+//
+//	}
+type GiftHistoryRecorderInterfaceMock struct {
+	// SnapshotWishlistFunc mocks the SnapshotWishlist method.
+	SnapshotWishlistFunc func(ctx context.Context, wishlistID pgtype.UUID) (int64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SnapshotWishlist holds details about calls to the SnapshotWishlist method.
+		SnapshotWishlist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+	}
+	lockSnapshotWishlist sync.RWMutex
+}
+
+// SnapshotWishlist calls SnapshotWishlistFunc.
+func (mock *GiftHistoryRecorderInterfaceMock) SnapshotWishlist(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+	if mock.SnapshotWishlistFunc == nil {
+		panic("GiftHistoryRecorderInterfaceMock.SnapshotWishlistFunc: method is nil but GiftHistoryRecorderInterface.SnapshotWishlist was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockSnapshotWishlist.Lock()
+	mock.calls.SnapshotWishlist = append(mock.calls.SnapshotWishlist, callInfo)
+	mock.lockSnapshotWishlist.Unlock()
+	return mock.SnapshotWishlistFunc(ctx, wishlistID)
+}
+
+// SnapshotWishlistCalls gets all the calls that were made to SnapshotWishlist.
+// Check the length with:
+//
+//	len(mockedGiftHistoryRecorderInterface.SnapshotWishlistCalls())
+func (mock *GiftHistoryRecorderInterfaceMock) SnapshotWishlistCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockSnapshotWishlist.RLock()
+	calls = mock.calls.SnapshotWishlist
+	mock.lockSnapshotWishlist.RUnlock()
+	return calls
+}
+
+// Ensure, that QuotaCheckerInterfaceMock does implement QuotaCheckerInterface.
+// If this is not the case, regenerate this file with moq.
+var _ QuotaCheckerInterface = &QuotaCheckerInterfaceMock{}
+
+// QuotaCheckerInterfaceMock is a mock implementation of QuotaCheckerInterface.
+//
+//	func TestSomethingThatUsesQuotaCheckerInterface(t *testing.T) {
+//
+//		// make and configure a mocked QuotaCheckerInterface
+//		mockedQuotaCheckerInterface := &QuotaCheckerInterfaceMock{
+//			AllowsCustomSlugFunc: func(ctx context.Context, userID pgtype.UUID) (bool, error) {
+//				panic("mock out the AllowsCustomSlug method")
+//			},
+//			CheckItemQuotaFunc: func(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error {
+//				panic("mock out the CheckItemQuota method")
+//			},
+//			CheckWishListQuotaFunc: func(ctx context.Context, userID pgtype.UUID) error {
+//				panic("mock out the CheckWishListQuota method")
+//			},
+//		}
+//
+//		// use mockedQuotaCheckerInterface in code that requires QuotaCheckerInterface
+//		// and then make assertions.
+//
+//	}
+type QuotaCheckerInterfaceMock struct {
+	// AllowsCustomSlugFunc mocks the AllowsCustomSlug method.
+	AllowsCustomSlugFunc func(ctx context.Context, userID pgtype.UUID) (bool, error)
+
+	// CheckItemQuotaFunc mocks the CheckItemQuota method.
+	CheckItemQuotaFunc func(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error
+
+	// CheckWishListQuotaFunc mocks the CheckWishListQuota method.
+	CheckWishListQuotaFunc func(ctx context.Context, userID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AllowsCustomSlug holds details about calls to the AllowsCustomSlug method.
+		AllowsCustomSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+		// CheckItemQuota holds details about calls to the CheckItemQuota method.
+		CheckItemQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+		// CheckWishListQuota holds details about calls to the CheckWishListQuota method.
+		CheckWishListQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+		}
+	}
+	lockAllowsCustomSlug   sync.RWMutex
+	lockCheckItemQuota     sync.RWMutex
+	lockCheckWishListQuota sync.RWMutex
+}
+
+// AllowsCustomSlug calls AllowsCustomSlugFunc.
+func (mock *QuotaCheckerInterfaceMock) AllowsCustomSlug(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	if mock.AllowsCustomSlugFunc == nil {
+		panic("QuotaCheckerInterfaceMock.AllowsCustomSlugFunc: method is nil but QuotaCheckerInterface.AllowsCustomSlug was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockAllowsCustomSlug.Lock()
+	mock.calls.AllowsCustomSlug = append(mock.calls.AllowsCustomSlug, callInfo)
+	mock.lockAllowsCustomSlug.Unlock()
+	return mock.AllowsCustomSlugFunc(ctx, userID)
+}
+
+// AllowsCustomSlugCalls gets all the calls that were made to AllowsCustomSlug.
+// Check the length with:
+//
+//	len(mockedQuotaCheckerInterface.AllowsCustomSlugCalls())
+func (mock *QuotaCheckerInterfaceMock) AllowsCustomSlugCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockAllowsCustomSlug.RLock()
+	calls = mock.calls.AllowsCustomSlug
+	mock.lockAllowsCustomSlug.RUnlock()
+	return calls
+}
+
+// CheckItemQuota calls CheckItemQuotaFunc.
+func (mock *QuotaCheckerInterfaceMock) CheckItemQuota(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error {
+	if mock.CheckItemQuotaFunc == nil {
+		panic("QuotaCheckerInterfaceMock.CheckItemQuotaFunc: method is nil but QuotaCheckerInterface.CheckItemQuota was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		UserID:     userID,
+		WishlistID: wishlistID,
+	}
+	mock.lockCheckItemQuota.Lock()
+	mock.calls.CheckItemQuota = append(mock.calls.CheckItemQuota, callInfo)
+	mock.lockCheckItemQuota.Unlock()
+	return mock.CheckItemQuotaFunc(ctx, userID, wishlistID)
+}
+
+// CheckItemQuotaCalls gets all the calls that were made to CheckItemQuota.
+// Check the length with:
+//
+//	len(mockedQuotaCheckerInterface.CheckItemQuotaCalls())
+func (mock *QuotaCheckerInterfaceMock) CheckItemQuotaCalls() []struct {
+	Ctx        context.Context
+	UserID     pgtype.UUID
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		WishlistID pgtype.UUID
+	}
+	mock.lockCheckItemQuota.RLock()
+	calls = mock.calls.CheckItemQuota
+	mock.lockCheckItemQuota.RUnlock()
+	return calls
+}
+
+// CheckWishListQuota calls CheckWishListQuotaFunc.
+func (mock *QuotaCheckerInterfaceMock) CheckWishListQuota(ctx context.Context, userID pgtype.UUID) error {
+	if mock.CheckWishListQuotaFunc == nil {
+		panic("QuotaCheckerInterfaceMock.CheckWishListQuotaFunc: method is nil but QuotaCheckerInterface.CheckWishListQuota was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockCheckWishListQuota.Lock()
+	mock.calls.CheckWishListQuota = append(mock.calls.CheckWishListQuota, callInfo)
+	mock.lockCheckWishListQuota.Unlock()
+	return mock.CheckWishListQuotaFunc(ctx, userID)
+}
+
+// CheckWishListQuotaCalls gets all the calls that were made to CheckWishListQuota.
+// Check the length with:
+//
+//	len(mockedQuotaCheckerInterface.CheckWishListQuotaCalls())
+func (mock *QuotaCheckerInterfaceMock) CheckWishListQuotaCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+	}
+	mock.lockCheckWishListQuota.RLock()
+	calls = mock.calls.CheckWishListQuota
+	mock.lockCheckWishListQuota.RUnlock()
+	return calls
+}
+
+// Ensure, that ContentModeratorInterfaceMock does implement ContentModeratorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ContentModeratorInterface = &ContentModeratorInterfaceMock{}
+
+// ContentModeratorInterfaceMock is a mock implementation of ContentModeratorInterface.
+//
+//	func TestSomethingThatUsesContentModeratorInterface(t *testing.T) {
+//
+//		// make and configure a mocked ContentModeratorInterface
+//		mockedContentModeratorInterface := &ContentModeratorInterfaceMock{
+//			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+//				panic("mock out the CheckContent method")
+//			},
+//			CheckPublishableFunc: func(ctx context.Context, wishlistID pgtype.UUID) error {
+//				panic("mock out the CheckPublishable method")
+//			},
+//		}
+//
+//		// use mockedContentModeratorInterface in code that requires ContentModeratorInterface
+//		// and then make assertions.
+//
+//	}
+type ContentModeratorInterfaceMock struct {
+	// CheckContentFunc mocks the CheckContent method.
+	CheckContentFunc func(ctx context.Context, imageURL string, texts ...string) error
+
+	// CheckPublishableFunc mocks the CheckPublishable method.
+	CheckPublishableFunc func(ctx context.Context, wishlistID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckContent holds details about calls to the CheckContent method.
+		CheckContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ImageURL is the imageURL argument value.
+			ImageURL string
+			// Texts is the texts argument value.
+			Texts []string
+		}
+		// CheckPublishable holds details about calls to the CheckPublishable method.
+		CheckPublishable []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+	}
+	lockCheckContent     sync.RWMutex
+	lockCheckPublishable sync.RWMutex
+}
+
+// CheckContent calls CheckContentFunc.
+func (mock *ContentModeratorInterfaceMock) CheckContent(ctx context.Context, imageURL string, texts ...string) error {
+	if mock.CheckContentFunc == nil {
+		panic("ContentModeratorInterfaceMock.CheckContentFunc: method is nil but ContentModeratorInterface.CheckContent was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}{
+		Ctx:      ctx,
+		ImageURL: imageURL,
+		Texts:    texts,
+	}
+	mock.lockCheckContent.Lock()
+	mock.calls.CheckContent = append(mock.calls.CheckContent, callInfo)
+	mock.lockCheckContent.Unlock()
+	return mock.CheckContentFunc(ctx, imageURL, texts...)
+}
+
+// CheckContentCalls gets all the calls that were made to CheckContent.
+// Check the length with:
+//
+//	len(mockedContentModeratorInterface.CheckContentCalls())
+func (mock *ContentModeratorInterfaceMock) CheckContentCalls() []struct {
+	Ctx      context.Context
+	ImageURL string
+	Texts    []string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}
+	mock.lockCheckContent.RLock()
+	calls = mock.calls.CheckContent
+	mock.lockCheckContent.RUnlock()
+	return calls
+}
+
+// CheckPublishable calls CheckPublishableFunc.
+func (mock *ContentModeratorInterfaceMock) CheckPublishable(ctx context.Context, wishlistID pgtype.UUID) error {
+	if mock.CheckPublishableFunc == nil {
+		panic("ContentModeratorInterfaceMock.CheckPublishableFunc: method is nil but ContentModeratorInterface.CheckPublishable was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockCheckPublishable.Lock()
+	mock.calls.CheckPublishable = append(mock.calls.CheckPublishable, callInfo)
+	mock.lockCheckPublishable.Unlock()
+	return mock.CheckPublishableFunc(ctx, wishlistID)
+}
+
+// CheckPublishableCalls gets all the calls that were made to CheckPublishable.
+// Check the length with:
+//
+//	len(mockedContentModeratorInterface.CheckPublishableCalls())
+func (mock *ContentModeratorInterfaceMock) CheckPublishableCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockCheckPublishable.RLock()
+	calls = mock.calls.CheckPublishable
+	mock.lockCheckPublishable.RUnlock()
+	return calls
+}
+
+// Ensure, that GiftItemImageRepositoryInterfaceMock does implement GiftItemImageRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ GiftItemImageRepositoryInterface = &GiftItemImageRepositoryInterfaceMock{}
+
+// GiftItemImageRepositoryInterfaceMock is a mock implementation of GiftItemImageRepositoryInterface.
+//
+//	func TestSomethingThatUsesGiftItemImageRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked GiftItemImageRepositoryInterface
+//		mockedGiftItemImageRepositoryInterface := &GiftItemImageRepositoryInterfaceMock{
+//			GetByItemIDsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*itemmodels.GiftItemImage, error) {
+//				panic("mock out the GetByItemIDs method")
+//			},
+//		}
+//
+//		// use mockedGiftItemImageRepositoryInterface in code that requires GiftItemImageRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type GiftItemImageRepositoryInterfaceMock struct {
+	// GetByItemIDsFunc mocks the GetByItemIDs method.
+	GetByItemIDsFunc func(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*itemmodels.GiftItemImage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByItemIDs holds details about calls to the GetByItemIDs method.
+		GetByItemIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemIDs is the itemIDs argument value.
+			ItemIDs []pgtype.UUID
+		}
+	}
+	lockGetByItemIDs sync.RWMutex
+}
+
+// GetByItemIDs calls GetByItemIDsFunc.
+func (mock *GiftItemImageRepositoryInterfaceMock) GetByItemIDs(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*itemmodels.GiftItemImage, error) {
+	if mock.GetByItemIDsFunc == nil {
+		panic("GiftItemImageRepositoryInterfaceMock.GetByItemIDsFunc: method is nil but GiftItemImageRepositoryInterface.GetByItemIDs was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ItemIDs: itemIDs,
+	}
+	mock.lockGetByItemIDs.Lock()
+	mock.calls.GetByItemIDs = append(mock.calls.GetByItemIDs, callInfo)
+	mock.lockGetByItemIDs.Unlock()
+	return mock.GetByItemIDsFunc(ctx, itemIDs)
+}
+
+// GetByItemIDsCalls gets all the calls that were made to GetByItemIDs.
+// Check the length with:
+//
+//	len(mockedGiftItemImageRepositoryInterface.GetByItemIDsCalls())
+func (mock *GiftItemImageRepositoryInterfaceMock) GetByItemIDsCalls() []struct {
+	Ctx     context.Context
+	ItemIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}
+	mock.lockGetByItemIDs.RLock()
+	calls = mock.calls.GetByItemIDs
+	mock.lockGetByItemIDs.RUnlock()
+	return calls
+}
+
+// Ensure, that NotifierInterfaceMock does implement NotifierInterface.
+// If this is not the case, regenerate this file with moq.
+var _ NotifierInterface = &NotifierInterfaceMock{}
+
+// NotifierInterfaceMock is a mock implementation of NotifierInterface.
+//
+//	func TestSomethingThatUsesNotifierInterface(t *testing.T) {
+//
+//		// make and configure a mocked NotifierInterface
+//		mockedNotifierInterface := &NotifierInterfaceMock{
+//			NotifyFunc: func(ctx context.Context, notification notificationmodels.Notification) error {
+//				panic("mock out the Notify method")
+//			},
+//		}
+//
+//		// use mockedNotifierInterface in code that requires NotifierInterface
+//		// and then make assertions.
+//
+//	}
+type NotifierInterfaceMock struct {
+	// NotifyFunc mocks the Notify method.
+	NotifyFunc func(ctx context.Context, notification notificationmodels.Notification) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Notify holds details about calls to the Notify method.
+		Notify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Notification is the notification argument value.
+			Notification notificationmodels.Notification
+		}
+	}
+	lockNotify sync.RWMutex
+}
+
+// Notify calls NotifyFunc.
+func (mock *NotifierInterfaceMock) Notify(ctx context.Context, notification notificationmodels.Notification) error {
+	if mock.NotifyFunc == nil {
+		panic("NotifierInterfaceMock.NotifyFunc: method is nil but NotifierInterface.Notify was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}{
+		Ctx:          ctx,
+		Notification: notification,
+	}
+	mock.lockNotify.Lock()
+	mock.calls.Notify = append(mock.calls.Notify, callInfo)
+	mock.lockNotify.Unlock()
+	return mock.NotifyFunc(ctx, notification)
+}
+
+// NotifyCalls gets all the calls that were made to Notify.
+// Check the length with:
+//
+//	len(mockedNotifierInterface.NotifyCalls())
+func (mock *NotifierInterfaceMock) NotifyCalls() []struct {
+	Ctx          context.Context
+	Notification notificationmodels.Notification
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Notification notificationmodels.Notification
+	}
+	mock.lockNotify.RLock()
+	calls = mock.calls.Notify
+	mock.lockNotify.RUnlock()
+	return calls
+}
+
+// Ensure, that PresenceTrackerInterfaceMock does implement PresenceTrackerInterface.
+// If this is not the case, regenerate this file with moq.
+var _ PresenceTrackerInterface = &PresenceTrackerInterfaceMock{}
+
+// PresenceTrackerInterfaceMock is a mock implementation of PresenceTrackerInterface.
+//
+//	func TestSomethingThatUsesPresenceTrackerInterface(t *testing.T) {
+//
+//		// make and configure a mocked PresenceTrackerInterface
+//		mockedPresenceTrackerInterface := &PresenceTrackerInterfaceMock{
+//			LeaveFunc: func(ctx context.Context, room string, sessionID string) error {
+//				panic("mock out the Leave method")
+//			},
+//			TouchFunc: func(ctx context.Context, room string, sessionID string, ttl time.Duration) ([]presence.Session, error) {
+//				panic("mock out the Touch method")
+//			},
+//		}
+//
+//		// use mockedPresenceTrackerInterface in code that requires PresenceTrackerInterface
+//		// and then make assertions.
+//
+//	}
+type PresenceTrackerInterfaceMock struct {
+	// LeaveFunc mocks the Leave method.
+	LeaveFunc func(ctx context.Context, room string, sessionID string) error
+
+	// TouchFunc mocks the Touch method.
+	TouchFunc func(ctx context.Context, room string, sessionID string, ttl time.Duration) ([]presence.Session, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Leave holds details about calls to the Leave method.
+		Leave []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Room is the room argument value.
+			Room string
+			// SessionID is the sessionID argument value.
+			SessionID string
+		}
+		// Touch holds details about calls to the Touch method.
+		Touch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Room is the room argument value.
+			Room string
+			// SessionID is the sessionID argument value.
+			SessionID string
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+	}
+	lockLeave sync.RWMutex
+	lockTouch sync.RWMutex
+}
+
+// Leave calls LeaveFunc.
+func (mock *PresenceTrackerInterfaceMock) Leave(ctx context.Context, room string, sessionID string) error {
+	if mock.LeaveFunc == nil {
+		panic("PresenceTrackerInterfaceMock.LeaveFunc: method is nil but PresenceTrackerInterface.Leave was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Room      string
+		SessionID string
+	}{
+		Ctx:       ctx,
+		Room:      room,
+		SessionID: sessionID,
+	}
+	mock.lockLeave.Lock()
+	mock.calls.Leave = append(mock.calls.Leave, callInfo)
+	mock.lockLeave.Unlock()
+	return mock.LeaveFunc(ctx, room, sessionID)
+}
+
+// LeaveCalls gets all the calls that were made to Leave.
+// Check the length with:
+//
+//	len(mockedPresenceTrackerInterface.LeaveCalls())
+func (mock *PresenceTrackerInterfaceMock) LeaveCalls() []struct {
+	Ctx       context.Context
+	Room      string
+	SessionID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Room      string
+		SessionID string
+	}
+	mock.lockLeave.RLock()
+	calls = mock.calls.Leave
+	mock.lockLeave.RUnlock()
+	return calls
+}
+
+// Touch calls TouchFunc.
+func (mock *PresenceTrackerInterfaceMock) Touch(ctx context.Context, room string, sessionID string, ttl time.Duration) ([]presence.Session, error) {
+	if mock.TouchFunc == nil {
+		panic("PresenceTrackerInterfaceMock.TouchFunc: method is nil but PresenceTrackerInterface.Touch was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Room      string
+		SessionID string
+		TTL       time.Duration
+	}{
+		Ctx:       ctx,
+		Room:      room,
+		SessionID: sessionID,
+		TTL:       ttl,
+	}
+	mock.lockTouch.Lock()
+	mock.calls.Touch = append(mock.calls.Touch, callInfo)
+	mock.lockTouch.Unlock()
+	return mock.TouchFunc(ctx, room, sessionID, ttl)
+}
+
+// TouchCalls gets all the calls that were made to Touch.
+// Check the length with:
+//
+//	len(mockedPresenceTrackerInterface.TouchCalls())
+func (mock *PresenceTrackerInterfaceMock) TouchCalls() []struct {
+	Ctx       context.Context
+	Room      string
+	SessionID string
+	TTL       time.Duration
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Room      string
+		SessionID string
+		TTL       time.Duration
+	}
+	mock.lockTouch.RLock()
+	calls = mock.calls.Touch
+	mock.lockTouch.RUnlock()
+	return calls
+}
+
+// Ensure, that ItemActivityRecorderInterfaceMock does implement ItemActivityRecorderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ItemActivityRecorderInterface = &ItemActivityRecorderInterfaceMock{}
+
+// ItemActivityRecorderInterfaceMock is a mock implementation of ItemActivityRecorderInterface.
+//
+//	func TestSomethingThatUsesItemActivityRecorderInterface(t *testing.T) {
+//
+//		// make and configure a mocked ItemActivityRecorderInterface
+//		mockedItemActivityRecorderInterface := &ItemActivityRecorderInterfaceMock{
+//			RecordItemViewsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) error {
+//				panic("mock out the RecordItemViews method")
+//			},
+//		}
+//
+//		// use mockedItemActivityRecorderInterface in code that requires ItemActivityRecorderInterface
+//		// and then make assertions.
+//
+//	}
+type ItemActivityRecorderInterfaceMock struct {
+	// RecordItemViewsFunc mocks the RecordItemViews method.
+	RecordItemViewsFunc func(ctx context.Context, itemIDs []pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RecordItemViews holds details about calls to the RecordItemViews method.
+		RecordItemViews []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemIDs is the itemIDs argument value.
+			ItemIDs []pgtype.UUID
+		}
+	}
+	lockRecordItemViews sync.RWMutex
+}
+
+// RecordItemViews calls RecordItemViewsFunc.
+func (mock *ItemActivityRecorderInterfaceMock) RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error {
+	if mock.RecordItemViewsFunc == nil {
+		panic("ItemActivityRecorderInterfaceMock.RecordItemViewsFunc: method is nil but ItemActivityRecorderInterface.RecordItemViews was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}{
+		Ctx:     ctx,
+		ItemIDs: itemIDs,
+	}
+	mock.lockRecordItemViews.Lock()
+	mock.calls.RecordItemViews = append(mock.calls.RecordItemViews, callInfo)
+	mock.lockRecordItemViews.Unlock()
+	return mock.RecordItemViewsFunc(ctx, itemIDs)
+}
+
+// RecordItemViewsCalls gets all the calls that were made to RecordItemViews.
+// Check the length with:
+//
+//	len(mockedItemActivityRecorderInterface.RecordItemViewsCalls())
+func (mock *ItemActivityRecorderInterfaceMock) RecordItemViewsCalls() []struct {
+	Ctx     context.Context
+	ItemIDs []pgtype.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ItemIDs []pgtype.UUID
+	}
+	mock.lockRecordItemViews.RLock()
+	calls = mock.calls.RecordItemViews
+	mock.lockRecordItemViews.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRepositoryInterfaceMock does implement UserRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ UserRepositoryInterface = &UserRepositoryInterfaceMock{}
+
+// UserRepositoryInterfaceMock is a mock implementation of UserRepositoryInterface.
+//
+//	func TestSomethingThatUsesUserRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked UserRepositoryInterface
+//		mockedUserRepositoryInterface := &UserRepositoryInterfaceMock{
+//			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+//				panic("mock out the GetByID method")
+//			},
+//		}
+//
+//		// use mockedUserRepositoryInterface in code that requires UserRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryInterfaceMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
+	}
+	lockGetByID sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *UserRepositoryInterfaceMock) GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+	if mock.GetByIDFunc == nil {
+		panic("UserRepositoryInterfaceMock.GetByIDFunc: method is nil but UserRepositoryInterface.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedUserRepositoryInterface.GetByIDCalls())
+func (mock *UserRepositoryInterfaceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Ensure, that ViewBufferInterfaceMock does implement ViewBufferInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ViewBufferInterface = &ViewBufferInterfaceMock{}
+
+// ViewBufferInterfaceMock is a mock implementation of ViewBufferInterface.
+//
+//	func TestSomethingThatUsesViewBufferInterface(t *testing.T) {
+//
+//		// make and configure a mocked ViewBufferInterface
+//		mockedViewBufferInterface := &ViewBufferInterfaceMock{
+//			DrainFunc: func(ctx context.Context) (map[string]int64, error) {
+//				panic("mock out the Drain method")
+//			},
+//			RecordFunc: func(ctx context.Context, page string, sessionID string, window time.Duration) (int64, error) {
+//				panic("mock out the Record method")
+//			},
+//		}
+//
+//		// use mockedViewBufferInterface in code that requires ViewBufferInterface
+//		// and then make assertions.
+//
+//	}
+type ViewBufferInterfaceMock struct {
+	// DrainFunc mocks the Drain method.
+	DrainFunc func(ctx context.Context) (map[string]int64, error)
+
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, page string, sessionID string, window time.Duration) (int64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Drain holds details about calls to the Drain method.
+		Drain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Page is the page argument value.
+			Page string
+			// SessionID is the sessionID argument value.
+			SessionID string
+			// Window is the window argument value.
+			Window time.Duration
+		}
+	}
+	lockDrain  sync.RWMutex
+	lockRecord sync.RWMutex
+}
+
+// Drain calls DrainFunc.
+func (mock *ViewBufferInterfaceMock) Drain(ctx context.Context) (map[string]int64, error) {
+	if mock.DrainFunc == nil {
+		panic("ViewBufferInterfaceMock.DrainFunc: method is nil but ViewBufferInterface.Drain was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDrain.Lock()
+	mock.calls.Drain = append(mock.calls.Drain, callInfo)
+	mock.lockDrain.Unlock()
+	return mock.DrainFunc(ctx)
+}
+
+// DrainCalls gets all the calls that were made to Drain.
+// Check the length with:
+//
+//	len(mockedViewBufferInterface.DrainCalls())
+func (mock *ViewBufferInterfaceMock) DrainCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDrain.RLock()
+	calls = mock.calls.Drain
+	mock.lockDrain.RUnlock()
+	return calls
+}
+
+// Record calls RecordFunc.
+func (mock *ViewBufferInterfaceMock) Record(ctx context.Context, page string, sessionID string, window time.Duration) (int64, error) {
+	if mock.RecordFunc == nil {
+		panic("ViewBufferInterfaceMock.RecordFunc: method is nil but ViewBufferInterface.Record was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Page      string
+		SessionID string
+		Window    time.Duration
+	}{
+		Ctx:       ctx,
+		Page:      page,
+		SessionID: sessionID,
+		Window:    window,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, page, sessionID, window)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedViewBufferInterface.RecordCalls())
+func (mock *ViewBufferInterfaceMock) RecordCalls() []struct {
+	Ctx       context.Context
+	Page      string
+	SessionID string
+	Window    time.Duration
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Page      string
+		SessionID string
+		Window    time.Duration
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
+
+// Ensure, that InviteEmailSenderInterfaceMock does implement InviteEmailSenderInterface.
+// If this is not the case, regenerate this file with moq.
+var _ InviteEmailSenderInterface = &InviteEmailSenderInterfaceMock{}
+
+// InviteEmailSenderInterfaceMock is a mock implementation of InviteEmailSenderInterface.
+//
+//	func TestSomethingThatUsesInviteEmailSenderInterface(t *testing.T) {
+//
+//		// make and configure a mocked InviteEmailSenderInterface
+//		mockedInviteEmailSenderInterface := &InviteEmailSenderInterfaceMock{
+//			SendWishlistInviteEmailFunc: func(ctx context.Context, recipientEmail string, wishlistTitle string, ownerName string, message string, link string) error {
+//				panic("mock out the SendWishlistInviteEmail method")
+//			},
+//		}
+//
+//		// use mockedInviteEmailSenderInterface in code that requires InviteEmailSenderInterface
+//		// and then make assertions.
+//
+//	}
+type InviteEmailSenderInterfaceMock struct {
+	// SendWishlistInviteEmailFunc mocks the SendWishlistInviteEmail method.
+	SendWishlistInviteEmailFunc func(ctx context.Context, recipientEmail string, wishlistTitle string, ownerName string, message string, link string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SendWishlistInviteEmail holds details about calls to the SendWishlistInviteEmail method.
+		SendWishlistInviteEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecipientEmail is the recipientEmail argument value.
+			RecipientEmail string
+			// WishlistTitle is the wishlistTitle argument value.
+			WishlistTitle string
+			// OwnerName is the ownerName argument value.
+			OwnerName string
+			// Message is the message argument value.
+			Message string
+			// Link is the link argument value.
+			Link string
+		}
+	}
+	lockSendWishlistInviteEmail sync.RWMutex
+}
+
+// SendWishlistInviteEmail calls SendWishlistInviteEmailFunc.
+func (mock *InviteEmailSenderInterfaceMock) SendWishlistInviteEmail(ctx context.Context, recipientEmail string, wishlistTitle string, ownerName string, message string, link string) error {
+	if mock.SendWishlistInviteEmailFunc == nil {
+		panic("InviteEmailSenderInterfaceMock.SendWishlistInviteEmailFunc: method is nil but InviteEmailSenderInterface.SendWishlistInviteEmail was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RecipientEmail string
+		WishlistTitle  string
+		OwnerName      string
+		Message        string
+		Link           string
+	}{
+		Ctx:            ctx,
+		RecipientEmail: recipientEmail,
+		WishlistTitle:  wishlistTitle,
+		OwnerName:      ownerName,
+		Message:        message,
+		Link:           link,
+	}
+	mock.lockSendWishlistInviteEmail.Lock()
+	mock.calls.SendWishlistInviteEmail = append(mock.calls.SendWishlistInviteEmail, callInfo)
+	mock.lockSendWishlistInviteEmail.Unlock()
+	return mock.SendWishlistInviteEmailFunc(ctx, recipientEmail, wishlistTitle, ownerName, message, link)
+}
+
+// SendWishlistInviteEmailCalls gets all the calls that were made to SendWishlistInviteEmail.
+// Check the length with:
+//
+//	len(mockedInviteEmailSenderInterface.SendWishlistInviteEmailCalls())
+func (mock *InviteEmailSenderInterfaceMock) SendWishlistInviteEmailCalls() []struct {
+	Ctx            context.Context
+	RecipientEmail string
+	WishlistTitle  string
+	OwnerName      string
+	Message        string
+	Link           string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RecipientEmail string
+		WishlistTitle  string
+		OwnerName      string
+		Message        string
+		Link           string
+	}
+	mock.lockSendWishlistInviteEmail.RLock()
+	calls = mock.calls.SendWishlistInviteEmail
+	mock.lockSendWishlistInviteEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that InviteContactsInterfaceMock does implement InviteContactsInterface.
+// If this is not the case, regenerate this file with moq.
+var _ InviteContactsInterface = &InviteContactsInterfaceMock{}
+
+// InviteContactsInterfaceMock is a mock implementation of InviteContactsInterface.
+//
+//	func TestSomethingThatUsesInviteContactsInterface(t *testing.T) {
+//
+//		// make and configure a mocked InviteContactsInterface
+//		mockedInviteContactsInterface := &InviteContactsInterfaceMock{
+//			ListEmailsByRelationshipsFunc: func(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+//				panic("mock out the ListEmailsByRelationships method")
+//			},
+//		}
+//
+//		// use mockedInviteContactsInterface in code that requires InviteContactsInterface
+//		// and then make assertions.
+//
+//	}
+type InviteContactsInterfaceMock struct {
+	// ListEmailsByRelationshipsFunc mocks the ListEmailsByRelationships method.
+	ListEmailsByRelationshipsFunc func(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListEmailsByRelationships holds details about calls to the ListEmailsByRelationships method.
+		ListEmailsByRelationships []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Relationships is the relationships argument value.
+			Relationships []string
+		}
+	}
+	lockListEmailsByRelationships sync.RWMutex
+}
+
+// ListEmailsByRelationships calls ListEmailsByRelationshipsFunc.
+func (mock *InviteContactsInterfaceMock) ListEmailsByRelationships(ctx context.Context, ownerID pgtype.UUID, relationships []string) ([]string, error) {
+	if mock.ListEmailsByRelationshipsFunc == nil {
+		panic("InviteContactsInterfaceMock.ListEmailsByRelationshipsFunc: method is nil but InviteContactsInterface.ListEmailsByRelationships was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		OwnerID       pgtype.UUID
+		Relationships []string
+	}{
+		Ctx:           ctx,
+		OwnerID:       ownerID,
+		Relationships: relationships,
+	}
+	mock.lockListEmailsByRelationships.Lock()
+	mock.calls.ListEmailsByRelationships = append(mock.calls.ListEmailsByRelationships, callInfo)
+	mock.lockListEmailsByRelationships.Unlock()
+	return mock.ListEmailsByRelationshipsFunc(ctx, ownerID, relationships)
+}
+
+// ListEmailsByRelationshipsCalls gets all the calls that were made to ListEmailsByRelationships.
+// Check the length with:
+//
+//	len(mockedInviteContactsInterface.ListEmailsByRelationshipsCalls())
+func (mock *InviteContactsInterfaceMock) ListEmailsByRelationshipsCalls() []struct {
+	Ctx           context.Context
+	OwnerID       pgtype.UUID
+	Relationships []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		OwnerID       pgtype.UUID
+		Relationships []string
+	}
+	mock.lockListEmailsByRelationships.RLock()
+	calls = mock.calls.ListEmailsByRelationships
+	mock.lockListEmailsByRelationships.RUnlock()
+	return calls
+}
@@ -0,0 +1,203 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+)
+
+// Ensure, that ImportRepositoryInterfaceMock does implement repository.ImportRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.ImportRepositoryInterface = &ImportRepositoryInterfaceMock{}
+
+// ImportRepositoryInterfaceMock is a mock implementation of repository.ImportRepositoryInterface.
+//
+//	func TestSomethingThatUsesImportRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.ImportRepositoryInterface
+//		mockedImportRepositoryInterface := &ImportRepositoryInterfaceMock{
+//			CountSinceFunc: func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+//				panic("mock out the CountSince method")
+//			},
+//			GetByWishListFunc: func(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+//				panic("mock out the GetByWishList method")
+//			},
+//			ImportFunc: func(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error) {
+//				panic("mock out the Import method")
+//			},
+//		}
+//
+//		// use mockedImportRepositoryInterface in code that requires repository.ImportRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type ImportRepositoryInterfaceMock struct {
+	// CountSinceFunc mocks the CountSince method.
+	CountSinceFunc func(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error)
+
+	// GetByWishListFunc mocks the GetByWishList method.
+	GetByWishListFunc func(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error)
+
+	// ImportFunc mocks the Import method.
+	ImportFunc func(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountSince holds details about calls to the CountSince method.
+		CountSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// GetByWishList holds details about calls to the GetByWishList method.
+		GetByWishList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishListID is the wishListID argument value.
+			WishListID pgtype.UUID
+		}
+		// Import holds details about calls to the Import method.
+		Import []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Source is the source argument value.
+			Source *models.WishList
+			// Next is the next argument value.
+			Next models.WishList
+			// ItemLimit is the itemLimit argument value.
+			ItemLimit int
+		}
+	}
+	lockCountSince    sync.RWMutex
+	lockGetByWishList sync.RWMutex
+	lockImport        sync.RWMutex
+}
+
+// CountSince calls CountSinceFunc.
+func (mock *ImportRepositoryInterfaceMock) CountSince(ctx context.Context, userID pgtype.UUID, since time.Time) (int, error) {
+	if mock.CountSinceFunc == nil {
+		panic("ImportRepositoryInterfaceMock.CountSinceFunc: method is nil but ImportRepositoryInterface.CountSince was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Since  time.Time
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Since:  since,
+	}
+	mock.lockCountSince.Lock()
+	mock.calls.CountSince = append(mock.calls.CountSince, callInfo)
+	mock.lockCountSince.Unlock()
+	return mock.CountSinceFunc(ctx, userID, since)
+}
+
+// CountSinceCalls gets all the calls that were made to CountSince.
+// Check the length with:
+//
+//	len(mockedImportRepositoryInterface.CountSinceCalls())
+func (mock *ImportRepositoryInterfaceMock) CountSinceCalls() []struct {
+	Ctx    context.Context
+	UserID pgtype.UUID
+	Since  time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID pgtype.UUID
+		Since  time.Time
+	}
+	mock.lockCountSince.RLock()
+	calls = mock.calls.CountSince
+	mock.lockCountSince.RUnlock()
+	return calls
+}
+
+// GetByWishList calls GetByWishListFunc.
+func (mock *ImportRepositoryInterfaceMock) GetByWishList(ctx context.Context, wishListID pgtype.UUID) (*models.WishListImport, error) {
+	if mock.GetByWishListFunc == nil {
+		panic("ImportRepositoryInterfaceMock.GetByWishListFunc: method is nil but ImportRepositoryInterface.GetByWishList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishListID: wishListID,
+	}
+	mock.lockGetByWishList.Lock()
+	mock.calls.GetByWishList = append(mock.calls.GetByWishList, callInfo)
+	mock.lockGetByWishList.Unlock()
+	return mock.GetByWishListFunc(ctx, wishListID)
+}
+
+// GetByWishListCalls gets all the calls that were made to GetByWishList.
+// Check the length with:
+//
+//	len(mockedImportRepositoryInterface.GetByWishListCalls())
+func (mock *ImportRepositoryInterfaceMock) GetByWishListCalls() []struct {
+	Ctx        context.Context
+	WishListID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}
+	mock.lockGetByWishList.RLock()
+	calls = mock.calls.GetByWishList
+	mock.lockGetByWishList.RUnlock()
+	return calls
+}
+
+// Import calls ImportFunc.
+func (mock *ImportRepositoryInterfaceMock) Import(ctx context.Context, source *models.WishList, next models.WishList, itemLimit int) (*models.WishList, *models.WishListImport, error) {
+	if mock.ImportFunc == nil {
+		panic("ImportRepositoryInterfaceMock.ImportFunc: method is nil but ImportRepositoryInterface.Import was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Source    *models.WishList
+		Next      models.WishList
+		ItemLimit int
+	}{
+		Ctx:       ctx,
+		Source:    source,
+		Next:      next,
+		ItemLimit: itemLimit,
+	}
+	mock.lockImport.Lock()
+	mock.calls.Import = append(mock.calls.Import, callInfo)
+	mock.lockImport.Unlock()
+	return mock.ImportFunc(ctx, source, next, itemLimit)
+}
+
+// ImportCalls gets all the calls that were made to Import.
+// Check the length with:
+//
+//	len(mockedImportRepositoryInterface.ImportCalls())
+func (mock *ImportRepositoryInterfaceMock) ImportCalls() []struct {
+	Ctx       context.Context
+	Source    *models.WishList
+	Next      models.WishList
+	ItemLimit int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Source    *models.WishList
+		Next      models.WishList
+		ItemLimit int
+	}
+	mock.lockImport.RLock()
+	calls = mock.calls.Import
+	mock.lockImport.RUnlock()
+	return calls
+}
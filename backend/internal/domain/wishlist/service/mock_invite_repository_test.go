@@ -0,0 +1,409 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+)
+
+// Ensure, that InviteRepositoryInterfaceMock does implement repository.InviteRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.InviteRepositoryInterface = &InviteRepositoryInterfaceMock{}
+
+// InviteRepositoryInterfaceMock is a mock implementation of repository.InviteRepositoryInterface.
+//
+//	func TestSomethingThatUsesInviteRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.InviteRepositoryInterface
+//		mockedInviteRepositoryInterface := &InviteRepositoryInterfaceMock{
+//			CountSentSinceFunc: func(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error) {
+//				panic("mock out the CountSentSince method")
+//			},
+//			DeleteGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) (int, error) {
+//				panic("mock out the DeleteGuestInvitesByEmail method")
+//			},
+//			ListByWishListFunc: func(ctx context.Context, wishListID pgtype.UUID) ([]*models.WishListInvite, error) {
+//				panic("mock out the ListByWishList method")
+//			},
+//			ListGuestInvitesByEmailFunc: func(ctx context.Context, guestEmail string) ([]*models.WishListInvite, error) {
+//				panic("mock out the ListGuestInvitesByEmail method")
+//			},
+//			PrepareFunc: func(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error) {
+//				panic("mock out the Prepare method")
+//			},
+//			RecordVisitFunc: func(ctx context.Context, token pgtype.UUID) (*models.WishListInvite, error) {
+//				panic("mock out the RecordVisit method")
+//			},
+//			SetStatusFunc: func(ctx context.Context, id pgtype.UUID, status string) error {
+//				panic("mock out the SetStatus method")
+//			},
+//		}
+//
+//		// use mockedInviteRepositoryInterface in code that requires repository.InviteRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type InviteRepositoryInterfaceMock struct {
+	// CountSentSinceFunc mocks the CountSentSince method.
+	CountSentSinceFunc func(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error)
+
+	// DeleteGuestInvitesByEmailFunc mocks the DeleteGuestInvitesByEmail method.
+	DeleteGuestInvitesByEmailFunc func(ctx context.Context, guestEmail string) (int, error)
+
+	// ListByWishListFunc mocks the ListByWishList method.
+	ListByWishListFunc func(ctx context.Context, wishListID pgtype.UUID) ([]*models.WishListInvite, error)
+
+	// ListGuestInvitesByEmailFunc mocks the ListGuestInvitesByEmail method.
+	ListGuestInvitesByEmailFunc func(ctx context.Context, guestEmail string) ([]*models.WishListInvite, error)
+
+	// PrepareFunc mocks the Prepare method.
+	PrepareFunc func(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error)
+
+	// RecordVisitFunc mocks the RecordVisit method.
+	RecordVisitFunc func(ctx context.Context, token pgtype.UUID) (*models.WishListInvite, error)
+
+	// SetStatusFunc mocks the SetStatus method.
+	SetStatusFunc func(ctx context.Context, id pgtype.UUID, status string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountSentSince holds details about calls to the CountSentSince method.
+		CountSentSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// DeleteGuestInvitesByEmail holds details about calls to the DeleteGuestInvitesByEmail method.
+		DeleteGuestInvitesByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// ListByWishList holds details about calls to the ListByWishList method.
+		ListByWishList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishListID is the wishListID argument value.
+			WishListID pgtype.UUID
+		}
+		// ListGuestInvitesByEmail holds details about calls to the ListGuestInvitesByEmail method.
+		ListGuestInvitesByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GuestEmail is the guestEmail argument value.
+			GuestEmail string
+		}
+		// Prepare holds details about calls to the Prepare method.
+		Prepare []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishListID is the wishListID argument value.
+			WishListID pgtype.UUID
+			// Emails is the emails argument value.
+			Emails []string
+			// ResendBefore is the resendBefore argument value.
+			ResendBefore time.Time
+		}
+		// RecordVisit holds details about calls to the RecordVisit method.
+		RecordVisit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token pgtype.UUID
+		}
+		// SetStatus holds details about calls to the SetStatus method.
+		SetStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Status is the status argument value.
+			Status string
+		}
+	}
+	lockCountSentSince            sync.RWMutex
+	lockDeleteGuestInvitesByEmail sync.RWMutex
+	lockListByWishList            sync.RWMutex
+	lockListGuestInvitesByEmail   sync.RWMutex
+	lockPrepare                   sync.RWMutex
+	lockRecordVisit               sync.RWMutex
+	lockSetStatus                 sync.RWMutex
+}
+
+// CountSentSince calls CountSentSinceFunc.
+func (mock *InviteRepositoryInterfaceMock) CountSentSince(ctx context.Context, ownerID pgtype.UUID, since time.Time) (int, error) {
+	if mock.CountSentSinceFunc == nil {
+		panic("InviteRepositoryInterfaceMock.CountSentSinceFunc: method is nil but InviteRepositoryInterface.CountSentSince was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+		Since:   since,
+	}
+	mock.lockCountSentSince.Lock()
+	mock.calls.CountSentSince = append(mock.calls.CountSentSince, callInfo)
+	mock.lockCountSentSince.Unlock()
+	return mock.CountSentSinceFunc(ctx, ownerID, since)
+}
+
+// CountSentSinceCalls gets all the calls that were made to CountSentSince.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.CountSentSinceCalls())
+func (mock *InviteRepositoryInterfaceMock) CountSentSinceCalls() []struct {
+	Ctx     context.Context
+	OwnerID pgtype.UUID
+	Since   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID pgtype.UUID
+		Since   time.Time
+	}
+	mock.lockCountSentSince.RLock()
+	calls = mock.calls.CountSentSince
+	mock.lockCountSentSince.RUnlock()
+	return calls
+}
+
+// DeleteGuestInvitesByEmail calls DeleteGuestInvitesByEmailFunc.
+func (mock *InviteRepositoryInterfaceMock) DeleteGuestInvitesByEmail(ctx context.Context, guestEmail string) (int, error) {
+	if mock.DeleteGuestInvitesByEmailFunc == nil {
+		panic("InviteRepositoryInterfaceMock.DeleteGuestInvitesByEmailFunc: method is nil but InviteRepositoryInterface.DeleteGuestInvitesByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockDeleteGuestInvitesByEmail.Lock()
+	mock.calls.DeleteGuestInvitesByEmail = append(mock.calls.DeleteGuestInvitesByEmail, callInfo)
+	mock.lockDeleteGuestInvitesByEmail.Unlock()
+	return mock.DeleteGuestInvitesByEmailFunc(ctx, guestEmail)
+}
+
+// DeleteGuestInvitesByEmailCalls gets all the calls that were made to DeleteGuestInvitesByEmail.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.DeleteGuestInvitesByEmailCalls())
+func (mock *InviteRepositoryInterfaceMock) DeleteGuestInvitesByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockDeleteGuestInvitesByEmail.RLock()
+	calls = mock.calls.DeleteGuestInvitesByEmail
+	mock.lockDeleteGuestInvitesByEmail.RUnlock()
+	return calls
+}
+
+// ListByWishList calls ListByWishListFunc.
+func (mock *InviteRepositoryInterfaceMock) ListByWishList(ctx context.Context, wishListID pgtype.UUID) ([]*models.WishListInvite, error) {
+	if mock.ListByWishListFunc == nil {
+		panic("InviteRepositoryInterfaceMock.ListByWishListFunc: method is nil but InviteRepositoryInterface.ListByWishList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishListID: wishListID,
+	}
+	mock.lockListByWishList.Lock()
+	mock.calls.ListByWishList = append(mock.calls.ListByWishList, callInfo)
+	mock.lockListByWishList.Unlock()
+	return mock.ListByWishListFunc(ctx, wishListID)
+}
+
+// ListByWishListCalls gets all the calls that were made to ListByWishList.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.ListByWishListCalls())
+func (mock *InviteRepositoryInterfaceMock) ListByWishListCalls() []struct {
+	Ctx        context.Context
+	WishListID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}
+	mock.lockListByWishList.RLock()
+	calls = mock.calls.ListByWishList
+	mock.lockListByWishList.RUnlock()
+	return calls
+}
+
+// ListGuestInvitesByEmail calls ListGuestInvitesByEmailFunc.
+func (mock *InviteRepositoryInterfaceMock) ListGuestInvitesByEmail(ctx context.Context, guestEmail string) ([]*models.WishListInvite, error) {
+	if mock.ListGuestInvitesByEmailFunc == nil {
+		panic("InviteRepositoryInterfaceMock.ListGuestInvitesByEmailFunc: method is nil but InviteRepositoryInterface.ListGuestInvitesByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		GuestEmail string
+	}{
+		Ctx:        ctx,
+		GuestEmail: guestEmail,
+	}
+	mock.lockListGuestInvitesByEmail.Lock()
+	mock.calls.ListGuestInvitesByEmail = append(mock.calls.ListGuestInvitesByEmail, callInfo)
+	mock.lockListGuestInvitesByEmail.Unlock()
+	return mock.ListGuestInvitesByEmailFunc(ctx, guestEmail)
+}
+
+// ListGuestInvitesByEmailCalls gets all the calls that were made to ListGuestInvitesByEmail.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.ListGuestInvitesByEmailCalls())
+func (mock *InviteRepositoryInterfaceMock) ListGuestInvitesByEmailCalls() []struct {
+	Ctx        context.Context
+	GuestEmail string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		GuestEmail string
+	}
+	mock.lockListGuestInvitesByEmail.RLock()
+	calls = mock.calls.ListGuestInvitesByEmail
+	mock.lockListGuestInvitesByEmail.RUnlock()
+	return calls
+}
+
+// Prepare calls PrepareFunc.
+func (mock *InviteRepositoryInterfaceMock) Prepare(ctx context.Context, wishListID pgtype.UUID, emails []string, resendBefore time.Time) ([]*models.WishListInvite, error) {
+	if mock.PrepareFunc == nil {
+		panic("InviteRepositoryInterfaceMock.PrepareFunc: method is nil but InviteRepositoryInterface.Prepare was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		WishListID   pgtype.UUID
+		Emails       []string
+		ResendBefore time.Time
+	}{
+		Ctx:          ctx,
+		WishListID:   wishListID,
+		Emails:       emails,
+		ResendBefore: resendBefore,
+	}
+	mock.lockPrepare.Lock()
+	mock.calls.Prepare = append(mock.calls.Prepare, callInfo)
+	mock.lockPrepare.Unlock()
+	return mock.PrepareFunc(ctx, wishListID, emails, resendBefore)
+}
+
+// PrepareCalls gets all the calls that were made to Prepare.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.PrepareCalls())
+func (mock *InviteRepositoryInterfaceMock) PrepareCalls() []struct {
+	Ctx          context.Context
+	WishListID   pgtype.UUID
+	Emails       []string
+	ResendBefore time.Time
+} {
+	var calls []struct {
+		Ctx          context.Context
+		WishListID   pgtype.UUID
+		Emails       []string
+		ResendBefore time.Time
+	}
+	mock.lockPrepare.RLock()
+	calls = mock.calls.Prepare
+	mock.lockPrepare.RUnlock()
+	return calls
+}
+
+// RecordVisit calls RecordVisitFunc.
+func (mock *InviteRepositoryInterfaceMock) RecordVisit(ctx context.Context, token pgtype.UUID) (*models.WishListInvite, error) {
+	if mock.RecordVisitFunc == nil {
+		panic("InviteRepositoryInterfaceMock.RecordVisitFunc: method is nil but InviteRepositoryInterface.RecordVisit was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockRecordVisit.Lock()
+	mock.calls.RecordVisit = append(mock.calls.RecordVisit, callInfo)
+	mock.lockRecordVisit.Unlock()
+	return mock.RecordVisitFunc(ctx, token)
+}
+
+// RecordVisitCalls gets all the calls that were made to RecordVisit.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.RecordVisitCalls())
+func (mock *InviteRepositoryInterfaceMock) RecordVisitCalls() []struct {
+	Ctx   context.Context
+	Token pgtype.UUID
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token pgtype.UUID
+	}
+	mock.lockRecordVisit.RLock()
+	calls = mock.calls.RecordVisit
+	mock.lockRecordVisit.RUnlock()
+	return calls
+}
+
+// SetStatus calls SetStatusFunc.
+func (mock *InviteRepositoryInterfaceMock) SetStatus(ctx context.Context, id pgtype.UUID, status string) error {
+	if mock.SetStatusFunc == nil {
+		panic("InviteRepositoryInterfaceMock.SetStatusFunc: method is nil but InviteRepositoryInterface.SetStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Status string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Status: status,
+	}
+	mock.lockSetStatus.Lock()
+	mock.calls.SetStatus = append(mock.calls.SetStatus, callInfo)
+	mock.lockSetStatus.Unlock()
+	return mock.SetStatusFunc(ctx, id, status)
+}
+
+// SetStatusCalls gets all the calls that were made to SetStatus.
+// Check the length with:
+//
+//	len(mockedInviteRepositoryInterface.SetStatusCalls())
+func (mock *InviteRepositoryInterfaceMock) SetStatusCalls() []struct {
+	Ctx    context.Context
+	ID     pgtype.UUID
+	Status string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     pgtype.UUID
+		Status string
+	}
+	mock.lockSetStatus.RLock()
+	calls = mock.calls.SetStatus
+	mock.lockSetStatus.RUnlock()
+	return calls
+}
@@ -0,0 +1,247 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sync"
+	"time"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+)
+
+// Ensure, that SnapshotRepositoryInterfaceMock does implement repository.SnapshotRepositoryInterface.
+// If this is not the case, regenerate this file with moq.
+var _ repository.SnapshotRepositoryInterface = &SnapshotRepositoryInterfaceMock{}
+
+// SnapshotRepositoryInterfaceMock is a mock implementation of repository.SnapshotRepositoryInterface.
+//
+//	func TestSomethingThatUsesSnapshotRepositoryInterface(t *testing.T) {
+//
+//		// make and configure a mocked repository.SnapshotRepositoryInterface
+//		mockedSnapshotRepositoryInterface := &SnapshotRepositoryInterfaceMock{
+//			CreateFunc: func(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error) {
+//				panic("mock out the Create method")
+//			},
+//			GetFunc: func(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(ctx context.Context, wishListID pgtype.UUID) ([]*models.SnapshotSummary, error) {
+//				panic("mock out the List method")
+//			},
+//			ListDueWishListsFunc: func(ctx context.Context, from time.Time, to time.Time) ([]*models.WishList, error) {
+//				panic("mock out the ListDueWishLists method")
+//			},
+//		}
+//
+//		// use mockedSnapshotRepositoryInterface in code that requires repository.SnapshotRepositoryInterface
+//		// and then make assertions.
+//
+//	}
+type SnapshotRepositoryInterfaceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, wishListID pgtype.UUID) ([]*models.SnapshotSummary, error)
+
+	// ListDueWishListsFunc mocks the ListDueWishLists method.
+	ListDueWishListsFunc func(ctx context.Context, from time.Time, to time.Time) ([]*models.WishList, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Snapshot is the snapshot argument value.
+			Snapshot models.Snapshot
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishListID is the wishListID argument value.
+			WishListID pgtype.UUID
+			// Date is the date argument value.
+			Date time.Time
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishListID is the wishListID argument value.
+			WishListID pgtype.UUID
+		}
+		// ListDueWishLists holds details about calls to the ListDueWishLists method.
+		ListDueWishLists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+	}
+	lockCreate           sync.RWMutex
+	lockGet              sync.RWMutex
+	lockList             sync.RWMutex
+	lockListDueWishLists sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *SnapshotRepositoryInterfaceMock) Create(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error) {
+	if mock.CreateFunc == nil {
+		panic("SnapshotRepositoryInterfaceMock.CreateFunc: method is nil but SnapshotRepositoryInterface.Create was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Snapshot models.Snapshot
+	}{
+		Ctx:      ctx,
+		Snapshot: snapshot,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, snapshot)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedSnapshotRepositoryInterface.CreateCalls())
+func (mock *SnapshotRepositoryInterfaceMock) CreateCalls() []struct {
+	Ctx      context.Context
+	Snapshot models.Snapshot
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Snapshot models.Snapshot
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *SnapshotRepositoryInterfaceMock) Get(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error) {
+	if mock.GetFunc == nil {
+		panic("SnapshotRepositoryInterfaceMock.GetFunc: method is nil but SnapshotRepositoryInterface.Get was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+		Date       time.Time
+	}{
+		Ctx:        ctx,
+		WishListID: wishListID,
+		Date:       date,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, wishListID, date)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedSnapshotRepositoryInterface.GetCalls())
+func (mock *SnapshotRepositoryInterfaceMock) GetCalls() []struct {
+	Ctx        context.Context
+	WishListID pgtype.UUID
+	Date       time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+		Date       time.Time
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *SnapshotRepositoryInterfaceMock) List(ctx context.Context, wishListID pgtype.UUID) ([]*models.SnapshotSummary, error) {
+	if mock.ListFunc == nil {
+		panic("SnapshotRepositoryInterfaceMock.ListFunc: method is nil but SnapshotRepositoryInterface.List was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishListID: wishListID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, wishListID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedSnapshotRepositoryInterface.ListCalls())
+func (mock *SnapshotRepositoryInterfaceMock) ListCalls() []struct {
+	Ctx        context.Context
+	WishListID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishListID pgtype.UUID
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListDueWishLists calls ListDueWishListsFunc.
+func (mock *SnapshotRepositoryInterfaceMock) ListDueWishLists(ctx context.Context, from time.Time, to time.Time) ([]*models.WishList, error) {
+	if mock.ListDueWishListsFunc == nil {
+		panic("SnapshotRepositoryInterfaceMock.ListDueWishListsFunc: method is nil but SnapshotRepositoryInterface.ListDueWishLists was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+		To:   to,
+	}
+	mock.lockListDueWishLists.Lock()
+	mock.calls.ListDueWishLists = append(mock.calls.ListDueWishLists, callInfo)
+	mock.lockListDueWishLists.Unlock()
+	return mock.ListDueWishListsFunc(ctx, from, to)
+}
+
+// ListDueWishListsCalls gets all the calls that were made to ListDueWishLists.
+// Check the length with:
+//
+//	len(mockedSnapshotRepositoryInterface.ListDueWishListsCalls())
+func (mock *SnapshotRepositoryInterfaceMock) ListDueWishListsCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}
+	mock.lockListDueWishLists.RLock()
+	calls = mock.calls.ListDueWishLists
+	mock.lockListDueWishLists.RUnlock()
+	return calls
+}
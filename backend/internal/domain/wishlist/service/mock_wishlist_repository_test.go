@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/jackc/pgx/v5/pgtype"
 	"sync"
+	"time"
 	"wish-list/internal/app/database"
 	"wish-list/internal/domain/wishlist/models"
 	"wish-list/internal/domain/wishlist/repository"
@@ -22,6 +23,12 @@ var _ repository.WishListRepositoryInterface = &WishListRepositoryInterfaceMock{
 //
 //		// make and configure a mocked repository.WishListRepositoryInterface
 //		mockedWishListRepositoryInterface := &WishListRepositoryInterfaceMock{
+//			AddViewsFunc: func(ctx context.Context, id pgtype.UUID, views int64) error {
+//				panic("mock out the AddViews method")
+//			},
+//			ClosePublicSlugFunc: func(ctx context.Context, id pgtype.UUID) error {
+//				panic("mock out the ClosePublicSlug method")
+//			},
 //			CreateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
 //				panic("mock out the Create method")
 //			},
@@ -37,17 +44,44 @@ var _ repository.WishListRepositoryInterface = &WishListRepositoryInterfaceMock{
 //			GetByOwnerFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishList, error) {
 //				panic("mock out the GetByOwner method")
 //			},
-//			GetByOwnerWithItemCountFunc: func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishListWithItemCount, error) {
+//			GetByOwnerWithItemCountFunc: func(ctx context.Context, ownerID pgtype.UUID, filters repository.WishListFilters) ([]*models.WishListWithItemCount, error) {
 //				panic("mock out the GetByOwnerWithItemCount method")
 //			},
 //			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
 //				panic("mock out the GetByPublicSlug method")
 //			},
-//			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+//			GetByRetiredSlugFunc: func(ctx context.Context, slug string) (*models.WishList, error) {
+//				panic("mock out the GetByRetiredSlug method")
+//			},
+//			GetByVanitySlugFunc: func(ctx context.Context, username string, slug string) (*models.WishList, error) {
+//				panic("mock out the GetByVanitySlug method")
+//			},
+//			IsSlugClosedFunc: func(ctx context.Context, slug string) (bool, error) {
+//				panic("mock out the IsSlugClosed method")
+//			},
+//			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID pgtype.UUID, ownerID pgtype.UUID) (bool, error) {
 //				panic("mock out the IsSlugTaken method")
 //			},
-//			IncrementViewCountFunc: func(ctx context.Context, id pgtype.UUID) error {
-//				panic("mock out the IncrementViewCount method")
+//			IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID pgtype.UUID, excludeID pgtype.UUID) (bool, error) {
+//				panic("mock out the IsVanitySlugTaken method")
+//			},
+//			ListDueForRolloverFunc: func(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+//				panic("mock out the ListDueForRollover method")
+//			},
+//			ListExpiredPublicFunc: func(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+//				panic("mock out the ListExpiredPublic method")
+//			},
+//			ListPublicWithInvalidSlugFunc: func(ctx context.Context) ([]*models.WishList, error) {
+//				panic("mock out the ListPublicWithInvalidSlug method")
+//			},
+//			MergeFunc: func(ctx context.Context, sourceID pgtype.UUID, targetID pgtype.UUID, opts repository.MergeOptions) (int64, error) {
+//				panic("mock out the Merge method")
+//			},
+//			RollOverFunc: func(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+//				panic("mock out the RollOver method")
+//			},
+//			RollOverUnpurchasedFunc: func(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+//				panic("mock out the RollOverUnpurchased method")
 //			},
 //			UpdateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
 //				panic("mock out the Update method")
@@ -59,6 +93,12 @@ var _ repository.WishListRepositoryInterface = &WishListRepositoryInterfaceMock{
 //
 //	}
 type WishListRepositoryInterfaceMock struct {
+	// AddViewsFunc mocks the AddViews method.
+	AddViewsFunc func(ctx context.Context, id pgtype.UUID, views int64) error
+
+	// ClosePublicSlugFunc mocks the ClosePublicSlug method.
+	ClosePublicSlugFunc func(ctx context.Context, id pgtype.UUID) error
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, wishList models.WishList) (*models.WishList, error)
 
@@ -75,22 +115,65 @@ type WishListRepositoryInterfaceMock struct {
 	GetByOwnerFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishList, error)
 
 	// GetByOwnerWithItemCountFunc mocks the GetByOwnerWithItemCount method.
-	GetByOwnerWithItemCountFunc func(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishListWithItemCount, error)
+	GetByOwnerWithItemCountFunc func(ctx context.Context, ownerID pgtype.UUID, filters repository.WishListFilters) ([]*models.WishListWithItemCount, error)
 
 	// GetByPublicSlugFunc mocks the GetByPublicSlug method.
 	GetByPublicSlugFunc func(ctx context.Context, publicSlug string) (*models.WishList, error)
 
+	// GetByRetiredSlugFunc mocks the GetByRetiredSlug method.
+	GetByRetiredSlugFunc func(ctx context.Context, slug string) (*models.WishList, error)
+
+	// GetByVanitySlugFunc mocks the GetByVanitySlug method.
+	GetByVanitySlugFunc func(ctx context.Context, username string, slug string) (*models.WishList, error)
+
+	// IsSlugClosedFunc mocks the IsSlugClosed method.
+	IsSlugClosedFunc func(ctx context.Context, slug string) (bool, error)
+
 	// IsSlugTakenFunc mocks the IsSlugTaken method.
-	IsSlugTakenFunc func(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error)
+	IsSlugTakenFunc func(ctx context.Context, slug string, excludeID pgtype.UUID, ownerID pgtype.UUID) (bool, error)
+
+	// IsVanitySlugTakenFunc mocks the IsVanitySlugTaken method.
+	IsVanitySlugTakenFunc func(ctx context.Context, slug string, ownerID pgtype.UUID, excludeID pgtype.UUID) (bool, error)
 
-	// IncrementViewCountFunc mocks the IncrementViewCount method.
-	IncrementViewCountFunc func(ctx context.Context, id pgtype.UUID) error
+	// ListDueForRolloverFunc mocks the ListDueForRollover method.
+	ListDueForRolloverFunc func(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error)
+
+	// ListExpiredPublicFunc mocks the ListExpiredPublic method.
+	ListExpiredPublicFunc func(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error)
+
+	// ListPublicWithInvalidSlugFunc mocks the ListPublicWithInvalidSlug method.
+	ListPublicWithInvalidSlugFunc func(ctx context.Context) ([]*models.WishList, error)
+
+	// MergeFunc mocks the Merge method.
+	MergeFunc func(ctx context.Context, sourceID pgtype.UUID, targetID pgtype.UUID, opts repository.MergeOptions) (int64, error)
+
+	// RollOverFunc mocks the RollOver method.
+	RollOverFunc func(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error)
+
+	// RollOverUnpurchasedFunc mocks the RollOverUnpurchased method.
+	RollOverUnpurchasedFunc func(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error)
 
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, wishList models.WishList) (*models.WishList, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddViews holds details about calls to the AddViews method.
+		AddViews []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+			// Views is the views argument value.
+			Views int64
+		}
+		// ClosePublicSlug holds details about calls to the ClosePublicSlug method.
+		ClosePublicSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID pgtype.UUID
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Ctx is the ctx argument value.
@@ -134,6 +217,8 @@ type WishListRepositoryInterfaceMock struct {
 			Ctx context.Context
 			// OwnerID is the ownerID argument value.
 			OwnerID pgtype.UUID
+			// Filters is the filters argument value.
+			Filters repository.WishListFilters
 		}
 		// GetByPublicSlug holds details about calls to the GetByPublicSlug method.
 		GetByPublicSlug []struct {
@@ -142,6 +227,29 @@ type WishListRepositoryInterfaceMock struct {
 			// PublicSlug is the publicSlug argument value.
 			PublicSlug string
 		}
+		// GetByRetiredSlug holds details about calls to the GetByRetiredSlug method.
+		GetByRetiredSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Slug is the slug argument value.
+			Slug string
+		}
+		// GetByVanitySlug holds details about calls to the GetByVanitySlug method.
+		GetByVanitySlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// Slug is the slug argument value.
+			Slug string
+		}
+		// IsSlugClosed holds details about calls to the IsSlugClosed method.
+		IsSlugClosed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Slug is the slug argument value.
+			Slug string
+		}
 		// IsSlugTaken holds details about calls to the IsSlugTaken method.
 		IsSlugTaken []struct {
 			// Ctx is the ctx argument value.
@@ -150,13 +258,67 @@ type WishListRepositoryInterfaceMock struct {
 			Slug string
 			// ExcludeID is the excludeID argument value.
 			ExcludeID pgtype.UUID
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
 		}
-		// IncrementViewCount holds details about calls to the IncrementViewCount method.
-		IncrementViewCount []struct {
+		// IsVanitySlugTaken holds details about calls to the IsVanitySlugTaken method.
+		IsVanitySlugTaken []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// ID is the id argument value.
-			ID pgtype.UUID
+			// Slug is the slug argument value.
+			Slug string
+			// OwnerID is the ownerID argument value.
+			OwnerID pgtype.UUID
+			// ExcludeID is the excludeID argument value.
+			ExcludeID pgtype.UUID
+		}
+		// ListDueForRollover holds details about calls to the ListDueForRollover method.
+		ListDueForRollover []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OccasionBefore is the occasionBefore argument value.
+			OccasionBefore time.Time
+		}
+		// ListExpiredPublic holds details about calls to the ListExpiredPublic method.
+		ListExpiredPublic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OccasionBefore is the occasionBefore argument value.
+			OccasionBefore time.Time
+		}
+		// ListPublicWithInvalidSlug holds details about calls to the ListPublicWithInvalidSlug method.
+		ListPublicWithInvalidSlug []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Merge holds details about calls to the Merge method.
+		Merge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceID is the sourceID argument value.
+			SourceID pgtype.UUID
+			// TargetID is the targetID argument value.
+			TargetID pgtype.UUID
+			// Opts is the opts argument value.
+			Opts repository.MergeOptions
+		}
+		// RollOver holds details about calls to the RollOver method.
+		RollOver []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceID is the sourceID argument value.
+			SourceID pgtype.UUID
+			// Next is the next argument value.
+			Next models.WishList
+		}
+		// RollOverUnpurchased holds details about calls to the RollOverUnpurchased method.
+		RollOverUnpurchased []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceID is the sourceID argument value.
+			SourceID pgtype.UUID
+			// Next is the next argument value.
+			Next models.WishList
 		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
@@ -166,16 +328,103 @@ type WishListRepositoryInterfaceMock struct {
 			WishList models.WishList
 		}
 	}
-	lockCreate                  sync.RWMutex
-	lockDelete                  sync.RWMutex
-	lockDeleteWithExecutor      sync.RWMutex
-	lockGetByID                 sync.RWMutex
-	lockGetByOwner              sync.RWMutex
-	lockGetByOwnerWithItemCount sync.RWMutex
-	lockGetByPublicSlug         sync.RWMutex
-	lockIsSlugTaken             sync.RWMutex
-	lockIncrementViewCount      sync.RWMutex
-	lockUpdate                  sync.RWMutex
+	lockAddViews                  sync.RWMutex
+	lockClosePublicSlug           sync.RWMutex
+	lockCreate                    sync.RWMutex
+	lockDelete                    sync.RWMutex
+	lockDeleteWithExecutor        sync.RWMutex
+	lockGetByID                   sync.RWMutex
+	lockGetByOwner                sync.RWMutex
+	lockGetByOwnerWithItemCount   sync.RWMutex
+	lockGetByPublicSlug           sync.RWMutex
+	lockGetByRetiredSlug          sync.RWMutex
+	lockGetByVanitySlug           sync.RWMutex
+	lockIsSlugClosed              sync.RWMutex
+	lockIsSlugTaken               sync.RWMutex
+	lockIsVanitySlugTaken         sync.RWMutex
+	lockListDueForRollover        sync.RWMutex
+	lockListExpiredPublic         sync.RWMutex
+	lockListPublicWithInvalidSlug sync.RWMutex
+	lockMerge                     sync.RWMutex
+	lockRollOver                  sync.RWMutex
+	lockRollOverUnpurchased       sync.RWMutex
+	lockUpdate                    sync.RWMutex
+}
+
+// AddViews calls AddViewsFunc.
+func (mock *WishListRepositoryInterfaceMock) AddViews(ctx context.Context, id pgtype.UUID, views int64) error {
+	if mock.AddViewsFunc == nil {
+		panic("WishListRepositoryInterfaceMock.AddViewsFunc: method is nil but WishListRepositoryInterface.AddViews was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		Views int64
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Views: views,
+	}
+	mock.lockAddViews.Lock()
+	mock.calls.AddViews = append(mock.calls.AddViews, callInfo)
+	mock.lockAddViews.Unlock()
+	return mock.AddViewsFunc(ctx, id, views)
+}
+
+// AddViewsCalls gets all the calls that were made to AddViews.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.AddViewsCalls())
+func (mock *WishListRepositoryInterfaceMock) AddViewsCalls() []struct {
+	Ctx   context.Context
+	ID    pgtype.UUID
+	Views int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    pgtype.UUID
+		Views int64
+	}
+	mock.lockAddViews.RLock()
+	calls = mock.calls.AddViews
+	mock.lockAddViews.RUnlock()
+	return calls
+}
+
+// ClosePublicSlug calls ClosePublicSlugFunc.
+func (mock *WishListRepositoryInterfaceMock) ClosePublicSlug(ctx context.Context, id pgtype.UUID) error {
+	if mock.ClosePublicSlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.ClosePublicSlugFunc: method is nil but WishListRepositoryInterface.ClosePublicSlug was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockClosePublicSlug.Lock()
+	mock.calls.ClosePublicSlug = append(mock.calls.ClosePublicSlug, callInfo)
+	mock.lockClosePublicSlug.Unlock()
+	return mock.ClosePublicSlugFunc(ctx, id)
+}
+
+// ClosePublicSlugCalls gets all the calls that were made to ClosePublicSlug.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.ClosePublicSlugCalls())
+func (mock *WishListRepositoryInterfaceMock) ClosePublicSlugCalls() []struct {
+	Ctx context.Context
+	ID  pgtype.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  pgtype.UUID
+	}
+	mock.lockClosePublicSlug.RLock()
+	calls = mock.calls.ClosePublicSlug
+	mock.lockClosePublicSlug.RUnlock()
+	return calls
 }
 
 // Create calls CreateFunc.
@@ -363,21 +612,23 @@ func (mock *WishListRepositoryInterfaceMock) GetByOwnerCalls() []struct {
 }
 
 // GetByOwnerWithItemCount calls GetByOwnerWithItemCountFunc.
-func (mock *WishListRepositoryInterfaceMock) GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID) ([]*models.WishListWithItemCount, error) {
+func (mock *WishListRepositoryInterfaceMock) GetByOwnerWithItemCount(ctx context.Context, ownerID pgtype.UUID, filters repository.WishListFilters) ([]*models.WishListWithItemCount, error) {
 	if mock.GetByOwnerWithItemCountFunc == nil {
 		panic("WishListRepositoryInterfaceMock.GetByOwnerWithItemCountFunc: method is nil but WishListRepositoryInterface.GetByOwnerWithItemCount was just called")
 	}
 	callInfo := struct {
 		Ctx     context.Context
 		OwnerID pgtype.UUID
+		Filters repository.WishListFilters
 	}{
 		Ctx:     ctx,
 		OwnerID: ownerID,
+		Filters: filters,
 	}
 	mock.lockGetByOwnerWithItemCount.Lock()
 	mock.calls.GetByOwnerWithItemCount = append(mock.calls.GetByOwnerWithItemCount, callInfo)
 	mock.lockGetByOwnerWithItemCount.Unlock()
-	return mock.GetByOwnerWithItemCountFunc(ctx, ownerID)
+	return mock.GetByOwnerWithItemCountFunc(ctx, ownerID, filters)
 }
 
 // GetByOwnerWithItemCountCalls gets all the calls that were made to GetByOwnerWithItemCount.
@@ -387,10 +638,12 @@ func (mock *WishListRepositoryInterfaceMock) GetByOwnerWithItemCount(ctx context
 func (mock *WishListRepositoryInterfaceMock) GetByOwnerWithItemCountCalls() []struct {
 	Ctx     context.Context
 	OwnerID pgtype.UUID
+	Filters repository.WishListFilters
 } {
 	var calls []struct {
 		Ctx     context.Context
 		OwnerID pgtype.UUID
+		Filters repository.WishListFilters
 	}
 	mock.lockGetByOwnerWithItemCount.RLock()
 	calls = mock.calls.GetByOwnerWithItemCount
@@ -434,8 +687,120 @@ func (mock *WishListRepositoryInterfaceMock) GetByPublicSlugCalls() []struct {
 	return calls
 }
 
+// GetByRetiredSlug calls GetByRetiredSlugFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByRetiredSlug(ctx context.Context, slug string) (*models.WishList, error) {
+	if mock.GetByRetiredSlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByRetiredSlugFunc: method is nil but WishListRepositoryInterface.GetByRetiredSlug was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Slug string
+	}{
+		Ctx:  ctx,
+		Slug: slug,
+	}
+	mock.lockGetByRetiredSlug.Lock()
+	mock.calls.GetByRetiredSlug = append(mock.calls.GetByRetiredSlug, callInfo)
+	mock.lockGetByRetiredSlug.Unlock()
+	return mock.GetByRetiredSlugFunc(ctx, slug)
+}
+
+// GetByRetiredSlugCalls gets all the calls that were made to GetByRetiredSlug.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByRetiredSlugCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByRetiredSlugCalls() []struct {
+	Ctx  context.Context
+	Slug string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Slug string
+	}
+	mock.lockGetByRetiredSlug.RLock()
+	calls = mock.calls.GetByRetiredSlug
+	mock.lockGetByRetiredSlug.RUnlock()
+	return calls
+}
+
+// GetByVanitySlug calls GetByVanitySlugFunc.
+func (mock *WishListRepositoryInterfaceMock) GetByVanitySlug(ctx context.Context, username string, slug string) (*models.WishList, error) {
+	if mock.GetByVanitySlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.GetByVanitySlugFunc: method is nil but WishListRepositoryInterface.GetByVanitySlug was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+		Slug     string
+	}{
+		Ctx:      ctx,
+		Username: username,
+		Slug:     slug,
+	}
+	mock.lockGetByVanitySlug.Lock()
+	mock.calls.GetByVanitySlug = append(mock.calls.GetByVanitySlug, callInfo)
+	mock.lockGetByVanitySlug.Unlock()
+	return mock.GetByVanitySlugFunc(ctx, username, slug)
+}
+
+// GetByVanitySlugCalls gets all the calls that were made to GetByVanitySlug.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.GetByVanitySlugCalls())
+func (mock *WishListRepositoryInterfaceMock) GetByVanitySlugCalls() []struct {
+	Ctx      context.Context
+	Username string
+	Slug     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+		Slug     string
+	}
+	mock.lockGetByVanitySlug.RLock()
+	calls = mock.calls.GetByVanitySlug
+	mock.lockGetByVanitySlug.RUnlock()
+	return calls
+}
+
+// IsSlugClosed calls IsSlugClosedFunc.
+func (mock *WishListRepositoryInterfaceMock) IsSlugClosed(ctx context.Context, slug string) (bool, error) {
+	if mock.IsSlugClosedFunc == nil {
+		panic("WishListRepositoryInterfaceMock.IsSlugClosedFunc: method is nil but WishListRepositoryInterface.IsSlugClosed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Slug string
+	}{
+		Ctx:  ctx,
+		Slug: slug,
+	}
+	mock.lockIsSlugClosed.Lock()
+	mock.calls.IsSlugClosed = append(mock.calls.IsSlugClosed, callInfo)
+	mock.lockIsSlugClosed.Unlock()
+	return mock.IsSlugClosedFunc(ctx, slug)
+}
+
+// IsSlugClosedCalls gets all the calls that were made to IsSlugClosed.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.IsSlugClosedCalls())
+func (mock *WishListRepositoryInterfaceMock) IsSlugClosedCalls() []struct {
+	Ctx  context.Context
+	Slug string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Slug string
+	}
+	mock.lockIsSlugClosed.RLock()
+	calls = mock.calls.IsSlugClosed
+	mock.lockIsSlugClosed.RUnlock()
+	return calls
+}
+
 // IsSlugTaken calls IsSlugTakenFunc.
-func (mock *WishListRepositoryInterfaceMock) IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID) (bool, error) {
+func (mock *WishListRepositoryInterfaceMock) IsSlugTaken(ctx context.Context, slug string, excludeID pgtype.UUID, ownerID pgtype.UUID) (bool, error) {
 	if mock.IsSlugTakenFunc == nil {
 		panic("WishListRepositoryInterfaceMock.IsSlugTakenFunc: method is nil but WishListRepositoryInterface.IsSlugTaken was just called")
 	}
@@ -443,15 +808,17 @@ func (mock *WishListRepositoryInterfaceMock) IsSlugTaken(ctx context.Context, sl
 		Ctx       context.Context
 		Slug      string
 		ExcludeID pgtype.UUID
+		OwnerID   pgtype.UUID
 	}{
 		Ctx:       ctx,
 		Slug:      slug,
 		ExcludeID: excludeID,
+		OwnerID:   ownerID,
 	}
 	mock.lockIsSlugTaken.Lock()
 	mock.calls.IsSlugTaken = append(mock.calls.IsSlugTaken, callInfo)
 	mock.lockIsSlugTaken.Unlock()
-	return mock.IsSlugTakenFunc(ctx, slug, excludeID)
+	return mock.IsSlugTakenFunc(ctx, slug, excludeID, ownerID)
 }
 
 // IsSlugTakenCalls gets all the calls that were made to IsSlugTaken.
@@ -462,11 +829,13 @@ func (mock *WishListRepositoryInterfaceMock) IsSlugTakenCalls() []struct {
 	Ctx       context.Context
 	Slug      string
 	ExcludeID pgtype.UUID
+	OwnerID   pgtype.UUID
 } {
 	var calls []struct {
 		Ctx       context.Context
 		Slug      string
 		ExcludeID pgtype.UUID
+		OwnerID   pgtype.UUID
 	}
 	mock.lockIsSlugTaken.RLock()
 	calls = mock.calls.IsSlugTaken
@@ -474,39 +843,275 @@ func (mock *WishListRepositoryInterfaceMock) IsSlugTakenCalls() []struct {
 	return calls
 }
 
-// IncrementViewCount calls IncrementViewCountFunc.
-func (mock *WishListRepositoryInterfaceMock) IncrementViewCount(ctx context.Context, id pgtype.UUID) error {
-	if mock.IncrementViewCountFunc == nil {
-		panic("WishListRepositoryInterfaceMock.IncrementViewCountFunc: method is nil but WishListRepositoryInterface.IncrementViewCount was just called")
+// IsVanitySlugTaken calls IsVanitySlugTakenFunc.
+func (mock *WishListRepositoryInterfaceMock) IsVanitySlugTaken(ctx context.Context, slug string, ownerID pgtype.UUID, excludeID pgtype.UUID) (bool, error) {
+	if mock.IsVanitySlugTakenFunc == nil {
+		panic("WishListRepositoryInterfaceMock.IsVanitySlugTakenFunc: method is nil but WishListRepositoryInterface.IsVanitySlugTaken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Slug      string
+		OwnerID   pgtype.UUID
+		ExcludeID pgtype.UUID
+	}{
+		Ctx:       ctx,
+		Slug:      slug,
+		OwnerID:   ownerID,
+		ExcludeID: excludeID,
+	}
+	mock.lockIsVanitySlugTaken.Lock()
+	mock.calls.IsVanitySlugTaken = append(mock.calls.IsVanitySlugTaken, callInfo)
+	mock.lockIsVanitySlugTaken.Unlock()
+	return mock.IsVanitySlugTakenFunc(ctx, slug, ownerID, excludeID)
+}
+
+// IsVanitySlugTakenCalls gets all the calls that were made to IsVanitySlugTaken.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.IsVanitySlugTakenCalls())
+func (mock *WishListRepositoryInterfaceMock) IsVanitySlugTakenCalls() []struct {
+	Ctx       context.Context
+	Slug      string
+	OwnerID   pgtype.UUID
+	ExcludeID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Slug      string
+		OwnerID   pgtype.UUID
+		ExcludeID pgtype.UUID
+	}
+	mock.lockIsVanitySlugTaken.RLock()
+	calls = mock.calls.IsVanitySlugTaken
+	mock.lockIsVanitySlugTaken.RUnlock()
+	return calls
+}
+
+// ListDueForRollover calls ListDueForRolloverFunc.
+func (mock *WishListRepositoryInterfaceMock) ListDueForRollover(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+	if mock.ListDueForRolloverFunc == nil {
+		panic("WishListRepositoryInterfaceMock.ListDueForRolloverFunc: method is nil but WishListRepositoryInterface.ListDueForRollover was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		OccasionBefore time.Time
+	}{
+		Ctx:            ctx,
+		OccasionBefore: occasionBefore,
+	}
+	mock.lockListDueForRollover.Lock()
+	mock.calls.ListDueForRollover = append(mock.calls.ListDueForRollover, callInfo)
+	mock.lockListDueForRollover.Unlock()
+	return mock.ListDueForRolloverFunc(ctx, occasionBefore)
+}
+
+// ListDueForRolloverCalls gets all the calls that were made to ListDueForRollover.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.ListDueForRolloverCalls())
+func (mock *WishListRepositoryInterfaceMock) ListDueForRolloverCalls() []struct {
+	Ctx            context.Context
+	OccasionBefore time.Time
+} {
+	var calls []struct {
+		Ctx            context.Context
+		OccasionBefore time.Time
+	}
+	mock.lockListDueForRollover.RLock()
+	calls = mock.calls.ListDueForRollover
+	mock.lockListDueForRollover.RUnlock()
+	return calls
+}
+
+// ListExpiredPublic calls ListExpiredPublicFunc.
+func (mock *WishListRepositoryInterfaceMock) ListExpiredPublic(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+	if mock.ListExpiredPublicFunc == nil {
+		panic("WishListRepositoryInterfaceMock.ListExpiredPublicFunc: method is nil but WishListRepositoryInterface.ListExpiredPublic was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		OccasionBefore time.Time
+	}{
+		Ctx:            ctx,
+		OccasionBefore: occasionBefore,
+	}
+	mock.lockListExpiredPublic.Lock()
+	mock.calls.ListExpiredPublic = append(mock.calls.ListExpiredPublic, callInfo)
+	mock.lockListExpiredPublic.Unlock()
+	return mock.ListExpiredPublicFunc(ctx, occasionBefore)
+}
+
+// ListExpiredPublicCalls gets all the calls that were made to ListExpiredPublic.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.ListExpiredPublicCalls())
+func (mock *WishListRepositoryInterfaceMock) ListExpiredPublicCalls() []struct {
+	Ctx            context.Context
+	OccasionBefore time.Time
+} {
+	var calls []struct {
+		Ctx            context.Context
+		OccasionBefore time.Time
+	}
+	mock.lockListExpiredPublic.RLock()
+	calls = mock.calls.ListExpiredPublic
+	mock.lockListExpiredPublic.RUnlock()
+	return calls
+}
+
+// ListPublicWithInvalidSlug calls ListPublicWithInvalidSlugFunc.
+func (mock *WishListRepositoryInterfaceMock) ListPublicWithInvalidSlug(ctx context.Context) ([]*models.WishList, error) {
+	if mock.ListPublicWithInvalidSlugFunc == nil {
+		panic("WishListRepositoryInterfaceMock.ListPublicWithInvalidSlugFunc: method is nil but WishListRepositoryInterface.ListPublicWithInvalidSlug was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
-		ID  pgtype.UUID
 	}{
 		Ctx: ctx,
-		ID:  id,
 	}
-	mock.lockIncrementViewCount.Lock()
-	mock.calls.IncrementViewCount = append(mock.calls.IncrementViewCount, callInfo)
-	mock.lockIncrementViewCount.Unlock()
-	return mock.IncrementViewCountFunc(ctx, id)
+	mock.lockListPublicWithInvalidSlug.Lock()
+	mock.calls.ListPublicWithInvalidSlug = append(mock.calls.ListPublicWithInvalidSlug, callInfo)
+	mock.lockListPublicWithInvalidSlug.Unlock()
+	return mock.ListPublicWithInvalidSlugFunc(ctx)
 }
 
-// IncrementViewCountCalls gets all the calls that were made to IncrementViewCount.
+// ListPublicWithInvalidSlugCalls gets all the calls that were made to ListPublicWithInvalidSlug.
 // Check the length with:
 //
-//	len(mockedWishListRepositoryInterface.IncrementViewCountCalls())
-func (mock *WishListRepositoryInterfaceMock) IncrementViewCountCalls() []struct {
+//	len(mockedWishListRepositoryInterface.ListPublicWithInvalidSlugCalls())
+func (mock *WishListRepositoryInterfaceMock) ListPublicWithInvalidSlugCalls() []struct {
 	Ctx context.Context
-	ID  pgtype.UUID
 } {
 	var calls []struct {
 		Ctx context.Context
-		ID  pgtype.UUID
 	}
-	mock.lockIncrementViewCount.RLock()
-	calls = mock.calls.IncrementViewCount
-	mock.lockIncrementViewCount.RUnlock()
+	mock.lockListPublicWithInvalidSlug.RLock()
+	calls = mock.calls.ListPublicWithInvalidSlug
+	mock.lockListPublicWithInvalidSlug.RUnlock()
+	return calls
+}
+
+// Merge calls MergeFunc.
+func (mock *WishListRepositoryInterfaceMock) Merge(ctx context.Context, sourceID pgtype.UUID, targetID pgtype.UUID, opts repository.MergeOptions) (int64, error) {
+	if mock.MergeFunc == nil {
+		panic("WishListRepositoryInterfaceMock.MergeFunc: method is nil but WishListRepositoryInterface.Merge was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		TargetID pgtype.UUID
+		Opts     repository.MergeOptions
+	}{
+		Ctx:      ctx,
+		SourceID: sourceID,
+		TargetID: targetID,
+		Opts:     opts,
+	}
+	mock.lockMerge.Lock()
+	mock.calls.Merge = append(mock.calls.Merge, callInfo)
+	mock.lockMerge.Unlock()
+	return mock.MergeFunc(ctx, sourceID, targetID, opts)
+}
+
+// MergeCalls gets all the calls that were made to Merge.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.MergeCalls())
+func (mock *WishListRepositoryInterfaceMock) MergeCalls() []struct {
+	Ctx      context.Context
+	SourceID pgtype.UUID
+	TargetID pgtype.UUID
+	Opts     repository.MergeOptions
+} {
+	var calls []struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		TargetID pgtype.UUID
+		Opts     repository.MergeOptions
+	}
+	mock.lockMerge.RLock()
+	calls = mock.calls.Merge
+	mock.lockMerge.RUnlock()
+	return calls
+}
+
+// RollOver calls RollOverFunc.
+func (mock *WishListRepositoryInterfaceMock) RollOver(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+	if mock.RollOverFunc == nil {
+		panic("WishListRepositoryInterfaceMock.RollOverFunc: method is nil but WishListRepositoryInterface.RollOver was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		Next     models.WishList
+	}{
+		Ctx:      ctx,
+		SourceID: sourceID,
+		Next:     next,
+	}
+	mock.lockRollOver.Lock()
+	mock.calls.RollOver = append(mock.calls.RollOver, callInfo)
+	mock.lockRollOver.Unlock()
+	return mock.RollOverFunc(ctx, sourceID, next)
+}
+
+// RollOverCalls gets all the calls that were made to RollOver.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.RollOverCalls())
+func (mock *WishListRepositoryInterfaceMock) RollOverCalls() []struct {
+	Ctx      context.Context
+	SourceID pgtype.UUID
+	Next     models.WishList
+} {
+	var calls []struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		Next     models.WishList
+	}
+	mock.lockRollOver.RLock()
+	calls = mock.calls.RollOver
+	mock.lockRollOver.RUnlock()
+	return calls
+}
+
+// RollOverUnpurchased calls RollOverUnpurchasedFunc.
+func (mock *WishListRepositoryInterfaceMock) RollOverUnpurchased(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+	if mock.RollOverUnpurchasedFunc == nil {
+		panic("WishListRepositoryInterfaceMock.RollOverUnpurchasedFunc: method is nil but WishListRepositoryInterface.RollOverUnpurchased was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		Next     models.WishList
+	}{
+		Ctx:      ctx,
+		SourceID: sourceID,
+		Next:     next,
+	}
+	mock.lockRollOverUnpurchased.Lock()
+	mock.calls.RollOverUnpurchased = append(mock.calls.RollOverUnpurchased, callInfo)
+	mock.lockRollOverUnpurchased.Unlock()
+	return mock.RollOverUnpurchasedFunc(ctx, sourceID, next)
+}
+
+// RollOverUnpurchasedCalls gets all the calls that were made to RollOverUnpurchased.
+// Check the length with:
+//
+//	len(mockedWishListRepositoryInterface.RollOverUnpurchasedCalls())
+func (mock *WishListRepositoryInterfaceMock) RollOverUnpurchasedCalls() []struct {
+	Ctx      context.Context
+	SourceID pgtype.UUID
+	Next     models.WishList
+} {
+	var calls []struct {
+		Ctx      context.Context
+		SourceID pgtype.UUID
+		Next     models.WishList
+	}
+	mock.lockRollOverUnpurchased.RLock()
+	calls = mock.calls.RollOverUnpurchased
+	mock.lockRollOverUnpurchased.RUnlock()
 	return calls
 }
 
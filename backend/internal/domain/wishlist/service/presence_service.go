@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/presence"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// EditPresenceTTL is how long an editing session counts as present after its last
+// heartbeat. Clients send a heartbeat about every third of it.
+const EditPresenceTTL = 45 * time.Second
+
+// maxEditSessionIDLength bounds the client-chosen session ID stored in presence
+const maxEditSessionIDLength = 64
+
+var ErrInvalidEditSession = errors.New("invalid edit session id")
+
+// PresenceTrackerInterface records which editing sessions have a wishlist open
+type PresenceTrackerInterface interface {
+	Touch(ctx context.Context, room, sessionID string, ttl time.Duration) ([]presence.Session, error)
+	Leave(ctx context.Context, room, sessionID string) error
+}
+
+// EditPresenceServiceInterface defines the interface for wishlist edit presence
+type EditPresenceServiceInterface interface {
+	Heartbeat(ctx context.Context, wishList *models.WishList, input EditHeartbeatInput) (*EditPresenceOutput, error)
+	Leave(ctx context.Context, wishList *models.WishList, sessionID string) error
+}
+
+// EditPresenceService lets the owner's editing sessions (tabs, devices) see each
+// other and learn when the wishlist was saved elsewhere since they loaded it. It
+// only warns; UpdateWishList's version check still rejects stale writes. Callers
+// pass a wishlist the user is known to own, e.g. from RequireWishListOwner.
+type EditPresenceService struct {
+	tracker PresenceTrackerInterface
+}
+
+// NewEditPresenceService creates a new edit presence service
+func NewEditPresenceService(tracker PresenceTrackerInterface) *EditPresenceService {
+	return &EditPresenceService{
+		tracker: tracker,
+	}
+}
+
+// EditHeartbeatInput is sent periodically by an open wishlist editor
+type EditHeartbeatInput struct {
+	SessionID     string // Chosen by the client, stable for the lifetime of the editor
+	LoadedVersion int32  // Version of the wishlist the editor is showing
+}
+
+// EditPresenceOutput describes who else is editing and whether the editor is stale
+type EditPresenceOutput struct {
+	CurrentVersion      int32
+	UpdatedAt           pgtype.Timestamptz
+	ModifiedSinceLoaded bool
+	OtherSessions       []presence.Session
+}
+
+// Heartbeat marks the session as editing the wishlist and reports the other
+// sessions editing it and whether it changed since the session loaded it
+func (s *EditPresenceService) Heartbeat(ctx context.Context, wishList *models.WishList, input EditHeartbeatInput) (*EditPresenceOutput, error) {
+	if input.SessionID == "" || len(input.SessionID) > maxEditSessionIDLength {
+		return nil, ErrInvalidEditSession
+	}
+
+	sessions, err := s.tracker.Touch(ctx, editPresenceRoom(wishList.ID), input.SessionID, EditPresenceTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record edit presence: %w", err)
+	}
+
+	others := make([]presence.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID != input.SessionID {
+			others = append(others, session)
+		}
+	}
+
+	return &EditPresenceOutput{
+		CurrentVersion:      wishList.Version,
+		UpdatedAt:           wishList.UpdatedAt,
+		ModifiedSinceLoaded: wishList.Version != input.LoadedVersion,
+		OtherSessions:       others,
+	}, nil
+}
+
+// Leave removes the session from the wishlist's editors, e.g. when the editor closes
+func (s *EditPresenceService) Leave(ctx context.Context, wishList *models.WishList, sessionID string) error {
+	if sessionID == "" || len(sessionID) > maxEditSessionIDLength {
+		return ErrInvalidEditSession
+	}
+
+	if err := s.tracker.Leave(ctx, editPresenceRoom(wishList.ID), sessionID); err != nil {
+		return fmt.Errorf("failed to remove edit presence: %w", err)
+	}
+	return nil
+}
+
+func editPresenceRoom(wishListID pgtype.UUID) string {
+	return "wishlist-edit:" + wishListID.String()
+}
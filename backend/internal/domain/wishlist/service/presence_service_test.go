@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/pkg/presence"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditPresenceService_Heartbeat(t *testing.T) {
+	wishListID := "00000000-0000-0000-0000-0000000000a1"
+
+	wishList := &models.WishList{Version: 4}
+	require.NoError(t, wishList.ID.Scan(wishListID))
+
+	t.Run("reports other editors and a stale editor", func(t *testing.T) {
+		seen := time.Now()
+		tracker := &PresenceTrackerInterfaceMock{
+			TouchFunc: func(ctx context.Context, room, sessionID string, ttl time.Duration) ([]presence.Session, error) {
+				assert.Equal(t, "wishlist-edit:"+wishListID, room)
+				assert.Equal(t, EditPresenceTTL, ttl)
+				return []presence.Session{{ID: "tab-1", LastSeen: seen}, {ID: "phone", LastSeen: seen}}, nil
+			},
+		}
+		svc := NewEditPresenceService(tracker)
+
+		out, err := svc.Heartbeat(context.Background(), wishList, EditHeartbeatInput{SessionID: "tab-1", LoadedVersion: 3})
+		require.NoError(t, err)
+		assert.True(t, out.ModifiedSinceLoaded)
+		assert.Equal(t, int32(4), out.CurrentVersion)
+		require.Len(t, out.OtherSessions, 1)
+		assert.Equal(t, "phone", out.OtherSessions[0].ID)
+	})
+
+	t.Run("up to date and alone", func(t *testing.T) {
+		tracker := &PresenceTrackerInterfaceMock{
+			TouchFunc: func(ctx context.Context, room, sessionID string, ttl time.Duration) ([]presence.Session, error) {
+				return []presence.Session{{ID: sessionID}}, nil
+			},
+		}
+		svc := NewEditPresenceService(tracker)
+
+		out, err := svc.Heartbeat(context.Background(), wishList, EditHeartbeatInput{SessionID: "tab-1", LoadedVersion: 4})
+		require.NoError(t, err)
+		assert.False(t, out.ModifiedSinceLoaded)
+		assert.Empty(t, out.OtherSessions)
+	})
+
+	t.Run("session id required", func(t *testing.T) {
+		svc := NewEditPresenceService(&PresenceTrackerInterfaceMock{})
+
+		_, err := svc.Heartbeat(context.Background(), wishList, EditHeartbeatInput{})
+		assert.ErrorIs(t, err, ErrInvalidEditSession)
+	})
+}
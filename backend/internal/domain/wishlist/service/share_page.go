@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	publicWishListCacheKey = "wishlist:public:%s"
+	sharePageCacheKey      = "wishlist:share-page:%s"
+)
+
+// SharePageItems is how many items the share page payload carries; the page
+// fetches any further ones through the paginated gift items endpoint
+const SharePageItems = 100
+
+// SharePageCacheTTL is how long a share page payload is served from cache. It is
+// kept short because reservation statuses change without the owner editing the list.
+const SharePageCacheTTL = 30 * time.Second
+
+// SharePageOutput is everything the public share page renders on first load
+type SharePageOutput struct {
+	WishList   *WishListOutput
+	OwnerName  string // Owner's first name; empty when they have not set one
+	Items      []*GiftItemOutput
+	TotalItems int
+	ComputedAt time.Time
+}
+
+// GetSharePage returns a public wishlist with its owner's name and the first
+// SharePageItems items, served from cache for up to SharePageCacheTTL. A retired
+// slug fails with WishListSlugMovedError like GetWishListByPublicSlug.
+func (s *WishListService) GetSharePage(ctx context.Context, publicSlug string) (*SharePageOutput, error) {
+	cacheKey := fmt.Sprintf(sharePageCacheKey, publicSlug)
+	if s.cache != nil {
+		var cached SharePageOutput
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil && time.Since(cached.ComputedAt) < SharePageCacheTTL {
+			s.recordSharePageViews(ctx, cached.Items)
+			return &cached, nil
+		}
+	}
+
+	wishList, err := s.GetWishListByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := s.GetGiftItemsByPublicSlugPaginated(ctx, publicSlug, SharePageItems, 0)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []*GiftItemOutput{}
+	}
+
+	output := &SharePageOutput{
+		WishList:   wishList,
+		OwnerName:  s.ownerName(ctx, wishList.OwnerID),
+		Items:      items,
+		TotalItems: total,
+		ComputedAt: time.Now().UTC(),
+	}
+
+	if s.cache != nil {
+		_ = s.cache.Set(ctx, cacheKey, output)
+	}
+
+	return output, nil
+}
+
+// ownerName looks up the first name shown on the share page. The page is served
+// without it when the lookup fails.
+func (s *WishListService) ownerName(ctx context.Context, ownerID string) string {
+	if s.userRepo == nil {
+		return ""
+	}
+
+	id := pgtype.UUID{}
+	if err := id.Scan(ownerID); err != nil {
+		return ""
+	}
+	owner, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get wishlist owner for share page", "error", err, "owner_id", ownerID)
+		return ""
+	}
+	return owner.FirstName.String
+}
+
+// recordSharePageViews counts the items of a cached share page as viewed, as
+// GetGiftItemsByPublicSlugPaginated does for fresh ones
+func (s *WishListService) recordSharePageViews(ctx context.Context, items []*GiftItemOutput) {
+	if s.itemActivity == nil {
+		return
+	}
+
+	ids := make([]pgtype.UUID, 0, len(items))
+	for _, item := range items {
+		id := pgtype.UUID{}
+		if err := id.Scan(item.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if err := s.itemActivity.RecordItemViews(ctx, ids); err != nil {
+		logger.WarnContext(ctx, "failed to record item views", "error", err)
+	}
+}
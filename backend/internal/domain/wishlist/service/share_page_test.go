@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/wishlist/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonCache stores values as JSON, as the Redis cache does
+func jsonCache() *CacheInterfaceMock {
+	entries := map[string][]byte{}
+	return &CacheInterfaceMock{
+		GetFunc: func(ctx context.Context, key string, dest any) error {
+			data, ok := entries[key]
+			if !ok {
+				return errors.New("cache miss")
+			}
+			return json.Unmarshal(data, dest)
+		},
+		SetFunc: func(ctx context.Context, key string, value any) error {
+			data, err := json.Marshal(value)
+			entries[key] = data
+			return err
+		},
+		DeleteFunc: func(ctx context.Context, key string) error {
+			delete(entries, key)
+			return nil
+		},
+	}
+}
+
+func TestWishListService_GetSharePage(t *testing.T) {
+	ownerID := pgtype.UUID{Bytes: [16]byte{7}, Valid: true}
+	itemID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+
+	newService := func(cache CacheInterface, users UserRepositoryInterface) (*WishListService, *GiftItemRepositoryInterfaceMock, *ItemActivityRecorderInterfaceMock) {
+		wishLists := &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+				return &models.WishList{
+					ID:         pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+					OwnerID:    ownerID,
+					Title:      "Birthday",
+					IsPublic:   pgtype.Bool{Bool: true, Valid: true},
+					PublicSlug: pgtype.Text{String: publicSlug, Valid: true},
+				}, nil
+			},
+		}
+		giftItems := &GiftItemRepositoryInterfaceMock{
+			GetPublicWishListGiftItemsPaginatedFunc: func(ctx context.Context, publicSlug string, limit, offset int) ([]*itemmodels.GiftItem, int, error) {
+				return []*itemmodels.GiftItem{{ID: itemID, Name: "Camera"}}, 120, nil
+			},
+		}
+		activity := &ItemActivityRecorderInterfaceMock{
+			RecordItemViewsFunc: func(ctx context.Context, itemIDs []pgtype.UUID) error { return nil },
+		}
+		svc := NewWishListService(wishLists, giftItems, nil, nil, nil, nil, cache, nil, nil, nil, nil, nil, activity, users)
+		return svc, giftItems, activity
+	}
+	users := &UserRepositoryInterfaceMock{
+		GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+			return &usermodels.User{ID: id, FirstName: pgtype.Text{String: "Alice", Valid: true}}, nil
+		},
+	}
+
+	t.Run("aggregates the wishlist, owner and first items", func(t *testing.T) {
+		svc, giftItems, _ := newService(nil, users)
+
+		page, err := svc.GetSharePage(context.Background(), "birthday")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Birthday", page.WishList.Title)
+		assert.Equal(t, "Alice", page.OwnerName)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "Camera", page.Items[0].Name)
+		assert.Equal(t, 120, page.TotalItems)
+		assert.Equal(t, ownerID, users.GetByIDCalls()[0].ID)
+		assert.Equal(t, SharePageItems, giftItems.GetPublicWishListGiftItemsPaginatedCalls()[0].Limit)
+	})
+
+	t.Run("serves a fresh payload from cache and still counts item views", func(t *testing.T) {
+		svc, giftItems, activity := newService(jsonCache(), users)
+
+		_, err := svc.GetSharePage(context.Background(), "birthday")
+		require.NoError(t, err)
+		page, err := svc.GetSharePage(context.Background(), "birthday")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Alice", page.OwnerName)
+		assert.Len(t, giftItems.GetPublicWishListGiftItemsPaginatedCalls(), 1)
+		require.Len(t, activity.RecordItemViewsCalls(), 2)
+		assert.Equal(t, []pgtype.UUID{itemID}, activity.RecordItemViewsCalls()[1].ItemIDs)
+	})
+
+	t.Run("recomputes a stale payload", func(t *testing.T) {
+		cache := jsonCache()
+		require.NoError(t, cache.Set(context.Background(), "wishlist:share-page:birthday", SharePageOutput{ComputedAt: time.Now().Add(-SharePageCacheTTL)}))
+		svc, giftItems, _ := newService(cache, users)
+
+		page, err := svc.GetSharePage(context.Background(), "birthday")
+
+		require.NoError(t, err)
+		assert.Len(t, giftItems.GetPublicWishListGiftItemsPaginatedCalls(), 1)
+		assert.Len(t, page.Items, 1)
+	})
+
+	t.Run("owner lookup failure leaves the name out", func(t *testing.T) {
+		failingUsers := &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				return nil, errors.New("db down")
+			},
+		}
+		svc, _, _ := newService(nil, failingUsers)
+
+		page, err := svc.GetSharePage(context.Background(), "birthday")
+
+		require.NoError(t, err)
+		assert.Empty(t, page.OwnerName)
+	})
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PublicSlugExpiry is how long after its occasion a wishlist that will not come
+// round again stays published
+const PublicSlugExpiry = 90 * 24 * time.Hour
+
+// PublicSlugExpiryInterval is how often wishlists are checked for public links to close
+const PublicSlugExpiryInterval = 24 * time.Hour
+
+// slugPattern accepts only lowercase letters, digits, and hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// reservedSlugs cannot be chosen as custom slugs: they collide with app and API
+// routes or could pass for official pages
+var reservedSlugs = map[string]struct{}{
+	"about": {}, "account": {}, "admin": {}, "api": {}, "app": {}, "assets": {},
+	"auth": {}, "billing": {}, "blog": {}, "contact": {}, "dashboard": {}, "docs": {},
+	"edit": {}, "favicon": {}, "health": {}, "help": {}, "login": {}, "logout": {},
+	"metrics": {}, "new": {}, "oauth": {}, "privacy": {}, "profile": {}, "public": {},
+	"register": {}, "robots": {}, "settings": {}, "signin": {}, "signup": {},
+	"sitemap": {}, "static": {}, "support": {}, "swagger": {}, "terms": {},
+	"wishlist": {}, "wishlists": {}, "www": {},
+}
+
+// validateCustomSlug checks the format of a slug chosen by an owner
+func validateCustomSlug(slug string) error {
+	if !slugPattern.MatchString(slug) {
+		return ErrSlugInvalid
+	}
+	if _, reserved := reservedSlugs[slug]; reserved {
+		return ErrSlugReserved
+	}
+	return nil
+}
+
+// claimCustomSlug validates a slug chosen by an owner and returns the public slug
+// and vanity slug to store. Premium owners keep the slug as chosen and get it as
+// their vanity slug, served under their username, which only has to be unique among
+// their own wishlists; if another owner already publishes under it, an owner with a
+// username gets a random suffix on the public slug only. Other plans get a random
+// suffix unless the slug is the wishlist's current one, and no vanity slug.
+// wishlistID and current are zero values for a wishlist that is being created.
+func (s *WishListService) claimCustomSlug(ctx context.Context, ownerID, wishlistID pgtype.UUID, requested string, current pgtype.Text) (string, pgtype.Text, error) {
+	if err := validateCustomSlug(requested); err != nil {
+		return "", pgtype.Text{}, err
+	}
+
+	allowed := true
+	if s.quota != nil {
+		var err error
+		if allowed, err = s.quota.AllowsCustomSlug(ctx, ownerID); err != nil {
+			return "", pgtype.Text{}, fmt.Errorf("failed to check plan: %w", err)
+		}
+	}
+
+	slug := requested
+	if !allowed && requested != current.String {
+		slug = generatePublicSlug(requested)
+	}
+
+	var vanity pgtype.Text
+	if allowed {
+		taken, err := s.wishListRepo.IsVanitySlugTaken(ctx, requested, ownerID, wishlistID)
+		if err != nil {
+			return "", pgtype.Text{}, fmt.Errorf("failed to check vanity slug uniqueness: %w", err)
+		}
+		if taken {
+			return "", pgtype.Text{}, ErrSlugTaken
+		}
+		vanity = pgtype.Text{String: requested, Valid: true}
+	}
+
+	taken, err := s.wishListRepo.IsSlugTaken(ctx, slug, wishlistID, ownerID)
+	if err != nil {
+		return "", pgtype.Text{}, fmt.Errorf("failed to check slug uniqueness: %w", err)
+	}
+	if !taken {
+		return slug, vanity, nil
+	}
+	if !vanity.Valid || !s.hasUsername(ctx, ownerID) {
+		return "", pgtype.Text{}, ErrSlugTaken
+	}
+
+	slug, err = s.generateUniquePublicSlug(ctx, requested, wishlistID, ownerID)
+	if err != nil {
+		return "", pgtype.Text{}, err
+	}
+	return slug, vanity, nil
+}
+
+// hasUsername reports whether the owner published a username, which their vanity
+// links are served under
+func (s *WishListService) hasUsername(ctx context.Context, ownerID pgtype.UUID) bool {
+	if s.userRepo == nil {
+		return false
+	}
+	owner, err := s.userRepo.GetByID(ctx, ownerID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to get wishlist owner for vanity slug", "error", err, "owner_id", ownerID)
+		return false
+	}
+	return owner.Username.Valid
+}
+
+// GetWishListByVanitySlug resolves a vanity link: the public wishlist published
+// under slug by the user with the given username. Both are matched lowercase.
+func (s *WishListService) GetWishListByVanitySlug(ctx context.Context, username, slug string) (*WishListOutput, error) {
+	username, slug = strings.ToLower(username), strings.ToLower(slug)
+	if !slugPattern.MatchString(slug) {
+		return nil, ErrWishListNotFound
+	}
+
+	wishList, err := s.wishListRepo.GetByVanitySlug(ctx, username, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrWishListNotFound) {
+			return nil, ErrWishListNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist by vanity slug from repository: %w", err)
+	}
+
+	return wishListToOutput(wishList), nil
+}
+
+// CloseExpiredPublicSlugs closes the public links of wishlists whose occasion was
+// more than PublicSlugExpiry ago and that will not come round again: one-off lists,
+// and recurring lists rolled over or merged into another. Each list is unpublished,
+// its cached public pages are dropped and its old link answers that it is closed.
+// Returns the number of wishlists closed; one that fails to close is logged and
+// retried on the next run.
+func (s *WishListService) CloseExpiredPublicSlugs(ctx context.Context) (int, error) {
+	wishLists, err := s.wishListRepo.ListExpiredPublic(ctx, time.Now().Add(-PublicSlugExpiry))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired public wishlists: %w", err)
+	}
+
+	closed := 0
+	for _, wishList := range wishLists {
+		if err := s.wishListRepo.ClosePublicSlug(ctx, wishList.ID); err != nil {
+			if !errors.Is(err, repository.ErrWishListNotFound) {
+				logger.ErrorContext(ctx, "failed to close public slug", "wishlist_id", wishList.ID.String(), "error", err)
+			}
+			continue
+		}
+		if s.cache != nil {
+			s.invalidatePublicSlug(ctx, wishList.PublicSlug.String)
+		}
+		closed++
+	}
+
+	return closed, nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	usermodels "wish-list/internal/domain/user/models"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomSlug(t *testing.T) {
+	tests := []struct {
+		slug    string
+		wantErr error
+	}{
+		{slug: "anna-birthday-2026"},
+		{slug: "admins"},
+		{slug: "Anna", wantErr: ErrSlugInvalid},
+		{slug: "anna birthday", wantErr: ErrSlugInvalid},
+		{slug: "admin", wantErr: ErrSlugReserved},
+		{slug: "api", wantErr: ErrSlugReserved},
+		{slug: "static", wantErr: ErrSlugReserved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			err := validateCustomSlug(tt.slug)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWishListService_CreateWishList_CustomSlug(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	premium := &QuotaCheckerInterfaceMock{
+		CheckWishListQuotaFunc: func(ctx context.Context, userID pgtype.UUID) error { return nil },
+		AllowsCustomSlugFunc:   func(ctx context.Context, userID pgtype.UUID) (bool, error) { return true, nil },
+	}
+
+	t.Run("stores a valid slug", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				assert.False(t, excludeID.Valid)
+				assert.Equal(t, testUUID, ownerID)
+				return false, nil
+			},
+			IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			CreateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				return &wishList, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, premium, nil, nil, nil, nil, nil)
+
+		result, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:      "Birthday",
+			PublicSlug: " anna-birthday ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "anna-birthday", result.PublicSlug)
+		assert.Equal(t, "anna-birthday", result.VanitySlug)
+	})
+
+	t.Run("premium owner with a username keeps the vanity slug when the public slug is taken", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				return slug == "birthday", nil
+			},
+			IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+				assert.Equal(t, testUUID, ownerID)
+				return false, nil
+			},
+			CreateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				return &wishList, nil
+			},
+		}
+		users := &UserRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*usermodels.User, error) {
+				return &usermodels.User{ID: id, Username: pgtype.Text{String: "anna", Valid: true}}, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, premium, nil, nil, nil, nil, users)
+
+		result, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:      "Birthday",
+			IsPublic:   true,
+			PublicSlug: "birthday",
+		})
+
+		require.NoError(t, err)
+		assert.Regexp(t, `^birthday-\d{4}$`, result.PublicSlug)
+		assert.Equal(t, "birthday", result.VanitySlug)
+	})
+
+	t.Run("free plan gets no vanity slug", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			CreateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				return &wishList, nil
+			},
+		}
+		free := &QuotaCheckerInterfaceMock{
+			CheckWishListQuotaFunc: func(ctx context.Context, userID pgtype.UUID) error { return nil },
+			AllowsCustomSlugFunc:   func(ctx context.Context, userID pgtype.UUID) (bool, error) { return false, nil },
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, free, nil, nil, nil, nil, nil)
+
+		result, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:      "Birthday",
+			PublicSlug: "birthday",
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.VanitySlug)
+		assert.Empty(t, mockWishListRepo.IsVanitySlugTakenCalls())
+	})
+
+	for _, tt := range []struct {
+		name        string
+		slug        string
+		taken       bool
+		vanityTaken bool
+		wantErr     error
+	}{
+		{name: "rejects reserved words", slug: "admin", wantErr: ErrSlugReserved},
+		{name: "rejects malformed slugs", slug: "Anna_Birthday", wantErr: ErrSlugInvalid},
+		{name: "rejects taken slugs without a username", slug: "anna-birthday", taken: true, wantErr: ErrSlugTaken},
+		{name: "rejects a vanity slug the owner already uses", slug: "anna-birthday", vanityTaken: true, wantErr: ErrSlugTaken},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+					return tt.taken, nil
+				},
+				IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+					return tt.vanityTaken, nil
+				},
+			}
+			service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, premium, nil, nil, nil, nil, nil)
+
+			_, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+				Title:      "Birthday",
+				IsPublic:   true,
+				PublicSlug: tt.slug,
+			})
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, mockWishListRepo.CreateCalls())
+		})
+	}
+}
+
+func TestWishListService_GetWishListByVanitySlug(t *testing.T) {
+	t.Run("resolves case-insensitively", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByVanitySlugFunc: func(ctx context.Context, username, slug string) (*models.WishList, error) {
+				assert.Equal(t, "anna", username)
+				assert.Equal(t, "birthday", slug)
+				return &models.WishList{
+					Title:      "Birthday",
+					PublicSlug: pgtype.Text{String: "birthday-1234", Valid: true},
+					VanitySlug: pgtype.Text{String: "birthday", Valid: true},
+				}, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		result, err := service.GetWishListByVanitySlug(context.Background(), "Anna", "Birthday")
+
+		require.NoError(t, err)
+		assert.Equal(t, "birthday-1234", result.PublicSlug)
+	})
+
+	t.Run("unknown link is not found", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByVanitySlugFunc: func(ctx context.Context, username, slug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.GetWishListByVanitySlug(context.Background(), "anna", "never-used")
+		require.ErrorIs(t, err, ErrWishListNotFound)
+
+		_, err = service.GetWishListByVanitySlug(context.Background(), "anna", "not a slug")
+		require.ErrorIs(t, err, ErrWishListNotFound)
+		assert.Len(t, mockWishListRepo.GetByVanitySlugCalls(), 1)
+	})
+}
+
+func TestWishListService_CloseExpiredPublicSlugs(t *testing.T) {
+	first := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	second := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	mockWishListRepo := &WishListRepositoryInterfaceMock{
+		ListExpiredPublicFunc: func(ctx context.Context, occasionBefore time.Time) ([]*models.WishList, error) {
+			assert.WithinDuration(t, time.Now().Add(-PublicSlugExpiry), occasionBefore, time.Minute)
+			return []*models.WishList{
+				{ID: first, PublicSlug: pgtype.Text{String: "birthday-2025", Valid: true}},
+				{ID: second, PublicSlug: pgtype.Text{String: "wedding-2025", Valid: true}},
+			}, nil
+		},
+		ClosePublicSlugFunc: func(ctx context.Context, id pgtype.UUID) error {
+			if id == second {
+				return errors.New("db down")
+			}
+			return nil
+		},
+	}
+	mockCache := &CacheInterfaceMock{
+		DeleteFunc: func(ctx context.Context, key string) error { return nil },
+	}
+	service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, mockCache, nil, nil, nil, nil, nil, nil, nil)
+
+	closed, err := service.CloseExpiredPublicSlugs(context.Background())
+
+	require.NoError(t, err, "a list that fails to close is retried on the next run")
+	assert.Equal(t, 1, closed)
+	assert.Len(t, mockWishListRepo.ClosePublicSlugCalls(), 2)
+	var deleted []string
+	for _, call := range mockCache.DeleteCalls() {
+		deleted = append(deleted, call.Key)
+	}
+	assert.Equal(t, []string{"wishlist:public:birthday-2025", "wishlist:share-page:birthday-2025"}, deleted)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/app/database"
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SnapshotDateLayout is how snapshot dates are written in URLs and responses
+const SnapshotDateLayout = time.DateOnly
+
+// SnapshotInterval is how often wishlists reaching their occasion date are
+// looked for. Lists are frozen within this long of the day starting (UTC).
+const SnapshotInterval = time.Hour
+
+// snapshotCatchUp is how far back a missed occasion is still snapshotted, e.g.
+// after the job was not running. Older lists have likely changed since.
+const snapshotCatchUp = 7 * 24 * time.Hour
+
+var (
+	ErrSnapshotNotFound    = errors.New("wishlist snapshot not found")
+	ErrInvalidSnapshotDate = errors.New("invalid snapshot date")
+)
+
+// SnapshotServiceInterface defines the interface for wishlist snapshots
+type SnapshotServiceInterface interface {
+	TakeSnapshot(ctx context.Context, wishList *models.WishList) (*SnapshotOutput, bool, error)
+	GetSnapshot(ctx context.Context, wishList *models.WishList, date string) (*SnapshotOutput, error)
+	ListSnapshots(ctx context.Context, wishList *models.WishList) ([]*SnapshotSummaryOutput, error)
+	SnapshotOccasions(ctx context.Context) (int, error)
+}
+
+// SnapshotService freezes wishlists with their items' reservation and purchase
+// state, on the occasion date or when the owner asks, so that later edits do not
+// change what the list looked like then. Callers pass a wishlist the user is
+// known to own, e.g. from RequireWishListOwner.
+type SnapshotService struct {
+	snapshots repository.SnapshotRepositoryInterface
+	giftItems GiftItemRepositoryInterface
+}
+
+// NewSnapshotService creates a new wishlist snapshot service
+func NewSnapshotService(snapshots repository.SnapshotRepositoryInterface, giftItems GiftItemRepositoryInterface) *SnapshotService {
+	return &SnapshotService{
+		snapshots: snapshots,
+		giftItems: giftItems,
+	}
+}
+
+// SnapshotOutput is a wishlist as it was on Date
+type SnapshotOutput struct {
+	WishListID   string
+	Date         string // SnapshotDateLayout
+	Title        string
+	Occasion     string
+	OccasionDate string // SnapshotDateLayout; empty when the list had none
+	Items        []models.SnapshotItem
+	CreatedAt    time.Time
+}
+
+// SnapshotSummaryOutput describes a snapshot without its items
+type SnapshotSummaryOutput struct {
+	Date      string // SnapshotDateLayout
+	Title     string
+	ItemCount int
+	CreatedAt time.Time
+}
+
+// TakeSnapshot freezes the wishlist as it is now under today's date (UTC). When
+// it was already frozen today that snapshot is returned instead; the bool
+// reports whether a new one was taken.
+func (s *SnapshotService) TakeSnapshot(ctx context.Context, wishList *models.WishList) (*SnapshotOutput, bool, error) {
+	return s.takeSnapshot(ctx, wishList, time.Now().UTC())
+}
+
+// GetSnapshot returns the wishlist's snapshot of date, written as SnapshotDateLayout
+func (s *SnapshotService) GetSnapshot(ctx context.Context, wishList *models.WishList, date string) (*SnapshotOutput, error) {
+	day, err := time.Parse(SnapshotDateLayout, date)
+	if err != nil {
+		return nil, ErrInvalidSnapshotDate
+	}
+
+	snapshot, err := s.snapshots.Get(ctx, wishList.ID, day)
+	if err != nil {
+		if errors.Is(err, repository.ErrSnapshotNotFound) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist snapshot: %w", err)
+	}
+
+	return snapshotToOutput(snapshot), nil
+}
+
+// ListSnapshots returns the wishlist's snapshots without their items, newest first
+func (s *SnapshotService) ListSnapshots(ctx context.Context, wishList *models.WishList) ([]*SnapshotSummaryOutput, error) {
+	snapshots, err := s.snapshots.List(ctx, wishList.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlist snapshots: %w", err)
+	}
+
+	outputs := make([]*SnapshotSummaryOutput, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		outputs = append(outputs, &SnapshotSummaryOutput{
+			Date:      snapshot.SnapshotDate.Time.Format(SnapshotDateLayout),
+			Title:     snapshot.Title,
+			ItemCount: snapshot.ItemCount,
+			CreatedAt: snapshot.CreatedAt.Time,
+		})
+	}
+	return outputs, nil
+}
+
+// SnapshotOccasions freezes every wishlist whose occasion date has come, under
+// that date, and returns how many were frozen. A list that fails is logged and
+// retried on the next run.
+func (s *SnapshotService) SnapshotOccasions(ctx context.Context) (int, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	due, err := s.snapshots.ListDueWishLists(ctx, today.Add(-snapshotCatchUp), today)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find wishlists due for a snapshot: %w", err)
+	}
+
+	taken := 0
+	for _, wishList := range due {
+		if _, created, err := s.takeSnapshot(ctx, wishList, wishList.OccasionDate.Time); err != nil {
+			logger.ErrorContext(ctx, "failed to snapshot wishlist", "wishlist_id", wishList.ID.String(), "error", err)
+		} else if created {
+			taken++
+		}
+	}
+	return taken, nil
+}
+
+func (s *SnapshotService) takeSnapshot(ctx context.Context, wishList *models.WishList, date time.Time) (*SnapshotOutput, bool, error) {
+	giftItems, err := s.giftItems.GetByWishList(ctx, wishList.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get gift items from repository: %w", err)
+	}
+
+	items := make(models.SnapshotItems, 0, len(giftItems))
+	for _, giftItem := range giftItems {
+		if giftItem != nil {
+			items = append(items, snapshotItem(giftItem))
+		}
+	}
+
+	snapshot, created, err := s.snapshots.Create(ctx, models.Snapshot{
+		WishListID:   wishList.ID,
+		SnapshotDate: pgtype.Date{Time: date, Valid: true},
+		Title:        wishList.Title,
+		Occasion:     wishList.Occasion,
+		OccasionDate: wishList.OccasionDate,
+		Items:        items,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to store wishlist snapshot: %w", err)
+	}
+
+	return snapshotToOutput(snapshot), created, nil
+}
+
+// snapshotItem freezes an item as its owner sees it now
+func snapshotItem(giftItem *itemmodels.GiftItem) models.SnapshotItem {
+	return models.SnapshotItem{
+		ID:                giftItem.ID.String(),
+		Name:              giftItem.Name,
+		Description:       database.TextToString(giftItem.Description),
+		Link:              database.TextToString(giftItem.Link),
+		ImageURL:          database.TextToString(giftItem.ImageUrl),
+		Price:             database.NumericToFloat64(giftItem.Price),
+		PriorityLevel:     giftItem.Level(),
+		Category:          database.TextToString(giftItem.Category),
+		Notes:             database.TextToString(giftItem.Notes),
+		Quantity:          giftItem.WantedQuantity(),
+		ReservedQuantity:  giftItem.ReservedQuantity,
+		ReservationStatus: giftItem.ReservationState(),
+		PurchasedAt:       formatTimestamp(giftItem.PurchasedAt),
+		PurchasedPrice:    database.NumericToFloat64(giftItem.PurchasedPrice),
+	}
+}
+
+func snapshotToOutput(snapshot *models.Snapshot) *SnapshotOutput {
+	output := &SnapshotOutput{
+		WishListID: snapshot.WishListID.String(),
+		Date:       snapshot.SnapshotDate.Time.Format(SnapshotDateLayout),
+		Title:      snapshot.Title,
+		Occasion:   database.TextToString(snapshot.Occasion),
+		Items:      snapshot.Items,
+		CreatedAt:  snapshot.CreatedAt.Time,
+	}
+	if output.Items == nil {
+		output.Items = []models.SnapshotItem{}
+	}
+	if snapshot.OccasionDate.Valid {
+		output.OccasionDate = snapshot.OccasionDate.Time.Format(SnapshotDateLayout)
+	}
+	return output
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storingSnapshots keeps snapshots in memory, one per wishlist and day as the table does
+func storingSnapshots() *SnapshotRepositoryInterfaceMock {
+	stored := map[string]*models.Snapshot{}
+	key := func(id pgtype.UUID, date time.Time) string {
+		return id.String() + "/" + date.Format(SnapshotDateLayout)
+	}
+	return &SnapshotRepositoryInterfaceMock{
+		CreateFunc: func(ctx context.Context, snapshot models.Snapshot) (*models.Snapshot, bool, error) {
+			k := key(snapshot.WishListID, snapshot.SnapshotDate.Time)
+			if existing, ok := stored[k]; ok {
+				return existing, false, nil
+			}
+			snapshot.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+			stored[k] = &snapshot
+			return &snapshot, true, nil
+		},
+		GetFunc: func(ctx context.Context, wishListID pgtype.UUID, date time.Time) (*models.Snapshot, error) {
+			if snapshot, ok := stored[key(wishListID, date)]; ok {
+				return snapshot, nil
+			}
+			return nil, repository.ErrSnapshotNotFound
+		},
+	}
+}
+
+func TestSnapshotService_TakeSnapshot(t *testing.T) {
+	wishList := &models.WishList{Title: "Birthday", Occasion: pgtype.Text{String: "Birthday", Valid: true}}
+	require.NoError(t, wishList.ID.Scan("00000000-0000-0000-0000-0000000000a1"))
+
+	item := &itemmodels.GiftItem{
+		Name:             "Headphones",
+		Quantity:         2,
+		ReservedQuantity: 1,
+		PurchasedAt:      pgtype.Timestamptz{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), Valid: true},
+	}
+	require.NoError(t, item.ID.Scan("00000000-0000-0000-0000-0000000000b1"))
+
+	giftItems := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return []*itemmodels.GiftItem{item}, nil
+		},
+	}
+	svc := NewSnapshotService(storingSnapshots(), giftItems)
+
+	out, created, err := svc.TakeSnapshot(context.Background(), wishList)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, time.Now().UTC().Format(SnapshotDateLayout), out.Date)
+	assert.Equal(t, "Birthday", out.Title)
+	require.Len(t, out.Items, 1)
+	assert.Equal(t, "Headphones", out.Items[0].Name)
+	assert.Equal(t, int32(2), out.Items[0].Quantity)
+	assert.Equal(t, int32(1), out.Items[0].ReservedQuantity)
+	assert.Equal(t, itemmodels.ReservationPartiallyReserved, out.Items[0].ReservationStatus)
+	assert.Equal(t, "2026-03-01T12:00:00Z", out.Items[0].PurchasedAt)
+
+	// Edits after the snapshot do not rewrite it
+	item.Name = "Speakers"
+	again, created, err := svc.TakeSnapshot(context.Background(), wishList)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, "Headphones", again.Items[0].Name)
+
+	got, err := svc.GetSnapshot(context.Background(), wishList, out.Date)
+	require.NoError(t, err)
+	assert.Equal(t, "Headphones", got.Items[0].Name)
+}
+
+func TestSnapshotService_GetSnapshot(t *testing.T) {
+	wishList := &models.WishList{}
+	require.NoError(t, wishList.ID.Scan("00000000-0000-0000-0000-0000000000a1"))
+	svc := NewSnapshotService(storingSnapshots(), &GiftItemRepositoryInterfaceMock{})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := svc.GetSnapshot(context.Background(), wishList, "24-12-2026")
+		assert.ErrorIs(t, err, ErrInvalidSnapshotDate)
+	})
+
+	t.Run("no snapshot that day", func(t *testing.T) {
+		_, err := svc.GetSnapshot(context.Background(), wishList, "2026-12-24")
+		assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	})
+}
+
+func TestSnapshotService_SnapshotOccasions(t *testing.T) {
+	occasion := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	wishList := &models.WishList{Title: "Christmas", OccasionDate: pgtype.Date{Time: occasion, Valid: true}}
+	require.NoError(t, wishList.ID.Scan("00000000-0000-0000-0000-0000000000a1"))
+
+	snapshots := storingSnapshots()
+	snapshots.ListDueWishListsFunc = func(ctx context.Context, from, to time.Time) ([]*models.WishList, error) {
+		assert.Equal(t, snapshotCatchUp, to.Sub(from))
+		return []*models.WishList{wishList}, nil
+	}
+	giftItems := &GiftItemRepositoryInterfaceMock{
+		GetByWishListFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error) {
+			return nil, nil
+		},
+	}
+	svc := NewSnapshotService(snapshots, giftItems)
+
+	taken, err := svc.SnapshotOccasions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+
+	// Frozen under the occasion date rather than the day the job ran
+	got, err := svc.GetSnapshot(context.Background(), wishList, "2026-12-24")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-12-24", got.OccasionDate)
+	assert.Empty(t, got.Items)
+}
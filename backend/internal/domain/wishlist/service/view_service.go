@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wish-list/internal/domain/wishlist/repository"
+	"wish-list/internal/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ViewDedupeWindow is how long repeat visits of a session count as a single view
+const ViewDedupeWindow = 30 * time.Minute
+
+// ViewFlushInterval is how often buffered views are added to the stored counts
+const ViewFlushInterval = time.Minute
+
+// maxViewSessionIDLength bounds the client-chosen session ID kept in the view buffer
+const maxViewSessionIDLength = 64
+
+var ErrInvalidViewSession = errors.New("invalid view session id")
+
+// ViewBufferInterface collects page views between flushes, e.g. a pageviews.Buffer
+type ViewBufferInterface interface {
+	Record(ctx context.Context, page, sessionID string, window time.Duration) (int64, error)
+	Drain(ctx context.Context) (map[string]int64, error)
+}
+
+// ViewServiceInterface defines the interface for counting public wishlist views
+type ViewServiceInterface interface {
+	RecordView(ctx context.Context, publicSlug, sessionID string) (*ViewOutput, error)
+}
+
+// ViewService counts visits to public wishlists for their view history, the
+// owner's digest and trending wishlists. Views are buffered and added to the
+// stored counts every ViewFlushInterval, so counts lag behind by up to that long.
+type ViewService struct {
+	wishListRepo repository.WishListRepositoryInterface
+	buffer       ViewBufferInterface
+}
+
+// NewViewService creates a new view service
+func NewViewService(wishListRepo repository.WishListRepositoryInterface, buffer ViewBufferInterface) *ViewService {
+	return &ViewService{
+		wishListRepo: wishListRepo,
+		buffer:       buffer,
+	}
+}
+
+// ViewOutput is the view count of a wishlist including views not flushed yet
+type ViewOutput struct {
+	Views int64
+}
+
+// RecordView counts a visit to the public wishlist by a client-chosen session ID,
+// once per ViewDedupeWindow, and returns the approximate view count. A view that
+// cannot be buffered is dropped rather than failing the page.
+func (s *ViewService) RecordView(ctx context.Context, publicSlug, sessionID string) (*ViewOutput, error) {
+	if sessionID == "" || len(sessionID) > maxViewSessionIDLength {
+		return nil, ErrInvalidViewSession
+	}
+
+	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrWishListNotFound) {
+			return nil, ErrWishListNotFound
+		}
+		return nil, fmt.Errorf("failed to get wishlist by public slug from repository: %w", err)
+	}
+
+	pending, err := s.buffer.Record(ctx, wishList.ID.String(), sessionID, ViewDedupeWindow)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to record wishlist view", "wishlist_id", wishList.ID.String(), "error", err)
+	}
+
+	return &ViewOutput{Views: int64(wishList.ViewCount.Int32) + pending}, nil
+}
+
+// FlushViews adds the views buffered since the last flush to the stored counts
+// and returns how many wishlists were updated. Views of a wishlist that fail to
+// be stored are logged and dropped.
+func (s *ViewService) FlushViews(ctx context.Context) (int, error) {
+	counts, err := s.buffer.Drain(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to drain buffered views: %w", err)
+	}
+
+	flushed := 0
+	for page, views := range counts {
+		id := pgtype.UUID{}
+		if err := id.Scan(page); err != nil || views <= 0 {
+			continue
+		}
+
+		if err := s.wishListRepo.AddViews(ctx, id, views); err != nil {
+			if !errors.Is(err, repository.ErrWishListNotFound) {
+				logger.ErrorContext(ctx, "failed to store wishlist views", "wishlist_id", page, "views", views, "error", err)
+			}
+			continue
+		}
+		flushed++
+	}
+
+	return flushed, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewService_RecordView(t *testing.T) {
+	wishListID := "00000000-0000-0000-0000-0000000000a1"
+
+	wishList := &models.WishList{ViewCount: pgtype.Int4{Int32: 40, Valid: true}}
+	require.NoError(t, wishList.ID.Scan(wishListID))
+
+	wishLists := &WishListRepositoryInterfaceMock{
+		GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+			if publicSlug != "birthday-2026" {
+				return nil, repository.ErrWishListNotFound
+			}
+			return wishList, nil
+		},
+	}
+
+	t.Run("adds the buffered views to the stored count", func(t *testing.T) {
+		buffer := &ViewBufferInterfaceMock{
+			RecordFunc: func(ctx context.Context, page, sessionID string, window time.Duration) (int64, error) {
+				assert.Equal(t, wishListID, page)
+				assert.Equal(t, "tab-1", sessionID)
+				assert.Equal(t, ViewDedupeWindow, window)
+				return 3, nil
+			},
+		}
+		svc := NewViewService(wishLists, buffer)
+
+		out, err := svc.RecordView(context.Background(), "birthday-2026", "tab-1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), out.Views)
+	})
+
+	t.Run("buffer down still answers with the stored count", func(t *testing.T) {
+		buffer := &ViewBufferInterfaceMock{
+			RecordFunc: func(ctx context.Context, page, sessionID string, window time.Duration) (int64, error) {
+				return 0, errors.New("connection refused")
+			},
+		}
+		svc := NewViewService(wishLists, buffer)
+
+		out, err := svc.RecordView(context.Background(), "birthday-2026", "tab-1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(40), out.Views)
+	})
+
+	t.Run("unknown or private wishlist", func(t *testing.T) {
+		buffer := &ViewBufferInterfaceMock{}
+		svc := NewViewService(wishLists, buffer)
+
+		_, err := svc.RecordView(context.Background(), "someone-elses", "tab-1")
+		assert.ErrorIs(t, err, ErrWishListNotFound)
+		assert.Empty(t, buffer.RecordCalls())
+	})
+
+	t.Run("invalid session", func(t *testing.T) {
+		svc := NewViewService(wishLists, &ViewBufferInterfaceMock{})
+
+		_, err := svc.RecordView(context.Background(), "birthday-2026", "")
+		assert.ErrorIs(t, err, ErrInvalidViewSession)
+
+		_, err = svc.RecordView(context.Background(), "birthday-2026", strings.Repeat("a", maxViewSessionIDLength+1))
+		assert.ErrorIs(t, err, ErrInvalidViewSession)
+	})
+}
+
+func TestViewService_FlushViews(t *testing.T) {
+	kept := "00000000-0000-0000-0000-0000000000a1"
+	deleted := "00000000-0000-0000-0000-0000000000a2"
+
+	buffer := &ViewBufferInterfaceMock{
+		DrainFunc: func(ctx context.Context) (map[string]int64, error) {
+			return map[string]int64{kept: 5, deleted: 2, "not-a-uuid": 1}, nil
+		},
+	}
+	wishLists := &WishListRepositoryInterfaceMock{
+		AddViewsFunc: func(ctx context.Context, id pgtype.UUID, views int64) error {
+			if id.String() == deleted {
+				return repository.ErrWishListNotFound
+			}
+			return nil
+		},
+	}
+	svc := NewViewService(wishLists, buffer)
+
+	flushed, err := svc.FlushViews(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, flushed)
+	assert.Len(t, wishLists.AddViewsCalls(), 2)
+	for _, call := range wishLists.AddViewsCalls() {
+		if call.ID.String() == kept {
+			assert.Equal(t, int64(5), call.Views)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GiftItemRepositoryInterface ReservationRepositoryInterface EmailServiceInterface CacheInterface
+//go:generate go run github.com/matryer/moq@latest -out mock_cross_domain_test.go -pkg service . GiftItemRepositoryInterface ReservationRepositoryInterface EmailServiceInterface CacheInterface GiftHistoryRecorderInterface QuotaCheckerInterface ContentModeratorInterface GiftItemImageRepositoryInterface NotifierInterface PresenceTrackerInterface ItemActivityRecorderInterface UserRepositoryInterface ViewBufferInterface InviteEmailSenderInterface InviteContactsInterface
 
 package service
 
@@ -9,23 +9,23 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"regexp"
 	"strings"
 	"time"
 
-	"wish-list/internal/app/database"
+	"wish-list/internal/domain/item/mapper"
 	itemmodels "wish-list/internal/domain/item/models"
+	notificationmodels "wish-list/internal/domain/notification/models"
 	reservationmodels "wish-list/internal/domain/reservation/models"
+	usermodels "wish-list/internal/domain/user/models"
 	"wish-list/internal/domain/wishlist/models"
 	"wish-list/internal/domain/wishlist/repository"
 	"wish-list/internal/pkg/logger"
+	"wish-list/internal/pkg/metrics"
+	"wish-list/internal/pkg/priority"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-// slugPattern accepts only lowercase letters, digits, and hyphens.
-var slugPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
-
 // Cross-domain interfaces - only methods actually used by WishListService
 
 // GiftItemRepositoryInterface defines gift item repository methods used by wishlist service
@@ -33,7 +33,9 @@ type GiftItemRepositoryInterface interface {
 	CreateWithOwner(ctx context.Context, giftItem itemmodels.GiftItem) (*itemmodels.GiftItem, error)
 	GetByID(ctx context.Context, id pgtype.UUID) (*itemmodels.GiftItem, error)
 	GetByWishList(ctx context.Context, wishlistID pgtype.UUID) ([]*itemmodels.GiftItem, error)
+	GetPublicWishListGiftItems(ctx context.Context, publicSlug string) ([]*itemmodels.GiftItem, error)
 	GetPublicWishListGiftItemsPaginated(ctx context.Context, publicSlug string, limit, offset int) ([]*itemmodels.GiftItem, int, error)
+	GetPublicWishListGiftItemGroups(ctx context.Context, publicSlug, groupBy string) ([]*itemmodels.GiftItemGroup, error)
 	Update(ctx context.Context, giftItem itemmodels.GiftItem) (*itemmodels.GiftItem, error)
 }
 
@@ -50,12 +52,18 @@ type GiftItemPurchaseRepositoryInterface interface {
 // ReservationRepositoryInterface defines reservation repository methods used by wishlist service
 type ReservationRepositoryInterface interface {
 	GetActiveReservationForGiftItem(ctx context.Context, giftItemID pgtype.UUID) (*reservationmodels.Reservation, error)
+	HasActiveReservationsForWishlist(ctx context.Context, wishlistID pgtype.UUID) (bool, error)
 }
 
 // EmailServiceInterface defines email service methods used by wishlist service
 type EmailServiceInterface interface {
 	SendReservationRemovedEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle string) error
-	SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName string) error
+	SendGiftPurchasedConfirmationEmail(ctx context.Context, recipientEmail, giftItemName, wishlistTitle, guestName, message string) error
+}
+
+// NotifierInterface defines notification center methods used by wishlist service
+type NotifierInterface interface {
+	Notify(ctx context.Context, notification notificationmodels.Notification) error
 }
 
 // CacheInterface defines cache methods used by wishlist service
@@ -65,6 +73,41 @@ type CacheInterface interface {
 	Delete(ctx context.Context, key string) error
 }
 
+// GiftHistoryRecorderInterface defines gift history methods used by wishlist service
+type GiftHistoryRecorderInterface interface {
+	SnapshotWishlist(ctx context.Context, wishlistID pgtype.UUID) (int64, error)
+}
+
+// QuotaCheckerInterface defines plan quota checks used by wishlist service.
+// Checks fail with the quota domain's QuotaExceededError, returned unchanged.
+type QuotaCheckerInterface interface {
+	CheckWishListQuota(ctx context.Context, userID pgtype.UUID) error
+	CheckItemQuota(ctx context.Context, userID, wishlistID pgtype.UUID) error
+	AllowsCustomSlug(ctx context.Context, userID pgtype.UUID) (bool, error)
+}
+
+// GiftItemImageRepositoryInterface defines item gallery reads used by wishlist service
+type GiftItemImageRepositoryInterface interface {
+	GetByItemIDs(ctx context.Context, itemIDs []pgtype.UUID) (map[string][]*itemmodels.GiftItemImage, error)
+}
+
+// ContentModeratorInterface defines content moderation checks used by wishlist service.
+// Rejected content fails with the moderation domain's ContentRejectedError, returned unchanged.
+type ContentModeratorInterface interface {
+	CheckContent(ctx context.Context, imageURL string, texts ...string) error
+	CheckPublishable(ctx context.Context, wishlistID pgtype.UUID) error
+}
+
+// ItemActivityRecorderInterface counts public views of items for their owners' insights
+type ItemActivityRecorderInterface interface {
+	RecordItemViews(ctx context.Context, itemIDs []pgtype.UUID) error
+}
+
+// UserRepositoryInterface defines user repository methods used by wishlist service
+type UserRepositoryInterface interface {
+	GetByID(ctx context.Context, id pgtype.UUID) (*usermodels.User, error)
+}
+
 // Sentinel errors
 var (
 	ErrWishListNotFound        = errors.New("wishlist not found")
@@ -76,25 +119,73 @@ var (
 	ErrActiveReservationsExist = errors.New("cannot delete wishlist with active reservations - please remove or cancel all reservations first")
 	ErrNameRequired            = errors.New("name is required")
 	ErrPriorityOutOfRange      = errors.New("priority value out of int32 range")
+	ErrPriorityLevelInvalid    = errors.New("priority must be must_have, nice_to_have or dream")
 	ErrPositionOutOfRange      = errors.New("position value out of int32 range")
 	ErrGiftItemIDRequired      = errors.New("gift item ID is required")
 	ErrUserIDRequired          = errors.New("user ID is required")
 	ErrSlugTaken               = errors.New("public slug is already taken by another wishlist")
 	ErrSlugInvalid             = errors.New("public slug must contain only lowercase letters, digits, and hyphens")
+	ErrSlugReserved            = errors.New("public slug is reserved")
+	ErrWishListVersionConflict = errors.New("wishlist was modified by another request")
+	ErrInvalidRecurrence       = errors.New("recurrence must be yearly or empty")
+	ErrRecurrenceRequiresDate  = errors.New("a recurring wishlist needs an occasion date")
+	ErrWishListSlugMoved       = errors.New("wishlist has moved to a new public slug")
+	ErrWishListClosed          = errors.New("wishlist is closed")
+	ErrInvalidWishListSort     = errors.New("sort must be occasion_date, created_at or title")
+	ErrInvalidWishListFilter   = errors.New("filter must be upcoming, past or no_date")
+	ErrInvalidGiftItemGroup    = errors.New("group_by must be priority or category")
+	ErrInvalidMergeMode        = errors.New("merge mode must be move or copy")
+	ErrMergeIntoItself         = errors.New("cannot merge a wishlist into itself")
+	ErrWishListMerged          = errors.New("wishlist was merged into another and is archived")
+	ErrWishListRolledOver      = errors.New("wishlist was already rolled over")
+	ErrInvalidOccasionDate     = errors.New("occasion date must be an RFC 3339 timestamp")
 )
 
+// WishListVersionConflictError is returned when an update was based on a stale version.
+// Current holds the wishlist as it is now stored so clients can merge and retry.
+type WishListVersionConflictError struct {
+	Current *WishListOutput
+}
+
+func (e *WishListVersionConflictError) Error() string {
+	return ErrWishListVersionConflict.Error()
+}
+
+func (e *WishListVersionConflictError) Unwrap() error {
+	return ErrWishListVersionConflict
+}
+
+// WishListSlugMovedError is returned when a public slug was retired by its owner.
+// CurrentSlug is where the wishlist is published now.
+type WishListSlugMovedError struct {
+	CurrentSlug string
+}
+
+func (e *WishListSlugMovedError) Error() string {
+	return ErrWishListSlugMoved.Error()
+}
+
+func (e *WishListSlugMovedError) Unwrap() error {
+	return ErrWishListSlugMoved
+}
+
 // WishListServiceInterface defines the interface for wishlist-related operations
 type WishListServiceInterface interface {
 	CreateWishList(ctx context.Context, userID string, input CreateWishListInput) (*WishListOutput, error)
 	GetWishList(ctx context.Context, wishListID string) (*WishListOutput, error)
 	GetWishListByPublicSlug(ctx context.Context, publicSlug string) (*WishListOutput, error)
-	GetWishListsByOwner(ctx context.Context, userID string) ([]*WishListOutput, error)
+	GetWishListByVanitySlug(ctx context.Context, username, slug string) (*WishListOutput, error)
+	GetSharePage(ctx context.Context, publicSlug string) (*SharePageOutput, error)
+	GetWishListsByOwner(ctx context.Context, userID string, filters repository.WishListFilters) ([]*WishListOutput, error)
 	UpdateWishList(ctx context.Context, wishListID, userID string, input UpdateWishListInput) (*WishListOutput, error)
 	DeleteWishList(ctx context.Context, wishListID, userID string) error
+	MergeWishLists(ctx context.Context, targetID, userID string, input MergeWishListsInput) (*MergeWishListsOutput, error)
+	RollOverUnpurchased(ctx context.Context, wishListID, userID string, input RollOverUnpurchasedInput) (*RollOverOutput, error)
 	CreateGiftItem(ctx context.Context, wishListID string, input CreateGiftItemInput) (*GiftItemOutput, error)
 	GetGiftItem(ctx context.Context, giftItemID string) (*GiftItemOutput, error)
 	GetGiftItemsByWishList(ctx context.Context, wishListID string) ([]*GiftItemOutput, error)
 	GetGiftItemsByPublicSlugPaginated(ctx context.Context, publicSlug string, limit, offset int) ([]*GiftItemOutput, int, error)
+	GetGiftItemGroupsByPublicSlug(ctx context.Context, publicSlug, groupBy string) ([]*GiftItemGroupOutput, error)
 	UpdateGiftItem(ctx context.Context, giftItemID string, input UpdateGiftItemInput) (*GiftItemOutput, error)
 	DeleteGiftItem(ctx context.Context, giftItemID string) error
 	MarkGiftItemAsPurchased(ctx context.Context, giftItemID, userID string, purchasedPrice float64) (*GiftItemOutput, error)
@@ -108,6 +199,13 @@ type WishListService struct {
 	emailService            EmailServiceInterface
 	reservationRepo         ReservationRepositoryInterface
 	cache                   CacheInterface
+	giftHistory             GiftHistoryRecorderInterface
+	quota                   QuotaCheckerInterface
+	moderator               ContentModeratorInterface
+	itemImages              GiftItemImageRepositoryInterface
+	notifier                NotifierInterface
+	itemActivity            ItemActivityRecorderInterface
+	userRepo                UserRepositoryInterface
 }
 
 func NewWishListService(
@@ -118,6 +216,13 @@ func NewWishListService(
 	emailService EmailServiceInterface,
 	reservationRepo ReservationRepositoryInterface,
 	cacheService CacheInterface,
+	giftHistoryRecorder GiftHistoryRecorderInterface,
+	quotaChecker QuotaCheckerInterface,
+	moderator ContentModeratorInterface,
+	itemImages GiftItemImageRepositoryInterface,
+	notifier NotifierInterface,
+	itemActivity ItemActivityRecorderInterface,
+	userRepo UserRepositoryInterface,
 ) *WishListService {
 	return &WishListService{
 		wishListRepo:            wishListRepo,
@@ -127,6 +232,13 @@ func NewWishListService(
 		emailService:            emailService,
 		reservationRepo:         reservationRepo,
 		cache:                   cacheService,
+		giftHistory:             giftHistoryRecorder,
+		quota:                   quotaChecker,
+		moderator:               moderator,
+		itemImages:              itemImages,
+		notifier:                notifier,
+		itemActivity:            itemActivity,
+		userRepo:                userRepo,
 	}
 }
 
@@ -136,6 +248,9 @@ type CreateWishListInput struct {
 	Occasion     string
 	OccasionDate string
 	IsPublic     bool
+	PublicSlug   string // Custom slug; empty generates one from the title when public
+	Recurrence   string // models.RecurrenceYearly, or empty for a one-off occasion
+	Discoverable bool   // List the wishlist in public search and trending while it is public
 }
 
 type UpdateWishListInput struct {
@@ -145,6 +260,9 @@ type UpdateWishListInput struct {
 	OccasionDate *string
 	IsPublic     *bool
 	PublicSlug   *string // nil = no change; empty string = clear slug; non-empty = set custom slug
+	Recurrence   *string // nil = no change; empty string = stop recurring
+	Discoverable *bool   // nil = no change
+	Version      *int32  // Expected current version; nil skips the client-side check
 }
 
 type WishListOutput struct {
@@ -156,66 +274,105 @@ type WishListOutput struct {
 	OccasionDate string
 	IsPublic     bool
 	PublicSlug   string
+	VanitySlug   string // Served under the owner's username; premium only
 	ViewCount    int64
 	ItemCount    int64 // Number of gift items in this wishlist
 	CreatedAt    string
 	UpdatedAt    string
+	Version      int32
+	Recurrence   string
+	Discoverable bool
+	RolledOverTo string // ID of the list created for the next occurrence
+	MergedInto   string // ID of the list this one was merged into, set once archived
+}
+
+// Ways a merge can treat the items of the source wishlist
+const (
+	MergeModeMove = "move" // Items leave the source, reservations follow them
+	MergeModeCopy = "copy" // Items stay on the source and are added to the target
+)
+
+type MergeWishListsInput struct {
+	SourceID      string
+	Mode          string // MergeModeMove or MergeModeCopy; empty moves
+	ArchiveSource bool   // Mark the source as merged and unpublish it
+}
+
+type MergeWishListsOutput struct {
+	WishList    *WishListOutput // The target after the merge
+	ItemsMerged int64           // Items newly added to the target; items it already had are skipped
+}
+
+type RollOverUnpurchasedInput struct {
+	Title        string // Empty keeps the source title, with its year moved along with the date
+	OccasionDate string // RFC 3339; empty moves a dated occasion to its next yearly anniversary
+}
+
+type RollOverOutput struct {
+	WishList     *WishListOutput // The new list, private until the owner shares it
+	ItemsCarried int64
 }
 
 type CreateGiftItemInput struct {
-	Name        string
-	Description string
-	Link        string
-	ImageURL    string
-	Price       float64
-	Priority    int
-	Notes       string
-	Position    int
+	Name          string
+	Description   string
+	Link          string
+	ImageURL      string
+	Price         float64
+	Priority      int    // Legacy 0-10 number
+	PriorityLevel string // Priority level; empty derives it from Priority
+	Notes         string
+	Position      int
 }
 
 type UpdateGiftItemInput struct {
-	Name        *string
-	Description *string
-	Link        *string
-	ImageURL    *string
-	Price       *float64
-	Priority    *int
-	Notes       *string
-	Position    *int
+	Name          *string
+	Description   *string
+	Link          *string
+	ImageURL      *string
+	Price         *float64
+	Priority      *int    // Legacy 0-10 number
+	PriorityLevel *string // Priority level, set together with Priority; nil derives it from Priority
+	Notes         *string
+	Position      *int
 }
 
 type GiftItemOutput struct {
-	ID                string
+	mapper.Item
 	WishlistID        string
-	OwnerID           string // Items now belong to users, not wishlists
-	Name              string
-	Description       string
-	Link              string
-	ImageURL          string
-	Price             float64
-	Priority          int
 	ReservedByUserID  string
 	ReservedAt        string
-	IsReserved        bool
 	PurchasedByUserID string
 	PurchasedAt       string
 	PurchasedPrice    float64
-	Notes             string
 	Position          int
-	CreatedAt         string
-	UpdatedAt         string
 }
 
-func isGiftItemReserved(item *itemmodels.GiftItem) bool {
-	if item == nil {
-		return false
-	}
+// Ways the items of a public wishlist can be grouped
+const (
+	GroupByPriority = "priority"
+	GroupByCategory = "category"
+)
 
-	if item.PurchasedByUserID.Valid || item.PurchasedAt.Valid {
-		return false
-	}
+// GiftItemGroupOutput is one section of a public wishlist's items
+type GiftItemGroupOutput struct {
+	Key       string // Priority level or category; empty for uncategorized items
+	Count     int
+	Reserved  int // Items with nothing left to reserve
+	Available int // Items with units still open
+	Items     []*GiftItemOutput
+}
 
-	return item.ReservedByUserID.Valid || item.ReservedAt.Valid || item.ManualReservedByName.Valid
+// parseRecurrence validates a recurrence value; empty means a one-off occasion
+func parseRecurrence(recurrence string) (pgtype.Text, error) {
+	switch recurrence {
+	case "":
+		return pgtype.Text{}, nil
+	case models.RecurrenceYearly:
+		return pgtype.Text{String: recurrence, Valid: true}, nil
+	default:
+		return pgtype.Text{}, ErrInvalidRecurrence
+	}
 }
 
 func (s *WishListService) CreateWishList(ctx context.Context, userID string, input CreateWishListInput) (*WishListOutput, error) {
@@ -230,14 +387,35 @@ func (s *WishListService) CreateWishList(ctx context.Context, userID string, inp
 		return nil, ErrInvalidWishListUserID
 	}
 
-	// Generate public slug if public
-	var publicSlug pgtype.Text
-	if input.IsPublic {
+	if s.quota != nil {
+		if err := s.quota.CheckWishListQuota(ctx, ownerID); err != nil {
+			return nil, err
+		}
+	}
+
+	customSlug := strings.TrimSpace(input.PublicSlug)
+	if s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, "", input.Title, input.Description, input.Occasion, customSlug); err != nil {
+			return nil, err
+		}
+	}
+
+	// Use the owner's slug if given, otherwise generate one if public
+	var publicSlug, vanitySlug pgtype.Text
+	switch {
+	case customSlug != "":
+		slug, vanity, err := s.claimCustomSlug(ctx, ownerID, pgtype.UUID{}, customSlug, pgtype.Text{})
+		if err != nil {
+			return nil, err
+		}
+		publicSlug = pgtype.Text{String: slug, Valid: true}
+		vanitySlug = vanity
+	case input.IsPublic:
 		publicSlug = pgtype.Text{
 			String: generatePublicSlug(input.Title),
 			Valid:  true,
 		}
-	} else {
+	default:
 		publicSlug = pgtype.Text{Valid: false}
 	}
 
@@ -256,6 +434,14 @@ func (s *WishListService) CreateWishList(ctx context.Context, userID string, inp
 		occasionDate = pgtype.Date{Valid: false}
 	}
 
+	recurrence, err := parseRecurrence(input.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	if recurrence.Valid && !occasionDate.Valid {
+		return nil, ErrRecurrenceRequiresDate
+	}
+
 	// Create wishlist
 	wishList := models.WishList{
 		OwnerID:      ownerID,
@@ -265,6 +451,9 @@ func (s *WishListService) CreateWishList(ctx context.Context, userID string, inp
 		OccasionDate: occasionDate,
 		IsPublic:     pgtype.Bool{Bool: input.IsPublic, Valid: true},
 		PublicSlug:   publicSlug,
+		Recurrence:   recurrence,
+		Discoverable: input.Discoverable,
+		VanitySlug:   vanitySlug,
 	}
 
 	createdWishList, err := s.wishListRepo.Create(ctx, wishList)
@@ -272,35 +461,7 @@ func (s *WishListService) CreateWishList(ctx context.Context, userID string, inp
 		return nil, fmt.Errorf("failed to create wishlist in repository: %w", err)
 	}
 
-	output := &WishListOutput{
-		ID:        createdWishList.ID.String(),
-		OwnerID:   createdWishList.OwnerID.String(),
-		Title:     createdWishList.Title,
-		CreatedAt: createdWishList.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt: createdWishList.UpdatedAt.Time.Format(time.RFC3339),
-	}
-
-	// Handle nullable fields
-	if createdWishList.Description.Valid {
-		output.Description = createdWishList.Description.String
-	}
-	if createdWishList.Occasion.Valid {
-		output.Occasion = createdWishList.Occasion.String
-	}
-	if createdWishList.OccasionDate.Valid {
-		output.OccasionDate = createdWishList.OccasionDate.Time.Format(time.RFC3339)
-	}
-	if createdWishList.IsPublic.Valid {
-		output.IsPublic = createdWishList.IsPublic.Bool
-	}
-	if createdWishList.PublicSlug.Valid {
-		output.PublicSlug = createdWishList.PublicSlug.String
-	}
-	if createdWishList.ViewCount.Valid {
-		output.ViewCount = int64(createdWishList.ViewCount.Int32)
-	}
-
-	return output, nil
+	return wishListToOutput(createdWishList), nil
 }
 
 func (s *WishListService) GetWishList(ctx context.Context, wishListID string) (*WishListOutput, error) {
@@ -314,41 +475,15 @@ func (s *WishListService) GetWishList(ctx context.Context, wishListID string) (*
 		return nil, fmt.Errorf("failed to get wishlist from repository: %w", err)
 	}
 
-	output := &WishListOutput{
-		ID:        wishList.ID.String(),
-		OwnerID:   wishList.OwnerID.String(),
-		Title:     wishList.Title,
-		CreatedAt: wishList.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt: wishList.UpdatedAt.Time.Format(time.RFC3339),
-	}
-
-	// Handle nullable fields
-	if wishList.Description.Valid {
-		output.Description = wishList.Description.String
-	}
-	if wishList.Occasion.Valid {
-		output.Occasion = wishList.Occasion.String
-	}
-	if wishList.OccasionDate.Valid {
-		output.OccasionDate = wishList.OccasionDate.Time.Format(time.RFC3339)
-	}
-	if wishList.IsPublic.Valid {
-		output.IsPublic = wishList.IsPublic.Bool
-	}
-	if wishList.PublicSlug.Valid {
-		output.PublicSlug = wishList.PublicSlug.String
-	}
-	if wishList.ViewCount.Valid {
-		output.ViewCount = int64(wishList.ViewCount.Int32)
-	}
-
-	return output, nil
+	return wishListToOutput(wishList), nil
 }
 
 func (s *WishListService) GetWishListByPublicSlug(ctx context.Context, publicSlug string) (*WishListOutput, error) {
-	// Try to get from cache if cache is available
+	// Try to get from cache if cache is available. The entry holds every field;
+	// sparse fieldsets are applied when the response is encoded, so all
+	// selections share it and invalidation stays a single delete.
 	if s.cache != nil {
-		cacheKey := fmt.Sprintf("wishlist:public:%s", publicSlug)
+		cacheKey := fmt.Sprintf(publicWishListCacheKey, publicSlug)
 		var cached WishListOutput
 		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil {
 			return &cached, nil
@@ -357,88 +492,45 @@ func (s *WishListService) GetWishListByPublicSlug(ctx context.Context, publicSlu
 
 	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, publicSlug)
 	if err != nil {
+		if errors.Is(err, repository.ErrWishListNotFound) {
+			return nil, s.resolveRetiredSlug(ctx, publicSlug)
+		}
 		return nil, fmt.Errorf("failed to get wishlist by public slug from repository: %w", err)
 	}
 
-	output := &WishListOutput{
-		ID:        wishList.ID.String(),
-		OwnerID:   wishList.OwnerID.String(),
-		Title:     wishList.Title,
-		CreatedAt: wishList.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt: wishList.UpdatedAt.Time.Format(time.RFC3339),
-	}
-
-	// Handle nullable fields
-	if wishList.Description.Valid {
-		output.Description = wishList.Description.String
-	}
-	if wishList.Occasion.Valid {
-		output.Occasion = wishList.Occasion.String
-	}
-	if wishList.OccasionDate.Valid {
-		output.OccasionDate = wishList.OccasionDate.Time.Format(time.RFC3339)
-	}
-	if wishList.IsPublic.Valid {
-		output.IsPublic = wishList.IsPublic.Bool
-	}
-	if wishList.PublicSlug.Valid {
-		output.PublicSlug = wishList.PublicSlug.String
-	}
-	if wishList.ViewCount.Valid {
-		output.ViewCount = int64(wishList.ViewCount.Int32)
-	}
+	output := wishListToOutput(wishList)
 
 	// Store in cache if cache is available
 	if s.cache != nil {
-		cacheKey := fmt.Sprintf("wishlist:public:%s", publicSlug)
+		cacheKey := fmt.Sprintf(publicWishListCacheKey, publicSlug)
 		_ = s.cache.Set(ctx, cacheKey, output)
 	}
 
 	return output, nil
 }
 
-func (s *WishListService) GetWishListsByOwner(ctx context.Context, userID string) ([]*WishListOutput, error) {
+func (s *WishListService) GetWishListsByOwner(ctx context.Context, userID string, filters repository.WishListFilters) ([]*WishListOutput, error) {
 	id := pgtype.UUID{}
 	if err := id.Scan(userID); err != nil {
 		return nil, ErrInvalidWishListUserID
 	}
 
 	// Use the efficient method that gets wishlists with item counts in a single query
-	wishLists, err := s.wishListRepo.GetByOwnerWithItemCount(ctx, id)
+	wishLists, err := s.wishListRepo.GetByOwnerWithItemCount(ctx, id, filters)
+	if errors.Is(err, repository.ErrInvalidSortField) {
+		return nil, ErrInvalidWishListSort
+	}
+	if errors.Is(err, repository.ErrInvalidFilter) {
+		return nil, ErrInvalidWishListFilter
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get wish lists by owner with item count from repository: %w", err)
 	}
 
 	var outputs []*WishListOutput
 	for _, wishListWithCount := range wishLists {
-		output := &WishListOutput{
-			ID:        wishListWithCount.ID.String(),
-			OwnerID:   wishListWithCount.OwnerID.String(),
-			Title:     wishListWithCount.Title,
-			ItemCount: wishListWithCount.ItemCount,
-			CreatedAt: wishListWithCount.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt: wishListWithCount.UpdatedAt.Time.Format(time.RFC3339),
-		}
-
-		// Handle nullable fields
-		if wishListWithCount.Description.Valid {
-			output.Description = wishListWithCount.Description.String
-		}
-		if wishListWithCount.Occasion.Valid {
-			output.Occasion = wishListWithCount.Occasion.String
-		}
-		if wishListWithCount.OccasionDate.Valid {
-			output.OccasionDate = wishListWithCount.OccasionDate.Time.Format(time.RFC3339)
-		}
-		if wishListWithCount.IsPublic.Valid {
-			output.IsPublic = wishListWithCount.IsPublic.Bool
-		}
-		if wishListWithCount.PublicSlug.Valid {
-			output.PublicSlug = wishListWithCount.PublicSlug.String
-		}
-		if wishListWithCount.ViewCount.Valid {
-			output.ViewCount = int64(wishListWithCount.ViewCount.Int32)
-		}
+		output := wishListToOutput(&wishListWithCount.WishList)
+		output.ItemCount = wishListWithCount.ItemCount
 
 		outputs = append(outputs, output)
 	}
@@ -467,6 +559,16 @@ func (s *WishListService) UpdateWishList(ctx context.Context, wishListID, userID
 		return nil, ErrWishListForbidden
 	}
 
+	if input.Version != nil && *input.Version != wishList.Version {
+		return nil, s.versionConflict(ctx, wishListID)
+	}
+
+	if s.moderator != nil {
+		if err := s.moderateUpdate(ctx, id, input); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update wishlist - only update fields that are provided in the input
 	updatedWishList := *wishList
 
@@ -511,23 +613,31 @@ func (s *WishListService) UpdateWishList(ctx context.Context, wishListID, userID
 		updatedWishList.OccasionDate = wishList.OccasionDate
 	}
 
+	if input.Recurrence != nil {
+		recurrence, err := parseRecurrence(*input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		updatedWishList.Recurrence = recurrence
+	}
+	if updatedWishList.Recurrence.Valid && !updatedWishList.OccasionDate.Valid {
+		return nil, ErrRecurrenceRequiresDate
+	}
+
+	if input.Discoverable != nil {
+		updatedWishList.Discoverable = *input.Discoverable
+	}
+
 	// Handle custom public slug provided by the user
 	if input.PublicSlug != nil {
 		customSlug := strings.TrimSpace(*input.PublicSlug)
 		if customSlug != "" {
-			// Validate format: lowercase letters, digits, hyphens only
-			if !slugPattern.MatchString(customSlug) {
-				return nil, ErrSlugInvalid
-			}
-			// Check uniqueness (exclude current wishlist)
-			taken, err := s.wishListRepo.IsSlugTaken(ctx, customSlug, id)
+			slug, vanity, err := s.claimCustomSlug(ctx, ownerID, id, customSlug, wishList.PublicSlug)
 			if err != nil {
-				return nil, fmt.Errorf("failed to check slug uniqueness: %w", err)
+				return nil, err
 			}
-			if taken {
-				return nil, ErrSlugTaken
-			}
-			updatedWishList.PublicSlug = pgtype.Text{String: customSlug, Valid: true}
+			updatedWishList.PublicSlug = pgtype.Text{String: slug, Valid: true}
+			updatedWishList.VanitySlug = vanity
 		}
 		// empty string → keep existing slug (do not clear it)
 	}
@@ -547,44 +657,52 @@ func (s *WishListService) UpdateWishList(ctx context.Context, wishListID, userID
 
 	updated, err := s.wishListRepo.Update(ctx, updatedWishList)
 	if err != nil {
+		if errors.Is(err, repository.ErrWishListVersionConflict) {
+			return nil, s.versionConflict(ctx, wishListID)
+		}
 		return nil, fmt.Errorf("failed to update wishlist in repository: %w", err)
 	}
 
-	// Invalidate cache if cache is available
-	if s.cache != nil && updated.PublicSlug.Valid {
-		cacheKey := fmt.Sprintf("wishlist:public:%s", updated.PublicSlug.String)
-		_ = s.cache.Delete(ctx, cacheKey)
+	// Invalidate cache if cache is available; a retired slug must stop serving
+	// the cached wishlist so it can redirect instead
+	if s.cache != nil {
+		for _, slug := range []pgtype.Text{updated.PublicSlug, wishList.PublicSlug} {
+			if slug.Valid && slug.String != "" {
+				s.invalidatePublicSlug(ctx, slug.String)
+			}
+		}
 	}
 
-	output := &WishListOutput{
-		ID:        updated.ID.String(),
-		OwnerID:   updated.OwnerID.String(),
-		Title:     updated.Title,
-		CreatedAt: updated.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt: updated.UpdatedAt.Time.Format(time.RFC3339),
-	}
+	return wishListToOutput(updated), nil
+}
 
-	// Handle nullable fields
-	if updated.Description.Valid {
-		output.Description = updated.Description.String
-	}
-	if updated.Occasion.Valid {
-		output.Occasion = updated.Occasion.String
-	}
-	if updated.OccasionDate.Valid {
-		output.OccasionDate = updated.OccasionDate.Time.Format(time.RFC3339)
-	}
-	if updated.IsPublic.Valid {
-		output.IsPublic = updated.IsPublic.Bool
+// versionConflict reloads the wishlist that changed since the update read it
+// moderateUpdate screens the changed text fields and refuses to publish a wishlist
+// that is taken down
+func (s *WishListService) moderateUpdate(ctx context.Context, id pgtype.UUID, input UpdateWishListInput) error {
+	var texts []string
+	for _, field := range []*string{input.Title, input.Description, input.Occasion, input.PublicSlug} {
+		if field != nil {
+			texts = append(texts, *field)
+		}
 	}
-	if updated.PublicSlug.Valid {
-		output.PublicSlug = updated.PublicSlug.String
+	if err := s.moderator.CheckContent(ctx, "", texts...); err != nil {
+		return err
 	}
-	if updated.ViewCount.Valid {
-		output.ViewCount = int64(updated.ViewCount.Int32)
+
+	if input.IsPublic != nil && *input.IsPublic {
+		return s.moderator.CheckPublishable(ctx, id)
 	}
 
-	return output, nil
+	return nil
+}
+
+func (s *WishListService) versionConflict(ctx context.Context, wishListID string) error {
+	current, err := s.GetWishList(ctx, wishListID)
+	if err != nil {
+		return fmt.Errorf("failed to reload wishlist after version conflict: %w", err)
+	}
+	return &WishListVersionConflictError{Current: current}
 }
 
 func (s *WishListService) DeleteWishList(ctx context.Context, wishListID, userID string) error {
@@ -609,34 +727,186 @@ func (s *WishListService) DeleteWishList(ctx context.Context, wishListID, userID
 	}
 
 	// Check if there are any active reservations for gift items in this wishlist
-	giftItems, err := s.giftItemRepo.GetByWishList(ctx, id)
+	hasActiveReservations, err := s.reservationRepo.HasActiveReservationsForWishlist(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to check for active reservations: %w", err)
 	}
 
-	hasActiveReservations := false
-	for _, item := range giftItems {
-		// Check if this gift item has any active reservations
-		reservation, err := s.reservationRepo.GetActiveReservationForGiftItem(ctx, item.ID)
-		if err == nil && reservation != nil {
-			hasActiveReservations = true
-			break
-		}
-	}
-
 	if hasActiveReservations {
 		return ErrActiveReservationsExist
 	}
 
+	// Keep what was given from this wishlist so the owner's gift history survives the delete
+	if s.giftHistory != nil {
+		if _, err := s.giftHistory.SnapshotWishlist(ctx, id); err != nil {
+			return fmt.Errorf("failed to retain gift history: %w", err)
+		}
+	}
+
 	// Invalidate cache if cache is available
 	if s.cache != nil && wishList.PublicSlug.Valid {
-		cacheKey := fmt.Sprintf("wishlist:public:%s", wishList.PublicSlug.String)
-		_ = s.cache.Delete(ctx, cacheKey)
+		s.invalidatePublicSlug(ctx, wishList.PublicSlug.String)
 	}
 
 	return s.wishListRepo.Delete(ctx, id)
 }
 
+// MergeWishLists brings the items of the source wishlist into the target. Both lists
+// must belong to the user and neither may be archived by an earlier merge.
+func (s *WishListService) MergeWishLists(ctx context.Context, targetID, userID string, input MergeWishListsInput) (*MergeWishListsOutput, error) {
+	opts := repository.MergeOptions{Archive: input.ArchiveSource}
+	switch input.Mode {
+	case "", MergeModeMove:
+		opts.Move = true
+	case MergeModeCopy:
+	default:
+		return nil, ErrInvalidMergeMode
+	}
+
+	target, source := pgtype.UUID{}, pgtype.UUID{}
+	if err := target.Scan(targetID); err != nil {
+		return nil, ErrInvalidWishListID
+	}
+	if err := source.Scan(input.SourceID); err != nil {
+		return nil, ErrInvalidWishListID
+	}
+	if target == source {
+		return nil, ErrMergeIntoItself
+	}
+
+	ownerID := pgtype.UUID{}
+	if err := ownerID.Scan(userID); err != nil {
+		return nil, ErrInvalidWishListUserID
+	}
+
+	if _, err := s.mergeableWishList(ctx, target, ownerID); err != nil {
+		return nil, err
+	}
+	sourceList, err := s.mergeableWishList(ctx, source, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := s.wishListRepo.Merge(ctx, source, target, opts)
+	if errors.Is(err, repository.ErrWishListMerged) {
+		return nil, ErrWishListMerged
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge wishlists: %w", err)
+	}
+
+	// An archived source is no longer public
+	if s.cache != nil && input.ArchiveSource && sourceList.PublicSlug.Valid {
+		s.invalidatePublicSlug(ctx, sourceList.PublicSlug.String)
+	}
+
+	targetList, err := s.wishListRepo.GetByID(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merged wishlist from repository: %w", err)
+	}
+
+	return &MergeWishListsOutput{WishList: wishListToOutput(targetList), ItemsMerged: merged}, nil
+}
+
+// RollOverUnpurchased starts a new list from the items of a past occasion that nobody
+// reserved or bought, and marks the source as rolled over so the scheduled rollover
+// leaves it alone. Like a scheduled rollover, the new list starts private.
+func (s *WishListService) RollOverUnpurchased(ctx context.Context, wishListID, userID string, input RollOverUnpurchasedInput) (*RollOverOutput, error) {
+	id := pgtype.UUID{}
+	if err := id.Scan(wishListID); err != nil {
+		return nil, ErrInvalidWishListID
+	}
+
+	ownerID := pgtype.UUID{}
+	if err := ownerID.Scan(userID); err != nil {
+		return nil, ErrInvalidWishListUserID
+	}
+
+	source, err := s.wishListRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrWishListNotFound) {
+		return nil, ErrWishListNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist from repository: %w", err)
+	}
+	if source.OwnerID != ownerID {
+		return nil, ErrWishListForbidden
+	}
+	if source.MergedInto.Valid {
+		return nil, ErrWishListMerged
+	}
+	if source.RolledOverTo.Valid {
+		return nil, ErrWishListRolledOver
+	}
+
+	next := models.WishList{
+		OwnerID:      ownerID,
+		Title:        strings.TrimSpace(input.Title),
+		Description:  source.Description,
+		Occasion:     source.Occasion,
+		OccasionDate: source.OccasionDate,
+		IsPublic:     pgtype.Bool{Bool: false, Valid: true},
+		Recurrence:   source.Recurrence,
+		Discoverable: source.Discoverable,
+	}
+	switch {
+	case input.OccasionDate != "":
+		date, err := time.Parse(time.RFC3339, input.OccasionDate)
+		if err != nil {
+			return nil, ErrInvalidOccasionDate
+		}
+		next.OccasionDate = pgtype.Date{Time: date, Valid: true}
+	case source.OccasionDate.Valid:
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		next.OccasionDate = pgtype.Date{Time: models.NextOccurrence(source.OccasionDate.Time, today), Valid: true}
+	}
+	if next.Title == "" {
+		next.Title = source.Title
+		if source.OccasionDate.Valid && next.OccasionDate.Valid {
+			next.Title = models.RolloverTitle(source.Title, source.OccasionDate.Time.Year(), next.OccasionDate.Time.Year())
+		}
+	} else if s.moderator != nil {
+		if err := s.moderator.CheckContent(ctx, "", next.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.quota != nil {
+		if err := s.quota.CheckWishListQuota(ctx, ownerID); err != nil {
+			return nil, err
+		}
+	}
+
+	created, carried, err := s.wishListRepo.RollOverUnpurchased(ctx, id, next)
+	if errors.Is(err, repository.ErrWishListRolledOver) {
+		return nil, ErrWishListRolledOver
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll over wishlist: %w", err)
+	}
+
+	return &RollOverOutput{WishList: wishListToOutput(created), ItemsCarried: carried}, nil
+}
+
+// mergeableWishList loads a wishlist taking part in a merge: it must belong to the
+// owner and must not have been archived by an earlier merge
+func (s *WishListService) mergeableWishList(ctx context.Context, id, ownerID pgtype.UUID) (*models.WishList, error) {
+	wishList, err := s.wishListRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrWishListNotFound) {
+		return nil, ErrWishListNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist from repository: %w", err)
+	}
+	if wishList.OwnerID != ownerID {
+		return nil, ErrWishListForbidden
+	}
+	if wishList.MergedInto.Valid {
+		return nil, ErrWishListMerged
+	}
+	return wishList, nil
+}
+
 func (s *WishListService) CreateGiftItem(ctx context.Context, wishListID string, input CreateGiftItemInput) (*GiftItemOutput, error) {
 	// Validate input
 	if input.Name == "" {
@@ -650,6 +920,11 @@ func (s *WishListService) CreateGiftItem(ctx context.Context, wishListID string,
 	if input.Position < math.MinInt32 || input.Position > math.MaxInt32 {
 		return nil, ErrPositionOutOfRange
 	}
+	if input.PriorityLevel != "" {
+		if _, err := priority.Parse(input.PriorityLevel); err != nil {
+			return nil, ErrPriorityLevelInvalid
+		}
+	}
 
 	// Parse wishlist ID
 	listID := pgtype.UUID{}
@@ -666,6 +941,12 @@ func (s *WishListService) CreateGiftItem(ctx context.Context, wishListID string,
 		return nil, fmt.Errorf("failed to get wishlist: %w", err)
 	}
 
+	if s.quota != nil {
+		if err := s.quota.CheckItemQuota(ctx, wishList.OwnerID, listID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create price numeric
 	priceBig := new(big.Int)
 	priceBig.SetInt64(int64(input.Price * 100)) // Convert to cents
@@ -678,56 +959,21 @@ func (s *WishListService) CreateGiftItem(ctx context.Context, wishListID string,
 		Link:        pgtype.Text{String: input.Link, Valid: input.Link != ""},
 		ImageUrl:    pgtype.Text{String: input.ImageURL, Valid: input.ImageURL != ""},
 		Price:       pgtype.Numeric{Int: priceBig, Exp: -2, Valid: input.Price > 0},
-		Priority:    pgtype.Int4{Int32: int32(input.Priority), Valid: true},
 		Notes:       pgtype.Text{String: input.Notes, Valid: input.Notes != ""},
 		Position:    pgtype.Int4{Int32: int32(input.Position), Valid: true},
 	}
+	giftItem.SetPriority(input.PriorityLevel, int32(input.Priority))
 
 	createdGiftItem, err := s.giftItemRepo.CreateWithOwner(ctx, giftItem)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gift item in repository: %w", err)
 	}
 
-	// Convert price to float64
-	var price float64
-	if createdGiftItem.Price.Valid {
-		priceValue, err := createdGiftItem.Price.Float64Value()
-		if err == nil && priceValue.Valid {
-			price = priceValue.Float64
-		}
-	}
-
-	output := &GiftItemOutput{
-		ID:         createdGiftItem.ID.String(),
-		WishlistID: wishListID,
-		OwnerID:    createdGiftItem.OwnerID.String(),
-		Name:       createdGiftItem.Name,
-		Price:      price,
-		IsReserved: isGiftItemReserved(createdGiftItem),
-		CreatedAt:  createdGiftItem.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:  createdGiftItem.UpdatedAt.Time.Format(time.RFC3339),
-	}
+	output := giftItemToOutput(wishListID, createdGiftItem)
 
-	// Handle nullable fields
-	if createdGiftItem.Description.Valid {
-		output.Description = createdGiftItem.Description.String
-	}
-	if createdGiftItem.Link.Valid {
-		output.Link = createdGiftItem.Link.String
-	}
-	if createdGiftItem.ImageUrl.Valid {
-		output.ImageURL = createdGiftItem.ImageUrl.String
-	}
-	if createdGiftItem.Priority.Valid {
-		output.Priority = int(createdGiftItem.Priority.Int32)
-	}
-	if createdGiftItem.Notes.Valid {
-		output.Notes = createdGiftItem.Notes.String
-	}
-	if createdGiftItem.Position.Valid {
-		output.Position = int(createdGiftItem.Position.Int32)
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
 	}
-
 	return output, nil
 }
 
@@ -742,46 +988,11 @@ func (s *WishListService) GetGiftItem(ctx context.Context, giftItemID string) (*
 		return nil, fmt.Errorf("failed to get gift item from repository: %w", err)
 	}
 
-	// Convert price to float64
-	var price float64
-	if giftItem.Price.Valid {
-		priceValue, err := giftItem.Price.Float64Value()
-		if err == nil && priceValue.Valid {
-			price = priceValue.Float64
-		}
-	}
-
-	output := &GiftItemOutput{
-		ID:         giftItem.ID.String(),
-		WishlistID: "",
-		OwnerID:    giftItem.OwnerID.String(),
-		Name:       giftItem.Name,
-		Price:      price,
-		IsReserved: isGiftItemReserved(giftItem),
-		CreatedAt:  giftItem.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:  giftItem.UpdatedAt.Time.Format(time.RFC3339),
-	}
+	output := giftItemToOutput("", giftItem)
 
-	// Handle nullable fields
-	if giftItem.Description.Valid {
-		output.Description = giftItem.Description.String
-	}
-	if giftItem.Link.Valid {
-		output.Link = giftItem.Link.String
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
 	}
-	if giftItem.ImageUrl.Valid {
-		output.ImageURL = giftItem.ImageUrl.String
-	}
-	if giftItem.Priority.Valid {
-		output.Priority = int(giftItem.Priority.Int32)
-	}
-	if giftItem.Notes.Valid {
-		output.Notes = giftItem.Notes.String
-	}
-	if giftItem.Position.Valid {
-		output.Position = int(giftItem.Position.Int32)
-	}
-
 	return output, nil
 }
 
@@ -803,67 +1014,15 @@ func (s *WishListService) GetGiftItemsByWishList(ctx context.Context, wishListID
 			continue // Skip nil items to avoid panic
 		}
 
-		// Convert price to float64
-		var price float64
-		if giftItem.Price.Valid {
-			priceValue, err := giftItem.Price.Float64Value()
-			if err == nil && priceValue.Valid {
-				price = priceValue.Float64
-			}
-		}
-
-		output := &GiftItemOutput{
-			ID:         giftItem.ID.String(),
-			WishlistID: wishListID,
-			OwnerID:    giftItem.OwnerID.String(),
-			Name:       giftItem.Name,
-			Price:      price,
-			IsReserved: isGiftItemReserved(giftItem),
-			CreatedAt:  giftItem.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:  giftItem.UpdatedAt.Time.Format(time.RFC3339),
-		}
-
-		// Handle nullable fields
-		if giftItem.Description.Valid {
-			output.Description = giftItem.Description.String
-		}
-		if giftItem.Link.Valid {
-			output.Link = giftItem.Link.String
-		}
-		if giftItem.ImageUrl.Valid {
-			output.ImageURL = giftItem.ImageUrl.String
-		}
-		if giftItem.Priority.Valid {
-			output.Priority = int(giftItem.Priority.Int32)
-		}
-		if giftItem.Notes.Valid {
-			output.Notes = giftItem.Notes.String
-		}
-		if giftItem.Position.Valid {
-			output.Position = int(giftItem.Position.Int32)
-		}
-		if giftItem.ReservedByUserID.Valid {
-			output.ReservedByUserID = giftItem.ReservedByUserID.String()
-		}
-		if giftItem.ReservedAt.Valid {
-			output.ReservedAt = giftItem.ReservedAt.Time.Format(time.RFC3339)
-		}
-		if giftItem.PurchasedByUserID.Valid {
-			output.PurchasedByUserID = giftItem.PurchasedByUserID.String()
-		}
-		if giftItem.PurchasedAt.Valid {
-			output.PurchasedAt = giftItem.PurchasedAt.Time.Format(time.RFC3339)
-		}
-		if giftItem.PurchasedPrice.Valid {
-			purchasedPriceValue, err := giftItem.PurchasedPrice.Float64Value()
-			if err == nil && purchasedPriceValue.Valid {
-				output.PurchasedPrice = purchasedPriceValue.Float64
-			}
-		}
+		output := giftItemToOutput(wishListID, giftItem)
 
 		outputs = append(outputs, output)
 	}
 
+	if err := s.attachImages(ctx, outputs...); err != nil {
+		return nil, err
+	}
+
 	return outputs, nil
 }
 
@@ -871,7 +1030,7 @@ func (s *WishListService) GetGiftItemsByPublicSlugPaginated(ctx context.Context,
 	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, publicSlug)
 	if err != nil {
 		if errors.Is(err, repository.ErrWishListNotFound) {
-			return nil, 0, ErrWishListNotFound
+			return nil, 0, s.resolveRetiredSlug(ctx, publicSlug)
 		}
 		return nil, 0, fmt.Errorf("failed to get wishlist by public slug: %w", err)
 	}
@@ -888,59 +1047,84 @@ func (s *WishListService) GetGiftItemsByPublicSlugPaginated(ctx context.Context,
 			continue // Skip nil items to avoid panic
 		}
 
-		// Convert price to float64
-		var price float64
-		if giftItem.Price.Valid {
-			priceValue, err := giftItem.Price.Float64Value()
-			if err == nil && priceValue.Valid {
-				price = priceValue.Float64
-			}
-		}
+		output := publicGiftItemOutput(wishList.ID.String(), giftItem)
+		outputs = append(outputs, output)
+	}
 
-		output := &GiftItemOutput{
-			ID:         giftItem.ID.String(),
-			WishlistID: wishList.ID.String(),
-			OwnerID:    giftItem.OwnerID.String(),
-			Name:       giftItem.Name,
-			Price:      price,
-			IsReserved: isGiftItemReserved(giftItem),
-			CreatedAt:  giftItem.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:  giftItem.UpdatedAt.Time.Format(time.RFC3339),
-		}
+	if err := s.attachImages(ctx, outputs...); err != nil {
+		return nil, 0, err
+	}
+	s.recordItemViews(ctx, giftItems)
 
-		// Handle nullable fields
-		if giftItem.Description.Valid {
-			output.Description = giftItem.Description.String
-		}
-		if giftItem.Link.Valid {
-			output.Link = giftItem.Link.String
-		}
-		if giftItem.ImageUrl.Valid {
-			output.ImageURL = giftItem.ImageUrl.String
-		}
-		if giftItem.Priority.Valid {
-			output.Priority = int(giftItem.Priority.Int32)
+	return outputs, totalCount, nil
+}
+
+// GetGiftItemGroupsByPublicSlug returns the items of a public wishlist grouped
+// by priority level or category. Tallies are computed by the database over all
+// items; each group lists its items in position order.
+func (s *WishListService) GetGiftItemGroupsByPublicSlug(ctx context.Context, publicSlug, groupBy string) ([]*GiftItemGroupOutput, error) {
+	if groupBy != GroupByPriority && groupBy != GroupByCategory {
+		return nil, ErrInvalidGiftItemGroup
+	}
+
+	wishList, err := s.wishListRepo.GetByPublicSlug(ctx, publicSlug)
+	if err != nil {
+		if errors.Is(err, repository.ErrWishListNotFound) {
+			return nil, s.resolveRetiredSlug(ctx, publicSlug)
 		}
-		if giftItem.Position.Valid {
-			output.Position = int(giftItem.Position.Int32)
+		return nil, fmt.Errorf("failed to get wishlist by public slug: %w", err)
+	}
+
+	groups, err := s.giftItemRepo.GetPublicWishListGiftItemGroups(ctx, publicSlug, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group gift items in repository: %w", err)
+	}
+
+	giftItems, err := s.giftItemRepo.GetPublicWishListGiftItems(ctx, publicSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift items from repository: %w", err)
+	}
+
+	outputs := make([]*GiftItemGroupOutput, 0, len(groups))
+	byKey := make(map[string]*GiftItemGroupOutput, len(groups))
+	for _, group := range groups {
+		output := &GiftItemGroupOutput{
+			Key:       group.Key,
+			Count:     group.Count,
+			Reserved:  group.Reserved,
+			Available: group.Available,
+			Items:     []*GiftItemOutput{},
 		}
-		if giftItem.ReservedAt.Valid {
-			output.ReservedAt = giftItem.ReservedAt.Time.Format(time.RFC3339)
+		outputs = append(outputs, output)
+		byKey[group.Key] = output
+	}
+
+	var items []*GiftItemOutput
+	for _, giftItem := range giftItems {
+		if giftItem == nil {
+			continue
 		}
-		if giftItem.PurchasedAt.Valid {
-			output.PurchasedAt = giftItem.PurchasedAt.Time.Format(time.RFC3339)
+
+		key := giftItem.Level()
+		if groupBy == GroupByCategory {
+			key = giftItem.Category.String
 		}
-		if giftItem.PurchasedPrice.Valid {
-			purchasedPriceValue, err := giftItem.PurchasedPrice.Float64Value()
-			if err == nil && purchasedPriceValue.Valid {
-				output.PurchasedPrice = purchasedPriceValue.Float64
-			}
+		group, ok := byKey[key]
+		if !ok {
+			continue // Item created after the tallies were read
 		}
 
-		outputs = append(outputs, output)
+		item := publicGiftItemOutput(wishList.ID.String(), giftItem)
+		group.Items = append(group.Items, item)
+		items = append(items, item)
 	}
 
-	return outputs, totalCount, nil
+	if err := s.attachImages(ctx, items...); err != nil {
+		return nil, err
+	}
+	s.recordItemViews(ctx, giftItems)
+
+	return outputs, nil
 }
 
 func (s *WishListService) UpdateGiftItem(ctx context.Context, giftItemID string, input UpdateGiftItemInput) (*GiftItemOutput, error) {
@@ -955,6 +1139,11 @@ func (s *WishListService) UpdateGiftItem(ctx context.Context, giftItemID string,
 			return nil, ErrPositionOutOfRange
 		}
 	}
+	if input.PriorityLevel != nil {
+		if _, err := priority.Parse(*input.PriorityLevel); err != nil {
+			return nil, ErrPriorityLevelInvalid
+		}
+	}
 
 	id := pgtype.UUID{}
 	if err := id.Scan(giftItemID); err != nil {
@@ -986,7 +1175,11 @@ func (s *WishListService) UpdateGiftItem(ctx context.Context, giftItemID string,
 		updatedGiftItem.Price = pgtype.Numeric{Int: priceBig, Exp: -2, Valid: *input.Price > 0}
 	}
 	if input.Priority != nil {
-		updatedGiftItem.Priority = pgtype.Int4{Int32: int32(*input.Priority), Valid: true} //nolint:gosec // Bounds checking performed above, conversion is safe
+		level := ""
+		if input.PriorityLevel != nil {
+			level = *input.PriorityLevel
+		}
+		updatedGiftItem.SetPriority(level, int32(*input.Priority)) //nolint:gosec // Bounds checking performed above, conversion is safe
 	}
 	if input.Notes != nil {
 		updatedGiftItem.Notes = pgtype.Text{String: *input.Notes, Valid: *input.Notes != ""}
@@ -1003,46 +1196,11 @@ func (s *WishListService) UpdateGiftItem(ctx context.Context, giftItemID string,
 	// Invalidate wishlist cache if cache is available
 	s.invalidatePublicWishlistsCacheByOwner(ctx, updated.OwnerID)
 
-	// Convert price to float64
-	var price float64
-	if updated.Price.Valid {
-		priceValue, err := updated.Price.Float64Value()
-		if err == nil && priceValue.Valid {
-			price = priceValue.Float64
-		}
-	}
-
-	output := &GiftItemOutput{
-		ID:         updated.ID.String(),
-		WishlistID: "",
-		OwnerID:    updated.OwnerID.String(),
-		Name:       updated.Name,
-		Price:      price,
-		IsReserved: isGiftItemReserved(updated),
-		CreatedAt:  updated.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:  updated.UpdatedAt.Time.Format(time.RFC3339),
-	}
+	output := giftItemToOutput("", updated)
 
-	// Handle nullable fields
-	if updated.Description.Valid {
-		output.Description = updated.Description.String
-	}
-	if updated.Link.Valid {
-		output.Link = updated.Link.String
-	}
-	if updated.ImageUrl.Valid {
-		output.ImageURL = updated.ImageUrl.String
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
 	}
-	if updated.Priority.Valid {
-		output.Priority = int(updated.Priority.Int32)
-	}
-	if updated.Notes.Valid {
-		output.Notes = updated.Notes.String
-	}
-	if updated.Position.Valid {
-		output.Position = int(updated.Position.Int32)
-	}
-
 	return output, nil
 }
 
@@ -1070,18 +1228,14 @@ func (s *WishListService) DeleteGiftItem(ctx context.Context, giftItemID string)
 	if len(activeReservations) > 0 {
 		wishlistTitles := make(map[string]string, len(activeReservations))
 
-		// Send notification emails to all reservation holders
+		// Notify all reservation holders: registered users in-app, guests by email
 		for _, reservation := range activeReservations {
 			var recipientEmail string
 			if reservation.GuestEmail.Valid {
 				recipientEmail = reservation.GuestEmail.String
-			} else if reservation.ReservedByUserID.Valid {
-				// For authenticated users, we would need to fetch their email
-				// For now, we'll skip sending to authenticated users in this implementation
-				continue
 			}
 
-			if recipientEmail == "" {
+			if recipientEmail == "" && !reservation.ReservedByUserID.Valid {
 				continue
 			}
 
@@ -1093,7 +1247,7 @@ func (s *WishListService) DeleteGiftItem(ctx context.Context, giftItemID string)
 				} else {
 					wishList, err := s.wishListRepo.GetByID(ctx, reservation.WishlistID)
 					if err != nil {
-						logger.Warn(
+						logger.WarnContext(ctx,
 							"failed to get wishlist details for reservation removal notification",
 							"error",
 							err,
@@ -1107,10 +1261,24 @@ func (s *WishListService) DeleteGiftItem(ctx context.Context, giftItemID string)
 				}
 			}
 
+			if reservation.ReservedByUserID.Valid {
+				s.notifyReservationHolder(ctx, notificationmodels.Notification{
+					UserID:     reservation.ReservedByUserID,
+					Type:       notificationmodels.TypeReservationRemoved,
+					Title:      fmt.Sprintf("%q was removed", giftItemForCache.Name),
+					Body:       fmt.Sprintf("The owner removed %q from %q, so your reservation was canceled.", giftItemForCache.Name, wishlistTitle),
+					WishlistID: reservation.WishlistID,
+				})
+			}
+
+			if recipientEmail == "" {
+				continue
+			}
+
 			err := s.emailService.SendReservationRemovedEmail(ctx, recipientEmail, giftItemForCache.Name, wishlistTitle)
 			if err != nil {
 				// Log the error but don't fail the deletion
-				logger.Warn(
+				logger.WarnContext(ctx,
 					"failed to send reservation removal notification",
 					"error",
 					err,
@@ -1126,6 +1294,21 @@ func (s *WishListService) DeleteGiftItem(ctx context.Context, giftItemID string)
 	return nil
 }
 
+// notifyReservationHolder adds an in-app notification for a registered reservation
+// holder. Failures are logged, not returned.
+func (s *WishListService) notifyReservationHolder(ctx context.Context, notification notificationmodels.Notification) {
+	if s.notifier == nil {
+		return
+	}
+
+	if err := s.notifier.Notify(ctx, notification); err != nil {
+		logger.WarnContext(ctx, "failed to create reservation notification",
+			"type", notification.Type,
+			"user_id", notification.UserID.String(),
+			"error", err)
+	}
+}
+
 // MarkGiftItemAsPurchased marks a gift item as purchased
 func (s *WishListService) MarkGiftItemAsPurchased(ctx context.Context, giftItemID, userID string, purchasedPrice float64) (*GiftItemOutput, error) {
 	// Validate input
@@ -1153,31 +1336,42 @@ func (s *WishListService) MarkGiftItemAsPurchased(ctx context.Context, giftItemI
 		return nil, fmt.Errorf("invalid price value: %w", err)
 	}
 
-	// Mark as purchased in repository
-	updatedGiftItem, err := s.giftItemPurchaseRepo.MarkAsPurchased(ctx, itemID, userUUID, priceValue)
+	updatedGiftItem, err := s.markGiftItemPurchased(ctx, itemID, userUUID, priceValue)
 	if err != nil {
-		return nil, fmt.Errorf("failed to mark gift item as purchased in repository: %w", err)
+		return nil, err
 	}
 
-	// Send email notification to the person who reserved the gift
-	if s.emailService != nil {
+	// Notify the person who reserved the gift
+	if s.emailService != nil || s.notifier != nil {
 		// Check if there's an active reservation for this gift item
 		reservation, err := s.reservationRepo.GetActiveReservationForGiftItem(ctx, updatedGiftItem.ID)
-		if err == nil && reservation != nil {
-			var recipientEmail, guestName string
+		if err == nil && reservation != nil && reservation.ReservedByUserID.Valid {
+			s.notifyReservationHolder(ctx, notificationmodels.Notification{
+				UserID:     reservation.ReservedByUserID,
+				Type:       notificationmodels.TypeItemPurchased,
+				Title:      fmt.Sprintf("%q was purchased", updatedGiftItem.Name),
+				Body:       fmt.Sprintf("%q you reserved has been marked as purchased.", updatedGiftItem.Name),
+				WishlistID: reservation.WishlistID,
+			})
+		}
+		if err == nil && reservation != nil && s.emailService != nil {
+			var recipientEmail, guestName, message string
 			if reservation.GuestEmail.Valid {
 				recipientEmail = reservation.GuestEmail.String
 			}
 			if reservation.GuestName.Valid {
 				guestName = reservation.GuestName.String
 			}
+			if reservation.Message.Valid {
+				message = reservation.Message.String
+			}
 
 			if recipientEmail != "" {
 				wishlistTitle := ""
 				if reservation.WishlistID.Valid {
 					wishList, err := s.wishListRepo.GetByID(ctx, reservation.WishlistID)
 					if err != nil {
-						logger.Warn(
+						logger.WarnContext(ctx,
 							"failed to get wishlist details for purchase confirmation notification",
 							"error",
 							err,
@@ -1195,10 +1389,11 @@ func (s *WishListService) MarkGiftItemAsPurchased(ctx context.Context, giftItemI
 					updatedGiftItem.Name,
 					wishlistTitle,
 					guestName,
+					message,
 				)
 				if err != nil {
 					// Log the error but don't fail the purchase marking
-					logger.Warn(
+					logger.WarnContext(ctx,
 						"failed to send gift purchased notification",
 						"error",
 						err,
@@ -1212,54 +1407,33 @@ func (s *WishListService) MarkGiftItemAsPurchased(ctx context.Context, giftItemI
 		}
 	}
 
-	s.invalidatePublicWishlistsCacheByOwner(ctx, updatedGiftItem.OwnerID)
+	output := giftItemToOutput("", updatedGiftItem)
 
-	// Convert to output format
-	output := &GiftItemOutput{
-		ID:             updatedGiftItem.ID.String(),
-		WishlistID:     "",
-		OwnerID:        updatedGiftItem.OwnerID.String(),
-		Name:           updatedGiftItem.Name,
-		Price:          database.NumericToFloat64(updatedGiftItem.Price),
-		IsReserved:     isGiftItemReserved(updatedGiftItem),
-		PurchasedPrice: database.NumericToFloat64(updatedGiftItem.PurchasedPrice),
-		CreatedAt:      updatedGiftItem.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:      updatedGiftItem.UpdatedAt.Time.Format(time.RFC3339),
+	if err := s.attachImages(ctx, output); err != nil {
+		return nil, err
 	}
+	return output, nil
+}
 
-	// Handle nullable fields
-	if updatedGiftItem.Description.Valid {
-		output.Description = updatedGiftItem.Description.String
-	}
-	if updatedGiftItem.Link.Valid {
-		output.Link = updatedGiftItem.Link.String
-	}
-	if updatedGiftItem.ImageUrl.Valid {
-		output.ImageURL = updatedGiftItem.ImageUrl.String
-	}
-	if updatedGiftItem.Priority.Valid {
-		output.Priority = int(updatedGiftItem.Priority.Int32)
-	}
-	if updatedGiftItem.Notes.Valid {
-		output.Notes = updatedGiftItem.Notes.String
-	}
-	if updatedGiftItem.Position.Valid {
-		output.Position = int(updatedGiftItem.Position.Int32)
-	}
-	if updatedGiftItem.ReservedByUserID.Valid {
-		output.ReservedByUserID = updatedGiftItem.ReservedByUserID.String()
-	}
-	if updatedGiftItem.ReservedAt.Valid {
-		output.ReservedAt = updatedGiftItem.ReservedAt.Time.Format(time.RFC3339)
-	}
-	if updatedGiftItem.PurchasedByUserID.Valid {
-		output.PurchasedByUserID = updatedGiftItem.PurchasedByUserID.String()
-	}
-	if updatedGiftItem.PurchasedAt.Valid {
-		output.PurchasedAt = updatedGiftItem.PurchasedAt.Time.Format(time.RFC3339)
+// MarkGiftItemAsPurchasedByHolder marks a gift item as purchased on behalf of the
+// holder of its reservation, e.g. from a signed link in an email. purchaserID is the
+// holder's account and is null for guests; a null price leaves it unknown. The
+// holder is not notified of their own purchase.
+func (s *WishListService) MarkGiftItemAsPurchasedByHolder(ctx context.Context, giftItemID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) error {
+	_, err := s.markGiftItemPurchased(ctx, giftItemID, purchaserID, purchasedPrice)
+	return err
+}
+
+// markGiftItemPurchased stores the purchase and drops the owner's cached public wishlists
+func (s *WishListService) markGiftItemPurchased(ctx context.Context, giftItemID, purchaserID pgtype.UUID, purchasedPrice pgtype.Numeric) (*itemmodels.GiftItem, error) {
+	updatedGiftItem, err := s.giftItemPurchaseRepo.MarkAsPurchased(ctx, giftItemID, purchaserID, purchasedPrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark gift item as purchased in repository: %w", err)
 	}
+	metrics.PurchaseMarked()
 
-	return output, nil
+	s.invalidatePublicWishlistsCacheByOwner(ctx, updatedGiftItem.OwnerID)
+	return updatedGiftItem, nil
 }
 
 func (s *WishListService) invalidatePublicWishlistsCacheByOwner(ctx context.Context, ownerID pgtype.UUID) {
@@ -1269,7 +1443,7 @@ func (s *WishListService) invalidatePublicWishlistsCacheByOwner(ctx context.Cont
 
 	wishLists, err := s.wishListRepo.GetByOwner(ctx, ownerID)
 	if err != nil {
-		logger.Warn("failed to get wishlists for cache invalidation", "error", err, "owner_id", ownerID.String())
+		logger.WarnContext(ctx, "failed to get wishlists for cache invalidation", "error", err, "owner_id", ownerID.String())
 		return
 	}
 
@@ -1278,13 +1452,139 @@ func (s *WishListService) invalidatePublicWishlistsCacheByOwner(ctx context.Cont
 			continue
 		}
 
-		cacheKey := fmt.Sprintf("wishlist:public:%s", wishList.PublicSlug.String)
+		s.invalidatePublicSlug(ctx, wishList.PublicSlug.String)
+	}
+}
+
+// invalidatePublicSlug drops the cached public wishlist and share page of slug.
+// Callers check that a cache is configured.
+func (s *WishListService) invalidatePublicSlug(ctx context.Context, slug string) {
+	for _, cacheKey := range []string{fmt.Sprintf(publicWishListCacheKey, slug), fmt.Sprintf(sharePageCacheKey, slug)} {
 		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			logger.Warn("failed to invalidate wishlist cache", "error", err, "cache_key", cacheKey)
+			logger.WarnContext(ctx, "failed to invalidate wishlist cache", "error", err, "cache_key", cacheKey)
 		}
 	}
 }
 
+// maxSlugAttempts bounds retries when a generated slug collides with an existing one
+const maxSlugAttempts = 5
+
+// RecomputeInvalidPublicSlugs assigns a fresh slug to public wishlists whose slug is
+// missing or no longer matches slugPattern. Used by the admin CLI; returns the number
+// of wishlists updated.
+func (s *WishListService) RecomputeInvalidPublicSlugs(ctx context.Context) (int, error) {
+	wishLists, err := s.wishListRepo.ListPublicWithInvalidSlug(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list wishlists with invalid slugs: %w", err)
+	}
+
+	updatedCount := 0
+	for _, wishList := range wishLists {
+		slug, err := s.generateUniquePublicSlug(ctx, wishList.Title, wishList.ID, wishList.OwnerID)
+		if err != nil {
+			return updatedCount, err
+		}
+
+		oldSlug := wishList.PublicSlug
+		wishList.PublicSlug = pgtype.Text{String: slug, Valid: true}
+		if _, err := s.wishListRepo.Update(ctx, *wishList); err != nil {
+			return updatedCount, fmt.Errorf("failed to update wishlist slug: %w", err)
+		}
+		updatedCount++
+
+		if s.cache != nil && oldSlug.Valid && oldSlug.String != "" {
+			s.invalidatePublicSlug(ctx, oldSlug.String)
+		}
+	}
+
+	return updatedCount, nil
+}
+
+// recordItemViews counts the items as viewed on the public page. A failure is
+// logged only; the page is served either way.
+func (s *WishListService) recordItemViews(ctx context.Context, giftItems []*itemmodels.GiftItem) {
+	if s.itemActivity == nil {
+		return
+	}
+
+	ids := make([]pgtype.UUID, 0, len(giftItems))
+	for _, giftItem := range giftItems {
+		if giftItem != nil {
+			ids = append(ids, giftItem.ID)
+		}
+	}
+	if err := s.itemActivity.RecordItemViews(ctx, ids); err != nil {
+		logger.WarnContext(ctx, "failed to record item views", "error", err)
+	}
+}
+
+// attachImages fills in each item's gallery. Items without one, or all items when
+// no gallery repository is configured, keep their single image_url.
+func (s *WishListService) attachImages(ctx context.Context, outputs ...*GiftItemOutput) error {
+	imagesByItem := map[string][]*itemmodels.GiftItemImage{}
+	if s.itemImages != nil && len(outputs) > 0 {
+		ids := make([]pgtype.UUID, 0, len(outputs))
+		for _, output := range outputs {
+			id := pgtype.UUID{}
+			if err := id.Scan(output.ID); err != nil {
+				return fmt.Errorf("invalid gift item id %q: %w", output.ID, err)
+			}
+			ids = append(ids, id)
+		}
+
+		var err error
+		imagesByItem, err = s.itemImages.GetByItemIDs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to get gift item images: %w", err)
+		}
+	}
+
+	for _, output := range outputs {
+		if images, ok := imagesByItem[output.ID]; ok {
+			output.Images = mapper.Gallery(images)
+		}
+	}
+	return nil
+}
+
+// resolveRetiredSlug reports where a wishlist published under a retired slug
+// lives now, ErrWishListClosed when its link was closed after the occasion, or
+// ErrWishListNotFound when the slug was never used
+func (s *WishListService) resolveRetiredSlug(ctx context.Context, publicSlug string) error {
+	wishList, err := s.wishListRepo.GetByRetiredSlug(ctx, publicSlug)
+	if errors.Is(err, repository.ErrWishListNotFound) {
+		closed, err := s.wishListRepo.IsSlugClosed(ctx, publicSlug)
+		if err != nil {
+			return fmt.Errorf("failed to resolve retired slug: %w", err)
+		}
+		if closed {
+			return ErrWishListClosed
+		}
+		return ErrWishListNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resolve retired slug: %w", err)
+	}
+
+	return &WishListSlugMovedError{CurrentSlug: wishList.PublicSlug.String}
+}
+
+// generateUniquePublicSlug generates a slug from the title that no other wishlist uses
+func (s *WishListService) generateUniquePublicSlug(ctx context.Context, title string, excludeID, ownerID pgtype.UUID) (string, error) {
+	for range maxSlugAttempts {
+		slug := generatePublicSlug(title)
+		taken, err := s.wishListRepo.IsSlugTaken(ctx, slug, excludeID, ownerID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug uniqueness: %w", err)
+		}
+		if !taken {
+			return slug, nil
+		}
+	}
+
+	return "", ErrSlugTaken
+}
+
 // Helper function to generate a public slug from title
 func generatePublicSlug(title string) string {
 	// 1. Initial cleanup: lowercasing and replacing spaces
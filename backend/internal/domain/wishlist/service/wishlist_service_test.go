@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist/repository"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -83,7 +87,7 @@ func TestWishListService_CreateWishList(t *testing.T) {
 				}
 			}
 
-			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil)
+			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := service.CreateWishList(context.Background(), tt.userID, tt.input)
 
@@ -151,7 +155,7 @@ func TestWishListService_GetWishList(t *testing.T) {
 				}
 			}
 
-			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil)
+			service := NewWishListService(mockWishListRepo, mockGiftItemRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := service.GetWishList(context.Background(), tt.wishListID)
 
@@ -166,3 +170,682 @@ func TestWishListService_GetWishList(t *testing.T) {
 		})
 	}
 }
+
+func TestWishListService_Recurrence(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+	t.Run("create stores yearly recurrence", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			CreateFunc: func(ctx context.Context, wl models.WishList) (*models.WishList, error) {
+				wl.ID = testUUID
+				return &wl, nil
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		result, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:        "Birthday 2026",
+			OccasionDate: "2026-12-25T00:00:00Z",
+			Recurrence:   models.RecurrenceYearly,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.RecurrenceYearly, result.Recurrence)
+		assert.Equal(t, models.RecurrenceYearly, mockWishListRepo.CreateCalls()[0].WishList.Recurrence.String)
+	})
+
+	t.Run("create rejects recurrence without occasion date", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:      "Birthday",
+			Recurrence: models.RecurrenceYearly,
+		})
+
+		require.ErrorIs(t, err, ErrRecurrenceRequiresDate)
+		assert.Empty(t, mockWishListRepo.CreateCalls())
+	})
+
+	t.Run("create rejects unknown recurrence", func(t *testing.T) {
+		service := NewWishListService(&WishListRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:        "Birthday",
+			OccasionDate: "2026-12-25T00:00:00Z",
+			Recurrence:   "weekly",
+		})
+
+		require.ErrorIs(t, err, ErrInvalidRecurrence)
+	})
+
+	t.Run("update clears recurrence with empty string", func(t *testing.T) {
+		stored := models.WishList{
+			ID:           testUUID,
+			OwnerID:      testUUID,
+			Title:        "Birthday",
+			OccasionDate: pgtype.Date{Valid: true},
+			Recurrence:   pgtype.Text{String: models.RecurrenceYearly, Valid: true},
+		}
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				current := stored
+				return &current, nil
+			},
+			UpdateFunc: func(ctx context.Context, wl models.WishList) (*models.WishList, error) {
+				return &wl, nil
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		empty := ""
+		result, err := service.UpdateWishList(context.Background(), testUUID.String(), testUUID.String(), UpdateWishListInput{
+			Recurrence: &empty,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Recurrence)
+		assert.False(t, mockWishListRepo.UpdateCalls()[0].WishList.Recurrence.Valid)
+	})
+}
+
+func TestWishListService_UpdateWishList_VersionConflict(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	wishListID := testUUID.String()
+	ownerID := testUUID.String()
+	newTitle := "New Title"
+
+	stored := models.WishList{ID: testUUID, OwnerID: testUUID, Title: "Stored Title", Version: 5}
+
+	t.Run("stale client version", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				current := stored
+				return &current, nil
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		staleVersion := int32(4)
+		result, err := service.UpdateWishList(context.Background(), wishListID, ownerID, UpdateWishListInput{
+			Title:   &newTitle,
+			Version: &staleVersion,
+		})
+
+		assert.Nil(t, result)
+		require.ErrorIs(t, err, ErrWishListVersionConflict)
+		var conflict *WishListVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, int32(5), conflict.Current.Version)
+		assert.Equal(t, "Stored Title", conflict.Current.Title)
+		assert.Empty(t, mockWishListRepo.UpdateCalls())
+	})
+
+	t.Run("concurrent update between read and write", func(t *testing.T) {
+		getCalls := 0
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				getCalls++
+				current := stored
+				if getCalls > 1 {
+					current.Title = "Changed Elsewhere"
+					current.Version = 6
+				}
+				return &current, nil
+			},
+			UpdateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				assert.Equal(t, int32(5), wishList.Version)
+				return nil, repository.ErrWishListVersionConflict
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		result, err := service.UpdateWishList(context.Background(), wishListID, ownerID, UpdateWishListInput{
+			Title: &newTitle,
+		})
+
+		assert.Nil(t, result)
+		var conflict *WishListVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, int32(6), conflict.Current.Version)
+		assert.Equal(t, "Changed Elsewhere", conflict.Current.Title)
+	})
+}
+
+func TestWishListService_UpdateWishList_CustomSlug(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	customSlug := "anna-birthday"
+
+	tests := []struct {
+		name     string
+		allowed  bool
+		expected string
+	}{
+		{name: "premium keeps the slug as chosen", allowed: true, expected: `^anna-birthday$`},
+		{name: "free plan gets a random suffix", allowed: false, expected: `^anna-birthday-\d{4}$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+					return &models.WishList{ID: testUUID, OwnerID: testUUID, Title: "Birthday"}, nil
+				},
+				IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+					return false, nil
+				},
+				IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+					return false, nil
+				},
+				UpdateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+					return &wishList, nil
+				},
+			}
+			mockQuota := &QuotaCheckerInterfaceMock{
+				AllowsCustomSlugFunc: func(ctx context.Context, userID pgtype.UUID) (bool, error) {
+					return tt.allowed, nil
+				},
+			}
+
+			service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, mockQuota, nil, nil, nil, nil, nil)
+
+			result, err := service.UpdateWishList(context.Background(), testUUID.String(), testUUID.String(), UpdateWishListInput{
+				PublicSlug: &customSlug,
+			})
+
+			require.NoError(t, err)
+			assert.Regexp(t, tt.expected, result.PublicSlug)
+			assert.Equal(t, result.PublicSlug, mockWishListRepo.IsSlugTakenCalls()[0].Slug)
+		})
+	}
+}
+
+func TestWishListService_RetiredSlugs(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+	t.Run("retired slug reports the current slug", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+			GetByRetiredSlugFunc: func(ctx context.Context, slug string) (*models.WishList, error) {
+				assert.Equal(t, "old-birthday", slug)
+				return &models.WishList{ID: testUUID, PublicSlug: pgtype.Text{String: "new-birthday", Valid: true}}, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.GetWishListByPublicSlug(context.Background(), "old-birthday")
+
+		var moved *WishListSlugMovedError
+		require.ErrorAs(t, err, &moved)
+		assert.Equal(t, "new-birthday", moved.CurrentSlug)
+		assert.ErrorIs(t, err, ErrWishListSlugMoved)
+	})
+
+	t.Run("unknown slug is not found", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+			GetByRetiredSlugFunc: func(ctx context.Context, slug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+			IsSlugClosedFunc: func(ctx context.Context, slug string) (bool, error) {
+				return false, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.GetWishListByPublicSlug(context.Background(), "never-used")
+		require.ErrorIs(t, err, ErrWishListNotFound)
+
+		_, _, err = service.GetGiftItemsByPublicSlugPaginated(context.Background(), "never-used", 10, 0)
+		require.ErrorIs(t, err, ErrWishListNotFound)
+	})
+
+	t.Run("closed slug reports the wishlist is closed", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByPublicSlugFunc: func(ctx context.Context, publicSlug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+			GetByRetiredSlugFunc: func(ctx context.Context, slug string) (*models.WishList, error) {
+				return nil, repository.ErrWishListNotFound
+			},
+			IsSlugClosedFunc: func(ctx context.Context, slug string) (bool, error) {
+				assert.Equal(t, "birthday-2025", slug)
+				return true, nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.GetWishListByPublicSlug(context.Background(), "birthday-2025")
+		require.ErrorIs(t, err, ErrWishListClosed)
+	})
+
+	t.Run("changing the slug invalidates the cached old slug", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				return &models.WishList{
+					ID:         testUUID,
+					OwnerID:    testUUID,
+					Title:      "Birthday",
+					IsPublic:   pgtype.Bool{Bool: true, Valid: true},
+					PublicSlug: pgtype.Text{String: "old-birthday", Valid: true},
+				}, nil
+			},
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			UpdateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				return &wishList, nil
+			},
+		}
+		mockCache := &CacheInterfaceMock{
+			DeleteFunc: func(ctx context.Context, key string) error { return nil },
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, mockCache, nil, nil, nil, nil, nil, nil, nil)
+
+		newSlug := "new-birthday"
+		_, err := service.UpdateWishList(context.Background(), testUUID.String(), testUUID.String(), UpdateWishListInput{
+			PublicSlug: &newSlug,
+		})
+
+		require.NoError(t, err)
+		var keys []string
+		for _, call := range mockCache.DeleteCalls() {
+			keys = append(keys, call.Key)
+		}
+		assert.ElementsMatch(t, []string{
+			"wishlist:public:new-birthday", "wishlist:public:old-birthday",
+			"wishlist:share-page:new-birthday", "wishlist:share-page:old-birthday",
+		}, keys)
+	})
+}
+
+func TestWishListService_DeleteWishList(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+	tests := []struct {
+		name                  string
+		hasActiveReservations bool
+		expectedError         error
+		expectDelete          bool
+	}{
+		{name: "deletes wishlist without active reservations", expectDelete: true},
+		{name: "refuses when items are reserved", hasActiveReservations: true, expectedError: ErrActiveReservationsExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+					return &models.WishList{ID: testUUID, OwnerID: testUUID}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id pgtype.UUID) error {
+					return nil
+				},
+			}
+			mockReservationRepo := &ReservationRepositoryInterfaceMock{
+				HasActiveReservationsForWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (bool, error) {
+					return tt.hasActiveReservations, nil
+				},
+			}
+			mockGiftHistory := &GiftHistoryRecorderInterfaceMock{
+				SnapshotWishlistFunc: func(ctx context.Context, wishlistID pgtype.UUID) (int64, error) {
+					return 2, nil
+				},
+			}
+
+			service := NewWishListService(mockWishListRepo, &GiftItemRepositoryInterfaceMock{}, nil, nil, nil, mockReservationRepo, nil, mockGiftHistory, nil, nil, nil, nil, nil, nil)
+
+			err := service.DeleteWishList(context.Background(), testUUID.String(), testUUID.String())
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, mockReservationRepo.HasActiveReservationsForWishlistCalls(), 1)
+			assert.Equal(t, testUUID, mockReservationRepo.HasActiveReservationsForWishlistCalls()[0].WishlistID)
+			assert.Empty(t, mockReservationRepo.GetActiveReservationForGiftItemCalls())
+			if tt.expectDelete {
+				assert.Len(t, mockWishListRepo.DeleteCalls(), 1)
+				require.Len(t, mockGiftHistory.SnapshotWishlistCalls(), 1)
+				assert.Equal(t, testUUID, mockGiftHistory.SnapshotWishlistCalls()[0].WishlistID)
+			} else {
+				assert.Empty(t, mockWishListRepo.DeleteCalls())
+				assert.Empty(t, mockGiftHistory.SnapshotWishlistCalls())
+			}
+		})
+	}
+}
+
+func TestWishListService_MergeWishLists(t *testing.T) {
+	ownerID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	targetID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	sourceID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	otherID := pgtype.UUID{Bytes: [16]byte{4}, Valid: true}
+
+	tests := []struct {
+		name          string
+		input         MergeWishListsInput
+		sourceOwner   pgtype.UUID
+		sourceMerged  bool
+		mergeErr      error
+		expectedError error
+		expectedOpts  repository.MergeOptions
+	}{
+		{name: "moves by default", input: MergeWishListsInput{SourceID: sourceID.String()}, expectedOpts: repository.MergeOptions{Move: true}},
+		{name: "copies and archives", input: MergeWishListsInput{SourceID: sourceID.String(), Mode: MergeModeCopy, ArchiveSource: true}, expectedOpts: repository.MergeOptions{Archive: true}},
+		{name: "rejects unknown mode", input: MergeWishListsInput{SourceID: sourceID.String(), Mode: "link"}, expectedError: ErrInvalidMergeMode},
+		{name: "rejects merging into itself", input: MergeWishListsInput{SourceID: targetID.String()}, expectedError: ErrMergeIntoItself},
+		{name: "rejects another user's source", input: MergeWishListsInput{SourceID: sourceID.String()}, sourceOwner: otherID, expectedError: ErrWishListForbidden},
+		{name: "rejects an archived source", input: MergeWishListsInput{SourceID: sourceID.String()}, sourceMerged: true, expectedError: ErrWishListMerged},
+		{name: "reports a concurrent merge", input: MergeWishListsInput{SourceID: sourceID.String(), ArchiveSource: true}, mergeErr: repository.ErrWishListMerged, expectedError: ErrWishListMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+					wishList := &models.WishList{ID: id, OwnerID: ownerID, Title: "Birthday"}
+					if id == sourceID {
+						if tt.sourceOwner.Valid {
+							wishList.OwnerID = tt.sourceOwner
+						}
+						if tt.sourceMerged {
+							wishList.MergedInto = otherID
+						}
+					}
+					return wishList, nil
+				},
+				MergeFunc: func(ctx context.Context, sourceID, targetID pgtype.UUID, opts repository.MergeOptions) (int64, error) {
+					return 4, tt.mergeErr
+				},
+			}
+
+			service := NewWishListService(mockWishListRepo, &GiftItemRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			output, err := service.MergeWishLists(context.Background(), targetID.String(), ownerID.String(), tt.input)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				if tt.mergeErr == nil {
+					assert.Empty(t, mockWishListRepo.MergeCalls())
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, targetID.String(), output.WishList.ID)
+			assert.Equal(t, int64(4), output.ItemsMerged)
+			require.Len(t, mockWishListRepo.MergeCalls(), 1)
+			call := mockWishListRepo.MergeCalls()[0]
+			assert.Equal(t, sourceID, call.SourceID)
+			assert.Equal(t, targetID, call.TargetID)
+			assert.Equal(t, tt.expectedOpts, call.Opts)
+		})
+	}
+}
+
+func TestWishListService_RollOverUnpurchased(t *testing.T) {
+	ownerID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	sourceID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	otherID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	lastWeek := today.AddDate(0, 0, -7)
+
+	tests := []struct {
+		name          string
+		source        models.WishList
+		input         RollOverUnpurchasedInput
+		repoErr       error
+		expectedError error
+		expectedTitle string
+		expectedDate  pgtype.Date
+	}{
+		{
+			name:          "moves a dated occasion and its year along",
+			source:        models.WishList{Title: fmt.Sprintf("Birthday %d", lastWeek.Year()), OccasionDate: pgtype.Date{Time: lastWeek, Valid: true}},
+			expectedTitle: fmt.Sprintf("Birthday %d", lastWeek.AddDate(1, 0, 0).Year()),
+			expectedDate:  pgtype.Date{Time: lastWeek.AddDate(1, 0, 0), Valid: true},
+		},
+		{
+			name:          "keeps an undated list undated",
+			source:        models.WishList{Title: "Someday"},
+			expectedTitle: "Someday",
+		},
+		{
+			name:          "takes title and date from the input",
+			source:        models.WishList{Title: "Birthday", OccasionDate: pgtype.Date{Time: lastWeek, Valid: true}},
+			input:         RollOverUnpurchasedInput{Title: " Housewarming ", OccasionDate: "2030-05-01T00:00:00Z"},
+			expectedTitle: "Housewarming",
+			expectedDate:  pgtype.Date{Time: time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		},
+		{name: "rejects a bad date", source: models.WishList{Title: "Birthday"}, input: RollOverUnpurchasedInput{OccasionDate: "May 1st"}, expectedError: ErrInvalidOccasionDate},
+		{name: "rejects another user's list", source: models.WishList{Title: "Birthday", OwnerID: otherID}, expectedError: ErrWishListForbidden},
+		{name: "rejects a list rolled over before", source: models.WishList{Title: "Birthday", RolledOverTo: otherID}, expectedError: ErrWishListRolledOver},
+		{name: "reports a concurrent rollover", source: models.WishList{Title: "Birthday"}, repoErr: repository.ErrWishListRolledOver, expectedError: ErrWishListRolledOver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+					source := tt.source
+					source.ID = id
+					if !source.OwnerID.Valid {
+						source.OwnerID = ownerID
+					}
+					return &source, nil
+				},
+				RollOverUnpurchasedFunc: func(ctx context.Context, sourceID pgtype.UUID, next models.WishList) (*models.WishList, int64, error) {
+					if tt.repoErr != nil {
+						return nil, 0, tt.repoErr
+					}
+					next.ID = otherID
+					return &next, 2, nil
+				},
+			}
+
+			service := NewWishListService(mockWishListRepo, &GiftItemRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			output, err := service.RollOverUnpurchased(context.Background(), sourceID.String(), ownerID.String(), tt.input)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), output.ItemsCarried)
+			require.Len(t, mockWishListRepo.RollOverUnpurchasedCalls(), 1)
+			call := mockWishListRepo.RollOverUnpurchasedCalls()[0]
+			assert.Equal(t, sourceID, call.SourceID)
+			assert.Equal(t, ownerID, call.Next.OwnerID)
+			assert.Equal(t, tt.expectedTitle, call.Next.Title)
+			assert.Equal(t, tt.expectedDate, call.Next.OccasionDate)
+			assert.False(t, call.Next.IsPublic.Bool, "the new list starts private")
+		})
+	}
+}
+
+func TestWishListService_GetWishListsByOwner_Filters(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+	tests := []struct {
+		name          string
+		filters       repository.WishListFilters
+		repoErr       error
+		expectedError error
+	}{
+		{name: "passes sort and filter to the repository", filters: repository.WishListFilters{Sort: "occasion_date", Filter: "upcoming"}},
+		{name: "rejects unknown sort", filters: repository.WishListFilters{Sort: "price"}, repoErr: repository.ErrInvalidSortField, expectedError: ErrInvalidWishListSort},
+		{name: "rejects unknown filter", filters: repository.WishListFilters{Filter: "soon"}, repoErr: repository.ErrInvalidFilter, expectedError: ErrInvalidWishListFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWishListRepo := &WishListRepositoryInterfaceMock{
+				GetByOwnerWithItemCountFunc: func(ctx context.Context, ownerID pgtype.UUID, filters repository.WishListFilters) ([]*models.WishListWithItemCount, error) {
+					if tt.repoErr != nil {
+						return nil, tt.repoErr
+					}
+					return []*models.WishListWithItemCount{
+						{WishList: models.WishList{ID: testUUID, OwnerID: testUUID, Title: "Birthday"}, ItemCount: 3},
+					}, nil
+				},
+			}
+
+			service := NewWishListService(mockWishListRepo, &GiftItemRepositoryInterfaceMock{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			outputs, err := service.GetWishListsByOwner(context.Background(), testUUID.String(), tt.filters)
+
+			require.Len(t, mockWishListRepo.GetByOwnerWithItemCountCalls(), 1)
+			assert.Equal(t, tt.filters, mockWishListRepo.GetByOwnerWithItemCountCalls()[0].Filters)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+			assert.Equal(t, int64(3), outputs[0].ItemCount)
+		})
+	}
+}
+
+func TestWishListService_RecomputeInvalidPublicSlugs(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+
+	t.Run("assigns valid slugs to listed wishlists", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			ListPublicWithInvalidSlugFunc: func(ctx context.Context) ([]*models.WishList, error) {
+				return []*models.WishList{
+					{ID: testUUID, Title: "Birthday List", IsPublic: pgtype.Bool{Bool: true, Valid: true}},
+					{ID: testUUID, Title: "Wedding", PublicSlug: pgtype.Text{String: "Wedding 2024", Valid: true}},
+				}, nil
+			},
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			IsVanitySlugTakenFunc: func(ctx context.Context, slug string, ownerID, excludeID pgtype.UUID) (bool, error) {
+				return false, nil
+			},
+			UpdateFunc: func(ctx context.Context, wishList models.WishList) (*models.WishList, error) {
+				return &wishList, nil
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		updated, err := service.RecomputeInvalidPublicSlugs(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+		require.Len(t, mockWishListRepo.UpdateCalls(), 2)
+		assert.Regexp(t, `^birthday-list-\d{4}$`, mockWishListRepo.UpdateCalls()[0].WishList.PublicSlug.String)
+		assert.Regexp(t, `^wedding-\d{4}$`, mockWishListRepo.UpdateCalls()[1].WishList.PublicSlug.String)
+	})
+
+	t.Run("gives up when generated slugs keep colliding", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			ListPublicWithInvalidSlugFunc: func(ctx context.Context) ([]*models.WishList, error) {
+				return []*models.WishList{{ID: testUUID, Title: "List"}}, nil
+			},
+			IsSlugTakenFunc: func(ctx context.Context, slug string, excludeID, ownerID pgtype.UUID) (bool, error) {
+				return true, nil
+			},
+		}
+
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		updated, err := service.RecomputeInvalidPublicSlugs(context.Background())
+
+		require.ErrorIs(t, err, ErrSlugTaken)
+		assert.Equal(t, 0, updated)
+		assert.Len(t, mockWishListRepo.IsSlugTakenCalls(), maxSlugAttempts)
+	})
+}
+
+func TestWishListService_Moderation(t *testing.T) {
+	testUUID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	errRejected := errors.New("content rejected")
+
+	t.Run("create rejects blocked content", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{}
+		mockModerator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+				return errRejected
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, mockModerator, nil, nil, nil, nil)
+
+		_, err := service.CreateWishList(context.Background(), testUUID.String(), CreateWishListInput{
+			Title:       "Birthday",
+			Description: "bad words",
+		})
+
+		require.ErrorIs(t, err, errRejected)
+		assert.Empty(t, mockWishListRepo.CreateCalls())
+		assert.Equal(t, []string{"Birthday", "bad words", "", ""}, mockModerator.CheckContentCalls()[0].Texts)
+	})
+
+	t.Run("update checks only changed fields", func(t *testing.T) {
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				return &models.WishList{ID: testUUID, OwnerID: testUUID, Title: "Birthday"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, wl models.WishList) (*models.WishList, error) {
+				return &wl, nil
+			},
+		}
+		mockModerator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+				return nil
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, mockModerator, nil, nil, nil, nil)
+		title := "Graduation"
+
+		_, err := service.UpdateWishList(context.Background(), testUUID.String(), testUUID.String(), UpdateWishListInput{
+			Title: &title,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Graduation"}, mockModerator.CheckContentCalls()[0].Texts)
+		assert.Empty(t, mockModerator.CheckPublishableCalls())
+	})
+
+	t.Run("update refuses to publish a taken down wishlist", func(t *testing.T) {
+		errTakenDown := errors.New("taken down")
+		mockWishListRepo := &WishListRepositoryInterfaceMock{
+			GetByIDFunc: func(ctx context.Context, id pgtype.UUID) (*models.WishList, error) {
+				return &models.WishList{ID: testUUID, OwnerID: testUUID, Title: "Birthday"}, nil
+			},
+		}
+		mockModerator := &ContentModeratorInterfaceMock{
+			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+				return nil
+			},
+			CheckPublishableFunc: func(ctx context.Context, wishlistID pgtype.UUID) error {
+				return errTakenDown
+			},
+		}
+		service := NewWishListService(mockWishListRepo, nil, nil, nil, nil, nil, nil, nil, nil, mockModerator, nil, nil, nil, nil)
+		isPublic := true
+
+		_, err := service.UpdateWishList(context.Background(), testUUID.String(), testUUID.String(), UpdateWishListInput{
+			IsPublic: &isPublic,
+		})
+
+		require.ErrorIs(t, err, errTakenDown)
+		assert.Empty(t, mockWishListRepo.UpdateCalls())
+	})
+}
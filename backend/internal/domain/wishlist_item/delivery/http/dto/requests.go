@@ -1,7 +1,12 @@
 package dto
 
 import (
+	"net/url"
+	"strings"
+
 	"wish-list/internal/domain/wishlist_item/service"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/variant"
 )
 
 // AttachItemRequest represents the request to attach an existing item to a wishlist
@@ -11,13 +16,19 @@ type AttachItemRequest struct {
 
 // CreateItemRequest represents the request to create a gift item in a wishlist
 type CreateItemRequest struct {
-	Title       string   `json:"title" validate:"required,min=1,max=255" example:"iPhone 15 Pro"`
-	Description *string  `json:"description" validate:"omitempty,max=2000" example:"256GB, Blue Titanium"`
-	Link        *string  `json:"link" validate:"omitempty,url" example:"https://apple.com/iphone-15-pro"`
-	ImageURL    *string  `json:"image_url" validate:"omitempty,url" example:"https://example.com/image.jpg"`
-	Price       *float64 `json:"price" validate:"omitempty,gte=0" example:"999.99"`
-	Priority    *int32   `json:"priority" validate:"omitempty,gte=0,lte=10" example:"3"`
-	Notes       *string  `json:"notes" validate:"omitempty,max=1000" example:"Preferred color: Blue"`
+	Title           string          `json:"title" validate:"required,min=1,max=255" example:"iPhone 15 Pro"`
+	Description     *string         `json:"description" validate:"omitempty,max=2000" example:"256GB, Blue Titanium"`
+	Link            *string         `json:"link" validate:"omitempty,url" example:"https://apple.com/iphone-15-pro"`
+	ImageURL        *string         `json:"image_url" validate:"omitempty,url" example:"https://example.com/image.jpg"`
+	Price           *float64        `json:"price" validate:"omitempty,gte=0" example:"999.99"`
+	Priority        *priority.Value `json:"priority" swaggertype:"string" enums:"must_have,nice_to_have,dream" example:"must_have"` // Level name, or a legacy 0-10 number
+	Notes           *string         `json:"notes" validate:"omitempty,max=1000" example:"Preferred color: Blue"`
+	NotesVisibility *string         `json:"notes_visibility" validate:"omitempty,oneof=private public" enums:"private,public" example:"private"` // Whether guests see the notes, defaults to private
+	Quantity        *int32          `json:"quantity" validate:"omitempty,min=1,max=100" example:"6"`                                             // Units wanted, defaults to 1
+	Options         variant.Options `json:"options"`                                                                                             // Acceptable sizes, colors and models
+	Category        *string         `json:"category" validate:"omitempty,max=50" example:"Electronics"`                                          // Section on the share page
+	// AllowDuplicate adds the item even when one with the same link or a similar name is already in the wishlist
+	AllowDuplicate bool `json:"allow_duplicate" example:"false"`
 }
 
 // MarkManualReservationRequest represents the request to manually mark a wishlist item as reserved
@@ -28,13 +39,61 @@ type MarkManualReservationRequest struct {
 
 // ToDomain converts CreateItemRequest to service input
 func (r *CreateItemRequest) ToDomain() service.CreateItemInput {
+	input := service.CreateItemInput{
+		Title:           r.Title,
+		Description:     r.Description,
+		Link:            r.Link,
+		ImageURL:        r.ImageURL,
+		Price:           r.Price,
+		Notes:           r.Notes,
+		NotesVisibility: r.NotesVisibility,
+		Quantity:        r.Quantity,
+		Options:         r.Options,
+		Category:        r.Category,
+
+		AllowDuplicate: r.AllowDuplicate,
+	}
+	if r.Priority != nil {
+		input.Priority = &r.Priority.Number
+		input.PriorityLevel = &r.Priority.Level
+	}
+	return input
+}
+
+// QuickAddItemRequest represents the request to add a product link to a wishlist from an integration
+type QuickAddItemRequest struct {
+	URL        string   `json:"url" validate:"required,url" example:"https://apple.com/iphone-15-pro"`
+	WishlistID string   `json:"wishlist_id" validate:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title      *string  `json:"title" validate:"omitempty,max=255" example:"iPhone 15 Pro"`
+	ImageURL   *string  `json:"image_url" validate:"omitempty,url" example:"https://example.com/image.jpg"`
+	Price      *float64 `json:"price" validate:"omitempty,gte=0" example:"999.99"`
+	Notes      *string  `json:"notes" validate:"omitempty,max=1000" example:"Preferred color: Blue"`
+	// AllowDuplicate adds the item even when one with the same link or a similar name is already in the wishlist
+	AllowDuplicate bool `json:"allow_duplicate" example:"false"`
+}
+
+// ToDomain converts QuickAddItemRequest to service input. Without a title the item
+// is named after the link's host, which the owner can rename later.
+func (r *QuickAddItemRequest) ToDomain() service.CreateItemInput {
+	title := ""
+	if r.Title != nil {
+		title = strings.TrimSpace(*r.Title)
+	}
+	if title == "" {
+		title = r.URL
+		if u, err := url.Parse(r.URL); err == nil && u.Hostname() != "" {
+			title = strings.TrimPrefix(u.Hostname(), "www.")
+		}
+	}
+
+	link := r.URL
 	return service.CreateItemInput{
-		Title:       r.Title,
-		Description: r.Description,
-		Link:        r.Link,
-		ImageURL:    r.ImageURL,
-		Price:       r.Price,
-		Priority:    r.Priority,
-		Notes:       r.Notes,
+		Title:    title,
+		Link:     &link,
+		ImageURL: r.ImageURL,
+		Price:    r.Price,
+		Notes:    r.Notes,
+
+		AllowDuplicate: r.AllowDuplicate,
 	}
 }
@@ -2,27 +2,53 @@ package dto
 
 import (
 	"wish-list/internal/domain/wishlist_item/service"
+	"wish-list/internal/pkg/fieldset"
+	"wish-list/internal/pkg/variant"
 )
 
 // ItemResponse represents a gift item in API responses
 type ItemResponse struct {
-	ID                    string  `json:"id" validate:"required" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
-	OwnerID               string  `json:"owner_id" validate:"required" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
-	Title                 string  `json:"title" validate:"required" example:"iPhone 15 Pro"`
-	Description           string  `json:"description" example:"256GB, Blue Titanium"`
-	Link                  string  `json:"link" example:"https://apple.com/iphone-15-pro"`
-	ImageURL              string  `json:"image_url" example:"https://example.com/image.jpg"`
-	Price                 float64 `json:"price" validate:"required" example:"999.99"`
-	Priority              int     `json:"priority" validate:"required" example:"3"`
-	Notes                 string  `json:"notes" example:"Preferred color: Blue"`
-	IsPurchased           bool    `json:"is_purchased" validate:"required" example:"false"`
-	IsReserved            bool    `json:"is_reserved" validate:"required" example:"false"`
-	IsManuallyReserved    bool    `json:"is_manually_reserved" validate:"required" example:"false"`
-	ManualReservedByName  string  `json:"manual_reserved_by_name" validate:"required" example:"Бабушка и дедушка"`
-	ManualReservationNote string  `json:"manual_reservation_note" validate:"required" example:"Сказали что купят велосипед"`
-	IsArchived            bool    `json:"is_archived" validate:"required" example:"false"`
-	CreatedAt             string  `json:"created_at" validate:"required" format:"date-time" example:"2024-01-01T12:00:00Z"`
-	UpdatedAt             string  `json:"updated_at" validate:"required" format:"date-time" example:"2024-01-01T12:00:00Z"`
+	ID                    string          `json:"id" validate:"required" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OwnerID               string          `json:"owner_id" validate:"required" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Title                 string          `json:"title" validate:"required" example:"iPhone 15 Pro"`
+	Description           string          `json:"description" example:"256GB, Blue Titanium"`
+	Link                  string          `json:"link" example:"https://apple.com/iphone-15-pro"`
+	ImageURL              string          `json:"image_url" example:"https://example.com/image.jpg"`
+	Price                 float64         `json:"price" validate:"required" example:"999.99"`
+	Priority              int             `json:"priority" validate:"required" example:"9"` // Legacy 0-10 number
+	PriorityLevel         string          `json:"priority_level" validate:"required" enums:"must_have,nice_to_have,dream" example:"must_have"`
+	PriorityWeight        int             `json:"priority_weight" validate:"required" example:"3"`
+	Notes                 string          `json:"notes" example:"Preferred color: Blue"`
+	NotesVisibility       string          `json:"notes_visibility" example:"private" enums:"private,public"`
+	IsPurchased           bool            `json:"is_purchased" validate:"required" example:"false"`
+	IsReserved            bool            `json:"is_reserved" validate:"required" example:"false"`
+	Quantity              int32           `json:"quantity" validate:"required" example:"6"`
+	ReservedQuantity      int32           `json:"reserved_quantity" validate:"required" example:"2"`
+	ReservationStatus     string          `json:"reservation_status" validate:"required" enums:"available,partially_reserved,fully_reserved" example:"partially_reserved"`
+	Options               variant.Options `json:"options"`
+	Category              string          `json:"category,omitempty" example:"Electronics"`
+	IsManuallyReserved    bool            `json:"is_manually_reserved" validate:"required" example:"false"`
+	ManualReservedByName  string          `json:"manual_reserved_by_name" validate:"required" example:"Бабушка и дедушка"`
+	ManualReservationNote string          `json:"manual_reservation_note" validate:"required" example:"Сказали что купят велосипед"`
+	IsArchived            bool            `json:"is_archived" validate:"required" example:"false"`
+	Version               int32           `json:"version" validate:"required" example:"1"`
+	CreatedAt             string          `json:"created_at" validate:"required" format:"date-time" example:"2024-01-01T12:00:00Z"`
+	UpdatedAt             string          `json:"updated_at" validate:"required" format:"date-time" example:"2024-01-01T12:00:00Z"`
+	AvailabilityStatus    string          `json:"availability_status" validate:"required" enums:"unknown,available,out_of_stock,not_found" example:"available"`
+	AvailabilityCheckedAt string          `json:"availability_checked_at,omitempty" format:"date-time" example:"2024-01-01T12:00:00Z"`
+
+	fields fieldset.Set // Sparse fieldset; the zero set encodes every member
+}
+
+// SelectFields limits the JSON encoding to a sparse fieldset
+func (r *ItemResponse) SelectFields(fields fieldset.Set) {
+	r.fields = fields
+}
+
+// MarshalJSON encodes the members selected with SelectFields
+func (r ItemResponse) MarshalJSON() ([]byte, error) {
+	type plain ItemResponse
+	return r.fields.Marshal(plain(r))
 }
 
 // ItemResponseFromService converts service output to API response
@@ -36,15 +62,26 @@ func ItemResponseFromService(item *service.ItemOutput) ItemResponse {
 		ImageURL:              item.ImageURL,
 		Price:                 item.Price,
 		Priority:              item.Priority,
+		PriorityLevel:         item.PriorityLevel,
+		PriorityWeight:        item.PriorityWeight,
 		Notes:                 item.Notes,
+		NotesVisibility:       item.NotesVisibility,
 		IsPurchased:           item.IsPurchased,
 		IsReserved:            item.IsReserved,
+		Quantity:              item.Quantity,
+		ReservedQuantity:      item.ReservedQuantity,
+		ReservationStatus:     item.ReservationStatus,
+		Options:               item.Options,
+		Category:              item.Category,
 		IsManuallyReserved:    item.IsManuallyReserved,
 		ManualReservedByName:  item.ManualReservedByName,
 		ManualReservationNote: item.ManualReservationNote,
 		IsArchived:            item.IsArchived,
+		Version:               item.Version,
 		CreatedAt:             item.CreatedAt,
 		UpdatedAt:             item.UpdatedAt,
+		AvailabilityStatus:    item.AvailabilityStatus,
+		AvailabilityCheckedAt: item.AvailabilityCheckedAt,
 	}
 }
 
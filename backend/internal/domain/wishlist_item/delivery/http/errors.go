@@ -3,13 +3,31 @@ package http
 import (
 	"errors"
 
+	moderationservice "wish-list/internal/domain/moderation/service"
+	quotaservice "wish-list/internal/domain/quota/service"
 	"wish-list/internal/domain/wishlist_item/service"
 	"wish-list/internal/pkg/apperrors"
 )
 
 // mapWishlistItemServiceError converts wishlist_item service errors to AppErrors
 func mapWishlistItemServiceError(err error) error {
+	var quotaErr *quotaservice.QuotaExceededError
+	var rejectedErr *moderationservice.ContentRejectedError
+	var duplicateErr *service.DuplicateItemError
+
 	switch {
+	case errors.As(err, &quotaErr) && quotaErr.Upgradable():
+		return apperrors.PaymentRequired(quotaErr.Error())
+	case errors.As(err, &quotaErr):
+		return apperrors.UnprocessableEntity(quotaErr.Error())
+	case errors.As(err, &rejectedErr):
+		return apperrors.UnprocessableEntity(rejectedErr.Error())
+	case errors.As(err, &duplicateErr):
+		return apperrors.Conflict("A similar item is already in this wishlist").WithDetails(map[string]string{
+			"item_id":    duplicateErr.ItemID,
+			"name":       duplicateErr.Name,
+			"matched_on": duplicateErr.MatchedOn,
+		})
 	case errors.Is(err, service.ErrWishListNotFound):
 		return apperrors.NotFound("Wishlist not found")
 	case errors.Is(err, service.ErrWishListForbidden):
@@ -34,6 +52,12 @@ func mapWishlistItemServiceError(err error) error {
 		return apperrors.BadRequest("reserved_by_name is required")
 	case errors.Is(err, service.ErrItemNotAvailable):
 		return apperrors.Conflict("Item is already reserved or purchased")
+	case errors.Is(err, service.ErrItemPriorityInvalid):
+		return apperrors.BadRequest("Priority must be must_have, nice_to_have or dream")
+	case errors.Is(err, service.ErrItemNotesVisibilityInvalid):
+		return apperrors.BadRequest("Notes visibility must be private or public")
+	case errors.Is(err, service.ErrItemOptionsInvalid):
+		return apperrors.BadRequest("Options must list size, color or model values of 1-50 characters, at most 20 each")
 	default:
 		return apperrors.Internal("Failed to process request").Wrap(err)
 	}
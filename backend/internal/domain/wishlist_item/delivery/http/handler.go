@@ -11,6 +11,9 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// itemsResource names items in the fields[...] sparse fieldset parameter
+const itemsResource = "items"
+
 // Handler handles HTTP requests for wishlist-item relationships
 type Handler struct {
 	service service.WishlistItemServiceInterface
@@ -32,7 +35,9 @@ func NewHandler(svc service.WishlistItemServiceInterface) *Handler {
 //	@Param			id		path		string							true	"Wishlist ID"
 //	@Param			page	query		int								false	"Page number (default 1)"
 //	@Param			limit	query		int								false	"Items per page (default 10, max 100)"
+//	@Param			fields	query		string							false	"Comma-separated item members to return; id is always included"
 //	@Success		200		{object}	dto.PaginatedItemsResponse		"List of items in wishlist"
+//	@Failure		400		{object}	map[string]string				"Unknown field requested"
 //	@Failure		401		{object}	map[string]string				"Not authenticated"
 //	@Failure		403		{object}	map[string]string				"Access denied"
 //	@Failure		404		{object}	map[string]string				"Wishlist not found"
@@ -45,6 +50,10 @@ func (h *Handler) GetWishlistItems(c echo.Context) error {
 
 	wishlistID := c.Param("id")
 	pagination := helpers.ParsePagination(c)
+	fields, err := helpers.ParseFields(c, itemsResource, dto.ItemResponse{})
+	if err != nil {
+		return err
+	}
 
 	ctx := c.Request().Context()
 
@@ -54,7 +63,12 @@ func (h *Handler) GetWishlistItems(c echo.Context) error {
 		return mapWishlistItemServiceError(err)
 	}
 
-	return c.JSON(nethttp.StatusOK, dto.PaginatedItemsResponseFromService(result))
+	response := dto.PaginatedItemsResponseFromService(result)
+	for i := range response.Items {
+		response.Items[i].SelectFields(fields)
+	}
+
+	return c.JSON(nethttp.StatusOK, response)
 }
 
 // AttachItemToWishlist godoc
@@ -69,9 +83,11 @@ func (h *Handler) GetWishlistItems(c echo.Context) error {
 //	@Success		204		{object}	nil						"Item attached successfully"
 //	@Failure		400		{object}	map[string]string		"Invalid request body"
 //	@Failure		401		{object}	map[string]string		"Not authenticated"
+//	@Failure		402		{object}	map[string]string		"Item limit of the free plan reached"
 //	@Failure		403		{object}	map[string]string		"Access denied"
 //	@Failure		404		{object}	map[string]string		"Wishlist or item not found"
 //	@Failure		409		{object}	map[string]string		"Item already attached"
+//	@Failure		422		{object}	map[string]string		"Item limit of the premium plan reached"
 //	@Failure		500		{object}	map[string]string		"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/wishlists/{id}/items [post]
@@ -108,8 +124,11 @@ func (h *Handler) AttachItemToWishlist(c echo.Context) error {
 //	@Success		201		{object}	dto.ItemResponse	"Item created and attached successfully"
 //	@Failure		400		{object}	map[string]string	"Invalid request body"
 //	@Failure		401		{object}	map[string]string	"Not authenticated"
+//	@Failure		402		{object}	map[string]string	"Item limit of the free plan reached"
 //	@Failure		403		{object}	map[string]string	"Access denied"
 //	@Failure		404		{object}	map[string]string	"Wishlist not found"
+//	@Failure		409		{object}	map[string]string	"A similar item is already in the wishlist; resend with allow_duplicate to add it anyway"
+//	@Failure		422		{object}	map[string]string	"Item limit of the premium plan reached or content rejected by moderation"
 //	@Failure		500		{object}	map[string]string	"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/wishlists/{id}/items/new [post]
@@ -134,6 +153,43 @@ func (h *Handler) CreateItemInWishlist(c echo.Context) error {
 	return c.JSON(nethttp.StatusCreated, dto.ItemResponseFromService(item))
 }
 
+// QuickAddItem godoc
+//
+//	@Summary		Quick-add item from a link
+//	@Description	Creates an item from a product URL in one of the token owner's wishlists. Meant for the browser extension and other integrations; authenticate with a personal API token that has the items:write scope. Without a title the item is named after the link's host.
+//	@Tags			Integrations
+//	@Accept			json
+//	@Produce		json
+//	@Param			item	body		dto.QuickAddItemRequest	true	"Product link and target wishlist"
+//	@Success		201		{object}	dto.ItemResponse		"Item created and attached successfully"
+//	@Failure		400		{object}	map[string]string		"Invalid request body"
+//	@Failure		401		{object}	map[string]string		"Invalid, expired or revoked API token"
+//	@Failure		402		{object}	map[string]string		"Item limit of the free plan reached"
+//	@Failure		403		{object}	map[string]string		"Missing scope or access denied"
+//	@Failure		404		{object}	map[string]string		"Wishlist not found"
+//	@Failure		409		{object}	map[string]string		"A similar item is already in the wishlist; resend with allow_duplicate to add it anyway"
+//	@Failure		422		{object}	map[string]string		"Item limit of the premium plan reached or content rejected by moderation"
+//	@Failure		500		{object}	map[string]string		"Internal server error"
+//	@Security		APITokenAuth
+//	@Router			/ext/items [post]
+func (h *Handler) QuickAddItem(c echo.Context) error {
+	userID := auth.MustGetUserID(c)
+
+	var req dto.QuickAddItemRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	item, err := h.service.CreateItemInWishlist(ctx, req.WishlistID, userID, req.ToDomain())
+	if err != nil {
+		return mapWishlistItemServiceError(err)
+	}
+
+	return c.JSON(nethttp.StatusCreated, dto.ItemResponseFromService(item))
+}
+
 // MarkManualReservation godoc
 //
 //	@Summary		Mark item as manually reserved
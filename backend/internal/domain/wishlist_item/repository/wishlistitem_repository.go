@@ -12,6 +12,7 @@ import (
 
 	"wish-list/internal/app/database"
 	itemmodels "wish-list/internal/domain/item/models"
+	"wish-list/internal/pkg/encryption"
 )
 
 // Sentinel errors for wishlist-item repository
@@ -28,11 +29,21 @@ type WishlistItemRepositoryInterface interface {
 	IsAttached(ctx context.Context, wishlistID, itemID pgtype.UUID) (bool, error)
 	GetWishlistsForItem(ctx context.Context, itemID pgtype.UUID) ([]pgtype.UUID, error)
 	DetachAll(ctx context.Context, itemID pgtype.UUID) error
+	ListItemRefs(ctx context.Context, wishlistID pgtype.UUID) ([]ItemRef, error)
+}
+
+// ItemRef is the part of an item needed to recognise it, e.g. when checking for duplicates
+type ItemRef struct {
+	ID   pgtype.UUID `db:"id"`
+	Name string      `db:"name"`
+	Link pgtype.Text `db:"link"`
 }
 
 // WishlistItemRepository implements WishlistItemRepositoryInterface
 type WishlistItemRepository struct {
-	db *database.DB
+	db                *database.DB
+	encryptionSvc     *encryption.Service
+	encryptionEnabled bool
 }
 
 // NewWishlistItemRepository creates a new WishlistItemRepository
@@ -42,6 +53,16 @@ func NewWishlistItemRepository(db *database.DB) WishlistItemRepositoryInterface
 	}
 }
 
+// NewWishlistItemRepositoryWithEncryption creates a WishlistItemRepository that
+// decrypts the manual reservation name of listed items
+func NewWishlistItemRepositoryWithEncryption(db *database.DB, encryptionSvc *encryption.Service) WishlistItemRepositoryInterface {
+	return &WishlistItemRepository{
+		db:                db,
+		encryptionSvc:     encryptionSvc,
+		encryptionEnabled: encryptionSvc != nil,
+	}
+}
+
 // Attach creates an association between wishlist and item
 func (r *WishlistItemRepository) Attach(ctx context.Context, wishlistID, itemID pgtype.UUID) error {
 	query := `
@@ -101,7 +122,11 @@ func (r *WishlistItemRepository) GetByWishlist(ctx context.Context, wishlistID p
 			gi.name, gi.id, gi.owner_id, gi.name, gi.description, gi.link, gi.image_url,
 			gi.price, gi.priority, gi.reserved_by_user_id, gi.reserved_at,
 			gi.purchased_by_user_id, gi.purchased_at, gi.purchased_price,
-			gi.notes, gi.position, gi.archived_at, gi.created_at, gi.updated_at,gi.purchased_by_user_id, gi.reserved_by_user_id
+			gi.notes, gi.position, gi.archived_at, gi.created_at, gi.updated_at,gi.purchased_by_user_id, gi.reserved_by_user_id,
+			gi.availability_status, gi.availability_checked_at, gi.quantity, gi.reserved_quantity, gi.priority_level, gi.options,
+			gi.category, gi.notes_visibility, gi.version,
+			gi.manual_reserved_by_name, gi.encrypted_manual_reserved_by_name,
+			gi.manual_reserved_at, gi.manual_reservation_note
 		FROM gift_items gi
 		INNER JOIN wishlist_items wi ON wi.gift_item_id = gi.id
 		WHERE wi.wishlist_id = $1
@@ -115,6 +140,19 @@ func (r *WishlistItemRepository) GetByWishlist(ctx context.Context, wishlistID p
 		return nil, fmt.Errorf("failed to get wishlist items: %w", err)
 	}
 
+	if r.encryptionEnabled {
+		for _, item := range items {
+			if !item.EncryptedManualReservedByName.Valid {
+				continue
+			}
+			name, err := r.encryptionSvc.Decrypt(ctx, item.EncryptedManualReservedByName.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt manual reserved by name: %w", err)
+			}
+			item.ManualReservedByName = pgtype.Text{String: name, Valid: true}
+		}
+	}
+
 	return items, nil
 }
 
@@ -185,3 +223,22 @@ func (r *WishlistItemRepository) DetachAll(ctx context.Context, itemID pgtype.UU
 
 	return nil
 }
+
+// ListItemRefs retrieves the name and link of every active item in a wishlist
+func (r *WishlistItemRepository) ListItemRefs(ctx context.Context, wishlistID pgtype.UUID) ([]ItemRef, error) {
+	query := `
+		SELECT gi.id, gi.name, gi.link
+		FROM gift_items gi
+		INNER JOIN wishlist_items wi ON wi.gift_item_id = gi.id
+		WHERE wi.wishlist_id = $1
+		  AND gi.archived_at IS NULL
+		ORDER BY wi.added_at DESC
+	`
+
+	var refs []ItemRef
+	if err := r.db.SelectContext(ctx, &refs, query, wishlistID); err != nil {
+		return nil, fmt.Errorf("failed to list wishlist item refs: %w", err)
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"wish-list/internal/domain/item/mapper"
+	itemmodels "wish-list/internal/domain/item/models"
+)
+
+// itemToOutput converts a stored item as the wishlist owner sees it
+func itemToOutput(item *itemmodels.GiftItem) *ItemOutput {
+	return &ItemOutput{Item: mapper.FromGiftItem(item)}
+}
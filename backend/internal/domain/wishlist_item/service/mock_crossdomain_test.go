@@ -246,6 +246,9 @@ func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservation(ctx context.C
 }
 
 // MarkManualReservationCalls gets all the calls that were made to MarkManualReservation.
+// Check the length with:
+//
+//	len(mockedGiftItemRepositoryInterface.MarkManualReservationCalls())
 func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservationCalls() []struct {
 	Ctx            context.Context
 	ItemID         pgtype.UUID
@@ -263,3 +266,159 @@ func (mock *GiftItemRepositoryInterfaceMock) MarkManualReservationCalls() []stru
 	mock.lockMarkManualReservation.RUnlock()
 	return calls
 }
+
+// Ensure, that QuotaCheckerInterfaceMock does implement QuotaCheckerInterface.
+// If this is not the case, regenerate this file with moq.
+var _ QuotaCheckerInterface = &QuotaCheckerInterfaceMock{}
+
+// QuotaCheckerInterfaceMock is a mock implementation of QuotaCheckerInterface.
+//
+//	func TestSomethingThatUsesQuotaCheckerInterface(t *testing.T) {
+//
+//		// make and configure a mocked QuotaCheckerInterface
+//		mockedQuotaCheckerInterface := &QuotaCheckerInterfaceMock{
+//			CheckItemQuotaFunc: func(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error {
+//				panic("mock out the CheckItemQuota method")
+//			},
+//		}
+//
+//		// use mockedQuotaCheckerInterface in code that requires QuotaCheckerInterface
+//		// and then make assertions.
+//
+//	}
+type QuotaCheckerInterfaceMock struct {
+	// CheckItemQuotaFunc mocks the CheckItemQuota method.
+	CheckItemQuotaFunc func(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckItemQuota holds details about calls to the CheckItemQuota method.
+		CheckItemQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID pgtype.UUID
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
+	}
+	lockCheckItemQuota sync.RWMutex
+}
+
+// CheckItemQuota calls CheckItemQuotaFunc.
+func (mock *QuotaCheckerInterfaceMock) CheckItemQuota(ctx context.Context, userID pgtype.UUID, wishlistID pgtype.UUID) error {
+	if mock.CheckItemQuotaFunc == nil {
+		panic("QuotaCheckerInterfaceMock.CheckItemQuotaFunc: method is nil but QuotaCheckerInterface.CheckItemQuota was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		UserID:     userID,
+		WishlistID: wishlistID,
+	}
+	mock.lockCheckItemQuota.Lock()
+	mock.calls.CheckItemQuota = append(mock.calls.CheckItemQuota, callInfo)
+	mock.lockCheckItemQuota.Unlock()
+	return mock.CheckItemQuotaFunc(ctx, userID, wishlistID)
+}
+
+// CheckItemQuotaCalls gets all the calls that were made to CheckItemQuota.
+// Check the length with:
+//
+//	len(mockedQuotaCheckerInterface.CheckItemQuotaCalls())
+func (mock *QuotaCheckerInterfaceMock) CheckItemQuotaCalls() []struct {
+	Ctx        context.Context
+	UserID     pgtype.UUID
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UserID     pgtype.UUID
+		WishlistID pgtype.UUID
+	}
+	mock.lockCheckItemQuota.RLock()
+	calls = mock.calls.CheckItemQuota
+	mock.lockCheckItemQuota.RUnlock()
+	return calls
+}
+
+// Ensure, that ContentModeratorInterfaceMock does implement ContentModeratorInterface.
+// If this is not the case, regenerate this file with moq.
+var _ ContentModeratorInterface = &ContentModeratorInterfaceMock{}
+
+// ContentModeratorInterfaceMock is a mock implementation of ContentModeratorInterface.
+//
+//	func TestSomethingThatUsesContentModeratorInterface(t *testing.T) {
+//
+//		// make and configure a mocked ContentModeratorInterface
+//		mockedContentModeratorInterface := &ContentModeratorInterfaceMock{
+//			CheckContentFunc: func(ctx context.Context, imageURL string, texts ...string) error {
+//				panic("mock out the CheckContent method")
+//			},
+//		}
+//
+//		// use mockedContentModeratorInterface in code that requires ContentModeratorInterface
+//		// and then make assertions.
+//
+//	}
+type ContentModeratorInterfaceMock struct {
+	// CheckContentFunc mocks the CheckContent method.
+	CheckContentFunc func(ctx context.Context, imageURL string, texts ...string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckContent holds details about calls to the CheckContent method.
+		CheckContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ImageURL is the imageURL argument value.
+			ImageURL string
+			// Texts is the texts argument value.
+			Texts []string
+		}
+	}
+	lockCheckContent sync.RWMutex
+}
+
+// CheckContent calls CheckContentFunc.
+func (mock *ContentModeratorInterfaceMock) CheckContent(ctx context.Context, imageURL string, texts ...string) error {
+	if mock.CheckContentFunc == nil {
+		panic("ContentModeratorInterfaceMock.CheckContentFunc: method is nil but ContentModeratorInterface.CheckContent was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}{
+		Ctx:      ctx,
+		ImageURL: imageURL,
+		Texts:    texts,
+	}
+	mock.lockCheckContent.Lock()
+	mock.calls.CheckContent = append(mock.calls.CheckContent, callInfo)
+	mock.lockCheckContent.Unlock()
+	return mock.CheckContentFunc(ctx, imageURL, texts...)
+}
+
+// CheckContentCalls gets all the calls that were made to CheckContent.
+// Check the length with:
+//
+//	len(mockedContentModeratorInterface.CheckContentCalls())
+func (mock *ContentModeratorInterfaceMock) CheckContentCalls() []struct {
+	Ctx      context.Context
+	ImageURL string
+	Texts    []string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ImageURL string
+		Texts    []string
+	}
+	mock.lockCheckContent.RLock()
+	calls = mock.calls.CheckContent
+	mock.lockCheckContent.RUnlock()
+	return calls
+}
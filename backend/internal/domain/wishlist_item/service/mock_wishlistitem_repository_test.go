@@ -42,6 +42,9 @@ var _ repository.WishlistItemRepositoryInterface = &WishlistItemRepositoryInterf
 //			IsAttachedFunc: func(ctx context.Context, wishlistID pgtype.UUID, itemID pgtype.UUID) (bool, error) {
 //				panic("mock out the IsAttached method")
 //			},
+//			ListItemRefsFunc: func(ctx context.Context, wishlistID pgtype.UUID) ([]repository.ItemRef, error) {
+//				panic("mock out the ListItemRefs method")
+//			},
 //		}
 //
 //		// use mockedWishlistItemRepositoryInterface in code that requires repository.WishlistItemRepositoryInterface
@@ -70,6 +73,9 @@ type WishlistItemRepositoryInterfaceMock struct {
 	// IsAttachedFunc mocks the IsAttached method.
 	IsAttachedFunc func(ctx context.Context, wishlistID pgtype.UUID, itemID pgtype.UUID) (bool, error)
 
+	// ListItemRefsFunc mocks the ListItemRefs method.
+	ListItemRefsFunc func(ctx context.Context, wishlistID pgtype.UUID) ([]repository.ItemRef, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Attach holds details about calls to the Attach method.
@@ -131,6 +137,13 @@ type WishlistItemRepositoryInterfaceMock struct {
 			// ItemID is the itemID argument value.
 			ItemID pgtype.UUID
 		}
+		// ListItemRefs holds details about calls to the ListItemRefs method.
+		ListItemRefs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WishlistID is the wishlistID argument value.
+			WishlistID pgtype.UUID
+		}
 	}
 	lockAttach              sync.RWMutex
 	lockDetach              sync.RWMutex
@@ -139,6 +152,7 @@ type WishlistItemRepositoryInterfaceMock struct {
 	lockGetByWishlistCount  sync.RWMutex
 	lockGetWishlistsForItem sync.RWMutex
 	lockIsAttached          sync.RWMutex
+	lockListItemRefs        sync.RWMutex
 }
 
 // Attach calls AttachFunc.
@@ -412,3 +426,39 @@ func (mock *WishlistItemRepositoryInterfaceMock) IsAttachedCalls() []struct {
 	mock.lockIsAttached.RUnlock()
 	return calls
 }
+
+// ListItemRefs calls ListItemRefsFunc.
+func (mock *WishlistItemRepositoryInterfaceMock) ListItemRefs(ctx context.Context, wishlistID pgtype.UUID) ([]repository.ItemRef, error) {
+	if mock.ListItemRefsFunc == nil {
+		panic("WishlistItemRepositoryInterfaceMock.ListItemRefsFunc: method is nil but WishlistItemRepositoryInterface.ListItemRefs was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}{
+		Ctx:        ctx,
+		WishlistID: wishlistID,
+	}
+	mock.lockListItemRefs.Lock()
+	mock.calls.ListItemRefs = append(mock.calls.ListItemRefs, callInfo)
+	mock.lockListItemRefs.Unlock()
+	return mock.ListItemRefsFunc(ctx, wishlistID)
+}
+
+// ListItemRefsCalls gets all the calls that were made to ListItemRefs.
+// Check the length with:
+//
+//	len(mockedWishlistItemRepositoryInterface.ListItemRefsCalls())
+func (mock *WishlistItemRepositoryInterfaceMock) ListItemRefsCalls() []struct {
+	Ctx        context.Context
+	WishlistID pgtype.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WishlistID pgtype.UUID
+	}
+	mock.lockListItemRefs.RLock()
+	calls = mock.calls.ListItemRefs
+	mock.lockListItemRefs.RUnlock()
+	return calls
+}
@@ -1,4 +1,4 @@
-//go:generate go run github.com/matryer/moq@latest -out mock_crossdomain_test.go -pkg service . WishListRepositoryInterface GiftItemRepositoryInterface
+//go:generate go run github.com/matryer/moq@latest -out mock_crossdomain_test.go -pkg service . WishListRepositoryInterface GiftItemRepositoryInterface QuotaCheckerInterface ContentModeratorInterface
 
 package service
 
@@ -7,32 +7,61 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
+	"wish-list/internal/domain/item/mapper"
 	itemmodels "wish-list/internal/domain/item/models"
 	itemrepository "wish-list/internal/domain/item/repository"
 	wishlistmodels "wish-list/internal/domain/wishlist/models"
 	"wish-list/internal/domain/wishlist_item/repository"
+	"wish-list/internal/pkg/priority"
+	"wish-list/internal/pkg/similarity"
+	"wish-list/internal/pkg/variant"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // Sentinel errors for wishlist-item operations
 var (
-	ErrItemAlreadyAttached       = errors.New("item already attached to this wishlist")
-	ErrItemNotInWishlist         = errors.New("item not found in this wishlist")
-	ErrInvalidWishlistItemWLID   = errors.New("invalid wishlist id")
-	ErrInvalidWishlistItemID     = errors.New("invalid item id")
-	ErrInvalidWishlistItemUser   = errors.New("invalid user id")
-	ErrWishlistItemTitleRequired = errors.New("title is required")
-	ErrWishListNotFound          = errors.New("wishlist not found")
-	ErrWishListForbidden         = errors.New("not authorized to access this wishlist")
-	ErrItemNotFound              = errors.New("item not found")
-	ErrItemForbidden             = errors.New("not authorized to access this item")
-	ErrManualReservedNameEmpty   = errors.New("reserved_by_name is required")
-	ErrItemNotAvailable          = errors.New("item is already reserved or purchased")
+	ErrItemAlreadyAttached        = errors.New("item already attached to this wishlist")
+	ErrItemNotInWishlist          = errors.New("item not found in this wishlist")
+	ErrInvalidWishlistItemWLID    = errors.New("invalid wishlist id")
+	ErrInvalidWishlistItemID      = errors.New("invalid item id")
+	ErrInvalidWishlistItemUser    = errors.New("invalid user id")
+	ErrWishlistItemTitleRequired  = errors.New("title is required")
+	ErrWishListNotFound           = errors.New("wishlist not found")
+	ErrWishListForbidden          = errors.New("not authorized to access this wishlist")
+	ErrItemNotFound               = errors.New("item not found")
+	ErrItemForbidden              = errors.New("not authorized to access this item")
+	ErrManualReservedNameEmpty    = errors.New("reserved_by_name is required")
+	ErrItemNotAvailable           = errors.New("item is already reserved or purchased")
+	ErrItemPriorityInvalid        = errors.New("priority must be must_have, nice_to_have or dream")
+	ErrItemOptionsInvalid         = variant.ErrInvalidOptions
+	ErrItemNotesVisibilityInvalid = errors.New("notes visibility must be private or public")
+	ErrDuplicateItem              = errors.New("a similar item is already in this wishlist")
 )
 
+// What a DuplicateItemError matched on
+const (
+	DuplicateMatchLink = "link"
+	DuplicateMatchName = "name"
+)
+
+// DuplicateItemError names the item a new one appears to duplicate, so the
+// owner can decide whether to add it anyway. It unwraps to ErrDuplicateItem.
+type DuplicateItemError struct {
+	ItemID    string
+	Name      string
+	MatchedOn string // DuplicateMatchLink or DuplicateMatchName
+}
+
+func (e *DuplicateItemError) Error() string {
+	return fmt.Sprintf("%s: %q matches on %s", ErrDuplicateItem, e.Name, e.MatchedOn)
+}
+
+func (e *DuplicateItemError) Unwrap() error {
+	return ErrDuplicateItem
+}
+
 // WishListRepositoryInterface defines what the wishlist_item service needs from wishlist repository (cross-domain)
 type WishListRepositoryInterface interface {
 	GetByID(ctx context.Context, id pgtype.UUID) (*wishlistmodels.WishList, error)
@@ -45,50 +74,41 @@ type GiftItemRepositoryInterface interface {
 	MarkManualReservation(ctx context.Context, itemID pgtype.UUID, reservedByName string, note *string) (*itemmodels.GiftItem, error)
 }
 
+// QuotaCheckerInterface defines what the wishlist_item service needs from the quota service (cross-domain).
+// A failed check returns the quota domain's QuotaExceededError, passed through unchanged.
+type QuotaCheckerInterface interface {
+	CheckItemQuota(ctx context.Context, userID, wishlistID pgtype.UUID) error
+}
+
+// ContentModeratorInterface defines what the wishlist_item service needs from the moderation service (cross-domain).
+// Rejected content fails with the moderation domain's ContentRejectedError, returned unchanged.
+type ContentModeratorInterface interface {
+	CheckContent(ctx context.Context, imageURL string, texts ...string) error
+}
+
 // Input/Output types
 
 // CreateItemInput represents input for creating an item in a wishlist
 type CreateItemInput struct {
-	Title       string
-	Description *string
-	Link        *string
-	ImageURL    *string
-	Price       *float64
-	Priority    *int32
-	Notes       *string
+	Title           string
+	Description     *string
+	Link            *string
+	ImageURL        *string
+	Price           *float64
+	Priority        *int32  // Legacy 0-10 number
+	PriorityLevel   *string // Priority level, set together with Priority; nil derives it from Priority
+	Notes           *string
+	NotesVisibility *string // private or public; nil keeps the notes private
+	Quantity        *int32  // Units wanted; nil means 1
+	Options         variant.Options
+	Category        *string // Section on the share page
+	// AllowDuplicate adds the item even when a similar one is already in the wishlist
+	AllowDuplicate bool
 }
 
 // ItemOutput represents an item in service responses
 type ItemOutput struct {
-	ID                    string
-	OwnerID               string
-	Name                  string
-	Description           string
-	Link                  string
-	ImageURL              string
-	Price                 float64
-	Priority              int
-	Notes                 string
-	IsPurchased           bool
-	IsReserved            bool
-	IsManuallyReserved    bool
-	ManualReservedByName  string
-	ManualReservationNote string
-	IsArchived            bool
-	CreatedAt             string
-	UpdatedAt             string
-}
-
-func isItemReserved(item *itemmodels.GiftItem) bool {
-	if item == nil {
-		return false
-	}
-
-	if item.PurchasedByUserID.Valid || item.PurchasedAt.Valid {
-		return false
-	}
-
-	return item.ReservedByUserID.Valid || item.ReservedAt.Valid || item.ManualReservedByName.Valid
+	mapper.Item
 }
 
 // PaginatedItemsOutput represents paginated list of items
@@ -114,18 +134,26 @@ type WishlistItemService struct {
 	wishlistRepo     WishListRepositoryInterface
 	itemRepo         GiftItemRepositoryInterface
 	wishlistItemRepo repository.WishlistItemRepositoryInterface
+	quota            QuotaCheckerInterface
+	moderator        ContentModeratorInterface
 }
 
-// NewWishlistItemService creates a new WishlistItemService
+// NewWishlistItemService creates a new WishlistItemService.
+// quotaChecker may be nil, in which case wishlists are not limited;
+// moderator may be nil, in which case item content is not screened.
 func NewWishlistItemService(
 	wishlistRepo WishListRepositoryInterface,
 	itemRepo GiftItemRepositoryInterface,
 	wishlistItemRepo repository.WishlistItemRepositoryInterface,
+	quotaChecker QuotaCheckerInterface,
+	moderator ContentModeratorInterface,
 ) *WishlistItemService {
 	return &WishlistItemService{
 		wishlistRepo:     wishlistRepo,
 		itemRepo:         itemRepo,
 		wishlistItemRepo: wishlistItemRepo,
+		quota:            quotaChecker,
+		moderator:        moderator,
 	}
 }
 
@@ -179,7 +207,7 @@ func (s *WishlistItemService) GetWishlistItems(ctx context.Context, wishlistID,
 	// Convert to output
 	outputs := make([]*ItemOutput, 0, len(items))
 	for _, item := range items {
-		outputs = append(outputs, s.convertItemToOutput(item))
+		outputs = append(outputs, itemToOutput(item))
 	}
 
 	totalPages := int((totalCount + int64(limit) - 1) / int64(limit))
@@ -243,6 +271,10 @@ func (s *WishlistItemService) AttachItem(ctx context.Context, wishlistID, itemID
 		return ErrItemAlreadyAttached
 	}
 
+	if err := s.checkItemQuota(ctx, wishlist.OwnerID, wlID); err != nil {
+		return err
+	}
+
 	// Attach
 	if err := s.wishlistItemRepo.Attach(ctx, wlID, itID); err != nil {
 		return fmt.Errorf("failed to attach item: %w", err)
@@ -280,6 +312,20 @@ func (s *WishlistItemService) CreateItemInWishlist(ctx context.Context, wishlist
 		return nil, ErrWishListForbidden
 	}
 
+	if err := s.checkItemQuota(ctx, wishlist.OwnerID, wlID); err != nil {
+		return nil, err
+	}
+
+	if !input.AllowDuplicate {
+		link := ""
+		if input.Link != nil {
+			link = *input.Link
+		}
+		if err := s.checkDuplicate(ctx, wlID, input.Title, link); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create item model
 	item := itemmodels.GiftItem{
 		OwnerID: ownerID,
@@ -296,11 +342,47 @@ func (s *WishlistItemService) CreateItemInWishlist(ctx context.Context, wishlist
 		item.ImageUrl = pgtype.Text{String: *input.ImageURL, Valid: true}
 	}
 	if input.Priority != nil {
-		item.Priority = pgtype.Int4{Int32: *input.Priority, Valid: true}
+		level := ""
+		if input.PriorityLevel != nil {
+			if _, err := priority.Parse(*input.PriorityLevel); err != nil {
+				return nil, ErrItemPriorityInvalid
+			}
+			level = *input.PriorityLevel
+		}
+		item.SetPriority(level, *input.Priority)
 	}
 	if input.Notes != nil && *input.Notes != "" {
 		item.Notes = pgtype.Text{String: *input.Notes, Valid: true}
 	}
+	if input.NotesVisibility != nil {
+		switch *input.NotesVisibility {
+		case itemmodels.NotesVisibilityPrivate, itemmodels.NotesVisibilityPublic:
+			item.NotesVisibility = *input.NotesVisibility
+		default:
+			return nil, ErrItemNotesVisibilityInvalid
+		}
+	}
+	if input.Quantity != nil {
+		item.Quantity = *input.Quantity
+	}
+	if input.Category != nil {
+		if category := strings.TrimSpace(*input.Category); category != "" {
+			item.Category = pgtype.Text{String: category, Valid: true}
+		}
+	}
+	if item.Options, err = input.Options.Normalize(); err != nil {
+		return nil, ErrItemOptionsInvalid
+	}
+
+	if s.moderator != nil {
+		texts := append([]string{item.Name, item.Description.String, item.Link.String}, item.Options.Values()...)
+		if item.Category.Valid {
+			texts = append(texts, item.Category.String)
+		}
+		if err := s.moderator.CheckContent(ctx, item.ImageUrl.String, texts...); err != nil {
+			return nil, err
+		}
+	}
 
 	// Set price if provided
 	if input.Price != nil && *input.Price > 0 {
@@ -322,7 +404,39 @@ func (s *WishlistItemService) CreateItemInWishlist(ctx context.Context, wishlist
 		return nil, fmt.Errorf("failed to attach item to wishlist: %w", err)
 	}
 
-	return s.convertItemToOutput(createdItem), nil
+	return itemToOutput(createdItem), nil
+}
+
+// checkItemQuota fails when the owner's plan allows no more items on the wishlist
+func (s *WishlistItemService) checkItemQuota(ctx context.Context, ownerID, wishlistID pgtype.UUID) error {
+	if s.quota == nil {
+		return nil
+	}
+	return s.quota.CheckItemQuota(ctx, ownerID, wishlistID)
+}
+
+// checkDuplicate fails with a DuplicateItemError when the wishlist already has
+// an item with the same link or a closely matching name. A link match wins, as
+// it is the stronger signal.
+func (s *WishlistItemService) checkDuplicate(ctx context.Context, wishlistID pgtype.UUID, name, link string) error {
+	refs, err := s.wishlistItemRepo.ListItemRefs(ctx, wishlistID)
+	if err != nil {
+		return fmt.Errorf("failed to check for duplicate items: %w", err)
+	}
+
+	var nameMatch *DuplicateItemError
+	for _, ref := range refs {
+		if link != "" && similarity.SameLink(link, ref.Link.String) {
+			return &DuplicateItemError{ItemID: ref.ID.String(), Name: ref.Name, MatchedOn: DuplicateMatchLink}
+		}
+		if nameMatch == nil && similarity.Names(name, ref.Name) >= similarity.NameThreshold {
+			nameMatch = &DuplicateItemError{ItemID: ref.ID.String(), Name: ref.Name, MatchedOn: DuplicateMatchName}
+		}
+	}
+	if nameMatch != nil {
+		return nameMatch
+	}
+	return nil
 }
 
 // DetachItem removes an item from a wishlist (doesn't delete the item)
@@ -372,57 +486,6 @@ func (s *WishlistItemService) DetachItem(ctx context.Context, wishlistID, itemID
 	return nil
 }
 
-// Helper to convert itemmodels.GiftItem to ItemOutput
-func (s *WishlistItemService) convertItemToOutput(item *itemmodels.GiftItem) *ItemOutput {
-	output := &ItemOutput{
-		ID:                 item.ID.String(),
-		OwnerID:            item.OwnerID.String(),
-		Name:               item.Name,
-		Description:        "",
-		Link:               "",
-		ImageURL:           "",
-		Price:              0,
-		Priority:           0,
-		Notes:              "",
-		IsPurchased:        item.PurchasedByUserID.Valid,
-		IsReserved:         isItemReserved(item),
-		IsManuallyReserved: item.ManualReservedByName.Valid,
-		IsArchived:         item.ArchivedAt.Valid,
-		CreatedAt:          item.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:          item.UpdatedAt.Time.Format(time.RFC3339),
-	}
-
-	// Handle nullable fields
-	if item.Description.Valid {
-		output.Description = item.Description.String
-	}
-	if item.Link.Valid {
-		output.Link = item.Link.String
-	}
-	if item.ImageUrl.Valid {
-		output.ImageURL = item.ImageUrl.String
-	}
-	if item.Price.Valid {
-		if priceValue, err := item.Price.Float64Value(); err == nil && priceValue.Valid {
-			output.Price = priceValue.Float64
-		}
-	}
-	if item.Priority.Valid {
-		output.Priority = int(item.Priority.Int32)
-	}
-	if item.Notes.Valid {
-		output.Notes = item.Notes.String
-	}
-	if item.ManualReservedByName.Valid {
-		output.ManualReservedByName = item.ManualReservedByName.String
-	}
-	if item.ManualReservationNote.Valid {
-		output.ManualReservationNote = item.ManualReservationNote.String
-	}
-
-	return output
-}
-
 // MarkManualReservation marks an item as reserved by someone specified by the wishlist owner.
 // This is for offline reservations (e.g., "Grandma said she'll buy the bicycle").
 func (s *WishlistItemService) MarkManualReservation(ctx context.Context, wishlistID, itemID, userID string, reservedByName string, note *string) (*ItemOutput, error) {
@@ -480,5 +543,5 @@ func (s *WishlistItemService) MarkManualReservation(ctx context.Context, wishlis
 		return nil, fmt.Errorf("failed to mark manual reservation: %w", err)
 	}
 
-	return s.convertItemToOutput(updated), nil
+	return itemToOutput(updated), nil
 }
@@ -8,7 +8,9 @@ import (
 
 	itemmodels "wish-list/internal/domain/item/models"
 	itemrepository "wish-list/internal/domain/item/repository"
+	quotaservice "wish-list/internal/domain/quota/service"
 	wishlistmodels "wish-list/internal/domain/wishlist/models"
+	"wish-list/internal/domain/wishlist_item/repository"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -57,13 +59,18 @@ func makeGiftItemWI(t *testing.T, itemID, ownerID uuid.UUID) *itemmodels.GiftIte
 	}
 }
 
+// noItemRefs stands in for ListItemRefs of a wishlist with nothing to duplicate.
+func noItemRefs(_ context.Context, _ pgtype.UUID) ([]repository.ItemRef, error) {
+	return nil, nil
+}
+
 // newTestService creates a WishlistItemService wired to the provided moq mocks.
 func newTestService(
 	wlRepo *WishListRepositoryInterfaceMock,
 	itemRepo *GiftItemRepositoryInterfaceMock,
 	wiRepo *WishlistItemRepositoryInterfaceMock,
 ) *WishlistItemService {
-	return NewWishlistItemService(wlRepo, itemRepo, wiRepo)
+	return NewWishlistItemService(wlRepo, itemRepo, wiRepo, nil, nil)
 }
 
 // ============================================================
@@ -644,6 +651,7 @@ func TestCreateItemInWishlist_Success(t *testing.T) {
 		},
 	}
 	wiRepo := &WishlistItemRepositoryInterfaceMock{
+		ListItemRefsFunc: noItemRefs,
 		AttachFunc: func(_ context.Context, _, _ pgtype.UUID) error {
 			return nil
 		},
@@ -699,6 +707,7 @@ func TestCreateItemInWishlist_Success_WithAllFields(t *testing.T) {
 		},
 	}
 	wiRepo := &WishlistItemRepositoryInterfaceMock{
+		ListItemRefsFunc: noItemRefs,
 		AttachFunc: func(_ context.Context, _, _ pgtype.UUID) error {
 			return nil
 		},
@@ -833,7 +842,7 @@ func TestCreateItemInWishlist_CreateItemRepoError(t *testing.T) {
 		},
 	}
 
-	svc := newTestService(wlRepo, itemRepo, &WishlistItemRepositoryInterfaceMock{})
+	svc := newTestService(wlRepo, itemRepo, &WishlistItemRepositoryInterfaceMock{ListItemRefsFunc: noItemRefs})
 
 	input := CreateItemInput{Title: "Some Item"}
 	result, err := svc.CreateItemInWishlist(context.Background(), wlID.String(), ownerID.String(), input)
@@ -843,6 +852,70 @@ func TestCreateItemInWishlist_CreateItemRepoError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to create item")
 }
 
+func TestCreateItemInWishlist_QuotaExceeded(t *testing.T) {
+	ownerID := uuid.New()
+	wlID := uuid.New()
+
+	wishlist := makeWishlistWI(t, wlID, ownerID, false)
+
+	wlRepo := &WishListRepositoryInterfaceMock{
+		GetByIDFunc: func(_ context.Context, _ pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return wishlist, nil
+		},
+	}
+	itemRepo := &GiftItemRepositoryInterfaceMock{}
+	quota := &QuotaCheckerInterfaceMock{
+		CheckItemQuotaFunc: func(_ context.Context, _, _ pgtype.UUID) error {
+			return &quotaservice.QuotaExceededError{Resource: "wishlist_items", Plan: "free", Limit: 50}
+		},
+	}
+
+	svc := NewWishlistItemService(wlRepo, itemRepo, &WishlistItemRepositoryInterfaceMock{}, quota, nil)
+
+	input := CreateItemInput{Title: "Item"}
+	result, err := svc.CreateItemInWishlist(context.Background(), wlID.String(), ownerID.String(), input)
+
+	require.ErrorIs(t, err, quotaservice.ErrQuotaExceeded)
+	assert.Nil(t, result)
+	assert.Empty(t, itemRepo.CreateWithOwnerCalls())
+	require.Len(t, quota.CheckItemQuotaCalls(), 1)
+	assert.Equal(t, wishlist.ID, quota.CheckItemQuotaCalls()[0].WishlistID)
+}
+
+func TestCreateItemInWishlist_ContentRejected(t *testing.T) {
+	ownerID := uuid.New()
+	wlID := uuid.New()
+
+	wishlist := makeWishlistWI(t, wlID, ownerID, false)
+
+	wlRepo := &WishListRepositoryInterfaceMock{
+		GetByIDFunc: func(_ context.Context, _ pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return wishlist, nil
+		},
+	}
+	itemRepo := &GiftItemRepositoryInterfaceMock{}
+	errRejected := errors.New("content rejected")
+	moderator := &ContentModeratorInterfaceMock{
+		CheckContentFunc: func(_ context.Context, _ string, _ ...string) error {
+			return errRejected
+		},
+	}
+
+	svc := NewWishlistItemService(wlRepo, itemRepo, &WishlistItemRepositoryInterfaceMock{ListItemRefsFunc: noItemRefs}, nil, moderator)
+
+	link := "https://shop.example/scarf"
+	image := "https://shop.example/scarf.jpg"
+	input := CreateItemInput{Title: "Scarf", Link: &link, ImageURL: &image}
+	result, err := svc.CreateItemInWishlist(context.Background(), wlID.String(), ownerID.String(), input)
+
+	require.ErrorIs(t, err, errRejected)
+	assert.Nil(t, result)
+	assert.Empty(t, itemRepo.CreateWithOwnerCalls())
+	require.Len(t, moderator.CheckContentCalls(), 1)
+	assert.Equal(t, image, moderator.CheckContentCalls()[0].ImageURL)
+	assert.Equal(t, []string{"Scarf", "", link}, moderator.CheckContentCalls()[0].Texts)
+}
+
 func TestCreateItemInWishlist_AttachRepoError(t *testing.T) {
 	ownerID := uuid.New()
 	wlID := uuid.New()
@@ -869,6 +942,7 @@ func TestCreateItemInWishlist_AttachRepoError(t *testing.T) {
 		},
 	}
 	wiRepo := &WishlistItemRepositoryInterfaceMock{
+		ListItemRefsFunc: noItemRefs,
 		AttachFunc: func(_ context.Context, _, _ pgtype.UUID) error {
 			return errors.New("attach failed")
 		},
@@ -884,6 +958,98 @@ func TestCreateItemInWishlist_AttachRepoError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to attach item to wishlist")
 }
 
+func TestCreateItemInWishlist_Duplicate(t *testing.T) {
+	ownerID := uuid.New()
+	wlID := uuid.New()
+	existingID := uuid.New()
+
+	wishlist := makeWishlistWI(t, wlID, ownerID, false)
+	refs := []repository.ItemRef{
+		{ID: uuidToPg(t, uuid.New()), Name: "Kindle Paperwhite", Link: pgtype.Text{String: "https://shop.example/kindle", Valid: true}},
+		{ID: uuidToPg(t, existingID), Name: "Noise cancelling headphones", Link: pgtype.Text{String: "https://www.shop.example/p/42?utm_source=mail", Valid: true}},
+	}
+
+	tests := []struct {
+		name      string
+		input     CreateItemInput
+		wantID    uuid.UUID
+		wantMatch string
+	}{
+		{"same link", CreateItemInput{Title: "Headphones", Link: strPtr("http://shop.example/p/42/")}, existingID, DuplicateMatchLink},
+		{"similar name", CreateItemInput{Title: "noise-cancelling headphones"}, existingID, DuplicateMatchName},
+		{"link wins over name", CreateItemInput{Title: "Kindle Paperwhite", Link: strPtr("https://shop.example/p/42")}, existingID, DuplicateMatchLink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wlRepo := &WishListRepositoryInterfaceMock{
+				GetByIDFunc: func(_ context.Context, _ pgtype.UUID) (*wishlistmodels.WishList, error) {
+					return wishlist, nil
+				},
+			}
+			itemRepo := &GiftItemRepositoryInterfaceMock{}
+			wiRepo := &WishlistItemRepositoryInterfaceMock{
+				ListItemRefsFunc: func(_ context.Context, _ pgtype.UUID) ([]repository.ItemRef, error) {
+					return refs, nil
+				},
+			}
+
+			svc := newTestService(wlRepo, itemRepo, wiRepo)
+			result, err := svc.CreateItemInWishlist(context.Background(), wlID.String(), ownerID.String(), tt.input)
+
+			require.ErrorIs(t, err, ErrDuplicateItem)
+			assert.Nil(t, result)
+			assert.Empty(t, itemRepo.CreateWithOwnerCalls())
+
+			var duplicateErr *DuplicateItemError
+			require.ErrorAs(t, err, &duplicateErr)
+			assert.Equal(t, tt.wantID.String(), duplicateErr.ItemID)
+			assert.Equal(t, tt.wantMatch, duplicateErr.MatchedOn)
+		})
+	}
+}
+
+func TestCreateItemInWishlist_AllowDuplicate(t *testing.T) {
+	ownerID := uuid.New()
+	wlID := uuid.New()
+
+	wishlist := makeWishlistWI(t, wlID, ownerID, false)
+	now := time.Now()
+	createdItem := &itemmodels.GiftItem{
+		ID:        uuidToPg(t, uuid.New()),
+		OwnerID:   uuidToPg(t, ownerID),
+		Name:      "Kindle Paperwhite",
+		CreatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+	}
+
+	wlRepo := &WishListRepositoryInterfaceMock{
+		GetByIDFunc: func(_ context.Context, _ pgtype.UUID) (*wishlistmodels.WishList, error) {
+			return wishlist, nil
+		},
+	}
+	itemRepo := &GiftItemRepositoryInterfaceMock{
+		CreateWithOwnerFunc: func(_ context.Context, _ itemmodels.GiftItem) (*itemmodels.GiftItem, error) {
+			return createdItem, nil
+		},
+	}
+	wiRepo := &WishlistItemRepositoryInterfaceMock{
+		AttachFunc: func(_ context.Context, _, _ pgtype.UUID) error {
+			return nil
+		},
+	}
+
+	svc := newTestService(wlRepo, itemRepo, wiRepo)
+
+	input := CreateItemInput{Title: "Kindle Paperwhite", AllowDuplicate: true}
+	result, err := svc.CreateItemInWishlist(context.Background(), wlID.String(), ownerID.String(), input)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Empty(t, wiRepo.ListItemRefsCalls(), "the check is skipped entirely")
+	assert.Len(t, itemRepo.CreateWithOwnerCalls(), 1)
+}
+
 // ============================================================
 // DetachItem
 // ============================================================
@@ -1137,6 +1303,7 @@ func TestCreateItemInWishlist_NoPriceSet(t *testing.T) {
 		},
 	}
 	wiRepo := &WishlistItemRepositoryInterfaceMock{
+		ListItemRefsFunc: noItemRefs,
 		AttachFunc: func(_ context.Context, _, _ pgtype.UUID) error {
 			return nil
 		},
@@ -0,0 +1,43 @@
+package abuse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPReputation(t *testing.T) {
+	_, err := NewIPReputation([]string{" 203.0.113.0/24 ", "", "198.51.100.7", "2001:db8::/32"})
+	require.NoError(t, err)
+
+	for _, entry := range []string{"example.com", "203.0.113.0/33", "198.51.100"} {
+		_, err := NewIPReputation([]string{entry})
+		assert.ErrorIs(t, err, ErrInvalidRange, entry)
+	}
+}
+
+func TestIPReputation_Listed(t *testing.T) {
+	reputation, err := NewIPReputation([]string{"203.0.113.0/24", "198.51.100.7", "2001:db8::/32"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"203.0.113.42", true},
+		{"198.51.100.7", true},
+		{"198.51.100.8", false},
+		{"::ffff:203.0.113.1", true},
+		{"2001:db8::1", true},
+		{"192.0.2.1", false},
+		{"not-an-ip", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, reputation.Listed(tt.ip), tt.ip)
+	}
+
+	var none *IPReputation
+	assert.False(t, none.Listed("203.0.113.42"))
+}
@@ -0,0 +1,125 @@
+// Package affiliate decorates outbound product links with affiliate tags.
+//
+// Rules are configured per merchant domain and add (or replace) one query
+// parameter on links to that domain or any of its subdomains. Links to
+// merchants without a rule are returned unchanged.
+//
+// Usage:
+//
+//	rules, err := affiliate.ParseRules([]string{"amazon.com=tag:wishlist-20"})
+//	decorator := affiliate.NewDecorator(rules)
+//	link := decorator.Decorate("https://www.amazon.com/dp/B0001")
+//	// https://www.amazon.com/dp/B0001?tag=wishlist-20
+package affiliate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var ErrInvalidRule = errors.New("affiliate rule must have the form domain=param:value")
+
+// Rule adds Param=Value to links pointing at Domain or one of its subdomains
+type Rule struct {
+	Domain string
+	Param  string
+	Value  string
+}
+
+// ParseRules parses rules of the form "domain=param:value", e.g.
+// "amazon.com=tag:wishlist-20". Empty entries are skipped.
+func ParseRules(entries []string) ([]Rule, error) {
+	rules := make([]Rule, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		domain, tag, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, entry)
+		}
+		param, value, ok := strings.Cut(tag, ":")
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+		param = strings.TrimSpace(param)
+		value = strings.TrimSpace(value)
+		if !ok || domain == "" || param == "" || value == "" || strings.ContainsAny(domain, "/:") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, entry)
+		}
+
+		rules = append(rules, Rule{Domain: domain, Param: param, Value: value})
+	}
+	return rules, nil
+}
+
+// Decorator rewrites links according to its rules. A nil Decorator leaves
+// every link unchanged.
+type Decorator struct {
+	rules []Rule
+}
+
+// NewDecorator creates a decorator. When several rules match a host the most
+// specific (longest) domain wins.
+func NewDecorator(rules []Rule) *Decorator {
+	return &Decorator{rules: rules}
+}
+
+// Decorate returns link with the matching merchant's affiliate parameter set.
+// Links that are not absolute http(s) URLs or have no matching rule are
+// returned as is.
+func (d *Decorator) Decorate(link string) string {
+	if d == nil || len(d.rules) == 0 || link == "" {
+		return link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return link
+	}
+
+	rule, ok := d.match(u.Hostname())
+	if !ok {
+		return link
+	}
+
+	query := u.Query()
+	query.Set(rule.Param, rule.Value)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// Merchant returns the domain of the rule matching link, or the link's host
+// when no rule matches. It is used to attribute clicks to a merchant.
+func (d *Decorator) Merchant(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	if d != nil {
+		if rule, ok := d.match(host); ok {
+			return rule.Domain
+		}
+	}
+	return strings.TrimPrefix(host, "www.")
+}
+
+func (d *Decorator) match(host string) (Rule, bool) {
+	host = strings.ToLower(host)
+
+	var best Rule
+	found := false
+	for _, rule := range d.rules {
+		if host != rule.Domain && !strings.HasSuffix(host, "."+rule.Domain) {
+			continue
+		}
+		if !found || len(rule.Domain) > len(best.Domain) {
+			best = rule
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,61 @@
+package affiliate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]string{" amazon.com=tag:wishlist-20 ", "", "WWW.eBay.com=campid:5338"})
+	require.NoError(t, err)
+	assert.Equal(t, []Rule{
+		{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"},
+		{Domain: "ebay.com", Param: "campid", Value: "5338"},
+	}, rules)
+
+	for _, entry := range []string{"amazon.com", "amazon.com=tag", "=tag:x", "amazon.com=:x", "amazon.com=tag:", "https://amazon.com=tag:x"} {
+		_, err := ParseRules([]string{entry})
+		assert.ErrorIs(t, err, ErrInvalidRule, entry)
+	}
+}
+
+func TestDecorator_Decorate(t *testing.T) {
+	decorator := NewDecorator([]Rule{
+		{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"},
+		{Domain: "smile.amazon.com", Param: "tag", Value: "smile-20"},
+	})
+
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"matching domain", "https://amazon.com/dp/B0001", "https://amazon.com/dp/B0001?tag=wishlist-20"},
+		{"subdomain", "https://www.amazon.com/dp/B0001?th=1", "https://www.amazon.com/dp/B0001?tag=wishlist-20&th=1"},
+		{"replaces existing tag", "https://www.amazon.com/dp/B0001?tag=someone-else", "https://www.amazon.com/dp/B0001?tag=wishlist-20"},
+		{"most specific rule wins", "https://smile.amazon.com/dp/B0001", "https://smile.amazon.com/dp/B0001?tag=smile-20"},
+		{"lookalike domain", "https://notamazon.com/dp/B0001", "https://notamazon.com/dp/B0001"},
+		{"other merchant", "https://shop.example.com/p/1", "https://shop.example.com/p/1"},
+		{"not http", "javascript:alert(1)", "javascript:alert(1)"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, decorator.Decorate(tt.link))
+		})
+	}
+
+	var none *Decorator
+	assert.Equal(t, "https://amazon.com/dp/B0001", none.Decorate("https://amazon.com/dp/B0001"))
+}
+
+func TestDecorator_Merchant(t *testing.T) {
+	decorator := NewDecorator([]Rule{{Domain: "amazon.com", Param: "tag", Value: "wishlist-20"}})
+
+	assert.Equal(t, "amazon.com", decorator.Merchant("https://www.amazon.com/dp/B0001"))
+	assert.Equal(t, "example.com", decorator.Merchant("https://WWW.Example.com/p/1"))
+	assert.Equal(t, "example.com", (*Decorator)(nil).Merchant("https://example.com/p/1"))
+}
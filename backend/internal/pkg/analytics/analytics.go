@@ -2,9 +2,9 @@ package analytics
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"time"
+
+	"wish-list/internal/pkg/logger"
 )
 
 // Event types for tracking user engagement
@@ -19,6 +19,7 @@ const (
 	EventGiftItemPurchased   = "gift_item_purchased"
 	EventReservationCanceled = "reservation_canceled"
 	EventAccountDeleted      = "account_deleted"
+	EventItemLinkClicked     = "item_link_clicked"
 )
 
 // Event represents an analytics event
@@ -60,11 +61,10 @@ func (s *AnalyticsService) Track(ctx context.Context, event Event) error {
 
 	// In production, this would send to analytics service
 	// Note: Do not log Properties as they may contain PII
-	log.Printf("[ANALYTICS] Event: %s, UserID: %s, Time: %s",
-		event.EventType,
-		event.UserID,
-		// event.Properties,
-		event.Timestamp.Format(time.RFC3339))
+	logger.InfoContext(ctx, "analytics event",
+		"event_type", event.EventType,
+		"user_id", event.UserID,
+		"timestamp", event.Timestamp.Format(time.RFC3339))
 
 	return nil
 }
@@ -195,6 +195,19 @@ func (s *AnalyticsService) TrackAccountDeleted(ctx context.Context, userID, reas
 	})
 }
 
+// TrackItemLinkClicked tracks a click-through from a public page to a merchant
+func (s *AnalyticsService) TrackItemLinkClicked(ctx context.Context, userID, giftItemID, merchant string, affiliated bool) error {
+	return s.Track(ctx, Event{
+		EventType: EventItemLinkClicked,
+		UserID:    userID,
+		Properties: map[string]any{
+			"gift_item_id": giftItemID,
+			"merchant":     merchant,
+			"affiliated":   affiliated,
+		},
+	})
+}
+
 // GetEngagementMetrics would return aggregated engagement metrics
 // In production, this would query the analytics backend
 func (s *AnalyticsService) GetEngagementMetrics(ctx context.Context, startDate, endDate time.Time) (map[string]any, error) {
@@ -227,7 +240,7 @@ func (s *AnalyticsService) BatchTrack(ctx context.Context, events []Event) error
 	for _, event := range events {
 		if err := s.Track(ctx, event); err != nil {
 			// Log error but continue processing other events
-			fmt.Printf("Error tracking event %s: %v\n", event.EventType, err)
+			logger.WarnContext(ctx, "failed to track analytics event", "event_type", event.EventType, "error", err)
 		}
 	}
 
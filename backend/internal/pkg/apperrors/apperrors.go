@@ -68,6 +68,16 @@ func (e *AppError) WithMessage(msg string) *AppError {
 	}
 }
 
+// WithDetails returns a copy carrying field-level details.
+func (e *AppError) WithDetails(details map[string]string) *AppError {
+	return &AppError{
+		Code:    e.Code,
+		Message: e.Message,
+		Details: details,
+		Err:     e.Err,
+	}
+}
+
 // --- Constructors ---
 
 // New creates an AppError with the given status code and message.
@@ -85,6 +95,11 @@ func Unauthorized(message string) *AppError {
 	return &AppError{Code: http.StatusUnauthorized, Message: message}
 }
 
+// PaymentRequired creates a 402 error.
+func PaymentRequired(message string) *AppError {
+	return &AppError{Code: http.StatusPaymentRequired, Message: message}
+}
+
 // Forbidden creates a 403 error.
 func Forbidden(message string) *AppError {
 	return &AppError{Code: http.StatusForbidden, Message: message}
@@ -100,6 +115,16 @@ func Conflict(message string) *AppError {
 	return &AppError{Code: http.StatusConflict, Message: message}
 }
 
+// PreconditionRequired creates a 428 error.
+func PreconditionRequired(message string) *AppError {
+	return &AppError{Code: http.StatusPreconditionRequired, Message: message}
+}
+
+// UnprocessableEntity creates a 422 error.
+func UnprocessableEntity(message string) *AppError {
+	return &AppError{Code: http.StatusUnprocessableEntity, Message: message}
+}
+
 // TooManyRequests creates a 429 error.
 func TooManyRequests(message string) *AppError {
 	return &AppError{Code: http.StatusTooManyRequests, Message: message}
@@ -115,6 +140,11 @@ func BadGateway(message string) *AppError {
 	return &AppError{Code: http.StatusBadGateway, Message: message}
 }
 
+// ServiceUnavailable creates a 503 error.
+func ServiceUnavailable(message string) *AppError {
+	return &AppError{Code: http.StatusServiceUnavailable, Message: message}
+}
+
 // NewValidationError creates a 400 error with field-level details.
 func NewValidationError(details map[string]string) *AppError {
 	return &AppError{
@@ -18,12 +18,16 @@ func TestConstructors(t *testing.T) {
 	}{
 		{"BadRequest", BadRequest, "bad input", http.StatusBadRequest},
 		{"Unauthorized", Unauthorized, "no token", http.StatusUnauthorized},
+		{"PaymentRequired", PaymentRequired, "upgrade", http.StatusPaymentRequired},
 		{"Forbidden", Forbidden, "access denied", http.StatusForbidden},
 		{"NotFound", NotFound, "not found", http.StatusNotFound},
 		{"Conflict", Conflict, "duplicate", http.StatusConflict},
+		{"UnprocessableEntity", UnprocessableEntity, "limit reached", http.StatusUnprocessableEntity},
+		{"PreconditionRequired", PreconditionRequired, "send If-Match", http.StatusPreconditionRequired},
 		{"TooManyRequests", TooManyRequests, "slow down", http.StatusTooManyRequests},
 		{"Internal", Internal, "oops", http.StatusInternalServerError},
 		{"BadGateway", BadGateway, "upstream", http.StatusBadGateway},
+		{"ServiceUnavailable", ServiceUnavailable, "not configured", http.StatusServiceUnavailable},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +111,17 @@ func TestWithMessage(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, custom.Code)
 }
 
+func TestWithDetails(t *testing.T) {
+	original := Conflict("Slug is taken")
+	detailed := original.WithDetails(map[string]string{"PublicSlug": "is already taken"})
+
+	assert.NotSame(t, original, detailed)
+	assert.Nil(t, original.Details)
+	assert.Equal(t, http.StatusConflict, detailed.Code)
+	assert.Equal(t, "Slug is taken", detailed.Message)
+	assert.Equal(t, map[string]string{"PublicSlug": "is already taken"}, detailed.Details)
+}
+
 func TestNewValidationError(t *testing.T) {
 	details := map[string]string{
 		"email":    "must be a valid email address",
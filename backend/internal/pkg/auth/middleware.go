@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
 
 	"github.com/labstack/echo/v4"
 )
@@ -28,14 +29,11 @@ func JWTMiddleware(tm *TokenManager) echo.MiddlewareFunc {
 
 			claims, err := tm.ValidateToken(tokenString)
 			if err != nil {
-				c.Logger().Warn("Token validation failed: ", err)
+				logger.WarnContext(c.Request().Context(), "token validation failed", "error", err)
 				return apperrors.Unauthorized("Invalid or expired token")
 			}
 
-			// Add claims to context
-			c.Set("user_id", claims.UserID)
-			c.Set("email", claims.Email)
-			c.Set("user_type", claims.UserType)
+			setClaims(c, claims)
 
 			return next(c)
 		}
@@ -68,10 +66,7 @@ func OptionalJWTMiddleware(tm *TokenManager) echo.MiddlewareFunc {
 				return next(c)
 			}
 
-			// Add claims to context
-			c.Set("user_id", claims.UserID)
-			c.Set("email", claims.Email)
-			c.Set("user_type", claims.UserType)
+			setClaims(c, claims)
 
 			return next(c)
 		}
@@ -131,3 +126,14 @@ func GetUserFromContext(c echo.Context) (userID, email, userType string, err err
 
 	return userID, email, userType, nil
 }
+
+// setClaims adds the token claims to the Echo context and the user ID to the
+// request-scoped logger
+func setClaims(c echo.Context, claims *Claims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("email", claims.Email)
+	c.Set("user_type", claims.UserType)
+
+	ctx := logger.WithContext(c.Request().Context(), "user_id", claims.UserID)
+	c.SetRequest(c.Request().WithContext(ctx))
+}
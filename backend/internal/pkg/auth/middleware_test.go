@@ -1,12 +1,16 @@
 package auth
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/logger"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +47,30 @@ func TestJWTMiddleware(t *testing.T) {
 	assert.Equal(t, "user", c.Get("user_type"))
 }
 
+func TestJWTMiddleware_AddsUserIDToLogger(t *testing.T) {
+	e := echo.New()
+	tm := NewTokenManager("test-secret")
+
+	tokenString, err := tm.GenerateAccessToken("user-123", "test@example.com", "user")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req = req.WithContext(logger.NewContext(req.Context(), slog.New(slog.NewJSONHandler(&buf, nil))))
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	handler := JWTMiddleware(tm)(func(c echo.Context) error {
+		logger.InfoContext(c.Request().Context(), "handled")
+		return nil
+	})
+	require.NoError(t, handler(c))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "user-123", entry["user_id"])
+}
+
 func TestJWTMiddlewareMissingHeader(t *testing.T) {
 	e := echo.New()
 	tm := NewTokenManager("test-secret")
@@ -1,8 +1,14 @@
 package auth
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,14 +18,16 @@ import (
 type Claims struct {
 	UserID   string `json:"user_id"`
 	Email    string `json:"email"`
-	UserType string `json:"user_type"`          // "user" or "guest"
+	UserType string `json:"user_type"`          // "user", "admin" or "guest"
 	TokenID  string `json:"token_id,omitempty"` // For refresh tokens only (enables rotation/blacklisting)
 	jwt.RegisteredClaims
 }
 
 // TokenManager handles JWT token operations
 type TokenManager struct {
-	secret []byte
+	mu       sync.RWMutex
+	secret   []byte
+	previous []byte // Secret before the last RotateSecret, still accepted by ValidateToken
 }
 
 // NewTokenManager creates a new TokenManager
@@ -29,6 +37,48 @@ func NewTokenManager(secret string) *TokenManager {
 	}
 }
 
+// RotateSecret signs new tokens with secret. Tokens signed with the replaced secret
+// stay valid until they expire, so a rotation does not log everyone out.
+func (tm *TokenManager) RotateSecret(secret string) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if string(tm.secret) == secret {
+		return
+	}
+	tm.previous = tm.secret
+	tm.secret = []byte(secret)
+}
+
+// signingKey returns the secret new tokens are signed with
+func (tm *TokenManager) signingKey() []byte {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.secret
+}
+
+// macKeys returns the secrets a signed link may be signed with, newest first
+func (tm *TokenManager) macKeys() [][]byte {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	if tm.previous == nil {
+		return [][]byte{tm.secret}
+	}
+	return [][]byte{tm.secret, tm.previous}
+}
+
+// verificationKeys returns the secrets a token may be signed with
+func (tm *TokenManager) verificationKeys() any {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	if tm.previous == nil {
+		return tm.secret
+	}
+	return jwt.VerificationKeySet{Keys: []jwt.VerificationKey{tm.secret, tm.previous}}
+}
+
 // GenerateGuestToken generates a JWT token for guest users
 func (tm *TokenManager) GenerateGuestToken(guestID, guestName, guestEmail string) (string, error) {
 	claims := Claims{
@@ -43,7 +93,7 @@ func (tm *TokenManager) GenerateGuestToken(guestID, guestName, guestEmail string
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(tm.secret)
+	signedToken, err := token.SignedString(tm.signingKey())
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -56,7 +106,7 @@ func (tm *TokenManager) ValidateToken(tokenString string) (*Claims, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
 		}
-		return tm.secret, nil
+		return tm.verificationKeys(), nil
 	})
 
 	if err != nil {
@@ -87,7 +137,7 @@ func (tm *TokenManager) GenerateAccessToken(userID, email, userType string) (str
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(tm.secret)
+	signedToken, err := token.SignedString(tm.signingKey())
 	if err != nil {
 		return "", fmt.Errorf("failed to sign access token: %w", err)
 	}
@@ -112,9 +162,87 @@ func (tm *TokenManager) GenerateRefreshToken(userID, email, userType, tokenID st
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(tm.secret)
+	signedToken, err := token.SignedString(tm.signingKey())
 	if err != nil {
 		return "", fmt.Errorf("failed to sign refresh token: %w", err)
 	}
 	return signedToken, nil
 }
+
+// ErrInvalidPurchaseToken is returned for a purchase token that is malformed,
+// expired or not signed with a current secret
+var ErrInvalidPurchaseToken = errors.New("invalid purchase token")
+
+// purchaseTokenPurpose keeps purchase token MACs apart from other uses of the secret
+const purchaseTokenPurpose = "reservation-purchase:"
+
+// GeneratePurchaseToken signs a link that lets a guest mark a reservation as
+// bought without signing in: "<reservation ID>.<expiry as unix seconds>.<hex
+// HMAC-SHA256>". It is not a JWT, so it can never pass as an access token.
+func (tm *TokenManager) GeneratePurchaseToken(reservationID string, expires time.Time) string {
+	payload := reservationID + "." + strconv.FormatInt(expires.Unix(), 10)
+	return payload + "." + linkMAC(tm.signingKey(), purchaseTokenPurpose, payload)
+}
+
+// ValidatePurchaseToken returns the reservation ID a purchase token was issued
+// for. Tokens signed before the last RotateSecret are still accepted.
+func (tm *TokenManager) ValidatePurchaseToken(token string) (string, error) {
+	sep := strings.LastIndex(token, ".")
+	if sep < 0 {
+		return "", ErrInvalidPurchaseToken
+	}
+	payload, mac := token[:sep], token[sep+1:]
+
+	reservationID, exp, ok := strings.Cut(payload, ".")
+	if !ok || reservationID == "" {
+		return "", ErrInvalidPurchaseToken
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || !time.Now().Before(time.Unix(unix, 0)) {
+		return "", ErrInvalidPurchaseToken
+	}
+
+	for _, key := range tm.macKeys() {
+		if hmac.Equal([]byte(mac), []byte(linkMAC(key, purchaseTokenPurpose, payload))) {
+			return reservationID, nil
+		}
+	}
+	return "", ErrInvalidPurchaseToken
+}
+
+// ErrInvalidItemShareToken is returned for an item share token that is malformed
+// or not signed with a current secret
+var ErrInvalidItemShareToken = errors.New("invalid item share token")
+
+// itemShareTokenPurpose keeps item share token MACs apart from other uses of the secret
+const itemShareTokenPurpose = "item-share:"
+
+// GenerateItemShareToken signs a link that shows a single gift item to anyone
+// holding it: "<share link ID>.<hex HMAC-SHA256>". The token does not expire;
+// the link is revoked by deleting it.
+func (tm *TokenManager) GenerateItemShareToken(linkID string) string {
+	return linkID + "." + linkMAC(tm.signingKey(), itemShareTokenPurpose, linkID)
+}
+
+// ValidateItemShareToken returns the share link ID an item share token was
+// issued for. Tokens signed before the last RotateSecret are still accepted.
+func (tm *TokenManager) ValidateItemShareToken(token string) (string, error) {
+	linkID, mac, ok := strings.Cut(token, ".")
+	if !ok || linkID == "" {
+		return "", ErrInvalidItemShareToken
+	}
+
+	for _, key := range tm.macKeys() {
+		if hmac.Equal([]byte(mac), []byte(linkMAC(key, itemShareTokenPurpose, linkID))) {
+			return linkID, nil
+		}
+	}
+	return "", ErrInvalidItemShareToken
+}
+
+// linkMAC signs the payload of a link token for one purpose
+func linkMAC(key []byte, purpose, payload string) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(purpose + payload))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,37 @@ func TestValidateTokenInvalid(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRotateSecret(t *testing.T) {
+	tm := NewTokenManager("old-secret")
+
+	oldToken, err := tm.GenerateAccessToken("user-123", "test@example.com", "user")
+	require.NoError(t, err)
+
+	tm.RotateSecret("new-secret")
+
+	newToken, err := tm.GenerateAccessToken("user-123", "test@example.com", "user")
+	require.NoError(t, err)
+
+	// New tokens are signed with the new secret only
+	_, err = NewTokenManager("new-secret").ValidateToken(newToken)
+	require.NoError(t, err)
+	_, err = NewTokenManager("old-secret").ValidateToken(newToken)
+	require.Error(t, err)
+
+	// Tokens issued before the rotation keep working
+	_, err = tm.ValidateToken(oldToken)
+	require.NoError(t, err)
+	_, err = tm.ValidateToken(newToken)
+	require.NoError(t, err)
+
+	// A second rotation drops the oldest secret
+	tm.RotateSecret("newest-secret")
+	_, err = tm.ValidateToken(oldToken)
+	require.Error(t, err)
+	_, err = tm.ValidateToken(newToken)
+	assert.NoError(t, err)
+}
+
 func TestGenerateGuestToken(t *testing.T) {
 	secret := "test-secret"
 	tm := NewTokenManager(secret)
@@ -143,3 +175,80 @@ func TestGenerateRefreshToken(t *testing.T) {
 	assert.Equal(t, tokenID, claims.TokenID)
 	assert.Equal(t, "wish-list-app", claims.Issuer)
 }
+
+func TestPurchaseToken(t *testing.T) {
+	tm := NewTokenManager("test-secret")
+	reservationID := "0c8f5b4e-6a2d-4f1b-9d3e-2b7a1c5e8f90"
+
+	t.Run("round trip", func(t *testing.T) {
+		token := tm.GeneratePurchaseToken(reservationID, time.Now().Add(time.Hour))
+
+		got, err := tm.ValidatePurchaseToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, reservationID, got)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		token := tm.GeneratePurchaseToken(reservationID, time.Now().Add(-time.Minute))
+
+		_, err := tm.ValidatePurchaseToken(token)
+		assert.ErrorIs(t, err, ErrInvalidPurchaseToken)
+	})
+
+	t.Run("tampered or signed with another secret", func(t *testing.T) {
+		token := tm.GeneratePurchaseToken(reservationID, time.Now().Add(time.Hour))
+
+		_, err := tm.ValidatePurchaseToken("1" + token[1:])
+		assert.ErrorIs(t, err, ErrInvalidPurchaseToken)
+		_, err = NewTokenManager("other-secret").ValidatePurchaseToken(token)
+		assert.ErrorIs(t, err, ErrInvalidPurchaseToken)
+		_, err = tm.ValidatePurchaseToken("not-a-token")
+		assert.ErrorIs(t, err, ErrInvalidPurchaseToken)
+	})
+
+	t.Run("survives a secret rotation", func(t *testing.T) {
+		rotating := NewTokenManager("old-secret")
+		token := rotating.GeneratePurchaseToken(reservationID, time.Now().Add(time.Hour))
+		rotating.RotateSecret("new-secret")
+
+		got, err := rotating.ValidatePurchaseToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, reservationID, got)
+	})
+
+	t.Run("is not an access token", func(t *testing.T) {
+		token := tm.GeneratePurchaseToken(reservationID, time.Now().Add(time.Hour))
+
+		_, err := tm.ValidateToken(token)
+		assert.Error(t, err)
+	})
+}
+
+func TestItemShareToken(t *testing.T) {
+	tm := NewTokenManager("test-secret")
+	linkID := "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	t.Run("round trip", func(t *testing.T) {
+		got, err := tm.ValidateItemShareToken(tm.GenerateItemShareToken(linkID))
+		require.NoError(t, err)
+		assert.Equal(t, linkID, got)
+	})
+
+	t.Run("tampered or signed with another secret", func(t *testing.T) {
+		token := tm.GenerateItemShareToken(linkID)
+
+		_, err := tm.ValidateItemShareToken("1" + token[1:])
+		assert.ErrorIs(t, err, ErrInvalidItemShareToken)
+		_, err = NewTokenManager("other-secret").ValidateItemShareToken(token)
+		assert.ErrorIs(t, err, ErrInvalidItemShareToken)
+		_, err = tm.ValidateItemShareToken("not-a-token")
+		assert.ErrorIs(t, err, ErrInvalidItemShareToken)
+	})
+
+	t.Run("is not a purchase token", func(t *testing.T) {
+		token := tm.GeneratePurchaseToken(linkID, time.Now().Add(time.Hour))
+
+		_, err := tm.ValidateItemShareToken(token)
+		assert.ErrorIs(t, err, ErrInvalidItemShareToken)
+	})
+}
@@ -7,25 +7,36 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net"
+	"net/url"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"wish-list/internal/pkg/breaker"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
+// ErrUnavailable is returned instead of calling S3 while its circuit breaker is open
+var ErrUnavailable = errors.New("storage temporarily unavailable")
+
 // S3Client wraps the AWS S3 client with helper methods
 type S3Client struct {
 	Client *s3.Client
 	Bucket string
 	Region string
+
+	// breaker skips S3 after repeated failures; nil calls S3 unconditionally
+	breaker *breaker.Breaker
 }
 
-// NewS3Client creates a new S3 client
-func NewS3Client(region, accessKeyID, secretAccessKey, bucketName string) (*S3Client, error) {
+// NewS3Client creates a new S3 client whose object calls are guarded by a circuit
+// breaker with cb
+func NewS3Client(region, accessKeyID, secretAccessKey, bucketName string, cb breaker.Config) (*S3Client, error) {
 	var cfg aws.Config
 	var err error
 
@@ -49,12 +60,46 @@ func NewS3Client(region, accessKeyID, secretAccessKey, bucketName string) (*S3Cl
 	client := s3.NewFromConfig(cfg)
 
 	return &S3Client{
-		Client: client,
-		Bucket: bucketName,
-		Region: region,
+		Client:  client,
+		Bucket:  bucketName,
+		Region:  region,
+		breaker: breaker.New("s3", cb),
 	}, nil
 }
 
+// Available reports whether object calls currently reach S3
+func (s *S3Client) Available() bool {
+	return s.breaker == nil || s.breaker.State() != breaker.StateOpen
+}
+
+func (s *S3Client) allow() error {
+	if s.breaker != nil && s.breaker.Allow() != nil {
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// record reports the outcome of an S3 call to the breaker. Network errors and
+// server-side (5xx) responses count as failures; e.g. a missing key does not.
+func (s *S3Client) record(err error) {
+	if s.breaker == nil {
+		return
+	}
+
+	failed := false
+	if err != nil {
+		var netErr net.Error
+		var respErr interface{ HTTPStatusCode() int }
+		switch {
+		case errors.As(err, &respErr):
+			failed = respErr.HTTPStatusCode() >= 500
+		case errors.As(err, &netErr), errors.Is(err, context.DeadlineExceeded):
+			failed = true
+		}
+	}
+	s.breaker.Record(failed)
+}
+
 // UploadFile uploads a file to S3
 func (s *S3Client) UploadFile(ctx context.Context, file multipart.File, fileName, contentType string) (string, error) {
 	fileBytes, err := io.ReadAll(file)
@@ -74,7 +119,11 @@ func (s *S3Client) UploadFile(ctx context.Context, file multipart.File, fileName
 		ContentType: aws.String(contentType),
 	}
 
+	if err := s.allow(); err != nil {
+		return "", err
+	}
 	_, err = s.Client.PutObject(ctx, uploadParams)
+	s.record(err)
 	if err != nil {
 		return "", fmt.Errorf("failed to upload file to S3: %w", err)
 	}
@@ -99,7 +148,11 @@ func (s *S3Client) UploadBytes(ctx context.Context, data []byte, fileName, conte
 		ContentType: aws.String(contentType),
 	}
 
+	if err := s.allow(); err != nil {
+		return "", err
+	}
 	_, err := s.Client.PutObject(ctx, uploadParams)
+	s.record(err)
 	if err != nil {
 		return "", fmt.Errorf("failed to upload data to S3: %w", err)
 	}
@@ -117,7 +170,11 @@ func (s *S3Client) DeleteFile(ctx context.Context, fileKey string) error {
 		Key:    aws.String(fileKey),
 	}
 
+	if err := s.allow(); err != nil {
+		return err
+	}
 	_, err := s.Client.DeleteObject(ctx, deleteParams)
+	s.record(err)
 	if err != nil {
 		return fmt.Errorf("failed to delete file from S3: %w", err)
 	}
@@ -125,6 +182,62 @@ func (s *S3Client) DeleteFile(ctx context.Context, fileKey string) error {
 	return nil
 }
 
+// UploadObject stores body under key as given. Unlike UploadFile the key is not
+// randomized and no public URL is returned, for objects served only through
+// presigned URLs.
+func (s *S3Client) UploadObject(ctx context.Context, key string, body io.Reader, contentType string) error {
+	if err := s.allow(); err != nil {
+		return err
+	}
+	_, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.Bucket),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	s.record(err)
+	if err != nil {
+		return fmt.Errorf("failed to upload object to S3: %w", err)
+	}
+
+	return nil
+}
+
+// GetObject opens the object stored under key. The caller must close it.
+func (s *S3Client) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := s.allow(); err != nil {
+		return nil, err
+	}
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(key),
+	})
+	s.record(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object from S3: %w", err)
+	}
+
+	return out.Body, nil
+}
+
+// KeyFromURL returns the object key of a public URL returned by UploadFile or
+// UploadBytes. It reports false for URLs outside the bucket.
+func (s *S3Client) KeyFromURL(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" {
+		return "", false
+	}
+	if u.Host != fmt.Sprintf("%s.s3.%s.amazonaws.com", s.Bucket, s.Region) {
+		return "", false
+	}
+
+	key := strings.TrimPrefix(u.Path, "/")
+	if key == "" {
+		return "", false
+	}
+	return key, true
+}
+
 // GeneratePresignedURL generates a presigned URL for temporary access to a file
 func (s *S3Client) GeneratePresignedURL(ctx context.Context, fileKey string, duration time.Duration) (string, error) {
 	presignClient := s3.NewPresignClient(s.Client)
@@ -210,3 +323,12 @@ func IsAnimatedGif(file multipart.File) (bool, error) {
 
 	return hasMultipleFrames, nil
 }
+
+// Ping checks that the configured bucket is reachable with the current credentials,
+// bypassing the circuit breaker
+func (s *S3Client) Ping(ctx context.Context) error {
+	if _, err := s.Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.Bucket)}); err != nil {
+		return fmt.Errorf("failed to reach bucket %s: %w", s.Bucket, err)
+	}
+	return nil
+}
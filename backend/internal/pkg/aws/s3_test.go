@@ -1,9 +1,21 @@
 package aws
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"wish-list/internal/pkg/breaker"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsValidImageExtension(t *testing.T) {
@@ -66,7 +78,7 @@ func TestNewS3Client(t *testing.T) {
 	secretAccessKey := ""
 	bucketName := "test-bucket"
 
-	client, err := NewS3Client(region, accessKeyID, secretAccessKey, bucketName)
+	client, err := NewS3Client(region, accessKeyID, secretAccessKey, bucketName, breaker.Config{})
 
 	// Since we don't have valid AWS credentials in test environment,
 	// we expect this to fail due to missing credentials
@@ -114,3 +126,75 @@ func TestDeleteFile(t *testing.T) {
 
 	t.Skip("Skipping test that requires real S3 client")
 }
+
+func TestKeyFromURL(t *testing.T) {
+	client := &S3Client{Bucket: "wishlist-images", Region: "eu-west-1"}
+
+	tests := []struct {
+		name    string
+		url     string
+		wantKey string
+		wantOK  bool
+	}{
+		{"Bucket URL", "https://wishlist-images.s3.eu-west-1.amazonaws.com/uploads/1/photo.jpg", "uploads/1/photo.jpg", true},
+		{"Other bucket", "https://other.s3.eu-west-1.amazonaws.com/uploads/1/photo.jpg", "", false},
+		{"External image", "https://shop.example.com/photo.jpg", "", false},
+		{"Plain HTTP", "http://wishlist-images.s3.eu-west-1.amazonaws.com/uploads/1/photo.jpg", "", false},
+		{"Bucket root", "https://wishlist-images.s3.eu-west-1.amazonaws.com/", "", false},
+		{"Not a URL", "::", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, ok := client.KeyFromURL(tt.url)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
+
+func TestS3Client_CircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &S3Client{
+		Client: s3.New(s3.Options{
+			BaseEndpoint:     aws.String(server.URL),
+			Region:           "eu-west-1",
+			Credentials:      credentials.NewStaticCredentialsProvider("key", "secret", ""),
+			UsePathStyle:     true,
+			RetryMaxAttempts: 1,
+		}),
+		Bucket:  "wishlist-images",
+		Region:  "eu-west-1",
+		breaker: breaker.New("s3-test", breaker.Config{Failures: 2, Cooldown: time.Minute}),
+	}
+	ctx := context.Background()
+
+	// Client errors do not count against S3
+	for range 3 {
+		_, err := client.GetObject(ctx, "missing")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrUnavailable)
+	}
+
+	for range 2 {
+		err := client.DeleteFile(ctx, "uploads/1/photo.jpg")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrUnavailable)
+	}
+
+	before := calls.Load()
+	err := client.UploadObject(ctx, "exports/1.zip", strings.NewReader("zip"), "application/zip")
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, before, calls.Load(), "an open breaker does not call S3")
+}
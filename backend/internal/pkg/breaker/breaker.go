@@ -0,0 +1,169 @@
+// Package breaker stops calling a dependency that keeps failing, so an outage
+// costs requests a quick rejection instead of a timeout each.
+package breaker
+
+import (
+	"errors"
+	"expvar"
+	"sync"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// ErrOpen is returned by Allow while the breaker rejects calls
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State of a breaker
+type State string
+
+const (
+	// StateClosed lets every call through
+	StateClosed State = "closed"
+	// StateOpen rejects calls until the cooldown has passed
+	StateOpen State = "open"
+	// StateHalfOpen lets a single probe call through to test for recovery
+	StateHalfOpen State = "half-open"
+)
+
+// Metrics holds the counters of every breaker by name, published through expvar
+// as circuit_breakers, e.g. {"redis": {"state": "open", "opened": 2, "rejected": 40}}
+var Metrics = expvar.NewMap("circuit_breakers")
+
+// Config holds the breaker thresholds
+type Config struct {
+	// Failures is how many consecutive failures open the breaker; 0 disables it
+	Failures int
+	// Cooldown is how long an open breaker rejects calls before probing again
+	Cooldown time.Duration
+}
+
+// Breaker counts consecutive failures of one dependency. Callers check Allow before
+// a call and report its outcome with Record.
+type Breaker struct {
+	name string
+	cfg  Config
+	now  func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool // A half-open probe is in flight
+
+	stateVar expvar.String
+	opened   expvar.Int
+	rejected expvar.Int
+}
+
+// New creates a closed breaker and registers its metrics under name
+func New(name string, cfg Config) *Breaker {
+	b := &Breaker{name: name, cfg: cfg, now: time.Now, state: StateClosed}
+	b.stateVar.Set(string(StateClosed))
+
+	vars := new(expvar.Map).Init()
+	vars.Set("state", &b.stateVar)
+	vars.Set("opened", &b.opened)
+	vars.Set("rejected", &b.rejected)
+	Metrics.Set(name, vars)
+
+	return b
+}
+
+// State returns the current state; an open breaker past its cooldown reports half-open
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.cfg.Cooldown {
+		return StateHalfOpen
+	}
+	return b.state
+}
+
+// Allow returns ErrOpen when the call should be skipped. Once the cooldown has
+// passed one call is let through as a probe; its Record closes or reopens the breaker.
+func (b *Breaker) Allow() error {
+	if b.cfg.Failures <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.Cooldown {
+			b.rejected.Add(1)
+			return ErrOpen
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			b.rejected.Add(1)
+			return ErrOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Record reports the outcome of an allowed call. failed should only be true for
+// errors that say the dependency is unhealthy, not e.g. for a missing key.
+func (b *Breaker) Record(failed bool) {
+	if b.cfg.Failures <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		b.failures = 0
+		b.probing = false
+		if b.state != StateClosed {
+			logger.Info("circuit breaker closed", "breaker", b.name)
+			b.setState(StateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.Failures {
+		b.open()
+	}
+}
+
+// Trip opens the breaker regardless of the failure count, e.g. when a dependency
+// is known to be down at startup
+func (b *Breaker) Trip() {
+	if b.cfg.Failures <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.open()
+}
+
+// Reset closes the breaker, e.g. after an out-of-band health check succeeded
+func (b *Breaker) Reset() {
+	b.Record(false)
+}
+
+func (b *Breaker) open() {
+	if b.state != StateOpen {
+		logger.Warn("circuit breaker opened", "breaker", b.name, "failures", b.failures, "cooldown", b.cfg.Cooldown.String())
+		b.opened.Add(1)
+	}
+	b.probing = false
+	b.openedAt = b.now()
+	b.setState(StateOpen)
+}
+
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.stateVar.Set(string(state))
+}
@@ -0,0 +1,92 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBreaker(cfg Config) (*Breaker, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := New("test", cfg)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newTestBreaker(Config{Failures: 3, Cooldown: time.Minute})
+
+	for range 2 {
+		require.NoError(t, b.Allow())
+		b.Record(true)
+	}
+	require.NoError(t, b.Allow())
+	b.Record(false) // A success resets the count
+
+	for range 3 {
+		require.NoError(t, b.Allow())
+		b.Record(true)
+	}
+
+	assert.Equal(t, StateOpen, b.State())
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+	assert.Equal(t, int64(1), b.opened.Value())
+	assert.Equal(t, int64(1), b.rejected.Value())
+	assert.Equal(t, "open", b.stateVar.Value())
+}
+
+func TestBreaker_ProbesAfterCooldown(t *testing.T) {
+	b, now := newTestBreaker(Config{Failures: 1, Cooldown: time.Minute})
+
+	require.NoError(t, b.Allow())
+	b.Record(true)
+	require.ErrorIs(t, b.Allow(), ErrOpen)
+
+	*now = now.Add(time.Minute)
+	assert.Equal(t, StateHalfOpen, b.State())
+
+	t.Run("failed probe reopens", func(t *testing.T) {
+		require.NoError(t, b.Allow())
+		assert.ErrorIs(t, b.Allow(), ErrOpen, "only one probe at a time")
+		b.Record(true)
+
+		assert.Equal(t, StateOpen, b.State())
+		assert.ErrorIs(t, b.Allow(), ErrOpen)
+	})
+
+	t.Run("successful probe closes", func(t *testing.T) {
+		*now = now.Add(time.Minute)
+
+		require.NoError(t, b.Allow())
+		b.Record(false)
+
+		assert.Equal(t, StateClosed, b.State())
+		assert.NoError(t, b.Allow())
+		assert.NoError(t, b.Allow())
+	})
+}
+
+func TestBreaker_TripAndReset(t *testing.T) {
+	b, _ := newTestBreaker(Config{Failures: 5, Cooldown: time.Minute})
+
+	b.Trip()
+	assert.Equal(t, StateOpen, b.State())
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+
+	b.Reset()
+	assert.Equal(t, StateClosed, b.State())
+	assert.NoError(t, b.Allow())
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b, _ := newTestBreaker(Config{})
+
+	b.Trip()
+	for range 10 {
+		require.NoError(t, b.Allow())
+		b.Record(true)
+	}
+	assert.Equal(t, StateClosed, b.State())
+}
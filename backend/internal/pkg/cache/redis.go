@@ -5,42 +5,119 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"time"
 
+	"wish-list/internal/pkg/breaker"
+	"wish-list/internal/pkg/logger"
+
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrUnavailable is returned instead of calling Redis while its circuit breaker is open
+var ErrUnavailable = errors.New("cache unavailable")
+
 // RedisCache provides caching functionality using Redis
 type RedisCache struct {
 	client *redis.Client
 	ttl    time.Duration
+
+	// breaker skips Redis after repeated connection failures so an outage does not
+	// add a timeout to every request; callers treat ErrUnavailable as a miss
+	breaker *breaker.Breaker
 }
 
 // NewRedisCache creates a new Redis cache instance
 func NewRedisCache(addr, password string, db int, ttl time.Duration) (*RedisCache, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     addr,
-		Password: password,
-		DB:       db,
-	})
+	c := NewLazyRedisCache(addr, password, db, ttl, breaker.Config{})
 
-	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := client.Ping(ctx).Err(); err != nil {
+	if err := c.Connect(ctx); err != nil {
+		_ = c.Close()
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
+	return c, nil
+}
+
+// NewLazyRedisCache creates a Redis cache without contacting Redis, guarded by a
+// circuit breaker with cb. The breaker starts out open: reads miss and writes are
+// skipped with ErrUnavailable until Connect or MonitorConnection reaches Redis, or
+// a call after the cooldown gets through.
+func NewLazyRedisCache(addr, password string, db int, ttl time.Duration, cb breaker.Config) *RedisCache {
+	c := &RedisCache{
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+		ttl:     ttl,
+		breaker: breaker.New("redis", cb),
+	}
+	c.breaker.Trip()
+	return c
+}
+
+// Connect pings Redis and enables the cache if it answers
+func (c *RedisCache) Connect(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return err
+	}
+	c.breaker.Reset()
+	return nil
+}
+
+// Available reports whether cache calls currently reach Redis
+func (c *RedisCache) Available() bool {
+	return c.breaker.State() != breaker.StateOpen
+}
+
+// MonitorConnection pings Redis every interval while its breaker is not closed and
+// turns caching back on once it answers. It blocks until ctx is canceled.
+func (c *RedisCache) MonitorConnection(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if c.breaker.State() == breaker.StateClosed {
+				continue
+			}
+			connectCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			err := c.Connect(connectCtx)
+			cancel()
+			if err == nil {
+				logger.Info("Redis connection established, caching enabled")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// record reports the outcome of a Redis call to the breaker. Only errors that show
+// Redis is unreachable or too slow count as failures.
+func (c *RedisCache) record(err error) {
+	c.breaker.Record(isConnectionError(err))
+}
 
-	return &RedisCache{
-		client: client,
-		ttl:    ttl,
-	}, nil
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded)
 }
 
 // Get retrieves a value from cache
 func (c *RedisCache) Get(ctx context.Context, key string, dest any) error {
+	if err := c.breaker.Allow(); err != nil {
+		return ErrUnavailable
+	}
+
 	val, err := c.client.Get(ctx, key).Result()
+	c.record(err)
 	if errors.Is(err, redis.Nil) {
 		return errors.New("cache miss")
 	}
@@ -62,7 +139,12 @@ func (c *RedisCache) Set(ctx context.Context, key string, value any) error {
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
 
-	if err := c.client.Set(ctx, key, data, c.ttl).Err(); err != nil {
+	if err := c.breaker.Allow(); err != nil {
+		return ErrUnavailable
+	}
+	err = c.client.Set(ctx, key, data, c.ttl).Err()
+	c.record(err)
+	if err != nil {
 		return fmt.Errorf("failed to set cache: %w", err)
 	}
 
@@ -71,7 +153,12 @@ func (c *RedisCache) Set(ctx context.Context, key string, value any) error {
 
 // Delete removes a value from cache
 func (c *RedisCache) Delete(ctx context.Context, key string) error {
-	if err := c.client.Del(ctx, key).Err(); err != nil {
+	if err := c.breaker.Allow(); err != nil {
+		return ErrUnavailable
+	}
+	err := c.client.Del(ctx, key).Err()
+	c.record(err)
+	if err != nil {
 		return fmt.Errorf("failed to delete from cache: %w", err)
 	}
 	return nil
@@ -79,13 +166,20 @@ func (c *RedisCache) Delete(ctx context.Context, key string) error {
 
 // DeletePattern removes all keys matching a pattern
 func (c *RedisCache) DeletePattern(ctx context.Context, pattern string) error {
+	if err := c.breaker.Allow(); err != nil {
+		return ErrUnavailable
+	}
+
 	iter := c.client.Scan(ctx, 0, pattern, 0).Iterator()
 	for iter.Next(ctx) {
 		if err := c.client.Del(ctx, iter.Val()).Err(); err != nil {
+			c.record(err)
 			return fmt.Errorf("failed to delete key %s: %w", iter.Val(), err)
 		}
 	}
-	if err := iter.Err(); err != nil {
+	err := iter.Err()
+	c.record(err)
+	if err != nil {
 		return fmt.Errorf("failed to scan keys: %w", err)
 	}
 	return nil
@@ -96,6 +190,17 @@ func (c *RedisCache) Close() error {
 	return c.client.Close()
 }
 
+// Ping checks that Redis is reachable, bypassing the circuit breaker
+func (c *RedisCache) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// Client returns the underlying Redis client for features that need more than
+// JSON values with the cache TTL, e.g. presence tracking
+func (c *RedisCache) Client() *redis.Client {
+	return c.client
+}
+
 // CacheInterface defines the caching operations
 type CacheInterface interface {
 	Get(ctx context.Context, key string, dest any) error
@@ -0,0 +1,80 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVerifier(t *testing.T) {
+	t.Run("supported providers", func(t *testing.T) {
+		for _, provider := range []string{ProviderHCaptcha, ProviderTurnstile, ProviderReCaptcha, "Turnstile"} {
+			v, err := NewVerifier(provider, "secret", time.Second)
+			require.NoError(t, err, provider)
+			assert.NotNil(t, v)
+		}
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		_, err := NewVerifier("unknown", "secret", time.Second)
+		assert.ErrorIs(t, err, ErrUnsupportedProvider)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := NewVerifier(ProviderHCaptcha, "", time.Second)
+		assert.ErrorIs(t, err, ErrMissingSecret)
+	})
+}
+
+func TestHTTPVerifier_Verify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test-secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "1.2.3.4", r.PostForm.Get("remoteip"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("response") == "valid-token" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	v := NewHTTPVerifier(server.URL, "test-secret", time.Second)
+
+	t.Run("valid token", func(t *testing.T) {
+		assert.NoError(t, v.Verify(context.Background(), "valid-token", "1.2.3.4"))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := v.Verify(context.Background(), "bad-token", "1.2.3.4")
+		assert.ErrorIs(t, err, ErrVerificationFailed)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		err := v.Verify(context.Background(), "", "1.2.3.4")
+		assert.ErrorIs(t, err, ErrMissingToken)
+	})
+}
+
+func TestHTTPVerifier_ProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	v := NewHTTPVerifier(server.URL, "test-secret", time.Second)
+	err := v.Verify(context.Background(), "token", "")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrVerificationFailed)
+}
+
+func TestNoopVerifier(t *testing.T) {
+	assert.NoError(t, NoopVerifier{}.Verify(context.Background(), "", ""))
+}
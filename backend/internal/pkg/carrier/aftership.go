@@ -0,0 +1,151 @@
+package carrier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AfterShipURL is the AfterShip tracking API endpoint
+const AfterShipURL = "https://api.aftership.com"
+
+// afterShipNotFound is the meta code AfterShip returns for unknown trackings
+const afterShipNotFound = 4004
+
+// AfterShipTracker tracks parcels of one carrier through the AfterShip aggregator.
+// AfterShip only reports trackings it was told about, so unknown tracking numbers
+// are registered on first lookup and reported as pending until AfterShip polls
+// the carrier.
+type AfterShipTracker struct {
+	baseURL string
+	apiKey  string
+	slug    string // AfterShip courier code, e.g. "ups" or "dhl"
+	client  *http.Client
+}
+
+// NewAfterShipTracker creates a tracker for the AfterShip courier slug
+func NewAfterShipTracker(baseURL, apiKey, slug string, timeout time.Duration) *AfterShipTracker {
+	if baseURL == "" {
+		baseURL = AfterShipURL
+	}
+	return &AfterShipTracker{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		slug:    slug,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+type afterShipResponse struct {
+	Meta struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"meta"`
+	Data struct {
+		Tracking afterShipTracking `json:"tracking"`
+	} `json:"data"`
+}
+
+type afterShipTracking struct {
+	Slug                 string `json:"slug,omitempty"`
+	TrackingNumber       string `json:"tracking_number,omitempty"`
+	Tag                  string `json:"tag,omitempty"`
+	SubtagMessage        string `json:"subtag_message,omitempty"`
+	ShipmentDeliveryDate string `json:"shipment_delivery_date,omitempty"`
+}
+
+// Track returns the parcel's latest status
+func (t *AfterShipTracker) Track(ctx context.Context, trackingNumber string) (*Result, error) {
+	path := "/v4/trackings/" + url.PathEscape(t.slug) + "/" + url.PathEscape(trackingNumber)
+	resp, err := t.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Meta.Code == afterShipNotFound {
+		return t.register(ctx, trackingNumber)
+	}
+	if resp.Meta.Code != http.StatusOK {
+		return nil, fmt.Errorf("AfterShip returned code %d: %s", resp.Meta.Code, resp.Meta.Message)
+	}
+
+	return afterShipResult(resp.Data.Tracking), nil
+}
+
+// register asks AfterShip to start tracking the parcel
+func (t *AfterShipTracker) register(ctx context.Context, trackingNumber string) (*Result, error) {
+	body, err := json.Marshal(map[string]afterShipTracking{
+		"tracking": {Slug: t.slug, TrackingNumber: trackingNumber},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode AfterShip tracking: %w", err)
+	}
+
+	resp, err := t.do(ctx, http.MethodPost, "/v4/trackings", body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Meta.Code {
+	case http.StatusOK, http.StatusCreated:
+		return &Result{Status: StatusPending}, nil
+	case afterShipNotFound, 4005: // 4005: tracking number does not match the courier's format
+		return nil, ErrTrackingNotFound
+	default:
+		return nil, fmt.Errorf("AfterShip returned code %d: %s", resp.Meta.Code, resp.Meta.Message)
+	}
+}
+
+func (t *AfterShipTracker) do(ctx context.Context, method, path string, body []byte) (*afterShipResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, method, t.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AfterShip request: %w", err)
+	}
+	req.Header.Set("as-api-key", t.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	//nolint:gosec // Intentional external API call to the configured tracking provider
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call AfterShip: %w", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read AfterShip response: %w", err)
+	}
+
+	var parsed afterShipResponse
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("AfterShip returned status %d with an unreadable body: %w", resp.StatusCode, err)
+	}
+	return &parsed, nil
+}
+
+// afterShipResult maps AfterShip's status tags onto the package's statuses
+func afterShipResult(tracking afterShipTracking) *Result {
+	result := &Result{Detail: tracking.SubtagMessage}
+
+	switch tracking.Tag {
+	case "InTransit", "AvailableForPickup":
+		result.Status = StatusInTransit
+	case "OutForDelivery":
+		result.Status = StatusOutForDelivery
+	case "Delivered":
+		result.Status = StatusDelivered
+		if deliveredAt, err := time.Parse(time.RFC3339, tracking.ShipmentDeliveryDate); err == nil {
+			result.DeliveredAt = deliveredAt
+		}
+	case "AttemptFail", "Exception", "Expired":
+		result.Status = StatusException
+	default: // Pending, InfoReceived
+		result.Status = StatusPending
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+// Package carrier looks up the delivery status of parcels with shipping carriers.
+//
+// Each carrier integration is a Tracker; a Router picks the tracker for a
+// shipment's carrier:
+//
+//	router := carrier.NewRouter(map[string]carrier.Tracker{
+//	    "ups": upsTracker,
+//	    "dhl": dhlTracker,
+//	})
+//	result, err := router.Track(ctx, "ups", "1Z999AA10123456784")
+//	if errors.Is(err, carrier.ErrUnsupportedCarrier) {
+//	    // no integration for this carrier
+//	}
+package carrier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Delivery statuses reported by trackers
+const (
+	StatusPending        = "pending"          // Label created or not yet scanned by the carrier
+	StatusInTransit      = "in_transit"       // On its way, including waiting at a pickup point
+	StatusOutForDelivery = "out_for_delivery" // With the courier for delivery today
+	StatusDelivered      = "delivered"
+	StatusException      = "exception" // Failed attempt, return to sender or tracking expired
+)
+
+var (
+	// ErrUnsupportedCarrier means no tracker is configured for the carrier
+	ErrUnsupportedCarrier = errors.New("carrier is not supported")
+	// ErrTrackingNotFound means the carrier does not know the tracking number
+	ErrTrackingNotFound = errors.New("tracking number not found")
+)
+
+// Result is the latest known state of a parcel
+type Result struct {
+	Status      string
+	Detail      string    // Carrier's description of the latest checkpoint
+	DeliveredAt time.Time // Zero unless Status is StatusDelivered and the carrier reported the time
+}
+
+// Tracker looks up parcels of one carrier
+type Tracker interface {
+	Track(ctx context.Context, trackingNumber string) (*Result, error)
+}
+
+// Router tracks parcels with the tracker configured for each carrier
+type Router struct {
+	trackers map[string]Tracker
+}
+
+// NewRouter creates a Router. Carriers without a tracker are rejected with ErrUnsupportedCarrier.
+func NewRouter(trackers map[string]Tracker) *Router {
+	return &Router{trackers: trackers}
+}
+
+// Track looks up a parcel sent with the given carrier
+func (r *Router) Track(ctx context.Context, carrierCode, trackingNumber string) (*Result, error) {
+	tracker, ok := r.trackers[carrierCode]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCarrier, carrierCode)
+	}
+	return tracker.Track(ctx, trackingNumber)
+}
+
+// Supports reports whether a tracker is configured for the carrier
+func (r *Router) Supports(carrierCode string) bool {
+	_, ok := r.trackers[carrierCode]
+	return ok
+}
+
+// Carriers returns the codes of the configured carriers, sorted
+func (r *Router) Carriers() []string {
+	codes := make([]string, 0, len(r.trackers))
+	for code := range r.trackers {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Enabled reports whether any carrier is configured
+func (r *Router) Enabled() bool {
+	return len(r.trackers) > 0
+}
@@ -0,0 +1,82 @@
+package carrier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trackerFunc func(ctx context.Context, trackingNumber string) (*Result, error)
+
+func (f trackerFunc) Track(ctx context.Context, trackingNumber string) (*Result, error) {
+	return f(ctx, trackingNumber)
+}
+
+func TestRouter_Track(t *testing.T) {
+	router := NewRouter(map[string]Tracker{
+		"ups": trackerFunc(func(ctx context.Context, trackingNumber string) (*Result, error) {
+			return &Result{Status: StatusInTransit, Detail: trackingNumber}, nil
+		}),
+		"dhl": trackerFunc(func(ctx context.Context, trackingNumber string) (*Result, error) {
+			return &Result{Status: StatusPending}, nil
+		}),
+	})
+
+	result, err := router.Track(context.Background(), "ups", "1Z999")
+	require.NoError(t, err)
+	assert.Equal(t, "1Z999", result.Detail)
+
+	_, err = router.Track(context.Background(), "fedex", "123")
+	assert.ErrorIs(t, err, ErrUnsupportedCarrier)
+
+	assert.True(t, router.Supports("dhl"))
+	assert.Equal(t, []string{"dhl", "ups"}, router.Carriers())
+	assert.False(t, NewRouter(nil).Enabled())
+}
+
+func TestAfterShipTracker_Track(t *testing.T) {
+	var registered []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("as-api-key"))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/trackings/ups/DELIVERED":
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"tracking": {"tag": "Delivered", "subtag_message": "Left at front door", "shipment_delivery_date": "2026-03-01T10:00:00Z"}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/trackings/ups/FAILED":
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"tracking": {"tag": "AttemptFail"}}}`))
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"meta": {"code": 4004, "message": "Tracking does not exist."}, "data": {}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/trackings":
+			var body map[string]afterShipTracking
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			registered = append(registered, body["tracking"].Slug+"/"+body["tracking"].TrackingNumber)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"meta": {"code": 201}, "data": {"tracking": {"tag": "Pending"}}}`))
+		}
+	}))
+	defer server.Close()
+
+	tracker := NewAfterShipTracker(server.URL, "secret", "ups", time.Second)
+
+	result, err := tracker.Track(context.Background(), "DELIVERED")
+	require.NoError(t, err)
+	assert.Equal(t, StatusDelivered, result.Status)
+	assert.Equal(t, "Left at front door", result.Detail)
+	assert.Equal(t, time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), result.DeliveredAt.UTC())
+
+	result, err = tracker.Track(context.Background(), "FAILED")
+	require.NoError(t, err)
+	assert.Equal(t, StatusException, result.Status)
+
+	result, err = tracker.Track(context.Background(), "NEW")
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, result.Status)
+	assert.Equal(t, []string{"ups/NEW"}, registered)
+}
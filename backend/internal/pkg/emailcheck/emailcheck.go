@@ -0,0 +1,316 @@
+// Package emailcheck decides whether an email address is acceptable for
+// guest reservations and registrations.
+//
+// Checks are layered by strictness: syntax only, syntax plus a disposable
+// domain blocklist, or all of the above plus an MX lookup that proves the
+// domain can receive mail. Domain verdicts are cached so repeat addresses
+// from the same provider do not hit DNS again.
+//
+// Usage:
+//
+//	validator, err := emailcheck.NewValidator(emailcheck.Options{Mode: emailcheck.ModeMX})
+//	if err := validator.Validate(ctx, address); err != nil {
+//	    // reject address
+//	}
+package emailcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Strictness modes, from most lenient to strictest
+const (
+	ModeOff        = "off"
+	ModeSyntax     = "syntax"
+	ModeDisposable = "disposable"
+	ModeMX         = "mx"
+)
+
+// Modes lists the supported strictness modes
+var Modes = []string{ModeOff, ModeSyntax, ModeDisposable, ModeMX}
+
+var (
+	ErrInvalidSyntax       = errors.New("email address is malformed")
+	ErrDisposableDomain    = errors.New("email domain is a disposable mailbox provider")
+	ErrUndeliverableDomain = errors.New("email domain does not accept mail")
+	ErrUnsupportedMode     = errors.New("unsupported email check mode")
+)
+
+// maxCachedDomains bounds the verdict cache; expired entries are swept once
+// it fills up
+const maxCachedDomains = 10000
+
+// DefaultDisposableDomains are well-known throwaway mailbox providers
+var DefaultDisposableDomains = []string{
+	"10minutemail.com",
+	"20minutemail.com",
+	"33mail.com",
+	"discard.email",
+	"dispostable.com",
+	"emailondeck.com",
+	"fakeinbox.com",
+	"getairmail.com",
+	"getnada.com",
+	"guerrillamail.biz",
+	"guerrillamail.com",
+	"guerrillamail.de",
+	"guerrillamail.info",
+	"guerrillamail.net",
+	"guerrillamail.org",
+	"guerrillamailblock.com",
+	"harakirimail.com",
+	"incognitomail.org",
+	"jetable.org",
+	"mailcatch.com",
+	"maildrop.cc",
+	"mailinator.com",
+	"mailinator.net",
+	"mailnesia.com",
+	"mintemail.com",
+	"moakt.com",
+	"mohmal.com",
+	"mytemp.email",
+	"sharklasers.com",
+	"spam4.me",
+	"spamgourmet.com",
+	"temp-mail.io",
+	"temp-mail.org",
+	"tempail.com",
+	"tempmail.dev",
+	"tempmailo.com",
+	"tempr.email",
+	"throwawaymail.com",
+	"trashmail.com",
+	"trashmail.de",
+	"yopmail.com",
+	"yopmail.fr",
+	"yopmail.net",
+}
+
+// Validator checks email addresses.
+type Validator interface {
+	Validate(ctx context.Context, address string) error
+}
+
+// Resolver looks up mail exchangers; satisfied by *net.Resolver
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// Options configures a DomainValidator
+type Options struct {
+	Mode              string
+	DisposableDomains []string      // Added to DefaultDisposableDomains
+	Resolver          Resolver      // Defaults to net.DefaultResolver
+	LookupTimeout     time.Duration // Per MX lookup; defaults to 3s
+	CacheTTL          time.Duration // How long domain verdicts are reused; defaults to 1h
+}
+
+// DomainValidator validates addresses according to its strictness mode
+type DomainValidator struct {
+	mode       string
+	disposable map[string]struct{}
+	resolver   Resolver
+	timeout    time.Duration
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedVerdict
+}
+
+// cachedVerdict is the outcome of an MX lookup for a domain; err is nil for
+// domains that accept mail
+type cachedVerdict struct {
+	err       error
+	expiresAt time.Time
+}
+
+// NewValidator creates a Validator for the given options.
+func NewValidator(opts Options) (*DomainValidator, error) {
+	mode := strings.ToLower(strings.TrimSpace(opts.Mode))
+	if mode == "" {
+		mode = ModeSyntax
+	}
+	switch mode {
+	case ModeOff, ModeSyntax, ModeDisposable, ModeMX:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedMode, opts.Mode)
+	}
+
+	disposable := make(map[string]struct{}, len(DefaultDisposableDomains)+len(opts.DisposableDomains))
+	for _, list := range [][]string{DefaultDisposableDomains, opts.DisposableDomains} {
+		for _, domain := range list {
+			if domain = normalizeDomain(domain); domain != "" {
+				disposable[domain] = struct{}{}
+			}
+		}
+	}
+
+	resolver := opts.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	timeout := opts.LookupTimeout
+	if timeout <= 0 {
+		timeout = 3 * time.Second
+	}
+	ttl := opts.CacheTTL
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+
+	return &DomainValidator{
+		mode:       mode,
+		disposable: disposable,
+		resolver:   resolver,
+		timeout:    timeout,
+		ttl:        ttl,
+		now:        time.Now,
+		cache:      make(map[string]cachedVerdict),
+	}, nil
+}
+
+// Mode returns the strictness mode the validator enforces
+func (v *DomainValidator) Mode() string {
+	return v.mode
+}
+
+// Validate returns nil when the address passes every check enabled by the
+// validator's mode. DNS failures other than a missing domain fail open so an
+// unreachable resolver never blocks sign-ups.
+func (v *DomainValidator) Validate(ctx context.Context, address string) error {
+	if v.mode == ModeOff {
+		return nil
+	}
+
+	domain, err := parseDomain(address)
+	if err != nil {
+		return err
+	}
+	if v.mode == ModeSyntax {
+		return nil
+	}
+
+	if v.isDisposable(domain) {
+		return ErrDisposableDomain
+	}
+	if v.mode == ModeDisposable {
+		return nil
+	}
+
+	return v.checkMX(ctx, domain)
+}
+
+// parseDomain checks the address syntax and returns its lowercased domain
+func parseDomain(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	parsed, err := mail.ParseAddress(address)
+	// Reject display-name forms such as "Jane <jane@example.com>"
+	if err != nil || parsed.Address != address {
+		return "", ErrInvalidSyntax
+	}
+
+	at := strings.LastIndexByte(address, '@')
+	domain := normalizeDomain(address[at+1:])
+	// Require a dotted host name; mail.ParseAddress accepts "user@localhost"
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, "[") {
+		return "", ErrInvalidSyntax
+	}
+	return domain, nil
+}
+
+// isDisposable reports whether domain or one of its parent domains is listed
+func (v *DomainValidator) isDisposable(domain string) bool {
+	for {
+		if _, ok := v.disposable[domain]; ok {
+			return true
+		}
+		dot := strings.IndexByte(domain, '.')
+		if dot < 0 {
+			return false
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+// checkMX verifies that the domain publishes a usable mail exchanger
+func (v *DomainValidator) checkMX(ctx context.Context, domain string) error {
+	if entry, ok := v.cached(domain); ok {
+		return entry.err
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	records, err := v.resolver.LookupMX(lookupCtx, domain)
+	var verdict error
+	switch {
+	case err != nil:
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			// Timeouts and resolver outages are not the address's fault
+			return nil
+		}
+		verdict = ErrUndeliverableDomain
+	case len(records) == 0 || (len(records) == 1 && records[0].Host == "."):
+		// No records, or a null MX (RFC 7505) declaring the domain takes no mail
+		verdict = ErrUndeliverableDomain
+	}
+
+	v.store(domain, verdict)
+	return verdict
+}
+
+func (v *DomainValidator) cached(domain string) (cachedVerdict, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	entry, ok := v.cache[domain]
+	if !ok || !v.now().Before(entry.expiresAt) {
+		return cachedVerdict{}, false
+	}
+	return entry, true
+}
+
+func (v *DomainValidator) store(domain string, verdict error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	if len(v.cache) >= maxCachedDomains {
+		for key, entry := range v.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(v.cache, key)
+			}
+		}
+		// Still full of live entries: start over rather than grow unbounded
+		if len(v.cache) >= maxCachedDomains {
+			clear(v.cache)
+		}
+	}
+	v.cache[domain] = cachedVerdict{err: verdict, expiresAt: now.Add(v.ttl)}
+}
+
+// Describe returns a client-facing explanation for a Validate error
+func Describe(err error) string {
+	switch {
+	case errors.Is(err, ErrDisposableDomain):
+		return "Disposable email addresses are not accepted"
+	case errors.Is(err, ErrUndeliverableDomain):
+		return "Email domain cannot receive mail"
+	default:
+		return "Email address is invalid"
+	}
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
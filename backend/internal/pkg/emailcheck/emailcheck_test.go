@@ -0,0 +1,139 @@
+package emailcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct {
+	records map[string][]*net.MX
+	err     error
+	calls   int
+}
+
+func (r *fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	records, ok := r.records[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func newTestValidator(t *testing.T, mode string, resolver Resolver) *DomainValidator {
+	t.Helper()
+	v, err := NewValidator(Options{
+		Mode:              mode,
+		DisposableDomains: []string{"Throwaway.Example"},
+		Resolver:          resolver,
+	})
+	require.NoError(t, err)
+	return v
+}
+
+func TestNewValidator(t *testing.T) {
+	t.Run("defaults to syntax mode", func(t *testing.T) {
+		v, err := NewValidator(Options{})
+		require.NoError(t, err)
+		assert.Equal(t, ModeSyntax, v.Mode())
+	})
+
+	t.Run("mode is case insensitive", func(t *testing.T) {
+		v, err := NewValidator(Options{Mode: "MX"})
+		require.NoError(t, err)
+		assert.Equal(t, ModeMX, v.Mode())
+	})
+
+	t.Run("unsupported mode", func(t *testing.T) {
+		_, err := NewValidator(Options{Mode: "paranoid"})
+		assert.ErrorIs(t, err, ErrUnsupportedMode)
+	})
+}
+
+func TestDomainValidator_Validate(t *testing.T) {
+	resolver := &fakeResolver{records: map[string][]*net.MX{
+		"example.com":    {{Host: "mx.example.com.", Pref: 10}},
+		"nomail.example": {{Host: ".", Pref: 0}},
+	}}
+
+	tests := []struct {
+		name    string
+		mode    string
+		address string
+		wantErr error
+	}{
+		{"off accepts anything", ModeOff, "not an email", nil},
+		{"syntax accepts valid address", ModeSyntax, "jane@example.com", nil},
+		{"syntax rejects missing at", ModeSyntax, "jane.example.com", ErrInvalidSyntax},
+		{"syntax rejects display name", ModeSyntax, "Jane <jane@example.com>", ErrInvalidSyntax},
+		{"syntax rejects dotless domain", ModeSyntax, "jane@localhost", ErrInvalidSyntax},
+		{"syntax ignores disposable domains", ModeSyntax, "jane@mailinator.com", nil},
+		{"disposable rejects built-in domain", ModeDisposable, "jane@Mailinator.com", ErrDisposableDomain},
+		{"disposable rejects configured domain", ModeDisposable, "jane@throwaway.example", ErrDisposableDomain},
+		{"disposable rejects subdomain", ModeDisposable, "jane@inbox.yopmail.com", ErrDisposableDomain},
+		{"disposable skips MX lookup", ModeDisposable, "jane@unknown.example", nil},
+		{"mx accepts domain with exchanger", ModeMX, "jane@example.com", nil},
+		{"mx rejects unknown domain", ModeMX, "jane@unknown.example", ErrUndeliverableDomain},
+		{"mx rejects null MX", ModeMX, "jane@nomail.example", ErrUndeliverableDomain},
+		{"mx still rejects disposable domain", ModeMX, "jane@mailinator.com", ErrDisposableDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newTestValidator(t, tt.mode, resolver)
+			err := v.Validate(context.Background(), tt.address)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDomainValidator_FailsOpenOnResolverErrors(t *testing.T) {
+	resolver := &fakeResolver{err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}}
+	v := newTestValidator(t, ModeMX, resolver)
+
+	assert.NoError(t, v.Validate(context.Background(), "jane@example.com"))
+	assert.NoError(t, v.Validate(context.Background(), "jane@example.com"))
+	assert.Equal(t, 2, resolver.calls, "transient failures are not cached")
+
+	resolver.err = errors.New("resolver unavailable")
+	assert.NoError(t, v.Validate(context.Background(), "jane@example.com"))
+}
+
+func TestDomainValidator_CachesDomainVerdicts(t *testing.T) {
+	resolver := &fakeResolver{records: map[string][]*net.MX{
+		"example.com": {{Host: "mx.example.com.", Pref: 10}},
+	}}
+	v := newTestValidator(t, ModeMX, resolver)
+	now := time.Now()
+	v.now = func() time.Time { return now }
+
+	require.NoError(t, v.Validate(context.Background(), "jane@example.com"))
+	require.NoError(t, v.Validate(context.Background(), "john@EXAMPLE.com"))
+	require.ErrorIs(t, v.Validate(context.Background(), "jane@unknown.example"), ErrUndeliverableDomain)
+	require.ErrorIs(t, v.Validate(context.Background(), "john@unknown.example"), ErrUndeliverableDomain)
+	assert.Equal(t, 2, resolver.calls)
+
+	now = now.Add(2 * time.Hour)
+	require.NoError(t, v.Validate(context.Background(), "jane@example.com"))
+	assert.Equal(t, 3, resolver.calls, "expired verdicts are looked up again")
+}
+
+func TestDescribe(t *testing.T) {
+	assert.Equal(t, "Disposable email addresses are not accepted", Describe(fmt.Errorf("wrapped: %w", ErrDisposableDomain)))
+	assert.Equal(t, "Email domain cannot receive mail", Describe(ErrUndeliverableDomain))
+	assert.Equal(t, "Email address is invalid", Describe(ErrInvalidSyntax))
+}
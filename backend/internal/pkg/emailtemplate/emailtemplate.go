@@ -0,0 +1,194 @@
+// Package emailtemplate renders transactional emails from the templates embedded
+// under templates/<locale>/.
+//
+// Every email <name> has two files per locale:
+//   - <name>.html defines "content", rendered inside the locale's layout.html;
+//   - <name>.txt defines "subject" and "body", the body rendered inside layout.txt.
+//
+// A locale only needs the emails it translates; the others fall back to DefaultLocale.
+// Data passed to Render is checked against its `validate` struct tags first, so an
+// email with a missing gift name fails loudly instead of going out half empty.
+package emailtemplate
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultLocale has every email and is used when a locale lacks one
+const DefaultLocale = "en"
+
+var (
+	ErrUnknownTemplate = errors.New("unknown email template")
+	ErrInvalidData     = errors.New("invalid email template data")
+)
+
+//go:embed templates
+var files embed.FS
+
+// Message is a rendered email
+type Message struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type email struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Set holds the parsed templates of every locale
+type Set struct {
+	locales  map[string]map[string]email
+	validate *validator.Validate
+}
+
+// Embedded returns the templates compiled into the binary. They are parsed once;
+// a broken template panics, which the package tests catch before release.
+var Embedded = sync.OnceValue(func() *Set {
+	set, err := Load(files)
+	if err != nil {
+		panic(err)
+	}
+	return set
+})
+
+// Load parses the templates found under templates/ in fsys
+func Load(fsys fs.FS) (*Set, error) {
+	dirs, err := fs.ReadDir(fsys, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read email templates: %w", err)
+	}
+
+	set := &Set{locales: make(map[string]map[string]email), validate: validator.New()}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		emails, err := loadLocale(fsys, path.Join("templates", dir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("locale %s: %w", dir.Name(), err)
+		}
+		set.locales[dir.Name()] = emails
+	}
+	if _, ok := set.locales[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("email templates for default locale %q are missing", DefaultLocale)
+	}
+	return set, nil
+}
+
+func loadLocale(fsys fs.FS, dir string) (map[string]email, error) {
+	htmlLayout, textLayout := path.Join(dir, "layout.html"), path.Join(dir, "layout.txt")
+	names, err := fs.Glob(fsys, path.Join(dir, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]email)
+	for _, file := range names {
+		if file == htmlLayout {
+			continue
+		}
+		name := strings.TrimSuffix(path.Base(file), ".html")
+
+		// subject is replaced with the rendered subject line on every Render
+		html, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap{"subject": func() string { return "" }}).
+			ParseFS(fsys, htmlLayout, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		text, err := texttemplate.New(name).ParseFS(fsys, textLayout, path.Join(dir, name+".txt"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s.txt: %w", name, err)
+		}
+		if text.Lookup("subject") == nil {
+			return nil, fmt.Errorf("%s.txt does not define a subject", name)
+		}
+		emails[name] = email{html: html, text: text}
+	}
+	return emails, nil
+}
+
+// Names returns the emails of the default locale, sorted
+func (s *Set) Names() []string {
+	names := make([]string, 0, len(s.locales[DefaultLocale]))
+	for name := range s.locales[DefaultLocale] {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Render validates data and renders the email name in locale. A locale such as
+// "pt-BR" falls back to "pt", then to DefaultLocale.
+func (s *Set) Render(name, locale string, data any) (Message, error) {
+	tmpl, ok := s.lookup(name, locale)
+	if !ok {
+		return Message{}, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+	if err := s.check(data); err != nil {
+		return Message{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "layout", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+
+	subjectLine := strings.TrimSpace(subject.String())
+	htmlTmpl, err := tmpl.html.Clone()
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+	htmlTmpl.Funcs(htmltemplate.FuncMap{"subject": func() string { return subjectLine }})
+	if err := htmlTmpl.ExecuteTemplate(&html, "layout", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	return Message{Subject: subjectLine, HTML: html.String(), Text: text.String()}, nil
+}
+
+func (s *Set) lookup(name, locale string) (email, bool) {
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+	for _, candidate := range append(candidates, DefaultLocale) {
+		if tmpl, ok := s.locales[strings.ToLower(candidate)][name]; ok {
+			return tmpl, true
+		}
+	}
+	return email{}, false
+}
+
+// check runs the validate tags of data, naming every failed field in the error
+func (s *Set) check(data any) error {
+	err := s.validate.Struct(data)
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		fields := make([]string, 0, len(fieldErrs))
+		for _, fe := range fieldErrs {
+			fields = append(fields, fe.Field()+" ("+fe.Tag()+")")
+		}
+		return fmt.Errorf("%w: %s", ErrInvalidData, strings.Join(fields, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidData, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package emailtemplate
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type greetingData struct {
+	Name  string `validate:"required"`
+	Notes []string
+}
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"templates/en/layout.html":   {Data: []byte(`{{define "layout"}}<title>{{subject}}</title>{{template "content" .}}{{end}}`)},
+		"templates/en/layout.txt":    {Data: []byte(`{{define "layout"}}{{template "body" .}} -- footer{{end}}`)},
+		"templates/en/greeting.html": {Data: []byte(`{{define "content"}}<p>Hello {{.Name}}</p>{{end}}`)},
+		"templates/en/greeting.txt":  {Data: []byte(`{{define "subject"}}Hi {{.Name}}{{end}}{{define "body"}}Hello {{.Name}}{{end}}`)},
+		"templates/en/farewell.html": {Data: []byte(`{{define "content"}}<p>Bye {{.Name}}</p>{{end}}`)},
+		"templates/en/farewell.txt":  {Data: []byte(`{{define "subject"}}Bye{{end}}{{define "body"}}Bye {{.Name}}{{end}}`)},
+		"templates/de/layout.html":   {Data: []byte(`{{define "layout"}}<title>{{subject}}</title>{{template "content" .}}{{end}}`)},
+		"templates/de/layout.txt":    {Data: []byte(`{{define "layout"}}{{template "body" .}} -- Fußzeile{{end}}`)},
+		"templates/de/greeting.html": {Data: []byte(`{{define "content"}}<p>Hallo {{.Name}}</p>{{end}}`)},
+		"templates/de/greeting.txt":  {Data: []byte(`{{define "subject"}}Hallo {{.Name}}{{end}}{{define "body"}}Hallo {{.Name}}{{end}}`)},
+	}
+}
+
+func TestRender(t *testing.T) {
+	set, err := Load(testFS())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"farewell", "greeting"}, set.Names())
+
+	t.Run("renders subject, html and text", func(t *testing.T) {
+		msg, err := set.Render("greeting", "en", greetingData{Name: "<Ann>"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Hi <Ann>", msg.Subject)
+		assert.Equal(t, "<title>Hi &lt;Ann&gt;</title><p>Hello &lt;Ann&gt;</p>", msg.HTML)
+		assert.Equal(t, "Hello <Ann> -- footer", msg.Text)
+	})
+
+	t.Run("uses the base language of a regional locale", func(t *testing.T) {
+		msg, err := set.Render("greeting", "de-AT", greetingData{Name: "Ann"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Hallo Ann", msg.Subject)
+		assert.Equal(t, "Hallo Ann -- Fußzeile", msg.Text)
+	})
+
+	t.Run("falls back to the default locale", func(t *testing.T) {
+		msg, err := set.Render("farewell", "de", greetingData{Name: "Ann"})
+		require.NoError(t, err)
+		assert.Equal(t, "Bye Ann -- footer", msg.Text)
+
+		msg, err = set.Render("greeting", "fr", greetingData{Name: "Ann"})
+		require.NoError(t, err)
+		assert.Equal(t, "Hi Ann", msg.Subject)
+	})
+
+	t.Run("rejects invalid data", func(t *testing.T) {
+		_, err := set.Render("greeting", "en", greetingData{})
+		require.ErrorIs(t, err, ErrInvalidData)
+		assert.Contains(t, err.Error(), "Name (required)")
+
+		_, err = set.Render("greeting", "en", nil)
+		assert.ErrorIs(t, err, ErrInvalidData)
+	})
+
+	t.Run("rejects unknown templates", func(t *testing.T) {
+		_, err := set.Render("missing", "en", greetingData{Name: "Ann"})
+		assert.ErrorIs(t, err, ErrUnknownTemplate)
+	})
+}
+
+func TestLoad_Errors(t *testing.T) {
+	t.Run("missing text part", func(t *testing.T) {
+		fsys := testFS()
+		delete(fsys, "templates/en/farewell.txt")
+
+		_, err := Load(fsys)
+		assert.ErrorContains(t, err, "farewell")
+	})
+
+	t.Run("missing subject", func(t *testing.T) {
+		fsys := testFS()
+		fsys["templates/en/farewell.txt"] = &fstest.MapFile{Data: []byte(`{{define "body"}}Bye{{end}}`)}
+
+		_, err := Load(fsys)
+		assert.ErrorContains(t, err, "subject")
+	})
+
+	t.Run("missing default locale", func(t *testing.T) {
+		fsys := testFS()
+		for name := range fsys {
+			if strings.HasPrefix(name, "templates/en/") {
+				delete(fsys, name)
+			}
+		}
+
+		_, err := Load(fsys)
+		assert.ErrorContains(t, err, "default locale")
+	})
+}
+
+func TestEmbedded(t *testing.T) {
+	set, err := Load(files)
+	require.NoError(t, err)
+	assert.NotEmpty(t, set.Names())
+}
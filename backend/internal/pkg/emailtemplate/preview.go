@@ -0,0 +1,48 @@
+package emailtemplate
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PreviewHandler renders an email with sample data, so templates can be checked in
+// a browser without sending anything. samples maps each email name to its data.
+//
+// Query parameters: locale (default DefaultLocale) and format, "html" (default) or
+// "text". Only meant for development servers.
+func PreviewHandler(set *Set, samples map[string]any) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		name := c.Param("template")
+		data, ok := samples[name]
+		if !ok {
+			return apperrors.NotFound("Unknown email template").
+				WithDetails(map[string]string{"templates": strings.Join(set.Names(), ", ")})
+		}
+
+		locale := c.QueryParam("locale")
+		if locale == "" {
+			locale = DefaultLocale
+		}
+		msg, err := set.Render(name, locale, data)
+		if errors.Is(err, ErrUnknownTemplate) {
+			return apperrors.NotFound("Unknown email template")
+		}
+		if err != nil {
+			return apperrors.Internal("Failed to render email template").Wrap(err)
+		}
+
+		switch c.QueryParam("format") {
+		case "", "html":
+			return c.HTML(http.StatusOK, msg.HTML)
+		case "text":
+			return c.String(http.StatusOK, "Subject: "+msg.Subject+"\n\n"+msg.Text)
+		default:
+			return apperrors.BadRequest("format must be html or text")
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LoadKeyring builds the full set of data keys used for key rotation.
+//
+// legacyKey is the original unversioned data key (from GetOrCreateDataKey) and is
+// always loaded under LegacyKeyID so existing ciphertext keeps decrypting.
+// Additional versioned keys are read from:
+//   - ENCRYPTION_KEYS: comma-separated "keyID:base64Key" pairs (development/testing)
+//   - ENCRYPTED_DATA_KEYS: comma-separated "keyID:kmsEncryptedKey" pairs, unwrapped via KMS_KEY_ID (production)
+//
+// ENCRYPTION_CURRENT_KEY_ID selects the key used for new encryptions and defaults to the legacy key.
+func LoadKeyring(ctx context.Context, legacyKey []byte) (keys map[string][]byte, currentKeyID string, err error) {
+	keys = map[string][]byte{LegacyKeyID: legacyKey}
+
+	plainKeys, err := parseKeyList(os.Getenv("ENCRYPTION_KEYS"))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse ENCRYPTION_KEYS: %w", err)
+	}
+	for keyID, encoded := range plainKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode key %q: %w", keyID, err)
+		}
+		if len(key) != 32 {
+			return nil, "", fmt.Errorf("key %q must be 32 bytes (got %d)", keyID, len(key))
+		}
+		keys[keyID] = key
+	}
+
+	wrappedKeys, err := parseKeyList(os.Getenv("ENCRYPTED_DATA_KEYS"))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse ENCRYPTED_DATA_KEYS: %w", err)
+	}
+	if len(wrappedKeys) > 0 {
+		kmsKeyID := os.Getenv("KMS_KEY_ID")
+		if kmsKeyID == "" {
+			return nil, "", fmt.Errorf("ENCRYPTED_DATA_KEYS requires KMS_KEY_ID")
+		}
+
+		kmsClient, err := NewKMSClient(ctx, kmsKeyID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create KMS client: %w", err)
+		}
+
+		for keyID, wrapped := range wrappedKeys {
+			key, err := kmsClient.DecryptDataKey(ctx, wrapped)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to decrypt key %q: %w", keyID, err)
+			}
+			keys[keyID] = key
+		}
+	}
+
+	currentKeyID = os.Getenv("ENCRYPTION_CURRENT_KEY_ID")
+	if _, ok := keys[currentKeyID]; !ok {
+		return nil, "", fmt.Errorf("%w: ENCRYPTION_CURRENT_KEY_ID=%q is not loaded", ErrUnknownKeyID, currentKeyID)
+	}
+
+	return keys, currentKeyID, nil
+}
+
+// parseKeyList parses a comma-separated list of "keyID:value" pairs
+func parseKeyList(value string) (map[string]string, error) {
+	result := make(map[string]string)
+	if strings.TrimSpace(value) == "" {
+		return result, nil
+	}
+
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		keyID, keyValue, found := strings.Cut(entry, keyIDSeparator)
+		keyID = strings.TrimSpace(keyID)
+		if !found || keyID == "" || keyValue == "" {
+			return nil, fmt.Errorf("invalid entry %q: expected keyID:value", entry)
+		}
+		if _, exists := result[keyID]; exists {
+			return nil, fmt.Errorf("duplicate key ID %q", keyID)
+		}
+		result[keyID] = strings.TrimSpace(keyValue)
+	}
+
+	return result, nil
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 )
 
 var (
@@ -16,17 +20,79 @@ var (
 	ErrInvalidCiphertext = errors.New("invalid ciphertext")
 	// ErrInvalidKeySize is returned when the encryption key size is incorrect
 	ErrInvalidKeySize = errors.New("encryption key must be 32 bytes for AES-256")
+	// ErrUnknownKeyID is returned when ciphertext references a key that is not loaded
+	ErrUnknownKeyID = errors.New("unknown encryption key ID")
+	// ErrInvalidKeyID is returned when a key ID contains the separator character
+	ErrInvalidKeyID = errors.New("encryption key ID must not contain ':'")
 )
 
-// Service provides field-level encryption for PII data using AES-256-GCM
+// keyIDSeparator separates the key ID from the base64 payload in versioned ciphertext.
+// Base64 never produces ':', so unprefixed values are unambiguously legacy ciphertext.
+const keyIDSeparator = ":"
+
+// LegacyKeyID identifies the original unversioned data key.
+// Ciphertext produced with this key carries no key ID prefix.
+const LegacyKeyID = ""
+
+// Service provides field-level encryption for PII data using AES-256-GCM.
+//
+// Multiple data keys can be loaded to support key rotation: new values are always
+// encrypted with the current key and stored as "<keyID>:<base64>", while values
+// written with any older loaded key (including legacy unprefixed values) still decrypt.
+//
+// Encrypted values cannot be compared in SQL, so HashEmail provides a keyed hash for
+// lookups and unique indexes on encrypted email columns.
 type Service struct {
-	dataKey []byte // 32-byte key for AES-256
-	gcm     cipher.AEAD
+	keys         map[string]cipher.AEAD
+	currentKeyID string
+	hashKey      []byte
 }
 
 // NewService creates a new encryption service with the provided data key
 // The key must be 32 bytes for AES-256 encryption
 func NewService(dataKey []byte) (*Service, error) {
+	return NewServiceWithKeys(map[string][]byte{LegacyKeyID: dataKey}, LegacyKeyID)
+}
+
+// NewServiceWithKeys creates an encryption service with a versioned keyring.
+// currentKeyID selects the key used for all new encryptions; every other key
+// is kept for decryption only.
+func NewServiceWithKeys(dataKeys map[string][]byte, currentKeyID string) (*Service, error) {
+	if _, ok := dataKeys[currentKeyID]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKeyID, currentKeyID)
+	}
+
+	keys := make(map[string]cipher.AEAD, len(dataKeys))
+	for keyID, dataKey := range dataKeys {
+		if strings.Contains(keyID, keyIDSeparator) {
+			return nil, ErrInvalidKeyID
+		}
+
+		gcm, err := newGCM(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		keys[keyID] = gcm
+	}
+
+	// Hashes must not change when the current key is rotated, so they are keyed by the
+	// legacy key, which LoadKeyring always loads
+	hashSource, ok := dataKeys[LegacyKeyID]
+	if !ok {
+		hashSource = dataKeys[currentKeyID]
+	}
+	mac := hmac.New(sha256.New, hashSource)
+	mac.Write([]byte("email-hash"))
+
+	return &Service{
+		keys:         keys,
+		currentKeyID: currentKeyID,
+		hashKey:      mac.Sum(nil),
+	}, nil
+}
+
+// newGCM creates an AES-256-GCM AEAD for the given key
+func newGCM(dataKey []byte) (cipher.AEAD, error) {
 	if len(dataKey) != 32 {
 		return nil, ErrInvalidKeySize
 	}
@@ -41,46 +107,62 @@ func NewService(dataKey []byte) (*Service, error) {
 		return nil, fmt.Errorf("failed to create GCM: %w", err)
 	}
 
-	return &Service{
-		dataKey: dataKey,
-		gcm:     gcm,
-	}, nil
+	return gcm, nil
+}
+
+// CurrentKeyID returns the ID of the key used for new encryptions
+func (s *Service) CurrentKeyID() string {
+	return s.currentKeyID
 }
 
 // Encrypt encrypts plaintext using AES-256-GCM and returns base64-encoded ciphertext
-// The ciphertext includes the nonce prepended to the encrypted data
+// The ciphertext includes the nonce prepended to the encrypted data, and is prefixed
+// with the current key ID unless the current key is the legacy key
 func (s *Service) Encrypt(ctx context.Context, plaintext string) (string, error) {
 	if plaintext == "" {
 		return "", nil
 	}
 
+	gcm := s.keys[s.currentKeyID]
+
 	// Generate a random nonce
-	nonce := make([]byte, s.gcm.NonceSize())
+	nonce := make([]byte, gcm.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return "", fmt.Errorf("failed to generate nonce: %w", err)
 	}
 
 	// Encrypt the plaintext
 	// The nonce is prepended to the ciphertext
-	ciphertext := s.gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
 
 	// Return base64-encoded ciphertext
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
+	encoded := base64.StdEncoding.EncodeToString(ciphertext)
+	if s.currentKeyID == LegacyKeyID {
+		return encoded, nil
+	}
+	return s.currentKeyID + keyIDSeparator + encoded, nil
 }
 
 // Decrypt decrypts base64-encoded ciphertext using AES-256-GCM
+// with whichever loaded key the ciphertext was produced by
 func (s *Service) Decrypt(ctx context.Context, ciphertext string) (string, error) {
 	if ciphertext == "" {
 		return "", nil
 	}
 
+	keyID, payload := splitKeyID(ciphertext)
+	gcm, ok := s.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownKeyID, keyID)
+	}
+
 	// Decode base64
-	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	data, err := base64.StdEncoding.DecodeString(payload)
 	if err != nil {
 		return "", fmt.Errorf("failed to decode base64: %w", err)
 	}
 
-	nonceSize := s.gcm.NonceSize()
+	nonceSize := gcm.NonceSize()
 	if len(data) < nonceSize {
 		return "", ErrInvalidCiphertext
 	}
@@ -89,7 +171,7 @@ func (s *Service) Decrypt(ctx context.Context, ciphertext string) (string, error
 	nonce, ciphertextBytes := data[:nonceSize], data[nonceSize:]
 
 	// Decrypt
-	plaintext, err := s.gcm.Open(nil, nonce, ciphertextBytes, nil)
+	plaintext, err := gcm.Open(nil, nonce, ciphertextBytes, nil)
 	if err != nil {
 		return "", fmt.Errorf("%w", ErrInvalidCiphertext)
 	}
@@ -97,6 +179,47 @@ func (s *Service) Decrypt(ctx context.Context, ciphertext string) (string, error
 	return string(plaintext), nil
 }
 
+// NeedsReEncryption reports whether ciphertext was produced with a key other than the current one
+func (s *Service) NeedsReEncryption(ciphertext string) bool {
+	if ciphertext == "" {
+		return false
+	}
+	keyID, _ := splitKeyID(ciphertext)
+	return keyID != s.currentKeyID
+}
+
+// ReEncrypt decrypts ciphertext with its original key and encrypts it again with the current key.
+// Ciphertext already using the current key is returned unchanged.
+func (s *Service) ReEncrypt(ctx context.Context, ciphertext string) (string, error) {
+	if !s.NeedsReEncryption(ciphertext) {
+		return ciphertext, nil
+	}
+
+	plaintext, err := s.Decrypt(ctx, ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Encrypt(ctx, plaintext)
+}
+
+// HashEmail returns a hex-encoded HMAC-SHA256 of the trimmed, lowercased email.
+// Equal addresses always hash alike, whichever key encrypts new values.
+func (s *Service) HashEmail(email string) string {
+	mac := hmac.New(sha256.New, s.hashKey)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// splitKeyID separates the key ID prefix from the base64 payload.
+// Unprefixed (legacy) ciphertext maps to LegacyKeyID.
+func splitKeyID(ciphertext string) (keyID, payload string) {
+	if keyID, payload, found := strings.Cut(ciphertext, keyIDSeparator); found {
+		return keyID, payload
+	}
+	return LegacyKeyID, ciphertext
+}
+
 // EncryptFields encrypts multiple fields in a single call
 func (s *Service) EncryptFields(ctx context.Context, fields map[string]string) (map[string]string, error) {
 	encrypted := make(map[string]string, len(fields))
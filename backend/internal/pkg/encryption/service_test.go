@@ -3,7 +3,9 @@ package encryption
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"errors"
+	"strings"
 	"testing"
 )
 
@@ -343,3 +345,186 @@ func TestKeyRotation(t *testing.T) {
 		}
 	})
 }
+
+func randomKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("failed to generate random key: %v", err)
+	}
+	return key
+}
+
+func TestKeyRotation_VersionedKeys(t *testing.T) {
+	ctx := context.Background()
+	legacyKey := randomKey(t)
+	newKey := randomKey(t)
+	plaintext := "rotate-me@example.com"
+
+	legacySvc, err := NewService(legacyKey)
+	if err != nil {
+		t.Fatalf("failed to create legacy service: %v", err)
+	}
+	legacyCiphertext, err := legacySvc.Encrypt(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("encrypt failed: %v", err)
+	}
+
+	rotated, err := NewServiceWithKeys(map[string][]byte{
+		LegacyKeyID: legacyKey,
+		"v2":        newKey,
+	}, "v2")
+	if err != nil {
+		t.Fatalf("failed to create rotated service: %v", err)
+	}
+
+	t.Run("new ciphertext is prefixed with current key ID", func(t *testing.T) {
+		ciphertext, err := rotated.Encrypt(ctx, plaintext)
+		if err != nil {
+			t.Fatalf("encrypt failed: %v", err)
+		}
+		if !strings.HasPrefix(ciphertext, "v2:") {
+			t.Fatalf("expected v2: prefix, got %q", ciphertext)
+		}
+		if rotated.NeedsReEncryption(ciphertext) {
+			t.Fatal("ciphertext with current key should not need re-encryption")
+		}
+	})
+
+	t.Run("legacy ciphertext still decrypts", func(t *testing.T) {
+		decrypted, err := rotated.Decrypt(ctx, legacyCiphertext)
+		if err != nil {
+			t.Fatalf("decrypt failed: %v", err)
+		}
+		if decrypted != plaintext {
+			t.Fatalf("expected %q, got %q", plaintext, decrypted)
+		}
+	})
+
+	t.Run("re-encrypt migrates to current key", func(t *testing.T) {
+		if !rotated.NeedsReEncryption(legacyCiphertext) {
+			t.Fatal("legacy ciphertext should need re-encryption")
+		}
+
+		reEncrypted, err := rotated.ReEncrypt(ctx, legacyCiphertext)
+		if err != nil {
+			t.Fatalf("re-encrypt failed: %v", err)
+		}
+		if !strings.HasPrefix(reEncrypted, "v2:") {
+			t.Fatalf("expected v2: prefix, got %q", reEncrypted)
+		}
+
+		decrypted, err := rotated.Decrypt(ctx, reEncrypted)
+		if err != nil {
+			t.Fatalf("decrypt failed: %v", err)
+		}
+		if decrypted != plaintext {
+			t.Fatalf("expected %q, got %q", plaintext, decrypted)
+		}
+	})
+
+	t.Run("unknown key ID fails", func(t *testing.T) {
+		_, err := rotated.Decrypt(ctx, "v9:"+legacyCiphertext)
+		if !errors.Is(err, ErrUnknownKeyID) {
+			t.Fatalf("expected ErrUnknownKeyID, got %v", err)
+		}
+	})
+
+	t.Run("current key must be loaded", func(t *testing.T) {
+		_, err := NewServiceWithKeys(map[string][]byte{"v1": newKey}, "v2")
+		if !errors.Is(err, ErrUnknownKeyID) {
+			t.Fatalf("expected ErrUnknownKeyID, got %v", err)
+		}
+	})
+
+	t.Run("key ID must not contain separator", func(t *testing.T) {
+		_, err := NewServiceWithKeys(map[string][]byte{"v:1": newKey}, "v:1")
+		if !errors.Is(err, ErrInvalidKeyID) {
+			t.Fatalf("expected ErrInvalidKeyID, got %v", err)
+		}
+	})
+}
+
+func TestHashEmail(t *testing.T) {
+	legacyKey := randomKey(t)
+
+	svc, err := NewService(legacyKey)
+	if err != nil {
+		t.Fatalf("failed to create service: %v", err)
+	}
+
+	hash := svc.HashEmail("Guest@Example.com")
+	if hash != svc.HashEmail("  guest@example.com ") {
+		t.Fatal("expected case and surrounding spaces to be ignored")
+	}
+	if hash == svc.HashEmail("other@example.com") {
+		t.Fatal("expected different emails to hash differently")
+	}
+
+	// Rotating the current key keeps hashes stable
+	rotated, err := NewServiceWithKeys(map[string][]byte{LegacyKeyID: legacyKey, "v2": randomKey(t)}, "v2")
+	if err != nil {
+		t.Fatalf("failed to create rotated service: %v", err)
+	}
+	if hash != rotated.HashEmail("guest@example.com") {
+		t.Fatal("expected hash to survive key rotation")
+	}
+
+	other, err := NewService(randomKey(t))
+	if err != nil {
+		t.Fatalf("failed to create other service: %v", err)
+	}
+	if hash == other.HashEmail("guest@example.com") {
+		t.Fatal("expected hash to depend on the key")
+	}
+}
+
+func TestLoadKeyring(t *testing.T) {
+	ctx := context.Background()
+	legacyKey := randomKey(t)
+	v2Key := base64.StdEncoding.EncodeToString(randomKey(t))
+
+	t.Run("defaults to legacy key", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_KEYS", "")
+		t.Setenv("ENCRYPTION_CURRENT_KEY_ID", "")
+
+		keys, current, err := LoadKeyring(ctx, legacyKey)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if current != LegacyKeyID || len(keys) != 1 {
+			t.Fatalf("expected only legacy key, got %d keys, current %q", len(keys), current)
+		}
+	})
+
+	t.Run("loads versioned keys", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_KEYS", "v2:"+v2Key)
+		t.Setenv("ENCRYPTION_CURRENT_KEY_ID", "v2")
+
+		keys, current, err := LoadKeyring(ctx, legacyKey)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if current != "v2" || len(keys) != 2 {
+			t.Fatalf("expected 2 keys with current v2, got %d keys, current %q", len(keys), current)
+		}
+	})
+
+	t.Run("rejects unknown current key", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_KEYS", "v2:"+v2Key)
+		t.Setenv("ENCRYPTION_CURRENT_KEY_ID", "v3")
+
+		if _, _, err := LoadKeyring(ctx, legacyKey); !errors.Is(err, ErrUnknownKeyID) {
+			t.Fatalf("expected ErrUnknownKeyID, got %v", err)
+		}
+	})
+
+	t.Run("rejects malformed entries", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_KEYS", "v2")
+		t.Setenv("ENCRYPTION_CURRENT_KEY_ID", "")
+
+		if _, _, err := LoadKeyring(ctx, legacyKey); err == nil {
+			t.Fatal("expected error for malformed ENCRYPTION_KEYS")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+// Package fieldset implements JSON:API style sparse fieldsets.
+//
+// Clients list the members they need, e.g. ?fields[items]=name,price, and
+// responses carry only those members plus "id". A zero Set selects every
+// member, so responses are unchanged for clients that do not ask.
+//
+// Usage:
+//
+//	fields, err := fieldset.Parse("name,price", ItemResponse{})
+//	data, err := fields.Marshal(item) // {"id":"...","name":"...","price":9.99}
+package fieldset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var ErrUnknownField = errors.New("unknown field")
+
+// idField is always part of a selection, so clients can tell resources apart
+const idField = "id"
+
+// Set is a selection of JSON members of one resource type
+type Set struct {
+	names []string // Sorted; empty selects every member
+}
+
+// Parse reads a comma-separated list of JSON member names of model, which must
+// be a struct or a pointer to one. An empty list selects every member.
+func Parse(raw string, model any) (Set, error) {
+	known := Names(model)
+
+	var names []string
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(known, name) {
+			return Set{}, fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return Set{}, nil
+	}
+
+	if slices.Contains(known, idField) {
+		names = append(names, idField)
+	}
+	slices.Sort(names)
+	return Set{names: slices.Compact(names)}, nil
+}
+
+// IsZero reports whether the set selects every member
+func (s Set) IsZero() bool {
+	return len(s.names) == 0
+}
+
+// Fields returns the selected member names in sorted order
+func (s Set) Fields() []string {
+	return slices.Clone(s.names)
+}
+
+// Marshal encodes v as JSON keeping only the selected members
+func (s Set) Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || s.IsZero() {
+		return data, err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, fmt.Errorf("sparse fieldsets need a JSON object: %w", err)
+	}
+	for name := range members {
+		if _, found := slices.BinarySearch(s.names, name); !found {
+			delete(members, name)
+		}
+	}
+	return json.Marshal(members)
+}
+
+// Names lists the JSON member names of a struct type in declaration order
+func Names(model any) []string {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,70 @@
+package fieldset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resource struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Price    float64 `json:"price"`
+	Category string  `json:"category,omitempty"`
+	Secret   string  `json:"-"`
+	internal string
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"id", "name", "price", "category"}, Names(resource{}))
+	assert.Equal(t, []string{"id", "name", "price", "category"}, Names(&resource{}))
+	assert.Nil(t, Names("not a struct"))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected []string
+	}{
+		{name: "empty selects everything", raw: "", expected: nil},
+		{name: "blank entries are ignored", raw: " , ", expected: nil},
+		{name: "id is always included", raw: "name,price", expected: []string{"id", "name", "price"}},
+		{name: "duplicates collapse", raw: "price, name,price,id", expected: []string{"id", "name", "price"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := Parse(tt.raw, resource{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, fields.Fields())
+			assert.Equal(t, tt.expected == nil, fields.IsZero())
+		})
+	}
+
+	for _, raw := range []string{"owner_id", "name,Secret", "internal"} {
+		_, err := Parse(raw, resource{})
+		assert.ErrorIs(t, err, ErrUnknownField, raw)
+	}
+}
+
+func TestSet_Marshal(t *testing.T) {
+	value := resource{ID: "item-1", Name: "Headphones", Price: 99.5, Category: "Audio"}
+
+	data, err := Set{}.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"item-1","name":"Headphones","price":99.5,"category":"Audio"}`, string(data))
+
+	fields, err := Parse("price,category", resource{})
+	require.NoError(t, err)
+	data, err = fields.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"item-1","price":99.5,"category":"Audio"}`, string(data))
+
+	// An omitted empty member stays omitted
+	value.Category = ""
+	data, err = fields.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"item-1","price":99.5}`, string(data))
+}
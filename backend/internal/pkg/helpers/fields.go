@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"wish-list/internal/pkg/apperrors"
+	"wish-list/internal/pkg/fieldset"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ParseFields reads the sparse fieldset a client asked for on resource, from
+// ?fields[resource]=a,b or, for the endpoint's primary resource, ?fields=a,b.
+// model is the response struct whose JSON members may be selected.
+//
+// Example usage in handler:
+//
+//	fields, err := helpers.ParseFields(c, "items", dto.ItemResponse{})
+//	if err != nil {
+//	    return err
+//	}
+func ParseFields(c echo.Context, resource string, model any) (fieldset.Set, error) {
+	raw := c.QueryParam("fields[" + resource + "]")
+	if raw == "" {
+		raw = c.QueryParam("fields")
+	}
+
+	fields, err := fieldset.Parse(raw, model)
+	if err != nil {
+		return fieldset.Set{}, apperrors.BadRequest("Invalid fields parameter").
+			WithDetails(map[string]string{"fields": err.Error()})
+	}
+	return fields, nil
+}
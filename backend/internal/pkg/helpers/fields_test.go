@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/pkg/apperrors"
+)
+
+func TestParseFields(t *testing.T) {
+	type item struct {
+		ID    string  `json:"id"`
+		Name  string  `json:"name"`
+		Price float64 `json:"price"`
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expected      []string
+		expectedError bool
+	}{
+		{name: "no selection", query: "", expected: nil},
+		{name: "plain fields", query: "fields=name", expected: []string{"id", "name"}},
+		{name: "resource fields", query: "fields%5Bitems%5D=price", expected: []string{"id", "price"}},
+		{name: "resource fields win over plain fields", query: "fields=name&fields%5Bitems%5D=price", expected: []string{"id", "price"}},
+		{name: "other resource is ignored", query: "fields%5Bwishlists%5D=title", expected: nil},
+		{name: "unknown field", query: "fields=owner_id", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			fields, err := ParseFields(c, "items", item{})
+
+			if tt.expectedError {
+				var appErr *apperrors.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, http.StatusBadRequest, appErr.Code)
+				assert.Contains(t, appErr.Details["fields"], "owner_id")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, fields.Fields())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"strconv"
+	"strings"
+
+	"wish-list/internal/pkg/apperrors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// versionRequiredKey marks requests whose updates must name the version they
+// were based on, see RequireVersionMiddleware
+const versionRequiredKey = "version_required"
+
+// RequireVersionMiddleware makes ExpectedVersion reject updates that send
+// neither If-Match nor a version field, so a client that never learned about
+// versions cannot silently overwrite someone else's change.
+func RequireVersionMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(versionRequiredKey, true)
+			return next(c)
+		}
+	}
+}
+
+// SetVersionETag sets the ETag response header to the entity version,
+// so clients can echo it back in If-Match on their next update.
+func SetVersionETag(c echo.Context, version int32) {
+	c.Response().Header().Set("ETag", strconv.Quote(strconv.FormatInt(int64(version), 10)))
+}
+
+// ExpectedVersion returns the entity version an update request was based on.
+// The If-Match header takes precedence over bodyVersion (the optional "version"
+// field of the request body). Returns nil when the client sent neither, or
+// If-Match: *, in which case the update is not checked against a client version.
+// Behind RequireVersionMiddleware sending neither is a 428 Precondition Required
+// instead; If-Match: * still opts out explicitly.
+//
+// Example usage in handler:
+//
+//	version, err := helpers.ExpectedVersion(c, req.Version)
+//	if err != nil {
+//	    return err
+//	}
+func ExpectedVersion(c echo.Context, bodyVersion *int32) (*int32, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	if ifMatch == "" && bodyVersion == nil {
+		if required, _ := c.Get(versionRequiredKey).(bool); required {
+			return nil, apperrors.PreconditionRequired("Send If-Match with the ETag of the version you are updating")
+		}
+	}
+	if ifMatch == "" || ifMatch == "*" {
+		return bodyVersion, nil
+	}
+
+	tag := strings.TrimPrefix(ifMatch, "W/")
+	if unquoted, err := strconv.Unquote(tag); err == nil {
+		tag = unquoted
+	}
+
+	parsed, err := strconv.ParseInt(tag, 10, 32)
+	if err != nil || parsed < 1 {
+		return nil, apperrors.BadRequest("Invalid If-Match header")
+	}
+	version := int32(parsed)
+
+	if bodyVersion != nil && *bodyVersion != version {
+		return nil, apperrors.BadRequest("If-Match header and version field do not match")
+	}
+
+	return &version, nil
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wish-list/internal/pkg/apperrors"
+)
+
+func TestSetVersionETag(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	SetVersionETag(c, 7)
+
+	assert.Equal(t, `"7"`, rec.Header().Get("ETag"))
+}
+
+func TestExpectedVersion(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name          string
+		ifMatch       string
+		bodyVersion   *int32
+		required      bool
+		expected      *int32
+		expectedCode  int
+		expectedError string
+	}{
+		{name: "no version sent", expected: nil},
+		{name: "body version only", bodyVersion: int32Ptr(3), expected: int32Ptr(3)},
+		{name: "quoted If-Match", ifMatch: `"4"`, expected: int32Ptr(4)},
+		{name: "weak If-Match", ifMatch: `W/"5"`, expected: int32Ptr(5)},
+		{name: "unquoted If-Match", ifMatch: "6", expected: int32Ptr(6)},
+		{name: "wildcard falls back to body", ifMatch: "*", bodyVersion: int32Ptr(2), expected: int32Ptr(2)},
+		{name: "header and body agree", ifMatch: `"2"`, bodyVersion: int32Ptr(2), expected: int32Ptr(2)},
+		{name: "header and body disagree", ifMatch: `"2"`, bodyVersion: int32Ptr(3), expectedError: "If-Match header and version field do not match"},
+		{name: "not a number", ifMatch: `"abc"`, expectedError: "Invalid If-Match header"},
+		{name: "zero version", ifMatch: `"0"`, expectedError: "Invalid If-Match header"},
+		{name: "required but not sent", required: true, expectedCode: http.StatusPreconditionRequired, expectedError: "Send If-Match with the ETag of the version you are updating"},
+		{name: "required and sent in the body", required: true, bodyVersion: int32Ptr(3), expected: int32Ptr(3)},
+		{name: "required and sent in If-Match", required: true, ifMatch: `"4"`, expected: int32Ptr(4)},
+		{name: "required but wildcard", required: true, ifMatch: "*", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var version *int32
+			handler := func(c echo.Context) error {
+				var err error
+				version, err = ExpectedVersion(c, tt.bodyVersion)
+				return err
+			}
+			if tt.required {
+				handler = RequireVersionMiddleware()(handler)
+			}
+			err := handler(c)
+
+			if tt.expectedError != "" {
+				var appErr *apperrors.AppError
+				require.ErrorAs(t, err, &appErr)
+				expectedCode := tt.expectedCode
+				if expectedCode == 0 {
+					expectedCode = http.StatusBadRequest
+				}
+				assert.Equal(t, expectedCode, appErr.Code)
+				assert.Equal(t, tt.expectedError, appErr.Message)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
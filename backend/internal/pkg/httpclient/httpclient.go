@@ -0,0 +1,178 @@
+// Package httpclient builds the HTTP clients used for outgoing calls to third
+// parties: OAuth providers, product pages and partner webhooks.
+//
+// Every client has an overall timeout and a pooled transport. Optionally it
+//   - refuses to connect to loopback, private and link-local addresses, checked on
+//     the resolved address when dialing, so DNS tricks and redirects are covered;
+//   - only speaks the allowed URL schemes, again including redirects;
+//   - retries idempotent requests (GET, HEAD, OPTIONS) after network errors and 502,
+//     503 or 504 responses, waiting a random share of an exponential backoff.
+//
+// Usage:
+//
+//	client := httpclient.New(httpclient.Options{
+//	    Timeout:      10 * time.Second,
+//	    BlockPrivate: true,
+//	    Schemes:      []string{"https"},
+//	    MaxRetries:   2,
+//	})
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrForbiddenTarget  = errors.New("target resolves to a non-public address")
+	ErrSchemeNotAllowed = errors.New("URL scheme is not allowed")
+)
+
+// Default retry backoff; the n-th retry waits up to DefaultRetryBackoff * 2^(n-1)
+const DefaultRetryBackoff = 200 * time.Millisecond
+
+// Options configures a client. The zero value is a plain client without timeout,
+// which callers should not rely on.
+type Options struct {
+	// Timeout bounds a whole request including redirects, retries and reading the body
+	Timeout time.Duration
+	// BlockPrivate refuses connections to non-public addresses (SSRF protection)
+	BlockPrivate bool
+	// Schemes lists the allowed URL schemes; empty allows any
+	Schemes []string
+	// MaxRetries is how often an idempotent request is retried; 0 disables retries
+	MaxRetries int
+	// RetryBackoff is the base wait between retries; 0 uses DefaultRetryBackoff
+	RetryBackoff time.Duration
+	// NoRedirects returns redirect responses instead of following them
+	NoRedirects bool
+}
+
+// New creates a client configured with opts
+func New(opts Options) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}
+	if opts.Timeout > 0 {
+		dialer.Timeout = min(dialer.Timeout, opts.Timeout)
+	}
+	if opts.BlockPrivate {
+		dialer.Control = rejectNonPublic
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 10
+	transport.IdleConnTimeout = 90 * time.Second
+	if opts.BlockPrivate {
+		// A proxy would make the dialer check the proxy's address instead of the target's
+		transport.Proxy = nil
+	}
+
+	var rt http.RoundTripper = transport
+	if opts.MaxRetries > 0 {
+		backoff := opts.RetryBackoff
+		if backoff <= 0 {
+			backoff = DefaultRetryBackoff
+		}
+		rt = &retryTransport{next: rt, maxRetries: opts.MaxRetries, backoff: backoff}
+	}
+	if len(opts.Schemes) > 0 {
+		rt = &schemeTransport{next: rt, schemes: opts.Schemes}
+	}
+
+	client := &http.Client{Timeout: opts.Timeout, Transport: rt}
+	if opts.NoRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client
+}
+
+// IsPublicIP reports whether ip is a globally routable unicast address
+func IsPublicIP(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified() && !ip.IsMulticast() && !ip.IsInterfaceLocalMulticast()
+}
+
+// rejectNonPublic is a dialer control hook that refuses connections to non-public addresses
+func rejectNonPublic(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil || !IsPublicIP(net.ParseIP(host)) {
+		return ErrForbiddenTarget
+	}
+	return nil
+}
+
+// schemeTransport rejects requests, including redirects, to other URL schemes
+type schemeTransport struct {
+	next    http.RoundTripper
+	schemes []string
+}
+
+func (t *schemeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !slices.Contains(t.schemes, req.URL.Scheme) {
+		return nil, fmt.Errorf("%w: %q", ErrSchemeNotAllowed, req.URL.Scheme)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// retryTransport retries idempotent requests that failed in a way a retry may fix
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !retryable(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		// Full jitter: a random wait up to the exponential backoff spreads out clients
+		wait := time.Duration(rand.Int64N(int64(t.backoff) << attempt))
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	return false
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrForbiddenTarget) && !errors.Is(err, ErrSchemeNotAllowed) &&
+			!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_BlockPrivate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := New(Options{Timeout: time.Second, BlockPrivate: true}).Get(server.URL)
+	require.ErrorIs(t, err, ErrForbiddenTarget)
+
+	resp, err := New(Options{Timeout: time.Second}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestNew_Schemes(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer target.Close()
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, strings.Replace(target.URL, "http://", "ftp://", 1), http.StatusFound)
+	}))
+	defer redirect.Close()
+
+	client := New(Options{Timeout: time.Second, Schemes: []string{"http"}})
+
+	resp, err := client.Get(target.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	_, err = client.Get(redirect.URL)
+	assert.ErrorIs(t, err, ErrSchemeNotAllowed, "redirects are checked too")
+
+	_, err = New(Options{Timeout: time.Second, Schemes: []string{"https"}}).Get(target.URL)
+	assert.ErrorIs(t, err, ErrSchemeNotAllowed)
+}
+
+func TestNew_Retries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New(Options{Timeout: time.Second, MaxRetries: 2, RetryBackoff: time.Millisecond})
+
+	t.Run("idempotent requests are retried", func(t *testing.T) {
+		calls.Store(0)
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("retries are bounded", func(t *testing.T) {
+		calls.Store(-10)
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(-7), calls.Load())
+	})
+
+	t.Run("other methods are not retried", func(t *testing.T) {
+		calls.Store(0)
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("canceled requests stop retrying", func(t *testing.T) {
+		calls.Store(-10)
+		ctx, cancel := context.WithCancel(context.Background())
+		slow := New(Options{Timeout: time.Second, MaxRetries: 5, RetryBackoff: time.Hour})
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		require.NoError(t, err)
+
+		time.AfterFunc(10*time.Millisecond, cancel)
+		_, err = slow.Do(req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"192.168.0.1", false},
+		{"169.254.169.254", false},
+		{"::1", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicIP(net.ParseIP(tt.ip)))
+		})
+	}
+}
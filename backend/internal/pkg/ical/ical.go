@@ -0,0 +1,157 @@
+// Package ical writes iCalendar (RFC 5545) feeds of all-day events that
+// calendar apps such as Google Calendar and Apple Calendar can subscribe to.
+//
+// Usage:
+//
+//	cal := ical.Calendar{ProdID: "-//Wishlist//Occasions//EN", Name: "Occasions"}
+//	cal.Events = append(cal.Events, ical.Event{UID: "id@wishlist", Summary: "Birthday", Date: date})
+//	data := cal.Bytes(time.Now())
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of an iCalendar feed
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets is the longest content line allowed before folding
+const maxLineOctets = 75
+
+// Calendar is a feed of events
+type Calendar struct {
+	ProdID string // Identifies the product that created the feed
+	Name   string // Shown by calendar apps for the subscription
+	Events []Event
+}
+
+// Event is an all-day event
+type Event struct {
+	UID         string // Globally unique and stable across feed refreshes
+	Summary     string
+	Description string
+	URL         string
+	Date        time.Time // Only the year, month and day are used
+	Yearly      bool      // Repeats every year on the same date
+	Alarms      []Alarm
+}
+
+// Alarm reminds the user of an event. Before is measured from the start of
+// the event's day, so 15*time.Hour fires at 09:00 the day before.
+type Alarm struct {
+	Before      time.Duration
+	Description string
+}
+
+// Bytes renders the calendar. now is used as the DTSTAMP of every event.
+func (c *Calendar) Bytes(now time.Time) []byte {
+	var b strings.Builder
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+c.ProdID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		start := time.Date(event.Date.Year(), event.Date.Month(), event.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+event.UID)
+		writeLine(&b, "DTSTAMP:"+stamp)
+		writeLine(&b, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+		writeLine(&b, "DTEND;VALUE=DATE:"+start.AddDate(0, 0, 1).Format("20060102"))
+		if event.Yearly {
+			writeLine(&b, "RRULE:FREQ=YEARLY")
+		}
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.URL != "" {
+			writeLine(&b, "URL:"+event.URL)
+		}
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		for _, alarm := range event.Alarms {
+			writeLine(&b, "BEGIN:VALARM")
+			writeLine(&b, "ACTION:DISPLAY")
+			writeLine(&b, "DESCRIPTION:"+escapeText(alarm.Description))
+			writeLine(&b, "TRIGGER:"+formatDuration(-alarm.Before))
+			writeLine(&b, "END:VALARM")
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine writes a content line, folding it after 75 octets without
+// splitting multi-byte characters (RFC 5545 section 3.1)
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // Continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+// formatDuration renders d as an iCalendar duration, e.g. -PT15H or P1DT2H
+func formatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+
+	out := sign + "P"
+	if days > 0 {
+		out += fmt.Sprintf("%dD", days)
+	}
+	if hours > 0 || minutes > 0 || days == 0 {
+		out += "T"
+		if hours > 0 || minutes == 0 {
+			out += fmt.Sprintf("%dH", hours)
+		}
+		if minutes > 0 {
+			out += fmt.Sprintf("%dM", minutes)
+		}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Bytes(t *testing.T) {
+	cal := Calendar{
+		ProdID: "-//Wishlist//Occasions//EN",
+		Name:   "Wishlist occasions",
+		Events: []Event{{
+			UID:         "abc@wishlist",
+			Summary:     "Birthday; party, cake",
+			Description: "Line one\nLine two",
+			URL:         "https://example.com/w/anna",
+			Date:        time.Date(2026, time.December, 31, 18, 0, 0, 0, time.FixedZone("UTC+3", 3*3600)),
+			Yearly:      true,
+			Alarms:      []Alarm{{Before: 15 * time.Hour, Description: "Birthday tomorrow"}},
+		}},
+	}
+
+	out := string(cal.Bytes(time.Date(2026, time.October, 15, 12, 30, 0, 0, time.UTC)))
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "X-WR-CALNAME:Wishlist occasions\r\n")
+	assert.Contains(t, out, "DTSTAMP:20261015T123000Z\r\n")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20261231\r\n", "the calendar date is kept regardless of time zone")
+	assert.Contains(t, out, "DTEND;VALUE=DATE:20270101\r\n")
+	assert.Contains(t, out, "RRULE:FREQ=YEARLY\r\n")
+	assert.Contains(t, out, `SUMMARY:Birthday\; party\, cake`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Line one\nLine two`+"\r\n")
+	assert.Contains(t, out, "BEGIN:VALARM\r\nACTION:DISPLAY\r\nDESCRIPTION:Birthday tomorrow\r\nTRIGGER:-PT15H\r\nEND:VALARM\r\n")
+}
+
+func TestWriteLine_Folds(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "SUMMARY:"+strings.Repeat("ж", 60))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+
+	unfolded := strings.ReplaceAll(b.String(), "\r\n ", "")
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("ж", 60)+"\r\n", unfolded, "folding never splits a character")
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-15 * time.Hour, "-PT15H"},
+		{9 * time.Hour, "PT9H"},
+		{-7 * 24 * time.Hour, "-P7D"},
+		{-(6*24*time.Hour + 15*time.Hour), "-P6DT15H"},
+		{90 * time.Minute, "PT1H30M"},
+		{0, "PT0H"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatDuration(tt.d), tt.d.String())
+	}
+}
@@ -0,0 +1,87 @@
+// Package lifecycle tracks background goroutines so shutdown can wait for
+// in-flight work before closing shared resources such as the database.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Group runs named background goroutines and waits for them on shutdown
+type Group struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	running map[string]int
+	closed  bool
+}
+
+// NewGroup creates an empty Group
+func NewGroup() *Group {
+	return &Group{running: make(map[string]int)}
+}
+
+// Go runs fn in a new goroutine tracked under name. Once Wait has been called no
+// new goroutines are started and Go returns false.
+func (g *Group) Go(name string, fn func()) bool {
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		return false
+	}
+	g.wg.Add(1)
+	g.running[name]++
+	g.mu.Unlock()
+
+	go func() {
+		defer func() {
+			g.mu.Lock()
+			g.running[name]--
+			if g.running[name] == 0 {
+				delete(g.running, name)
+			}
+			g.mu.Unlock()
+			g.wg.Done()
+		}()
+		fn()
+	}()
+
+	return true
+}
+
+// Running returns the names of goroutines that have not finished yet
+func (g *Group) Running() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	names := make([]string, 0, len(g.running))
+	for name := range g.running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Wait stops accepting new goroutines and blocks until all tracked goroutines
+// finish or ctx is done. Goroutines should already have been told to stop,
+// typically by canceling the context they were started with.
+func (g *Group) Wait(ctx context.Context) error {
+	g.mu.Lock()
+	g.closed = true
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("background work still running (%s): %w", strings.Join(g.Running(), ", "), ctx.Err())
+	}
+}
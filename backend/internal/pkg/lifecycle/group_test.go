@@ -0,0 +1,60 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_WaitForInFlightWork(t *testing.T) {
+	g := NewGroup()
+
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	require.True(t, g.Go("job", func() {
+		<-release
+		close(finished)
+	}))
+
+	assert.Equal(t, []string{"job"}, g.Running())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	require.NoError(t, g.Wait(context.Background()))
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Wait returned before the goroutine finished")
+	}
+	assert.Empty(t, g.Running())
+}
+
+func TestGroup_WaitTimeout(t *testing.T) {
+	g := NewGroup()
+
+	release := make(chan struct{})
+	defer close(release)
+	g.Go("stuck-job", func() { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := g.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "stuck-job")
+}
+
+func TestGroup_GoAfterWait(t *testing.T) {
+	g := NewGroup()
+	require.NoError(t, g.Wait(context.Background()))
+
+	started := g.Go("late", func() { t.Error("goroutine started after Wait") })
+
+	assert.False(t, started)
+}
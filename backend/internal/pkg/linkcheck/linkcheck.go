@@ -0,0 +1,171 @@
+// Package linkcheck checks whether a product page is still available.
+//
+// A page that is gone (404 or 410) is reported as not found. A page that loads
+// is scanned for the availability markup shops publish for search engines
+// (schema.org Offer availability, Open Graph product:availability), so a sold
+// out or discontinued product is reported as out of stock.
+//
+// Links come from users, so the checker refuses to connect to loopback,
+// private and link-local addresses, including after redirects.
+//
+// Usage:
+//
+//	checker := linkcheck.NewHTTPChecker(10 * time.Second)
+//	result, err := checker.Check(ctx, "https://shop.example.com/product/42")
+//	if errors.Is(err, linkcheck.ErrInconclusive) {
+//	    // try again later
+//	}
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"wish-list/internal/pkg/httpclient"
+)
+
+// Result is the availability of a product page
+type Result string
+
+const (
+	ResultAvailable  Result = "available"
+	ResultOutOfStock Result = "out_of_stock"
+	ResultNotFound   Result = "not_found"
+)
+
+// userAgent identifies the checker to shops
+const userAgent = "WishListLinkChecker/1.0"
+
+// maxBodyBytes bounds how much of a page is scanned for availability markup
+const maxBodyBytes = 512 * 1024
+
+var (
+	ErrInvalidURL      = errors.New("link must be an absolute http or https URL")
+	ErrForbiddenTarget = httpclient.ErrForbiddenTarget
+	ErrInconclusive    = errors.New("availability could not be determined")
+)
+
+// outOfStockPattern matches schema.org and Open Graph markup for unavailable products
+var outOfStockPattern = regexp.MustCompile(
+	`schema\.org/(outofstock|soldout|discontinued)` +
+		`|"availability"\s*:\s*"(outofstock|soldout|discontinued)"` +
+		`|(og|product):availability"\s+content="(out of stock|oos|discontinued)"`,
+)
+
+// Checker reports the availability of a product page
+type Checker interface {
+	Check(ctx context.Context, link string) (Result, error)
+}
+
+// HTTPChecker checks product pages over HTTP
+type HTTPChecker struct {
+	client *http.Client
+}
+
+// NewHTTPChecker creates a checker whose requests time out after timeout
+func NewHTTPChecker(timeout time.Duration) *HTTPChecker {
+	return newHTTPChecker(timeout, false)
+}
+
+// newHTTPChecker creates a checker; allowPrivate lifts the public address restriction for tests
+func newHTTPChecker(timeout time.Duration, allowPrivate bool) *HTTPChecker {
+	return &HTTPChecker{
+		client: httpclient.New(httpclient.Options{
+			Timeout:      timeout,
+			BlockPrivate: !allowPrivate,
+			Schemes:      []string{"http", "https"},
+			MaxRetries:   1,
+		}),
+	}
+}
+
+// Check fetches the page behind link and classifies it. Network failures, server
+// errors and other unexpected responses return ErrInconclusive.
+func (c *HTTPChecker) Check(ctx context.Context, link string) (Result, error) {
+	parsed, err := url.Parse(link)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", ErrInvalidURL
+	}
+
+	// HEAD is cheap and settles dead links; many shops reject it, so anything else falls through to GET
+	if status, err := c.status(ctx, http.MethodHead, link); err == nil && isGone(status) {
+		return ResultNotFound, nil
+	} else if errors.Is(err, ErrForbiddenTarget) {
+		return "", err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, link)
+	if err != nil {
+		return "", err
+	}
+
+	//nolint:gosec // Intentional request to a user-provided link; non-public targets are rejected by the dialer
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", classifyTransportError(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case isGone(resp.StatusCode):
+		return ResultNotFound, nil
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return "", fmt.Errorf("%w: status %d", ErrInconclusive, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInconclusive, err)
+	}
+
+	if outOfStockPattern.Match([]byte(strings.ToLower(string(body)))) {
+		return ResultOutOfStock, nil
+	}
+
+	return ResultAvailable, nil
+}
+
+func (c *HTTPChecker) status(ctx context.Context, method, link string) (int, error) {
+	req, err := c.newRequest(ctx, method, link)
+	if err != nil {
+		return 0, err
+	}
+
+	//nolint:gosec // Intentional request to a user-provided link; non-public targets are rejected by the dialer
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, classifyTransportError(err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+func (c *HTTPChecker) newRequest(ctx context.Context, method, link string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	return req, nil
+}
+
+func isGone(status int) bool {
+	return status == http.StatusNotFound || status == http.StatusGone
+}
+
+func classifyTransportError(err error) error {
+	if errors.Is(err, ErrForbiddenTarget) {
+		return ErrForbiddenTarget
+	}
+	return fmt.Errorf("%w: %w", ErrInconclusive, err)
+}
@@ -0,0 +1,92 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPChecker_Check(t *testing.T) {
+	pages := map[string]struct {
+		status int
+		body   string
+	}{
+		"/available":    {http.StatusOK, `<html><script type="application/ld+json">{"availability": "https://schema.org/InStock"}</script></html>`},
+		"/schema-oos":   {http.StatusOK, `<div itemprop="availability" href="http://schema.org/OutOfStock"></div>`},
+		"/jsonld-oos":   {http.StatusOK, `{"@type":"Offer","availability" : "OutOfStock"}`},
+		"/og-oos":       {http.StatusOK, `<meta property="product:availability" content="out of stock">`},
+		"/gone":         {http.StatusGone, ""},
+		"/server-error": {http.StatusBadGateway, ""},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, userAgent, r.Header.Get("User-Agent"))
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// Like many shops, reject HEAD for pages that exist
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(page.status)
+		_, _ = w.Write([]byte(page.body))
+	}))
+	defer server.Close()
+
+	checker := newHTTPChecker(time.Second, true)
+
+	tests := []struct {
+		path     string
+		expected Result
+		err      error
+	}{
+		{"/available", ResultAvailable, nil},
+		{"/schema-oos", ResultOutOfStock, nil},
+		{"/jsonld-oos", ResultOutOfStock, nil},
+		{"/og-oos", ResultOutOfStock, nil},
+		{"/missing", ResultNotFound, nil},
+		{"/gone", ResultNotFound, nil},
+		{"/server-error", "", ErrInconclusive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result, err := checker.Check(context.Background(), server.URL+tt.path)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestHTTPChecker_RejectsNonPublicTargets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach a loopback server")
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPChecker(time.Second).Check(context.Background(), server.URL)
+
+	require.ErrorIs(t, err, ErrForbiddenTarget)
+}
+
+func TestHTTPChecker_RejectsInvalidURLs(t *testing.T) {
+	checker := NewHTTPChecker(time.Second)
+
+	for _, link := range []string{"", "not a url", "ftp://example.com/file", "/relative/path"} {
+		_, err := checker.Check(context.Background(), link)
+		assert.ErrorIs(t, err, ErrInvalidURL, link)
+	}
+}
@@ -6,8 +6,14 @@
 //	logger.Error("failed to create item", "error", err, "item_id", itemID)
 //	logger.Warn("retry attempt", "attempt", retryCount, "max_retries", maxRetries)
 //
-// The package automatically initializes a global logger with JSON formatting
-// and appropriate log levels based on the environment.
+// Initialize configures a global logger with JSON output in production and
+// human-readable text in development, at a level chosen by the environment.
+//
+// Request-scoped loggers travel in the context: middleware stores one carrying
+// request_id (and user_id once authenticated) with NewContext, and the *Context
+// functions log through it.
+//
+//	logger.InfoContext(ctx, "wishlist created", "wishlist_id", id)
 package logger
 
 import (
@@ -17,9 +23,13 @@ import (
 	"strings"
 )
 
-var log *slog.Logger
+var log = slog.Default()
+
+// ctxKey is the context key for the request-scoped logger
+type ctxKey struct{}
 
-// Initialize sets up the global logger with JSON formatting.
+// Initialize sets up the global logger and makes it the slog default, so output
+// from the standard log package goes through it too.
 // Call this once during application startup.
 func Initialize(env string) {
 	level := slog.LevelInfo
@@ -34,8 +44,7 @@ func Initialize(env string) {
 		level = slog.LevelWarn
 	}
 
-	// Create JSON handler for structured logging
-	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	opts := &slog.HandlerOptions{
 		Level: level,
 		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
 			// Customize attribute names if needed
@@ -45,7 +54,16 @@ func Initialize(env string) {
 			}
 			return a
 		},
-	})
+	}
+
+	// JSON for log aggregation everywhere except local development
+	var handler slog.Handler
+	switch strings.ToLower(env) {
+	case "development", "dev":
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	default:
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
 
 	log = slog.New(handler)
 	slog.SetDefault(log)
@@ -56,9 +74,9 @@ func Debug(msg string, args ...any) {
 	log.Debug(msg, args...)
 }
 
-// DebugContext logs a debug-level message with context.
+// DebugContext logs a debug-level message through the request-scoped logger in ctx.
 func DebugContext(ctx context.Context, msg string, args ...any) {
-	log.DebugContext(ctx, msg, args...)
+	FromContext(ctx).DebugContext(ctx, msg, args...)
 }
 
 // Info logs an info-level message with optional key-value pairs.
@@ -66,9 +84,9 @@ func Info(msg string, args ...any) {
 	log.Info(msg, args...)
 }
 
-// InfoContext logs an info-level message with context.
+// InfoContext logs an info-level message through the request-scoped logger in ctx.
 func InfoContext(ctx context.Context, msg string, args ...any) {
-	log.InfoContext(ctx, msg, args...)
+	FromContext(ctx).InfoContext(ctx, msg, args...)
 }
 
 // Warn logs a warning-level message with optional key-value pairs.
@@ -76,9 +94,9 @@ func Warn(msg string, args ...any) {
 	log.Warn(msg, args...)
 }
 
-// WarnContext logs a warning-level message with context.
+// WarnContext logs a warning-level message through the request-scoped logger in ctx.
 func WarnContext(ctx context.Context, msg string, args ...any) {
-	log.WarnContext(ctx, msg, args...)
+	FromContext(ctx).WarnContext(ctx, msg, args...)
 }
 
 // Error logs an error-level message with optional key-value pairs.
@@ -86,9 +104,9 @@ func Error(msg string, args ...any) {
 	log.Error(msg, args...)
 }
 
-// ErrorContext logs an error-level message with context.
+// ErrorContext logs an error-level message through the request-scoped logger in ctx.
 func ErrorContext(ctx context.Context, msg string, args ...any) {
-	log.ErrorContext(ctx, msg, args...)
+	FromContext(ctx).ErrorContext(ctx, msg, args...)
 }
 
 // With returns a new logger with the given key-value pairs added to all log entries.
@@ -103,6 +121,30 @@ func With(args ...any) *slog.Logger {
 	return log.With(args...)
 }
 
+// NewContext returns a copy of ctx carrying l as its request-scoped logger.
+func NewContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the request-scoped logger stored in ctx, or the global
+// logger when there is none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return log
+}
+
+// WithContext returns a copy of ctx whose request-scoped logger also carries
+// the given key-value pairs.
+//
+// Example:
+//
+//	ctx = logger.WithContext(ctx, "user_id", userID)
+func WithContext(ctx context.Context, args ...any) context.Context {
+	return NewContext(ctx, FromContext(ctx).With(args...))
+}
+
 // GetLogger returns the underlying slog.Logger instance.
 // Use this when you need direct access to slog.Logger methods.
 func GetLogger() *slog.Logger {
@@ -173,3 +173,28 @@ func TestJSONFormatting(t *testing.T) {
 		t.Errorf("expected key2 123, got %v", logEntry["key2"])
 	}
 }
+
+func TestFromContext(t *testing.T) {
+	Initialize("production")
+
+	if FromContext(context.Background()) != GetLogger() {
+		t.Error("FromContext() without a scoped logger should return the global logger")
+	}
+
+	var buf bytes.Buffer
+	ctx := NewContext(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+	ctx = WithContext(ctx, "request_id", "req-123")
+	ctx = WithContext(ctx, "user_id", "user-456")
+
+	WarnContext(ctx, "scoped message", "key", "value")
+
+	var logEntry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &logEntry); err != nil {
+		t.Fatalf("failed to parse JSON log: %v\nOutput: %s", err, buf.String())
+	}
+	for key, want := range map[string]string{"request_id": "req-123", "user_id": "user-456", "key": "value"} {
+		if logEntry[key] != want {
+			t.Errorf("expected %s %q, got %v", key, want, logEntry[key])
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+
+	"wish-list/internal/pkg/breaker"
+	"wish-list/internal/pkg/logger"
+)
+
+// ErrNoProvider is returned when no provider is configured or every one is skipped
+var ErrNoProvider = errors.New("no email provider available")
+
+// Metrics holds the send counters of every provider by name, published through
+// expvar as email_providers, e.g. {"primary": {"sent": 120, "failed": 3, "skipped": 9}}.
+// failed counts errors, skipped counts messages passed on while its breaker was open.
+var Metrics = expvar.NewMap("email_providers")
+
+// Provider is a named sender taking part in failover
+type Provider struct {
+	Name   string
+	Sender Sender
+}
+
+type provider struct {
+	Provider
+	breaker *breaker.Breaker
+
+	sent    expvar.Int
+	failed  expvar.Int
+	skipped expvar.Int
+}
+
+// Failover sends through the first provider that accepts a message
+type Failover struct {
+	providers []*provider
+}
+
+// NewFailover tries providers in the given order. Each gets a circuit breaker named
+// email_<name>, so a provider that is down costs a skip instead of a timeout.
+func NewFailover(cb breaker.Config, providers ...Provider) *Failover {
+	f := &Failover{}
+	for _, p := range providers {
+		fp := &provider{Provider: p, breaker: breaker.New("email_"+p.Name, cb)}
+
+		vars := new(expvar.Map).Init()
+		vars.Set("sent", &fp.sent)
+		vars.Set("failed", &fp.failed)
+		vars.Set("skipped", &fp.skipped)
+		Metrics.Set(p.Name, vars)
+
+		f.providers = append(f.providers, fp)
+	}
+	return f
+}
+
+// Send tries each provider in turn until one accepts msg. A rejected recipient or a
+// canceled context is returned right away, since another provider would not help.
+func (f *Failover) Send(ctx context.Context, msg *Message) error {
+	var errs []error
+	for i, p := range f.providers {
+		if err := p.breaker.Allow(); err != nil {
+			p.skipped.Add(1)
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+			continue
+		}
+
+		err := p.Sender.Send(ctx, msg)
+		final := err == nil || errors.Is(err, ErrRecipientRejected) || ctx.Err() != nil
+		// A rejected recipient says nothing about the provider's health
+		p.breaker.Record(err != nil && !errors.Is(err, ErrRecipientRejected))
+		if err == nil {
+			p.sent.Add(1)
+			if i > 0 {
+				logger.InfoContext(ctx, "email sent through fallback provider", "provider", p.Name)
+			}
+			return nil
+		}
+
+		p.failed.Add(1)
+		if final {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+		if i < len(f.providers)-1 {
+			logger.WarnContext(ctx, "email provider failed, trying the next one", "provider", p.Name, "error", err)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+	}
+	return fmt.Errorf("%w: %w", ErrNoProvider, errors.Join(errs...))
+}
@@ -0,0 +1,200 @@
+// Package mailer delivers rendered emails through SMTP providers.
+//
+// Production setups list a primary and optionally a secondary provider; a message
+// the primary cannot take (connection errors, timeouts, 4xx/5xx replies) is handed
+// to the next one, so an outage at one email service does not drop notifications.
+// Development uses a sandbox that writes messages to disk or the log instead.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+
+	"wish-list/internal/pkg/breaker"
+)
+
+// ErrInvalidHeader is returned for header values containing line breaks
+var ErrInvalidHeader = errors.New("email header contains a line break")
+
+// Sender delivers a message
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Message is an email with an HTML and a plain-text body
+type Message struct {
+	From        string
+	To          string
+	Subject     string
+	HTML        string
+	Text        string
+	Headers     map[string]string
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to a message
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Config selects the sender built by New
+type Config struct {
+	// Sandbox delivers nothing: messages are written to SandboxDir, or logged when it is empty
+	Sandbox    bool
+	SandboxDir string
+	// Providers are tried in order; at least one is required outside the sandbox
+	Providers []SMTPConfig
+	// Breaker skips a provider that keeps failing for its cooldown
+	Breaker breaker.Config
+}
+
+// New creates the sender described by cfg
+func New(cfg Config) Sender {
+	if cfg.Sandbox {
+		return NewSandbox(cfg.SandboxDir)
+	}
+	providers := make([]Provider, 0, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		providers = append(providers, Provider{Name: p.Name, Sender: NewSMTP(p)})
+	}
+	return NewFailover(cfg.Breaker, providers...)
+}
+
+// Bytes encodes the message as MIME: a multipart/alternative text and HTML body,
+// wrapped in multipart/mixed when there are attachments
+func (m *Message) Bytes() ([]byte, error) {
+	headers := map[string]string{
+		"From":         m.From,
+		"To":           m.To,
+		"Subject":      mime.QEncoding.Encode("utf-8", m.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"Message-ID":   messageID(m.From),
+		"MIME-Version": "1.0",
+	}
+	maps.Copy(headers, m.Headers)
+
+	var buf bytes.Buffer
+	for _, key := range slices.Sorted(maps.Keys(headers)) {
+		if strings.ContainsAny(key+headers[key], "\r\n") {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidHeader, key)
+		}
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, headers[key])
+	}
+
+	alternative, boundary, err := m.alternative()
+	if err != nil {
+		return nil, err
+	}
+	if len(m.Attachments) == 0 {
+		fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", boundary)
+		buf.Write(alternative)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", mixed.Boundary())
+	part, err := mixed.CreatePart(textproto.MIMEHeader{"Content-Type": {"multipart/alternative; boundary=" + boundary}})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(alternative); err != nil {
+		return nil, err
+	}
+	for _, a := range m.Attachments {
+		if err := writeAttachment(mixed, a); err != nil {
+			return nil, err
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// alternative encodes the text and HTML bodies and returns them with their boundary
+func (m *Message) alternative() ([]byte, string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, body := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		if body.content == "" {
+			continue
+		}
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {body.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		qp := quotedprintable.NewWriter(part)
+		if _, err := qp.Write([]byte(body.content)); err != nil {
+			return nil, "", err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), w.Boundary(), nil
+}
+
+func writeAttachment(w *multipart.Writer, a Attachment) error {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+	})
+	if err != nil {
+		return err
+	}
+	return writeBase64Lines(part, a.Data)
+}
+
+// messageID returns a unique Message-ID in the sender's domain
+func messageID(from string) string {
+	domain := "localhost"
+	if i := strings.LastIndexByte(from, '@'); i >= 0 {
+		domain = strings.TrimSuffix(from[i+1:], ">")
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
+
+// writeBase64Lines writes data base64 encoded in lines of 76 characters (RFC 2045)
+func writeBase64Lines(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 0 {
+		n := min(len(encoded), 76)
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"wish-list/internal/pkg/breaker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *Message {
+	return &Message{
+		From:    "no-reply@example.com",
+		To:      "guest@example.com",
+		Subject: "Geschenk reserviert ✓",
+		HTML:    "<p>Hello</p>",
+		Text:    "Hello",
+		Headers: map[string]string{"X-Request-ID": "req-1"},
+	}
+}
+
+func TestMessage_Bytes(t *testing.T) {
+	t.Run("alternative bodies", func(t *testing.T) {
+		raw, err := testMessage().Bytes()
+		require.NoError(t, err)
+
+		parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+		require.NoError(t, err)
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Geschenk reserviert ✓", subject)
+		assert.Equal(t, "req-1", parsed.Header.Get("X-Request-ID"))
+		assert.Contains(t, parsed.Header.Get("Message-ID"), "@example.com>")
+
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		parts := multipart.NewReader(parsed.Body, params["boundary"])
+		for _, want := range []string{"Hello", "<p>Hello</p>"} {
+			part, err := parts.NextPart()
+			require.NoError(t, err)
+			body, err := io.ReadAll(part)
+			require.NoError(t, err)
+			assert.Equal(t, want, string(body))
+		}
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		msg := testMessage()
+		msg.Attachments = []Attachment{{Filename: "export.json", ContentType: "application/json", Data: []byte(`{"a":1}`)}}
+		raw, err := msg.Bytes()
+		require.NoError(t, err)
+
+		parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+		require.NoError(t, err)
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/mixed", mediaType)
+
+		parts := multipart.NewReader(parsed.Body, params["boundary"])
+		_, err = parts.NextPart()
+		require.NoError(t, err)
+		attachment, err := parts.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "export.json", attachment.FileName())
+		assert.Equal(t, "base64", attachment.Header.Get("Content-Transfer-Encoding"))
+	})
+
+	t.Run("rejects header injection", func(t *testing.T) {
+		msg := testMessage()
+		msg.To = "guest@example.com\r\nBcc: victim@example.com"
+
+		_, err := msg.Bytes()
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	})
+}
+
+type fakeSender struct {
+	err   error
+	calls int
+}
+
+func (f *fakeSender) Send(context.Context, *Message) error {
+	f.calls++
+	return f.err
+}
+
+func TestFailover(t *testing.T) {
+	serverError := &textproto.Error{Code: 554, Msg: "transaction failed"}
+
+	t.Run("uses the primary while it works", func(t *testing.T) {
+		primary, secondary := &fakeSender{}, &fakeSender{}
+		f := NewFailover(breaker.Config{}, Provider{"primary", primary}, Provider{"secondary", secondary})
+
+		require.NoError(t, f.Send(context.Background(), testMessage()))
+		assert.Equal(t, 1, primary.calls)
+		assert.Zero(t, secondary.calls)
+		assert.Equal(t, int64(1), f.providers[0].sent.Value())
+	})
+
+	t.Run("fails over on a server error", func(t *testing.T) {
+		primary, secondary := &fakeSender{err: serverError}, &fakeSender{}
+		f := NewFailover(breaker.Config{}, Provider{"primary", primary}, Provider{"secondary", secondary})
+
+		require.NoError(t, f.Send(context.Background(), testMessage()))
+		assert.Equal(t, 1, secondary.calls)
+		assert.Equal(t, int64(1), f.providers[0].failed.Value())
+		assert.Equal(t, int64(1), f.providers[1].sent.Value())
+	})
+
+	t.Run("does not fail over a rejected recipient", func(t *testing.T) {
+		primary := &fakeSender{err: errors.Join(ErrRecipientRejected, &textproto.Error{Code: 550, Msg: "no such user"})}
+		secondary := &fakeSender{}
+		f := NewFailover(breaker.Config{Failures: 1, Cooldown: time.Minute}, Provider{"primary", primary}, Provider{"secondary", secondary})
+
+		require.ErrorIs(t, f.Send(context.Background(), testMessage()), ErrRecipientRejected)
+		assert.Zero(t, secondary.calls)
+		assert.Equal(t, breaker.StateClosed, f.providers[0].breaker.State(), "the provider is healthy")
+	})
+
+	t.Run("skips a provider whose breaker is open", func(t *testing.T) {
+		primary, secondary := &fakeSender{err: serverError}, &fakeSender{}
+		f := NewFailover(breaker.Config{Failures: 1, Cooldown: time.Minute}, Provider{"primary", primary}, Provider{"secondary", secondary})
+
+		require.NoError(t, f.Send(context.Background(), testMessage()))
+		require.NoError(t, f.Send(context.Background(), testMessage()))
+		assert.Equal(t, 1, primary.calls)
+		assert.Equal(t, 2, secondary.calls)
+		assert.Equal(t, int64(1), f.providers[0].skipped.Value())
+	})
+
+	t.Run("every provider failing", func(t *testing.T) {
+		f := NewFailover(breaker.Config{}, Provider{"primary", &fakeSender{err: serverError}}, Provider{"secondary", &fakeSender{err: serverError}})
+
+		err := f.Send(context.Background(), testMessage())
+		require.ErrorIs(t, err, ErrNoProvider)
+		assert.ErrorContains(t, err, `secondary: 554 "transaction failed"`)
+	})
+
+	t.Run("no providers", func(t *testing.T) {
+		assert.ErrorIs(t, NewFailover(breaker.Config{}).Send(context.Background(), testMessage()), ErrNoProvider)
+	})
+}
+
+func TestSandbox(t *testing.T) {
+	t.Run("writes eml files", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mail")
+		require.NoError(t, NewSandbox(dir).Send(context.Background(), testMessage()))
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Contains(t, files[0].Name(), "-geschenk-reserviert.eml")
+
+		raw, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+		require.NoError(t, err)
+		_, err = mail.ReadMessage(bytes.NewReader(raw))
+		assert.NoError(t, err)
+	})
+
+	t.Run("logs without a directory", func(t *testing.T) {
+		assert.NoError(t, NewSandbox("").Send(context.Background(), testMessage()))
+	})
+}
@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Sandbox keeps messages local for development: each one is written to dir as an
+// .eml file that mail clients open. Without dir only the subject and size are
+// logged, since bodies carry names and other personal data.
+type Sandbox struct {
+	dir string
+}
+
+// NewSandbox creates a sandbox writing to dir; an empty dir logs messages instead
+func NewSandbox(dir string) *Sandbox {
+	return &Sandbox{dir: dir}
+}
+
+// Send writes or logs msg; nothing leaves the machine
+func (s *Sandbox) Send(ctx context.Context, msg *Message) error {
+	raw, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if s.dir == "" {
+		logger.InfoContext(ctx, "email sandbox", "subject", msg.Subject, "bytes", len(raw), "attachments", len(msg.Attachments))
+		return nil
+	}
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create email sandbox directory: %w", err)
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), slug(msg.Subject))
+	path := filepath.Join(s.dir, name)
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return fmt.Errorf("failed to write sandbox email: %w", err)
+	}
+
+	logger.InfoContext(ctx, "email written to sandbox", "subject", msg.Subject, "path", path)
+	return nil
+}
+
+// slug turns a subject into a short file name part
+func slug(subject string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(subject) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+		if b.Len() >= 40 {
+			break
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
@@ -0,0 +1,117 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer speaks just enough SMTP for one session per connection. replies
+// overrides the reply to a command, e.g. "RCPT": "550 no such user".
+func fakeSMTPServer(t *testing.T, replies map[string]string) (SMTPConfig, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, replies, received)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	return SMTPConfig{Name: "test", Host: host, Port: portNum, Timeout: 5 * time.Second}, received
+}
+
+func serveSMTP(conn net.Conn, replies map[string]string, received chan<- string) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	reply := func(cmd, fallback string) {
+		if r, ok := replies[cmd]; ok {
+			fallback = r
+		}
+		_ = text.PrintfLine("%s", fallback)
+	}
+
+	_ = text.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.Fields(line + " ")[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = text.PrintfLine("250 fake")
+		case "MAIL":
+			reply(cmd, "250 OK")
+		case "RCPT":
+			reply(cmd, "250 OK")
+		case "DATA":
+			_ = text.PrintfLine("354 go ahead")
+			data, err := text.ReadDotLines()
+			if err != nil {
+				return
+			}
+			received <- strings.Join(data, "\n")
+			reply("END", "250 queued")
+		case "QUIT":
+			_ = text.PrintfLine("221 bye")
+			return
+		default:
+			_ = text.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Run("delivers the message", func(t *testing.T) {
+		cfg, received := fakeSMTPServer(t, nil)
+
+		require.NoError(t, NewSMTP(cfg).Send(context.Background(), testMessage()))
+		assert.Contains(t, <-received, "X-Request-ID: req-1")
+	})
+
+	t.Run("rejected recipient", func(t *testing.T) {
+		cfg, _ := fakeSMTPServer(t, map[string]string{"RCPT": "550 no such user"})
+
+		err := NewSMTP(cfg).Send(context.Background(), testMessage())
+		assert.ErrorIs(t, err, ErrRecipientRejected)
+	})
+
+	t.Run("temporary recipient failure is a provider error", func(t *testing.T) {
+		cfg, _ := fakeSMTPServer(t, map[string]string{"RCPT": "451 try again later"})
+
+		err := NewSMTP(cfg).Send(context.Background(), testMessage())
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRecipientRejected)
+	})
+
+	t.Run("server error after data", func(t *testing.T) {
+		cfg, _ := fakeSMTPServer(t, map[string]string{"END": "554 transaction failed"})
+
+		err := NewSMTP(cfg).Send(context.Background(), testMessage())
+		var reply *textproto.Error
+		require.ErrorAs(t, err, &reply)
+		assert.Equal(t, 554, reply.Code)
+	})
+
+	t.Run("unreachable server", func(t *testing.T) {
+		err := NewSMTP(SMTPConfig{Host: "127.0.0.1", Port: 1, Timeout: time.Second}).Send(context.Background(), testMessage())
+		assert.ErrorContains(t, err, "failed to connect")
+	})
+}
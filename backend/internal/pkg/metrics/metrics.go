@@ -0,0 +1,175 @@
+// Package metrics counts business events of the reservation funnel and serves
+// them in the Prometheus text exposition format, so product can follow
+// conversion on dashboards instead of querying the database.
+//
+// Services report events through the functions below. Like the expvar counters
+// the values live in process memory, so every API instance is scraped and the
+// series are summed in Prometheus.
+//
+// Usage:
+//
+//	metrics.ReservationCreated(true)
+//	metrics.ReservationAfterView(time.Since(viewedAt))
+//	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ContentType is the media type of the Prometheus text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Who made a reservation
+const (
+	actorGuest = "guest"
+	actorUser  = "user"
+)
+
+// maxViewToReservation bounds the durations observed; longer ones are most likely
+// a stale page left open, which would skew the average
+const maxViewToReservation = 7 * 24 * time.Hour
+
+var (
+	reservationsCreated = newCounter("wishlist_reservations_created_total",
+		"Reservations made, by guests or signed-in users.", "actor", actorGuest, actorUser)
+	reservationsCanceled = newCounter("wishlist_reservations_canceled_total",
+		"Reservations canceled by the giver who made them.", "actor", actorGuest, actorUser)
+	reservationsExpired = newCounter("wishlist_reservations_expired_total",
+		"Guest reservations that expired before the item was bought.", "")
+	purchasesMarked = newCounter("wishlist_purchases_marked_total",
+		"Gift items marked as purchased.", "")
+	viewToReservation = newSummary("wishlist_view_to_reservation_seconds",
+		"Time from opening a shared wish list to reserving an item from it.")
+)
+
+// collector is a metric written to the exposition
+type collector interface {
+	write(w io.Writer)
+}
+
+// registry lists the metrics in the order they are written
+var registry = []collector{reservationsCreated, reservationsCanceled, reservationsExpired, purchasesMarked, viewToReservation}
+
+// ReservationCreated counts a new reservation
+func ReservationCreated(guest bool) {
+	reservationsCreated.inc(actor(guest))
+}
+
+// ReservationCanceled counts a reservation canceled by its giver
+func ReservationCanceled(guest bool) {
+	reservationsCanceled.inc(actor(guest))
+}
+
+// ReservationExpired counts a guest reservation that expired
+func ReservationExpired() {
+	reservationsExpired.inc("")
+}
+
+// PurchaseMarked counts an item marked as purchased
+func PurchaseMarked() {
+	purchasesMarked.inc("")
+}
+
+// ReservationAfterView records how long after opening a shared wish list an item
+// was reserved. Negative durations and ones over a week are ignored.
+func ReservationAfterView(d time.Duration) {
+	if d < 0 || d > maxViewToReservation {
+		return
+	}
+	viewToReservation.observe(d.Seconds())
+}
+
+func actor(guest bool) string {
+	if guest {
+		return actorGuest
+	}
+	return actorUser
+}
+
+// Handler serves every metric in the Prometheus text exposition format
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		for _, c := range registry {
+			c.write(w)
+		}
+	})
+}
+
+// counter is a monotonically increasing count, optionally split by one label
+type counter struct {
+	name, help string
+	label      string // Empty for a counter without label
+	values     map[string]*atomic.Int64
+}
+
+// newCounter creates a counter. values lists every value of label, so each
+// series is exported from the start, even at zero.
+func newCounter(name, help, label string, values ...string) *counter {
+	c := &counter{name: name, help: help, label: label, values: make(map[string]*atomic.Int64)}
+	if label == "" {
+		values = []string{""}
+	}
+	for _, v := range values {
+		c.values[v] = new(atomic.Int64)
+	}
+	return c
+}
+
+func (c *counter) inc(value string) {
+	if n, ok := c.values[value]; ok {
+		n.Add(1)
+	}
+}
+
+func (c *counter) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+	values := make([]string, 0, len(c.values))
+	for v := range c.values {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	for _, v := range values {
+		if c.label == "" {
+			fmt.Fprintf(w, "%s %d\n", c.name, c.values[v].Load())
+		} else {
+			fmt.Fprintf(w, "%s{%s=%s} %d\n", c.name, c.label, strconv.Quote(v), c.values[v].Load())
+		}
+	}
+}
+
+// summary tracks the count and sum of observations; their ratio is the average
+type summary struct {
+	name, help string
+	mu         sync.Mutex
+	count      int64
+	sum        float64
+}
+
+func newSummary(name, help string) *summary {
+	return &summary{name: name, help: help}
+}
+
+func (s *summary) observe(v float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	s.sum += v
+}
+
+func (s *summary) write(w io.Writer) {
+	s.mu.Lock()
+	count, sum := s.count, s.sum
+	s.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s summary\n", s.name, s.help, s.name)
+	fmt.Fprintf(w, "%s_sum %s\n%s_count %d\n", s.name, strconv.FormatFloat(sum, 'g', -1, 64), s.name, count)
+}
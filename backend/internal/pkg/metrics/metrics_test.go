@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reset zeroes every metric
+func reset(t *testing.T) {
+	t.Helper()
+	for _, c := range []*counter{reservationsCreated, reservationsCanceled, reservationsExpired, purchasesMarked} {
+		for _, n := range c.values {
+			n.Store(0)
+		}
+	}
+	viewToReservation.mu.Lock()
+	viewToReservation.count, viewToReservation.sum = 0, 0
+	viewToReservation.mu.Unlock()
+}
+
+func scrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+	return rec.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	reset(t)
+	t.Cleanup(func() { reset(t) })
+
+	ReservationCreated(true)
+	ReservationCreated(true)
+	ReservationCreated(false)
+	ReservationCanceled(true)
+	ReservationExpired()
+	PurchaseMarked()
+	ReservationAfterView(90 * time.Second)
+	ReservationAfterView(30 * time.Second)
+
+	out := scrape(t)
+
+	assert.Contains(t, out, "# HELP wishlist_reservations_created_total Reservations made, by guests or signed-in users.\n"+
+		"# TYPE wishlist_reservations_created_total counter\n"+
+		"wishlist_reservations_created_total{actor=\"guest\"} 2\n"+
+		"wishlist_reservations_created_total{actor=\"user\"} 1\n")
+	assert.Contains(t, out, "wishlist_reservations_canceled_total{actor=\"guest\"} 1\n")
+	assert.Contains(t, out, "wishlist_reservations_canceled_total{actor=\"user\"} 0\n", "series are exported before their first event")
+	assert.Contains(t, out, "wishlist_reservations_expired_total 1\n")
+	assert.Contains(t, out, "wishlist_purchases_marked_total 1\n")
+	assert.Contains(t, out, "# TYPE wishlist_view_to_reservation_seconds summary\n"+
+		"wishlist_view_to_reservation_seconds_sum 120\n"+
+		"wishlist_view_to_reservation_seconds_count 2\n")
+}
+
+func TestReservationAfterView_IgnoresImplausibleDurations(t *testing.T) {
+	reset(t)
+	t.Cleanup(func() { reset(t) })
+
+	ReservationAfterView(-time.Minute)
+	ReservationAfterView(8 * 24 * time.Hour)
+
+	assert.Contains(t, scrape(t), "wishlist_view_to_reservation_seconds_count 0\n")
+}
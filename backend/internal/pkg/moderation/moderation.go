@@ -0,0 +1,207 @@
+// Package moderation screens user-supplied content before it is published.
+//
+// TextFilter rejects text containing blocked words or links to blocked
+// domains. Words match whole tokens case-insensitively, so "class" is not
+// caught by a blocked "ass". Domains match the host and all its subdomains.
+//
+// ImageModerator classifies images through a pluggable provider. The HTTP
+// moderator speaks a minimal JSON contract that is easy to put in front of
+// any classification service:
+//
+//	POST <endpoint>  {"image_url": "https://..."}
+//	200 OK           {"flagged": true, "labels": ["nudity"]}
+//
+// Usage:
+//
+//	filter := moderation.NewTextFilter(moderation.DefaultBlockedWords, []string{"spam.example"})
+//	if err := filter.Check(title, description); err != nil {
+//	    // reject content
+//	}
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrBlockedWord     = errors.New("text contains a blocked word")
+	ErrBlockedDomain   = errors.New("text links to a blocked domain")
+	ErrMissingEndpoint = errors.New("image moderation endpoint is required")
+)
+
+// DefaultBlockedWords is the built-in profanity list, extended by configuration
+var DefaultBlockedWords = []string{
+	"asshole", "bastard", "bitch", "bullshit", "cunt", "dickhead", "fuck", "fucker",
+	"fucking", "motherfucker", "nigger", "faggot", "retard", "shit", "slut", "whore",
+}
+
+// urlPattern finds links in free text, with or without a scheme
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?://)?(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}(?:[/?#][^\s]*)?`)
+
+// TextFilter rejects text with blocked words or links to blocked domains
+type TextFilter struct {
+	words   map[string]struct{}
+	domains []string
+}
+
+// NewTextFilter creates a filter; entries are trimmed and lowercased and empty entries ignored
+func NewTextFilter(words, domains []string) *TextFilter {
+	f := &TextFilter{words: make(map[string]struct{}, len(words))}
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			f.words[word] = struct{}{}
+		}
+	}
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+		if domain != "" {
+			f.domains = append(f.domains, domain)
+		}
+	}
+	return f
+}
+
+// Check returns ErrBlockedWord or ErrBlockedDomain, wrapped with the offending
+// term, for the first text that fails; nil when every text passes
+func (f *TextFilter) Check(texts ...string) error {
+	for _, text := range texts {
+		if text == "" {
+			continue
+		}
+
+		lower := strings.ToLower(text)
+		for _, token := range strings.FieldsFunc(lower, isSeparator) {
+			if _, blocked := f.words[token]; blocked {
+				return fmt.Errorf("%w: %q", ErrBlockedWord, token)
+			}
+		}
+
+		for _, link := range urlPattern.FindAllString(lower, -1) {
+			if domain, blocked := f.blockedDomain(link); blocked {
+				return fmt.Errorf("%w: %q", ErrBlockedDomain, domain)
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockedDomain reports the blocked domain a link points to, if any
+func (f *TextFilter) blockedDomain(link string) (string, bool) {
+	if len(f.domains) == 0 {
+		return "", false
+	}
+
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	host := parsed.Hostname()
+
+	for _, domain := range f.domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return domain, true
+		}
+	}
+	return "", false
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Verdict is an image classification result
+type Verdict struct {
+	Flagged bool
+	Labels  []string // Provider categories that triggered the flag, if any
+}
+
+// ImageModerator classifies an image by URL
+type ImageModerator interface {
+	CheckImage(ctx context.Context, imageURL string) (Verdict, error)
+}
+
+// HTTPImageModerator classifies images through an HTTP endpoint
+type HTTPImageModerator struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+// NewHTTPImageModerator creates a moderator for endpoint; apiKey, when set, is sent as a bearer token
+func NewHTTPImageModerator(endpoint, apiKey string, timeout time.Duration) (*HTTPImageModerator, error) {
+	if endpoint == "" {
+		return nil, ErrMissingEndpoint
+	}
+
+	return &HTTPImageModerator{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type imageRequest struct {
+	ImageURL string `json:"image_url"`
+}
+
+type imageResponse struct {
+	Flagged bool     `json:"flagged"`
+	Labels  []string `json:"labels"`
+}
+
+// CheckImage asks the provider to classify the image
+func (m *HTTPImageModerator) CheckImage(ctx context.Context, imageURL string) (Verdict, error) {
+	body, err := json.Marshal(imageRequest{ImageURL: imageURL})
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to encode moderation request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to create moderation request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	//nolint:gosec // Intentional external API call to the configured moderation provider
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to call moderation provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("moderation provider returned status %d", resp.StatusCode)
+	}
+
+	var result imageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Verdict{}, fmt.Errorf("failed to decode moderation response: %w", err)
+	}
+
+	return Verdict{Flagged: result.Flagged, Labels: result.Labels}, nil
+}
+
+// NoopImageModerator approves every image. Used when image moderation is disabled.
+type NoopImageModerator struct{}
+
+// CheckImage always approves
+func (NoopImageModerator) CheckImage(context.Context, string) (Verdict, error) {
+	return Verdict{}, nil
+}
@@ -0,0 +1,89 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextFilter_Check(t *testing.T) {
+	filter := NewTextFilter([]string{"darn", " Heck "}, []string{"spam.example", "www.scam.test"})
+
+	tests := []struct {
+		name    string
+		texts   []string
+		wantErr error
+	}{
+		{name: "clean text", texts: []string{"Birthday wishes", "A blue scarf"}},
+		{name: "empty text", texts: []string{"", ""}},
+		{name: "blocked word", texts: []string{"Nice list", "What the DARN!"}, wantErr: ErrBlockedWord},
+		{name: "configured word is trimmed", texts: []string{"oh heck"}, wantErr: ErrBlockedWord},
+		{name: "word inside another word", texts: []string{"darned socks"}},
+		{name: "blocked domain", texts: []string{"see https://spam.example/offer"}, wantErr: ErrBlockedDomain},
+		{name: "blocked subdomain without scheme", texts: []string{"visit shop.spam.example today"}, wantErr: ErrBlockedDomain},
+		{name: "www prefix stripped from config", texts: []string{"https://scam.test"}, wantErr: ErrBlockedDomain},
+		{name: "lookalike domain", texts: []string{"https://notspam.example"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := filter.Check(tt.texts...)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestHTTPImageModerator_CheckImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+		var req imageRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		switch req.ImageURL {
+		case "https://img.example/bad.jpg":
+			_, _ = w.Write([]byte(`{"flagged": true, "labels": ["nudity"]}`))
+		case "https://img.example/error.jpg":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"flagged": false}`))
+		}
+	}))
+	defer server.Close()
+
+	m, err := NewHTTPImageModerator(server.URL, "test-key", time.Second)
+	require.NoError(t, err)
+
+	t.Run("approved", func(t *testing.T) {
+		verdict, err := m.CheckImage(context.Background(), "https://img.example/ok.jpg")
+		require.NoError(t, err)
+		assert.False(t, verdict.Flagged)
+	})
+
+	t.Run("flagged", func(t *testing.T) {
+		verdict, err := m.CheckImage(context.Background(), "https://img.example/bad.jpg")
+		require.NoError(t, err)
+		assert.True(t, verdict.Flagged)
+		assert.Equal(t, []string{"nudity"}, verdict.Labels)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		_, err := m.CheckImage(context.Background(), "https://img.example/error.jpg")
+		assert.Error(t, err)
+	})
+}
+
+func TestNewHTTPImageModerator_MissingEndpoint(t *testing.T) {
+	_, err := NewHTTPImageModerator("", "", time.Second)
+	assert.ErrorIs(t, err, ErrMissingEndpoint)
+}
@@ -0,0 +1,55 @@
+package pageviews
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryBuffer keeps views in process memory. Expired sessions are dropped on Drain.
+type MemoryBuffer struct {
+	mu      sync.Mutex
+	pending map[string]int64
+	seen    map[string]time.Time // page + "\x00" + session -> end of its window
+	now     func() time.Time
+}
+
+// NewMemoryBuffer creates a Buffer for a single API instance
+func NewMemoryBuffer() *MemoryBuffer {
+	return &MemoryBuffer{
+		pending: make(map[string]int64),
+		seen:    make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Record counts the view unless the session viewed page within window
+func (b *MemoryBuffer) Record(_ context.Context, page, sessionID string, window time.Duration) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	key := page + "\x00" + sessionID
+	if until, ok := b.seen[key]; !ok || !now.Before(until) {
+		b.seen[key] = now.Add(window)
+		b.pending[page]++
+	}
+	return b.pending[page], nil
+}
+
+// Drain returns the views recorded since the last Drain and starts over
+func (b *MemoryBuffer) Drain(_ context.Context) (map[string]int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	counts := b.pending
+	b.pending = make(map[string]int64)
+
+	now := b.now()
+	for key, until := range b.seen {
+		if !now.Before(until) {
+			delete(b.seen, key)
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,54 @@
+package pageviews
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBuffer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	buffer := NewMemoryBuffer()
+	buffer.now = func() time.Time { return now }
+
+	pending, err := buffer.Record(ctx, "list-1", "a", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+
+	// The same session again only reads the count
+	pending, err = buffer.Record(ctx, "list-1", "a", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+
+	pending, err = buffer.Record(ctx, "list-1", "b", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pending)
+
+	// Pages are separate
+	pending, err = buffer.Record(ctx, "list-2", "a", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+
+	counts, err := buffer.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"list-1": 2, "list-2": 1}, counts)
+
+	// Draining starts over but sessions stay deduplicated until their window ends
+	pending, err = buffer.Record(ctx, "list-1", "a", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+
+	now = now.Add(time.Hour)
+	pending, err = buffer.Record(ctx, "list-1", "a", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+
+	now = now.Add(2 * time.Hour)
+	_, err = buffer.Drain(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, buffer.seen, "expired sessions are dropped on drain")
+}
@@ -0,0 +1,27 @@
+// Package pageviews buffers page views so that a busy page costs one database write
+// per flush instead of one per visit. A session's views of a page count once per
+// window; the job owning the buffer drains it periodically and adds the counts to
+// the stored totals:
+//
+//	pending, err := buffer.Record(ctx, wishlistID, sessionID, 30*time.Minute)
+//	views := stored + pending // approximate until the next flush
+//
+//	counts, err := buffer.Drain(ctx) // page -> views since the last drain
+//
+// RedisBuffer shares views and deduplication between API instances; MemoryBuffer is
+// for a single instance without Redis.
+package pageviews
+
+import (
+	"context"
+	"time"
+)
+
+// Buffer collects page views between flushes
+type Buffer interface {
+	// Record counts a view of page by sessionID unless the session viewed it within
+	// window, and returns the views of page recorded since the last Drain
+	Record(ctx context.Context, page, sessionID string, window time.Duration) (int64, error)
+	// Drain returns the views recorded since the last Drain by page and starts over
+	Drain(ctx context.Context) (map[string]int64, error)
+}
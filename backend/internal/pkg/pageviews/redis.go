@@ -0,0 +1,79 @@
+package pageviews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// redisKeyPrefix namespaces page view keys from the cache
+	redisKeyPrefix = "pageviews:"
+	// redisPendingKey is a hash of page -> views since the last drain
+	redisPendingKey = redisKeyPrefix + "pending"
+)
+
+// RedisBuffer counts views in a Redis hash and remembers each session's view in a
+// key that expires with its window
+type RedisBuffer struct {
+	client redis.Cmdable
+}
+
+// NewRedisBuffer creates a Buffer backed by Redis
+func NewRedisBuffer(client redis.Cmdable) *RedisBuffer {
+	return &RedisBuffer{client: client}
+}
+
+// Record counts the view unless the session viewed page within window
+func (b *RedisBuffer) Record(ctx context.Context, page, sessionID string, window time.Duration) (int64, error) {
+	first, err := b.client.SetNX(ctx, redisKeyPrefix+"seen:"+page+":"+sessionID, 1, window).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check page view session: %w", err)
+	}
+
+	if first {
+		pending, err := b.client.HIncrBy(ctx, redisPendingKey, page, 1).Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to record page view: %w", err)
+		}
+		return pending, nil
+	}
+
+	pending, err := b.client.HGet(ctx, redisPendingKey, page).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending page views: %w", err)
+	}
+	return pending, nil
+}
+
+// Drain returns the views recorded since the last Drain and starts over. Reading
+// and clearing happen in one transaction, so views from other instances are
+// never lost between the two.
+func (b *RedisBuffer) Drain(ctx context.Context) (map[string]int64, error) {
+	var pending *redis.MapStringStringCmd
+	_, err := b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pending = pipe.HGetAll(ctx, redisPendingKey)
+		pipe.Del(ctx, redisPendingKey)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to drain page views: %w", err)
+	}
+
+	counts := make(map[string]int64, len(pending.Val()))
+	for page, value := range pending.Val() {
+		views, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		counts[page] = views
+	}
+	return counts, nil
+}
@@ -0,0 +1,316 @@
+// Package pdf writes simple printable PDF documents: A4 pages of text blocks,
+// each with an optional picture on its left, flowing onto new pages as needed.
+//
+// Text is set in the standard Helvetica fonts every PDF reader has, so no font is
+// embedded. Those fonts only cover the Windows-1252 character set; other
+// characters are printed as "?".
+//
+// Usage:
+//
+//	doc := pdf.New("Shopping list")
+//	doc.Add(pdf.Block{Lines: []pdf.Line{{Text: "Birthday", Size: 16, Bold: true}}})
+//	doc.Add(pdf.Block{Image: thumb, Lines: []pdf.Line{{Text: "Book"}, {Text: link, URL: link}}})
+//	data := doc.Bytes()
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ContentType is the media type of a PDF document
+const ContentType = "application/pdf"
+
+// DefaultFontSize is the size of lines that do not set one, in points
+const DefaultFontSize = 10.0
+
+// Page layout in points (1/72 inch)
+const (
+	pageWidth   = 595.28 // A4
+	pageHeight  = 841.89
+	margin      = 50.0
+	imageBox    = 64.0 // Longest side of a block's picture
+	imageGap    = 12.0 // Between a picture and its text
+	blockGap    = 10.0 // Between blocks
+	lineSpacing = 1.3  // Line height as a multiple of the font size
+)
+
+// Block is a group of lines kept together on one page, with an optional
+// picture on their left
+type Block struct {
+	Image *Image
+	Lines []Line
+}
+
+// Line is a paragraph of text, wrapped to the width of the page
+type Line struct {
+	Text string
+	Size float64 // In points; 0 uses DefaultFontSize
+	Bold bool
+	URL  string // Makes the text a link; only http and https URLs are linked
+}
+
+// Document is a PDF document being built
+type Document struct {
+	title  string
+	pages  []*page
+	images []*Image
+	y      float64 // Top of the free space on the last page, from the bottom edge
+}
+
+type page struct {
+	content bytes.Buffer
+	links   []link
+}
+
+// link is a clickable area of a page
+type link struct {
+	x1, y1, x2, y2 float64
+	url            string
+}
+
+// New creates an empty document. title is shown by PDF readers in the window title.
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// Add places a block below the previous one, starting a new page when it does
+// not fit on the current one
+func (d *Document) Add(b Block) {
+	textX := margin
+	var imageWidth, imageHeight float64
+	if b.Image != nil {
+		imageWidth, imageHeight = b.Image.fit(imageBox)
+		textX += imageBox + imageGap
+	}
+	width := pageWidth - margin - textX
+
+	type row struct {
+		text []byte
+		size float64
+		bold bool
+		url  string
+	}
+	var rows []row
+	textHeight := 0.0
+	for _, line := range b.Lines {
+		size := line.Size
+		if size <= 0 {
+			size = DefaultFontSize
+		}
+		linkURL := ""
+		if isWebURL(line.URL) {
+			linkURL = line.URL
+		}
+		for _, text := range wrap(encode(line.Text), size, line.Bold, width) {
+			rows = append(rows, row{text: text, size: size, bold: line.Bold, url: linkURL})
+			textHeight += size * lineSpacing
+		}
+	}
+	height := max(textHeight, imageHeight)
+
+	if len(d.pages) == 0 || (d.y-height < margin && d.y < pageHeight-margin) {
+		d.newPage()
+	}
+	p := d.pages[len(d.pages)-1]
+	top := d.y
+
+	if b.Image != nil {
+		fmt.Fprintf(&p.content, "q %.2f 0 0 %.2f %.2f %.2f cm /%s Do Q\n",
+			imageWidth, imageHeight, margin, top-imageHeight, d.imageName(b.Image))
+	}
+
+	y := top
+	for _, r := range rows {
+		y -= r.size
+		font := "F1"
+		if r.bold {
+			font = "F2"
+		}
+		color := "0 0 0"
+		if r.url != "" {
+			color = "0 0.27 0.8"
+			p.links = append(p.links, link{
+				x1: textX, y1: y - r.size*0.25,
+				x2: textX + textWidth(r.text, r.size, r.bold), y2: y + r.size,
+				url: r.url,
+			})
+		}
+		fmt.Fprintf(&p.content, "BT /%s %.1f Tf %s rg %.2f %.2f Td %s Tj ET\n", font, r.size, color, textX, y, literal(r.text))
+		y -= r.size * (lineSpacing - 1)
+	}
+
+	d.y = top - height - blockGap
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &page{})
+	d.y = pageHeight - margin
+}
+
+// imageName returns the resource name of an image, adding it on first use
+func (d *Document) imageName(img *Image) string {
+	for i, known := range d.images {
+		if known == img {
+			return fmt.Sprintf("Im%d", i+1)
+		}
+	}
+	d.images = append(d.images, img)
+	return fmt.Sprintf("Im%d", len(d.images))
+}
+
+// Bytes renders the document
+func (d *Document) Bytes() []byte {
+	if len(d.pages) == 0 {
+		d.newPage()
+	}
+
+	// Objects 1-5 are fixed, then come the images, then every page followed by
+	// its content stream and link annotations
+	const catalogID, pagesID, fontID, boldFontID, infoID = 1, 2, 3, 4, 5
+	firstImageID := infoID + 1
+	pageIDs := make([]int, len(d.pages))
+	next := firstImageID + len(d.images)
+	for i, p := range d.pages {
+		pageIDs[i] = next
+		next += 2 + len(p.links)
+	}
+
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	w.object(catalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+	kids := make([]string, len(pageIDs))
+	for i, id := range pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	w.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pageIDs)))
+	w.object(fontID, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	w.object(boldFontID, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	w.object(infoID, fmt.Sprintf("<< /Title %s /Producer (Wish List) >>", literal(encode(d.title))))
+
+	var xobjects strings.Builder
+	for i, img := range d.images {
+		id := firstImageID + i
+		fmt.Fprintf(&xobjects, " /Im%d %d 0 R", i+1, id)
+		w.stream(id, fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /DCTDecode",
+			img.width, img.height), img.data)
+	}
+	resources := fmt.Sprintf("<< /Font << /F1 %d 0 R /F2 %d 0 R >> /XObject <<%s >> >>", fontID, boldFontID, xobjects.String())
+
+	for i, p := range d.pages {
+		id := pageIDs[i]
+		annots := make([]string, len(p.links))
+		for j := range p.links {
+			annots[j] = fmt.Sprintf("%d 0 R", id+2+j)
+		}
+		w.object(id, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources %s /Contents %d 0 R /Annots [%s] >>",
+			pagesID, pageWidth, pageHeight, resources, id+1, strings.Join(annots, " ")))
+		w.stream(id+1, "", p.content.Bytes())
+		for j, l := range p.links {
+			w.object(id+2+j, fmt.Sprintf("<< /Type /Annot /Subtype /Link /Rect [%.2f %.2f %.2f %.2f] /Border [0 0 0] /A << /S /URI /URI %s >> >>",
+				l.x1, l.y1, l.x2, l.y2, literal([]byte(l.url))))
+		}
+	}
+
+	return w.finish(catalogID, infoID)
+}
+
+// writer writes numbered objects and the cross-reference table pointing at them
+type writer struct {
+	buf     bytes.Buffer
+	offsets map[int]int
+}
+
+func (w *writer) object(id int, body string) {
+	w.begin(id)
+	w.buf.WriteString(body)
+	w.buf.WriteString("\nendobj\n")
+}
+
+func (w *writer) stream(id int, dict string, data []byte) {
+	w.begin(id)
+	fmt.Fprintf(&w.buf, "<< %s /Length %d >>\nstream\n", strings.TrimSpace(dict), len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func (w *writer) begin(id int) {
+	if w.offsets == nil {
+		w.offsets = make(map[int]int)
+	}
+	w.offsets[id] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n", id)
+}
+
+func (w *writer) finish(rootID, infoID int) []byte {
+	xref := w.buf.Len()
+	size := len(w.offsets) + 1
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", size)
+	for id := 1; id < size; id++ {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", w.offsets[id])
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, rootID, infoID, xref)
+	return w.buf.Bytes()
+}
+
+// literal writes encoded text as a PDF string, escaping delimiters and
+// non-printable bytes
+func literal(text []byte) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range text {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// wrap breaks text into lines no wider than width, at spaces where possible
+func wrap(text []byte, size float64, bold bool, width float64) [][]byte {
+	var lines [][]byte
+	var line []byte
+	for _, word := range bytes.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+		if textWidth(candidate, size, bold) <= width {
+			line = candidate
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+		// A word longer than a whole line, such as a URL, is split anywhere
+		for textWidth(word, size, bold) > width {
+			cut := 1
+			for cut < len(word) && textWidth(word[:cut+1], size, bold) <= width {
+				cut++
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if len(line) > 0 || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// isWebURL reports whether s is an absolute http or https URL
+func isWebURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
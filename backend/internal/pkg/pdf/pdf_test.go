@@ -0,0 +1,170 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.RGBA{R: 200, G: 30, B: 30, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// assertValidXref checks that every cross-reference entry points at its object
+func assertValidXref(t *testing.T, data []byte) {
+	t.Helper()
+	start := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(data)
+	require.NotNil(t, start, "document ends with startxref")
+	xref, err := strconv.Atoi(string(start[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n0 ")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data[offset:], fmt.Appendf(nil, "%d 0 obj\n", i+1)), "object %d", i+1)
+	}
+}
+
+func TestDocument_Bytes(t *testing.T) {
+	thumb, err := NewThumbnail(testPNG(t, 10, 10), 64)
+	require.NoError(t, err)
+
+	doc := New("Shopping list")
+	doc.Add(Block{Lines: []Line{{Text: "Birthday (30)", Size: 16, Bold: true}}})
+	doc.Add(Block{Image: thumb, Lines: []Line{
+		{Text: "Café crème — 2×"},
+		{Text: "https://shop.example/item", URL: "https://shop.example/item"},
+		{Text: "javascript:alert(1)", URL: "javascript:alert(1)"},
+	}})
+	data := doc.Bytes()
+	out := string(data)
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assertValidXref(t, data)
+	assert.Contains(t, out, "/Title (Shopping list)")
+	assert.Contains(t, out, `/F2 16.0 Tf 0 0 0 rg 50.00 775.89 Td (Birthday \(30\)) Tj`)
+	assert.Contains(t, out, `(Caf\351 cr\350me \227 2\327) Tj`, "text is encoded as Windows-1252")
+	assert.Contains(t, out, "/Subtype /Image /Width 10 /Height 10")
+	assert.Contains(t, out, "/Im1 Do")
+	assert.Equal(t, 1, strings.Count(out, "/Subtype /Link"), "only web URLs are linked")
+	assert.Contains(t, out, "/URI (https://shop.example/item)")
+}
+
+func TestDocument_Add_BreaksPages(t *testing.T) {
+	doc := New("Long list")
+	for i := range 100 {
+		doc.Add(Block{Lines: []Line{{Text: fmt.Sprintf("Item %d", i), Bold: true}, {Text: "Details"}}})
+	}
+	data := doc.Bytes()
+
+	require.Greater(t, len(doc.pages), 1)
+	assert.Contains(t, string(data), fmt.Sprintf("/Count %d", len(doc.pages)))
+	assertValidXref(t, data)
+	for _, p := range doc.pages {
+		content := p.content.String()
+		assert.Equal(t, strings.Count(content, "(Item "), strings.Count(content, "(Details)"), "blocks are kept together")
+	}
+	assert.Contains(t, doc.pages[len(doc.pages)-1].content.String(), "(Item 99)")
+}
+
+func TestDocument_Bytes_Empty(t *testing.T) {
+	data := New("Empty").Bytes()
+
+	assert.Contains(t, string(data), "/Count 1")
+	assertValidXref(t, data)
+}
+
+func TestWrap(t *testing.T) {
+	lines := wrap([]byte("one two three"), 10, false, textWidth([]byte("one two"), 10, false))
+	assert.Equal(t, [][]byte{[]byte("one two"), []byte("three")}, lines)
+
+	long := []byte(strings.Repeat("a", 50))
+	lines = wrap(long, 10, false, textWidth([]byte(strings.Repeat("a", 20)), 10, false))
+	require.Len(t, lines, 3, "a word longer than the line is split")
+	assert.Equal(t, long, bytes.Join(lines, nil))
+
+	assert.Equal(t, [][]byte{nil}, wrap(nil, 10, false, 100), "an empty line still takes its space")
+}
+
+func TestEncode(t *testing.T) {
+	assert.Equal(t, []byte("a b ?\x80"), encode("a\tb Ж€"))
+}
+
+func TestNewThumbnail(t *testing.T) {
+	t.Run("scales down keeping the aspect ratio", func(t *testing.T) {
+		img, err := NewThumbnail(testPNG(t, 200, 100), 64)
+		require.NoError(t, err)
+		assert.Equal(t, 64, img.width)
+		assert.Equal(t, 32, img.height)
+
+		decoded, format, err := image.Decode(bytes.NewReader(img.data))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		r, _, _, _ := decoded.At(10, 10).RGBA()
+		assert.InDelta(t, 200, r>>8, 10)
+	})
+
+	t.Run("keeps small images", func(t *testing.T) {
+		img, err := NewThumbnail(testPNG(t, 20, 30), 64)
+		require.NoError(t, err)
+		assert.Equal(t, 20, img.width)
+		assert.Equal(t, 30, img.height)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		_, err := NewThumbnail([]byte("<html>"), 64)
+		assert.ErrorIs(t, err, ErrInvalidImage)
+	})
+}
+
+func TestImageFetcher_Thumbnail(t *testing.T) {
+	picture := testPNG(t, 100, 100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/item.png" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(picture)
+	}))
+	defer server.Close()
+
+	fetcher := newImageFetcher(time.Second, true)
+
+	img, err := fetcher.Thumbnail(context.Background(), server.URL+"/item.png", 32)
+	require.NoError(t, err)
+	assert.Equal(t, 32, img.width)
+
+	_, err = fetcher.Thumbnail(context.Background(), server.URL+"/missing.png", 32)
+	assert.Error(t, err)
+
+	_, err = fetcher.Thumbnail(context.Background(), "file:///etc/passwd", 32)
+	assert.ErrorIs(t, err, ErrInvalidImage)
+
+	_, err = NewImageFetcher(time.Second).Thumbnail(context.Background(), server.URL+"/item.png", 32)
+	assert.Error(t, err, "local addresses are refused")
+}
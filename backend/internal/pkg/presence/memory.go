@@ -0,0 +1,63 @@
+package presence
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryTracker keeps presence in process memory. Rooms are pruned as they are
+// touched, and emptied rooms are dropped.
+type MemoryTracker struct {
+	mu    sync.Mutex
+	rooms map[string]map[string]time.Time
+	now   func() time.Time
+}
+
+// NewMemoryTracker creates a Tracker for a single API instance
+func NewMemoryTracker() *MemoryTracker {
+	return &MemoryTracker{
+		rooms: make(map[string]map[string]time.Time),
+		now:   time.Now,
+	}
+}
+
+// Touch marks sessionID present in room and returns the sessions seen within ttl
+func (t *MemoryTracker) Touch(_ context.Context, room, sessionID string, ttl time.Duration) ([]Session, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	sessions := t.rooms[room]
+	if sessions == nil {
+		sessions = make(map[string]time.Time)
+		t.rooms[room] = sessions
+	}
+	sessions[sessionID] = now
+
+	present := make([]Session, 0, len(sessions))
+	for id, lastSeen := range sessions {
+		if lastSeen.Before(now.Add(-ttl)) {
+			delete(sessions, id)
+			continue
+		}
+		present = append(present, Session{ID: id, LastSeen: lastSeen})
+	}
+	sort.Slice(present, func(i, j int) bool {
+		return present[i].LastSeen.After(present[j].LastSeen)
+	})
+	return present, nil
+}
+
+// Leave removes sessionID from room
+func (t *MemoryTracker) Leave(_ context.Context, room, sessionID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.rooms[room], sessionID)
+	if len(t.rooms[room]) == 0 {
+		delete(t.rooms, room)
+	}
+	return nil
+}
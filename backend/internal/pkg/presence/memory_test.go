@@ -0,0 +1,42 @@
+package presence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryTracker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewMemoryTracker()
+	tracker.now = func() time.Time { return now }
+
+	sessions, err := tracker.Touch(ctx, "wishlist:1", "a", time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	now = now.Add(30 * time.Second)
+	sessions, err = tracker.Touch(ctx, "wishlist:1", "b", time.Minute)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "b", sessions[0].ID, "most recently seen first")
+
+	// Other rooms are separate
+	sessions, err = tracker.Touch(ctx, "wishlist:2", "c", time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	// a stops sending heartbeats
+	now = now.Add(45 * time.Second)
+	sessions, err = tracker.Touch(ctx, "wishlist:1", "b", time.Minute)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "b", sessions[0].ID)
+
+	require.NoError(t, tracker.Leave(ctx, "wishlist:1", "b"))
+	assert.NotContains(t, tracker.rooms, "wishlist:1")
+}
@@ -0,0 +1,33 @@
+// Package presence tracks which client sessions currently have a resource open,
+// e.g. the editor of a wishlist. Sessions announce themselves with Touch on a
+// short heartbeat and drop out when they Leave or stop calling Touch for longer
+// than the TTL:
+//
+//	sessions, err := tracker.Touch(ctx, "wishlist:"+id, sessionID, time.Minute)
+//	if len(sessions) > 1 {
+//	    // someone else is editing too
+//	}
+//
+// RedisTracker shares presence between API instances; MemoryTracker is for a
+// single instance without Redis.
+package presence
+
+import (
+	"context"
+	"time"
+)
+
+// Session is a client session present in a room
+type Session struct {
+	ID       string
+	LastSeen time.Time
+}
+
+// Tracker records the sessions present in rooms
+type Tracker interface {
+	// Touch marks sessionID present in room for ttl and returns the sessions
+	// present, including sessionID, most recently seen first
+	Touch(ctx context.Context, room, sessionID string, ttl time.Duration) ([]Session, error)
+	// Leave removes sessionID from room
+	Leave(ctx context.Context, room, sessionID string) error
+}
@@ -0,0 +1,60 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisKeyPrefix namespaces presence keys from the cache
+const redisKeyPrefix = "presence:"
+
+// RedisTracker keeps each room in a sorted set scored by last-seen time
+type RedisTracker struct {
+	client redis.Cmdable
+}
+
+// NewRedisTracker creates a Tracker backed by Redis
+func NewRedisTracker(client redis.Cmdable) *RedisTracker {
+	return &RedisTracker{client: client}
+}
+
+// Touch marks sessionID present in room and returns the sessions seen within ttl
+func (t *RedisTracker) Touch(ctx context.Context, room, sessionID string, ttl time.Duration) ([]Session, error) {
+	key := redisKeyPrefix + room
+	now := time.Now()
+
+	var members *redis.ZSliceCmd
+	_, err := t.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: sessionID})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(now.Add(-ttl).UnixMilli(), 10))
+		members = pipe.ZRevRangeWithScores(ctx, key, 0, -1)
+		// The room disappears once its last session stops refreshing
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record presence: %w", err)
+	}
+
+	sessions := make([]Session, 0, len(members.Val()))
+	for _, member := range members.Val() {
+		id, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		sessions = append(sessions, Session{ID: id, LastSeen: time.UnixMilli(int64(member.Score))})
+	}
+	return sessions, nil
+}
+
+// Leave removes sessionID from room
+func (t *RedisTracker) Leave(ctx context.Context, room, sessionID string) error {
+	if err := t.client.ZRem(ctx, redisKeyPrefix+room, sessionID).Err(); err != nil {
+		return fmt.Errorf("failed to remove presence: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Package priority defines the named priority levels of gift items.
+//
+// Items used to carry a raw 0-10 integer. The named levels replace it, but
+// numbers are still accepted from older clients and mapped onto a level:
+// 7-10 is must have, 4-6 nice to have, 1-3 dream and 0 (no priority) nice to
+// have. Sorting uses the level's weight, highest first when descending.
+//
+// Usage:
+//
+//	level, err := priority.Parse("must-have") // "must_have"
+//	weight := priority.Weight(level)           // 3
+//	level = priority.FromNumber(5)             // "nice_to_have"
+package priority
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Priority levels, as stored in gift_items.priority_level
+const (
+	MustHave   = "must_have"
+	NiceToHave = "nice_to_have"
+	Dream      = "dream"
+)
+
+// Default is the level of items created without a priority
+const Default = NiceToHave
+
+// MaxNumber is the highest legacy integer priority
+const MaxNumber = 10
+
+var ErrInvalidLevel = errors.New("priority must be must_have, nice_to_have, dream or a number from 0 to 10")
+
+// Levels lists every level from the highest weight to the lowest
+var Levels = []string{MustHave, NiceToHave, Dream}
+
+// Parse normalizes a level name. Case and hyphens are ignored, so "Must-Have"
+// is accepted as must_have.
+func Parse(s string) (string, error) {
+	level := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_")
+	switch level {
+	case MustHave, NiceToHave, Dream:
+		return level, nil
+	default:
+		return "", ErrInvalidLevel
+	}
+}
+
+// Weight returns the sort weight of a level: 3 for must have, 2 for nice to
+// have, 1 for dream and 0 for an unknown level
+func Weight(level string) int {
+	switch level {
+	case MustHave:
+		return 3
+	case NiceToHave:
+		return 2
+	case Dream:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// FromNumber maps a legacy 0-10 priority onto a level
+func FromNumber(n int32) string {
+	switch {
+	case n >= 7:
+		return MustHave
+	case n >= 4:
+		return NiceToHave
+	case n >= 1:
+		return Dream
+	default:
+		return Default
+	}
+}
+
+// Number returns the legacy integer stored alongside a level set by name, so
+// clients that still read numbers see a value in the level's range
+func Number(level string) int32 {
+	switch level {
+	case MustHave:
+		return 9
+	case Dream:
+		return 2
+	default:
+		return 5
+	}
+}
+
+// Value is a priority sent by a client: a level name or, for backward
+// compatibility, a legacy 0-10 integer. Both fields are always set after
+// unmarshaling; Number keeps the integer a legacy client sent.
+type Value struct {
+	Level  string
+	Number int32
+}
+
+// UnmarshalJSON accepts "must_have", "nice-to-have", "dream" or 0-10
+func (v *Value) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		level, err := Parse(s)
+		if err != nil {
+			return err
+		}
+		*v = Value{Level: level, Number: Number(level)}
+		return nil
+	}
+
+	var n int32
+	if err := json.Unmarshal(data, &n); err != nil {
+		return ErrInvalidLevel
+	}
+	if n < 0 || n > MaxNumber {
+		return fmt.Errorf("%w: got %d", ErrInvalidLevel, n)
+	}
+	*v = Value{Level: FromNumber(n), Number: n}
+	return nil
+}
@@ -0,0 +1,74 @@
+package priority
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for input, want := range map[string]string{
+		"must_have":     MustHave,
+		"Must-Have":     MustHave,
+		" nice-to-have": NiceToHave,
+		"DREAM":         Dream,
+	} {
+		level, err := Parse(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, level, input)
+	}
+
+	for _, input := range []string{"", "high", "must have", "5"} {
+		_, err := Parse(input)
+		assert.ErrorIs(t, err, ErrInvalidLevel, input)
+	}
+}
+
+func TestFromNumber(t *testing.T) {
+	tests := []struct {
+		number int32
+		want   string
+	}{
+		{0, NiceToHave},
+		{1, Dream},
+		{3, Dream},
+		{4, NiceToHave},
+		{6, NiceToHave},
+		{7, MustHave},
+		{10, MustHave},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, FromNumber(tt.number), tt.number)
+		// The number stored for a level maps back onto the same level
+		assert.Equal(t, FromNumber(Number(tt.want)), tt.want)
+	}
+}
+
+func TestWeight(t *testing.T) {
+	assert.Greater(t, Weight(MustHave), Weight(NiceToHave))
+	assert.Greater(t, Weight(NiceToHave), Weight(Dream))
+	assert.Zero(t, Weight("unknown"))
+}
+
+func TestValue_UnmarshalJSON(t *testing.T) {
+	var req struct {
+		Priority *Value `json:"priority"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"priority": "nice-to-have"}`), &req))
+	assert.Equal(t, &Value{Level: NiceToHave, Number: 5}, req.Priority)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"priority": 8}`), &req))
+	assert.Equal(t, &Value{Level: MustHave, Number: 8}, req.Priority)
+
+	req.Priority = nil
+	require.NoError(t, json.Unmarshal([]byte(`{"priority": null}`), &req))
+	assert.Nil(t, req.Priority)
+
+	for _, body := range []string{`{"priority": "urgent"}`, `{"priority": 11}`, `{"priority": -1}`, `{"priority": 2.5}`, `{"priority": true}`} {
+		err := json.Unmarshal([]byte(body), &req)
+		assert.ErrorIs(t, err, ErrInvalidLevel, body)
+	}
+}
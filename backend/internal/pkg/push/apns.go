@@ -0,0 +1,170 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// APNs endpoints
+const (
+	APNsProductionURL  = "https://api.push.apple.com"
+	APNsDevelopmentURL = "https://api.sandbox.push.apple.com"
+)
+
+// apnsTokenTTL is how long a provider token is reused; Apple rejects tokens older than
+// an hour and throttles refreshing them more often than every 20 minutes
+const apnsTokenTTL = 45 * time.Minute
+
+// APNsConfig identifies the app and the signing key used for token-based APNs auth
+type APNsConfig struct {
+	BaseURL string // APNsProductionURL or APNsDevelopmentURL
+	KeyPEM  []byte // Contents of the .p8 signing key
+	KeyID   string
+	TeamID  string
+	Topic   string // The app's bundle ID
+}
+
+// APNsSender sends messages through the Apple Push Notification service
+type APNsSender struct {
+	baseURL string
+	key     *ecdsa.PrivateKey
+	keyID   string
+	teamID  string
+	topic   string
+	client  *http.Client
+
+	mu        sync.Mutex
+	token     string
+	issuedAt  time.Time
+	timeNowFn func() time.Time
+}
+
+// NewAPNsSender creates a sender that signs requests with the team's APNs key
+func NewAPNsSender(cfg APNsConfig, timeout time.Duration) (*APNsSender, error) {
+	key, err := jwt.ParseECPrivateKeyFromPEM(cfg.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse APNs key: %w", err)
+	}
+	if cfg.KeyID == "" || cfg.TeamID == "" || cfg.Topic == "" {
+		return nil, fmt.Errorf("APNs key ID, team ID and topic are required")
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = APNsProductionURL
+	}
+
+	return &APNsSender{
+		baseURL:   baseURL,
+		key:       key,
+		keyID:     cfg.KeyID,
+		teamID:    cfg.TeamID,
+		topic:     cfg.Topic,
+		client:    &http.Client{Timeout: timeout},
+		timeNowFn: time.Now,
+	}, nil
+}
+
+type apnsPayload struct {
+	APS  apnsAPS           `json:"aps"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+type apnsAPS struct {
+	Alert apnsAlert `json:"alert"`
+	Sound string    `json:"sound"`
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+// Send delivers msg to an APNs device token
+func (s *APNsSender) Send(ctx context.Context, token string, msg Message) error {
+	body, err := json.Marshal(apnsPayload{
+		APS:  apnsAPS{Alert: apnsAlert{Title: msg.Title, Body: msg.Body}, Sound: "default"},
+		Data: msg.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode APNs payload: %w", err)
+	}
+
+	providerToken, err := s.providerToken()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create APNs request: %w", err)
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", s.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("Content-Type", "application/json")
+
+	//nolint:gosec // Intentional external API call to the configured push provider
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call APNs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var apnsErr apnsErrorResponse
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	_ = json.Unmarshal(raw, &apnsErr)
+
+	switch apnsErr.Reason {
+	case "BadDeviceToken", "Unregistered", "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	}
+	if resp.StatusCode == http.StatusGone {
+		return ErrInvalidToken
+	}
+
+	return fmt.Errorf("APNs returned status %d: %s", resp.StatusCode, apnsErr.Reason)
+}
+
+// providerToken returns the signed JWT APNs expects, reusing it for apnsTokenTTL
+func (s *APNsSender) providerToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timeNowFn()
+	if s.token != "" && now.Sub(s.issuedAt) < apnsTokenTTL {
+		return s.token, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": s.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = s.keyID
+
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs token: %w", err)
+	}
+
+	s.token = signed
+	s.issuedAt = now
+	return signed, nil
+}
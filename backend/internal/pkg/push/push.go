@@ -0,0 +1,75 @@
+// Package push delivers notifications to mobile devices through Firebase Cloud
+// Messaging (Android) and the Apple Push Notification service (iOS).
+//
+// Each provider is a Sender; a Router picks the sender for a device's platform:
+//
+//	router := push.NewRouter(map[string]push.Sender{
+//	    push.PlatformAndroid: fcmSender,
+//	    push.PlatformIOS:     apnsSender,
+//	})
+//	err := router.Send(ctx, push.PlatformIOS, token, push.Message{Title: "Hi"})
+//	if errors.Is(err, push.ErrInvalidToken) {
+//	    // forget the token
+//	}
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Supported device platforms
+const (
+	PlatformAndroid = "android"
+	PlatformIOS     = "ios"
+)
+
+var (
+	// ErrInvalidToken means the provider no longer accepts the device token, usually
+	// because the app was uninstalled. The token should be removed, not retried.
+	ErrInvalidToken = errors.New("push token is invalid or unregistered")
+	// ErrUnsupportedPlatform means no sender is configured for the device's platform
+	ErrUnsupportedPlatform = errors.New("push platform is not configured")
+)
+
+// Message is a notification shown on the device
+type Message struct {
+	Title string
+	Body  string
+	Data  map[string]string // Delivered to the app alongside the alert
+}
+
+// Sender delivers a message to a single device token
+type Sender interface {
+	Send(ctx context.Context, token string, msg Message) error
+}
+
+// Router sends messages with the sender configured for each platform
+type Router struct {
+	senders map[string]Sender
+}
+
+// NewRouter creates a Router. Platforms without a sender are rejected with ErrUnsupportedPlatform.
+func NewRouter(senders map[string]Sender) *Router {
+	return &Router{senders: senders}
+}
+
+// Send delivers msg to a device on the given platform
+func (r *Router) Send(ctx context.Context, platform, token string, msg Message) error {
+	sender, ok := r.senders[platform]
+	if !ok || sender == nil {
+		return fmt.Errorf("%w: %q", ErrUnsupportedPlatform, platform)
+	}
+	return sender.Send(ctx, token, msg)
+}
+
+// Enabled reports whether at least one platform has a sender
+func (r *Router) Enabled() bool {
+	for _, sender := range r.senders {
+		if sender != nil {
+			return true
+		}
+	}
+	return false
+}
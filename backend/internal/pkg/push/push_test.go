@@ -0,0 +1,164 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+type senderFunc func(ctx context.Context, token string, msg Message) error
+
+func (f senderFunc) Send(ctx context.Context, token string, msg Message) error {
+	return f(ctx, token, msg)
+}
+
+func TestRouter_Send(t *testing.T) {
+	var got string
+	router := NewRouter(map[string]Sender{
+		PlatformAndroid: senderFunc(func(ctx context.Context, token string, msg Message) error {
+			got = token
+			return nil
+		}),
+	})
+
+	require.NoError(t, router.Send(context.Background(), PlatformAndroid, "device-1", Message{Title: "Hi"}))
+	assert.Equal(t, "device-1", got)
+	assert.True(t, router.Enabled())
+
+	err := router.Send(context.Background(), PlatformIOS, "device-2", Message{Title: "Hi"})
+	assert.ErrorIs(t, err, ErrUnsupportedPlatform)
+
+	assert.False(t, NewRouter(nil).Enabled())
+}
+
+func TestFCMSender_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+
+		var req fcmRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "Gift purchased", req.Message.Notification.Title)
+		assert.Equal(t, "wl-1", req.Message.Data["wishlist_id"])
+
+		switch req.Message.Token {
+		case "valid":
+			_, _ = w.Write([]byte(`{"name": "projects/p/messages/1"}`))
+		case "unregistered":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"status": "NOT_FOUND", "details": [{"errorCode": "UNREGISTERED"}]}}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error": {"status": "UNAVAILABLE", "message": "try later"}}`))
+		}
+	}))
+	defer server.Close()
+
+	sender := NewFCMSenderWithTokenSource(server.URL, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"}), time.Second)
+	msg := Message{Title: "Gift purchased", Body: "Scarf", Data: map[string]string{"wishlist_id": "wl-1"}}
+
+	require.NoError(t, sender.Send(context.Background(), "valid", msg))
+	assert.ErrorIs(t, sender.Send(context.Background(), "unregistered", msg), ErrInvalidToken)
+
+	err := sender.Send(context.Background(), "flaky", msg)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
+
+func testAPNsKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestAPNsSender_Send(t *testing.T) {
+	key, keyPEM := testAPNsKey(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "com.example.wishlist", r.Header.Get("apns-topic"))
+
+		raw := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+		parsed, err := jwt.Parse(raw, func(*jwt.Token) (any, error) { return &key.PublicKey, nil })
+		require.NoError(t, err)
+		assert.Equal(t, "KEY123", parsed.Header["kid"])
+		issuer, _ := parsed.Claims.GetIssuer()
+		assert.Equal(t, "TEAM123", issuer)
+
+		switch strings.TrimPrefix(r.URL.Path, "/3/device/") {
+		case "valid":
+			w.WriteHeader(http.StatusOK)
+		case "gone":
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason": "Unregistered"}`))
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"reason": "TooManyRequests"}`))
+		}
+	}))
+	defer server.Close()
+
+	sender, err := NewAPNsSender(APNsConfig{
+		BaseURL: server.URL,
+		KeyPEM:  keyPEM,
+		KeyID:   "KEY123",
+		TeamID:  "TEAM123",
+		Topic:   "com.example.wishlist",
+	}, time.Second)
+	require.NoError(t, err)
+
+	msg := Message{Title: "Occasion soon", Body: "Birthday is in 7 days"}
+	require.NoError(t, sender.Send(context.Background(), "valid", msg))
+	assert.ErrorIs(t, sender.Send(context.Background(), "gone", msg), ErrInvalidToken)
+
+	err = sender.Send(context.Background(), "busy", msg)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestAPNsSender_ReusesProviderToken(t *testing.T) {
+	_, keyPEM := testAPNsKey(t)
+	sender, err := NewAPNsSender(APNsConfig{KeyPEM: keyPEM, KeyID: "K", TeamID: "T", Topic: "app"}, time.Second)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	sender.timeNowFn = func() time.Time { return now }
+
+	first, err := sender.providerToken()
+	require.NoError(t, err)
+
+	now = now.Add(10 * time.Minute)
+	second, err := sender.providerToken()
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	now = now.Add(apnsTokenTTL)
+	third, err := sender.providerToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+}
+
+func TestNewAPNsSender_Validation(t *testing.T) {
+	_, keyPEM := testAPNsKey(t)
+
+	_, err := NewAPNsSender(APNsConfig{KeyPEM: []byte("not a key"), KeyID: "K", TeamID: "T", Topic: "app"}, time.Second)
+	assert.Error(t, err)
+
+	_, err = NewAPNsSender(APNsConfig{KeyPEM: keyPEM}, time.Second)
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+// Package requestid carries the per-request correlation ID through context so
+// error responses, logs and outgoing notifications can reference it.
+package requestid
+
+import "context"
+
+// Header is the HTTP header that carries the request ID
+const Header = "X-Request-ID"
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the request ID
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" when there is none
+// (for example in background jobs)
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
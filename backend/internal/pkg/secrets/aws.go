@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// awsJSONClient calls AWS APIs that speak the JSON 1.1 protocol. Secrets Manager
+// and SSM need one action each, which does not justify pulling in their SDKs.
+type awsJSONClient struct {
+	service  string // Signing name, e.g. "secretsmanager"
+	target   string // X-Amz-Target prefix, e.g. "secretsmanager"
+	endpoint string
+	region   string
+	creds    aws.CredentialsProvider
+	signer   *v4.Signer
+	http     *http.Client
+}
+
+func newAWSJSONClient(ctx context.Context, service, target string) (*awsJSONClient, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("AWS region is not configured")
+	}
+
+	return &awsJSONClient{
+		service:  service,
+		target:   target,
+		endpoint: fmt.Sprintf("https://%s.%s.amazonaws.com/", service, cfg.Region),
+		region:   cfg.Region,
+		creds:    cfg.Credentials,
+		signer:   v4.NewSigner(),
+		http:     &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// call sends input to action and decodes the response into output
+func (c *awsJSONClient) call(ctx context.Context, action string, input, output any) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", c.target+"."+action)
+
+	creds, err := c.creds.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), c.service, c.region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", action, err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", action, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(payload, &apiErr)
+		return fmt.Errorf("%s returned %d: %s %s", action, resp.StatusCode, apiErr.Type, apiErr.Message)
+	}
+
+	if err := json.Unmarshal(payload, output); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", action, err)
+	}
+	return nil
+}
+
+// AWSSecretsManager reads secrets from AWS Secrets Manager
+type AWSSecretsManager struct {
+	client *awsJSONClient
+}
+
+// NewAWSSecretsManager creates a Secrets Manager provider using the default AWS
+// credential chain and region
+func NewAWSSecretsManager(ctx context.Context) (*AWSSecretsManager, error) {
+	client, err := newAWSJSONClient(ctx, "secretsmanager", "secretsmanager")
+	if err != nil {
+		return nil, err
+	}
+	return &AWSSecretsManager{client: client}, nil
+}
+
+// Fetch returns the current version of a string secret; name is its ID or ARN
+func (p *AWSSecretsManager) Fetch(ctx context.Context, name string) (string, error) {
+	var out struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if err := p.client.call(ctx, "GetSecretValue", map[string]string{"SecretId": name}, &out); err != nil {
+		return "", err
+	}
+	if out.SecretString == nil {
+		return "", fmt.Errorf("secret %s has no string value", name)
+	}
+	return *out.SecretString, nil
+}
+
+// AWSParameterStore reads parameters from AWS Systems Manager Parameter Store
+type AWSParameterStore struct {
+	client *awsJSONClient
+}
+
+// NewAWSParameterStore creates a Parameter Store provider using the default AWS
+// credential chain and region
+func NewAWSParameterStore(ctx context.Context) (*AWSParameterStore, error) {
+	client, err := newAWSJSONClient(ctx, "ssm", "AmazonSSM")
+	if err != nil {
+		return nil, err
+	}
+	return &AWSParameterStore{client: client}, nil
+}
+
+// Fetch returns a parameter's value, decrypting SecureString parameters
+func (p *AWSParameterStore) Fetch(ctx context.Context, name string) (string, error) {
+	var out struct {
+		Parameter struct {
+			Value string `json:"Value"`
+		} `json:"Parameter"`
+	}
+	input := map[string]any{"Name": name, "WithDecryption": true}
+	if err := p.client.call(ctx, "GetParameter", input, &out); err != nil {
+		return "", err
+	}
+	return out.Parameter.Value, nil
+}
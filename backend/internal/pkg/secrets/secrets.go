@@ -0,0 +1,229 @@
+// Package secrets loads configuration values kept in a secrets manager.
+//
+// An environment variable whose value is a reference is replaced by the secret
+// before the configuration is read, so every consumer sees the plain value:
+//
+//	JWT_SECRET=aws-sm://prod/wish-list#jwt_secret           AWS Secrets Manager
+//	DATABASE_URL=aws-ssm:///wish-list/prod/database-url     AWS SSM Parameter Store (decrypted)
+//	ENCRYPTED_DATA_KEY=vault://secret/data/wish-list#data_key  HashiCorp Vault KV at VAULT_ADDR
+//
+// "#field" picks a field of a JSON secret and is required for Vault. AWS requests
+// use the default credential chain and region; Vault requests send VAULT_TOKEN.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"wish-list/internal/pkg/logger"
+)
+
+// Reference schemes
+const (
+	SchemeAWSSecretsManager = "aws-sm"
+	SchemeAWSSSM            = "aws-ssm"
+	SchemeVault             = "vault"
+)
+
+// Provider fetches a secret by name from one secrets manager
+type Provider interface {
+	Fetch(ctx context.Context, name string) (string, error)
+}
+
+// reference points an environment variable at a secret
+type reference struct {
+	scheme string
+	name   string
+	field  string // JSON field of the secret; empty for the whole value
+}
+
+// parseReference reports whether value is a secret reference
+func parseReference(value string) (reference, bool) {
+	scheme, rest, ok := strings.Cut(value, "://")
+	if !ok {
+		return reference{}, false
+	}
+	switch scheme {
+	case SchemeAWSSecretsManager, SchemeAWSSSM, SchemeVault:
+	default:
+		return reference{}, false
+	}
+
+	name, field, _ := strings.Cut(rest, "#")
+	return reference{scheme: scheme, name: name, field: field}, true
+}
+
+// Resolver replaces the environment variables that reference secrets with their
+// values, and can refresh them later to pick up rotated secrets
+type Resolver struct {
+	mu        sync.Mutex
+	providers map[string]Provider
+	refs      map[string]reference // By environment variable
+	values    map[string]string    // Last resolved value by environment variable
+	setenv    func(key, value string) error
+}
+
+// NewResolver finds the references among environ ("KEY=value" pairs, as returned
+// by os.Environ). providers holds one Provider per scheme in use.
+func NewResolver(environ []string, providers map[string]Provider) *Resolver {
+	r := &Resolver{
+		providers: providers,
+		refs:      make(map[string]reference),
+		values:    make(map[string]string),
+		setenv:    os.Setenv,
+	}
+	for _, pair := range environ {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		if ref, ok := parseReference(value); ok {
+			r.refs[key] = ref
+		}
+	}
+	return r
+}
+
+// Keys returns the environment variables loaded from a secrets manager, sorted
+func (r *Resolver) Keys() []string {
+	return slices.Sorted(maps.Keys(r.refs))
+}
+
+// Resolve fetches every referenced secret and stores it in the environment. A
+// secret shared by several variables is fetched once. It returns the variables whose
+// value differs from the previous call; on the first call, all of them.
+func (r *Resolver) Resolve(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fetched := make(map[string]string) // By scheme://name
+	var changed []string
+	var errs []error
+
+	for _, key := range r.Keys() {
+		ref := r.refs[key]
+		value, err := r.fetch(ctx, ref, fetched)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		if previous, ok := r.values[key]; ok && previous == value {
+			continue
+		}
+		if err := r.setenv(key, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		r.values[key] = value
+		changed = append(changed, key)
+	}
+
+	return changed, errors.Join(errs...)
+}
+
+func (r *Resolver) fetch(ctx context.Context, ref reference, fetched map[string]string) (string, error) {
+	cacheKey := ref.scheme + "://" + ref.name
+	raw, ok := fetched[cacheKey]
+	if !ok {
+		provider := r.providers[ref.scheme]
+		if provider == nil {
+			return "", fmt.Errorf("no %s provider configured", ref.scheme)
+		}
+		var err error
+		if raw, err = provider.Fetch(ctx, ref.name); err != nil {
+			return "", fmt.Errorf("failed to fetch %s: %w", cacheKey, err)
+		}
+		fetched[cacheKey] = raw
+	}
+
+	if ref.field == "" {
+		return raw, nil
+	}
+	return jsonField(raw, ref.field)
+}
+
+// jsonField returns a string field of a JSON object secret
+func jsonField(raw, field string) (string, error) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, so it has no field %q", field)
+	}
+	value, ok := fields[field].(string)
+	if !ok {
+		return "", fmt.Errorf("secret has no string field %q", field)
+	}
+	return value, nil
+}
+
+// Watch resolves the secrets again every interval until ctx is canceled and hands
+// the variables whose value changed to onChange. It blocks, so callers start it in a
+// goroutine. Fetch failures are logged and the previous values kept.
+func (r *Resolver) Watch(ctx context.Context, interval time.Duration, onChange func(keys []string)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info("secrets reload started", "interval", interval.String(), "keys", r.Keys())
+
+	for {
+		select {
+		case <-ticker.C:
+			changed, err := r.Resolve(ctx)
+			if err != nil {
+				logger.Error("failed to reload secrets", "error", err)
+			}
+			if len(changed) > 0 {
+				onChange(changed)
+			}
+		case <-ctx.Done():
+			logger.Info("secrets reload stopped")
+			return
+		}
+	}
+}
+
+// ResolveEnv resolves the secret references in the process environment. Providers
+// are only set up for the schemes in use, so without references it does nothing.
+func ResolveEnv(ctx context.Context) (*Resolver, error) {
+	resolver := NewResolver(os.Environ(), nil)
+
+	providers := make(map[string]Provider)
+	for _, key := range resolver.Keys() {
+		scheme := resolver.refs[key].scheme
+		if _, ok := providers[scheme]; ok {
+			continue
+		}
+		provider, err := newProvider(ctx, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		providers[scheme] = provider
+	}
+	resolver.providers = providers
+
+	if _, err := resolver.Resolve(ctx); err != nil {
+		return nil, err
+	}
+	return resolver, nil
+}
+
+func newProvider(ctx context.Context, scheme string) (Provider, error) {
+	switch scheme {
+	case SchemeAWSSecretsManager:
+		return NewAWSSecretsManager(ctx)
+	case SchemeAWSSSM:
+		return NewAWSParameterStore(ctx)
+	case SchemeVault:
+		return NewVault(os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN"), os.Getenv("VAULT_NAMESPACE"))
+	default:
+		return nil, fmt.Errorf("unknown secrets scheme %q", scheme)
+	}
+}
@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault reads secrets from a HashiCorp Vault KV secrets engine
+type Vault struct {
+	addr      string
+	token     string
+	namespace string
+	http      *http.Client
+}
+
+// NewVault creates a Vault provider for the server at addr (VAULT_ADDR)
+func NewVault(addr, token, namespace string) (*Vault, error) {
+	if addr == "" {
+		return nil, fmt.Errorf("VAULT_ADDR is required for vault:// secrets")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("VAULT_TOKEN is required for vault:// secrets")
+	}
+
+	return &Vault{
+		addr:      strings.TrimSuffix(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		http:      &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Fetch reads the secret at an API path such as "secret/data/wish-list" and
+// returns its key/value pairs as a JSON object. Both KV version 2 ({"data": {"data":
+// ...}}) and version 1 ({"data": ...}) responses are understood.
+func (v *Vault) Fetch(ctx context.Context, name string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.addr+"/v1/"+strings.TrimPrefix(name, "/"), http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned %d for %s", resp.StatusCode, name)
+	}
+
+	var out struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+
+	// KV v2 nests the secret under data.data next to data.metadata
+	if nested, ok := out.Data["data"]; ok {
+		if _, hasMetadata := out.Data["metadata"]; hasMetadata {
+			return string(nested), nil
+		}
+	}
+
+	data, err := json.Marshal(out.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode vault secret: %w", err)
+	}
+	return string(data), nil
+}
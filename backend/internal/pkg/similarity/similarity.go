@@ -0,0 +1,98 @@
+// Package similarity spots items that are probably the same gift.
+//
+// Links are compared after normalization, so the same product page matches
+// regardless of scheme, "www.", tracking parameters or a trailing slash.
+// Names are compared by trigram similarity in the manner of Postgres pg_trgm,
+// which tolerates word order, case, punctuation and small typos.
+//
+// Usage:
+//
+//	similarity.SameLink("https://www.shop.com/p/1?utm_source=x", "http://shop.com/p/1/") // true
+//	similarity.Names("Kindle Paperwhite", "paperwhite kindle")                         // 1
+package similarity
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// NameThreshold is the trigram similarity from which two names count as the
+// same item. It is stricter than pg_trgm's default of 0.3, as a false match
+// interrupts the owner.
+const NameThreshold = 0.6
+
+// trackingParams are query parameters that do not change which page a link opens
+var trackingParams = []string{"fbclid", "gclid", "yclid", "msclkid", "mc_cid", "mc_eid", "ref", "ref_", "srsltid"}
+
+// NormalizeLink reduces an http(s) link to host, path and meaningful query,
+// e.g. "shop.com/p/1?color=red". Anything else normalizes to "".
+func NormalizeLink(link string) string {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.TrimRight(u.EscapedPath(), "/")
+
+	query := u.Query()
+	for param := range query {
+		if strings.HasPrefix(strings.ToLower(param), "utm_") || slices.Contains(trackingParams, strings.ToLower(param)) {
+			query.Del(param)
+		}
+	}
+
+	normalized := host + path
+	if len(query) > 0 {
+		normalized += "?" + query.Encode() // Encode sorts by key
+	}
+	return normalized
+}
+
+// SameLink reports whether two links open the same page. Links that do not
+// normalize never match.
+func SameLink(a, b string) bool {
+	normalized := NormalizeLink(a)
+	return normalized != "" && normalized == NormalizeLink(b)
+}
+
+// NormalizeName lowercases a name and reduces everything but letters and
+// digits to single spaces
+func NormalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// Names returns the trigram similarity of two names, from 0 (nothing in
+// common) to 1 (same words)
+func Names(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range ta {
+		if _, ok := tb[trigram]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// trigrams splits the normalized name into words and collects the three-rune
+// windows of each word padded with two leading and one trailing space, as pg_trgm does
+func trigrams(name string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for word := range strings.FieldsSeq(NormalizeName(name)) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
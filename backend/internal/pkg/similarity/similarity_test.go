@@ -0,0 +1,65 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLink(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"plain", "https://shop.com/p/1", "shop.com/p/1"},
+		{"scheme, www and case", "HTTP://WWW.Shop.com/p/1", "shop.com/p/1"},
+		{"trailing slash and fragment", "https://shop.com/p/1/#reviews", "shop.com/p/1"},
+		{"tracking params", "https://shop.com/p/1?utm_source=mail&UTM_Campaign=x&fbclid=abc&ref=home", "shop.com/p/1"},
+		{"meaningful params sorted", "https://shop.com/p/1?size=m&color=red&gclid=x", "shop.com/p/1?color=red&size=m"},
+		{"port kept out", "https://shop.com:443/p/1", "shop.com/p/1"},
+		{"not http", "ftp://shop.com/p/1", ""},
+		{"no host", "/p/1", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeLink(tt.link))
+		})
+	}
+}
+
+func TestSameLink(t *testing.T) {
+	assert.True(t, SameLink("https://www.shop.com/p/1?utm_source=x", "http://shop.com/p/1/"))
+	assert.False(t, SameLink("https://shop.com/p/1", "https://shop.com/p/2"))
+	assert.False(t, SameLink("https://shop.com/p/1?color=red", "https://shop.com/p/1?color=blue"))
+	assert.False(t, SameLink("", ""), "links that do not normalize never match")
+}
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "sony wh 1000xm5", NormalizeName("  Sony  WH-1000XM5! "))
+	assert.Equal(t, "plüschbär", NormalizeName("Plüschbär"))
+	assert.Empty(t, NormalizeName("--"))
+}
+
+func TestNames(t *testing.T) {
+	assert.InDelta(t, 1.0, Names("Kindle Paperwhite", "paperwhite, kindle"), 0.001)
+	assert.Zero(t, Names("", "Kindle"))
+
+	similar := [][2]string{
+		{"LEGO Millennium Falcon", "Lego Millenium Falcon"},
+		{"iPhone 15 Pro", "iPhone 14 Pro"},
+	}
+	for _, pair := range similar {
+		assert.GreaterOrEqual(t, Names(pair[0], pair[1]), NameThreshold, pair)
+	}
+
+	different := [][2]string{
+		{"Red scarf", "Blue scarf"},
+		{"Kindle Paperwhite", "Kobo Clara"},
+	}
+	for _, pair := range different {
+		assert.Less(t, Names(pair[0], pair[1]), NameThreshold, pair)
+	}
+}
@@ -0,0 +1,247 @@
+// Package stripe is a minimal client for the parts of the Stripe API used by
+// billing: creating subscription Checkout Sessions and verifying webhook events.
+//
+// Usage:
+//
+//	client := stripe.NewClient(secretKey, 10*time.Second)
+//	session, err := client.CreateCheckoutSession(ctx, stripe.CheckoutSessionParams{...})
+//
+//	event, err := stripe.ConstructEvent(payload, r.Header.Get(stripe.SignatureHeader), webhookSecret)
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAPIURL = "https://api.stripe.com/v1"
+
+	// SignatureHeader is the request header carrying the webhook signature
+	SignatureHeader = "Stripe-Signature"
+
+	// DefaultTolerance is how old a signed webhook may be before it is rejected as a replay
+	DefaultTolerance = 5 * time.Minute
+)
+
+// Webhook event types handled by billing
+const (
+	EventCheckoutSessionCompleted = "checkout.session.completed"
+	EventSubscriptionCreated      = "customer.subscription.created"
+	EventSubscriptionUpdated      = "customer.subscription.updated"
+	EventSubscriptionDeleted      = "customer.subscription.deleted"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrMissingSecret    = errors.New("stripe secret is required")
+)
+
+// Client calls the Stripe REST API
+type Client struct {
+	apiURL    string
+	secretKey string
+	client    *http.Client
+}
+
+// NewClient creates a client for the Stripe API
+func NewClient(secretKey string, timeout time.Duration) *Client {
+	return NewClientWithURL(defaultAPIURL, secretKey, timeout)
+}
+
+// NewClientWithURL creates a client for a Stripe-compatible API at apiURL
+func NewClientWithURL(apiURL, secretKey string, timeout time.Duration) *Client {
+	return &Client{
+		apiURL:    strings.TrimSuffix(apiURL, "/"),
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+// CheckoutSessionParams describes a subscription checkout for one price.
+// CustomerID reuses an existing Stripe customer; otherwise CustomerEmail prefills a new one.
+type CheckoutSessionParams struct {
+	PriceID           string
+	CustomerID        string
+	CustomerEmail     string
+	ClientReferenceID string
+	SuccessURL        string
+	CancelURL         string
+}
+
+// CheckoutSession is a hosted payment page the user is redirected to
+type CheckoutSession struct {
+	ID                string `json:"id"`
+	URL               string `json:"url"`
+	Customer          string `json:"customer"`
+	Subscription      string `json:"subscription"`
+	ClientReferenceID string `json:"client_reference_id"`
+}
+
+// Subscription is the subset of a Stripe subscription that billing syncs
+type Subscription struct {
+	ID               string            `json:"id"`
+	Customer         string            `json:"customer"`
+	Status           string            `json:"status"`
+	CurrentPeriodEnd int64             `json:"current_period_end"`
+	Metadata         map[string]string `json:"metadata"` // user_id is set by CreateCheckoutSession
+}
+
+// Event is a webhook notification. Data.Object holds the resource the event is about.
+type Event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// apiError is the error envelope returned by the Stripe API
+type apiError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// CreateCheckoutSession creates a subscription Checkout Session
+func (c *Client) CreateCheckoutSession(ctx context.Context, params CheckoutSessionParams) (*CheckoutSession, error) {
+	form := url.Values{}
+	form.Set("mode", "subscription")
+	form.Set("line_items[0][price]", params.PriceID)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("success_url", params.SuccessURL)
+	form.Set("cancel_url", params.CancelURL)
+	if params.ClientReferenceID != "" {
+		form.Set("client_reference_id", params.ClientReferenceID)
+		form.Set("subscription_data[metadata][user_id]", params.ClientReferenceID)
+	}
+	if params.CustomerID != "" {
+		form.Set("customer", params.CustomerID)
+	} else if params.CustomerEmail != "" {
+		form.Set("customer_email", params.CustomerEmail)
+	}
+
+	var session CheckoutSession
+	if err := c.post(ctx, "/checkout/sessions", form, &session); err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+	return &session, nil
+}
+
+func (c *Client) post(ctx context.Context, path string, form url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create stripe request: %w", err)
+	}
+	req.SetBasicAuth(c.secretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	//nolint:gosec // Intentional external API call to the configured Stripe endpoint
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call stripe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("stripe returned status %d: %s", resp.StatusCode, apiErr.Error.Message)
+		}
+		return fmt.Errorf("stripe returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode stripe response: %w", err)
+	}
+	return nil
+}
+
+// ConstructEvent verifies the signature header of a webhook payload and decodes the event.
+// Signatures older than DefaultTolerance are rejected to prevent replays.
+func ConstructEvent(payload []byte, header, secret string) (*Event, error) {
+	return ConstructEventWithTolerance(payload, header, secret, DefaultTolerance, time.Now())
+}
+
+// ConstructEventWithTolerance is ConstructEvent with an explicit tolerance and current time
+func ConstructEventWithTolerance(payload []byte, header, secret string, tolerance time.Duration, now time.Time) (*Event, error) {
+	if secret == "" {
+		return nil, ErrMissingSecret
+	}
+
+	timestamp, signatures := parseSignatureHeader(header)
+	if timestamp == "" || len(signatures) == 0 {
+		return nil, ErrInvalidSignature
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > tolerance || age < -tolerance {
+		return nil, fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	expected := computeSignature(payload, secret, timestamp)
+	valid := false
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, ErrInvalidSignature
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event: %w", err)
+	}
+	return &event, nil
+}
+
+// GenerateTestHeader signs payload the way Stripe does, for use in tests
+func GenerateTestHeader(payload []byte, secret string, at time.Time) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + computeSignature(payload, secret, timestamp)
+}
+
+// parseSignatureHeader splits "t=...,v1=...,v1=..." into the timestamp and v1 signatures
+func parseSignatureHeader(header string) (string, []string) {
+	var timestamp string
+	var signatures []string
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	return timestamp, signatures
+}
+
+func computeSignature(payload []byte, secret, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
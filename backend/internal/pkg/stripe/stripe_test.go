@@ -0,0 +1,119 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateCheckoutSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/checkout/sessions", r.URL.Path)
+		user, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "sk_test", user)
+
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "subscription", r.PostForm.Get("mode"))
+		assert.Equal(t, "price_1", r.PostForm.Get("line_items[0][price]"))
+		assert.Equal(t, "user-1", r.PostForm.Get("client_reference_id"))
+		assert.Equal(t, "user@example.com", r.PostForm.Get("customer_email"))
+		assert.Empty(t, r.PostForm.Get("customer"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cs_1", "url": "https://checkout.stripe.com/c/cs_1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "sk_test", time.Second)
+	session, err := client.CreateCheckoutSession(context.Background(), CheckoutSessionParams{
+		PriceID:           "price_1",
+		CustomerEmail:     "user@example.com",
+		ClientReferenceID: "user-1",
+		SuccessURL:        "https://app.example.com/billing/success",
+		CancelURL:         "https://app.example.com/billing",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cs_1", session.ID)
+	assert.Equal(t, "https://checkout.stripe.com/c/cs_1", session.URL)
+}
+
+func TestClient_CreateCheckoutSession_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "message": "No such price"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "sk_test", time.Second)
+	_, err := client.CreateCheckoutSession(context.Background(), CheckoutSessionParams{PriceID: "missing"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No such price")
+}
+
+func TestConstructEvent(t *testing.T) {
+	payload := []byte(`{"id": "evt_1", "type": "customer.subscription.updated", "created": 1700000000, "data": {"object": {"id": "sub_1"}}}`)
+	now := time.Unix(1700000100, 0)
+
+	t.Run("valid signature", func(t *testing.T) {
+		header := GenerateTestHeader(payload, "whsec_test", now)
+
+		event, err := ConstructEventWithTolerance(payload, header, "whsec_test", DefaultTolerance, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "evt_1", event.ID)
+		assert.Equal(t, EventSubscriptionUpdated, event.Type)
+		assert.JSONEq(t, `{"id": "sub_1"}`, string(event.Data.Object))
+	})
+
+	t.Run("any of several signatures may match", func(t *testing.T) {
+		header := GenerateTestHeader(payload, "whsec_test", now) + ",v1=deadbeef"
+
+		_, err := ConstructEventWithTolerance(payload, header, "whsec_test", DefaultTolerance, now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		header := GenerateTestHeader(payload, "whsec_other", now)
+
+		_, err := ConstructEventWithTolerance(payload, header, "whsec_test", DefaultTolerance, now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		header := GenerateTestHeader(payload, "whsec_test", now)
+
+		_, err := ConstructEventWithTolerance([]byte(`{"id": "evt_2"}`), header, "whsec_test", DefaultTolerance, now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("replayed signature", func(t *testing.T) {
+		header := GenerateTestHeader(payload, "whsec_test", now.Add(-time.Hour))
+
+		_, err := ConstructEventWithTolerance(payload, header, "whsec_test", DefaultTolerance, now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		_, err := ConstructEventWithTolerance(payload, "garbage", "whsec_test", DefaultTolerance, now)
+
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := ConstructEventWithTolerance(payload, GenerateTestHeader(payload, "", now), "", DefaultTolerance, now)
+
+		assert.ErrorIs(t, err, ErrMissingSecret)
+	})
+}
@@ -0,0 +1,178 @@
+// Package variant defines the structured options of gift items (size, color,
+// model) and the variant a giver picks when reserving one.
+//
+// An item lists the acceptable values of each option; a single value means
+// "exactly this". A reservation may then record which of them the giver is
+// buying. Both are stored as JSONB and read back as empty values when NULL.
+//
+// Usage:
+//
+//	opts, err := variant.Options{Size: []string{"M", "L"}}.Normalize()
+//	choice, err := opts.Match(variant.Choice{Size: "m"}) // Size "M"
+package variant
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxValues is the most values one option can list
+	MaxValues = 20
+	// MaxValueLength is the longest value, in characters
+	MaxValueLength = 50
+)
+
+var (
+	ErrInvalidOptions = errors.New("options must list size, color or model values of 1-50 characters, at most 20 each")
+	ErrInvalidChoice  = errors.New("variant must pick one of the item's listed options")
+)
+
+// Options are the acceptable values of each option of an item
+type Options struct {
+	Size  []string `json:"size,omitempty"`
+	Color []string `json:"color,omitempty"`
+	Model []string `json:"model,omitempty"`
+}
+
+// IsEmpty reports whether no option lists any value
+func (o Options) IsEmpty() bool {
+	return len(o.Size) == 0 && len(o.Color) == 0 && len(o.Model) == 0
+}
+
+// Values returns every listed value, for content screening
+func (o Options) Values() []string {
+	return slices.Concat(o.Size, o.Color, o.Model)
+}
+
+// Normalize trims every value and drops case-insensitive duplicates. It fails
+// with ErrInvalidOptions on an empty or too long value or too many values.
+func (o Options) Normalize() (Options, error) {
+	var out Options
+	for _, f := range []struct {
+		in  []string
+		out *[]string
+	}{{o.Size, &out.Size}, {o.Color, &out.Color}, {o.Model, &out.Model}} {
+		values, err := normalizeValues(f.in)
+		if err != nil {
+			return Options{}, err
+		}
+		*f.out = values
+	}
+	return out, nil
+}
+
+func normalizeValues(in []string) ([]string, error) {
+	if len(in) > MaxValues {
+		return nil, ErrInvalidOptions
+	}
+	var out []string
+	for _, v := range in {
+		v = strings.TrimSpace(v)
+		if v == "" || len([]rune(v)) > MaxValueLength {
+			return nil, ErrInvalidOptions
+		}
+		if !slices.ContainsFunc(out, func(s string) bool { return strings.EqualFold(s, v) }) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// Match checks a choice against the options and returns it spelled as the
+// options list it. Every listed option must be picked; picking an option the
+// item does not list fails with ErrInvalidChoice.
+func (o Options) Match(c Choice) (Choice, error) {
+	var out Choice
+	for _, f := range []struct {
+		name    string
+		values  []string
+		picked  string
+		matched *string
+	}{
+		{"size", o.Size, c.Size, &out.Size},
+		{"color", o.Color, c.Color, &out.Color},
+		{"model", o.Model, c.Model, &out.Model},
+	} {
+		picked := strings.TrimSpace(f.picked)
+		if len(f.values) == 0 {
+			if picked != "" {
+				return Choice{}, fmt.Errorf("%w: the item has no %s option", ErrInvalidChoice, f.name)
+			}
+			continue
+		}
+		i := slices.IndexFunc(f.values, func(v string) bool { return strings.EqualFold(v, picked) })
+		if i < 0 {
+			return Choice{}, fmt.Errorf("%w: %s must be one of %s", ErrInvalidChoice, f.name, strings.Join(f.values, ", "))
+		}
+		*f.matched = f.values[i]
+	}
+	return out, nil
+}
+
+// Scan implements sql.Scanner for a JSONB column
+func (o *Options) Scan(src any) error {
+	*o = Options{}
+	return scanJSON(src, o)
+}
+
+// Value implements driver.Valuer; empty options are stored as NULL
+func (o Options) Value() (driver.Value, error) {
+	if o.IsEmpty() {
+		return nil, nil
+	}
+	return valueJSON(o)
+}
+
+// Choice is the variant picked by a giver, one value per option
+type Choice struct {
+	Size  string `json:"size,omitempty"`
+	Color string `json:"color,omitempty"`
+	Model string `json:"model,omitempty"`
+}
+
+// IsEmpty reports whether nothing was picked
+func (c Choice) IsEmpty() bool {
+	return c == Choice{}
+}
+
+// Scan implements sql.Scanner for a JSONB column
+func (c *Choice) Scan(src any) error {
+	*c = Choice{}
+	return scanJSON(src, c)
+}
+
+// Value implements driver.Valuer; an empty choice is stored as NULL
+func (c Choice) Value() (driver.Value, error) {
+	if c.IsEmpty() {
+		return nil, nil
+	}
+	return valueJSON(c)
+}
+
+func scanJSON(src, dst any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", src, dst)
+	}
+	return json.Unmarshal(data, dst)
+}
+
+func valueJSON(v any) (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,63 @@
+package variant
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_Normalize(t *testing.T) {
+	opts, err := Options{Size: []string{" M ", "L", "m"}, Color: []string{"Navy"}}.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, Options{Size: []string{"M", "L"}, Color: []string{"Navy"}}, opts)
+
+	for _, bad := range []Options{
+		{Size: []string{""}},
+		{Color: []string{strings.Repeat("x", MaxValueLength+1)}},
+		{Model: make([]string, MaxValues+1)},
+	} {
+		_, err := bad.Normalize()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+	}
+}
+
+func TestOptions_Match(t *testing.T) {
+	opts := Options{Size: []string{"M", "L"}, Color: []string{"Navy"}}
+
+	choice, err := opts.Match(Choice{Size: "l", Color: " navy"})
+	require.NoError(t, err)
+	assert.Equal(t, Choice{Size: "L", Color: "Navy"}, choice)
+
+	for _, bad := range []Choice{
+		{Size: "XL", Color: "Navy"},            // not listed
+		{Size: "M"},                            // color not picked
+		{Size: "M", Color: "Navy", Model: "X"}, // no model option
+	} {
+		_, err := opts.Match(bad)
+		assert.ErrorIs(t, err, ErrInvalidChoice)
+	}
+
+	choice, err = Options{}.Match(Choice{})
+	require.NoError(t, err)
+	assert.True(t, choice.IsEmpty())
+}
+
+func TestOptions_ScanValue(t *testing.T) {
+	opts := Options{Size: []string{"M"}}
+	value, err := opts.Value()
+	require.NoError(t, err)
+
+	var scanned Options
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, opts, scanned)
+
+	value, err = Options{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	scanned = Options{}
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsEmpty())
+}
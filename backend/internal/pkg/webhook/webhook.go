@@ -0,0 +1,107 @@
+// Package webhook posts signed JSON events to endpoints registered by third parties.
+//
+// Every request carries the event name, a delivery ID the receiver can use to drop
+// duplicates, and an HMAC-SHA256 signature over the timestamp and body:
+//
+//	X-Webhook-Event: item.reserved
+//	X-Webhook-ID: 9b2f...
+//	X-Webhook-Signature: t=1760541600,v1=5d41402abc4b2a76b9719d911017c592...
+//
+// Receivers recompute hex(HMAC-SHA256(secret, "<t>.<body>")), compare it to v1 and
+// reject old timestamps to prevent replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"wish-list/internal/pkg/httpclient"
+)
+
+// Headers set on every delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderID        = "X-Webhook-ID"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Delivery is one event for one endpoint
+type Delivery struct {
+	ID    string
+	Event string
+	Body  []byte // JSON
+}
+
+// Sender posts deliveries over HTTP
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender creates a Sender whose requests give up after timeout. Only https
+// endpoints on public addresses are called, and redirects are not followed, so an
+// endpoint cannot bounce signed events elsewhere. Failed deliveries are not retried
+// here; the caller schedules retries.
+func NewSender(timeout time.Duration) *Sender {
+	return newSender(timeout, false)
+}
+
+// newSender creates a Sender; allowPrivate lifts the https and public address
+// restrictions for tests
+func newSender(timeout time.Duration, allowPrivate bool) *Sender {
+	opts := httpclient.Options{
+		Timeout:      timeout,
+		BlockPrivate: true,
+		Schemes:      []string{"https"},
+		NoRedirects:  true,
+	}
+	if allowPrivate {
+		opts.BlockPrivate = false
+		opts.Schemes = nil
+	}
+
+	return &Sender{client: httpclient.New(opts), now: time.Now}
+}
+
+// Send posts the delivery to url, signed with secret. Any response other than 2xx
+// is an error.
+func (s *Sender) Send(ctx context.Context, url, secret string, d Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(d.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "WishList-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderID, d.ID)
+	req.Header.Set(HeaderSignature, Sign(secret, s.now(), d.Body))
+
+	//nolint:gosec // Endpoints are registered by partners; non-public and non-https targets are rejected by the client
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the signature header value for body sent at timestamp
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
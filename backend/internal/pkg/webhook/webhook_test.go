@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"wish-list/internal/pkg/httpclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"item.reserved"}`)
+	sentAt := time.Unix(1760541600, 0)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1760541600." + string(body)))
+
+	assert.Equal(t, "t=1760541600,v1="+hex.EncodeToString(mac.Sum(nil)), Sign("whsec_test", sentAt, body))
+	assert.NotEqual(t, Sign("whsec_test", sentAt, body), Sign("whsec_other", sentAt, body))
+}
+
+func TestSender_Send(t *testing.T) {
+	sentAt := time.Unix(1760541600, 0)
+	body := []byte(`{"event":"item.purchased"}`)
+
+	t.Run("posts the signed event", func(t *testing.T) {
+		var got *http.Request
+		var gotBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			gotBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender := newSender(time.Second, true)
+		sender.now = func() time.Time { return sentAt }
+
+		err := sender.Send(context.Background(), server.URL, "whsec_test", Delivery{ID: "delivery-1", Event: "item.purchased", Body: body})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, got.Method)
+		assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+		assert.Equal(t, "item.purchased", got.Header.Get(HeaderEvent))
+		assert.Equal(t, "delivery-1", got.Header.Get(HeaderID))
+		assert.Equal(t, Sign("whsec_test", sentAt, body), got.Header.Get(HeaderSignature))
+		assert.Equal(t, body, gotBody)
+	})
+
+	t.Run("rejected delivery", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := newSender(time.Second, true).Send(context.Background(), server.URL, "whsec_test", Delivery{ID: "delivery-1", Body: body})
+
+		assert.ErrorContains(t, err, "503")
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		followed := false
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			followed = true
+		}))
+		defer target.Close()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		err := newSender(time.Second, true).Send(context.Background(), server.URL, "whsec_test", Delivery{ID: "delivery-1", Body: body})
+
+		assert.Error(t, err)
+		assert.False(t, followed)
+	})
+	t.Run("internal endpoints are refused", func(t *testing.T) {
+		called := false
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		err := NewSender(time.Second).Send(context.Background(), server.URL, "whsec_test", Delivery{ID: "delivery-1", Body: body})
+
+		assert.ErrorIs(t, err, httpclient.ErrForbiddenTarget)
+		assert.False(t, called)
+	})
+
+	t.Run("plain http is refused", func(t *testing.T) {
+		err := NewSender(time.Second).Send(context.Background(), "http://partner.example.com/hooks", "whsec_test", Delivery{ID: "delivery-1", Body: body})
+
+		assert.ErrorIs(t, err, httpclient.ErrSchemeNotAllowed)
+	})
+}
@@ -2,6 +2,12 @@ import type { NextConfig } from 'next';
 
 const path = require('node:path');
 
+// The API generates the sitemap and robots.txt; they have to be served from the
+// site's own origin, so they are proxied
+const apiOrigin = (
+  process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080/api'
+).replace(/\/api\/?$/, '');
+
 const nextConfig: NextConfig = {
   /* config options here */
   reactCompiler: true,
@@ -9,6 +15,13 @@ const nextConfig: NextConfig = {
   // turbopack: {
   //   root: path.join(__dirname),
   // },
+  async rewrites() {
+    return [
+      { source: '/robots.txt', destination: `${apiOrigin}/robots.txt` },
+      { source: '/sitemap.xml', destination: `${apiOrigin}/sitemap.xml` },
+      { source: '/sitemap-:chunk.xml', destination: `${apiOrigin}/sitemap-:chunk.xml` },
+    ];
+  },
 };
 
 export default nextConfig;
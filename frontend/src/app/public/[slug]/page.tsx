@@ -1,7 +1,7 @@
 'use client';
 
 import { useQuery } from '@tanstack/react-query';
-import { useParams } from 'next/navigation';
+import { useParams, useSearchParams } from 'next/navigation';
 import { useMemo, useState } from 'react';
 import { useTranslation } from 'react-i18next';
 import { GuestReservationDialog } from '@/components/guest/GuestReservationDialog';
@@ -41,8 +41,24 @@ const isItemReserved = (item: GiftItem) => {
 
 const isItemPurchased = (item: GiftItem) => !!item.purchased_by_user_id;
 
+const VIEW_SESSION_KEY = 'wishlist-view-session';
+
+// Stable for the browser tab's session, so reloading the page is not a new view
+const getViewSessionId = () => {
+  try {
+    const existing = sessionStorage.getItem(VIEW_SESSION_KEY);
+    if (existing) return existing;
+    const id = crypto.randomUUID();
+    sessionStorage.setItem(VIEW_SESSION_KEY, id);
+    return id;
+  } catch {
+    return crypto.randomUUID();
+  }
+};
+
 export default function PublicWishListPage() {
   const { slug } = useParams<{ slug: string }>();
+  const inviteToken = useSearchParams().get('invite');
   const { t } = useTranslation();
   const [searchQuery, setSearchQuery] = useState('');
   const [statusFilter, setStatusFilter] = useState<StatusFilter>('all');
@@ -70,6 +86,27 @@ export default function PublicWishListPage() {
     retry: 1,
   });
 
+  // Counted once per page load; a failure only leaves the loaded count showing
+  const { data: views } = useQuery({
+    queryKey: ['public-wishlist-view', slug],
+    queryFn: () => apiClient.recordPublicWishListView(slug, getViewSessionId()),
+    enabled: !!slug && !!wishList,
+    staleTime: Number.POSITIVE_INFINITY,
+    refetchOnWindowFocus: false,
+    retry: false,
+  });
+
+  // Opened from an invite email: tells the owner this guest visited
+  useQuery({
+    queryKey: ['invite-visit', inviteToken],
+    queryFn: () =>
+      apiClient.recordInviteVisit(inviteToken as string).then(() => true),
+    enabled: !!inviteToken && !!wishList,
+    staleTime: Number.POSITIVE_INFINITY,
+    refetchOnWindowFocus: false,
+    retry: false,
+  });
+
   const isLoading = isLoadingWishList || isLoadingGiftItems;
   const isError = isErrorWishList || isErrorGiftItems;
   const giftItems = giftItemsData?.items || [];
@@ -164,7 +201,11 @@ export default function PublicWishListPage() {
     <div className="max-w-3xl mx-auto px-4 py-12">
       {/* Wishlist header with staggered entrance */}
       <div className="wl-fade-up wl-delay-0">
-        <WishlistHeader wishlist={wishList} reservedCount={reservedCount} />
+        <WishlistHeader
+          wishlist={wishList}
+          reservedCount={reservedCount}
+          views={views}
+        />
       </div>
 
       {/* List controls */}
@@ -7,11 +7,14 @@ import type { WishList } from '@/lib/api/types';
 interface WishlistHeaderProps {
   wishlist: WishList;
   reservedCount?: number;
+  /** Live count from recording this visit; falls back to the loaded count */
+  views?: number;
 }
 
 export function WishlistHeader({
   wishlist,
   reservedCount = 0,
+  views,
 }: WishlistHeaderProps) {
   const { t } = useTranslation();
 
@@ -27,10 +30,8 @@ export function WishlistHeader({
       })
     : null;
 
-  const viewCount =
-    wishlist.view_count && wishlist.view_count !== '0'
-      ? Number(wishlist.view_count)
-      : null;
+  const totalViews = Number(views ?? wishlist.view_count ?? 0);
+  const viewCount = totalViews > 0 ? totalViews : null;
 
   return (
     <header className="mb-10">
@@ -169,6 +169,52 @@ class ApiClient {
     return data;
   }
 
+  /**
+   * Count a view of a public wishlist (public endpoint)
+   * Repeat views by the same session count once; returns the approximate
+   * view count to display.
+   */
+  async recordPublicWishListView(
+    slug: string,
+    sessionId: string,
+  ): Promise<number> {
+    const response = await fetch(
+      `${API_BASE_URL}/public/wishlists/${encodeURIComponent(slug)}/view`,
+      {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ session_id: sessionId }),
+      },
+    );
+
+    if (!response.ok) {
+      throw new ApiClientError(
+        'Failed to record wish list view',
+        response.status,
+      );
+    }
+
+    const data = (await response.json()) as { views: number };
+    return data.views;
+  }
+
+  /**
+   * Count a visit through the invite link a guest was emailed (public endpoint)
+   */
+  async recordInviteVisit(token: string): Promise<void> {
+    const response = await fetch(
+      `${API_BASE_URL}/public/invites/${encodeURIComponent(token)}/visit`,
+      { method: 'POST' },
+    );
+
+    if (!response.ok) {
+      throw new ApiClientError(
+        'Failed to record invite visit',
+        response.status,
+      );
+    }
+  }
+
   /**
    * Create a reservation for a gift item (public endpoint)
    * Guests provide name/email; authenticated users are identified by token.